@@ -432,19 +432,72 @@ func (x *_Module_11_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Module_12_list)(nil)
+
+type _Module_12_list struct {
+	list *[]*ModuleCallGrant
+}
+
+func (x *_Module_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Module_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Module_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleCallGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Module_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleCallGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Module_12_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleCallGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Module_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Module_12_list) NewElement() protoreflect.Value {
+	v := new(ModuleCallGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Module_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Module                     protoreflect.MessageDescriptor
-	fd_Module_app_name            protoreflect.FieldDescriptor
-	fd_Module_pre_blockers        protoreflect.FieldDescriptor
-	fd_Module_begin_blockers      protoreflect.FieldDescriptor
-	fd_Module_end_blockers        protoreflect.FieldDescriptor
-	fd_Module_tx_validators       protoreflect.FieldDescriptor
-	fd_Module_init_genesis        protoreflect.FieldDescriptor
-	fd_Module_export_genesis      protoreflect.FieldDescriptor
-	fd_Module_order_migrations    protoreflect.FieldDescriptor
-	fd_Module_gas_config          protoreflect.FieldDescriptor
-	fd_Module_override_store_keys protoreflect.FieldDescriptor
-	fd_Module_skip_store_keys     protoreflect.FieldDescriptor
+	md_Module                       protoreflect.MessageDescriptor
+	fd_Module_app_name              protoreflect.FieldDescriptor
+	fd_Module_pre_blockers          protoreflect.FieldDescriptor
+	fd_Module_begin_blockers        protoreflect.FieldDescriptor
+	fd_Module_end_blockers          protoreflect.FieldDescriptor
+	fd_Module_tx_validators         protoreflect.FieldDescriptor
+	fd_Module_init_genesis          protoreflect.FieldDescriptor
+	fd_Module_export_genesis        protoreflect.FieldDescriptor
+	fd_Module_order_migrations      protoreflect.FieldDescriptor
+	fd_Module_gas_config            protoreflect.FieldDescriptor
+	fd_Module_override_store_keys   protoreflect.FieldDescriptor
+	fd_Module_skip_store_keys       protoreflect.FieldDescriptor
+	fd_Module_module_call_grants    protoreflect.FieldDescriptor
+	fd_Module_restrict_module_calls protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -461,6 +514,8 @@ func init() {
 	fd_Module_gas_config = md_Module.Fields().ByName("gas_config")
 	fd_Module_override_store_keys = md_Module.Fields().ByName("override_store_keys")
 	fd_Module_skip_store_keys = md_Module.Fields().ByName("skip_store_keys")
+	fd_Module_module_call_grants = md_Module.Fields().ByName("module_call_grants")
+	fd_Module_restrict_module_calls = md_Module.Fields().ByName("restrict_module_calls")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -594,6 +649,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.ModuleCallGrants) != 0 {
+		value := protoreflect.ValueOfList(&_Module_12_list{list: &x.ModuleCallGrants})
+		if !f(fd_Module_module_call_grants, value) {
+			return
+		}
+	}
+	if x.RestrictModuleCalls != false {
+		value := protoreflect.ValueOfBool(x.RestrictModuleCalls)
+		if !f(fd_Module_restrict_module_calls, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -631,6 +698,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.OverrideStoreKeys) != 0
 	case "cosmos.app.runtime.v2.Module.skip_store_keys":
 		return len(x.SkipStoreKeys) != 0
+	case "cosmos.app.runtime.v2.Module.module_call_grants":
+		return len(x.ModuleCallGrants) != 0
+	case "cosmos.app.runtime.v2.Module.restrict_module_calls":
+		return x.RestrictModuleCalls != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.Module"))
@@ -669,6 +740,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.OverrideStoreKeys = nil
 	case "cosmos.app.runtime.v2.Module.skip_store_keys":
 		x.SkipStoreKeys = nil
+	case "cosmos.app.runtime.v2.Module.module_call_grants":
+		x.ModuleCallGrants = nil
+	case "cosmos.app.runtime.v2.Module.restrict_module_calls":
+		x.RestrictModuleCalls = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.Module"))
@@ -745,6 +820,15 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Module_11_list{list: &x.SkipStoreKeys}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.app.runtime.v2.Module.module_call_grants":
+		if len(x.ModuleCallGrants) == 0 {
+			return protoreflect.ValueOfList(&_Module_12_list{})
+		}
+		listValue := &_Module_12_list{list: &x.ModuleCallGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.app.runtime.v2.Module.restrict_module_calls":
+		value := x.RestrictModuleCalls
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.Module"))
@@ -805,6 +889,12 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Module_11_list)
 		x.SkipStoreKeys = *clv.list
+	case "cosmos.app.runtime.v2.Module.module_call_grants":
+		lv := value.List()
+		clv := lv.(*_Module_12_list)
+		x.ModuleCallGrants = *clv.list
+	case "cosmos.app.runtime.v2.Module.restrict_module_calls":
+		x.RestrictModuleCalls = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.Module"))
@@ -884,8 +974,16 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Module_11_list{list: &x.SkipStoreKeys}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.app.runtime.v2.Module.module_call_grants":
+		if x.ModuleCallGrants == nil {
+			x.ModuleCallGrants = []*ModuleCallGrant{}
+		}
+		value := &_Module_12_list{list: &x.ModuleCallGrants}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.app.runtime.v2.Module.app_name":
 		panic(fmt.Errorf("field app_name of message cosmos.app.runtime.v2.Module is not mutable"))
+	case "cosmos.app.runtime.v2.Module.restrict_module_calls":
+		panic(fmt.Errorf("field restrict_module_calls of message cosmos.app.runtime.v2.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.Module"))
@@ -931,6 +1029,11 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cosmos.app.runtime.v2.Module.skip_store_keys":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Module_11_list{list: &list})
+	case "cosmos.app.runtime.v2.Module.module_call_grants":
+		list := []*ModuleCallGrant{}
+		return protoreflect.ValueOfList(&_Module_12_list{list: &list})
+	case "cosmos.app.runtime.v2.Module.restrict_module_calls":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.Module"))
@@ -1062,6 +1165,15 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.ModuleCallGrants) > 0 {
+			for _, e := range x.ModuleCallGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RestrictModuleCalls {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1091,6 +1203,32 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RestrictModuleCalls {
+			i--
+			if x.RestrictModuleCalls {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.ModuleCallGrants) > 0 {
+			for iNdEx := len(x.ModuleCallGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ModuleCallGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
 		if len(x.SkipStoreKeys) > 0 {
 			for iNdEx := len(x.SkipStoreKeys) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.SkipStoreKeys[iNdEx])
@@ -1607,6 +1745,60 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 				}
 				x.SkipStoreKeys = append(x.SkipStoreKeys, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleCallGrants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleCallGrants = append(x.ModuleCallGrants, &ModuleCallGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ModuleCallGrants[len(x.ModuleCallGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RestrictModuleCalls", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RestrictModuleCalls = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2142,28 +2334,74 @@ func (x *fastReflection_GasConfig) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ModuleCallGrant_2_list)(nil)
+
+type _ModuleCallGrant_2_list struct {
+	list *[]string
+}
+
+func (x *_ModuleCallGrant_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleCallGrant_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ModuleCallGrant_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleCallGrant_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleCallGrant_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ModuleCallGrant at list field MsgTypeUrls as it is not of Message kind"))
+}
+
+func (x *_ModuleCallGrant_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleCallGrant_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ModuleCallGrant_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_StoreKeyConfig              protoreflect.MessageDescriptor
-	fd_StoreKeyConfig_module_name  protoreflect.FieldDescriptor
-	fd_StoreKeyConfig_kv_store_key protoreflect.FieldDescriptor
+	md_ModuleCallGrant               protoreflect.MessageDescriptor
+	fd_ModuleCallGrant_module_name   protoreflect.FieldDescriptor
+	fd_ModuleCallGrant_msg_type_urls protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_app_runtime_v2_module_proto_init()
-	md_StoreKeyConfig = File_cosmos_app_runtime_v2_module_proto.Messages().ByName("StoreKeyConfig")
-	fd_StoreKeyConfig_module_name = md_StoreKeyConfig.Fields().ByName("module_name")
-	fd_StoreKeyConfig_kv_store_key = md_StoreKeyConfig.Fields().ByName("kv_store_key")
+	md_ModuleCallGrant = File_cosmos_app_runtime_v2_module_proto.Messages().ByName("ModuleCallGrant")
+	fd_ModuleCallGrant_module_name = md_ModuleCallGrant.Fields().ByName("module_name")
+	fd_ModuleCallGrant_msg_type_urls = md_ModuleCallGrant.Fields().ByName("msg_type_urls")
 }
 
-var _ protoreflect.Message = (*fastReflection_StoreKeyConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_ModuleCallGrant)(nil)
 
-type fastReflection_StoreKeyConfig StoreKeyConfig
+type fastReflection_ModuleCallGrant ModuleCallGrant
 
-func (x *StoreKeyConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_StoreKeyConfig)(x)
+func (x *ModuleCallGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleCallGrant)(x)
 }
 
-func (x *StoreKeyConfig) slowProtoReflect() protoreflect.Message {
+func (x *ModuleCallGrant) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_app_runtime_v2_module_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2175,43 +2413,43 @@ func (x *StoreKeyConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_StoreKeyConfig_messageType fastReflection_StoreKeyConfig_messageType
-var _ protoreflect.MessageType = fastReflection_StoreKeyConfig_messageType{}
+var _fastReflection_ModuleCallGrant_messageType fastReflection_ModuleCallGrant_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleCallGrant_messageType{}
 
-type fastReflection_StoreKeyConfig_messageType struct{}
+type fastReflection_ModuleCallGrant_messageType struct{}
 
-func (x fastReflection_StoreKeyConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_StoreKeyConfig)(nil)
+func (x fastReflection_ModuleCallGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleCallGrant)(nil)
 }
-func (x fastReflection_StoreKeyConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_StoreKeyConfig)
+func (x fastReflection_ModuleCallGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleCallGrant)
 }
-func (x fastReflection_StoreKeyConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_StoreKeyConfig
+func (x fastReflection_ModuleCallGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleCallGrant
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_StoreKeyConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_StoreKeyConfig
+func (x *fastReflection_ModuleCallGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleCallGrant
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_StoreKeyConfig) Type() protoreflect.MessageType {
-	return _fastReflection_StoreKeyConfig_messageType
+func (x *fastReflection_ModuleCallGrant) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleCallGrant_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_StoreKeyConfig) New() protoreflect.Message {
-	return new(fastReflection_StoreKeyConfig)
+func (x *fastReflection_ModuleCallGrant) New() protoreflect.Message {
+	return new(fastReflection_ModuleCallGrant)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_StoreKeyConfig) Interface() protoreflect.ProtoMessage {
-	return (*StoreKeyConfig)(x)
+func (x *fastReflection_ModuleCallGrant) Interface() protoreflect.ProtoMessage {
+	return (*ModuleCallGrant)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2219,16 +2457,16 @@ func (x *fastReflection_StoreKeyConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_StoreKeyConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_ModuleCallGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ModuleName != "" {
 		value := protoreflect.ValueOfString(x.ModuleName)
-		if !f(fd_StoreKeyConfig_module_name, value) {
+		if !f(fd_ModuleCallGrant_module_name, value) {
 			return
 		}
 	}
-	if x.KvStoreKey != "" {
-		value := protoreflect.ValueOfString(x.KvStoreKey)
-		if !f(fd_StoreKeyConfig_kv_store_key, value) {
+	if len(x.MsgTypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleCallGrant_2_list{list: &x.MsgTypeUrls})
+		if !f(fd_ModuleCallGrant_msg_type_urls, value) {
 			return
 		}
 	}
@@ -2245,17 +2483,17 @@ func (x *fastReflection_StoreKeyConfig) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_StoreKeyConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ModuleCallGrant) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+	case "cosmos.app.runtime.v2.ModuleCallGrant.module_name":
 		return x.ModuleName != ""
-	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
-		return x.KvStoreKey != ""
+	case "cosmos.app.runtime.v2.ModuleCallGrant.msg_type_urls":
+		return len(x.MsgTypeUrls) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.ModuleCallGrant"))
 		}
-		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.ModuleCallGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2265,17 +2503,17 @@ func (x *fastReflection_StoreKeyConfig) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StoreKeyConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ModuleCallGrant) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+	case "cosmos.app.runtime.v2.ModuleCallGrant.module_name":
 		x.ModuleName = ""
-	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
-		x.KvStoreKey = ""
+	case "cosmos.app.runtime.v2.ModuleCallGrant.msg_type_urls":
+		x.MsgTypeUrls = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.ModuleCallGrant"))
 		}
-		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.ModuleCallGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2285,19 +2523,22 @@ func (x *fastReflection_StoreKeyConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_StoreKeyConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleCallGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+	case "cosmos.app.runtime.v2.ModuleCallGrant.module_name":
 		value := x.ModuleName
 		return protoreflect.ValueOfString(value)
-	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
-		value := x.KvStoreKey
-		return protoreflect.ValueOfString(value)
+	case "cosmos.app.runtime.v2.ModuleCallGrant.msg_type_urls":
+		if len(x.MsgTypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_ModuleCallGrant_2_list{})
+		}
+		listValue := &_ModuleCallGrant_2_list{list: &x.MsgTypeUrls}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.ModuleCallGrant"))
 		}
-		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.ModuleCallGrant does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2311,17 +2552,19 @@ func (x *fastReflection_StoreKeyConfig) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StoreKeyConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ModuleCallGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+	case "cosmos.app.runtime.v2.ModuleCallGrant.module_name":
 		x.ModuleName = value.Interface().(string)
-	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
-		x.KvStoreKey = value.Interface().(string)
+	case "cosmos.app.runtime.v2.ModuleCallGrant.msg_type_urls":
+		lv := value.List()
+		clv := lv.(*_ModuleCallGrant_2_list)
+		x.MsgTypeUrls = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.ModuleCallGrant"))
 		}
-		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.ModuleCallGrant does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2335,44 +2578,49 @@ func (x *fastReflection_StoreKeyConfig) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StoreKeyConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleCallGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
-		panic(fmt.Errorf("field module_name of message cosmos.app.runtime.v2.StoreKeyConfig is not mutable"))
-	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
-		panic(fmt.Errorf("field kv_store_key of message cosmos.app.runtime.v2.StoreKeyConfig is not mutable"))
+	case "cosmos.app.runtime.v2.ModuleCallGrant.msg_type_urls":
+		if x.MsgTypeUrls == nil {
+			x.MsgTypeUrls = []string{}
+		}
+		value := &_ModuleCallGrant_2_list{list: &x.MsgTypeUrls}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.app.runtime.v2.ModuleCallGrant.module_name":
+		panic(fmt.Errorf("field module_name of message cosmos.app.runtime.v2.ModuleCallGrant is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.ModuleCallGrant"))
 		}
-		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.ModuleCallGrant does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_StoreKeyConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ModuleCallGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
-		return protoreflect.ValueOfString("")
-	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
+	case "cosmos.app.runtime.v2.ModuleCallGrant.module_name":
 		return protoreflect.ValueOfString("")
+	case "cosmos.app.runtime.v2.ModuleCallGrant.msg_type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ModuleCallGrant_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.ModuleCallGrant"))
 		}
-		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.ModuleCallGrant does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_StoreKeyConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ModuleCallGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.app.runtime.v2.StoreKeyConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.app.runtime.v2.ModuleCallGrant", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2380,7 +2628,7 @@ func (x *fastReflection_StoreKeyConfig) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_StoreKeyConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ModuleCallGrant) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2391,7 +2639,7 @@ func (x *fastReflection_StoreKeyConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StoreKeyConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ModuleCallGrant) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2403,7 +2651,7 @@ func (x *fastReflection_StoreKeyConfig) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_StoreKeyConfig) IsValid() bool {
+func (x *fastReflection_ModuleCallGrant) IsValid() bool {
 	return x != nil
 }
 
@@ -2413,9 +2661,9 @@ func (x *fastReflection_StoreKeyConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ModuleCallGrant) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*StoreKeyConfig)
+		x := input.Message.Interface().(*ModuleCallGrant)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2431,9 +2679,11 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.KvStoreKey)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.MsgTypeUrls) > 0 {
+			for _, s := range x.MsgTypeUrls {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2445,7 +2695,7 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*StoreKeyConfig)
+		x := input.Message.Interface().(*ModuleCallGrant)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2464,12 +2714,14 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.KvStoreKey) > 0 {
-			i -= len(x.KvStoreKey)
-			copy(dAtA[i:], x.KvStoreKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KvStoreKey)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.MsgTypeUrls) > 0 {
+			for iNdEx := len(x.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypeUrls[iNdEx])
+				copy(dAtA[i:], x.MsgTypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
 		if len(x.ModuleName) > 0 {
 			i -= len(x.ModuleName)
@@ -2489,7 +2741,7 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*StoreKeyConfig)
+		x := input.Message.Interface().(*ModuleCallGrant)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2521,10 +2773,10 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleCallGrant: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleCallGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2561,7 +2813,7 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KvStoreKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2589,7 +2841,7 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.KvStoreKey = string(dAtA[iNdEx:postIndex])
+				x.MsgTypeUrls = append(x.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2626,9 +2878,493 @@ func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
+var (
+	md_StoreKeyConfig              protoreflect.MessageDescriptor
+	fd_StoreKeyConfig_module_name  protoreflect.FieldDescriptor
+	fd_StoreKeyConfig_kv_store_key protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_app_runtime_v2_module_proto_init()
+	md_StoreKeyConfig = File_cosmos_app_runtime_v2_module_proto.Messages().ByName("StoreKeyConfig")
+	fd_StoreKeyConfig_module_name = md_StoreKeyConfig.Fields().ByName("module_name")
+	fd_StoreKeyConfig_kv_store_key = md_StoreKeyConfig.Fields().ByName("kv_store_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_StoreKeyConfig)(nil)
+
+type fastReflection_StoreKeyConfig StoreKeyConfig
+
+func (x *StoreKeyConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoreKeyConfig)(x)
+}
+
+func (x *StoreKeyConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_app_runtime_v2_module_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StoreKeyConfig_messageType fastReflection_StoreKeyConfig_messageType
+var _ protoreflect.MessageType = fastReflection_StoreKeyConfig_messageType{}
+
+type fastReflection_StoreKeyConfig_messageType struct{}
+
+func (x fastReflection_StoreKeyConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoreKeyConfig)(nil)
+}
+func (x fastReflection_StoreKeyConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoreKeyConfig)
+}
+func (x fastReflection_StoreKeyConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreKeyConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StoreKeyConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreKeyConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StoreKeyConfig) Type() protoreflect.MessageType {
+	return _fastReflection_StoreKeyConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StoreKeyConfig) New() protoreflect.Message {
+	return new(fastReflection_StoreKeyConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StoreKeyConfig) Interface() protoreflect.ProtoMessage {
+	return (*StoreKeyConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StoreKeyConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_StoreKeyConfig_module_name, value) {
+			return
+		}
+	}
+	if x.KvStoreKey != "" {
+		value := protoreflect.ValueOfString(x.KvStoreKey)
+		if !f(fd_StoreKeyConfig_kv_store_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StoreKeyConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+		return x.ModuleName != ""
+	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
+		return x.KvStoreKey != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreKeyConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+		x.ModuleName = ""
+	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
+		x.KvStoreKey = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StoreKeyConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
+		value := x.KvStoreKey
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreKeyConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
+		x.KvStoreKey = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreKeyConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+		panic(fmt.Errorf("field module_name of message cosmos.app.runtime.v2.StoreKeyConfig is not mutable"))
+	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
+		panic(fmt.Errorf("field kv_store_key of message cosmos.app.runtime.v2.StoreKeyConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StoreKeyConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.app.runtime.v2.StoreKeyConfig.module_name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.app.runtime.v2.StoreKeyConfig.kv_store_key":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.app.runtime.v2.StoreKeyConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.app.runtime.v2.StoreKeyConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StoreKeyConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.app.runtime.v2.StoreKeyConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StoreKeyConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StoreKeyConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StoreKeyConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StoreKeyConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StoreKeyConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KvStoreKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StoreKeyConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.KvStoreKey) > 0 {
+			i -= len(x.KvStoreKey)
+			copy(dAtA[i:], x.KvStoreKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KvStoreKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StoreKeyConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreKeyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KvStoreKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KvStoreKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: cosmos/app/runtime/v2/module.proto
 
@@ -2682,6 +3418,14 @@ type Module struct {
 	// module's keeper. This is useful when a module does not have a store key.
 	// NOTE: the provided environment variable will have a fake store service.
 	SkipStoreKeys []string `protobuf:"bytes,11,rep,name=skip_store_keys,json=skipStoreKeys,proto3" json:"skip_store_keys,omitempty"`
+	// module_call_grants grants modules the capability to invoke messages of other
+	// modules through the message router service, without encoding a transaction.
+	// A module listed here can only invoke the messages granted to it.
+	ModuleCallGrants []*ModuleCallGrant `protobuf:"bytes,12,rep,name=module_call_grants,json=moduleCallGrants,proto3" json:"module_call_grants,omitempty"`
+	// restrict_module_calls, if set, prevents the modules not listed in
+	// module_call_grants from invoking any message through the message router
+	// service. Otherwise, they can invoke all messages.
+	RestrictModuleCalls bool `protobuf:"varint,13,opt,name=restrict_module_calls,json=restrictModuleCalls,proto3" json:"restrict_module_calls,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -2781,6 +3525,20 @@ func (x *Module) GetSkipStoreKeys() []string {
 	return nil
 }
 
+func (x *Module) GetModuleCallGrants() []*ModuleCallGrant {
+	if x != nil {
+		return x.ModuleCallGrants
+	}
+	return nil
+}
+
+func (x *Module) GetRestrictModuleCalls() bool {
+	if x != nil {
+		return x.RestrictModuleCalls
+	}
+	return false
+}
+
 // GasConfig is the config object for gas limits.
 type GasConfig struct {
 	state         protoimpl.MessageState
@@ -2836,6 +3594,55 @@ func (x *GasConfig) GetSimulationGasLimit() uint64 {
 	return 0
 }
 
+// ModuleCallGrant grants a module the capability to invoke messages through the
+// message router service.
+type ModuleCallGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module_name is the name of the calling module.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// msg_type_urls are the type URLs of the messages the module can invoke, e.g.
+	// "/cosmos.bank.v1beta1.MsgSend". The "*" wildcard grants all messages and
+	// should only be used by modules executing arbitrary messages, like x/gov.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (x *ModuleCallGrant) Reset() {
+	*x = ModuleCallGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_app_runtime_v2_module_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleCallGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleCallGrant) ProtoMessage() {}
+
+// Deprecated: Use ModuleCallGrant.ProtoReflect.Descriptor instead.
+func (*ModuleCallGrant) Descriptor() ([]byte, []int) {
+	return file_cosmos_app_runtime_v2_module_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ModuleCallGrant) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ModuleCallGrant) GetMsgTypeUrls() []string {
+	if x != nil {
+		return x.MsgTypeUrls
+	}
+	return nil
+}
+
 // StoreKeyConfig may be supplied to override the default module store key, which
 // is the module name.
 type StoreKeyConfig struct {
@@ -2852,7 +3659,7 @@ type StoreKeyConfig struct {
 func (x *StoreKeyConfig) Reset() {
 	*x = StoreKeyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_app_runtime_v2_module_proto_msgTypes[2]
+		mi := &file_cosmos_app_runtime_v2_module_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2866,7 +3673,7 @@ func (*StoreKeyConfig) ProtoMessage() {}
 
 // Deprecated: Use StoreKeyConfig.ProtoReflect.Descriptor instead.
 func (*StoreKeyConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_app_runtime_v2_module_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_app_runtime_v2_module_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StoreKeyConfig) GetModuleName() string {
@@ -2891,7 +3698,7 @@ var file_cosmos_app_runtime_v2_module_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x70,
 	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x32, 0x1a, 0x20, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x04,
+	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x05,
 	0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e,
 	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
@@ -2922,39 +3729,53 @@ var file_cosmos_app_runtime_v2_module_proto_rawDesc = []byte{
 	0x11, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65,
 	0x79, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
 	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6b, 0x69,
-	0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x36, 0xba, 0xc0, 0x96, 0xda,
-	0x01, 0x30, 0x0a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x32, 0x12, 0x15, 0x0a, 0x13, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x22, 0x98, 0x01, 0x0a, 0x09, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x31, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x78, 0x5f,
-	0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x78, 0x47, 0x61, 0x73, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x67, 0x61, 0x73,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x69, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x53, 0x0a,
-	0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0c, 0x6b, 0x76, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x76, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b,
-	0x65, 0x79, 0x42, 0xd1, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x32,
-	0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x76,
-	0x32, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x52, 0xaa, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x70, 0x70, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x32, 0xca,
-	0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x21, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x41, 0x70, 0x70, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x32, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x70, 0x70, 0x3a, 0x3a, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x54, 0x0a, 0x12, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x10,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x13, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43,
+	0x61, 0x6c, 0x6c, 0x73, 0x3a, 0x36, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x30, 0x0a, 0x17, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2f, 0x76, 0x32, 0x12, 0x15, 0x0a, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x98, 0x01, 0x0a,
+	0x09, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x31, 0x0a, 0x15, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x78, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x47, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x56, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x43, 0x61, 0x6c, 0x6c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x22,
+	0x53, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6b, 0x76, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x76, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x4b, 0x65, 0x79, 0x42, 0xd1, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x32, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x32, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x52, 0xaa, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56,
+	0x32, 0xca, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x21, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56,
+	0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x70, 0x70, 0x3a, 0x3a, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2969,20 +3790,22 @@ func file_cosmos_app_runtime_v2_module_proto_rawDescGZIP() []byte {
 	return file_cosmos_app_runtime_v2_module_proto_rawDescData
 }
 
-var file_cosmos_app_runtime_v2_module_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_app_runtime_v2_module_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cosmos_app_runtime_v2_module_proto_goTypes = []interface{}{
-	(*Module)(nil),         // 0: cosmos.app.runtime.v2.Module
-	(*GasConfig)(nil),      // 1: cosmos.app.runtime.v2.GasConfig
-	(*StoreKeyConfig)(nil), // 2: cosmos.app.runtime.v2.StoreKeyConfig
+	(*Module)(nil),          // 0: cosmos.app.runtime.v2.Module
+	(*GasConfig)(nil),       // 1: cosmos.app.runtime.v2.GasConfig
+	(*ModuleCallGrant)(nil), // 2: cosmos.app.runtime.v2.ModuleCallGrant
+	(*StoreKeyConfig)(nil),  // 3: cosmos.app.runtime.v2.StoreKeyConfig
 }
 var file_cosmos_app_runtime_v2_module_proto_depIdxs = []int32{
 	1, // 0: cosmos.app.runtime.v2.Module.gas_config:type_name -> cosmos.app.runtime.v2.GasConfig
-	2, // 1: cosmos.app.runtime.v2.Module.override_store_keys:type_name -> cosmos.app.runtime.v2.StoreKeyConfig
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 1: cosmos.app.runtime.v2.Module.override_store_keys:type_name -> cosmos.app.runtime.v2.StoreKeyConfig
+	2, // 2: cosmos.app.runtime.v2.Module.module_call_grants:type_name -> cosmos.app.runtime.v2.ModuleCallGrant
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_app_runtime_v2_module_proto_init() }
@@ -3016,6 +3839,18 @@ func file_cosmos_app_runtime_v2_module_proto_init() {
 			}
 		}
 		file_cosmos_app_runtime_v2_module_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleCallGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_app_runtime_v2_module_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoreKeyConfig); i {
 			case 0:
 				return &v.state
@@ -3034,7 +3869,7 @@ func file_cosmos_app_runtime_v2_module_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_app_runtime_v2_module_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
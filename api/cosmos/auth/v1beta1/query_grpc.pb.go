@@ -29,6 +29,10 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_PubKeyRotations_FullMethodName      = "/cosmos.auth.v1beta1.Query/PubKeyRotations"
+	Query_AccountNumberInfo_FullMethodName    = "/cosmos.auth.v1beta1.Query/AccountNumberInfo"
+	Query_AccountNumberAudit_FullMethodName   = "/cosmos.auth.v1beta1.Query/AccountNumberAudit"
+	Query_AnteRejections_FullMethodName       = "/cosmos.auth.v1beta1.Query/AnteRejections"
 )
 
 // QueryClient is the client API for Query service.
@@ -59,6 +63,21 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// PubKeyRotations returns the public key rotation history of an account.
+	PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error)
+	// AccountNumberInfo returns the next account number to be allocated and the
+	// high-water mark of the account numbers in use.
+	AccountNumberInfo(ctx context.Context, in *QueryAccountNumberInfoRequest, opts ...grpc.CallOption) (*QueryAccountNumberInfoResponse, error)
+	// AccountNumberAudit scans all the accounts for account number gaps and
+	// collisions.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the number of accounts is large.
+	AccountNumberAudit(ctx context.Context, in *QueryAccountNumberAuditRequest, opts ...grpc.CallOption) (*QueryAccountNumberAuditResponse, error)
+	// AnteRejections returns the number of transactions rejected by each ante
+	// decorator, by error code, since the queried node started. The counters are
+	// local to the node and are not part of the state.
+	AnteRejections(ctx context.Context, in *QueryAnteRejectionsRequest, opts ...grpc.CallOption) (*QueryAnteRejectionsResponse, error)
 }
 
 type queryClient struct {
@@ -169,6 +188,46 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, Query_PubKeyRotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountNumberInfo(ctx context.Context, in *QueryAccountNumberInfoRequest, opts ...grpc.CallOption) (*QueryAccountNumberInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountNumberInfoResponse)
+	err := c.cc.Invoke(ctx, Query_AccountNumberInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountNumberAudit(ctx context.Context, in *QueryAccountNumberAuditRequest, opts ...grpc.CallOption) (*QueryAccountNumberAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountNumberAuditResponse)
+	err := c.cc.Invoke(ctx, Query_AccountNumberAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnteRejections(ctx context.Context, in *QueryAnteRejectionsRequest, opts ...grpc.CallOption) (*QueryAnteRejectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAnteRejectionsResponse)
+	err := c.cc.Invoke(ctx, Query_AnteRejections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -197,6 +256,21 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// PubKeyRotations returns the public key rotation history of an account.
+	PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error)
+	// AccountNumberInfo returns the next account number to be allocated and the
+	// high-water mark of the account numbers in use.
+	AccountNumberInfo(context.Context, *QueryAccountNumberInfoRequest) (*QueryAccountNumberInfoResponse, error)
+	// AccountNumberAudit scans all the accounts for account number gaps and
+	// collisions.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the number of accounts is large.
+	AccountNumberAudit(context.Context, *QueryAccountNumberAuditRequest) (*QueryAccountNumberAuditResponse, error)
+	// AnteRejections returns the number of transactions rejected by each ante
+	// decorator, by error code, since the queried node started. The counters are
+	// local to the node and are not part of the state.
+	AnteRejections(context.Context, *QueryAnteRejectionsRequest) (*QueryAnteRejectionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -237,6 +311,18 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyRotations not implemented")
+}
+func (UnimplementedQueryServer) AccountNumberInfo(context.Context, *QueryAccountNumberInfoRequest) (*QueryAccountNumberInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountNumberInfo not implemented")
+}
+func (UnimplementedQueryServer) AccountNumberAudit(context.Context, *QueryAccountNumberAuditRequest) (*QueryAccountNumberAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountNumberAudit not implemented")
+}
+func (UnimplementedQueryServer) AnteRejections(context.Context, *QueryAnteRejectionsRequest) (*QueryAnteRejectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnteRejections not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -438,6 +524,78 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PubKeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyRotations(ctx, req.(*QueryPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountNumberInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountNumberInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountNumberInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountNumberInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountNumberInfo(ctx, req.(*QueryAccountNumberInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountNumberAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountNumberAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountNumberAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountNumberAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountNumberAudit(ctx, req.(*QueryAccountNumberAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnteRejections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnteRejectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnteRejections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AnteRejections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnteRejections(ctx, req.(*QueryAnteRejectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -485,6 +643,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "PubKeyRotations",
+			Handler:    _Query_PubKeyRotations_Handler,
+		},
+		{
+			MethodName: "AccountNumberInfo",
+			Handler:    _Query_AccountNumberInfo_Handler,
+		},
+		{
+			MethodName: "AccountNumberAudit",
+			Handler:    _Query_AccountNumberAudit_Handler,
+		},
+		{
+			MethodName: "AnteRejections",
+			Handler:    _Query_AnteRejections_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
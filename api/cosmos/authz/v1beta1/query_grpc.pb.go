@@ -24,6 +24,7 @@ const (
 	Query_Grants_FullMethodName        = "/cosmos.authz.v1beta1.Query/Grants"
 	Query_GranterGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranterGrants"
 	Query_GranteeGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_CanExec_FullMethodName       = "/cosmos.authz.v1beta1.Query/CanExec"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +39,10 @@ type QueryClient interface {
 	GranterGrants(ctx context.Context, in *QueryGranterGrantsRequest, opts ...grpc.CallOption) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// CanExec returns whether the grantee would be authorized to execute the messages
+	// with a MsgExec at the current block, evaluating the grants of the signers of the
+	// messages without executing them.
+	CanExec(ctx context.Context, in *QueryCanExecRequest, opts ...grpc.CallOption) (*QueryCanExecResponse, error)
 }
 
 type queryClient struct {
@@ -78,6 +83,16 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) CanExec(ctx context.Context, in *QueryCanExecRequest, opts ...grpc.CallOption) (*QueryCanExecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCanExecResponse)
+	err := c.cc.Invoke(ctx, Query_CanExec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -90,6 +105,10 @@ type QueryServer interface {
 	GranterGrants(context.Context, *QueryGranterGrantsRequest) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// CanExec returns whether the grantee would be authorized to execute the messages
+	// with a MsgExec at the current block, evaluating the grants of the signers of the
+	// messages without executing them.
+	CanExec(context.Context, *QueryCanExecRequest) (*QueryCanExecResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -109,6 +128,9 @@ func (UnimplementedQueryServer) GranterGrants(context.Context, *QueryGranterGran
 func (UnimplementedQueryServer) GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (UnimplementedQueryServer) CanExec(context.Context, *QueryCanExecRequest) (*QueryCanExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanExec not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -184,6 +206,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CanExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CanExec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanExec(ctx, req.(*QueryCanExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +243,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "CanExec",
+			Handler:    _Query_CanExec_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
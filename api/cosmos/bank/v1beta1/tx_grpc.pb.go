@@ -19,11 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName             = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName        = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName             = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName   = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_BlockAddresses_FullMethodName   = "/cosmos.bank.v1beta1.Msg/BlockAddresses"
+	Msg_UnblockAddresses_FullMethodName = "/cosmos.bank.v1beta1.Msg/UnblockAddresses"
+	Msg_SendWithTimeout_FullMethodName  = "/cosmos.bank.v1beta1.Msg/SendWithTimeout"
+	Msg_ClaimEscrow_FullMethodName      = "/cosmos.bank.v1beta1.Msg/ClaimEscrow"
+	Msg_RefundEscrow_FullMethodName     = "/cosmos.bank.v1beta1.Msg/RefundEscrow"
 )
 
 // MsgClient is the client API for Msg service.
@@ -46,6 +51,24 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// BlockAddresses is a governance operation for blocking addresses from receiving funds.
+	// The authority is defined in the keeper.
+	BlockAddresses(ctx context.Context, in *MsgBlockAddresses, opts ...grpc.CallOption) (*MsgBlockAddressesResponse, error)
+	// UnblockAddresses is a governance operation for allowing addresses previously blocked
+	// with BlockAddresses to receive funds again. The addresses blocked by default, such as
+	// the module accounts, cannot be unblocked.
+	// The authority is defined in the keeper.
+	UnblockAddresses(ctx context.Context, in *MsgUnblockAddresses, opts ...grpc.CallOption) (*MsgUnblockAddressesResponse, error)
+	// SendWithTimeout defines a method for sending coins to an account through an escrow.
+	// The recipient can claim the coins with ClaimEscrow until the timeout elapses,
+	// after which the sender can get them back with RefundEscrow.
+	SendWithTimeout(ctx context.Context, in *MsgSendWithTimeout, opts ...grpc.CallOption) (*MsgSendWithTimeoutResponse, error)
+	// ClaimEscrow defines a method for the recipient of an escrow to claim its coins
+	// before the escrow expires.
+	ClaimEscrow(ctx context.Context, in *MsgClaimEscrow, opts ...grpc.CallOption) (*MsgClaimEscrowResponse, error)
+	// RefundEscrow defines a method for the sender of an escrow to get its coins back
+	// once the escrow expired without being claimed.
+	RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error)
 }
 
 type msgClient struct {
@@ -106,6 +129,56 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) BlockAddresses(ctx context.Context, in *MsgBlockAddresses, opts ...grpc.CallOption) (*MsgBlockAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBlockAddressesResponse)
+	err := c.cc.Invoke(ctx, Msg_BlockAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblockAddresses(ctx context.Context, in *MsgUnblockAddresses, opts ...grpc.CallOption) (*MsgUnblockAddressesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnblockAddressesResponse)
+	err := c.cc.Invoke(ctx, Msg_UnblockAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendWithTimeout(ctx context.Context, in *MsgSendWithTimeout, opts ...grpc.CallOption) (*MsgSendWithTimeoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSendWithTimeoutResponse)
+	err := c.cc.Invoke(ctx, Msg_SendWithTimeout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimEscrow(ctx context.Context, in *MsgClaimEscrow, opts ...grpc.CallOption) (*MsgClaimEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRefundEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_RefundEscrow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -126,6 +199,24 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// BlockAddresses is a governance operation for blocking addresses from receiving funds.
+	// The authority is defined in the keeper.
+	BlockAddresses(context.Context, *MsgBlockAddresses) (*MsgBlockAddressesResponse, error)
+	// UnblockAddresses is a governance operation for allowing addresses previously blocked
+	// with BlockAddresses to receive funds again. The addresses blocked by default, such as
+	// the module accounts, cannot be unblocked.
+	// The authority is defined in the keeper.
+	UnblockAddresses(context.Context, *MsgUnblockAddresses) (*MsgUnblockAddressesResponse, error)
+	// SendWithTimeout defines a method for sending coins to an account through an escrow.
+	// The recipient can claim the coins with ClaimEscrow until the timeout elapses,
+	// after which the sender can get them back with RefundEscrow.
+	SendWithTimeout(context.Context, *MsgSendWithTimeout) (*MsgSendWithTimeoutResponse, error)
+	// ClaimEscrow defines a method for the recipient of an escrow to claim its coins
+	// before the escrow expires.
+	ClaimEscrow(context.Context, *MsgClaimEscrow) (*MsgClaimEscrowResponse, error)
+	// RefundEscrow defines a method for the sender of an escrow to get its coins back
+	// once the escrow expired without being claimed.
+	RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -151,6 +242,21 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) BlockAddresses(context.Context, *MsgBlockAddresses) (*MsgBlockAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAddresses not implemented")
+}
+func (UnimplementedMsgServer) UnblockAddresses(context.Context, *MsgUnblockAddresses) (*MsgUnblockAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockAddresses not implemented")
+}
+func (UnimplementedMsgServer) SendWithTimeout(context.Context, *MsgSendWithTimeout) (*MsgSendWithTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWithTimeout not implemented")
+}
+func (UnimplementedMsgServer) ClaimEscrow(context.Context, *MsgClaimEscrow) (*MsgClaimEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimEscrow not implemented")
+}
+func (UnimplementedMsgServer) RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -262,6 +368,96 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BlockAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BlockAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockAddresses(ctx, req.(*MsgBlockAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblockAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblockAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblockAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnblockAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblockAddresses(ctx, req.(*MsgUnblockAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendWithTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendWithTimeout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendWithTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendWithTimeout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendWithTimeout(ctx, req.(*MsgSendWithTimeout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimEscrow(ctx, req.(*MsgClaimEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefundEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundEscrow(ctx, req.(*MsgRefundEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +485,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "BlockAddresses",
+			Handler:    _Msg_BlockAddresses_Handler,
+		},
+		{
+			MethodName: "UnblockAddresses",
+			Handler:    _Msg_UnblockAddresses_Handler,
+		},
+		{
+			MethodName: "SendWithTimeout",
+			Handler:    _Msg_SendWithTimeout_Handler,
+		},
+		{
+			MethodName: "ClaimEscrow",
+			Handler:    _Msg_ClaimEscrow_Handler,
+		},
+		{
+			MethodName: "RefundEscrow",
+			Handler:    _Msg_RefundEscrow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -25,6 +25,7 @@ const (
 	Query_ValidatorCommission_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
 	Query_ValidatorSlashes_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
 	Query_DelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
+	Query_DelegationRewardsEstimate_FullMethodName   = "/cosmos.distribution.v1beta1.Query/DelegationRewardsEstimate"
 	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
@@ -49,6 +50,9 @@ type QueryClient interface {
 	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error)
+	// DelegationRewardsEstimate projects the rewards accrued by a delegation
+	// over the given number of future blocks.
+	DelegationRewardsEstimate(ctx context.Context, in *QueryDelegationRewardsEstimateRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsEstimateResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
@@ -131,6 +135,16 @@ func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegation
 	return out, nil
 }
 
+func (c *queryClient) DelegationRewardsEstimate(ctx context.Context, in *QueryDelegationRewardsEstimateRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsEstimateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegationRewardsEstimateResponse)
+	err := c.cc.Invoke(ctx, Query_DelegationRewardsEstimate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryDelegationTotalRewardsResponse)
@@ -190,6 +204,9 @@ type QueryServer interface {
 	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
+	// DelegationRewardsEstimate projects the rewards accrued by a delegation
+	// over the given number of future blocks.
+	DelegationRewardsEstimate(context.Context, *QueryDelegationRewardsEstimateRequest) (*QueryDelegationRewardsEstimateResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
@@ -230,6 +247,9 @@ func (UnimplementedQueryServer) ValidatorSlashes(context.Context, *QueryValidato
 func (UnimplementedQueryServer) DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
 }
+func (UnimplementedQueryServer) DelegationRewardsEstimate(context.Context, *QueryDelegationRewardsEstimateRequest) (*QueryDelegationRewardsEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewardsEstimate not implemented")
+}
 func (UnimplementedQueryServer) DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
@@ -371,6 +391,24 @@ func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegationRewardsEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewardsEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegationRewardsEstimate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewardsEstimate(ctx, req.(*QueryDelegationRewardsEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegationTotalRewardsRequest)
 	if err := dec(in); err != nil {
@@ -474,6 +512,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationRewards",
 			Handler:    _Query_DelegationRewards_Handler,
 		},
+		{
+			MethodName: "DelegationRewardsEstimate",
+			Handler:    _Query_DelegationRewardsEstimate_Handler,
+		},
 		{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
@@ -21,9 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName   = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_PruneAllowances_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_RegrantAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RegrantAllowance"
+	Msg_RevokeRegrant_FullMethodName    = "/cosmos.feegrant.v1beta1.Msg/RevokeRegrant"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +42,12 @@ type MsgClient interface {
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// RegrantAllowance re-grants a portion of the grantee's allowance of the
+	// granter's funds to a third party.
+	RegrantAllowance(ctx context.Context, in *MsgRegrantAllowance, opts ...grpc.CallOption) (*MsgRegrantAllowanceResponse, error)
+	// RevokeRegrant revokes an allowance re-granted by the grantee, along with
+	// all the allowances re-granted from it.
+	RevokeRegrant(ctx context.Context, in *MsgRevokeRegrant, opts ...grpc.CallOption) (*MsgRevokeRegrantResponse, error)
 }
 
 type msgClient struct {
@@ -80,6 +88,26 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) RegrantAllowance(ctx context.Context, in *MsgRegrantAllowance, opts ...grpc.CallOption) (*MsgRegrantAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegrantAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_RegrantAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRegrant(ctx context.Context, in *MsgRevokeRegrant, opts ...grpc.CallOption) (*MsgRevokeRegrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeRegrantResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRegrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -94,6 +122,12 @@ type MsgServer interface {
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// RegrantAllowance re-grants a portion of the grantee's allowance of the
+	// granter's funds to a third party.
+	RegrantAllowance(context.Context, *MsgRegrantAllowance) (*MsgRegrantAllowanceResponse, error)
+	// RevokeRegrant revokes an allowance re-granted by the grantee, along with
+	// all the allowances re-granted from it.
+	RevokeRegrant(context.Context, *MsgRevokeRegrant) (*MsgRevokeRegrantResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -113,6 +147,12 @@ func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowan
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) RegrantAllowance(context.Context, *MsgRegrantAllowance) (*MsgRegrantAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegrantAllowance not implemented")
+}
+func (UnimplementedMsgServer) RevokeRegrant(context.Context, *MsgRevokeRegrant) (*MsgRevokeRegrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRegrant not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -188,6 +228,42 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegrantAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegrantAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegrantAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegrantAllowance(ctx, req.(*MsgRegrantAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRegrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRegrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRegrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRegrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRegrant(ctx, req.(*MsgRevokeRegrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +283,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "RegrantAllowance",
+			Handler:    _Msg_RegrantAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeRegrant",
+			Handler:    _Msg_RevokeRegrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
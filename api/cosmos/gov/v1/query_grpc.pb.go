@@ -30,8 +30,13 @@ const (
 	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
 	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
 	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalTurnout_FullMethodName     = "/cosmos.gov.v1.Query/ProposalTurnout"
+	Query_ProposalTurnouts_FullMethodName    = "/cosmos.gov.v1.Query/ProposalTurnouts"
 	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
 	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_ParamAnnotations_FullMethodName    = "/cosmos.gov.v1.Query/ParamAnnotations"
+	Query_MinDeposit_FullMethodName          = "/cosmos.gov.v1.Query/MinDeposit"
+	Query_InactiveStake_FullMethodName       = "/cosmos.gov.v1.Query/InactiveStake"
 )
 
 // QueryClient is the client API for Query service.
@@ -58,10 +63,25 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalTurnout queries the turnout of a proposal vote, broken down between
+	// validators and delegators. The turnout of a proposal in voting period is
+	// computed on the fly from the current votes.
+	ProposalTurnout(ctx context.Context, in *QueryProposalTurnoutRequest, opts ...grpc.CallOption) (*QueryProposalTurnoutResponse, error)
+	// ProposalTurnouts queries the turnouts of all the tallied proposals.
+	ProposalTurnouts(ctx context.Context, in *QueryProposalTurnoutsRequest, opts ...grpc.CallOption) (*QueryProposalTurnoutsResponse, error)
 	// ProposalVoteOptions queries the valid voting options for a proposal.
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// ParamAnnotations queries the annotations of the module params registered by the application,
+	// optionally filtered by the type URL of the message updating them.
+	ParamAnnotations(ctx context.Context, in *QueryParamAnnotationsRequest, opts ...grpc.CallOption) (*QueryParamAnnotationsResponse, error)
+	// MinDeposit queries the current minimum deposit of standard proposals, which is adjusted to the
+	// proposal volume when the min deposit throttler is enabled.
+	MinDeposit(ctx context.Context, in *QueryMinDepositRequest, opts ...grpc.CallOption) (*QueryMinDepositResponse, error)
+	// InactiveStake queries the stake of the inactive accounts, which is excluded from the quorum
+	// when the inactive stake exemption is enabled.
+	InactiveStake(ctx context.Context, in *QueryInactiveStakeRequest, opts ...grpc.CallOption) (*QueryInactiveStakeResponse, error)
 }
 
 type queryClient struct {
@@ -162,6 +182,26 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalTurnout(ctx context.Context, in *QueryProposalTurnoutRequest, opts ...grpc.CallOption) (*QueryProposalTurnoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProposalTurnoutResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalTurnout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalTurnouts(ctx context.Context, in *QueryProposalTurnoutsRequest, opts ...grpc.CallOption) (*QueryProposalTurnoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProposalTurnoutsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalTurnouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryProposalVoteOptionsResponse)
@@ -182,6 +222,36 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) ParamAnnotations(ctx context.Context, in *QueryParamAnnotationsRequest, opts ...grpc.CallOption) (*QueryParamAnnotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamAnnotationsResponse)
+	err := c.cc.Invoke(ctx, Query_ParamAnnotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinDeposit(ctx context.Context, in *QueryMinDepositRequest, opts ...grpc.CallOption) (*QueryMinDepositResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMinDepositResponse)
+	err := c.cc.Invoke(ctx, Query_MinDeposit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InactiveStake(ctx context.Context, in *QueryInactiveStakeRequest, opts ...grpc.CallOption) (*QueryInactiveStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryInactiveStakeResponse)
+	err := c.cc.Invoke(ctx, Query_InactiveStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -206,10 +276,25 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalTurnout queries the turnout of a proposal vote, broken down between
+	// validators and delegators. The turnout of a proposal in voting period is
+	// computed on the fly from the current votes.
+	ProposalTurnout(context.Context, *QueryProposalTurnoutRequest) (*QueryProposalTurnoutResponse, error)
+	// ProposalTurnouts queries the turnouts of all the tallied proposals.
+	ProposalTurnouts(context.Context, *QueryProposalTurnoutsRequest) (*QueryProposalTurnoutsResponse, error)
 	// ProposalVoteOptions queries the valid voting options for a proposal.
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// ParamAnnotations queries the annotations of the module params registered by the application,
+	// optionally filtered by the type URL of the message updating them.
+	ParamAnnotations(context.Context, *QueryParamAnnotationsRequest) (*QueryParamAnnotationsResponse, error)
+	// MinDeposit queries the current minimum deposit of standard proposals, which is adjusted to the
+	// proposal volume when the min deposit throttler is enabled.
+	MinDeposit(context.Context, *QueryMinDepositRequest) (*QueryMinDepositResponse, error)
+	// InactiveStake queries the stake of the inactive accounts, which is excluded from the quorum
+	// when the inactive stake exemption is enabled.
+	InactiveStake(context.Context, *QueryInactiveStakeRequest) (*QueryInactiveStakeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -247,12 +332,27 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ProposalTurnout(context.Context, *QueryProposalTurnoutRequest) (*QueryProposalTurnoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTurnout not implemented")
+}
+func (UnimplementedQueryServer) ProposalTurnouts(context.Context, *QueryProposalTurnoutsRequest) (*QueryProposalTurnoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTurnouts not implemented")
+}
 func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposalVoteOptions not implemented")
 }
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) ParamAnnotations(context.Context, *QueryParamAnnotationsRequest) (*QueryParamAnnotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamAnnotations not implemented")
+}
+func (UnimplementedQueryServer) MinDeposit(context.Context, *QueryMinDepositRequest) (*QueryMinDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinDeposit not implemented")
+}
+func (UnimplementedQueryServer) InactiveStake(context.Context, *QueryInactiveStakeRequest) (*QueryInactiveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveStake not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -436,6 +536,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalTurnout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTurnoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTurnout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalTurnout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTurnout(ctx, req.(*QueryProposalTurnoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalTurnouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTurnoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTurnouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalTurnouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTurnouts(ctx, req.(*QueryProposalTurnoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ProposalVoteOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryProposalVoteOptionsRequest)
 	if err := dec(in); err != nil {
@@ -472,6 +608,60 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParamAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamAnnotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamAnnotations(ctx, req.(*QueryParamAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MinDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinDeposit(ctx, req.(*QueryMinDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InactiveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInactiveStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InactiveStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveStake(ctx, req.(*QueryInactiveStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -515,6 +705,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalTurnout",
+			Handler:    _Query_ProposalTurnout_Handler,
+		},
+		{
+			MethodName: "ProposalTurnouts",
+			Handler:    _Query_ProposalTurnouts_Handler,
+		},
 		{
 			MethodName: "ProposalVoteOptions",
 			Handler:    _Query_ProposalVoteOptions_Handler,
@@ -523,6 +721,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "ParamAnnotations",
+			Handler:    _Query_ParamAnnotations_Handler,
+		},
+		{
+			MethodName: "MinDeposit",
+			Handler:    _Query_MinDeposit_Handler,
+		},
+		{
+			MethodName: "InactiveStake",
+			Handler:    _Query_InactiveStake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
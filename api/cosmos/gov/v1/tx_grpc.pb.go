@@ -31,6 +31,7 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_CancelScheduledProposal_FullMethodName      = "/cosmos.gov.v1.Msg/CancelScheduledProposal"
 )
 
 // MsgClient is the client API for Msg service.
@@ -62,6 +63,9 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// CancelScheduledProposal defines a governance operation to cancel the execution of a scheduled proposal.
+	// A proposal containing this message only passes with the scheduled_cancel_threshold supermajority.
+	CancelScheduledProposal(ctx context.Context, in *MsgCancelScheduledProposal, opts ...grpc.CallOption) (*MsgCancelScheduledProposalResponse, error)
 }
 
 type msgClient struct {
@@ -172,6 +176,16 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CancelScheduledProposal(ctx context.Context, in *MsgCancelScheduledProposal, opts ...grpc.CallOption) (*MsgCancelScheduledProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -201,6 +215,9 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// CancelScheduledProposal defines a governance operation to cancel the execution of a scheduled proposal.
+	// A proposal containing this message only passes with the scheduled_cancel_threshold supermajority.
+	CancelScheduledProposal(context.Context, *MsgCancelScheduledProposal) (*MsgCancelScheduledProposalResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -241,6 +258,9 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) CancelScheduledProposal(context.Context, *MsgCancelScheduledProposal) (*MsgCancelScheduledProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledProposal not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -442,6 +462,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelScheduledProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledProposal(ctx, req.(*MsgCancelScheduledProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -489,6 +527,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "CancelScheduledProposal",
+			Handler:    _Msg_CancelScheduledProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -31,6 +31,8 @@ const (
 	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_HistoricalValidatorSet_FullMethodName        = "/cosmos.staking.v1beta1.Query/HistoricalValidatorSet"
+	Query_ValidatorMetadataVerification_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorMetadataVerification"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
 )
@@ -91,6 +93,13 @@ type QueryClient interface {
 	// Deprecated: Do not use.
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
+	// HistoricalValidatorSet queries the bonded validator set at a given height,
+	// as resulting from the validator set changes of the EndBlocker at that height.
+	// Only the heights within the unbonding period are retained.
+	HistoricalValidatorSet(ctx context.Context, in *QueryHistoricalValidatorSetRequest, opts ...grpc.CallOption) (*QueryHistoricalValidatorSetResponse, error)
+	// ValidatorMetadataVerification queries the verification status of the metadata
+	// of a validator, and where clients can re-check the proof of its website domain.
+	ValidatorMetadataVerification(ctx context.Context, in *QueryValidatorMetadataVerificationRequest, opts ...grpc.CallOption) (*QueryValidatorMetadataVerificationResponse, error)
 	// Pool queries the pool info.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
@@ -226,6 +235,26 @@ func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInf
 	return out, nil
 }
 
+func (c *queryClient) HistoricalValidatorSet(ctx context.Context, in *QueryHistoricalValidatorSetRequest, opts ...grpc.CallOption) (*QueryHistoricalValidatorSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHistoricalValidatorSetResponse)
+	err := c.cc.Invoke(ctx, Query_HistoricalValidatorSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorMetadataVerification(ctx context.Context, in *QueryValidatorMetadataVerificationRequest, opts ...grpc.CallOption) (*QueryValidatorMetadataVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryValidatorMetadataVerificationResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorMetadataVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryPoolResponse)
@@ -302,6 +331,13 @@ type QueryServer interface {
 	// Deprecated: Do not use.
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
+	// HistoricalValidatorSet queries the bonded validator set at a given height,
+	// as resulting from the validator set changes of the EndBlocker at that height.
+	// Only the heights within the unbonding period are retained.
+	HistoricalValidatorSet(context.Context, *QueryHistoricalValidatorSetRequest) (*QueryHistoricalValidatorSetResponse, error)
+	// ValidatorMetadataVerification queries the verification status of the metadata
+	// of a validator, and where clients can re-check the proof of its website domain.
+	ValidatorMetadataVerification(context.Context, *QueryValidatorMetadataVerificationRequest) (*QueryValidatorMetadataVerificationResponse, error)
 	// Pool queries the pool info.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
@@ -352,6 +388,12 @@ func (UnimplementedQueryServer) DelegatorValidator(context.Context, *QueryDelega
 func (UnimplementedQueryServer) HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
 }
+func (UnimplementedQueryServer) HistoricalValidatorSet(context.Context, *QueryHistoricalValidatorSetRequest) (*QueryHistoricalValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalValidatorSet not implemented")
+}
+func (UnimplementedQueryServer) ValidatorMetadataVerification(context.Context, *QueryValidatorMetadataVerificationRequest) (*QueryValidatorMetadataVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorMetadataVerification not implemented")
+}
 func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
@@ -595,6 +637,42 @@ func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HistoricalValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HistoricalValidatorSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalValidatorSet(ctx, req.(*QueryHistoricalValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorMetadataVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorMetadataVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorMetadataVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorMetadataVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorMetadataVerification(ctx, req.(*QueryValidatorMetadataVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPoolRequest)
 	if err := dec(in); err != nil {
@@ -686,6 +764,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HistoricalInfo",
 			Handler:    _Query_HistoricalInfo_Handler,
 		},
+		{
+			MethodName: "HistoricalValidatorSet",
+			Handler:    _Query_HistoricalValidatorSet_Handler,
+		},
+		{
+			MethodName: "ValidatorMetadataVerification",
+			Handler:    _Query_ValidatorMetadataVerification_Handler,
+		},
 		{
 			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
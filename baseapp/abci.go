@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -899,6 +900,25 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 // skipped. This is to support compatibility with proposers injecting vote
 // extensions into the proposal, which should not themselves be executed in cases
 // where they adhere to the sdk.Tx interface.
+//
+// The events of the block are emitted in a deterministic order, which is the
+// execution order of the block:
+//
+//  1. the PreBlock events, with the "mode" attribute set to "PreBlock",
+//  2. the BeginBlock events, with the "mode" attribute set to "BeginBlock",
+//  3. the events of each transaction, in the order of the transactions in the
+//     block, with the "tx_index" attribute: those of its AnteHandler, then
+//     those of its messages in order, with the "msg_index" attribute, then
+//     those of its PostHandler, a failed transaction only keeping the events
+//     of its AnteHandler,
+//  4. the EndBlock events, with the "mode" attribute set to "EndBlock".
+//
+// The events of each stage are in emission order, and the PreBlock, BeginBlock
+// and EndBlock events are numbered by the "event_index" attribute. The
+// PreBlock, BeginBlock and EndBlock events are returned in the Events of the
+// response, and the events of each transaction in its TxResults. The hash of
+// the events of the block, see EventsHash, is logged, reported to telemetry
+// and kept as LastEventsHash.
 func (app *BaseApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (res *abci.FinalizeBlockResponse, err error) {
 	defer func() {
 		// call the streaming service hooks with the FinalizeBlock messages
@@ -919,6 +939,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (res *abci.Fin
 		if !aborted {
 			if res != nil {
 				res.AppHash = app.workingHash()
+				app.recordEventsHash(req.Height, res)
 			}
 
 			return res, err
@@ -933,6 +954,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (res *abci.Fin
 	res, err = app.internalFinalizeBlock(context.Background(), req)
 	if res != nil {
 		res.AppHash = app.workingHash()
+		app.recordEventsHash(req.Height, res)
 	}
 
 	return res, err
@@ -1065,6 +1087,22 @@ func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.Q
 				Value:     []byte(app.version),
 			}
 
+		case "indexer_status":
+			if app.indexerStatus == nil {
+				return queryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "indexer is not enabled"), app.trace)
+			}
+
+			bz, err := json.Marshal(app.indexerStatus())
+			if err != nil {
+				return queryResult(errorsmod.Wrap(err, "failed to JSON encode indexer status"), app.trace)
+			}
+
+			return &abci.QueryResponse{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return queryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
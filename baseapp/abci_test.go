@@ -302,6 +302,7 @@ func TestABCI_FinalizeBlock_WithBeginAndEndBlocker(t *testing.T) {
 	require.Equal(t, "bar", res.Events[1].Attributes[0].Value)
 	require.Equal(t, "mode", res.Events[1].Attributes[1].Key)
 	require.Equal(t, "EndBlock", res.Events[1].Attributes[1].Value)
+	require.Equal(t, baseapp.EventsHash(res), app.LastEventsHash())
 
 	_, err = app.Commit()
 	require.NoError(t, err)
@@ -309,6 +310,71 @@ func TestABCI_FinalizeBlock_WithBeginAndEndBlocker(t *testing.T) {
 	require.Equal(t, int64(1), app.LastBlockHeight())
 }
 
+func TestEventsHash(t *testing.T) {
+	newEvent := func(eventType, mode string, index bool) abci.Event {
+		return abci.Event{
+			Type: eventType,
+			Attributes: []abci.EventAttribute{
+				{Key: fooStr, Value: "bar", Index: index},
+				{Key: "mode", Value: mode, Index: index},
+			},
+		}
+	}
+	txEvent := abci.Event{Type: "tx", Attributes: []abci.EventAttribute{{Key: "tx_index", Value: "0"}}}
+
+	res := &abci.FinalizeBlockResponse{
+		Events:    []abci.Event{newEvent("begin", "BeginBlock", false), newEvent("end", "EndBlock", false)},
+		TxResults: []*abci.ExecTxResult{{Events: []abci.Event{txEvent}}},
+	}
+	hash := baseapp.EventsHash(res)
+	require.Len(t, hash, 32)
+
+	// the index flag depends on the node configuration and is not hashed
+	indexed := &abci.FinalizeBlockResponse{
+		Events:    []abci.Event{newEvent("begin", "BeginBlock", true), newEvent("end", "EndBlock", true)},
+		TxResults: res.TxResults,
+	}
+	require.Equal(t, hash, baseapp.EventsHash(indexed))
+
+	// the order of the events is committed to
+	reordered := &abci.FinalizeBlockResponse{
+		Events:    []abci.Event{newEvent("end", "EndBlock", false), newEvent("begin", "BeginBlock", false)},
+		TxResults: res.TxResults,
+	}
+	require.NotEqual(t, hash, baseapp.EventsHash(reordered))
+
+	// as is the transaction the events belong to
+	moved := &abci.FinalizeBlockResponse{
+		Events:    res.Events,
+		TxResults: []*abci.ExecTxResult{{}, {Events: []abci.Event{txEvent}}},
+	}
+	require.NotEqual(t, hash, baseapp.EventsHash(moved))
+}
+
+func TestLastEventsHashConcurrentReads(t *testing.T) {
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), coretesting.NewMemDB(), nil)
+	_, err := app.InitChain(&abci.InitChainRequest{InitialHeight: 1})
+	require.NoError(t, err)
+
+	// the hash of the last block is read while the next blocks are finalized
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = app.LastEventsHash()
+		}
+	}()
+
+	for height := int64(1); height <= 5; height++ {
+		res, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height})
+		require.NoError(t, err)
+		require.Equal(t, baseapp.EventsHash(res), app.LastEventsHash())
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+	<-done
+}
+
 func TestABCI_ExtendVote(t *testing.T) {
 	name := t.Name()
 	db := coretesting.NewMemDB()
@@ -2779,3 +2845,169 @@ func TestABCI_Proposal_FailReCheckTx(t *testing.T) {
 	require.NotEmpty(t, res.TxResults[0].Events)
 	require.True(t, res.TxResults[0].IsOK(), fmt.Sprintf("%v", res))
 }
+
+func TestABCI_CheckTx_TxReplacement(t *testing.T) {
+	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		TxReplacement:   mempool.NewFeeBumpTxReplacement[int64](10),
+		SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+	})
+
+	anteCalls := 0
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			anteCalls++
+
+			// emulate the sequence check and increment of the x/auth ante handler
+			sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+			if err != nil {
+				return ctx, err
+			}
+
+			key := []byte("seq")
+			store := ctx.KVStore(capKey1)
+			seq := getIntFromStore(t, store, key)
+			if sigs[0].Sequence != uint64(seq) {
+				return ctx, sdkerrors.ErrWrongSequence
+			}
+			setIntOnStore(store, key, seq+1)
+
+			return ctx, nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	newTx := func(seq, fee int64) []byte {
+		tx := newTxCounter(t, suite.txConfig, suite.ac, seq, 0)
+		builder, err := suite.txConfig.WrapTxBuilder(tx)
+		require.NoError(t, err)
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", fee)))
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	checkTx := func(txBytes []byte, typ abci.CheckTxType) *abci.CheckTxResponse {
+		res, err := suite.baseApp.CheckTx(&abci.CheckTxRequest{Tx: txBytes, Type: typ})
+		require.NoError(t, err)
+		return res
+	}
+
+	original0, original1 := newTx(0, 100), newTx(1, 100)
+	replacement0, replacement1, underpriced1 := newTx(0, 110), newTx(1, 110), newTx(1, 105)
+
+	res := checkTx(original0, abci.CHECK_TX_TYPE_CHECK)
+	require.True(t, res.IsOK(), res.Log)
+	res = checkTx(original1, abci.CHECK_TX_TYPE_CHECK)
+	require.True(t, res.IsOK(), res.Log)
+
+	// the replacement of a tx which is not the lowest pending sequence is validated
+	// against the check state rewound for the replaced tx only, which includes the
+	// preceding pending tx
+	res = checkTx(replacement1, abci.CHECK_TX_TYPE_CHECK)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 2, pool.CountTx())
+
+	// an underpriced replacement is rejected without running the ante handler
+	anteCalls = 0
+	res = checkTx(underpriced1, abci.CHECK_TX_TYPE_CHECK)
+	require.Equal(t, sdkerrors.ErrTxReplacementUnderpriced.ABCICode(), res.Code)
+	require.Equal(t, sdkerrors.ErrTxReplacementUnderpriced.Codespace(), res.Codespace)
+	require.Zero(t, anteCalls)
+
+	res = checkTx(replacement0, abci.CHECK_TX_TYPE_CHECK)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 2, pool.CountTx())
+
+	// recheck happens after a block has been committed
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	// on recheck, the original txs are evicted without touching the mempool
+	for _, original := range [][]byte{original0, original1} {
+		res = checkTx(original, abci.CHECK_TX_TYPE_RECHECK)
+		require.Equal(t, sdkerrors.ErrTxReplaced.ABCICode(), res.Code)
+		require.Equal(t, sdkerrors.ErrTxReplaced.Codespace(), res.Codespace)
+		require.Equal(t, 2, pool.CountTx())
+	}
+
+	res = checkTx(replacement0, abci.CHECK_TX_TYPE_RECHECK)
+	require.True(t, res.IsOK(), res.Log)
+	res = checkTx(replacement1, abci.CHECK_TX_TYPE_RECHECK)
+	require.True(t, res.IsOK(), res.Log)
+
+	resPrepareProposal, err := suite.baseApp.PrepareProposal(&abci.PrepareProposalRequest{
+		MaxTxBytes: 1000,
+		Height:     2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{replacement0, replacement1}, resPrepareProposal.Txs)
+}
+
+func TestABCI_FinalizeBlock_AnteDivergenceDetection(t *testing.T) {
+	anteKey := []byte("ante-key")
+	var divergences []baseapp.AnteDivergence
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		anteHandler := anteHandlerTxTest(t, capKey1, anteKey)
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100000))
+
+			counter, _ := parseTxMemo(t, tx)
+			switch {
+			case counter == 1 && ctx.IsCheckTx():
+				ctx.GasMeter().ConsumeGas(5, "check tx only")
+			case counter == 2 && simulate:
+				return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "simulation only failure")
+			}
+
+			return anteHandler(ctx, tx, simulate)
+		})
+		bapp.SetAnteDivergenceReporter(func(_ sdk.Context, divergence baseapp.AnteDivergence) {
+			divergences = append(divergences, divergence)
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetAnteDivergenceDetection(true))
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	txs := [][]byte{}
+	for counter := int64(0); counter < 3; counter++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, suite.ac, counter, counter))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: txs})
+	require.NoError(t, err)
+
+	// shadow runs must not affect the actual execution
+	for i, txRes := range res.TxResults {
+		require.True(t, txRes.IsOK(), "tx %d: %s", i, txRes.Log)
+	}
+
+	require.Len(t, divergences, 2)
+	require.Equal(t, sdk.ExecModeCheck, divergences[0].Mode)
+	require.Equal(t, divergences[0].DeliverGasUsed+5, divergences[0].ShadowGasUsed)
+	require.Contains(t, divergences[0].Reason, "gas in check tx")
+
+	require.Equal(t, sdk.ExecModeSimulate, divergences[1].Mode)
+	require.NoError(t, divergences[1].DeliverErr)
+	require.ErrorIs(t, divergences[1].ShadowErr, sdkerrors.ErrInvalidRequest)
+	require.Contains(t, divergences[1].Reason, "passes in finalize block but fails in simulate")
+}
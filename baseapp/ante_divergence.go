@@ -0,0 +1,164 @@
+package baseapp
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AnteDivergence describes a transaction for which the AnteHandler behaved
+// differently when run in CheckTx or simulation mode than when run in
+// FinalizeBlock, on the same state.
+type AnteDivergence struct {
+	// TxHash is the hex encoded hash of the transaction.
+	TxHash string
+	// Mode is the execution mode of the shadow run, either ExecModeCheck or ExecModeSimulate.
+	Mode sdk.ExecMode
+	// Reason is a human readable description of the divergence.
+	Reason string
+
+	DeliverErr     error
+	DeliverGasUsed uint64
+	ShadowErr      error
+	ShadowGasUsed  uint64
+}
+
+// AnteDivergenceReporter is called for every divergence found by the ante
+// divergence detection.
+type AnteDivergenceReporter func(ctx sdk.Context, divergence AnteDivergence)
+
+// anteOutcome is the result of a single AnteHandler run.
+type anteOutcome struct {
+	mode    sdk.ExecMode
+	err     error
+	gasUsed uint64
+}
+
+// runShadowAnte runs the AnteHandler on discarded branches of the given
+// FinalizeBlock context, once as in CheckTx and once as in simulation.
+// Everything else, such as the block header, the consensus params and the
+// (empty) minimum gas prices, is inherited from the FinalizeBlock context so
+// that only the execution mode differs between the runs.
+func (app *BaseApp) runShadowAnte(ctx sdk.Context, txBytes []byte, tx sdk.Tx) []anteOutcome {
+	checkCtx := ctx.WithIsCheckTx(true).WithExecMode(sdk.ExecModeCheck)
+	simCtx := ctx.WithExecMode(sdk.ExecModeSimulate)
+
+	return []anteOutcome{
+		app.runShadowAnteWithCtx(checkCtx, txBytes, tx, false),
+		app.runShadowAnteWithCtx(simCtx, txBytes, tx, true),
+	}
+}
+
+func (app *BaseApp) runShadowAnteWithCtx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate bool) (outcome anteOutcome) {
+	outcome.mode = ctx.ExecMode()
+
+	// the store branch is never written, and a fresh gas meter is used so
+	// that the shadow run cannot affect the actual execution.
+	shadowCtx, _ := app.cacheTxContext(ctx, txBytes)
+	shadowCtx = shadowCtx.
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+
+	gasMeter := shadowCtx.GasMeter()
+	defer func() {
+		if r := recover(); r != nil {
+			outcome.err = fmt.Errorf("panic in ante handler: %v", r)
+		}
+		outcome.gasUsed = gasMeter.GasConsumed()
+	}()
+
+	newCtx, err := app.anteHandler(shadowCtx, tx, simulate)
+	if !newCtx.IsZero() {
+		gasMeter = newCtx.GasMeter()
+	}
+	outcome.err = err
+
+	return outcome
+}
+
+// reportAnteDivergences compares the outcome of the AnteHandler run in
+// FinalizeBlock with the shadow runs and reports every divergence.
+//
+// A CheckTx run is expected to have the same outcome and to consume exactly
+// the same amount of gas. A simulation run skips signature verification, hence
+// it is only expected to have the same outcome and to not underestimate gas.
+func (app *BaseApp) reportAnteDivergences(ctx sdk.Context, txBytes []byte, deliver anteOutcome, shadows []anteOutcome) {
+	for _, shadow := range shadows {
+		reason := anteDivergenceReason(deliver, shadow)
+		if reason == "" {
+			continue
+		}
+
+		divergence := AnteDivergence{
+			TxHash:         fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+			Mode:           shadow.mode,
+			Reason:         reason,
+			DeliverErr:     deliver.err,
+			DeliverGasUsed: deliver.gasUsed,
+			ShadowErr:      shadow.err,
+			ShadowGasUsed:  shadow.gasUsed,
+		}
+
+		if app.anteDivergenceReporter != nil {
+			app.anteDivergenceReporter(ctx, divergence)
+			continue
+		}
+
+		app.logger.Error(
+			"ante handler divergence detected",
+			"tx_hash", divergence.TxHash,
+			"mode", execModeName(divergence.Mode),
+			"reason", divergence.Reason,
+			"deliver_err", divergence.DeliverErr,
+			"deliver_gas_used", divergence.DeliverGasUsed,
+			"shadow_err", divergence.ShadowErr,
+			"shadow_gas_used", divergence.ShadowGasUsed,
+		)
+	}
+}
+
+func anteDivergenceReason(deliver, shadow anteOutcome) string {
+	switch {
+	case deliver.err == nil && shadow.err != nil:
+		return fmt.Sprintf("passes in finalize block but fails in %s", execModeName(shadow.mode))
+	case deliver.err != nil && shadow.err == nil:
+		return fmt.Sprintf("passes in %s but fails in finalize block", execModeName(shadow.mode))
+	case deliver.err != nil:
+		deliverCodespace, deliverCode, _ := errorsmod.ABCIInfo(deliver.err, false)
+		shadowCodespace, shadowCode, _ := errorsmod.ABCIInfo(shadow.err, false)
+		if deliverCodespace != shadowCodespace || deliverCode != shadowCode {
+			return fmt.Sprintf("fails with %s/%d in finalize block but with %s/%d in %s",
+				deliverCodespace, deliverCode, shadowCodespace, shadowCode, execModeName(shadow.mode))
+		}
+		return ""
+	}
+
+	switch shadow.mode {
+	case sdk.ExecModeCheck:
+		if shadow.gasUsed != deliver.gasUsed {
+			return fmt.Sprintf("consumes %d gas in finalize block but %d gas in check tx", deliver.gasUsed, shadow.gasUsed)
+		}
+	case sdk.ExecModeSimulate:
+		if shadow.gasUsed < deliver.gasUsed {
+			return fmt.Sprintf("simulation underestimates gas: %d < %d", shadow.gasUsed, deliver.gasUsed)
+		}
+	}
+
+	return ""
+}
+
+func execModeName(mode sdk.ExecMode) string {
+	switch mode {
+	case sdk.ExecModeCheck:
+		return "check tx"
+	case sdk.ExecModeSimulate:
+		return "simulate"
+	default:
+		return fmt.Sprintf("exec mode %d", mode)
+	}
+}
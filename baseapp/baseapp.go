@@ -21,6 +21,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema/indexer"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
@@ -180,6 +181,9 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// indexerStatus reports the status of the indexer targets, it is nil if the indexer is not enabled
+	indexerStatus func() map[string]indexer.TargetStatus
+
 	chainID string
 
 	cdc codec.Codec
@@ -191,6 +195,17 @@ type BaseApp struct {
 
 	// includeNestedMsgsGas holds a set of message types for which gas costs for its nested messages are calculated.
 	includeNestedMsgsGas map[string]struct{}
+
+	// anteDivergenceDetection enables the shadow execution of the AnteHandler in
+	// CheckTx and simulation modes during FinalizeBlock, to detect divergences.
+	// This is a development feature and must not be enabled in production.
+	anteDivergenceDetection bool
+	anteDivergenceReporter  AnteDivergenceReporter
+
+	// eventsHash is the hash of the events of the last finalized block, see EventsHash.
+	// It is guarded by eventsHashMtx as LastEventsHash may be called concurrently.
+	eventsHashMtx sync.RWMutex
+	eventsHash    []byte
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -685,6 +700,32 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 	return ctx
 }
 
+// replacementContext returns a context for validating on CheckTx a tx replacing
+// a pending tx. Its multistore branches the last committed state, to which the
+// AnteHandler of the pending txs of the sender preceding the replaced tx is
+// applied, so that it reflects the check state as if the replaced tx had not
+// been checked.
+func (app *BaseApp) replacementContext(ctx sdk.Context, pending []sdk.Tx) (sdk.Context, error) {
+	ctx = ctx.WithMultiStore(app.cms.CacheMultiStore())
+	if app.anteHandler == nil {
+		return ctx, nil
+	}
+
+	for _, pendingTx := range pending {
+		txBytes, err := app.txEncoder(pendingTx)
+		if err != nil {
+			return sdk.Context{}, err
+		}
+
+		anteCtx := ctx.WithTxBytes(txBytes).WithEventManager(sdk.NewEventManager())
+		if _, err := app.anteHandler(anteCtx, pendingTx, false); err != nil {
+			return sdk.Context{}, errorsmod.Wrap(err, "failed to apply a pending tx preceding the replaced tx")
+		}
+	}
+
+	return ctx, nil
+}
+
 // cacheTxContext returns a new context based off of the provided context with
 // a branched multi-store.
 func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context, storetypes.CacheMultiStore) {
@@ -891,6 +932,31 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		}
 	}
 
+	// A tx conflicting with a pending tx, i.e. from the same sender and with the
+	// same sequence, either replaces it or has been replaced by it. On CheckTx, the
+	// replacement is first checked against the replacement rule of the mempool, so
+	// that an underpriced replacement is rejected without running the AnteHandler.
+	// As the check state already includes the replaced tx, the replacement is then
+	// validated against the check state rewound for the replaced tx only, see
+	// replacementContext, which is never written.
+	if mode == execModeCheck || mode == execModeReCheck {
+		if mp, ok := app.mempool.(mempool.ReplaceableMempool); ok && mp.HasConflict(tx) {
+			if mode == execModeReCheck {
+				return gInfo, nil, nil, errorsmod.Wrap(sdkerrors.ErrTxReplaced, "tx has been replaced by a tx with the same sequence")
+			}
+
+			if err := mp.CheckReplacement(ctx, tx); err != nil {
+				return gInfo, nil, nil, err
+			}
+
+			ctx, err = app.replacementContext(ctx, mp.PendingBefore(tx))
+			if err != nil {
+				return gInfo, nil, nil, err
+			}
+			ms = ctx.MultiStore()
+		}
+	}
+
 	msgs := tx.GetMsgs()
 	// run validate basic if mode != recheck.
 	// as validate basic is stateless, it is guaranteed to pass recheck, given that its passed checkTx.
@@ -913,6 +979,11 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		// NOTE: Alternatively, we could require that AnteHandler ensures that
 		// writes do not happen if aborted/failed.  This may have some
 		// performance benefits, but it'll be more difficult to get right.
+		var shadows []anteOutcome
+		if mode == execModeFinalize && app.anteDivergenceDetection {
+			shadows = app.runShadowAnte(ctx, txBytes, tx)
+		}
+
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
 		if mode == execModeSimulate {
@@ -935,6 +1006,11 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
 
+		if shadows != nil {
+			deliver := anteOutcome{mode: sdk.ExecModeFinalize, err: err, gasUsed: ctx.GasMeter().GasConsumed()}
+			app.reportAnteDivergences(ctx, txBytes, deliver, shadows)
+		}
+
 		if err != nil {
 			if mode == execModeReCheck {
 				// if the ante handler fails on recheck, we want to remove the tx from the mempool
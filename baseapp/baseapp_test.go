@@ -18,6 +18,7 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema/indexer"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/rootmulti"
@@ -414,6 +415,31 @@ func TestVersionSetterGetter(t *testing.T) {
 	require.Equal(t, versionString, string(res.Value))
 }
 
+func TestIndexerStatusQuery(t *testing.T) {
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), coretesting.NewMemDB(), nil)
+
+	res, err := app.Query(context.TODO(), &abci.QueryRequest{Path: "app/indexer_status"})
+	require.NoError(t, err)
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "indexer is not enabled")
+
+	indexer.Register("baseapp_test", indexer.Initializer{
+		InitFunc: func(indexer.InitParams) (indexer.InitResult, error) {
+			return indexer.InitResult{}, nil
+		},
+		ConfigType: struct{}{},
+	})
+	err = app.EnableIndexer(indexer.IndexingConfig{Target: map[string]indexer.Config{
+		"test": {Type: "baseapp_test", Config: struct{}{}},
+	}}, nil, nil)
+	require.NoError(t, err)
+
+	res, err = app.Query(context.TODO(), &abci.QueryRequest{Path: "app/indexer_status"})
+	require.NoError(t, err)
+	require.True(t, res.IsOK())
+	require.JSONEq(t, `{"test":{"type":"baseapp_test","last_height":0,"lag":0,"error_count":0}}`, string(res.Value))
+}
+
 func TestLoadVersionInvalid(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOpt := baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
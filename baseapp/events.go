@@ -0,0 +1,115 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EventsHash returns the hash committing to the events of a finalized block,
+// taken in the order in which they are emitted, as documented by FinalizeBlock. Events flagged to be indexed
+// by CometBFT depend on the configuration of the node, so the Index flag of
+// the event attributes is not part of the hash, making it equal on all nodes.
+func EventsHash(res *abci.FinalizeBlockResponse) []byte {
+	h := sha256.New()
+
+	// the block events are split around the transaction events, at the first
+	// EndBlock event
+	endBlockStart := len(res.Events)
+	for i, event := range res.Events {
+		if eventMode(event) == "EndBlock" {
+			endBlockStart = i
+			break
+		}
+	}
+
+	writeEvents(h, res.Events[:endBlockStart])
+	writeUvarint(h, uint64(len(res.TxResults)))
+	for _, txResult := range res.TxResults {
+		if txResult == nil {
+			writeEvents(h, nil)
+			continue
+		}
+		writeEvents(h, txResult.Events)
+	}
+	writeEvents(h, res.Events[endBlockStart:])
+
+	return h.Sum(nil)
+}
+
+// LastEventsHash returns the hash of the events of the last finalized block,
+// as returned by EventsHash.
+func (app *BaseApp) LastEventsHash() []byte {
+	app.eventsHashMtx.RLock()
+	defer app.eventsHashMtx.RUnlock()
+
+	return app.eventsHash
+}
+
+// eventMode returns the value of the "mode" attribute of a block event.
+func eventMode(event abci.Event) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == "mode" {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+// writeEvents writes length-prefixed events to the hash, so that no two
+// distinct lists of events are written the same.
+func writeEvents(h hash.Hash, events []abci.Event) {
+	writeUvarint(h, uint64(len(events)))
+	for _, event := range events {
+		writeBytes(h, []byte(event.Type))
+		writeUvarint(h, uint64(len(event.Attributes)))
+		for _, attr := range event.Attributes {
+			writeBytes(h, []byte(attr.Key))
+			writeBytes(h, []byte(attr.Value))
+		}
+	}
+}
+
+func writeBytes(h hash.Hash, bz []byte) {
+	writeUvarint(h, uint64(len(bz)))
+	_, _ = h.Write(bz)
+}
+
+func writeUvarint(h hash.Hash, n uint64) {
+	_, _ = h.Write(binary.AppendUvarint(nil, n))
+}
+
+// recordEventsHash computes the hash of the events of a finalized block, which
+// is kept as LastEventsHash and logged. The number of events and the first
+// bytes of the hash are reported to telemetry, so that the nodes diverging on
+// the events of a block can be spotted.
+func (app *BaseApp) recordEventsHash(height int64, res *abci.FinalizeBlockResponse) {
+	eventsHash := EventsHash(res)
+
+	app.eventsHashMtx.Lock()
+	app.eventsHash = eventsHash
+	app.eventsHashMtx.Unlock()
+
+	numEvents := len(res.Events)
+	for _, txResult := range res.TxResults {
+		if txResult != nil {
+			numEvents += len(txResult.Events)
+		}
+	}
+
+	telemetry.SetGauge(float32(numEvents), "finalize_block", "events")
+	telemetry.SetGauge(eventsHashGaugeValue(eventsHash), "finalize_block", "events_hash")
+	app.logger.Debug("hash of block events", "height", height, "events", numEvents, "eventsHash", fmt.Sprintf("%X", eventsHash))
+}
+
+// eventsHashGaugeValue returns the first 3 bytes of the events hash as a gauge
+// value, as a float32 represents integers of up to 24 bits exactly.
+func eventsHashGaugeValue(eventsHash []byte) float32 {
+	return float32(uint32(eventsHash[0])<<16 | uint32(eventsHash[1])<<8 | uint32(eventsHash[2]))
+}
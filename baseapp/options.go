@@ -132,6 +132,17 @@ func SetIncludeNestedMsgsGas(msgs []sdk.Msg) func(*BaseApp) {
 	}
 }
 
+// SetAnteDivergenceDetection enables or disables the detection of divergences
+// between the AnteHandler runs in CheckTx, simulation and FinalizeBlock modes.
+// When enabled, the AnteHandler of every transaction in FinalizeBlock is also
+// run on discarded state branches as in CheckTx and in simulation, and any
+// difference in outcome or gas consumption is reported.
+// This is a development feature, it slows down block execution and must not
+// be enabled in production.
+func SetAnteDivergenceDetection(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.anteDivergenceDetection = enabled }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -251,6 +262,16 @@ func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
 	app.postHandler = ph
 }
 
+// SetAnteDivergenceReporter sets the function called for every divergence found
+// by the ante divergence detection. By default divergences are logged.
+func (app *BaseApp) SetAnteDivergenceReporter(reporter AnteDivergenceReporter) {
+	if app.sealed {
+		panic("SetAnteDivergenceReporter() on sealed BaseApp")
+	}
+
+	app.anteDivergenceReporter = reporter
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
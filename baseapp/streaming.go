@@ -44,15 +44,32 @@ func (app *BaseApp) EnableIndexer(indexerOpts interface{}, keys map[string]*stor
 		return err
 	}
 
+	app.SetIndexerListener(listener.Listener, keys)
+	app.indexerStatus = listener.Status
+
+	return nil
+}
+
+// IndexerStatus returns the status of the indexer targets by name, or nil if the built-in indexer
+// is not enabled.
+func (app *BaseApp) IndexerStatus() map[string]indexer.TargetStatus {
+	if app.indexerStatus == nil {
+		return nil
+	}
+	return app.indexerStatus()
+}
+
+// SetIndexerListener streams the app data of every block, including the state changes of the provided
+// kv-store keys, to the listener. It is used by EnableIndexer and by tools replaying blocks, such as
+// the indexer backfill command, that need to capture the app data themselves.
+func (app *BaseApp) SetIndexerListener(listener appdata.Listener, keys map[string]*storetypes.KVStoreKey) {
 	exposedKeys := exposeStoreKeysSorted([]string{"*"}, keys)
 	app.cms.AddListeners(exposedKeys)
 
 	app.streamingManager = storetypes.StreamingManager{
-		ABCIListeners: []storetypes.ABCIListener{listenerWrapper{listener.Listener}},
+		ABCIListeners: []storetypes.ABCIListener{listenerWrapper{listener}},
 		StopNodeOnErr: true,
 	}
-
-	return nil
 }
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
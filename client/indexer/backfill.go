@@ -0,0 +1,436 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+	schemaindexer "cosmossdk.io/schema/indexer"
+	"cosmossdk.io/store/rootmulti"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagSnapshotHeight = "snapshot-height"
+	flagSnapshotFormat = "snapshot-format"
+	flagEndHeight      = "end-height"
+	flagBackfillDir    = "backfill-dir"
+)
+
+// backfillApp is the application interface required to backfill an indexer.
+// It is implemented by apps built with runtime.
+type backfillApp interface {
+	servertypes.Application
+
+	SchemaDecoderResolver() decoding.DecoderResolver
+	SetIndexerListener(listener appdata.Listener, keys map[string]*storetypes.KVStoreKey)
+}
+
+// BackfillCmd returns a command to backfill the configured indexer from the block store.
+func BackfillCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Backfill the configured indexer by replaying the blocks of the block store",
+		Long: `Backfill the indexer configured in app.toml by replaying the blocks of the CometBFT block store
+on a fresh application, so that an indexer can be added to an existing archive node.
+
+The application is initialized either from genesis, in which case the block store must contain every block,
+or from a local state sync snapshot, in which case the snapshot state is sent to the indexer first and only
+the subsequent blocks are replayed. The app hash of every replayed block is checked against the block store.
+
+The node must not be running when calling this command.`,
+		Example: fmt.Sprintf("%s indexer backfill --snapshot-height 1000000", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+			logger := serverCtx.Logger
+
+			indexerCfg := serverCtx.Viper.Get("indexer")
+			if indexerCfg == nil {
+				return fmt.Errorf("no indexer configured in app.toml")
+			}
+
+			snapshotHeight, err := cmd.Flags().GetUint64(flagSnapshotHeight)
+			if err != nil {
+				return err
+			}
+			snapshotFormat, err := cmd.Flags().GetUint32(flagSnapshotFormat)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetUint64(flagEndHeight)
+			if err != nil {
+				return err
+			}
+			dir, err := cmd.Flags().GetString(flagBackfillDir)
+			if err != nil {
+				return err
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+			if err != nil {
+				return err
+			}
+			genDoc, err := appGenesis.ToGenesisDoc()
+			if err != nil {
+				return err
+			}
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return fmt.Errorf("error opening block store, make sure the node is not running: %w", err)
+			}
+			blockStore := store.NewBlockStore(blockStoreDB)
+			defer blockStore.Close()
+
+			stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return fmt.Errorf("error opening state store, make sure the node is not running: %w", err)
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+			defer stateStore.Close()
+
+			if endHeight == 0 {
+				endHeight = uint64(blockStore.Height())
+			}
+			if endHeight > uint64(blockStore.Height()) {
+				return fmt.Errorf("end height %d is greater than the block store height %d", endHeight, blockStore.Height())
+			}
+
+			// the app is created in a separate directory so that the state of the node is left untouched
+			if dir == "" {
+				dir, err = os.MkdirTemp("", "backfill")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(dir)
+			}
+
+			db, err := server.OpenDB(dir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+
+			appOpts := backfillAppOptions{
+				AppOptions: serverCtx.Viper,
+				overrides: map[string]any{
+					flags.FlagHome:                       dir,
+					flags.FlagChainID:                    genDoc.ChainID,
+					server.FlagHaltHeight:                0,
+					server.FlagHaltTime:                  0,
+					server.FlagStateSyncSnapshotInterval: 0,
+					// the indexer is fed by the backfill itself, not by the app
+					"indexer":   nil,
+					"streaming": nil,
+				},
+			}
+			app := appCreator(logger, db, nil, appOpts)
+			defer app.Close()
+
+			bApp, ok := any(app).(backfillApp)
+			if !ok {
+				return fmt.Errorf("app %T does not support indexing", app)
+			}
+
+			rms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("expected rootmulti.Store, got %T", app.CommitMultiStore())
+			}
+			keys := kvStoreKeys(rms)
+
+			collector := &blockCollector{}
+			bApp.SetIndexerListener(collector.listener(), keys)
+
+			resolver := bApp.SchemaDecoderResolver()
+			target, err := schemaindexer.StartIndexing(schemaindexer.IndexingOptions{
+				Config:   indexerCfg,
+				Resolver: resolver,
+				Logger:   logger.With(log.ModuleKey, "indexer"),
+				Context:  cmd.Context(),
+			})
+			if err != nil {
+				return err
+			}
+
+			opts := schemaindexer.BackfillOptions{
+				Target:   target.Listener,
+				Resolver: resolver,
+				Blocks: blockStoreSource{
+					app:           app,
+					blockStore:    blockStore,
+					stateStore:    stateStore,
+					initialHeight: genDoc.InitialHeight,
+					collector:     collector,
+				},
+				EndHeight: endHeight,
+				Logger:    logger.With(log.ModuleKey, "backfill"),
+				Context:   cmd.Context(),
+			}
+
+			if snapshotHeight > 0 {
+				if err := restoreSnapshot(serverCtx, app, blockStore, snapshotHeight, snapshotFormat); err != nil {
+					return err
+				}
+
+				opts.InitialHeight = snapshotHeight
+				opts.InitialState = storeSyncSource{store: rms, keys: keys}
+			} else {
+				if base := blockStore.Base(); base > genDoc.InitialHeight {
+					return fmt.Errorf("block store is pruned below height %d, a snapshot height must be provided", base)
+				}
+
+				if err := initChain(app, genDoc); err != nil {
+					return err
+				}
+
+				opts.InitialHeight = uint64(genDoc.InitialHeight - 1)
+			}
+
+			return schemaindexer.Backfill(opts)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSnapshotHeight, 0, "Height of the local snapshot to initialize the state from, 0 to replay all blocks from genesis")
+	cmd.Flags().Uint32(flagSnapshotFormat, snapshottypes.CurrentFormat, "Format of the local snapshot to initialize the state from")
+	cmd.Flags().Uint64(flagEndHeight, 0, "Height of the last block to replay, defaults to the block store height")
+	cmd.Flags().String(flagBackfillDir, "", "Directory of the replayed app state, defaults to a temporary directory removed afterwards")
+
+	return cmd
+}
+
+// restoreSnapshot restores the local snapshot at the given height into the app and checks its app hash
+// against the block store.
+func restoreSnapshot(
+	serverCtx *server.Context, app servertypes.Application, blockStore *store.BlockStore, height uint64, format uint32,
+) error {
+	meta := blockStore.LoadBlockMeta(int64(height) + 1)
+	if meta == nil {
+		return fmt.Errorf("block %d not found in block store", height+1)
+	}
+	appHash := meta.Header.AppHash
+
+	snapshotStore, err := server.GetSnapshotStore(serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := snapshotStore.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d with format %d not found", height, format)
+	}
+
+	abciSnapshot, err := snapshot.ToABCI()
+	if err != nil {
+		return err
+	}
+
+	offerRes, err := app.OfferSnapshot(&abci.OfferSnapshotRequest{Snapshot: &abciSnapshot, AppHash: appHash})
+	if err != nil {
+		return err
+	}
+	if offerRes.Result != abci.OFFER_SNAPSHOT_RESULT_ACCEPT {
+		return fmt.Errorf("snapshot at height %d rejected: %s", height, offerRes.Result)
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		reader, err := snapshotStore.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		chunk, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return err
+		}
+
+		applyRes, err := app.ApplySnapshotChunk(&abci.ApplySnapshotChunkRequest{Index: i, Chunk: chunk})
+		if err != nil {
+			return err
+		}
+		if applyRes.Result != abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT {
+			return fmt.Errorf("snapshot chunk %d rejected: %s", i, applyRes.Result)
+		}
+	}
+
+	if restored := app.CommitMultiStore().LastCommitID().Hash; !bytes.Equal(restored, appHash) {
+		return fmt.Errorf("app hash mismatch after restoring snapshot at height %d: expected %X, got %X", height, appHash, restored)
+	}
+
+	return nil
+}
+
+// initChain initializes the app from genesis, the same way CometBFT does on the first start of a node.
+func initChain(app servertypes.Application, genDoc *cmttypes.GenesisDoc) error {
+	validators := make([]*cmttypes.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = cmttypes.NewValidator(val.PubKey, val.Power)
+	}
+
+	consensusParams := genDoc.ConsensusParams.ToProto()
+	_, err := app.InitChain(&abci.InitChainRequest{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		InitialHeight:   genDoc.InitialHeight,
+		ConsensusParams: &consensusParams,
+		Validators:      cmttypes.TM2PB.ValidatorUpdates(cmttypes.NewValidatorSet(validators)),
+		AppStateBytes:   genDoc.AppState,
+	})
+
+	return err
+}
+
+// blockStoreSource is a schemaindexer.BlockSource re-executing the blocks of the CometBFT block store.
+type blockStoreSource struct {
+	app           servertypes.Application
+	blockStore    *store.BlockStore
+	stateStore    sm.Store
+	initialHeight int64
+	collector     *blockCollector
+}
+
+// Block implements schemaindexer.BlockSource.
+func (s blockStoreSource) Block(_ context.Context, height uint64) (schemaindexer.BlockData, error) {
+	block, _ := s.blockStore.LoadBlock(int64(height))
+	if block == nil {
+		return schemaindexer.BlockData{}, fmt.Errorf("block %d not found in block store", height)
+	}
+
+	var commitInfo abci.CommitInfo
+	if block.Height != s.initialHeight {
+		lastValSet, err := s.stateStore.LoadValidators(block.Height - 1)
+		if err != nil {
+			return schemaindexer.BlockData{}, err
+		}
+		commitInfo = sm.BuildLastCommitInfo(block, lastValSet, s.initialHeight)
+	}
+
+	res, err := s.app.FinalizeBlock(&abci.FinalizeBlockRequest{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  commitInfo,
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+	})
+	if err != nil {
+		return schemaindexer.BlockData{}, err
+	}
+
+	if _, err := s.app.Commit(); err != nil {
+		return schemaindexer.BlockData{}, err
+	}
+
+	// the app hash of a block is only known once the next block is committed to the block store
+	if next := s.blockStore.LoadBlockMeta(block.Height + 1); next != nil && !bytes.Equal(next.Header.AppHash, res.AppHash) {
+		return schemaindexer.BlockData{}, fmt.Errorf("app hash mismatch: expected %X, got %X", next.Header.AppHash, res.AppHash)
+	}
+
+	return s.collector.block, nil
+}
+
+// blockCollector collects the app data streamed by the app for a single block.
+type blockCollector struct {
+	block schemaindexer.BlockData
+}
+
+func (c *blockCollector) listener() appdata.Listener {
+	return appdata.Listener{
+		StartBlock: func(data appdata.StartBlockData) error {
+			c.block = schemaindexer.BlockData{StartBlock: data}
+			return nil
+		},
+		OnTx: func(data appdata.TxData) error {
+			c.block.Txs = append(c.block.Txs, data)
+			return nil
+		},
+		OnEvent: func(data appdata.EventData) error {
+			c.block.Events = append(c.block.Events, data.Events...)
+			return nil
+		},
+		OnKVPair: func(data appdata.KVPairData) error {
+			c.block.StateChanges = append(c.block.StateChanges, data.Updates...)
+			return nil
+		},
+	}
+}
+
+// storeSyncSource is a decoding.SyncSource iterating over the latest state of the multistore.
+type storeSyncSource struct {
+	store storetypes.MultiStore
+	keys  map[string]*storetypes.KVStoreKey
+}
+
+// IterateAllKVPairs implements decoding.SyncSource.
+func (s storeSyncSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	key, ok := s.keys[moduleName]
+	if !ok {
+		return nil
+	}
+
+	it := s.store.GetKVStore(key).Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+func kvStoreKeys(rms *rootmulti.Store) map[string]*storetypes.KVStoreKey {
+	keys := make(map[string]*storetypes.KVStoreKey)
+	for name, key := range rms.StoreKeysByName() {
+		if kv, ok := key.(*storetypes.KVStoreKey); ok {
+			keys[name] = kv
+		}
+	}
+
+	return keys
+}
+
+// backfillAppOptions overrides some of the app options of the node for the replayed app.
+type backfillAppOptions struct {
+	servertypes.AppOptions
+
+	overrides map[string]any
+}
+
+func (o backfillAppOptions) Get(key string) any {
+	if value, ok := o.overrides[key]; ok {
+		return value
+	}
+
+	return o.AppOptions.Get(key)
+}
+
+func (o backfillAppOptions) GetString(key string) string {
+	return cast.ToString(o.Get(key))
+}
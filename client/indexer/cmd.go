@@ -0,0 +1,19 @@
+package indexer
+
+import (
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Cmd returns the indexer group command
+func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexer",
+		Short: "Manage the built-in indexer",
+	}
+	cmd.AddCommand(
+		BackfillCmd(appCreator),
+	)
+	return cmd
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/enclave"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -63,6 +64,10 @@ local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
 multisig transactions.
 
+With the enclave keyring backend, new keys are generated and kept in the secure enclave of the
+operating system (the Secure Enclave on macOS, the TPM on Windows), and no mnemonic is created.
+When the secure enclave is not available, a local key is created instead.
+
 Use the --source flag to import mnemonic from a file in recover or interactive mode. 
 Example:
 
@@ -287,10 +292,25 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
 	}
 
+	recoverFlag, _ := cmd.Flags().GetBool(flagRecover)
+
+	// With the enclave backend, new keys are generated in the secure enclave, falling back to a local key
+	// when it is not available.
+	if kb.Backend() == keyring.BackendEnclave && !recoverFlag && !interactive {
+		k, err := kb.SaveEnclaveKey(name)
+		switch {
+		case err == nil:
+			return printCreate(ctx, cmd, k, false, false, "", outputFormat)
+		case errors.Is(err, enclave.ErrNotAvailable):
+			cmd.PrintErrln("secure enclave is not available, creating a local key instead")
+		default:
+			return err
+		}
+	}
+
 	// Get bip39 mnemonic
 	var mnemonic, bip39Passphrase string
 
-	recoverFlag, _ := cmd.Flags().GetBool(flagRecover)
 	mnemonicSrc, _ := cmd.Flags().GetString(flagMnemonicSrc)
 	if recoverFlag {
 		if mnemonicSrc != "" {
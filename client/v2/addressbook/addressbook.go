@@ -0,0 +1,227 @@
+package addressbook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// FileName is the name of the file, within the client home directory, where the address book is stored.
+const FileName = "addressbook.json"
+
+// ErrNotFound is returned when a name cannot be resolved to an address.
+var ErrNotFound = errors.New("name not found in address book")
+
+// Resolver resolves names to addresses. It allows plugging external name services,
+// such as an on-chain name service, into the address book.
+type Resolver interface {
+	// Resolve returns the address registered for the given name.
+	// It must return an error wrapping ErrNotFound if the name is unknown.
+	Resolve(ctx context.Context, name string) (string, error)
+}
+
+// Entry is a labeled address of the address book.
+type Entry struct {
+	Label   string `json:"label"`
+	Address string `json:"address"`
+}
+
+// AddressBook is a local store of labeled addresses, optionally backed by name resolvers.
+type AddressBook struct {
+	path      string
+	entries   map[string]string // label -> address
+	resolvers []Resolver
+}
+
+// New returns an empty in-memory address book which only resolves names through the given resolvers.
+// It cannot be persisted.
+func New(resolvers ...Resolver) *AddressBook {
+	return &AddressBook{
+		entries:   map[string]string{},
+		resolvers: resolvers,
+	}
+}
+
+// Load loads the address book stored in the given directory.
+// An empty address book is returned if the directory doesn't contain an address book yet.
+func Load(dir string, resolvers ...Resolver) (*AddressBook, error) {
+	book := &AddressBook{
+		path:      filepath.Join(dir, FileName),
+		entries:   map[string]string{},
+		resolvers: resolvers,
+	}
+
+	bz, err := os.ReadFile(book.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return book, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read address book: %w", err)
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode address book %s: %w", book.path, err)
+	}
+
+	for _, entry := range entries {
+		book.entries[entry.Label] = entry.Address
+	}
+
+	return book, nil
+}
+
+// Set labels the given address and persists the address book.
+// An existing label is overwritten.
+func (b *AddressBook) Set(label, address string) error {
+	if err := validateLabel(label); err != nil {
+		return err
+	}
+
+	if address == "" {
+		return errors.New("address cannot be empty")
+	}
+
+	b.entries[label] = address
+	return b.save()
+}
+
+// Delete removes the given label and persists the address book.
+func (b *AddressBook) Delete(label string) error {
+	if _, ok := b.entries[label]; !ok {
+		return fmt.Errorf("%s: %w", label, ErrNotFound)
+	}
+
+	delete(b.entries, label)
+	return b.save()
+}
+
+// Lookup returns the address labeled with the given label in the local address book.
+func (b *AddressBook) Lookup(label string) (string, bool) {
+	address, ok := b.entries[label]
+	return address, ok
+}
+
+// Label returns the label of the given address in the local address book.
+// If several labels point to the address, the first one in lexicographic order is returned.
+func (b *AddressBook) Label(address string) (string, bool) {
+	for _, entry := range b.Entries() {
+		if entry.Address == address {
+			return entry.Label, true
+		}
+	}
+
+	return "", false
+}
+
+// Entries returns the entries of the local address book sorted by label.
+func (b *AddressBook) Entries() []Entry {
+	entries := make([]Entry, 0, len(b.entries))
+	for _, label := range slices.Sorted(maps.Keys(b.entries)) {
+		entries = append(entries, Entry{Label: label, Address: b.entries[label]})
+	}
+
+	return entries
+}
+
+// Resolve resolves the given name to an address. The local address book is looked up first,
+// then each resolver is tried in order.
+func (b *AddressBook) Resolve(ctx context.Context, name string) (string, error) {
+	if address, ok := b.Lookup(name); ok {
+		return address, nil
+	}
+
+	for _, resolver := range b.resolvers {
+		address, err := resolver.Resolve(ctx, name)
+		if err == nil {
+			return address, nil
+		}
+
+		if !errors.Is(err, ErrNotFound) {
+			return "", fmt.Errorf("failed to resolve %s: %w", name, err)
+		}
+	}
+
+	return "", fmt.Errorf("%s: %w", name, ErrNotFound)
+}
+
+func (b *AddressBook) save() error {
+	if b.path == "" {
+		return errors.New("in-memory address book cannot be persisted")
+	}
+
+	bz, err := json.MarshalIndent(b.Entries(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(b.path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(b.path, bz, 0o600)
+}
+
+func validateLabel(label string) error {
+	if label == "" {
+		return errors.New("label cannot be empty")
+	}
+
+	if strings.ContainsFunc(label, unicode.IsSpace) {
+		return fmt.Errorf("label %q cannot contain whitespaces", label)
+	}
+
+	return nil
+}
+
+// LabelJSON returns a copy of the given JSON document where every string value matching
+// an address of the local address book is replaced by "label (address)".
+// It is meant for human-readable output only.
+func (b *AddressBook) LabelJSON(bz []byte) ([]byte, error) {
+	if len(b.entries) == 0 {
+		return bz, nil
+	}
+
+	labels := make(map[string]string, len(b.entries))
+	for _, entry := range b.Entries() {
+		if _, ok := labels[entry.Address]; !ok {
+			labels[entry.Address] = entry.Label
+		}
+	}
+
+	// use json.Number to not lose precision on large numbers
+	var doc any
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(labelValue(doc, labels))
+}
+
+func labelValue(v any, labels map[string]string) any {
+	switch v := v.(type) {
+	case string:
+		if label, ok := labels[v]; ok {
+			return fmt.Sprintf("%s (%s)", label, v)
+		}
+	case map[string]any:
+		for k, elem := range v {
+			v[k] = labelValue(elem, labels)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = labelValue(elem, labels)
+		}
+	}
+
+	return v
+}
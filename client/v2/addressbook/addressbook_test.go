@@ -0,0 +1,96 @@
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mapResolver map[string]string
+
+func (m mapResolver) Resolve(_ context.Context, name string) (string, error) {
+	if addr, ok := m[name]; ok {
+		return addr, nil
+	}
+	return "", ErrNotFound
+}
+
+type failingResolver struct{}
+
+func (failingResolver) Resolve(context.Context, string) (string, error) {
+	return "", errors.New("name service unavailable")
+}
+
+func TestAddressBook(t *testing.T) {
+	dir := t.TempDir()
+
+	book, err := Load(dir)
+	require.NoError(t, err)
+	require.Empty(t, book.Entries())
+
+	require.NoError(t, book.Set("bob", "cosmos1bob"))
+	require.NoError(t, book.Set("alice", "cosmos1alice"))
+	require.ErrorContains(t, book.Set("", "cosmos1alice"), "label cannot be empty")
+	require.ErrorContains(t, book.Set("al ice", "cosmos1alice"), "cannot contain whitespaces")
+	require.ErrorContains(t, book.Set("carol", ""), "address cannot be empty")
+
+	// reload from disk
+	book, err = Load(dir)
+	require.NoError(t, err)
+	require.Equal(t, []Entry{{Label: "alice", Address: "cosmos1alice"}, {Label: "bob", Address: "cosmos1bob"}}, book.Entries())
+
+	addr, ok := book.Lookup("alice")
+	require.True(t, ok)
+	require.Equal(t, "cosmos1alice", addr)
+
+	label, ok := book.Label("cosmos1bob")
+	require.True(t, ok)
+	require.Equal(t, "bob", label)
+
+	require.NoError(t, book.Delete("bob"))
+	require.ErrorIs(t, book.Delete("bob"), ErrNotFound)
+
+	book, err = Load(dir)
+	require.NoError(t, err)
+	_, ok = book.Lookup("bob")
+	require.False(t, ok)
+
+	require.ErrorContains(t, New().Set("alice", "cosmos1alice"), "cannot be persisted")
+}
+
+func TestResolve(t *testing.T) {
+	book, err := Load(t.TempDir(), mapResolver{"alice": "cosmos1remote", "carol": "cosmos1carol"})
+	require.NoError(t, err)
+	require.NoError(t, book.Set("alice", "cosmos1alice"))
+
+	// local labels take precedence over resolvers
+	addr, err := book.Resolve(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1alice", addr)
+
+	addr, err = book.Resolve(context.Background(), "carol")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1carol", addr)
+
+	_, err = book.Resolve(context.Background(), "dave")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = New(failingResolver{}).Resolve(context.Background(), "dave")
+	require.ErrorContains(t, err, "name service unavailable")
+}
+
+func TestLabelJSON(t *testing.T) {
+	book, err := Load(t.TempDir())
+	require.NoError(t, err)
+
+	out, err := book.LabelJSON([]byte(`{"from":"cosmos1alice"}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"from":"cosmos1alice"}`, string(out))
+
+	require.NoError(t, book.Set("alice", "cosmos1alice"))
+	out, err = book.LabelJSON([]byte(`{"from":"cosmos1alice","to":["cosmos1bob",{"addr":"cosmos1alice"}],"amount":123456789012345678901234567890}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"from":"alice (cosmos1alice)","to":["cosmos1bob",{"addr":"alice (cosmos1alice)"}],"amount":123456789012345678901234567890}`, string(out))
+}
@@ -0,0 +1,96 @@
+package addressbook
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// AddressBookCmd returns the commands to manage the local address book.
+func AddressBookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-book",
+		Short: "Manage the local address book.",
+		Long: `Manage the local address book.
+Labels of the address book can be used instead of addresses in commands arguments and flags,
+and are displayed next to the addresses they label in text output.`,
+	}
+
+	cmd.AddCommand(
+		AddCmd(),
+		RemoveCmd(),
+		ListCmd(),
+	)
+
+	return cmd
+}
+
+// AddCmd labels an address in the address book.
+func AddCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <label> <address>",
+		Short: "Label an address.",
+		Long:  "Label an address in the address book. An existing label is overwritten.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			if _, err := clientCtx.AddressCodec.StringToBytes(args[1]); err != nil {
+				if _, valErr := clientCtx.ValidatorAddressCodec.StringToBytes(args[1]); valErr != nil {
+					return fmt.Errorf("invalid address %s: %w", args[1], err)
+				}
+			}
+
+			book, err := Load(clientCtx.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			return book.Set(args[0], args[1])
+		},
+	}
+}
+
+// RemoveCmd removes a label from the address book.
+func RemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <label>",
+		Short: "Remove a label.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			book, err := Load(clientCtx.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			return book.Delete(args[0])
+		},
+	}
+}
+
+// ListCmd lists the labels of the address book.
+func ListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the labels of the address book.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			book, err := Load(clientCtx.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range book.Entries() {
+				cmd.Printf("%s\t%s\n", entry.Label, entry.Address)
+			}
+
+			return nil
+		},
+	}
+}
@@ -65,8 +65,9 @@ func (appOptions AppOptions) EnhanceRootCommand(rootCmd *cobra.Command) error {
 		GetClientConn: func(cmd *cobra.Command) (grpc.ClientConnInterface, error) {
 			return client.GetClientQueryContext(cmd)
 		},
-		AddQueryConnFlags: sdkflags.AddQueryFlagsToCmd,
-		AddTxConnFlags:    sdkflags.AddTxFlagsToCmd,
+		AddQueryConnFlags:  sdkflags.AddQueryFlagsToCmd,
+		AddTxConnFlags:     sdkflags.AddTxFlagsToCmd,
+		SubscribeNewBlocks: subscribeNewBlocks,
 	}
 
 	return appOptions.EnhanceRootCommandWithBuilder(rootCmd, builder)
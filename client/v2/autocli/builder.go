@@ -19,8 +19,21 @@ type Builder struct {
 	// AddQueryConnFlags and AddTxConnFlags are functions that add flags to query and transaction commands
 	AddQueryConnFlags func(*cobra.Command)
 	AddTxConnFlags    func(*cobra.Command)
+
+	// SubscribeNewBlocks specifies how query commands are notified of the heights of the new blocks
+	// with the --watch flag. The unsubscribe function releases the subscription. The --watch flag
+	// is only added when it is set.
+	SubscribeNewBlocks func(*cobra.Command) (heights <-chan int64, unsubscribe func(), err error)
+
+	// OutputRenderers are custom output formats for query commands, selected by the value of the --output flag.
+	// They take precedence over the built-in output formats.
+	OutputRenderers map[string]OutputRenderer
 }
 
+// OutputRenderer renders the JSON output of a query command in a custom output format.
+// columns are the field paths selected with the --columns flag, if any.
+type OutputRenderer func(out []byte, columns []string) ([]byte, error)
+
 // ValidateAndComplete the builder fields.
 // It returns an error if any of the required fields are missing.
 func (b *Builder) ValidateAndComplete() error {
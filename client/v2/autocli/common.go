@@ -2,6 +2,7 @@ package autocli
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -9,7 +10,9 @@ import (
 	"sigs.k8s.io/yaml"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/client/v2/addressbook"
 	"cosmossdk.io/client/v2/internal/flags"
+	"cosmossdk.io/client/v2/internal/render"
 	"cosmossdk.io/client/v2/internal/util"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -228,6 +231,17 @@ func enhanceCustomCmd(builder *Builder, cmd *cobra.Command, cmdType cmdType, mod
 
 // outOrStdoutFormat formats the output based on the output flag and writes it to the command's output stream.
 func (b *Builder) outOrStdoutFormat(cmd *cobra.Command, out []byte) error {
+	formatted, err := b.formatOutput(cmd, out)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(formatted)
+	return nil
+}
+
+// formatOutput formats the JSON output of a command based on the output flag.
+func (b *Builder) formatOutput(cmd *cobra.Command, out []byte) (string, error) {
 	clientCtx := client.Context{}
 	if v := cmd.Context().Value(client.ClientContextKey); v != nil {
 		clientCtx = *(v.(*client.Context))
@@ -238,17 +252,63 @@ func (b *Builder) outOrStdoutFormat(cmd *cobra.Command, out []byte) error {
 		clientCtx = clientCtx.WithOutputFormat(output)
 	}
 
-	var err error
 	outputType := clientCtx.OutputFormat
-	// if the output type is text, convert the json to yaml
-	// if output type is json or nil, default to json
-	if outputType == flags.OutputFormatText {
-		out, err = yaml.JSONToYAML(out)
+	columns, _ := flagSet.GetStringSlice(flags.FlagColumns)
+	if renderer, ok := b.OutputRenderers[outputType]; ok {
+		out, err := renderer(out, columns)
 		if err != nil {
-			return err
+			return "", err
 		}
+
+		return strings.TrimSpace(string(out)), nil
 	}
 
-	cmd.Println(strings.TrimSpace(string(out)))
-	return nil
+	if len(columns) > 0 && outputType != flags.OutputFormatTable && outputType != flags.OutputFormatCSV {
+		return "", fmt.Errorf("--%s is only supported with the %s and %s output formats", flags.FlagColumns, flags.OutputFormatTable, flags.OutputFormatCSV)
+	}
+
+	// display the labels of the address book next to the addresses they label in human-readable formats
+	if clientCtx.HomeDir != "" && (outputType == flags.OutputFormatText || outputType == flags.OutputFormatYAML || outputType == flags.OutputFormatTable) {
+		book, err := addressbook.Load(clientCtx.HomeDir)
+		if err != nil {
+			return "", err
+		}
+
+		out, err = book.LabelJSON(out)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var err error
+	// if the output type is text or yaml, convert the json to yaml
+	// if the output type is table or csv, render the selected columns
+	// if output type is json, nil or unknown, default to json
+	switch outputType {
+	case flags.OutputFormatText, flags.OutputFormatYAML:
+		out, err = yaml.JSONToYAML(out)
+	case flags.OutputFormatTable:
+		out, err = render.Table(out, columns)
+	case flags.OutputFormatCSV:
+		out, err = render.CSV(out, columns)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// outputFormats returns the names of the built-in output formats, followed by the custom ones in alphabetical order.
+func (b *Builder) outputFormats() []string {
+	formats := []string{flags.OutputFormatText, flags.OutputFormatJSON, flags.OutputFormatYAML, flags.OutputFormatTable, flags.OutputFormatCSV}
+	custom := make([]string, 0, len(b.OutputRenderers))
+	for name := range b.OutputRenderers {
+		if !slices.Contains(formats, name) {
+			custom = append(custom, name)
+		}
+	}
+	slices.Sort(custom)
+
+	return append(formats, custom...)
 }
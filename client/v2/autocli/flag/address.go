@@ -2,10 +2,12 @@ package flag
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"cosmossdk.io/client/v2/addressbook"
 	"cosmossdk.io/client/v2/autocli/keyring"
 	"cosmossdk.io/core/address"
 
@@ -20,7 +22,7 @@ import (
 type addressStringType struct{}
 
 func (a addressStringType) NewValue(ctx *context.Context, b *Builder) Value {
-	return &addressValue{addressCodec: b.AddressCodec, ctx: ctx}
+	return &addressValue{addressCodec: b.AddressCodec, resolvers: b.AddressResolvers, ctx: ctx}
 }
 
 func (a addressStringType) DefaultValue() string {
@@ -30,7 +32,7 @@ func (a addressStringType) DefaultValue() string {
 type validatorAddressStringType struct{}
 
 func (a validatorAddressStringType) NewValue(ctx *context.Context, b *Builder) Value {
-	return &addressValue{addressCodec: b.ValidatorAddressCodec, ctx: ctx}
+	return &addressValue{addressCodec: b.ValidatorAddressCodec, resolvers: b.AddressResolvers, ctx: ctx}
 }
 
 func (a validatorAddressStringType) DefaultValue() string {
@@ -40,20 +42,39 @@ func (a validatorAddressStringType) DefaultValue() string {
 type addressValue struct {
 	ctx          *context.Context
 	addressCodec address.Codec
+	resolvers    []addressbook.Resolver
 
 	value string
+	// name is set when the value is neither a key name nor an address.
+	// It is resolved through the address book when the value is retrieved.
+	name string
 }
 
 func (a addressValue) Get(protoreflect.Value) (protoreflect.Value, error) {
-	return protoreflect.ValueOfString(a.value), nil
+	if a.name == "" {
+		return protoreflect.ValueOfString(a.value), nil
+	}
+
+	addrStr, err := a.resolve(a.name)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	return protoreflect.ValueOfString(addrStr), nil
 }
 
 func (a addressValue) String() string {
+	if a.name != "" {
+		return a.name
+	}
+
 	return a.value
 }
 
 // Set implements the flag.Value interface for addressValue.
 func (a *addressValue) Set(s string) error {
+	a.name = ""
+
 	// we get the keyring on set, as in NewValue the context is the parent context (before RunE)
 	keyring := getKeyringFromCtx(a.ctx)
 	addr, err := keyring.LookupAddressByKeyName(s)
@@ -68,17 +89,47 @@ func (a *addressValue) Set(s string) error {
 	}
 
 	_, err = a.addressCodec.StringToBytes(s)
+	if err == nil {
+		a.value = s
+		return nil
+	}
+
+	// the value may be a label of the address book, which is resolved on Get
+	// as the client home directory is only known once the command runs
+	a.value, a.name = "", s
+	return nil
+}
+
+// resolve resolves a name through the address book labels and name resolvers.
+func (a addressValue) resolve(name string) (string, error) {
+	_, addrErr := a.addressCodec.StringToBytes(name)
+
+	book, err := getAddressBookFromCtx(a.ctx, a.resolvers)
 	if err != nil {
-		return fmt.Errorf("invalid account address or key name: %w", err)
+		return "", err
 	}
 
-	a.value = s
+	resolveCtx := *a.ctx
+	if resolveCtx == nil {
+		resolveCtx = context.Background()
+	}
 
-	return nil
+	addrStr, err := book.Resolve(resolveCtx, name)
+	if errors.Is(err, addressbook.ErrNotFound) {
+		return "", fmt.Errorf("invalid account address, key name or label: %w", addrErr)
+	} else if err != nil {
+		return "", err
+	}
+
+	if _, err = a.addressCodec.StringToBytes(addrStr); err != nil {
+		return "", fmt.Errorf("invalid account address resolved from %s: %w", name, err)
+	}
+
+	return addrStr, nil
 }
 
 func (a addressValue) Type() string {
-	return "account address or key name"
+	return "account address, key name or label"
 }
 
 type consensusAddressStringType struct{}
@@ -108,6 +159,10 @@ func (a consensusAddressValue) String() string {
 	return a.value
 }
 
+func (a consensusAddressValue) Type() string {
+	return "account address or key name"
+}
+
 func (a *consensusAddressValue) Set(s string) error {
 	// we get the keyring on set, as in NewValue the context is the parent context (before RunE)
 	keyring := getKeyringFromCtx(a.ctx)
@@ -147,6 +202,19 @@ func (a *consensusAddressValue) Set(s string) error {
 	return nil
 }
 
+// getAddressBookFromCtx loads the address book of the client home directory.
+// If the client home directory is unknown, only the resolvers are used.
+func getAddressBookFromCtx(ctx *context.Context, resolvers []addressbook.Resolver) (*addressbook.AddressBook, error) {
+	dctx := *ctx
+	if dctx != nil {
+		if clientCtx := dctx.Value(client.ClientContextKey); clientCtx != nil && clientCtx.(*client.Context).HomeDir != "" {
+			return addressbook.Load(clientCtx.(*client.Context).HomeDir, resolvers...)
+		}
+	}
+
+	return addressbook.New(resolvers...), nil
+}
+
 func getKeyringFromCtx(ctx *context.Context) keyring.Keyring {
 	dctx := *ctx
 	if dctx != nil {
@@ -16,6 +16,7 @@ import (
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	"cosmossdk.io/client/v2/addressbook"
 	"cosmossdk.io/client/v2/internal/flags"
 	"cosmossdk.io/client/v2/internal/util"
 	"cosmossdk.io/core/address"
@@ -52,6 +53,10 @@ type Builder struct {
 	AddressCodec          address.Codec
 	ValidatorAddressCodec address.ValidatorAddressCodec
 	ConsensusAddressCodec address.ConsensusAddressCodec
+
+	// AddressResolvers are optional name resolvers, such as an on-chain name service, used to
+	// resolve address arguments which are neither key names, labels of the address book nor addresses.
+	AddressResolvers []addressbook.Resolver
 }
 
 func (b *Builder) init() {
@@ -178,6 +178,9 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 		b.AddTxConnFlags(cmd)
 	}
 
+	cmd.Flags().String(flags.FlagTxEncoding, "auto", "Transaction encoding used for broadcasting (auto|proto|amino-json); auto selects it from the node version")
+	cmd.Flags().String(flags.FlagLegacyRESTAddr, "", "Legacy REST server address receiving amino-json transactions, e.g. http://localhost:1317")
+
 	// silence usage only for inner txs & queries commands
 	cmd.SilenceUsage = true
 
@@ -135,19 +135,31 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 			return err
 		}
 
-		output := outputType.New()
-		if err := clientConn.Invoke(cmd.Context(), methodName, input.Interface(), output.Interface()); err != nil {
-			return err
-		}
-
 		if noIndent, _ := cmd.Flags().GetBool(flags.FlagNoIndent); noIndent {
 			encoderOptions.Indent = ""
 		}
 
-		enc := encoder(aminojson.NewEncoder(encoderOptions))
-		bz, err := enc.Marshal(output.Interface())
+		query := func() ([]byte, error) {
+			output := outputType.New()
+			if err := clientConn.Invoke(cmd.Context(), methodName, input.Interface(), output.Interface()); err != nil {
+				return nil, err
+			}
+
+			enc := encoder(aminojson.NewEncoder(encoderOptions))
+			bz, err := enc.Marshal(output.Interface())
+			if err != nil {
+				return nil, fmt.Errorf("cannot marshal response %v: %w", output.Interface(), err)
+			}
+			return bz, nil
+		}
+
+		if watch, _ := cmd.Flags().GetBool(flagWatch); watch {
+			return b.watchQuery(cmd, query)
+		}
+
+		bz, err := query()
 		if err != nil {
-			return fmt.Errorf("cannot marshal response %v: %w", output.Interface(), err)
+			return err
 		}
 
 		return b.outOrStdoutFormat(cmd, bz)
@@ -160,6 +172,14 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 		b.AddQueryConnFlags(cmd)
 
 		cmd.Flags().BoolP(flags.FlagNoIndent, "", false, "Do not indent JSON output")
+		cmd.Flags().StringSlice(flags.FlagColumns, nil, "Field paths to display with the table and csv output formats (e.g. balance.denom,balance.amount)")
+		if outputFlag := cmd.Flags().Lookup(flags.FlagOutput); outputFlag != nil {
+			outputFlag.Usage = fmt.Sprintf("Output format (%s)", strings.Join(b.outputFormats(), "|"))
+		}
+		if b.SubscribeNewBlocks != nil {
+			cmd.Flags().Bool(flagWatch, false, "Re-execute the query on each new block")
+			cmd.Flags().Bool(flagWatchDiff, false, "With --watch, only print the lines of the output which changed")
+		}
 	}
 
 	// silence usage only for inner txs & queries commands
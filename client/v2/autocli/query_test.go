@@ -18,6 +18,7 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/client/v2/addressbook"
 	"cosmossdk.io/client/v2/internal/testpb"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -599,6 +600,32 @@ func TestAddressValidation(t *testing.T) {
 	assert.ErrorContains(t, err, "invalid account address")
 }
 
+func TestAddressBook(t *testing.T) {
+	fixture := initFixture(t)
+
+	addr := "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"
+	book, err := addressbook.Load(fixture.clientCtx.HomeDir)
+	assert.NilError(t, err)
+	assert.NilError(t, book.Set("alice", addr))
+
+	out, err := runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--an-address", "alice",
+		"--output", "text",
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, addr, fixture.conn.lastRequest.(*testpb.EchoRequest).AnAddress)
+	assert.Assert(t, strings.Contains(out.String(), "an_address: alice ("+addr+")"))
+
+	_, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--an-address", "bob",
+	)
+	assert.ErrorContains(t, err, "invalid account address, key name or label")
+}
+
 func TestOutputFormat(t *testing.T) {
 	fixture := initFixture(t)
 
@@ -617,6 +644,49 @@ func TestOutputFormat(t *testing.T) {
 	)
 	assert.NilError(t, err)
 	assert.Assert(t, strings.Contains(out.String(), "  positional1: 1"))
+
+	out, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo", "2bar",
+		"--output", "table",
+		"--columns", "request.positional1,request.positional3_varargs.denom,request.positional3_varargs.amount",
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, out.String(), `REQUEST.POSITIONAL1  REQUEST.POSITIONAL3_VARARGS.DENOM  REQUEST.POSITIONAL3_VARARGS.AMOUNT
+1                    foo                                1
+1                    bar                                2
+`)
+
+	out, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo", "2bar",
+		"--output", "csv",
+		"--columns", "request.positional3_varargs.denom,request.positional3_varargs.amount",
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, out.String(), "request.positional3_varargs.denom,request.positional3_varargs.amount\nfoo,1\nbar,2\n")
+
+	_, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--output", "json",
+		"--columns", "request.positional1",
+	)
+	assert.ErrorContains(t, err, "--columns is only supported with the table and csv output formats")
+
+	fixture.b.OutputRenderers = map[string]OutputRenderer{
+		"count": func(out []byte, columns []string) ([]byte, error) {
+			return []byte(fmt.Sprintf("%d bytes %v", len(out), columns)), nil
+		},
+	}
+	out, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--output", "count",
+		"--columns", "request",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasSuffix(out.String(), " bytes [request]\n"))
 }
 
 func TestHelpQuery(t *testing.T) {
@@ -0,0 +1,171 @@
+package autocli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	flagWatch     = "watch"
+	flagWatchDiff = "watch-diff"
+)
+
+// watchQuery prints the output of the query, then re-executes it on each new block and prints
+// either its full output or, with the --watch-diff flag, the lines which changed. It returns when
+// the context of the command is done or the subscription to the new blocks is closed.
+// The errors of the query are printed without stopping the watch.
+func (b *Builder) watchQuery(cmd *cobra.Command, query func() ([]byte, error)) error {
+	if cmd.Flags().Changed(flags.FlagHeight) {
+		return fmt.Errorf("--%s can't be used with --%s", flagWatch, flags.FlagHeight)
+	}
+	diff, _ := cmd.Flags().GetBool(flagWatchDiff)
+
+	// subscribe before the first query so that no block is missed
+	heights, unsubscribe, err := b.SubscribeNewBlocks(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to new blocks: %w", err)
+	}
+	defer unsubscribe()
+
+	run := func() (string, error) {
+		bz, err := query()
+		if err != nil {
+			return "", err
+		}
+		return b.formatOutput(cmd, bz)
+	}
+
+	out, err := run()
+	if err != nil {
+		return err
+	}
+	cmd.Println(out)
+
+	for {
+		select {
+		case <-cmd.Context().Done():
+			return nil
+		case height, ok := <-heights:
+			if !ok {
+				return errors.New("new blocks subscription closed")
+			}
+
+			next, err := run()
+			if err != nil {
+				cmd.PrintErrf("height %d: %v\n", height, err)
+				continue
+			}
+			if !diff {
+				cmd.Printf("--- height %d\n%s\n", height, next)
+			} else if next != out {
+				cmd.Printf("--- height %d\n%s\n", height, diffLines(out, next))
+			}
+			out = next
+		}
+	}
+}
+
+// diffLines returns the lines removed from old, prefixed with "- ", and added to updated,
+// prefixed with "+ ", in the order of their longest common subsequence.
+func diffLines(old, updated string) string {
+	a, b := strings.Split(old, "\n"), strings.Split(updated, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var res []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			res = append(res, "- "+a[i])
+			i++
+		default:
+			res = append(res, "+ "+b[j])
+			j++
+		}
+	}
+
+	return strings.Join(res, "\n")
+}
+
+// subscribeNewBlocks subscribes to the new block headers of the CometBFT websocket of the node
+// of the client context.
+func subscribeNewBlocks(cmd *cobra.Command) (<-chan int64, func(), error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c, err := rpchttp.New(clientCtx.NodeURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := c.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	const subscriber = "autocli-watch"
+	events, err := c.Subscribe(cmd.Context(), subscriber, cmttypes.EventQueryNewBlockHeader.String())
+	if err != nil {
+		_ = c.Stop()
+		return nil, nil, err
+	}
+
+	heights := make(chan int64)
+	done := make(chan struct{})
+	go func() {
+		defer close(heights)
+		for {
+			select {
+			case <-done:
+				return
+			case evt, ok := <-events:
+				if !ok {
+					return
+				}
+				header, ok := evt.Data.(cmttypes.EventDataNewBlockHeader)
+				if !ok {
+					continue
+				}
+				select {
+				case heights <- header.Header.Height:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+
+	unsubscribe := func() {
+		close(done)
+		_ = c.UnsubscribeAll(context.Background(), subscriber)
+		_ = c.Stop()
+	}
+
+	return heights, unsubscribe, nil
+}
@@ -0,0 +1,57 @@
+package autocli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+)
+
+func TestWatchQuery(t *testing.T) {
+	fixture := initFixture(t)
+	unsubscribed := 0
+	fixture.b.SubscribeNewBlocks = func(*cobra.Command) (<-chan int64, func(), error) {
+		heights := make(chan int64, 2)
+		heights <- 2
+		heights <- 3
+		close(heights)
+		return heights, func() { unsubscribed++ }, nil
+	}
+
+	out, err := runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--output", "json",
+		"--watch",
+	)
+	assert.ErrorContains(t, err, "new blocks subscription closed")
+	assert.Equal(t, unsubscribed, 1)
+	assert.Equal(t, strings.Count(out.String(), `"positional1": 1`), 3)
+	assert.Assert(t, strings.Contains(out.String(), "--- height 2\n"))
+	assert.Assert(t, strings.Contains(out.String(), "--- height 3\n"))
+
+	// the output doesn't change between the blocks
+	out, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--output", "json",
+		"--watch", "--watch-diff",
+	)
+	assert.ErrorContains(t, err, "new blocks subscription closed")
+	assert.Equal(t, strings.Count(out.String(), `"positional1": 1`), 1)
+	assert.Assert(t, !strings.Contains(out.String(), "--- height"))
+
+	_, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--watch", "--height", "5",
+	)
+	assert.ErrorContains(t, err, "--watch can't be used with --height")
+}
+
+func TestDiffLines(t *testing.T) {
+	old := "balances:\n- amount: 10\n  denom: stake\npagination: {}"
+	updated := "balances:\n- amount: 12\n  denom: stake\n- amount: 1\n  denom: foo\npagination: {}"
+	assert.Equal(t, diffLines(old, updated), "- - amount: 10\n+ - amount: 12\n+ - amount: 1\n+   denom: foo")
+}
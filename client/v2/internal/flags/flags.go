@@ -8,6 +8,9 @@ const (
 	// FlagOutput is the flag to set the output format.
 	FlagOutput = "output"
 
+	// FlagColumns is the flag to select the fields displayed with the table and csv output formats.
+	FlagColumns = "columns"
+
 	// FlagNoIndent is the flag to not indent the output.
 	FlagNoIndent = "no-indent"
 
@@ -17,10 +20,19 @@ const (
 	// FlagNoProposal is the flag convert a gov proposal command into a normal command.
 	// This is used to allow user of chains with custom authority to not use gov submit proposals for usual proposal commands.
 	FlagNoProposal = "no-proposal"
+
+	// FlagTxEncoding is the flag to set the encoding a transaction is broadcast with.
+	FlagTxEncoding = "tx-encoding"
+
+	// FlagLegacyRESTAddr is the flag to set the legacy REST server receiving amino-json transactions.
+	FlagLegacyRESTAddr = "legacy-rest-addr"
 )
 
 // List of supported output formats
 const (
-	OutputFormatJSON = "json"
-	OutputFormatText = "text"
+	OutputFormatJSON  = "json"
+	OutputFormatText  = "text"
+	OutputFormatYAML  = "yaml"
+	OutputFormatTable = "table"
+	OutputFormatCSV   = "csv"
 )
@@ -0,0 +1,306 @@
+package render
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Table renders the JSON output as a table with one column per field path.
+// If no columns are given, all the fields of the output are displayed.
+func Table(out []byte, columns []string) ([]byte, error) {
+	header, rows, err := Rows(out, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	for i, column := range header {
+		header[i] = strings.ToUpper(column)
+	}
+	writeTableRow(w, header)
+	for _, row := range rows {
+		writeTableRow(w, row)
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeTableRow(w io.Writer, row []string) {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		cells[i] = strings.Join(strings.Fields(cell), " ")
+	}
+
+	_, _ = fmt.Fprintln(w, strings.Join(cells, "\t"))
+}
+
+// CSV renders the JSON output as CSV with one column per field path, including a header line.
+// If no columns are given, all the fields of the output are displayed.
+func CSV(out []byte, columns []string) ([]byte, error) {
+	header, rows, err := Rows(out, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Rows extracts the rows of the given columns from the JSON output.
+// A column is a field path, with the field names separated by dots (e.g. balance.denom).
+// Lists traversed by a field path are expanded into one row per element, and the columns
+// with a single value are repeated on every row.
+// Objects and lists at the end of a field path are displayed as compact JSON.
+// If no columns are given, the columns are all the fields of the output, in order.
+func Rows(out []byte, columns []string) (header []string, rows [][]string, err error) {
+	dec := json.NewDecoder(bytes.NewReader(out))
+	dec.UseNumber()
+	doc, err := decode(dec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot decode output: %w", err)
+	}
+
+	if len(columns) == 0 {
+		columns = fieldPaths(doc, "", nil)
+	}
+
+	values := make([][]any, len(columns))
+	numRows, longest := 0, ""
+	for i, column := range columns {
+		if column == "" {
+			return nil, nil, errors.New("empty column field path")
+		}
+
+		values[i] = resolve(doc, strings.Split(column, "."))
+		if len(values[i]) > numRows {
+			numRows, longest = len(values[i]), column
+		}
+	}
+
+	for i, column := range columns {
+		if n := len(values[i]); n > 1 && n != numRows {
+			return nil, nil, fmt.Errorf("columns %s and %s cannot be displayed in the same rows, they have %d and %d values", longest, column, numRows, n)
+		}
+	}
+
+	rows = make([][]string, numRows)
+	for i := range rows {
+		rows[i] = make([]string, len(columns))
+		for j, vals := range values {
+			switch len(vals) {
+			case 0:
+				continue
+			case 1:
+				rows[i][j], err = formatValue(vals[0])
+			default:
+				rows[i][j], err = formatValue(vals[i])
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return columns, rows, nil
+}
+
+// object is a decoded JSON object which preserves the order of its fields.
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		bz, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(bz)
+		buf.WriteByte(':')
+
+		bz, err = json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(bz)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// decode decodes the next JSON value, keeping the field order of the objects.
+func decode(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := &object{values: map[string]any{}}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			key, ok := keyTok.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", keyTok)
+			}
+
+			value, err := decode(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := obj.values[key]; !ok {
+				obj.keys = append(obj.keys, key)
+			}
+			obj.values[key] = value
+		}
+
+		_, err = dec.Token()
+		return obj, err
+	case json.Delim('['):
+		list := []any{}
+		for dec.More() {
+			value, err := decode(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, value)
+		}
+
+		_, err = dec.Token()
+		return list, err
+	default:
+		return tok, nil
+	}
+}
+
+// fieldPaths appends the paths of all the fields of the value, lists of objects being traversed.
+func fieldPaths(value any, prefix string, paths []string) []string {
+	switch value := value.(type) {
+	case *object:
+		if len(value.keys) == 0 && prefix != "" {
+			return appendPath(paths, prefix)
+		}
+
+		for _, key := range value.keys {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+
+			paths = fieldPaths(value.values[key], path, paths)
+		}
+
+		return paths
+	case []any:
+		hasObjects := false
+		for _, elem := range value {
+			if obj, ok := elem.(*object); ok {
+				hasObjects = true
+				paths = fieldPaths(obj, prefix, paths)
+			}
+		}
+
+		if !hasObjects && prefix != "" {
+			return appendPath(paths, prefix)
+		}
+
+		return paths
+	default:
+		if prefix == "" {
+			return paths
+		}
+
+		return appendPath(paths, prefix)
+	}
+}
+
+func appendPath(paths []string, path string) []string {
+	for _, p := range paths {
+		if p == path {
+			return paths
+		}
+	}
+
+	return append(paths, path)
+}
+
+// resolve returns the values at the given field path, lists being expanded when traversed.
+func resolve(value any, path []string) []any {
+	if len(path) == 0 {
+		return []any{value}
+	}
+
+	switch value := value.(type) {
+	case *object:
+		child, ok := value.values[path[0]]
+		if !ok {
+			return nil
+		}
+
+		return resolve(child, path[1:])
+	case []any:
+		var values []any
+		for _, elem := range value {
+			values = append(values, resolve(elem, path)...)
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
+
+// formatValue formats a single cell value.
+func formatValue(value any) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return fmt.Sprint(value), nil
+	default:
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+
+		return string(bz), nil
+	}
+}
@@ -0,0 +1,112 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/client/v2/internal/render"
+)
+
+const balancesOutput = `{
+  "balances": [
+    {"denom": "stake", "amount": "10"},
+    {"denom": "atom", "amount": "20"}
+  ],
+  "pagination": {"next_key": null, "total": "2"}
+}`
+
+func TestRows(t *testing.T) {
+	testCases := []struct {
+		name      string
+		out       string
+		columns   []string
+		expHeader []string
+		expRows   [][]string
+		expErr    string
+	}{
+		{
+			name:      "single object",
+			out:       `{"balance": {"denom": "stake", "amount": "10"}}`,
+			columns:   []string{"balance.denom", "balance.amount"},
+			expHeader: []string{"balance.denom", "balance.amount"},
+			expRows:   [][]string{{"stake", "10"}},
+		},
+		{
+			name:      "list expanded into rows",
+			out:       balancesOutput,
+			columns:   []string{"balances.amount", "balances.denom"},
+			expHeader: []string{"balances.amount", "balances.denom"},
+			expRows:   [][]string{{"10", "stake"}, {"20", "atom"}},
+		},
+		{
+			name:      "single values repeated on every row",
+			out:       balancesOutput,
+			columns:   []string{"balances.denom", "pagination.total"},
+			expHeader: []string{"balances.denom", "pagination.total"},
+			expRows:   [][]string{{"stake", "2"}, {"atom", "2"}},
+		},
+		{
+			name:      "all fields by default",
+			out:       balancesOutput,
+			expHeader: []string{"balances.denom", "balances.amount", "pagination.next_key", "pagination.total"},
+			expRows:   [][]string{{"stake", "10", "", "2"}, {"atom", "20", "", "2"}},
+		},
+		{
+			name:      "objects and lists as json",
+			out:       `{"params": {"b": [1, 2], "a": {"z": true, "y": 1.5}}}`,
+			columns:   []string{"params.b", "params.a", "missing"},
+			expHeader: []string{"params.b", "params.a", "missing"},
+			expRows:   [][]string{{"[1,2]", `{"z":true,"y":1.5}`, ""}},
+		},
+		{
+			name:      "empty list",
+			out:       `{"balances": [], "pagination": {"total": "0"}}`,
+			expHeader: []string{"balances", "pagination.total"},
+			expRows:   [][]string{{"[]", "0"}},
+		},
+		{
+			name:    "mismatching number of values",
+			out:     `{"a": [{"x": 1}, {"x": 2}], "b": [{"y": 1}, {"y": 2}, {"y": 3}]}`,
+			expErr:  "columns b.y and a.x cannot be displayed in the same rows, they have 3 and 2 values",
+			columns: []string{"a.x", "b.y"},
+		},
+		{
+			name:    "empty column",
+			out:     balancesOutput,
+			columns: []string{"balances.denom", ""},
+			expErr:  "empty column field path",
+		},
+		{
+			name:   "invalid json",
+			out:    `{"balances": [`,
+			expErr: "cannot decode output",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header, rows, err := render.Rows([]byte(tc.out), tc.columns)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expHeader, header)
+			require.Equal(t, tc.expRows, rows)
+		})
+	}
+}
+
+func TestTable(t *testing.T) {
+	out, err := render.Table([]byte(balancesOutput), []string{"balances.denom", "balances.amount"})
+	require.NoError(t, err)
+	require.Equal(t, "BALANCES.DENOM  BALANCES.AMOUNT\nstake           10\natom            20\n", string(out))
+}
+
+func TestCSV(t *testing.T) {
+	out, err := render.CSV([]byte(`{"memo": "a, \"b\"", "total": 1}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, "memo,total\n\"a, \"\"b\"\"\",1\n", string(out))
+}
@@ -0,0 +1,145 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"golang.org/x/mod/semver"
+
+	cmtv1beta1 "cosmossdk.io/api/cosmos/base/tendermint/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+)
+
+// TxEncoding defines the encoding a signed transaction is broadcast with.
+type TxEncoding string
+
+const (
+	// TxEncodingAuto selects the encoding from the version of the node.
+	TxEncodingAuto TxEncoding = "auto"
+	// TxEncodingProto broadcasts protobuf encoded transactions to the CometBFT RPC.
+	TxEncodingProto TxEncoding = "proto"
+	// TxEncodingAminoJSON broadcasts legacy amino JSON StdTx to the legacy REST `/txs` endpoint.
+	TxEncodingAminoJSON TxEncoding = "amino-json"
+)
+
+// protoTxVersion is the first Cosmos SDK version accepting protobuf encoded transactions.
+const protoTxVersion = "v0.40.0"
+
+// parseTxEncoding parses the --tx-encoding flag value. An empty value, when the
+// flag is not registered, keeps broadcasting protobuf transactions.
+func parseTxEncoding(encoding string) (TxEncoding, error) {
+	switch TxEncoding(encoding) {
+	case "":
+		return TxEncodingProto, nil
+	case TxEncodingAuto, TxEncodingProto, TxEncodingAminoJSON:
+		return TxEncoding(encoding), nil
+	default:
+		return "", fmt.Errorf("invalid tx encoding %q, expected one of %s, %s or %s", encoding, TxEncodingAuto, TxEncodingProto, TxEncodingAminoJSON)
+	}
+}
+
+// txEncodingForVersion returns the encoding accepted by a node running the given
+// Cosmos SDK version. Versions that cannot be parsed, such as development builds,
+// are assumed to accept protobuf transactions.
+func txEncodingForVersion(version string) TxEncoding {
+	if version != "" && !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+
+	if semver.IsValid(version) && semver.Compare(version, protoTxVersion) < 0 {
+		return TxEncodingAminoJSON
+	}
+
+	return TxEncodingProto
+}
+
+// negotiateTxEncoding queries the version of the node behind conn and returns
+// the encoding its transactions must be broadcast with.
+func negotiateTxEncoding(ctx context.Context, conn gogogrpc.ClientConn) (TxEncoding, error) {
+	res, err := cmtv1beta1.NewServiceClient(conn).GetNodeInfo(ctx, &cmtv1beta1.GetNodeInfoRequest{})
+	if err != nil {
+		return "", fmt.Errorf("failed to query node version, set --tx-encoding explicitly: %w", err)
+	}
+
+	return txEncodingForVersion(res.GetApplicationVersion().GetCosmosSdkVersion()), nil
+}
+
+// legacyBroadcastReq is the request body of the legacy REST `/txs` endpoint.
+type legacyBroadcastReq struct {
+	Tx   json.RawMessage `json:"tx"`
+	Mode string          `json:"mode"`
+}
+
+// broadcastLegacyTx submits an amino JSON StdTx to the legacy REST `/txs` endpoint
+// served at restAddr and returns the raw JSON response of the node.
+func broadcastLegacyTx(ctx context.Context, restAddr, mode string, stdTx []byte) (json.RawMessage, error) {
+	if restAddr == "" {
+		return nil, fmt.Errorf("amino-json broadcasting requires the --%s flag", flagLegacyRESTAddr)
+	}
+
+	body, err := json.Marshal(legacyBroadcastReq{Tx: stdTx, Mode: mode})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(restAddr, "/")+"/txs", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	res, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("legacy broadcast failed with status %d: %s", resp.StatusCode, res)
+	}
+
+	return res, nil
+}
+
+// negotiateTxEncoding resolves an auto tx encoding against the node version.
+// Legacy amino-json broadcasts require LEGACY_AMINO_JSON signatures, which are
+// selected when no sign mode was requested.
+func (f *Factory) negotiateTxEncoding(ctx context.Context) error {
+	if f.txParams.txEncoding == TxEncodingAuto {
+		encoding, err := negotiateTxEncoding(ctx, f.conn)
+		if err != nil {
+			return err
+		}
+		f.txParams.txEncoding = encoding
+	}
+
+	if f.txParams.txEncoding != TxEncodingAminoJSON {
+		return nil
+	}
+
+	switch f.txParams.signMode {
+	case apitxsigning.SignMode_SIGN_MODE_UNSPECIFIED:
+		f.txParams.signMode = apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+	default:
+		return fmt.Errorf("amino-json encoding requires the amino-json sign mode, got %s", f.txParams.signMode)
+	}
+
+	if f.simulateAndExecute() {
+		return errors.New("gas simulation is not supported with amino-json encoding, set --gas explicitly")
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	cmtv1beta1 "cosmossdk.io/api/cosmos/base/tendermint/v1beta1"
+	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+)
+
+type mockNodeInfoConn struct {
+	mockClientConn
+	version string
+}
+
+func (m mockNodeInfoConn) Invoke(_ context.Context, _ string, _, reply interface{}, _ ...grpc.CallOption) error {
+	reply.(*cmtv1beta1.GetNodeInfoResponse).ApplicationVersion = &cmtv1beta1.VersionInfo{CosmosSdkVersion: m.version}
+	return nil
+}
+
+func Test_parseTxEncoding(t *testing.T) {
+	tests := []struct {
+		encoding string
+		want     TxEncoding
+		wantErr  bool
+	}{
+		{encoding: "", want: TxEncodingProto},
+		{encoding: "auto", want: TxEncodingAuto},
+		{encoding: "proto", want: TxEncodingProto},
+		{encoding: "amino-json", want: TxEncodingAminoJSON},
+		{encoding: "amino", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.encoding, func(t *testing.T) {
+			got, err := parseTxEncoding(tt.encoding)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_txEncodingForVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    TxEncoding
+	}{
+		{version: "v0.39.2", want: TxEncodingAminoJSON},
+		{version: "0.38.4", want: TxEncodingAminoJSON},
+		{version: "v0.40.0-rc0", want: TxEncodingAminoJSON},
+		{version: "v0.40.0", want: TxEncodingProto},
+		{version: "v0.50.10", want: TxEncodingProto},
+		{version: "(devel)", want: TxEncodingProto},
+		{version: "", want: TxEncodingProto},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			require.Equal(t, tt.want, txEncodingForVersion(tt.version))
+		})
+	}
+}
+
+func TestFactory_negotiateTxEncoding(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      string
+		params       TxParameters
+		wantEncoding TxEncoding
+		wantSignMode apitxsigning.SignMode
+		wantErr      string
+	}{
+		{
+			name:         "auto with recent node",
+			version:      "v0.50.10",
+			params:       TxParameters{BroadcastConfig: BroadcastConfig{txEncoding: TxEncodingAuto}},
+			wantEncoding: TxEncodingProto,
+		},
+		{
+			name:         "auto with legacy node",
+			version:      "v0.39.2",
+			params:       TxParameters{BroadcastConfig: BroadcastConfig{txEncoding: TxEncodingAuto}},
+			wantEncoding: TxEncodingAminoJSON,
+			wantSignMode: apitxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		},
+		{
+			name:         "explicit proto skips negotiation",
+			version:      "v0.39.2",
+			params:       TxParameters{BroadcastConfig: BroadcastConfig{txEncoding: TxEncodingProto}},
+			wantEncoding: TxEncodingProto,
+		},
+		{
+			name:    "amino-json with direct sign mode",
+			version: "v0.39.2",
+			params: TxParameters{
+				signMode:        apitxsigning.SignMode_SIGN_MODE_DIRECT,
+				BroadcastConfig: BroadcastConfig{txEncoding: TxEncodingAminoJSON},
+			},
+			wantErr: "requires the amino-json sign mode",
+		},
+		{
+			name:    "amino-json with gas simulation",
+			version: "v0.39.2",
+			params: TxParameters{
+				ExecutionOptions: ExecutionOptions{simulateAndExecute: true},
+				BroadcastConfig:  BroadcastConfig{txEncoding: TxEncodingAuto},
+			},
+			wantErr: "gas simulation is not supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFactory(keybase, cdc, mockAccountRetriever{}, txConf, ac, mockNodeInfoConn{version: tt.version}, tt.params)
+			require.NoError(t, err)
+
+			err = f.negotiateTxEncoding(context.Background())
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEncoding, f.txParams.txEncoding)
+			require.Equal(t, tt.wantSignMode, f.txParams.signMode)
+		})
+	}
+}
+
+func Test_encodeLegacyAminoJSONTx(t *testing.T) {
+	wTx := getWrappedTx(t)
+	encode := encodeLegacyAminoJSONTx(txConf.SigningContext())
+
+	_, err := encode(wTx)
+	require.ErrorContains(t, err, "fee payers or granters")
+
+	wTx.Tx.AuthInfo.Fee.Payer = ""
+	wTx.Tx.AuthInfo.Fee.GasLimit = 200000
+	bz, err := encode(wTx)
+	require.NoError(t, err)
+
+	var stdTx map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &stdTx))
+	require.JSONEq(t, `{"amount":[{"denom":"cosmos","amount":"1000"}],"gas":"200000"}`, string(stdTx["fee"]))
+	require.Contains(t, string(stdTx["msg"]), `"type":"cosmos-sdk/increase_counter"`)
+	require.Contains(t, string(stdTx["signatures"]), `"type":"tendermint/PubKeySecp256k1"`)
+}
+
+func Test_broadcastLegacyTx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/txs", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"tx":{"memo":"foo"},"mode":"sync"}`, string(body))
+		_, _ = w.Write([]byte(`{"txhash":"ABCD"}`))
+	}))
+	defer server.Close()
+
+	res, err := broadcastLegacyTx(context.Background(), server.URL+"/", "sync", []byte(`{"memo":"foo"}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"txhash":"ABCD"}`, string(res))
+
+	_, err = broadcastLegacyTx(context.Background(), "", "sync", []byte(`{}`))
+	require.ErrorContains(t, err, flagLegacyRESTAddr)
+}
@@ -1,13 +1,18 @@
 package tx
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	protov2 "google.golang.org/protobuf/proto"
 
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	txdecode "cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -100,6 +105,89 @@ func encodeJsonTx(tx Tx) ([]byte, error) {
 	return jsonMarshalOptions.Marshal(wTx.Tx)
 }
 
+// legacyStdTx is the amino JSON representation of a transaction accepted by the
+// legacy REST endpoints of nodes predating protobuf transactions.
+type legacyStdTx struct {
+	Msgs       []json.RawMessage    `json:"msg"`
+	Fee        legacyStdFee         `json:"fee"`
+	Signatures []legacyStdSignature `json:"signatures"`
+	Memo       string               `json:"memo"`
+}
+
+// legacyStdFee is the amino JSON representation of a transaction fee.
+type legacyStdFee struct {
+	Amount json.RawMessage `json:"amount"`
+	Gas    string          `json:"gas"`
+}
+
+// legacyStdSignature is the amino JSON representation of a transaction signature.
+type legacyStdSignature struct {
+	PubKey    json.RawMessage `json:"pub_key"`
+	Signature []byte          `json:"signature"`
+}
+
+// encodeLegacyAminoJSONTx returns an encoder marshaling a signed apitx.Tx into
+// a legacy amino JSON StdTx. Features unknown to StdTx are rejected.
+func encodeLegacyAminoJSONTx(signingCtx *signing.Context) txEncoder {
+	enc := aminojson.NewEncoder(aminojson.EncoderOptions{
+		FileResolver: signingCtx.FileResolver(),
+	})
+
+	return func(tx Tx) ([]byte, error) {
+		wTx, ok := tx.(*wrappedTx)
+		if !ok {
+			return nil, fmt.Errorf("unexpected tx type: %T", tx)
+		}
+
+		body, authInfo := wTx.Tx.Body, wTx.Tx.AuthInfo
+		if (body.TimeoutTimestamp != nil && !body.TimeoutTimestamp.AsTime().IsZero()) || body.Unordered {
+			return nil, errors.New("amino-json encoding does not support unordered or timeout timestamp transactions")
+		}
+		if len(body.ExtensionOptions) > 0 || len(body.NonCriticalExtensionOptions) > 0 {
+			return nil, errors.New("amino-json encoding does not support extension options")
+		}
+
+		stdTx := legacyStdTx{
+			Msgs:       make([]json.RawMessage, len(body.Messages)),
+			Signatures: make([]legacyStdSignature, len(authInfo.SignerInfos)),
+			Memo:       body.Memo,
+		}
+		for i, msg := range body.Messages {
+			bz, err := enc.Marshal(msg)
+			if err != nil {
+				return nil, err
+			}
+			stdTx.Msgs[i] = bz
+		}
+
+		fee := authInfo.Fee
+		if fee.Payer != "" || fee.Granter != "" {
+			return nil, errors.New("amino-json encoding does not support fee payers or granters")
+		}
+		amount, err := json.Marshal(fee.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if len(fee.Amount) == 0 {
+			amount = []byte("[]")
+		}
+		stdTx.Fee = legacyStdFee{Amount: amount, Gas: strconv.FormatUint(fee.GasLimit, 10)}
+
+		if len(wTx.Tx.Signatures) != len(authInfo.SignerInfos) {
+			return nil, fmt.Errorf("expected %d signatures, got %d", len(authInfo.SignerInfos), len(wTx.Tx.Signatures))
+		}
+		for i, signerInfo := range authInfo.SignerInfos {
+			pubKey, err := enc.Marshal(signerInfo.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+			stdTx.Signatures[i] = legacyStdSignature{PubKey: pubKey, Signature: wTx.Tx.Signatures[i]}
+		}
+
+		return json.Marshal(stdTx)
+	}
+}
+
 func protoTxBytes(tx *txv1beta1.Tx) ([]byte, error) {
 	bodyBytes, err := marshalOption.Marshal(tx.Body)
 	if err != nil {
@@ -27,6 +27,8 @@ const (
 	flagUnordered        = "unordered"
 	flagOffline          = "offline"
 	flagGenerateOnly     = "generate-only"
+	flagTxEncoding       = "tx-encoding"
+	flagLegacyRESTAddr   = "legacy-rest-addr"
 )
 
 // parseGasSetting parses a string gas value. The value may either be 'auto',
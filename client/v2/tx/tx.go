@@ -2,6 +2,7 @@ package tx
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -130,6 +131,15 @@ func SimulateTx(ctx client.Context, flagSet *pflag.FlagSet, msgs ...transaction.
 // given set of messages. It will also simulate gas requirements if necessary.
 // It will return an error upon failure.
 func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...transaction.Msg) error {
+	ctx := clientCtx.CmdContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if err := txf.negotiateTxEncoding(ctx); err != nil {
+		return err
+	}
+
 	if txf.simulateAndExecute() {
 		err := txf.calculateGas(msgs...)
 		if err != nil {
@@ -178,6 +188,21 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...transaction.Msg)
 		return err
 	}
 
+	if txf.txParams.txEncoding == TxEncodingAminoJSON {
+		stdTx, err := encodeLegacyAminoJSONTx(txf.txConfig.SigningContext())(signedTx)
+		if err != nil {
+			return err
+		}
+
+		// broadcast to the legacy REST server of the node
+		res, err := broadcastLegacyTx(ctx, txf.txParams.legacyRESTAddr, clientCtx.BroadcastMode, stdTx)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintRaw(res)
+	}
+
 	txBytes, err := txf.txConfig.TxEncoder()(signedTx)
 	if err != nil {
 		return err
@@ -36,6 +36,7 @@ type TxParameters struct {
 	GasConfig        // GasConfig specifies the gas settings for the transaction.
 	FeeConfig        // FeeConfig details the fee associated with the transaction.
 	ExecutionOptions // ExecutionOptions includes settings that modify how the transaction is executed.
+	BroadcastConfig  // BroadcastConfig defines how the signed transaction is submitted to the node.
 }
 
 // AccountConfig defines the 'account' related fields in a transaction.
@@ -104,6 +105,12 @@ type ExecutionOptions struct {
 	simulateAndExecute bool // simulateAndExecute indicates if the transaction should be simulated before execution.
 }
 
+// BroadcastConfig defines how a signed transaction is submitted to the node.
+type BroadcastConfig struct {
+	txEncoding     TxEncoding // txEncoding is the encoding the transaction is broadcast with, negotiated with the node when auto.
+	legacyRESTAddr string     // legacyRESTAddr is the address of the legacy REST server receiving amino-json transactions.
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
@@ -181,6 +188,13 @@ func txParamsFromFlagSet(flags *pflag.FlagSet, keybase keyring2.Keyring, ac addr
 
 	unordered, _ := flags.GetBool(flagUnordered)
 
+	encoding, _ := flags.GetString(flagTxEncoding)
+	txEncoding, err := parseTxEncoding(encoding)
+	if err != nil {
+		return params, err
+	}
+	legacyRESTAddr, _ := flags.GetString(flagLegacyRESTAddr)
+
 	gasConfig, err := NewGasConfig(gasValue, gasAdjustment, gasPrices)
 	if err != nil {
 		return params, err
@@ -208,6 +222,10 @@ func txParamsFromFlagSet(flags *pflag.FlagSet, keybase keyring2.Keyring, ac addr
 			unordered:          unordered,
 			simulateAndExecute: simulate,
 		},
+		BroadcastConfig: BroadcastConfig{
+			txEncoding:     txEncoding,
+			legacyRESTAddr: legacyRESTAddr,
+		},
 	}
 
 	return txParams, nil
@@ -0,0 +1,227 @@
+// Package stream decodes large JSON and protobuf payloads incrementally, such as
+// genesis files and snapshots, so that they do not have to be loaded in memory.
+package stream
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// JSONDecoder decodes a JSON document incrementally from a reader, so that
+// large documents such as genesis files do not have to be loaded in memory: only
+// the values decoded by the caller are held in memory, one at a time.
+//
+// Objects and arrays are walked with Object and Array, whose callbacks must consume
+// the value of each field or element with one of the decoder methods.
+type JSONDecoder struct {
+	dec *json.Decoder
+}
+
+// NewJSONDecoder returns a decoder of the JSON document read from r.
+func NewJSONDecoder(r io.Reader) *JSONDecoder {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return &JSONDecoder{dec: dec}
+}
+
+// Object decodes the next value, which must be a JSON object or null, calling fn
+// with the key of each of its fields, in the document order. fn must consume the
+// value of the field.
+func (d *JSONDecoder) Object(fn func(key string) error) error {
+	ok, err := d.open('{')
+	if err != nil || !ok {
+		return err
+	}
+
+	for d.dec.More() {
+		t, err := d.dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := t.(string)
+		if !ok {
+			return fmt.Errorf("expected an object key, got %v: the value of the previous field was not consumed", t)
+		}
+
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+
+	return d.close('}')
+}
+
+// Array decodes the next value, which must be a JSON array or null, calling fn
+// with the index of each of its elements. fn must consume the element.
+func (d *JSONDecoder) Array(fn func(i int) error) error {
+	ok, err := d.open('[')
+	if err != nil || !ok {
+		return err
+	}
+
+	for i := 0; d.dec.More(); i++ {
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+
+	return d.close(']')
+}
+
+// DecodeProto decodes the next value into the given message with the JSON codec,
+// which resolves the Any types of the message.
+func (d *JSONDecoder) DecodeProto(cdc codec.JSONCodec, msg gogoproto.Message) error {
+	bz, err := d.Raw()
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(bz, msg)
+}
+
+// Decode decodes the next value into v with encoding/json.
+func (d *JSONDecoder) Decode(v any) error {
+	return d.dec.Decode(v)
+}
+
+// Raw returns the next value as is.
+func (d *JSONDecoder) Raw() (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+// Skip skips the next value without holding it in memory.
+func (d *JSONDecoder) Skip() error {
+	depth := 0
+	for {
+		t, err := d.dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// open consumes the opening delimiter of the next value, returning false if the
+// value is null.
+func (d *JSONDecoder) open(delim json.Delim) (bool, error) {
+	t, err := d.dec.Token()
+	if err != nil {
+		return false, err
+	}
+	if t == nil {
+		return false, nil
+	}
+	if t != delim {
+		return false, fmt.Errorf("expected %s, got %v", delim, t)
+	}
+
+	return true, nil
+}
+
+func (d *JSONDecoder) close(delim json.Delim) error {
+	t, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+	if t != delim {
+		return fmt.Errorf("expected %s, got %v", delim, t)
+	}
+
+	return nil
+}
+
+// LengthPrefixedDecoder decodes a stream of length-prefixed messages, as encoded
+// by codec.BinaryCodec.MarshalLengthPrefixed, one message at a time, so that a large
+// stream such as a snapshot does not have to be loaded in memory.
+type LengthPrefixedDecoder struct {
+	r       *bufio.Reader
+	cdc     codec.BinaryCodec
+	maxSize uint64
+	buf     []byte
+}
+
+// NewLengthPrefixedDecoder returns a decoder of the length-prefixed messages read
+// from r, which fails on messages larger than maxSize bytes.
+func NewLengthPrefixedDecoder(r io.Reader, cdc codec.BinaryCodec, maxSize uint64) *LengthPrefixedDecoder {
+	return &LengthPrefixedDecoder{
+		r:       bufio.NewReader(r),
+		cdc:     cdc,
+		maxSize: maxSize,
+	}
+}
+
+// Decode decodes the next message of the stream into msg. It returns io.EOF at the
+// end of the stream, and io.ErrUnexpectedEOF if the stream ends within a message.
+func (d *LengthPrefixedDecoder) Decode(msg gogoproto.Message) error {
+	size, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		return err
+	}
+	if size > d.maxSize {
+		return fmt.Errorf("message size %d exceeds the max size %d", size, d.maxSize)
+	}
+
+	if uint64(cap(d.buf)) < size {
+		d.buf = make([]byte, size)
+	}
+	d.buf = d.buf[:size]
+
+	if _, err := io.ReadFull(d.r, d.buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	return d.cdc.Unmarshal(d.buf, msg)
+}
+
+// NewPayloadsReader returns a reader of the concatenation of the payloads returned
+// by next until it returns an error, io.EOF at the end of the payloads, such as the
+// payloads of a snapshot extension. It allows decoding a stream split across
+// payloads without joining them.
+func NewPayloadsReader(next func() ([]byte, error)) io.Reader {
+	return &payloadsReader{next: next}
+}
+
+type payloadsReader struct {
+	next    func() ([]byte, error)
+	payload []byte
+	err     error
+}
+
+func (r *payloadsReader) Read(p []byte) (int, error) {
+	for len(r.payload) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.payload, r.err = r.next()
+	}
+
+	n := copy(p, r.payload)
+	r.payload = r.payload[n:]
+	return n, nil
+}
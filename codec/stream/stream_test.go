@@ -0,0 +1,135 @@
+package stream_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/stream"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func createTestInterfaceRegistry() types.InterfaceRegistry {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	interfaceRegistry.RegisterInterface("testdata.Animal",
+		(*testdata.Animal)(nil),
+		&testdata.Dog{},
+		&testdata.Cat{},
+	)
+
+	return interfaceRegistry
+}
+
+func TestJSONDecoder(t *testing.T) {
+	cdc := codec.NewProtoCodec(createTestInterfaceRegistry())
+	animal, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err)
+	bz, err := cdc.MarshalJSON(&testdata.HasAnimal{Animal: animal, X: 10})
+	require.NoError(t, err)
+
+	doc := `{
+		"chain_id": "test",
+		"skipped": {"nested": [1, {"deep": [true, null]}], "value": "x"},
+		"app_state": {
+			"animals": {"animals": [` + string(bz) + `, ` + string(bz) + `], "count": 2},
+			"empty": null
+		}
+	}`
+
+	var (
+		chainID string
+		animals []*testdata.HasAnimal
+		count   int
+		keys    []string
+	)
+	dec := stream.NewJSONDecoder(strings.NewReader(doc))
+	err = dec.Object(func(key string) error {
+		keys = append(keys, key)
+		switch key {
+		case "chain_id":
+			return dec.Decode(&chainID)
+		case "app_state":
+			return dec.Object(func(module string) error {
+				if module != "animals" {
+					return dec.Object(func(string) error { return dec.Skip() })
+				}
+
+				return dec.Object(func(field string) error {
+					if field == "count" {
+						return dec.Decode(&count)
+					}
+
+					return dec.Array(func(int) error {
+						var animal testdata.HasAnimal
+						if err := dec.DecodeProto(cdc, &animal); err != nil {
+							return err
+						}
+						animals = append(animals, &animal)
+						return nil
+					})
+				})
+			})
+		default:
+			return dec.Skip()
+		}
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"chain_id", "skipped", "app_state"}, keys)
+	require.Equal(t, "test", chainID)
+	require.Equal(t, 2, count)
+	require.Len(t, animals, 2)
+	require.Equal(t, int64(10), animals[1].X)
+	require.Equal(t, "Spot", animals[1].Animal.GetCachedValue().(*testdata.Dog).Name)
+
+	// a callback not consuming the value of a field is detected
+	dec = stream.NewJSONDecoder(strings.NewReader(`{"a": {"b": 1}, "c": 2}`))
+	err = dec.Object(func(string) error { return nil })
+	require.ErrorContains(t, err, "the value of the previous field was not consumed")
+
+	dec = stream.NewJSONDecoder(strings.NewReader(`[1]`))
+	require.ErrorContains(t, dec.Object(func(string) error { return nil }), "expected {")
+}
+
+func TestLengthPrefixedDecoder(t *testing.T) {
+	cdc := codec.NewProtoCodec(createTestInterfaceRegistry())
+	cats := []*testdata.Cat{{Moniker: "Garfield", Lives: 9}, {Moniker: "Tom"}, {Moniker: strings.Repeat("x", 1000), Lives: 1}}
+
+	// the messages are split across payloads, as the payloads of a snapshot extension
+	var payloads [][]byte
+	for _, cat := range cats {
+		bz, err := cdc.MarshalLengthPrefixed(cat)
+		require.NoError(t, err)
+		payloads = append(payloads, bz[:len(bz)/2], bz[len(bz)/2:])
+	}
+	next := func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+
+	dec := stream.NewLengthPrefixedDecoder(stream.NewPayloadsReader(next), cdc, 2000)
+	for _, expected := range cats {
+		var cat testdata.Cat
+		require.NoError(t, dec.Decode(&cat))
+		require.Equal(t, expected, &cat)
+	}
+	require.ErrorIs(t, dec.Decode(&testdata.Cat{}), io.EOF)
+
+	bz, err := cdc.MarshalLengthPrefixed(cats[2])
+	require.NoError(t, err)
+
+	dec = stream.NewLengthPrefixedDecoder(bytes.NewReader(bz), cdc, 100)
+	require.ErrorContains(t, dec.Decode(&testdata.Cat{}), "exceeds the max size 100")
+
+	dec = stream.NewLengthPrefixedDecoder(bytes.NewReader(bz[:len(bz)-1]), cdc, 2000)
+	require.ErrorIs(t, dec.Decode(&testdata.Cat{}), io.ErrUnexpectedEOF)
+}
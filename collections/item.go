@@ -65,3 +65,11 @@ func (noKey) DecodeJSON(b []byte) (noKey, error) {
 func (k noKey) EncodeNonTerminal(_ []byte, _ noKey) (int, error) { panic("must not be called") }
 func (k noKey) DecodeNonTerminal(_ []byte) (int, noKey, error)   { panic("must not be called") }
 func (k noKey) SizeNonTerminal(_ noKey) int                      { panic("must not be called") }
+
+// SchemaCodec implements codec.HasSchemaCodec, an item has no key fields.
+func (noKey) SchemaCodec() (codec.SchemaCodec[noKey], error) {
+	return codec.SchemaCodec[noKey]{
+		ToSchemaType:   func(noKey) (any, error) { return nil, nil },
+		FromSchemaType: func(any) (noKey, error) { return noKey{}, nil },
+	}, nil
+}
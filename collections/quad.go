@@ -359,6 +359,10 @@ func (t quadKeyCodec[K1, K2, K3, K4]) SizeNonTerminal(key Quad[K1, K2, K3, K4])
 	return size
 }
 
+func (t quadKeyCodec[K1, K2, K3, K4]) Name() string {
+	return fmt.Sprintf("%s,%s,%s,%s", t.name1, t.name2, t.name3, t.name4)
+}
+
 func (t quadKeyCodec[K1, K2, K3, K4]) SchemaCodec() (codec.SchemaCodec[Quad[K1, K2, K3, K4]], error) {
 	field1, err := getNamedKeyField(t.keyCodec1, t.name1)
 	if err != nil {
@@ -394,32 +398,171 @@ func NewPrefixUntilQuadRange[K1, K2, K3, K4 any](k1 K1) Ranger[Quad[K1, K2, K3,
 	}
 }
 
-// NewPrefixedQuadRange provides a Range for all keys prefixed with the given
-// first part of the Quad key.
-func NewPrefixedQuadRange[K1, K2, K3, K4 any](k1 K1) Ranger[Quad[K1, K2, K3, K4]] {
+// NewPrefixedQuadRange provides a QuadRange for all keys prefixed with the given
+// first part of the Quad key. It can be bounded on the second part of the key.
+func NewPrefixedQuadRange[K1, K2, K3, K4 any](k1 K1) *QuadRange[K1, K2, K3, K4] {
 	key := QuadPrefix[K1, K2, K3, K4](k1)
-	return &Range[Quad[K1, K2, K3, K4]]{
+	return &QuadRange[K1, K2, K3, K4]{
+		k1:    k1,
 		start: RangeKeyExact(key),
 		end:   RangeKeyPrefixEnd(key),
 	}
 }
 
-// NewSuperPrefixedQuadRange provides a Range for all keys prefixed with the given
-// first and second parts of the Quad key.
-func NewSuperPrefixedQuadRange[K1, K2, K3, K4 any](k1 K1, k2 K2) Ranger[Quad[K1, K2, K3, K4]] {
+// NewSuperPrefixedQuadRange provides a QuadSuperRange for all keys prefixed with the given
+// first and second parts of the Quad key. It can be bounded on the third part of the key.
+func NewSuperPrefixedQuadRange[K1, K2, K3, K4 any](k1 K1, k2 K2) *QuadSuperRange[K1, K2, K3, K4] {
 	key := QuadSuperPrefix[K1, K2, K3, K4](k1, k2)
-	return &Range[Quad[K1, K2, K3, K4]]{
+	return &QuadSuperRange[K1, K2, K3, K4]{
+		k1:    k1,
+		k2:    k2,
 		start: RangeKeyExact(key),
 		end:   RangeKeyPrefixEnd(key),
 	}
 }
 
-// NewSuperPrefixedQuadRange3 provides a Range for all keys prefixed with the given
-// first, second and third parts of the Quad key.
-func NewSuperPrefixedQuadRange3[K1, K2, K3, K4 any](k1 K1, k2 K2, k3 K3) Ranger[Quad[K1, K2, K3, K4]] {
+// NewSuperPrefixedQuadRange3 provides a QuadSuperRange3 for all keys prefixed with the given
+// first, second and third parts of the Quad key. It can be bounded on the fourth part of the key.
+func NewSuperPrefixedQuadRange3[K1, K2, K3, K4 any](k1 K1, k2 K2, k3 K3) *QuadSuperRange3[K1, K2, K3, K4] {
 	key := QuadSuperPrefix3[K1, K2, K3, K4](k1, k2, k3)
-	return &Range[Quad[K1, K2, K3, K4]]{
+	return &QuadSuperRange3[K1, K2, K3, K4]{
+		k1:    k1,
+		k2:    k2,
+		k3:    k3,
 		start: RangeKeyExact(key),
 		end:   RangeKeyPrefixEnd(key),
 	}
 }
+
+// QuadRange is an API that facilitates iterating over the Quad keys sharing the same first part,
+// e.g. (A, *, *, *), bounded on the second part. The bounds include or exclude all the keys with
+// the given second part, whatever their other parts.
+// It implements the Ranger API.
+// Unstable: API and methods are currently unstable.
+type QuadRange[K1, K2, K3, K4 any] struct {
+	k1    K1
+	start *RangeKey[Quad[K1, K2, K3, K4]]
+	end   *RangeKey[Quad[K1, K2, K3, K4]]
+	order Order
+}
+
+// StartInclusive starts the range at the keys with the given second part.
+func (t *QuadRange[K1, K2, K3, K4]) StartInclusive(k2 K2) *QuadRange[K1, K2, K3, K4] {
+	t.start = RangeKeyExact(QuadSuperPrefix[K1, K2, K3, K4](t.k1, k2))
+	return t
+}
+
+// StartExclusive starts the range after the keys with the given second part.
+func (t *QuadRange[K1, K2, K3, K4]) StartExclusive(k2 K2) *QuadRange[K1, K2, K3, K4] {
+	t.start = RangeKeyPrefixEnd(QuadSuperPrefix[K1, K2, K3, K4](t.k1, k2))
+	return t
+}
+
+// EndInclusive ends the range after the keys with the given second part.
+func (t *QuadRange[K1, K2, K3, K4]) EndInclusive(k2 K2) *QuadRange[K1, K2, K3, K4] {
+	t.end = RangeKeyPrefixEnd(QuadSuperPrefix[K1, K2, K3, K4](t.k1, k2))
+	return t
+}
+
+// EndExclusive ends the range before the keys with the given second part.
+func (t *QuadRange[K1, K2, K3, K4]) EndExclusive(k2 K2) *QuadRange[K1, K2, K3, K4] {
+	t.end = RangeKeyExact(QuadSuperPrefix[K1, K2, K3, K4](t.k1, k2))
+	return t
+}
+
+func (t *QuadRange[K1, K2, K3, K4]) Descending() *QuadRange[K1, K2, K3, K4] {
+	t.order = OrderDescending
+	return t
+}
+
+func (t *QuadRange[K1, K2, K3, K4]) RangeValues() (start, end *RangeKey[Quad[K1, K2, K3, K4]], order Order, err error) {
+	return t.start, t.end, t.order, nil
+}
+
+// QuadSuperRange is an API that facilitates iterating over the Quad keys sharing the same first and
+// second parts, e.g. (A, B, *, *), bounded on the third part. The bounds include or exclude all the keys
+// with the given third part, whatever their fourth part.
+// It implements the Ranger API.
+// Unstable: API and methods are currently unstable.
+type QuadSuperRange[K1, K2, K3, K4 any] struct {
+	k1    K1
+	k2    K2
+	start *RangeKey[Quad[K1, K2, K3, K4]]
+	end   *RangeKey[Quad[K1, K2, K3, K4]]
+	order Order
+}
+
+// StartInclusive starts the range at the keys with the given third part.
+func (t *QuadSuperRange[K1, K2, K3, K4]) StartInclusive(k3 K3) *QuadSuperRange[K1, K2, K3, K4] {
+	t.start = RangeKeyExact(QuadSuperPrefix3[K1, K2, K3, K4](t.k1, t.k2, k3))
+	return t
+}
+
+// StartExclusive starts the range after the keys with the given third part.
+func (t *QuadSuperRange[K1, K2, K3, K4]) StartExclusive(k3 K3) *QuadSuperRange[K1, K2, K3, K4] {
+	t.start = RangeKeyPrefixEnd(QuadSuperPrefix3[K1, K2, K3, K4](t.k1, t.k2, k3))
+	return t
+}
+
+// EndInclusive ends the range after the keys with the given third part.
+func (t *QuadSuperRange[K1, K2, K3, K4]) EndInclusive(k3 K3) *QuadSuperRange[K1, K2, K3, K4] {
+	t.end = RangeKeyPrefixEnd(QuadSuperPrefix3[K1, K2, K3, K4](t.k1, t.k2, k3))
+	return t
+}
+
+// EndExclusive ends the range before the keys with the given third part.
+func (t *QuadSuperRange[K1, K2, K3, K4]) EndExclusive(k3 K3) *QuadSuperRange[K1, K2, K3, K4] {
+	t.end = RangeKeyExact(QuadSuperPrefix3[K1, K2, K3, K4](t.k1, t.k2, k3))
+	return t
+}
+
+func (t *QuadSuperRange[K1, K2, K3, K4]) Descending() *QuadSuperRange[K1, K2, K3, K4] {
+	t.order = OrderDescending
+	return t
+}
+
+func (t *QuadSuperRange[K1, K2, K3, K4]) RangeValues() (start, end *RangeKey[Quad[K1, K2, K3, K4]], order Order, err error) {
+	return t.start, t.end, t.order, nil
+}
+
+// QuadSuperRange3 is an API that facilitates iterating over the Quad keys sharing the same first, second
+// and third parts, e.g. (A, B, C, *), bounded on the fourth part.
+// It implements the Ranger API.
+// Unstable: API and methods are currently unstable.
+type QuadSuperRange3[K1, K2, K3, K4 any] struct {
+	k1    K1
+	k2    K2
+	k3    K3
+	start *RangeKey[Quad[K1, K2, K3, K4]]
+	end   *RangeKey[Quad[K1, K2, K3, K4]]
+	order Order
+}
+
+func (t *QuadSuperRange3[K1, K2, K3, K4]) StartInclusive(k4 K4) *QuadSuperRange3[K1, K2, K3, K4] {
+	t.start = RangeKeyExact(Join4(t.k1, t.k2, t.k3, k4))
+	return t
+}
+
+func (t *QuadSuperRange3[K1, K2, K3, K4]) StartExclusive(k4 K4) *QuadSuperRange3[K1, K2, K3, K4] {
+	t.start = RangeKeyNext(Join4(t.k1, t.k2, t.k3, k4))
+	return t
+}
+
+func (t *QuadSuperRange3[K1, K2, K3, K4]) EndInclusive(k4 K4) *QuadSuperRange3[K1, K2, K3, K4] {
+	t.end = RangeKeyNext(Join4(t.k1, t.k2, t.k3, k4))
+	return t
+}
+
+func (t *QuadSuperRange3[K1, K2, K3, K4]) EndExclusive(k4 K4) *QuadSuperRange3[K1, K2, K3, K4] {
+	t.end = RangeKeyExact(Join4(t.k1, t.k2, t.k3, k4))
+	return t
+}
+
+func (t *QuadSuperRange3[K1, K2, K3, K4]) Descending() *QuadSuperRange3[K1, K2, K3, K4] {
+	t.order = OrderDescending
+	return t
+}
+
+func (t *QuadSuperRange3[K1, K2, K3, K4]) RangeValues() (start, end *RangeKey[Quad[K1, K2, K3, K4]], order Order, err error) {
+	return t.start, t.end, t.order, nil
+}
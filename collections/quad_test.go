@@ -59,3 +59,74 @@ func TestQuadRange(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, keys[:1], gotKeys)
 }
+
+func TestQuadRangeBounds(t *testing.T) {
+	ctx := coretesting.Context()
+	sk := coretesting.KVStoreService(ctx, "test")
+	schema := collections.NewSchemaBuilder(sk)
+	kc := collections.QuadKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey, collections.Uint64Key)
+
+	keySet := collections.NewKeySet(schema, collections.NewPrefix(0), "quad", kc)
+
+	keys := []collections.Quad[uint64, string, string, uint64]{
+		collections.Join4(uint64(1), "A", "X", uint64(1)),
+		collections.Join4(uint64(1), "AB", "X", uint64(1)),
+		collections.Join4(uint64(1), "B", "X", uint64(1)),
+		collections.Join4(uint64(1), "B", "X", uint64(2)),
+		collections.Join4(uint64(1), "B", "XY", uint64(1)),
+		collections.Join4(uint64(1), "B", "Y", uint64(1)),
+		collections.Join4(uint64(1), "C", "X", uint64(1)),
+		collections.Join4(uint64(2), "A", "X", uint64(1)),
+	}
+
+	for _, k := range keys {
+		require.NoError(t, keySet.Set(ctx, k))
+	}
+
+	testCases := []struct {
+		name   string
+		ranger collections.Ranger[collections.Quad[uint64, string, string, uint64]]
+		exp    []collections.Quad[uint64, string, string, uint64]
+	}{
+		{
+			name:   "(1, [AB, B], *, *)",
+			ranger: collections.NewPrefixedQuadRange[uint64, string, string, uint64](1).StartInclusive("AB").EndInclusive("B"),
+			exp:    keys[1:6],
+		},
+		{
+			name:   "(1, (A, C), *, *) descending",
+			ranger: collections.NewPrefixedQuadRange[uint64, string, string, uint64](1).StartExclusive("A").EndExclusive("C").Descending(),
+			exp:    []collections.Quad[uint64, string, string, uint64]{keys[5], keys[4], keys[3], keys[2], keys[1]},
+		},
+		{
+			name:   "(1, B, [X, X], *)",
+			ranger: collections.NewSuperPrefixedQuadRange[uint64, string, string, uint64](1, "B").StartInclusive("X").EndInclusive("X"),
+			exp:    keys[2:4],
+		},
+		{
+			name:   "(1, B, (X, Y], *)",
+			ranger: collections.NewSuperPrefixedQuadRange[uint64, string, string, uint64](1, "B").StartExclusive("X").EndInclusive("Y"),
+			exp:    keys[4:6],
+		},
+		{
+			name:   "(1, B, X, (1, 2])",
+			ranger: collections.NewSuperPrefixedQuadRange3[uint64, string, string, uint64](1, "B", "X").StartExclusive(1).EndInclusive(2),
+			exp:    keys[3:4],
+		},
+		{
+			name:   "(1, B, X, [1, 2))",
+			ranger: collections.NewSuperPrefixedQuadRange3[uint64, string, string, uint64](1, "B", "X").StartInclusive(1).EndExclusive(2),
+			exp:    keys[2:3],
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			iter, err := keySet.Iterate(ctx, tc.ranger)
+			require.NoError(t, err)
+			gotKeys, err := iter.Keys()
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, gotKeys)
+		})
+	}
+}
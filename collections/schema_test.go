@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
+	schemapkg "cosmossdk.io/schema"
 )
 
 func TestNameRegex(t *testing.T) {
@@ -70,3 +73,37 @@ func TestSchemaBuilderCantBeUsedAfterBuild(t *testing.T) {
 		NewMap(schemaBuilder, NewPrefix(2), "def", Uint64Key, Uint64Value)
 	})
 }
+
+func TestSchemaModuleCodec(t *testing.T) {
+	sk, _ := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	NewItem(schemaBuilder, NewPrefix(1), "item", Uint64Value)
+	NewMap(schemaBuilder, NewPrefix(2), "map", Uint64Key, Uint64Value)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	moduleCodec, err := schema.ModuleCodec(IndexingOptions{})
+	require.NoError(t, err)
+
+	itemType, found := moduleCodec.Schema.LookupStateObjectType("item")
+	require.True(t, found)
+	require.Empty(t, itemType.KeyFields)
+
+	updates, err := moduleCodec.KVDecoder(schemapkg.KVPairUpdate{Key: []byte{1}, Value: encodeValue(t, Uint64Value, 10)})
+	require.NoError(t, err)
+	require.Equal(t, []schemapkg.StateObjectUpdate{{TypeName: "item", Value: uint64(10)}}, updates)
+	require.NoError(t, itemType.ValidateObjectUpdate(updates[0], moduleCodec.Schema))
+
+	key, err := EncodeKeyWithPrefix(NewPrefix(2), Uint64Key, 1)
+	require.NoError(t, err)
+	updates, err = moduleCodec.KVDecoder(schemapkg.KVPairUpdate{Key: key, Value: encodeValue(t, Uint64Value, 20)})
+	require.NoError(t, err)
+	require.Equal(t, []schemapkg.StateObjectUpdate{{TypeName: "map", Key: uint64(1), Value: uint64(20)}}, updates)
+}
+
+func encodeValue[V any](t *testing.T, cdc codec.ValueCodec[V], value V) []byte {
+	t.Helper()
+	bz, err := cdc.Encode(value)
+	require.NoError(t, err)
+	return bz
+}
@@ -323,22 +323,111 @@ func NewPrefixUntilTripleRange[K1, K2, K3 any](k1 K1) Ranger[Triple[K1, K2, K3]]
 	}
 }
 
-// NewPrefixedTripleRange provides a Range for all keys prefixed with the given
-// first part of the Triple key.
-func NewPrefixedTripleRange[K1, K2, K3 any](k1 K1) Ranger[Triple[K1, K2, K3]] {
+// NewPrefixedTripleRange provides a TripleRange for all keys prefixed with the given
+// first part of the Triple key. It can be bounded on the second part of the key.
+func NewPrefixedTripleRange[K1, K2, K3 any](k1 K1) *TripleRange[K1, K2, K3] {
 	key := TriplePrefix[K1, K2, K3](k1)
-	return &Range[Triple[K1, K2, K3]]{
+	return &TripleRange[K1, K2, K3]{
+		k1:    k1,
 		start: RangeKeyExact(key),
 		end:   RangeKeyPrefixEnd(key),
 	}
 }
 
-// NewSuperPrefixedTripleRange provides a Range for all keys prefixed with the given
-// first and second parts of the Triple key.
-func NewSuperPrefixedTripleRange[K1, K2, K3 any](k1 K1, k2 K2) Ranger[Triple[K1, K2, K3]] {
+// NewSuperPrefixedTripleRange provides a TripleSuperRange for all keys prefixed with the given
+// first and second parts of the Triple key. It can be bounded on the third part of the key.
+func NewSuperPrefixedTripleRange[K1, K2, K3 any](k1 K1, k2 K2) *TripleSuperRange[K1, K2, K3] {
 	key := TripleSuperPrefix[K1, K2, K3](k1, k2)
-	return &Range[Triple[K1, K2, K3]]{
+	return &TripleSuperRange[K1, K2, K3]{
+		k1:    k1,
+		k2:    k2,
 		start: RangeKeyExact(key),
 		end:   RangeKeyPrefixEnd(key),
 	}
 }
+
+// TripleRange is an API that facilitates iterating over the Triple keys sharing the same first part,
+// e.g. (A, *, *), bounded on the second part. The bounds include or exclude all the keys with
+// the given second part, whatever their third part.
+// It implements the Ranger API.
+// Unstable: API and methods are currently unstable.
+type TripleRange[K1, K2, K3 any] struct {
+	k1    K1
+	start *RangeKey[Triple[K1, K2, K3]]
+	end   *RangeKey[Triple[K1, K2, K3]]
+	order Order
+}
+
+// StartInclusive starts the range at the keys with the given second part.
+func (t *TripleRange[K1, K2, K3]) StartInclusive(k2 K2) *TripleRange[K1, K2, K3] {
+	t.start = RangeKeyExact(TripleSuperPrefix[K1, K2, K3](t.k1, k2))
+	return t
+}
+
+// StartExclusive starts the range after the keys with the given second part.
+func (t *TripleRange[K1, K2, K3]) StartExclusive(k2 K2) *TripleRange[K1, K2, K3] {
+	t.start = RangeKeyPrefixEnd(TripleSuperPrefix[K1, K2, K3](t.k1, k2))
+	return t
+}
+
+// EndInclusive ends the range after the keys with the given second part.
+func (t *TripleRange[K1, K2, K3]) EndInclusive(k2 K2) *TripleRange[K1, K2, K3] {
+	t.end = RangeKeyPrefixEnd(TripleSuperPrefix[K1, K2, K3](t.k1, k2))
+	return t
+}
+
+// EndExclusive ends the range before the keys with the given second part.
+func (t *TripleRange[K1, K2, K3]) EndExclusive(k2 K2) *TripleRange[K1, K2, K3] {
+	t.end = RangeKeyExact(TripleSuperPrefix[K1, K2, K3](t.k1, k2))
+	return t
+}
+
+func (t *TripleRange[K1, K2, K3]) Descending() *TripleRange[K1, K2, K3] {
+	t.order = OrderDescending
+	return t
+}
+
+func (t *TripleRange[K1, K2, K3]) RangeValues() (start, end *RangeKey[Triple[K1, K2, K3]], order Order, err error) {
+	return t.start, t.end, t.order, nil
+}
+
+// TripleSuperRange is an API that facilitates iterating over the Triple keys sharing the same first
+// and second parts, e.g. (A, B, *), bounded on the third part.
+// It implements the Ranger API.
+// Unstable: API and methods are currently unstable.
+type TripleSuperRange[K1, K2, K3 any] struct {
+	k1    K1
+	k2    K2
+	start *RangeKey[Triple[K1, K2, K3]]
+	end   *RangeKey[Triple[K1, K2, K3]]
+	order Order
+}
+
+func (t *TripleSuperRange[K1, K2, K3]) StartInclusive(k3 K3) *TripleSuperRange[K1, K2, K3] {
+	t.start = RangeKeyExact(Join3(t.k1, t.k2, k3))
+	return t
+}
+
+func (t *TripleSuperRange[K1, K2, K3]) StartExclusive(k3 K3) *TripleSuperRange[K1, K2, K3] {
+	t.start = RangeKeyNext(Join3(t.k1, t.k2, k3))
+	return t
+}
+
+func (t *TripleSuperRange[K1, K2, K3]) EndInclusive(k3 K3) *TripleSuperRange[K1, K2, K3] {
+	t.end = RangeKeyNext(Join3(t.k1, t.k2, k3))
+	return t
+}
+
+func (t *TripleSuperRange[K1, K2, K3]) EndExclusive(k3 K3) *TripleSuperRange[K1, K2, K3] {
+	t.end = RangeKeyExact(Join3(t.k1, t.k2, k3))
+	return t
+}
+
+func (t *TripleSuperRange[K1, K2, K3]) Descending() *TripleSuperRange[K1, K2, K3] {
+	t.order = OrderDescending
+	return t
+}
+
+func (t *TripleSuperRange[K1, K2, K3]) RangeValues() (start, end *RangeKey[Triple[K1, K2, K3]], order Order, err error) {
+	return t.start, t.end, t.order, nil
+}
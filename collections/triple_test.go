@@ -52,3 +52,69 @@ func TestTripleRange(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, keys[:2], gotKeys)
 }
+
+func TestTripleRangeBounds(t *testing.T) {
+	ctx := coretesting.Context()
+	sk := coretesting.KVStoreService(ctx, "test")
+	schema := collections.NewSchemaBuilder(sk)
+	kc := collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Uint64Key)
+
+	keySet := collections.NewKeySet(schema, collections.NewPrefix(0), "triple", kc)
+
+	keys := []collections.Triple[uint64, string, uint64]{
+		collections.Join3(uint64(1), "A", uint64(1)),
+		collections.Join3(uint64(1), "A", uint64(2)),
+		collections.Join3(uint64(1), "AB", uint64(1)),
+		collections.Join3(uint64(1), "B", uint64(1)),
+		collections.Join3(uint64(1), "B", uint64(2)),
+		collections.Join3(uint64(1), "B", uint64(3)),
+		collections.Join3(uint64(1), "C", uint64(1)),
+		collections.Join3(uint64(2), "A", uint64(1)),
+	}
+
+	for _, k := range keys {
+		require.NoError(t, keySet.Set(ctx, k))
+	}
+
+	testCases := []struct {
+		name   string
+		ranger collections.Ranger[collections.Triple[uint64, string, uint64]]
+		exp    []collections.Triple[uint64, string, uint64]
+	}{
+		{
+			name:   "(1, [AB, B], *)",
+			ranger: collections.NewPrefixedTripleRange[uint64, string, uint64](1).StartInclusive("AB").EndInclusive("B"),
+			exp:    keys[2:6],
+		},
+		{
+			name:   "(1, (A, C), *)",
+			ranger: collections.NewPrefixedTripleRange[uint64, string, uint64](1).StartExclusive("A").EndExclusive("C"),
+			exp:    keys[2:6],
+		},
+		{
+			name:   "(1, [A, A], *) descending",
+			ranger: collections.NewPrefixedTripleRange[uint64, string, uint64](1).StartInclusive("A").EndInclusive("A").Descending(),
+			exp:    []collections.Triple[uint64, string, uint64]{keys[1], keys[0]},
+		},
+		{
+			name:   "(1, B, [2, 3])",
+			ranger: collections.NewSuperPrefixedTripleRange[uint64, string, uint64](1, "B").StartInclusive(2).EndInclusive(3),
+			exp:    keys[4:6],
+		},
+		{
+			name:   "(1, B, (1, 3))",
+			ranger: collections.NewSuperPrefixedTripleRange[uint64, string, uint64](1, "B").StartExclusive(1).EndExclusive(3),
+			exp:    keys[4:5],
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			iter, err := keySet.Iterate(ctx, tc.ranger)
+			require.NoError(t, err)
+			gotKeys, err := iter.Keys()
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, gotKeys)
+		})
+	}
+}
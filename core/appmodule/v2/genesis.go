@@ -35,3 +35,20 @@ type HasABCIGenesis interface {
 type GenesisDecoder interface {
 	DecodeGenesisJSON(data json.RawMessage) ([]json.RawMessage, error)
 }
+
+// HasGenesisOrdering is implemented by modules whose genesis must be initialized
+// before or after the genesis of other modules.
+// The runtime derives the init genesis order of the application from these
+// constraints when the order is not set in the app config, and otherwise checks
+// that the configured order satisfies them. Constraints on modules which are not
+// part of the application are ignored.
+type HasGenesisOrdering interface {
+	AppModule
+
+	// InitGenesisAfter returns the names of the modules whose genesis must be
+	// initialized before the genesis of this module.
+	InitGenesisAfter() []string
+	// InitGenesisBefore returns the names of the modules whose genesis must be
+	// initialized after the genesis of this module.
+	InitGenesisBefore() []string
+}
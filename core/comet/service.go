@@ -18,6 +18,20 @@ type Info struct {
 	ValidatorsHash  []byte
 	ProposerAddress []byte     // ProposerAddress is  the address of the block proposer
 	LastCommit      CommitInfo // DecidedLastCommit returns the last commit info
+	// BlockMetrics returns the propagation metrics of the block, when exposed by the consensus engine.
+	// For Comet, they are only known once the block is stored, so in FinalizeBlock and not in the proposal handlers.
+	// They are read from the Comet block store, so they are not set when Comet runs out of process: the state
+	// transitions relying on them require every node to run Comet in-process.
+	BlockMetrics BlockMetrics
+}
+
+// BlockMetrics is the propagation information of a block.
+// The zero value means the consensus engine did not expose it.
+type BlockMetrics struct {
+	// Parts is the number of parts the block was split into to be gossiped.
+	Parts uint32
+	// Size is the size of the block in bytes.
+	Size int64
 }
 
 // MisbehaviorType is the type of misbehavior for a validator
@@ -48,6 +62,9 @@ type CommitInfo struct {
 type VoteInfo struct {
 	Validator   Validator
 	BlockIDFlag BlockIDFlag
+	// Timestamp is the time at which the validator signed its vote, when exposed by the consensus engine.
+	// It is zero when the vote is absent or the time is not known.
+	Timestamp time.Time
 }
 
 // BlockIDFlag indicates which BlockID the signature is for
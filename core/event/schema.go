@@ -0,0 +1,67 @@
+package event
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/transaction"
+)
+
+// SchemaRegistrar is a registry of typed event schemas. It is provided by the runtime at wiring time
+// and derives the schema of each registered event from its protobuf definition.
+type SchemaRegistrar interface {
+	// RegisterEventSchema registers the schema of the typed event of the same type as the provided
+	// event and returns its fully-qualified protobuf message name. Only the type of the event is
+	// used, so it can be a nil pointer.
+	RegisterEventSchema(event transaction.Msg) (string, error)
+}
+
+// HasEventSchemas is an interface that modules must implement if they want to register the schemas
+// of the typed events they emit, so that they can be consumed by indexers.
+type HasEventSchemas interface {
+	RegisterEventSchemas(registrar SchemaRegistrar) error
+}
+
+// Emitter emits typed events of a single type whose schema has been registered with RegisterEvent.
+type Emitter[E transaction.Msg] struct {
+	eventName string
+}
+
+// Name returns the name of the event type emitted by the emitter.
+func (e Emitter[E]) Name() string {
+	return e.eventName
+}
+
+// Emit emits the typed event using the provided event manager.
+func (e Emitter[E]) Emit(manager Manager, event E) error {
+	if err := manager.Emit(event); err != nil {
+		return fmt.Errorf("failed to emit event %s: %w", e.eventName, err)
+	}
+	return nil
+}
+
+// RegisterEvent is a helper function that modules can use to register the schema of a typed event
+// and obtain an Emitter which only accepts events of that type. The name of the event is derived
+// from E by the registrar. Example usage:
+// ```go
+//
+//	func (m Module) RegisterEventSchemas(registrar event.SchemaRegistrar) error {
+//		var err error
+//		m.keeper.sendEmitter, err = event.RegisterEvent[*types.EventSend](registrar)
+//		return err
+//	}
+//
+//	func (k Keeper) Send(ctx context.Context, ...) error {
+//		... send logic ...
+//		return k.sendEmitter.Emit(k.EventService.EventManager(ctx), &types.EventSend{...})
+//	}
+//
+// ```
+func RegisterEvent[E transaction.Msg](registrar SchemaRegistrar) (Emitter[E], error) {
+	var event E
+	eventName, err := registrar.RegisterEventSchema(event)
+	if err != nil {
+		return Emitter[E]{}, err
+	}
+
+	return Emitter[E]{eventName: eventName}, nil
+}
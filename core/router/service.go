@@ -2,6 +2,7 @@ package router
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/core/transaction"
 )
@@ -14,3 +15,20 @@ type Service interface {
 	// Invoke execute a message or query. The response should be type casted by the caller to the expected response.
 	Invoke(ctx context.Context, req transaction.Msg) (res transaction.Msg, err error)
 }
+
+// InvokeTyped executes a message or query through the router and casts the response to the
+// expected response type Resp, so that modules can call each other without untyped responses.
+func InvokeTyped[Resp transaction.Msg](ctx context.Context, router Service, req transaction.Msg) (Resp, error) {
+	var zero Resp
+	res, err := router.Invoke(ctx, req)
+	if err != nil {
+		return zero, err
+	}
+
+	typed, ok := res.(Resp)
+	if !ok {
+		return zero, fmt.Errorf("unexpected response type %T, expected %T", res, zero)
+	}
+
+	return typed, nil
+}
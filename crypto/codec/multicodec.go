@@ -0,0 +1,247 @@
+package codec
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/btcutil/base58"
+	"github.com/cosmos/gogoproto/proto"
+	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Multicodec codes of the public key types registered by DefaultMulticodecRegistry,
+// see https://github.com/multiformats/multicodec/blob/master/table.csv.
+const (
+	MulticodecEd25519PubKey   uint64 = 0xed
+	MulticodecSecp256k1PubKey uint64 = 0xe7
+	MulticodecP256PubKey      uint64 = 0x1200
+)
+
+// Multibase is the prefix character of a multibase encoding, see
+// https://github.com/multiformats/multibase.
+type Multibase byte
+
+// Multibase encodings supported by the multicodec registries.
+const (
+	Base58BTC Multibase = 'z' // base58 with the bitcoin alphabet, used by did:key
+	Base16    Multibase = 'f' // lowercase hexadecimal
+	Base32    Multibase = 'b' // lowercase RFC 4648 base32 without padding
+	Base64    Multibase = 'm' // RFC 4648 base64 without padding
+	Base64URL Multibase = 'u' // RFC 4648 base64url without padding
+)
+
+// DIDKeyPrefix is the prefix of the did:key identifiers of public keys, see
+// https://w3c-ccg.github.io/did-method-key.
+const DIDKeyPrefix = "did:key:"
+
+var base32Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// PubKeyDecoder returns the public key of the given raw key bytes, that is the key
+// bytes following the multicodec code of its type.
+type PubKeyDecoder func(key []byte) (cryptotypes.PubKey, error)
+
+// MulticodecRegistry encodes public keys with the multicodec codes of their types,
+// as the varint of the code followed by the bytes of the key, and in the string
+// forms of multibase and did:key, easing the interoperability with non-Cosmos
+// tooling, while the proto Any remains the representation of the public keys in
+// state and transactions. The types of public keys are registered with Register,
+// see DefaultMulticodecRegistry for the public key types of the SDK.
+type MulticodecRegistry struct {
+	codes    map[string]uint64 // proto message name -> multicodec code
+	decoders map[uint64]PubKeyDecoder
+}
+
+// NewMulticodecRegistry returns an empty multicodec registry.
+func NewMulticodecRegistry() *MulticodecRegistry {
+	return &MulticodecRegistry{
+		codes:    map[string]uint64{},
+		decoders: map[uint64]PubKeyDecoder{},
+	}
+}
+
+// DefaultMulticodecRegistry returns a multicodec registry of the ed25519, secp256k1
+// and secp256r1 public keys.
+func DefaultMulticodecRegistry() *MulticodecRegistry {
+	r := NewMulticodecRegistry()
+	for _, t := range []struct {
+		pk     cryptotypes.PubKey
+		code   uint64
+		decode PubKeyDecoder
+	}{
+		{&ed25519.PubKey{}, MulticodecEd25519PubKey, decodeEd25519PubKey},
+		{&secp256k1.PubKey{}, MulticodecSecp256k1PubKey, decodeSecp256k1PubKey},
+		{&secp256r1.PubKey{}, MulticodecP256PubKey, func(key []byte) (cryptotypes.PubKey, error) {
+			return secp256r1.NewPubKeyFromBytes(key)
+		}},
+	} {
+		if err := r.Register(t.pk, t.code, t.decode); err != nil {
+			panic(err)
+		}
+	}
+
+	return r
+}
+
+// Register registers the multicodec code of the type of the given public key, and
+// the decoder of the public keys of the type. A type or a code can't be registered
+// twice.
+func (r *MulticodecRegistry) Register(pk cryptotypes.PubKey, code uint64, decode PubKeyDecoder) error {
+	name := proto.MessageName(pk)
+	if name == "" {
+		return fmt.Errorf("public key %T is not a registered proto message", pk)
+	}
+	if _, ok := r.codes[name]; ok {
+		return fmt.Errorf("public key type %s is already registered", name)
+	}
+	if _, ok := r.decoders[code]; ok {
+		return fmt.Errorf("multicodec code 0x%x is already registered", code)
+	}
+
+	r.codes[name] = code
+	r.decoders[code] = decode
+	return nil
+}
+
+// Marshal returns the multicodec encoding of the public key, the varint of the
+// multicodec code of its type followed by its bytes.
+func (r *MulticodecRegistry) Marshal(pk cryptotypes.PubKey) ([]byte, error) {
+	code, ok := r.codes[proto.MessageName(pk)]
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "no multicodec code registered for public key %T", pk)
+	}
+
+	return append(binary.AppendUvarint(nil, code), pk.Bytes()...), nil
+}
+
+// Unmarshal returns the public key of the given multicodec encoding, see Marshal.
+func (r *MulticodecRegistry) Unmarshal(bz []byte) (cryptotypes.PubKey, error) {
+	code, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidPubKey, "invalid multicodec code varint")
+	}
+
+	decode, ok := r.decoders[code]
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "no public key type registered for multicodec code 0x%x", code)
+	}
+
+	pk, err := decode(bz[n:])
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid public key of multicodec code 0x%x: %s", code, err)
+	}
+
+	return pk, nil
+}
+
+// MarshalString returns the multicodec encoding of the public key, see Marshal, in
+// the given multibase encoding.
+func (r *MulticodecRegistry) MarshalString(pk cryptotypes.PubKey, base Multibase) (string, error) {
+	bz, err := r.Marshal(pk)
+	if err != nil {
+		return "", err
+	}
+
+	var encoded string
+	switch base {
+	case Base58BTC:
+		encoded = base58.Encode(bz)
+	case Base16:
+		encoded = hex.EncodeToString(bz)
+	case Base32:
+		encoded = strings.ToLower(base32Encoding.EncodeToString(bz))
+	case Base64:
+		encoded = base64.RawStdEncoding.EncodeToString(bz)
+	case Base64URL:
+		encoded = base64.RawURLEncoding.EncodeToString(bz)
+	default:
+		return "", fmt.Errorf("unsupported multibase encoding %q", base)
+	}
+
+	return string(base) + encoded, nil
+}
+
+// UnmarshalString returns the public key of the given multibase string, see
+// MarshalString.
+func (r *MulticodecRegistry) UnmarshalString(s string) (cryptotypes.PubKey, error) {
+	if s == "" {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidPubKey, "empty multibase string")
+	}
+
+	var (
+		bz  []byte
+		err error
+	)
+	switch base, encoded := Multibase(s[0]), s[1:]; base {
+	case Base58BTC:
+		if bz = base58.Decode(encoded); len(bz) == 0 {
+			err = fmt.Errorf("invalid base58 string")
+		}
+	case Base16:
+		bz, err = hex.DecodeString(encoded)
+	case Base32:
+		bz, err = base32Encoding.DecodeString(strings.ToUpper(encoded))
+	case Base64:
+		bz, err = base64.RawStdEncoding.DecodeString(encoded)
+	case Base64URL:
+		bz, err = base64.RawURLEncoding.DecodeString(encoded)
+	default:
+		err = fmt.Errorf("unsupported multibase encoding %q", base)
+	}
+	if err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	return r.Unmarshal(bz)
+}
+
+// DIDKey returns the did:key identifier of the public key, its base58btc multibase
+// string prefixed with DIDKeyPrefix.
+func (r *MulticodecRegistry) DIDKey(pk cryptotypes.PubKey) (string, error) {
+	s, err := r.MarshalString(pk, Base58BTC)
+	if err != nil {
+		return "", err
+	}
+
+	return DIDKeyPrefix + s, nil
+}
+
+// ParseDIDKey returns the public key of the given did:key identifier, see DIDKey.
+func (r *MulticodecRegistry) ParseDIDKey(did string) (cryptotypes.PubKey, error) {
+	s, ok := strings.CutPrefix(did, DIDKeyPrefix)
+	if !ok || len(s) == 0 || Multibase(s[0]) != Base58BTC {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid did:key identifier %s", did)
+	}
+
+	return r.UnmarshalString(s)
+}
+
+func decodeEd25519PubKey(key []byte) (cryptotypes.PubKey, error) {
+	if len(key) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("expected %d bytes, got %d", ed25519.PubKeySize, len(key))
+	}
+
+	return &ed25519.PubKey{Key: key}, nil
+}
+
+func decodeSecp256k1PubKey(key []byte) (cryptotypes.PubKey, error) {
+	if len(key) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("expected %d bytes, got %d", secp256k1.PubKeySize, len(key))
+	}
+	if _, err := secp256k1dcrd.ParsePubKey(key); err != nil {
+		return nil, err
+	}
+
+	return &secp256k1.PubKey{Key: key}, nil
+}
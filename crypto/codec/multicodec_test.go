@@ -0,0 +1,99 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestMulticodecRegistry(t *testing.T) {
+	r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	registry := codec.DefaultMulticodecRegistry()
+	for _, tc := range []struct {
+		name         string
+		pk           cryptotypes.PubKey
+		code         byte
+		didKeyPrefix string
+	}{
+		{"ed25519", ed25519.GenPrivKey().PubKey(), 0xed, "did:key:z6Mk"},
+		{"secp256k1", secp256k1.GenPrivKey().PubKey(), 0xe7, "did:key:zQ3s"},
+		{"secp256r1", r1Key.PubKey(), 0x80, "did:key:zDn"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := registry.Marshal(tc.pk)
+			require.NoError(t, err)
+			require.Equal(t, tc.code, bz[0])
+			require.Equal(t, tc.pk.Bytes(), bz[len(bz)-len(tc.pk.Bytes()):])
+
+			pk, err := registry.Unmarshal(bz)
+			require.NoError(t, err)
+			require.True(t, tc.pk.Equals(pk))
+
+			for _, base := range []codec.Multibase{codec.Base58BTC, codec.Base16, codec.Base32, codec.Base64, codec.Base64URL} {
+				s, err := registry.MarshalString(tc.pk, base)
+				require.NoError(t, err)
+				require.Equal(t, byte(base), s[0])
+
+				pk, err := registry.UnmarshalString(s)
+				require.NoError(t, err)
+				require.True(t, tc.pk.Equals(pk), "multibase %q", base)
+			}
+
+			did, err := registry.DIDKey(tc.pk)
+			require.NoError(t, err)
+			require.Contains(t, did, tc.didKeyPrefix)
+
+			pk, err = registry.ParseDIDKey(did)
+			require.NoError(t, err)
+			require.True(t, tc.pk.Equals(pk))
+		})
+	}
+}
+
+func TestMulticodecRegistryErrors(t *testing.T) {
+	registry := codec.DefaultMulticodecRegistry()
+	edKey := ed25519.GenPrivKey().PubKey()
+
+	// the types and the codes are registered once
+	require.ErrorContains(t, registry.Register(&ed25519.PubKey{}, 0x1300, nil), "already registered")
+	require.ErrorContains(t, registry.Register(&multisig.LegacyAminoPubKey{}, codec.MulticodecEd25519PubKey, nil), "already registered")
+
+	// unregistered types and codes
+	_, err := registry.Marshal(&multisig.LegacyAminoPubKey{})
+	require.ErrorContains(t, err, "no multicodec code registered")
+	_, err = codec.NewMulticodecRegistry().Marshal(edKey)
+	require.ErrorContains(t, err, "no multicodec code registered")
+	_, err = registry.Unmarshal([]byte{0x01, 0x02})
+	require.ErrorContains(t, err, "no public key type registered for multicodec code 0x1")
+
+	// invalid keys
+	_, err = registry.Unmarshal(append([]byte{0xed, 0x01}, edKey.Bytes()[1:]...))
+	require.ErrorContains(t, err, "expected 32 bytes, got 31")
+	_, err = registry.Unmarshal(append([]byte{0xe7, 0x01, 0x05}, make([]byte, 32)...))
+	require.ErrorContains(t, err, "invalid public key of multicodec code 0xe7")
+	_, err = registry.Unmarshal(nil)
+	require.ErrorContains(t, err, "invalid multicodec code varint")
+
+	// invalid strings
+	_, err = registry.UnmarshalString("")
+	require.ErrorContains(t, err, "empty multibase string")
+	_, err = registry.UnmarshalString("x1234")
+	require.ErrorContains(t, err, "unsupported multibase encoding")
+	_, err = registry.UnmarshalString("fzz")
+	require.Error(t, err)
+	_, err = registry.ParseDIDKey("did:web:example.com")
+	require.ErrorContains(t, err, "invalid did:key identifier")
+	_, err = registry.ParseDIDKey("did:key:f" + "ed01")
+	require.ErrorContains(t, err, "invalid did:key identifier")
+	_, err = registry.MarshalString(edKey, codec.Multibase('x'))
+	require.ErrorContains(t, err, "unsupported multibase encoding")
+}
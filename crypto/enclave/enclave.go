@@ -0,0 +1,73 @@
+// Package enclave gives access to the secure hardware of the operating system, which generates and
+// stores secp256r1 (P-256) keys whose private keys never leave the hardware.
+// It is supported on macOS with the Secure Enclave, when built with cgo, and on Windows with the
+// TPM-backed Platform Crypto Provider, which also stores the Windows Hello keys.
+package enclave
+
+import (
+	"crypto/ecdh"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+// ErrNotAvailable is returned when the secure hardware cannot be used on this machine.
+var ErrNotAvailable = errors.New("secure enclave is not available")
+
+// Enclave is the secure hardware of the operating system. Keys are referenced by a label.
+type Enclave interface {
+	// Available returns whether the secure hardware can be used on this machine.
+	Available() bool
+
+	// GenerateKey generates a new key stored under the given label and returns its public key.
+	GenerateKey(label string) (*secp256r1.PubKey, error)
+
+	// Sign signs the SHA-256 hash of the message with the key stored under the given label.
+	// The signature is encoded as R || S with a low S, as verified by secp256r1.PubKey.
+	Sign(label string, msg []byte) ([]byte, error)
+
+	// DeleteKey deletes the key stored under the given label.
+	DeleteKey(label string) error
+}
+
+// Default returns the secure hardware of the current operating system.
+// On unsupported platforms, it is never available.
+func Default() Enclave {
+	return osEnclave{}
+}
+
+var p256Order = elliptic.P256().Params().N
+
+// pubKeyFromPoint returns the secp256r1 public key of the given point.
+// It returns an error if the point is not on the curve.
+func pubKeyFromPoint(x, y *big.Int) (*secp256r1.PubKey, error) {
+	if x.Sign() < 0 || y.Sign() < 0 || x.BitLen() > 256 || y.BitLen() > 256 {
+		return nil, errors.New("secure enclave: invalid public key")
+	}
+
+	uncompressed := make([]byte, 65)
+	uncompressed[0] = 4
+	x.FillBytes(uncompressed[1:33])
+	y.FillBytes(uncompressed[33:])
+	if _, err := ecdh.P256().NewPublicKey(uncompressed); err != nil {
+		return nil, fmt.Errorf("secure enclave: invalid public key: %w", err)
+	}
+
+	return secp256r1.NewPubKeyFromBytes(elliptic.MarshalCompressed(elliptic.P256(), x, y))
+}
+
+// rawSignature encodes the signature as R || S, with S normalized to the lower half of the curve order
+// since secp256r1.PubKey rejects high S signatures.
+func rawSignature(r, s *big.Int) []byte {
+	if s.Cmp(new(big.Int).Rsh(p256Order, 1)) > 0 {
+		s = new(big.Int).Sub(p256Order, s)
+	}
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig
+}
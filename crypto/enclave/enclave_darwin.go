@@ -0,0 +1,219 @@
+//go:build darwin && cgo
+
+package enclave
+
+/*
+#cgo LDFLAGS: -framework CoreFoundation -framework Security
+#include <CoreFoundation/CoreFoundation.h>
+#include <Security/Security.h>
+
+static CFMutableDictionaryRef newDictionary(void) {
+	return CFDictionaryCreateMutable(kCFAllocatorDefault, 0, &kCFTypeDictionaryKeyCallBacks, &kCFTypeDictionaryValueCallBacks);
+}
+
+static OSStatus errorStatus(CFErrorRef err) {
+	if (err == NULL) {
+		return errSecInternalComponent;
+	}
+	OSStatus status = (OSStatus)CFErrorGetCode(err);
+	CFRelease(err);
+	return status;
+}
+
+// keyQuery returns the keychain query of the private key stored with the given tag.
+static CFMutableDictionaryRef keyQuery(const void *tag, int tagLen) {
+	CFDataRef data = CFDataCreate(kCFAllocatorDefault, tag, tagLen);
+	CFMutableDictionaryRef query = newDictionary();
+	CFDictionarySetValue(query, kSecClass, kSecClassKey);
+	CFDictionarySetValue(query, kSecAttrKeyClass, kSecAttrKeyClassPrivate);
+	CFDictionarySetValue(query, kSecAttrApplicationTag, data);
+	CFRelease(data);
+	return query;
+}
+
+// exportPublicKey writes the 65 bytes uncompressed public key of the private key to pub.
+static OSStatus exportPublicKey(SecKeyRef key, UInt8 *pub) {
+	SecKeyRef publicKey = SecKeyCopyPublicKey(key);
+	if (publicKey == NULL) {
+		return errSecInternalComponent;
+	}
+
+	CFErrorRef err = NULL;
+	CFDataRef data = SecKeyCopyExternalRepresentation(publicKey, &err);
+	CFRelease(publicKey);
+	if (data == NULL) {
+		return errorStatus(err);
+	}
+
+	OSStatus status = errSecSuccess;
+	if (CFDataGetLength(data) != 65) {
+		status = errSecDecode;
+	} else {
+		CFDataGetBytes(data, CFRangeMake(0, 65), pub);
+	}
+	CFRelease(data);
+	return status;
+}
+
+// generateKey generates a P-256 key in the Secure Enclave, stored in the keychain with the given tag,
+// and writes its uncompressed public key to pub.
+static OSStatus generateKey(const void *tag, int tagLen, UInt8 *pub) {
+	CFErrorRef err = NULL;
+	SecAccessControlRef access = SecAccessControlCreateWithFlags(kCFAllocatorDefault,
+		kSecAttrAccessibleWhenUnlockedThisDeviceOnly, kSecAccessControlPrivateKeyUsage, &err);
+	if (access == NULL) {
+		return errorStatus(err);
+	}
+
+	CFDataRef tagData = CFDataCreate(kCFAllocatorDefault, tag, tagLen);
+	CFMutableDictionaryRef privateAttrs = newDictionary();
+	CFDictionarySetValue(privateAttrs, kSecAttrIsPermanent, kCFBooleanTrue);
+	CFDictionarySetValue(privateAttrs, kSecAttrApplicationTag, tagData);
+	CFDictionarySetValue(privateAttrs, kSecAttrAccessControl, access);
+
+	int bits = 256;
+	CFNumberRef keySize = CFNumberCreate(kCFAllocatorDefault, kCFNumberIntType, &bits);
+	CFMutableDictionaryRef attrs = newDictionary();
+	CFDictionarySetValue(attrs, kSecAttrKeyType, kSecAttrKeyTypeECSECPrimeRandom);
+	CFDictionarySetValue(attrs, kSecAttrKeySizeInBits, keySize);
+	CFDictionarySetValue(attrs, kSecAttrTokenID, kSecAttrTokenIDSecureEnclave);
+	CFDictionarySetValue(attrs, kSecPrivateKeyAttrs, privateAttrs);
+
+	SecKeyRef key = SecKeyCreateRandomKey(attrs, &err);
+	CFRelease(attrs);
+	CFRelease(keySize);
+	CFRelease(privateAttrs);
+	CFRelease(tagData);
+	CFRelease(access);
+	if (key == NULL) {
+		return errorStatus(err);
+	}
+
+	OSStatus status = exportPublicKey(key, pub);
+	CFRelease(key);
+	return status;
+}
+
+// signMessage signs the SHA-256 hash of the message with the key stored with the given tag, and writes
+// the DER encoded signature to sig, whose capacity is given by sigLen and which is set to the signature length.
+static OSStatus signMessage(const void *tag, int tagLen, const void *msg, int msgLen, UInt8 *sig, int *sigLen) {
+	CFMutableDictionaryRef query = keyQuery(tag, tagLen);
+	CFDictionarySetValue(query, kSecReturnRef, kCFBooleanTrue);
+	SecKeyRef key = NULL;
+	OSStatus status = SecItemCopyMatching(query, (CFTypeRef *)&key);
+	CFRelease(query);
+	if (status != errSecSuccess) {
+		return status;
+	}
+
+	CFErrorRef err = NULL;
+	CFDataRef data = CFDataCreate(kCFAllocatorDefault, msg, msgLen);
+	CFDataRef signature = SecKeyCreateSignature(key, kSecKeyAlgorithmECDSASignatureMessageX962SHA256, data, &err);
+	CFRelease(data);
+	CFRelease(key);
+	if (signature == NULL) {
+		return errorStatus(err);
+	}
+
+	CFIndex length = CFDataGetLength(signature);
+	if (length > *sigLen) {
+		status = errSecDecode;
+	} else {
+		CFDataGetBytes(signature, CFRangeMake(0, length), sig);
+		*sigLen = (int)length;
+	}
+	CFRelease(signature);
+	return status;
+}
+
+// deleteKey deletes the key stored with the given tag from the keychain.
+static OSStatus deleteKey(const void *tag, int tagLen) {
+	CFMutableDictionaryRef query = keyQuery(tag, tagLen);
+	OSStatus status = SecItemDelete(query);
+	CFRelease(query);
+	return status;
+}
+*/
+import "C"
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"unsafe"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+// probeLabel is the label of the key generated to check whether the Secure Enclave can be used.
+const probeLabel = "cosmos-sdk-enclave-probe"
+
+// osEnclave stores the keys in the Secure Enclave, referenced in the keychain by their application tag.
+// Storing keys in the keychain requires the binary to be signed with the keychain entitlements.
+type osEnclave struct{}
+
+func (e osEnclave) Available() bool {
+	if _, err := e.GenerateKey(probeLabel); err != nil {
+		return false
+	}
+
+	_ = e.DeleteKey(probeLabel)
+	return true
+}
+
+func (osEnclave) GenerateKey(label string) (*secp256r1.PubKey, error) {
+	tag := []byte(label)
+	pub := make([]byte, 65)
+	status := C.generateKey(bytesPtr(tag), C.int(len(tag)), (*C.UInt8)(unsafe.Pointer(&pub[0])))
+	if status != 0 {
+		return nil, statusError("generate key", status)
+	}
+
+	// the public key is in the uncompressed X9.62 format: 0x04 || X || Y
+	if pub[0] != 4 {
+		return nil, errors.New("secure enclave: invalid public key")
+	}
+
+	return pubKeyFromPoint(new(big.Int).SetBytes(pub[1:33]), new(big.Int).SetBytes(pub[33:]))
+}
+
+func (osEnclave) Sign(label string, msg []byte) ([]byte, error) {
+	tag := []byte(label)
+	// the DER encoding of a P-256 signature is at most 72 bytes long
+	der := make([]byte, 72)
+	derLen := C.int(len(der))
+	status := C.signMessage(bytesPtr(tag), C.int(len(tag)), bytesPtr(msg), C.int(len(msg)), (*C.UInt8)(unsafe.Pointer(&der[0])), &derLen)
+	if status != 0 {
+		return nil, statusError("sign", status)
+	}
+
+	var sig struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(der[:derLen], &sig); err != nil {
+		return nil, fmt.Errorf("secure enclave: invalid signature: %w", err)
+	}
+
+	return rawSignature(sig.R, sig.S), nil
+}
+
+func (osEnclave) DeleteKey(label string) error {
+	tag := []byte(label)
+	if status := C.deleteKey(bytesPtr(tag), C.int(len(tag))); status != 0 {
+		return statusError("delete key", status)
+	}
+
+	return nil
+}
+
+// bytesPtr returns a pointer to the first byte of bz, or nil if it is empty.
+func bytesPtr(bz []byte) unsafe.Pointer {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	return unsafe.Pointer(&bz[0])
+}
+
+func statusError(op string, status C.OSStatus) error {
+	return fmt.Errorf("secure enclave: %s failed with status %d", op, int32(status))
+}
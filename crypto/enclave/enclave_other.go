@@ -0,0 +1,16 @@
+//go:build !windows && !(darwin && cgo)
+
+package enclave
+
+import "github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+
+// osEnclave is never available on platforms without supported secure hardware.
+type osEnclave struct{}
+
+func (osEnclave) Available() bool { return false }
+
+func (osEnclave) GenerateKey(string) (*secp256r1.PubKey, error) { return nil, ErrNotAvailable }
+
+func (osEnclave) Sign(string, []byte) ([]byte, error) { return nil, ErrNotAvailable }
+
+func (osEnclave) DeleteKey(string) error { return ErrNotAvailable }
@@ -0,0 +1,42 @@
+package enclave
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawSignature(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pub, err := pubKeyFromPoint(priv.X, priv.Y)
+	require.NoError(t, err)
+	require.Equal(t, elliptic.MarshalCompressed(elliptic.P256(), priv.X, priv.Y), pub.Bytes())
+
+	msg := []byte("some message")
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, priv, hash[:])
+	require.NoError(t, err)
+
+	highS := s
+	if s.Cmp(new(big.Int).Rsh(p256Order, 1)) <= 0 {
+		highS = new(big.Int).Sub(p256Order, s)
+	}
+
+	// both the low and high S forms are encoded with a low S
+	sig := rawSignature(r, highS)
+	require.Len(t, sig, 64)
+	require.True(t, pub.VerifySignature(msg, sig))
+	require.Equal(t, sig, rawSignature(r, new(big.Int).Sub(p256Order, highS)))
+}
+
+func TestPubKeyFromPointInvalid(t *testing.T) {
+	_, err := pubKeyFromPoint(big.NewInt(1), big.NewInt(1))
+	require.Error(t, err)
+}
@@ -0,0 +1,211 @@
+//go:build windows
+
+package enclave
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+const (
+	// platformCryptoProvider is the key storage provider of the TPM, the private keys it stores never leave the TPM.
+	platformCryptoProvider = "Microsoft Platform Crypto Provider"
+	// ecdsaP256Algorithm is the identifier of the P-256 ECDSA algorithm.
+	ecdsaP256Algorithm = "ECDSA_P256"
+	// eccPublicBlob is the BCRYPT_ECCKEY_BLOB export format of public keys.
+	eccPublicBlob = "ECCPUBLICBLOB"
+	// ecdsaPublicP256Magic is the magic number of the BCRYPT_ECCKEY_BLOB of P-256 ECDSA public keys.
+	ecdsaPublicP256Magic = 0x31534345
+)
+
+var (
+	ncrypt = windows.NewLazySystemDLL("ncrypt.dll")
+
+	procNCryptOpenStorageProvider = ncrypt.NewProc("NCryptOpenStorageProvider")
+	procNCryptCreatePersistedKey  = ncrypt.NewProc("NCryptCreatePersistedKey")
+	procNCryptFinalizeKey         = ncrypt.NewProc("NCryptFinalizeKey")
+	procNCryptOpenKey             = ncrypt.NewProc("NCryptOpenKey")
+	procNCryptExportKey           = ncrypt.NewProc("NCryptExportKey")
+	procNCryptSignHash            = ncrypt.NewProc("NCryptSignHash")
+	procNCryptDeleteKey           = ncrypt.NewProc("NCryptDeleteKey")
+	procNCryptFreeObject          = ncrypt.NewProc("NCryptFreeObject")
+)
+
+// osEnclave stores the keys in the TPM through the Platform Crypto Provider.
+type osEnclave struct{}
+
+func (osEnclave) Available() bool {
+	provider, err := openProvider()
+	if err != nil {
+		return false
+	}
+
+	freeObject(provider)
+	return true
+}
+
+func (osEnclave) GenerateKey(label string) (*secp256r1.PubKey, error) {
+	provider, err := openProvider()
+	if err != nil {
+		return nil, err
+	}
+	defer freeObject(provider)
+
+	algorithm, err := windows.UTF16PtrFromString(ecdsaP256Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	name, err := windows.UTF16PtrFromString(label)
+	if err != nil {
+		return nil, err
+	}
+
+	var key uintptr
+	status, _, _ := procNCryptCreatePersistedKey.Call(
+		provider, uintptr(unsafe.Pointer(&key)), uintptr(unsafe.Pointer(algorithm)), uintptr(unsafe.Pointer(name)), 0, 0,
+	)
+	if status != 0 {
+		return nil, statusError(procNCryptCreatePersistedKey, status)
+	}
+
+	if status, _, _ := procNCryptFinalizeKey.Call(key, 0); status != 0 {
+		freeObject(key)
+		return nil, statusError(procNCryptFinalizeKey, status)
+	}
+
+	pubKey, err := exportPublicKey(key)
+	if err != nil {
+		// do not leave a key which cannot be used behind
+		_, _, _ = procNCryptDeleteKey.Call(key, 0)
+		return nil, err
+	}
+
+	freeObject(key)
+	return pubKey, nil
+}
+
+func (osEnclave) Sign(label string, msg []byte) ([]byte, error) {
+	provider, err := openProvider()
+	if err != nil {
+		return nil, err
+	}
+	defer freeObject(provider)
+
+	key, err := openKey(provider, label)
+	if err != nil {
+		return nil, err
+	}
+	defer freeObject(key)
+
+	hash := sha256.Sum256(msg)
+	sig := make([]byte, 64)
+	var size uint32
+	status, _, _ := procNCryptSignHash.Call(
+		key, 0, uintptr(unsafe.Pointer(&hash[0])), uintptr(len(hash)),
+		uintptr(unsafe.Pointer(&sig[0])), uintptr(len(sig)), uintptr(unsafe.Pointer(&size)), 0,
+	)
+	if status != 0 {
+		return nil, statusError(procNCryptSignHash, status)
+	}
+	if size != 64 {
+		return nil, fmt.Errorf("secure enclave: unexpected signature size %d", size)
+	}
+
+	return rawSignature(new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])), nil
+}
+
+func (osEnclave) DeleteKey(label string) error {
+	provider, err := openProvider()
+	if err != nil {
+		return err
+	}
+	defer freeObject(provider)
+
+	key, err := openKey(provider, label)
+	if err != nil {
+		return err
+	}
+
+	// the key handle is freed when the key is deleted
+	if status, _, _ := procNCryptDeleteKey.Call(key, 0); status != 0 {
+		freeObject(key)
+		return statusError(procNCryptDeleteKey, status)
+	}
+
+	return nil
+}
+
+func openProvider() (uintptr, error) {
+	if err := ncrypt.Load(); err != nil {
+		return 0, ErrNotAvailable
+	}
+
+	name, err := windows.UTF16PtrFromString(platformCryptoProvider)
+	if err != nil {
+		return 0, err
+	}
+
+	var provider uintptr
+	status, _, _ := procNCryptOpenStorageProvider.Call(uintptr(unsafe.Pointer(&provider)), uintptr(unsafe.Pointer(name)), 0)
+	if status != 0 {
+		return 0, errors.Join(ErrNotAvailable, statusError(procNCryptOpenStorageProvider, status))
+	}
+
+	return provider, nil
+}
+
+func openKey(provider uintptr, label string) (uintptr, error) {
+	name, err := windows.UTF16PtrFromString(label)
+	if err != nil {
+		return 0, err
+	}
+
+	var key uintptr
+	status, _, _ := procNCryptOpenKey.Call(provider, uintptr(unsafe.Pointer(&key)), uintptr(unsafe.Pointer(name)), 0, 0)
+	if status != 0 {
+		return 0, statusError(procNCryptOpenKey, status)
+	}
+
+	return key, nil
+}
+
+// exportPublicKey exports the public key of the key handle, encoded as a BCRYPT_ECCKEY_BLOB:
+// the magic number and the size of the coordinates, followed by the X and Y coordinates.
+func exportPublicKey(key uintptr) (*secp256r1.PubKey, error) {
+	blobType, err := windows.UTF16PtrFromString(eccPublicBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	blob := make([]byte, 8+2*32)
+	var size uint32
+	status, _, _ := procNCryptExportKey.Call(
+		key, 0, uintptr(unsafe.Pointer(blobType)), 0,
+		uintptr(unsafe.Pointer(&blob[0])), uintptr(len(blob)), uintptr(unsafe.Pointer(&size)), 0,
+	)
+	if status != 0 {
+		return nil, statusError(procNCryptExportKey, status)
+	}
+
+	if int(size) != len(blob) || binary.LittleEndian.Uint32(blob[0:4]) != ecdsaPublicP256Magic || binary.LittleEndian.Uint32(blob[4:8]) != 32 {
+		return nil, errors.New("secure enclave: unexpected public key format")
+	}
+
+	return pubKeyFromPoint(new(big.Int).SetBytes(blob[8:40]), new(big.Int).SetBytes(blob[40:72]))
+}
+
+func freeObject(handle uintptr) {
+	_, _, _ = procNCryptFreeObject.Call(handle)
+}
+
+func statusError(proc *windows.LazyProc, status uintptr) error {
+	return fmt.Errorf("secure enclave: %s failed with status 0x%08x", proc.Name, uint32(status))
+}
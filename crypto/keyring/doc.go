@@ -25,6 +25,9 @@
 //		credentials store to handle keys storage operations securely. It should be noted
 //		that the keyring may be kept unlocked for the whole duration of the user
 //		session.
+//	enclave	This backend stores the key records like the os backend, but generates the new keys in
+//		the secure enclave of the operating system (the Secure Enclave on macOS, the TPM on
+//		Windows), so that their private keys never leave the hardware. See SaveEnclaveKey.
 //	file	This backend more closely resembles the previous keyring storage used prior to
 //		v0.38.1. It stores the keyring encrypted within the app's configuration directory.
 //		This keyring will request a password each time it is accessed, which may occur
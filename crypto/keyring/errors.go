@@ -35,4 +35,8 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrNotEnclaveObj is raised when a record does not reference a secure enclave key.
+	ErrNotEnclaveObj = errors.New("not a secure enclave object")
+	// ErrEnclaveInvalidSignature is raised when the secure enclave generates an invalid signature.
+	ErrEnclaveInvalidSignature = errors.New("secure enclave generated an invalid signature")
 )
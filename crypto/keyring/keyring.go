@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"bufio"
+	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/enclave"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
@@ -32,6 +34,7 @@ import (
 const (
 	BackendFile    = "file"
 	BackendOS      = "os"
+	BackendEnclave = "enclave"
 	BackendKWallet = "kwallet"
 	BackendPass    = "pass"
 	BackendTest    = "test"
@@ -47,6 +50,8 @@ const (
 	passPhrase = "temp"
 	// prefix for exported hex private keys
 	hexPrefix = "0x"
+	// prefix of the labels of the keys stored in the secure enclave
+	enclaveLabelPrefix = "cosmos-sdk-"
 )
 
 var (
@@ -56,7 +61,7 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Backend get the backend type used in the keyring config: "file", "os", "enclave", "kwallet", "pass", "test", "memory".
 	Backend() string
 
 	// DB get the db keyring used in the keystore.
@@ -94,6 +99,10 @@ type Keyring interface {
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SaveEnclaveKey generates a new key in the secure enclave of the operating system and persists a reference to it.
+	// It returns enclave.ErrNotAvailable if the secure enclave cannot be used on this machine.
+	SaveEnclaveKey(uid string) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -162,7 +171,9 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "enclave", "file", "kwallet", "memory", "pass", "test".
+// The enclave backend stores the key records like the os backend, and generates the new keys in the
+// secure enclave of the operating system.
 func newKeyringGeneric(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -178,7 +189,7 @@ func newKeyringGeneric(
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
 		db, err = keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput))
-	case BackendOS:
+	case BackendOS, BackendEnclave:
 		db, err = keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendKWallet:
 		db, err = keyring.Open(newKWalletBackendKeyringConfig(appName, rootDir, userInput))
@@ -230,6 +241,10 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 		ledger.SetSkipDERConversion()
 	}
 
+	if options.Enclave == nil {
+		options.Enclave = enclave.Default()
+	}
+
 	return keystore{
 		db:      kr,
 		cdc:     cdc,
@@ -410,6 +425,9 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetEnclave() != nil:
+		return ks.signWithEnclave(k, msg)
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -453,6 +471,64 @@ func (ks keystore) writeLedgerKey(name string, pk types.PubKey, path *hd.BIP44Pa
 	return k, ks.writeRecord(k)
 }
 
+func (ks keystore) SaveEnclaveKey(uid string) (*Record, error) {
+	if _, err := ks.Key(uid); err == nil {
+		return nil, errorsmod.Wrap(ErrOverwriteKey, uid)
+	}
+
+	if !ks.options.Enclave.Available() {
+		return nil, enclave.ErrNotAvailable
+	}
+
+	bz := make([]byte, 16)
+	if _, err := rand.Read(bz); err != nil {
+		return nil, err
+	}
+	label := enclaveLabelPrefix + hex.EncodeToString(bz)
+
+	pubKey, err := ks.options.Enclave.GenerateKey(label)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := NewEnclaveRecord(uid, pubKey, label)
+	if err == nil {
+		err = ks.writeRecord(k)
+	}
+	if err != nil {
+		// do not leave a key which is not referenced by the keyring in the secure enclave
+		_ = ks.options.Enclave.DeleteKey(label)
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// signWithEnclave signs a binary message with the secure enclave key referenced by the record
+// and returns the signed bytes and the public key.
+func (ks keystore) signWithEnclave(k *Record, msg []byte) ([]byte, types.PubKey, error) {
+	enclaveInfo := k.GetEnclave()
+	if enclaveInfo == nil {
+		return nil, nil, ErrNotEnclaveObj
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err := ks.options.Enclave.Sign(enclaveInfo.Label, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pubKey.VerifySignature(msg, sig) {
+		return nil, nil, ErrEnclaveInvalidSignature
+	}
+
+	return sig, pubKey, nil
+}
+
 func (ks keystore) SaveMultisig(uid string, pubkey types.PubKey) (*Record, error) {
 	return ks.writeMultisigKey(uid, pubkey)
 }
@@ -520,6 +596,10 @@ func (ks keystore) Delete(uid string) error {
 		return err
 	}
 
+	if enclaveInfo := k.GetEnclave(); enclaveInfo != nil {
+		return ks.options.Enclave.DeleteKey(enclaveInfo.Label)
+	}
+
 	return nil
 }
 
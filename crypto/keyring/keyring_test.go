@@ -21,11 +21,13 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/enclave"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cosmosbcrypt "github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -1868,6 +1870,104 @@ func TestAltKeyring_Sign(t *testing.T) {
 	}
 }
 
+// mockEnclave is a secure enclave keeping the keys in memory.
+type mockEnclave struct {
+	available bool
+	keys      map[string]*secp256r1.PrivKey
+}
+
+func newMockEnclave(available bool) *mockEnclave {
+	return &mockEnclave{available: available, keys: map[string]*secp256r1.PrivKey{}}
+}
+
+func (e *mockEnclave) Available() bool { return e.available }
+
+func (e *mockEnclave) GenerateKey(label string) (*secp256r1.PubKey, error) {
+	priv, err := secp256r1.GenPrivKey()
+	if err != nil {
+		return nil, err
+	}
+
+	e.keys[label] = priv
+	return priv.PubKey().(*secp256r1.PubKey), nil
+}
+
+func (e *mockEnclave) Sign(label string, msg []byte) ([]byte, error) {
+	priv, ok := e.keys[label]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", label)
+	}
+
+	return priv.Sign(msg)
+}
+
+func (e *mockEnclave) DeleteKey(label string) error {
+	if _, ok := e.keys[label]; !ok {
+		return fmt.Errorf("key %s not found", label)
+	}
+
+	delete(e.keys, label)
+	return nil
+}
+
+func TestEnclaveKey(t *testing.T) {
+	cdc := getCodec()
+
+	kr := NewInMemory(cdc, func(options *Options) { options.Enclave = newMockEnclave(false) })
+	_, err := kr.SaveEnclaveKey("unavailable")
+	require.ErrorIs(t, err, enclave.ErrNotAvailable)
+
+	mock := newMockEnclave(true)
+	kr = NewInMemory(cdc, func(options *Options) { options.Enclave = mock })
+
+	k, err := kr.SaveEnclaveKey("enclave")
+	require.NoError(t, err)
+	require.Equal(t, TypeEnclave, k.GetType())
+	require.Equal(t, "enclave", k.GetType().String())
+	require.Len(t, mock.keys, 1)
+	require.Contains(t, mock.keys, k.GetEnclave().Label)
+
+	_, err = kr.SaveEnclaveKey("enclave")
+	require.ErrorIs(t, err, ErrOverwriteKey)
+	require.Len(t, mock.keys, 1)
+
+	// the record is persisted with its public key and label
+	stored, err := kr.Key("enclave")
+	require.NoError(t, err)
+	require.Equal(t, k.GetEnclave().Label, stored.GetEnclave().Label)
+	pub, err := stored.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &secp256r1.PubKey{}, pub)
+
+	msg := []byte("some message")
+	sig, signPub, err := kr.Sign("enclave", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(signPub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	sig, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the private key cannot be exported
+	_, err = kr.ExportPrivKeyArmor("enclave", "passphrase")
+	require.ErrorIs(t, err, ErrPrivKeyExtr)
+
+	// the signatures of another key are rejected
+	mock.keys[k.GetEnclave().Label], err = secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	_, _, err = kr.Sign("enclave", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrEnclaveInvalidSignature)
+
+	// deleting the record deletes the key in the enclave
+	require.NoError(t, kr.Delete("enclave"))
+	require.Empty(t, mock.keys)
+	_, err = kr.Key("enclave")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
 func TestAltKeyring_SignByAddress(t *testing.T) {
 	cdc := getCodec()
 	tests := []struct {
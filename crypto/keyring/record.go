@@ -69,6 +69,13 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordMultiItem)
 }
 
+// NewEnclaveRecord creates a new Record with enclave item
+func NewEnclaveRecord(name string, pk cryptotypes.PubKey, label string) (*Record, error) {
+	recordEnclave := &Record_Enclave{label}
+	recordEnclaveItem := &Record_Enclave_{recordEnclave}
+	return newRecord(name, pk, recordEnclaveItem)
+}
+
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
@@ -99,6 +106,8 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetEnclave() != nil:
+		return TypeEnclave
 	default:
 		panic("unrecognized record type")
 	}
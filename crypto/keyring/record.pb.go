@@ -38,6 +38,7 @@ type Record struct {
 	//	*Record_Ledger_
 	//	*Record_Multi_
 	//	*Record_Offline_
+	//	*Record_Enclave_
 	Item isRecord_Item `protobuf_oneof:"item"`
 }
 
@@ -92,11 +93,15 @@ type Record_Multi_ struct {
 type Record_Offline_ struct {
 	Offline *Record_Offline `protobuf:"bytes,6,opt,name=offline,proto3,oneof" json:"offline,omitempty"`
 }
+type Record_Enclave_ struct {
+	Enclave *Record_Enclave `protobuf:"bytes,7,opt,name=enclave,proto3,oneof" json:"enclave,omitempty"`
+}
 
 func (*Record_Local_) isRecord_Item()   {}
 func (*Record_Ledger_) isRecord_Item()  {}
 func (*Record_Multi_) isRecord_Item()   {}
 func (*Record_Offline_) isRecord_Item() {}
+func (*Record_Enclave_) isRecord_Item() {}
 
 func (m *Record) GetItem() isRecord_Item {
 	if m != nil {
@@ -133,6 +138,13 @@ func (m *Record) GetOffline() *Record_Offline {
 	return nil
 }
 
+func (m *Record) GetEnclave() *Record_Enclave {
+	if x, ok := m.GetItem().(*Record_Enclave_); ok {
+		return x.Enclave
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Record) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -140,6 +152,7 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 		(*Record_Ledger_)(nil),
 		(*Record_Multi_)(nil),
 		(*Record_Offline_)(nil),
+		(*Record_Enclave_)(nil),
 	}
 }
 
@@ -294,12 +307,52 @@ func (m *Record_Offline) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Record_Offline proto.InternalMessageInfo
 
+// Enclave item
+type Record_Enclave struct {
+	// label references the key in the secure hardware.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *Record_Enclave) Reset()         { *m = Record_Enclave{} }
+func (m *Record_Enclave) String() string { return proto.CompactTextString(m) }
+func (*Record_Enclave) ProtoMessage()    {}
+func (*Record_Enclave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{0, 4}
+}
+func (m *Record_Enclave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record_Enclave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Record_Enclave.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Record_Enclave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record_Enclave.Merge(m, src)
+}
+func (m *Record_Enclave) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record_Enclave) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record_Enclave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record_Enclave proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Record)(nil), "cosmos.crypto.keyring.v1.Record")
 	proto.RegisterType((*Record_Local)(nil), "cosmos.crypto.keyring.v1.Record.Local")
 	proto.RegisterType((*Record_Ledger)(nil), "cosmos.crypto.keyring.v1.Record.Ledger")
 	proto.RegisterType((*Record_Multi)(nil), "cosmos.crypto.keyring.v1.Record.Multi")
 	proto.RegisterType((*Record_Offline)(nil), "cosmos.crypto.keyring.v1.Record.Offline")
+	proto.RegisterType((*Record_Enclave)(nil), "cosmos.crypto.keyring.v1.Record.Enclave")
 }
 
 func init() {
@@ -307,33 +360,35 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x13, 0xcd, 0x8b, 0xfb, 0x78, 0x1b, 0x7a, 0x88, 0x41, 0x62, 0x11, 0xd4, 0x82, 0xec,
+	0x0c, 0xab, 0x3d, 0x78, 0x5a, 0xd8, 0xa2, 0x50, 0x59, 0x17, 0x97, 0x1c, 0xbd, 0xc8, 0x24, 0x99,
+	0x26, 0xa1, 0x93, 0x4c, 0x98, 0x26, 0x85, 0x7c, 0x0b, 0x8f, 0x7e, 0xa4, 0x3d, 0xae, 0x37, 0x8f,
+	0xda, 0x7e, 0x11, 0x99, 0x97, 0x1e, 0x5c, 0xd0, 0xed, 0xa9, 0x33, 0xf4, 0xf7, 0x7f, 0x99, 0x67,
+	0x26, 0xf0, 0x22, 0x17, 0x9b, 0x46, 0x6c, 0x48, 0x2e, 0xc7, 0xae, 0x17, 0x64, 0xcd, 0x46, 0x59,
+	0xb7, 0x25, 0xd9, 0x9e, 0x11, 0xc9, 0x72, 0x21, 0x0b, 0xdc, 0x49, 0xd1, 0x0b, 0x14, 0x19, 0x0c,
+	0x1b, 0x0c, 0x5b, 0x0c, 0x6f, 0xcf, 0xe2, 0x49, 0x29, 0x4a, 0xa1, 0x21, 0xa2, 0x56, 0x86, 0x8f,
+	0x9f, 0x94, 0x42, 0x94, 0x9c, 0x11, 0xbd, 0xcb, 0x86, 0x15, 0xa1, 0xed, 0x68, 0xff, 0x7a, 0xfa,
+	0x77, 0x62, 0x55, 0xa8, 0xb0, 0xca, 0x06, 0x3d, 0xff, 0xe1, 0x41, 0x90, 0xea, 0x64, 0x84, 0xc0,
+	0x6b, 0x69, 0xc3, 0x22, 0x77, 0xea, 0xce, 0x4e, 0x52, 0xbd, 0x46, 0xa7, 0x10, 0x76, 0x43, 0xf6,
+	0x75, 0xcd, 0xc6, 0xe8, 0xc1, 0xd4, 0x9d, 0x3d, 0x7e, 0x33, 0xc1, 0x26, 0x09, 0x1f, 0x92, 0xf0,
+	0x45, 0x3b, 0xa6, 0x41, 0x37, 0x64, 0x97, 0x6c, 0x44, 0xe7, 0xe0, 0x73, 0x91, 0x53, 0x1e, 0x3d,
+	0xd4, 0xf0, 0x4b, 0xfc, 0xaf, 0x63, 0x60, 0x93, 0x89, 0x3f, 0x29, 0x7a, 0xe9, 0xa4, 0x46, 0x86,
+	0x2e, 0x20, 0xe0, 0xac, 0x28, 0x99, 0x8c, 0x3c, 0x6d, 0xf0, 0xea, 0x7e, 0x03, 0x8d, 0x2f, 0x9d,
+	0xd4, 0x0a, 0x55, 0x85, 0x66, 0xe0, 0x7d, 0x1d, 0xf9, 0x47, 0x56, 0xb8, 0x52, 0xb4, 0xaa, 0xa0,
+	0x65, 0xe8, 0x3d, 0x84, 0x62, 0xb5, 0xe2, 0x75, 0xcb, 0xa2, 0x40, 0x3b, 0xcc, 0xee, 0x75, 0xf8,
+	0x6c, 0xf8, 0xa5, 0x93, 0x1e, 0xa4, 0xca, 0x85, 0xb5, 0x39, 0xa7, 0x5b, 0x16, 0x85, 0x47, 0xba,
+	0x7c, 0x30, 0xbc, 0x72, 0xb1, 0xd2, 0xf8, 0x1d, 0xf8, 0x7a, 0x40, 0x88, 0xc0, 0xa3, 0x4e, 0xd6,
+	0x5b, 0x7d, 0x0f, 0xee, 0x7f, 0xee, 0x21, 0x54, 0xd4, 0x25, 0x1b, 0xe3, 0x73, 0x08, 0xcc, 0x64,
+	0xd0, 0x1c, 0xbc, 0x8e, 0xf6, 0x95, 0x95, 0x4d, 0xef, 0xd4, 0xa8, 0x0a, 0xd5, 0x60, 0xf1, 0xf1,
+	0x7a, 0x3e, 0xbf, 0xa6, 0x92, 0x36, 0x9b, 0x54, 0xd3, 0x71, 0x08, 0xbe, 0x9e, 0x4b, 0x7c, 0x02,
+	0xa1, 0x3d, 0x5e, 0xfc, 0x0c, 0x42, 0xdb, 0x11, 0x4d, 0xc0, 0xe7, 0x34, 0x63, 0xdc, 0xbe, 0x15,
+	0xb3, 0x59, 0x04, 0xe0, 0xd5, 0x3d, 0x6b, 0x16, 0x57, 0x37, 0xbf, 0x13, 0xe7, 0x66, 0x97, 0xb8,
+	0xb7, 0xbb, 0xc4, 0xfd, 0xb5, 0x4b, 0xdc, 0x6f, 0xfb, 0xc4, 0xf9, 0xbe, 0x4f, 0x9c, 0xdb, 0x7d,
+	0xe2, 0xfc, 0xdc, 0x27, 0xce, 0x97, 0xd7, 0x65, 0xdd, 0x57, 0x43, 0x86, 0x73, 0xd1, 0x90, 0xc3,
+	0xf3, 0xd4, 0x3f, 0xa7, 0x9b, 0x62, 0x7d, 0xe7, 0xdb, 0xc8, 0x02, 0x7d, 0xc4, 0xb7, 0x7f, 0x06,
+	0x00, 0x9a, 0x22, 0x17, 0xae, 0x3b, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -471,6 +526,27 @@ func (m *Record_Offline_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Record_Enclave_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_Enclave_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Enclave != nil {
+		{
+			size, err := m.Enclave.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Record_Local) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -587,6 +663,36 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Record_Enclave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record_Enclave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_Enclave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRecord(v)
 	base := offset
@@ -666,6 +772,18 @@ func (m *Record_Offline_) Size() (n int) {
 	}
 	return n
 }
+func (m *Record_Enclave_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enclave != nil {
+		l = m.Enclave.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
 func (m *Record_Local) Size() (n int) {
 	if m == nil {
 		return 0
@@ -710,6 +828,19 @@ func (m *Record_Offline) Size() (n int) {
 	return n
 }
 
+func (m *Record_Enclave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
 func sovRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -953,6 +1084,41 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &Record_Offline_{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enclave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Record_Enclave{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &Record_Enclave_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -1246,6 +1412,88 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Record_Enclave) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Enclave: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Enclave: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,6 +24,16 @@ type customProtobufType interface {
 
 var _ customProtobufType = (*ecdsaPK)(nil)
 
+// NewPubKeyFromBytes returns the public key encoded in the compressed form returned by Bytes.
+func NewPubKeyFromBytes(bz []byte) (*PubKey, error) {
+	pk := &ecdsaPK{}
+	if err := pk.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return &PubKey{Key: pk}, nil
+}
+
 // String implements proto.Message interface.
 func (m *PubKey) String() string {
 	return m.Key.String(name)
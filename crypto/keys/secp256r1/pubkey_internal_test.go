@@ -51,6 +51,15 @@ func (suite *PKSuite) TestBytes() {
 	suite.Nil(pk.Bytes())
 }
 
+func (suite *PKSuite) TestNewPubKeyFromBytes() {
+	pk, err := NewPubKeyFromBytes(suite.pk.Bytes())
+	suite.Require().NoError(err)
+	suite.True(pk.Equals(suite.pk))
+
+	_, err = NewPubKeyFromBytes(suite.pk.Bytes()[1:])
+	suite.Error(err)
+}
+
 func (suite *PKSuite) TestEquals() {
 	require := suite.Require()
 
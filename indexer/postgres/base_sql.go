@@ -30,4 +30,8 @@ CREATE TABLE IF NOT EXISTS event
     type         TEXT   NOT NULL,
     data         JSONB  NOT NULL
 );
+
+CREATE INDEX IF NOT EXISTS tx_block_number ON tx (block_number);
+
+CREATE INDEX IF NOT EXISTS event_block_number ON event (block_number);
 `
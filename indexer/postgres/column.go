@@ -43,6 +43,27 @@ func (tm *objectIndexer) createColumnDefinition(writer io.Writer, field schema.F
 			if err != nil {
 				return err
 			}
+		case schema.CoinKind:
+			// for coin fields, we store the coin as JSONB and generate two columns for ease of use:
+			// - one with the denom, suffixed with _denom
+			// - one with the amount as a NUMERIC, suffixed with _amount
+			_, err = fmt.Fprintf(writer, "JSONB")
+			if err != nil {
+				return err
+			}
+
+			err = writeNullability(writer, field.Nullable)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(writer, "%q TEXT GENERATED ALWAYS AS (%q->>'denom') STORED,\n\t", field.Name+"_denom", field.Name)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(writer, "%q NUMERIC GENERATED ALWAYS AS ((%q->>'amount')::NUMERIC) STORED,\n\t", field.Name+"_amount", field.Name)
+			return err
 		default:
 			return fmt.Errorf("unexpected kind: %v, this should have been handled earlier", field.Kind)
 		}
@@ -90,6 +111,10 @@ func simpleColumnType(kind schema.Kind) string {
 		return "NUMERIC"
 	case schema.IntegerKind:
 		return "NUMERIC"
+	case schema.Int128Kind:
+		return "NUMERIC(39)"
+	case schema.Uint128Kind:
+		return "NUMERIC(39)"
 	case schema.DecimalKind:
 		return "NUMERIC"
 	case schema.Float32Kind:
@@ -98,6 +123,8 @@ func simpleColumnType(kind schema.Kind) string {
 		return "DOUBLE PRECISION"
 	case schema.JSONKind:
 		return "JSONB"
+	case schema.CoinsKind:
+		return "JSONB"
 	case schema.DurationKind:
 		return "BIGINT"
 	case schema.AddressKind:
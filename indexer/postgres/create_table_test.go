@@ -36,6 +36,12 @@ func Example_objectIndexer_createTableSql_allKinds() {
 	//	"address" TEXT NOT NULL,
 	//	"enum" "test_my_enum" NOT NULL,
 	//	"json" JSONB NOT NULL,
+	//	"int128" NUMERIC(39) NOT NULL,
+	//	"uint128" NUMERIC(39) NOT NULL,
+	//	"coin" JSONB NOT NULL,
+	//	"coin_denom" TEXT GENERATED ALWAYS AS ("coin"->>'denom') STORED,
+	//	"coin_amount" NUMERIC GENERATED ALWAYS AS (("coin"->>'amount')::NUMERIC) STORED,
+	//	"coins" JSONB NOT NULL,
 	//	PRIMARY KEY ("id", "ts_nanos")
 	// );
 	// GRANT SELECT ON TABLE "test_all_kinds" TO PUBLIC;
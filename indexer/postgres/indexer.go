@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/schema/indexer"
 	"cosmossdk.io/schema/logutil"
@@ -19,6 +20,23 @@ type Config struct {
 
 	// DisableRetainDeletions disables the retain deletions functionality even if it is set in an object type schema.
 	DisableRetainDeletions bool `json:"disable_retain_deletions"`
+
+	// MaxOpenConnections is the maximum number of open connections of the connection pool to the database.
+	// It defaults to 0, which means unlimited.
+	MaxOpenConnections int `json:"max_open_connections"`
+
+	// MaxIdleConnections is the maximum number of idle connections kept in the connection pool. It defaults
+	// to 0, which keeps the default of database/sql, and a negative value keeps no idle connections.
+	MaxIdleConnections int `json:"max_idle_connections"`
+
+	// ConnectionMaxLifetime is the maximum amount of time a connection of the pool may be reused, as a
+	// duration string such as "30m". It defaults to no limit.
+	ConnectionMaxLifetime string `json:"connection_max_lifetime"`
+
+	// RetainBlocks is the number of the most recent blocks whose block, transaction and event rows are
+	// retained, the older rows being pruned when a block is committed. It defaults to 0, which retains
+	// all the blocks. The state of the object types is not pruned.
+	RetainBlocks uint64 `json:"retain_blocks"`
 }
 
 type indexerImpl struct {
@@ -28,6 +46,11 @@ type indexerImpl struct {
 	opts    options
 	modules map[string]*moduleIndexer
 	logger  logutil.Logger
+
+	// retainBlocks is the number of blocks retained, see Config.RetainBlocks.
+	retainBlocks uint64
+	// blockHeight is the height of the current block.
+	blockHeight uint64
 }
 
 func init() {
@@ -52,6 +75,11 @@ func startIndexer(params indexer.InitParams) (indexer.InitResult, error) {
 		return indexer.InitResult{}, errors.New("missing database URL")
 	}
 
+	// blocks have no time in the database, see Prune
+	if retention := params.Config.Retention; retention != nil && retention.KeepSince != "" {
+		return indexer.InitResult{}, errors.New("retention by time is not supported, keep_last_blocks must be used instead of keep_since")
+	}
+
 	driver := config.DatabaseDriver
 	if driver == "" {
 		driver = "pgx"
@@ -62,6 +90,18 @@ func startIndexer(params indexer.InitParams) (indexer.InitResult, error) {
 		return indexer.InitResult{}, err
 	}
 
+	db.SetMaxOpenConns(config.MaxOpenConnections)
+	if config.MaxIdleConnections != 0 {
+		db.SetMaxIdleConns(config.MaxIdleConnections)
+	}
+	if config.ConnectionMaxLifetime != "" {
+		lifetime, err := time.ParseDuration(config.ConnectionMaxLifetime)
+		if err != nil {
+			return indexer.InitResult{}, fmt.Errorf("invalid connection max lifetime: %v", err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+		db.SetConnMaxLifetime(lifetime)
+	}
+
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return indexer.InitResult{}, err
@@ -87,10 +127,13 @@ func startIndexer(params indexer.InitParams) (indexer.InitResult, error) {
 		opts:    opts,
 		modules: moduleIndexers,
 		logger:  params.Logger,
+
+		retainBlocks: config.RetainBlocks,
 	}
 
 	return indexer.InitResult{
 		Listener: idx.listener(),
 		View:     idx,
+		Pruner:   idx,
 	}, nil
 }
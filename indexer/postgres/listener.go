@@ -22,6 +22,7 @@ func (i *indexerImpl) listener() appdata.Listener {
 			return mm.initializeSchema(i.ctx, i.tx)
 		},
 		StartBlock: func(data appdata.StartBlockData) error {
+			i.blockHeight = data.Height
 			_, err := i.tx.Exec("INSERT INTO block (number) VALUES ($1)", data.Height)
 			return err
 		},
@@ -54,7 +55,13 @@ func (i *indexerImpl) listener() appdata.Listener {
 			return nil
 		},
 		Commit: func(data appdata.CommitData) (func() error, error) {
-			err := i.tx.Commit()
+			// the old blocks are pruned within the transaction of the block
+			err := i.pruneBlocks()
+			if err != nil {
+				return nil, err
+			}
+
+			err = i.tx.Commit()
 			if err != nil {
 				return nil, err
 			}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -107,6 +108,13 @@ func (tm *objectIndexer) bindParam(field schema.Field, value interface{}) (param
 		}
 
 		param = int64(t)
+	} else if field.Kind == schema.CoinKind || field.Kind == schema.CoinsKind {
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("coin encoding failed for field %q: %w", field.Name, err)
+		}
+
+		param = string(bz)
 	} else if field.Kind == schema.AddressKind {
 		param, err = tm.options.addressCodec.BytesToString(value.([]byte))
 		if err != nil {
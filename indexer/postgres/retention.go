@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"errors"
+
+	"cosmossdk.io/schema/indexer"
+)
+
+// pruneBlocks prunes the blocks older than the retained blocks, see Config.RetainBlocks.
+func (i *indexerImpl) pruneBlocks() error {
+	if i.retainBlocks == 0 || i.blockHeight <= i.retainBlocks {
+		return nil
+	}
+
+	return i.Prune(indexer.PruneOptions{Height: i.blockHeight - i.retainBlocks + 1})
+}
+
+// Prune implements indexer.Pruner by deleting the blocks below the height of the options, along with
+// their transactions and events. Blocks have no time in the database, so they can only be pruned by
+// height, the retention of the target being rejected at initialization if it has a keep since duration.
+// The deletions are committed along with the next block.
+func (i *indexerImpl) Prune(opts indexer.PruneOptions) error {
+	if !opts.Time.IsZero() {
+		return errors.New("blocks can't be pruned by time")
+	}
+	if opts.Height <= 1 {
+		return nil
+	}
+
+	for _, sqlStr := range []string{
+		"DELETE FROM event WHERE block_number < $1",
+		"DELETE FROM tx WHERE block_number < $1",
+		"DELETE FROM block WHERE number < $1",
+	} {
+		if i.logger != nil {
+			i.logger.Debug("Prune blocks", "sql", sqlStr, "height", opts.Height)
+		}
+		_, err := i.tx.ExecContext(i.ctx, sqlStr, opts.Height)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
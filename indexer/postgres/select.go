@@ -242,7 +242,7 @@ func (tm *objectIndexer) readCol(field schema.Field, value interface{}) (interfa
 	str := nullStr.String
 
 	switch field.Kind {
-	case schema.StringKind, schema.EnumKind, schema.IntegerKind, schema.DecimalKind:
+	case schema.StringKind, schema.EnumKind, schema.IntegerKind, schema.DecimalKind, schema.Int128Kind, schema.Uint128Kind:
 		return str, nil
 	case schema.Uint8Kind:
 		value, err := strconv.ParseUint(str, 10, 8)
@@ -279,6 +279,14 @@ func (tm *objectIndexer) readCol(field schema.Field, value interface{}) (interfa
 		return value, err
 	case schema.JSONKind:
 		return json.RawMessage(str), nil
+	case schema.CoinKind:
+		var coin schema.Coin
+		err := json.Unmarshal([]byte(str), &coin)
+		return coin, err
+	case schema.CoinsKind:
+		var coins []schema.Coin
+		err := json.Unmarshal([]byte(str), &coins)
+		return coins, err
 	case schema.TimeKind:
 		value, err := strconv.ParseInt(str, 10, 64)
 		if err != nil {
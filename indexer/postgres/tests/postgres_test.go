@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"database/sql"
 	"os"
 	"strings"
 	"testing"
@@ -107,3 +108,72 @@ func testPostgresIndexer(t *testing.T, retainDeletions bool) {
 		debugLog.Reset()
 	}
 }
+
+func TestPostgresIndexerRetainBlocks(t *testing.T) {
+	dbUrl := createTestDB(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	res, err := indexer.StartIndexing(indexer.IndexingOptions{
+		Config: indexer.IndexingConfig{
+			Target: map[string]indexer.Config{
+				"postgres": {
+					Type: "postgres",
+					Config: postgres.Config{
+						DatabaseURL:            dbUrl,
+						DisableRetainDeletions: true,
+						MaxOpenConnections:     4,
+						ConnectionMaxLifetime:  "1m",
+						RetainBlocks:           3,
+					},
+				},
+			},
+		},
+		Context:      ctx,
+		AddressCodec: addressutil.HexAddressCodec{},
+	})
+	require.NoError(t, err)
+
+	sim, err := appdatasim.NewSimulator(appdatasim.Options{
+		Listener:  res.Listener,
+		AppSchema: indexertesting.ExampleAppSchema,
+	})
+	require.NoError(t, err)
+
+	blockDataGen := sim.BlockDataGenN(10, 100)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, sim.ProcessBlockData(blockDataGen.Example(i)))
+	}
+
+	// only the blocks of the last 3 heights are retained, the object state is not pruned
+	require.Empty(t, appdatasim.DiffAppData(sim, res.IndexerInfos["postgres"].View))
+
+	db, err := sql.Open("pgx", dbUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	var numBlocks, minBlock int64
+	require.NoError(t, db.QueryRow("SELECT count(*), min(number) FROM block").Scan(&numBlocks, &minBlock))
+	require.Equal(t, int64(3), numBlocks)
+	require.Equal(t, int64(8), minBlock)
+}
+
+func TestPostgresIndexerRetentionKeepSince(t *testing.T) {
+	_, err := indexer.StartIndexing(indexer.IndexingOptions{
+		Config: indexer.IndexingConfig{
+			Target: map[string]indexer.Config{
+				"postgres": {
+					Type: "postgres",
+					Config: postgres.Config{
+						DatabaseURL: "postgres://localhost/test",
+					},
+					Retention: &indexer.RetentionConfig{KeepSince: "24h"},
+				},
+			},
+		},
+		Context:      context.Background(),
+		AddressCodec: addressutil.HexAddressCodec{},
+	})
+	require.ErrorContains(t, err, "retention by time is not supported")
+}
@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema/decoding"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -277,6 +278,17 @@ func (a *App) SetInitChainer(initChainer sdk.InitChainer) {
 	a.BaseApp.SetInitChainer(initChainer)
 }
 
+// SchemaDecoderResolver returns the decoder resolver of the app modules, used to decode the app data
+// streamed to indexers.
+func (a *App) SchemaDecoderResolver() decoding.DecoderResolver {
+	moduleSet := map[string]any{}
+	for modName, mod := range a.ModuleManager.Modules {
+		moduleSet[modName] = mod
+	}
+
+	return decoding.ModuleSetDecoderResolver(moduleSet)
+}
+
 // GetStoreKeys returns all the stored store keys.
 func (a *App) GetStoreKeys() []storetypes.StoreKey {
 	return a.storeKeys
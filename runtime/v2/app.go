@@ -35,12 +35,14 @@ type App[T transaction.Tx] struct {
 	queryRouterBuilder *stf.MsgRouterBuilder
 	db                 Store
 	storeLoader        StoreLoader
+	storeActors        storeActors // storeActors defines the store keys registered by the modules
 
 	// modules
 	interfaceRegistrar registry.InterfaceRegistrar
 	amino              registry.AminoRegistrar
 	moduleManager      *MM[T]
 	queryHandlers      map[string]appmodulev2.Handler // queryHandlers defines the query handlers
+	eventSchemas       *eventSchemaRegistry           // eventSchemas defines the registered typed event schemas
 }
 
 // Name returns the app name.
@@ -94,7 +96,24 @@ func (a *App[T]) SchemaDecoderResolver() decoding.DecoderResolver {
 	for moduleName, module := range a.moduleManager.Modules() {
 		moduleSet[moduleName] = module
 	}
-	return decoding.ModuleSetDecoderResolver(moduleSet)
+	resolver, err := decoding.WithEventTypes(decoding.ModuleSetDecoderResolver(moduleSet), a.eventSchemas.eventTypes()...)
+	if err != nil {
+		// event schemas are validated when registered
+		panic(err)
+	}
+	return resolver
+}
+
+// RecentPanics returns the diagnostics of the most recent message handler panics, newest first.
+// It implements stf.PanicReporter.
+func (a *App[T]) RecentPanics() []stf.PanicDiagnostic {
+	return a.stf.RecentPanics()
+}
+
+// StoreActors returns the store actors registered with the store builder by the runtime module,
+// with the modules owning them. It implements stf.StoreActorReporter.
+func (a *App[T]) StoreActors() []stf.StoreActor {
+	return a.storeActors.list()
 }
 
 // Close is called in start cmd to gracefully cleanup resources.
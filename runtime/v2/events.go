@@ -0,0 +1,139 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/schema"
+)
+
+var _ event.SchemaRegistrar = (*eventSchemaRegistry)(nil)
+
+// eventSchemaRegistry implements event.SchemaRegistrar by deriving the schema of typed events
+// from their protobuf descriptors.
+type eventSchemaRegistry struct {
+	files   protodesc.Resolver
+	schemas map[string]schema.EventType
+}
+
+func newEventSchemaRegistry(files protodesc.Resolver) *eventSchemaRegistry {
+	return &eventSchemaRegistry{
+		files:   files,
+		schemas: map[string]schema.EventType{},
+	}
+}
+
+// RegisterEventSchema implements event.SchemaRegistrar.
+func (r *eventSchemaRegistry) RegisterEventSchema(event transaction.Msg) (string, error) {
+	if event == nil {
+		return "", errors.New("unable to derive the name of a nil event")
+	}
+
+	eventName := gogoproto.MessageName(event)
+	if eventName == "" {
+		return "", fmt.Errorf("unable to derive the name of event %T", event)
+	}
+
+	if _, ok := r.schemas[eventName]; ok {
+		return "", fmt.Errorf("event schema %s already registered", eventName)
+	}
+
+	desc, err := r.files.FindDescriptorByName(protoreflect.FullName(eventName))
+	if err != nil {
+		return "", fmt.Errorf("unable to find descriptor for event %s: %w", eventName, err)
+	}
+
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return "", fmt.Errorf("event %s is not a protobuf message", eventName)
+	}
+
+	eventType := eventTypeFromDescriptor(msgDesc)
+	if err := eventType.Validate(); err != nil {
+		return "", err
+	}
+
+	r.schemas[eventName] = eventType
+	return eventName, nil
+}
+
+// eventTypes returns the registered event schemas sorted by name.
+func (r *eventSchemaRegistry) eventTypes() []schema.EventType {
+	if r == nil {
+		return nil
+	}
+
+	eventTypes := make([]schema.EventType, 0, len(r.schemas))
+	for _, name := range slices.Sorted(maps.Keys(r.schemas)) {
+		eventTypes = append(eventTypes, r.schemas[name])
+	}
+	return eventTypes
+}
+
+// eventTypeFromDescriptor derives the schema of a typed event from its protobuf descriptor.
+// Field names and values follow the protobuf JSON mapping used when emitting typed events
+// with original field names. Fields which don't map to a basic kind are represented as JSON.
+func eventTypeFromDescriptor(desc protoreflect.MessageDescriptor) schema.EventType {
+	fields := desc.Fields()
+	eventType := schema.EventType{
+		Name:   string(desc.FullName()),
+		Fields: make([]schema.Field, 0, fields.Len()),
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		kind, nullable := fieldKind(fd)
+		eventType.Fields = append(eventType.Fields, schema.Field{
+			Name:     string(fd.Name()),
+			Kind:     kind,
+			Nullable: nullable || fd.ContainingOneof() != nil,
+		})
+	}
+
+	return eventType
+}
+
+func fieldKind(fd protoreflect.FieldDescriptor) (kind schema.Kind, nullable bool) {
+	if fd.IsList() || fd.IsMap() {
+		return schema.JSONKind, false
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind, protoreflect.EnumKind:
+		return schema.StringKind, false
+	case protoreflect.BoolKind:
+		return schema.BoolKind, false
+	case protoreflect.BytesKind:
+		return schema.BytesKind, false
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return schema.Int32Kind, false
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return schema.Uint32Kind, false
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return schema.Int64Kind, false
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return schema.Uint64Kind, false
+	case protoreflect.FloatKind:
+		return schema.Float32Kind, false
+	case protoreflect.DoubleKind:
+		return schema.Float64Kind, false
+	}
+
+	// message fields are null when unset
+	switch fd.Message().FullName() {
+	case "google.protobuf.Timestamp":
+		return schema.TimeKind, true
+	case "google.protobuf.Duration":
+		return schema.DurationKind, true
+	default:
+		return schema.JSONKind, true
+	}
+}
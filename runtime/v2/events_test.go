@@ -0,0 +1,64 @@
+package runtime
+
+import (
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/typepb"
+
+	runtimev2 "cosmossdk.io/api/cosmos/app/runtime/v2"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/schema"
+)
+
+// namedEvent is an event whose protobuf message name is provided explicitly.
+type namedEvent string
+
+func (namedEvent) Reset()                    {}
+func (e namedEvent) String() string          { return string(e) }
+func (namedEvent) ProtoMessage()             {}
+func (e namedEvent) XXX_MessageName() string { return string(e) }
+
+func TestEventSchemaRegistry(t *testing.T) {
+	registry := newEventSchemaRegistry(gogoproto.HybridResolver)
+
+	emitter, err := event.RegisterEvent[*runtimev2.Module](registry)
+	require.NoError(t, err)
+	require.Equal(t, "cosmos.app.runtime.v2.Module", emitter.Name())
+
+	name, err := registry.RegisterEventSchema(&typepb.Type{})
+	require.NoError(t, err)
+	require.Equal(t, "google.protobuf.Type", name)
+
+	_, err = event.RegisterEvent[*typepb.Type](registry)
+	require.ErrorContains(t, err, "already registered")
+	_, err = event.RegisterEvent[transaction.Msg](registry)
+	require.ErrorContains(t, err, "nil event")
+	_, err = registry.RegisterEventSchema(namedEvent(""))
+	require.ErrorContains(t, err, "unable to derive the name")
+	_, err = registry.RegisterEventSchema(namedEvent("cosmos.unknown.v1.EventUnknown"))
+	require.ErrorContains(t, err, "unable to find descriptor")
+	_, err = registry.RegisterEventSchema(namedEvent("google.protobuf.Type.name"))
+	require.ErrorContains(t, err, "not a protobuf message")
+
+	eventTypes := registry.eventTypes()
+	require.Len(t, eventTypes, 2)
+	require.Equal(t, "cosmos.app.runtime.v2.Module", eventTypes[0].Name)
+	require.Equal(t, schema.Field{Name: "app_name", Kind: schema.StringKind}, eventTypes[0].Fields[0])
+	require.Equal(t, schema.Field{Name: "pre_blockers", Kind: schema.JSONKind}, eventTypes[0].Fields[1])
+
+	require.Equal(t, schema.EventType{
+		Name: "google.protobuf.Type",
+		Fields: []schema.Field{
+			{Name: "name", Kind: schema.StringKind},
+			{Name: "fields", Kind: schema.JSONKind},
+			{Name: "oneofs", Kind: schema.JSONKind},
+			{Name: "options", Kind: schema.JSONKind},
+			{Name: "source_context", Kind: schema.JSONKind, Nullable: true},
+			{Name: "syntax", Kind: schema.StringKind},
+			{Name: "edition", Kind: schema.StringKind},
+		},
+	}, eventTypes[1])
+}
@@ -0,0 +1,135 @@
+package runtime
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+)
+
+// genesisConstraints returns the genesis ordering constraints declared by the
+// modules, as a map of module name -> names of the modules whose genesis must be
+// initialized before it. Constraints on modules which are not part of the app are
+// ignored.
+func genesisConstraints(modules map[string]appmodulev2.AppModule) (map[string][]string, error) {
+	after := make(map[string][]string)
+	addConstraint := func(name, dep string) {
+		if !slices.Contains(after[name], dep) {
+			after[name] = append(after[name], dep)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(modules)) {
+		module, ok := modules[name].(appmodulev2.HasGenesisOrdering)
+		if !ok {
+			continue
+		}
+
+		for _, dep := range module.InitGenesisAfter() {
+			if dep == name {
+				return nil, fmt.Errorf("module %s cannot initialize its genesis after itself", name)
+			}
+			if _, ok := modules[dep]; ok {
+				addConstraint(name, dep)
+			}
+		}
+
+		for _, next := range module.InitGenesisBefore() {
+			if next == name {
+				return nil, fmt.Errorf("module %s cannot initialize its genesis before itself", name)
+			}
+			if _, ok := modules[next]; ok {
+				addConstraint(next, name)
+			}
+		}
+	}
+
+	return after, nil
+}
+
+// sortInitGenesis returns the init genesis order of the modules satisfying their
+// genesis ordering constraints. Modules which are not constrained relative to each
+// other are sorted in ascending alphabetical order.
+func sortInitGenesis(modules map[string]appmodulev2.AppModule) ([]string, error) {
+	after, err := genesisConstraints(modules)
+	if err != nil {
+		return nil, err
+	}
+
+	// pending is the number of modules to initialize before a module, and next
+	// the modules to initialize after it
+	pending := make(map[string]int, len(modules))
+	next := make(map[string][]string, len(modules))
+	for name, deps := range after {
+		pending[name] = len(deps)
+		for _, dep := range deps {
+			next[dep] = append(next[dep], name)
+		}
+	}
+
+	var ready []string
+	for name := range modules {
+		if pending[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+	slices.Sort(ready)
+
+	order := make([]string, 0, len(modules))
+	for len(ready) > 0 {
+		name := ready[0]
+		ready = ready[1:]
+		order = append(order, name)
+
+		for _, n := range next[name] {
+			pending[n]--
+			if pending[n] == 0 {
+				ready = append(ready, n)
+				slices.Sort(ready)
+			}
+		}
+	}
+
+	if len(order) != len(modules) {
+		var cycle []string
+		for name, count := range pending {
+			if count > 0 {
+				cycle = append(cycle, name)
+			}
+		}
+		slices.Sort(cycle)
+		return nil, fmt.Errorf("genesis ordering constraints have a cycle between modules %v", cycle)
+	}
+
+	return order, nil
+}
+
+// validateInitGenesisOrder checks that the InitGenesis order of the config
+// satisfies the genesis ordering constraints of the modules.
+func (m *MM[T]) validateInitGenesisOrder() error {
+	after, err := genesisConstraints(m.modules)
+	if err != nil {
+		return err
+	}
+
+	position := make(map[string]int, len(m.config.InitGenesis))
+	for i, name := range m.config.InitGenesis {
+		position[name] = i
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(after)) {
+		pos, ok := position[name]
+		if !ok {
+			continue
+		}
+
+		for _, dep := range after[name] {
+			if depPos, ok := position[dep]; ok && depPos > pos {
+				return fmt.Errorf("invalid InitGenesis order: module %s must be initialized after module %s", name, dep)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	runtimev2 "cosmossdk.io/api/cosmos/app/runtime/v2"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/transaction"
+)
+
+type orderedModule struct {
+	appmodulev2.AppModule
+	after, before []string
+}
+
+func (m orderedModule) InitGenesisAfter() []string  { return m.after }
+func (m orderedModule) InitGenesisBefore() []string { return m.before }
+
+func TestSortInitGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modules  map[string]appmodulev2.AppModule
+		expOrder []string
+		expErr   string
+	}{
+		{
+			name: "no constraints",
+			modules: map[string]appmodulev2.AppModule{
+				"c": orderedModule{}, "a": orderedModule{}, "b": orderedModule{},
+			},
+			expOrder: []string{"a", "b", "c"},
+		},
+		{
+			name: "after and before constraints",
+			modules: map[string]appmodulev2.AppModule{
+				"genutil": orderedModule{after: []string{"auth", "staking"}},
+				"auth":    orderedModule{},
+				"staking": orderedModule{after: []string{"auth"}},
+				"bank":    orderedModule{before: []string{"auth"}},
+				"gov":     orderedModule{},
+			},
+			expOrder: []string{"bank", "auth", "gov", "staking", "genutil"},
+		},
+		{
+			name: "constraints on missing modules are ignored",
+			modules: map[string]appmodulev2.AppModule{
+				"a": orderedModule{after: []string{"b", "missing"}, before: []string{"missing"}},
+				"b": orderedModule{},
+			},
+			expOrder: []string{"b", "a"},
+		},
+		{
+			name: "cycle",
+			modules: map[string]appmodulev2.AppModule{
+				"a": orderedModule{after: []string{"b"}},
+				"b": orderedModule{after: []string{"c"}},
+				"c": orderedModule{before: []string{"a"}, after: []string{"a"}},
+				"d": orderedModule{},
+			},
+			expErr: "cycle between modules [a b c]",
+		},
+		{
+			name: "self constraint",
+			modules: map[string]appmodulev2.AppModule{
+				"a": orderedModule{before: []string{"a"}},
+			},
+			expErr: "module a cannot initialize its genesis before itself",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order, err := sortInitGenesis(tc.modules)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expOrder, order)
+		})
+	}
+}
+
+func TestValidateInitGenesisOrder(t *testing.T) {
+	modules := map[string]appmodulev2.AppModule{
+		"auth":    orderedModule{},
+		"staking": orderedModule{},
+		"genutil": orderedModule{after: []string{"auth", "staking"}},
+	}
+
+	mm := &MM[transaction.Tx]{
+		modules: modules,
+		config:  &runtimev2.Module{InitGenesis: []string{"auth", "staking", "genutil"}},
+	}
+	require.NoError(t, mm.validateInitGenesisOrder())
+
+	// modules missing from the order are not constrained
+	mm.config.InitGenesis = []string{"staking", "genutil"}
+	require.NoError(t, mm.validateInitGenesisOrder())
+
+	mm.config.InitGenesis = []string{"auth", "genutil", "staking"}
+	require.ErrorContains(t, mm.validateInitGenesisOrder(), "module genutil must be initialized after module staking")
+}
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"slices"
 	"sort"
+	"strings"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc"
@@ -20,6 +21,7 @@ import (
 	cosmosmsg "cosmossdk.io/api/cosmos/msg/v1"
 	"cosmossdk.io/core/appmodule"
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
@@ -57,7 +59,11 @@ func NewModuleManager[T transaction.Tx](
 		config.TxValidators = modulesName
 	}
 	if len(config.InitGenesis) == 0 {
-		config.InitGenesis = modulesName
+		initGenesis, err := sortInitGenesis(modules)
+		if err != nil {
+			panic(err)
+		}
+		config.InitGenesis = initGenesis
 	}
 	if len(config.ExportGenesis) == 0 {
 		config.ExportGenesis = modulesName
@@ -502,6 +508,41 @@ func (m *MM[T]) RegisterServices(app *App[T]) error {
 		if module, ok := module.(appmodulev2.HasPostMsgHandlers); ok {
 			module.RegisterPostMsgHandlers(app.msgRouterBuilder)
 		}
+
+		// register typed event schemas
+		if module, ok := module.(event.HasEventSchemas); ok {
+			if err := module.RegisterEventSchemas(app.eventSchemas); err != nil {
+				return err
+			}
+		}
+	}
+
+	return m.validateModuleCallGrants(app.msgRouterBuilder)
+}
+
+// validateModuleCallGrants asserts that the module call grants of the configuration
+// reference existing modules and messages, so that a typo can't silently grant nothing.
+func (m *MM[T]) validateModuleCallGrants(msgRouterBuilder *stf.MsgRouterBuilder) error {
+	seen := make(map[string]struct{}, len(m.config.ModuleCallGrants))
+	for _, grant := range m.config.ModuleCallGrants {
+		if _, ok := m.modules[grant.ModuleName]; !ok {
+			return fmt.Errorf("module call grant for unknown module %q", grant.ModuleName)
+		}
+
+		if _, ok := seen[grant.ModuleName]; ok {
+			return fmt.Errorf("duplicate module call grant for module %q", grant.ModuleName)
+		}
+		seen[grant.ModuleName] = struct{}{}
+
+		for _, typeURL := range grant.MsgTypeUrls {
+			if typeURL == services.AllMsgsGrant {
+				continue
+			}
+
+			if !msgRouterBuilder.HandlerExists(strings.TrimPrefix(typeURL, "/")) {
+				return fmt.Errorf("module call grant of module %q for unknown message %q", grant.ModuleName, typeURL)
+			}
+		}
 	}
 
 	return nil
@@ -562,6 +603,10 @@ func (m *MM[T]) validateConfig() error {
 		return err
 	}
 
+	if err := m.validateInitGenesisOrder(); err != nil {
+		return err
+	}
+
 	if err := m.assertNoForgottenModules("ExportGenesis", m.config.ExportGenesis, func(moduleName string) bool {
 		module := m.modules[moduleName]
 		if _, hasGenesis := module.(appmodule.HasGenesisAuto); hasGenesis {
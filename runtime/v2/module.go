@@ -19,6 +19,7 @@ import (
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/registry"
+	"cosmossdk.io/core/router"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/depinject"
@@ -132,7 +133,9 @@ func ProvideAppBuilder[T transaction.Tx](
 		msgRouterBuilder:   msgRouterBuilder,
 		queryRouterBuilder: stf.NewMsgRouterBuilder(), // TODO dedicated query router
 		queryHandlers:      map[string]appmodulev2.Handler{},
+		eventSchemas:       newEventSchemaRegistry(protoFiles),
 		storeLoader:        DefaultStoreLoader,
+		storeActors:        storeActors{},
 	}
 	appBuilder := &AppBuilder[T]{app: app, storeBuilder: storeBuilder}
 
@@ -160,6 +163,7 @@ func SetupAppBuilder(inputs AppInputs) {
 	app.moduleManager.RegisterLegacyAminoCodec(inputs.LegacyAmino)
 	// STF requires some state to run
 	inputs.StoreBuilder.RegisterKey("stf")
+	app.storeActors.register("stf", ModuleName)
 }
 
 func ProvideModuleManager[T transaction.Tx](
@@ -175,6 +179,7 @@ func ProvideKVService(
 	key depinject.ModuleKey,
 	kvFactory store.KVStoreServiceFactory,
 	storeBuilder root.Builder,
+	appBuilder *AppBuilder[transaction.Tx],
 ) (store.KVStoreService, store.MemoryStoreService) {
 	// skips modules that have no store
 	if slices.Contains(config.SkipStoreKeys, key.Name()) {
@@ -189,6 +194,7 @@ func ProvideKVService(
 	}
 
 	storeBuilder.RegisterKey(kvStoreKey)
+	appBuilder.app.storeActors.register(kvStoreKey, key.Name())
 	return kvFactory([]byte(kvStoreKey)), stf.NewMemoryStoreService([]byte(fmt.Sprintf("memory:%s", kvStoreKey)))
 }
 
@@ -201,15 +207,32 @@ func storeKeyOverride(config *runtimev2.Module, moduleName string) *runtimev2.St
 	return nil
 }
 
+func moduleCallGrant(config *runtimev2.Module, moduleName string) *runtimev2.ModuleCallGrant {
+	for _, grant := range config.ModuleCallGrants {
+		if grant.ModuleName == moduleName {
+			return grant
+		}
+	}
+	return nil
+}
+
 // ProvideEnvironment provides the environment for keeper modules, while maintaining backward compatibility and provide services directly as well.
 func ProvideEnvironment(
 	logger log.Logger,
+	config *runtimev2.Module,
 	key depinject.ModuleKey,
 	kvService store.KVStoreService,
 	memKvService store.MemoryStoreService,
 	headerService header.Service,
 	eventService event.Service,
 ) appmodulev2.Environment {
+	var msgRouterService router.Service = stf.NewMsgRouterService([]byte(key.Name()))
+	if grant := moduleCallGrant(config, key.Name()); grant != nil {
+		msgRouterService = services.NewGrantedMsgRouterService(key.Name(), grant.MsgTypeUrls, msgRouterService)
+	} else if config.RestrictModuleCalls {
+		msgRouterService = services.NewGrantedMsgRouterService(key.Name(), nil, msgRouterService)
+	}
+
 	return appmodulev2.Environment{
 		Logger:             logger,
 		BranchService:      stf.BranchService{},
@@ -217,7 +240,7 @@ func ProvideEnvironment(
 		GasService:         stf.NewGasMeterService(),
 		HeaderService:      headerService,
 		QueryRouterService: stf.NewQueryRouterService(),
-		MsgRouterService:   stf.NewMsgRouterService([]byte(key.Name())),
+		MsgRouterService:   msgRouterService,
 		TransactionService: services.NewContextAwareTransactionService(),
 		KVStoreService:     kvService,
 		MemStoreService:    memKvService,
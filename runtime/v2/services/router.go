@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/router"
+	"cosmossdk.io/core/transaction"
+)
+
+// ErrModuleCallNotGranted is returned when a module invokes a message it has not been granted.
+var ErrModuleCallNotGranted = errors.New("module call not granted")
+
+// AllMsgsGrant is the wildcard granting a module all messages.
+const AllMsgsGrant = "*"
+
+var _ router.Service = (*GrantedMsgRouterService)(nil)
+
+// GrantedMsgRouterService is a message router service which only lets a module invoke
+// the messages it has been granted in the app config.
+type GrantedMsgRouterService struct {
+	router.Service
+
+	moduleName string
+	granted    map[string]struct{}
+	all        bool
+}
+
+// NewGrantedMsgRouterService wraps the message router service of the module moduleName so
+// that it can only invoke the messages of the given type URLs. The AllMsgsGrant wildcard
+// grants all messages.
+func NewGrantedMsgRouterService(moduleName string, msgTypeURLs []string, msgRouter router.Service) *GrantedMsgRouterService {
+	s := &GrantedMsgRouterService{
+		Service:    msgRouter,
+		moduleName: moduleName,
+		granted:    make(map[string]struct{}, len(msgTypeURLs)),
+	}
+
+	for _, typeURL := range msgTypeURLs {
+		if typeURL == AllMsgsGrant {
+			s.all = true
+			continue
+		}
+
+		s.granted[strings.TrimPrefix(typeURL, "/")] = struct{}{}
+	}
+
+	return s
+}
+
+// CanInvoke returns an error if the module has not been granted the message or if it cannot be invoked.
+func (s *GrantedMsgRouterService) CanInvoke(ctx context.Context, typeURL string) error {
+	if err := s.checkGrant(strings.TrimPrefix(typeURL, "/")); err != nil {
+		return err
+	}
+
+	return s.Service.CanInvoke(ctx, typeURL)
+}
+
+// Invoke executes the message if the module has been granted it.
+func (s *GrantedMsgRouterService) Invoke(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+	if err := s.checkGrant(gogoproto.MessageName(msg)); err != nil {
+		return nil, err
+	}
+
+	return s.Service.Invoke(ctx, msg)
+}
+
+func (s *GrantedMsgRouterService) checkGrant(msgName string) error {
+	if s.all {
+		return nil
+	}
+
+	if _, ok := s.granted[msgName]; !ok {
+		return fmt.Errorf("%w: module %s cannot invoke /%s", ErrModuleCallNotGranted, s.moduleName, msgName)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/router"
+	"cosmossdk.io/core/transaction"
+)
+
+type echoRouter struct{}
+
+func (echoRouter) CanInvoke(context.Context, string) error { return nil }
+
+func (echoRouter) Invoke(_ context.Context, req transaction.Msg) (transaction.Msg, error) {
+	return req, nil
+}
+
+func TestGrantedMsgRouterService(t *testing.T) {
+	ctx := context.Background()
+	granted := NewGrantedMsgRouterService("gov", []string{"/google.protobuf.BoolValue"}, echoRouter{})
+
+	require.NoError(t, granted.CanInvoke(ctx, "/google.protobuf.BoolValue"))
+	require.NoError(t, granted.CanInvoke(ctx, "google.protobuf.BoolValue"))
+	require.ErrorIs(t, granted.CanInvoke(ctx, "/google.protobuf.StringValue"), ErrModuleCallNotGranted)
+
+	res, err := router.InvokeTyped[*gogotypes.BoolValue](ctx, granted, &gogotypes.BoolValue{Value: true})
+	require.NoError(t, err)
+	require.True(t, res.Value)
+
+	_, err = router.InvokeTyped[*gogotypes.StringValue](ctx, granted, &gogotypes.BoolValue{})
+	require.ErrorContains(t, err, "unexpected response type")
+
+	_, err = granted.Invoke(ctx, &gogotypes.StringValue{})
+	require.ErrorIs(t, err, ErrModuleCallNotGranted)
+
+	// a module without grants can't invoke anything
+	restricted := NewGrantedMsgRouterService("bank", nil, echoRouter{})
+	_, err = restricted.Invoke(ctx, &gogotypes.BoolValue{})
+	require.ErrorIs(t, err, ErrModuleCallNotGranted)
+
+	// the wildcard grants all messages
+	all := NewGrantedMsgRouterService("authz", []string{AllMsgsGrant}, echoRouter{})
+	_, err = all.Invoke(ctx, &gogotypes.StringValue{})
+	require.NoError(t, err)
+}
@@ -1,8 +1,10 @@
 package runtime
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/server/v2/stf"
@@ -22,7 +24,9 @@ type Store interface {
 	GetLatestVersion() (uint64, error)
 	// StateLatest returns a readonly view over the latest
 	// committed state of the store. Alongside the version
-	// associated with it.
+	// associated with it. The view is immutable, and StateLatest
+	// is safe to call from the query servers concurrently with
+	// the commits.
 	StateLatest() (uint64, store.ReaderMap, error)
 
 	// StateAt returns a readonly view over the provided
@@ -131,3 +135,27 @@ func checkStoreUpgrade(storeUpgrades *store.StoreUpgrades) error {
 
 	return nil
 }
+
+// storeActors maps the store keys registered with the store builder to the names of the modules
+// owning them.
+type storeActors map[string][]string
+
+func (s storeActors) register(storeKey, moduleName string) {
+	if slices.Contains(s[storeKey], moduleName) {
+		return
+	}
+	s[storeKey] = append(s[storeKey], moduleName)
+}
+
+func (s storeActors) list() []stf.StoreActor {
+	res := make([]stf.StoreActor, 0, len(s))
+	for storeKey, modules := range s {
+		modules = slices.Clone(modules)
+		slices.Sort(modules)
+		res = append(res, stf.StoreActor{Actor: []byte(storeKey), Modules: modules})
+	}
+	slices.SortFunc(res, func(a, b stf.StoreActor) int {
+		return bytes.Compare(a.Actor, b.Actor)
+	})
+	return res
+}
@@ -1,9 +1,11 @@
 package runtime
 
 import (
+	"reflect"
 	"testing"
 
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/server/v2/stf"
 )
 
 func TestCheckStoreUpgrade(t *testing.T) {
@@ -69,3 +71,22 @@ func TestCheckStoreUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestStoreActors(t *testing.T) {
+	actors := storeActors{}
+	actors.register("stf", ModuleName)
+	actors.register("bank", "bank")
+	actors.register("acc", "auth")
+	// a store key shared by several modules through overrides
+	actors.register("acc", "accounts")
+	actors.register("acc", "auth")
+
+	want := []stf.StoreActor{
+		{Actor: []byte("acc"), Modules: []string{"accounts", "auth"}},
+		{Actor: []byte("bank"), Modules: []string{"bank"}},
+		{Actor: []byte("stf"), Modules: []string{ModuleName}},
+	}
+	if got := actors.list(); !reflect.DeepEqual(got, want) {
+		t.Errorf("list() = %v, want %v", got, want)
+	}
+}
@@ -90,6 +90,13 @@ func AsyncListener(opts AsyncListenerOptions, listener Listener) Listener {
 		}
 	}
 
+	if listener.OnSchemaUpgrade != nil {
+		res.OnSchemaUpgrade = func(data SchemaUpgradeData) error {
+			packetChan <- data
+			return nil
+		}
+	}
+
 	if listener.StartBlock != nil {
 		res.StartBlock = func(data StartBlockData) error {
 			packetChan <- data
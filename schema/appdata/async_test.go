@@ -14,6 +14,9 @@ func TestAsyncListenerMux(t *testing.T) {
 		if listener.InitializeModuleData != nil {
 			t.Error("expected nil")
 		}
+		if listener.OnSchemaUpgrade != nil {
+			t.Error("expected nil")
+		}
 		if listener.StartBlock != nil {
 			t.Error("expected nil")
 		}
@@ -56,6 +59,7 @@ func TestAsyncListenerMux(t *testing.T) {
 
 		expectedCalls := []string{
 			"InitializeModuleData",
+			"OnSchemaUpgrade",
 			"StartBlock",
 			"OnTx",
 			"OnEvent",
@@ -119,6 +123,7 @@ func TestAsyncListener(t *testing.T) {
 
 		checkExpectedCallOrder(t, calls, []string{
 			"InitializeModuleData",
+			"OnSchemaUpgrade",
 			"StartBlock",
 			"OnTx",
 			"OnEvent",
@@ -156,6 +161,6 @@ func TestAsyncListener(t *testing.T) {
 			t.Fatalf("expected error, got %v", err)
 		}
 
-		checkExpectedCallOrder(t, calls, []string{"InitializeModuleData", "StartBlock", "OnTx", "OnEvent"})
+		checkExpectedCallOrder(t, calls, []string{"InitializeModuleData", "OnSchemaUpgrade", "StartBlock", "OnTx", "OnEvent"})
 	})
 }
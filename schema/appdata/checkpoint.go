@@ -0,0 +1,162 @@
+package appdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrCheckpointTimeout is returned by CheckpointBarrier.Wait when the listeners did not acknowledge
+// the requested height before the timeout.
+var ErrCheckpointTimeout = errors.New("timed out waiting for listener checkpoint")
+
+// CheckpointBarrier tracks, for a set of named listeners, the last block height whose Commit each of
+// them acknowledged, i.e. up to which they have persisted the data they received. It lets a data source
+// wait until all these listeners have persisted a block before discarding the state of that block,
+// for instance when pruning, as a listener which lost data could never re-read it.
+//
+// Listeners are registered with CheckpointBarrier.Listener.
+type CheckpointBarrier struct {
+	mu      sync.Mutex
+	heights map[string]uint64
+	// acked is closed and replaced each time a listener acknowledges a height.
+	acked chan struct{}
+}
+
+// NewCheckpointBarrier creates a new CheckpointBarrier without any listener.
+func NewCheckpointBarrier() *CheckpointBarrier {
+	return &CheckpointBarrier{
+		heights: map[string]uint64{},
+		acked:   make(chan struct{}),
+	}
+}
+
+// Listener registers a listener with the provided name and returns a listener which forwards all
+// callbacks to it and acknowledges the height of each commit once its completion callback returned
+// without error. The height of a commit is CommitData.Height or, if it is zero, the height of the
+// last StartBlockData received.
+//
+// The returned listener should be wrapped by AsyncListener, if needed, and not the other way
+// around, so that a commit is only acknowledged once the listener completed its processing.
+func (b *CheckpointBarrier) Listener(name string, listener Listener) Listener {
+	b.mu.Lock()
+	if _, ok := b.heights[name]; ok {
+		b.mu.Unlock()
+		panic(fmt.Sprintf("listener %q already registered", name))
+	}
+	b.heights[name] = 0
+	b.mu.Unlock()
+
+	var blockHeight uint64
+	startBlock := listener.StartBlock
+	listener.StartBlock = func(data StartBlockData) error {
+		blockHeight = data.Height
+		if startBlock == nil {
+			return nil
+		}
+		return startBlock(data)
+	}
+
+	commit := listener.Commit
+	listener.Commit = func(data CommitData) (func() error, error) {
+		height := data.Height
+		if height == 0 {
+			height = blockHeight
+		}
+
+		var cb func() error
+		if commit != nil {
+			var err error
+			cb, err = commit(data)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return func() error {
+			if cb != nil {
+				if err := cb(); err != nil {
+					return err
+				}
+			}
+			b.Ack(name, height)
+			return nil
+		}, nil
+	}
+
+	// batches must go through the callbacks above
+	listener.onBatch = nil
+
+	return listener
+}
+
+// Ack records that the listener with the provided name persisted all the data up to the provided
+// height. Heights lower than the last acknowledged one, and unknown listeners, are ignored.
+func (b *CheckpointBarrier) Ack(name string, height uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	last, ok := b.heights[name]
+	if !ok || height <= last {
+		return
+	}
+
+	b.heights[name] = height
+	close(b.acked)
+	b.acked = make(chan struct{})
+}
+
+// Checkpoint returns the highest height acknowledged by all the listeners. It returns math.MaxUint64
+// if no listener is registered, as nothing holds back the data source.
+func (b *CheckpointBarrier) Checkpoint() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	checkpoint, _ := b.checkpoint()
+	return checkpoint
+}
+
+// Wait blocks until all the listeners acknowledged the provided height. It returns ErrCheckpointTimeout
+// if the timeout elapses first, or the context error if the context is done first. A zero timeout
+// means no timeout.
+func (b *CheckpointBarrier) Wait(ctx context.Context, height uint64, timeout time.Duration) error {
+	var timeoutCh <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	for {
+		b.mu.Lock()
+		checkpoint, acked := b.checkpoint()
+		b.mu.Unlock()
+
+		if checkpoint >= height {
+			return nil
+		}
+
+		select {
+		case <-acked:
+		case <-timeoutCh:
+			return ErrCheckpointTimeout
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// checkpoint returns the checkpoint and the channel closed on the next acknowledgement. The caller
+// must hold the lock.
+func (b *CheckpointBarrier) checkpoint() (uint64, <-chan struct{}) {
+	checkpoint := uint64(math.MaxUint64)
+	for _, height := range b.heights {
+		if height < checkpoint {
+			checkpoint = height
+		}
+	}
+	return checkpoint, b.acked
+}
@@ -0,0 +1,119 @@
+package appdata
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCheckpointBarrier(t *testing.T) {
+	t.Run("no listener", func(t *testing.T) {
+		b := NewCheckpointBarrier()
+		if b.Checkpoint() != math.MaxUint64 {
+			t.Fatalf("expected max checkpoint, got %d", b.Checkpoint())
+		}
+		if err := b.Wait(context.Background(), 10, time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("acknowledge commits", func(t *testing.T) {
+		b := NewCheckpointBarrier()
+		commitErr := errors.New("commit error")
+		var failCommit bool
+		l1 := b.Listener("l1", Listener{
+			Commit: func(CommitData) (func() error, error) {
+				return func() error {
+					if failCommit {
+						return commitErr
+					}
+					return nil
+				}, nil
+			},
+		})
+		// a listener without callbacks still acknowledges commits
+		l2 := b.Listener("l2", Listener{})
+
+		if err := l1.SendPacket(CommitData{Height: 1}); err != nil {
+			t.Fatal(err)
+		}
+		if b.Checkpoint() != 0 {
+			t.Fatalf("expected checkpoint 0, got %d", b.Checkpoint())
+		}
+
+		// the height of the last block is used if the commit has no height
+		if err := l2.SendPacket(PacketBatch{StartBlockData{Height: 2}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := l2.SendPacket(CommitData{}); err != nil {
+			t.Fatal(err)
+		}
+		if b.Checkpoint() != 1 {
+			t.Fatalf("expected checkpoint 1, got %d", b.Checkpoint())
+		}
+
+		// a failed commit is not acknowledged
+		failCommit = true
+		if err := l1.SendPacket(CommitData{Height: 2}); !errors.Is(err, commitErr) {
+			t.Fatalf("expected commit error, got %v", err)
+		}
+		if b.Checkpoint() != 1 {
+			t.Fatalf("expected checkpoint 1, got %d", b.Checkpoint())
+		}
+
+		// lower heights are ignored
+		b.Ack("l1", 3)
+		b.Ack("l2", 4)
+		b.Ack("l2", 1)
+		if b.Checkpoint() != 3 {
+			t.Fatalf("expected checkpoint 3, got %d", b.Checkpoint())
+		}
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		b := NewCheckpointBarrier()
+		listener := AsyncListener(AsyncListenerOptions{}, b.Listener("l", Listener{}))
+
+		if err := b.Wait(context.Background(), 1, 10*time.Millisecond); !errors.Is(err, ErrCheckpointTimeout) {
+			t.Fatalf("expected timeout, got %v", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := b.Wait(ctx, 1, 0); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context canceled, got %v", err)
+		}
+
+		done := make(chan error)
+		go func() {
+			done <- b.Wait(context.Background(), 2, time.Minute)
+		}()
+
+		for height := uint64(1); height <= 2; height++ {
+			cb, err := listener.Commit(CommitData{Height: height})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := cb(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("duplicate listener", func(t *testing.T) {
+		b := NewCheckpointBarrier()
+		b.Listener("l", Listener{})
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("expected panic")
+			}
+		}()
+		b.Listener("l", Listener{})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/diff"
 )
 
 // ModuleInitializationData represents data for related to module initialization, in particular
@@ -16,6 +17,28 @@ type ModuleInitializationData struct {
 	Schema schema.ModuleSchema
 }
 
+// SchemaUpgradeData represents the data passed to a listener when the schema of a module changed,
+// or its version increased, since the listener last initialized it, usually because of an upgrade.
+type SchemaUpgradeData struct {
+	// ModuleName is the name of the module.
+	ModuleName string
+
+	// Height is the height of the first block processed with the new schema. It may be zero if
+	// the source does not provide it.
+	Height uint64
+
+	// OldSchema is the schema of the module the listener last initialized.
+	OldSchema schema.ModuleSchema
+
+	// NewSchema is the upgraded schema of the module.
+	NewSchema schema.ModuleSchema
+
+	// Diff is the difference between the old and the new schemas, which the listener can use to
+	// drive its migration. Its HasCompatibleChanges method reports whether the schema only changed
+	// in ways that indexers should be able to migrate to automatically.
+	Diff diff.ModuleSchemaDiff
+}
+
 // StartBlockData represents the data that is passed to a listener when a block is started.
 type StartBlockData struct {
 	// Height is the height of the block.
@@ -76,6 +99,14 @@ type Event struct {
 
 	// Attributes lazily returns the key-value attribute representation of the event.
 	Attributes ToEventAttributes
+
+	// EventType is the schema of the event if it is a typed event whose schema is known,
+	// and nil otherwise. It is usually populated by the decoding middleware.
+	EventType *schema.EventType
+
+	// Values lazily returns the decoded values of a typed event in the order of the fields
+	// of EventType. It is only set when EventType is set.
+	Values ToEventValues
 }
 
 // BlockStage represents the stage of block processing for an event.
@@ -111,6 +142,9 @@ type ToJSON = func() (json.RawMessage, error)
 // ToEventAttributes is a function that lazily returns the key-value attribute representation of an event.
 type ToEventAttributes = func() ([]EventAttribute, error)
 
+// ToEventValues is a function that lazily returns the decoded values of a typed event.
+type ToEventValues = func() ([]interface{}, error)
+
 // KVPairData represents a batch of key-value pair data that is passed to a listener.
 type KVPairData struct {
 	Updates []ActorKVPairUpdate
@@ -134,5 +168,8 @@ type ObjectUpdateData struct {
 	Updates []schema.StateObjectUpdate
 }
 
-// CommitData represents commit data. It is empty for now, but fields could be added later.
-type CommitData struct{}
+// CommitData represents commit data.
+type CommitData struct {
+	// Height is the height of the block being committed. It may be zero if the source does not provide it.
+	Height uint64
+}
@@ -5,6 +5,7 @@ package appdata
 func PacketForwarder(f func(Packet) error) Listener {
 	return Listener{
 		InitializeModuleData: func(data ModuleInitializationData) error { return f(data) },
+		OnSchemaUpgrade:      func(data SchemaUpgradeData) error { return f(data) },
 		OnTx:                 func(data TxData) error { return f(data) },
 		OnEvent:              func(data EventData) error { return f(data) },
 		OnKVPair:             func(data KVPairData) error { return f(data) },
@@ -14,6 +14,7 @@ func TestPacketForwarder(t *testing.T) {
 
 	expected := []Packet{
 		ModuleInitializationData{},
+		SchemaUpgradeData{},
 		StartBlockData{},
 		TxData{},
 		EventData{},
@@ -15,6 +15,13 @@ type Listener struct {
 	// an error. Module names must conform to the NameFormat regular expression.
 	InitializeModuleData func(ModuleInitializationData) error
 
+	// OnSchemaUpgrade is called before InitializeModuleData when the schema of a module differs from
+	// the schema the listener last initialized the module with, or its version is greater, so that
+	// the listener can migrate its persisted data, for instance by altering tables, rather than
+	// failing on an incompatible schema. It is invoked by the indexer manager for targets that
+	// provide a view of their module schemas.
+	OnSchemaUpgrade func(SchemaUpgradeData) error
+
 	// StartBlock is called at the beginning of processing a block.
 	StartBlock func(StartBlockData) error
 
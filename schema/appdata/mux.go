@@ -22,6 +22,23 @@ func ListenerMux(listeners ...Listener) Listener {
 		}
 	}
 
+	schemaUpgradeCbs := make([]func(SchemaUpgradeData) error, 0, len(listeners))
+	for _, l := range listeners {
+		if l.OnSchemaUpgrade != nil {
+			schemaUpgradeCbs = append(schemaUpgradeCbs, l.OnSchemaUpgrade)
+		}
+	}
+	if len(schemaUpgradeCbs) > 0 {
+		mux.OnSchemaUpgrade = func(data SchemaUpgradeData) error {
+			for _, cb := range schemaUpgradeCbs {
+				if err := cb(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
 	startBlockCbs := make([]func(StartBlockData) error, 0, len(listeners))
 	for _, l := range listeners {
 		if l.StartBlock != nil {
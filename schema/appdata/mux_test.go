@@ -12,6 +12,9 @@ func TestListenerMux(t *testing.T) {
 		if listener.InitializeModuleData != nil {
 			t.Error("expected nil")
 		}
+		if listener.OnSchemaUpgrade != nil {
+			t.Error("expected nil")
+		}
 		if listener.StartBlock != nil {
 			t.Error("expected nil")
 		}
@@ -50,6 +53,8 @@ func TestListenerMux(t *testing.T) {
 		checkExpectedCallOrder(t, calls, []string{
 			"InitializeModuleData 1",
 			"InitializeModuleData 2",
+			"OnSchemaUpgrade 1",
+			"OnSchemaUpgrade 2",
 			"StartBlock 1",
 			"StartBlock 2",
 			"OnTx 1",
@@ -71,6 +76,9 @@ func callAllCallbacksOnces(t *testing.T, listener Listener) (completeCb func() e
 	if err := listener.InitializeModuleData(ModuleInitializationData{}); err != nil {
 		t.Error(err)
 	}
+	if err := listener.OnSchemaUpgrade(SchemaUpgradeData{}); err != nil {
+		t.Error(err)
+	}
 	if err := listener.StartBlock(StartBlockData{}); err != nil {
 		t.Error(err)
 	}
@@ -100,6 +108,10 @@ func callCollector(i int, onCall func(string, int, Packet)) Listener {
 			onCall("InitializeModuleData", i, nil)
 			return nil
 		},
+		OnSchemaUpgrade: func(SchemaUpgradeData) error {
+			onCall("OnSchemaUpgrade", i, nil)
+			return nil
+		},
 		StartBlock: func(StartBlockData) error {
 			onCall("StartBlock", i, nil)
 			return nil
@@ -2,7 +2,7 @@ package appdata
 
 // Packet is the interface that all listener data structures implement so that this data can be "packetized"
 // and processed in a stream, possibly asynchronously.
-// Valid implementations are ModuleInitializationData, StartBlockData, TxData, EventData, KVPairData, ObjectUpdateData,
+// Valid implementations are ModuleInitializationData, SchemaUpgradeData, StartBlockData, TxData, EventData, KVPairData, ObjectUpdateData,
 // and CommitData.
 type Packet interface {
 	apply(*Listener) error
@@ -20,6 +20,13 @@ func (m ModuleInitializationData) apply(l *Listener) error {
 	return l.InitializeModuleData(m)
 }
 
+func (u SchemaUpgradeData) apply(l *Listener) error {
+	if l.OnSchemaUpgrade == nil {
+		return nil
+	}
+	return l.OnSchemaUpgrade(u)
+}
+
 func (b StartBlockData) apply(l *Listener) error {
 	if l.StartBlock == nil {
 		return nil
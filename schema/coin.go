@@ -0,0 +1,45 @@
+package schema
+
+import "fmt"
+
+// Coin is the go encoding of CoinKind values, an amount of a coin denomination.
+type Coin struct {
+	// Denom is the denomination of the coin, which must match the DenomFormat regex.
+	Denom string `json:"denom"`
+
+	// Amount is the amount of the coin, a canonically encoded non-negative base10 integer string.
+	Amount string `json:"amount"`
+}
+
+// Validate validates the coin.
+func (c Coin) Validate() error {
+	if !denomRegex.MatchString(c.Denom) {
+		return fmt.Errorf("invalid coin denom %q", c.Denom)
+	}
+
+	if len(c.Amount) == 0 || c.Amount[0] == '-' || !canonicalIntegerRegex.MatchString(c.Amount) {
+		return fmt.Errorf("expected canonical non-negative base10 integer amount for coin %s, got %q", c.Denom, c.Amount)
+	}
+
+	return nil
+}
+
+// validateCoins validates that the coins are valid, have positive amounts and are sorted by strictly
+// increasing denom.
+func validateCoins(coins []Coin) error {
+	for i, coin := range coins {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+
+		if coin.Amount == "0" {
+			return fmt.Errorf("expected positive amount for coin %s", coin.Denom)
+		}
+
+		if i > 0 && coins[i-1].Denom >= coin.Denom {
+			return fmt.Errorf("expected coins sorted by strictly increasing denom, got %s after %s", coin.Denom, coins[i-1].Denom)
+		}
+	}
+
+	return nil
+}
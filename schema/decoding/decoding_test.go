@@ -166,6 +166,172 @@ func TestSync_filtered(t *testing.T) {
 	}
 }
 
+func TestSync_resume(t *testing.T) {
+	tl := newTestFixture(t)
+	tl.bankMod.Mint("bob", "foo", 100)
+	err := tl.bankMod.Send("bob", "alice", "foo", 50)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	tl.oneMod.SetValue("def")
+
+	// interrupt the sync after the first bank update
+	checkpoints := &testSyncCheckpointStore{}
+	listener := tl.Listener
+	onObjectUpdate := listener.OnObjectUpdate
+	listener.OnObjectUpdate = func(data appdata.ObjectUpdateData) error {
+		if len(tl.bankUpdates) == 1 {
+			return fmt.Errorf("interrupted")
+		}
+		return onObjectUpdate(data)
+	}
+
+	opts := SyncOptions{Checkpoints: checkpoints, CheckpointInterval: 1}
+	err = Sync(listener, tl.multiStore, tl.resolver, opts)
+	if err == nil || err.Error() != "interrupted" {
+		t.Fatalf("expected sync to be interrupted, got %v", err)
+	}
+
+	expectedCheckpoint := SyncCheckpoint{ModuleName: "bank", LastKey: tl.bankMod.store.sortedKeys()[0]}
+	if checkpoints.checkpoint == nil || !reflect.DeepEqual(*checkpoints.checkpoint, expectedCheckpoint) {
+		t.Fatalf("expected checkpoint %v, got %v", expectedCheckpoint, checkpoints.checkpoint)
+	}
+
+	// resume the sync
+	err = Sync(tl.Listener, tl.multiStore, tl.resolver, opts)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	expected := []schema.StateObjectUpdate{
+		{
+			TypeName: "balances",
+			Key:      []interface{}{"alice", "foo"},
+			Value:    uint64(50),
+		},
+		{
+			TypeName: "balances",
+			Key:      []interface{}{"bob", "foo"},
+			Value:    uint64(50),
+		},
+		{
+			TypeName: "supply",
+			Key:      []interface{}{"foo"},
+			Value:    uint64(100),
+		},
+	}
+
+	if !reflect.DeepEqual(tl.bankUpdates, expected) {
+		t.Fatalf("expected %v, got %v", expected, tl.bankUpdates)
+	}
+
+	expectedOne := []schema.StateObjectUpdate{
+		{TypeName: "item", Value: "def"},
+	}
+
+	if !reflect.DeepEqual(tl.oneValueUpdates, expectedOne) {
+		t.Fatalf("expected %v, got %v", expectedOne, tl.oneValueUpdates)
+	}
+
+	if checkpoints.checkpoint != nil {
+		t.Fatalf("expected checkpoint to be cleared, got %v", checkpoints.checkpoint)
+	}
+}
+
+func TestSync_concurrent(t *testing.T) {
+	tl := newTestFixture(t)
+	tl.bankMod.Mint("bob", "foo", 100)
+	err := tl.bankMod.Send("bob", "alice", "foo", 50)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	tl.oneMod.SetValue("def")
+
+	// interrupt the sync after the first bank update
+	checkpoints := &testSyncCheckpointStore{}
+	listener := tl.Listener
+	onObjectUpdate := listener.OnObjectUpdate
+	listener.OnObjectUpdate = func(data appdata.ObjectUpdateData) error {
+		if data.ModuleName == "bank" && len(tl.bankUpdates) == 1 {
+			return fmt.Errorf("interrupted")
+		}
+		return onObjectUpdate(data)
+	}
+
+	opts := SyncOptions{Checkpoints: checkpoints, CheckpointInterval: 1, Concurrency: 2}
+	err = Sync(listener, tl.multiStore, tl.resolver, opts)
+	if err == nil || err.Error() != "interrupted" {
+		t.Fatalf("expected sync to be interrupted, got %v", err)
+	}
+
+	if checkpoints.checkpoint == nil || !reflect.DeepEqual(checkpoints.checkpoint.LastKeys["bank"], tl.bankMod.store.sortedKeys()[0]) {
+		t.Fatalf("expected checkpoint of the first bank key, got %v", checkpoints.checkpoint)
+	}
+
+	// resume the sync
+	err = Sync(tl.Listener, tl.multiStore, tl.resolver, opts)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	expected := []schema.StateObjectUpdate{
+		{
+			TypeName: "balances",
+			Key:      []interface{}{"alice", "foo"},
+			Value:    uint64(50),
+		},
+		{
+			TypeName: "balances",
+			Key:      []interface{}{"bob", "foo"},
+			Value:    uint64(50),
+		},
+		{
+			TypeName: "supply",
+			Key:      []interface{}{"foo"},
+			Value:    uint64(100),
+		},
+	}
+
+	if !reflect.DeepEqual(tl.bankUpdates, expected) {
+		t.Fatalf("expected %v, got %v", expected, tl.bankUpdates)
+	}
+
+	expectedOne := []schema.StateObjectUpdate{
+		{TypeName: "item", Value: "def"},
+	}
+
+	if !reflect.DeepEqual(tl.oneValueUpdates, expectedOne) {
+		t.Fatalf("expected %v, got %v", expectedOne, tl.oneValueUpdates)
+	}
+
+	if checkpoints.checkpoint != nil {
+		t.Fatalf("expected checkpoint to be cleared, got %v", checkpoints.checkpoint)
+	}
+}
+
+type testSyncCheckpointStore struct {
+	checkpoint *SyncCheckpoint
+}
+
+func (s *testSyncCheckpointStore) LoadSyncCheckpoint() (SyncCheckpoint, bool, error) {
+	if s.checkpoint == nil {
+		return SyncCheckpoint{}, false, nil
+	}
+	return *s.checkpoint, true, nil
+}
+
+func (s *testSyncCheckpointStore) SaveSyncCheckpoint(checkpoint SyncCheckpoint) error {
+	s.checkpoint = &checkpoint
+	return nil
+}
+
+func (s *testSyncCheckpointStore) ClearSyncCheckpoint() error {
+	s.checkpoint = nil
+	return nil
+}
+
 type testFixture struct {
 	appdata.Listener
 	bankUpdates     []schema.StateObjectUpdate
@@ -279,6 +445,19 @@ func (ms *testMultiStore) newTestStore(t *testing.T, modName string) *testStore
 	return s
 }
 
+func (t testStore) sortedKeys() [][]byte {
+	var keys []string
+	for key := range t.store {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	res := make([][]byte, len(keys))
+	for i, key := range keys {
+		res[i] = []byte(key)
+	}
+	return res
+}
+
 func (t testStore) Get(key []byte) []byte {
 	return t.store[string(key)]
 }
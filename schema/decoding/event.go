@@ -0,0 +1,211 @@
+package decoding
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+// EventTypeResolver is an optional interface that a DecoderResolver can implement to allow indexers
+// to decode typed events into structured values.
+type EventTypeResolver interface {
+	// LookupEventType looks up the event type with the provided name.
+	LookupEventType(name string) (schema.EventType, bool)
+}
+
+// WithEventTypes returns a DecoderResolver which wraps the provided resolver and also implements
+// EventTypeResolver for the provided event types.
+func WithEventTypes(resolver DecoderResolver, eventTypes ...schema.EventType) (DecoderResolver, error) {
+	types := make(map[string]schema.EventType, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if err := eventType.Validate(); err != nil {
+			return nil, err
+		}
+
+		if _, ok := types[eventType.Name]; ok {
+			return nil, fmt.Errorf("duplicate event type %q", eventType.Name)
+		}
+
+		types[eventType.Name] = eventType
+	}
+
+	return eventTypeResolver{DecoderResolver: resolver, eventTypes: types}, nil
+}
+
+type eventTypeResolver struct {
+	DecoderResolver
+	eventTypes map[string]schema.EventType
+}
+
+func (r eventTypeResolver) LookupEventType(name string) (schema.EventType, bool) {
+	eventType, ok := r.eventTypes[name]
+	return eventType, ok
+}
+
+// decodeEvent sets the EventType and Values of an event if its type is known to the resolver.
+func decodeEvent(resolver EventTypeResolver, event appdata.Event) appdata.Event {
+	eventType, ok := resolver.LookupEventType(event.Type)
+	if !ok {
+		return event
+	}
+
+	data, attrs := event.Data, event.Attributes
+	event.EventType = &eventType
+	event.Values = func() ([]interface{}, error) {
+		fields, err := eventFields(data, attrs)
+		if err != nil {
+			return nil, err
+		}
+
+		return DecodeEventValues(eventType, fields)
+	}
+
+	return event
+}
+
+// eventFields returns the JSON representation of each field of an event, preferring its JSON data
+// and falling back to its attributes when the data is not available.
+func eventFields(data appdata.ToJSON, attrs appdata.ToEventAttributes) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if data != nil {
+		bz, err := data()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(bz, &fields); err != nil {
+			return nil, err
+		}
+
+		return fields, nil
+	}
+
+	if attrs == nil {
+		return fields, nil
+	}
+
+	attributes, err := attrs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attr := range attributes {
+		fields[attr.Key] = json.RawMessage(attr.Value)
+	}
+
+	return fields, nil
+}
+
+// DecodeEventValues decodes the JSON representation of the fields of a typed event into values
+// ordered as the fields of the event type. The JSON representation is expected to follow the
+// protobuf JSON mapping. Missing and null fields are decoded as nil, which is only accepted for
+// nullable fields.
+func DecodeEventValues(eventType schema.EventType, fields map[string]json.RawMessage) ([]interface{}, error) {
+	values := make([]interface{}, len(eventType.Fields))
+	for i, field := range eventType.Fields {
+		raw, ok := fields[field.Name]
+		if !ok || string(raw) == "null" {
+			if !field.Nullable {
+				return nil, fmt.Errorf("missing value for non-nullable field %q of event type %q", field.Name, eventType.Name)
+			}
+			continue
+		}
+
+		value, err := decodeJSONValue(field.Kind, raw)
+		if err != nil {
+			return nil, fmt.Errorf("can't decode field %q of event type %q: %v", field.Name, eventType.Name, err) //nolint:errorlint // false positive due to using go1.12
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func decodeJSONValue(kind schema.Kind, raw json.RawMessage) (interface{}, error) {
+	switch kind {
+	case schema.JSONKind:
+		return raw, nil
+	case schema.CoinKind:
+		var coin schema.Coin
+		err := json.Unmarshal(raw, &coin)
+		return coin, err
+	case schema.CoinsKind:
+		var coins []schema.Coin
+		err := json.Unmarshal(raw, &coins)
+		return coins, err
+	case schema.BoolKind:
+		var b bool
+		err := json.Unmarshal(raw, &b)
+		return b, err
+	case schema.Float32Kind, schema.Float64Kind:
+		var f float64
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, err
+		}
+		if kind == schema.Float32Kind {
+			return float32(f), nil
+		}
+		return f, nil
+	}
+
+	// all other supported kinds are represented either as JSON strings or as JSON numbers
+	// which we handle as their literal representation
+	str, err := jsonString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case schema.StringKind, schema.EnumKind, schema.IntegerKind, schema.DecimalKind, schema.Int128Kind, schema.Uint128Kind:
+		return str, nil
+	case schema.BytesKind:
+		return base64.StdEncoding.DecodeString(str)
+	case schema.Int8Kind:
+		i, err := strconv.ParseInt(str, 10, 8)
+		return int8(i), err
+	case schema.Int16Kind:
+		i, err := strconv.ParseInt(str, 10, 16)
+		return int16(i), err
+	case schema.Int32Kind:
+		i, err := strconv.ParseInt(str, 10, 32)
+		return int32(i), err
+	case schema.Int64Kind:
+		return strconv.ParseInt(str, 10, 64)
+	case schema.Uint8Kind:
+		i, err := strconv.ParseUint(str, 10, 8)
+		return uint8(i), err
+	case schema.Uint16Kind:
+		i, err := strconv.ParseUint(str, 10, 16)
+		return uint16(i), err
+	case schema.Uint32Kind:
+		i, err := strconv.ParseUint(str, 10, 32)
+		return uint32(i), err
+	case schema.Uint64Kind:
+		return strconv.ParseUint(str, 10, 64)
+	case schema.TimeKind:
+		return time.Parse(time.RFC3339Nano, str)
+	case schema.DurationKind:
+		return time.ParseDuration(str)
+	default:
+		return nil, fmt.Errorf("unsupported event field kind %q", kind)
+	}
+}
+
+func jsonString(raw json.RawMessage) (string, error) {
+	if len(raw) > 0 && raw[0] == '"' {
+		var str string
+		err := json.Unmarshal(raw, &str)
+		return str, err
+	}
+
+	var num json.Number
+	if err := json.Unmarshal(raw, &num); err != nil {
+		return "", err
+	}
+	return num.String(), nil
+}
@@ -0,0 +1,168 @@
+package decoding
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+var testEventType = schema.EventType{
+	Name: "test.v1.EventTransfer",
+	Fields: []schema.Field{
+		{Name: "sender", Kind: schema.StringKind},
+		{Name: "amount", Kind: schema.Uint64Kind},
+		{Name: "height", Kind: schema.Int32Kind},
+		{Name: "memo", Kind: schema.BytesKind},
+		{Name: "time", Kind: schema.TimeKind},
+		{Name: "coins", Kind: schema.JSONKind, Nullable: true},
+	},
+}
+
+func TestMiddleware_Events(t *testing.T) {
+	resolver, err := WithEventTypes(ModuleSetDecoderResolver(map[string]interface{}{}), testEventType)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	var received []appdata.Event
+	listener, err := Middleware(appdata.Listener{
+		OnEvent: func(data appdata.EventData) error {
+			received = append(received, data.Events...)
+			return nil
+		},
+	}, resolver, MiddlewareOptions{})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	data := `{"sender":"bob","amount":"100","height":5,"memo":"aGk=","time":"2024-01-02T03:04:05Z","coins":null}`
+	err = listener.OnEvent(appdata.EventData{Events: []appdata.Event{
+		{
+			Type: testEventType.Name,
+			Data: func() (json.RawMessage, error) { return json.RawMessage(data), nil },
+		},
+		{
+			Type: testEventType.Name,
+			Attributes: func() ([]appdata.EventAttribute, error) {
+				return []appdata.EventAttribute{
+					{Key: "sender", Value: `"alice"`},
+					{Key: "amount", Value: `"1"`},
+					{Key: "height", Value: `6`},
+					{Key: "memo", Value: `""`},
+					{Key: "time", Value: `"2024-01-02T03:04:05Z"`},
+				}, nil
+			},
+		},
+		{Type: "transfer"},
+	}})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if len(received) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(received))
+	}
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	expected := [][]interface{}{
+		{"bob", uint64(100), int32(5), []byte("hi"), ts, nil},
+		{"alice", uint64(1), int32(6), []byte{}, ts, nil},
+	}
+	for i, values := range expected {
+		if received[i].EventType == nil || received[i].EventType.Name != testEventType.Name {
+			t.Fatalf("expected event %d to have event type %q", i, testEventType.Name)
+		}
+
+		got, err := received[i].Values()
+		if err != nil {
+			t.Fatal("unexpected error", err)
+		}
+
+		if !reflect.DeepEqual(got, values) {
+			t.Fatalf("expected %v, got %v", values, got)
+		}
+
+		if err := testEventType.ValidateValues(got); err != nil {
+			t.Fatal("unexpected error", err)
+		}
+	}
+
+	if received[2].EventType != nil || received[2].Values != nil {
+		t.Fatal("expected untyped event to be left unchanged")
+	}
+}
+
+func TestDecodeEventValues(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      map[string]json.RawMessage
+		errContains string
+	}{
+		{
+			name:        "missing non-nullable field",
+			fields:      map[string]json.RawMessage{"sender": json.RawMessage(`"bob"`)},
+			errContains: "missing value for non-nullable field \"amount\"",
+		},
+		{
+			name: "out of range value",
+			fields: map[string]json.RawMessage{
+				"sender": json.RawMessage(`"bob"`),
+				"amount": json.RawMessage(`"1"`),
+				"height": json.RawMessage(`4294967296`),
+			},
+			errContains: "can't decode field \"height\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeEventValues(testEventType, tt.fields)
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Fatalf("expected error to contain %q, got: %v", tt.errContains, err)
+			}
+		})
+	}
+}
+
+func TestDecodeEventValues_Coins(t *testing.T) {
+	eventType := schema.EventType{
+		Name: "test.v1.EventFee",
+		Fields: []schema.Field{
+			{Name: "fee", Kind: schema.CoinKind},
+			{Name: "amount", Kind: schema.CoinsKind},
+		},
+	}
+
+	values, err := DecodeEventValues(eventType, map[string]json.RawMessage{
+		"fee":    json.RawMessage(`{"denom":"uatom","amount":"10"}`),
+		"amount": json.RawMessage(`[{"denom":"stake","amount":"1"},{"denom":"uatom","amount":"2"}]`),
+	})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	expected := []interface{}{
+		schema.Coin{Denom: "uatom", Amount: "10"},
+		[]schema.Coin{{Denom: "stake", Amount: "1"}, {Denom: "uatom", Amount: "2"}},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("expected %v, got %v", expected, values)
+	}
+}
+
+func TestWithEventTypes(t *testing.T) {
+	_, err := WithEventTypes(ModuleSetDecoderResolver(map[string]interface{}{}), testEventType, testEventType)
+	if err == nil || !strings.Contains(err.Error(), "duplicate event type") {
+		t.Fatalf("expected duplicate event type error, got: %v", err)
+	}
+
+	_, err = WithEventTypes(ModuleSetDecoderResolver(map[string]interface{}{}), schema.EventType{Name: "invalid-name"})
+	if err == nil || !strings.Contains(err.Error(), "invalid event type name") {
+		t.Fatalf("expected invalid event type name error, got: %v", err)
+	}
+}
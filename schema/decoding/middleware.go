@@ -10,8 +10,20 @@ type MiddlewareOptions struct {
 }
 
 // Middleware decodes raw data passed to the listener as kv-updates into decoded object updates. Module initialization
-// is done lazily as modules are encountered in the kv-update stream.
+// is done lazily as modules are encountered in the kv-update stream. If the resolver also implements
+// EventTypeResolver, typed events with a known event type are decoded into structured values.
 func Middleware(target appdata.Listener, resolver DecoderResolver, opts MiddlewareOptions) (appdata.Listener, error) {
+	if eventTypes, ok := resolver.(EventTypeResolver); ok && target.OnEvent != nil {
+		onEvent := target.OnEvent
+		target.OnEvent = func(data appdata.EventData) error {
+			events := make([]appdata.Event, len(data.Events))
+			for i, event := range data.Events {
+				events[i] = decodeEvent(eventTypes, event)
+			}
+			return onEvent(appdata.EventData{Events: events})
+		}
+	}
+
 	initializeModuleData := target.InitializeModuleData
 	onObjectUpdate := target.OnObjectUpdate
 
@@ -103,6 +115,19 @@ func Middleware(target appdata.Listener, resolver DecoderResolver, opts Middlewa
 					continue
 				}
 
+				// convert the raw decoded values with the value codecs of the object type fields
+				for i, update := range updates {
+					objType, found := pcdc.Schema.LookupStateObjectType(update.TypeName)
+					if !found {
+						continue
+					}
+
+					updates[i], err = objType.ConvertObjectUpdate(update)
+					if err != nil {
+						return err
+					}
+				}
+
 				err = target.OnObjectUpdate(appdata.ObjectUpdateData{
 					ModuleName: moduleName,
 					Updates:    updates,
@@ -1,6 +1,10 @@
 package decoding
 
 import (
+	"bytes"
+	"errors"
+	"sync"
+
 	"cosmossdk.io/schema"
 	"cosmossdk.io/schema/appdata"
 )
@@ -12,12 +16,97 @@ type SyncSource interface {
 	IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error
 }
 
+// RangeSyncSource is a SyncSource which can start iterating over the key-value pairs of a module from
+// a given key, so that a resumed sync doesn't need to iterate over the keys which were already synced.
+type RangeSyncSource interface {
+	SyncSource
+
+	// IterateKVPairsAfter iterates in ascending key order over the key-value pairs of a given module
+	// whose key is greater than after.
+	IterateKVPairsAfter(moduleName string, after []byte, fn func(key, value []byte) error) error
+}
+
+// SyncCheckpoint is the progress of a catch-up sync.
+type SyncCheckpoint struct {
+	// CompletedModules are the names of the modules whose state was fully synced.
+	CompletedModules []string `json:"completed_modules,omitempty"`
+
+	// ModuleName is the name of the module which was being synced, if any.
+	ModuleName string `json:"module_name,omitempty"`
+
+	// LastKey is the last key of ModuleName which was synced. The key-value pairs of a module
+	// are expected to be iterated in ascending key order.
+	LastKey []byte `json:"last_key,omitempty"`
+
+	// LastKeys are the last keys synced of the modules which were being synced concurrently,
+	// by module name.
+	LastKeys map[string][]byte `json:"last_keys,omitempty"`
+}
+
+func (c SyncCheckpoint) lastKey(moduleName string) []byte {
+	if c.ModuleName == moduleName {
+		return c.LastKey
+	}
+	return c.LastKeys[moduleName]
+}
+
+func (c SyncCheckpoint) copy() SyncCheckpoint {
+	res := SyncCheckpoint{
+		CompletedModules: append([]string(nil), c.CompletedModules...),
+		ModuleName:       c.ModuleName,
+		LastKey:          c.LastKey,
+	}
+	if len(c.LastKeys) != 0 {
+		res.LastKeys = make(map[string][]byte, len(c.LastKeys))
+		for moduleName, key := range c.LastKeys {
+			res.LastKeys[moduleName] = key
+		}
+	}
+	return res
+}
+
+// SyncCheckpointStore persists the progress of a catch-up sync, so that an interrupted sync resumes where
+// it left off instead of starting from scratch. It is generally implemented by the indexer target, which
+// should persist a checkpoint atomically with the object updates it received before it, for instance in
+// the same database transaction.
+type SyncCheckpointStore interface {
+	// LoadSyncCheckpoint returns the last saved checkpoint, if any.
+	LoadSyncCheckpoint() (checkpoint SyncCheckpoint, found bool, err error)
+
+	// SaveSyncCheckpoint saves the progress of the sync.
+	SaveSyncCheckpoint(checkpoint SyncCheckpoint) error
+
+	// ClearSyncCheckpoint is called once the sync completed, so that the next sync starts from scratch.
+	ClearSyncCheckpoint() error
+}
+
 // SyncOptions are the options for Sync.
 type SyncOptions struct {
 	ModuleFilter func(moduleName string) bool
+
+	// Checkpoints is used to save the progress of the sync and resume an interrupted sync. It is optional,
+	// if it is nil the sync starts from scratch every time.
+	Checkpoints SyncCheckpointStore
+
+	// CheckpointInterval is the number of key-value pairs synced between two checkpoints of a module.
+	// A checkpoint is also saved each time a module is fully synced. It defaults to 1024.
+	CheckpointInterval int
+
+	// Concurrency is the maximum number of modules synced concurrently. The object updates of a module
+	// are still sent to the listener in key order, and the listener is never called concurrently, but
+	// the updates of different modules are interleaved. It defaults to 1, syncing the modules one after
+	// the other.
+	Concurrency int
 }
 
+const defaultSyncCheckpointInterval = 1024
+
 // Sync synchronizes existing state from the sync source to the listener using the resolver to decode data.
+//
+// InitializeModuleData is called for all the modules before their state is synced. When
+// SyncOptions.Checkpoints is set, the sync resumes from the last saved checkpoint. In that case
+// InitializeModuleData is still called for every module, including those which were already synced,
+// so that the listener is initialized as it would be for a sync from scratch.
 func Sync(listener appdata.Listener, source SyncSource, resolver DecoderResolver, opts SyncOptions) error {
 	initializeModuleData := listener.InitializeModuleData
 	onObjectUpdate := listener.OnObjectUpdate
@@ -27,7 +116,34 @@ func Sync(listener appdata.Listener, source SyncSource, resolver DecoderResolver
 		return nil
 	}
 
-	return resolver.AllDecoders(func(moduleName string, cdc schema.ModuleCodec) error {
+	s := &syncer{
+		source:         source,
+		onObjectUpdate: onObjectUpdate,
+		checkpoints:    opts.Checkpoints,
+		interval:       opts.CheckpointInterval,
+		concurrent:     opts.Concurrency > 1,
+	}
+	if s.interval <= 0 {
+		s.interval = defaultSyncCheckpointInterval
+	}
+
+	if opts.Checkpoints != nil {
+		saved, found, err := opts.Checkpoints.LoadSyncCheckpoint()
+		if err != nil {
+			return err
+		}
+		if found {
+			s.checkpoint = saved
+		}
+	}
+
+	completed := make(map[string]bool, len(s.checkpoint.CompletedModules))
+	for _, moduleName := range s.checkpoint.CompletedModules {
+		completed[moduleName] = true
+	}
+
+	var modules []syncModule
+	err := resolver.AllDecoders(func(moduleName string, cdc schema.ModuleCodec) error {
 		if opts.ModuleFilter != nil && !opts.ModuleFilter(moduleName) {
 			// ignore this module
 			return nil
@@ -43,21 +159,180 @@ func Sync(listener appdata.Listener, source SyncSource, resolver DecoderResolver
 			}
 		}
 
-		if onObjectUpdate == nil || cdc.KVDecoder == nil {
+		if onObjectUpdate == nil || cdc.KVDecoder == nil || completed[moduleName] {
 			return nil
 		}
 
-		return source.IterateAllKVPairs(moduleName, func(key, value []byte) error {
-			updates, err := cdc.KVDecoder(schema.KVPairUpdate{Key: key, Value: value})
+		modules = append(modules, syncModule{name: moduleName, decoder: cdc.KVDecoder})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.concurrent {
+		err = s.syncConcurrently(modules, opts.Concurrency)
+	} else {
+		for _, m := range modules {
+			err = s.syncModule(m, nil)
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.Checkpoints != nil {
+		return opts.Checkpoints.ClearSyncCheckpoint()
+	}
+
+	return nil
+}
+
+type syncModule struct {
+	name    string
+	decoder schema.KVDecoder
+}
+
+// syncer syncs the state of modules, possibly concurrently. The listener calls and the checkpoints
+// are serialized with mu.
+type syncer struct {
+	source         SyncSource
+	onObjectUpdate func(appdata.ObjectUpdateData) error
+	checkpoints    SyncCheckpointStore
+	interval       int
+	concurrent     bool
+
+	mu         sync.Mutex
+	checkpoint SyncCheckpoint
+}
+
+// errSyncStopped is returned by the iteration over the key-value pairs of a module when the
+// concurrent sync of another module failed.
+var errSyncStopped = errors.New("sync stopped")
+
+// syncConcurrently syncs the modules with a pool of workers, stopping at the first error.
+func (s *syncer) syncConcurrently(modules []syncModule, concurrency int) error {
+	if concurrency > len(modules) {
+		concurrency = len(modules)
+	}
+
+	queue := make(chan syncModule, len(modules))
+	for _, m := range modules {
+		queue <- m
+	}
+	close(queue)
+
+	var (
+		wg       sync.WaitGroup
+		stopOnce sync.Once
+		firstErr error
+	)
+	stop := make(chan struct{})
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for m := range queue {
+				err := s.syncModule(m, stop)
+				if err == nil {
+					continue
+				}
+				stopOnce.Do(func() {
+					firstErr = err
+					close(stop)
+				})
+				return
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// syncModule syncs the state of a module from its last checkpoint. It returns errSyncStopped if stop
+// is closed before the module is synced.
+func (s *syncer) syncModule(m syncModule, stop <-chan struct{}) error {
+	s.mu.Lock()
+	after := s.checkpoint.lastKey(m.name)
+	s.mu.Unlock()
+
+	n := 0
+	err := iterateKVPairsAfter(s.source, m.name, after, func(key, value []byte) error {
+		select {
+		case <-stop:
+			return errSyncStopped
+		default:
+		}
+
+		updates, err := m.decoder(schema.KVPairUpdate{Key: key, Value: value})
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if len(updates) != 0 {
+			err = s.onObjectUpdate(appdata.ObjectUpdateData{ModuleName: m.name, Updates: updates})
 			if err != nil {
 				return err
 			}
+		}
+
+		n++
+		if s.checkpoints == nil || n%s.interval != 0 {
+			return nil
+		}
 
-			if len(updates) == 0 {
-				return nil
+		key = append([]byte(nil), key...)
+		if s.concurrent {
+			if s.checkpoint.LastKeys == nil {
+				s.checkpoint.LastKeys = map[string][]byte{}
 			}
+			s.checkpoint.LastKeys[m.name] = key
+		} else {
+			s.checkpoint.ModuleName = m.name
+			s.checkpoint.LastKey = key
+		}
+		return s.checkpoints.SaveSyncCheckpoint(s.checkpoint.copy())
+	})
+	if err != nil {
+		return err
+	}
 
-			return onObjectUpdate(appdata.ObjectUpdateData{ModuleName: moduleName, Updates: updates})
-		})
+	if s.checkpoints == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint.CompletedModules = append(s.checkpoint.CompletedModules, m.name)
+	if s.checkpoint.ModuleName == m.name {
+		s.checkpoint.ModuleName = ""
+		s.checkpoint.LastKey = nil
+	}
+	delete(s.checkpoint.LastKeys, m.name)
+	return s.checkpoints.SaveSyncCheckpoint(s.checkpoint.copy())
+}
+
+// iterateKVPairsAfter iterates over the key-value pairs of a module whose key is greater than after,
+// or over all of them if after is nil.
+func iterateKVPairsAfter(source SyncSource, moduleName string, after []byte, fn func(key, value []byte) error) error {
+	if after == nil {
+		return source.IterateAllKVPairs(moduleName, fn)
+	}
+
+	if rangeSource, ok := source.(RangeSyncSource); ok {
+		return rangeSource.IterateKVPairsAfter(moduleName, after, fn)
+	}
+
+	return source.IterateAllKVPairs(moduleName, func(key, value []byte) error {
+		if bytes.Compare(key, after) <= 0 {
+			return nil
+		}
+		return fn(key, value)
 	})
 }
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EventType describes the structure of a typed event. Typed events are usually derived from
+// protobuf messages and their values are passed to listeners as the JSON data of an event.
+type EventType struct {
+	// Name is the name of the event type as it appears in the Type field of emitted events,
+	// usually the fully-qualified name of the protobuf message it was derived from.
+	// It must consist of one or more dot-separated segments each conforming to the NameFormat
+	// regular expression.
+	Name string `json:"name"`
+
+	// Fields are the fields of the event. Field names must be unique within the event.
+	// EnumKind, StructKind and OneOfKind fields are not allowed because event types
+	// are not part of a TypeSet.
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// Validate validates the event type.
+func (e EventType) Validate() error {
+	if e.Name == "" {
+		return fmt.Errorf("event type name cannot be empty")
+	}
+
+	for _, segment := range strings.Split(e.Name, ".") {
+		if !ValidateName(segment) {
+			return fmt.Errorf("invalid event type name %q", e.Name)
+		}
+	}
+
+	fieldNames := map[string]bool{}
+	for _, field := range e.Fields {
+		if err := field.Validate(EmptyTypeSet()); err != nil {
+			return fmt.Errorf("invalid field %q in event type %q: %v", field.Name, e.Name, err) //nolint:errorlint // false positive due to using go1.12
+		}
+
+		if fieldNames[field.Name] {
+			return fmt.Errorf("duplicate field name %q in event type %q", field.Name, e.Name)
+		}
+		fieldNames[field.Name] = true
+	}
+
+	return nil
+}
+
+// ValidateValues validates that the values conform to the fields of the event type.
+// Values must be passed in the same order as the event type's fields.
+func (e EventType) ValidateValues(values []interface{}) error {
+	if len(values) != len(e.Fields) {
+		return fmt.Errorf("expected %d values for event type %q, got %d", len(e.Fields), e.Name, len(values))
+	}
+
+	for i, field := range e.Fields {
+		if err := field.ValidateValue(values[i], EmptyTypeSet()); err != nil {
+			return fmt.Errorf("invalid value for event type %q: %v", e.Name, err) //nolint:errorlint // false positive due to using go1.12
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEventType_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		eventType   EventType
+		errContains string
+	}{
+		{
+			name: "valid event type",
+			eventType: EventType{
+				Name:   "cosmos.bank.v1beta1.EventSend",
+				Fields: []Field{{Name: "from", Kind: StringKind}, {Name: "amount", Kind: JSONKind, Nullable: true}},
+			},
+		},
+		{
+			name:        "no fields",
+			eventType:   EventType{Name: "event"},
+			errContains: "",
+		},
+		{
+			name:        "empty name",
+			eventType:   EventType{Fields: []Field{{Name: "a", Kind: StringKind}}},
+			errContains: "event type name cannot be empty",
+		},
+		{
+			name:        "invalid name segment",
+			eventType:   EventType{Name: "cosmos..EventSend"},
+			errContains: "invalid event type name",
+		},
+		{
+			name: "invalid field",
+			eventType: EventType{
+				Name:   "event",
+				Fields: []Field{{Name: "a", Kind: InvalidKind}},
+			},
+			errContains: "invalid field \"a\"",
+		},
+		{
+			name: "enum field",
+			eventType: EventType{
+				Name:   "event",
+				Fields: []Field{{Name: "a", Kind: EnumKind, ReferencedType: "status"}},
+			},
+			errContains: "can't find enum type",
+		},
+		{
+			name: "duplicate field",
+			eventType: EventType{
+				Name:   "event",
+				Fields: []Field{{Name: "a", Kind: StringKind}, {Name: "a", Kind: Int32Kind}},
+			},
+			errContains: "duplicate field name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.eventType.Validate()
+			if tt.errContains == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Fatalf("expected error to contain %q, got: %v", tt.errContains, err)
+			}
+		})
+	}
+}
+
+func TestEventType_ValidateValues(t *testing.T) {
+	eventType := EventType{
+		Name:   "event",
+		Fields: []Field{{Name: "a", Kind: StringKind}, {Name: "b", Kind: Uint64Kind, Nullable: true}},
+	}
+
+	tests := []struct {
+		name        string
+		values      []interface{}
+		errContains string
+	}{
+		{
+			name:   "valid values",
+			values: []interface{}{"foo", uint64(1)},
+		},
+		{
+			name:   "null nullable value",
+			values: []interface{}{"foo", nil},
+		},
+		{
+			name:        "wrong number of values",
+			values:      []interface{}{"foo"},
+			errContains: "expected 2 values",
+		},
+		{
+			name:        "wrong value type",
+			values:      []interface{}{"foo", int64(1)},
+			errContains: "invalid value",
+		},
+		{
+			name:        "null non-nullable value",
+			values:      []interface{}{nil, uint64(1)},
+			errContains: "cannot be null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := eventType.ValidateValues(tt.values)
+			if tt.errContains == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Fatalf("expected error to contain %q, got: %v", tt.errContains, err)
+			}
+		})
+	}
+}
@@ -30,6 +30,11 @@ type Field struct {
 	// If it is 0, such fields have no maximum length.
 	// It is invalid to have a non-zero Size for other kinds.
 	Size uint32 `json:"size,omitempty"`
+
+	// ValueCodec optionally converts the raw values produced by the module decoder into the
+	// canonical representation of the field's kind, see ConvertValue. It is not part of the
+	// serialized schema.
+	ValueCodec ValueCodec `json:"-"`
 }
 
 // Validate validates the field.
@@ -77,6 +82,9 @@ func (c Field) ValidateValue(value interface{}, typeSet TypeSet) error {
 	}
 	err := c.Kind.ValidateValueType(value)
 	if err != nil {
+		if c.ValueCodec == nil {
+			return fmt.Errorf("invalid value for field %q: %v (set a ValueCodec on the field to convert %T values to %s)", c.Name, err, value, c.Kind) //nolint:errorlint // false positive due to using go1.12
+		}
 		return fmt.Errorf("invalid value for field %q: %v", c.Name, err) //nolint:errorlint // false positive due to using go1.12
 	}
 
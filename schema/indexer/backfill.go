@@ -0,0 +1,220 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/logutil"
+)
+
+// BlockSource is a source of historical blocks, usually backed by a block store from which blocks are
+// re-executed.
+type BlockSource interface {
+	// Block returns all the app data produced by the block at the given height.
+	Block(ctx context.Context, height uint64) (BlockData, error)
+}
+
+// BlockData is the app data produced by a single block.
+type BlockData struct {
+	// StartBlock is the data passed to StartBlock. Its Height must be set.
+	StartBlock appdata.StartBlockData
+
+	// Txs are the transactions of the block.
+	Txs []appdata.TxData
+
+	// Events are the events emitted by the block.
+	Events []appdata.Event
+
+	// StateChanges are the key-value pair updates written by the block.
+	StateChanges []appdata.ActorKVPairUpdate
+}
+
+// BackfillOptions are the options for Backfill.
+type BackfillOptions struct {
+	// Target is the listener to backfill, usually the Listener of the IndexingTarget returned by StartIndexing.
+	// It is required.
+	Target appdata.Listener
+
+	// Resolver is the decoder resolver used to encode the module names of the initial state.
+	// It is required if InitialState is set.
+	Resolver decoding.DecoderResolver
+
+	// InitialState is the state of the app at InitialHeight, usually restored from a state sync snapshot.
+	// It is sent to the target as the key-value pairs written by a block at InitialHeight.
+	// It is optional, if it is nil the blocks are replayed from InitialHeight + 1 on and the first replayed
+	// block is expected to contain the genesis state.
+	InitialState decoding.SyncSource
+
+	// InitialHeight is the height of InitialState. Blocks are replayed from InitialHeight + 1 on.
+	InitialHeight uint64
+
+	// Blocks is the source of the blocks to replay. It is required.
+	Blocks BlockSource
+
+	// EndHeight is the height of the last block to replay.
+	EndHeight uint64
+
+	// Logger is the logger used to report progress. It is optional.
+	Logger logutil.Logger
+
+	// Context is used to cancel the backfill between blocks. If it is omitted, context.Background will be used.
+	Context context.Context
+}
+
+// stateBatchSize is the maximum number of key-value pairs of the initial state sent in a single OnKVPair call.
+const stateBatchSize = 1024
+
+// Backfill replays historical data to an indexing target, so that an indexer can be added to an existing node.
+// The initial state, if any, and each block are delivered to the target as regular blocks, from StartBlock to
+// Commit, so that the target can't tell backfilled data from live data.
+func Backfill(opts BackfillOptions) error {
+	if opts.Blocks == nil {
+		return fmt.Errorf("block source is required")
+	}
+
+	if opts.InitialState != nil && opts.Resolver == nil {
+		return fmt.Errorf("decoder resolver is required to backfill the initial state")
+	}
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = logutil.NoopLogger{}
+	}
+
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if opts.InitialState != nil {
+		logger.Info("Backfilling initial state", "height", opts.InitialHeight)
+		if err := backfillState(opts.Target, opts.Resolver, opts.InitialState, opts.InitialHeight); err != nil {
+			return fmt.Errorf("failed to backfill initial state at height %d: %v", opts.InitialHeight, err) //nolint:errorlint // false positive due to using go1.12
+		}
+	}
+
+	for height := opts.InitialHeight + 1; height <= opts.EndHeight; height++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		block, err := opts.Blocks.Block(ctx, height)
+		if err != nil {
+			return fmt.Errorf("failed to load block %d: %v", height, err) //nolint:errorlint // false positive due to using go1.12
+		}
+
+		if err := backfillBlock(opts.Target, block); err != nil {
+			return fmt.Errorf("failed to backfill block %d: %v", height, err) //nolint:errorlint // false positive due to using go1.12
+		}
+
+		logger.Debug("Backfilled block", "height", height)
+	}
+
+	logger.Info("Backfill complete", "end_height", opts.EndHeight)
+
+	return nil
+}
+
+func backfillState(target appdata.Listener, resolver decoding.DecoderResolver, state decoding.SyncSource, height uint64) error {
+	if target.StartBlock != nil {
+		if err := target.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			return err
+		}
+	}
+
+	if target.OnKVPair != nil {
+		err := resolver.AllDecoders(func(moduleName string, _ schema.ModuleCodec) error {
+			actor, err := resolver.EncodeModuleName(moduleName)
+			if err != nil {
+				return err
+			}
+
+			var changes []schema.KVPairUpdate
+			flush := func() error {
+				if len(changes) == 0 {
+					return nil
+				}
+
+				err := target.OnKVPair(appdata.KVPairData{Updates: []appdata.ActorKVPairUpdate{{
+					Actor:        actor,
+					StateChanges: changes,
+				}}})
+				changes = nil
+				return err
+			}
+
+			err = state.IterateAllKVPairs(moduleName, func(key, value []byte) error {
+				changes = append(changes, schema.KVPairUpdate{Key: key, Value: value})
+				if len(changes) < stateBatchSize {
+					return nil
+				}
+
+				return flush()
+			})
+			if err != nil {
+				return err
+			}
+
+			return flush()
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return commit(target, height)
+}
+
+func backfillBlock(target appdata.Listener, block BlockData) error {
+	if target.StartBlock != nil {
+		if err := target.StartBlock(block.StartBlock); err != nil {
+			return err
+		}
+	}
+
+	if target.OnTx != nil {
+		for _, tx := range block.Txs {
+			if err := target.OnTx(tx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if target.OnEvent != nil && len(block.Events) > 0 {
+		if err := target.OnEvent(appdata.EventData{Events: block.Events}); err != nil {
+			return err
+		}
+	}
+
+	if target.OnKVPair != nil && len(block.StateChanges) > 0 {
+		if err := target.OnKVPair(appdata.KVPairData{Updates: block.StateChanges}); err != nil {
+			return err
+		}
+	}
+
+	return commit(target, block.StartBlock.Height)
+}
+
+// commit commits the target and waits for the commit to complete, so that a failure is reported
+// for the block that caused it.
+func commit(target appdata.Listener, height uint64) error {
+	if target.Commit == nil {
+		return nil
+	}
+
+	cb, err := target.Commit(appdata.CommitData{Height: height})
+	if err != nil {
+		return err
+	}
+
+	if cb != nil {
+		return cb()
+	}
+
+	return nil
+}
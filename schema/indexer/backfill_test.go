@@ -0,0 +1,110 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+)
+
+type testModule struct{}
+
+func (testModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{}, nil
+}
+
+type testState map[string][][2]string
+
+func (s testState) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	for _, kv := range s[moduleName] {
+		if err := fn([]byte(kv[0]), []byte(kv[1])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type testBlocks struct{}
+
+func (testBlocks) Block(_ context.Context, height uint64) (BlockData, error) {
+	if height > 3 {
+		return BlockData{}, fmt.Errorf("block %d not found", height)
+	}
+
+	return BlockData{
+		StartBlock: appdata.StartBlockData{Height: height},
+		Txs:        []appdata.TxData{{TxIndex: 0}},
+		Events:     []appdata.Event{{Type: "transfer"}},
+		StateChanges: []appdata.ActorKVPairUpdate{{
+			Actor:        []byte("bank"),
+			StateChanges: []schema.KVPairUpdate{{Key: []byte{byte(height)}}},
+		}},
+	}, nil
+}
+
+func TestBackfill(t *testing.T) {
+	var calls []string
+	target := appdata.Listener{
+		StartBlock: func(data appdata.StartBlockData) error {
+			calls = append(calls, fmt.Sprintf("start %d", data.Height))
+			return nil
+		},
+		OnTx: func(appdata.TxData) error {
+			calls = append(calls, "tx")
+			return nil
+		},
+		OnEvent: func(data appdata.EventData) error {
+			calls = append(calls, fmt.Sprintf("events %d", len(data.Events)))
+			return nil
+		},
+		OnKVPair: func(data appdata.KVPairData) error {
+			for _, update := range data.Updates {
+				calls = append(calls, fmt.Sprintf("kv %s %d", update.Actor, len(update.StateChanges)))
+			}
+			return nil
+		},
+		Commit: func(appdata.CommitData) (func() error, error) {
+			return func() error {
+				calls = append(calls, "commit")
+				return nil
+			}, nil
+		},
+	}
+
+	err := Backfill(BackfillOptions{
+		Target:        target,
+		Resolver:      decoding.ModuleSetDecoderResolver(map[string]interface{}{"bank": testModule{}, "staking": testModule{}}),
+		InitialState:  testState{"bank": {{"a", "1"}, {"b", "2"}}},
+		InitialHeight: 1,
+		Blocks:        testBlocks{},
+		EndHeight:     3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"start 1", "kv bank 2", "commit",
+		"start 2", "tx", "events 1", "kv bank 1", "commit",
+		"start 3", "tx", "events 1", "kv bank 1", "commit",
+	}
+	if !reflect.DeepEqual(expected, calls) {
+		t.Fatalf("expected %v, got %v", expected, calls)
+	}
+
+	err = Backfill(BackfillOptions{Target: target, Blocks: testBlocks{}, EndHeight: 4})
+	if err == nil || err.Error() != "failed to load block 4: block 4 not found" {
+		t.Fatalf("expected block not found error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = Backfill(BackfillOptions{Target: target, Blocks: testBlocks{}, EndHeight: 3, Context: ctx})
+	if err != context.Canceled {
+		t.Fatalf("expected context canceled error, got %v", err)
+	}
+}
@@ -15,8 +15,39 @@ type Config struct {
 	// Config are the indexer specific config options specified by the user.
 	Config interface{} `mapstructure:"config" toml:"config" json:"config,omitempty" comment:"Indexer specific configuration options."`
 
+	// Critical specifies that the indexer must have persisted a block before the state of that block
+	// can be discarded, e.g. pruned, by the app. The commits of critical indexers are tracked by
+	// IndexingTarget.Checkpoints.
+	Critical bool `mapstructure:"critical" toml:"critical" json:"critical,omitempty" comment:"Whether the app must wait for the indexer to persist a block before pruning the state of that block."`
+
 	// Filter is the filter configuration for the indexer.
 	Filter *FilterConfig `mapstructure:"filter" toml:"filter" json:"filter,omitempty" comment:"Filter configuration for the indexer. Currently UNSUPPORTED!"`
+
+	// StartHeight is the height of the first block indexed by the target. The blocks below it,
+	// and the genesis state when it is set, are skipped.
+	StartHeight uint64 `mapstructure:"start_height" toml:"start_height" json:"start_height,omitempty" comment:"Height of the first block indexed by the target, 0 to index from genesis."`
+
+	// StopHeight is the height of the last block indexed by the target. The blocks above it are
+	// skipped. Zero means that the target has no stop height.
+	StopHeight uint64 `mapstructure:"stop_height" toml:"stop_height" json:"stop_height,omitempty" comment:"Height of the last block indexed by the target, 0 for no stop height."`
+
+	// Retention is the retention policy of the historical data of the indexer, such as its blocks,
+	// transactions and events. It requires the indexer to return a Pruner in its InitResult.
+	Retention *RetentionConfig `mapstructure:"retention" toml:"retention" json:"retention,omitempty" comment:"Retention policy of the historical data of the indexer."`
+}
+
+// RetentionConfig specifies how long an indexer retains its historical data. The indexer manager
+// invokes the Pruner of the indexer after every Interval committed blocks. At least one of
+// KeepLastBlocks and KeepSince must be set, the data being pruned once both allow it.
+type RetentionConfig struct {
+	// KeepLastBlocks is the number of the most recent blocks whose data is retained.
+	KeepLastBlocks uint64 `mapstructure:"keep_last_blocks" toml:"keep_last_blocks" json:"keep_last_blocks,omitempty" comment:"Number of the most recent blocks whose data is retained."`
+
+	// KeepSince is the duration, such as "720h", during which the data is retained.
+	KeepSince string `mapstructure:"keep_since" toml:"keep_since" json:"keep_since,omitempty" comment:"Duration during which the data is retained, such as 720h."`
+
+	// Interval is the number of committed blocks between two prunings. It defaults to 100.
+	Interval uint64 `mapstructure:"interval" toml:"interval" json:"interval,omitempty" comment:"Number of committed blocks between two prunings, 100 by default."`
 }
 
 // FilterConfig specifies the configuration for filtering the data stream
@@ -0,0 +1,73 @@
+package indexer
+
+import "cosmossdk.io/schema/appdata"
+
+// heightRangeListener wraps the listener of an indexer target so that only the blocks in the range
+// [startHeight, stopHeight] are sent to it, a zero stopHeight meaning no stop height. The block callbacks,
+// from StartBlock to Commit, are skipped outside the range, whereas the module initialization and
+// schema upgrade callbacks are always sent.
+func heightRangeListener(listener appdata.Listener, startHeight, stopHeight uint64) appdata.Listener {
+	var blockHeight uint64
+	inRange := func() bool {
+		return blockHeight >= startHeight && (stopHeight == 0 || blockHeight <= stopHeight)
+	}
+
+	startBlock := listener.StartBlock
+	listener.StartBlock = func(data appdata.StartBlockData) error {
+		blockHeight = data.Height
+		if startBlock == nil || !inRange() {
+			return nil
+		}
+		return startBlock(data)
+	}
+
+	if onTx := listener.OnTx; onTx != nil {
+		listener.OnTx = func(data appdata.TxData) error {
+			if !inRange() {
+				return nil
+			}
+			return onTx(data)
+		}
+	}
+
+	if onEvent := listener.OnEvent; onEvent != nil {
+		listener.OnEvent = func(data appdata.EventData) error {
+			if !inRange() {
+				return nil
+			}
+			return onEvent(data)
+		}
+	}
+
+	if onKVPair := listener.OnKVPair; onKVPair != nil {
+		listener.OnKVPair = func(data appdata.KVPairData) error {
+			if !inRange() {
+				return nil
+			}
+			return onKVPair(data)
+		}
+	}
+
+	if onObjectUpdate := listener.OnObjectUpdate; onObjectUpdate != nil {
+		listener.OnObjectUpdate = func(data appdata.ObjectUpdateData) error {
+			if !inRange() {
+				return nil
+			}
+			return onObjectUpdate(data)
+		}
+	}
+
+	if commit := listener.Commit; commit != nil {
+		listener.Commit = func(data appdata.CommitData) (func() error, error) {
+			if data.Height != 0 {
+				blockHeight = data.Height
+			}
+			if !inRange() {
+				return nil, nil
+			}
+			return commit(data)
+		}
+	}
+
+	return listener
+}
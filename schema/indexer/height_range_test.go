@@ -0,0 +1,83 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestHeightRangeListener(t *testing.T) {
+	var calls []string
+	listener := heightRangeListener(appdata.Listener{
+		InitializeModuleData: func(data appdata.ModuleInitializationData) error {
+			calls = append(calls, "init "+data.ModuleName)
+			return nil
+		},
+		StartBlock: func(data appdata.StartBlockData) error {
+			calls = append(calls, "start")
+			return nil
+		},
+		OnTx: func(appdata.TxData) error {
+			calls = append(calls, "tx")
+			return nil
+		},
+		OnObjectUpdate: func(appdata.ObjectUpdateData) error {
+			calls = append(calls, "update")
+			return nil
+		},
+		Commit: func(data appdata.CommitData) (func() error, error) {
+			calls = append(calls, "commit")
+			return nil, nil
+		},
+	}, 2, 3)
+
+	if err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "bank"}); err != nil {
+		t.Fatal(err)
+	}
+	// genesis state
+	if err := listener.OnObjectUpdate(appdata.ObjectUpdateData{}); err != nil {
+		t.Fatal(err)
+	}
+	for height := uint64(1); height <= 4; height++ {
+		if err := listener.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			t.Fatal(err)
+		}
+		if err := listener.OnTx(appdata.TxData{}); err != nil {
+			t.Fatal(err)
+		}
+		if err := listener.OnObjectUpdate(appdata.ObjectUpdateData{}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := listener.Commit(appdata.CommitData{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := []string{
+		"init bank",
+		"start", "tx", "update", "commit",
+		"start", "tx", "update", "commit",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestStartIndexingInvalidHeightRange(t *testing.T) {
+	Register("height_range", Initializer{
+		InitFunc: func(InitParams) (InitResult, error) {
+			return InitResult{}, nil
+		},
+		ConfigType: testConfig{},
+	})
+
+	_, err := StartIndexing(IndexingOptions{
+		Config: IndexingConfig{Target: map[string]Config{
+			"target": {Type: "height_range", Config: testConfig{}, StartHeight: 10, StopHeight: 5},
+		}},
+	})
+	if err == nil {
+		t.Fatal("expected error for a stop height below the start height")
+	}
+}
@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/schema/addressutil"
 	"cosmossdk.io/schema/appdata"
@@ -52,4 +53,31 @@ type InitResult struct {
 	// If the block number is non-zero but does not match the current chain height, a runtime error
 	// will occur because this is an unsafe condition that indicates lost data.
 	View view.AppData
+
+	// Pruner prunes the historical data of the indexer. It is optional and may be nil, but it is
+	// required to configure the retention of the indexer, see Config.Retention.
+	Pruner Pruner
+
+	// StatusReporter reports indexer specific status information which is added to the status of the
+	// indexer target, see IndexingTarget.Status. It is optional and may be nil.
+	StatusReporter StatusReporter
+}
+
+// Pruner is implemented by indexers which can prune their historical data, such as blocks,
+// transactions and events. It is invoked by the indexer manager according to the retention
+// configuration of the indexer, once a block has been committed.
+type Pruner interface {
+	// Prune deletes the historical data which is no longer retained. The data of an indexer which
+	// can't tell the time of its data is pruned by height only.
+	Prune(PruneOptions) error
+}
+
+// PruneOptions are the options passed to Pruner.Prune.
+type PruneOptions struct {
+	// Height is the height below which the data of the blocks is pruned. It is zero when the data
+	// isn't pruned by height.
+	Height uint64
+
+	// Time is the time before which the data is pruned. It is zero when the data isn't pruned by time.
+	Time time.Time
 }
@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+// defaultRetentionInterval is the default number of committed blocks between two prunings.
+const defaultRetentionInterval = 100
+
+// pruningListener wraps the listener of an indexer target so that its pruner is invoked, once a block
+// is committed, after every interval of committed blocks of its retention config. The pruning runs
+// after the completion of the commit of the listener, and its errors are returned as commit errors.
+func pruningListener(listener appdata.Listener, pruner Pruner, retention RetentionConfig, now func() time.Time) (appdata.Listener, error) {
+	var keepSince time.Duration
+	if retention.KeepSince != "" {
+		var err error
+		keepSince, err = time.ParseDuration(retention.KeepSince)
+		if err != nil {
+			return appdata.Listener{}, err
+		}
+		if keepSince <= 0 {
+			return appdata.Listener{}, errors.New("keep since duration must be positive")
+		}
+	}
+	if retention.KeepLastBlocks == 0 && keepSince == 0 {
+		return appdata.Listener{}, errors.New("either keep last blocks or keep since must be set")
+	}
+
+	interval := retention.Interval
+	if interval == 0 {
+		interval = defaultRetentionInterval
+	}
+
+	var blockHeight, lastPruneHeight uint64
+	startBlock := listener.StartBlock
+	listener.StartBlock = func(data appdata.StartBlockData) error {
+		blockHeight = data.Height
+		if startBlock == nil {
+			return nil
+		}
+		return startBlock(data)
+	}
+
+	prune := func(height uint64) error {
+		if height < lastPruneHeight+interval {
+			return nil
+		}
+		lastPruneHeight = height
+
+		var opts PruneOptions
+		if retention.KeepLastBlocks != 0 && height >= retention.KeepLastBlocks {
+			opts.Height = height - retention.KeepLastBlocks + 1
+		}
+		if keepSince != 0 {
+			opts.Time = now().Add(-keepSince)
+		}
+		if opts.Height == 0 && opts.Time.IsZero() {
+			return nil
+		}
+
+		return pruner.Prune(opts)
+	}
+
+	commit := listener.Commit
+	listener.Commit = func(data appdata.CommitData) (func() error, error) {
+		height := data.Height
+		if height == 0 {
+			height = blockHeight
+		}
+
+		var cb func() error
+		if commit != nil {
+			var err error
+			cb, err = commit(data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if cb == nil {
+			return nil, prune(height)
+		}
+
+		return func() error {
+			if err := cb(); err != nil {
+				return err
+			}
+			return prune(height)
+		}, nil
+	}
+
+	return listener, nil
+}
@@ -0,0 +1,120 @@
+package indexer
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+type testPruner []PruneOptions
+
+func (p *testPruner) Prune(opts PruneOptions) error {
+	*p = append(*p, opts)
+	return nil
+}
+
+func TestPruningListener(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	var pruner testPruner
+	var committed []uint64
+	listener, err := pruningListener(appdata.Listener{
+		Commit: func(data appdata.CommitData) (func() error, error) {
+			return func() error {
+				committed = append(committed, data.Height)
+				return nil
+			}, nil
+		},
+	}, &pruner, RetentionConfig{
+		KeepLastBlocks: 5,
+		KeepSince:      "24h",
+		Interval:       3,
+	}, func() time.Time { return now })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for height := uint64(1); height <= 7; height++ {
+		if err := listener.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			t.Fatal(err)
+		}
+		cb, err := listener.Commit(appdata.CommitData{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cb(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(committed) != 7 {
+		t.Fatalf("expected 7 commits, got %v", committed)
+	}
+	yesterday := now.Add(-24 * time.Hour)
+	expected := testPruner{
+		{Height: 0, Time: yesterday},
+		{Height: 2, Time: yesterday},
+	}
+	if !reflect.DeepEqual(pruner, expected) {
+		t.Fatalf("expected prunings %v, got %v", expected, pruner)
+	}
+}
+
+func TestPruningListenerCommitError(t *testing.T) {
+	var pruner testPruner
+	listener, err := pruningListener(appdata.Listener{
+		Commit: func(appdata.CommitData) (func() error, error) {
+			return func() error { return errors.New("commit failed") }, nil
+		},
+	}, &pruner, RetentionConfig{KeepLastBlocks: 1, Interval: 1}, time.Now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cb, err := listener.Commit(appdata.CommitData{Height: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cb(); err == nil {
+		t.Fatal("expected commit error")
+	}
+	if len(pruner) != 0 {
+		t.Fatalf("expected no pruning after a failed commit, got %v", pruner)
+	}
+}
+
+func TestPruningListenerInvalidConfig(t *testing.T) {
+	for _, cfg := range []RetentionConfig{
+		{},
+		{KeepSince: "a month"},
+		{KeepSince: "-1h"},
+	} {
+		if _, err := pruningListener(appdata.Listener{}, &testPruner{}, cfg, time.Now); err == nil {
+			t.Errorf("expected error for %+v", cfg)
+		}
+	}
+}
+
+func TestStartIndexingRetentionWithoutPruner(t *testing.T) {
+	Register("no-pruner", Initializer{
+		InitFunc: func(InitParams) (InitResult, error) {
+			return InitResult{}, nil
+		},
+		ConfigType: testConfig{},
+	})
+
+	_, err := StartIndexing(IndexingOptions{
+		Config: IndexingConfig{Target: map[string]Config{
+			"target": {
+				Type:      "no-pruner",
+				Config:    testConfig{},
+				Retention: &RetentionConfig{KeepLastBlocks: 10},
+			},
+		}},
+	})
+	if err == nil {
+		t.Fatal("expected error for a retention without pruner")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"time"
 
 	"cosmossdk.io/schema/addressutil"
 	"cosmossdk.io/schema/appdata"
@@ -56,6 +57,11 @@ type IndexingConfig struct {
 	// ChannelBufferSize is the buffer size of the channels used for buffering data sent to indexer go routines.
 	// It defaults to 1024.
 	ChannelBufferSize int `mapstructure:"channel_buffer_size" toml:"channel_buffer_size" json:"channel_buffer_size,omitempty" comment:"Buffer size of the channels used for buffering data sent to indexer go routines."`
+
+	// CheckpointTimeout is the duration, such as "10s", during which the app waits for the critical
+	// targets to persist a block before pruning the state of that block. The state is kept until the
+	// next pruning if they did not persist it in time. If it is empty, the app waits without timeout.
+	CheckpointTimeout string `mapstructure:"checkpoint_timeout" toml:"checkpoint_timeout" json:"checkpoint_timeout,omitempty" comment:"How long the app waits for the critical targets to persist a block before pruning its state, such as 10s. Empty to wait without timeout."`
 }
 
 // IndexingTarget returns the indexing target listener and associated data.
@@ -70,6 +76,26 @@ type IndexingTarget struct {
 	ModuleFilter *ModuleFilterConfig
 
 	IndexerInfos map[string]IndexerInfo
+
+	// Checkpoints tracks the heights persisted by the critical indexer targets. An app should wait
+	// for a height to be acknowledged, with a timeout, before pruning the state at that height.
+	// It is nil if no target is critical.
+	Checkpoints *appdata.CheckpointBarrier
+
+	// CheckpointTimeout is the timeout to wait for Checkpoints with, as configured by
+	// IndexingConfig.CheckpointTimeout. Zero means no timeout.
+	CheckpointTimeout time.Duration
+
+	status *statusTracker
+}
+
+// Status returns the health status of the indexer targets by name, so that an app can monitor
+// the indexers without probing their databases.
+func (t IndexingTarget) Status() map[string]TargetStatus {
+	if t.status == nil {
+		return nil
+	}
+	return t.status.status()
 }
 
 // IndexerInfo contains data returned by a specific indexer after initialization that maybe useful for the app.
@@ -93,6 +119,14 @@ func StartIndexing(opts IndexingOptions) (IndexingTarget, error) {
 		return IndexingTarget{}, err
 	}
 
+	var checkpointTimeout time.Duration
+	if cfg.CheckpointTimeout != "" {
+		checkpointTimeout, err = time.ParseDuration(cfg.CheckpointTimeout)
+		if err != nil {
+			return IndexingTarget{}, fmt.Errorf("invalid checkpoint timeout %q: %v", cfg.CheckpointTimeout, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
 	ctx := opts.Context
 	if ctx == nil {
 		ctx = context.Background()
@@ -100,6 +134,8 @@ func StartIndexing(opts IndexingOptions) (IndexingTarget, error) {
 
 	listeners := make([]appdata.Listener, 0, len(cfg.Target))
 	indexerInfos := make(map[string]IndexerInfo, len(cfg.Target))
+	var checkpoints *appdata.CheckpointBarrier
+	status := newStatusTracker()
 
 	for targetName, targetCfg := range cfg.Target {
 		init, ok := indexerRegistry[targetCfg.Type]
@@ -113,6 +149,10 @@ func StartIndexing(opts IndexingOptions) (IndexingTarget, error) {
 			return IndexingTarget{}, fmt.Errorf("indexer filter options are not supported yet")
 		}
 
+		if targetCfg.StopHeight != 0 && targetCfg.StopHeight < targetCfg.StartHeight {
+			return IndexingTarget{}, fmt.Errorf("stop height %d of target %q is below its start height %d", targetCfg.StopHeight, targetName, targetCfg.StartHeight)
+		}
+
 		childLogger := logger
 		if scopeableLogger, ok := logger.(logutil.ScopeableLogger); ok {
 			childLogger = scopeableLogger.WithContext("indexer", targetName).(logutil.Logger)
@@ -133,7 +173,28 @@ func StartIndexing(opts IndexingOptions) (IndexingTarget, error) {
 			return IndexingTarget{}, err
 		}
 
-		listener := initRes.Listener
+		listener := schemaUpgradeListener(initRes.Listener, initRes.View)
+		if targetCfg.Retention != nil {
+			if initRes.Pruner == nil {
+				return IndexingTarget{}, fmt.Errorf("indexer target %q doesn't support retention, indexer type %q has no pruner", targetName, targetCfg.Type)
+			}
+			listener, err = pruningListener(listener, initRes.Pruner, *targetCfg.Retention, time.Now)
+			if err != nil {
+				return IndexingTarget{}, fmt.Errorf("invalid retention config for target %q: %v", targetName, err) //nolint:errorlint // using %v for go 1.12 compat
+			}
+		}
+		if targetCfg.StartHeight != 0 || targetCfg.StopHeight != 0 {
+			listener = heightRangeListener(listener, targetCfg.StartHeight, targetCfg.StopHeight)
+		}
+		if targetCfg.Critical {
+			if checkpoints == nil {
+				checkpoints = appdata.NewCheckpointBarrier()
+			}
+			// the listener is wrapped before being made asynchronous so that the commits are
+			// acknowledged once the indexer has processed them
+			listener = checkpoints.Listener(targetName, listener)
+		}
+		listener = status.targetListener(targetName, targetCfg.Type, initRes.StatusReporter, listener)
 		listeners = append(listeners, listener)
 
 		indexerInfos[targetName] = IndexerInfo{
@@ -161,10 +222,14 @@ func StartIndexing(opts IndexingOptions) (IndexingTarget, error) {
 		return IndexingTarget{}, err
 	}
 	rootListener = appdata.AsyncListener(asyncOpts, rootListener)
+	rootListener = status.sourceListener(rootListener)
 
 	return IndexingTarget{
-		Listener:     rootListener,
-		IndexerInfos: indexerInfos,
+		Listener:          rootListener,
+		IndexerInfos:      indexerInfos,
+		Checkpoints:       checkpoints,
+		CheckpointTimeout: checkpointTimeout,
+		status:            status,
 	}, nil
 }
 
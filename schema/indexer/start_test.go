@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"cosmossdk.io/schema/appdata"
 )
@@ -79,6 +80,58 @@ func TestStart(t *testing.T) {
 	}
 }
 
+func TestStartCriticalTarget(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+	Register("test_critical", Initializer{
+		InitFunc: func(params InitParams) (InitResult, error) {
+			return InitResult{Listener: appdata.Listener{}}, nil
+		},
+		ConfigType: testConfig{},
+	})
+
+	target, err := StartIndexing(IndexingOptions{
+		Config: IndexingConfig{Target: map[string]Config{
+			"t1": {Type: "test_critical", Config: testConfig{}},
+		}},
+		Context: ctx,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Checkpoints != nil {
+		t.Fatal("expected no checkpoints without critical targets")
+	}
+
+	target, err = StartIndexing(IndexingOptions{
+		Config: IndexingConfig{Target: map[string]Config{
+			"t1": {Type: "test_critical", Config: testConfig{}},
+			"t2": {Type: "test_critical", Config: testConfig{}, Critical: true},
+		}},
+		Context: ctx,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Checkpoints == nil {
+		t.Fatal("expected checkpoints")
+	}
+
+	for height := uint64(1); height <= 3; height++ {
+		if err := target.Listener.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			t.Fatal(err)
+		}
+		callCommit(t, target.Listener)
+	}
+
+	if err := target.Checkpoints.Wait(ctx, 3, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if checkpoint := target.Checkpoints.Checkpoint(); checkpoint != 3 {
+		t.Fatalf("expected checkpoint 3, got %d", checkpoint)
+	}
+}
+
 func callCommit(t *testing.T, listener appdata.Listener) {
 	t.Helper()
 	cb, err := listener.Commit(appdata.CommitData{})
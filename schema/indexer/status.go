@@ -0,0 +1,216 @@
+package indexer
+
+import (
+	"sync"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+// TargetStatus is the health status of an indexer target, as tracked by the indexer manager.
+type TargetStatus struct {
+	// Type is the indexer type of the target.
+	Type string `json:"type"`
+
+	// LastHeight is the height of the last block committed by the target.
+	LastHeight uint64 `json:"last_height"`
+
+	// Lag is the number of blocks committed by the app which the target hasn't committed yet.
+	Lag uint64 `json:"lag"`
+
+	// ErrorCount is the number of errors returned by the target's listener.
+	ErrorCount uint64 `json:"error_count"`
+
+	// LastError is the last error returned by the target's listener, if any.
+	LastError string `json:"last_error,omitempty"`
+
+	// Details is the indexer specific status returned by the StatusReporter of the target, if any.
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// StatusReporter is implemented by indexers which can report indexer specific status information,
+// such as the size of their database, in addition to the status tracked by the indexer manager.
+type StatusReporter interface {
+	// Status returns the indexer specific status. It is called concurrently with the listener
+	// of the indexer and must be safe for concurrent use.
+	Status() map[string]interface{}
+}
+
+// statusTracker tracks the status of the indexer targets.
+type statusTracker struct {
+	mu sync.Mutex
+	// height is the height of the last block committed by the app
+	height  uint64
+	targets map[string]*targetStatus
+}
+
+type targetStatus struct {
+	status   TargetStatus
+	reporter StatusReporter
+}
+
+func newStatusTracker() *statusTracker {
+	return &statusTracker{targets: map[string]*targetStatus{}}
+}
+
+// sourceListener wraps the root listener to track the height of the blocks committed by the app.
+func (t *statusTracker) sourceListener(listener appdata.Listener) appdata.Listener {
+	var blockHeight uint64
+	startBlock := listener.StartBlock
+	listener.StartBlock = func(data appdata.StartBlockData) error {
+		blockHeight = data.Height
+		if startBlock == nil {
+			return nil
+		}
+		return startBlock(data)
+	}
+
+	commit := listener.Commit
+	listener.Commit = func(data appdata.CommitData) (func() error, error) {
+		height := data.Height
+		if height == 0 {
+			height = blockHeight
+		}
+		t.mu.Lock()
+		t.height = height
+		t.mu.Unlock()
+
+		if commit == nil {
+			return nil, nil
+		}
+		return commit(data)
+	}
+
+	return listener
+}
+
+// targetListener wraps the listener of an indexer target to track the height of the blocks it
+// committed and the errors it returned.
+func (t *statusTracker) targetListener(name, indexerType string, reporter StatusReporter, listener appdata.Listener) appdata.Listener {
+	target := &targetStatus{
+		status:   TargetStatus{Type: indexerType},
+		reporter: reporter,
+	}
+	t.mu.Lock()
+	t.targets[name] = target
+	t.mu.Unlock()
+
+	track := func(err error) error {
+		if err != nil {
+			t.mu.Lock()
+			target.status.ErrorCount++
+			target.status.LastError = err.Error()
+			t.mu.Unlock()
+		}
+		return err
+	}
+
+	if initializeModuleData := listener.InitializeModuleData; initializeModuleData != nil {
+		listener.InitializeModuleData = func(data appdata.ModuleInitializationData) error {
+			return track(initializeModuleData(data))
+		}
+	}
+
+	if onSchemaUpgrade := listener.OnSchemaUpgrade; onSchemaUpgrade != nil {
+		listener.OnSchemaUpgrade = func(data appdata.SchemaUpgradeData) error {
+			return track(onSchemaUpgrade(data))
+		}
+	}
+
+	var blockHeight uint64
+	startBlock := listener.StartBlock
+	listener.StartBlock = func(data appdata.StartBlockData) error {
+		blockHeight = data.Height
+		if startBlock == nil {
+			return nil
+		}
+		return track(startBlock(data))
+	}
+
+	if onTx := listener.OnTx; onTx != nil {
+		listener.OnTx = func(data appdata.TxData) error {
+			return track(onTx(data))
+		}
+	}
+
+	if onEvent := listener.OnEvent; onEvent != nil {
+		listener.OnEvent = func(data appdata.EventData) error {
+			return track(onEvent(data))
+		}
+	}
+
+	if onKVPair := listener.OnKVPair; onKVPair != nil {
+		listener.OnKVPair = func(data appdata.KVPairData) error {
+			return track(onKVPair(data))
+		}
+	}
+
+	if onObjectUpdate := listener.OnObjectUpdate; onObjectUpdate != nil {
+		listener.OnObjectUpdate = func(data appdata.ObjectUpdateData) error {
+			return track(onObjectUpdate(data))
+		}
+	}
+
+	committed := func(height uint64) {
+		t.mu.Lock()
+		target.status.LastHeight = height
+		t.mu.Unlock()
+	}
+
+	commit := listener.Commit
+	listener.Commit = func(data appdata.CommitData) (func() error, error) {
+		height := data.Height
+		if height == 0 {
+			height = blockHeight
+		}
+
+		var cb func() error
+		if commit != nil {
+			var err error
+			cb, err = commit(data)
+			if err != nil {
+				return nil, track(err)
+			}
+		}
+		if cb == nil {
+			committed(height)
+			return nil, nil
+		}
+
+		return func() error {
+			if err := cb(); err != nil {
+				return track(err)
+			}
+			committed(height)
+			return nil
+		}, nil
+	}
+
+	return listener
+}
+
+// status returns the status of the indexer targets by name.
+func (t *statusTracker) status() map[string]TargetStatus {
+	t.mu.Lock()
+	res := make(map[string]TargetStatus, len(t.targets))
+	reporters := make(map[string]StatusReporter, len(t.targets))
+	for name, target := range t.targets {
+		status := target.status
+		if t.height > status.LastHeight {
+			status.Lag = t.height - status.LastHeight
+		}
+		res[name] = status
+		if target.reporter != nil {
+			reporters[name] = target.reporter
+		}
+	}
+	t.mu.Unlock()
+
+	// the reporters are called without holding the lock as they may be slow
+	for name, reporter := range reporters {
+		status := res[name]
+		status.Details = reporter.Status()
+		res[name] = status
+	}
+
+	return res
+}
@@ -0,0 +1,79 @@
+package indexer
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"cosmossdk.io/schema/appdata"
+)
+
+type testStatusReporter struct{}
+
+func (testStatusReporter) Status() map[string]interface{} {
+	return map[string]interface{}{"rows": 10}
+}
+
+func TestStatusTracker(t *testing.T) {
+	tracker := newStatusTracker()
+	source := tracker.sourceListener(appdata.Listener{})
+
+	var commitCalled int
+	target := tracker.targetListener("t1", "test", testStatusReporter{}, appdata.Listener{
+		OnTx: func(data appdata.TxData) error {
+			if data.TxIndex == 1 {
+				return errors.New("tx error")
+			}
+			return nil
+		},
+		Commit: func(appdata.CommitData) (func() error, error) {
+			commitCalled++
+			return func() error { return nil }, nil
+		},
+	})
+	tracker.targetListener("t2", "test", nil, appdata.Listener{})
+
+	for height := uint64(1); height <= 3; height++ {
+		if err := source.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := source.Commit(appdata.CommitData{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for height := uint64(1); height <= 2; height++ {
+		if err := target.StartBlock(appdata.StartBlockData{Height: height}); err != nil {
+			t.Fatal(err)
+		}
+		_ = target.OnTx(appdata.TxData{TxIndex: int32(height)})
+		cb, err := target.Commit(appdata.CommitData{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cb(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if commitCalled != 2 {
+		t.Fatalf("expected 2 commits, got %d", commitCalled)
+	}
+
+	expected := map[string]TargetStatus{
+		"t1": {
+			Type:       "test",
+			LastHeight: 2,
+			Lag:        1,
+			ErrorCount: 1,
+			LastError:  "tx error",
+			Details:    map[string]interface{}{"rows": 10},
+		},
+		"t2": {
+			Type: "test",
+			Lag:  3,
+		},
+	}
+	if got := tracker.status(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,73 @@
+package indexer
+
+import (
+	"fmt"
+
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/diff"
+	"cosmossdk.io/schema/view"
+)
+
+// schemaUpgradeListener wraps the listener of an indexer target so that OnSchemaUpgrade is called
+// before a module is initialized with a schema which differs from the schema persisted by the target,
+// as reported by its view, or whose version is greater. A module schema version lower than the persisted
+// one is rejected. The listener is returned unchanged when the target has no view or doesn't
+// listen to schema upgrades.
+func schemaUpgradeListener(listener appdata.Listener, appView view.AppData) appdata.Listener {
+	if appView == nil || listener.OnSchemaUpgrade == nil || listener.InitializeModuleData == nil {
+		return listener
+	}
+
+	var blockHeight uint64
+	startBlock := listener.StartBlock
+	listener.StartBlock = func(data appdata.StartBlockData) error {
+		blockHeight = data.Height
+		if startBlock == nil {
+			return nil
+		}
+		return startBlock(data)
+	}
+
+	initializeModuleData := listener.InitializeModuleData
+	onSchemaUpgrade := listener.OnSchemaUpgrade
+	listener.InitializeModuleData = func(data appdata.ModuleInitializationData) error {
+		appState := appView.AppState()
+		if appState == nil {
+			return initializeModuleData(data)
+		}
+
+		modState, err := appState.GetModule(data.ModuleName)
+		if err != nil {
+			return fmt.Errorf("failed to get the indexed state of module %s: %v", data.ModuleName, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+		if modState == nil {
+			return initializeModuleData(data)
+		}
+
+		oldSchema := modState.ModuleSchema()
+		if data.Schema.Version() < oldSchema.Version() {
+			return fmt.Errorf("schema version %d of module %s is lower than the indexed version %d",
+				data.Schema.Version(), data.ModuleName, oldSchema.Version())
+		}
+
+		// the schema of a module may also change without a version increase, e.g. when the
+		// module doesn't version its schema
+		schemaDiff := diff.CompareModuleSchemas(oldSchema, data.Schema)
+		if data.Schema.Version() > oldSchema.Version() || !schemaDiff.Empty() {
+			err = onSchemaUpgrade(appdata.SchemaUpgradeData{
+				ModuleName: data.ModuleName,
+				Height:     blockHeight,
+				OldSchema:  oldSchema,
+				NewSchema:  data.Schema,
+				Diff:       schemaDiff,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to upgrade the schema of module %s to version %d: %v", data.ModuleName, data.Schema.Version(), err) //nolint:errorlint // using %v for go 1.12 compat
+			}
+		}
+
+		return initializeModuleData(data)
+	}
+
+	return listener
+}
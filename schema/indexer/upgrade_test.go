@@ -0,0 +1,138 @@
+package indexer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/view"
+)
+
+type testView map[string]schema.ModuleSchema
+
+func (v testView) BlockNum() (uint64, error) { return 1, nil }
+
+func (v testView) AppState() view.AppState { return v }
+
+func (v testView) GetModule(moduleName string) (view.ModuleState, error) {
+	modSchema, ok := v[moduleName]
+	if !ok {
+		return nil, nil
+	}
+	return testModuleState{name: moduleName, schema: modSchema}, nil
+}
+
+func (v testView) Modules(func(view.ModuleState, error) bool) {}
+
+func (v testView) NumModules() (int, error) { return len(v), nil }
+
+type testModuleState struct {
+	view.ModuleState
+	name   string
+	schema schema.ModuleSchema
+}
+
+func (m testModuleState) ModuleName() string { return m.name }
+
+func (m testModuleState) ModuleSchema() schema.ModuleSchema { return m.schema }
+
+func TestSchemaUpgradeListener(t *testing.T) {
+	oldSchema := schema.MustCompileModuleSchema(schema.StateObjectType{
+		Name:      "balances",
+		KeyFields: []schema.Field{{Name: "address", Kind: schema.StringKind}},
+	}).WithVersion(1)
+	newSchema := schema.MustCompileModuleSchema(schema.StateObjectType{
+		Name:        "balances",
+		KeyFields:   []schema.Field{{Name: "address", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{{Name: "amount", Kind: schema.Int64Kind, Nullable: true}},
+	}).WithVersion(2)
+
+	var calls []string
+	var upgrade appdata.SchemaUpgradeData
+	listener := schemaUpgradeListener(appdata.Listener{
+		InitializeModuleData: func(data appdata.ModuleInitializationData) error {
+			calls = append(calls, "InitializeModuleData "+data.ModuleName)
+			return nil
+		},
+		OnSchemaUpgrade: func(data appdata.SchemaUpgradeData) error {
+			calls = append(calls, "OnSchemaUpgrade "+data.ModuleName)
+			upgrade = data
+			return nil
+		},
+	}, testView{"bank": oldSchema, "staking": newSchema})
+
+	if err := listener.StartBlock(appdata.StartBlockData{Height: 10}); err != nil {
+		t.Fatal(err)
+	}
+
+	// bank is upgraded, staking is unchanged and gov is initialized for the first time
+	for _, moduleName := range []string{"bank", "staking", "gov"} {
+		err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: moduleName, Schema: newSchema})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expectedCalls := []string{
+		"OnSchemaUpgrade bank",
+		"InitializeModuleData bank",
+		"InitializeModuleData staking",
+		"InitializeModuleData gov",
+	}
+	if !reflect.DeepEqual(calls, expectedCalls) {
+		t.Fatalf("expected calls %v, got %v", expectedCalls, calls)
+	}
+
+	if upgrade.Height != 10 || upgrade.OldSchema.Version() != 1 || upgrade.NewSchema.Version() != 2 {
+		t.Fatalf("unexpected schema upgrade data %+v", upgrade)
+	}
+	if len(upgrade.Diff.ChangedStateObjectTypes) != 1 || !upgrade.Diff.HasCompatibleChanges() {
+		t.Fatalf("unexpected schema diff %+v", upgrade.Diff)
+	}
+
+	err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: "staking", Schema: oldSchema})
+	if err == nil || !strings.Contains(err.Error(), "lower than the indexed version") {
+		t.Fatalf("expected a schema downgrade error, got %v", err)
+	}
+}
+
+func TestSchemaUpgradeListenerUnversioned(t *testing.T) {
+	oldSchema := schema.MustCompileModuleSchema(schema.StateObjectType{
+		Name:      "params",
+		KeyFields: []schema.Field{{Name: "name", Kind: schema.StringKind}},
+	})
+	newSchema := schema.MustCompileModuleSchema(schema.StateObjectType{
+		Name:      "params",
+		KeyFields: []schema.Field{{Name: "name", Kind: schema.StringKind}},
+	}, schema.StateObjectType{
+		Name:      "votes",
+		KeyFields: []schema.Field{{Name: "voter", Kind: schema.StringKind}},
+	})
+
+	var upgrades []appdata.SchemaUpgradeData
+	listener := schemaUpgradeListener(appdata.Listener{
+		InitializeModuleData: func(appdata.ModuleInitializationData) error { return nil },
+		OnSchemaUpgrade: func(data appdata.SchemaUpgradeData) error {
+			upgrades = append(upgrades, data)
+			return nil
+		},
+	}, testView{"gov": oldSchema, "mint": oldSchema})
+
+	// the schema of gov changed without a version increase, mint is unchanged
+	for moduleName, modSchema := range map[string]schema.ModuleSchema{"gov": newSchema, "mint": oldSchema} {
+		err := listener.InitializeModuleData(appdata.ModuleInitializationData{ModuleName: moduleName, Schema: modSchema})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(upgrades) != 1 || upgrades[0].ModuleName != "gov" {
+		t.Fatalf("expected a schema upgrade of gov, got %+v", upgrades)
+	}
+	added := upgrades[0].Diff.AddedStateObjectTypes
+	if len(added) != 1 || added[0].Name != "votes" {
+		t.Fatalf("unexpected schema diff %+v", upgrades[0].Diff)
+	}
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"regexp"
 	"time"
 	"unicode/utf8"
@@ -178,12 +179,13 @@ const (
 	Float64Kind
 
 	// AddressKind represents an account address which is represented by a variable length array of bytes.
-	// Addresses usually have a human-readable rendering, such as bech32, and tooling should provide
-	// a way for apps to define a string encoder for friendly user-facing display. Addresses have a maximum
+	// The kind makes no assumption on the human-readable rendering of addresses, which depends on the chain,
+	// such as bech32 with a chain specific prefix or hex for EVM addresses. Tooling should let apps provide
+	// the string encoding of their addresses, see addressutil.AddressCodec. Addresses have a maximum
 	// supported length of 63 bytes.
 	// Go Encoding: []byte
-	// JSON Encoding: addresses should be encoded as strings using the human-readable address renderer
-	// provided to the JSON encoder.
+	// JSON Encoding: addresses should be encoded as strings using the address codec provided to the
+	// JSON encoder.
 	// Key Binary Encoding:
 	//   non-terminal: bytes prefixed with 1-byte length prefix
 	//   terminal: raw bytes with no length prefix
@@ -206,10 +208,44 @@ const (
 	// Value Binary Encoding: string encoding
 	JSONKind
 
+	// Int128Kind represents a 128-bit signed integer, commonly used for amounts.
+	// Go Encoding: string which matches the IntegerFormat regex, canonically encoded with no leading zeros
+	// and no negative zero, and in the range [-2^127, 2^127-1].
+	// JSON Encoding: base10 integer string, canonically encoded.
+	// Key Binary Encoding: 16-byte two's complement big-endian encoding, with the first bit inverted for sorting.
+	// Value Binary Encoding: 16-byte two's complement little-endian encoding.
+	Int128Kind
+
+	// Uint128Kind represents a 128-bit unsigned integer, commonly used for coin amounts.
+	// Go Encoding: string which matches the IntegerFormat regex, canonically encoded with no leading zeros,
+	// and in the range [0, 2^128-1].
+	// JSON Encoding: base10 integer string, canonically encoded.
+	// Key Binary Encoding: 16-byte unsigned big-endian encoding.
+	// Value Binary Encoding: 16-byte unsigned little-endian encoding.
+	Uint128Kind
+
+	// CoinKind represents an amount of a coin denomination.
+	// Go Encoding: Coin, where Denom matches the DenomFormat regex and Amount is a non-negative base10 integer
+	// string matching the IntegerFormat regex, canonically encoded with no leading zeros.
+	// JSON Encoding: an object with the "denom" string and "amount" base10 integer string fields.
+	// Key Binary Encoding: not valid as a key field.
+	// Value Binary Encoding: the denom with the value binary encoding of StringKind followed by the amount
+	// with the value binary encoding of StringKind.
+	CoinKind
+
+	// CoinsKind represents a set of coins of distinct denominations, such as a balance or fees.
+	// Go Encoding: []Coin, where each coin is a valid CoinKind value with a positive amount and the coins
+	// are sorted by strictly increasing denom.
+	// JSON Encoding: an array of CoinKind JSON objects.
+	// Key Binary Encoding: not valid as a key field.
+	// Value Binary Encoding: 32-bit unsigned little-endian count of the number of coins followed by each
+	// coin encoded with the value binary encoding of CoinKind.
+	CoinsKind
+
 	// UIntNKind represents a signed integer type with a width in bits specified by the Size field in the
 	// field definition.
 	// Support for this is currently UNIMPLEMENTED, this notice will be removed when it is added.
-	// N must be a multiple of 8, and it is invalid for N to equal 8, 16, 32, 64 as there are more specific
+	// N must be a multiple of 8, and it is invalid for N to equal 8, 16, 32, 64, 128 as there are more specific
 	// types for these widths.
 	// Go Encoding: []byte where len([]byte) == Size / 8, little-endian encoded.
 	// JSON Encoding: base10 integer string matching the IntegerFormat regex, canonically with no leading zeros.
@@ -220,7 +256,7 @@ const (
 	// IntNKind represents an unsigned integer type with a width in bits specified by the Size field in the
 	// field definition. N must be a multiple of 8.
 	// Support for this is currently UNIMPLEMENTED, this notice will be removed when it is added.
-	// N must be a multiple of 8, and it is invalid for N to equal 8, 16, 32, 64 as there are more specific
+	// N must be a multiple of 8, and it is invalid for N to equal 8, 16, 32, 64, 128 as there are more specific
 	// types for these widths.
 	// Go Encoding: []byte where len([]byte) == Size / 8, two's complement little-endian encoded.
 	// JSON Encoding: base10 integer string matching the IntegerFormat regex, canonically with no leading zeros.
@@ -260,7 +296,7 @@ const (
 )
 
 // MAX_VALID_KIND is the maximum valid kind value.
-const MAX_VALID_KIND = JSONKind
+const MAX_VALID_KIND = CoinsKind
 
 const (
 	// IntegerFormat is a regex that describes the format integer number strings must match. It specifies
@@ -272,6 +308,10 @@ const (
 	// exponent of up to 2 digits. These restrictions ensure that the decimal can be accurately represented
 	// by a wide variety of implementations.
 	DecimalFormat = `^-?[0-9]{1,50}(\.[0-9]{1,50})?([eE][-+]?[0-9]{1,2})?$`
+
+	// DenomFormat is a regex that describes the format coin denominations must match. It is the default
+	// denomination format of the Cosmos SDK.
+	DenomFormat = `^[a-zA-Z][a-zA-Z0-9/:._-]{2,127}$`
 )
 
 // Validate returns an errContains if the kind is invalid.
@@ -279,7 +319,7 @@ func (t Kind) Validate() error {
 	if t <= InvalidKind {
 		return fmt.Errorf("unknown type: %d", t)
 	}
-	if t > JSONKind {
+	if t > MAX_VALID_KIND {
 		return fmt.Errorf("invalid type: %d", t)
 	}
 	return nil
@@ -328,6 +368,14 @@ func (t Kind) String() string {
 		return "enum"
 	case JSONKind:
 		return "json"
+	case Int128Kind:
+		return "int128"
+	case Uint128Kind:
+		return "uint128"
+	case CoinKind:
+		return "coin"
+	case CoinsKind:
+		return "coins"
 	default:
 		return fmt.Sprintf("invalid(%d)", t)
 	}
@@ -441,6 +489,21 @@ func (t Kind) ValidateValueType(value interface{}) error {
 		if !ok {
 			return fmt.Errorf("expected json.RawMessage, got %T", value)
 		}
+	case Int128Kind, Uint128Kind:
+		_, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected string, got %T", value)
+		}
+	case CoinKind:
+		_, ok := value.(Coin)
+		if !ok {
+			return fmt.Errorf("expected Coin, got %T", value)
+		}
+	case CoinsKind:
+		_, ok := value.([]Coin)
+		if !ok {
+			return fmt.Errorf("expected []Coin, got %T", value)
+		}
 	default:
 		return fmt.Errorf("invalid type: %d", t)
 	}
@@ -448,8 +511,8 @@ func (t Kind) ValidateValueType(value interface{}) error {
 }
 
 // ValidateValue returns an errContains if the value does not conform to the expected go type and format.
-// It is more thorough, but slower, than Kind.ValidateValueType and validates that Integer, Decimal and JSON
-// values are formatted correctly. It cannot validate enum values because Kind's do not have enum schemas.
+// It is more thorough, but slower, than Kind.ValidateValueType and validates that Integer, Decimal, JSON
+// and coin values are formatted correctly. It cannot validate enum values because Kind's do not have enum schemas.
 func (t Kind) ValidateValue(value interface{}) error {
 	err := t.ValidateValueType(value)
 	if err != nil {
@@ -481,18 +544,45 @@ func (t Kind) ValidateValue(value interface{}) error {
 		if !json.Valid(value.(json.RawMessage)) {
 			return fmt.Errorf("expected valid JSON, got %s", value)
 		}
+	case Int128Kind:
+		return validateInt128(value.(string), minInt128, maxInt128)
+	case Uint128Kind:
+		return validateInt128(value.(string), big.NewInt(0), maxUint128)
+	case CoinKind:
+		return value.(Coin).Validate()
+	case CoinsKind:
+		return validateCoins(value.([]Coin))
 	default:
 		return nil
 	}
 	return nil
 }
 
+var (
+	minInt128  = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	maxInt128  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+)
+
+// validateInt128 validates that the string is a canonically encoded base10 integer in the range [lo, hi].
+func validateInt128(str string, lo, hi *big.Int) error {
+	if !canonicalIntegerRegex.MatchString(str) {
+		return fmt.Errorf("expected canonical base10 integer, got %s", str)
+	}
+
+	i, ok := new(big.Int).SetString(str, 10)
+	if !ok || i.Cmp(lo) < 0 || i.Cmp(hi) > 0 {
+		return fmt.Errorf("expected integer in the range [%s, %s], got %s", lo, hi, str)
+	}
+	return nil
+}
+
 // ValidKeyKind returns true if the kind is a valid key kind.
-// All kinds except Float32Kind, Float64Kind, and JSONKind are valid key kinds
-// because they do not define a strict form of equality.
+// All kinds except Float32Kind, Float64Kind, JSONKind, CoinKind and CoinsKind are valid key kinds
+// because they do not define a strict form of equality or a key binary encoding.
 func (t Kind) ValidKeyKind() bool {
 	switch t {
-	case Float32Kind, Float64Kind, JSONKind:
+	case Float32Kind, Float64Kind, JSONKind, CoinKind, CoinsKind:
 		return false
 	default:
 		return true
@@ -502,6 +592,10 @@ func (t Kind) ValidKeyKind() bool {
 var (
 	integerRegex = regexp.MustCompile(IntegerFormat)
 	decimalRegex = regexp.MustCompile(DecimalFormat)
+	denomRegex   = regexp.MustCompile(DenomFormat)
+
+	// canonicalIntegerRegex matches integers with no leading zeros and no negative zero
+	canonicalIntegerRegex = regexp.MustCompile(`^(0|-?[1-9][0-9]{0,99})$`)
 )
 
 // KindForGoValue finds the simplest kind that can represent the given go value. It will not, however,
@@ -541,6 +635,10 @@ func KindForGoValue(value interface{}) Kind {
 		return DurationKind
 	case json.RawMessage:
 		return JSONKind
+	case Coin:
+		return CoinKind
+	case []Coin:
+		return CoinsKind
 	default:
 		return InvalidKind
 	}
@@ -55,6 +55,14 @@ func TestKind_ValidateValueType(t *testing.T) {
 		{kind: Uint64Kind, value: uint32(1), valid: false},
 		{kind: IntegerKind, value: "1", valid: true},
 		{kind: IntegerKind, value: int32(1), valid: false},
+		{kind: Int128Kind, value: "-1", valid: true},
+		{kind: Int128Kind, value: int64(1), valid: false},
+		{kind: Uint128Kind, value: "1", valid: true},
+		{kind: Uint128Kind, value: uint64(1), valid: false},
+		{kind: CoinKind, value: Coin{Denom: "uatom", Amount: "1"}, valid: true},
+		{kind: CoinKind, value: "1uatom", valid: false},
+		{kind: CoinsKind, value: []Coin{{Denom: "uatom", Amount: "1"}}, valid: true},
+		{kind: CoinsKind, value: Coin{Denom: "uatom", Amount: "1"}, valid: false},
 		{kind: DecimalKind, value: "1.0", valid: true},
 		{kind: DecimalKind, value: "1", valid: true},
 		{kind: DecimalKind, value: "1.1e4", valid: true},
@@ -174,6 +182,35 @@ func TestKind_ValidateValue(t *testing.T) {
 		{JSONKind, json.RawMessage(`tru`), false},
 		{JSONKind, json.RawMessage(`[`), false},
 		{JSONKind, json.RawMessage(`{`), false},
+		{Int128Kind, "0", true},
+		{Int128Kind, "-42", true},
+		{Int128Kind, "170141183460469231731687303715884105727", true},  // 2^127-1
+		{Int128Kind, "-170141183460469231731687303715884105728", true}, // -2^127
+		{Int128Kind, "170141183460469231731687303715884105728", false},
+		{Int128Kind, "-170141183460469231731687303715884105729", false},
+		{Int128Kind, "01", false}, // leading zeros aren't canonical
+		{Int128Kind, "-0", false},
+		{Int128Kind, "1.0", false},
+		{Int128Kind, "", false},
+		{Uint128Kind, "0", true},
+		{Uint128Kind, "340282366920938463463374607431768211455", true}, // 2^128-1
+		{Uint128Kind, "340282366920938463463374607431768211456", false},
+		{Uint128Kind, "-1", false},
+		{Uint128Kind, "007", false},
+		{Uint128Kind, "abc", false},
+		{CoinKind, Coin{Denom: "uatom", Amount: "0"}, true},
+		{CoinKind, Coin{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Amount: "1000000"}, true},
+		{CoinKind, Coin{Denom: "uatom", Amount: "-1"}, false},
+		{CoinKind, Coin{Denom: "uatom", Amount: "010"}, false},
+		{CoinKind, Coin{Denom: "uatom", Amount: ""}, false},
+		{CoinKind, Coin{Denom: "1atom", Amount: "1"}, false},
+		{CoinKind, Coin{Denom: "at", Amount: "1"}, false},
+		{CoinsKind, []Coin{}, true},
+		{CoinsKind, []Coin{{Denom: "stake", Amount: "10"}, {Denom: "uatom", Amount: "1"}}, true},
+		{CoinsKind, []Coin{{Denom: "uatom", Amount: "1"}, {Denom: "stake", Amount: "10"}}, false}, // unsorted
+		{CoinsKind, []Coin{{Denom: "uatom", Amount: "1"}, {Denom: "uatom", Amount: "2"}}, false},  // duplicate
+		{CoinsKind, []Coin{{Denom: "uatom", Amount: "0"}}, false},
+		{CoinsKind, []Coin{{Denom: "uatom", Amount: "1.5"}}, false},
 	}
 
 	for i, tt := range tests {
@@ -214,6 +251,10 @@ func TestKind_String(t *testing.T) {
 		{JSONKind, "json"},
 		{EnumKind, "enum"},
 		{AddressKind, "address"},
+		{Int128Kind, "int128"},
+		{Uint128Kind, "uint128"},
+		{CoinKind, "coin"},
+		{CoinsKind, "coins"},
 		{InvalidKind, "invalid(0)"},
 	}
 	for i, tt := range tests {
@@ -246,6 +287,8 @@ func TestKindForGoValue(t *testing.T) {
 		{time.Now(), TimeKind},
 		{time.Second, DurationKind},
 		{json.RawMessage("{}"), JSONKind},
+		{Coin{Denom: "uatom", Amount: "1"}, CoinKind},
+		{[]Coin{{Denom: "uatom", Amount: "1"}}, CoinsKind},
 		{map[string]interface{}{"a": 1}, InvalidKind},
 	}
 	for i, tt := range tests {
@@ -290,6 +333,10 @@ func TestKindJSON(t *testing.T) {
 		{JSONKind, `"json"`, false},
 		{EnumKind, `"enum"`, false},
 		{AddressKind, `"address"`, false},
+		{Int128Kind, `"int128"`, false},
+		{Uint128Kind, `"uint128"`, false},
+		{CoinKind, `"coin"`, false},
+		{CoinsKind, `"coins"`, false},
 		{InvalidKind, `""`, true},
 		{Kind(100), `""`, true},
 	}
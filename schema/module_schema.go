@@ -8,7 +8,8 @@ import (
 
 // ModuleSchema represents the logical schema of a module for purposes of indexing and querying.
 type ModuleSchema struct {
-	types map[string]Type
+	types   map[string]Type
+	version uint32
 }
 
 // CompileModuleSchema compiles the types into a ModuleSchema and validates it.
@@ -44,6 +45,19 @@ func MustCompileModuleSchema(types ...Type) ModuleSchema {
 	return sch
 }
 
+// Version returns the version of the module schema. See WithVersion.
+func (s ModuleSchema) Version() uint32 {
+	return s.version
+}
+
+// WithVersion returns a copy of the module schema with the given version. Modules should
+// increase the version of their schema whenever it changes, usually in an upgrade, so that
+// indexers can migrate the data they persisted with the previous schema.
+func (s ModuleSchema) WithVersion(version uint32) ModuleSchema {
+	s.version = version
+	return s
+}
+
 // Validate validates the module schema.
 func (s ModuleSchema) Validate() error {
 	for _, typ := range s.types {
@@ -71,6 +85,17 @@ func (s ModuleSchema) ValidateObjectUpdate(update StateObjectUpdate) error {
 	return objTyp.ValidateObjectUpdate(update, s)
 }
 
+// ConvertObjectUpdate converts the key and value of the update with the ValueCodec's of
+// the fields of its object type. See StateObjectType.ConvertObjectUpdate.
+func (s ModuleSchema) ConvertObjectUpdate(update StateObjectUpdate) (StateObjectUpdate, error) {
+	objTyp, ok := s.LookupStateObjectType(update.TypeName)
+	if !ok {
+		return update, fmt.Errorf("object type %q not found in module schema", update.TypeName)
+	}
+
+	return objTyp.ConvertObjectUpdate(update)
+}
+
 // LookupType looks up a type by name in the module schema.
 func (s ModuleSchema) LookupType(name string) (Type, bool) {
 	typ, ok := s.types[name]
@@ -141,15 +166,16 @@ func (s ModuleSchema) EnumTypes(f func(EnumType) bool) {
 }
 
 type moduleSchemaJson struct {
+	Version     uint32            `json:"version,omitempty"`
 	ObjectTypes []StateObjectType `json:"object_types"`
 	EnumTypes   []EnumType        `json:"enum_types"`
 }
 
 // MarshalJSON implements the json.Marshaler interface for ModuleSchema.
 // It marshals the module schema into a JSON object with the object types and enum types
-// under the keys "object_types" and "enum_types" respectively.
+// under the keys "object_types" and "enum_types" respectively, and its version, if any, under the key "version".
 func (s ModuleSchema) MarshalJSON() ([]byte, error) {
-	asJson := moduleSchemaJson{}
+	asJson := moduleSchemaJson{Version: s.version}
 
 	s.StateObjectTypes(func(objType StateObjectType) bool {
 		asJson.ObjectTypes = append(asJson.ObjectTypes, objType)
@@ -185,6 +211,7 @@ func (s *ModuleSchema) UnmarshalJSON(data []byte) error {
 	}
 
 	s.types = types
+	s.version = asJson.Version
 
 	// validate adds all enum types to the type map
 	err = s.Validate()
@@ -335,3 +335,29 @@ func TestModuleSchemaJSON(t *testing.T) {
 		t.Fatalf("expected %v, got %v", moduleSchema, moduleSchema2)
 	}
 }
+
+func TestModuleSchemaVersionJSON(t *testing.T) {
+	moduleSchema := exampleSchema(t).WithVersion(3)
+	if moduleSchema.Version() != 3 {
+		t.Fatalf("expected version 3, got %d", moduleSchema.Version())
+	}
+
+	b, err := moduleSchema.MarshalJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(string(b), `{"version":3,`) {
+		t.Fatalf("expected version in JSON, got %s", string(b))
+	}
+
+	var moduleSchema2 ModuleSchema
+	err = moduleSchema2.UnmarshalJSON(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(moduleSchema, moduleSchema2) {
+		t.Fatalf("expected %v, got %v", moduleSchema, moduleSchema2)
+	}
+}
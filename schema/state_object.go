@@ -12,8 +12,8 @@ type StateObjectType struct {
 	// It can be empty, in which case, indexers should assume that this object is
 	// a singleton and only has one value. Field names must be unique within the
 	// object between both key and value fields.
-	// Key fields CANNOT be nullable and Float32Kind, Float64Kind, JSONKind, StructKind,
-	// OneOfKind, RepeatedKind, ListKind or ObjectKind
+	// Key fields CANNOT be nullable and Float32Kind, Float64Kind, JSONKind, CoinKind, CoinsKind,
+	// StructKind, OneOfKind, RepeatedKind, ListKind or ObjectKind
 	// are NOT ALLOWED.
 	// It is an INCOMPATIBLE change to add, remove or change fields in the key as this
 	// changes the underlying primary key of the object.
@@ -80,8 +80,8 @@ func DiffFieldValues(field schema.Field, expected, actual any) string {
 
 // CompareKindValues compares the expected and actual values for the provided kind and returns true if they are equal,
 // false if they are not, and an error if the types are not valid for the kind.
-// For IntegerKind and DecimalKind values, comparisons are made based on equality of the underlying numeric
-// values rather than their string encoding.
+// For IntegerKind, Int128Kind, Uint128Kind and DecimalKind values, and the amounts of CoinKind and CoinsKind values,
+// comparisons are made based on equality of the underlying numeric values rather than their string encoding.
 func CompareKindValues(kind schema.Kind, expected, actual any) (bool, error) {
 	if kind.ValidateValueType(expected) != nil {
 		return false, fmt.Errorf("unexpected type %T for kind %s", expected, kind)
@@ -96,21 +96,21 @@ func CompareKindValues(kind schema.Kind, expected, actual any) (bool, error) {
 		if !bytes.Equal(expected.([]byte), actual.([]byte)) {
 			return false, nil
 		}
-	case schema.IntegerKind:
-		expectedInt := big.NewInt(0)
-		expectedInt, ok := expectedInt.SetString(expected.(string), 10)
-		if !ok {
-			return false, fmt.Errorf("could not convert %v to big.Int", expected)
-		}
-
-		actualInt := big.NewInt(0)
-		actualInt, ok = actualInt.SetString(actual.(string), 10)
-		if !ok {
-			return false, fmt.Errorf("could not convert %v to big.Int", actual)
+	case schema.IntegerKind, schema.Int128Kind, schema.Uint128Kind:
+		return compareIntegers(expected.(string), actual.(string))
+	case schema.CoinKind:
+		return compareCoins(expected.(schema.Coin), actual.(schema.Coin))
+	case schema.CoinsKind:
+		expectedCoins, actualCoins := expected.([]schema.Coin), actual.([]schema.Coin)
+		if len(expectedCoins) != len(actualCoins) {
+			return false, nil
 		}
 
-		if expectedInt.Cmp(actualInt) != 0 {
-			return false, nil
+		for i := range expectedCoins {
+			eq, err := compareCoins(expectedCoins[i], actualCoins[i])
+			if !eq || err != nil {
+				return eq, err
+			}
 		}
 	case schema.DecimalKind:
 		expectedDec, _, err := apd.NewFromString(expected.(string))
@@ -133,3 +133,25 @@ func CompareKindValues(kind schema.Kind, expected, actual any) (bool, error) {
 	}
 	return true, nil
 }
+
+func compareIntegers(expected, actual string) (bool, error) {
+	expectedInt, ok := new(big.Int).SetString(expected, 10)
+	if !ok {
+		return false, fmt.Errorf("could not convert %v to big.Int", expected)
+	}
+
+	actualInt, ok := new(big.Int).SetString(actual, 10)
+	if !ok {
+		return false, fmt.Errorf("could not convert %v to big.Int", actual)
+	}
+
+	return expectedInt.Cmp(actualInt) == 0, nil
+}
+
+func compareCoins(expected, actual schema.Coin) (bool, error) {
+	if expected.Denom != actual.Denom {
+		return false, nil
+	}
+
+	return compareIntegers(expected.Amount, actual.Amount)
+}
@@ -132,8 +132,11 @@ var ExampleAppSchema = map[string]schema.ModuleSchema{
 
 func mkAllKindsModule() schema.ModuleSchema {
 	types := []schema.Type{testEnum}
-	for i := 1; i < int(schema.MAX_VALID_KIND); i++ {
+	for i := 1; i <= int(schema.MAX_VALID_KIND); i++ {
 		kind := schema.Kind(i)
+		if kind == schema.JSONKind {
+			continue
+		}
 		typ := mkTestObjectType(kind)
 		types = append(types, typ)
 	}
@@ -2,7 +2,9 @@ package schematesting
 
 import (
 	"fmt"
+	"math/big"
 	"slices"
+	"strings"
 	"time"
 
 	"pgregory.net/rapid"
@@ -11,10 +13,12 @@ import (
 )
 
 var (
-	kindGen = rapid.Map(rapid.IntRange(int(schema.InvalidKind+1), int(schema.MAX_VALID_KIND-1)),
+	kindGen = rapid.Map(rapid.IntRange(int(schema.InvalidKind+1), int(schema.MAX_VALID_KIND)),
 		func(i int) schema.Kind {
 			return schema.Kind(i)
-		})
+		}).Filter(func(kind schema.Kind) bool {
+		return kind != schema.JSONKind
+	})
 	boolGen = rapid.Bool()
 )
 
@@ -97,6 +101,21 @@ func baseFieldValue(field schema.Field, typeSet schema.TypeSet) *rapid.Generator
 		return rapid.StringMatching(schema.IntegerFormat).AsAny()
 	case schema.DecimalKind:
 		return rapid.StringMatching(schema.DecimalFormat).AsAny()
+	case schema.Int128Kind:
+		return int128Gen(true).AsAny()
+	case schema.Uint128Kind:
+		return int128Gen(false).AsAny()
+	case schema.CoinKind:
+		return coinGen(false).AsAny()
+	case schema.CoinsKind:
+		return rapid.Map(rapid.SliceOfNDistinct(coinGen(true), 0, 5, func(c schema.Coin) string {
+			return c.Denom
+		}), func(coins []schema.Coin) []schema.Coin {
+			slices.SortFunc(coins, func(a, b schema.Coin) int {
+				return strings.Compare(a.Denom, b.Denom)
+			})
+			return coins
+		}).AsAny()
 	case schema.BoolKind:
 		return rapid.Bool().AsAny()
 	case schema.TimeKind:
@@ -197,3 +216,28 @@ func ObjectValueGen(valueFields []schema.Field, forUpdate bool, typeSet schema.T
 		}
 	})
 }
+
+// int128Gen generates canonically encoded 128-bit integers, signed or unsigned.
+func int128Gen(signed bool) *rapid.Generator[string] {
+	return rapid.Map(rapid.SliceOfN(rapid.Byte(), 16, 16), func(bz []byte) string {
+		i := new(big.Int).SetBytes(bz)
+		if signed {
+			i.Sub(i, new(big.Int).Lsh(big.NewInt(1), 127))
+		}
+		return i.String()
+	})
+}
+
+// coinGen generates valid coins with amounts of up to 256 bits, which are positive if positive is true.
+func coinGen(positive bool) *rapid.Generator[schema.Coin] {
+	return rapid.Custom(func(t *rapid.T) schema.Coin {
+		amount := new(big.Int).SetBytes(rapid.SliceOfN(rapid.Byte(), 0, 32).Draw(t, "amount"))
+		if positive {
+			amount.Add(amount, big.NewInt(1))
+		}
+		return schema.Coin{
+			Denom:  rapid.StringMatching(schema.DenomFormat).Draw(t, "denom"),
+			Amount: amount.String(),
+		}
+	})
+}
@@ -0,0 +1,427 @@
+package indexertest
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/logutil"
+	schematesting "cosmossdk.io/schema/testing"
+	"cosmossdk.io/schema/testing/appdatasim"
+	"cosmossdk.io/schema/testing/statesim"
+	"cosmossdk.io/schema/view"
+)
+
+// Options are the options for running the conformance tests against an indexer target.
+type Options struct {
+	// NewTarget creates a new indexer target backed by empty storage and returns its initialization function.
+	// It is called once per test. The returned function may be called several times within a test to simulate
+	// restarts of the target, in which case it must reopen the same storage. The InitResult returned by the target
+	// must include a View, which is used to check the indexed data. NewTarget is required.
+	NewTarget func(t *testing.T) indexer.InitFunc
+
+	// Config is the target specific configuration passed to the initialization function in InitParams.Config.Config.
+	Config interface{}
+
+	// AppSchema is the app schema to simulate. It must include at least two modules.
+	// If it is nil, then schematesting.ExampleAppSchema will be used.
+	AppSchema map[string]schema.ModuleSchema
+
+	// StateSimOptions are the options of the state simulator, they should match the capabilities of the target.
+	StateSimOptions statesim.Options
+
+	// NumBlocks is the number of blocks simulated by each test. It defaults to 20.
+	NumBlocks int
+
+	// Logger is the logger passed to the target. It is optional.
+	Logger logutil.Logger
+}
+
+// RunConformanceTests runs all the conformance tests against the indexer target as subtests.
+func RunConformanceTests(t *testing.T, opts Options) {
+	t.Helper()
+
+	t.Run("OutOfOrderInit", func(t *testing.T) { OutOfOrderInit(t, opts) })
+	t.Run("CatchUpSync", func(t *testing.T) { CatchUpSync(t, opts) })
+	t.Run("DuplicateBlockDelivery", func(t *testing.T) { DuplicateBlockDelivery(t, opts) })
+	t.Run("Filters", func(t *testing.T) { Filters(t, opts) })
+}
+
+// OutOfOrderInit checks that the target handles modules initialized in any order, including modules initialized
+// in the middle of the stream, after other modules were indexed for several blocks, e.g. modules added by an upgrade.
+func OutOfOrderInit(t *testing.T, opts Options) {
+	t.Helper()
+	opts = opts.withDefaults()
+
+	target := startTarget(t, opts, nil, nil)
+	sim := newSimulator(t, opts, target.sendPacket)
+
+	modules := sortedModuleNames(opts.AppSchema)
+	sort.Sort(sort.Reverse(sort.StringSlice(modules)))
+	initial, late := modules[:len(modules)/2], modules[len(modules)/2:]
+
+	for _, moduleName := range initial {
+		require.NoError(t, sim.ProcessPacket(moduleInitializationData(opts, moduleName)))
+	}
+
+	blockDataGen := sim.BlockDataGenN(1, 20)
+	for i := 0; i < opts.NumBlocks; i++ {
+		blockData := blockDataGen.Example(i)
+
+		if i == opts.NumBlocks/2 {
+			// the late modules are initialized after StartBlock, as done by upgrades adding modules
+			require.NoError(t, sim.ProcessPacket(blockData[0]))
+			for _, moduleName := range late {
+				require.NoError(t, sim.ProcessPacket(moduleInitializationData(opts, moduleName)))
+			}
+			blockData = blockData[1:]
+		}
+
+		require.NoError(t, sim.ProcessBlockData(blockData))
+		target.requireEqual(sim)
+	}
+}
+
+// CatchUpSync checks that a target started on an app with existing state can be synced with the state of the app,
+// as done by decoding.Sync, and then index the following blocks.
+func CatchUpSync(t *testing.T, opts Options) {
+	t.Helper()
+	opts = opts.withDefaults()
+
+	forwarder := &packetForwarder{}
+	sim := newSimulator(t, opts, forwarder.sendPacket)
+	for _, moduleName := range sortedModuleNames(opts.AppSchema) {
+		require.NoError(t, sim.ProcessPacket(moduleInitializationData(opts, moduleName)))
+	}
+
+	blockDataGen := sim.BlockDataGenN(1, 20)
+	for i := 0; i < opts.NumBlocks/2; i++ {
+		require.NoError(t, sim.ProcessBlockData(blockDataGen.Example(i)))
+	}
+
+	target := startTarget(t, opts, nil, nil)
+	blockNum, err := target.view.BlockNum()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), blockNum, "a new target must start at block 0 to be synced")
+
+	var syncErr error
+	sim.AppState().Modules(func(modState view.ModuleState, err error) bool {
+		if err == nil {
+			err = syncModule(target, modState)
+		}
+		syncErr = err
+		return err == nil
+	})
+	require.NoError(t, syncErr)
+
+	forwarder.target = target
+	for i := opts.NumBlocks / 2; i < opts.NumBlocks; i++ {
+		require.NoError(t, sim.ProcessBlockData(blockDataGen.Example(i)))
+		target.requireEqual(sim)
+	}
+}
+
+// DuplicateBlockDelivery checks that the target handles blocks delivered again after being committed,
+// both while running and after a restart, which happens when the app crashes after the target committed
+// a block but before the app committed it.
+func DuplicateBlockDelivery(t *testing.T, opts Options) {
+	t.Helper()
+	opts = opts.withDefaults()
+
+	forwarder := &packetForwarder{}
+	forwarder.target = startTarget(t, opts, nil, nil)
+	sim := newSimulator(t, opts, forwarder.sendPacket)
+	for _, moduleName := range sortedModuleNames(opts.AppSchema) {
+		require.NoError(t, sim.ProcessPacket(moduleInitializationData(opts, moduleName)))
+	}
+
+	blockDataGen := sim.BlockDataGenN(1, 20)
+	for i := 0; i < opts.NumBlocks; i++ {
+		blockData := blockDataGen.Example(i)
+		require.NoError(t, sim.ProcessBlockData(blockData))
+		forwarder.target.requireEqual(sim)
+
+		if i == opts.NumBlocks/2 {
+			// restart the target, which must resume from the last committed block
+			forwarder.target.stop()
+			forwarder.target = startTarget(t, opts, forwarder.target.initFunc, nil)
+
+			blockNum, err := forwarder.target.view.BlockNum()
+			require.NoError(t, err)
+			require.Equal(t, uint64(i+1), blockNum, "a restarted target must resume from the last committed block")
+
+			// modules are initialized again on each start
+			for _, moduleName := range sortedModuleNames(opts.AppSchema) {
+				require.NoError(t, forwarder.target.sendPacket(moduleInitializationData(opts, moduleName)))
+			}
+		}
+
+		if i%2 == 0 || i == opts.NumBlocks/2 {
+			for _, packet := range blockData {
+				require.NoError(t, forwarder.target.sendPacket(packet))
+			}
+			forwarder.target.requireEqual(sim)
+		}
+	}
+}
+
+// Filters checks that the target handles the data streams filtered according to the filter configurations of
+// the indexer manager. Each filter configuration is tested against a new target.
+func Filters(t *testing.T, opts Options) {
+	t.Helper()
+	opts = opts.withDefaults()
+
+	modules := sortedModuleNames(opts.AppSchema)
+	testCases := []struct {
+		name   string
+		filter indexer.FilterConfig
+	}{
+		{
+			name:   "include modules",
+			filter: indexer.FilterConfig{Modules: &indexer.ModuleFilterConfig{Include: modules[:1]}},
+		},
+		{
+			name:   "exclude modules",
+			filter: indexer.FilterConfig{Modules: &indexer.ModuleFilterConfig{Exclude: modules[:1]}},
+		},
+		{
+			name:   "exclude state",
+			filter: indexer.FilterConfig{ExcludeState: true},
+		},
+		{
+			name:   "exclude block headers, events and txs",
+			filter: indexer.FilterConfig{ExcludeBlockHeaders: true, ExcludeEvents: true, ExcludeTxs: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			target := startTarget(t, opts, nil, &tc.filter)
+
+			// the expected simulator only receives the filtered packets and forwards them to the target
+			expected := newSimulator(t, opts, target.sendPacket)
+			source := newSimulator(t, opts, func(packet appdata.Packet) error {
+				packet, ok := filterPacket(tc.filter, packet)
+				if !ok {
+					return nil
+				}
+				return expected.ProcessPacket(packet)
+			})
+
+			for _, moduleName := range modules {
+				require.NoError(t, source.ProcessPacket(moduleInitializationData(opts, moduleName)))
+			}
+
+			blockDataGen := source.BlockDataGenN(1, 20)
+			for i := 0; i < opts.NumBlocks; i++ {
+				require.NoError(t, source.ProcessBlockData(blockDataGen.Example(i)))
+				target.requireEqual(expected)
+			}
+		})
+	}
+}
+
+// filterPacket applies the filter configuration to the packet as the indexer manager does, and returns
+// the filtered packet, or false if the packet is filtered out.
+func filterPacket(filter indexer.FilterConfig, packet appdata.Packet) (appdata.Packet, bool) {
+	switch packet := packet.(type) {
+	case appdata.ModuleInitializationData:
+		return packet, !filter.ExcludeState && includeModule(filter.Modules, packet.ModuleName)
+	case appdata.ObjectUpdateData:
+		return packet, !filter.ExcludeState && includeModule(filter.Modules, packet.ModuleName)
+	case appdata.KVPairData:
+		if filter.ExcludeState {
+			return packet, false
+		}
+		updates := make([]appdata.ActorKVPairUpdate, 0, len(packet.Updates))
+		for _, update := range packet.Updates {
+			if includeModule(filter.Modules, string(update.Actor)) {
+				updates = append(updates, update)
+			}
+		}
+		return appdata.KVPairData{Updates: updates}, len(updates) > 0
+	case appdata.EventData:
+		return packet, !filter.ExcludeEvents
+	case appdata.TxData:
+		return packet, !filter.ExcludeTxs
+	case appdata.StartBlockData:
+		if filter.ExcludeBlockHeaders {
+			packet.HeaderBytes = nil
+			packet.HeaderJSON = nil
+		}
+		return packet, true
+	default:
+		return packet, true
+	}
+}
+
+func includeModule(filter *indexer.ModuleFilterConfig, moduleName string) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.Include) > 0 {
+		return containsString(filter.Include, moduleName)
+	}
+
+	return !containsString(filter.Exclude, moduleName)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (opts Options) withDefaults() Options {
+	if opts.AppSchema == nil {
+		opts.AppSchema = schematesting.ExampleAppSchema
+	}
+	if opts.NumBlocks == 0 {
+		opts.NumBlocks = 20
+	}
+	return opts
+}
+
+func sortedModuleNames(appSchema map[string]schema.ModuleSchema) []string {
+	modules := make([]string, 0, len(appSchema))
+	for moduleName := range appSchema {
+		modules = append(modules, moduleName)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+func moduleInitializationData(opts Options, moduleName string) appdata.ModuleInitializationData {
+	return appdata.ModuleInitializationData{ModuleName: moduleName, Schema: opts.AppSchema[moduleName]}
+}
+
+// newSimulator creates a simulator without initialized modules, sending its packets to the given function.
+func newSimulator(t *testing.T, opts Options, sendPacket func(appdata.Packet) error) *appdatasim.Simulator {
+	t.Helper()
+
+	sim, err := appdatasim.NewSimulator(appdatasim.Options{
+		Listener:        appdata.PacketForwarder(sendPacket),
+		StateSimOptions: opts.StateSimOptions,
+	})
+	require.NoError(t, err)
+	return sim
+}
+
+// syncModule sends the module initialization data and all the objects of the module state to the target.
+func syncModule(target *targetInstance, modState view.ModuleState) error {
+	err := target.sendPacket(appdata.ModuleInitializationData{
+		ModuleName: modState.ModuleName(),
+		Schema:     modState.ModuleSchema(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var syncErr error
+	modState.ObjectCollections(func(objColl view.ObjectCollection, err error) bool {
+		if err != nil {
+			syncErr = err
+			return false
+		}
+
+		var updates []schema.StateObjectUpdate
+		objColl.AllState(func(update schema.StateObjectUpdate, err error) bool {
+			if err != nil {
+				syncErr = err
+				return false
+			}
+			if !update.Delete {
+				updates = append(updates, update)
+			}
+			return true
+		})
+		if syncErr != nil || len(updates) == 0 {
+			return syncErr == nil
+		}
+
+		syncErr = target.sendPacket(appdata.ObjectUpdateData{ModuleName: modState.ModuleName(), Updates: updates})
+		return syncErr == nil
+	})
+	return syncErr
+}
+
+// packetForwarder forwards packets to a target, which can be changed or unset.
+type packetForwarder struct {
+	target *targetInstance
+}
+
+func (f *packetForwarder) sendPacket(packet appdata.Packet) error {
+	if f.target == nil {
+		return nil
+	}
+	return f.target.sendPacket(packet)
+}
+
+// targetInstance is a running instance of the target, whose listener is run asynchronously as done by
+// the indexer manager.
+type targetInstance struct {
+	t        *testing.T
+	initFunc indexer.InitFunc
+	listener appdata.Listener
+	view     view.AppData
+	cancel   context.CancelFunc
+}
+
+// startTarget starts an instance of the target with the given filter configuration, which may be nil.
+// If initFunc is nil, a new target is created.
+func startTarget(t *testing.T, opts Options, initFunc indexer.InitFunc, filter *indexer.FilterConfig) *targetInstance {
+	t.Helper()
+	require.NotNil(t, opts.NewTarget, "NewTarget is required")
+
+	if initFunc == nil {
+		initFunc = opts.NewTarget(t)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	res, err := initFunc(indexer.InitParams{
+		Config: indexer.Config{
+			Type:   "conformance",
+			Config: opts.Config,
+			Filter: filter,
+		},
+		Context:      ctx,
+		Logger:       opts.Logger,
+		AddressCodec: addressutil.HexAddressCodec{},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res.View, "the target must provide a View to run the conformance tests")
+
+	return &targetInstance{
+		t:        t,
+		initFunc: initFunc,
+		listener: appdata.AsyncListener(appdata.AsyncListenerOptions{Context: ctx}, res.Listener),
+		view:     res.View,
+		cancel:   cancel,
+	}
+}
+
+func (ti *targetInstance) sendPacket(packet appdata.Packet) error {
+	return ti.listener.SendPacket(packet)
+}
+
+func (ti *targetInstance) stop() {
+	ti.cancel()
+}
+
+// requireEqual checks that the data indexed by the target matches the expected data.
+func (ti *targetInstance) requireEqual(expected view.AppData) {
+	ti.t.Helper()
+	require.Empty(ti.t, appdatasim.DiffAppData(expected, ti.view))
+}
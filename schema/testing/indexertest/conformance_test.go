@@ -0,0 +1,68 @@
+package indexertest
+
+import (
+	"testing"
+
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/schema/testing/statesim"
+	"cosmossdk.io/schema/view"
+)
+
+// memoryStorage is the storage of an in-memory indexer target, which survives restarts of the target.
+type memoryStorage struct {
+	state    *statesim.App
+	blockNum uint64
+}
+
+func (s *memoryStorage) AppState() view.AppState {
+	return s.state
+}
+
+func (s *memoryStorage) BlockNum() (uint64, error) {
+	return s.blockNum, nil
+}
+
+// newMemoryTarget returns an in-memory indexer target, which skips the blocks that were already committed.
+func newMemoryTarget(*testing.T) indexer.InitFunc {
+	storage := &memoryStorage{state: statesim.NewApp(nil, statesim.Options{})}
+
+	return func(indexer.InitParams) (indexer.InitResult, error) {
+		var (
+			height  uint64
+			skipped bool
+		)
+
+		listener := appdata.Listener{
+			InitializeModuleData: func(data appdata.ModuleInitializationData) error {
+				if mod, err := storage.state.GetModule(data.ModuleName); err != nil || mod != nil {
+					return err
+				}
+				return storage.state.InitializeModule(data)
+			},
+			StartBlock: func(data appdata.StartBlockData) error {
+				height = data.Height
+				skipped = data.Height <= storage.blockNum
+				return nil
+			},
+			OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+				if skipped {
+					return nil
+				}
+				return storage.state.ApplyUpdate(data)
+			},
+			Commit: func(appdata.CommitData) (func() error, error) {
+				if !skipped {
+					storage.blockNum = height
+				}
+				return nil, nil
+			},
+		}
+
+		return indexer.InitResult{Listener: listener, View: storage}, nil
+	}
+}
+
+func TestRunConformanceTests(t *testing.T) {
+	RunConformanceTests(t, Options{NewTarget: newMemoryTarget})
+}
@@ -0,0 +1,3 @@
+// Package indexertest contains a conformance test suite that indexer targets can run to verify that they handle
+// the app data streams produced by the indexer manager correctly, including its edge cases.
+package indexertest
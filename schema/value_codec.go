@@ -0,0 +1,156 @@
+package schema
+
+import "fmt"
+
+// ValueCodec converts the raw values produced by a module decoder into the canonical
+// representation of a field's kind, so that each decoder does not need to reimplement
+// the conversion of common types. For instance, a ValueCodec can convert an integer type
+// which implements fmt.Stringer into the string expected by IntegerKind, or strip
+// the padding from a key.
+type ValueCodec interface {
+	// ToSchemaValue converts a raw non-nil value into a value of the field's kind.
+	ToSchemaValue(value interface{}) (interface{}, error)
+}
+
+// ValueCodecFunc is a function which implements ValueCodec.
+type ValueCodecFunc func(value interface{}) (interface{}, error)
+
+// ToSchemaValue implements the ValueCodec interface.
+func (f ValueCodecFunc) ToSchemaValue(value interface{}) (interface{}, error) {
+	return f(value)
+}
+
+// StringerValueCodec is a ValueCodec which converts values implementing fmt.Stringer to
+// strings, which is the canonical representation of the IntegerKind, DecimalKind, Int128Kind,
+// Uint128Kind and EnumKind kinds. Strings are returned unchanged.
+type StringerValueCodec struct{}
+
+// ToSchemaValue implements the ValueCodec interface.
+func (StringerValueCodec) ToSchemaValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case fmt.Stringer:
+		return value.String(), nil
+	default:
+		return nil, fmt.Errorf("expected fmt.Stringer, got %T", value)
+	}
+}
+
+// ConvertValue converts a raw value to the field's kind with the field's ValueCodec.
+// The value is returned unchanged if it is nil or if the field has no ValueCodec.
+func (c Field) ConvertValue(value interface{}) (interface{}, error) {
+	if value == nil || c.ValueCodec == nil {
+		return value, nil
+	}
+
+	converted, err := c.ValueCodec.ToSchemaValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert value of type %T for field %q: %v", value, c.Name, err) //nolint:errorlint // false positive due to using go1.12
+	}
+	return converted, nil
+}
+
+// ConvertObjectKey converts the raw key of a StateObjectUpdate with the ValueCodec's of the key fields.
+// See StateObjectUpdate.Key for documentation on the format of such keys.
+func ConvertObjectKey(keyFields []Field, value interface{}) (interface{}, error) {
+	return convertFieldsValue(keyFields, value)
+}
+
+// ConvertObjectValue converts the raw value of a StateObjectUpdate with the ValueCodec's of the value fields.
+// See StateObjectUpdate.Value for documentation on the format of such values.
+func ConvertObjectValue(valueFields []Field, value interface{}) (interface{}, error) {
+	if !hasValueCodec(valueFields) {
+		return value, nil
+	}
+
+	valueUpdates, ok := value.(ValueUpdates)
+	if !ok {
+		return convertFieldsValue(valueFields, value)
+	}
+
+	fieldsByName := make(map[string]Field, len(valueFields))
+	for _, field := range valueFields {
+		fieldsByName[field.Name] = field
+	}
+
+	converted := MapValueUpdates{}
+	var convertErr error
+	err := valueUpdates.Iterate(func(fieldName string, value interface{}) bool {
+		field, ok := fieldsByName[fieldName]
+		if !ok {
+			// unknown fields are left to the validation
+			converted[fieldName] = value
+			return true
+		}
+
+		converted[fieldName], convertErr = field.ConvertValue(value)
+		return convertErr == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if convertErr != nil {
+		return nil, convertErr
+	}
+
+	return converted, nil
+}
+
+// ConvertObjectUpdate converts the key and value of the update with the ValueCodec's of the object
+// type's fields. The update is returned unchanged if none of the fields have a ValueCodec.
+func (o StateObjectType) ConvertObjectUpdate(update StateObjectUpdate) (StateObjectUpdate, error) {
+	var err error
+	update.Key, err = ConvertObjectKey(o.KeyFields, update.Key)
+	if err != nil {
+		return update, fmt.Errorf("invalid key for object type %q: %v", update.TypeName, err) //nolint:errorlint // false positive due to using go1.12
+	}
+
+	if update.Delete {
+		return update, nil
+	}
+
+	update.Value, err = ConvertObjectValue(o.ValueFields, update.Value)
+	if err != nil {
+		return update, fmt.Errorf("invalid value for object type %q: %v", update.TypeName, err) //nolint:errorlint // false positive due to using go1.12
+	}
+	return update, nil
+}
+
+func convertFieldsValue(fields []Field, value interface{}) (interface{}, error) {
+	if !hasValueCodec(fields) {
+		return value, nil
+	}
+
+	if len(fields) == 1 {
+		return fields[0].ConvertValue(value)
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected slice of values for fields, got %T", value)
+	}
+
+	if len(fields) != len(values) {
+		return nil, fmt.Errorf("expected %d fields, got %d values", len(fields), len(values))
+	}
+
+	converted := make([]interface{}, len(values))
+	for i, field := range fields {
+		var err error
+		converted[i], err = field.ConvertValue(values[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+func hasValueCodec(fields []Field) bool {
+	for _, field := range fields {
+		if field.ValueCodec != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testInt struct{ i int64 }
+
+func (t testInt) String() string { return fmt.Sprintf("%d", t.i) }
+
+var trimPaddingCodec = ValueCodecFunc(func(value interface{}) (interface{}, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected string, got %T", value)
+	}
+	return strings.TrimLeft(s, "0"), nil
+})
+
+var testCodecObjectType = StateObjectType{
+	Name: "object",
+	KeyFields: []Field{
+		{Name: "key1", Kind: StringKind, ValueCodec: trimPaddingCodec},
+		{Name: "key2", Kind: Int32Kind},
+	},
+	ValueFields: []Field{
+		{Name: "value1", Kind: IntegerKind, ValueCodec: StringerValueCodec{}},
+		{Name: "value2", Kind: StringKind, Nullable: true, ValueCodec: trimPaddingCodec},
+	},
+}
+
+func TestStateObjectType_ConvertObjectUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		update      StateObjectUpdate
+		expected    StateObjectUpdate
+		errContains string
+	}{
+		{
+			name: "values",
+			update: StateObjectUpdate{
+				TypeName: "object",
+				Key:      []interface{}{"00abc", int32(1)},
+				Value:    []interface{}{testInt{-10}, nil},
+			},
+			expected: StateObjectUpdate{
+				TypeName: "object",
+				Key:      []interface{}{"abc", int32(1)},
+				Value:    []interface{}{"-10", nil},
+			},
+		},
+		{
+			name: "value updates",
+			update: StateObjectUpdate{
+				TypeName: "object",
+				Key:      []interface{}{"abc", int32(1)},
+				Value:    MapValueUpdates{"value2": "007"},
+			},
+			expected: StateObjectUpdate{
+				TypeName: "object",
+				Key:      []interface{}{"abc", int32(1)},
+				Value:    MapValueUpdates{"value2": "7"},
+			},
+		},
+		{
+			name: "delete",
+			update: StateObjectUpdate{
+				TypeName: "object",
+				Key:      []interface{}{"0abc", int32(1)},
+				Delete:   true,
+			},
+			expected: StateObjectUpdate{
+				TypeName: "object",
+				Key:      []interface{}{"abc", int32(1)},
+				Delete:   true,
+			},
+		},
+		{
+			name: "invalid key",
+			update: StateObjectUpdate{
+				TypeName: "object",
+				Key:      []interface{}{1, int32(1)},
+			},
+			errContains: `cannot convert value of type int for field "key1"`,
+		},
+		{
+			name: "invalid value update",
+			update: StateObjectUpdate{
+				TypeName: "object",
+				Key:      []interface{}{"abc", int32(1)},
+				Value:    MapValueUpdates{"value1": 10},
+			},
+			errContains: "expected fmt.Stringer, got int",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testCodecObjectType.ConvertObjectUpdate(tt.update)
+			if tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Fatalf("expected error contains: %s, got: %v", tt.errContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			if err := testCodecObjectType.ValidateObjectUpdate(got, EmptyTypeSet()); err != nil {
+				t.Fatalf("converted update is invalid: %v", err)
+			}
+		})
+	}
+}
+
+func TestConvertObjectValue_noCodec(t *testing.T) {
+	fields := []Field{{Name: "value", Kind: IntegerKind}}
+	value := testInt{1}
+	got, err := ConvertObjectValue(fields, value)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != value {
+		t.Fatalf("expected value to be unchanged, got %v", got)
+	}
+
+	err = fields[0].ValidateValue(got, EmptyTypeSet())
+	if err == nil || !strings.Contains(err.Error(), "set a ValueCodec on the field to convert schema.testInt values to integer") {
+		t.Fatalf("expected error to suggest a ValueCodec, got: %v", err)
+	}
+}
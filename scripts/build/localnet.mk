@@ -1,3 +1,11 @@
+# localnet-start will run a 4-node simapp v2 testnet locally, as subprocesses of
+# the localnet command in ./simapp/v2/localnet. The nodes run until interrupted.
+#? localnet-start: Run a 4-node simapp v2 testnet locally
+localnet-start:
+	COSMOS_BUILD_OPTIONS=v2 $(MAKE) build
+	rm -rf $(CURDIR)/.testnets
+	cd $(CURDIR)/simapp/v2 && go run ./localnet/cmd/localnet -binary $(BUILDDIR)/simdv2 -dir $(CURDIR)/.testnets
+
 #? localnet-build-env: Run `make -C contrib/images simd-env`
 localnet-build-env:
 	$(MAKE) -C contrib/images simd-env
@@ -10,15 +18,10 @@ localnet-build-nodes:
 			  testnet init-files -n 4 -o /data --starting-ip-address 192.168.10.2 --keyring-backend=test --listen-ip-address 0.0.0.0
 	docker-compose up -d
 
-#? localnet-stop: Stop localnet node
+#? localnet-stop: Stop localnet docker nodes
 localnet-stop:
 	docker-compose down
 
-# localnet-start will run a 4-node testnet locally. The nodes are
-# based off the docker images in: ./contrib/images/simd-env
-#? localnet-start: Run a 4-node testnet locally
-localnet-start: localnet-stop localnet-build-env localnet-build-nodes
-
 # localnet-debug will run a 4-node testnet locally in debug mode
 # you can read more about the debug mode here: ./contrib/images/simd-dlv/README.md
 #? localnet-debug: Run a 4-node testnet locally in debug mode
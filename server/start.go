@@ -41,6 +41,7 @@ import (
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema/indexer"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -81,6 +82,8 @@ const (
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagShutdownGrace       = "shutdown-grace"
 
+	FlagAnteDivergenceDetection = "ante-divergence-detection"
+
 	// state sync-related flags
 
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -236,6 +239,10 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 
 	emitServerInfoMetrics()
 
+	if err := registerIndexerMetrics(svrCfg, app); err != nil {
+		return err
+	}
+
 	if !withCmt {
 		return startStandAlone[T](svrCtx, svrCfg, clientCtx, app, metrics, opts)
 	}
@@ -619,6 +626,23 @@ func emitServerInfoMetrics() {
 	telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, ls)
 }
 
+// registerIndexerMetrics exports the status of the indexer targets as Prometheus metrics when the
+// Prometheus sink is enabled and the app reports the status of its indexer.
+func registerIndexerMetrics(svrCfg serverconfig.Config, app any) error {
+	if !svrCfg.Telemetry.Enabled || svrCfg.Telemetry.PrometheusRetentionTime <= 0 {
+		return nil
+	}
+
+	statusApp, ok := app.(interface {
+		IndexerStatus() map[string]indexer.TargetStatus
+	})
+	if !ok {
+		return nil
+	}
+
+	return telemetry.RegisterIndexerStatusCollector(statusApp.IndexerStatus)
+}
+
 func getCtx(svrCtx *Context, block bool) (*errgroup.Group, context.Context) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
@@ -1032,6 +1056,7 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
+	cmd.Flags().Bool(FlagAnteDivergenceDetection, false, "Report divergences of the AnteHandler between CheckTx, simulation and FinalizeBlock (development only, do not use in production)")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -553,6 +553,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetAnteDivergenceDetection(cast.ToBool(appOpts.Get(FlagAnteDivergenceDetection))),
 	}
 }
 
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/server/v2/audit"
+)
+
+// auditEndpoint is the endpoint of the audit entries of the gRPC server.
+const auditEndpoint = "grpc"
+
+// auditInterceptors returns the interceptors audit logging the calls of the given methods,
+// whether they are served by a registered service or by the unknown service handler.
+func auditInterceptors(logger *audit.Logger, methods []string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	audited := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		audited[method] = struct{}{}
+	}
+
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := audited[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logger.Log(auditEntry(ctx, info.FullMethod, req, err, time.Since(start)))
+		return resp, err
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := audited[info.FullMethod]; !ok {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		wrapped := &auditedStream{ServerStream: ss}
+		err := handler(srv, wrapped)
+		logger.Log(auditEntry(ss.Context(), info.FullMethod, wrapped.lastReq, err, time.Since(start)))
+		return err
+	}
+
+	return unary, stream
+}
+
+// auditEntry returns the audit entry of the call of the method with the given request.
+// The transaction hash and size are known when the request holds the transaction bytes, as the
+// cosmos.tx.v1beta1.BroadcastTxRequest does, otherwise the size is the size of the request.
+func auditEntry(ctx context.Context, method string, req any, err error, latency time.Duration) audit.Entry {
+	entry := audit.Entry{
+		Endpoint: auditEndpoint,
+		Method:   method,
+		Code:     uint32(status.Code(err)),
+		Latency:  latency,
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		entry.Peer = p.Addr.String()
+	}
+
+	switch req := req.(type) {
+	case interface{ GetTxBytes() []byte }:
+		txBytes := req.GetTxBytes()
+		if len(txBytes) > 0 {
+			hash := sha256.Sum256(txBytes)
+			entry.TxHash = hash[:]
+		}
+		entry.Size = len(txBytes)
+	case gogoproto.Message:
+		entry.Size = gogoproto.Size(req)
+	}
+
+	return entry
+}
+
+// auditedStream is a grpc.ServerStream keeping the last received request.
+type auditedStream struct {
+	grpc.ServerStream
+
+	lastReq any
+}
+
+func (s *auditedStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.lastReq = m
+	}
+
+	return err
+}
@@ -1,6 +1,11 @@
 package grpc
 
-import "math"
+import (
+	"fmt"
+	"math"
+
+	"cosmossdk.io/server/v2/audit"
+)
 
 func DefaultConfig() *Config {
 	return &Config{
@@ -8,6 +13,10 @@ func DefaultConfig() *Config {
 		Address:        "localhost:9090",
 		MaxRecvMsgSize: 1024 * 1024 * 10,
 		MaxSendMsgSize: math.MaxInt32,
+		Audit: AuditConfig{
+			Config:  audit.DefaultConfig(),
+			Methods: []string{"/cosmos.tx.v1beta1.Service/BroadcastTx"},
+		},
 	}
 }
 
@@ -26,6 +35,40 @@ type Config struct {
 	// MaxSendMsgSize defines the max message size in bytes the server can send.
 	// The default value is math.MaxInt32.
 	MaxSendMsgSize int `mapstructure:"max-send-msg-size" toml:"max-send-msg-size" comment:"MaxSendMsgSize defines the max message size in bytes the server can send.\nThe default value is math.MaxInt32."`
+
+	// Listeners defines additional listeners serving the gRPC API, each with its own TLS and allowed methods.
+	Listeners []ListenerConfig `mapstructure:"listeners" toml:"listeners" comment:"Listeners defines additional listeners serving the gRPC API, each with its own TLS and allowed methods."`
+
+	// Audit defines the audit logging of the transaction submissions received by the gRPC server.
+	Audit AuditConfig `mapstructure:"audit" toml:"audit" comment:"Audit defines the audit logging of the transaction submissions received by the gRPC server."`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if err := c.Audit.Validate(); err != nil {
+		return fmt.Errorf("invalid audit config: %w", err)
+	}
+
+	addresses := map[string]struct{}{c.Address: {}}
+	for _, listener := range c.Listeners {
+		if err := listener.Validate(); err != nil {
+			return err
+		}
+		if _, ok := addresses[listener.Address]; ok {
+			return fmt.Errorf("address %s is bound more than once", listener.Address)
+		}
+		addresses[listener.Address] = struct{}{}
+	}
+
+	return nil
+}
+
+// AuditConfig defines the audit logging configuration of the gRPC server.
+type AuditConfig struct {
+	audit.Config `mapstructure:",squash"`
+
+	// Methods defines the full names of the gRPC methods submitting transactions, which are audit logged.
+	Methods []string `mapstructure:"methods" toml:"methods" comment:"Methods defines the full names of the gRPC methods submitting transactions, which are audit logged."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
@@ -0,0 +1,230 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// unixScheme is the address prefix of the unix domain socket listeners.
+const unixScheme = "unix://"
+
+// ListenerConfig defines an additional listener of the gRPC server.
+// Each listener is served by its own gRPC server, allowing to isolate privileged clients,
+// for instance sidecars connecting over a unix domain socket, from remote clients.
+type ListenerConfig struct {
+	// Address defines the address to bind to, either host:port for TCP or unix:///path/to/socket
+	// for a unix domain socket.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the address to bind to, either host:port for TCP or unix:///path/to/socket for a unix domain socket."`
+
+	// SocketMode defines the octal file mode of the unix domain socket, e.g. 0600.
+	// When empty, the file mode is derived from the process umask.
+	SocketMode string `mapstructure:"socket-mode" toml:"socket-mode" comment:"SocketMode defines the octal file mode of the unix domain socket, e.g. 0600.\nWhen empty, the file mode is derived from the process umask."`
+
+	// TLS defines the TLS configuration of a TCP listener.
+	TLS TLSConfig `mapstructure:"tls" toml:"tls" comment:"TLS defines the TLS configuration of a TCP listener."`
+
+	// AllowedMethods defines the full names of the gRPC methods served by the listener.
+	// A name ending with * matches all the methods starting with the prefix, e.g. /cosmos.bank.v1beta1.Query/*.
+	// When empty, all the methods are served.
+	AllowedMethods []string `mapstructure:"allowed-methods" toml:"allowed-methods" comment:"AllowedMethods defines the full names of the gRPC methods served by the listener.\nA name ending with * matches all the methods starting with the prefix, e.g. /cosmos.bank.v1beta1.Query/*.\nWhen empty, all the methods are served."`
+}
+
+// TLSConfig defines the TLS configuration of a listener.
+type TLSConfig struct {
+	// CertFile defines the path of the PEM encoded server certificate. TLS is enabled when set.
+	CertFile string `mapstructure:"cert-file" toml:"cert-file" comment:"CertFile defines the path of the PEM encoded server certificate. TLS is enabled when set."`
+
+	// KeyFile defines the path of the PEM encoded server private key.
+	KeyFile string `mapstructure:"key-file" toml:"key-file" comment:"KeyFile defines the path of the PEM encoded server private key."`
+
+	// ClientCAFile defines the path of the PEM encoded certificate authorities of the clients.
+	// When set, clients must authenticate with a certificate issued by one of them (mTLS).
+	ClientCAFile string `mapstructure:"client-ca-file" toml:"client-ca-file" comment:"ClientCAFile defines the path of the PEM encoded certificate authorities of the clients.\nWhen set, clients must authenticate with a certificate issued by one of them (mTLS)."`
+}
+
+// Enabled returns true if TLS is enabled.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+// Validate returns an error if the listener configuration is invalid.
+func (c ListenerConfig) Validate() error {
+	if c.Address == "" {
+		return errors.New("listener address cannot be empty")
+	}
+
+	if c.isUnix() {
+		if strings.TrimPrefix(c.Address, unixScheme) == "" {
+			return fmt.Errorf("listener %s: unix domain socket path cannot be empty", c.Address)
+		}
+		if c.TLS.Enabled() {
+			return fmt.Errorf("listener %s: TLS cannot be enabled on a unix domain socket", c.Address)
+		}
+		if _, err := c.socketMode(); err != nil {
+			return fmt.Errorf("listener %s: invalid socket mode %q: %w", c.Address, c.SocketMode, err)
+		}
+	} else if c.SocketMode != "" {
+		return fmt.Errorf("listener %s: socket mode can only be set on a unix domain socket", c.Address)
+	}
+
+	if c.TLS.Enabled() != (c.TLS.KeyFile != "") {
+		return fmt.Errorf("listener %s: TLS certificate and key files must be set together", c.Address)
+	}
+	if c.TLS.ClientCAFile != "" && !c.TLS.Enabled() {
+		return fmt.Errorf("listener %s: client certificate authorities require TLS to be enabled", c.Address)
+	}
+
+	for _, method := range c.AllowedMethods {
+		if !strings.HasPrefix(method, "/") {
+			return fmt.Errorf("listener %s: allowed method %q must be a full method name starting with /", c.Address, method)
+		}
+	}
+
+	return nil
+}
+
+func (c ListenerConfig) isUnix() bool {
+	return strings.HasPrefix(c.Address, unixScheme)
+}
+
+func (c ListenerConfig) socketMode() (os.FileMode, error) {
+	if c.SocketMode == "" {
+		return 0, nil
+	}
+
+	mode, err := strconv.ParseUint(c.SocketMode, 8, 32)
+	if err != nil {
+		return 0, err
+	}
+	if mode > 0o777 {
+		return 0, errors.New("socket mode must only hold permission bits")
+	}
+
+	return os.FileMode(mode), nil
+}
+
+// listen binds the listener address. A stale unix domain socket left by a previous run is removed.
+func (c ListenerConfig) listen() (net.Listener, error) {
+	if !c.isUnix() {
+		return net.Listen("tcp", c.Address)
+	}
+
+	path := strings.TrimPrefix(c.Address, unixScheme)
+	if fi, err := os.Stat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale unix domain socket: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := c.socketMode()
+	if err != nil {
+		return nil, errors.Join(err, listener.Close())
+	}
+	if mode != 0 {
+		if err := os.Chmod(path, mode); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to set unix domain socket mode: %w", err), listener.Close())
+		}
+	}
+
+	return listener, nil
+}
+
+// serverOptions returns the gRPC server options enforcing the listener TLS configuration and
+// allowed methods.
+func (c ListenerConfig) serverOptions() ([]grpc.ServerOption, error) {
+	var opts []grpc.ServerOption
+
+	if c.TLS.Enabled() {
+		tlsCfg, err := c.TLS.Load()
+		if err != nil {
+			return nil, fmt.Errorf("listener %s: %w", c.Address, err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+
+	if len(c.AllowedMethods) > 0 {
+		unary, stream := allowedMethodsInterceptors(c.AllowedMethods)
+		opts = append(opts, grpc.ChainUnaryInterceptor(unary), grpc.ChainStreamInterceptor(stream))
+	}
+
+	return opts, nil
+}
+
+// Load returns the TLS configuration, requiring and verifying the client certificates when
+// client certificate authorities are set.
+func (c TLSConfig) Load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.ClientCAFile != "" {
+		pem, err := os.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client certificate authorities: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", c.ClientCAFile)
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsCfg, nil
+}
+
+// allowedMethodsInterceptors returns the interceptors rejecting the calls of the methods not
+// matching any of the given method names or prefixes.
+func allowedMethodsInterceptors(methods []string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	allowed := func(method string) bool {
+		for _, m := range methods {
+			if prefix, ok := strings.CutSuffix(m, "*"); ok {
+				if strings.HasPrefix(method, prefix) {
+					return true
+				}
+			} else if m == method {
+				return true
+			}
+		}
+		return false
+	}
+
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !allowed(info.FullMethod) {
+			return nil, status.Errorf(codes.PermissionDenied, "method %s is not allowed on this listener", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !allowed(info.FullMethod) {
+			return status.Errorf(codes.PermissionDenied, "method %s is not allowed on this listener", info.FullMethod)
+		}
+		return handler(srv, ss)
+	}
+
+	return unary, stream
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListenerConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		listener ListenerConfig
+		expErr   string
+	}{
+		{
+			name:     "tcp",
+			listener: ListenerConfig{Address: "localhost:9091", TLS: TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem", ClientCAFile: "ca.pem"}},
+		},
+		{
+			name:     "unix",
+			listener: ListenerConfig{Address: "unix:///tmp/grpc.sock", SocketMode: "0600", AllowedMethods: []string{"/cosmos.bank.v1beta1.Query/*"}},
+		},
+		{
+			name:     "empty address",
+			listener: ListenerConfig{},
+			expErr:   "listener address cannot be empty",
+		},
+		{
+			name:     "empty socket path",
+			listener: ListenerConfig{Address: "unix://"},
+			expErr:   "unix domain socket path cannot be empty",
+		},
+		{
+			name:     "tls on unix socket",
+			listener: ListenerConfig{Address: "unix:///tmp/grpc.sock", TLS: TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem"}},
+			expErr:   "TLS cannot be enabled on a unix domain socket",
+		},
+		{
+			name:     "invalid socket mode",
+			listener: ListenerConfig{Address: "unix:///tmp/grpc.sock", SocketMode: "0999"},
+			expErr:   "invalid socket mode",
+		},
+		{
+			name:     "socket mode on tcp",
+			listener: ListenerConfig{Address: "localhost:9091", SocketMode: "0600"},
+			expErr:   "socket mode can only be set on a unix domain socket",
+		},
+		{
+			name:     "missing key file",
+			listener: ListenerConfig{Address: "localhost:9091", TLS: TLSConfig{CertFile: "cert.pem"}},
+			expErr:   "TLS certificate and key files must be set together",
+		},
+		{
+			name:     "client ca without tls",
+			listener: ListenerConfig{Address: "localhost:9091", TLS: TLSConfig{ClientCAFile: "ca.pem"}},
+			expErr:   "client certificate authorities require TLS to be enabled",
+		},
+		{
+			name:     "invalid allowed method",
+			listener: ListenerConfig{Address: "localhost:9091", AllowedMethods: []string{"cosmos.bank.v1beta1.Query/Balance"}},
+			expErr:   "must be a full method name",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.listener.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+
+	cfg := DefaultConfig()
+	cfg.Listeners = []ListenerConfig{{Address: cfg.Address}}
+	require.ErrorContains(t, cfg.Validate(), "is bound more than once")
+}
+
+func TestAllowedMethodsInterceptors(t *testing.T) {
+	unary, stream := allowedMethodsInterceptors([]string{"/cosmos.bank.v1beta1.Query/*", "/cosmos.tx.v1beta1.Service/Simulate"})
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	for method, allowed := range map[string]bool{
+		"/cosmos.bank.v1beta1.Query/Balance":     true,
+		"/cosmos.tx.v1beta1.Service/Simulate":    true,
+		"/cosmos.tx.v1beta1.Service/BroadcastTx": false,
+	} {
+		_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		if allowed {
+			require.NoError(t, err, method)
+		} else {
+			require.Equal(t, codes.PermissionDenied, status.Code(err), method)
+		}
+	}
+
+	err := stream(nil, nil, &grpc.StreamServerInfo{FullMethod: "/cosmos.tx.v1beta1.Service/BroadcastTx"}, func(any, grpc.ServerStream) error { return nil })
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestListenUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "grpc.sock")
+	listener := ListenerConfig{Address: unixScheme + path, SocketMode: "0600"}
+
+	lis, err := listener.listen()
+	require.NoError(t, err)
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+
+	// a socket left behind is replaced.
+	lis2, err := listener.listen()
+	require.NoError(t, err)
+	require.NoError(t, lis2.Close())
+	_ = lis.Close()
+}
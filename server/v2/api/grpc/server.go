@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"maps"
-	"net"
 	"slices"
 	"strconv"
 	"strings"
@@ -14,6 +13,7 @@ import (
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/pflag"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -25,6 +25,7 @@ import (
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/api/grpc/gogoreflection"
+	"cosmossdk.io/server/v2/audit"
 )
 
 const (
@@ -39,6 +40,8 @@ type Server[T transaction.Tx] struct {
 	cfgOptions []CfgOption
 
 	grpcSrv *grpc.Server
+	// listenerSrvs are the gRPC servers of the additional listeners, in the config order.
+	listenerSrvs []*grpc.Server
 }
 
 // New creates a new grpc server.
@@ -57,25 +60,61 @@ func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.L
 			return fmt.Errorf("failed to unmarshal config: %w", err)
 		}
 	}
+	if err := serverCfg.Validate(); err != nil {
+		return err
+	}
 	methodsMap := appI.QueryHandlers()
+	logger = logger.With(log.ModuleKey, s.Name())
+
+	grpcSrv := newGRPCServer(appI, serverCfg, methodsMap, nil, logger)
+
+	listenerSrvs := make([]*grpc.Server, 0, len(serverCfg.Listeners))
+	for _, listener := range serverCfg.Listeners {
+		opts, err := listener.serverOptions()
+		if err != nil {
+			return err
+		}
+		listenerSrvs = append(listenerSrvs, newGRPCServer(appI, serverCfg, methodsMap, opts, logger))
+	}
 
-	grpcSrv := grpc.NewServer(
+	s.grpcSrv = grpcSrv
+	s.listenerSrvs = listenerSrvs
+	s.config = serverCfg
+	s.logger = logger
+
+	return nil
+}
+
+// newGRPCServer returns a gRPC server serving the app queries, with the given listener options
+// applied on top of the server configuration.
+func newGRPCServer[T transaction.Tx](
+	appI serverv2.AppI[T],
+	serverCfg *Config,
+	methodsMap map[string]appmodulev2.Handler,
+	listenerOpts []grpc.ServerOption,
+	logger log.Logger,
+) *grpc.Server {
+	opts := []grpc.ServerOption{
 		grpc.ForceServerCodec(newProtoCodec(appI.InterfaceRegistry()).GRPCCodec()),
 		grpc.MaxSendMsgSize(serverCfg.MaxSendMsgSize),
 		grpc.MaxRecvMsgSize(serverCfg.MaxRecvMsgSize),
 		grpc.UnknownServiceHandler(
 			makeUnknownServiceHandler(methodsMap, appI),
 		),
-	)
+	}
+	// the listener options go first so that calls of disallowed methods are rejected before being audited.
+	opts = append(opts, listenerOpts...)
+	if auditLogger := audit.NewLogger(logger, serverCfg.Audit.Config); auditLogger != nil {
+		unary, stream := auditInterceptors(auditLogger, serverCfg.Audit.Methods)
+		opts = append(opts, grpc.ChainUnaryInterceptor(unary), grpc.ChainStreamInterceptor(stream))
+	}
+
+	grpcSrv := grpc.NewServer(opts...)
 
 	// Reflection allows external clients to see what services and methods the gRPC server exposes.
 	gogoreflection.Register(grpcSrv, slices.Collect(maps.Keys(methodsMap)), logger.With("sub-module", "grpc-reflection"))
 
-	s.grpcSrv = grpcSrv
-	s.config = serverCfg
-	s.logger = logger.With(log.ModuleKey, s.Name())
-
-	return nil
+	return grpcSrv
 }
 
 func (s *Server[T]) StartCmdFlags() *pflag.FlagSet {
@@ -186,23 +225,56 @@ func (s *Server[T]) Config() any {
 	return s.config
 }
 
+// StartupReport implements serverv2.HasStartupReport.
+func (s *Server[T]) StartupReport() (bool, map[string]any) {
+	cfg := s.Config().(*Config)
+	details := map[string]any{"address": cfg.Address}
+	if len(cfg.Listeners) > 0 {
+		listeners := make([]string, 0, len(cfg.Listeners))
+		for _, listener := range cfg.Listeners {
+			listeners = append(listeners, listener.Address)
+		}
+		details["listeners"] = listeners
+	}
+
+	return cfg.Enable, details
+}
+
 func (s *Server[T]) Start(ctx context.Context) error {
 	if !s.config.Enable {
 		s.logger.Info(fmt.Sprintf("%s server is disabled via config", s.Name()))
 		return nil
 	}
 
-	listener, err := net.Listen("tcp", s.config.Address)
-	if err != nil {
-		return fmt.Errorf("failed to listen on address %s: %w", s.config.Address, err)
+	g, ctx := errgroup.WithContext(ctx)
+	serve := func(srv *grpc.Server, listener ListenerConfig) {
+		g.Go(func() error {
+			lis, err := listener.listen()
+			if err != nil {
+				return fmt.Errorf("failed to listen on address %s: %w", listener.Address, err)
+			}
+
+			s.logger.Info("starting gRPC server...", "address", listener.Address)
+			if err := srv.Serve(lis); err != nil {
+				return fmt.Errorf("failed to start gRPC server on address %s: %w", listener.Address, err)
+			}
+
+			return nil
+		})
 	}
 
-	s.logger.Info("starting gRPC server...", "address", s.config.Address)
-	if err := s.grpcSrv.Serve(listener); err != nil {
-		return fmt.Errorf("failed to start gRPC server: %w", err)
+	serve(s.grpcSrv, ListenerConfig{Address: s.config.Address})
+	for i, listener := range s.config.Listeners {
+		serve(s.listenerSrvs[i], listener)
 	}
 
-	return nil
+	// a server failing to start stops the other ones.
+	go func() {
+		<-ctx.Done()
+		s.stopAll()
+	}()
+
+	return g.Wait()
 }
 
 func (s *Server[T]) Stop(ctx context.Context) error {
@@ -211,11 +283,19 @@ func (s *Server[T]) Stop(ctx context.Context) error {
 	}
 
 	s.logger.Info("stopping gRPC server...", "address", s.config.Address)
-	s.grpcSrv.GracefulStop()
+	s.stopAll()
 	return nil
 }
 
-// GetGRPCServer returns the underlying gRPC server.
+// stopAll gracefully stops the gRPC servers of all the listeners.
+func (s *Server[T]) stopAll() {
+	s.grpcSrv.GracefulStop()
+	for _, srv := range s.listenerSrvs {
+		srv.GracefulStop()
+	}
+}
+
+// GetGRPCServer returns the underlying gRPC server of the main address.
 func (s *Server[T]) GetGRPCServer() *grpc.Server {
 	return s.grpcSrv
 }
@@ -19,6 +19,7 @@ import (
 var (
 	_ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
 	_ serverv2.HasConfig                       = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasStartupReport                = (*Server[transaction.Tx])(nil)
 )
 
 const ServerName = "grpc-gateway"
@@ -80,6 +81,12 @@ func (s *Server[T]) Config() any {
 	return s.config
 }
 
+// StartupReport implements serverv2.HasStartupReport.
+func (s *Server[T]) StartupReport() (bool, map[string]any) {
+	cfg := s.Config().(*Config)
+	return cfg.Enable, map[string]any{"address": cfg.Address}
+}
+
 func (s *Server[T]) Init(appI serverv2.AppI[transaction.Tx], cfg map[string]any, logger log.Logger) error {
 	serverCfg := s.Config().(*Config)
 	if len(cfg) > 0 {
@@ -94,3 +94,9 @@ func (s *Server[T]) Config() any {
 
 	return s.config
 }
+
+// StartupReport implements serverv2.HasStartupReport.
+func (s *Server[T]) StartupReport() (bool, map[string]any) {
+	cfg := s.Config().(*Config)
+	return cfg.Enable, map[string]any{"address": cfg.Address}
+}
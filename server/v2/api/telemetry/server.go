@@ -15,6 +15,7 @@ import (
 var (
 	_ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
 	_ serverv2.HasConfig                       = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasStartupReport                = (*Server[transaction.Tx])(nil)
 )
 
 const ServerName = "telemetry"
@@ -44,6 +45,12 @@ func (s *Server[T]) Config() any {
 	return s.config
 }
 
+// StartupReport implements serverv2.HasStartupReport.
+func (s *Server[T]) StartupReport() (bool, map[string]any) {
+	cfg := s.Config().(*Config)
+	return cfg.Enable, map[string]any{"address": cfg.Address}
+}
+
 // Init implements serverv2.ServerComponent.
 func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.Logger) error {
 	serverCfg := s.Config().(*Config)
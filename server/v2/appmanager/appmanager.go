@@ -33,6 +33,14 @@ type AppManager[T transaction.Tx] interface {
 		block *server.BlockRequest[T],
 	) (*server.BlockResponse, corestore.WriterMap, error)
 
+	// ReplayBlock re-executes an already committed block on top of the state
+	// committed at block.Height-1. The resulting state is never committed, it is
+	// returned to the caller for inspection.
+	ReplayBlock(
+		ctx context.Context,
+		block *server.BlockRequest[T],
+	) (*server.BlockResponse, corestore.WriterMap, error)
+
 	// ValidateTx will validate the tx against the latest storage state. This means that
 	// only the stateful validation will be run, not the execution portion of the tx.
 	// If full execution is needed, Simulate must be used.
@@ -171,6 +179,29 @@ func (a appManager[T]) DeliverBlock(
 	return blockResponse, newState, nil
 }
 
+// ReplayBlock re-executes an already committed block on top of the state
+// committed at block.Height-1, without committing the result.
+func (a appManager[T]) ReplayBlock(
+	ctx context.Context,
+	block *server.BlockRequest[T],
+) (*server.BlockResponse, corestore.WriterMap, error) {
+	if block.Height == 0 {
+		return nil, nil, errors.New("cannot replay block at height 0")
+	}
+
+	previousState, err := a.db.StateAt(block.Height - 1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load state at height %d: %w", block.Height-1, err)
+	}
+
+	blockResponse, newState, err := a.stf.DeliverBlock(ctx, block, previousState)
+	if err != nil {
+		return nil, nil, fmt.Errorf("block replay failed: %w", err)
+	}
+
+	return blockResponse, newState, nil
+}
+
 // ValidateTx will validate the tx against the latest storage state. This means that
 // only the stateful validation will be run, not the execution portion of the tx.
 // If full execution is needed, Simulate must be used.
@@ -0,0 +1,112 @@
+// Package audit logs an audit trail of the transaction submissions received by the server components.
+// The entries only contain the metadata of the submissions: the transaction hash and size, the result
+// code, the latency and an anonymized peer address, but never the transaction content.
+package audit
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"net"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+// ModuleName is the module key of the audit log entries.
+const ModuleName = "audit"
+
+// Entry is the audit log entry of a transaction submission.
+type Entry struct {
+	// Endpoint is the endpoint which received the submission, e.g. "grpc" or "check_tx".
+	Endpoint string
+	// Method is the method called on the endpoint, if any.
+	Method string
+	// TxHash is the hash of the submitted transaction, if known.
+	TxHash []byte
+	// Peer is the network address of the peer which submitted the transaction, if known.
+	// It is anonymized before being logged.
+	Peer string
+	// Size is the size in bytes of the submission.
+	Size int
+	// Codespace and Code are the result of the submission, a zero code meaning it has been accepted.
+	Codespace string
+	Code      uint32
+	// Latency is the time taken to handle the submission.
+	Latency time.Duration
+}
+
+// Logger logs the audit entries of the transaction submissions.
+// A nil Logger discards all entries.
+type Logger struct {
+	logger     log.Logger
+	sampleRate float64
+}
+
+// NewLogger returns a Logger writing the entries to the given logger, or nil if audit logging is disabled.
+func NewLogger(logger log.Logger, cfg Config) *Logger {
+	if !cfg.Enable {
+		return nil
+	}
+
+	return &Logger{
+		logger:     logger.With(log.ModuleKey, ModuleName),
+		sampleRate: cfg.SampleRate,
+	}
+}
+
+// Log logs the entry, if it is sampled.
+func (l *Logger) Log(e Entry) {
+	if l == nil || (e.Code == 0 && !l.sampled(e.TxHash)) {
+		return
+	}
+
+	l.logger.Info("tx submission",
+		"endpoint", e.Endpoint,
+		"method", e.Method,
+		"tx_hash", fmt.Sprintf("%X", e.TxHash),
+		"peer", AnonymizePeer(e.Peer),
+		"size", e.Size,
+		"codespace", e.Codespace,
+		"code", e.Code,
+		"latency", e.Latency,
+	)
+}
+
+// sampled returns whether the entry of the transaction with the given hash is logged.
+// The decision is derived from the hash when known, so that a transaction submitted through several
+// endpoints is consistently logged or not.
+func (l *Logger) sampled(txHash []byte) bool {
+	switch {
+	case l.sampleRate >= 1:
+		return true
+	case l.sampleRate <= 0:
+		return false
+	case len(txHash) >= 8:
+		return float64(binary.BigEndian.Uint64(txHash))/math.MaxUint64 < l.sampleRate
+	default:
+		return rand.Float64() < l.sampleRate
+	}
+}
+
+// AnonymizePeer returns the network of the peer address, with the host part of the IP address zeroed,
+// using a /24 mask for IPv4 and a /48 mask for IPv6, and the port removed.
+// It returns an empty string if the address is not an IP address.
+func AnonymizePeer(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return ""
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(24, 32)).String()
+	}
+
+	return ip.Mask(net.CIDRMask(48, 128)).String()
+}
@@ -0,0 +1,102 @@
+package audit_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/server/v2/audit"
+)
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := audit.NewLogger(log.NewLogger(&buf, log.OutputJSONOption()), audit.Config{Enable: true, SampleRate: 1})
+
+	hash := sha256.Sum256([]byte("tx"))
+	logger.Log(audit.Entry{
+		Endpoint: "grpc",
+		Method:   "/cosmos.tx.v1beta1.Service/BroadcastTx",
+		TxHash:   hash[:],
+		Peer:     "203.0.113.42:51234",
+		Size:     128,
+		Latency:  time.Millisecond,
+	})
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, audit.ModuleName, entry[log.ModuleKey])
+	require.Equal(t, "grpc", entry["endpoint"])
+	require.Equal(t, fmt.Sprintf("%X", hash), entry["tx_hash"])
+	require.Equal(t, "203.0.113.0", entry["peer"])
+	require.Equal(t, float64(128), entry["size"])
+	require.Equal(t, float64(0), entry["code"])
+	require.NotContains(t, buf.String(), "203.0.113.42")
+}
+
+func TestLoggerDisabled(t *testing.T) {
+	var buf bytes.Buffer
+	logger := audit.NewLogger(log.NewLogger(&buf), audit.DefaultConfig())
+	require.Nil(t, logger)
+
+	logger.Log(audit.Entry{Endpoint: "check_tx", Code: 1})
+	require.Zero(t, buf.Len())
+}
+
+func TestLoggerSampling(t *testing.T) {
+	var buf bytes.Buffer
+	logger := audit.NewLogger(log.NewLogger(&buf, log.OutputJSONOption()), audit.Config{Enable: true, SampleRate: 0.5})
+
+	sampled := 0
+	for i := 0; i < 1000; i++ {
+		hash := sha256.Sum256([]byte{byte(i), byte(i >> 8)})
+		before := buf.Len()
+		logger.Log(audit.Entry{Endpoint: "check_tx", TxHash: hash[:]})
+		if buf.Len() > before {
+			sampled++
+
+			// the decision is the same for the same transaction
+			before = buf.Len()
+			logger.Log(audit.Entry{Endpoint: "grpc", TxHash: hash[:]})
+			require.Greater(t, buf.Len(), before)
+		}
+	}
+	require.InDelta(t, 500, sampled, 100)
+
+	// rejected submissions are always logged
+	buf.Reset()
+	logger = audit.NewLogger(log.NewLogger(&buf, log.OutputJSONOption()), audit.Config{Enable: true, SampleRate: 0})
+	logger.Log(audit.Entry{Endpoint: "check_tx", TxHash: []byte{0xff}})
+	require.Zero(t, buf.Len())
+	logger.Log(audit.Entry{Endpoint: "check_tx", TxHash: []byte{0xff}, Codespace: "sdk", Code: 5})
+	require.Equal(t, 1, strings.Count(buf.String(), "\n"))
+}
+
+func TestAnonymizePeer(t *testing.T) {
+	tests := []struct {
+		addr string
+		want string
+	}{
+		{"203.0.113.42:51234", "203.0.113.0"},
+		{"203.0.113.42", "203.0.113.0"},
+		{"[2001:db8:85a3:8d3:1319:8a2e:370:7348]:443", "2001:db8:85a3::"},
+		{"bufconn", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, audit.AnonymizePeer(tt.addr), tt.addr)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, audit.DefaultConfig().Validate())
+	require.Error(t, audit.Config{SampleRate: 1.5}.Validate())
+	require.Error(t, audit.Config{SampleRate: -0.1}.Validate())
+}
@@ -0,0 +1,30 @@
+package audit
+
+import "fmt"
+
+// DefaultConfig returns the default audit configuration, which disables audit logging.
+func DefaultConfig() Config {
+	return Config{
+		Enable:     false,
+		SampleRate: 1,
+	}
+}
+
+// Config defines the configuration of the audit logging of the transaction submissions.
+type Config struct {
+	// Enable defines if the transaction submissions should be audit logged.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the transaction submissions should be audit logged."`
+
+	// SampleRate defines the fraction of the accepted transaction submissions which are logged, between 0 and 1.
+	// Rejected submissions are always logged.
+	SampleRate float64 `mapstructure:"sample-rate" toml:"sample-rate" comment:"SampleRate defines the fraction of the accepted transaction submissions which are logged, between 0 and 1.\nRejected submissions are always logged."`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("audit sample rate must be between 0 and 1, got %v", c.SampleRate)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
@@ -26,7 +27,9 @@ import (
 	errorsmod "cosmossdk.io/errors/v2"
 	"cosmossdk.io/log"
 	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
 	"cosmossdk.io/server/v2/appmanager"
+	"cosmossdk.io/server/v2/audit"
 	"cosmossdk.io/server/v2/cometbft/client/grpc/cmtservice"
 	"cosmossdk.io/server/v2/cometbft/handlers"
 	"cosmossdk.io/server/v2/cometbft/mempool"
@@ -67,6 +70,11 @@ type Consensus[T transaction.Tx] struct {
 	extendVote             handlers.ExtendVoteHandler
 	checkTxHandler         handlers.CheckTxHandler[T]
 
+	auditLogger *audit.Logger // audit logs the submissions of new transactions, nil if disabled
+	blockStore  blockStore    // the CometBFT block store, set before the handshake, nil if CometBFT is not started in-process
+
+	indexerStatus func() map[string]indexer.TargetStatus // the status of the indexer targets, nil if indexing is disabled
+
 	addrPeerFilter types.PeerFilter // filter peers by address and port
 	idPeerFilter   types.PeerFilter // filter peers by node ID
 
@@ -130,6 +138,18 @@ func (c *Consensus[T]) RegisterSnapshotExtensions(extensions ...snapshots.Extens
 // CheckTx implements types.Application.
 // It is called by cometbft to verify transaction validity
 func (c *Consensus[T]) CheckTx(ctx context.Context, req *abciproto.CheckTxRequest) (*abciproto.CheckTxResponse, error) {
+	// only the submissions of new transactions are audited, not the rechecks of the mempool
+	if c.auditLogger == nil || req.Type == abciproto.CHECK_TX_TYPE_RECHECK {
+		return c.checkTx(ctx, req)
+	}
+
+	start := time.Now()
+	resp, err := c.checkTx(ctx, req)
+	c.auditLogger.Log(checkTxAuditEntry(req.Tx, resp, err, time.Since(start)))
+	return resp, err
+}
+
+func (c *Consensus[T]) checkTx(ctx context.Context, req *abciproto.CheckTxRequest) (*abciproto.CheckTxResponse, error) {
 	decodedTx, err := c.txCodec.Decode(req.Tx)
 	if err != nil {
 		return nil, err
@@ -486,12 +506,12 @@ func (c *Consensus[T]) FinalizeBlock(
 		Txs:     decodedTxs,
 	}
 
-	ciCtx := contextWithCometInfo(ctx, comet.Info{
+	ciCtx := contextWithCometInfo(ctx, withBlockStoreInfo(comet.Info{
 		Evidence:        toCoreEvidence(req.Misbehavior),
 		ValidatorsHash:  req.NextValidatorsHash,
 		ProposerAddress: req.ProposerAddress,
 		LastCommit:      toCoreCommitInfo(req.DecidedLastCommit),
-	})
+	}, c.blockStore, req.Height))
 
 	resp, newState, err := c.app.DeliverBlock(ciCtx, blockReq)
 	if err != nil {
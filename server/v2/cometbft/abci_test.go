@@ -1,9 +1,11 @@
 package cometbft
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"fmt"
 	"io"
 	"strings"
 	"testing"
@@ -20,6 +22,7 @@ import (
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
 	"cosmossdk.io/server/v2/appmanager"
+	"cosmossdk.io/server/v2/audit"
 	"cosmossdk.io/server/v2/cometbft/handlers"
 	cometmock "cosmossdk.io/server/v2/cometbft/internal/mock"
 	"cosmossdk.io/server/v2/cometbft/mempool"
@@ -323,6 +326,39 @@ func TestConsensus_CheckTx(t *testing.T) {
 	require.NotEqual(t, res.GasUsed, 0)
 }
 
+func TestConsensus_CheckTxAudit(t *testing.T) {
+	c := setUpConsensus(t, 100_000, mempool.NoOpMempool[mock.Tx]{})
+	var buf bytes.Buffer
+	c.auditLogger = audit.NewLogger(log.NewLogger(&buf, log.OutputJSONOption()), audit.Config{Enable: true, SampleRate: 1})
+
+	tx := mock.Tx{
+		Sender:   []byte("sender"),
+		Msg:      &gogotypes.BoolValue{Value: true},
+		GasLimit: 100_000,
+	}.Bytes()
+	_, err := c.CheckTx(context.Background(), &abciproto.CheckTxRequest{Tx: tx, Type: abciproto.CHECK_TX_TYPE_CHECK})
+	require.NoError(t, err)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, "check_tx", entry["endpoint"])
+	require.Equal(t, fmt.Sprintf("%X", sha256.Sum256(tx)), entry["tx_hash"])
+	require.Equal(t, float64(len(tx)), entry["size"])
+	require.Equal(t, float64(0), entry["code"])
+
+	// rechecks are not audited
+	buf.Reset()
+	_, err = c.CheckTx(context.Background(), &abciproto.CheckTxRequest{Tx: tx, Type: abciproto.CHECK_TX_TYPE_RECHECK})
+	require.NoError(t, err)
+	require.Zero(t, buf.Len())
+
+	// undecodable transactions are audited as rejected
+	_, err = c.CheckTx(context.Background(), &abciproto.CheckTxRequest{Tx: []byte{}, Type: abciproto.CHECK_TX_TYPE_CHECK})
+	require.Error(t, err)
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.NotEqual(t, float64(0), entry["code"])
+}
+
 func TestConsensus_ExtendVote(t *testing.T) {
 	c := setUpConsensus(t, 100_000, mempool.NoOpMempool[mock.Tx]{})
 
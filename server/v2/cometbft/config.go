@@ -4,6 +4,7 @@ import (
 	cmtcfg "github.com/cometbft/cometbft/config"
 
 	"cosmossdk.io/schema/indexer"
+	"cosmossdk.io/server/v2/audit"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 )
 
@@ -24,6 +25,7 @@ func DefaultAppTomlConfig() *AppTomlConfig {
 		Trace:           false,
 		Standalone:      false,
 		Mempool:         mempool.DefaultConfig(),
+		Audit:           audit.DefaultConfig(),
 		Indexer: indexer.IndexingConfig{
 			Target:            make(map[string]indexer.Config),
 			ChannelBufferSize: 1024,
@@ -44,6 +46,7 @@ type AppTomlConfig struct {
 	// Sub configs
 	Mempool mempool.Config         `mapstructure:"mempool" toml:"mempool" comment:"mempool defines the configuration for the SDK built-in app-side mempool implementations."`
 	Indexer indexer.IndexingConfig `mapstructure:"indexer" toml:"indexer" comment:"indexer defines the configuration for the SDK built-in indexer implementation."`
+	Audit   audit.Config           `mapstructure:"audit" toml:"audit" comment:"audit defines the audit logging of the transactions submitted to the node, checked in CheckTx."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
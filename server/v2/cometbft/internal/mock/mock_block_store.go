@@ -0,0 +1,33 @@
+package mock
+
+import (
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// MockBlockStore is an in-memory CometBFT block store, holding the block metas and
+// commits of the heights set in it.
+type MockBlockStore struct {
+	metas   map[int64]*cmttypes.BlockMeta
+	commits map[int64]*cmttypes.Commit
+}
+
+func NewMockBlockStore() *MockBlockStore {
+	return &MockBlockStore{
+		metas:   make(map[int64]*cmttypes.BlockMeta),
+		commits: make(map[int64]*cmttypes.Commit),
+	}
+}
+
+// SetBlock sets the meta of the block at the given height and the commit of that block.
+func (bs *MockBlockStore) SetBlock(height int64, meta *cmttypes.BlockMeta, commit *cmttypes.Commit) {
+	bs.metas[height] = meta
+	bs.commits[height] = commit
+}
+
+func (bs *MockBlockStore) LoadBlockMeta(height int64) *cmttypes.BlockMeta {
+	return bs.metas[height]
+}
+
+func (bs *MockBlockStore) LoadBlockCommit(height int64) *cmttypes.Commit {
+	return bs.commits[height]
+}
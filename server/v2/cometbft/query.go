@@ -2,6 +2,8 @@ package cometbft
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"strings"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
@@ -10,6 +12,8 @@ import (
 	errorsmod "cosmossdk.io/errors/v2"
 	"cosmossdk.io/server/v2/cometbft/types"
 	cometerrors "cosmossdk.io/server/v2/cometbft/types/errors"
+	"cosmossdk.io/server/v2/stf"
+	storev2 "cosmossdk.io/store/v2"
 )
 
 func (c *Consensus[T]) handleQueryP2P(path []string) (*abci.QueryResponse, error) {
@@ -41,7 +45,14 @@ func (c *Consensus[T]) handleQueryP2P(path []string) (*abci.QueryResponse, error
 // If the second element is 'simulate', it decodes the request data into a transaction,
 // simulates the transaction using the application, and returns the simulation result.
 // If the second element is 'version', it returns the version of the application.
-// If the second element is neither 'simulate' nor 'version', it returns an error indicating an unknown query.
+// If the second element is 'actor_sizes', it returns the approximate size of the state of each store actor,
+// if the store tracks it.
+// If the second element is 'store_actors', it returns the store actors registered by the app with the modules
+// owning them, and their approximate size if the store tracks it.
+// If the second element is 'panics', it returns the diagnostics of the most recent message handler panics,
+// newest first, optionally limited to the number given as third element.
+// If the second element is 'indexer_status', it returns the status of the indexer targets by name.
+// If the second element is none of the above, it returns an error indicating an unknown query.
 func (c *Consensus[T]) handlerQueryApp(ctx context.Context, path []string, req *abci.QueryRequest) (*abci.QueryResponse, error) {
 	if len(path) < 2 {
 		return nil, errorsmod.Wrap(
@@ -79,6 +90,87 @@ func (c *Consensus[T]) handlerQueryApp(ctx context.Context, path []string, req *
 			Value:     []byte(c.version),
 			Height:    req.Height,
 		}, nil
+
+	case "actor_sizes":
+		tracker, ok := c.store.(storev2.SizeTracker)
+		if !ok {
+			return nil, errorsmod.Wrap(cometerrors.ErrUnknownRequest, "store does not track actor sizes")
+		}
+
+		bz, err := json.Marshal(intoActorSizesResponse(tracker.ActorSizes()))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to marshal actor sizes")
+		}
+
+		return &abci.QueryResponse{
+			Codespace: cometerrors.RootCodespace,
+			Value:     bz,
+			Height:    req.Height,
+		}, nil
+
+	case "store_actors":
+		reporter, ok := c.app.(stf.StoreActorReporter)
+		if !ok {
+			return nil, errorsmod.Wrap(cometerrors.ErrUnknownRequest, "app does not report store actors")
+		}
+
+		var sizes []storev2.ActorSize
+		if tracker, ok := c.store.(storev2.SizeTracker); ok {
+			sizes = tracker.ActorSizes()
+		}
+
+		bz, err := json.Marshal(intoStoreActorsResponse(reporter.StoreActors(), sizes))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to marshal store actors")
+		}
+
+		return &abci.QueryResponse{
+			Codespace: cometerrors.RootCodespace,
+			Value:     bz,
+			Height:    req.Height,
+		}, nil
+
+	case "panics":
+		reporter, ok := c.app.(stf.PanicReporter)
+		if !ok {
+			return nil, errorsmod.Wrap(cometerrors.ErrUnknownRequest, "app does not report panics")
+		}
+
+		panics := reporter.RecentPanics()
+		if len(path) > 2 {
+			limit, err := strconv.Atoi(path[2])
+			if err != nil || limit < 0 {
+				return nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "invalid number of panics: %s", path[2])
+			}
+			panics = panics[:min(limit, len(panics))]
+		}
+
+		bz, err := json.Marshal(panics)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to marshal panics")
+		}
+
+		return &abci.QueryResponse{
+			Codespace: cometerrors.RootCodespace,
+			Value:     bz,
+			Height:    req.Height,
+		}, nil
+
+	case "indexer_status":
+		if c.indexerStatus == nil {
+			return nil, errorsmod.Wrap(cometerrors.ErrUnknownRequest, "indexing is disabled")
+		}
+
+		bz, err := json.Marshal(c.indexerStatus())
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to marshal indexer status")
+		}
+
+		return &abci.QueryResponse{
+			Codespace: cometerrors.RootCodespace,
+			Value:     bz,
+			Height:    req.Height,
+		}, nil
 	}
 
 	return nil, errorsmod.Wrapf(cometerrors.ErrUnknownRequest, "unknown query: %s", path)
@@ -129,3 +221,52 @@ func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.
 
 	return res, nil
 }
+
+// actorSize is the JSON representation of the size of a store actor returned
+// by the "/app/actor_sizes" query.
+type actorSize struct {
+	Actor    string `json:"actor"`
+	KeyCount int64  `json:"key_count"`
+	ByteSize int64  `json:"byte_size"`
+}
+
+func intoActorSizesResponse(sizes []storev2.ActorSize) []actorSize {
+	res := make([]actorSize, len(sizes))
+	for i, size := range sizes {
+		res[i] = actorSize{
+			Actor:    string(size.Actor),
+			KeyCount: size.KeyCount,
+			ByteSize: size.ByteSize,
+		}
+	}
+	return res
+}
+
+// storeActor is the JSON representation of a store actor returned by the
+// "/app/store_actors" query. The size is only set if the store tracks it.
+type storeActor struct {
+	Actor    string   `json:"actor"`
+	Modules  []string `json:"modules"`
+	KeyCount *int64   `json:"key_count,omitempty"`
+	ByteSize *int64   `json:"byte_size,omitempty"`
+}
+
+func intoStoreActorsResponse(actors []stf.StoreActor, sizes []storev2.ActorSize) []storeActor {
+	sizesByActor := make(map[string]storev2.ActorSize, len(sizes))
+	for _, size := range sizes {
+		sizesByActor[string(size.Actor)] = size
+	}
+
+	res := make([]storeActor, len(actors))
+	for i, actor := range actors {
+		res[i] = storeActor{
+			Actor:   string(actor.Actor),
+			Modules: actor.Modules,
+		}
+		if size, ok := sizesByActor[string(actor.Actor)]; ok {
+			res[i].KeyCount = &size.KeyCount
+			res[i].ByteSize = &size.ByteSize
+		}
+	}
+	return res
+}
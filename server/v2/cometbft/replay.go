@@ -0,0 +1,419 @@
+package cometbft
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	serverv2 "cosmossdk.io/server/v2"
+	serverstore "cosmossdk.io/server/v2/store"
+	storev2 "cosmossdk.io/store/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// ReplayReport is the result of replaying a committed block.
+type ReplayReport struct {
+	Height         uint64                `json:"height"`
+	TxResults      []ReplayTxResult      `json:"tx_results"`
+	BlockEvents    []ReplayEvent         `json:"block_events,omitempty"`
+	StateChanges   []ReplayStateChange   `json:"state_changes"`
+	Mismatches     int                   `json:"mismatches"`
+	CommittedBlock *ReplayCommittedBlock `json:"committed_block,omitempty"`
+}
+
+// ReplayCommittedBlock holds a summary of the committed block result, as
+// recorded by CometBFT.
+type ReplayCommittedBlock struct {
+	AppHash   string `json:"app_hash"`
+	NumEvents int    `json:"num_events"`
+}
+
+// ReplayTxResult compares the replayed result of a transaction with the
+// committed one. The log contains the full error when tracing is enabled.
+type ReplayTxResult struct {
+	Index            int           `json:"index"`
+	Code             uint32        `json:"code"`
+	Codespace        string        `json:"codespace,omitempty"`
+	Log              string        `json:"log,omitempty"`
+	GasWanted        int64         `json:"gas_wanted"`
+	GasUsed          int64         `json:"gas_used"`
+	Events           []ReplayEvent `json:"events,omitempty"`
+	CommittedCode    *uint32       `json:"committed_code,omitempty"`
+	CommittedGasUsed *int64        `json:"committed_gas_used,omitempty"`
+	Match            bool          `json:"match"`
+}
+
+// ReplayEvent is a flattened representation of an event emitted during the replay.
+type ReplayEvent struct {
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// ReplayStateChange compares a key written during the replay or changed in the
+// committed state with the value committed at the replayed height.
+type ReplayStateChange struct {
+	Actor     string `json:"actor"`
+	Key       string `json:"key"`
+	Replayed  string `json:"replayed,omitempty"`
+	Committed string `json:"committed,omitempty"`
+	Removed   bool   `json:"removed,omitempty"`
+	// Unreplayed is true if the key was changed in the committed state but not
+	// written during the replay.
+	Unreplayed bool `json:"unreplayed,omitempty"`
+	Match      bool `json:"match"`
+}
+
+// ReplayBlockCmd returns a command that loads the application state at height-1,
+// replays the block stored by CometBFT at the given height through the state
+// transition function, and reports the differences with the committed result.
+// It is meant to diagnose consensus failures and must be run on a stopped node.
+func ReplayBlockCmd[T transaction.Tx](newApp serverv2.AppCreator[T], txCodec transaction.Codec[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-block [height]",
+		Short: "Replay a committed block and print the state diffs and events against the committed result",
+		Long: `Replay a committed block through the state transition function on top of the state at height-1.
+The block is loaded from the CometBFT block store, the resulting state changes are compared with the
+state committed at the given height and the transaction results with the ones recorded by CometBFT.
+The replayed state is never committed. The node must be stopped while running this command.`,
+		Example: "<appd> debug replay-block 1024 --trace",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[0], err)
+			}
+			if height < 2 {
+				return errors.New("height must be greater than 1")
+			}
+
+			trace, err := cmd.Flags().GetBool(flagReplayTrace)
+			if err != nil {
+				return err
+			}
+
+			v := serverv2.GetViperFromCmd(cmd)
+			logger := serverv2.GetLoggerFromCmd(cmd)
+			cmtConfig := client.GetConfigFromCmd(cmd)
+
+			block, blockMeta, commitInfo, finalizeResp, err := loadCommittedBlock(cmtConfig, int64(height))
+			if err != nil {
+				return err
+			}
+
+			txs, err := decodeTxs(block.Txs.ToSliceOfBytes(), txCodec)
+			if err != nil {
+				return err
+			}
+
+			app := newApp(logger, v)
+			defer app.Close()
+
+			ctx := contextWithCometInfo(cmd.Context(), comet.Info{
+				Evidence:        toCoreEvidence(block.Evidence.Evidence.ToABCI()),
+				ValidatorsHash:  block.NextValidatorsHash,
+				ProposerAddress: block.ProposerAddress,
+				LastCommit:      withVoteTimestamps(toCoreCommitInfo(commitInfo), block.LastCommit),
+				BlockMetrics:    toCoreBlockMetrics(blockMeta),
+			})
+
+			resp, newState, err := app.ReplayBlock(ctx, &server.BlockRequest[T]{
+				Height:  height,
+				Time:    block.Time,
+				Hash:    block.Hash(),
+				AppHash: block.AppHash,
+				ChainId: block.ChainID,
+				Txs:     txs,
+			})
+			if err != nil {
+				return err
+			}
+
+			previousState, err := app.Store().StateAt(height - 1)
+			if err != nil {
+				return fmt.Errorf("unable to load committed state at height %d: %w", height-1, err)
+			}
+
+			committedState, err := app.Store().StateAt(height)
+			if err != nil {
+				return fmt.Errorf("unable to load committed state at height %d: %w", height, err)
+			}
+
+			committedChanges, err := committedStateChanges(app.Store(), height, previousState, committedState)
+			if err != nil {
+				return err
+			}
+
+			report, err := newReplayReport(height, resp, newState, committedState, committedChanges, finalizeResp, trace)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return printOutput(cmd, out)
+		},
+	}
+
+	cmd.Flags().Bool(flagReplayTrace, false, "Include the events and full errors of every replayed transaction")
+	cmd.Flags().StringP(FlagOutput, "o", OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+const flagReplayTrace = "trace"
+
+// loadCommittedBlock loads the block at the given height from the CometBFT block store,
+// alongside the commit info of the previous block and, when available, the
+// finalize block response recorded by CometBFT.
+func loadCommittedBlock(cfg *cmtcfg.Config, height int64) (*cmttypes.Block, *cmttypes.BlockMeta, abci.CommitInfo, *abci.FinalizeBlockResponse, error) {
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, nil, abci.CommitInfo{}, nil, err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, nil, abci.CommitInfo{}, nil, err
+	}
+	defer stateDB.Close()
+
+	block, blockMeta := cmtstore.NewBlockStore(blockStoreDB, cmtstore.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout)).LoadBlock(height)
+	if block == nil {
+		return nil, nil, abci.CommitInfo{}, nil, fmt.Errorf("block %d not found in block store", height)
+	}
+
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+
+	commitInfo := abci.CommitInfo{}
+	if block.LastCommit != nil {
+		valSet, err := stateStore.LoadValidators(height - 1)
+		if err != nil {
+			return nil, nil, abci.CommitInfo{}, nil, fmt.Errorf("unable to load validator set at height %d: %w", height-1, err)
+		}
+
+		commitInfo.Round = block.LastCommit.Round
+		for i, sig := range block.LastCommit.Signatures {
+			if i >= len(valSet.Validators) {
+				break
+			}
+			val := valSet.Validators[i]
+			commitInfo.Votes = append(commitInfo.Votes, abci.VoteInfo{
+				Validator:   abci.Validator{Address: val.Address, Power: val.VotingPower},
+				BlockIdFlag: cmtproto.BlockIDFlag(sig.BlockIDFlag),
+			})
+		}
+	}
+
+	// the finalize block response may have been discarded by the node, in which case
+	// only the state changes can be compared.
+	finalizeResp, err := stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		finalizeResp = nil
+	}
+
+	return block, blockMeta, commitInfo, finalizeResp, nil
+}
+
+// committedChange is a key changed in the committed state of an actor.
+type committedChange struct {
+	Actor []byte
+	serverstore.KVDiff
+}
+
+// committedStateChanges returns the changes committed at the given height, diffing
+// the state at height-1 and height of the actors whose commitment changed.
+func committedStateChanges(
+	rs storev2.RootStore,
+	height uint64,
+	previousState, committedState store.ReaderMap,
+) ([]committedChange, error) {
+	previousInfo, err := rs.GetStateCommitment().GetCommitInfo(height - 1)
+	if err != nil {
+		return nil, err
+	}
+	committedInfo, err := rs.GetStateCommitment().GetCommitInfo(height)
+	if err != nil {
+		return nil, err
+	}
+	if previousInfo == nil || committedInfo == nil {
+		return nil, fmt.Errorf("no commit info found at height %d or %d", height-1, height)
+	}
+
+	previousHashes := make(map[string][]byte, len(previousInfo.StoreInfos))
+	for _, si := range previousInfo.StoreInfos {
+		previousHashes[string(si.Name)] = si.CommitID.Hash
+	}
+
+	var changes []committedChange
+	for _, si := range committedInfo.StoreInfos {
+		if hash, ok := previousHashes[string(si.Name)]; ok && bytes.Equal(hash, si.CommitID.Hash) {
+			continue
+		}
+
+		_, err := serverstore.DiffActor(si.Name, previousState, committedState, func(kv serverstore.KVDiff) error {
+			changes = append(changes, committedChange{Actor: si.Name, KVDiff: kv})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to diff committed state of actor %s: %w", si.Name, err)
+		}
+	}
+
+	return changes, nil
+}
+
+// newReplayReport builds the replay report by comparing the replayed block
+// against the committed state and block results. The keys written during the
+// replay and the keys changed in the committed state are both compared, so that
+// a change missing from the replay is reported too.
+func newReplayReport(
+	height uint64,
+	resp *server.BlockResponse,
+	replayedState store.WriterMap,
+	committedState store.ReaderMap,
+	committedChanges []committedChange,
+	finalizeResp *abci.FinalizeBlockResponse,
+	trace bool,
+) (*ReplayReport, error) {
+	report := &ReplayReport{Height: height}
+
+	txResults, err := intoABCITxResults(resp.TxResults, nil, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, txResult := range txResults {
+		res := ReplayTxResult{
+			Index:     i,
+			Code:      txResult.Code,
+			Codespace: txResult.Codespace,
+			Log:       txResult.Log,
+			GasWanted: txResult.GasWanted,
+			GasUsed:   txResult.GasUsed,
+			Match:     true,
+		}
+		if trace {
+			res.Events = toReplayEvents(resp.TxResults[i].Events)
+		}
+
+		if finalizeResp != nil && i < len(finalizeResp.TxResults) {
+			committed := finalizeResp.TxResults[i]
+			res.CommittedCode = &committed.Code
+			res.CommittedGasUsed = &committed.GasUsed
+			res.Match = committed.Code == res.Code && committed.GasUsed == res.GasUsed
+		}
+		if !res.Match {
+			report.Mismatches++
+		}
+
+		report.TxResults = append(report.TxResults, res)
+	}
+
+	if trace {
+		report.BlockEvents = append(report.BlockEvents, toReplayEvents(resp.PreBlockEvents)...)
+		report.BlockEvents = append(report.BlockEvents, toReplayEvents(resp.BeginBlockEvents)...)
+		report.BlockEvents = append(report.BlockEvents, toReplayEvents(resp.EndBlockEvents)...)
+	}
+
+	if finalizeResp != nil {
+		report.CommittedBlock = &ReplayCommittedBlock{
+			AppHash:   hex.EncodeToString(finalizeResp.AppHash),
+			NumEvents: len(finalizeResp.Events),
+		}
+	}
+
+	stateChanges, err := replayedState.GetStateChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	replayedKeys := make(map[string]struct{})
+	for _, changes := range stateChanges {
+		committedActorState, err := committedState.GetReader(changes.Actor)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get committed state for actor %s: %w", changes.Actor, err)
+		}
+
+		for _, kv := range changes.StateChanges {
+			committed, err := committedActorState.Get(kv.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			change := ReplayStateChange{
+				Actor:     string(changes.Actor),
+				Key:       hex.EncodeToString(kv.Key),
+				Committed: hex.EncodeToString(committed),
+				Removed:   kv.Remove,
+			}
+			if kv.Remove {
+				change.Match = committed == nil
+			} else {
+				change.Replayed = hex.EncodeToString(kv.Value)
+				change.Match = bytes.Equal(kv.Value, committed)
+			}
+			if !change.Match {
+				report.Mismatches++
+			}
+
+			report.StateChanges = append(report.StateChanges, change)
+			replayedKeys[string(changes.Actor)+"/"+change.Key] = struct{}{}
+		}
+	}
+
+	for _, change := range committedChanges {
+		key := hex.EncodeToString(change.Key)
+		if _, ok := replayedKeys[string(change.Actor)+"/"+key]; ok {
+			continue
+		}
+
+		report.Mismatches++
+		report.StateChanges = append(report.StateChanges, ReplayStateChange{
+			Actor:      string(change.Actor),
+			Key:        key,
+			Committed:  hex.EncodeToString(change.New),
+			Unreplayed: true,
+		})
+	}
+
+	return report, nil
+}
+
+func toReplayEvents(events []event.Event) []ReplayEvent {
+	res := make([]ReplayEvent, 0, len(events))
+	for _, e := range events {
+		attrs, err := e.Attributes()
+		if err != nil {
+			res = append(res, ReplayEvent{Type: e.Type})
+			continue
+		}
+
+		re := ReplayEvent{Type: e.Type, Attributes: make(map[string]string, len(attrs))}
+		for _, attr := range attrs {
+			re.Attributes[attr.Key] = attr.Value
+		}
+		res = append(res, re)
+	}
+	return res
+}
@@ -0,0 +1,88 @@
+package cometbft
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	cometmock "cosmossdk.io/server/v2/cometbft/internal/mock"
+	"cosmossdk.io/server/v2/stf/branch"
+	serverstore "cosmossdk.io/server/v2/store"
+)
+
+func TestNewReplayReport(t *testing.T) {
+	ss := cometmock.NewMockStorage(log.NewNopLogger(), t.TempDir())
+	sc := cometmock.NewMockCommiter(log.NewNopLogger(), string(actorName))
+	mockStore := cometmock.NewMockStore(ss, sc)
+
+	// commit height 1, the replay base
+	_, err := mockStore.Commit(&store.Changeset{Changes: []store.StateChanges{{
+		Actor:        actorName,
+		StateChanges: []store.KVPair{{Key: []byte("a"), Value: []byte("0")}},
+	}}})
+	require.NoError(t, err)
+
+	// commit height 2 with three keys
+	_, err = mockStore.Commit(&store.Changeset{Changes: []store.StateChanges{{
+		Actor: actorName,
+		StateChanges: []store.KVPair{
+			{Key: []byte("a"), Value: []byte("1")},
+			{Key: []byte("b"), Value: []byte("2")},
+			{Key: []byte("c"), Value: []byte("4")},
+		},
+	}}})
+	require.NoError(t, err)
+
+	previousState, err := mockStore.StateAt(1)
+	require.NoError(t, err)
+	committedState, err := mockStore.StateAt(2)
+	require.NoError(t, err)
+
+	// replay diverges on key b and doesn't write key c
+	replayed := branch.DefaultNewWriterMap(previousState)
+	writer, err := replayed.GetWriter(actorName)
+	require.NoError(t, err)
+	require.NoError(t, writer.Set([]byte("a"), []byte("1")))
+	require.NoError(t, writer.Set([]byte("b"), []byte("3")))
+
+	resp := &server.BlockResponse{
+		TxResults: []server.TxResult{
+			{GasWanted: 100, GasUsed: 50},
+			{GasWanted: 100, GasUsed: 60, Error: errors.New("boom")},
+		},
+	}
+	finalizeResp := &abci.FinalizeBlockResponse{
+		TxResults: []*abci.ExecTxResult{
+			{GasWanted: 100, GasUsed: 50},
+			{GasWanted: 100, GasUsed: 60},
+		},
+	}
+
+	committedChanges := []committedChange{
+		{Actor: actorName, KVDiff: serverstore.KVDiff{Key: []byte("a"), Old: []byte("0"), New: []byte("1")}},
+		{Actor: actorName, KVDiff: serverstore.KVDiff{Key: []byte("b"), New: []byte("2")}},
+		{Actor: actorName, KVDiff: serverstore.KVDiff{Key: []byte("c"), New: []byte("4")}},
+	}
+
+	report, err := newReplayReport(2, resp, replayed, committedState, committedChanges, finalizeResp, false)
+	require.NoError(t, err)
+	require.Len(t, report.TxResults, 2)
+	require.True(t, report.TxResults[0].Match)
+	require.False(t, report.TxResults[1].Match)
+	require.Len(t, report.StateChanges, 3)
+	require.True(t, report.StateChanges[0].Match)
+	require.False(t, report.StateChanges[1].Match)
+	require.Equal(t, ReplayStateChange{
+		Actor:      string(actorName),
+		Key:        hex.EncodeToString([]byte("c")),
+		Committed:  hex.EncodeToString([]byte("4")),
+		Unreplayed: true,
+	}, report.StateChanges[2])
+	require.Equal(t, 3, report.Mismatches)
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 
+	dbm "github.com/cometbft/cometbft-db"
 	abciserver "github.com/cometbft/cometbft/abci/server"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
@@ -15,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	cmtstore "github.com/cometbft/cometbft/store"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -22,6 +24,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/schema/indexer"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/audit"
 	cometlog "cosmossdk.io/server/v2/cometbft/log"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 	"cosmossdk.io/store/v2/snapshots"
@@ -35,6 +38,7 @@ var (
 	_ serverv2.ServerComponent[transaction.Tx] = (*CometBFTServer[transaction.Tx])(nil)
 	_ serverv2.HasCLICommands                  = (*CometBFTServer[transaction.Tx])(nil)
 	_ serverv2.HasStartFlags                   = (*CometBFTServer[transaction.Tx])(nil)
+	_ serverv2.HasStartupReport                = (*CometBFTServer[transaction.Tx])(nil)
 )
 
 type CometBFTServer[T transaction.Tx] struct {
@@ -124,6 +128,11 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 	consensus.addrPeerFilter = s.serverOptions.AddrPeerFilter
 	consensus.idPeerFilter = s.serverOptions.IdPeerFilter
 
+	if err := s.config.AppTomlConfig.Audit.Validate(); err != nil {
+		return fmt.Errorf("invalid audit config: %w", err)
+	}
+	consensus.auditLogger = audit.NewLogger(s.logger, s.config.AppTomlConfig.Audit)
+
 	ss := rs.GetStateStorage().(snapshots.StorageSnapshotter)
 	sc := rs.GetStateCommitment().(snapshots.CommitSnapshotter)
 
@@ -144,6 +153,11 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 			return fmt.Errorf("failed to start indexing: %w", err)
 		}
 		consensus.listener = &listener.Listener
+		consensus.indexerStatus = listener.Status
+
+		if err := setCheckpointPruneBarrier(rs, listener); err != nil {
+			return err
+		}
 	}
 
 	s.Consensus = consensus
@@ -151,6 +165,30 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 	return nil
 }
 
+// pruneBarrierSetter is implemented by the root stores whose pruning can wait on a barrier.
+type pruneBarrierSetter interface {
+	SetPruneBarrier(barrier func(version uint64) error)
+}
+
+// setCheckpointPruneBarrier makes the root store wait, before pruning the state of a block, until
+// the critical indexer targets persisted that block, as they could never index it once pruned.
+func setCheckpointPruneBarrier(rs any, target indexer.IndexingTarget) error {
+	if target.Checkpoints == nil {
+		return nil
+	}
+
+	setter, ok := rs.(pruneBarrierSetter)
+	if !ok {
+		return fmt.Errorf("critical indexer targets are not supported by the root store %T", rs)
+	}
+
+	setter.SetPruneBarrier(func(version uint64) error {
+		return target.Checkpoints.Wait(context.Background(), version, target.CheckpointTimeout)
+	})
+
+	return nil
+}
+
 func (s *CometBFTServer[T]) Name() string {
 	return ServerName
 }
@@ -189,7 +227,7 @@ func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 		nodeKey,
 		proxy.NewConsensusSyncLocalClientCreator(s.Consensus),
 		getGenDocProvider(s.config.ConfigTomlConfig),
-		cmtcfg.DefaultDBProvider,
+		s.blockStoreDBProvider(),
 		node.DefaultMetricsProvider(s.config.ConfigTomlConfig.Instrumentation),
 		wrappedLogger,
 	)
@@ -200,6 +238,25 @@ func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 	return s.Node.Start()
 }
 
+// blockStoreDBProvider returns the CometBFT DB provider, which also sets the block store of the
+// consensus once its DB is opened. NewNode replays the stored blocks the application is missing
+// during the handshake, so the block store must be set before the node is created for the comet
+// info of the replayed blocks to match the one of the blocks finalized live.
+func (s *CometBFTServer[T]) blockStoreDBProvider() cmtcfg.DBProvider {
+	return func(ctx *cmtcfg.DBContext) (dbm.DB, error) {
+		db, err := cmtcfg.DefaultDBProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if ctx.ID == "blockstore" {
+			s.Consensus.blockStore = cmtstore.NewBlockStore(db, cmtstore.WithDBKeyLayout(ctx.Config.Storage.ExperimentalKeyLayout))
+		}
+
+		return db, nil
+	}
+}
+
 func (s *CometBFTServer[T]) Stop(context.Context) error {
 	if s.Node != nil && s.Node.IsRunning() {
 		return s.Node.Stop()
@@ -303,6 +360,33 @@ func (s *CometBFTServer[T]) Config() any {
 	return s.config.AppTomlConfig
 }
 
+// StartupReport implements serverv2.HasStartupReport.
+// It reports the listen addresses of the node and the app, and the indexer targets by name and type.
+func (s *CometBFTServer[T]) StartupReport() (bool, map[string]any) {
+	appCfg := s.Config().(*AppTomlConfig)
+
+	targets := make(map[string]string, len(appCfg.Indexer.Target))
+	for name, target := range appCfg.Indexer.Target {
+		targets[name] = target.Type
+	}
+
+	details := map[string]any{
+		"address":         appCfg.Address,
+		"transport":       appCfg.Transport,
+		"standalone":      appCfg.Standalone,
+		"indexer_targets": targets,
+	}
+
+	if cmtCfg := s.config.ConfigTomlConfig; cmtCfg != nil {
+		details["rpc_address"] = cmtCfg.RPC.ListenAddress
+		details["p2p_address"] = cmtCfg.P2P.ListenAddress
+		details["db_backend"] = cmtCfg.DBBackend
+		details["tx_indexer"] = cmtCfg.TxIndex.Indexer
+	}
+
+	return true, details
+}
+
 // WriteCustomConfigAt writes the default cometbft config.toml
 func (s *CometBFTServer[T]) WriteCustomConfigAt(configPath string) error {
 	cfg := &Config{ConfigTomlConfig: cmtcfg.DefaultConfig()}
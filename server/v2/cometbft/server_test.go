@@ -0,0 +1,76 @@
+package cometbft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/root"
+)
+
+func TestCheckpointPruneBarrier(t *testing.T) {
+	pruningOption := &storev2.PruningOption{KeepRecent: 1, Interval: 1}
+	rs, err := root.CreateRootStore(&root.FactoryOptions{
+		Logger:  log.NewNopLogger(),
+		RootDir: t.TempDir(),
+		Options: root.Options{
+			SSType:          root.SSTypeSQLite,
+			SCType:          root.SCTypeIavl,
+			SSPruningOption: pruningOption,
+			SCPruningOption: pruningOption,
+			IavlConfig:      iavl.DefaultConfig(),
+		},
+		StoreKeys: []string{"acc"},
+		SCRawDB:   db.NewMemDB(),
+	})
+	require.NoError(t, err)
+	defer rs.Close()
+
+	require.NoError(t, setCheckpointPruneBarrier(rs, indexer.IndexingTarget{}))
+	require.ErrorContains(t, setCheckpointPruneBarrier(struct{}{}, indexer.IndexingTarget{Checkpoints: appdata.NewCheckpointBarrier()}), "not supported")
+
+	target := indexer.IndexingTarget{Checkpoints: appdata.NewCheckpointBarrier()}
+	critical := target.Checkpoints.Listener("critical", appdata.Listener{})
+	require.NoError(t, setCheckpointPruneBarrier(rs, target))
+
+	commit := func(version int) error {
+		_, err := rs.Commit(corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{
+			"acc": {{Key: []byte{byte(version)}, Value: []byte("value")}},
+		}))
+		return err
+	}
+
+	// nothing is pruned at the first height, and the second one prunes up to the genesis state
+	require.NoError(t, commit(1))
+	require.NoError(t, commit(2))
+
+	// the third height prunes the first one, once the critical target persisted it
+	done := make(chan error)
+	go func() {
+		done <- commit(3)
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("pruning did not wait for the critical target: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	ack, err := critical.Commit(appdata.CommitData{Height: 1})
+	require.NoError(t, err)
+	require.NoError(t, ack())
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("pruning still waiting once the critical target persisted the pruned height")
+	}
+}
@@ -2,8 +2,10 @@ package cometbft
 
 import (
 	"context"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/core/comet"
 	corecontext "cosmossdk.io/core/context"
@@ -68,3 +70,58 @@ func toCoreExtendedCommitInfo(commit abci.ExtendedCommitInfo) comet.CommitInfo {
 
 	return ci
 }
+
+// blockStore is the part of the CometBFT block store used to complete the comet info with the data
+// CometBFT does not pass through ABCI.
+type blockStore interface {
+	LoadBlockMeta(height int64) *cmttypes.BlockMeta
+	LoadBlockCommit(height int64) *cmttypes.Commit
+}
+
+// withBlockStoreInfo completes the comet info of the block at the given height with its block metrics
+// and the vote timestamps of its last commit, read from the block store.
+// CometBFT stores the block before finalizing it, so they are available in FinalizeBlock.
+func withBlockStoreInfo(info comet.Info, bs blockStore, height int64) comet.Info {
+	if bs == nil {
+		return info
+	}
+
+	info.BlockMetrics = toCoreBlockMetrics(bs.LoadBlockMeta(height))
+	if height > 1 {
+		info.LastCommit = withVoteTimestamps(info.LastCommit, bs.LoadBlockCommit(height-1))
+	}
+
+	return info
+}
+
+// toCoreBlockMetrics takes comet block meta and returns sdk block metrics
+func toCoreBlockMetrics(meta *cmttypes.BlockMeta) comet.BlockMetrics {
+	if meta == nil {
+		return comet.BlockMetrics{}
+	}
+
+	return comet.BlockMetrics{
+		Parts: meta.BlockID.PartSetHeader.Total,
+		Size:  int64(meta.BlockSize),
+	}
+}
+
+// withVoteTimestamps sets the timestamps of the votes of the commit info from the signatures of the commit.
+func withVoteTimestamps(ci comet.CommitInfo, commit *cmttypes.Commit) comet.CommitInfo {
+	if commit == nil {
+		return ci
+	}
+
+	timestamps := make(map[string]time.Time, len(commit.Signatures))
+	for _, sig := range commit.Signatures {
+		if sig.BlockIDFlag != cmttypes.BlockIDFlagAbsent {
+			timestamps[string(sig.ValidatorAddress)] = sig.Timestamp
+		}
+	}
+
+	for i, vote := range ci.Votes {
+		ci.Votes[i].Timestamp = timestamps[string(vote.Validator.Address)]
+	}
+
+	return ci
+}
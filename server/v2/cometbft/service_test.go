@@ -0,0 +1,74 @@
+package cometbft
+
+import (
+	"testing"
+	"time"
+
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/transaction"
+	cometmock "cosmossdk.io/server/v2/cometbft/internal/mock"
+)
+
+func TestWithBlockStoreInfo(t *testing.T) {
+	val1, val2, val3 := []byte("validator1"), []byte("validator2"), []byte("validator3")
+	voteTime := time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC)
+
+	bs := cometmock.NewMockBlockStore()
+	bs.SetBlock(1, &cmttypes.BlockMeta{}, &cmttypes.Commit{
+		Height: 1,
+		Signatures: []cmttypes.CommitSig{
+			{BlockIDFlag: cmttypes.BlockIDFlagCommit, ValidatorAddress: val1, Timestamp: voteTime},
+			{BlockIDFlag: cmttypes.BlockIDFlagNil, ValidatorAddress: val2, Timestamp: voteTime.Add(time.Second)},
+			{BlockIDFlag: cmttypes.BlockIDFlagAbsent},
+		},
+	})
+	bs.SetBlock(2, &cmttypes.BlockMeta{
+		BlockID:   cmttypes.BlockID{PartSetHeader: cmttypes.PartSetHeader{Total: 3}},
+		BlockSize: 150_000,
+	}, nil)
+
+	info := withBlockStoreInfo(comet.Info{
+		LastCommit: toCoreCommitInfo(abciproto.CommitInfo{
+			Votes: []abciproto.VoteInfo{
+				{Validator: abciproto.Validator{Address: val1, Power: 10}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+				{Validator: abciproto.Validator{Address: val2, Power: 10}, BlockIdFlag: cmtproto.BlockIDFlagNil},
+				{Validator: abciproto.Validator{Address: val3, Power: 10}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+			},
+		}),
+	}, bs, 2)
+
+	require.Equal(t, comet.BlockMetrics{Parts: 3, Size: 150_000}, info.BlockMetrics)
+	require.Equal(t, voteTime, info.LastCommit.Votes[0].Timestamp)
+	require.Equal(t, voteTime.Add(time.Second), info.LastCommit.Votes[1].Timestamp)
+	require.True(t, info.LastCommit.Votes[2].Timestamp.IsZero())
+
+	// without a block store, e.g. in standalone mode, the info is left as is
+	info = withBlockStoreInfo(comet.Info{}, nil, 2)
+	require.Equal(t, comet.BlockMetrics{}, info.BlockMetrics)
+
+	// a block missing from the block store has no metrics
+	info = withBlockStoreInfo(comet.Info{}, bs, 5)
+	require.Equal(t, comet.BlockMetrics{}, info.BlockMetrics)
+}
+
+func TestBlockStoreDBProvider(t *testing.T) {
+	cfg := cmtcfg.DefaultConfig()
+	cfg.DBBackend = "memdb"
+	s := &CometBFTServer[transaction.Tx]{Consensus: &Consensus[transaction.Tx]{}}
+	dbProvider := s.blockStoreDBProvider()
+
+	_, err := dbProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	require.NoError(t, err)
+	require.Nil(t, s.Consensus.blockStore)
+
+	// the block store is set as soon as its DB is opened, before the node replays the blocks
+	_, err = dbProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	require.NoError(t, err)
+	require.NotNil(t, s.Consensus.blockStore)
+}
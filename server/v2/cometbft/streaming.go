@@ -98,7 +98,7 @@ func (c *Consensus[T]) streamDeliverBlockChanges(
 	}
 	// stream the CommitData to the listener.
 	if c.listener.Commit != nil {
-		if completionCallback, err := c.listener.Commit(appdata.CommitData{}); err != nil {
+		if completionCallback, err := c.listener.Commit(appdata.CommitData{Height: uint64(height)}); err != nil {
 			return err
 		} else if completionCallback != nil {
 			if err := completionCallback(); err != nil {
@@ -2,6 +2,7 @@ package cometbft
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"math"
@@ -19,6 +20,7 @@ import (
 	"cosmossdk.io/core/server"
 	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors/v2"
+	"cosmossdk.io/server/v2/audit"
 	consensus "cosmossdk.io/x/consensus/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -400,3 +402,24 @@ func uint64ToInt64(u uint64) int64 {
 	}
 	return int64(u)
 }
+
+// checkTxAuditEntry returns the audit entry of the check of a new transaction.
+// The peer is not known, as CometBFT does not pass it to the application.
+func checkTxAuditEntry(tx []byte, resp *abci.CheckTxResponse, err error, latency time.Duration) audit.Entry {
+	hash := sha256.Sum256(tx)
+	entry := audit.Entry{
+		Endpoint: "check_tx",
+		TxHash:   hash[:],
+		Size:     len(tx),
+		Latency:  latency,
+	}
+
+	switch {
+	case err != nil:
+		entry.Codespace, entry.Code, _ = errorsmod.ABCIInfo(err, false)
+	case resp != nil:
+		entry.Codespace, entry.Code = resp.Codespace, resp.Code
+	}
+
+	return entry
+}
@@ -116,6 +116,10 @@ func createStartCommand[T transaction.Tx](
 				return err
 			}
 
+			if err := emitStartupReport(l, v, server); err != nil {
+				return err
+			}
+
 			ctx, cancelFn := context.WithCancel(cmd.Context())
 			go func() {
 				sigCh := make(chan os.Signal, 1)
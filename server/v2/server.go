@@ -182,6 +182,7 @@ func (s *Server[T]) StartCmdFlags() *pflag.FlagSet {
 	flags := pflag.NewFlagSet(s.Name(), pflag.ExitOnError)
 	flags.String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	flags.String(FlagCPUProfiling, "", "Enable CPU profiling and write to the specified file")
+	flags.String(FlagStartupReport, "", "Write the startup report, listing the configuration of all server components, to the specified JSON file")
 
 	return flags
 }
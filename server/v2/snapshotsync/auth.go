@@ -0,0 +1,79 @@
+package snapshotsync
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// authInterceptors returns the interceptors rejecting the calls which do not authenticate
+// with one of the given bearer tokens.
+func authInterceptors(tokens []string) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	authenticate := func(ctx context.Context) error {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, value := range md.Get(authorizationHeader) {
+			token, ok := strings.CutPrefix(value, bearerPrefix)
+			if !ok {
+				continue
+			}
+			for _, t := range tokens {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+					return nil
+				}
+			}
+		}
+
+		return status.Error(codes.Unauthenticated, "invalid or missing bearer token")
+	}
+
+	unary := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authenticate(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authenticate(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+
+	return unary, stream
+}
+
+// tokenCredentials authenticates the calls of a client with a bearer token.
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+var _ credentials.PerRPCCredentials = tokenCredentials{}
+
+// NewTokenCredentials returns the credentials authenticating the calls to a snapshot server with
+// the given bearer token. Unless insecure is set, the token is only sent over TLS connections.
+func NewTokenCredentials(token string, insecure bool) credentials.PerRPCCredentials {
+	return tokenCredentials{token: token, requireTLS: !insecure}
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (c tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{authorizationHeader: bearerPrefix + c.token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
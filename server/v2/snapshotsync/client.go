@@ -0,0 +1,221 @@
+package snapshotsync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+const (
+	FlagHeight   = "height"
+	FlagFormat   = "format"
+	FlagToken    = "token"
+	FlagCAFile   = "ca-file"
+	FlagInsecure = "insecure"
+)
+
+// Fetch fetches the snapshot of the given height and format from a snapshot server and saves it
+// into the store, from which it can be restored. The latest snapshot of the format is fetched when
+// the height is 0. The chunks are checked against the chunk hashes of the snapshot metadata.
+func Fetch(ctx context.Context, client SnapshotServiceClient, store *snapshots.Store, height uint64, format uint32) (*types.Snapshot, error) {
+	list, err := client.ListSnapshots(ctx, &ListSnapshotsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	var snapshot *types.Snapshot
+	for _, s := range list.Snapshots {
+		if s.Format == format && (height == 0 || s.Height == height) {
+			snapshot = s
+			break
+		}
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("no snapshot at height %d and format %d on the server", height, format)
+	}
+	if len(snapshot.Metadata.ChunkHashes) != int(snapshot.Chunks) {
+		return nil, fmt.Errorf("snapshot at height %d has %d chunks but %d chunk hashes", snapshot.Height, snapshot.Chunks, len(snapshot.Metadata.ChunkHashes))
+	}
+
+	if local, err := store.Get(snapshot.Height, snapshot.Format); err != nil {
+		return nil, err
+	} else if local != nil {
+		return nil, fmt.Errorf("snapshot at height %d and format %d already exists in the local store", snapshot.Height, snapshot.Format)
+	}
+
+	type saveResult struct {
+		snapshot *types.Snapshot
+		err      error
+	}
+	chunks := make(chan io.ReadCloser)
+	saved := make(chan saveResult, 1)
+	go func() {
+		s, err := store.Save(snapshot.Height, snapshot.Format, chunks)
+		saved <- saveResult{snapshot: s, err: err}
+	}()
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := fetchChunk(ctx, client, snapshot, i)
+		if err != nil {
+			// fail the save with the error, so that the partial snapshot is not saved
+			pr, pw := io.Pipe()
+			_ = pw.CloseWithError(err)
+			chunks <- pr
+			close(chunks)
+			<-saved
+			_ = store.Delete(snapshot.Height, snapshot.Format)
+			return nil, err
+		}
+		chunks <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(chunks)
+
+	res := <-saved
+	if res.err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", res.err)
+	}
+	if !bytes.Equal(res.snapshot.Hash, snapshot.Hash) {
+		_ = store.Delete(snapshot.Height, snapshot.Format)
+		return nil, fmt.Errorf("saved snapshot hash %X does not match the snapshot hash %X", res.snapshot.Hash, snapshot.Hash)
+	}
+
+	return snapshot, nil
+}
+
+// fetchChunk fetches a chunk of the snapshot and checks it against its hash.
+func fetchChunk(ctx context.Context, client SnapshotServiceClient, snapshot *types.Snapshot, index uint32) ([]byte, error) {
+	stream, err := client.LoadChunk(ctx, &LoadChunkRequest{Height: snapshot.Height, Format: snapshot.Format, Chunk: index})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chunk %d: %w", index, err)
+	}
+
+	var chunk []byte
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load chunk %d: %w", index, err)
+		}
+		chunk = append(chunk, res.Data...)
+	}
+
+	hash := sha256.Sum256(chunk)
+	if !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[index]) {
+		return nil, fmt.Errorf("chunk %d hash %X does not match the snapshot chunk hash %X", index, hash[:], snapshot.Metadata.ChunkHashes[index])
+	}
+
+	return chunk, nil
+}
+
+// FetchSnapshotCmd returns the command fetching a snapshot from a snapshot server into the
+// local snapshot store.
+func (s *Server[T]) FetchSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fetch <address>",
+		Short: "Fetch a snapshot from a snapshot server into the local snapshot store",
+		Long: `Fetch a snapshot from a snapshot server into the local snapshot store.
+The latest snapshot is fetched unless a height is given. Once fetched, the snapshot can be
+restored with the restore command of the store.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := serverv2.GetViperFromCmd(cmd)
+
+			height, err := cmd.Flags().GetUint64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetUint32(FlagFormat)
+			if err != nil {
+				return err
+			}
+			token, err := cmd.Flags().GetString(FlagToken)
+			if err != nil {
+				return err
+			}
+			caFile, err := cmd.Flags().GetString(FlagCAFile)
+			if err != nil {
+				return err
+			}
+			insecureConn, err := cmd.Flags().GetBool(FlagInsecure)
+			if err != nil {
+				return err
+			}
+
+			transportCreds, err := clientTransportCredentials(caFile, insecureConn)
+			if err != nil {
+				return err
+			}
+
+			conn, err := grpc.NewClient(args[0],
+				grpc.WithTransportCredentials(transportCreds),
+				grpc.WithPerRPCCredentials(NewTokenCredentials(token, insecureConn)),
+			)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			store, err := snapshots.NewStore(filepath.Join(v.GetString(serverv2.FlagHome), "data", "snapshots"))
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := Fetch(cmd.Context(), NewSnapshotServiceClient(conn), store, height, format)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot fetched at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeight, 0, "Height of the snapshot to fetch, default to the latest snapshot")
+	cmd.Flags().Uint32(FlagFormat, types.CurrentFormat, "Format of the snapshot to fetch")
+	cmd.Flags().String(FlagToken, "", "Bearer token authenticating to the snapshot server")
+	cmd.Flags().String(FlagCAFile, "", "Path of the PEM encoded certificate authorities verifying the server certificate, default to the system ones")
+	cmd.Flags().Bool(FlagInsecure, false, "Connect to the snapshot server without TLS")
+	_ = cmd.MarkFlagRequired(FlagToken)
+
+	return cmd
+}
+
+// clientTransportCredentials returns the transport credentials of the connection to a snapshot server.
+func clientTransportCredentials(caFile string, insecureConn bool) (credentials.TransportCredentials, error) {
+	if insecureConn {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read certificate authorities: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
@@ -0,0 +1,77 @@
+package snapshotsync
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/server/v2/api/grpc"
+)
+
+// DefaultConfig returns the default configuration of the snapshot server, which is disabled.
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:        false,
+		Address:       "localhost:9095",
+		ChunkPartSize: 1024 * 1024,
+	}
+}
+
+// Config defines the configuration of the snapshot server.
+type Config struct {
+	// Enable defines if the snapshot server should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the snapshot server should be enabled."`
+
+	// Address defines the address the snapshot server listens on.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the address the snapshot server listens on."`
+
+	// Tokens defines the bearer tokens the clients authenticate with. At least one token must be set
+	// when the server is enabled.
+	Tokens []string `mapstructure:"tokens" toml:"tokens" comment:"Tokens defines the bearer tokens the clients authenticate with.\nAt least one token must be set when the server is enabled."`
+
+	// TLS defines the TLS configuration of the snapshot server.
+	TLS grpc.TLSConfig `mapstructure:"tls" toml:"tls" comment:"TLS defines the TLS configuration of the snapshot server."`
+
+	// MaxSendRate defines the maximum rate in bytes per second the snapshot chunks are sent at,
+	// shared by all the clients. 0 means unlimited.
+	MaxSendRate uint64 `mapstructure:"max-send-rate" toml:"max-send-rate" comment:"MaxSendRate defines the maximum rate in bytes per second the snapshot chunks are sent at, shared by all the clients.\n0 means unlimited."`
+
+	// ChunkPartSize defines the size in bytes of the parts the snapshot chunks are streamed in.
+	ChunkPartSize int `mapstructure:"chunk-part-size" toml:"chunk-part-size" comment:"ChunkPartSize defines the size in bytes of the parts the snapshot chunks are streamed in."`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.Address == "" {
+		return errors.New("snapshot server address cannot be empty")
+	}
+	if len(c.Tokens) == 0 {
+		return errors.New("snapshot server requires at least one token")
+	}
+	for _, token := range c.Tokens {
+		if token == "" {
+			return errors.New("snapshot server tokens cannot be empty")
+		}
+	}
+	if c.TLS.Enabled() != (c.TLS.KeyFile != "") {
+		return errors.New("snapshot server TLS certificate and key files must be set together")
+	}
+	if c.ChunkPartSize <= 0 {
+		return fmt.Errorf("snapshot server chunk part size must be positive, got %d", c.ChunkPartSize)
+	}
+
+	return nil
+}
+
+// CfgOption is a function that allows to overwrite the default server configuration.
+type CfgOption func(*Config)
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
@@ -0,0 +1,169 @@
+// Package snapshotsync provides a server component serving the state snapshots of a node over
+// authenticated gRPC, and the commands fetching them from a snapshot server into the local
+// snapshot store, so that operators can bootstrap nodes from their own snapshot servers in
+// addition to the CometBFT p2p state sync.
+package snapshotsync
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/store/v2/snapshots"
+)
+
+var (
+	_ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasConfig                       = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasCLICommands                  = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasStartupReport                = (*Server[transaction.Tx])(nil)
+)
+
+const ServerName = "snapshot-sync"
+
+// Server serves the snapshots of the local snapshot store of the node over gRPC.
+type Server[T transaction.Tx] struct {
+	logger     log.Logger
+	config     *Config
+	cfgOptions []CfgOption
+	home       string
+
+	grpcSrv *grpc.Server
+}
+
+// New creates a new snapshot server.
+func New[T transaction.Tx](cfgOptions ...CfgOption) *Server[T] {
+	return &Server[T]{
+		cfgOptions: cfgOptions,
+	}
+}
+
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Init(_ serverv2.AppI[T], cfg map[string]any, logger log.Logger) error {
+	serverCfg := s.Config().(*Config)
+	if len(cfg) > 0 {
+		if err := serverv2.UnmarshalSubConfig(cfg, s.Name(), &serverCfg); err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+	if err := serverCfg.Validate(); err != nil {
+		return err
+	}
+
+	s.home, _ = cfg[serverv2.FlagHome].(string)
+	s.config = serverCfg
+	s.logger = logger.With(log.ModuleKey, s.Name())
+
+	return nil
+}
+
+func (s *Server[T]) Start(ctx context.Context) error {
+	if !s.config.Enable {
+		s.logger.Info(fmt.Sprintf("%s server is disabled via config", s.Name()))
+		return nil
+	}
+
+	snapshotStore, err := snapshots.NewStore(filepath.Join(s.home, "data", "snapshots"))
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot store: %w", err)
+	}
+
+	grpcSrv, err := newGRPCServer(s.config, snapshotStore)
+	if err != nil {
+		return err
+	}
+	s.grpcSrv = grpcSrv
+
+	lis, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on address %s: %w", s.config.Address, err)
+	}
+
+	s.logger.Info("starting snapshot server...", "address", s.config.Address)
+	if err := s.grpcSrv.Serve(lis); err != nil {
+		return fmt.Errorf("failed to start snapshot server on address %s: %w", s.config.Address, err)
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(context.Context) error {
+	if !s.config.Enable || s.grpcSrv == nil {
+		return nil
+	}
+
+	s.logger.Info("stopping snapshot server...", "address", s.config.Address)
+	s.grpcSrv.GracefulStop()
+	return nil
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || s.config.Address == "" {
+		cfg := DefaultConfig()
+
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
+
+// CLICommands implements serverv2.HasCLICommands.
+func (s *Server[T]) CLICommands() serverv2.CLIConfig {
+	return serverv2.CLIConfig{
+		Commands: []*cobra.Command{
+			s.FetchSnapshotCmd(),
+		},
+	}
+}
+
+// StartupReport implements serverv2.HasStartupReport.
+func (s *Server[T]) StartupReport() (bool, map[string]any) {
+	cfg := s.Config().(*Config)
+	return cfg.Enable, map[string]any{
+		"address":       cfg.Address,
+		"tls":           cfg.TLS.Enabled(),
+		"max_send_rate": cfg.MaxSendRate,
+	}
+}
+
+// newGRPCServer returns a gRPC server serving the snapshots of the store to the clients
+// authenticating with one of the configured tokens.
+func newGRPCServer(cfg *Config, store *snapshots.Store) (*grpc.Server, error) {
+	unary, stream := authInterceptors(cfg.Tokens)
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary),
+		grpc.ChainStreamInterceptor(stream),
+	}
+
+	if cfg.TLS.Enabled() {
+		tlsCfg, err := cfg.TLS.Load()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+
+	grpcSrv := grpc.NewServer(opts...)
+	RegisterSnapshotServiceServer(grpcSrv, &snapshotService{
+		store:    store,
+		limiter:  newRateLimiter(cfg.MaxSendRate),
+		partSize: cfg.ChunkPartSize,
+	})
+
+	return grpcSrv, nil
+}
@@ -0,0 +1,112 @@
+package snapshotsync
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/v2/snapshots"
+)
+
+var _ SnapshotServiceServer = (*snapshotService)(nil)
+
+// snapshotService serves the snapshots of a snapshot store.
+type snapshotService struct {
+	store    *snapshots.Store
+	limiter  *rateLimiter
+	partSize int
+}
+
+// ListSnapshots implements SnapshotServiceServer.
+func (s *snapshotService) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	list, err := s.store.List()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
+	}
+
+	return &ListSnapshotsResponse{Snapshots: list}, nil
+}
+
+// LoadChunk implements SnapshotServiceServer.
+func (s *snapshotService) LoadChunk(req *LoadChunkRequest, stream SnapshotService_LoadChunkServer) error {
+	chunk, err := s.store.LoadChunk(req.Height, req.Format, req.Chunk)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load chunk: %v", err)
+	}
+	if chunk == nil {
+		return status.Errorf(codes.NotFound, "chunk %d of snapshot at height %d and format %d not found", req.Chunk, req.Height, req.Format)
+	}
+	defer chunk.Close()
+
+	buf := make([]byte, s.partSize)
+	for {
+		n, err := io.ReadFull(chunk, buf)
+		if n > 0 {
+			if err := s.limiter.wait(stream.Context(), n); err != nil {
+				return status.FromContextError(err).Err()
+			}
+			if err := stream.Send(&LoadChunkResponse{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			return nil
+		case err != nil:
+			return status.Errorf(codes.Internal, "failed to read chunk: %v", err)
+		}
+	}
+}
+
+// rateLimiter limits the rate bytes are sent at. A nil limiter does not limit the rate.
+type rateLimiter struct {
+	mu sync.Mutex
+	// rate is the number of bytes per second.
+	rate uint64
+	// next is the time the next bytes can be sent at.
+	next time.Time
+}
+
+func newRateLimiter(rate uint64) *rateLimiter {
+	if rate == 0 {
+		return nil
+	}
+
+	return &rateLimiter{rate: rate}
+}
+
+// wait blocks until n bytes can be sent, or the context is done.
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(n) / float64(l.rate) * float64(time.Second)))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
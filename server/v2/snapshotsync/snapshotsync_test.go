@@ -0,0 +1,173 @@
+package snapshotsync
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+func newStore(t *testing.T) *snapshots.Store {
+	t.Helper()
+
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func saveSnapshot(t *testing.T, store *snapshots.Store, height uint64, chunks ...[]byte) *types.Snapshot {
+	t.Helper()
+
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+
+	snapshot, err := store.Save(height, types.CurrentFormat, ch)
+	require.NoError(t, err)
+	return snapshot
+}
+
+func newClient(t *testing.T, cfg *Config, store *snapshots.Store, token string) SnapshotServiceClient {
+	t.Helper()
+
+	srv, err := newGRPCServer(cfg, store)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(NewTokenCredentials(token, true)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return NewSnapshotServiceClient(conn)
+}
+
+func testConfig() *Config {
+	cfg := DefaultConfig()
+	cfg.Enable = true
+	cfg.Tokens = []string{"secret"}
+	cfg.ChunkPartSize = 3
+	return cfg
+}
+
+func TestFetch(t *testing.T) {
+	source := newStore(t)
+	saveSnapshot(t, source, 1, []byte("first"))
+	expected := saveSnapshot(t, source, 2, []byte("chunk 0"), []byte("chunk 1"), []byte("chunk 2"))
+
+	client := newClient(t, testConfig(), source, "secret")
+
+	// the latest snapshot is fetched by default
+	target := newStore(t)
+	snapshot, err := Fetch(context.Background(), client, target, 0, types.CurrentFormat)
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+
+	saved, err := target.Get(2, types.CurrentFormat)
+	require.NoError(t, err)
+	require.Equal(t, expected, saved)
+
+	for i, content := range []string{"chunk 0", "chunk 1", "chunk 2"} {
+		chunk, err := target.LoadChunk(2, types.CurrentFormat, uint32(i))
+		require.NoError(t, err)
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		require.Equal(t, content, string(bz))
+	}
+
+	// a snapshot already in the local store is not fetched again
+	_, err = Fetch(context.Background(), client, target, 2, types.CurrentFormat)
+	require.ErrorContains(t, err, "already exists in the local store")
+
+	snapshot, err = Fetch(context.Background(), client, target, 1, types.CurrentFormat)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), snapshot.Height)
+
+	_, err = Fetch(context.Background(), client, target, 3, types.CurrentFormat)
+	require.ErrorContains(t, err, "no snapshot at height 3")
+}
+
+func TestFetchUnauthenticated(t *testing.T) {
+	source := newStore(t)
+	saveSnapshot(t, source, 1, []byte("chunk"))
+
+	client := newClient(t, testConfig(), source, "wrong")
+
+	_, err := client.ListSnapshots(context.Background(), &ListSnapshotsRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream, err := client.LoadChunk(context.Background(), &LoadChunkRequest{Height: 1, Format: types.CurrentFormat})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestLoadChunkNotFound(t *testing.T) {
+	client := newClient(t, testConfig(), newStore(t), "secret")
+
+	stream, err := client.LoadChunk(context.Background(), &LoadChunkRequest{Height: 1, Format: types.CurrentFormat})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRateLimiter(t *testing.T) {
+	var limiter *rateLimiter
+	require.Nil(t, newRateLimiter(0))
+	require.NoError(t, limiter.wait(context.Background(), 1000))
+
+	limiter = newRateLimiter(1000)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.wait(context.Background(), 100))
+	}
+	// the first 100 bytes are sent right away, the next ones after 100ms each
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	// the wait is interrupted when the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, limiter.wait(ctx, 1000), context.Canceled)
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+	require.NoError(t, testConfig().Validate())
+
+	cfg := testConfig()
+	cfg.Tokens = nil
+	require.ErrorContains(t, cfg.Validate(), "at least one token")
+
+	cfg = testConfig()
+	cfg.Tokens = []string{""}
+	require.ErrorContains(t, cfg.Validate(), "tokens cannot be empty")
+
+	cfg = testConfig()
+	cfg.TLS.CertFile = "cert.pem"
+	require.ErrorContains(t, cfg.Validate(), "must be set together")
+
+	cfg = testConfig()
+	cfg.ChunkPartSize = 0
+	require.ErrorContains(t, cfg.Validate(), "chunk part size must be positive")
+}
@@ -0,0 +1,144 @@
+package serverv2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+)
+
+// HasStartupReport is a server component that describes its configuration in the startup report.
+type HasStartupReport interface {
+	// StartupReport returns whether the component is enabled, and details about its configuration
+	// such as listen addresses, store backends or indexer targets.
+	// Details must be JSON serializable and must not contain secrets.
+	StartupReport() (enabled bool, details map[string]any)
+}
+
+// StartupReport is a machine-readable description of the configuration of a node at startup.
+// It is meant to be consumed by fleet management tools to detect configuration drift between nodes.
+type StartupReport struct {
+	// Time is the time at which the report was generated.
+	Time time.Time `json:"time"`
+	// Components are the server components, in the order they were registered.
+	Components []ComponentReport `json:"components"`
+}
+
+// ComponentReport describes the configuration of a single server component.
+type ComponentReport struct {
+	// Name is the name of the component.
+	Name string `json:"name"`
+	// Enabled is false if the component is registered but disabled by its configuration.
+	Enabled bool `json:"enabled"`
+	// ConfigHash is the hex encoded SHA-256 hash of the JSON encoding of the component config.
+	// It is empty if the component has no config.
+	ConfigHash string `json:"config_hash,omitempty"`
+	// Details are the component specific details, see HasStartupReport.
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// StartupReport returns the startup report of the server and all its components.
+// It must be called after Init, so that the reported configs are the ones in use.
+func (s *Server[T]) StartupReport() (StartupReport, error) {
+	serverReport, err := componentReport[T](s)
+	if err != nil {
+		return StartupReport{}, err
+	}
+
+	report := StartupReport{
+		Time:       time.Now().UTC(),
+		Components: []ComponentReport{serverReport},
+	}
+
+	for _, mod := range s.components {
+		modReport, err := componentReport(mod)
+		if err != nil {
+			return StartupReport{}, err
+		}
+
+		report.Components = append(report.Components, modReport)
+	}
+
+	return report, nil
+}
+
+func componentReport[T transaction.Tx](mod ServerComponent[T]) (ComponentReport, error) {
+	report := ComponentReport{
+		Name:    mod.Name(),
+		Enabled: true,
+	}
+
+	var cfg any
+	switch m := mod.(type) {
+	case *Server[T]:
+		cfg = m.Config()
+	case HasConfig:
+		cfg = m.Config()
+	}
+
+	if cfg != nil {
+		hash, err := configHash(cfg)
+		if err != nil {
+			return ComponentReport{}, fmt.Errorf("failed to hash %s config: %w", mod.Name(), err)
+		}
+
+		report.ConfigHash = hash
+	}
+
+	if reporter, ok := mod.(HasStartupReport); ok {
+		report.Enabled, report.Details = reporter.StartupReport()
+	}
+
+	return report, nil
+}
+
+// configHash returns the hex encoded SHA-256 hash of the JSON encoding of cfg.
+// Map keys are sorted by the JSON encoder, so the hash is deterministic.
+func configHash(cfg any) (string, error) {
+	bz, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// emitStartupReport logs the startup report as a single JSON encoded line and, if enabled,
+// writes it to the file given by the startup report flag.
+func emitStartupReport[T transaction.Tx](logger log.Logger, v *viper.Viper, server *Server[T]) error {
+	report, err := server.StartupReport()
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	logger.With(log.ModuleKey, server.Name()).Info("startup report", "report", string(bz))
+
+	reportFile := v.GetString(FlagStartupReport)
+	if len(reportFile) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(reportFile), 0o755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(reportFile, bz, 0o600); err != nil {
+		return fmt.Errorf("failed to write startup report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package serverv2_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	grpc "cosmossdk.io/server/v2/api/grpc"
+	"cosmossdk.io/server/v2/store"
+)
+
+func TestStartupReport(t *testing.T) {
+	logger := log.NewLogger(os.Stdout)
+	cfg := map[string]any{
+		"grpc": map[string]any{
+			"enable":  true,
+			"address": "localhost:19090",
+		},
+	}
+
+	grpcServer := grpc.New[transaction.Tx]()
+	require.NoError(t, grpcServer.Init(&mockApp[transaction.Tx]{}, cfg, logger))
+
+	storeServer := store.New[transaction.Tx]()
+	require.NoError(t, storeServer.Init(&mockApp[transaction.Tx]{}, cfg, logger))
+
+	mockServer := &mockServer{name: "mock-server-1", ch: make(chan string, 100)}
+
+	server := serverv2.NewServer(serverv2.DefaultServerConfig(), grpcServer, storeServer, mockServer)
+
+	report, err := server.StartupReport()
+	require.NoError(t, err)
+	require.Len(t, report.Components, 4)
+
+	names := make([]string, 0, len(report.Components))
+	for _, component := range report.Components {
+		names = append(names, component.Name)
+		require.True(t, component.Enabled, component.Name)
+		require.Len(t, component.ConfigHash, 64, component.Name)
+	}
+	require.Equal(t, []string{"server", "grpc", "store", "mock-server-1"}, names)
+
+	require.Equal(t, map[string]any{"address": "localhost:19090"}, report.Components[1].Details)
+	require.Equal(t, "goleveldb", report.Components[2].Details["app_db_backend"])
+	require.Nil(t, report.Components[3].Details)
+
+	// the report is deterministic, except for its time
+	report2, err := server.StartupReport()
+	require.NoError(t, err)
+	require.Equal(t, report.Components, report2.Components)
+
+	// a config change is reflected in the config hash
+	cfg["grpc"].(map[string]any)["enable"] = false
+	grpcServer = grpc.New[transaction.Tx]()
+	require.NoError(t, grpcServer.Init(&mockApp[transaction.Tx]{}, cfg, logger))
+
+	report3, err := serverv2.NewServer(serverv2.DefaultServerConfig(), grpcServer).StartupReport()
+	require.NoError(t, err)
+	require.False(t, report3.Components[1].Enabled)
+	require.NotEqual(t, report.Components[1].ConfigHash, report3.Components[1].ConfigHash)
+	require.Equal(t, report.Components[0].ConfigHash, report3.Components[0].ConfigHash)
+
+	_, err = json.Marshal(report)
+	require.NoError(t, err)
+}
@@ -79,8 +79,10 @@ func TypedEventToEvent(tev transaction.Msg) (event.Event, error) {
 		})
 	}
 
+	data := buf.Bytes()
 	return event.Event{
 		Type:       evtType,
+		Data:       func() (json.RawMessage, error) { return data, nil },
 		Attributes: func() ([]event.Attribute, error) { return attrs, nil },
 	}, nil
 }
@@ -36,3 +36,17 @@ func (s storeService) OpenKVStore(ctx context.Context) store.KVStore {
 func (s storeService) OpenMemoryStore(ctx context.Context) store.KVStore {
 	return s.OpenKVStore(ctx)
 }
+
+// StoreActor is a store actor, the namespace of a state, registered by an app.
+type StoreActor struct {
+	// Actor is the store key of the actor.
+	Actor []byte `json:"actor"`
+	// Modules are the names of the modules owning the actor, sorted.
+	Modules []string `json:"modules"`
+}
+
+// StoreActorReporter is implemented by apps listing the store actors they registered.
+type StoreActorReporter interface {
+	// StoreActors returns the registered store actors, ordered by actor.
+	StoreActors() []StoreActor
+}
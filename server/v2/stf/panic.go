@@ -0,0 +1,261 @@
+package stf
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"cosmossdk.io/core/gas"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+const (
+	// maxRecentPanics is the number of the most recent message handler panics kept by the STF.
+	maxRecentPanics = 100
+	// maxStoreOps is the number of the most recent store operations captured in a PanicDiagnostic.
+	maxStoreOps = 32
+)
+
+// PanicDiagnostic is the diagnostic bundle captured when a message handler panics.
+type PanicDiagnostic struct {
+	// Height is the height of the block of the transaction.
+	Height int64 `json:"height"`
+	// TxIndex is the 1-based index of the transaction in the block, 0 for simulations.
+	TxIndex int32 `json:"tx_index"`
+	// MsgIndex is the 1-based index of the message in the transaction.
+	MsgIndex int32 `json:"msg_index"`
+	// MsgType is the type URL of the message.
+	MsgType string `json:"msg_type"`
+	// Value is the value the handler panicked with.
+	Value string `json:"value"`
+	// Stack is the stack trace of the panic.
+	Stack string `json:"stack"`
+	// StoreOps are the most recent store operations of the message, oldest first.
+	StoreOps []StoreOp `json:"store_ops"`
+	// GasLimit is the gas limit of the message execution.
+	GasLimit uint64 `json:"gas_limit"`
+	// GasConsumed is the gas consumed when the handler panicked.
+	GasConsumed uint64 `json:"gas_consumed"`
+}
+
+// StoreOp is a store operation performed by a message handler.
+type StoreOp struct {
+	// Op is the operation: has, get, set, delete, iterator or reverse_iterator.
+	Op string `json:"op"`
+	// Actor is the actor whose state was accessed.
+	Actor []byte `json:"actor"`
+	// Key is the key of the operation, or the start of the iteration.
+	Key []byte `json:"key"`
+}
+
+// PanicError is the error of a transaction whose message handler panicked. The panic is isolated
+// to the transaction, whose state changes are discarded like for any failed message.
+type PanicError struct {
+	Diagnostic PanicDiagnostic
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in message handler of %s: %s", e.Diagnostic.MsgType, e.Diagnostic.Value)
+}
+
+// PanicReporter is implemented by apps reporting the diagnostics of the most recent message handler panics.
+type PanicReporter interface {
+	// RecentPanics returns the diagnostics of the most recent panics, newest first.
+	RecentPanics() []PanicDiagnostic
+}
+
+// RecentPanics returns the diagnostics of the most recent message handler panics which occurred while
+// finalizing blocks, newest first.
+func (s STF[T]) RecentPanics() []PanicDiagnostic {
+	return s.panics.recent()
+}
+
+// invokeMsg invokes the handler of the message. A panic of the handler is recovered into a PanicError
+// holding the diagnostic of the message, which is also recorded in the recent panics when finalizing a block.
+func (s STF[T]) invokeMsg(
+	ctx *executionContext,
+	msg transaction.Msg,
+	ops *storeOpsRecorder,
+	txIndex, msgIndex int32,
+) (resp transaction.Msg, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		diagnostic := PanicDiagnostic{
+			Height:      ctx.headerInfo.Height,
+			TxIndex:     txIndex,
+			MsgIndex:    msgIndex,
+			MsgType:     msgTypeURL(msg),
+			Value:       fmt.Sprint(r),
+			Stack:       string(debug.Stack()),
+			StoreOps:    ops.snapshot(),
+			GasLimit:    ctx.meter.Limit(),
+			GasConsumed: ctx.meter.Consumed(),
+		}
+		if ctx.execMode == transaction.ExecModeFinalize {
+			s.panics.add(diagnostic)
+		}
+		s.logger.Error("panic during message execution",
+			"msg_type", diagnostic.MsgType,
+			"height", diagnostic.Height,
+			"tx_index", diagnostic.TxIndex,
+			"msg_index", diagnostic.MsgIndex,
+			"panic", diagnostic.Value,
+		)
+
+		resp, err = nil, &PanicError{Diagnostic: diagnostic}
+	}()
+
+	return s.msgRouter.Invoke(ctx, msg)
+}
+
+// panicLog is a ring buffer of the most recent panic diagnostics, shared by the clones of the STF.
+type panicLog struct {
+	mu          sync.Mutex
+	diagnostics []PanicDiagnostic
+	next        int
+}
+
+func newPanicLog() *panicLog {
+	return &panicLog{diagnostics: make([]PanicDiagnostic, 0, maxRecentPanics)}
+}
+
+func (l *panicLog) add(diagnostic PanicDiagnostic) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.diagnostics) < maxRecentPanics {
+		l.diagnostics = append(l.diagnostics, diagnostic)
+	} else {
+		l.diagnostics[l.next] = diagnostic
+	}
+	l.next = (l.next + 1) % maxRecentPanics
+}
+
+func (l *panicLog) recent() []PanicDiagnostic {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	res := make([]PanicDiagnostic, 0, len(l.diagnostics))
+	for i := 1; i <= len(l.diagnostics); i++ {
+		res = append(res, l.diagnostics[(l.next-i+len(l.diagnostics))%len(l.diagnostics)])
+	}
+	return res
+}
+
+// storeOpsRecorder records the most recent store operations of a message in a ring buffer,
+// reusing the buffers of the keys.
+type storeOpsRecorder struct {
+	ops [maxStoreOps]StoreOp
+	n   int
+}
+
+func (r *storeOpsRecorder) record(op string, actor, key []byte) {
+	slot := &r.ops[r.n%maxStoreOps]
+	slot.Op = op
+	slot.Actor = append(slot.Actor[:0], actor...)
+	slot.Key = append(slot.Key[:0], key...)
+	r.n++
+}
+
+func (r *storeOpsRecorder) reset() {
+	r.n = 0
+}
+
+// snapshot returns a copy of the recorded store operations, oldest first.
+func (r *storeOpsRecorder) snapshot() []StoreOp {
+	count := min(r.n, maxStoreOps)
+	res := make([]StoreOp, count)
+	for i := range res {
+		op := r.ops[(r.n-count+i)%maxStoreOps]
+		res[i] = StoreOp{
+			Op:    op.Op,
+			Actor: append([]byte(nil), op.Actor...),
+			Key:   append([]byte(nil), op.Key...),
+		}
+	}
+	return res
+}
+
+// wrapMakeGasMeteredState wraps the function making the gas metered state of an execution context,
+// so that the operations on the states it makes are recorded.
+func (r *storeOpsRecorder) wrapMakeGasMeteredState(makeGasMeteredState makeGasMeteredStateFn) makeGasMeteredStateFn {
+	return func(meter gas.Meter, state store.WriterMap) store.WriterMap {
+		return recordingWriterMap{
+			WriterMap: makeGasMeteredState(meter, state),
+			recorder:  r,
+			writers:   make(map[string]store.Writer),
+		}
+	}
+}
+
+// recordingWriterMap is a store.WriterMap whose writers record their operations.
+type recordingWriterMap struct {
+	store.WriterMap
+	recorder *storeOpsRecorder
+	writers  map[string]store.Writer
+}
+
+func (m recordingWriterMap) GetReader(actor []byte) (store.Reader, error) { return m.GetWriter(actor) }
+
+func (m recordingWriterMap) GetWriter(actor []byte) (store.Writer, error) {
+	if w, ok := m.writers[string(actor)]; ok {
+		return w, nil
+	}
+
+	w, err := m.WriterMap.GetWriter(actor)
+	if err != nil {
+		return nil, err
+	}
+	w = recordingWriter{Writer: w, actor: actor, recorder: m.recorder}
+	m.writers[string(actor)] = w
+
+	return w, nil
+}
+
+// recordingWriter is a store.Writer recording its operations.
+type recordingWriter struct {
+	store.Writer
+	actor    []byte
+	recorder *storeOpsRecorder
+}
+
+func (w recordingWriter) Has(key []byte) (bool, error) {
+	w.recorder.record("has", w.actor, key)
+	return w.Writer.Has(key)
+}
+
+func (w recordingWriter) Get(key []byte) ([]byte, error) {
+	w.recorder.record("get", w.actor, key)
+	return w.Writer.Get(key)
+}
+
+func (w recordingWriter) Iterator(start, end []byte) (store.Iterator, error) {
+	w.recorder.record("iterator", w.actor, start)
+	return w.Writer.Iterator(start, end)
+}
+
+func (w recordingWriter) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	w.recorder.record("reverse_iterator", w.actor, start)
+	return w.Writer.ReverseIterator(start, end)
+}
+
+func (w recordingWriter) Set(key, value []byte) error {
+	w.recorder.record("set", w.actor, key)
+	return w.Writer.Set(key, value)
+}
+
+func (w recordingWriter) Delete(key []byte) error {
+	w.recorder.record("delete", w.actor, key)
+	return w.Writer.Delete(key)
+}
@@ -0,0 +1,38 @@
+package stf
+
+import "testing"
+
+func TestPanicLog(t *testing.T) {
+	l := newPanicLog()
+	for i := 1; i <= maxRecentPanics+2; i++ {
+		l.add(PanicDiagnostic{Height: int64(i)})
+	}
+
+	recent := l.recent()
+	if len(recent) != maxRecentPanics {
+		t.Fatalf("Expected %d panics, got %d", maxRecentPanics, len(recent))
+	}
+	if recent[0].Height != maxRecentPanics+2 || recent[len(recent)-1].Height != 3 {
+		t.Errorf("Expected the newest panics first, got heights %d to %d", recent[0].Height, recent[len(recent)-1].Height)
+	}
+}
+
+func TestStoreOpsRecorder(t *testing.T) {
+	r := &storeOpsRecorder{}
+	for i := 0; i < maxStoreOps+3; i++ {
+		r.record("get", []byte("actor"), []byte{byte(i)})
+	}
+
+	ops := r.snapshot()
+	if len(ops) != maxStoreOps {
+		t.Fatalf("Expected %d ops, got %d", maxStoreOps, len(ops))
+	}
+	if ops[0].Key[0] != 3 || ops[len(ops)-1].Key[0] != maxStoreOps+2 {
+		t.Errorf("Expected the most recent ops oldest first, got keys %v to %v", ops[0].Key, ops[len(ops)-1].Key)
+	}
+
+	r.reset()
+	if ops := r.snapshot(); len(ops) != 0 {
+		t.Errorf("Expected no ops after reset, got %v", ops)
+	}
+}
@@ -42,6 +42,8 @@ type STF[T transaction.Tx] struct {
 	branchFn            branchFn // branchFn is a function that given a readonly state it returns a writable version of it.
 	makeGasMeter        makeGasMeterFn
 	makeGasMeteredState makeGasMeteredStateFn
+
+	panics *panicLog // panics are the diagnostics of the most recent message handler panics.
 }
 
 // New returns a new STF instance.
@@ -79,6 +81,7 @@ func New[T transaction.Tx](
 		branchFn:            branch,
 		makeGasMeter:        stfgas.DefaultGasMeter,
 		makeGasMeteredState: stfgas.DefaultWrapWithGasMeter,
+		panics:              newPanicLog(),
 	}, nil
 }
 
@@ -166,6 +169,7 @@ func (s STF[T]) DeliverBlock(
 }
 
 // deliverTx executes a TX and returns the result.
+// The panics of the message handlers are isolated by runTxMsgs, other panics fail the tx.
 func (s STF[T]) deliverTx(
 	ctx context.Context,
 	state store.WriterMap,
@@ -173,13 +177,15 @@ func (s STF[T]) deliverTx(
 	execMode transaction.ExecMode,
 	hi header.Info,
 	txIndex int32,
-) server.TxResult {
+) (txResult server.TxResult) {
 	// recover in the case of a panic
-	var recoveryError error
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryError = fmt.Errorf("panic during transaction execution: %s", r)
+			recoveryError := fmt.Errorf("panic during transaction execution: %s", r)
 			s.logger.Error("panic during transaction execution", "error", recoveryError)
+			txResult = server.TxResult{
+				Error: recoveryError,
+			}
 		}
 	}()
 	// handle error from GetGasLimit
@@ -190,11 +196,6 @@ func (s STF[T]) deliverTx(
 		}
 	}
 
-	if recoveryError != nil {
-		return server.TxResult{
-			Error: recoveryError,
-		}
-	}
 	validateGas, validationEvents, err := s.validateTx(ctx, state, gasLimit, tx, execMode)
 	if err != nil {
 		return server.TxResult{
@@ -211,7 +212,7 @@ func (s STF[T]) deliverTx(
 		events = append(events, e)
 	}
 
-	execResp, execGas, execEvents, err := s.execTx(ctx, state, gasLimit-validateGas, tx, execMode, hi)
+	execResp, execGas, execEvents, err := s.execTx(ctx, state, gasLimit-validateGas, tx, execMode, hi, txIndex)
 	// set the TxIndex in the exec events
 	for _, e := range execEvents {
 		e.BlockStage = appdata.TxProcessingStage
@@ -263,10 +264,11 @@ func (s STF[T]) execTx(
 	tx T,
 	execMode transaction.ExecMode,
 	hi header.Info,
+	txIndex int32,
 ) ([]transaction.Msg, uint64, []event.Event, error) {
 	execState := s.branchFn(state)
 
-	msgsResp, gasUsed, runTxMsgsEvents, txErr := s.runTxMsgs(ctx, execState, gasLimit, tx, execMode, hi)
+	msgsResp, gasUsed, runTxMsgsEvents, txErr := s.runTxMsgs(ctx, execState, gasLimit, tx, execMode, hi, txIndex)
 	if txErr != nil {
 		// in case of error during message execution, we do not apply the exec state.
 		// instead we run the post exec handler in a new branchFn from the initial state.
@@ -321,6 +323,8 @@ func (s STF[T]) execTx(
 }
 
 // runTxMsgs will execute the messages contained in the TX with the provided state.
+// The store operations of each message are recorded, so that a panic of its handler
+// fails the TX with a PanicError holding the diagnostic of the message.
 func (s STF[T]) runTxMsgs(
 	ctx context.Context,
 	state store.WriterMap,
@@ -328,6 +332,7 @@ func (s STF[T]) runTxMsgs(
 	tx T,
 	execMode transaction.ExecMode,
 	hi header.Info,
+	txIndex int32,
 ) ([]transaction.Msg, uint64, []event.Event, error) {
 	txSenders, err := tx.GetSenders()
 	if err != nil {
@@ -341,12 +346,15 @@ func (s STF[T]) runTxMsgs(
 
 	execCtx := s.makeContext(ctx, RuntimeIdentity, state, execMode)
 	execCtx.setHeaderInfo(hi)
+	ops := &storeOpsRecorder{}
+	execCtx.makeGasMeteredStore = ops.wrapMakeGasMeteredState(execCtx.makeGasMeteredStore)
 	execCtx.setGasLimit(gasLimit)
 	events := make([]event.Event, 0)
 	for i, msg := range msgs {
 		execCtx.sender = txSenders[i]
 		execCtx.events = make([]event.Event, 0) // reset events
-		resp, err := s.msgRouter.Invoke(execCtx, msg)
+		ops.reset()
+		resp, err := s.invokeMsg(execCtx, msg, ops, txIndex, int32(i+1))
 		if err != nil {
 			return nil, 0, nil, err // do not wrap the error or we lose the original error type
 		}
@@ -498,6 +506,7 @@ func (s STF[T]) clone() STF[T] {
 		branchFn:            s.branchFn,
 		makeGasMeter:        s.makeGasMeter,
 		makeGasMeteredState: s.makeGasMeteredState,
+		panics:              s.panics,
 	}
 }
 
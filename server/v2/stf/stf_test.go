@@ -353,6 +353,54 @@ func TestSTF(t *testing.T) {
 		stateHas(t, newState, "post-tx-exec")
 	})
 
+	t.Run("exec tx panics", func(t *testing.T) {
+		s := s.clone()
+		s.logger = nopLogger{}
+		s.panics = newPanicLog()
+		addMsgHandlerToSTF(t, &s, func(ctx context.Context, msg *gogotypes.BoolValue) (*gogotypes.BoolValue, error) {
+			kvSet(t, ctx, "exec")
+			panic("handler failure")
+		})
+
+		blockResult, newState, err := s.DeliverBlock(context.Background(), &server.BlockRequest[mock.Tx]{
+			Height:  uint64(1),
+			Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+			AppHash: sum[:],
+			Hash:    sum[:],
+			Txs:     []mock.Tx{mockTx},
+		}, state)
+		if err != nil {
+			t.Errorf("DeliverBlock error: %v", err)
+		}
+		var panicErr *PanicError
+		if !errors.As(blockResult.TxResults[0].Error, &panicErr) {
+			t.Fatalf("Expected PanicError, got %v", blockResult.TxResults[0].Error)
+		}
+		diagnostic := panicErr.Diagnostic
+		if diagnostic.Height != 1 || diagnostic.TxIndex != 1 || diagnostic.MsgIndex != 1 {
+			t.Errorf("Unexpected diagnostic position: %+v", diagnostic)
+		}
+		if diagnostic.MsgType != msgTypeURL(mockTx.Msg) || diagnostic.Value != "handler failure" {
+			t.Errorf("Unexpected diagnostic message: %+v", diagnostic)
+		}
+		if !strings.Contains(diagnostic.Stack, "panic") {
+			t.Errorf("Expected diagnostic stack, got %s", diagnostic.Stack)
+		}
+		if len(diagnostic.StoreOps) != 1 || diagnostic.StoreOps[0].Op != "set" || string(diagnostic.StoreOps[0].Key) != "exec" {
+			t.Errorf("Unexpected diagnostic store ops: %+v", diagnostic.StoreOps)
+		}
+		if diagnostic.GasLimit == 0 || diagnostic.GasConsumed == 0 {
+			t.Errorf("Expected diagnostic gas state, got %+v", diagnostic)
+		}
+		stateNotHas(t, newState, "exec")
+		stateHas(t, newState, "post-tx-exec")
+
+		recentPanics := s.RecentPanics()
+		if len(recentPanics) != 1 || recentPanics[0].MsgType != diagnostic.MsgType {
+			t.Errorf("Expected the panic in the recent panics, got %+v", recentPanics)
+		}
+	})
+
 	t.Run("tx is success but post tx failed", func(t *testing.T) {
 		s := s.clone()
 		s.postTxExec = func(ctx context.Context, tx mock.Tx, success bool) error {
@@ -453,6 +501,14 @@ func TestSTF(t *testing.T) {
 
 var actorName = []byte("cookies")
 
+type nopLogger struct{}
+
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Impl() any            { return nil }
+
 func kvSet(t *testing.T, ctx context.Context, v string) {
 	t.Helper()
 	state, err := ctx.(*executionContext).state.GetWriter(actorName)
@@ -0,0 +1,343 @@
+package store
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/root"
+)
+
+const (
+	FlagOtherHome = "other-home"
+	FlagModules   = "modules"
+	FlagDetailed  = "detailed"
+)
+
+// KVDiff is a difference on a single key between two states.
+// Old is nil when the key was added and New is nil when the key was removed.
+type KVDiff struct {
+	Key []byte
+	Old []byte
+	New []byte
+}
+
+// ActorDiff holds the number of differences of a single actor between two states.
+type ActorDiff struct {
+	Actor   []byte
+	Added   int
+	Removed int
+	Changed int
+}
+
+// Empty returns true if both states are identical for the actor.
+func (d ActorDiff) Empty() bool {
+	return d.Added+d.Removed+d.Changed == 0
+}
+
+// DiffActor compares the state of an actor between two states.
+// Both states are iterated in order, so the diff is computed in a single pass.
+// The differences are passed to fn as they are found rather than kept in
+// memory, and are only counted when fn is nil.
+func DiffActor(actor []byte, from, to corestore.ReaderMap, fn func(KVDiff) error) (ActorDiff, error) {
+	diff := ActorDiff{Actor: actor}
+
+	fromReader, err := from.GetReader(actor)
+	if err != nil {
+		return diff, err
+	}
+	toReader, err := to.GetReader(actor)
+	if err != nil {
+		return diff, err
+	}
+
+	fromIter, err := fromReader.Iterator(nil, nil)
+	if err != nil {
+		return diff, err
+	}
+	defer fromIter.Close()
+
+	toIter, err := toReader.Iterator(nil, nil)
+	if err != nil {
+		return diff, err
+	}
+	defer toIter.Close()
+
+	emit := func(kv KVDiff) error {
+		if fn == nil {
+			return nil
+		}
+		return fn(kv)
+	}
+
+	for fromIter.Valid() || toIter.Valid() {
+		var cmp int
+		switch {
+		case !fromIter.Valid():
+			cmp = 1
+		case !toIter.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(fromIter.Key(), toIter.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			diff.Removed++
+			if err := emit(KVDiff{Key: bytes.Clone(fromIter.Key()), Old: bytes.Clone(fromIter.Value())}); err != nil {
+				return diff, err
+			}
+			fromIter.Next()
+		case cmp > 0:
+			diff.Added++
+			if err := emit(KVDiff{Key: bytes.Clone(toIter.Key()), New: bytes.Clone(toIter.Value())}); err != nil {
+				return diff, err
+			}
+			toIter.Next()
+		default:
+			if !bytes.Equal(fromIter.Value(), toIter.Value()) {
+				diff.Changed++
+				err := emit(KVDiff{
+					Key: bytes.Clone(fromIter.Key()),
+					Old: bytes.Clone(fromIter.Value()),
+					New: bytes.Clone(toIter.Value()),
+				})
+				if err != nil {
+					return diff, err
+				}
+			}
+			fromIter.Next()
+			toIter.Next()
+		}
+	}
+
+	if err := fromIter.Error(); err != nil {
+		return diff, err
+	}
+
+	return diff, toIter.Error()
+}
+
+// StateDiffCmd returns a command that diffs the state between two heights of the
+// application store, or between the application store and the store of another
+// data directory. When the modules expose a schema codec, values are decoded
+// to a human-readable form.
+func StateDiffCmd[T transaction.Tx](newApp serverv2.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff <from-height> [to-height]",
+		Short: "Diff the application state between two heights or two data directories",
+		Long: `Diff the application state between two heights of the store, per module.
+When --other-home is provided, the state at <from-height> of this node is compared with the state
+at [to-height] (defaults to <from-height>) of the node located at the other home directory.
+By default only a summary is printed, use --detailed to print every differing key before the
+summary of its module.`,
+		Example: `<appd> debug state-diff 100 101 --modules bank,staking --detailed
+<appd> debug state-diff 100 --other-home /path/to/other/node`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[0], err)
+			}
+			toHeight := fromHeight
+			if len(args) == 2 {
+				toHeight, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid height %q: %w", args[1], err)
+				}
+			}
+
+			otherHome, err := cmd.Flags().GetString(FlagOtherHome)
+			if err != nil {
+				return err
+			}
+			if otherHome == "" && fromHeight == toHeight {
+				return errors.New("nothing to compare, provide two different heights or --other-home")
+			}
+
+			modules, err := cmd.Flags().GetStringSlice(FlagModules)
+			if err != nil {
+				return err
+			}
+
+			detailed, err := cmd.Flags().GetBool(FlagDetailed)
+			if err != nil {
+				return err
+			}
+
+			v := serverv2.GetViperFromCmd(cmd)
+			logger := serverv2.GetLoggerFromCmd(cmd)
+
+			app := newApp(logger, v)
+			defer app.Close()
+
+			var toStore storev2.RootStore = app.Store()
+			if otherHome != "" {
+				toStore, err = createRootStoreAt(v.AllSettings(), otherHome, logger)
+				if err != nil {
+					return err
+				}
+				defer toStore.Close()
+			}
+
+			from, err := app.Store().StateAt(fromHeight)
+			if err != nil {
+				return fmt.Errorf("unable to load state at height %d: %w", fromHeight, err)
+			}
+			to, err := toStore.StateAt(toHeight)
+			if err != nil {
+				return fmt.Errorf("unable to load state at height %d: %w", toHeight, err)
+			}
+
+			actors, err := diffActors(app.Store(), fromHeight, modules)
+			if err != nil {
+				return err
+			}
+
+			resolver := app.SchemaDecoderResolver()
+			for _, actor := range actors {
+				// the differing keys are printed as they are found, before the summary of the actor
+				var printKVDiff func(KVDiff) error
+				if detailed {
+					codec, _, err := resolver.LookupDecoder(string(actor))
+					if err != nil {
+						return err
+					}
+					printKVDiff = func(kv KVDiff) error {
+						cmd.Println(formatKVDiff(codec.KVDecoder, kv))
+						return nil
+					}
+				}
+
+				diff, err := DiffActor(actor, from, to, printKVDiff)
+				if err != nil {
+					return fmt.Errorf("failed to diff %s: %w", actor, err)
+				}
+
+				cmd.Printf("%s: %d added, %d removed, %d changed\n", actor, diff.Added, diff.Removed, diff.Changed)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagOtherHome, "", "Home directory of another node to compare the state with")
+	cmd.Flags().StringSlice(FlagModules, nil, "Restrict the diff to the given modules (default: all)")
+	cmd.Flags().Bool(FlagDetailed, false, "Print every differing key instead of a summary")
+
+	return cmd
+}
+
+// diffActors returns the actors to diff, either the provided modules or all the
+// stores committed at the given height.
+func diffActors(rs storev2.RootStore, height uint64, modules []string) ([][]byte, error) {
+	if len(modules) > 0 {
+		actors := make([][]byte, len(modules))
+		for i, m := range modules {
+			actors[i] = []byte(m)
+		}
+		return actors, nil
+	}
+
+	commitInfo, err := rs.GetStateCommitment().GetCommitInfo(height)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo == nil {
+		return nil, fmt.Errorf("no commit info found at height %d", height)
+	}
+
+	actors := make([][]byte, 0, len(commitInfo.StoreInfos))
+	for _, si := range commitInfo.StoreInfos {
+		actors = append(actors, si.Name)
+	}
+	sort.Slice(actors, func(i, j int) bool { return bytes.Compare(actors[i], actors[j]) < 0 })
+
+	return actors, nil
+}
+
+// formatKVDiff renders a key difference, decoding it with the module schema
+// codec when possible and falling back to hex otherwise.
+func formatKVDiff(decoder schema.KVDecoder, kv KVDiff) string {
+	op := "~"
+	switch {
+	case kv.Old == nil:
+		op = "+"
+	case kv.New == nil:
+		op = "-"
+	}
+
+	if decoder != nil {
+		if key, oldValue, newValue, ok := decodeKVDiff(decoder, kv); ok {
+			return fmt.Sprintf("  %s %s: %s => %s", op, key, oldValue, newValue)
+		}
+	}
+
+	return fmt.Sprintf("  %s %s: %s => %s", op, hex.EncodeToString(kv.Key), hex.EncodeToString(kv.Old), hex.EncodeToString(kv.New))
+}
+
+// decodeKVDiff decodes the key and both the old and new values of a key
+// difference with the module schema codec. A missing value is rendered empty.
+func decodeKVDiff(decoder schema.KVDecoder, kv KVDiff) (key, oldValue, newValue string, ok bool) {
+	decode := func(value []byte) ([]schema.StateObjectUpdate, bool) {
+		if value == nil {
+			return nil, true
+		}
+		updates, err := decoder(schema.KVPairUpdate{Key: kv.Key, Value: value})
+		return updates, err == nil && len(updates) > 0
+	}
+
+	oldUpdates, ok := decode(kv.Old)
+	if !ok {
+		return "", "", "", false
+	}
+	newUpdates, ok := decode(kv.New)
+	if !ok {
+		return "", "", "", false
+	}
+
+	keyUpdates := newUpdates
+	if keyUpdates == nil {
+		keyUpdates = oldUpdates
+	}
+	keys := make([]string, len(keyUpdates))
+	for i, u := range keyUpdates {
+		keys[i] = fmt.Sprintf("%s %v", u.TypeName, u.Key)
+	}
+
+	return strings.Join(keys, "; "), formatObjectValues(oldUpdates), formatObjectValues(newUpdates), true
+}
+
+// formatObjectValues renders the values of decoded state object updates.
+func formatObjectValues(updates []schema.StateObjectUpdate) string {
+	values := make([]string, len(updates))
+	for i, u := range updates {
+		values[i] = fmt.Sprintf("%v", u.Value)
+	}
+
+	return strings.Join(values, "; ")
+}
+
+// createRootStoreAt creates a root store using the store configuration but
+// located at the provided home directory.
+func createRootStoreAt(cfg map[string]any, home string, logger log.Logger) (storev2.RootStore, error) {
+	storeConfig, err := UnmarshalConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	storeConfig.Home = home
+
+	return root.NewBuilder().Build(logger, storeConfig)
+}
@@ -0,0 +1,96 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/schema"
+)
+
+type memReaderMap map[string]corestore.KVStoreWithBatch
+
+func (m memReaderMap) GetReader(actor []byte) (corestore.Reader, error) {
+	db, ok := m[string(actor)]
+	if !ok {
+		db = coretesting.NewMemDB()
+		m[string(actor)] = db
+	}
+	return db, nil
+}
+
+func newMemReaderMap(t *testing.T, actor string, kvs map[string]string) memReaderMap {
+	t.Helper()
+	db := coretesting.NewMemDB()
+	for k, v := range kvs {
+		require.NoError(t, db.Set([]byte(k), []byte(v)))
+	}
+	return memReaderMap{actor: db}
+}
+
+func TestDiffActor(t *testing.T) {
+	from := newMemReaderMap(t, "bank", map[string]string{"a": "1", "b": "2", "c": "3"})
+	to := newMemReaderMap(t, "bank", map[string]string{"b": "2", "c": "4", "d": "5"})
+
+	var diffs []KVDiff
+	diff, err := DiffActor([]byte("bank"), from, to, func(kv KVDiff) error {
+		diffs = append(diffs, kv)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, diff.Added)
+	require.Equal(t, 1, diff.Removed)
+	require.Equal(t, 1, diff.Changed)
+	require.Equal(t, []KVDiff{
+		{Key: []byte("a"), Old: []byte("1")},
+		{Key: []byte("c"), Old: []byte("3"), New: []byte("4")},
+		{Key: []byte("d"), New: []byte("5")},
+	}, diffs)
+
+	// the differences are only counted without callback
+	diff, err = DiffActor([]byte("bank"), from, to, nil)
+	require.NoError(t, err)
+	require.Equal(t, ActorDiff{Actor: []byte("bank"), Added: 1, Removed: 1, Changed: 1}, diff)
+
+	// the diff stops at the first error of the callback
+	errStop := errors.New("stop")
+	diff, err = DiffActor([]byte("bank"), from, to, func(KVDiff) error { return errStop })
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 1, diff.Removed)
+	require.Zero(t, diff.Changed)
+
+	// identical states
+	diff, err = DiffActor([]byte("bank"), from, from, nil)
+	require.NoError(t, err)
+	require.True(t, diff.Empty())
+
+	// unknown actor
+	diff, err = DiffActor([]byte("staking"), from, to, nil)
+	require.NoError(t, err)
+	require.True(t, diff.Empty())
+}
+
+func TestFormatKVDiff(t *testing.T) {
+	require.Equal(t, "  + 61:  => 01", formatKVDiff(nil, KVDiff{Key: []byte("a"), New: []byte{1}}))
+	require.Equal(t, "  - 61: 01 => ", formatKVDiff(nil, KVDiff{Key: []byte("a"), Old: []byte{1}}))
+	require.Equal(t, "  ~ 61: 01 => 02", formatKVDiff(nil, KVDiff{Key: []byte("a"), Old: []byte{1}, New: []byte{2}}))
+
+	decoder := func(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+		return []schema.StateObjectUpdate{{TypeName: "balance", Key: string(update.Key), Value: uint64(update.Value[0])}}, nil
+	}
+	require.Equal(t, "  ~ balance a: 1 => 2", formatKVDiff(decoder, KVDiff{Key: []byte("a"), Old: []byte{1}, New: []byte{2}}))
+	require.Equal(t, "  + balance a:  => 2", formatKVDiff(decoder, KVDiff{Key: []byte("a"), New: []byte{2}}))
+	require.Equal(t, "  - balance a: 1 => ", formatKVDiff(decoder, KVDiff{Key: []byte("a"), Old: []byte{1}}))
+
+	// values which cannot be decoded fall back to hex
+	failing := func(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+		if update.Value[0] == 1 {
+			return nil, errors.New("cannot decode")
+		}
+		return decoder(update)
+	}
+	require.Equal(t, "  ~ 61: 01 => 02", formatKVDiff(failing, KVDiff{Key: []byte("a"), Old: []byte{1}, New: []byte{2}}))
+}
@@ -17,6 +17,7 @@ var (
 	_ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
 	_ serverv2.HasConfig                       = (*Server[transaction.Tx])(nil)
 	_ serverv2.HasCLICommands                  = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasStartupReport                = (*Server[transaction.Tx])(nil)
 )
 
 const ServerName = "store"
@@ -59,6 +60,7 @@ func (s *Server[T]) CLICommands() serverv2.CLIConfig {
 			s.DumpArchiveCmd(),
 			s.LoadArchiveCmd(),
 			s.RestoreSnapshotCmd(s.backend),
+			s.VerifyCmd(),
 		},
 	}
 }
@@ -71,6 +73,16 @@ func (s *Server[T]) Config() any {
 	return s.config
 }
 
+// StartupReport implements serverv2.HasStartupReport.
+func (s *Server[T]) StartupReport() (bool, map[string]any) {
+	cfg := s.Config().(*root.Config)
+	return true, map[string]any{
+		"app_db_backend": cfg.AppDBBackend,
+		"ss_type":        cfg.Options.SSType,
+		"sc_type":        cfg.Options.SCType,
+	}
+}
+
 // UnmarshalConfig unmarshals the store config from the given map.
 // If the config is not found in the map, the default config is returned.
 // If the home directory is found in the map, it sets the home directory in the config.
@@ -0,0 +1,236 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	storedb "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
+)
+
+const (
+	FlagVersions = "versions"
+	FlagSample   = "sample"
+)
+
+// commitInfoRecomputer is implemented by the state commitment stores able to
+// recompute their commit info from the nodes of their trees.
+type commitInfoRecomputer interface {
+	GetCommitInfo(version uint64) (*proof.CommitInfo, error)
+	RecomputeCommitInfo(version uint64, newTree commitment.MountTreeFn) (*proof.CommitInfo, error)
+}
+
+// VersionVerification is the result of the verification of a version of the
+// state commitment.
+type VersionVerification struct {
+	Version uint64
+	// AppHash is the app hash recorded for the version.
+	AppHash []byte
+	// RecomputedAppHash is the app hash recomputed from the nodes of the trees.
+	RecomputedAppHash []byte
+	// CorruptedStores holds the stores whose recomputed hash differs from the
+	// recorded one.
+	CorruptedStores []string
+}
+
+// Verified returns true if the recomputed app hash matches the recorded one.
+func (v VersionVerification) Verified() bool {
+	return len(v.CorruptedStores) == 0 && bytes.Equal(v.AppHash, v.RecomputedAppHash)
+}
+
+// VerifyVersion recomputes the commitment hashes of the given version of the
+// state commitment and compares them with the recorded app hash. The hashes are
+// recomputed by importing the nodes of each tree into a scratch IAVL tree stored
+// in a temporary directory, which is removed once the version is verified.
+func VerifyVersion(sc storev2.Committer, version uint64, logger log.Logger) (VersionVerification, error) {
+	res := VersionVerification{Version: version}
+
+	recomputer, ok := sc.(commitInfoRecomputer)
+	if !ok {
+		return res, fmt.Errorf("state commitment %T cannot recompute its commit info", sc)
+	}
+
+	recorded, err := recomputer.GetCommitInfo(version)
+	if err != nil {
+		return res, err
+	}
+	if recorded == nil {
+		return res, fmt.Errorf("no commit info found for version %d", version)
+	}
+	res.AppHash = recorded.Hash()
+
+	scratchDir, err := os.MkdirTemp("", "store-verify")
+	if err != nil {
+		return res, err
+	}
+	defer os.RemoveAll(scratchDir)
+
+	scratchDB, err := storedb.NewGoLevelDB("scratch", scratchDir, nil)
+	if err != nil {
+		return res, err
+	}
+	defer scratchDB.Close()
+
+	newTree := func(storeKey string) (commitment.Tree, error) {
+		return iavl.NewIavlTree(storedb.NewPrefixDB(scratchDB, []byte(storeKey)), logger, iavl.DefaultConfig()), nil
+	}
+	recomputed, err := recomputer.RecomputeCommitInfo(version, newTree)
+	if err != nil {
+		return res, err
+	}
+	res.RecomputedAppHash = recomputed.Hash()
+
+	for _, si := range recorded.StoreInfos {
+		if !bytes.Equal(si.CommitID.Hash, recomputed.GetStoreCommitID(si.Name).Hash) {
+			res.CorruptedStores = append(res.CorruptedStores, string(si.Name))
+		}
+	}
+
+	return res, nil
+}
+
+// VerifyCmd returns a command verifying the integrity of the state commitment.
+func (s *Server[T]) VerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the integrity of the state commitment by recomputing its hashes",
+		Long: `Verify the integrity of the state commitment by recomputing its hashes.
+The hash of every store is recomputed from the nodes of its tree, and the resulting app hash is compared
+with the app hash recorded when the version was committed. A mismatch means that the store was silently
+corrupted on disk, and that the node would fail to reach consensus.
+
+The latest version is always verified. Historical versions can be verified with --versions, or a random
+sample of them with --sample. Historical versions which have been pruned are skipped.
+The node must be stopped while the command runs.`,
+		Example: fmt.Sprintf("%s store verify --sample 10", "<appd>"),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := serverv2.GetViperFromCmd(cmd)
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if err := vp.BindPFlags(cmd.PersistentFlags()); err != nil {
+				return err
+			}
+
+			versions, err := cmd.Flags().GetUintSlice(FlagVersions)
+			if err != nil {
+				return err
+			}
+			sample, err := cmd.Flags().GetUint(FlagSample)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+
+			rootStore, opts, err := createRootStore(vp, logger)
+			if err != nil {
+				return fmt.Errorf("can not create root store %w", err)
+			}
+			defer rootStore.Close()
+
+			latestVersion, err := rootStore.GetLatestVersion()
+			if err != nil {
+				return err
+			}
+			if latestVersion == 0 {
+				return errors.New("the database has no version to verify")
+			}
+
+			toVerify := []uint64{latestVersion}
+			for _, v := range versions {
+				if uint64(v) > latestVersion {
+					return fmt.Errorf("version %d is greater than the latest version %d", v, latestVersion)
+				}
+				toVerify = append(toVerify, uint64(v))
+			}
+			toVerify = append(toVerify, sampleVersions(latestVersion, opts.SCPruningOption.KeepRecent, sample)...)
+			slices.Sort(toVerify)
+			toVerify = slices.Compact(toVerify)
+
+			sc := rootStore.GetStateCommitment()
+			corrupted := 0
+			for _, version := range toVerify {
+				ci, err := sc.GetCommitInfo(version)
+				if err != nil {
+					return err
+				}
+				if ci == nil && version != latestVersion {
+					cmd.Printf("version %d: skipped, the version has been pruned\n", version)
+					continue
+				}
+
+				res, err := VerifyVersion(sc, version, logger)
+				if err != nil {
+					return fmt.Errorf("failed to verify version %d: %w", version, err)
+				}
+
+				if res.Verified() {
+					cmd.Printf("version %d: app hash %X verified\n", version, res.AppHash)
+					continue
+				}
+
+				corrupted++
+				cmd.Printf("version %d: app hash mismatch, recorded %X, recomputed %X, corrupted stores: %v\n",
+					version, res.AppHash, res.RecomputedAppHash, res.CorruptedStores)
+			}
+
+			if corrupted > 0 {
+				return fmt.Errorf("found %d corrupted version(s), the store must be restored from a snapshot or a backup", corrupted)
+			}
+
+			cmd.Println("successfully verified the application state commitment")
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().UintSlice(FlagVersions, nil, "Historical versions to verify in addition to the latest version")
+	cmd.Flags().Uint(FlagSample, 0, "Number of random historical versions to verify in addition to the latest version")
+
+	return cmd
+}
+
+// sampleVersions returns up to n distinct random historical versions among the
+// versions kept by the pruning options.
+func sampleVersions(latestVersion, keepRecent uint64, n uint) []uint64 {
+	oldest := uint64(1)
+	if keepRecent > 0 && latestVersion > keepRecent {
+		oldest = latestVersion - keepRecent
+	}
+	if n == 0 || oldest >= latestVersion {
+		return nil
+	}
+
+	count := latestVersion - oldest
+	if uint64(n) >= count {
+		versions := make([]uint64, 0, count)
+		for v := oldest; v < latestVersion; v++ {
+			versions = append(versions, v)
+		}
+		return versions
+	}
+
+	sampled := make(map[uint64]struct{}, n)
+	for uint(len(sampled)) < n {
+		sampled[oldest+rand.Uint64N(count)] = struct{}{}
+	}
+
+	versions := make([]uint64, 0, n)
+	for v := range sampled {
+		versions = append(versions, v)
+	}
+	return versions
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	storedb "cosmossdk.io/store/v2/db"
+)
+
+func TestVerifyVersion(t *testing.T) {
+	db := storedb.NewMemDB()
+	trees := make(map[string]commitment.Tree)
+	for _, storeKey := range []string{"bank", "staking"} {
+		trees[storeKey] = iavl.NewIavlTree(storedb.NewPrefixDB(db, []byte(storeKey)), coretesting.NewNopLogger(), iavl.DefaultConfig())
+	}
+	sc, err := commitment.NewCommitStore(trees, nil, db, coretesting.NewNopLogger())
+	require.NoError(t, err)
+
+	for v := uint64(1); v <= 2; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add([]byte("bank"), []byte(fmt.Sprintf("balance-%d", v)), []byte("100"), false)
+		require.NoError(t, sc.WriteChangeset(cs))
+		_, err = sc.Commit(v)
+		require.NoError(t, err)
+	}
+
+	for v := uint64(1); v <= 2; v++ {
+		res, err := VerifyVersion(sc, v, log.NewNopLogger())
+		require.NoError(t, err)
+		require.True(t, res.Verified())
+		require.Empty(t, res.CorruptedStores)
+
+		ci, err := sc.GetCommitInfo(v)
+		require.NoError(t, err)
+		require.Equal(t, ci.Hash(), res.RecomputedAppHash)
+	}
+
+	_, err = VerifyVersion(sc, 3, log.NewNopLogger())
+	require.ErrorContains(t, err, "no commit info found for version 3")
+}
+
+func TestSampleVersions(t *testing.T) {
+	require.Empty(t, sampleVersions(100, 0, 0))
+	require.Empty(t, sampleVersions(1, 0, 5))
+	require.ElementsMatch(t, []uint64{1, 2, 3}, sampleVersions(4, 0, 5))
+	require.ElementsMatch(t, []uint64{90, 91, 92, 93, 94, 95, 96, 97, 98, 99}, sampleVersions(100, 10, 20))
+
+	sampled := sampleVersions(100, 10, 5)
+	require.Len(t, sampled, 5)
+	for _, v := range sampled {
+		require.GreaterOrEqual(t, v, uint64(90))
+		require.Less(t, v, uint64(100))
+	}
+}
@@ -29,6 +29,7 @@ import (
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	lockup "cosmossdk.io/x/accounts/defaults/lockup"
 	"cosmossdk.io/x/accounts/defaults/multisig"
+	"cosmossdk.io/x/accounts/defaults/spendlimit"
 	"cosmossdk.io/x/accounts/testing/account_abstraction"
 	"cosmossdk.io/x/accounts/testing/counter"
 	"cosmossdk.io/x/authz"
@@ -129,7 +130,7 @@ var (
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:         nil,
-		distrtypes.ModuleName:              nil,
+		distrtypes.ModuleName:              {authtypes.Burner},
 		pooltypes.ModuleName:               nil,
 		pooltypes.StreamAccount:            nil,
 		pooltypes.ProtocolPoolDistrAccount: nil,
@@ -317,6 +318,7 @@ func NewSimApp(
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		accountstd.AddAccount("multisig", multisig.NewAccount),
+		accountstd.AddAccount(spendlimit.SPEND_LIMIT_ACCOUNT, spendlimit.NewAccount),
 		// PRODUCTION: add
 		baseaccount.NewAccount("base", txConfig.SignModeHandler(), baseaccount.WithSecp256K1PubKey()),
 	)
@@ -451,6 +453,7 @@ func NewSimApp(
 		app.ConsensusParamsKeeper,
 		app.AuthKeeper.AddressCodec(),
 		app.StakingKeeper.ConsensusAddressCodec(),
+		govModuleAddr,
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
@@ -666,6 +669,7 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				FeegrantKeeper:           app.FeeGrantKeeper,
 				SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
 				UnorderedTxManager:       app.UnorderedTxManager,
+				RejectionCounter:         app.AuthKeeper.AnteRejections,
 			},
 			&app.CircuitKeeper,
 		},
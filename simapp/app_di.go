@@ -18,6 +18,7 @@ import (
 	basedepinject "cosmossdk.io/x/accounts/defaults/base/depinject"
 	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
 	multisigdepinject "cosmossdk.io/x/accounts/defaults/multisig/depinject"
+	spendlimitdepinject "cosmossdk.io/x/accounts/defaults/spendlimit/depinject"
 	bankkeeper "cosmossdk.io/x/bank/keeper"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	consensuskeeper "cosmossdk.io/x/consensus/keeper"
@@ -161,6 +162,7 @@ func NewSimApp(
 			depinject.Provide(
 				// inject desired account types:
 				multisigdepinject.ProvideAccount,
+				spendlimitdepinject.ProvideAccount,
 				basedepinject.ProvideAccount,
 				lockupdepinject.ProvideAllLockupAccounts,
 
@@ -55,6 +55,7 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	// BlockedAddresses returns a map of addresses in app v1 and a map of modules names in app di.
 	blockedAddrs, err := BlockedAddresses(app.interfaceRegistry.SigningContext().AddressCodec())
 	require.NoError(t, err)
+	ctx := app.NewContext(true)
 	for acc := range blockedAddrs {
 		var addr sdk.AccAddress
 		if modAddr, err := app.InterfaceRegistry().SigningContext().AddressCodec().StringToBytes(acc); err == nil {
@@ -65,7 +66,7 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 
 		require.True(
 			t,
-			app.BankKeeper.BlockedAddr(addr),
+			app.BankKeeper.BlockedAddr(ctx, addr),
 			fmt.Sprintf("ensure that blocked addresses are properly set in bank keeper: %s should be blocked", acc),
 		)
 	}
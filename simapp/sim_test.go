@@ -101,6 +101,7 @@ func TestAppImportExport(t *testing.T) {
 			stakingtypes.StoreKey: {
 				stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
 				stakingtypes.UnbondingIDKey, stakingtypes.UnbondingIndexKey, stakingtypes.UnbondingTypeKey,
+				stakingtypes.HistoricalValidatorSetDiffKey, stakingtypes.HistoricalValidatorSetCheckpointKey, stakingtypes.HistoricalValidatorSetLatestKey,
 			},
 			authzkeeper.StoreKey:   {authzkeeper.GrantQueuePrefix},
 			feegrant.StoreKey:      {feegrant.FeeAllowanceQueueKeyPrefix},
@@ -7,14 +7,18 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"cosmossdk.io/client/v2/addressbook"
 	"cosmossdk.io/client/v2/offchain"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/simapp"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
+	upgradecli "cosmossdk.io/x/upgrade/client/cli"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/indexer"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -43,6 +47,10 @@ func initRootCmd(
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
+		indexer.Cmd(newApp),
+		upgradecli.DryRunUpgradeCmd(newApp, func(app servertypes.Application) *upgradekeeper.Keeper {
+			return app.(*simapp.SimApp).UpgradeKeeper
+		}),
 	)
 
 	server.AddCommands(rootCmd, newApp, server.StartCmdOptions[servertypes.Application]{})
@@ -55,6 +63,7 @@ func initRootCmd(
 		txCommand(),
 		keys.Commands(),
 		offchain.OffChain(),
+		addressbook.AddressBookCmd(),
 	)
 }
 
@@ -18,6 +18,7 @@ import (
 	basedepinject "cosmossdk.io/x/accounts/defaults/base/depinject"
 	lockupdepinject "cosmossdk.io/x/accounts/defaults/lockup/depinject"
 	multisigdepinject "cosmossdk.io/x/accounts/defaults/multisig/depinject"
+	spendlimitdepinject "cosmossdk.io/x/accounts/defaults/spendlimit/depinject"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
@@ -135,6 +136,7 @@ func NewSimApp[T transaction.Tx](
 			depinject.Provide(
 				// inject desired account types:
 				multisigdepinject.ProvideAccount,
+				spendlimitdepinject.ProvideAccount,
 				basedepinject.ProvideAccount,
 				lockupdepinject.ProvideAllLockupAccounts,
 
@@ -0,0 +1,49 @@
+// localnet runs a local multi-node network of the simulation application until it
+// is interrupted, see the localnet package.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cosmossdk.io/simapp/v2/localnet"
+)
+
+func main() {
+	cfg := localnet.DefaultConfig("./.testnets")
+	flag.StringVar(&cfg.Binary, "binary", cfg.Binary, "path of the application binary")
+	flag.StringVar(&cfg.Dir, "dir", cfg.Dir, "directory holding the files and the logs of the nodes")
+	flag.StringVar(&cfg.ChainID, "chain-id", cfg.ChainID, "chain ID of the network")
+	flag.IntVar(&cfg.NumValidators, "validators", cfg.NumValidators, "number of validator nodes")
+	flag.DurationVar(&cfg.CommitTimeout, "commit-timeout", cfg.CommitTimeout, "time to wait after a block commit before starting on the next height")
+	flag.DurationVar(&cfg.StartTimeout, "start-timeout", cfg.StartTimeout, "time the nodes have to produce their first block")
+	flag.Parse()
+
+	if err := run(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg localnet.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	network, err := localnet.Start(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range network.Nodes {
+		fmt.Printf("%s: rpc=%s grpc=%s grpc-gateway=%s rest=%s home=%s log=%s\n",
+			node.Moniker, node.RPCAddress, node.GRPCAddress, node.GRPCGatewayAddress, node.RESTAddress, node.Home, node.LogFile)
+	}
+	fmt.Println("localnet is running, press Ctrl+C to stop it")
+
+	<-ctx.Done()
+	return network.Stop()
+}
@@ -0,0 +1,187 @@
+// Package localnet runs a local multi-node network of the simulation application.
+//
+// The network is initialized with the `testnet init-files --single-host` command of
+// the application binary, which generates the genesis file, the validator keys and
+// the genesis transactions of every node, and each node is then started as a
+// subprocess. The ports of the nodes are derived from their index, so the endpoints
+// of a network are known in advance and the same from one run to the next.
+package localnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// The first ports of the node servers. The ports of the node of index i are the
+// first ports plus i, see Node.
+const (
+	RPCPortStart         = 26657
+	P2PPortStart         = 16656
+	GRPCPortStart        = 9090
+	GRPCGatewayPortStart = 1317
+	RESTPortStart        = 8080
+	TelemetryPortStart   = 8180
+)
+
+// maxValidators is the maximum number of validators of a network, so that the ports
+// of the node servers do not overlap.
+const maxValidators = TelemetryPortStart - RESTPortStart
+
+// Config defines the configuration of a local network.
+type Config struct {
+	// Binary is the path of the application binary.
+	Binary string
+	// Dir is the directory holding the files and the logs of the nodes. It must not
+	// exist or be empty.
+	Dir string
+	// ChainID is the chain ID of the network.
+	ChainID string
+	// NumValidators is the number of validator nodes of the network.
+	NumValidators int
+	// CommitTimeout is the time the nodes wait after committing a block before
+	// starting on the next height.
+	CommitTimeout time.Duration
+	// StartTimeout is the time the nodes have to produce their first block.
+	StartTimeout time.Duration
+	// InitArgs are extra arguments of the `testnet init-files` command.
+	InitArgs []string
+	// StartArgs are extra arguments of the `start` command of the nodes.
+	StartArgs []string
+}
+
+// DefaultConfig returns the configuration of a local network of 4 simdv2 validators
+// in the given directory.
+func DefaultConfig(dir string) Config {
+	return Config{
+		Binary:        "simdv2",
+		Dir:           dir,
+		ChainID:       "localnet",
+		NumValidators: 4,
+		CommitTimeout: time.Second,
+		StartTimeout:  time.Minute,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (cfg Config) Validate() error {
+	switch {
+	case cfg.Binary == "":
+		return errors.New("binary must be set")
+	case cfg.Dir == "":
+		return errors.New("directory must be set")
+	case cfg.ChainID == "":
+		return errors.New("chain ID must be set")
+	case cfg.NumValidators <= 0 || cfg.NumValidators > maxValidators:
+		return fmt.Errorf("number of validators must be between 1 and %d, got %d", maxValidators, cfg.NumValidators)
+	case cfg.CommitTimeout <= 0:
+		return errors.New("commit timeout must be positive")
+	case cfg.StartTimeout <= 0:
+		return errors.New("start timeout must be positive")
+	}
+
+	return nil
+}
+
+// Network is a running local network.
+type Network struct {
+	Config Config
+	Nodes  []*Node
+}
+
+// Start initializes the files of a local network in the configured directory, starts
+// its nodes and waits until they have produced their first block. If a node fails to
+// start, the nodes already started are stopped and the error is returned.
+func Start(ctx context.Context, cfg Config) (*Network, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if entries, err := os.ReadDir(cfg.Dir); err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("directory %s is not empty", cfg.Dir)
+	}
+
+	dir, err := filepath.Abs(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Dir = dir
+
+	if err := initFiles(ctx, cfg); err != nil {
+		return nil, err
+	}
+
+	n := &Network{Config: cfg, Nodes: make([]*Node, cfg.NumValidators)}
+	for i := range n.Nodes {
+		n.Nodes[i] = newNode(cfg, i)
+		if err := n.Nodes[i].configure(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, node := range n.Nodes {
+		if err := node.start(cfg.StartArgs); err != nil {
+			_ = n.Stop()
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.StartTimeout)
+	defer cancel()
+	if err := n.WaitForHeight(ctx, 1); err != nil {
+		_ = n.Stop()
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// initFiles runs the `testnet init-files` command of the binary.
+func initFiles(ctx context.Context, cfg Config) error {
+	args := append([]string{
+		"testnet", "init-files",
+		"--single-host",
+		"--chain-id=" + cfg.ChainID,
+		"--output-dir=" + cfg.Dir,
+		"--validator-count=" + strconv.Itoa(cfg.NumValidators),
+		"--node-daemon-home=" + daemonHome,
+		"--keyring-backend=test",
+		"--commit-timeout=" + cfg.CommitTimeout.String(),
+	}, cfg.InitArgs...)
+
+	out, err := exec.CommandContext(ctx, cfg.Binary, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to initialize the network files: %w\n%s", err, out)
+	}
+
+	return nil
+}
+
+// WaitForHeight waits until every node of the network has committed the block at
+// the given height.
+func (n *Network) WaitForHeight(ctx context.Context, height int64) error {
+	for _, node := range n.Nodes {
+		if err := node.WaitForHeight(ctx, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Stop stops the nodes of the network. The files of the nodes are kept.
+func (n *Network) Stop() error {
+	var errs []error
+	for _, node := range n.Nodes {
+		if err := node.Stop(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
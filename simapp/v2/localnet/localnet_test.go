@@ -0,0 +1,61 @@
+package localnet
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultConfig("dir").Validate())
+
+	cfg := DefaultConfig("")
+	require.ErrorContains(t, cfg.Validate(), "directory must be set")
+
+	cfg = DefaultConfig("dir")
+	cfg.NumValidators = maxValidators + 1
+	require.ErrorContains(t, cfg.Validate(), "number of validators must be between 1 and 100")
+}
+
+func TestNodeAddresses(t *testing.T) {
+	node := newNode(DefaultConfig("/localnet"), 2)
+	require.Equal(t, "node2", node.Moniker)
+	require.Equal(t, "/localnet/node2/simdv2", node.Home)
+	require.Equal(t, "127.0.0.1:26659", node.RPCAddress)
+	require.Equal(t, "127.0.0.1:16658", node.P2PAddress)
+	require.Equal(t, "127.0.0.1:9092", node.GRPCAddress)
+	require.Equal(t, "127.0.0.1:1319", node.GRPCGatewayAddress)
+	require.Equal(t, "127.0.0.1:8082", node.RESTAddress)
+	require.Equal(t, "127.0.0.1:8182", node.TelemetryAddress)
+}
+
+// TestStart runs a local network of the binary set in the LOCALNET_BINARY environment
+// variable, e.g. after `COSMOS_BUILD_OPTIONS=v2 make build`:
+//
+//	LOCALNET_BINARY=$(pwd)/build/simdv2 go test ./localnet -run TestStart
+func TestStart(t *testing.T) {
+	binary := os.Getenv("LOCALNET_BINARY")
+	if binary == "" {
+		t.Skip("LOCALNET_BINARY is not set")
+	}
+
+	cfg := DefaultConfig(t.TempDir())
+	cfg.Binary = binary
+	cfg.CommitTimeout = 500 * time.Millisecond
+
+	network, err := Start(context.Background(), cfg)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, network.Stop()) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	require.NoError(t, network.WaitForHeight(ctx, 3))
+
+	// the nodes can be stopped one by one, the others keep running
+	require.NoError(t, network.Nodes[0].Stop())
+	require.False(t, network.Nodes[0].Running())
+	require.True(t, network.Nodes[1].Running())
+}
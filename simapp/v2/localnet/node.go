@@ -0,0 +1,224 @@
+package localnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+const (
+	// daemonHome is the name of the home directory of the nodes, under their node directory.
+	daemonHome = "simdv2"
+
+	// pollInterval is the interval at which the status of the nodes is polled.
+	pollInterval = 100 * time.Millisecond
+
+	// stopTimeout is the time a node has to shut down gracefully before being killed.
+	stopTimeout = 10 * time.Second
+)
+
+// Node is a node of a local network. The servers of the node of index i listen on
+// 127.0.0.1 at the first port of the server plus i.
+type Node struct {
+	Index   int
+	Moniker string
+	// Home is the home directory of the node.
+	Home string
+	// LogFile is the file the output of the node is written to.
+	LogFile string
+
+	RPCAddress         string
+	P2PAddress         string
+	GRPCAddress        string
+	GRPCGatewayAddress string
+	RESTAddress        string
+	TelemetryAddress   string
+
+	binary string
+	cmd    *exec.Cmd
+	done   chan struct{}
+	err    error
+}
+
+func newNode(cfg Config, i int) *Node {
+	moniker := fmt.Sprintf("node%d", i)
+	return &Node{
+		Index:              i,
+		Moniker:            moniker,
+		Home:               filepath.Join(cfg.Dir, moniker, daemonHome),
+		LogFile:            filepath.Join(cfg.Dir, moniker+".log"),
+		RPCAddress:         address(RPCPortStart + i),
+		P2PAddress:         address(P2PPortStart + i),
+		GRPCAddress:        address(GRPCPortStart + i),
+		GRPCGatewayAddress: address(GRPCGatewayPortStart + i),
+		RESTAddress:        address(RESTPortStart + i),
+		TelemetryAddress:   address(TelemetryPortStart + i),
+		binary:             cfg.Binary,
+	}
+}
+
+func address(port int) string {
+	return "127.0.0.1:" + strconv.Itoa(port)
+}
+
+// configure sets the addresses of the servers the `testnet init-files` command does
+// not configure in the app.toml of the node, so that they do not conflict with the
+// servers of the other nodes.
+func (n *Node) configure() error {
+	path := filepath.Join(n.Home, "config", "app.toml")
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	cfg := map[string]any{}
+	if err := toml.Unmarshal(bz, &cfg); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	for section, addr := range map[string]string{
+		"grpc-gateway": n.GRPCGatewayAddress,
+		"rest":         n.RESTAddress,
+		"telemetry":    n.TelemetryAddress,
+	} {
+		sub, ok := cfg[section].(map[string]any)
+		if !ok {
+			sub = map[string]any{}
+			cfg[section] = sub
+		}
+		sub["address"] = addr
+	}
+
+	if bz, err = toml.Marshal(cfg); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// start starts the node process, writing its output to the log file of the node.
+func (n *Node) start(args []string) error {
+	logFile, err := os.Create(n.LogFile)
+	if err != nil {
+		return err
+	}
+
+	n.cmd = exec.Command(n.binary, append([]string{"start", "--home=" + n.Home}, args...)...)
+	n.cmd.Stdout = logFile
+	n.cmd.Stderr = logFile
+	if err := n.cmd.Start(); err != nil {
+		_ = logFile.Close()
+		return fmt.Errorf("failed to start %s: %w", n.Moniker, err)
+	}
+
+	n.done = make(chan struct{})
+	go func() {
+		n.err = n.cmd.Wait()
+		_ = logFile.Close()
+		close(n.done)
+	}()
+
+	return nil
+}
+
+// Running returns true if the node process is running.
+func (n *Node) Running() bool {
+	if n.done == nil {
+		return false
+	}
+
+	select {
+	case <-n.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Height returns the height of the latest block committed by the node.
+func (n *Node) Height(ctx context.Context) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+n.RPCAddress+"/status", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, fmt.Errorf("failed to decode the status of %s: %w", n.Moniker, err)
+	}
+
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// WaitForHeight waits until the node has committed the block at the given height.
+// It returns an error if the node exits before.
+func (n *Node) WaitForHeight(ctx context.Context, height int64) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if n.done == nil {
+			return fmt.Errorf("%s is not started", n.Moniker)
+		}
+
+		select {
+		case <-n.done:
+			return fmt.Errorf("%s exited: %v, see %s", n.Moniker, n.err, n.LogFile)
+		default:
+		}
+
+		if h, err := n.Height(ctx); err == nil && h >= height {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s did not reach height %d, see %s: %w", n.Moniker, height, n.LogFile, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop interrupts the node process and waits for it to exit, killing it if it does
+// not exit in time.
+func (n *Node) Stop() error {
+	if !n.Running() {
+		return nil
+	}
+
+	if err := n.cmd.Process.Signal(os.Interrupt); err != nil {
+		_ = n.cmd.Process.Kill()
+	}
+
+	select {
+	case <-n.done:
+	case <-time.After(stopTimeout):
+		if err := n.cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return fmt.Errorf("failed to kill %s: %w", n.Moniker, err)
+		}
+		<-n.done
+	}
+
+	return nil
+}
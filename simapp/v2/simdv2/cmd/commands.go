@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"cosmossdk.io/client/v2/addressbook"
 	"cosmossdk.io/client/v2/offchain"
 	corectx "cosmossdk.io/core/context"
 	"cosmossdk.io/core/transaction"
@@ -18,6 +19,7 @@ import (
 	"cosmossdk.io/server/v2/api/rest"
 	"cosmossdk.io/server/v2/api/telemetry"
 	"cosmossdk.io/server/v2/cometbft"
+	"cosmossdk.io/server/v2/snapshotsync"
 	serverstore "cosmossdk.io/server/v2/store"
 	"cosmossdk.io/simapp/v2"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
@@ -49,9 +51,15 @@ func initRootCmd[T transaction.Tx](
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(
+		cometbft.ReplayBlockCmd(newApp[T], &genericTxDecoder[T]{txConfig}),
+		serverstore.StateDiffCmd(newApp[T]),
+	)
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(moduleManager),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		NewTestnetCmd(moduleManager),
 	)
@@ -63,6 +71,7 @@ func initRootCmd[T transaction.Tx](
 		txCommand(),
 		keys.Commands(),
 		offchain.OffChain(),
+		addressbook.AddressBookCmd(),
 	)
 
 	// wire server commands
@@ -79,6 +88,7 @@ func initRootCmd[T transaction.Tx](
 		serverstore.New[T](),
 		telemetry.New[T](),
 		rest.New[T](),
+		snapshotsync.New[T](),
 	); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,198 @@
+package simsx
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// CoverageReport lists the message types and invariants exercised by a simulation run, so that
+// operations which never complete don't go unnoticed.
+type CoverageReport struct {
+	Seed       int64               `json:"seed"`
+	Msgs       []MsgCoverage       `json:"msgs"`
+	Invariants []InvariantCoverage `json:"invariants"`
+}
+
+// MsgCoverage is the number of executions of the operation of a message type during a simulation run.
+type MsgCoverage struct {
+	Module    string `json:"module"`
+	MsgType   string `json:"msg_type"`
+	Weight    uint32 `json:"weight"`
+	Completed int    `json:"completed"`
+	Skipped   int    `json:"skipped"`
+}
+
+// InvariantCoverage is the result of the assertion of an invariant at the end of a simulation run.
+type InvariantCoverage struct {
+	Module  string `json:"module"`
+	Route   string `json:"route"`
+	Broken  bool   `json:"broken"`
+	Message string `json:"message,omitempty"`
+}
+
+// Uncovered returns the message types with a non-zero weight which never completed.
+func (r CoverageReport) Uncovered() []MsgCoverage {
+	var res []MsgCoverage
+	for _, m := range r.Msgs {
+		if m.Weight != 0 && m.Completed == 0 {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+// Broken returns the invariants which were broken.
+func (r CoverageReport) Broken() []InvariantCoverage {
+	var res []InvariantCoverage
+	for _, inv := range r.Invariants {
+		if inv.Broken {
+			res = append(res, inv)
+		}
+	}
+	return res
+}
+
+func (r CoverageReport) String() string {
+	var sb strings.Builder
+	uncovered := r.Uncovered()
+	sb.WriteString(fmt.Sprintf("Msg types: %d registered, %d never completed\n", len(r.Msgs), len(uncovered)))
+	for _, m := range uncovered {
+		sb.WriteString(fmt.Sprintf("\t%s (weight %d, skipped %d)\n", m.MsgType, m.Weight, m.Skipped))
+	}
+	broken := r.Broken()
+	sb.WriteString(fmt.Sprintf("Invariants: %d asserted, %d broken\n", len(r.Invariants), len(broken)))
+	for _, inv := range broken {
+		sb.WriteString(fmt.Sprintf("\t%s/%s: %s\n", inv.Module, inv.Route, inv.Message))
+	}
+	return sb.String()
+}
+
+// ExportJSON saves the coverage report as a JSON file on a given path.
+func (r CoverageReport) ExportJSON(path string) error {
+	bz, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// newCoverageReport builds the coverage report of the registered msg types from the execution summary.
+func newCoverageReport(seed int64, registered []MsgCoverage, summary *ExecutionSummary, invariants []InvariantCoverage) CoverageReport {
+	msgs := make([]MsgCoverage, len(registered))
+	for i, m := range registered {
+		m.Completed = summary.msgCount(m.MsgType, completed)
+		m.Skipped = summary.msgCount(m.MsgType, skipped)
+		msgs[i] = m
+	}
+	return CoverageReport{Seed: seed, Msgs: msgs, Invariants: invariants}
+}
+
+var _ Registry = &moduleRegistry{}
+
+// moduleRegistry adjusts the weights of the message factories of a module to the simulation
+// config, and records them for the coverage report.
+type moduleRegistry struct {
+	Registry
+	module     string
+	multiplier float64
+	registered *[]MsgCoverage
+}
+
+func (r *moduleRegistry) Add(weight uint32, f SimMsgFactoryX) {
+	if f == nil {
+		panic("message factory must not be nil")
+	}
+	weight = uint32(float64(weight) * r.multiplier)
+	msgType := sdk.MsgTypeURL(f.MsgType())
+	moduleName := r.module
+	if moduleName == "" {
+		moduleName = sdk.GetModuleNameFromTypeURL(msgType)
+	}
+	*r.registered = append(*r.registered, MsgCoverage{
+		Module:  moduleName,
+		MsgType: msgType,
+		Weight:  weight,
+	})
+	r.Registry.Add(weight, f)
+}
+
+// scaleWeightedOperations adjusts the weights of legacy operations with the multiplier and drops
+// the operations whose weight becomes 0.
+func scaleWeightedOperations(ops []simtypes.WeightedOperation, multiplier float64) []simtypes.WeightedOperation {
+	if multiplier == 1 {
+		return ops
+	}
+	res := make([]simtypes.WeightedOperation, 0, len(ops))
+	for _, op := range ops {
+		if weight := int(float64(op.Weight()) * multiplier); weight > 0 {
+			res = append(res, weightedOperation{weight: weight, op: op.Op()})
+		}
+	}
+	return res
+}
+
+type weightedOperation struct {
+	weight int
+	op     simtypes.Operation
+}
+
+func (w weightedOperation) Weight() int            { return w.weight }
+func (w weightedOperation) Op() simtypes.Operation { return w.op }
+
+// moduleName returns the name of a simulation module, or an empty string if the module doesn't
+// expose it.
+func moduleName(m module.AppModuleSimulation) string {
+	if named, ok := m.(interface{ Name() string }); ok {
+		return named.Name()
+	}
+	return ""
+}
+
+var _ sdk.InvariantRegistry = &invariantRegistry{}
+
+type registeredInvariant struct {
+	module, route string
+	invariant     sdk.Invariant
+}
+
+// invariantRegistry collects the invariants registered by the modules.
+type invariantRegistry []registeredInvariant
+
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	*r = append(*r, registeredInvariant{module: moduleName, route: route, invariant: invar})
+}
+
+// assertInvariants asserts the invariants of the target modules of the simulation.
+func assertInvariants(ctx sdk.Context, sm *module.SimulationManager, config simtypes.Config) []InvariantCoverage {
+	var reg invariantRegistry
+	for _, m := range sm.Modules {
+		if hasInvariants, ok := m.(module.HasInvariants); ok {
+			hasInvariants.RegisterInvariants(&reg)
+		}
+	}
+
+	res := make([]InvariantCoverage, 0, len(reg))
+	for _, inv := range reg {
+		if !config.IsTargetModule(inv.module) {
+			continue
+		}
+		cacheCtx, _ := ctx.CacheContext()
+		coverage := InvariantCoverage{Module: inv.module, Route: inv.route}
+		if msg, broken := inv.invariant(cacheCtx); broken {
+			coverage.Broken = true
+			coverage.Message = msg
+		}
+		res = append(res, coverage)
+	}
+	slices.SortStableFunc(res, func(a, b InvariantCoverage) int {
+		return strings.Compare(a.Module+"/"+a.Route, b.Module+"/"+b.Route)
+	})
+	return res
+}
@@ -0,0 +1,76 @@
+package simsx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCoverageReport(t *testing.T) {
+	factory := SimMsgFactoryFn[*testdata.TestMsg](func(ctx context.Context, testData *ChainDataSource, reporter SimulationReporter) (signer []SimAccount, msg *testdata.TestMsg) {
+		return nil, nil
+	})
+	msgType := sdk.MsgTypeURL(&testdata.TestMsg{})
+
+	specs := map[string]struct {
+		multiplier   float64
+		completed    int
+		expWeight    uint32
+		expUncovered int
+	}{
+		"completed": {
+			multiplier: 1,
+			completed:  2,
+			expWeight:  10,
+		},
+		"never completed": {
+			multiplier:   1,
+			expWeight:    10,
+			expUncovered: 1,
+		},
+		"weight scaled": {
+			multiplier: 2.5,
+			completed:  1,
+			expWeight:  25,
+		},
+		"disabled module": {
+			multiplier: 0,
+			expWeight:  0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var registered []MsgCoverage
+			var added []uint32
+			reg := &moduleRegistry{
+				Registry:   registryFn(func(weight uint32, _ SimMsgFactoryX) { added = append(added, weight) }),
+				module:     "mymodule",
+				multiplier: spec.multiplier,
+				registered: &registered,
+			}
+			// when
+			reg.Add(10, factory)
+			summary := NewExecutionSummary()
+			for range spec.completed {
+				summary.Add("mymodule", msgType, completed, "")
+			}
+			summary.Add("mymodule", msgType, skipped, "testing")
+			report := newCoverageReport(1, registered, summary, []InvariantCoverage{{Module: "mymodule", Route: "ok"}})
+			// then
+			assert.Equal(t, []uint32{spec.expWeight}, added)
+			require.Len(t, report.Msgs, 1)
+			assert.Equal(t, MsgCoverage{Module: "mymodule", MsgType: msgType, Weight: spec.expWeight, Completed: spec.completed, Skipped: 1}, report.Msgs[0])
+			assert.Len(t, report.Uncovered(), spec.expUncovered)
+			assert.Empty(t, report.Broken())
+		})
+	}
+}
+
+type registryFn func(weight uint32, f SimMsgFactoryX)
+
+func (r registryFn) Add(weight uint32, f SimMsgFactoryX) { r(weight, f) }
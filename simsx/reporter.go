@@ -208,12 +208,17 @@ func (x *BasicSimulationReporter) Summary() *ExecutionSummary {
 
 type ExecutionSummary struct {
 	mx          sync.RWMutex
-	counts      map[string]int            // module to count
-	skipReasons map[string]map[string]int // msg type to reason->count
+	counts      map[string]int                    // module to count
+	msgCounts   map[string]map[ReporterStatus]int // msg type to status->count
+	skipReasons map[string]map[string]int         // msg type to reason->count
 }
 
 func NewExecutionSummary() *ExecutionSummary {
-	return &ExecutionSummary{counts: make(map[string]int), skipReasons: make(map[string]map[string]int)}
+	return &ExecutionSummary{
+		counts:      make(map[string]int),
+		msgCounts:   make(map[string]map[ReporterStatus]int),
+		skipReasons: make(map[string]map[string]int),
+	}
 }
 
 func (s *ExecutionSummary) Add(module, url string, status ReporterStatus, comment string) {
@@ -221,6 +226,12 @@ func (s *ExecutionSummary) Add(module, url string, status ReporterStatus, commen
 	defer s.mx.Unlock()
 	combinedKey := fmt.Sprintf("%s_%s", module, status.String())
 	s.counts[combinedKey] += 1
+	c, ok := s.msgCounts[url]
+	if !ok {
+		c = make(map[ReporterStatus]int)
+		s.msgCounts[url] = c
+	}
+	c[status] += 1
 	if status == completed {
 		return
 	}
@@ -251,6 +262,13 @@ func (s *ExecutionSummary) String() string {
 	return sb.String()
 }
 
+// msgCount returns the number of executions of the msg type with the given status.
+func (s *ExecutionSummary) msgCount(url string, status ReporterStatus) int {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+	return s.msgCounts[url][status]
+}
+
 func sum(values []int) int {
 	var r int
 	for _, v := range values {
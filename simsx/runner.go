@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
@@ -152,7 +153,7 @@ func RunWithSeed[T SimulationApp](
 
 	app := testInstance.App
 	stateFactory := setupStateFactory(app)
-	ops, reporter := prepareWeightedOps(app.SimulationManager(), stateFactory, tCfg, testInstance.App.TxConfig(), runLogger)
+	ops, reporter, registered := prepareWeightedOps(app.SimulationManager(), stateFactory, tCfg, testInstance.App.TxConfig(), runLogger)
 	simParams, accs, err := simulation.SimulateFromSeedX(tb, runLogger, WriteToDebugLog(runLogger), app.GetBaseApp(), stateFactory.AppStateFn, simtypes.RandomAccounts, ops, stateFactory.BlockedAddr, tCfg, stateFactory.Codec, testInstance.ExecLogWriter)
 	require.NoError(tb, err)
 	err = simtestutil.CheckExportSimulation(app, tCfg, simParams)
@@ -160,8 +161,15 @@ func RunWithSeed[T SimulationApp](
 	if tCfg.Commit && tCfg.DBBackend == "goleveldb" {
 		simtestutil.PrintStats(testInstance.DB.(*dbm.GoLevelDB), tb.Log)
 	}
+	ctx := app.GetBaseApp().NewContextLegacy(true, cmtproto.Header{Height: app.GetBaseApp().LastBlockHeight()})
+	invariants := assertInvariants(ctx, app.SimulationManager(), tCfg)
+	coverage := newCoverageReport(seed, registered, reporter.Summary(), invariants)
+	if tCfg.ExportCoveragePath != "" {
+		require.NoError(tb, coverage.ExportJSON(tCfg.ExportCoveragePath))
+	}
 	// not using tb.Log to always print the summary
-	fmt.Printf("+++ DONE (seed: %d): \n%s\n", seed, reporter.Summary().String())
+	fmt.Printf("+++ DONE (seed: %d): \n%s\n%s\n", seed, reporter.Summary().String(), coverage.String())
+	require.Empty(tb, coverage.Broken(), "broken invariants")
 	for _, step := range postRunActions {
 		step(tb, testInstance, accs)
 	}
@@ -225,7 +233,7 @@ func prepareWeightedOps(
 	config simtypes.Config,
 	txConfig client.TxConfig,
 	logger log.Logger,
-) (simulation.WeightedOperations, *BasicSimulationReporter) {
+) (simulation.WeightedOperations, *BasicSimulationReporter, []MsgCoverage) {
 	cdc := stateFact.Codec
 	signingCtx := cdc.InterfaceRegistry().SigningContext()
 	simState := module.SimulationState{
@@ -272,18 +280,23 @@ func prepareWeightedOps(
 
 	oReg := NewSimsMsgRegistryAdapter(reporter, stateFact.AccountSource, stateFact.BalanceSource, txConfig, logger)
 	wOps := make([]simtypes.WeightedOperation, 0, len(sm.Modules))
+	var registered []MsgCoverage
 	for _, m := range sm.Modules {
+		// the operation weights of each module are adjusted to the targeted modules of the config
+		name := moduleName(m)
+		multiplier := config.ModuleWeight(name)
+		mReg := &moduleRegistry{Registry: oReg, module: name, multiplier: multiplier, registered: &registered}
 		// add operations
 		switch xm := m.(type) {
 		case HasWeightedOperationsX:
-			xm.WeightedOperationsX(weights, oReg)
+			xm.WeightedOperationsX(weights, mReg)
 		case HasWeightedOperationsXWithProposals:
-			xm.WeightedOperationsX(weights, oReg, AppendIterators(legacyPReg.Iterator(), pReg.Iterator()), wContent)
+			xm.WeightedOperationsX(weights, mReg, AppendIterators(legacyPReg.Iterator(), pReg.Iterator()), wContent)
 		case HasLegacyWeightedOperations:
-			wOps = append(wOps, xm.WeightedOperations(simState)...)
+			wOps = append(wOps, scaleWeightedOperations(xm.WeightedOperations(simState), multiplier)...)
 		}
 	}
-	return append(wOps, oReg.ToLegacyObjects()...), reporter
+	return append(wOps, oReg.ToLegacyObjects()...), reporter, registered
 }
 
 // NewSimulationAppInstance initializes and returns a TestInstance of a SimulationApp.
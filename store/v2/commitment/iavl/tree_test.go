@@ -1,6 +1,8 @@
 package iavl
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 	"time"
 
@@ -212,3 +214,71 @@ func TestIavlTreeIterator(t *testing.T) {
 	require.Equal(t, len(expectedKeys), count)
 	require.NoError(t, iter.Close())
 }
+
+func TestRecomputeCommitInfo(t *testing.T) {
+	db := dbm.NewMemDB()
+	logger := coretesting.NewNopLogger()
+	newCommitStore := func() *commitment.CommitStore {
+		trees := make(map[string]commitment.Tree)
+		for _, storeKey := range []string{"store1", "store2"} {
+			trees[storeKey] = NewIavlTree(dbm.NewPrefixDB(db, []byte(storeKey)), logger, DefaultConfig())
+		}
+		commitStore, err := commitment.NewCommitStore(trees, nil, db, logger)
+		require.NoError(t, err)
+		return commitStore
+	}
+	commitStore := newCommitStore()
+
+	// store2 is left empty
+	for v := uint64(1); v <= 3; v++ {
+		cs := corestore.NewChangeset()
+		for i := 0; i < 10; i++ {
+			cs.Add([]byte("store1"), []byte(fmt.Sprintf("key-%d-%d", v, i)), []byte(fmt.Sprintf("value-%d-%d", v, i)), false)
+		}
+		require.NoError(t, commitStore.WriteChangeset(cs))
+		_, err := commitStore.Commit(v)
+		require.NoError(t, err)
+	}
+
+	newScratchTree := func(string) (commitment.Tree, error) {
+		return NewIavlTree(dbm.NewMemDB(), logger, DefaultConfig()), nil
+	}
+	for v := uint64(1); v <= 3; v++ {
+		recorded, err := commitStore.GetCommitInfo(v)
+		require.NoError(t, err)
+		recomputed, err := commitStore.RecomputeCommitInfo(v, newScratchTree)
+		require.NoError(t, err)
+		require.Equal(t, recorded.StoreInfos, recomputed.StoreInfos)
+		require.Equal(t, recorded.Hash(), recomputed.Hash())
+	}
+
+	_, err := commitStore.RecomputeCommitInfo(4, newScratchTree)
+	require.ErrorContains(t, err, "no commit info found for version 4")
+
+	// corrupt the value of a leaf node written at version 1
+	iter, err := db.Iterator([]byte("store1"), []byte("store2"))
+	require.NoError(t, err)
+	corrupted := map[string][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		if bytes.Contains(iter.Value(), []byte("value-1-0")) {
+			corrupted[string(iter.Key())] = bytes.ReplaceAll(iter.Value(), []byte("value-1-0"), []byte("VALUE-1-0"))
+		}
+	}
+	require.NoError(t, iter.Close())
+	require.NotEmpty(t, corrupted)
+	for key, value := range corrupted {
+		require.NoError(t, db.Set([]byte(key), value))
+	}
+
+	// reload the trees so that the nodes are not read from the cache
+	commitStore = newCommitStore()
+	require.NoError(t, commitStore.LoadVersion(3))
+
+	recorded, err := commitStore.GetCommitInfo(3)
+	require.NoError(t, err)
+	recomputed, err := commitStore.RecomputeCommitInfo(3, newScratchTree)
+	require.NoError(t, err)
+	require.NotEqual(t, recorded.GetStoreCommitID([]byte("store1")), recomputed.GetStoreCommitID([]byte("store1")))
+	require.Equal(t, recorded.GetStoreCommitID([]byte("store2")), recomputed.GetStoreCommitID([]byte("store2")))
+	require.NotEqual(t, recorded.Hash(), recomputed.Hash())
+}
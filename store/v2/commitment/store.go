@@ -544,6 +544,96 @@ func (c *CommitStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
 	return c.metadata.GetCommitInfo(version)
 }
 
+// RecomputeCommitInfo recomputes the commit info of the given version from the
+// nodes of the trees instead of reading the recorded one, in order to detect a
+// corruption of the trees. The nodes of each tree recorded in the commit info at
+// the version are exported and imported into an empty scratch tree created by
+// newTree, which recomputes the hash of every node. The scratch trees are closed
+// once their hash is computed.
+func (c *CommitStore) RecomputeCommitInfo(version uint64, newTree MountTreeFn) (*proof.CommitInfo, error) {
+	recorded, err := c.metadata.GetCommitInfo(version)
+	if err != nil {
+		return nil, err
+	}
+	if recorded == nil {
+		return nil, fmt.Errorf("no commit info found for version %d", version)
+	}
+
+	storeInfos := make([]proof.StoreInfo, 0, len(recorded.StoreInfos))
+	for _, si := range recorded.StoreInfos {
+		storeKey := conv.UnsafeBytesToStr(si.Name)
+		tree, ok := c.multiTrees[storeKey]
+		if !ok {
+			tree, ok = c.oldTrees[storeKey]
+		}
+		if !ok {
+			return nil, fmt.Errorf("store %s not found", storeKey)
+		}
+
+		hash, err := recomputeTreeHash(tree, version, storeKey, newTree)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recompute the hash of store %s at version %d: %w", storeKey, version, err)
+		}
+		storeInfos = append(storeInfos, proof.StoreInfo{
+			Name: si.Name,
+			CommitID: proof.CommitID{
+				Version: version,
+				Hash:    hash,
+			},
+		})
+	}
+
+	return &proof.CommitInfo{
+		Version:    version,
+		Timestamp:  recorded.Timestamp,
+		StoreInfos: storeInfos,
+	}, nil
+}
+
+func recomputeTreeHash(tree Tree, version uint64, storeKey string, newTree MountTreeFn) ([]byte, error) {
+	exporter, err := tree.Export(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export tree: %w", err)
+	}
+	defer exporter.Close()
+
+	scratch, err := newTree(storeKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scratch tree: %w", err)
+	}
+	defer scratch.Close()
+
+	importer, err := scratch.Import(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import tree: %w", err)
+	}
+	defer importer.Close()
+
+	empty := true
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get the next export node: %w", err)
+		}
+
+		empty = false
+		if err := importer.Add(item); err != nil {
+			return nil, fmt.Errorf("failed to add node to importer: %w", err)
+		}
+	}
+	// an empty tree has no root to commit, its hash is the one of the empty scratch tree.
+	if empty {
+		return scratch.Hash(), nil
+	}
+	if err := importer.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit importer: %w", err)
+	}
+
+	return scratch.Hash(), nil
+}
+
 func (c *CommitStore) GetLatestVersion() (uint64, error) {
 	return c.metadata.GetLatestVersion()
 }
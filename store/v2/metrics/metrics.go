@@ -12,6 +12,7 @@ var _ StoreMetrics = Metrics{}
 // StoreMetrics defines the set of supported metric APIs for the store package.
 type StoreMetrics interface {
 	MeasureSince(start time.Time, keys ...string)
+	SetGauge(val float32, labels []metrics.Label, keys ...string)
 }
 
 // Metrics defines a default StoreMetrics implementation.
@@ -45,3 +46,9 @@ func NewMetrics(labels [][]string) (Metrics, error) {
 func (m Metrics) MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
+
+// SetGauge provides a wrapper functionality for emitting a gauge metric with
+// the given labels and the global labels (if any).
+func (m Metrics) SetGauge(val float32, labels []metrics.Label, keys ...string) {
+	metrics.SetGaugeWithLabels(keys, val, append(labels, m.Labels...))
+}
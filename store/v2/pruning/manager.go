@@ -1,6 +1,8 @@
 package pruning
 
 import (
+	"fmt"
+
 	"cosmossdk.io/store/v2"
 )
 
@@ -14,6 +16,8 @@ type Manager struct {
 	ssPruner store.Pruner
 	// ssPruningOption are the pruning options for the SS.
 	ssPruningOption *store.PruningOption
+	// barrier is called before pruning a version, if set.
+	barrier func(version uint64) error
 }
 
 // NewManager creates a new Pruning Manager.
@@ -26,6 +30,14 @@ func NewManager(scPruner, ssPruner store.Pruner, scPruningOption, ssPruningOptio
 	}
 }
 
+// SetBarrier sets the function called with the version up to which the SC or SS
+// are about to be pruned. It must block until the state of that version can be
+// discarded, e.g. once it has been persisted by the listeners which can't lose
+// it. If it returns an error, the version is not pruned.
+func (m *Manager) SetBarrier(barrier func(version uint64) error) {
+	m.barrier = barrier
+}
+
 // Prune prunes the SC and SS to the provided version.
 //
 // NOTE: It can be called outside of the store manually.
@@ -33,6 +45,9 @@ func (m *Manager) Prune(version uint64) error {
 	// Prune the SC.
 	if m.scPruningOption != nil {
 		if prune, pruneTo := m.scPruningOption.ShouldPrune(version); prune {
+			if err := m.wait(pruneTo); err != nil {
+				return err
+			}
 			if err := m.scPruner.Prune(pruneTo); err != nil {
 				return err
 			}
@@ -42,6 +57,9 @@ func (m *Manager) Prune(version uint64) error {
 	// Prune the SS.
 	if m.ssPruningOption != nil {
 		if prune, pruneTo := m.ssPruningOption.ShouldPrune(version); prune {
+			if err := m.wait(pruneTo); err != nil {
+				return err
+			}
 			if err := m.ssPruner.Prune(pruneTo); err != nil {
 				return err
 			}
@@ -51,6 +69,19 @@ func (m *Manager) Prune(version uint64) error {
 	return nil
 }
 
+// wait calls the barrier, if any, before pruning to the provided version.
+func (m *Manager) wait(pruneTo uint64) error {
+	if m.barrier == nil {
+		return nil
+	}
+
+	if err := m.barrier(pruneTo); err != nil {
+		return fmt.Errorf("failed to wait before pruning version %d: %w", pruneTo, err)
+	}
+
+	return nil
+}
+
 // SignalCommit signals to the manager that a commit has started or finished.
 // It is used to trigger the pruning of the SC and SS.
 // It pauses or resumes the pruning of the SC and SS if the pruner implements
@@ -1,6 +1,7 @@
 package pruning
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -256,3 +257,36 @@ func (s *PruningManagerTestSuite) TestSignalCommit() {
 	}
 	s.Require().Eventually(checkSCPrune, 10*time.Second, 1*time.Second)
 }
+
+type recordingPruner struct {
+	pruned []uint64
+}
+
+func (p *recordingPruner) Prune(version uint64) error {
+	p.pruned = append(p.pruned, version)
+	return nil
+}
+
+func TestPruneBarrier(t *testing.T) {
+	scPruner, ssPruner := &recordingPruner{}, &recordingPruner{}
+	manager := NewManager(scPruner, ssPruner, store.NewPruningOptionWithCustom(0, 1), store.NewPruningOptionWithCustom(1, 1))
+
+	var barrierVersions []uint64
+	manager.SetBarrier(func(version uint64) error {
+		barrierVersions = append(barrierVersions, version)
+		if version == 4 {
+			return errors.New("not persisted")
+		}
+		return nil
+	})
+
+	require.NoError(t, manager.Prune(4))
+	require.Equal(t, []uint64{3, 2}, barrierVersions)
+	require.Equal(t, []uint64{3}, scPruner.pruned)
+	require.Equal(t, []uint64{2}, ssPruner.pruned)
+
+	// nothing is pruned when the barrier fails
+	require.ErrorContains(t, manager.Prune(5), "not persisted")
+	require.Equal(t, []uint64{3}, scPruner.pruned)
+	require.Equal(t, []uint64{2}, ssPruner.pruned)
+}
@@ -37,6 +37,7 @@ const (
 type Options struct {
 	SSType          SSType               `mapstructure:"ss-type" toml:"ss-type" comment:"State storage database type. Currently we support: \"sqlite\", \"pebble\" and \"rocksdb\""`
 	SCType          SCType               `mapstructure:"sc-type" toml:"sc-type" comment:"State commitment database type. Currently we support: \"iavl\" and \"iavl-v2\""`
+	TrackActorSizes bool                 `mapstructure:"track-actor-sizes" toml:"track-actor-sizes" comment:"Track the approximate key count and byte size of each store, exposed through telemetry. Enabling it requires iterating over the whole state storage on startup."`
 	SSPruningOption *store.PruningOption `mapstructure:"ss-pruning-option" toml:"ss-pruning-option" comment:"Pruning options for state storage"`
 	SCPruningOption *store.PruningOption `mapstructure:"sc-pruning-option" toml:"sc-pruning-option" comment:"Pruning options for state commitment"`
 	IavlConfig      *iavl.Config         `mapstructure:"iavl-config" toml:"iavl-config"`
@@ -177,5 +178,17 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 	}
 
 	pm := pruning.NewManager(sc, ss, storeOpts.SCPruningOption, storeOpts.SSPruningOption)
-	return New(opts.SCRawDB, opts.Logger, ss, sc, pm, nil, nil)
+	rs, err := New(opts.SCRawDB, opts.Logger, ss, sc, pm, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Options.TrackActorSizes {
+		tracked, ok := rs.(*Store)
+		if !ok {
+			return nil, fmt.Errorf("tracking actor sizes is not supported by %T", rs)
+		}
+		tracked.TrackActorSizes()
+	}
+
+	return rs, nil
 }
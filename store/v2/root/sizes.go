@@ -0,0 +1,137 @@
+package root
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	gometrics "github.com/hashicorp/go-metrics"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/metrics"
+)
+
+// actorSizes tracks the approximate key count and byte size of the state of
+// each store actor. It is updated incrementally on every commit.
+type actorSizes struct {
+	mtx   sync.RWMutex
+	sizes map[string]*store.ActorSize
+}
+
+func newActorSizes() *actorSizes {
+	return &actorSizes{sizes: make(map[string]*store.ActorSize)}
+}
+
+func (a *actorSizes) get(actor []byte) *store.ActorSize {
+	size, ok := a.sizes[string(actor)]
+	if !ok {
+		size = &store.ActorSize{Actor: bytes.Clone(actor)}
+		a.sizes[string(actor)] = size
+	}
+	return size
+}
+
+// load resets the sizes by iterating over the state of every given actor at
+// the given version.
+func (a *actorSizes) load(reader store.VersionedReader, version uint64, actors [][]byte) error {
+	sizes := make(map[string]*store.ActorSize, len(actors))
+	for _, actor := range actors {
+		size := &store.ActorSize{Actor: bytes.Clone(actor)}
+		iter, err := reader.Iterator(actor, version, nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to iterate over %s: %w", actor, err)
+		}
+		for ; iter.Valid(); iter.Next() {
+			size.KeyCount++
+			size.ByteSize += int64(len(iter.Key()) + len(iter.Value()))
+		}
+		if err := iter.Close(); err != nil {
+			return fmt.Errorf("failed to close iterator over %s: %w", actor, err)
+		}
+		sizes[string(actor)] = size
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.sizes = sizes
+	return nil
+}
+
+// apply updates the sizes with the given changeset, using the state at the
+// given version to find out whether the changed keys already existed. It returns
+// the actors whose size changed.
+func (a *actorSizes) apply(reader store.VersionedReader, version uint64, cs *corestore.Changeset) ([][]byte, error) {
+	type delta struct{ keys, bytes int64 }
+	deltas := make([]delta, len(cs.Changes))
+	for i, changes := range cs.Changes {
+		for _, kv := range changes.StateChanges {
+			var (
+				prev []byte
+				err  error
+			)
+			if version > 0 {
+				prev, err = reader.Get(changes.Actor, version, kv.Key)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			switch {
+			case kv.Remove && prev != nil:
+				deltas[i].keys--
+				deltas[i].bytes -= int64(len(kv.Key) + len(prev))
+			case !kv.Remove && prev == nil:
+				deltas[i].keys++
+				deltas[i].bytes += int64(len(kv.Key) + len(kv.Value))
+			case !kv.Remove:
+				deltas[i].bytes += int64(len(kv.Value) - len(prev))
+			}
+		}
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	updated := make([][]byte, 0, len(cs.Changes))
+	for i, changes := range cs.Changes {
+		if deltas[i] == (delta{}) {
+			continue
+		}
+		size := a.get(changes.Actor)
+		size.KeyCount += deltas[i].keys
+		size.ByteSize += deltas[i].bytes
+		updated = append(updated, changes.Actor)
+	}
+
+	return updated, nil
+}
+
+// all returns a copy of the sizes of all actors, ordered by actor.
+func (a *actorSizes) all() []store.ActorSize {
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+
+	res := make([]store.ActorSize, 0, len(a.sizes))
+	for _, size := range a.sizes {
+		res = append(res, *size)
+	}
+	sort.Slice(res, func(i, j int) bool { return bytes.Compare(res[i].Actor, res[j].Actor) < 0 })
+	return res
+}
+
+// emit emits the sizes of the given actors as telemetry gauges.
+func (a *actorSizes) emit(m metrics.StoreMetrics, actors [][]byte) {
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+
+	for _, actor := range actors {
+		size, ok := a.sizes[string(actor)]
+		if !ok {
+			continue
+		}
+		labels := []gometrics.Label{{Name: "actor", Value: string(actor)}}
+		m.SetGauge(float32(size.KeyCount), labels, "root_store", "actor_keys")
+		m.SetGauge(float32(size.ByteSize), labels, "root_store", "actor_bytes")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -24,6 +25,7 @@ import (
 var (
 	_ store.RootStore        = (*Store)(nil)
 	_ store.UpgradeableStore = (*Store)(nil)
+	_ store.SizeTracker      = (*Store)(nil)
 )
 
 // Store defines the SDK's default RootStore implementation. It contains a single
@@ -50,12 +52,22 @@ type Store struct {
 	// lastCommitInfo reflects the last version/hash that has been committed
 	lastCommitInfo *proof.CommitInfo
 
+	// latestState is the read-only view of the state at the latest committed
+	// version, published once a version is loaded or committed. It is read by
+	// StateLatest without locking, so that the queries never contend with the
+	// commits.
+	latestState atomic.Pointer[ReaderMap]
+
 	// telemetry reflects a telemetry agent responsible for emitting metrics (if any)
 	telemetry metrics.StoreMetrics
 
 	// pruningManager reflects the pruning manager used to prune state of the SS and SC backends
 	pruningManager *pruning.Manager
 
+	// actorSizes tracks the approximate size of the state of each store actor,
+	// it is nil if the tracking is disabled
+	actorSizes *actorSizes
+
 	// Migration related fields
 	// migrationManager reflects the migration manager used to migrate state from v1 to v2
 	migrationManager *migration.Manager
@@ -104,6 +116,7 @@ func (s *Store) Close() (err error) {
 	s.stateCommitment = nil
 	s.lastCommitInfo = nil
 	s.commitHeader = nil
+	s.latestState.Store(nil)
 
 	return err
 }
@@ -112,6 +125,31 @@ func (s *Store) SetMetrics(m metrics.Metrics) {
 	s.telemetry = m
 }
 
+// TrackActorSizes enables the tracking of the approximate key count and byte size
+// of the state of each store actor. The sizes are computed by iterating over the
+// state storage when a version is loaded, and are then updated incrementally on
+// every commit. It must be called before loading a version.
+func (s *Store) TrackActorSizes() {
+	s.actorSizes = newActorSizes()
+}
+
+// ActorSizes returns the approximate key count and byte size of the state of
+// each store actor, ordered by actor. It returns nil if the tracking is disabled.
+func (s *Store) ActorSizes() []store.ActorSize {
+	if s.actorSizes == nil {
+		return nil
+	}
+
+	return s.actorSizes.all()
+}
+
+// SetPruneBarrier sets the function called with the version up to which the
+// state is about to be pruned, see pruning.Manager.SetBarrier. When it returns
+// an error, the state is kept until the next pruning.
+func (s *Store) SetPruneBarrier(barrier func(version uint64) error) {
+	s.pruningManager.SetBarrier(barrier)
+}
+
 func (s *Store) SetInitialVersion(v uint64) error {
 	s.initialVersion = v
 
@@ -145,7 +183,16 @@ func (s *Store) getVersionedReader(version uint64) (store.VersionedReader, error
 	return nil, fmt.Errorf("version %d does not exist", version)
 }
 
+// StateLatest returns a read-only view of the state at the latest committed
+// version. It does not lock and is safe to call concurrently with WorkingHash and
+// Commit, e.g. from the query servers. The view is immutable: it keeps reading
+// the version it was returned for once newer versions are committed, until that
+// version is pruned.
 func (s *Store) StateLatest() (uint64, corestore.ReaderMap, error) {
+	if state := s.latestState.Load(); state != nil {
+		return state.version, state, nil
+	}
+
 	v, err := s.GetLatestVersion()
 	if err != nil {
 		return 0, nil, err
@@ -159,7 +206,8 @@ func (s *Store) StateLatest() (uint64, corestore.ReaderMap, error) {
 	return v, NewReaderMap(v, vReader), nil
 }
 
-// StateAt returns a read-only view of the state at a given version.
+// StateAt returns a read-only view of the state at a given version. Like
+// StateLatest, it is safe to call concurrently with WorkingHash and Commit.
 func (s *Store) StateAt(v uint64) (corestore.ReaderMap, error) {
 	vReader, err := s.getVersionedReader(v)
 	return NewReaderMap(v, vReader), err
@@ -332,14 +380,42 @@ func (s *Store) loadVersion(v uint64, upgrades *corestore.StoreUpgrades) error {
 		return fmt.Errorf("failed to get commit info for version %d: %w", v, err)
 	}
 
+	// the state storage is only written once the migration is complete, so the
+	// sizes can't be tracked while migrating
+	if s.actorSizes != nil && !s.isMigrating && s.lastCommitInfo != nil {
+		actors := make([][]byte, len(s.lastCommitInfo.StoreInfos))
+		for i, si := range s.lastCommitInfo.StoreInfos {
+			actors[i] = si.Name
+		}
+		if err := s.actorSizes.load(s.stateStorage, v, actors); err != nil {
+			return fmt.Errorf("failed to load actor sizes for version %d: %w", v, err)
+		}
+	}
+
 	// if we're migrating, we need to start the migration process
 	if s.isMigrating {
 		s.startMigration()
 	}
 
+	s.publishLatestState(v)
+
 	return nil
 }
 
+// publishLatestState publishes the read-only view of the state at the given
+// committed version for StateLatest. No view is published when the version can't
+// be read, e.g. before the initial commit, StateLatest then falls back to reading
+// the latest version of the backends.
+func (s *Store) publishLatestState(v uint64) {
+	vReader, err := s.getVersionedReader(v)
+	if err != nil {
+		s.latestState.Store(nil)
+		return
+	}
+
+	s.latestState.Store(NewReaderMap(v, vReader))
+}
+
 func (s *Store) SetCommitHeader(h *coreheader.Info) {
 	s.commitHeader = h
 }
@@ -421,6 +497,20 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		})
 	}
 
+	// update the actor sizes async, the previous values of the changed keys are
+	// read from the previous version so the SS commit doesn't affect them
+	var updatedActors [][]byte
+	if s.actorSizes != nil && !s.isMigrating {
+		eg.Go(func() (err error) {
+			updatedActors, err = s.actorSizes.apply(s.stateStorage, version-1, cs)
+			if err != nil {
+				return fmt.Errorf("failed to update actor sizes: %w", err)
+			}
+
+			return nil
+		})
+	}
+
 	// commit SC async
 	eg.Go(func() error {
 		if err := s.commitSC(); err != nil {
@@ -443,6 +533,12 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		s.lastCommitInfo.Timestamp = s.commitHeader.Time
 	}
 
+	if s.telemetry != nil && len(updatedActors) > 0 {
+		s.actorSizes.emit(s.telemetry, updatedActors)
+	}
+
+	s.publishLatestState(version)
+
 	return s.lastCommitInfo.Hash(), nil
 }
 
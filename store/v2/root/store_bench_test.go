@@ -0,0 +1,101 @@
+package root
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+func newBenchStore(b *testing.B) store.RootStore {
+	b.Helper()
+	noopLog := coretesting.NewNopLogger()
+
+	sqliteDB, err := sqlite.New(b.TempDir())
+	require.NoError(b, err)
+	ss := storage.NewStorageStore(sqliteDB, noopLog)
+
+	tree := iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	sc, err := commitment.NewCommitStore(map[string]commitment.Tree{testStoreKey: tree}, nil, dbm.NewMemDB(), noopLog)
+	require.NoError(b, err)
+
+	rs, err := New(dbm.NewMemDB(), noopLog, ss, sc, pruning.NewManager(sc, ss, nil, nil), nil, nil)
+	require.NoError(b, err)
+	b.Cleanup(func() { require.NoError(b, rs.Close()) })
+
+	return rs
+}
+
+func benchChangeset(v uint64) *corestore.Changeset {
+	cs := corestore.NewChangeset()
+	for i := 0; i < 100; i++ {
+		cs.Add(testStoreKeyBytes, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d_%d", i, v)), false)
+	}
+	return cs
+}
+
+// BenchmarkStateLatest measures the reads of the latest state by concurrent
+// queries, with and without versions being committed in the background.
+func BenchmarkStateLatest(b *testing.B) {
+	for _, committing := range []bool{false, true} {
+		b.Run(fmt.Sprintf("committing_%t", committing), func(b *testing.B) {
+			rs := newBenchStore(b)
+			_, err := rs.Commit(benchChangeset(1))
+			require.NoError(b, err)
+
+			var done atomic.Bool
+			committed := make(chan error, 1)
+			if committing {
+				go func() {
+					for v := uint64(2); !done.Load(); v++ {
+						if _, err := rs.Commit(benchChangeset(v)); err != nil {
+							committed <- err
+							return
+						}
+					}
+					committed <- nil
+				}()
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					_, ro, err := rs.StateLatest()
+					if err != nil {
+						b.Error(err)
+						return
+					}
+					reader, err := ro.GetReader(testStoreKeyBytes)
+					if err != nil {
+						b.Error(err)
+						return
+					}
+					if _, err := reader.Get([]byte(fmt.Sprintf("key%03d", i%100))); err != nil {
+						b.Error(err)
+						return
+					}
+					i++
+				}
+			})
+			b.StopTimer()
+
+			done.Store(true)
+			if committing {
+				require.NoError(b, <-committed)
+			}
+		})
+	}
+}
@@ -111,6 +111,7 @@ func TestLoadVersion(t *testing.T) {
 	require.Error(t, err)
 	sc.EXPECT().LoadVersionAndUpgrade(uint64(2), v).Return(nil)
 	sc.EXPECT().GetCommitInfo(uint64(2)).Return(nil, nil)
+	ss.EXPECT().VersionExists(uint64(2)).Return(true, nil)
 	ss.EXPECT().PruneStoreKeys(gomock.Any(), uint64(2)).Return(errors.New("error"))
 	err = rs.LoadVersionAndUpgrade(uint64(2), v)
 	require.Error(t, err)
@@ -199,6 +200,7 @@ func TestCommit(t *testing.T) {
 	sc.EXPECT().Commit(gomock.Any()).Return(&proof.CommitInfo{}, nil)
 	sc.EXPECT().PausePruning(false).Return()
 	ss.EXPECT().PausePruning(false).Return()
+	ss.EXPECT().VersionExists(uint64(0)).Return(true, nil)
 	_, err = rs.Commit(cs)
 	require.NoError(t, err)
 }
@@ -3,10 +3,12 @@ package root
 import (
 	"crypto/sha256"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/sync/errgroup"
 
 	coreheader "cosmossdk.io/core/header"
 	corestore "cosmossdk.io/core/store"
@@ -340,6 +342,50 @@ func (s *RootStoreTestSuite) TestCommit() {
 	}
 }
 
+func (s *RootStoreTestSuite) TestActorSizes() {
+	rs := s.rootStore.(*Store)
+	s.Require().Nil(rs.ActorSizes())
+
+	rs.TrackActorSizes()
+	s.Require().NoError(rs.LoadLatestVersion())
+
+	cs := corestore.NewChangeset()
+	for i := 0; i < 10; i++ {
+		cs.Add(testStoreKeyBytes, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d", i)), false)
+	}
+	cs.Add(testStoreKey2Bytes, []byte("key000"), []byte("val000"), false)
+	_, err := rs.Commit(cs)
+	s.Require().NoError(err)
+
+	s.Require().Equal([]store.ActorSize{
+		{Actor: testStoreKeyBytes, KeyCount: 10, ByteSize: 120},
+		{Actor: testStoreKey2Bytes, KeyCount: 1, ByteSize: 12},
+	}, rs.ActorSizes())
+
+	// update a key, remove a key, add a key and remove a missing key
+	cs = corestore.NewChangeset()
+	cs.Add(testStoreKeyBytes, []byte("key000"), []byte("value000"), false)
+	cs.Add(testStoreKeyBytes, []byte("key001"), nil, true)
+	cs.Add(testStoreKeyBytes, []byte("key100"), []byte("val100"), false)
+	cs.Add(testStoreKey2Bytes, []byte("key001"), nil, true)
+	_, err = rs.Commit(cs)
+	s.Require().NoError(err)
+
+	expected := []store.ActorSize{
+		{Actor: testStoreKeyBytes, KeyCount: 10, ByteSize: 122},
+		{Actor: testStoreKey2Bytes, KeyCount: 1, ByteSize: 12},
+	}
+	s.Require().Equal(expected, rs.ActorSizes())
+
+	// sizes are recomputed from the state storage when loading a version
+	s.Require().NoError(rs.LoadLatestVersion())
+	s.Require().Equal([]store.ActorSize{
+		{Actor: testStoreKeyBytes, KeyCount: 10, ByteSize: 122},
+		{Actor: testStoreKey2Bytes, KeyCount: 1, ByteSize: 12},
+		{Actor: testStoreKey3Bytes},
+	}, rs.ActorSizes())
+}
+
 func (s *RootStoreTestSuite) TestStateAt() {
 	// write keys over multiple versions
 	for v := uint64(1); v <= 5; v++ {
@@ -393,6 +439,60 @@ func (s *RootStoreTestSuite) TestStateAt() {
 	}
 }
 
+func (s *RootStoreTestSuite) TestStateLatestConcurrentCommits() {
+	const (
+		commits = 50
+		readers = 8
+	)
+
+	commit := func(v uint64) {
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+		_, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+	commit(1)
+
+	// the readers read the latest state while the versions are committed, each
+	// view must keep reading the version it was returned for
+	var done atomic.Bool
+	eg := new(errgroup.Group)
+	for i := 0; i < readers; i++ {
+		eg.Go(func() error {
+			for !done.Load() {
+				v, ro, err := s.rootStore.StateLatest()
+				if err != nil {
+					return err
+				}
+				reader, err := ro.GetReader(testStoreKeyBytes)
+				if err != nil {
+					return err
+				}
+				for j := 0; j < 2; j++ {
+					val, err := reader.Get([]byte("key"))
+					if err != nil {
+						return err
+					}
+					if expected := fmt.Sprintf("val%03d", v); string(val) != expected {
+						return fmt.Errorf("unexpected value at version %d: got %s, expected %s", v, val, expected)
+					}
+				}
+			}
+			return nil
+		})
+	}
+
+	for v := uint64(2); v <= commits; v++ {
+		commit(v)
+	}
+	done.Store(true)
+	s.Require().NoError(eg.Wait())
+
+	v, _, err := s.rootStore.StateLatest()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(commits), v)
+}
+
 func (s *RootStoreTestSuite) TestWorkingHash() {
 	// write keys over multiple versions
 	for v := uint64(1); v <= 5; v++ {
@@ -16,7 +16,8 @@ type RootStore interface {
 	Backend
 
 	// StateLatest returns a read-only version of the RootStore at the latest
-	// height, alongside the associated version.
+	// height, alongside the associated version. The returned view is immutable
+	// and it must be safe to call StateLatest concurrently with Commit.
 	StateLatest() (uint64, corestore.ReaderMap, error)
 
 	// StateAt is analogous to StateLatest() except it returns a read-only version
@@ -103,6 +104,22 @@ type PausablePruner interface {
 	PausePruning(pause bool)
 }
 
+// SizeTracker defines the interface for RootStore implementations tracking the
+// approximate state size of each store actor.
+type SizeTracker interface {
+	// ActorSizes returns the approximate key count and byte size of the state of
+	// each store actor, ordered by actor.
+	ActorSizes() []ActorSize
+}
+
+// ActorSize defines the approximate size of the state of a store actor.
+type ActorSize struct {
+	Actor    []byte
+	KeyCount int64
+	// ByteSize is the sum of the lengths of the keys and values of the actor.
+	ByteSize int64
+}
+
 // QueryResult defines the response type to performing a query on a RootStore.
 type QueryResult struct {
 	Key      []byte
@@ -0,0 +1,68 @@
+package telemetry
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"cosmossdk.io/schema/indexer"
+)
+
+// IndexerStatusCollector is a Prometheus collector exporting the status of the indexer targets,
+// so that operators can alert when a target falls behind the app or returns errors.
+type IndexerStatusCollector struct {
+	status func() map[string]indexer.TargetStatus
+
+	lastHeight *prometheus.Desc
+	lag        *prometheus.Desc
+	errors     *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*IndexerStatusCollector)(nil)
+
+// NewIndexerStatusCollector returns a collector exporting the indexer target status returned by
+// status, usually IndexingTarget.Status.
+func NewIndexerStatusCollector(status func() map[string]indexer.TargetStatus) *IndexerStatusCollector {
+	labels := []string{"target", "type"}
+	return &IndexerStatusCollector{
+		status: status,
+		lastHeight: prometheus.NewDesc(
+			"cosmos_indexer_last_height",
+			"Height of the last block committed by the indexer target.",
+			labels, nil,
+		),
+		lag: prometheus.NewDesc(
+			"cosmos_indexer_lag_blocks",
+			"Number of blocks committed by the app which the indexer target hasn't committed yet.",
+			labels, nil,
+		),
+		errors: prometheus.NewDesc(
+			"cosmos_indexer_errors_total",
+			"Number of errors returned by the indexer target.",
+			labels, nil,
+		),
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *IndexerStatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.lastHeight
+	ch <- c.lag
+	ch <- c.errors
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *IndexerStatusCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, status := range c.status() {
+		ch <- prometheus.MustNewConstMetric(c.lastHeight, prometheus.GaugeValue, float64(status.LastHeight), name, status.Type)
+		ch <- prometheus.MustNewConstMetric(c.lag, prometheus.GaugeValue, float64(status.Lag), name, status.Type)
+		ch <- prometheus.MustNewConstMetric(c.errors, prometheus.CounterValue, float64(status.ErrorCount), name, status.Type)
+	}
+}
+
+// RegisterIndexerStatusCollector registers a collector exporting the indexer target status
+// returned by status with the default Prometheus registerer. A collector registered previously,
+// e.g. for another app instance in the same process, is replaced.
+func RegisterIndexerStatusCollector(status func() map[string]indexer.TargetStatus) error {
+	collector := NewIndexerStatusCollector(status)
+	prometheus.Unregister(collector)
+	return prometheus.Register(collector)
+}
@@ -0,0 +1,32 @@
+package telemetry
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/schema/indexer"
+)
+
+func TestIndexerStatusCollector(t *testing.T) {
+	collector := NewIndexerStatusCollector(func() map[string]indexer.TargetStatus {
+		return map[string]indexer.TargetStatus{
+			"postgres": {Type: "postgres", LastHeight: 8, Lag: 2, ErrorCount: 1},
+		}
+	})
+
+	expected := `
+# HELP cosmos_indexer_errors_total Number of errors returned by the indexer target.
+# TYPE cosmos_indexer_errors_total counter
+cosmos_indexer_errors_total{target="postgres",type="postgres"} 1
+# HELP cosmos_indexer_lag_blocks Number of blocks committed by the app which the indexer target hasn't committed yet.
+# TYPE cosmos_indexer_lag_blocks gauge
+cosmos_indexer_lag_blocks{target="postgres",type="postgres"} 2
+# HELP cosmos_indexer_last_height Height of the last block committed by the indexer target.
+# TYPE cosmos_indexer_last_height gauge
+cosmos_indexer_last_height{target="postgres",type="postgres"} 8
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
@@ -163,16 +163,20 @@ func (s *E2ETestSuite) TestContinuousLockingAccount() {
 		require.NoError(t, err)
 		require.Equal(t, len(ubd.Entries), 1)
 
-		// check if tracking is updated accordingly
+		// the undelegation is tracked once the unbonding completes
 		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
 		delLocking := lockupAccountInfoResponse.DelegatedLocking
-		require.True(t, delLocking.AmountOf("stake").Equal(math.ZeroInt()))
+		require.True(t, delLocking.AmountOf("stake").Equal(math.NewInt(100)))
+		unbondingEntries := s.queryUnbondingEntries(ctx, app, accountAddr)
+		require.Len(t, unbondingEntries, 1)
+		require.Equal(t, ubd.Entries[0].CompletionTime, unbondingEntries[0].EndTime)
 	})
 
 	// Update context time to end time
 	ctx = ctx.WithHeaderInfo(header.Info{
 		Time: currentTime.Add(time.Minute),
 	})
+	s.completeUnbonding(ctx, app, accountAddr, val.OperatorAddress)
 
 	// test if tracking delegate work perfectly
 	t.Run("ok - execute delegate message", func(t *testing.T) {
@@ -125,10 +125,13 @@ func (s *E2ETestSuite) TestDelayedLockingAccount() {
 		require.NoError(t, err)
 		require.Equal(t, len(ubd.Entries), 1)
 
-		// check if tracking is updated accordingly
+		// the undelegation is tracked once the unbonding completes
 		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
 		delLocking := lockupAccountInfoResponse.DelegatedLocking
-		require.True(t, delLocking.AmountOf("stake").Equal(math.ZeroInt()))
+		require.True(t, delLocking.AmountOf("stake").Equal(math.NewInt(100)))
+		unbondingEntries := s.queryUnbondingEntries(ctx, app, accountAddr)
+		require.Len(t, unbondingEntries, 1)
+		require.Equal(t, ubd.Entries[0].CompletionTime, unbondingEntries[0].EndTime)
 	})
 
 	// Update context time
@@ -136,6 +139,7 @@ func (s *E2ETestSuite) TestDelayedLockingAccount() {
 	ctx = ctx.WithHeaderInfo(header.Info{
 		Time: currentTime.Add(time.Second * 61),
 	})
+	s.completeUnbonding(ctx, app, accountAddr, val.OperatorAddress)
 
 	// Check if token is sendable after unlock
 	t.Run("ok - execute send message", func(t *testing.T) {
@@ -165,8 +169,8 @@ func (s *E2ETestSuite) TestDelayedLockingAccount() {
 		require.NoError(t, err)
 
 		// withdrawable amount should be
-		// 1000stake - 100stake( above sent amt ) - 100stake(above delegate amt) = 800stake
+		// 1000stake - 100stake( above sent amt ) = 900stake, the delegated amount having been undelegated
 		balance := app.BankKeeper.GetBalance(ctx, withdrawAcc, "stake")
-		require.True(t, balance.Amount.Equal(math.NewInt(800)))
+		require.True(t, balance.Amount.Equal(math.NewInt(900)))
 	})
 }
@@ -185,10 +185,13 @@ func (s *E2ETestSuite) TestPeriodicLockingAccount() {
 		require.NoError(t, err)
 		require.Equal(t, len(ubd.Entries), 1)
 
-		// check if tracking is updated accordingly
+		// the undelegation is tracked once the unbonding completes
 		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
 		delLocking := lockupAccountInfoResponse.DelegatedLocking
-		require.True(t, delLocking.AmountOf("stake").Equal(math.ZeroInt()))
+		require.True(t, delLocking.AmountOf("stake").Equal(math.NewInt(100)))
+		unbondingEntries := s.queryUnbondingEntries(ctx, app, accountAddr)
+		require.Len(t, unbondingEntries, 1)
+		require.Equal(t, ubd.Entries[0].CompletionTime, unbondingEntries[0].EndTime)
 	})
 
 	// Update context time
@@ -196,6 +199,7 @@ func (s *E2ETestSuite) TestPeriodicLockingAccount() {
 	ctx = ctx.WithHeaderInfo(header.Info{
 		Time: currentTime.Add(time.Minute * 3),
 	})
+	s.completeUnbonding(ctx, app, accountAddr, val.OperatorAddress)
 
 	t.Run("ok - execute delegate message", func(t *testing.T) {
 		msg := &types.MsgDelegate{
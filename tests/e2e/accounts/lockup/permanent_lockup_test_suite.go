@@ -109,11 +109,19 @@ func (s *E2ETestSuite) TestPermanentLockingAccount() {
 		require.NoError(t, err)
 		require.Equal(t, len(ubd.Entries), 1)
 
-		// check if tracking is updated accordingly
+		// the undelegation is tracked once the unbonding completes
 		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
 		delLocking := lockupAccountInfoResponse.DelegatedLocking
-		require.True(t, delLocking.AmountOf("stake").Equal(math.ZeroInt()))
+		require.True(t, delLocking.AmountOf("stake").Equal(math.NewInt(100)))
+		unbondingEntries := s.queryUnbondingEntries(ctx, app, accountAddr)
+		require.Len(t, unbondingEntries, 1)
+		require.Equal(t, ubd.Entries[0].CompletionTime, unbondingEntries[0].EndTime)
+	})
+
+	ctx = ctx.WithHeaderInfo(header.Info{
+		Time: currentTime.Add(unbondingTime),
 	})
+	s.completeUnbonding(ctx, app, accountAddr, val.OperatorAddress)
 
 	s.fundAccount(app, ctx, accountAddr, sdk.Coins{sdk.NewCoin("stake", math.NewInt(1000))})
 
@@ -128,5 +136,11 @@ func (s *E2ETestSuite) TestPermanentLockingAccount() {
 
 		balance := app.BankKeeper.GetBalance(ctx, randAcc, "stake")
 		require.True(t, balance.Amount.Equal(math.NewInt(100)))
+
+		// the completed unbonding has been tracked
+		lockupAccountInfoResponse := s.queryLockupAccInfo(ctx, app, accountAddr)
+		delLocking := lockupAccountInfoResponse.DelegatedLocking
+		require.True(t, delLocking.AmountOf("stake").Equal(math.ZeroInt()))
+		require.Empty(t, s.queryUnbondingEntries(ctx, app, accountAddr))
 	})
 }
@@ -2,6 +2,7 @@ package lockup
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -20,6 +21,10 @@ var (
 	accOwner  = sdk.AccAddress(ownerAddr)
 )
 
+// unbondingTime is the staking unbonding time of the test app, short enough for the unbondings
+// to complete within the lockup periods.
+const unbondingTime = time.Second
+
 type E2ETestSuite struct {
 	suite.Suite
 
@@ -42,6 +47,13 @@ func (s *E2ETestSuite) TearDownSuite() {
 func setupApp(t *testing.T) *simapp.SimApp {
 	t.Helper()
 	app := simapp.Setup(t, false)
+
+	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger())
+	params, err := app.StakingKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.UnbondingTime = unbondingTime
+	require.NoError(t, app.StakingKeeper.Params.Set(ctx, params))
+
 	return app
 }
 
@@ -59,6 +71,27 @@ func (s *E2ETestSuite) fundAccount(app *simapp.SimApp, ctx sdk.Context, addr sdk
 	require.NoError(s.T(), testutil.FundAccount(ctx, app.BankKeeper, addr, amt))
 }
 
+// completeUnbonding completes the mature unbonding delegation entries of the account, as the staking end blocker does.
+func (s *E2ETestSuite) completeUnbonding(ctx sdk.Context, app *simapp.SimApp, accAddr []byte, valAddr string) {
+	valbz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(valAddr)
+	require.NoError(s.T(), err)
+
+	_, err = app.StakingKeeper.CompleteUnbonding(ctx, accAddr, valbz)
+	require.NoError(s.T(), err)
+}
+
+func (s *E2ETestSuite) queryUnbondingEntries(ctx sdk.Context, app *simapp.SimApp, accAddr []byte) []*types.UnbondingEntry {
+	req := &types.QueryUnbondingEntriesRequest{}
+	resp, err := s.queryAcc(ctx, req, app, accAddr)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), resp)
+
+	unbondingEntriesResponse, ok := resp.(*types.QueryUnbondingEntriesResponse)
+	require.True(s.T(), ok)
+
+	return unbondingEntriesResponse.UnbondingEntries
+}
+
 func (s *E2ETestSuite) queryLockupAccInfo(ctx sdk.Context, app *simapp.SimApp, accAddr []byte) *types.QueryLockupAccountInfoResponse {
 	req := &types.QueryLockupAccountInfoRequest{}
 	resp, err := s.queryAcc(ctx, req, app, accAddr)
@@ -61,6 +61,16 @@ func TestMigrateToAccounts(t *testing.T) {
 		require.ErrorContains(t, err, "only BaseAccount can be migrated")
 	})
 
+	t.Run("empty account type", func(t *testing.T) {
+		resp, err := msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+			Signer:         f.mustAddr(addr),
+			AccountType:    "",
+			AccountInitMsg: nil,
+		})
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "account type cannot be empty")
+	})
+
 	t.Run("success", func(t *testing.T) {
 		pk, err := codectypes.NewAnyWithValue(privKey.PubKey())
 		require.NoError(t, err)
@@ -97,4 +107,34 @@ func TestMigrateToAccounts(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, migrateMsg.PubKey, pkResp.(*basev1.QueryPubKeyResponse).PubKey)
 	})
+
+	t.Run("sequence is kept", func(t *testing.T) {
+		privKey := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+
+		acc := f.authKeeper.NewAccountWithAddress(f.ctx, addr)
+		require.NoError(t, acc.SetPubKey(privKey.PubKey()))
+		require.NoError(t, acc.SetSequence(42))
+		f.authKeeper.SetAccount(f.ctx, acc)
+
+		pk, err := codectypes.NewAnyWithValue(privKey.PubKey())
+		require.NoError(t, err)
+		initMsgAny, err := codectypes.NewAnyWithValue(&basev1.MsgInit{PubKey: pk})
+		require.NoError(t, err)
+
+		_, err = msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+			Signer:         f.mustAddr(addr),
+			AccountType:    "base",
+			AccountInitMsg: initMsgAny,
+		})
+		require.NoError(t, err)
+
+		seq, err := f.accountsKeeper.Query(f.ctx, addr, &basev1.QuerySequence{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), seq.(*basev1.QuerySequenceResponse).Sequence)
+
+		num, err := f.accountsKeeper.AccountByNumber.Get(f.ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, acc.GetAccountNumber(), num)
+	})
 }
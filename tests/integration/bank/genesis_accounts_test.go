@@ -0,0 +1,93 @@
+package bank_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestGenesisVestingAndModuleAccounts(t *testing.T) {
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	continuousAddr := sdk.AccAddress("continuous__________")
+	delayedAddr := sdk.AccAddress("delayed_____________")
+	periodicAddr := sdk.AccAddress("periodic____________")
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+
+	continuous, err := simtestutil.NewContinuousVestingGenesisAccount(continuousAddr, stake(1000), stake(800), genesisTime, genesisTime.Add(100*time.Second))
+	require.NoError(t, err)
+	delayed, err := simtestutil.NewDelayedVestingGenesisAccount(delayedAddr, stake(500), stake(500), genesisTime.Add(time.Hour))
+	require.NoError(t, err)
+	periodic, err := simtestutil.NewPeriodicVestingGenesisAccount(periodicAddr, stake(300), genesisTime, vestingtypes.Periods{
+		{Length: 50, Amount: stake(100)},
+		{Length: 50, Amount: stake(200)},
+	})
+	require.NoError(t, err)
+	module := simtestutil.NewModuleGenesisAccount("test_module", stake(10), authtypes.Burner)
+
+	// the vesting coins must be held by the account
+	_, err = simtestutil.NewDelayedVestingGenesisAccount(delayedAddr, stake(100), stake(500), genesisTime.Add(time.Hour))
+	require.ErrorContains(t, err, "exceed the balance")
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.BlockTime = genesisTime
+	// the module account is not delegating to the validators, although it comes first
+	startupCfg.GenesisAccounts = append([]simtestutil.GenesisAccount{module}, append(startupCfg.GenesisAccounts, continuous, delayed, periodic)...)
+
+	var (
+		bankKeeper    bankkeeper.Keeper
+		accountKeeper authkeeper.AccountKeeper
+	)
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.AccountsModule(),
+				configurator.AuthModule(),
+				configurator.VestingModule(),
+				configurator.StakingModule(),
+				configurator.TxModule(),
+				configurator.ValidateModule(),
+				configurator.ConsensusModule(),
+				configurator.BankModule(),
+			),
+			depinject.Supply(log.NewNopLogger()),
+		),
+		startupCfg, &bankKeeper, &accountKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(true)
+	spendableAt := func(addr sdk.AccAddress, d time.Duration) sdk.Coins {
+		return bankKeeper.SpendableCoins(ctx.WithHeaderInfo(header.Info{Time: genesisTime.Add(d)}), addr)
+	}
+
+	require.IsType(t, &vestingtypes.ContinuousVestingAccount{}, accountKeeper.GetAccount(ctx, continuousAddr))
+	require.Equal(t, stake(200), spendableAt(continuousAddr, 0))
+	require.Equal(t, stake(600), spendableAt(continuousAddr, 50*time.Second))
+
+	require.IsType(t, &vestingtypes.DelayedVestingAccount{}, accountKeeper.GetAccount(ctx, delayedAddr))
+	require.True(t, spendableAt(delayedAddr, 50*time.Minute).IsZero())
+	require.Equal(t, stake(500), spendableAt(delayedAddr, time.Hour))
+
+	require.IsType(t, &vestingtypes.PeriodicVestingAccount{}, accountKeeper.GetAccount(ctx, periodicAddr))
+	require.Equal(t, stake(100), spendableAt(periodicAddr, 50*time.Second))
+	require.Equal(t, stake(300), spendableAt(periodicAddr, 100*time.Second))
+
+	moduleAcc, ok := accountKeeper.GetAccount(ctx, authtypes.NewModuleAddress("test_module")).(sdk.ModuleAccountI)
+	require.True(t, ok)
+	require.Equal(t, "test_module", moduleAcc.GetName())
+	require.True(t, moduleAcc.HasPermission(authtypes.Burner))
+	require.Equal(t, stake(10), bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress()))
+}
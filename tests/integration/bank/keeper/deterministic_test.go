@@ -60,6 +60,7 @@ var (
 )
 
 type deterministicFixture struct {
+	app         *integration.App
 	ctx         sdk.Context
 	bankKeeper  keeper.BaseKeeper
 	queryClient banktypes.QueryClient
@@ -140,6 +141,7 @@ func initDeterministicFixture(t *testing.T) *deterministicFixture {
 	queryClient := banktypes.NewQueryClient(qr)
 
 	f := deterministicFixture{
+		app:         integrationApp,
 		ctx:         sdkCtx,
 		bankKeeper:  bankKeeper,
 		queryClient: queryClient,
@@ -564,3 +566,44 @@ func TestGRPCDenomOwners(t *testing.T) {
 	}
 	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.DenomOwners, 2516, false)
 }
+
+func TestGRPCGoldenQueries(t *testing.T) {
+	t.Parallel()
+	f := initDeterministicFixture(t)
+
+	fundAccount(f, addr1, coin1)
+	f.bankKeeper.SetDenomMetaData(f.ctx, metadataAtom)
+
+	f.app.AssertGoldenQueries(t,
+		integration.GoldenQuery{
+			Name:     "query_balance.golden",
+			Method:   "/cosmos.bank.v1beta1.Query/Balance",
+			Request:  &banktypes.QueryBalanceRequest{Address: addr1.String(), Denom: coin1.Denom},
+			Response: &banktypes.QueryBalanceResponse{},
+		},
+		integration.GoldenQuery{
+			Name:     "query_all_balances.golden",
+			Method:   "/cosmos.bank.v1beta1.Query/AllBalances",
+			Request:  &banktypes.QueryAllBalancesRequest{Address: addr1.String()},
+			Response: &banktypes.QueryAllBalancesResponse{},
+		},
+		integration.GoldenQuery{
+			Name:     "query_total_supply.golden",
+			Method:   "/cosmos.bank.v1beta1.Query/TotalSupply",
+			Request:  &banktypes.QueryTotalSupplyRequest{},
+			Response: &banktypes.QueryTotalSupplyResponse{},
+		},
+		integration.GoldenQuery{
+			Name:     "query_denom_metadata.golden",
+			Method:   "/cosmos.bank.v1beta1.Query/DenomMetadata",
+			Request:  &banktypes.QueryDenomMetadataRequest{Denom: metadataAtom.Base},
+			Response: &banktypes.QueryDenomMetadataResponse{},
+		},
+		integration.GoldenQuery{
+			Name:     "query_params.golden",
+			Method:   "/cosmos.bank.v1beta1.Query/Params",
+			Request:  &banktypes.QueryParamsRequest{},
+			Response: &banktypes.QueryParamsResponse{},
+		},
+	)
+}
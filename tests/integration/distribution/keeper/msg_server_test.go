@@ -357,6 +357,11 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 				// check current balance is greater than initial balance
 				curBalance := f.bankKeeper.GetAllBalances(f.sdkCtx, sdk.AccAddress(f.valAddr))
 				assert.Assert(t, initBalance.IsAllLTE(curBalance))
+
+				f.app.RequireEventEmitted(t, distrtypes.EventTypeWithdrawRewards,
+					sdk.NewAttribute(distrtypes.AttributeKeyValidator, f.valAddr.String()),
+					sdk.NewAttribute(distrtypes.AttributeKeyDelegator, delAddr.String()),
+				)
 			}
 
 			var previousTotalPower int64
@@ -712,6 +717,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					WithdrawAddrEnabled: withdrawAddrEnabled,
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
+					FeeBurnRate:         math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -726,6 +732,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					WithdrawAddrEnabled: withdrawAddrEnabled,
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
+					FeeBurnRate:         math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -740,6 +747,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					WithdrawAddrEnabled: withdrawAddrEnabled,
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
+					FeeBurnRate:         math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -754,11 +762,57 @@ func TestMsgUpdateParams(t *testing.T) {
 					WithdrawAddrEnabled: withdrawAddrEnabled,
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
+					FeeBurnRate:         math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
 			expErrMsg: "community tax must be positive: -0.200000000000000000",
 		},
+		{
+			name: "negative fee burn rate",
+			msg: &distrtypes.MsgUpdateParams{
+				Authority: f.distrKeeper.GetAuthority(),
+				Params: distrtypes.Params{
+					CommunityTax:        communityTax,
+					WithdrawAddrEnabled: withdrawAddrEnabled,
+					BaseProposerReward:  math.LegacyZeroDec(),
+					BonusProposerReward: math.LegacyZeroDec(),
+					FeeBurnRate:         math.LegacyNewDecWithPrec(-2, 1),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "fee burn rate must be positive: -0.200000000000000000",
+		},
+		{
+			name: "fee burn rate > 1",
+			msg: &distrtypes.MsgUpdateParams{
+				Authority: f.distrKeeper.GetAuthority(),
+				Params: distrtypes.Params{
+					CommunityTax:        communityTax,
+					WithdrawAddrEnabled: withdrawAddrEnabled,
+					BaseProposerReward:  math.LegacyZeroDec(),
+					BonusProposerReward: math.LegacyZeroDec(),
+					FeeBurnRate:         math.LegacyNewDecWithPrec(2, 0),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "fee burn rate too large: 2.000000000000000000",
+		},
+		{
+			name: "fee burn rate without burner permission",
+			msg: &distrtypes.MsgUpdateParams{
+				Authority: f.distrKeeper.GetAuthority(),
+				Params: distrtypes.Params{
+					CommunityTax:        communityTax,
+					WithdrawAddrEnabled: withdrawAddrEnabled,
+					BaseProposerReward:  math.LegacyZeroDec(),
+					BonusProposerReward: math.LegacyZeroDec(),
+					FeeBurnRate:         math.LegacyNewDecWithPrec(1, 1),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "distribution module account must have the burner permission to burn fees",
+		},
 		{
 			name: "base proposer reward set",
 			msg: &distrtypes.MsgUpdateParams{
@@ -768,6 +822,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					BaseProposerReward:  math.LegacyNewDecWithPrec(1, 2),
 					BonusProposerReward: math.LegacyZeroDec(),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnRate:         math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -782,6 +837,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyNewDecWithPrec(1, 2),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnRate:         math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -796,6 +852,7 @@ func TestMsgUpdateParams(t *testing.T) {
 					BaseProposerReward:  math.LegacyZeroDec(),
 					BonusProposerReward: math.LegacyZeroDec(),
 					WithdrawAddrEnabled: withdrawAddrEnabled,
+					FeeBurnRate:         math.LegacyZeroDec(),
 				},
 			},
 			expErr: false,
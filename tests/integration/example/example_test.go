@@ -5,26 +5,45 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/mock/gomock"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/epochs"
+	epochskeeper "cosmossdk.io/x/epochs/keeper"
+	epochstypes "cosmossdk.io/x/epochs/types"
 	"cosmossdk.io/x/mint"
 	mintkeeper "cosmossdk.io/x/mint/keeper"
 	minttypes "cosmossdk.io/x/mint/types"
+	"cosmossdk.io/x/upgrade"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtestutil "github.com/cosmos/cosmos-sdk/x/auth/testutil"
@@ -241,3 +260,563 @@ func Example_oneModule() {
 	fmt.Println(got.MaxMemoCharacters)
 	// Output: 1000
 }
+
+// exampleChain holds the stores, keepers and modules of an example chain, from which the
+// integration applications of the examples below are created.
+type exampleChain struct {
+	encodingCfg moduletestutil.TestEncodingConfig
+	authority   string
+	logger      log.Logger
+
+	keys map[string]*storetypes.KVStoreKey
+	cms  storetypes.CommitMultiStore
+	// ctx is the context the applications are created with, on the multistore of the chain.
+	ctx sdk.Context
+
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.BaseKeeper
+	modules       map[string]appmodule.AppModule
+}
+
+// newExampleChain creates the stores and the keepers of an example chain running the auth and bank modules.
+// The stores of the given store keys are mounted as well, for the modules added by the examples.
+func newExampleChain(logger log.Logger, storeKeys ...string) *exampleChain {
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{}, bank.AppModule{})
+	keys := storetypes.NewKVStoreKeys(append([]string{authtypes.StoreKey, banktypes.StoreKey}, storeKeys...)...)
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	cms := integration.CreateMultiStore(keys, logger)
+
+	// gomock initializations
+	acctsModKeeper := authtestutil.NewMockAccountsModKeeper(gomock.NewController(&testing.T{}))
+	accNum := uint64(0)
+	acctsModKeeper.EXPECT().NextAccountNumber(gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context) (uint64, error) {
+		currentNum := accNum
+		accNum++
+		return currentNum, nil
+	})
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
+		encodingCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		acctsModKeeper,
+		map[string][]string{
+			minttypes.ModuleName: {authtypes.Minter},
+			transferModuleName:   {authtypes.Minter, authtypes.Burner},
+		},
+		addresscodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		authority,
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(keys[banktypes.StoreKey]), log.NewNopLogger()),
+		encodingCfg.Codec,
+		accountKeeper,
+		map[string]bool{},
+		authority,
+	)
+
+	return &exampleChain{
+		encodingCfg:   encodingCfg,
+		authority:     authority,
+		logger:        logger,
+		keys:          keys,
+		cms:           cms,
+		ctx:           sdk.NewContext(cms, true, logger),
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		modules: map[string]appmodule.AppModule{
+			authtypes.ModuleName: auth.NewAppModule(encodingCfg.Codec, accountKeeper, acctsModKeeper, authsims.RandomGenesisAccounts, nil),
+			banktypes.ModuleName: bank.NewAppModule(encodingCfg.Codec, bankKeeper, accountKeeper),
+		},
+	}
+}
+
+// newApp creates an integration application of the modules of the chain with the given base app
+// options, and registers the message and query servers of the modules. The application must share the
+// multistore of the chain to run blocks, see integration.WithSharedMultiStore.
+func (c *exampleChain) newApp(baseAppOptions ...func(*baseapp.BaseApp)) *integration.App {
+	signingCtx := c.encodingCfg.InterfaceRegistry.SigningContext()
+
+	app := integration.NewIntegrationApp(
+		c.ctx,
+		c.logger,
+		c.keys,
+		c.encodingCfg.Codec,
+		signingCtx.AddressCodec(),
+		signingCtx.ValidatorAddressCodec(),
+		c.modules,
+		baseapp.NewMsgServiceRouter(),
+		baseapp.NewGRPCQueryRouter(),
+		baseAppOptions...,
+	)
+	authtypes.RegisterMsgServer(app.MsgServiceRouter(), authkeeper.NewMsgServerImpl(c.accountKeeper))
+	authtypes.RegisterQueryServer(app.GRPCQueryRouter(), authkeeper.NewQueryServer(c.accountKeeper))
+	banktypes.RegisterMsgServer(app.MsgServiceRouter(), bankkeeper.NewMsgServerImpl(c.bankKeeper))
+
+	return app
+}
+
+// Example_runBlock shows how to use the integration test framework to run blocks holding transactions.
+func Example_runBlock() {
+	// replace the logger by testing values in a real test case (e.g. log.NewTestLogger(t))
+	chain := newExampleChain(log.NewLogger(io.Discard))
+
+	// the time of the context is the time from which the blocks are run
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	chain.ctx = chain.ctx.WithHeaderInfo(header.Info{Time: genesisTime})
+
+	// the application must share the multistore of the context to run blocks
+	integrationApp := chain.newApp(integration.WithSharedMultiStore(chain.cms))
+
+	params := authtypes.DefaultParams()
+	params.MaxMemoCharacters = 1000
+
+	txBuilder := chain.encodingCfg.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(&authtypes.MsgUpdateParams{Authority: chain.authority, Params: params}); err != nil {
+		panic(err)
+	}
+
+	// the transaction is delivered in a block which is then committed
+	res, err := integrationApp.RunBlock(txBuilder.GetTx())
+	if err != nil {
+		panic(err)
+	}
+	if err := res.TxResults[0].Err(); err != nil {
+		panic(err)
+	}
+
+	// empty blocks can be run to advance the chain, at controlled times
+	integrationApp.SetBlockTimeDelta(time.Minute)
+	integrationApp.AdvanceTime(time.Hour)
+	if _, err := integrationApp.RunBlocks(2); err != nil {
+		panic(err)
+	}
+
+	// the application context is at the height and time of the last block
+	sdkCtx := sdk.UnwrapSDKContext(integrationApp.Context())
+	got := chain.accountKeeper.GetParams(sdkCtx)
+	fmt.Println(sdkCtx.BlockHeight(), sdkCtx.HeaderInfo().Time.Sub(genesisTime), got.MaxMemoCharacters)
+	// Output: 4 1h2m5s 1000
+}
+
+// Example_query shows how to use the integration test framework to query the committed state of the application.
+func Example_query() {
+	// replace the logger by testing values in a real test case (e.g. log.NewTestLogger(t))
+	chain := newExampleChain(log.NewLogger(io.Discard))
+
+	// the application must share the multistore of the context to query its committed state, the
+	// queries are routed through the gRPC query router of the application
+	integrationApp := chain.newApp(integration.WithSharedMultiStore(chain.cms))
+
+	params := authtypes.DefaultParams()
+	params.MaxMemoCharacters = 1000
+
+	txBuilder := chain.encodingCfg.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(&authtypes.MsgUpdateParams{Authority: chain.authority, Params: params}); err != nil {
+		panic(err)
+	}
+
+	res, err := integrationApp.RunBlock(txBuilder.GetTx())
+	if err != nil {
+		panic(err)
+	}
+
+	// the latest committed state holds the updated params
+	latest := &authtypes.QueryParamsResponse{}
+	if err := integrationApp.Query("/cosmos.auth.v1beta1.Query/Params", &authtypes.QueryParamsRequest{}, latest); err != nil {
+		panic(err)
+	}
+
+	// the state committed before the block still holds the default params
+	previous := &authtypes.QueryParamsResponse{}
+	if err := integrationApp.QueryAt(res.Height-1, "/cosmos.auth.v1beta1.Query/Params", &authtypes.QueryParamsRequest{}, previous); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(latest.Params.MaxMemoCharacters, previous.Params.MaxMemoCharacters)
+	// Output: 1000 256
+}
+
+// Example_signAndDeliver shows how to use the integration test framework to deliver signed transactions.
+func Example_signAndDeliver() {
+	// replace the logger by testing values in a real test case (e.g. log.NewTestLogger(t))
+	chain := newExampleChain(log.NewLogger(io.Discard))
+
+	// the ante handler meters the gas of the transactions up to their gas limit, verifies
+	// their signatures and increments the sequences of their signers
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(runtime.NewEnvironment(runtime.NewKVStoreService(chain.keys[authtypes.StoreKey]), log.NewNopLogger()), noBlockGasLimit{}),
+		ante.NewSigVerificationDecorator(chain.accountKeeper, chain.encodingCfg.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil),
+	)
+
+	// the account numbers and sequences of the signers are queried from the auth query service
+	integrationApp := chain.newApp(
+		integration.WithSharedMultiStore(chain.cms),
+		func(bApp *baseapp.BaseApp) { bApp.SetAnteHandler(anteHandler) },
+	)
+
+	alice := secp256k1.GenPrivKeyFromSecret([]byte("alice"))
+	aliceAddr := sdk.AccAddress(alice.PubKey().Address())
+	bobAddr := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("bob")).PubKey().Address())
+
+	// the signer account must be committed before signing
+	sdkCtx := sdk.UnwrapSDKContext(integrationApp.Context())
+	chain.accountKeeper.SetAccount(sdkCtx, chain.accountKeeper.NewAccountWithAddress(sdkCtx, aliceAddr))
+	if err := banktestutil.FundAccount(sdkCtx, chain.bankKeeper, aliceAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))); err != nil {
+		panic(err)
+	}
+	if _, err := integrationApp.RunBlock(); err != nil {
+		panic(err)
+	}
+
+	send := &banktypes.MsgSend{
+		FromAddress: aliceAddr.String(),
+		ToAddress:   bobAddr.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := integrationApp.SignAndDeliver(alice, send); err != nil {
+			panic(err)
+		}
+	}
+
+	// several transactions of the same signer can be delivered in a block
+	factory := integrationApp.TxBuilderFactory()
+	txs := make([]sdk.Tx, 3)
+	for i := range txs {
+		tx, err := factory.BuildTx([]sdk.Msg{send}, alice)
+		if err != nil {
+			panic(err)
+		}
+		txs[i] = tx
+	}
+	res, err := integrationApp.RunBlock(txs...)
+	if err != nil {
+		panic(err)
+	}
+	for _, txResult := range res.TxResults {
+		if err := txResult.Err(); err != nil {
+			panic(err)
+		}
+	}
+
+	sdkCtx = sdk.UnwrapSDKContext(integrationApp.Context())
+	fmt.Println(chain.bankKeeper.GetBalance(sdkCtx, bobAddr, "stake"), chain.accountKeeper.GetAccount(sdkCtx, aliceAddr).GetSequence())
+
+	// the results of the failing transactions hold their errors, which can be matched
+	// against the registered errors
+	send.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	txResult, err := integrationApp.SignAndDeliver(alice, send)
+	fmt.Println(errors.Is(err, sdkerrors.ErrInsufficientFunds), txResult.Codespace, txResult.Code)
+
+	send.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	tx, err := integrationApp.TxBuilderFactory().WithGasLimit(1000).BuildTx([]sdk.Msg{send}, alice)
+	if err != nil {
+		panic(err)
+	}
+	res, err = integrationApp.RunBlock(tx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(errors.Is(res.TxResults[0].Err(), sdkerrors.ErrOutOfGas), res.TxResults[0].GasWanted)
+	// Output:
+	// 500stake 5
+	// true sdk 5
+	// true 1000
+}
+
+// noBlockGasLimit returns consensus block params without a block gas limit.
+type noBlockGasLimit struct{}
+
+func (noBlockGasLimit) BlockParams(context.Context) (maxGas, maxBytes uint64, err error) {
+	return 0, 0, nil
+}
+
+// Example_runUpgrade shows how to use the integration test framework to test a software upgrade.
+func Example_runUpgrade() {
+	// replace the logger by testing values in a real test case (e.g. log.NewTestLogger(t))
+	logger := log.NewLogger(io.Discard)
+	chain := newExampleChain(logger, upgradetypes.StoreKey)
+	cdc := chain.encodingCfg.Codec
+
+	// the upgrade module writes the upgrade info to its home directory when halting the chain
+	// use a testing directory in a real test case (e.g. t.TempDir())
+	homeDir, err := os.MkdirTemp("", "upgrade")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(homeDir)
+
+	upgradeKeeper := upgradekeeper.NewKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(chain.keys[upgradetypes.StoreKey]), logger),
+		nil, cdc, homeDir, nil, chain.authority, nil,
+	)
+	chain.modules[upgradetypes.ModuleName] = upgrade.NewAppModule(upgradeKeeper)
+	upgradeKeeper.SetInitVersionMap(module.NewManagerFromMap(chain.modules).GetVersionMap())
+
+	integrationApp := chain.newApp(integration.WithSharedMultiStore(chain.cms))
+	upgradetypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), upgradekeeper.NewMsgServerImpl(upgradeKeeper))
+
+	// the upgrade adds the epochs module, the keys of the stores kept must be reused
+	chain.keys = maps.Clone(chain.keys)
+	chain.keys[epochstypes.StoreKey] = storetypes.NewKVStoreKey(epochstypes.StoreKey)
+
+	// the upgraded application is created on the same multistore, as a restarted node would
+	var epochsKeeper *epochskeeper.Keeper
+	upgradedApp, err := integrationApp.RunUpgrade("v2", 10, chain.authority, func() *integration.App {
+		upgradeKeeper := upgradekeeper.NewKeeper(
+			runtime.NewEnvironment(runtime.NewKVStoreService(chain.keys[upgradetypes.StoreKey]), logger),
+			nil, cdc, homeDir, nil, chain.authority, nil,
+		)
+		epochsKeeper = epochskeeper.NewKeeper(
+			runtime.NewEnvironment(runtime.NewKVStoreService(chain.keys[epochstypes.StoreKey]), logger),
+			cdc,
+		)
+
+		chain.modules = maps.Clone(chain.modules)
+		chain.modules[upgradetypes.ModuleName] = upgrade.NewAppModule(upgradeKeeper)
+		chain.modules[epochstypes.ModuleName] = epochs.NewAppModule(cdc, epochsKeeper)
+
+		app := chain.newApp(integration.WithSharedMultiStore(chain.cms))
+		upgradetypes.RegisterMsgServer(app.MsgServiceRouter(), upgradekeeper.NewMsgServerImpl(upgradeKeeper))
+
+		// the migrations initialize the genesis of the epochs module, absent from the module versions
+		upgradeKeeper.SetUpgradeHandler("v2", func(ctx context.Context, _ upgradetypes.Plan, fromVM appmodule.VersionMap) (appmodule.VersionMap, error) {
+			return app.RunMigrations(ctx, fromVM)
+		})
+
+		return app
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// the upgraded application keeps running blocks
+	if _, err := upgradedApp.RunBlocks(2); err != nil {
+		panic(err)
+	}
+
+	epochInfos, err := epochsKeeper.AllEpochInfos(upgradedApp.Context())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(upgradedApp.LastBlockHeight(), len(epochInfos), epochInfos[0].CurrentEpochStartHeight)
+	// Output: 12 4 10
+}
+
+// Example_stateSync shows how to use the integration test framework to test state sync, by restoring
+// the snapshot of an application into an application joining the chain.
+func Example_stateSync() {
+	// replace the logger by testing values in a real test case (e.g. log.NewTestLogger(t))
+	logger := log.NewLogger(io.Discard)
+
+	// the snapshots are stored in a directory
+	// use a testing directory in a real test case (e.g. t.TempDir())
+	snapshotDir, err := os.MkdirTemp("", "snapshots")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(snapshotDir)
+
+	// each application has its own stores, as a node of the chain
+	chain := newExampleChain(logger)
+	node := newExampleChain(logger)
+
+	// the application of the chain takes snapshots
+	integrationApp := chain.newApp(integration.WithSharedMultiStore(chain.cms), integration.WithSnapshots(filepath.Join(snapshotDir, "chain")))
+
+	params := authtypes.DefaultParams()
+	params.MaxMemoCharacters = 1000
+	if _, err := integrationApp.RunMsg(&authtypes.MsgUpdateParams{Authority: chain.authority, Params: params}); err != nil {
+		panic(err)
+	}
+	if _, err := integrationApp.RunBlocks(2); err != nil {
+		panic(err)
+	}
+
+	snapshot, err := integrationApp.CreateSnapshot(uint64(integrationApp.LastBlockHeight()))
+	if err != nil {
+		panic(err)
+	}
+
+	// the application joining the chain restores the snapshot instead of running the chain from genesis
+	syncedApp := node.newApp(integration.WithSharedMultiStore(node.cms), integration.WithStateSync(filepath.Join(snapshotDir, "node")))
+	if err := syncedApp.RestoreSnapshot(snapshot); err != nil {
+		panic(err)
+	}
+
+	// the restored application keeps running blocks
+	if _, err := syncedApp.RunBlock(); err != nil {
+		panic(err)
+	}
+
+	got := node.accountKeeper.GetParams(sdk.UnwrapSDKContext(syncedApp.Context()))
+	fmt.Println(snapshot.Height(), syncedApp.LastBlockHeight(), got.MaxMemoCharacters)
+	// Output: 3 4 1000
+}
+
+// Example_coordinator shows how to use the integration test framework to test interchain logic in
+// process, by coordinating two chains and relaying the packets sent from a chain to the other.
+func Example_coordinator() {
+	// replace the logger by testing values in a real test case (e.g. log.NewTestLogger(t))
+	logger := log.NewLogger(io.Discard)
+
+	// each chain has its own application and stores, and is identified by its chain ID set with a
+	// base app option, the applications must share the multistore of their context to run blocks
+	a, b := newExampleChain(logger), newExampleChain(logger)
+	chainA := a.newApp(integration.WithSharedMultiStore(a.cms), baseapp.SetChainID("chain-a"))
+	chainB := b.newApp(integration.WithSharedMultiStore(b.cms), baseapp.SetChainID("chain-b"))
+	bankKeeperA, bankKeeperB := a.bankKeeper, b.bankKeeper
+
+	coordinator, err := integration.NewCoordinator(chainA, chainB)
+	if err != nil {
+		panic(err)
+	}
+
+	alice := secp256k1.GenPrivKeyFromSecret([]byte("alice"))
+	aliceAddr := sdk.AccAddress(alice.PubKey().Address())
+	bobAddr := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("bob")).PubKey().Address())
+
+	sdkCtx := sdk.UnwrapSDKContext(chainA.Context())
+	if err := banktestutil.FundAccount(sdkCtx, bankKeeperA, aliceAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))); err != nil {
+		panic(err)
+	}
+	if err := coordinator.CommitBlock(); err != nil {
+		panic(err)
+	}
+
+	// the endpoints stand for the module under test: the coins sent by chain A are burnt when the
+	// packet is committed, and minted as vouchers on chain B when the packet is received
+	coordinator.SetEndpoint("chain-b", integration.Endpoint{
+		RecvPacket: func(app *integration.App, packet integration.Packet) ([]byte, error) {
+			coins, err := sdk.ParseCoinsNormalized(string(packet.Data))
+			if err != nil {
+				return nil, err
+			}
+			vouchers := sdk.NewCoins()
+			for _, coin := range coins {
+				vouchers = vouchers.Add(sdk.NewCoin(packet.SourceChain+"/"+coin.Denom, coin.Amount))
+			}
+
+			sdkCtx := sdk.UnwrapSDKContext(app.Context())
+			if err := bankKeeperB.MintCoins(sdkCtx, transferModuleName, vouchers); err != nil {
+				return nil, err
+			}
+			if err := bankKeeperB.SendCoinsFromModuleToAccount(sdkCtx, transferModuleName, bobAddr, vouchers); err != nil {
+				return nil, err
+			}
+
+			return []byte("ok"), nil
+		},
+	})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	sdkCtx = sdk.UnwrapSDKContext(chainA.Context())
+	if err := bankKeeperA.SendCoinsFromAccountToModule(sdkCtx, aliceAddr, transferModuleName, coins); err != nil {
+		panic(err)
+	}
+	if err := bankKeeperA.BurnCoins(sdkCtx, authtypes.NewModuleAddress(transferModuleName), coins); err != nil {
+		panic(err)
+	}
+	if err := coordinator.CommitBlock("chain-a"); err != nil {
+		panic(err)
+	}
+
+	// the packet is received on chain B, and acknowledged on chain A
+	ack, err := coordinator.RelayPacket(integration.Packet{
+		Sequence:         1,
+		SourceChain:      "chain-a",
+		DestinationChain: "chain-b",
+		Data:             []byte(coins.String()),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// the client of each chain on the other chain was updated while relaying the packet
+	clientA, _ := coordinator.ClientState("chain-b", "chain-a")
+	clientB, _ := coordinator.ClientState("chain-a", "chain-b")
+
+	aliceBalance := bankKeeperA.GetBalance(sdk.UnwrapSDKContext(chainA.Context()), aliceAddr, "stake")
+	bobBalance := bankKeeperB.GetBalance(sdk.UnwrapSDKContext(chainB.Context()), bobAddr, "chain-a/stake")
+	fmt.Println(string(ack), aliceBalance, bobBalance, clientA.Height, clientB.Height)
+	// Output: ok 900stake 100chain-a/stake 3 3
+}
+
+// transferModuleName is the name of the module account burning and minting the coins sent between
+// the chains of Example_coordinator.
+const transferModuleName = "transfer"
+
+// Example_runWithGasMeter shows how to use the integration test framework to measure the gas consumed by keepers.
+func Example_runWithGasMeter() {
+	// replace the logger by testing values in a real test case (e.g. log.NewTestLogger(t))
+	chain := newExampleChain(log.NewLogger(io.Discard))
+	app, bankKeeper := chain.newApp(integration.WithSharedMultiStore(chain.cms)), chain.bankKeeper
+
+	aliceAddr := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("alice")).PubKey().Address())
+	bobAddr := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("bob")).PubKey().Address())
+	if err := banktestutil.FundAccount(sdk.UnwrapSDKContext(app.Context()), bankKeeper, aliceAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))); err != nil {
+		panic(err)
+	}
+
+	send := func(ctx context.Context) error {
+		return bankKeeper.SendCoins(ctx, aliceAddr, bobAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	}
+
+	// the gas consumed by the store accesses is deterministic, a zero limit sets an infinite gas meter
+	gasUsed, err := app.RunWithGasMeter(0, send)
+	if err != nil {
+		panic(err)
+	}
+
+	// running out of gas returns an error, and the branch of the execution is discarded
+	_, err = app.RunWithGasMeter(gasUsed-1, send)
+	if !errors.Is(err, sdkerrors.ErrOutOfGas) {
+		panic(fmt.Errorf("expected an out of gas error, got %v", err))
+	}
+
+	bobBalance := bankKeeper.GetBalance(sdk.UnwrapSDKContext(app.Context()), bobAddr, "stake")
+	fmt.Println(gasUsed, bobBalance)
+	// Output: 14717 100stake
+}
+
+// Example_checkpoint shows how to use the integration test framework to reuse the setup of an application across test cases.
+func Example_checkpoint() {
+	// replace the logger by testing values in a real test case (e.g. log.NewTestLogger(t))
+	chain := newExampleChain(log.NewLogger(io.Discard))
+	accountKeeper, authority := chain.accountKeeper, chain.authority
+
+	// checkpoints can't be taken on an application sharing the multistore of its context
+	integrationApp := chain.newApp()
+
+	// the checkpoint is taken once the application is set up, in a real test case the
+	// setup would for instance fund accounts or create validators
+	checkpoint, err := integrationApp.Checkpoint()
+	if err != nil {
+		panic(err)
+	}
+
+	// each test case starts from the state of the checkpoint, e.g. in the subtests of t.Run
+	for _, maxMemoCharacters := range []uint64{1000, 2000} {
+		integrationApp.Rollback(checkpoint)
+
+		params := accountKeeper.GetParams(sdk.UnwrapSDKContext(integrationApp.Context()))
+		fmt.Print(params.MaxMemoCharacters, " ")
+
+		params.MaxMemoCharacters = maxMemoCharacters
+		if _, err := integrationApp.RunMsg(&authtypes.MsgUpdateParams{Authority: authority, Params: params}, integration.WithAutomaticFinalizeBlock()); err != nil {
+			panic(err)
+		}
+
+		params = accountKeeper.GetParams(sdk.UnwrapSDKContext(integrationApp.Context()))
+		fmt.Print(params.MaxMemoCharacters, " ")
+	}
+
+	// rolling back discards the state written since the checkpoint
+	integrationApp.Rollback(checkpoint)
+	fmt.Println(accountKeeper.GetParams(sdk.UnwrapSDKContext(integrationApp.Context())).MaxMemoCharacters)
+	// Output: 256 1000 256 2000 256
+}
@@ -213,6 +213,31 @@ func TestBulkAddGenesisAccountCmd(t *testing.T) {
 			appendFlag: true,
 			expectErr:  false,
 		},
+		{
+			name: "duplicate addresses in a file are merged",
+			state: [][]genutil.GenesisAccount{
+				{
+					{
+						Address: addr1Str,
+						Coins:   sdk.NewCoins(sdk.NewInt64Coin("test", 1)),
+					},
+					{
+						Address: addr2Str,
+						Coins:   sdk.NewCoins(sdk.NewInt64Coin("test", 1)),
+					},
+					{
+						Address: addr1Str,
+						Coins:   sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+					},
+				},
+			},
+			expected: map[string]sdk.Coins{
+				addr1Str: sdk.NewCoins(sdk.NewInt64Coin("test", 1), sdk.NewInt64Coin("stake", 2)),
+				addr2Str: sdk.NewCoins(sdk.NewInt64Coin("test", 1)),
+			},
+			appendFlag: false,
+			expectErr:  false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -646,6 +646,126 @@ func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	}
 }
 
+func TestScheduledProposalEndBlocker(t *testing.T) {
+	testcases := []struct {
+		name     string
+		cancel   bool
+		expected v1.ProposalStatus
+	}{
+		{
+			name:     "executed after the execution delay",
+			expected: v1.StatusPassed,
+		},
+		{
+			name:     "canceled before the execution time",
+			cancel:   true,
+			expected: v1.StatusRejected,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.app
+			ctx := app.BaseApp.NewContext(false)
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+			valAddr := sdk.ValAddress(addrs[0])
+
+			acc := suite.AccountKeeper.NewAccountWithAddress(ctx, addrs[0])
+			suite.AccountKeeper.SetAccount(ctx, acc)
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			_, err := suite.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			addr0Str, err := suite.AccountKeeper.AddressCodec().BytesToString(addrs[0])
+			require.NoError(t, err)
+
+			// fund the governance account so that the proposal message can be executed
+			govAddr := suite.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			sendCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000)))
+			require.NoError(t, suite.BankKeeper.SendCoins(ctx, addrs[1], govAddr, sendCoins))
+			govAddrStr, err := suite.AccountKeeper.AddressCodec().BytesToString(govAddr)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{banktypes.NewMsgSend(govAddrStr, addr0Str, sendCoins)}, proposalCoins, addr0Str, "", "Proposal", "description of proposal", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			executionDelay := time.Hour
+			newProposalMsg.ExecutionDelay = &executionDelay
+
+			res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
+			require.NoError(t, err)
+
+			err = suite.GovKeeper.AddVote(ctx, res.ProposalId, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+			require.NoError(t, err)
+
+			params, err := suite.GovKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			newHeader := ctx.HeaderInfo()
+			newHeader.Time = ctx.HeaderInfo().Time.Add(*params.VotingPeriod)
+			ctx = ctx.WithHeaderInfo(newHeader)
+
+			// the proposal passes, its execution is scheduled
+			require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+
+			proposal, err := suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+			require.NoError(t, err)
+			require.Equal(t, v1.StatusScheduled, proposal.Status)
+			require.Equal(t, ctx.HeaderInfo().Time.Add(executionDelay), *proposal.ExecutionTime)
+
+			has, err := suite.GovKeeper.ScheduledProposalsQueue.Has(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id))
+			require.NoError(t, err)
+			require.True(t, has)
+
+			if tc.cancel {
+				_, err = govMsgSvr.CancelScheduledProposal(ctx, &v1.MsgCancelScheduledProposal{
+					Authority:  suite.GovKeeper.GetAuthority(),
+					ProposalId: proposal.Id,
+				})
+				require.NoError(t, err)
+			}
+
+			// the execution time is not reached yet
+			newHeader = ctx.HeaderInfo()
+			newHeader.Time = ctx.HeaderInfo().Time.Add(executionDelay / 2)
+			ctx = ctx.WithHeaderInfo(newHeader)
+			require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+			require.NoError(t, err)
+			if !tc.cancel {
+				require.Equal(t, v1.StatusScheduled, proposal.Status)
+			}
+
+			newHeader = ctx.HeaderInfo()
+			newHeader.Time = ctx.HeaderInfo().Time.Add(executionDelay / 2)
+			ctx = ctx.WithHeaderInfo(newHeader)
+			require.NoError(t, suite.GovKeeper.EndBlocker(ctx))
+
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, proposal.Status, proposal.FailedReason)
+
+			// the proposal message only moved the funds if it was executed
+			expectedBalance := sdk.NewCoins()
+			if tc.cancel {
+				expectedBalance = sendCoins
+			}
+			require.True(t, suite.BankKeeper.GetAllBalances(ctx, govAddr).Equal(expectedBalance))
+
+			has, err = suite.GovKeeper.ScheduledProposalsQueue.Has(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id))
+			require.NoError(t, err)
+			require.False(t, has)
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	t.Helper()
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
@@ -100,6 +100,8 @@ var (
 	SignableTypes = []GeneratedType{
 		// auth
 		GenType(&authtypes.MsgUpdateParams{}, &authapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&authtypes.MsgRotatePubKey{}, &authapi.MsgRotatePubKey{},
+			GenOpts.WithAnyTypes(&ed25519.PubKey{}).WithInterfaceHint("cosmos.crypto.PubKey", &ed25519.PubKey{})),
 
 		// authz
 		GenType(&authztypes.MsgGrant{}, &authzapi.MsgGrant{},
@@ -135,6 +137,7 @@ var (
 			GenOpts.WithAnyTypes(&evidenceapi.Equivocation{}).
 				WithDisallowNil().
 				WithInterfaceHint("cosmos.evidence.v1beta1.Evidence", &evidenceapi.Equivocation{})),
+		GenType(&evidencetypes.MsgUpdateParams{}, &evidenceapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 
 		// feegrant
 		GenType(&feegranttypes.MsgGrantAllowance{}, &feegrantapi.MsgGrantAllowance{},
@@ -146,6 +149,15 @@ var (
 				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
 		),
 		GenType(&feegranttypes.MsgRevokeAllowance{}, &feegrantapi.MsgRevokeAllowance{}, GenOpts),
+		GenType(&feegranttypes.MsgRegrantAllowance{}, &feegrantapi.MsgRegrantAllowance{},
+			GenOpts.WithDisallowNil().
+				WithAnyTypes(
+					&feegrantapi.BasicAllowance{},
+					&feegrantapi.PeriodicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.BasicAllowance{}).
+				WithInterfaceHint("cosmos.feegrant.v1beta1.FeeAllowanceI", &feegrantapi.PeriodicAllowance{}),
+		),
+		GenType(&feegranttypes.MsgRevokeRegrant{}, &feegrantapi.MsgRevokeRegrant{}, GenOpts),
 
 		// gov v1beta1
 		GenType(&gov_v1beta1_types.MsgSubmitProposal{}, &gov_v1beta1_api.MsgSubmitProposal{},
@@ -172,6 +184,7 @@ var (
 				WithDisallowNil().
 				WithInterfaceHint("cosmos.gov.v1beta1.Content", &gov_v1beta1_api.TextProposal{})),
 		GenType(&gov_v1_types.MsgUpdateParams{}, &gov_v1_api.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&gov_v1_types.MsgCancelScheduledProposal{}, &gov_v1_api.MsgCancelScheduledProposal{}, GenOpts),
 
 		// group
 		GenType(&grouptypes.MsgCreateGroup{}, &groupapi.MsgCreateGroup{}, GenOpts),
@@ -193,11 +206,15 @@ var (
 				WithInterfaceHint("cosmos.base.v1beta1.Msg", &groupapi.MsgUpdateGroupMembers{}),
 		),
 		GenType(&grouptypes.MsgVote{}, &groupapi.MsgVote{}, GenOpts),
+		GenType(&grouptypes.MsgCommitVote{}, &groupapi.MsgCommitVote{}, GenOpts),
+		GenType(&grouptypes.MsgRevealVote{}, &groupapi.MsgRevealVote{}, GenOpts),
 		GenType(&grouptypes.MsgExec{}, &groupapi.MsgExec{}, GenOpts),
+		GenType(&grouptypes.MsgRetryExec{}, &groupapi.MsgRetryExec{}, GenOpts),
 		GenType(&grouptypes.MsgLeaveGroup{}, &groupapi.MsgLeaveGroup{}, GenOpts),
 
 		// mint
 		GenType(&minttypes.MsgUpdateParams{}, &mintapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
+		GenType(&minttypes.MsgSetPaused{}, &mintapi.MsgSetPaused{}, GenOpts),
 
 		// slashing
 		GenType(&slashingtypes.MsgUnjail{}, &slashingapi.MsgUnjail{}, GenOpts),
@@ -220,7 +237,7 @@ var (
 		GenType(&upgradetypes.MsgCancelUpgrade{}, &upgradeapi.MsgCancelUpgrade{}, GenOpts),
 	}
 	NonsignableTypes = []GeneratedType{
-		GenType(&authtypes.Params{}, &authapi.Params{}, GenOpts),
+		GenType(&authtypes.Params{}, &authapi.Params{}, GenOpts.WithDisallowNil()),
 		GenType(&authtypes.BaseAccount{}, &authapi.BaseAccount{}, GenOpts.WithAnyTypes(&ed25519.PubKey{})),
 		GenType(&authtypes.ModuleCredential{}, &authapi.ModuleCredential{}, GenOpts),
 
@@ -0,0 +1,110 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	_ "cosmossdk.io/x/evidence" // import as blank for app wiring
+	slashingkeeper "cosmossdk.io/x/slashing/keeper"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCometInfoProvider(t *testing.T) {
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	const equivocationHeight = 4
+
+	startupCfg, err := sims.StartUpConfigWithValidatorSet(3, nil)
+	require.NoError(t, err)
+	valSet, err := startupCfg.ValidatorSet()
+	require.NoError(t, err)
+	proposer, absent, equivocator := valSet.Validators[0], valSet.Validators[1], valSet.Validators[2]
+
+	// from the second block, the first validator proposes the blocks, the second one
+	// never votes, and the third one double signs a block
+	startupCfg.BlockTime = genesisTime
+	startupCfg.CometInfoProvider = func(height int64) comet.Info {
+		if height < 2 {
+			return comet.Info{}
+		}
+
+		info := comet.Info{
+			ProposerAddress: proposer.Address,
+			LastCommit: comet.CommitInfo{Votes: []comet.VoteInfo{
+				{Validator: comet.Validator{Address: proposer.Address, Power: proposer.VotingPower}, BlockIDFlag: comet.BlockIDFlagCommit},
+				{Validator: comet.Validator{Address: absent.Address, Power: absent.VotingPower}, BlockIDFlag: comet.BlockIDFlagAbsent},
+				{Validator: comet.Validator{Address: equivocator.Address, Power: equivocator.VotingPower}, BlockIDFlag: comet.BlockIDFlagCommit},
+			}},
+		}
+		if height == equivocationHeight+1 {
+			info.Evidence = []comet.Evidence{{
+				Type:             comet.DuplicateVote,
+				Validator:        comet.Validator{Address: equivocator.Address, Power: equivocator.VotingPower},
+				Height:           equivocationHeight,
+				Time:             genesisTime.Add(equivocationHeight * time.Minute),
+				TotalVotingPower: valSet.TotalVotingPower(),
+			}}
+		}
+		return info
+	}
+
+	var slashingKeeper slashingkeeper.Keeper
+	app, err := sims.SetupWithConfiguration(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.AccountsModule(),
+				configurator.AuthModule(),
+				configurator.BankModule(),
+				configurator.StakingModule(),
+				configurator.SlashingModule(),
+				configurator.EvidenceModule(),
+				configurator.TxModule(),
+				configurator.ValidateModule(),
+				configurator.ConsensusModule(),
+			),
+			depinject.Supply(log.NewNopLogger()),
+		),
+		startupCfg, &slashingKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: genesisTime})
+	ctx, err = sims.NextBlock(app, ctx, time.Minute)
+	require.NoError(t, err)
+
+	// the signing infos of the validators are not set by the genesis of the validator set
+	for _, val := range valSet.Validators {
+		consAddr := sdk.ConsAddress(val.Address)
+		require.NoError(t, slashingKeeper.ValidatorSigningInfo.Set(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr.String(), 1, time.Unix(0, 0), false, 0)))
+	}
+
+	for height := int64(2); height <= 6; height++ {
+		ctx, err = sims.NextBlock(app, ctx, time.Minute)
+		require.NoError(t, err)
+	}
+
+	// the context of the next block holds its comet info
+	require.Equal(t, sdk.ConsAddress(proposer.Address), sdk.ConsAddress(ctx.CometInfo().ProposerAddress))
+	require.Len(t, ctx.CometInfo().LastCommit.Votes, 3)
+
+	// the absent validator missed all the blocks
+	signingInfo, err := slashingKeeper.ValidatorSigningInfo.Get(ctx, sdk.ConsAddress(absent.Address))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), signingInfo.MissedBlocksCounter)
+
+	signingInfo, err = slashingKeeper.ValidatorSigningInfo.Get(ctx, sdk.ConsAddress(proposer.Address))
+	require.NoError(t, err)
+	require.Zero(t, signingInfo.MissedBlocksCounter)
+
+	// the equivocating validator is tombstoned by the evidence module
+	require.True(t, slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(equivocator.Address)))
+	require.False(t, slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(proposer.Address)))
+}
@@ -2,14 +2,19 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authKeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -30,3 +35,183 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc = accountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(types.NotBondedPoolName))
 	require.NotNil(t, acc)
 }
+
+func TestSetupWithValidatorSet(t *testing.T) {
+	powers := []int64{1, 10, 5}
+	startupCfg, err := simtestutil.StartUpConfigWithValidatorSet(len(powers), powers)
+	require.NoError(t, err)
+	require.Len(t, startupCfg.ValidatorSigners, len(powers))
+
+	var stakingKeeper *stakingkeeper.Keeper
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(
+			AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		), startupCfg, &stakingKeeper)
+	require.NoError(t, err)
+
+	valSet, err := startupCfg.ValidatorSet()
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false)
+	validators, err := stakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, len(powers))
+
+	// the validators of the set are sorted by power, as are their signers
+	for i, val := range valSet.Validators {
+		consAddr, err := validators[i].GetConsAddr()
+		require.NoError(t, err)
+		require.Equal(t, val.Address.Bytes(), consAddr)
+		require.Equal(t, val.VotingPower, validators[i].GetConsensusPower(sdk.DefaultPowerReduction))
+
+		pubKey, err := startupCfg.ValidatorSigners[i].GetPubKey()
+		require.NoError(t, err)
+		require.Equal(t, val.PubKey, pubKey)
+	}
+
+	_, _, err = simtestutil.CreateValidatorSet(2, []int64{1})
+	require.ErrorContains(t, err, "expected 2 validator powers")
+}
+
+func TestSetupWithBlockTime(t *testing.T) {
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.BlockTime = genesisTime
+
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(
+			AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		), startupCfg)
+	require.NoError(t, err)
+
+	// the first block is finalized but not committed
+	ctx := app.BaseApp.GetContextForFinalizeBlock(nil)
+	require.Equal(t, genesisTime, ctx.HeaderInfo().Time)
+
+	ctx, err = simtestutil.NextBlock(app, ctx, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, genesisTime.Add(time.Hour), ctx.HeaderInfo().Time)
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	appConfig := depinject.Configs(
+		AppConfig,
+		depinject.Supply(log.NewNopLogger()),
+	)
+
+	var stakingKeeper *stakingkeeper.Keeper
+	app, err := simtestutil.Setup(appConfig, &stakingKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.GetContextForFinalizeBlock(nil)
+	for i := 0; i < 3; i++ {
+		ctx, err = simtestutil.NextBlock(app, ctx, time.Minute)
+		require.NoError(t, err)
+	}
+
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+
+	genesis, err := simtestutil.ExportGenesis(app)
+	require.NoError(t, err)
+	require.Equal(t, app.LastBlockHeight()+1, genesis.Height)
+
+	var importedStakingKeeper *stakingkeeper.Keeper
+	imported, err := simtestutil.SetupFromGenesis(appConfig, genesis, &importedStakingKeeper)
+	require.NoError(t, err)
+
+	// the imported chain continues at the exported height
+	importedCtx := imported.BaseApp.GetContextForFinalizeBlock(nil)
+	require.Equal(t, genesis.Height, importedCtx.BlockHeight())
+
+	importedValidators, err := importedStakingKeeper.GetAllValidators(importedCtx)
+	require.NoError(t, err)
+	require.Equal(t, validators, importedValidators)
+
+	for i := 0; i < 3; i++ {
+		importedCtx, err = simtestutil.NextBlock(imported, importedCtx, time.Minute)
+		require.NoError(t, err)
+	}
+	require.Equal(t, genesis.Height+2, imported.LastBlockHeight())
+}
+
+func TestSetupWithChainIDAndBondDenom(t *testing.T) {
+	bondDenom := "ugea"
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, math.LegacyNewDecWithPrec(25, 3)))
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.ChainID = "gea-test-1"
+	startupCfg.BondDenom = bondDenom
+	startupCfg.MinGasPrices = minGasPrices
+	for i := range startupCfg.GenesisAccounts {
+		startupCfg.GenesisAccounts[i].Coins = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100000000000000))
+	}
+
+	var (
+		stakingKeeper *stakingkeeper.Keeper
+		bankKeeper    bankkeeper.Keeper
+	)
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(
+			AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		), startupCfg, &stakingKeeper, &bankKeeper)
+	require.NoError(t, err)
+	require.Equal(t, "gea-test-1", app.ChainID())
+
+	ctx := app.BaseApp.NewContext(true)
+	require.Equal(t, minGasPrices, ctx.MinGasPrices())
+
+	params, err := stakingKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, bondDenom, params.BondDenom)
+
+	bondedPool := authtypes.NewModuleAddress(types.BondedPoolName)
+	require.True(t, bankKeeper.GetBalance(ctx, bondedPool, bondDenom).IsPositive())
+	require.True(t, bankKeeper.GetBalance(ctx, bondedPool, sdk.DefaultBondDenom).IsZero())
+}
+
+func TestSetupWithStateChangeRecorder(t *testing.T) {
+	recorder := simtestutil.NewStateChangeRecorder()
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.StateChangeRecorder = recorder
+
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(
+			AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		), startupCfg)
+	require.NoError(t, err)
+
+	// the genesis validator is stored by staking
+	require.NotEmpty(t, recorder.GenesisStateChanges(types.StoreKey))
+
+	ctx := app.BaseApp.GetContextForFinalizeBlock(nil)
+	for i := 0; i < 2; i++ {
+		ctx, err = simtestutil.NextBlock(app, ctx, time.Minute)
+		require.NoError(t, err)
+	}
+
+	// the first commit holds the genesis state
+	require.GreaterOrEqual(t, len(recorder.BlockStateChanges(1, types.StoreKey)), len(recorder.GenesisStateChanges(types.StoreKey)))
+
+	// minting changes the bank state of every block
+	blockChanges := recorder.BlockStateChanges(2, "bank")
+	require.NotEmpty(t, blockChanges)
+
+	diff := recorder.Diff(1, 2, "bank")
+	require.NotEmpty(t, diff)
+	for _, change := range diff {
+		var last simtestutil.StateChange
+		for _, blockChange := range blockChanges {
+			if string(blockChange.Key) == string(change.Key) {
+				last = blockChange
+			}
+		}
+		require.Equal(t, last, change)
+	}
+
+	require.Empty(t, recorder.Diff(2, 2, "bank"))
+}
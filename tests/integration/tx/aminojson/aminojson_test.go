@@ -269,6 +269,10 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 				MinSignedPerWindow:      math.LegacyNewDec(10),
 				SlashFractionDoubleSign: math.LegacyZeroDec(),
 				SlashFractionDowntime:   math.LegacyZeroDec(),
+				DoubleSignSlashScaling: slashingtypes.DoubleSignSlashScaling{
+					MaxSlashFraction: math.LegacyZeroDec(),
+					SaturationShare:  math.LegacyZeroDec(),
+				},
 			},
 		},
 		"staking/msg_update_params": {
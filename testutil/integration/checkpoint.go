@@ -0,0 +1,68 @@
+package integration
+
+import (
+	"errors"
+	"slices"
+
+	cmtabcitypes "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Checkpoint is a snapshot of the state of the application context, taken with
+// App.Checkpoint and restored with App.Rollback.
+type Checkpoint struct {
+	// ms is the multistore holding the state at the checkpoint. It is never written
+	// to once the checkpoint is taken, the state written afterwards is held in its
+	// branches.
+	ms       storetypes.MultiStore
+	ctx      sdk.Context
+	blockCtx sdk.Context
+	events   []cmtabcitypes.Event
+}
+
+// Checkpoint snapshots the state of the application context, along with its header
+// and the events emitted since the last block, so that it can be restored with
+// Rollback. It allows an expensive setup of the application to be reused across
+// many subtests, each rolling back to the checkpoint taken after the setup.
+//
+// The state written after the checkpoint, by keepers or by messages run with RunMsg,
+// is held in a branch of the multistore of the application context. Checkpoints
+// can't be taken on an application sharing the multistore of its context, see
+// WithSharedMultiStore, as the state of its blocks is committed to the multistore.
+func (app *App) Checkpoint() (Checkpoint, error) {
+	if app.sharedStore {
+		return Checkpoint{}, errors.New("checkpoints can't be taken on an application sharing the multistore of its context, see WithSharedMultiStore")
+	}
+
+	cp := Checkpoint{
+		ms:       app.ctx.MultiStore(),
+		ctx:      app.ctx,
+		blockCtx: app.blockCtx,
+		events:   slices.Clone(app.events),
+	}
+	app.branch(cp)
+
+	return cp, nil
+}
+
+// Rollback restores the state of the application context to the given checkpoint,
+// discarding all the state written since. A checkpoint can be rolled back to many
+// times. The contexts previously returned by Context must not be used anymore.
+// The height of the base application, see LastBlockHeight, is not rolled back.
+func (app *App) Rollback(cp Checkpoint) {
+	app.branch(cp)
+}
+
+// branch sets the application context to a new branch of the multistore of the
+// checkpoint.
+func (app *App) branch(cp Checkpoint) {
+	ms := cp.ms.CacheMultiStore()
+
+	app.ctx = cp.ctx.WithMultiStore(ms).WithEventManager(sdk.NewEventManager())
+	app.blockCtx = cp.blockCtx.WithMultiStore(ms).WithEventManager(sdk.NewEventManager())
+	app.queryHelper.Ctx = app.ctx
+	app.events = slices.Clone(cp.events)
+}
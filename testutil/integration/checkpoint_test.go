@@ -0,0 +1,12 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointSharedStore(t *testing.T) {
+	_, err := newTestApp(t, true).Checkpoint()
+	require.ErrorContains(t, err, "checkpoints can't be taken on an application sharing the multistore")
+}
@@ -0,0 +1,209 @@
+package integration
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Coordinator coordinates several integration applications, each running its own
+// chain, so that the interchain logic of modules can be tested in process: the
+// blocks and the time of the chains are advanced together, and the packets sent
+// from a chain to another are relayed by the coordinator as a relayer would.
+//
+// The light clients of the chains are stubbed: updating the client of a chain on
+// another one records the latest header of the chain, see UpdateClient, and the
+// endpoints of the chains deliver the packets to the modules under test, see
+// Endpoint. The chains must be created with distinct chain IDs, set with the
+// baseapp.SetChainID option, and must share the multistore of their context, see
+// WithSharedMultiStore.
+type Coordinator struct {
+	chains    map[string]*App
+	chainIDs  []string
+	endpoints map[string]Endpoint
+	clients   map[clientID]ClientState
+}
+
+// clientID identifies the client of a counterparty chain on a chain.
+type clientID struct {
+	chainID             string
+	counterpartyChainID string
+}
+
+// ClientState is the state of the stubbed light client of a counterparty chain on
+// a chain, holding the latest header of the counterparty chain it was updated to.
+type ClientState struct {
+	ChainID string
+	Height  int64
+	Time    time.Time
+	AppHash []byte
+}
+
+// Packet is a packet sent by a module of a source chain to a module of a destination
+// chain, see RelayPacket.
+type Packet struct {
+	Sequence         uint64
+	SourceChain      string
+	DestinationChain string
+	Data             []byte
+}
+
+// Endpoint defines how the packets are delivered to the modules of a chain. The
+// functions are called with the application of the chain, and usually run the
+// message of the module under test handling the packet or its acknowledgement.
+// Unset functions are skipped.
+type Endpoint struct {
+	// RecvPacket delivers a packet sent to the chain, and returns its acknowledgement.
+	RecvPacket func(app *App, packet Packet) ([]byte, error)
+	// AcknowledgePacket delivers the acknowledgement of a packet sent by the chain.
+	AcknowledgePacket func(app *App, packet Packet, ack []byte) error
+	// UpdateClient is called when the client of a counterparty chain on the chain is
+	// updated, see UpdateClient.
+	UpdateClient func(app *App, client ClientState) error
+}
+
+// NewCoordinator returns a coordinator of the given applications, identified by their
+// chain IDs.
+func NewCoordinator(apps ...*App) (*Coordinator, error) {
+	c := &Coordinator{
+		chains:    make(map[string]*App, len(apps)),
+		endpoints: make(map[string]Endpoint, len(apps)),
+		clients:   map[clientID]ClientState{},
+	}
+
+	for _, app := range apps {
+		chainID := app.ChainID()
+		if _, ok := c.chains[chainID]; ok {
+			return nil, fmt.Errorf("chain %s is coordinated more than once, see baseapp.SetChainID", chainID)
+		}
+		if !app.sharedStore {
+			return nil, fmt.Errorf("chain %s must share the multistore of its context, see WithSharedMultiStore", chainID)
+		}
+
+		c.chains[chainID] = app
+		c.chainIDs = append(c.chainIDs, chainID)
+	}
+
+	return c, nil
+}
+
+// Chain returns the application of the chain with the given chain ID.
+func (c *Coordinator) Chain(chainID string) *App {
+	app, ok := c.chains[chainID]
+	if !ok {
+		panic(fmt.Errorf("chain %s is not coordinated", chainID))
+	}
+
+	return app
+}
+
+// SetEndpoint sets the endpoint of the chain with the given chain ID.
+func (c *Coordinator) SetEndpoint(chainID string, endpoint Endpoint) {
+	c.endpoints[chainID] = endpoint
+}
+
+// CommitBlock runs an empty block on the chains with the given chain IDs, or on all
+// the chains when none is given.
+func (c *Coordinator) CommitBlock(chainIDs ...string) error {
+	if len(chainIDs) == 0 {
+		chainIDs = c.chainIDs
+	}
+
+	for _, chainID := range chainIDs {
+		if _, err := c.Chain(chainID).RunBlock(); err != nil {
+			return fmt.Errorf("chain %s: %w", chainID, err)
+		}
+	}
+
+	return nil
+}
+
+// AdvanceTime advances the time of all the chains, see App.AdvanceTime.
+func (c *Coordinator) AdvanceTime(d time.Duration) {
+	for _, chainID := range c.chainIDs {
+		c.Chain(chainID).AdvanceTime(d)
+	}
+}
+
+// UpdateClient updates the client of the counterparty chain on the chain to the last
+// block committed by the counterparty chain, and calls the UpdateClient function of
+// the endpoint of the chain with the updated client state.
+func (c *Coordinator) UpdateClient(chainID, counterpartyChainID string) (ClientState, error) {
+	app, counterparty := c.Chain(chainID), c.Chain(counterpartyChainID)
+
+	lastCommitID := counterparty.LastCommitID()
+	if lastCommitID.Version == 0 {
+		return ClientState{}, fmt.Errorf("chain %s has not committed any block", counterpartyChainID)
+	}
+
+	client := ClientState{
+		ChainID: counterpartyChainID,
+		Height:  lastCommitID.Version,
+		Time:    counterparty.ctx.HeaderInfo().Time,
+		AppHash: lastCommitID.Hash,
+	}
+
+	if update := c.endpoints[chainID].UpdateClient; update != nil {
+		if err := update(app, client); err != nil {
+			return ClientState{}, fmt.Errorf("failed to update client of %s on %s: %w", counterpartyChainID, chainID, err)
+		}
+	}
+	c.clients[clientID{chainID: chainID, counterpartyChainID: counterpartyChainID}] = client
+
+	return client, nil
+}
+
+// ClientState returns the state of the client of the counterparty chain on the chain,
+// and false if the client was never updated.
+func (c *Coordinator) ClientState(chainID, counterpartyChainID string) (ClientState, bool) {
+	client, ok := c.clients[clientID{chainID: chainID, counterpartyChainID: counterpartyChainID}]
+	return client, ok
+}
+
+// RelayPacket relays a packet committed by the source chain to the destination chain,
+// and its acknowledgement back to the source chain, as a relayer would:
+//
+//   - the client of the source chain is updated on the destination chain;
+//   - the packet is received by the endpoint of the destination chain, and a block is
+//     committed on the destination chain;
+//   - the client of the destination chain is updated on the source chain;
+//   - the acknowledgement is delivered to the endpoint of the source chain, and a block
+//     is committed on the source chain.
+//
+// The acknowledgement of the packet is returned.
+func (c *Coordinator) RelayPacket(packet Packet) ([]byte, error) {
+	source, dest := c.Chain(packet.SourceChain), c.Chain(packet.DestinationChain)
+	if source == dest {
+		return nil, errors.New("packet source and destination chains must be different")
+	}
+
+	if _, err := c.UpdateClient(packet.DestinationChain, packet.SourceChain); err != nil {
+		return nil, err
+	}
+
+	var ack []byte
+	if recv := c.endpoints[packet.DestinationChain].RecvPacket; recv != nil {
+		var err error
+		if ack, err = recv(dest, packet); err != nil {
+			return nil, fmt.Errorf("failed to receive packet %d on %s: %w", packet.Sequence, packet.DestinationChain, err)
+		}
+	}
+	if err := c.CommitBlock(packet.DestinationChain); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.UpdateClient(packet.SourceChain, packet.DestinationChain); err != nil {
+		return nil, err
+	}
+
+	if acknowledge := c.endpoints[packet.SourceChain].AcknowledgePacket; acknowledge != nil {
+		if err := acknowledge(source, packet, ack); err != nil {
+			return nil, fmt.Errorf("failed to acknowledge packet %d on %s: %w", packet.Sequence, packet.SourceChain, err)
+		}
+	}
+	if err := c.CommitBlock(packet.SourceChain); err != nil {
+		return nil, err
+	}
+
+	return ack, nil
+}
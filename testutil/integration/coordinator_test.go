@@ -0,0 +1,58 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+func TestNewCoordinatorErrors(t *testing.T) {
+	_, err := NewCoordinator(newTestApp(t, true), newTestApp(t, true))
+	require.ErrorContains(t, err, "chain integration-app is coordinated more than once")
+
+	_, err = NewCoordinator(newTestApp(t, false))
+	require.ErrorContains(t, err, "chain integration-app must share the multistore of its context")
+}
+
+func TestRelayPacketErrors(t *testing.T) {
+	coordinator, err := NewCoordinator(
+		newTestApp(t, true, baseapp.SetChainID("chain-a")),
+		newTestApp(t, true, baseapp.SetChainID("chain-b")),
+	)
+	require.NoError(t, err)
+
+	require.Panics(t, func() { coordinator.Chain("chain-c") })
+
+	_, err = coordinator.RelayPacket(Packet{Sequence: 1, SourceChain: "chain-a", DestinationChain: "chain-a"})
+	require.ErrorContains(t, err, "packet source and destination chains must be different")
+
+	errFailed := errors.New("failed")
+	packet := Packet{Sequence: 1, SourceChain: "chain-a", DestinationChain: "chain-b"}
+
+	coordinator.SetEndpoint("chain-b", Endpoint{
+		UpdateClient: func(*App, ClientState) error { return errFailed },
+	})
+	_, err = coordinator.RelayPacket(packet)
+	require.ErrorIs(t, err, errFailed)
+	require.ErrorContains(t, err, "failed to update client of chain-a on chain-b")
+	_, ok := coordinator.ClientState("chain-b", "chain-a")
+	require.False(t, ok)
+
+	coordinator.SetEndpoint("chain-b", Endpoint{
+		RecvPacket: func(*App, Packet) ([]byte, error) { return nil, errFailed },
+	})
+	_, err = coordinator.RelayPacket(packet)
+	require.ErrorIs(t, err, errFailed)
+	require.ErrorContains(t, err, "failed to receive packet 1 on chain-b")
+
+	coordinator.SetEndpoint("chain-b", Endpoint{})
+	coordinator.SetEndpoint("chain-a", Endpoint{
+		AcknowledgePacket: func(*App, Packet, []byte) error { return errFailed },
+	})
+	_, err = coordinator.RelayPacket(packet)
+	require.ErrorIs(t, err, errFailed)
+	require.ErrorContains(t, err, "failed to acknowledge packet 1 on chain-a")
+}
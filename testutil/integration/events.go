@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	cmtabcitypes "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Events returns the events emitted by the messages run with RunMsg since the
+// last block run with RunBlock.
+func (app *App) Events() []cmtabcitypes.Event {
+	return app.events
+}
+
+// LastBlockEvents returns the events emitted by the last block run with RunBlock,
+// those of the begin and end blockers followed by those of the transactions.
+func (app *App) LastBlockEvents() []cmtabcitypes.Event {
+	return app.lastBlockEvents
+}
+
+// RequireEventEmitted asserts that an event of the given type, holding all the
+// given attributes, is part of either Events or LastBlockEvents.
+func (app *App) RequireEventEmitted(t testing.TB, eventType string, attrs ...sdk.Attribute) {
+	t.Helper()
+
+	events := append(append([]cmtabcitypes.Event{}, app.events...), app.lastBlockEvents...)
+	for _, event := range events {
+		if event.Type == eventType && hasAttributes(event, attrs) {
+			return
+		}
+	}
+
+	t.Fatalf("event %s with attributes %s was not emitted, emitted events:\n%s", eventType, formatAttributes(attrs), formatEvents(events))
+}
+
+// hasAttributes returns true if the event holds all the given attributes.
+func hasAttributes(event cmtabcitypes.Event, attrs []sdk.Attribute) bool {
+	for _, attr := range attrs {
+		found := false
+		for _, eventAttr := range event.Attributes {
+			if eventAttr.Key == attr.Key && eventAttr.Value == attr.Value {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatAttributes(attrs []sdk.Attribute) string {
+	parts := make([]string, len(attrs))
+	for i, attr := range attrs {
+		parts[i] = fmt.Sprintf("%s=%s", attr.Key, attr.Value)
+	}
+
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+func formatEvents(events []cmtabcitypes.Event) string {
+	var sb strings.Builder
+	for _, event := range events {
+		attrs := make([]sdk.Attribute, len(event.Attributes))
+		for i, attr := range event.Attributes {
+			attrs[i] = sdk.NewAttribute(attr.Key, attr.Value)
+		}
+		fmt.Fprintf(&sb, "  %s %s\n", event.Type, formatAttributes(attrs))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,82 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// GoldenQuery is a gRPC query request whose response is compared against a golden file.
+type GoldenQuery struct {
+	// Name is the golden file name, relative to the testdata directory of the test package.
+	Name string
+	// Method is the fully qualified gRPC method name, e.g. "/cosmos.bank.v1beta1.Query/Balance".
+	Method string
+	// Request is the query request.
+	Request proto.Message
+	// Response is an empty message of the query response type, the response is unmarshaled into it.
+	Response proto.Message
+}
+
+// AssertGoldenQueries executes the queries against the application and compares their
+// canonicalized JSON responses against the golden files. Each query is executed twice
+// to ensure that its response is deterministic.
+// Run the tests with the -update flag to create or update the golden files.
+// The query services must be registered on the application query helper beforehand.
+func (app *App) AssertGoldenQueries(t *testing.T, queries ...GoldenQuery) {
+	t.Helper()
+
+	for _, q := range queries {
+		first, err := app.goldenQueryJSON(q)
+		assert.NilError(t, err, "query %s", q.Name)
+
+		second, err := app.goldenQueryJSON(q)
+		assert.NilError(t, err, "query %s", q.Name)
+		assert.Equal(t, first, second, "query %s is not deterministic", q.Name)
+
+		golden.Assert(t, first, q.Name)
+	}
+}
+
+// goldenQueryJSON executes the query and returns its canonicalized JSON response.
+func (app *App) goldenQueryJSON(q GoldenQuery) (string, error) {
+	res := proto.Clone(q.Response)
+	res.Reset()
+
+	if err := app.queryHelper.Invoke(app.ctx, q.Method, q.Request, res); err != nil {
+		return "", err
+	}
+
+	bz, err := codec.ProtoMarshalJSON(res, app.interfaceRegistry)
+	if err != nil {
+		return "", err
+	}
+
+	return canonicalizeJSON(bz)
+}
+
+// canonicalizeJSON returns the indented JSON with sorted object keys, so that golden files
+// do not depend on field ordering or formatting.
+func canonicalizeJSON(bz []byte) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(out) + "\n", nil
+}
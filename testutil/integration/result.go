@@ -0,0 +1,55 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	cmtabcitypes "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// TxResult is the result of a transaction run in a block with RunBlock, holding
+// its gas wanted and used, and its code, codespace and log if it failed.
+type TxResult struct {
+	*cmtabcitypes.ExecTxResult
+}
+
+// Failed returns true if the transaction failed.
+func (r *TxResult) Failed() bool {
+	return r.Code != 0
+}
+
+// Err returns the error the transaction failed with, nil if it succeeded. The error
+// wraps the registered error of the codespace and code of the result, so that it can
+// be matched with errors.Is, e.g. errors.Is(err, sdkerrors.ErrOutOfGas).
+func (r *TxResult) Err() error {
+	if !r.Failed() {
+		return nil
+	}
+
+	return errorsmod.ABCIError(r.Codespace, r.Code, r.Log)
+}
+
+// RequireSuccess asserts that the transaction succeeded.
+func (r *TxResult) RequireSuccess(t testing.TB) {
+	t.Helper()
+
+	if r.Failed() {
+		t.Fatalf("transaction failed with codespace %q and code %d: %s", r.Codespace, r.Code, r.Log)
+	}
+}
+
+// RequireError asserts that the transaction failed with the given error, matched
+// with errors.Is against the error of the result, see Err.
+func (r *TxResult) RequireError(t testing.TB, target error) {
+	t.Helper()
+
+	if !r.Failed() {
+		t.Fatalf("transaction succeeded, expected it to fail with: %v", target)
+	}
+
+	if err := r.Err(); !errors.Is(err, target) {
+		t.Fatalf("transaction failed with codespace %q and code %d: %s, expected it to fail with: %v", r.Codespace, r.Code, r.Log, target)
+	}
+}
@@ -2,28 +2,36 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	cmtabcitypes "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	coreheader "cosmossdk.io/core/header"
 	corestore "cosmossdk.io/core/store"
 	coretesting "cosmossdk.io/core/testing"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
@@ -31,16 +39,50 @@ import (
 const (
 	appName   = "integration-app"
 	consensus = "consensus"
+
+	// DefaultBlockTimeDelta is the default time elapsed between two blocks run by
+	// the application.
+	DefaultBlockTimeDelta = 5 * time.Second
 )
 
 // App is a test application that can be used to test the integration of modules.
 type App struct {
 	*baseapp.BaseApp
 
-	ctx           sdk.Context
-	logger        log.Logger
-	moduleManager module.Manager
-	queryHelper   *baseapp.QueryServiceTestHelper
+	ctx               sdk.Context
+	logger            log.Logger
+	moduleManager     module.Manager
+	queryHelper       *baseapp.QueryServiceTestHelper
+	interfaceRegistry codectypes.InterfaceRegistry
+	txConfig          client.TxConfig
+	addressCodec      address.Codec
+	blockTimeDelta    time.Duration
+
+	// sharedStore is true when the application commits the multistore of the
+	// application context, which is required to run blocks. See WithSharedMultiStore.
+	sharedStore bool
+	// runningBlock is true while a block is run with RunBlock, so that the begin
+	// and end blockers run on the block context.
+	runningBlock bool
+	// blockCtx is the context the pre, begin and end blockers run on outside of
+	// RunBlock. It shares the multistore of the application context, see Checkpoint.
+	blockCtx sdk.Context
+
+	// events are the events emitted by RunMsg since the last block, see Events.
+	events []cmtabcitypes.Event
+	// lastBlockEvents are the events emitted by the last block, see LastBlockEvents.
+	lastBlockEvents []cmtabcitypes.Event
+}
+
+// BlockResult is the result of a block run by the application.
+type BlockResult struct {
+	Height int64
+	// GasUsed is the gas used by all the transactions of the block.
+	GasUsed int64
+	// Events are the events emitted by the begin and end blockers.
+	Events []cmtabcitypes.Event
+	// TxResults are the results of the transactions, in the block order.
+	TxResults []*TxResult
 }
 
 // NewIntegrationApp creates an application for testing purposes. This application
@@ -58,14 +100,39 @@ func NewIntegrationApp(
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *App {
 	db := coretesting.NewMemDB()
-
-	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	app := &App{}
+
+	interfaceRegistry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addressCodec,
+			ValidatorAddressCodec: validatorCodec,
+		},
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to create interface registry: %w", err))
+	}
 	moduleManager := module.NewManagerFromMap(modules)
 	moduleManager.RegisterInterfaces(interfaceRegistry)
 
 	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), addressCodec, validatorCodec, authtx.DefaultSignModes)
-	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), append(baseAppOptions, baseapp.SetChainID(appName))...)
-	bApp.MountKVStores(keys)
+	// the chain ID defaults to the application name, and can be set with baseapp.SetChainID
+	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), append([]func(*baseapp.BaseApp){baseapp.SetChainID(appName)}, baseAppOptions...)...)
+	// the stores are already mounted on the multistore of the context when it is
+	// shared with the application, see WithSharedMultiStore.
+	sharedStore := bApp.CommitMultiStore() == sdkCtx.MultiStore()
+	if !sharedStore {
+		bApp.MountKVStores(keys)
+	}
+	// an application created on a shared multistore holding committed blocks resumes
+	// from the last block instead of initializing the chain, see RunUpgrade.
+	resume := sharedStore && bApp.CommitMultiStore().LastCommitID().Version > 0
+	if resume {
+		bApp.SetStoreLoader(addedStoresLoader(keys))
+	}
+	// an application joining the chain with state sync is not initialized, its state
+	// is restored from a snapshot, see RestoreSnapshot.
+	stateSync := setSnapshots(bApp)
 
 	bApp.SetInitChainer(func(_ sdk.Context, _ *cmtabcitypes.InitChainRequest) (*cmtabcitypes.InitChainResponse, error) {
 		for _, mod := range modules {
@@ -79,19 +146,40 @@ func NewIntegrationApp(
 		return &cmtabcitypes.InitChainResponse{}, nil
 	})
 
-	bApp.SetBeginBlocker(func(_ sdk.Context) (sdk.BeginBlock, error) {
-		return moduleManager.BeginBlock(sdkCtx)
+	bApp.SetPreBlocker(func(ctx sdk.Context, _ *cmtabcitypes.FinalizeBlockRequest) error {
+		if !app.runningBlock {
+			ctx = app.blockCtx
+		}
+		return moduleManager.PreBlock(ctx)
 	})
-	bApp.SetEndBlocker(func(_ sdk.Context) (sdk.EndBlock, error) {
-		return moduleManager.EndBlock(sdkCtx)
+	bApp.SetBeginBlocker(func(ctx sdk.Context) (sdk.BeginBlock, error) {
+		if !app.runningBlock {
+			ctx = app.blockCtx
+		}
+		return moduleManager.BeginBlock(ctx)
+	})
+	bApp.SetEndBlocker(func(ctx sdk.Context) (sdk.EndBlock, error) {
+		if !app.runningBlock {
+			ctx = app.blockCtx
+		}
+		return moduleManager.EndBlock(ctx)
 	})
 
+	bApp.SetInterfaceRegistry(interfaceRegistry)
 	msgRouter.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetMsgServiceRouter(msgRouter)
 	grpcRouter.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetGRPCQueryRouter(grpcRouter)
 
-	if keys[consensus] != nil {
+	if resume || stateSync {
+		if keys[consensus] != nil {
+			bApp.SetParamStore(newParamStore(runtime.NewKVStoreService(keys[consensus]), appCodec))
+		}
+
+		if err := bApp.LoadLatestVersion(); err != nil {
+			panic(fmt.Errorf("failed to load application version from store: %w", err))
+		}
+	} else if keys[consensus] != nil {
 		cps := newParamStore(runtime.NewKVStoreService(keys[consensus]), appCodec)
 		bApp.SetParamStore(cps)
 
@@ -105,7 +193,7 @@ func NewIntegrationApp(
 			panic(fmt.Errorf("failed to load application version from store: %w", err))
 		}
 
-		if _, err := bApp.InitChain(&cmtabcitypes.InitChainRequest{ChainId: appName, ConsensusParams: simtestutil.DefaultConsensusParams}); err != nil {
+		if _, err := bApp.InitChain(&cmtabcitypes.InitChainRequest{ChainId: bApp.ChainID(), ConsensusParams: simtestutil.DefaultConsensusParams}); err != nil {
 			panic(fmt.Errorf("failed to initialize application: %w", err))
 		}
 	} else {
@@ -113,25 +201,33 @@ func NewIntegrationApp(
 			panic(fmt.Errorf("failed to load application version from store: %w", err))
 		}
 
-		if _, err := bApp.InitChain(&cmtabcitypes.InitChainRequest{ChainId: appName}); err != nil {
+		if _, err := bApp.InitChain(&cmtabcitypes.InitChainRequest{ChainId: bApp.ChainID()}); err != nil {
 			panic(fmt.Errorf("failed to initialize application: %w", err))
 		}
 	}
 
-	_, err := bApp.Commit()
-	if err != nil {
-		panic(err)
+	if !resume && !stateSync {
+		if _, err := bApp.Commit(); err != nil {
+			panic(err)
+		}
 	}
 
-	ctx := sdkCtx.WithBlockHeader(cmtproto.Header{ChainID: appName}).WithIsCheckTx(true)
-
-	return &App{
-		BaseApp:       bApp,
-		logger:        logger,
-		ctx:           ctx,
-		moduleManager: *moduleManager,
-		queryHelper:   baseapp.NewQueryServerTestHelper(ctx, interfaceRegistry),
+	ctx := sdkCtx.WithBlockHeader(cmtproto.Header{ChainID: bApp.ChainID()}).WithIsCheckTx(true)
+
+	*app = App{
+		BaseApp:           bApp,
+		logger:            logger,
+		ctx:               ctx,
+		moduleManager:     *moduleManager,
+		queryHelper:       baseapp.NewQueryServerTestHelper(ctx, interfaceRegistry),
+		interfaceRegistry: interfaceRegistry,
+		txConfig:          txConfig,
+		addressCodec:      addressCodec,
+		blockTimeDelta:    DefaultBlockTimeDelta,
+		sharedStore:       sharedStore,
+		blockCtx:          sdkCtx,
 	}
+	return app
 }
 
 // RunMsg provides the ability to run a message and return the response.
@@ -174,6 +270,7 @@ func (app *App) RunMsg(msg sdk.Msg, option ...Option) (*codectypes.Any, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute message %s: %w", sdk.MsgTypeURL(msg), err)
 	}
+	app.events = append(app.events, msgResult.Events...)
 
 	var response *codectypes.Any
 	if len(msgResult.MsgResponses) > 0 {
@@ -188,6 +285,133 @@ func (app *App) RunMsg(msg sdk.Msg, option ...Option) (*codectypes.Any, error) {
 	return response, nil
 }
 
+// RunWithGasMeter runs fn on a branch of the application context holding a gas meter
+// of the given limit, and returns the gas consumed by fn. As the gas consumed by the
+// store accesses is deterministic, it can be used to benchmark keepers or to make sure
+// that their gas consumption does not change unexpectedly.
+// A zero limit sets an infinite gas meter, which still accounts for the consumed gas.
+// The branch is written to the application context only if fn succeeds. If fn runs out
+// of gas, an ErrOutOfGas error is returned along with the gas consumed until then.
+func (app *App) RunWithGasMeter(limit storetypes.Gas, fn func(ctx context.Context) error) (gasUsed storetypes.Gas, err error) {
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	if limit > 0 {
+		gasMeter = storetypes.NewGasMeter(limit)
+	}
+
+	ctx, writeCache := app.ctx.CacheContext()
+	ctx = ctx.WithGasMeter(gasMeter)
+
+	defer func() {
+		gasUsed = gasMeter.GasConsumed()
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gasLimit: %d, gasUsed: %d", oog.Descriptor, limit, gasUsed)
+		}
+	}()
+
+	if err := fn(ctx); err != nil {
+		return 0, err
+	}
+	writeCache()
+
+	return 0, nil
+}
+
+// RunBlock runs a block holding the given transactions through FinalizeBlock,
+// and commits it. The transactions are executed as they would be on chain, except
+// that they are not checked by an ante handler unless one has been set on the
+// application. The block is run at the next height, and its time is the time of
+// the application context plus the block time delta, see SetBlockTimeDelta and
+// AdvanceTime. The application context is then updated to the header of the block.
+// A failing transaction does not make RunBlock return an error, its result holds
+// the failure instead, see TxResult.
+func (app *App) RunBlock(txs ...sdk.Tx) (*BlockResult, error) {
+	if !app.sharedStore {
+		return nil, errors.New("running blocks requires the application to share the multistore of its context, see WithSharedMultiStore")
+	}
+
+	rawTxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		bz, err := app.txConfig.TxEncoder()(tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode transaction %d: %w", i, err)
+		}
+		rawTxs[i] = bz
+	}
+
+	height := app.LastBlockHeight() + 1
+	blockTime := app.ctx.HeaderInfo().Time.Add(app.blockTimeDelta)
+
+	app.runningBlock = true
+	res, err := app.FinalizeBlock(&cmtabcitypes.FinalizeBlockRequest{
+		Height:            height,
+		Time:              blockTime,
+		Txs:               rawTxs,
+		DecidedLastCommit: cmtabcitypes.CommitInfo{Votes: []cmtabcitypes.VoteInfo{{}}},
+	})
+	app.runningBlock = false
+	if err != nil {
+		return nil, fmt.Errorf("failed to run finalize block %d: %w", height, err)
+	}
+
+	if _, err := app.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit block %d: %w", height, err)
+	}
+
+	app.ctx = app.ctx.
+		WithBlockHeader(cmtproto.Header{ChainID: app.ChainID(), Height: height, Time: blockTime}).
+		WithHeaderInfo(coreheader.Info{ChainID: app.ChainID(), Height: height, Time: blockTime})
+	app.queryHelper.Ctx = app.ctx
+
+	result := &BlockResult{
+		Height:    height,
+		Events:    res.Events,
+		TxResults: make([]*TxResult, len(res.TxResults)),
+	}
+	app.events = nil
+	app.lastBlockEvents = append([]cmtabcitypes.Event{}, res.Events...)
+	for i, txResult := range res.TxResults {
+		result.TxResults[i] = &TxResult{ExecTxResult: txResult}
+		result.GasUsed += txResult.GasUsed
+		app.lastBlockEvents = append(app.lastBlockEvents, txResult.Events...)
+	}
+
+	return result, nil
+}
+
+// RunBlocks runs and commits n empty blocks, and returns their results.
+func (app *App) RunBlocks(n int) ([]*BlockResult, error) {
+	results := make([]*BlockResult, 0, n)
+	for i := 0; i < n; i++ {
+		res, err := app.RunBlock()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// SetBlockTimeDelta sets the time elapsed between two blocks run by the application.
+func (app *App) SetBlockTimeDelta(d time.Duration) {
+	app.blockTimeDelta = d
+}
+
+// AdvanceTime moves the time of the application context forward by d, so that
+// time-based logic such as unbonding or vesting periods can be tested. The next
+// block run by the application is d later than it would have been otherwise.
+func (app *App) AdvanceTime(d time.Duration) {
+	headerInfo := app.ctx.HeaderInfo()
+	headerInfo.Time = headerInfo.Time.Add(d)
+
+	app.ctx = app.ctx.WithHeaderInfo(headerInfo)
+	app.queryHelper.Ctx = app.ctx
+}
+
 // Context returns the application context. It can be unwrapped to a sdk.Context,
 // with the sdk.UnwrapSDKContext function.
 func (app *App) Context() context.Context {
@@ -200,13 +424,67 @@ func (app *App) QueryHelper() *baseapp.QueryServiceTestHelper {
 	return app.queryHelper
 }
 
+// Query executes the gRPC query of the given fully qualified method name, e.g.
+// "/cosmos.bank.v1beta1.Query/Balance", against the latest committed state, and
+// unmarshals its response into res. The query is routed through the gRPC query
+// router of the application, on which the query services must be registered.
+// Contrary to the query helper, it does not see the state written since the last
+// commit, so it requires the application to share the multistore of its context,
+// see WithSharedMultiStore.
+func (app *App) Query(method string, req, res proto.Message) error {
+	return app.QueryAt(0, method, req, res)
+}
+
+// QueryAt executes the gRPC query of the given fully qualified method name against
+// the state committed at the given height, see Query. A zero height queries the
+// latest committed state.
+func (app *App) QueryAt(height int64, method string, req, res proto.Message) error {
+	if !app.sharedStore {
+		return errors.New("querying committed state requires the application to share the multistore of its context, see WithSharedMultiStore")
+	}
+
+	handler := app.GRPCQueryRouter().Route(method)
+	if handler == nil {
+		return fmt.Errorf("handler is nil, can't route query %s", method)
+	}
+
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return fmt.Errorf("failed to create query context at height %d: %w", height, err)
+	}
+
+	bz, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal query request %s: %w", method, err)
+	}
+
+	queryRes, err := handler(ctx, &cmtabcitypes.QueryRequest{Path: method, Data: bz, Height: ctx.BlockHeight()})
+	if err != nil {
+		return fmt.Errorf("failed to execute query %s: %w", method, err)
+	}
+
+	return proto.Unmarshal(queryRes.Value, res)
+}
+
+// WithSharedMultiStore is a base app option making the application commit the
+// given multistore, which must be the one of the context given to NewIntegrationApp
+// and created with CreateMultiStore. It is required to run blocks with RunBlock, so
+// that the state written by the blocks is visible from the application context.
+// The multistore is reloaded when the application is created, so no state must be
+// written to it beforehand.
+func WithSharedMultiStore(cms storetypes.CommitMultiStore) func(*baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		bApp.SetCMS(cms)
+	}
+}
+
 // CreateMultiStore is a helper for setting up multiple stores for provided modules.
 func CreateMultiStore(keys map[string]*storetypes.KVStoreKey, logger log.Logger) storetypes.CommitMultiStore {
 	db := coretesting.NewMemDB()
 	cms := store.NewCommitMultiStore(db, logger, metrics.NewNoOpMetrics())
 
 	for key := range keys {
-		cms.MountStoreWithDB(keys[key], storetypes.StoreTypeIAVL, db)
+		cms.MountStoreWithDB(keys[key], storetypes.StoreTypeIAVL, nil)
 	}
 
 	_ = cms.LoadLatestVersion()
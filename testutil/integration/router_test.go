@@ -0,0 +1,117 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// testKey is the key of the store of the applications created by newTestApp.
+var testKey = storetypes.NewKVStoreKey("test")
+
+// newTestApp returns an application without modules, mounting the store of testKey.
+// The application shares the multistore of its context if shared is true.
+func newTestApp(t *testing.T, shared bool, baseAppOptions ...func(*baseapp.BaseApp)) *App {
+	t.Helper()
+
+	keys := map[string]*storetypes.KVStoreKey{testKey.Name(): testKey}
+	logger := log.NewNopLogger()
+	cms := CreateMultiStore(keys, logger)
+	if shared {
+		baseAppOptions = append([]func(*baseapp.BaseApp){WithSharedMultiStore(cms)}, baseAppOptions...)
+	}
+
+	return NewIntegrationApp(
+		sdk.NewContext(cms, true, logger),
+		logger,
+		keys,
+		codectestutil.CodecOptions{}.NewCodec(),
+		addresscodec.NewBech32Codec("cosmos"),
+		addresscodec.NewBech32Codec("cosmosvaloper"),
+		nil,
+		baseapp.NewMsgServiceRouter(),
+		baseapp.NewGRPCQueryRouter(),
+		baseAppOptions...,
+	)
+}
+
+func TestRunMsgNoHandler(t *testing.T) {
+	app := newTestApp(t, false)
+
+	_, err := app.RunMsg(&authtypes.MsgUpdateParams{})
+	require.ErrorContains(t, err, "can't route message /cosmos.auth.v1beta1.MsgUpdateParams")
+}
+
+func TestRunBlockNotSharedStore(t *testing.T) {
+	app := newTestApp(t, false)
+
+	_, err := app.RunBlock()
+	require.ErrorContains(t, err, "requires the application to share the multistore")
+
+	_, err = app.RunBlocks(2)
+	require.ErrorContains(t, err, "requires the application to share the multistore")
+	require.Equal(t, int64(1), app.LastBlockHeight())
+}
+
+func TestQueryErrors(t *testing.T) {
+	req, res := &authtypes.QueryParamsRequest{}, &authtypes.QueryParamsResponse{}
+
+	err := newTestApp(t, false).Query("/cosmos.auth.v1beta1.Query/Params", req, res)
+	require.ErrorContains(t, err, "requires the application to share the multistore")
+
+	app := newTestApp(t, true)
+	err = app.Query("/cosmos.auth.v1beta1.Query/Params", req, res)
+	require.ErrorContains(t, err, "can't route query /cosmos.auth.v1beta1.Query/Params")
+
+	authtypes.RegisterQueryServer(app.GRPCQueryRouter(), &authtypes.UnimplementedQueryServer{})
+	err = app.QueryAt(app.LastBlockHeight()+1, "/cosmos.auth.v1beta1.Query/Params", req, res)
+	require.ErrorContains(t, err, "failed to create query context at height 2")
+}
+
+func TestRunWithGasMeterErrors(t *testing.T) {
+	app := newTestApp(t, false)
+
+	set := func(ctx context.Context) error {
+		sdk.UnwrapSDKContext(ctx).KVStore(testKey).Set([]byte("key"), []byte("value"))
+		return nil
+	}
+	gasUsed, err := app.RunWithGasMeter(0, set)
+	require.NoError(t, err)
+	require.NotZero(t, gasUsed)
+
+	// the state written by a failing function is discarded
+	errFailed := errors.New("failed")
+	_, err = app.RunWithGasMeter(0, func(ctx context.Context) error {
+		sdk.UnwrapSDKContext(ctx).KVStore(testKey).Set([]byte("other"), []byte("value"))
+		return errFailed
+	})
+	require.ErrorIs(t, err, errFailed)
+
+	outOfGas, err := app.RunWithGasMeter(gasUsed-1, func(ctx context.Context) error {
+		sdk.UnwrapSDKContext(ctx).KVStore(testKey).Set([]byte("other"), []byte("value"))
+		return nil
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.Greater(t, outOfGas, gasUsed-1)
+
+	store := sdk.UnwrapSDKContext(app.Context()).KVStore(testKey)
+	require.Equal(t, []byte("value"), store.Get([]byte("key")))
+	require.False(t, store.Has([]byte("other")))
+
+	// panics other than running out of gas are not recovered
+	require.PanicsWithValue(t, "panic", func() {
+		_, _ = app.RunWithGasMeter(0, func(context.Context) error { panic("panic") })
+	})
+}
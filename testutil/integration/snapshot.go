@@ -0,0 +1,191 @@
+package integration
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	cmtabcitypes "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
+	coreheader "cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// snapshotConfigs are the snapshot configurations of the applications being created
+// with WithSnapshots or WithStateSync, applied by NewIntegrationApp once all the
+// base app options are applied, so that they apply to the multistore of the app.
+var snapshotConfigs sync.Map
+
+type snapshotConfig struct {
+	dir       string
+	stateSync bool
+}
+
+// WithSnapshots is a base app option enabling the snapshots of the application,
+// stored in the given directory, see App.CreateSnapshot.
+func WithSnapshots(dir string) func(*baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		snapshotConfigs.Store(bApp, snapshotConfig{dir: dir})
+	}
+}
+
+// WithStateSync is a base app option creating the application as a node joining
+// the chain with state sync: the application is not initialized, and its state must
+// be restored from a snapshot with App.RestoreSnapshot before it is used. The chunks
+// of the snapshot are stored in the given directory while they are restored.
+func WithStateSync(dir string) func(*baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		snapshotConfigs.Store(bApp, snapshotConfig{dir: dir, stateSync: true})
+	}
+}
+
+// setSnapshots sets the snapshot manager of the application if it is created with
+// WithSnapshots or WithStateSync, and returns true if it is created with WithStateSync.
+func setSnapshots(bApp *baseapp.BaseApp) bool {
+	v, ok := snapshotConfigs.LoadAndDelete(bApp)
+	if !ok {
+		return false
+	}
+
+	cfg := v.(snapshotConfig)
+	snapshotStore, err := snapshots.NewStore(coretesting.NewMemDB(), cfg.dir)
+	if err != nil {
+		panic(fmt.Errorf("failed to create snapshot store: %w", err))
+	}
+	// snapshots are only taken on demand, and all of them are kept
+	bApp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0))
+
+	return cfg.stateSync
+}
+
+// Snapshot is a snapshot of the state of an application with its chunks, as a node
+// joining the chain with state sync gets it from its peers. The app hash and the
+// time of the snapshot height are the ones the node gets from the light client.
+type Snapshot struct {
+	Metadata cmtabcitypes.Snapshot
+	AppHash  []byte
+	Time     time.Time
+	Chunks   [][]byte
+}
+
+// Height returns the height of the snapshot.
+func (s *Snapshot) Height() int64 {
+	return int64(s.Metadata.Height)
+}
+
+// CreateSnapshot creates a snapshot of the state committed by the application at
+// the given height, with the snapshot manager of the application, and loads its
+// chunks as the ABCI snapshot connection of the node would. The application must be
+// created with WithSnapshots.
+func (app *App) CreateSnapshot(height uint64) (*Snapshot, error) {
+	manager := app.SnapshotManager()
+	if manager == nil {
+		return nil, errors.New("snapshots are not enabled, see WithSnapshots")
+	}
+
+	commitInfo, err := app.commitInfo(int64(height))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := manager.Create(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot at height %d: %w", height, err)
+	}
+
+	metadata, err := snapshot.ToABCI()
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make([][]byte, snapshot.Chunks)
+	for i := range chunks {
+		res, err := app.LoadSnapshotChunk(&cmtabcitypes.LoadSnapshotChunkRequest{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  uint32(i),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load snapshot chunk %d: %w", i, err)
+		}
+		chunks[i] = res.Chunk
+	}
+
+	return &Snapshot{
+		Metadata: metadata,
+		AppHash:  commitInfo.Hash(),
+		Time:     commitInfo.Timestamp,
+		Chunks:   chunks,
+	}, nil
+}
+
+// RestoreSnapshot restores the state of the application from the given snapshot,
+// offering it and applying its chunks as CometBFT state sync would, and checks the
+// restored state matches the app hash of the snapshot. The application must be
+// created with WithStateSync. Once restored, the application resumes from the block
+// following the snapshot height.
+func (app *App) RestoreSnapshot(snapshot *Snapshot) error {
+	if app.LastBlockHeight() != 0 {
+		return fmt.Errorf("cannot restore a snapshot into an application at height %d, see WithStateSync", app.LastBlockHeight())
+	}
+
+	offer, err := app.OfferSnapshot(&cmtabcitypes.OfferSnapshotRequest{Snapshot: &snapshot.Metadata, AppHash: snapshot.AppHash})
+	if err != nil {
+		return err
+	}
+	if offer.Result != cmtabcitypes.OFFER_SNAPSHOT_RESULT_ACCEPT {
+		return fmt.Errorf("snapshot at height %d was not accepted: %s", snapshot.Height(), offer.Result)
+	}
+
+	for i, chunk := range snapshot.Chunks {
+		res, err := app.ApplySnapshotChunk(&cmtabcitypes.ApplySnapshotChunkRequest{Index: uint32(i), Chunk: chunk, Sender: appName})
+		if err != nil {
+			return err
+		}
+		if res.Result != cmtabcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT {
+			return fmt.Errorf("snapshot chunk %d was not applied: %s", i, res.Result)
+		}
+	}
+
+	// CometBFT checks the restored state against the light client
+	lastCommitID := app.LastCommitID()
+	if lastCommitID.Version != snapshot.Height() {
+		return fmt.Errorf("restored height %d does not match the snapshot height %d", lastCommitID.Version, snapshot.Height())
+	}
+	if !bytes.Equal(lastCommitID.Hash, snapshot.AppHash) {
+		return fmt.Errorf("restored app hash %X does not match the snapshot app hash %X", lastCommitID.Hash, snapshot.AppHash)
+	}
+
+	app.ctx = app.ctx.
+		WithBlockHeader(cmtproto.Header{ChainID: app.ChainID(), Height: snapshot.Height(), Time: snapshot.Time}).
+		WithHeaderInfo(coreheader.Info{ChainID: app.ChainID(), Height: snapshot.Height(), Time: snapshot.Time})
+	app.queryHelper.Ctx = app.ctx
+
+	return nil
+}
+
+// commitInfo returns the commit info of the multistore of the application at the
+// given height.
+func (app *App) commitInfo(height int64) (*storetypes.CommitInfo, error) {
+	cms, ok := app.CommitMultiStore().(interface {
+		GetCommitInfo(int64) (*storetypes.CommitInfo, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("cannot get the commit info of multistore %T", app.CommitMultiStore())
+	}
+
+	commitInfo, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+
+	return commitInfo, nil
+}
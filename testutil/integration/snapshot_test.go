@@ -0,0 +1,40 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCreateSnapshotErrors(t *testing.T) {
+	_, err := newTestApp(t, true).CreateSnapshot(1)
+	require.ErrorContains(t, err, "snapshots are not enabled")
+
+	app := newTestApp(t, true, WithSnapshots(t.TempDir()))
+	_, err = app.CreateSnapshot(2)
+	require.ErrorContains(t, err, "failed to get the commit info at height 2")
+}
+
+func TestRestoreSnapshotErrors(t *testing.T) {
+	app := newTestApp(t, true, WithSnapshots(t.TempDir()))
+	sdk.UnwrapSDKContext(app.Context()).KVStore(testKey).Set([]byte("key"), []byte("value"))
+	_, err := app.RunBlock()
+	require.NoError(t, err)
+
+	snapshot, err := app.CreateSnapshot(uint64(app.LastBlockHeight()))
+	require.NoError(t, err)
+
+	// only an application joining the chain with state sync can restore a snapshot
+	err = newTestApp(t, true).RestoreSnapshot(snapshot)
+	require.ErrorContains(t, err, "cannot restore a snapshot into an application at height 1")
+
+	// the restored state is checked against the app hash of the snapshot
+	tampered := *snapshot
+	tampered.AppHash = []byte("tampered")
+	err = newTestApp(t, true, WithStateSync(t.TempDir())).RestoreSnapshot(&tampered)
+	require.ErrorContains(t, err, "does not match the snapshot app hash")
+
+	require.NoError(t, newTestApp(t, true, WithStateSync(t.TempDir())).RestoreSnapshot(snapshot))
+}
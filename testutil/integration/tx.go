@@ -0,0 +1,172 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TxBuilderFactory builds transactions signed for the integration app. The account
+// numbers and sequences of the signers are read from the latest committed auth state,
+// so the auth query service must be registered on the gRPC query router of the
+// application, and the signer accounts must exist.
+// The sequences of the transactions signed by the factory since the last block are
+// accounted for, so that a block can hold several transactions of the same signer.
+type TxBuilderFactory struct {
+	app      *App
+	gasLimit uint64
+	fees     sdk.Coins
+	memo     string
+
+	// height is the height of the state the signed sequences are relative to.
+	height int64
+	// signed is the number of transactions signed at height, by signer address.
+	signed map[string]uint64
+}
+
+// TxBuilderFactory returns a factory building transactions signed for the application,
+// with the simulation default gas limit and no fees.
+func (app *App) TxBuilderFactory() *TxBuilderFactory {
+	return &TxBuilderFactory{
+		app:      app,
+		gasLimit: simtestutil.DefaultGenTxGas,
+		signed:   map[string]uint64{},
+	}
+}
+
+// WithGasLimit sets the gas limit of the transactions built by the factory.
+func (f *TxBuilderFactory) WithGasLimit(gasLimit uint64) *TxBuilderFactory {
+	f.gasLimit = gasLimit
+	return f
+}
+
+// WithFees sets the fees of the transactions built by the factory.
+func (f *TxBuilderFactory) WithFees(fees sdk.Coins) *TxBuilderFactory {
+	f.fees = fees
+	return f
+}
+
+// WithMemo sets the memo of the transactions built by the factory.
+func (f *TxBuilderFactory) WithMemo(memo string) *TxBuilderFactory {
+	f.memo = memo
+	return f
+}
+
+// NewTxBuilder returns a transaction builder holding the messages, signed by the
+// signers in the given order with the default sign mode of the application.
+func (f *TxBuilderFactory) NewTxBuilder(msgs []sdk.Msg, signers ...cryptotypes.PrivKey) (client.TxBuilder, error) {
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("no signer for messages %v", msgs)
+	}
+
+	txConfig := f.app.txConfig
+	signMode, err := authsign.APISignModeToInternal(txConfig.SignModeHandler().DefaultMode())
+	if err != nil {
+		return nil, err
+	}
+
+	if height := f.app.LastBlockHeight(); height != f.height {
+		f.height = height
+		f.signed = map[string]uint64{}
+	}
+
+	signerData := make([]authsign.SignerData, len(signers))
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, priv := range signers {
+		address, err := f.app.addressCodec.BytesToString(priv.PubKey().Address())
+		if err != nil {
+			return nil, err
+		}
+
+		res := &authtypes.QueryAccountInfoResponse{}
+		if err := f.app.Query("/cosmos.auth.v1beta1.Query/AccountInfo", &authtypes.QueryAccountInfoRequest{Address: address}, res); err != nil {
+			return nil, fmt.Errorf("failed to query account of signer %s: %w", address, err)
+		}
+
+		signerData[i] = authsign.SignerData{
+			Address:       address,
+			ChainID:       f.app.ChainID(),
+			AccountNumber: res.Info.AccountNumber,
+			Sequence:      res.Info.Sequence + f.signed[address],
+			PubKey:        priv.PubKey(),
+		}
+		// the signer infos are set with empty signatures first, as they are signed
+		sigs[i] = signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: signerData[i].Sequence,
+		}
+	}
+
+	txBuilder := txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	txBuilder.SetGasLimit(f.gasLimit)
+	txBuilder.SetFeeAmount(f.fees)
+	txBuilder.SetMemo(f.memo)
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	for i, priv := range signers {
+		signBytes, err := authsign.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signMode, signerData[i], txBuilder.GetTx())
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i].Data.(*signing.SingleSignatureData).Signature = sig
+	}
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	for _, data := range signerData {
+		f.signed[data.Address]++
+	}
+
+	return txBuilder, nil
+}
+
+// BuildTx returns a transaction holding the messages, signed by the signers, see NewTxBuilder.
+func (f *TxBuilderFactory) BuildTx(msgs []sdk.Msg, signers ...cryptotypes.PrivKey) (sdk.Tx, error) {
+	txBuilder, err := f.NewTxBuilder(msgs, signers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx(), nil
+}
+
+// SignAndDeliver signs a transaction holding the messages with the private key, see
+// TxBuilderFactory, and runs a block holding it, see RunBlock. It returns the result
+// of the transaction, and the error of the transaction if it failed, see TxResult.Err.
+func (app *App) SignAndDeliver(priv cryptotypes.PrivKey, msgs ...sdk.Msg) (*TxResult, error) {
+	tx, err := app.TxBuilderFactory().BuildTx(msgs, priv)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := app.RunBlock(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	txResult := res.TxResults[0]
+	if err := txResult.Err(); err != nil {
+		return txResult, fmt.Errorf("transaction failed with code %d: %w", txResult.Code, err)
+	}
+
+	return txResult, nil
+}
@@ -0,0 +1,32 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestNewTxBuilderErrors(t *testing.T) {
+	msg := &authtypes.MsgUpdateParams{}
+	priv := secp256k1.GenPrivKeyFromSecret([]byte("alice"))
+
+	_, err := newTestApp(t, true).TxBuilderFactory().BuildTx([]sdk.Msg{msg})
+	require.ErrorContains(t, err, "no signer for messages")
+
+	// the account of the signer is queried from the committed state
+	_, err = newTestApp(t, false).TxBuilderFactory().BuildTx([]sdk.Msg{msg}, priv)
+	require.ErrorContains(t, err, "failed to query account of signer")
+	require.ErrorContains(t, err, "requires the application to share the multistore")
+
+	app := newTestApp(t, true)
+	_, err = app.TxBuilderFactory().BuildTx([]sdk.Msg{msg}, priv)
+	require.ErrorContains(t, err, "can't route query /cosmos.auth.v1beta1.Query/AccountInfo")
+
+	_, err = app.SignAndDeliver(priv, msg)
+	require.ErrorContains(t, err, "failed to query account of signer")
+	require.Equal(t, int64(1), app.LastBlockHeight())
+}
@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+
+	upgradev1beta1 "cosmossdk.io/api/cosmos/upgrade/v1beta1"
+	"cosmossdk.io/core/appmodule"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// RunUpgrade runs the software upgrade of the given name at the given height, as a
+// chain would, so that upgrades can be tested without running nodes:
+//
+//   - the upgrade plan is scheduled with a MsgSoftwareUpgrade from the authority of
+//     the upgrade module, whose message server must be registered on the application;
+//   - blocks are run until the upgrade module halts the chain at the upgrade height;
+//   - the upgraded application is created with newApp, which must call NewIntegrationApp
+//     with the multistore of the application and the upgraded module set;
+//   - the upgraded application runs the upgrade block, in which the upgrade module
+//     applies the upgrade handler set on its keeper, which usually runs the module
+//     migrations with RunMigrations.
+//
+// The upgraded application is returned, the application must not be used anymore.
+// Running blocks requires the application to share the multistore of its context,
+// see WithSharedMultiStore.
+func (app *App) RunUpgrade(name string, height int64, authority string, newApp func() *App) (*App, error) {
+	if height <= app.LastBlockHeight()+1 {
+		return nil, fmt.Errorf("upgrade height %d must be after the next block %d", height, app.LastBlockHeight()+1)
+	}
+
+	if err := app.scheduleUpgrade(name, height, authority); err != nil {
+		return nil, err
+	}
+
+	for app.LastBlockHeight() < height-1 {
+		if _, err := app.RunBlock(); err != nil {
+			return nil, err
+		}
+	}
+
+	// the upgrade module fails the upgrade block as the application has no handler for it
+	_, err := app.RunBlock()
+	if err == nil {
+		return nil, fmt.Errorf("chain did not halt for upgrade %q at height %d", name, height)
+	}
+	if !strings.Contains(err.Error(), fmt.Sprintf("UPGRADE %q NEEDED", name)) {
+		return nil, err
+	}
+
+	upgraded := newApp()
+	if upgraded.CommitMultiStore() != app.CommitMultiStore() {
+		return nil, errors.New("upgraded application must be created with the multistore of the application")
+	}
+
+	upgraded.ctx = upgraded.ctx.WithBlockHeader(app.ctx.BlockHeader()).WithHeaderInfo(app.ctx.HeaderInfo())
+	upgraded.queryHelper.Ctx = upgraded.ctx
+	upgraded.blockTimeDelta = app.blockTimeDelta
+
+	if _, err := upgraded.RunBlock(); err != nil {
+		return nil, fmt.Errorf("failed to run upgrade %q: %w", name, err)
+	}
+
+	return upgraded, nil
+}
+
+// scheduleUpgrade schedules the upgrade plan of the given name and height. The message
+// is built from its API type, as the upgrade module is not a dependency of the SDK.
+func (app *App) scheduleUpgrade(name string, height int64, authority string) error {
+	bz, err := protov2.Marshal(&upgradev1beta1.MsgSoftwareUpgrade{
+		Authority: authority,
+		Plan:      &upgradev1beta1.Plan{Name: name, Height: height},
+	})
+	if err != nil {
+		return err
+	}
+
+	msg, err := app.interfaceRegistry.Resolve("/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
+	if err != nil {
+		return fmt.Errorf("upgrade module is not registered on the application: %w", err)
+	}
+
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		return err
+	}
+
+	if _, err := app.RunMsg(msg); err != nil {
+		return fmt.Errorf("failed to schedule upgrade %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// RunMigrations runs the migrations of the modules of the application from the given
+// module versions, and initializes the genesis of the modules absent from them, see
+// module.Manager.RunMigrations. It is meant to be called by upgrade handlers.
+func (app *App) RunMigrations(ctx context.Context, fromVM appmodule.VersionMap) (appmodule.VersionMap, error) {
+	// the services of the modules are registered on throwaway routers, as they are
+	// already registered on the application and only the migrations are needed
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(app.interfaceRegistry)
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(app.interfaceRegistry)
+
+	cfg := module.NewConfigurator(codec.NewProtoCodec(app.interfaceRegistry), msgRouter, queryRouter)
+	if err := app.moduleManager.RegisterServices(cfg); err != nil {
+		return nil, fmt.Errorf("failed to register module migrations: %w", err)
+	}
+
+	return app.moduleManager.RunMigrations(ctx, cfg, fromVM)
+}
+
+// addedStoresLoader returns a store loader mounting the stores of the keys which are
+// not mounted on the multistore, as stores added by an upgrade. The keys of the stores
+// already mounted must be the ones they were mounted with.
+func addedStoresLoader(keys map[string]*storetypes.KVStoreKey) baseapp.StoreLoader {
+	return func(ms storetypes.CommitMultiStore) error {
+		mounted, ok := ms.(interface {
+			StoreKeysByName() map[string]storetypes.StoreKey
+		})
+		if !ok {
+			return fmt.Errorf("cannot list the stores of multistore %T", ms)
+		}
+
+		upgrades := &storetypes.StoreUpgrades{}
+		storeKeys := mounted.StoreKeysByName()
+		for _, key := range keys {
+			if _, ok := storeKeys[key.Name()]; !ok {
+				ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+				upgrades.Added = append(upgrades.Added, key.Name())
+			}
+		}
+
+		return ms.LoadLatestVersionAndUpgrade(upgrades)
+	}
+}
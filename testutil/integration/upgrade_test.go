@@ -0,0 +1,22 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunUpgradeErrors(t *testing.T) {
+	app := newTestApp(t, true)
+	newApp := func() *App {
+		t.Fatal("the upgraded application must not be created")
+		return nil
+	}
+
+	_, err := app.RunUpgrade("v2", 2, "authority", newApp)
+	require.ErrorContains(t, err, "upgrade height 2 must be after the next block 2")
+
+	_, err = app.RunUpgrade("v2", 10, "authority", newApp)
+	require.ErrorContains(t, err, "upgrade module is not registered on the application")
+	require.Equal(t, int64(1), app.LastBlockHeight())
+}
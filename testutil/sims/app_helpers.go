@@ -4,12 +4,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
 
 	coreheader "cosmossdk.io/core/header"
 	corestore "cosmossdk.io/core/store"
@@ -19,6 +22,7 @@ import (
 	banktypes "cosmossdk.io/x/bank/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -70,6 +74,55 @@ func CreateRandomValidatorSet() (*cmttypes.ValidatorSet, error) {
 	return cmttypes.NewValidatorSet([]*cmttypes.Validator{validator}), nil
 }
 
+// CreateValidatorSet creates a validator set with n random validators, and returns
+// the private validators signing for them, in the order of the validators of the set.
+// powers defines the voting power of each validator, all validators have a voting
+// power of 1 when it is empty.
+func CreateValidatorSet(n int, powers []int64) (*cmttypes.ValidatorSet, []cmttypes.PrivValidator, error) {
+	if n <= 0 {
+		return nil, nil, fmt.Errorf("invalid number of validators: %d", n)
+	}
+	if len(powers) != 0 && len(powers) != n {
+		return nil, nil, fmt.Errorf("expected %d validator powers, got %d", n, len(powers))
+	}
+
+	validators := make([]*cmttypes.Validator, 0, n)
+	privVals := make(map[string]cmttypes.PrivValidator, n)
+	for i := 0; i < n; i++ {
+		power := int64(1)
+		if len(powers) != 0 {
+			power = powers[i]
+		}
+		if power <= 0 {
+			return nil, nil, fmt.Errorf("invalid power %d of validator %d", power, i)
+		}
+
+		privVal := mock.NewPV()
+		pubKey, err := privVal.GetPubKey()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get pub key: %w", err)
+		}
+
+		validators = append(validators, cmttypes.NewValidator(pubKey, power))
+		privVals[pubKey.Address().String()] = privVal
+	}
+
+	// the validator set sorts the validators by voting power
+	valSet := cmttypes.NewValidatorSet(validators)
+	signers := make([]cmttypes.PrivValidator, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		signers[i] = privVals[val.Address.String()]
+	}
+
+	return valSet, signers, nil
+}
+
+// GenesisAccount is an account of the genesis of a test application, with the
+// coins of its genesis balance. The account can be a base, vesting or module
+// account, see NewBaseGenesisAccount, NewContinuousVestingGenesisAccount,
+// NewDelayedVestingGenesisAccount, NewPeriodicVestingGenesisAccount and
+// NewModuleGenesisAccount. The vesting accounts require the vesting module to be
+// part of the application.
 type GenesisAccount struct {
 	authtypes.GenesisAccount
 	Coins sdk.Coins
@@ -78,14 +131,45 @@ type GenesisAccount struct {
 // StartupConfig defines the startup configuration new a test application.
 //
 // ValidatorSet defines a custom validator set to be validating the app.
+// ValidatorSigners defines the private validators signing for the validator set, if known.
 // BaseAppOption defines the additional operations that must be run on baseapp before app start.
 // AtGenesis defines if the app started should already have produced block or not.
+// ChainID defines the chain ID of the app, BondDenom the staking bond denom of its
+// genesis, defaulting to sdk.DefaultBondDenom, and MinGasPrices the minimum gas
+// prices of its CheckTx. The GenesisAccounts should be funded in the bond denom,
+// the validators being delegated to by the first one which is not a module account.
+// StateChangeRecorder optionally records the state changes of the app.
 type StartupConfig struct {
-	ValidatorSet    func() (*cmttypes.ValidatorSet, error)
-	BaseAppOption   runtime.BaseAppOption
-	AtGenesis       bool
-	GenesisAccounts []GenesisAccount
-	DB              corestore.KVStoreWithBatch
+	ValidatorSet     func() (*cmttypes.ValidatorSet, error)
+	ValidatorSigners []cmttypes.PrivValidator
+	BaseAppOption    runtime.BaseAppOption
+	AtGenesis        bool
+	GenesisAccounts  []GenesisAccount
+	// DB is the database of the app. When nil, a new database of the StoreBackend is
+	// opened for each app, so that apps set up from the same configuration, e.g. by
+	// parallel tests, do not share their state.
+	DB corestore.KVStoreWithBatch
+	// StoreBackend is the backend of the databases opened for the apps, one of memdb,
+	// goleveldb or pebbledb (with the pebbledb build tag), defaulting to memdb.
+	StoreBackend dbm.BackendType
+	// HomeDir is the home directory of the app, holding the database of the on-disk
+	// backends. When empty, a unique temporary home is created for each app and
+	// removed when the app is closed.
+	HomeDir string
+	// BlockTime is the genesis time of the chain, and the time of its first block.
+	// Following blocks can be produced at controlled times with NextBlock.
+	BlockTime    time.Time
+	ChainID      string
+	BondDenom    string
+	MinGasPrices sdk.DecCoins
+	// CometInfoProvider provides the comet info of the blocks produced by
+	// SetupWithConfiguration and NextBlock, so that the logic depending on the
+	// misbehavior of validators, the votes of the last commit or the proposer, e.g.
+	// of x/evidence, x/slashing or x/distribution, can be tested. When nil, the
+	// blocks are produced without comet info.
+	CometInfoProvider CometInfoProvider
+
+	StateChangeRecorder *StateChangeRecorder
 }
 
 func DefaultStartUpConfig() StartupConfig {
@@ -96,10 +180,75 @@ func DefaultStartUpConfig() StartupConfig {
 		ValidatorSet:    CreateRandomValidatorSet,
 		AtGenesis:       false,
 		GenesisAccounts: []GenesisAccount{ga},
-		DB:              coretesting.NewMemDB(),
+		StoreBackend:    dbm.MemDBBackend,
+		BondDenom:       sdk.DefaultBondDenom,
 	}
 }
 
+// OpenDB opens the database of an app set up with the given configuration, see
+// StartupConfig.DB.
+func OpenDB(startupConfig StartupConfig) (corestore.KVStoreWithBatch, error) {
+	if startupConfig.DB != nil {
+		return startupConfig.DB, nil
+	}
+
+	switch startupConfig.StoreBackend {
+	case "", dbm.MemDBBackend:
+		return coretesting.NewMemDB(), nil
+	case dbm.GoLevelDBBackend, dbm.PebbleDBBackend:
+	default:
+		return nil, fmt.Errorf("unsupported store backend %q", startupConfig.StoreBackend)
+	}
+
+	home := startupConfig.HomeDir
+	if home == "" {
+		var err error
+		if home, err = os.MkdirTemp("", "sims-app-"); err != nil {
+			return nil, fmt.Errorf("failed to create app home: %w", err)
+		}
+	}
+
+	db, err := dbm.NewDB("application", startupConfig.StoreBackend, filepath.Join(home, "data"))
+	if err != nil {
+		if startupConfig.HomeDir == "" {
+			_ = os.RemoveAll(home)
+		}
+		return nil, fmt.Errorf("failed to open %s database: %w", startupConfig.StoreBackend, err)
+	}
+
+	if startupConfig.HomeDir == "" {
+		return tempHomeDB{DB: db, home: home}, nil
+	}
+
+	return db, nil
+}
+
+// tempHomeDB is a database removing its temporary home once closed.
+type tempHomeDB struct {
+	dbm.DB
+	home string
+}
+
+func (db tempHomeDB) Close() error {
+	return errors.Join(db.DB.Close(), os.RemoveAll(db.home))
+}
+
+// StartUpConfigWithValidatorSet returns the default startup configuration with a
+// validator set of n validators with the given powers (see CreateValidatorSet).
+// The private validators of the set are exposed in ValidatorSigners, in the order
+// of the validators of the set, so that tests can sign blocks.
+func StartUpConfigWithValidatorSet(n int, powers []int64) (StartupConfig, error) {
+	valSet, signers, err := CreateValidatorSet(n, powers)
+	if err != nil {
+		return StartupConfig{}, err
+	}
+
+	cfg := DefaultStartUpConfig()
+	cfg.ValidatorSet = func() (*cmttypes.ValidatorSet, error) { return valSet, nil }
+	cfg.ValidatorSigners = signers
+	return cfg, nil
+}
+
 // Setup initializes a new runtime.App and can inject values into extraOutputs.
 // It uses SetupWithConfiguration under the hood.
 func Setup(appConfig depinject.Config, extraOutputs ...interface{}) (*runtime.App, error) {
@@ -114,9 +263,11 @@ func SetupAtGenesis(appConfig depinject.Config, extraOutputs ...interface{}) (*r
 	return SetupWithConfiguration(appConfig, cfg, extraOutputs...)
 }
 
-// NextBlock starts a new block.
+// NextBlock starts a new block. When the app was set up with a comet info provider,
+// the block is finalized with the comet info of its height, and the returned context
+// holds the comet info of the new block.
 func NextBlock(app *runtime.App, ctx sdk.Context, jumpTime time.Duration) (sdk.Context, error) {
-	_, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+	_, err := app.FinalizeBlock(finalizeBlockRequest(app, ctx.BlockHeight(), ctx.BlockTime()))
 	if err != nil {
 		return sdk.Context{}, err
 	}
@@ -135,6 +286,9 @@ func NextBlock(app *runtime.App, ctx sdk.Context, jumpTime time.Duration) (sdk.C
 		Height: header.Height,
 		Time:   header.Time,
 	})
+	if info, ok := cometInfo(app, header.Height); ok {
+		newCtx = newCtx.WithCometInfo(info)
+	}
 
 	return newCtx, nil
 }
@@ -154,10 +308,25 @@ func SetupWithConfiguration(appConfig depinject.Config, startupConfig StartupCon
 		return nil, fmt.Errorf("failed to inject dependencies: %w", err)
 	}
 
+	baseAppOptions := []func(*baseapp.BaseApp){baseapp.SetChainID(startupConfig.ChainID)}
+	if !startupConfig.MinGasPrices.Empty() {
+		baseAppOptions = append(baseAppOptions, baseapp.SetMinGasPrices(startupConfig.MinGasPrices.String()))
+	}
 	if startupConfig.BaseAppOption != nil {
-		app = appBuilder.Build(startupConfig.DB, nil, startupConfig.BaseAppOption)
-	} else {
-		app = appBuilder.Build(startupConfig.DB, nil)
+		baseAppOptions = append(baseAppOptions, startupConfig.BaseAppOption)
+	}
+
+	db, err := OpenDB(startupConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	app = appBuilder.Build(db, nil, baseAppOptions...)
+	if startupConfig.CometInfoProvider != nil {
+		cometInfoProviders.Store(app, startupConfig.CometInfoProvider)
+	}
+	if startupConfig.StateChangeRecorder != nil {
+		startupConfig.StateChangeRecorder.register(app)
 	}
 	if err := app.Load(true); err != nil {
 		return nil, fmt.Errorf("failed to load app: %w", err)
@@ -177,8 +346,16 @@ func SetupWithConfiguration(appConfig depinject.Config, startupConfig StartupCon
 		genAccounts = append(genAccounts, ga.GenesisAccount)
 		balances = append(balances, banktypes.Balance{Address: ga.GenesisAccount.GetAddress().String(), Coins: ga.Coins})
 	}
+	if err := authtypes.ValidateGenAccounts(genAccounts); err != nil {
+		return nil, fmt.Errorf("invalid genesis accounts: %w", err)
+	}
 
-	genesisState, err := GenesisStateWithValSet(codec, app.DefaultGenesis(), valSet, genAccounts, balances...)
+	bondDenom := startupConfig.BondDenom
+	if bondDenom == "" {
+		bondDenom = sdk.DefaultBondDenom
+	}
+
+	genesisState, err := genesisStateWithValSet(codec, app.DefaultGenesis(), valSet, bondDenom, genAccounts, balances...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create genesis state: %w", err)
 	}
@@ -191,6 +368,8 @@ func SetupWithConfiguration(appConfig depinject.Config, startupConfig StartupCon
 
 	// init chain will set the validator set and initialize the genesis accounts
 	_, err = app.InitChain(&abci.InitChainRequest{
+		ChainId:         startupConfig.ChainID,
+		Time:            startupConfig.BlockTime,
 		Validators:      []abci.ValidatorUpdate{},
 		ConsensusParams: DefaultConsensusParams,
 		AppStateBytes:   stateBytes,
@@ -199,13 +378,19 @@ func SetupWithConfiguration(appConfig depinject.Config, startupConfig StartupCon
 		return nil, fmt.Errorf("failed to init chain: %w", err)
 	}
 
+	if startupConfig.StateChangeRecorder != nil {
+		if err := startupConfig.StateChangeRecorder.recordGenesis(app); err != nil {
+			return nil, fmt.Errorf("failed to record genesis state changes: %w", err)
+		}
+	}
+
 	// commit genesis changes
 	if !startupConfig.AtGenesis {
-		_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{
-			Height:             app.LastBlockHeight() + 1,
-			NextValidatorsHash: valSet.Hash(),
-		})
-		if err != nil {
+		req := finalizeBlockRequest(app, app.LastBlockHeight()+1, startupConfig.BlockTime)
+		if req.NextValidatorsHash == nil {
+			req.NextValidatorsHash = valSet.Hash()
+		}
+		if _, err = app.FinalizeBlock(req); err != nil {
 			return nil, fmt.Errorf("failed to finalize block: %w", err)
 		}
 	}
@@ -213,6 +398,71 @@ func SetupWithConfiguration(appConfig depinject.Config, startupConfig StartupCon
 	return app, nil
 }
 
+// ExportGenesis exports the state of the app at its last committed height, as
+// the genesis of a chain starting at the next height. The validator set is not
+// exported on its own, it is restored from the exported staking genesis.
+func ExportGenesis(app *runtime.App) (servertypes.ExportedApp, error) {
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+
+	genState, err := app.ModuleManager.ExportGenesis(ctx)
+	if err != nil {
+		return servertypes.ExportedApp{}, fmt.Errorf("failed to export genesis: %w", err)
+	}
+
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, fmt.Errorf("failed to marshal exported genesis: %w", err)
+	}
+
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Height:          app.LastBlockHeight() + 1,
+		ConsensusParams: app.GetConsensusParams(ctx),
+	}, nil
+}
+
+// SetupFromGenesis initializes a new runtime.App from a genesis exported with
+// ExportGenesis, and finalizes its first block at the exported height, so that
+// tests can check that an exported chain can be imported and produce blocks.
+// extraOutputs defines the extra outputs to be assigned by the dependency injector (depinject).
+func SetupFromGenesis(appConfig depinject.Config, genesis servertypes.ExportedApp, extraOutputs ...interface{}) (*runtime.App, error) {
+	var (
+		app        *runtime.App
+		appBuilder *runtime.AppBuilder
+	)
+
+	if err := depinject.Inject(appConfig, append(extraOutputs, &appBuilder)...); err != nil {
+		return nil, fmt.Errorf("failed to inject dependencies: %w", err)
+	}
+
+	app = appBuilder.Build(coretesting.NewMemDB(), nil)
+	if err := app.Load(true); err != nil {
+		return nil, fmt.Errorf("failed to load app: %w", err)
+	}
+
+	initialHeight := genesis.Height
+	if initialHeight < 1 {
+		initialHeight = 1
+	}
+
+	_, err := app.InitChain(&abci.InitChainRequest{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: &genesis.ConsensusParams,
+		AppStateBytes:   genesis.AppState,
+		InitialHeight:   initialHeight,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to init chain: %w", err)
+	}
+
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: initialHeight})
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize block: %w", err)
+	}
+
+	return app, nil
+}
+
 // GenesisStateWithValSet returns a new genesis state with the validator set
 func GenesisStateWithValSet(
 	codec codec.Codec,
@@ -220,17 +470,45 @@ func GenesisStateWithValSet(
 	valSet *cmttypes.ValidatorSet,
 	genAccs []authtypes.GenesisAccount,
 	balances ...banktypes.Balance,
+) (map[string]json.RawMessage, error) {
+	return genesisStateWithValSet(codec, genesisState, valSet, sdk.DefaultBondDenom, genAccs, balances...)
+}
+
+// genesisStateWithValSet returns a new genesis state with the validator set,
+// bonded in the given bond denom.
+func genesisStateWithValSet(
+	codec codec.Codec,
+	genesisState map[string]json.RawMessage,
+	valSet *cmttypes.ValidatorSet,
+	bondDenom string,
+	genAccs []authtypes.GenesisAccount,
+	balances ...banktypes.Balance,
 ) (map[string]json.RawMessage, error) {
 	// set genesis accounts
 	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
 	genesisState[authtypes.ModuleName] = codec.MustMarshalJSON(authGenesis)
 
+	// the validators are delegated to by the first account which is not a module account
+	var delegator sdk.AccAddress
+	for _, acc := range genAccs {
+		if _, ok := acc.(sdk.ModuleAccountI); !ok {
+			delegator = acc.GetAddress()
+			break
+		}
+	}
+	if delegator == nil {
+		return nil, errors.New("no genesis account to delegate to the validators")
+	}
+
 	validators := make([]stakingtypes.Validator, 0, len(valSet.Validators))
 	delegations := make([]stakingtypes.Delegation, 0, len(valSet.Validators))
 
-	bondAmt := sdk.DefaultPowerReduction
-
+	totalBonded := sdkmath.ZeroInt()
 	for _, val := range valSet.Validators {
+		// the bonded tokens of the validator match its voting power
+		bondAmt := sdk.TokensFromConsensusPower(val.VotingPower, sdk.DefaultPowerReduction)
+		totalBonded = totalBonded.Add(bondAmt)
+
 		pk, err := cryptocodec.FromCmtPubKeyInterface(val.PubKey)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert pubkey: %w", err)
@@ -255,12 +533,14 @@ func GenesisStateWithValSet(
 			MinSelfDelegation: sdkmath.ZeroInt(),
 		}
 		validators = append(validators, validator)
-		delegations = append(delegations, stakingtypes.NewDelegation(genAccs[0].GetAddress().String(), sdk.ValAddress(val.Address).String(), sdkmath.LegacyOneDec()))
+		delegations = append(delegations, stakingtypes.NewDelegation(delegator.String(), sdk.ValAddress(val.Address).String(), sdkmath.LegacyOneDec()))
 
 	}
 
 	// set validators and delegations
-	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), validators, delegations)
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = bondDenom
+	stakingGenesis := stakingtypes.NewGenesisState(stakingParams, validators, delegations)
 	genesisState[stakingtypes.ModuleName] = codec.MustMarshalJSON(stakingGenesis)
 
 	totalSupply := sdk.NewCoins()
@@ -269,16 +549,22 @@ func GenesisStateWithValSet(
 		totalSupply = totalSupply.Add(b.Coins...)
 	}
 
-	for range delegations {
-		// add delegated tokens to total supply
-		totalSupply = totalSupply.Add(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))
+	// add delegated tokens to total supply
+	totalSupply = totalSupply.Add(sdk.NewCoin(bondDenom, totalBonded))
+
+	// add bonded amount to bonded pool module account, which may be a genesis account
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	bonded := sdk.NewCoin(bondDenom, totalBonded)
+	found := false
+	for i, b := range balances {
+		if b.Address == bondedPool {
+			balances[i].Coins = b.Coins.Add(bonded)
+			found = true
+		}
+	}
+	if !found {
+		balances = append(balances, banktypes.Balance{Address: bondedPool, Coins: sdk.Coins{bonded}})
 	}
-
-	// add bonded amount to bonded pool module account
-	balances = append(balances, banktypes.Balance{
-		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
-		Coins:   sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, bondAmt)},
-	})
 
 	// update total supply
 	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}, []banktypes.SendEnabled{})
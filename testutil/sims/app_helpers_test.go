@@ -0,0 +1,60 @@
+package sims
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenDB(t *testing.T) {
+	for _, backend := range []dbm.BackendType{dbm.MemDBBackend, dbm.GoLevelDBBackend} {
+		t.Run(string(backend), func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultStartUpConfig()
+			cfg.StoreBackend = backend
+
+			// apps set up from the same configuration do not share their database
+			db1, err := OpenDB(cfg)
+			require.NoError(t, err)
+			db2, err := OpenDB(cfg)
+			require.NoError(t, err)
+
+			require.NoError(t, db1.Set([]byte("key"), []byte("value")))
+			has, err := db2.Has([]byte("key"))
+			require.NoError(t, err)
+			require.False(t, has)
+
+			if backend != dbm.MemDBBackend {
+				// the temporary homes are removed once the databases are closed
+				home := db1.(tempHomeDB).home
+				require.NotEqual(t, home, db2.(tempHomeDB).home)
+				require.DirExists(t, home)
+				require.NoError(t, db1.Close())
+				require.NoDirExists(t, home)
+			}
+			require.NoError(t, db2.Close())
+		})
+	}
+}
+
+func TestOpenDBHomeDir(t *testing.T) {
+	cfg := DefaultStartUpConfig()
+	cfg.StoreBackend = dbm.GoLevelDBBackend
+	cfg.HomeDir = t.TempDir()
+
+	db, err := OpenDB(cfg)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// the given home is kept
+	_, err = os.Stat(filepath.Join(cfg.HomeDir, "data", "application.db"))
+	require.NoError(t, err)
+
+	cfg.StoreBackend = "unknown"
+	_, err = OpenDB(cfg)
+	require.ErrorContains(t, err, `unsupported store backend "unknown"`)
+}
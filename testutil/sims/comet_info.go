@@ -0,0 +1,67 @@
+package sims
+
+import (
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
+	"cosmossdk.io/core/comet"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// CometInfoProvider returns the comet info of the block at the given height, that
+// is the evidence of misbehavior, the votes of the last commit, the proposer address
+// and the next validators hash passed to FinalizeBlock, see StartupConfig.
+type CometInfoProvider func(height int64) comet.Info
+
+// cometInfoProviders holds the comet info providers of the apps set up with one, as
+// NextBlock is only given the app.
+var cometInfoProviders sync.Map // map[*runtime.App]CometInfoProvider
+
+// cometInfo returns the comet info of the block of the app at the given height, and
+// false if the app was set up without a comet info provider.
+func cometInfo(app *runtime.App, height int64) (comet.Info, bool) {
+	provider, ok := cometInfoProviders.Load(app)
+	if !ok {
+		return comet.Info{}, false
+	}
+
+	return provider.(CometInfoProvider)(height), true
+}
+
+// finalizeBlockRequest returns the FinalizeBlock request of the block of the app at
+// the given height and time, holding the comet info of the block.
+func finalizeBlockRequest(app *runtime.App, height int64, blockTime time.Time) *abci.FinalizeBlockRequest {
+	req := &abci.FinalizeBlockRequest{Height: height, Time: blockTime}
+
+	info, ok := cometInfo(app, height)
+	if !ok {
+		return req
+	}
+
+	for _, ev := range info.Evidence {
+		req.Misbehavior = append(req.Misbehavior, abci.Misbehavior{
+			Type:             abci.MisbehaviorType(ev.Type),
+			Validator:        abci.Validator{Address: ev.Validator.Address, Power: ev.Validator.Power},
+			Height:           ev.Height,
+			Time:             ev.Time,
+			TotalVotingPower: ev.TotalVotingPower,
+		})
+	}
+
+	req.DecidedLastCommit = abci.CommitInfo{Round: info.LastCommit.Round}
+	for _, vote := range info.LastCommit.Votes {
+		req.DecidedLastCommit.Votes = append(req.DecidedLastCommit.Votes, abci.VoteInfo{
+			Validator:   abci.Validator{Address: vote.Validator.Address, Power: vote.Validator.Power},
+			BlockIdFlag: cmtproto.BlockIDFlag(vote.BlockIDFlag),
+		})
+	}
+
+	req.ProposerAddress = info.ProposerAddress
+	req.NextValidatorsHash = info.ValidatorsHash
+
+	return req
+}
@@ -0,0 +1,91 @@
+package sims
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewBaseGenesisAccount returns a genesis account of a base account funded with
+// the coins.
+func NewBaseGenesisAccount(address sdk.AccAddress, coins sdk.Coins) GenesisAccount {
+	return GenesisAccount{
+		GenesisAccount: authtypes.NewBaseAccountWithAddress(address),
+		Coins:          coins,
+	}
+}
+
+// NewContinuousVestingGenesisAccount returns a genesis account of a continuous
+// vesting account funded with the coins, of which the vesting coins vest linearly
+// from the start time to the end time.
+func NewContinuousVestingGenesisAccount(address sdk.AccAddress, coins, vesting sdk.Coins, start, end time.Time) (GenesisAccount, error) {
+	if err := validateVestingCoins(coins, vesting); err != nil {
+		return GenesisAccount{}, err
+	}
+
+	acc, err := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(address), vesting, start.Unix(), end.Unix())
+	if err != nil {
+		return GenesisAccount{}, fmt.Errorf("invalid continuous vesting account %s: %w", address, err)
+	}
+
+	return GenesisAccount{GenesisAccount: acc, Coins: coins}, nil
+}
+
+// NewDelayedVestingGenesisAccount returns a genesis account of a delayed vesting
+// account funded with the coins, of which the vesting coins all vest at the end
+// time.
+func NewDelayedVestingGenesisAccount(address sdk.AccAddress, coins, vesting sdk.Coins, end time.Time) (GenesisAccount, error) {
+	if err := validateVestingCoins(coins, vesting); err != nil {
+		return GenesisAccount{}, err
+	}
+
+	acc, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(address), vesting, end.Unix())
+	if err != nil {
+		return GenesisAccount{}, fmt.Errorf("invalid delayed vesting account %s: %w", address, err)
+	}
+
+	return GenesisAccount{GenesisAccount: acc, Coins: coins}, nil
+}
+
+// NewPeriodicVestingGenesisAccount returns a genesis account of a periodic vesting
+// account funded with the coins, of which the amounts of the periods vest at the
+// end of each period, the first one starting at the start time.
+func NewPeriodicVestingGenesisAccount(address sdk.AccAddress, coins sdk.Coins, start time.Time, periods vestingtypes.Periods) (GenesisAccount, error) {
+	vesting := periods.TotalAmount()
+	if err := validateVestingCoins(coins, vesting); err != nil {
+		return GenesisAccount{}, err
+	}
+
+	acc, err := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(address), vesting, start.Unix(), periods)
+	if err != nil {
+		return GenesisAccount{}, fmt.Errorf("invalid periodic vesting account %s: %w", address, err)
+	}
+
+	return GenesisAccount{GenesisAccount: acc, Coins: coins}, nil
+}
+
+// NewModuleGenesisAccount returns a genesis account of the account of the module
+// with the given name and permissions, funded with the coins.
+func NewModuleGenesisAccount(name string, coins sdk.Coins, permissions ...string) GenesisAccount {
+	return GenesisAccount{
+		GenesisAccount: authtypes.NewEmptyModuleAccount(name, permissions...),
+		Coins:          coins,
+	}
+}
+
+// validateVestingCoins checks that the balance of a vesting account covers its
+// vesting coins, as a vesting account can't vest coins it does not hold.
+func validateVestingCoins(coins, vesting sdk.Coins) error {
+	if vesting.IsZero() {
+		return errors.New("no vesting coins")
+	}
+	if !coins.IsAllGTE(vesting) {
+		return fmt.Errorf("vesting coins %s exceed the balance %s", vesting, coins)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package sims
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StateChange is a change of a key of a store, which is either set to a value or deleted.
+type StateChange struct {
+	Key    []byte
+	Value  []byte
+	Delete bool
+}
+
+// String implements fmt.Stringer.
+func (c StateChange) String() string {
+	if c.Delete {
+		return fmt.Sprintf("delete %X", c.Key)
+	}
+
+	return fmt.Sprintf("set %X = %X", c.Key, c.Value)
+}
+
+var _ storetypes.ABCIListener = (*StateChangeRecorder)(nil)
+
+// StateChangeRecorder records the state changes of a test app by store, at
+// genesis and in each block it commits, so that tests can assert them. It is
+// enabled by setting StartupConfig.StateChangeRecorder.
+type StateChangeRecorder struct {
+	genesis map[string][]StateChange
+	blocks  map[int64]map[string][]StateChange
+}
+
+// NewStateChangeRecorder returns a new, empty StateChangeRecorder.
+func NewStateChangeRecorder() *StateChangeRecorder {
+	return &StateChangeRecorder{
+		genesis: make(map[string][]StateChange),
+		blocks:  make(map[int64]map[string][]StateChange),
+	}
+}
+
+// GenesisStateChanges returns the state changes of the given store made by
+// InitChain, sorted by key.
+func (r *StateChangeRecorder) GenesisStateChanges(storeKey string) []StateChange {
+	return r.genesis[storeKey]
+}
+
+// BlockStateChanges returns the state changes of the given store committed at
+// the given height, in write order. The changes committed at the initial height
+// include those of InitChain.
+func (r *StateChangeRecorder) BlockStateChanges(height int64, storeKey string) []StateChange {
+	return r.blocks[height][storeKey]
+}
+
+// Diff returns the net state changes of the given store committed in the blocks
+// after fromHeight up to toHeight included, sorted by key. Only the last change
+// of each key is returned.
+func (r *StateChangeRecorder) Diff(fromHeight, toHeight int64, storeKey string) []StateChange {
+	changes := make(map[string]StateChange)
+	for height := fromHeight + 1; height <= toHeight; height++ {
+		for _, change := range r.blocks[height][storeKey] {
+			changes[string(change.Key)] = change
+		}
+	}
+
+	return sortedStateChanges(changes)
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (r *StateChangeRecorder) ListenFinalizeBlock(context.Context, abci.FinalizeBlockRequest, abci.FinalizeBlockResponse) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, recording the state changes
+// committed at the height of the block.
+func (r *StateChangeRecorder) ListenCommit(ctx context.Context, _ abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	changes := make(map[string][]StateChange)
+	for _, pair := range changeSet {
+		changes[pair.StoreKey] = append(changes[pair.StoreKey], StateChange{
+			Key:    pair.Key,
+			Value:  pair.Value,
+			Delete: pair.Delete,
+		})
+	}
+	r.blocks[height] = changes
+
+	return nil
+}
+
+// register makes the recorder listen to the blocks committed by the app. It
+// must be called before the app is loaded.
+func (r *StateChangeRecorder) register(app *runtime.App) {
+	var keys []storetypes.StoreKey
+	for _, key := range app.GetStoreKeys() {
+		if _, ok := key.(*storetypes.KVStoreKey); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	app.CommitMultiStore().AddListeners(keys)
+	app.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: []storetypes.ABCIListener{r},
+		StopNodeOnErr: true,
+	})
+}
+
+// recordGenesis records the state of the stores of the app after InitChain,
+// which is their state changes from an empty state.
+func (r *StateChangeRecorder) recordGenesis(app *runtime.App) error {
+	ctx := app.GetContextForFinalizeBlock(nil)
+	for _, key := range app.GetStoreKeys() {
+		if _, ok := key.(*storetypes.KVStoreKey); !ok {
+			continue
+		}
+
+		it := ctx.KVStore(key).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			r.genesis[key.Name()] = append(r.genesis[key.Name()], StateChange{
+				Key:   bytes.Clone(it.Key()),
+				Value: bytes.Clone(it.Value()),
+			})
+		}
+
+		if err := it.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sortedStateChanges(changes map[string]StateChange) []StateChange {
+	sorted := make([]StateChange, 0, len(changes))
+	for _, change := range changes {
+		sorted = append(sorted, change)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Key, sorted[j].Key) < 0
+	})
+
+	return sorted
+}
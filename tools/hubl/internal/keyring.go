@@ -140,7 +140,7 @@ func KeyringCmd(chainName string) *cobra.Command {
 		keys.RenameKeyCommand(),
 		keys.ShowKeysCmd(),
 	)
-	keyringCmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	keyringCmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|enclave|file|kwallet|pass|test|memory)")
 	keyringCmd.PersistentFlags().String(flags.FlagOutput, flags.OutputFormatText, "Output format (text|json)")
 
 	return keyringCmd
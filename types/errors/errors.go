@@ -146,4 +146,13 @@ var (
 	// ErrTxTimeout defines an error for when a tx is rejected out due to an
 	// explicitly set timeout timestamp.
 	ErrTxTimeout = errorsmod.Register(RootCodespace, 42, "tx timeout")
+
+	// ErrTxReplaced defines an error for when a pending tx has been replaced in
+	// the mempool by another tx from the same sender and with the same sequence.
+	ErrTxReplaced = errorsmod.Register(RootCodespace, 43, "tx replaced in mempool")
+
+	// ErrTxReplacementUnderpriced defines an error for when a tx does not pay
+	// enough fees to replace a pending tx from the same sender and with the same
+	// sequence.
+	ErrTxReplacementUnderpriced = errorsmod.Register(RootCodespace, 44, "tx replacement underpriced")
 )
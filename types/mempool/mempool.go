@@ -27,6 +27,28 @@ type Mempool interface {
 	Remove(sdk.Tx) error
 }
 
+// ReplaceableMempool defines an app-side mempool which supports replacing a
+// pending transaction by another transaction from the same sender and with the
+// same sequence (nonce), e.g. a transaction paying higher fees.
+type ReplaceableMempool interface {
+	Mempool
+
+	// HasConflict reports whether the mempool holds a transaction, different
+	// from tx, from the same sender and with the same sequence. That is, whether
+	// tx would replace a pending transaction or has been replaced by one.
+	HasConflict(tx sdk.Tx) bool
+
+	// CheckReplacement returns an error if tx conflicts with a pending
+	// transaction which it is not allowed to replace, so that the replacement
+	// can be rejected before it is validated.
+	CheckReplacement(ctx context.Context, tx sdk.Tx) error
+
+	// PendingBefore returns the pending transactions from the sender of tx with
+	// a lower sequence than tx, ordered by sequence. They are the transactions
+	// the state tx is validated against must include.
+	PendingBefore(tx sdk.Tx) []sdk.Tx
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
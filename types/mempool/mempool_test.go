@@ -1,6 +1,7 @@
 package mempool_test
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -53,6 +54,7 @@ type testTx struct {
 	priority int64
 	nonce    uint64
 	address  sdk.AccAddress
+	fee      sdk.Coins
 	// useful for debugging
 	strAddress string
 }
@@ -73,6 +75,7 @@ func (tx testTx) GetSignaturesV2() (res []txsigning.SignatureV2, err error) {
 
 var (
 	_ sdk.Tx                  = (*testTx)(nil)
+	_ sdk.FeeTx               = (*testTx)(nil)
 	_ signing.SigVerifiableTx = (*testTx)(nil)
 	_ cryptotypes.PubKey      = (*testPubKey)(nil)
 )
@@ -82,9 +85,17 @@ func (tx testTx) Bytes() []byte {
 }
 
 func (tx testTx) Hash() [32]byte {
-	return [32]byte{}
+	return sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s", tx.id, tx.String(), tx.fee)))
 }
 
+func (tx testTx) GetGas() uint64 { return 0 }
+
+func (tx testTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx testTx) FeePayer() []byte { return tx.address }
+
+func (tx testTx) FeeGranter() []byte { return nil }
+
 func (tx testTx) GetGasLimit() (uint64, error) {
 	return 0, nil
 }
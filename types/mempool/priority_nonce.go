@@ -9,12 +9,16 @@ import (
 
 	"github.com/huandu/skiplist"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
-	_ Mempool  = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator = (*PriorityNonceIterator[int64])(nil)
+	_ ReplaceableMempool = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator           = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -29,7 +33,11 @@ type (
 
 		// TxReplacement is a callback to be called when duplicated transaction nonce
 		// detected during mempool insert. An application can define a transaction
-		// replacement rule based on tx priority or certain transaction fields.
+		// replacement rule based on tx priority or certain transaction fields,
+		// e.g. a fee bump through NewFeeBumpTxReplacement.
+		//
+		// Setting a rule enables the replacement of pending transactions submitted
+		// through CheckTx, see HasConflict.
 		TxReplacement func(op, np C, oTx, nTx sdk.Tx) bool
 
 		// MaxTx sets the maximum number of transactions allowed in the mempool with
@@ -254,14 +262,8 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	// changes.
 	sk := txMeta[C]{nonce: nonce, sender: sender}
 	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
-			return fmt.Errorf(
-				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
-				oldScore.priority,
-				priority,
-				senderIndex.Get(key).Value.(sdk.Tx),
-				tx,
-			)
+		if err := mp.checkReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx); err != nil {
+			return err
 		}
 
 		mp.priorityIndex.Remove(txMeta[C]{
@@ -498,6 +500,167 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	return nil
 }
 
+// HasConflict reports whether the mempool holds a transaction, different from
+// tx, from the same sender and with the same nonce. Transactions are compared
+// by hash.
+//
+// HasConflict always returns false when no TxReplacement rule is configured, as
+// the mempool then does not support replacing pending transactions through
+// CheckTx.
+func (mp *PriorityNonceMempool[C]) HasConflict(tx sdk.Tx) bool {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	_, _, conflict := mp.conflict(tx)
+	return conflict != nil
+}
+
+// CheckReplacement returns an error if tx conflicts with a pending transaction
+// which it is not allowed to replace by the TxReplacement rule, as Insert would.
+// It lets CheckTx reject an underpriced replacement before validating it.
+func (mp *PriorityNonceMempool[C]) CheckReplacement(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	sender, nonce, conflict := mp.conflict(tx)
+	if conflict == nil {
+		return nil
+	}
+
+	oldScore := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]
+	return mp.checkReplacement(oldScore.priority, mp.cfg.TxPriority.GetTxPriority(ctx, tx), conflict, tx)
+}
+
+// PendingBefore returns the pending transactions from the sender of tx with a
+// lower sequence than tx, ordered by sequence. Unordered transactions, which
+// don't use sequences, are neither returned nor preceded by any transaction.
+func (mp *PriorityNonceMempool[C]) PendingBefore(tx sdk.Tx) []sdk.Tx {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if unordered, ok := tx.(sdk.TxWithUnordered); ok && unordered.GetUnordered() {
+		return nil
+	}
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil || len(sigs) == 0 {
+		return nil
+	}
+
+	senderIndex, ok := mp.senderIndices[sigs[0].Signer.String()]
+	if !ok {
+		return nil
+	}
+
+	// the sender index is ordered by nonce
+	var pending []sdk.Tx
+	for e := senderIndex.Front(); e != nil; e = e.Next() {
+		if e.Key().(txMeta[C]).nonce >= sigs[0].Sequence {
+			break
+		}
+
+		pendingTx := e.Value.(sdk.Tx)
+		if unordered, ok := pendingTx.(sdk.TxWithUnordered); ok && unordered.GetUnordered() {
+			continue
+		}
+		pending = append(pending, pendingTx)
+	}
+
+	return pending
+}
+
+// conflict returns the sender and nonce of tx, along with the pending transaction
+// it conflicts with, nil if none or if no TxReplacement rule is configured. The
+// caller must hold the mempool lock.
+func (mp *PriorityNonceMempool[C]) conflict(tx sdk.Tx) (sender string, nonce uint64, conflict sdk.Tx) {
+	if mp.cfg.TxReplacement == nil {
+		return "", 0, nil
+	}
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil || len(sigs) == 0 {
+		return "", 0, nil
+	}
+
+	sig := sigs[0]
+	sender = sig.Signer.String()
+	nonce = sig.Sequence
+
+	// if it's an unordered tx, we use the gas instead of the nonce
+	if unordered, ok := tx.(sdk.TxWithUnordered); ok && unordered.GetUnordered() {
+		gasLimit, err := unordered.GetGasLimit()
+		if err != nil {
+			return "", 0, nil
+		}
+		nonce = gasLimit
+	}
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return "", 0, nil
+	}
+
+	elem := senderIndex.Get(txMeta[C]{nonce: nonce, sender: sender})
+	if elem == nil || elem.Value.(sdk.Tx).Hash() == tx.Hash() {
+		return "", 0, nil
+	}
+
+	return sender, nonce, elem.Value.(sdk.Tx)
+}
+
+// checkReplacement returns an error if the pending transaction oTx is not allowed
+// to be replaced by nTx according to the TxReplacement rule.
+func (mp *PriorityNonceMempool[C]) checkReplacement(op, np C, oTx, nTx sdk.Tx) error {
+	if mp.cfg.TxReplacement == nil || mp.cfg.TxReplacement(op, np, oTx, nTx) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		sdkerrors.ErrTxReplacementUnderpriced,
+		"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
+		op,
+		np,
+		oTx,
+		nTx,
+	)
+}
+
+// NewFeeBumpTxReplacement returns a TxReplacement rule allowing a pending
+// transaction to be replaced only by a transaction paying, for every denom of
+// the pending transaction fee, strictly more and at least bumpPercent percent
+// more fees. A pending transaction without fees can be replaced by any
+// transaction paying fees. Transactions not implementing sdk.FeeTx are never
+// replaced.
+func NewFeeBumpTxReplacement[C comparable](bumpPercent uint64) func(op, np C, oTx, nTx sdk.Tx) bool {
+	return func(_, _ C, oTx, nTx sdk.Tx) bool {
+		oFeeTx, ok := oTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+		nFeeTx, ok := nTx.(sdk.FeeTx)
+		if !ok {
+			return false
+		}
+
+		oldFee, newFee := oFeeTx.GetFee(), nFeeTx.GetFee()
+		if oldFee.IsZero() {
+			return !newFee.IsZero()
+		}
+
+		bump := sdkmath.NewIntFromUint64(100 + bumpPercent)
+		for _, coin := range oldFee {
+			// round the minimum fee up so that a bump is never lost to truncation
+			minAmount := coin.Amount.Mul(bump).AddRaw(99).QuoRaw(100)
+			newAmount := newFee.AmountOf(coin.Denom)
+			if newAmount.LTE(coin.Amount) || newAmount.LT(minAmount) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
 func IsEmpty[C comparable](mempool Mempool) error {
 	mp := mempool.(*PriorityNonceMempool[C])
 	if mp.priorityIndex.Len() != 0 {
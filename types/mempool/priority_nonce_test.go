@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/log"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -975,3 +976,105 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestFeeBumpTxReplacement(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	fee := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			TxReplacement:   mempool.NewFeeBumpTxReplacement[int64](10),
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	original := testTx{id: 0, nonce: 1, address: sa, fee: fee(100)}
+	require.False(t, mp.HasConflict(original))
+	require.NoError(t, mp.Insert(ctx, original))
+	require.False(t, mp.HasConflict(original))
+
+	// same sequence, other sender
+	require.False(t, mp.HasConflict(testTx{id: 1, nonce: 1, address: sb, fee: fee(100)}))
+	// same sender, other sequence
+	require.False(t, mp.HasConflict(testTx{id: 2, nonce: 2, address: sa, fee: fee(100)}))
+
+	testCases := []struct {
+		name string
+		tx   testTx
+	}{
+		{"same fee", testTx{id: 3, nonce: 1, address: sa, fee: fee(100)}},
+		{"lower fee", testTx{id: 4, nonce: 1, address: sa, fee: fee(90)}},
+		{"bump below percentage", testTx{id: 5, nonce: 1, address: sa, fee: fee(109)}},
+		{"other denom", testTx{id: 6, nonce: 1, address: sa, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, mp.HasConflict(tc.tx))
+			err := mp.Insert(ctx, tc.tx)
+			require.ErrorIs(t, err, sdkerrors.ErrTxReplacementUnderpriced)
+			require.Equal(t, 1, mp.CountTx())
+			require.Equal(t, original, mp.Select(ctx, nil).Tx())
+		})
+	}
+
+	replacement := testTx{id: 7, nonce: 1, address: sa, fee: fee(110)}
+	require.True(t, mp.HasConflict(replacement))
+	require.NoError(t, mp.CheckReplacement(ctx, replacement))
+	require.ErrorIs(t, mp.CheckReplacement(ctx, testTx{id: 3, nonce: 1, address: sa, fee: fee(100)}), sdkerrors.ErrTxReplacementUnderpriced)
+	// a tx without conflict is not subject to the replacement rule
+	require.NoError(t, mp.CheckReplacement(ctx, testTx{id: 2, nonce: 2, address: sa}))
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, replacement, mp.Select(ctx, nil).Tx())
+
+	// the original tx is now the conflicting one
+	require.True(t, mp.HasConflict(original))
+	require.False(t, mp.HasConflict(replacement))
+	require.ErrorIs(t, mp.Insert(ctx, original), sdkerrors.ErrTxReplacementUnderpriced)
+
+	// a pending tx without fees can be replaced by any tx paying fees
+	free := testTx{id: 8, nonce: 3, address: sa}
+	require.NoError(t, mp.Insert(ctx, free))
+	require.ErrorIs(t, mp.Insert(ctx, testTx{id: 9, nonce: 3, address: sa}), sdkerrors.ErrTxReplacementUnderpriced)
+	require.NoError(t, mp.Insert(ctx, testTx{id: 10, nonce: 3, address: sa, fee: fee(1)}))
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestPendingBefore(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	mp := mempool.DefaultPriorityMempool()
+	txs := []testTx{
+		{id: 0, nonce: 2, address: sa},
+		{id: 1, nonce: 0, address: sa},
+		{id: 2, nonce: 1, address: sa},
+		{id: 3, nonce: 0, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	require.Empty(t, mp.PendingBefore(testTx{id: 4, nonce: 0, address: sa}))
+	require.Equal(t, []sdk.Tx{txs[1], txs[2]}, mp.PendingBefore(testTx{id: 4, nonce: 2, address: sa}))
+	require.Equal(t, []sdk.Tx{txs[1], txs[2], txs[0]}, mp.PendingBefore(testTx{id: 4, nonce: 5, address: sa}))
+	require.Equal(t, []sdk.Tx{txs[3]}, mp.PendingBefore(testTx{id: 4, nonce: 1, address: sb}))
+	// no pending tx from the sender
+	require.Empty(t, mp.PendingBefore(testTx{id: 4, nonce: 1, address: sc}))
+}
+
+func TestHasConflict_NoTxReplacement(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	mp := mempool.DefaultPriorityMempool()
+	require.NoError(t, mp.Insert(ctx, testTx{id: 0, nonce: 1, address: sa}))
+	require.False(t, mp.HasConflict(testTx{id: 1, nonce: 1, address: sa}))
+	require.NoError(t, mp.CheckReplacement(ctx, testTx{id: 1, nonce: 1, address: sa}))
+}
@@ -27,6 +27,15 @@ func WithCollectionPaginationTriplePrefix[K1, K2, K3 any](prefix K1) func(o *Col
 	}
 }
 
+// WithCollectionPaginationTripleSuperPrefix applies a super prefix, made of the first two keys, to a
+// collection, whose key is a collection.Triple, being paginated that needs prefixing.
+func WithCollectionPaginationTripleSuperPrefix[K1, K2, K3 any](k1 K1, k2 K2) func(o *CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+	return func(o *CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+		prefix := collections.TripleSuperPrefix[K1, K2, K3](k1, k2)
+		o.Prefix = &prefix
+	}
+}
+
 // CollectionsPaginateOptions provides extra options for pagination in collections.
 type CollectionsPaginateOptions[K any] struct {
 	// Prefix allows to optionally set a prefix for the pagination.
@@ -62,6 +71,33 @@ func CollectionPaginate[K, V any, C Collection[K, V], T any](
 	)
 }
 
+// CollectPage returns the values of the page of the collection requested by pageReq.
+// It honors key and offset based pagination, reverse ordering and count total, in the same
+// way as CollectionPaginate.
+func CollectPage[K, V any, C Collection[K, V]](
+	ctx context.Context,
+	coll C,
+	pageReq *PageRequest,
+	opts ...func(opt *CollectionsPaginateOptions[K]),
+) ([]V, *PageResponse, error) {
+	return CollectionPaginate(ctx, coll, pageReq, func(_ K, value V) (V, error) {
+		return value, nil
+	}, opts...)
+}
+
+// CollectKeysPage returns the keys of the page of the collection requested by pageReq.
+// It is meant to be used with collections which only hold keys, such as KeySets and indexes.
+func CollectKeysPage[K, V any, C Collection[K, V]](
+	ctx context.Context,
+	coll C,
+	pageReq *PageRequest,
+	opts ...func(opt *CollectionsPaginateOptions[K]),
+) ([]K, *PageResponse, error) {
+	return CollectionPaginate(ctx, coll, pageReq, func(key K, _ V) (K, error) {
+		return key, nil
+	}, opts...)
+}
+
 // CollectionFilteredPaginate works in the same way as CollectionPaginate but allows to filter
 // results using a predicateFunc.
 // A nil predicateFunc means no filtering is applied and results are collected as is.
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -161,6 +162,53 @@ func TestCollectionPagination(t *testing.T) {
 	}
 }
 
+func TestCollectPage(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	m := collections.NewMap(sb, collections.NewPrefix(0), "map", collections.Uint64Key, collections.StringValue)
+	ks := collections.NewKeySet(sb, collections.NewPrefix(1), "keyset", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key))
+
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, m.Set(ctx, i, fmt.Sprintf("v%d", i)))
+		require.NoError(t, ks.Set(ctx, collections.Join3("a", "b", i)))
+		require.NoError(t, ks.Set(ctx, collections.Join3("a", "c", i)))
+	}
+
+	// offset based, with count total
+	values, pageRes, err := CollectPage(ctx, m, &PageRequest{Offset: 2, Limit: 3, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"v2", "v3", "v4"}, values)
+	require.Equal(t, uint64(10), pageRes.Total)
+
+	// key based, continuing from the previous page
+	values, pageRes, err = CollectPage(ctx, m, &PageRequest{Key: pageRes.NextKey, Limit: 3})
+	require.NoError(t, err)
+	require.Equal(t, []string{"v5", "v6", "v7"}, values)
+	require.NotNil(t, pageRes.NextKey)
+
+	// reverse
+	values, _, err = CollectPage(ctx, m, &PageRequest{Limit: 2, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"v9", "v8"}, values)
+
+	// keys, with a super prefix
+	keys, pageRes, err := CollectKeysPage(ctx, ks, &PageRequest{Limit: 4, CountTotal: true},
+		WithCollectionPaginationTripleSuperPrefix[string, string, uint64]("a", "c"))
+	require.NoError(t, err)
+	require.Len(t, keys, 4)
+	require.Equal(t, uint64(10), pageRes.Total)
+	for i, key := range keys {
+		require.Equal(t, collections.Join3("a", "c", uint64(i)), key)
+	}
+
+	keys, pageRes, err = CollectKeysPage(ctx, ks, &PageRequest{Key: pageRes.NextKey, Limit: 10},
+		WithCollectionPaginationTripleSuperPrefix[string, string, uint64]("a", "c"))
+	require.NoError(t, err)
+	require.Len(t, keys, 6)
+	require.Equal(t, collections.Join3("a", "c", uint64(4)), keys[0])
+	require.Nil(t, pageRes.NextKey)
+}
+
 type testStore struct {
 	db store.KVStoreWithBatch
 }
@@ -1,6 +1,9 @@
 package simulation
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 // Config contains the necessary configuration flags for the simulator
 type Config struct {
@@ -11,6 +14,7 @@ type Config struct {
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ExportCoveragePath string // custom file path to save the simulation coverage report JSON
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
@@ -22,6 +26,9 @@ type Config struct {
 	Lean   bool // lean simulation log output
 	Commit bool // have the simulation commit
 
+	Modules       []string           // modules whose operations and invariants are run; all the modules if empty
+	ModuleWeights map[string]float64 // multipliers of the weights of the operations of the given modules; 0 disables them
+
 	DBBackend   string // custom db backend type
 	BlockMaxGas int64  // custom max gas for block
 	FuzzSeed    []byte
@@ -33,6 +40,25 @@ func (c Config) shallowCopy() Config {
 	return c
 }
 
+// ModuleWeight returns the multiplier of the weights of the operations of a module. It is 0 if the
+// simulation targets other modules, and defaults to 1.
+func (c Config) ModuleWeight(moduleName string) float64 {
+	if !c.IsTargetModule(moduleName) {
+		return 0
+	}
+
+	if weight, ok := c.ModuleWeights[moduleName]; ok {
+		return weight
+	}
+
+	return 1
+}
+
+// IsTargetModule returns true if the simulation runs the operations and invariants of a module.
+func (c Config) IsTargetModule(moduleName string) bool {
+	return len(c.Modules) == 0 || slices.Contains(c.Modules, moduleName)
+}
+
 // With sets the values of t, seed, and fuzzSeed in a copy of the Config and returns the copy.
 func (c Config) With(tb testing.TB, seed int64, fuzzSeed []byte) Config {
 	tb.Helper()
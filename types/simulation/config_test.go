@@ -0,0 +1,44 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigModuleWeight(t *testing.T) {
+	specs := map[string]struct {
+		config    Config
+		expWeight float64
+		expTarget bool
+	}{
+		"all modules by default": {
+			expWeight: 1,
+			expTarget: true,
+		},
+		"target module": {
+			config:    Config{Modules: []string{"bank"}},
+			expWeight: 1,
+			expTarget: true,
+		},
+		"other module targeted": {
+			config:    Config{Modules: []string{"gov"}},
+			expWeight: 0,
+		},
+		"weighted module": {
+			config:    Config{ModuleWeights: map[string]float64{"bank": 2}},
+			expWeight: 2,
+			expTarget: true,
+		},
+		"weighted module not targeted": {
+			config:    Config{Modules: []string{"gov"}, ModuleWeights: map[string]float64{"bank": 2}},
+			expWeight: 0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.expWeight, spec.config.ModuleWeight("bank"))
+			assert.Equal(t, spec.expTarget, spec.config.IsTargetModule("bank"))
+		})
+	}
+}
@@ -105,6 +105,14 @@ func SenderIsAccountsModule(ctx context.Context) bool {
 // returns nil.
 func Funds(ctx context.Context) sdk.Coins { return implementation.Funds(ctx) }
 
+// LegacySequence returns the sequence of the legacy x/auth account which is being
+// migrated to this account. It reports false if the account is not being
+// initialized as part of a migration. Accounts tracking a sequence should
+// preserve it, so that transactions signed for the legacy account can't be replayed.
+func LegacySequence(ctx context.Context) (uint64, bool) {
+	return implementation.LegacySequence(ctx)
+}
+
 func ExecModule[MsgResp, Msg transaction.Msg](ctx context.Context, msg Msg) (resp MsgResp, err error) {
 	untyped, err := implementation.ExecModule(ctx, msg)
 	if err != nil {
@@ -29,3 +29,7 @@ func NewMockContext(
 func SetSender(ctx context.Context, sender []byte) context.Context {
 	return implementation.SetSender(ctx, sender)
 }
+
+func SetLegacySequence(ctx context.Context, sequence uint64) context.Context {
+	return implementation.WithLegacySequence(ctx, sequence)
+}
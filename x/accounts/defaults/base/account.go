@@ -75,8 +75,14 @@ type Account struct {
 }
 
 func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
-	if msg.InitSequence != 0 {
-		err := a.Sequence.Set(ctx, msg.InitSequence)
+	initSequence := msg.InitSequence
+	// when migrating from a legacy account, the sequence can't go backwards,
+	// otherwise transactions signed for the legacy account could be replayed.
+	if legacySequence, ok := accountstd.LegacySequence(ctx); ok && legacySequence > initSequence {
+		initSequence = legacySequence
+	}
+	if initSequence != 0 {
+		err := a.Sequence.Set(ctx, initSequence)
 		if err != nil {
 			return nil, err
 		}
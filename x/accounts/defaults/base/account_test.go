@@ -79,6 +79,36 @@ func TestInit(t *testing.T) {
 	}
 }
 
+func TestInitWithLegacySequence(t *testing.T) {
+	testcases := []struct {
+		name           string
+		initSequence   uint64
+		legacySequence uint64
+		expSequence    uint64
+	}{
+		{"legacy sequence is kept", 0, 10, 10},
+		{"greater init sequence is kept", 20, 10, 20},
+		{"lower init sequence is ignored", 5, 10, 10},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			baseAcc := setupBaseAccount(t, ss)
+			ctx = accountstd.SetLegacySequence(ctx, tc.legacySequence)
+			_, err := baseAcc.Init(ctx, &v1.MsgInit{
+				PubKey:       toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				InitSequence: tc.initSequence,
+			})
+			require.NoError(t, err)
+
+			sequence, err := baseAcc.Sequence.Peek(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expSequence, sequence)
+		})
+	}
+}
+
 func TestSwapKey(t *testing.T) {
 	ctx, ss := newMockContext(t)
 	baseAcc := setupBaseAccount(t, ss)
@@ -102,6 +102,10 @@ func TestContinousAccountUndelegate(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// the undelegation is tracked once the unbonding completes
+	err = acc.checkUnbondingEntriesMature(sdkCtx)
+	require.NoError(t, err)
+
 	delLocking, err = acc.DelegatedLocking.Get(ctx, "test")
 	require.NoError(t, err)
 	require.True(t, delLocking.Equal(math.ZeroInt()))
@@ -137,6 +141,10 @@ func TestContinousAccountUndelegate(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// the undelegation is tracked once the unbonding completes
+	err = acc.checkUnbondingEntriesMature(sdkCtx)
+	require.NoError(t, err)
+
 	delLocking, err = acc.DelegatedLocking.Get(ctx, "test")
 	require.NoError(t, err)
 	require.True(t, delLocking.Equal(math.NewInt(2)))
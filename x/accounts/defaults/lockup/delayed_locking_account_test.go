@@ -101,6 +101,10 @@ func TestDelayedAccountUndelegate(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// the undelegation is tracked once the unbonding completes
+	err = acc.checkUnbondingEntriesMature(sdkCtx)
+	require.NoError(t, err)
+
 	delLocking, err = acc.DelegatedLocking.Get(ctx, "test")
 	require.NoError(t, err)
 	require.True(t, delLocking.Equal(math.ZeroInt()))
@@ -136,6 +140,10 @@ func TestDelayedAccountUndelegate(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// the undelegation is tracked once the unbonding completes
+	err = acc.checkUnbondingEntriesMature(sdkCtx)
+	require.NoError(t, err)
+
 	delFree, err = acc.DelegatedFree.Get(ctx, "test")
 	require.NoError(t, err)
 	require.True(t, delFree.Equal(math.NewInt(2)))
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
 	collcodec "cosmossdk.io/collections/codec"
@@ -22,6 +24,7 @@ import (
 	distrtypes "cosmossdk.io/x/distribution/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -36,6 +39,7 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	UnbondEntriesPrefix    = collections.NewPrefix(8)
 )
 
 var (
@@ -55,6 +59,7 @@ func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
 		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
 		WithdrawedCoins:  collections.NewMap(d.SchemaBuilder, WithdrawedCoinsPrefix, "withdrawed_coins", collections.StringKey, sdk.IntValue),
+		UnbondEntries:    collections.NewMap(d.SchemaBuilder, UnbondEntriesPrefix, "unbond_entries", collections.StringKey, codec.CollValue[lockuptypes.UnbondingEntries](d.LegacyStateCodec)),
 		addressCodec:     d.AddressCodec,
 		headerService:    d.Environment.HeaderService,
 		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
@@ -70,8 +75,11 @@ type BaseLockup struct {
 	DelegatedFree    collections.Map[string, math.Int]
 	DelegatedLocking collections.Map[string, math.Int]
 	WithdrawedCoins  collections.Map[string, math.Int]
-	addressCodec     address.Codec
-	headerService    header.Service
+	// UnbondEntries are the unbonding operations of the account by validator address, which are
+	// accounted for as undelegated once completed.
+	UnbondEntries collections.Map[string, lockuptypes.UnbondingEntries]
+	addressCodec  address.Codec
+	headerService header.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
 }
@@ -144,6 +152,11 @@ func (bva *BaseLockup) Delegate(
 		return nil, err
 	}
 
+	err = bva.checkUnbondingEntriesMature(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	hs := bva.headerService.HeaderInfo(ctx)
 
 	balance, err := bva.getBalance(ctx, delegatorAddress, msg.Amount.Denom)
@@ -193,10 +206,18 @@ func (bva *BaseLockup) Undelegate(
 		return nil, err
 	}
 
-	err = bva.TrackUndelegation(ctx, sdk.Coins{msg.Amount})
+	err = bva.checkUnbondingEntriesMature(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if msg.Amount.Denom != bondDenom || !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap("undelegation attempt with zero coins for staking denom")
+	}
 
 	msgUndelegate := &stakingtypes.MsgUndelegate{
 		DelegatorAddress: delegatorAddress,
@@ -208,6 +229,24 @@ func (bva *BaseLockup) Undelegate(
 		return nil, err
 	}
 
+	// The undelegation is only tracked once the unbonding completes, as the coins are not part of
+	// the account balance until then.
+	msgUndelegateResp, err := accountstd.UnpackAny[stakingtypes.MsgUndelegateResponse](resp[0])
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	err = bva.addUnbondingEntry(ctx, lockuptypes.UnbondingEntry{
+		CreationHeight:   hs.Height,
+		EndTime:          msgUndelegateResp.CompletionTime,
+		Amount:           msgUndelegateResp.Amount,
+		ValidatorAddress: msg.ValidatorAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &lockuptypes.MsgExecuteMessagesResponse{Responses: resp}, nil
 }
 
@@ -253,6 +292,11 @@ func (bva *BaseLockup) SendCoins(
 		return nil, err
 	}
 
+	err = bva.checkUnbondingEntriesMature(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	hs := bva.headerService.HeaderInfo(ctx)
 
 	if err := msg.Amount.Validate(); err != nil {
@@ -299,6 +343,11 @@ func (bva *BaseLockup) WithdrawUnlockedCoins(
 		return nil, err
 	}
 
+	err = bva.checkUnbondingEntriesMature(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// deduplicate the denoms
 	denoms := make(map[string]struct{})
 	for _, denom := range msg.Denoms {
@@ -406,6 +455,22 @@ func sendMessage(ctx context.Context, msg proto.Message) ([]*codectypes.Any, err
 	return accountstd.ExecModuleAnys(ctx, []*codectypes.Any{asAny})
 }
 
+// getUnbondingEntries returns the entries of the unbonding delegation of the delegator from the validator,
+// or no entries if there is none.
+func getUnbondingEntries(ctx context.Context, delAddr, valAddr string) ([]stakingtypes.UnbondingDelegationEntry, error) {
+	resp, err := accountstd.QueryModule[*stakingtypes.QueryUnbondingDelegationResponse](
+		ctx, &stakingtypes.QueryUnbondingDelegationRequest{DelegatorAddr: delAddr, ValidatorAddr: valAddr},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Unbond.Entries, nil
+}
+
 func getStakingDenom(ctx context.Context) (string, error) {
 	// Query account balance for the sent denom
 	resp, err := accountstd.QueryModule[*stakingtypes.QueryParamsResponse](ctx, &stakingtypes.QueryParamsRequest{})
@@ -478,6 +543,97 @@ func (bva *BaseLockup) TrackDelegation(
 	return nil
 }
 
+// addUnbondingEntry tracks the unbonding entry until its completion. Like the staking module, the entries
+// created at the same height and completing at the same time are merged.
+func (bva *BaseLockup) addUnbondingEntry(ctx context.Context, entry lockuptypes.UnbondingEntry) error {
+	entries, err := bva.UnbondEntries.Get(ctx, entry.ValidatorAddress)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	idx := slices.IndexFunc(entries.Entries, func(e *lockuptypes.UnbondingEntry) bool {
+		return e.CreationHeight == entry.CreationHeight && e.EndTime.Equal(entry.EndTime)
+	})
+	if idx >= 0 {
+		entries.Entries[idx].Amount = entries.Entries[idx].Amount.Add(entry.Amount)
+	} else {
+		entries.Entries = append(entries.Entries, &entry)
+	}
+
+	return bva.UnbondEntries.Set(ctx, entry.ValidatorAddress, entries)
+}
+
+// checkUnbondingEntriesMature tracks the undelegation of the unbonding entries which have completed,
+// their coins being returned to the account balance. An entry has completed when its end time has passed
+// and the staking module no longer holds it, as it is only released at the end of the block.
+// The amount of the entries still unbonding is updated to their staking balance, which is lower
+// than the unbonded amount if the validator has been slashed in the meantime.
+func (bva *BaseLockup) checkUnbondingEntriesMature(ctx context.Context) error {
+	delegatorAddress, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	var validators []string
+	err = bva.UnbondEntries.Walk(ctx, nil, func(valAddr string, entries lockuptypes.UnbondingEntries) (stop bool, err error) {
+		// only query the staking module when an entry may have completed
+		if slices.ContainsFunc(entries.Entries, func(e *lockuptypes.UnbondingEntry) bool {
+			return !e.EndTime.After(hs.Time)
+		}) {
+			validators = append(validators, valAddr)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	completed := sdk.Coins{}
+	for _, valAddr := range validators {
+		entries, err := bva.UnbondEntries.Get(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		stakingEntries, err := getUnbondingEntries(ctx, delegatorAddress, valAddr)
+		if err != nil {
+			return err
+		}
+
+		var remaining []*lockuptypes.UnbondingEntry
+		for _, entry := range entries.Entries {
+			idx := slices.IndexFunc(stakingEntries, func(e stakingtypes.UnbondingDelegationEntry) bool {
+				return e.CreationHeight == entry.CreationHeight && e.CompletionTime.Equal(entry.EndTime)
+			})
+			switch {
+			case idx >= 0:
+				entry.Amount.Amount = stakingEntries[idx].Balance
+				remaining = append(remaining, entry)
+			case !entry.EndTime.After(hs.Time):
+				completed = completed.Add(entry.Amount)
+			default:
+				// the unbonding has been canceled, the coins are delegated again
+			}
+		}
+
+		if len(remaining) == 0 {
+			err = bva.UnbondEntries.Remove(ctx, valAddr)
+		} else {
+			err = bva.UnbondEntries.Set(ctx, valAddr, lockuptypes.UnbondingEntries{Entries: remaining})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if completed.IsZero() {
+		return nil
+	}
+
+	return bva.TrackUndelegation(ctx, completed)
+}
+
 // TrackUndelegation tracks an undelegation amount by setting the necessary
 // values by which delegated locking and delegated free need to decrease and
 // by which amount the base coins need to increase.
@@ -672,7 +828,35 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 	}, nil
 }
 
+// QueryUnbondingEntries returns the lockup account unbonding entries, optionally filtered by validator.
+func (bva BaseLockup) QueryUnbondingEntries(ctx context.Context, req *lockuptypes.QueryUnbondingEntriesRequest) (
+	*lockuptypes.QueryUnbondingEntriesResponse, error,
+) {
+	if req.ValidatorAddress != "" {
+		entries, err := bva.UnbondEntries.Get(ctx, req.ValidatorAddress)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		return &lockuptypes.QueryUnbondingEntriesResponse{UnbondingEntries: entries.Entries}, nil
+	}
+
+	resp := &lockuptypes.QueryUnbondingEntriesResponse{}
+	err := bva.UnbondEntries.Walk(ctx, nil, func(_ string, entries lockuptypes.UnbondingEntries) (stop bool, err error) {
+		resp.UnbondingEntries = append(resp.UnbondingEntries, entries.Entries...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (bva BaseLockup) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, bva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, bva.WithdrawReward)
 }
+
+func (bva BaseLockup) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, bva.QueryUnbondingEntries)
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/v1"
 
@@ -277,3 +279,56 @@ func TestQueryLockupAccountBaseInfo(t *testing.T) {
 	require.Equal(t, res.Owner, "owner")
 	require.NoError(t, err)
 }
+
+func TestUnbondingEntries(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Height: 10,
+		Time:   now,
+	})
+
+	baseLockup := setup(t, sdkCtx, ss)
+	err := baseLockup.DelegatedLocking.Set(sdkCtx, "test", math.NewInt(5))
+	require.NoError(t, err)
+	err = baseLockup.DelegatedFree.Set(sdkCtx, "test", math.NewInt(5))
+	require.NoError(t, err)
+
+	entries := []lockuptypes.UnbondingEntry{
+		{CreationHeight: 1, EndTime: now.Add(-time.Second), Amount: sdk.NewCoin("test", math.NewInt(2)), ValidatorAddress: "val_a"},
+		{CreationHeight: 1, EndTime: now.Add(-time.Second), Amount: sdk.NewCoin("test", math.NewInt(2)), ValidatorAddress: "val_a"},
+		{CreationHeight: 2, EndTime: now.Add(time.Hour), Amount: sdk.NewCoin("test", math.NewInt(3)), ValidatorAddress: "val_c"},
+		{CreationHeight: 3, EndTime: now, Amount: sdk.NewCoin("test", math.NewInt(4)), ValidatorAddress: "val_b"},
+	}
+	for _, entry := range entries {
+		err = baseLockup.addUnbondingEntry(sdkCtx, entry)
+		require.NoError(t, err)
+	}
+
+	// entries created at the same height and completing at the same time are merged
+	res, err := baseLockup.QueryUnbondingEntries(sdkCtx, &lockuptypes.QueryUnbondingEntriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.UnbondingEntries, 3)
+	require.Equal(t, math.NewInt(4), res.UnbondingEntries[0].Amount.Amount)
+
+	res, err = baseLockup.QueryUnbondingEntries(sdkCtx, &lockuptypes.QueryUnbondingEntriesRequest{ValidatorAddress: "val_b"})
+	require.NoError(t, err)
+	require.Len(t, res.UnbondingEntries, 1)
+	require.Equal(t, int64(3), res.UnbondingEntries[0].CreationHeight)
+
+	// the completed entries are tracked as undelegated, the others are kept
+	err = baseLockup.checkUnbondingEntriesMature(sdkCtx)
+	require.NoError(t, err)
+
+	res, err = baseLockup.QueryUnbondingEntries(sdkCtx, &lockuptypes.QueryUnbondingEntriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.UnbondingEntries, 1)
+	require.Equal(t, int64(2), res.UnbondingEntries[0].CreationHeight)
+
+	delegatedLocking, err := baseLockup.DelegatedLocking.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(2), delegatedLocking)
+	delegatedFree, err := baseLockup.DelegatedFree.Get(sdkCtx, "test")
+	require.NoError(t, err)
+	require.Equal(t, math.ZeroInt(), delegatedFree)
+}
@@ -135,6 +135,10 @@ func TestPeriodicAccountUndelegate(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// the undelegation is tracked once the unbonding completes
+	err = acc.checkUnbondingEntriesMature(sdkCtx)
+	require.NoError(t, err)
+
 	delLocking, err = acc.DelegatedLocking.Get(ctx, "test")
 	require.NoError(t, err)
 	require.True(t, delLocking.Equal(math.ZeroInt()))
@@ -170,6 +174,10 @@ func TestPeriodicAccountUndelegate(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// the undelegation is tracked once the unbonding completes
+	err = acc.checkUnbondingEntriesMature(sdkCtx)
+	require.NoError(t, err)
+
 	delLocking, err = acc.DelegatedLocking.Get(ctx, "test")
 	require.NoError(t, err)
 	require.True(t, delLocking.Equal(math.NewInt(2)))
@@ -77,6 +77,14 @@ func TestPermanentAccountUndelegate(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	delLocking, err = acc.DelegatedLocking.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.Equal(math.NewInt(1)))
+
+	// the undelegation is tracked once the unbonding completes
+	err = acc.checkUnbondingEntriesMature(sdkCtx)
+	require.NoError(t, err)
+
 	delLocking, err = acc.DelegatedLocking.Get(ctx, "test")
 	require.NoError(t, err)
 	require.True(t, delLocking.Equal(math.ZeroInt()))
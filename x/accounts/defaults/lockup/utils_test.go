@@ -7,6 +7,8 @@ import (
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
@@ -77,7 +79,10 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 			case "/cosmos.staking.v1beta1.MsgDelegate":
 				return &stakingtypes.MsgDelegateResponse{}, nil
 			case "/cosmos.staking.v1beta1.MsgUndelegate":
-				return &stakingtypes.MsgUndelegate{}, nil
+				// unbondings complete immediately, at the end of the block
+				return &stakingtypes.MsgUndelegateResponse{
+					Amount: msg.(*stakingtypes.MsgUndelegate).Amount,
+				}, nil
 			case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
 				return &distrtypes.MsgWithdrawDelegatorRewardResponse{}, nil
 			case "/cosmos.bank.v1beta1.MsgSend":
@@ -86,23 +91,27 @@ func newMockContext(t *testing.T) (context.Context, store.KVStoreService) {
 				return nil, errors.New("unrecognized request type")
 			}
 		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
-			_, ok := req.(*banktypes.QueryBalanceRequest)
-			if !ok {
-				_, ok = req.(*stakingtypes.QueryParamsRequest)
-				require.True(t, ok)
+			switch req.(type) {
+			case *banktypes.QueryBalanceRequest:
+				return &banktypes.QueryBalanceResponse{
+					Balance: &(sdk.Coin{
+						Denom:  "test",
+						Amount: TestFunds.AmountOf("test"),
+					}),
+				}, nil
+			case *stakingtypes.QueryParamsRequest:
 				return &stakingtypes.QueryParamsResponse{
 					Params: stakingtypes.Params{
 						BondDenom: "test",
 					},
 				}, nil
+			case *stakingtypes.QueryUnbondingDelegationRequest:
+				// completed unbondings are removed from the staking module
+				return nil, status.Error(codes.NotFound, "unbonding delegation not found")
+			default:
+				require.Fail(t, "unrecognized query type", sdk.MsgTypeURL(req))
+				return nil, nil
 			}
-
-			return &banktypes.QueryBalanceResponse{
-				Balance: &(sdk.Coin{
-					Denom:  "test",
-					Amount: TestFunds.AmountOf("test"),
-				}),
-			}, nil
 		},
 	)
 }
@@ -5,6 +5,7 @@ package v1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -12,6 +13,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -84,8 +86,128 @@ func (m *Period) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// UnbondingEntry defines an unbonding operation of the lockup account, tracked until its completion.
+type UnbondingEntry struct {
+	// creation_height is the height at which the unbonding operation was initiated.
+	CreationHeight int64 `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// end_time is the time at which the unbonding operation completes.
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// amount is the amount of coins being unbonded, which is updated if the unbonding delegation is slashed.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// validator_address is the address of the validator the coins are unbonded from.
+	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9783f5e2b76d96, []int{1}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+func (m *UnbondingEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *UnbondingEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *UnbondingEntry) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// UnbondingEntries defines the unbonding operations of the lockup account from a validator.
+type UnbondingEntries struct {
+	Entries []*UnbondingEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *UnbondingEntries) Reset()         { *m = UnbondingEntries{} }
+func (m *UnbondingEntries) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntries) ProtoMessage()    {}
+func (*UnbondingEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9783f5e2b76d96, []int{2}
+}
+func (m *UnbondingEntries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntries.Merge(m, src)
+}
+func (m *UnbondingEntries) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntries proto.InternalMessageInfo
+
+func (m *UnbondingEntries) GetEntries() []*UnbondingEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.accounts.defaults.lockup.v1.Period")
+	proto.RegisterType((*UnbondingEntry)(nil), "cosmos.accounts.defaults.lockup.v1.UnbondingEntry")
+	proto.RegisterType((*UnbondingEntries)(nil), "cosmos.accounts.defaults.lockup.v1.UnbondingEntries")
 }
 
 func init() {
@@ -93,28 +215,40 @@ func init() {
 }
 
 var fileDescriptor_6b9783f5e2b76d96 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x18, 0x85, 0x63, 0x90, 0x32, 0x04, 0x18, 0xa8, 0x18, 0x4a, 0x07, 0xb7, 0xea, 0x54, 0x55, 0xc2,
-	0xbf, 0x02, 0x17, 0x40, 0xa5, 0x62, 0x46, 0x8c, 0x2c, 0xc8, 0x71, 0x5c, 0xd7, 0x6a, 0x92, 0xbf,
-	0xaa, 0x9d, 0x8a, 0xde, 0x82, 0x11, 0x71, 0x02, 0xc4, 0xd4, 0x4b, 0x20, 0x75, 0xec, 0xc8, 0x44,
-	0x51, 0x33, 0xf4, 0x1a, 0x28, 0x89, 0xb3, 0xb2, 0x24, 0xcf, 0xb2, 0xbf, 0xf7, 0xfe, 0x67, 0x07,
-	0x20, 0xd0, 0xa4, 0x68, 0x80, 0x0b, 0x81, 0x79, 0x66, 0x0d, 0xc4, 0x72, 0xc2, 0xf3, 0xc4, 0x1a,
-	0x48, 0x50, 0xcc, 0xf2, 0x39, 0x2c, 0x43, 0xa7, 0xd8, 0x7c, 0x81, 0x16, 0x5b, 0xfd, 0x1a, 0x60,
-	0x0d, 0xc0, 0x1a, 0x80, 0xb9, 0x63, 0xcb, 0xb0, 0x73, 0xce, 0x53, 0x9d, 0x21, 0x54, 0xdf, 0x1a,
-	0xeb, 0x50, 0x97, 0x13, 0x71, 0x23, 0x61, 0x19, 0x46, 0xd2, 0xf2, 0x10, 0x04, 0xea, 0xcc, 0xed,
-	0x5f, 0x28, 0x54, 0x58, 0x49, 0x28, 0x55, 0x43, 0x29, 0x44, 0x95, 0x48, 0xa8, 0x56, 0x51, 0x3e,
-	0x81, 0x38, 0x5f, 0x70, 0xab, 0xd1, 0x51, 0xfd, 0x2f, 0x12, 0xf8, 0x0f, 0x72, 0xa1, 0x31, 0x6e,
-	0xdd, 0x06, 0x7e, 0x22, 0x33, 0x65, 0xa7, 0x6d, 0xd2, 0x23, 0x83, 0x93, 0xeb, 0x4b, 0x56, 0xb3,
-	0xac, 0x61, 0xd9, 0xd8, 0xb1, 0xa3, 0xb3, 0xcd, 0x4f, 0xd7, 0x7b, 0xdb, 0x75, 0xc9, 0xc7, 0x61,
-	0x3d, 0x24, 0x8f, 0x8e, 0x6b, 0xad, 0x02, 0x9f, 0xa7, 0x65, 0xa7, 0xf6, 0x51, 0xef, 0xb8, 0x72,
-	0x70, 0x55, 0xcb, 0x99, 0x99, 0x9b, 0x99, 0xdd, 0xa1, 0xce, 0x46, 0xf7, 0xa5, 0xc3, 0xe7, 0xae,
-	0x3b, 0x50, 0xda, 0x4e, 0xf3, 0x88, 0x09, 0x4c, 0x9b, 0x8b, 0xac, 0x7f, 0x57, 0x26, 0x9e, 0x81,
-	0x5d, 0xcd, 0xa5, 0xa9, 0x00, 0xf3, 0x7e, 0x58, 0x0f, 0x4f, 0x13, 0xa9, 0xb8, 0x58, 0x3d, 0x97,
-	0xad, 0x8d, 0x8b, 0xae, 0x03, 0x47, 0xe3, 0xcd, 0x9e, 0x92, 0xed, 0x9e, 0x92, 0xdf, 0x3d, 0x25,
-	0xaf, 0x05, 0xf5, 0xb6, 0x05, 0xf5, 0xbe, 0x0b, 0xea, 0x3d, 0x0d, 0x6b, 0x3f, 0x13, 0xcf, 0x98,
-	0x46, 0x78, 0xf9, 0xef, 0x9d, 0x22, 0xbf, 0xaa, 0x7a, 0xf3, 0x17, 0x00, 0x00, 0xff, 0xff, 0xf4,
-	0xa4, 0x0b, 0x08, 0xd4, 0x01, 0x00, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbd, 0x6e, 0x13, 0x41,
+	0x10, 0xf6, 0xc5, 0xc8, 0x21, 0x0b, 0x84, 0xe4, 0x44, 0xe1, 0x58, 0xe2, 0x6c, 0xdc, 0x60, 0x59,
+	0xca, 0xae, 0x1c, 0x5a, 0x0a, 0x30, 0x06, 0x51, 0x20, 0x84, 0xcc, 0x4f, 0x41, 0x63, 0xf6, 0x6e,
+	0x37, 0xeb, 0x95, 0xef, 0x76, 0xad, 0xdb, 0xbd, 0x13, 0x7e, 0x08, 0xa4, 0x94, 0x88, 0x27, 0x40,
+	0x54, 0x29, 0x78, 0x05, 0xa4, 0x94, 0x11, 0x15, 0x15, 0x41, 0x76, 0x91, 0xd7, 0x40, 0xb7, 0x3f,
+	0x91, 0x1c, 0x04, 0x34, 0x77, 0xb3, 0x33, 0xf3, 0x7d, 0x33, 0xdf, 0xb7, 0x77, 0x00, 0x25, 0x52,
+	0x65, 0x52, 0x21, 0x9c, 0x24, 0xb2, 0x10, 0x5a, 0x21, 0x42, 0x0f, 0x71, 0x91, 0x6a, 0x85, 0x52,
+	0x99, 0xcc, 0x8a, 0x39, 0x2a, 0x07, 0x2e, 0x82, 0xf3, 0x5c, 0x6a, 0x19, 0x76, 0x2d, 0x00, 0x7a,
+	0x00, 0xf4, 0x00, 0xe8, 0xda, 0xca, 0x41, 0x6b, 0x17, 0x67, 0x5c, 0x48, 0x64, 0x9e, 0x16, 0xd6,
+	0x8a, 0xdc, 0x9c, 0x18, 0x2b, 0x8a, 0xca, 0x41, 0x4c, 0x35, 0x1e, 0xa0, 0x44, 0x72, 0xe1, 0xea,
+	0x7b, 0xb6, 0x3e, 0x31, 0x27, 0xb7, 0x94, 0x2b, 0xdd, 0x62, 0x92, 0x49, 0x9b, 0xaf, 0x22, 0x4f,
+	0xc8, 0xa4, 0x64, 0x29, 0x45, 0xe6, 0x14, 0x17, 0x87, 0x88, 0x14, 0x39, 0xd6, 0x5c, 0x7a, 0xc2,
+	0xf6, 0xe5, 0xba, 0xe6, 0x19, 0x55, 0x1a, 0x67, 0x4e, 0x48, 0xf7, 0x5b, 0x00, 0x1a, 0x2f, 0x68,
+	0xce, 0x25, 0x09, 0x1f, 0x80, 0x46, 0x4a, 0x05, 0xd3, 0xd3, 0x66, 0xd0, 0x09, 0x7a, 0xd7, 0x0e,
+	0xf6, 0xa0, 0x05, 0x43, 0x0f, 0x86, 0x23, 0x47, 0x3e, 0xbc, 0x71, 0xf2, 0xb3, 0x5d, 0xfb, 0x78,
+	0xd6, 0x0e, 0x3e, 0x9f, 0x1f, 0xf7, 0x83, 0xb1, 0xc3, 0x85, 0x0b, 0xd0, 0xc0, 0x59, 0xe5, 0x47,
+	0x73, 0xa3, 0x53, 0x37, 0x0c, 0x4e, 0x42, 0xa5, 0x17, 0x3a, 0xbd, 0xf0, 0x91, 0xe4, 0x62, 0xf8,
+	0xa4, 0x62, 0xf8, 0x72, 0xd6, 0xee, 0x31, 0xae, 0xa7, 0x45, 0x0c, 0x13, 0x99, 0xf9, 0x4b, 0xb0,
+	0xaf, 0x7d, 0x45, 0x66, 0x48, 0x2f, 0xe6, 0x54, 0x19, 0x80, 0xfa, 0x74, 0x7e, 0xdc, 0xbf, 0x9e,
+	0x52, 0x86, 0x93, 0xc5, 0xa4, 0x72, 0x4c, 0xb9, 0xd1, 0x76, 0x60, 0xf7, 0xc3, 0x06, 0xd8, 0x7e,
+	0x2d, 0x62, 0x29, 0x08, 0x17, 0xec, 0xb1, 0xd0, 0xf9, 0x22, 0xbc, 0x0b, 0x6e, 0x26, 0x39, 0x35,
+	0x0b, 0x4f, 0xa6, 0x94, 0xb3, 0xa9, 0x36, 0xc2, 0xea, 0xe3, 0x6d, 0x9f, 0x7e, 0x6a, 0xb2, 0xe1,
+	0x08, 0x5c, 0xa5, 0x82, 0x4c, 0x2a, 0x6b, 0x9a, 0x1b, 0x46, 0x7a, 0xeb, 0x0f, 0xe9, 0xaf, 0xbc,
+	0x6f, 0x56, 0xfb, 0xd1, 0x85, 0xf6, 0x4d, 0x2a, 0x48, 0x55, 0x0c, 0xef, 0x5f, 0x88, 0xaf, 0x3b,
+	0xfb, 0xfe, 0x2a, 0x7e, 0xab, 0xa2, 0x58, 0xdb, 0x3f, 0x7c, 0x0e, 0x76, 0x4b, 0x9c, 0x72, 0x82,
+	0xb5, 0xcc, 0x27, 0x98, 0x90, 0x9c, 0x2a, 0xd5, 0xbc, 0xd2, 0x09, 0x7a, 0x5b, 0xc3, 0x3b, 0xdf,
+	0xbf, 0xee, 0xdf, 0x76, 0x5c, 0x6f, 0x7c, 0xcf, 0x43, 0xdb, 0xf2, 0x52, 0xe7, 0x5c, 0xb0, 0xf1,
+	0x4e, 0x79, 0x29, 0xdf, 0x7d, 0x07, 0x76, 0xd6, 0xec, 0xe0, 0x54, 0x85, 0xcf, 0xc0, 0x26, 0xb5,
+	0x61, 0x33, 0x30, 0xf7, 0x73, 0x00, 0xff, 0xff, 0x19, 0xc3, 0x75, 0x57, 0xc7, 0x9e, 0x62, 0x38,
+	0x3a, 0x59, 0x46, 0xc1, 0xe9, 0x32, 0x0a, 0x7e, 0x2d, 0xa3, 0xe0, 0x68, 0x15, 0xd5, 0x4e, 0x57,
+	0x51, 0xed, 0xc7, 0x2a, 0xaa, 0xbd, 0xed, 0x5b, 0x56, 0x45, 0x66, 0x90, 0x4b, 0xf4, 0xfe, 0x5f,
+	0x7f, 0x55, 0xdc, 0x30, 0x0e, 0xdf, 0xfb, 0x3d, 0x00, 0xb8, 0x6a, 0x19, 0x0b, 0x82, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Period) Marshal() (dAtA []byte, err error) {
@@ -162,6 +296,96 @@ func (m *Period) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLockup(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLockup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLockup(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintLockup(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingEntries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLockup(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLockup(v)
 	base := offset
@@ -190,6 +414,41 @@ func (m *Period) Size() (n int) {
 	return n
 }
 
+func (m *UnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovLockup(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLockup(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLockup(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbondingEntries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovLockup(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -313,6 +572,257 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingEntries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &UnbondingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLockup(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -258,11 +258,106 @@ func (m *QueryLockingPeriodsResponse) GetLockingPeriods() []*Period {
 	return nil
 }
 
+// QueryUnbondingEntriesRequest is used to query the lockup account unbonding entries.
+type QueryUnbondingEntriesRequest struct {
+	// validator_address filters the entries of the unbondings from the given validator, if set.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryUnbondingEntriesRequest) Reset()         { *m = QueryUnbondingEntriesRequest{} }
+func (m *QueryUnbondingEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingEntriesRequest) ProtoMessage()    {}
+func (*QueryUnbondingEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{4}
+}
+func (m *QueryUnbondingEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEntriesRequest.Merge(m, src)
+}
+func (m *QueryUnbondingEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingEntriesRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingEntriesRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryUnbondingEntriesResponse returns the lockup account unbonding entries.
+type QueryUnbondingEntriesResponse struct {
+	// unbonding_entries defines the lockup account unbonding entries which have not been
+	// accounted for as undelegated yet.
+	UnbondingEntries []*UnbondingEntry `protobuf:"bytes,1,rep,name=unbonding_entries,json=unbondingEntries,proto3" json:"unbonding_entries,omitempty"`
+}
+
+func (m *QueryUnbondingEntriesResponse) Reset()         { *m = QueryUnbondingEntriesResponse{} }
+func (m *QueryUnbondingEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingEntriesResponse) ProtoMessage()    {}
+func (*QueryUnbondingEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2c1403191515490, []int{5}
+}
+func (m *QueryUnbondingEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingEntriesResponse.Merge(m, src)
+}
+func (m *QueryUnbondingEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingEntriesResponse) GetUnbondingEntries() []*UnbondingEntry {
+	if m != nil {
+		return m.UnbondingEntries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryLockupAccountInfoRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoRequest")
 	proto.RegisterType((*QueryLockupAccountInfoResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockupAccountInfoResponse")
 	proto.RegisterType((*QueryLockingPeriodsRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsRequest")
 	proto.RegisterType((*QueryLockingPeriodsResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryLockingPeriodsResponse")
+	proto.RegisterType((*QueryUnbondingEntriesRequest)(nil), "cosmos.accounts.defaults.lockup.v1.QueryUnbondingEntriesRequest")
+	proto.RegisterType((*QueryUnbondingEntriesResponse)(nil), "cosmos.accounts.defaults.lockup.v1.QueryUnbondingEntriesResponse")
 }
 
 func init() {
@@ -270,39 +365,44 @@ func init() {
 }
 
 var fileDescriptor_f2c1403191515490 = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xc7, 0x1b, 0xb6, 0xee, 0xc5, 0x85, 0x6d, 0x44, 0x3b, 0x84, 0x02, 0x49, 0xd5, 0x53, 0x35,
-	0x09, 0x9b, 0x8e, 0x23, 0x07, 0x44, 0x41, 0x48, 0x48, 0x3b, 0x40, 0xe0, 0xc4, 0x25, 0xca, 0xcb,
-	0xd3, 0x60, 0x35, 0xb5, 0x33, 0xdb, 0x29, 0xdb, 0xb7, 0xd8, 0xe7, 0xe0, 0x93, 0xec, 0xb8, 0x23,
-	0x27, 0x86, 0xda, 0xef, 0x81, 0x50, 0x62, 0xbb, 0x68, 0x12, 0x2f, 0x3d, 0x74, 0xa7, 0xc4, 0xf6,
-	0xf3, 0xfc, 0x7f, 0xcf, 0xe3, 0xbf, 0x6d, 0x84, 0x53, 0x2e, 0xa7, 0x5c, 0x92, 0x38, 0x4d, 0x79,
-	0xc5, 0x94, 0x24, 0x19, 0x8c, 0xe3, 0xaa, 0x50, 0x92, 0x14, 0x3c, 0x9d, 0x54, 0x25, 0x99, 0x0d,
-	0xc9, 0x69, 0x05, 0xe2, 0x1c, 0x97, 0x82, 0x2b, 0xee, 0xf6, 0x75, 0x3c, 0xb6, 0xf1, 0xd8, 0xc6,
-	0x63, 0x1d, 0x8f, 0x67, 0xc3, 0x2e, 0x59, 0x41, 0xd3, 0x44, 0x37, 0xa2, 0x5d, 0xdf, 0x24, 0x24,
-	0xb1, 0x04, 0x32, 0x1b, 0x26, 0xa0, 0xe2, 0x21, 0x49, 0x39, 0x65, 0x66, 0xfd, 0x30, 0xe7, 0x39,
-	0x6f, 0x7e, 0x49, 0xfd, 0x67, 0x66, 0x83, 0x9c, 0xf3, 0xbc, 0x00, 0xd2, 0x8c, 0x92, 0x6a, 0x4c,
-	0x14, 0x9d, 0x82, 0x54, 0xf1, 0xd4, 0xc8, 0xf6, 0x03, 0xf4, 0xf8, 0x7d, 0x5d, 0xfa, 0x49, 0xc3,
-	0x7a, 0xa9, 0xab, 0x79, 0xcb, 0xc6, 0x3c, 0x84, 0xd3, 0x0a, 0xa4, 0xea, 0xff, 0x6c, 0x23, 0xff,
-	0x6f, 0x11, 0xb2, 0xe4, 0x4c, 0x82, 0x3b, 0x43, 0x07, 0x5c, 0xd0, 0x9c, 0xb2, 0xb8, 0x88, 0xea,
-	0x9a, 0x29, 0xcb, 0x3d, 0xa7, 0xb7, 0x31, 0xe8, 0x1c, 0x3f, 0x30, 0x5b, 0x87, 0xeb, 0xaa, 0xb1,
-	0xa9, 0x1a, 0xbf, 0xe2, 0x94, 0x8d, 0x9e, 0x5e, 0x7e, 0x0f, 0x5a, 0x5f, 0xaf, 0x83, 0x41, 0x4e,
-	0xd5, 0xe7, 0x2a, 0xc1, 0x29, 0x9f, 0xda, 0x3d, 0xd1, 0x9f, 0x27, 0x32, 0x9b, 0x10, 0x75, 0x5e,
-	0x82, 0x6c, 0x12, 0x64, 0xb8, 0x6f, 0x21, 0x27, 0x9a, 0xe1, 0x0a, 0xb4, 0x97, 0x41, 0x01, 0x79,
-	0xac, 0x20, 0x8b, 0xc6, 0x02, 0xc0, 0xbb, 0xb3, 0x7e, 0xea, 0xbd, 0x25, 0xe2, 0x8d, 0x00, 0x70,
-	0xcf, 0xd0, 0xfd, 0xdf, 0x4c, 0xdb, 0xec, 0xc6, 0xfa, 0xb1, 0x07, 0x4b, 0x8a, 0xed, 0xf6, 0x05,
-	0x42, 0x52, 0xc5, 0x42, 0x45, 0xb5, 0x85, 0xde, 0x66, 0xcf, 0x19, 0x74, 0x8e, 0xbb, 0x58, 0xfb,
-	0x8b, 0xad, 0xbf, 0xf8, 0xa3, 0xf5, 0x77, 0xb4, 0x79, 0x71, 0x1d, 0x38, 0xe1, 0x6e, 0x93, 0x53,
-	0xcf, 0xba, 0xcf, 0xd1, 0x0e, 0xb0, 0x4c, 0xa7, 0xb7, 0x57, 0x4c, 0xdf, 0x06, 0x96, 0x35, 0xc9,
-	0x0c, 0xdd, 0xad, 0xbb, 0x85, 0x2c, 0xaa, 0xcf, 0x9c, 0xf4, 0xb6, 0xd6, 0xdf, 0x72, 0x47, 0x03,
-	0x9a, 0x41, 0xed, 0x6d, 0xc5, 0x6e, 0x10, 0xb7, 0x6f, 0xc1, 0x5b, 0x8b, 0xd0, 0xcc, 0x43, 0xd4,
-	0xe6, 0x5f, 0x18, 0x08, 0x6f, 0xa7, 0xe7, 0x0c, 0x76, 0x43, 0x3d, 0xe8, 0x3f, 0x42, 0xdd, 0xe5,
-	0xf9, 0xa7, 0x2c, 0x7f, 0x07, 0x82, 0xf2, 0x4c, 0xda, 0xeb, 0x21, 0xd0, 0xc3, 0x3f, 0xae, 0x9a,
-	0xab, 0xf1, 0x01, 0xed, 0x9b, 0x43, 0x12, 0x95, 0x7a, 0xc9, 0xdc, 0x8c, 0x23, 0xfc, 0xff, 0x47,
-	0x02, 0x6b, 0xb5, 0x70, 0xaf, 0xb8, 0x21, 0x3e, 0x7a, 0x7d, 0x39, 0xf7, 0x9d, 0xab, 0xb9, 0xef,
-	0xfc, 0x98, 0xfb, 0xce, 0xc5, 0xc2, 0x6f, 0x5d, 0x2d, 0xfc, 0xd6, 0xb7, 0x85, 0xdf, 0xfa, 0x74,
-	0xa4, 0x45, 0x65, 0x36, 0xc1, 0x94, 0x93, 0xb3, 0x7f, 0xbd, 0x2e, 0xc9, 0x56, 0x63, 0xfa, 0xb3,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x78, 0xc7, 0xe4, 0x56, 0xde, 0x04, 0x00, 0x00,
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x72, 0xd3, 0x30,
+	0x10, 0xc7, 0x63, 0xfa, 0xad, 0x42, 0x9b, 0x7a, 0x7a, 0x08, 0xa1, 0x75, 0x3a, 0x3e, 0x65, 0xca,
+	0x20, 0x91, 0x72, 0xe4, 0xc0, 0xb4, 0x7c, 0xcc, 0x30, 0xf4, 0x00, 0x06, 0x2e, 0x5c, 0x3c, 0xb6,
+	0xb5, 0x31, 0x9a, 0x3a, 0x52, 0x2a, 0xc9, 0xa1, 0xbd, 0xf1, 0x08, 0x7d, 0x0e, 0x9e, 0xa4, 0xc7,
+	0x1e, 0x39, 0x51, 0xa6, 0x7d, 0x0f, 0x86, 0xb1, 0x25, 0x85, 0x09, 0x43, 0x21, 0x87, 0x70, 0xb2,
+	0xa5, 0xdd, 0xfd, 0xff, 0xf6, 0xef, 0x95, 0x8c, 0x70, 0x26, 0xd4, 0x40, 0x28, 0x92, 0x64, 0x99,
+	0x28, 0xb9, 0x56, 0x84, 0x42, 0x3f, 0x29, 0x0b, 0xad, 0x48, 0x21, 0xb2, 0xa3, 0x72, 0x48, 0x46,
+	0x3d, 0x72, 0x5c, 0x82, 0x3c, 0xc5, 0x43, 0x29, 0xb4, 0xf0, 0x43, 0x93, 0x8f, 0x5d, 0x3e, 0x76,
+	0xf9, 0xd8, 0xe4, 0xe3, 0x51, 0xaf, 0x4d, 0xa6, 0xd0, 0xb4, 0xd9, 0xb5, 0x68, 0x3b, 0xb0, 0x05,
+	0x69, 0xa2, 0x80, 0x8c, 0x7a, 0x29, 0xe8, 0xa4, 0x47, 0x32, 0xc1, 0xb8, 0x8d, 0x6f, 0xe6, 0x22,
+	0x17, 0xf5, 0x2b, 0xa9, 0xde, 0xec, 0x6e, 0x27, 0x17, 0x22, 0x2f, 0x80, 0xd4, 0xab, 0xb4, 0xec,
+	0x13, 0xcd, 0x06, 0xa0, 0x74, 0x32, 0xb0, 0xb2, 0x61, 0x07, 0x6d, 0xbf, 0xa9, 0x5a, 0x3f, 0xac,
+	0x59, 0xfb, 0xa6, 0x9b, 0x97, 0xbc, 0x2f, 0x22, 0x38, 0x2e, 0x41, 0xe9, 0xf0, 0xc7, 0x02, 0x0a,
+	0x6e, 0xca, 0x50, 0x43, 0xc1, 0x15, 0xf8, 0x23, 0xd4, 0x14, 0x92, 0xe5, 0x8c, 0x27, 0x45, 0x5c,
+	0xf5, 0xcc, 0x78, 0xde, 0xf2, 0x76, 0xe6, 0xba, 0xab, 0x7b, 0x77, 0xed, 0xa7, 0xc3, 0x55, 0xd7,
+	0xd8, 0x76, 0x8d, 0x9f, 0x0a, 0xc6, 0x0f, 0x1e, 0x9e, 0x7f, 0xeb, 0x34, 0xbe, 0x5c, 0x76, 0xba,
+	0x39, 0xd3, 0x1f, 0xcb, 0x14, 0x67, 0x62, 0xe0, 0xbe, 0x89, 0x79, 0x3c, 0x50, 0xf4, 0x88, 0xe8,
+	0xd3, 0x21, 0xa8, 0xba, 0x40, 0x45, 0xeb, 0x0e, 0x72, 0x68, 0x18, 0xbe, 0x44, 0x6b, 0x14, 0x0a,
+	0xc8, 0x13, 0x0d, 0x34, 0xee, 0x4b, 0x80, 0xd6, 0xad, 0xd9, 0x53, 0xef, 0x8c, 0x11, 0x2f, 0x24,
+	0x80, 0x7f, 0x82, 0x36, 0x7e, 0x31, 0x9d, 0xd9, 0xb9, 0xd9, 0x63, 0x9b, 0x63, 0x8a, 0x73, 0xfb,
+	0x04, 0x21, 0xa5, 0x13, 0xa9, 0xe3, 0x6a, 0x84, 0xad, 0xf9, 0x1d, 0xaf, 0xbb, 0xba, 0xd7, 0xc6,
+	0x66, 0xbe, 0xd8, 0xcd, 0x17, 0xbf, 0x73, 0xf3, 0x3d, 0x98, 0x3f, 0xbb, 0xec, 0x78, 0xd1, 0x4a,
+	0x5d, 0x53, 0xed, 0xfa, 0x8f, 0xd1, 0x32, 0x70, 0x6a, 0xca, 0x17, 0xa6, 0x2c, 0x5f, 0x02, 0x4e,
+	0xeb, 0x62, 0x8e, 0x6e, 0x57, 0x6e, 0x81, 0xc6, 0xd5, 0x99, 0x53, 0xad, 0xc5, 0xd9, 0x5b, 0x5e,
+	0x35, 0x80, 0x7a, 0x51, 0xcd, 0xb6, 0xe4, 0x13, 0xc4, 0xa5, 0xff, 0x30, 0x5b, 0x87, 0x30, 0xcc,
+	0x4d, 0xb4, 0x20, 0x3e, 0x71, 0x90, 0xad, 0xe5, 0x1d, 0xaf, 0xbb, 0x12, 0x99, 0x45, 0xb8, 0x85,
+	0xda, 0xe3, 0xf3, 0xcf, 0x78, 0xfe, 0x1a, 0x24, 0x13, 0x54, 0xb9, 0xeb, 0x21, 0xd1, 0xbd, 0x3f,
+	0x46, 0xed, 0xd5, 0x78, 0x8b, 0xd6, 0xed, 0x21, 0x89, 0x87, 0x26, 0x64, 0x6f, 0xc6, 0x2e, 0xfe,
+	0xf7, 0x4f, 0x02, 0x1b, 0xb5, 0x68, 0xad, 0x98, 0x10, 0x0f, 0x5f, 0xa1, 0xad, 0x9a, 0xf9, 0x9e,
+	0xa7, 0x82, 0x53, 0xc6, 0xf3, 0xe7, 0x5c, 0x4b, 0x06, 0xae, 0x27, 0xff, 0x3e, 0xda, 0x18, 0x25,
+	0x05, 0xa3, 0x89, 0x16, 0x32, 0x4e, 0x28, 0x95, 0xa0, 0x2a, 0x6c, 0xe5, 0xa9, 0x39, 0x0e, 0xec,
+	0x9b, 0xfd, 0xf0, 0xb3, 0x87, 0xb6, 0x6f, 0x50, 0xb3, 0x1e, 0x62, 0xb4, 0x51, 0xba, 0x58, 0x0c,
+	0x26, 0x68, 0x5d, 0xec, 0x4d, 0xe3, 0x62, 0x42, 0xf8, 0x34, 0x6a, 0x96, 0xbf, 0x81, 0x0e, 0x9e,
+	0x9d, 0x5f, 0x05, 0xde, 0xc5, 0x55, 0xe0, 0x7d, 0xbf, 0x0a, 0xbc, 0xb3, 0xeb, 0xa0, 0x71, 0x71,
+	0x1d, 0x34, 0xbe, 0x5e, 0x07, 0x8d, 0x0f, 0xbb, 0x46, 0x5e, 0xd1, 0x23, 0xcc, 0x04, 0x39, 0xf9,
+	0xdb, 0xdf, 0x32, 0x5d, 0xac, 0x0f, 0xf1, 0xa3, 0x9f, 0x03, 0x00, 0x7f, 0x36, 0x95, 0xd9, 0xae,
+	0x05, 0x00, 0x00,
 }
 
 func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
@@ -508,6 +608,73 @@ func (m *QueryLockingPeriodsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUnbondingEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingEntries) > 0 {
+		for iNdEx := len(m.UnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -603,6 +770,34 @@ func (m *QueryLockingPeriodsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUnbondingEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingEntries) > 0 {
+		for _, e := range m.UnbondingEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1117,6 +1312,172 @@ func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUnbondingEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEntries = append(m.UnbondingEntries, &UnbondingEntry{})
+			if err := m.UnbondingEntries[len(m.UnbondingEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,477 @@
+package spendlimit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/spendlimit/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	OwnerPrefix         = collections.NewPrefix(0)
+	LimitsPrefix        = collections.NewPrefix(1)
+	PendingLimitsPrefix = collections.NewPrefix(2)
+	RaiseDelayPrefix    = collections.NewPrefix(3)
+	DailyWindowPrefix   = collections.NewPrefix(4)
+	WeeklyWindowPrefix  = collections.NewPrefix(5)
+)
+
+var SPEND_LIMIT_ACCOUNT = "spend-limit-account"
+
+const (
+	// Day is the duration of the daily spend window.
+	Day = 24 * time.Hour
+	// Week is the duration of the weekly spend window.
+	Week = 7 * Day
+)
+
+// ErrSpendLimitExceeded is returned when a send exceeds the spend limits of the account.
+var ErrSpendLimitExceeded = errors.New("spend limit exceeded")
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface = (*Account)(nil)
+)
+
+// Account is an account whose owner can only send limited amounts of coins per
+// day and per week, except to allowed recipients. Raising the limits only takes
+// effect after a delay, during which the owner can cancel the raise, so that a
+// stolen owner key cannot be used to drain the account at once.
+type Account struct {
+	// Owner is the address of the account owner.
+	Owner collections.Item[[]byte]
+	// Limits are the spend limits in effect.
+	Limits collections.Item[v1.SpendLimits]
+	// PendingLimits are the raised spend limits waiting for the raise delay.
+	PendingLimits collections.Item[v1.PendingSpendLimits]
+	// RaiseDelay is the raise delay, in nanoseconds.
+	RaiseDelay   collections.Item[int64]
+	DailyWindow  collections.Item[v1.SpendWindow]
+	WeeklyWindow collections.Item[v1.SpendWindow]
+
+	addrCodec     address.Codec
+	headerService header.Service
+}
+
+// NewAccount returns a new spend limit account creator function.
+func NewAccount(deps accountstd.Dependencies) (*Account, error) {
+	return &Account{
+		Owner:         collections.NewItem(deps.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+		Limits:        collections.NewItem(deps.SchemaBuilder, LimitsPrefix, "limits", codec.CollValue[v1.SpendLimits](deps.LegacyStateCodec)),
+		PendingLimits: collections.NewItem(deps.SchemaBuilder, PendingLimitsPrefix, "pending_limits", codec.CollValue[v1.PendingSpendLimits](deps.LegacyStateCodec)),
+		RaiseDelay:    collections.NewItem(deps.SchemaBuilder, RaiseDelayPrefix, "raise_delay", collections.Int64Value),
+		DailyWindow:   collections.NewItem(deps.SchemaBuilder, DailyWindowPrefix, "daily_window", codec.CollValue[v1.SpendWindow](deps.LegacyStateCodec)),
+		WeeklyWindow:  collections.NewItem(deps.SchemaBuilder, WeeklyWindowPrefix, "weekly_window", codec.CollValue[v1.SpendWindow](deps.LegacyStateCodec)),
+		addrCodec:     deps.AddressCodec,
+		headerService: deps.Environment.HeaderService,
+	}, nil
+}
+
+// Init initializes the spend limit account with its owner, spend limits and raise delay.
+func (a *Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	owner, err := a.addrCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'owner' address: %s", err)
+	}
+
+	if err := a.validateLimits(msg.Limits); err != nil {
+		return nil, err
+	}
+
+	if msg.RaiseDelay <= 0 {
+		return nil, errors.New("raise delay must be positive")
+	}
+
+	if err := a.Owner.Set(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	if err := a.Limits.Set(ctx, msg.Limits); err != nil {
+		return nil, err
+	}
+
+	if err := a.RaiseDelay.Set(ctx, int64(msg.RaiseDelay)); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+// Send sends coins from the account. Unless the recipient is allowed, the amounts
+// sent during the current day and week windows must not exceed the spend limits.
+func (a *Account) Send(ctx context.Context, msg *v1.MsgSend) (*v1.MsgSendResponse, error) {
+	if err := a.checkSender(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	toAddress, err := a.addrCodec.StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	limits, _, err := a.applyPendingLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := a.containsAddress(limits.AllowedRecipients, toAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		now := a.headerService.HeaderInfo(ctx).Time
+		if err := a.spend(ctx, a.DailyWindow, Day, "daily", limits.DailyLimits, msg.Amount, now); err != nil {
+			return nil, err
+		}
+		if err := a.spend(ctx, a.WeeklyWindow, Week, "weekly", limits.WeeklyLimits, msg.Amount, now); err != nil {
+			return nil, err
+		}
+	}
+
+	fromAddress, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = sendMessage(ctx, &banktypes.MsgSend{
+		FromAddress: fromAddress,
+		ToAddress:   msg.ToAddress,
+		Amount:      msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSendResponse{}, nil
+}
+
+// UpdateSpendLimits updates the spend limits of the account. Lowered limits take
+// effect immediately, while raised limits take effect after the raise delay. In
+// both cases, the pending spend limits are replaced, so that the owner can undo a
+// raise made with a stolen key by lowering the limits.
+func (a *Account) UpdateSpendLimits(ctx context.Context, msg *v1.MsgUpdateSpendLimits) (*v1.MsgUpdateSpendLimitsResponse, error) {
+	if err := a.checkSender(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if err := a.validateLimits(msg.Limits); err != nil {
+		return nil, err
+	}
+
+	current, _, err := a.applyPendingLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	raised, err := a.isRaise(current, msg.Limits)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveTime := a.headerService.HeaderInfo(ctx).Time
+	if !raised {
+		if err := a.Limits.Set(ctx, msg.Limits); err != nil {
+			return nil, err
+		}
+		if err := a.PendingLimits.Remove(ctx); err != nil {
+			return nil, err
+		}
+
+		return &v1.MsgUpdateSpendLimitsResponse{EffectiveTime: effectiveTime}, nil
+	}
+
+	raiseDelay, err := a.RaiseDelay.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveTime = effectiveTime.Add(time.Duration(raiseDelay))
+	err = a.PendingLimits.Set(ctx, v1.PendingSpendLimits{
+		Limits:        msg.Limits,
+		EffectiveTime: effectiveTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateSpendLimitsResponse{EffectiveTime: effectiveTime}, nil
+}
+
+// CancelPendingSpendLimits cancels the raised spend limits which are not in effect yet.
+func (a *Account) CancelPendingSpendLimits(ctx context.Context, msg *v1.MsgCancelPendingSpendLimits) (*v1.MsgCancelPendingSpendLimitsResponse, error) {
+	if err := a.checkSender(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	_, pending, err := a.applyPendingLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pending == nil {
+		return nil, errors.New("no pending spend limits")
+	}
+
+	if err := a.PendingLimits.Remove(ctx); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelPendingSpendLimitsResponse{}, nil
+}
+
+// QuerySpendLimits returns the spend limits of the account and the amounts sent
+// during the current windows.
+func (a *Account) QuerySpendLimits(ctx context.Context, _ *v1.QuerySpendLimits) (*v1.QuerySpendLimitsResponse, error) {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddress, err := a.addrCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, pending, err := a.loadLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	raiseDelay, err := a.RaiseDelay.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := a.headerService.HeaderInfo(ctx).Time
+	daily, err := currentWindow(ctx, a.DailyWindow, Day, now)
+	if err != nil {
+		return nil, err
+	}
+
+	weekly, err := currentWindow(ctx, a.WeeklyWindow, Week, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QuerySpendLimitsResponse{
+		Owner:       ownerAddress,
+		Limits:      limits,
+		Pending:     pending,
+		RaiseDelay:  time.Duration(raiseDelay),
+		DailySpent:  daily.Spent,
+		WeeklySpent: weekly.Spent,
+	}, nil
+}
+
+// loadLimits returns the spend limits in effect and the pending spend limits, if
+// any. The pending spend limits whose raise delay has elapsed are in effect.
+func (a *Account) loadLimits(ctx context.Context) (v1.SpendLimits, *v1.PendingSpendLimits, error) {
+	limits, err := a.Limits.Get(ctx)
+	if err != nil {
+		return v1.SpendLimits{}, nil, err
+	}
+
+	pending, err := a.PendingLimits.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return limits, nil, nil
+	}
+	if err != nil {
+		return v1.SpendLimits{}, nil, err
+	}
+
+	if !a.headerService.HeaderInfo(ctx).Time.Before(pending.EffectiveTime) {
+		return pending.Limits, nil, nil
+	}
+
+	return limits, &pending, nil
+}
+
+// applyPendingLimits stores the pending spend limits whose raise delay has elapsed
+// as the spend limits in effect, and returns the result of loadLimits.
+func (a *Account) applyPendingLimits(ctx context.Context) (v1.SpendLimits, *v1.PendingSpendLimits, error) {
+	limits, pending, err := a.loadLimits(ctx)
+	if err != nil || pending != nil {
+		return limits, pending, err
+	}
+
+	has, err := a.PendingLimits.Has(ctx)
+	if err != nil || !has {
+		return limits, nil, err
+	}
+
+	if err := a.Limits.Set(ctx, limits); err != nil {
+		return v1.SpendLimits{}, nil, err
+	}
+
+	return limits, nil, a.PendingLimits.Remove(ctx)
+}
+
+// spend adds the amount to the spend window, checking that the amounts sent
+// during the window do not exceed the limits. A denom without a limit cannot
+// be sent, its limit being zero.
+func (a *Account) spend(
+	ctx context.Context, window collections.Item[v1.SpendWindow], period time.Duration, name string,
+	limits, amount sdk.Coins, now time.Time,
+) error {
+	w, err := currentWindow(ctx, window, period, now)
+	if err != nil {
+		return err
+	}
+
+	spent := w.Spent.Add(amount...)
+	for _, coin := range amount {
+		limit := sdk.NewCoin(coin.Denom, limits.AmountOf(coin.Denom))
+		if spent.AmountOf(coin.Denom).GT(limit.Amount) {
+			return fmt.Errorf("%w: sending %s exceeds the %s limit of %s, %s already sent since %s",
+				ErrSpendLimitExceeded, coin, name, limit,
+				sdk.NewCoin(coin.Denom, w.Spent.AmountOf(coin.Denom)), w.StartTime)
+		}
+	}
+
+	w.Spent = spent
+	return window.Set(ctx, w)
+}
+
+// currentWindow returns the spend window holding the given time. A new window
+// starts with the first send after the previous window has ended. The windows
+// are fixed rather than rolling: as a window is reset once its period has
+// elapsed, up to twice the limit can be sent around the end of a window.
+func currentWindow(ctx context.Context, window collections.Item[v1.SpendWindow], period time.Duration, now time.Time) (v1.SpendWindow, error) {
+	w, err := window.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) || err == nil && !now.Before(w.StartTime.Add(period)) {
+		return v1.SpendWindow{StartTime: now, Spent: sdk.Coins{}}, nil
+	}
+
+	return w, err
+}
+
+// isRaise returns whether the next spend limits raise the current ones: a limit
+// is raised or added, or a recipient is allowed.
+func (a *Account) isRaise(current, next v1.SpendLimits) (bool, error) {
+	if limitsRaised(current.DailyLimits, next.DailyLimits) || limitsRaised(current.WeeklyLimits, next.WeeklyLimits) {
+		return true, nil
+	}
+
+	for _, recipient := range next.AllowedRecipients {
+		addr, err := a.addrCodec.StringToBytes(recipient)
+		if err != nil {
+			return false, err
+		}
+
+		allowed, err := a.containsAddress(current.AllowedRecipients, addr)
+		if err != nil || !allowed {
+			return true, err
+		}
+	}
+
+	return false, nil
+}
+
+// limitsRaised returns whether a limit is raised or added, a missing limit being zero.
+func limitsRaised(current, next sdk.Coins) bool {
+	for _, limit := range next {
+		if limit.Amount.GT(current.AmountOf(limit.Denom)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Account) validateLimits(limits v1.SpendLimits) error {
+	if err := limits.DailyLimits.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid daily limits: %s", err)
+	}
+
+	if err := limits.WeeklyLimits.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid weekly limits: %s", err)
+	}
+
+	recipients := make(map[string]struct{}, len(limits.AllowedRecipients))
+	for _, recipient := range limits.AllowedRecipients {
+		addr, err := a.addrCodec.StringToBytes(recipient)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed recipient %s: %s", recipient, err)
+		}
+
+		if _, ok := recipients[string(addr)]; ok {
+			return fmt.Errorf("duplicate allowed recipient %s", recipient)
+		}
+		recipients[string(addr)] = struct{}{}
+	}
+
+	return nil
+}
+
+func (a *Account) containsAddress(addresses []string, addr []byte) (bool, error) {
+	for _, address := range addresses {
+		bz, err := a.addrCodec.StringToBytes(address)
+		if err != nil {
+			return false, err
+		}
+
+		if bytes.Equal(bz, addr) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (a *Account) checkSender(ctx context.Context, sender string) error {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err.Error())
+	}
+	senderBytes, err := a.addrCodec.StringToBytes(sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+	if !bytes.Equal(owner, senderBytes) {
+		return errors.New("sender is not the owner of this spend limit account")
+	}
+
+	return nil
+}
+
+func sendMessage(ctx context.Context, msg proto.Message) ([]*codectypes.Any, error) {
+	asAny, err := accountstd.PackAny(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountstd.ExecModuleAnys(ctx, []*codectypes.Any{asAny})
+}
+
+func (a *Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.Send)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateSpendLimits)
+	accountstd.RegisterExecuteHandler(builder, a.CancelPendingSpendLimits)
+}
+
+func (a *Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySpendLimits)
+}
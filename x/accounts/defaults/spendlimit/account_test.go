@@ -0,0 +1,211 @@
+package spendlimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	v1 "cosmossdk.io/x/accounts/defaults/spendlimit/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var genesisTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func setup(t *testing.T, limits v1.SpendLimits) (*Account, sdk.Context, *[]*banktypes.MsgSend) {
+	t.Helper()
+	sends := &[]*banktypes.MsgSend{}
+	ctx, ss := newMockContext(t, sends)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: genesisTime,
+	})
+
+	acc, err := NewAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+
+	_, err = acc.Init(sdkCtx, &v1.MsgInit{
+		Owner:      "owner",
+		Limits:     limits,
+		RaiseDelay: 48 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	return acc, sdkCtx, sends
+}
+
+func at(ctx sdk.Context, d time.Duration) sdk.Context {
+	return ctx.WithHeaderInfo(header.Info{Time: genesisTime.Add(d)})
+}
+
+func send(ctx context.Context, acc *Account, to string, amount int64) error {
+	_, err := acc.Send(ctx, &v1.MsgSend{
+		Sender:    "owner",
+		ToAddress: to,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("test", amount)),
+	})
+	return err
+}
+
+func TestInit(t *testing.T) {
+	sends := &[]*banktypes.MsgSend{}
+	ctx, ss := newMockContext(t, sends)
+	acc, err := NewAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"no raise delay",
+			&v1.MsgInit{Owner: "owner"},
+			"raise delay must be positive",
+		},
+		{
+			"invalid limits",
+			&v1.MsgInit{Owner: "owner", RaiseDelay: time.Hour, Limits: v1.SpendLimits{
+				DailyLimits: sdk.Coins{sdk.NewInt64Coin("test", 0)},
+			}},
+			"invalid daily limits",
+		},
+		{
+			"duplicate allowed recipients",
+			&v1.MsgInit{Owner: "owner", RaiseDelay: time.Hour, Limits: v1.SpendLimits{
+				AllowedRecipients: []string{"savings", "savings"},
+			}},
+			"duplicate allowed recipient savings",
+		},
+		{
+			"valid",
+			&v1.MsgInit{Owner: "owner", RaiseDelay: time.Hour},
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSend(t *testing.T) {
+	acc, ctx, sends := setup(t, v1.SpendLimits{
+		DailyLimits:       sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+		WeeklyLimits:      sdk.NewCoins(sdk.NewInt64Coin("test", 250)),
+		AllowedRecipients: []string{"savings"},
+	})
+
+	// only the owner can send
+	_, err := acc.Send(ctx, &v1.MsgSend{Sender: "thief", ToAddress: "thief", Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1))})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	require.NoError(t, send(ctx, acc, "recipient", 60))
+	require.NoError(t, send(at(ctx, time.Hour), acc, "recipient", 40))
+	require.ErrorIs(t, send(at(ctx, 2*time.Hour), acc, "recipient", 1), ErrSpendLimitExceeded)
+
+	// the denoms without a limit cannot be sent
+	_, err = acc.Send(at(ctx, 2*time.Hour), &v1.MsgSend{Sender: "owner", ToAddress: "recipient", Amount: sdk.NewCoins(sdk.NewInt64Coin("other", 1))})
+	require.ErrorIs(t, err, ErrSpendLimitExceeded)
+	require.ErrorContains(t, err, "daily limit of 0other")
+
+	// the allowed recipients are not limited
+	require.NoError(t, send(at(ctx, 2*time.Hour), acc, "savings", 1000))
+	_, err = acc.Send(at(ctx, 2*time.Hour), &v1.MsgSend{Sender: "owner", ToAddress: "savings", Amount: sdk.NewCoins(sdk.NewInt64Coin("other", 1000))})
+	require.NoError(t, err)
+
+	// the daily window ends a day after its first send
+	require.NoError(t, send(at(ctx, Day), acc, "recipient", 100))
+	require.NoError(t, send(at(ctx, 2*Day), acc, "recipient", 50))
+	err = send(at(ctx, 3*Day), acc, "recipient", 1)
+	require.ErrorIs(t, err, ErrSpendLimitExceeded)
+	require.ErrorContains(t, err, "weekly limit of 250test")
+
+	// the weekly window ends a week after its first send
+	require.NoError(t, send(at(ctx, Week), acc, "recipient", 100))
+	require.Len(t, *sends, 7)
+	require.Equal(t, &banktypes.MsgSend{
+		FromAddress: "spend_limit_account",
+		ToAddress:   "recipient",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+	}, (*sends)[6])
+
+	resp, err := acc.QuerySpendLimits(at(ctx, Week), &v1.QuerySpendLimits{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), resp.DailySpent)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), resp.WeeklySpent)
+}
+
+func TestUpdateSpendLimits(t *testing.T) {
+	limits := v1.SpendLimits{
+		DailyLimits:  sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+		WeeklyLimits: sdk.NewCoins(sdk.NewInt64Coin("test", 500), sdk.NewInt64Coin("other", 10)),
+	}
+	acc, ctx, _ := setup(t, limits)
+
+	_, err := acc.UpdateSpendLimits(ctx, &v1.MsgUpdateSpendLimits{Sender: "thief"})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	// lowering or removing the limits takes effect immediately
+	lowered := v1.SpendLimits{
+		DailyLimits:  sdk.NewCoins(sdk.NewInt64Coin("test", 50)),
+		WeeklyLimits: sdk.NewCoins(sdk.NewInt64Coin("test", 500)),
+	}
+	resp, err := acc.UpdateSpendLimits(ctx, &v1.MsgUpdateSpendLimits{Sender: "owner", Limits: lowered})
+	require.NoError(t, err)
+	require.Equal(t, genesisTime, resp.EffectiveTime)
+	require.ErrorIs(t, send(ctx, acc, "recipient", 60), ErrSpendLimitExceeded)
+
+	// raising the limits, here by allowing a recipient, takes effect after the raise delay
+	raised := lowered
+	raised.AllowedRecipients = []string{"exchange"}
+	resp, err = acc.UpdateSpendLimits(ctx, &v1.MsgUpdateSpendLimits{Sender: "owner", Limits: raised})
+	require.NoError(t, err)
+	require.Equal(t, genesisTime.Add(48*time.Hour), resp.EffectiveTime)
+	require.ErrorIs(t, send(at(ctx, 47*time.Hour), acc, "exchange", 60), ErrSpendLimitExceeded)
+
+	query, err := acc.QuerySpendLimits(at(ctx, 47*time.Hour), &v1.QuerySpendLimits{})
+	require.NoError(t, err)
+	require.Equal(t, lowered, query.Limits)
+	require.Equal(t, &v1.PendingSpendLimits{Limits: raised, EffectiveTime: resp.EffectiveTime}, query.Pending)
+	require.Equal(t, 48*time.Hour, query.RaiseDelay)
+
+	require.NoError(t, send(at(ctx, 48*time.Hour), acc, "exchange", 60))
+	query, err = acc.QuerySpendLimits(at(ctx, 48*time.Hour), &v1.QuerySpendLimits{})
+	require.NoError(t, err)
+	require.Equal(t, raised, query.Limits)
+	require.Nil(t, query.Pending)
+
+	// adding a limit is a raise, which can be canceled
+	added := raised
+	added.DailyLimits = sdk.NewCoins(sdk.NewInt64Coin("test", 50), sdk.NewInt64Coin("other", 1))
+	resp, err = acc.UpdateSpendLimits(at(ctx, 48*time.Hour), &v1.MsgUpdateSpendLimits{Sender: "owner", Limits: added})
+	require.NoError(t, err)
+	require.Equal(t, genesisTime.Add(96*time.Hour), resp.EffectiveTime)
+	_, err = acc.CancelPendingSpendLimits(at(ctx, 49*time.Hour), &v1.MsgCancelPendingSpendLimits{Sender: "owner"})
+	require.NoError(t, err)
+	_, err = acc.CancelPendingSpendLimits(at(ctx, 49*time.Hour), &v1.MsgCancelPendingSpendLimits{Sender: "owner"})
+	require.ErrorContains(t, err, "no pending spend limits")
+	require.ErrorIs(t, send(at(ctx, Week), acc, "recipient", 60), ErrSpendLimitExceeded)
+
+	// lowering the limits replaces a pending raise
+	_, err = acc.UpdateSpendLimits(at(ctx, Week), &v1.MsgUpdateSpendLimits{Sender: "owner", Limits: limits})
+	require.NoError(t, err)
+	_, err = acc.UpdateSpendLimits(at(ctx, Week), &v1.MsgUpdateSpendLimits{Sender: "owner", Limits: lowered})
+	require.NoError(t, err)
+	query, err = acc.QuerySpendLimits(at(ctx, 2*Week), &v1.QuerySpendLimits{})
+	require.NoError(t, err)
+	require.Equal(t, lowered, query.Limits)
+	require.Nil(t, query.Pending)
+}
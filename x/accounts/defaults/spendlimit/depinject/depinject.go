@@ -0,0 +1,10 @@
+package spendlimitdepinject
+
+import (
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/spendlimit"
+)
+
+func ProvideAccount() accountstd.DepinjectAccount {
+	return accountstd.DIAccount(spendlimit.SPEND_LIMIT_ACCOUNT, spendlimit.NewAccount)
+}
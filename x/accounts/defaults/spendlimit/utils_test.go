@@ -0,0 +1,93 @@
+package spendlimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/x/accounts/accountstd"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mock statecodec
+type mockStateCodec struct {
+	codec.Codec
+}
+
+var _ codec.Codec = mockStateCodec{}
+
+func (c mockStateCodec) Marshal(m gogoproto.Message) ([]byte, error) {
+	// Size() check can catch the typed nil value.
+	if m == nil || gogoproto.Size(m) == 0 {
+		// return empty bytes instead of nil, because nil has special meaning in places like store.Set
+		return []byte{}, nil
+	}
+
+	return gogoproto.Marshal(m)
+}
+
+func (c mockStateCodec) Unmarshal(bz []byte, ptr gogoproto.Message) error {
+	return gogoproto.Unmarshal(bz, ptr)
+}
+
+// mock address codec
+type addressCodec struct{}
+
+func (a addressCodec) StringToBytes(text string) ([]byte, error) {
+	if text == "" {
+		return nil, errors.New("empty address")
+	}
+	return []byte(text), nil
+}
+func (a addressCodec) BytesToString(bz []byte) (string, error) { return string(bz), nil }
+
+// mock header service
+type headerService struct{}
+
+func (h headerService) HeaderInfo(ctx context.Context) header.Info {
+	return sdk.UnwrapSDKContext(ctx).HeaderInfo()
+}
+
+// newMockContext returns a mock account context, the bank sends executed by the
+// account being appended to sends.
+func newMockContext(t *testing.T, sends *[]*banktypes.MsgSend) (context.Context, store.KVStoreService) {
+	t.Helper()
+	return accountstd.NewMockContext(
+		0, []byte("spend_limit_account"), []byte("owner"), sdk.NewCoins(),
+		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			send, ok := msg.(*banktypes.MsgSend)
+			if !ok {
+				return nil, errors.New("unrecognized request type")
+			}
+			*sends = append(*sends, send)
+			return &banktypes.MsgSendResponse{}, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			require.Fail(t, "unrecognized query type", sdk.MsgTypeURL(req))
+			return nil, nil
+		},
+	)
+}
+
+func makeMockDependencies(storeservice store.KVStoreService) accountstd.Dependencies {
+	sb := collections.NewSchemaBuilder(storeservice)
+
+	return accountstd.Dependencies{
+		SchemaBuilder:    sb,
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: mockStateCodec{},
+		Environment: appmodulev2.Environment{
+			HeaderService: headerService{},
+		},
+	}
+}
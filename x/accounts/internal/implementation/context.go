@@ -122,3 +122,18 @@ func Whoami(ctx context.Context) []byte {
 
 // Funds returns the funds associated with the execution context.
 func Funds(ctx context.Context) sdk.Coins { return getCtx(ctx).funds }
+
+type legacySequenceKey struct{}
+
+// WithLegacySequence marks the context as being used to migrate a legacy x/auth
+// account whose sequence is the provided one.
+func WithLegacySequence(ctx context.Context, sequence uint64) context.Context {
+	return context.WithValue(ctx, legacySequenceKey{}, sequence)
+}
+
+// LegacySequence returns the sequence of the legacy account being migrated,
+// it reports false if the context is not used for a migration.
+func LegacySequence(ctx context.Context) (uint64, bool) {
+	sequence, ok := ctx.Value(legacySequenceKey{}).(uint64)
+	return sequence, ok
+}
@@ -23,7 +23,8 @@ import (
 )
 
 var (
-	errAccountTypeNotFound = errors.New("account type not found")
+	errAccountTypeNotFound  = errors.New("account type not found")
+	errAccountAlreadyExists = errors.New("account already exists")
 	// ErrUnauthorized is returned when a message sender is not allowed to perform the operation.
 	ErrUnauthorized = errors.New("unauthorized")
 )
@@ -120,6 +121,18 @@ func (k Keeper) NextAccountNumber(
 	return accNum, nil
 }
 
+// PeekAccountNumber returns the account number which will be assigned to the next
+// account, without incrementing it.
+func (k Keeper) PeekAccountNumber(ctx context.Context) (uint64, error) {
+	return k.AccountNumber.Peek(ctx)
+}
+
+// IterateAccountNumbers iterates over the account numbers of all the accounts, ordered
+// by address. The iteration stops when the callback returns true or an error.
+func (k Keeper) IterateAccountNumbers(ctx context.Context, cb func(addr []byte, accNum uint64) (stop bool, err error)) error {
+	return k.AccountByNumber.Walk(ctx, nil, cb)
+}
+
 // InitAccountNumberSeqUnsafe use to set accounts account number tracking.
 // Only use for account number migration.
 func (k Keeper) InitAccountNumberSeqUnsafe(ctx context.Context, accNum uint64) error {
@@ -218,14 +231,21 @@ func (k Keeper) init(
 // Concretely speaking this works like Init, but with a custom account number provided,
 // Where the creator is the account itself. This can be used by the x/auth module to
 // gradually migrate base accounts to x/accounts.
+// The sequence of the legacy account is made available to the account initialization
+// through accountstd.LegacySequence.
 // NOTE: this assumes the calling module checks for account overrides.
 func (k Keeper) MigrateLegacyAccount(
 	ctx context.Context,
 	addr []byte, // The current address of the account
 	accNum uint64, // The current account number
+	sequence uint64, // The current sequence of the account
 	accType string, // The account type to migrate to
 	msg transaction.Msg, // The init msg of the account type we're migrating to
 ) (transaction.Msg, error) {
+	if k.IsAccountsModuleAccount(ctx, addr) {
+		return nil, fmt.Errorf("%w: %x", errAccountAlreadyExists, addr)
+	}
+	ctx = implementation.WithLegacySequence(ctx, sequence)
 	return k.init(ctx, accType, addr, accNum, addr, msg, nil)
 }
 
@@ -25,7 +25,11 @@ type HandlerOptions struct {
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter gas.Meter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	TxPolicyProvider         TxPolicyProvider
 	UnorderedTxManager       *unorderedtx.Manager
+	// RejectionCounter, if set, counts the transactions rejected by each decorator.
+	// The rejections are reported to telemetry regardless.
+	RejectionCounter *types.AnteRejectionCounter
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -50,6 +54,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewValidateBasicDecorator(options.Environment),
 		NewTxTimeoutHeightDecorator(options.Environment),
 		NewValidateMemoDecorator(options.AccountKeeper),
+		NewTxPolicyDecorator(options.AccountKeeper, options.TxPolicyProvider),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewValidateSigCountDecorator(options.AccountKeeper),
@@ -60,5 +65,5 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		anteDecorators = append(anteDecorators, NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, options.UnorderedTxManager, options.Environment, DefaultSha256Cost))
 	}
 
-	return sdk.ChainAnteDecorators(anteDecorators...), nil
+	return sdk.ChainAnteDecorators(InstrumentDecorators(options.RejectionCounter, anteDecorators...)...), nil
 }
@@ -0,0 +1,83 @@
+package ante
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/hashicorp/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// InstrumentDecorators wraps the decorators so that the transactions they reject are counted in the
+// tx_ante_rejected telemetry counter, labeled with the decorator name and the error codespace and code,
+// and in counter if it is not nil. The rejections in simulation mode are not counted.
+//
+// The rejection of a transaction is attributed to the decorator returning the error, not to the
+// decorators wrapping it.
+func InstrumentDecorators(counter *types.AnteRejectionCounter, decorators ...sdk.AnteDecorator) []sdk.AnteDecorator {
+	res := make([]sdk.AnteDecorator, len(decorators))
+	for i, decorator := range decorators {
+		res[i] = instrumentedDecorator{
+			AnteDecorator: decorator,
+			name:          DecoratorName(decorator),
+			counter:       counter,
+		}
+	}
+	return res
+}
+
+// DecoratorName returns the name of a decorator used in the rejection metrics, the name of its type.
+func DecoratorName(decorator any) string {
+	t := reflect.TypeOf(decorator)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// RecordRejection counts the rejection of a transaction by the decorator in the tx_ante_rejected
+// telemetry counter and in counter if it is not nil.
+func RecordRejection(counter *types.AnteRejectionCounter, decorator string, err error) {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"tx", "ante", "rejected"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("decorator", decorator),
+			telemetry.NewLabel("codespace", codespace),
+			telemetry.NewLabel("code", strconv.FormatUint(uint64(code), 10)),
+		},
+	)
+
+	if counter != nil {
+		counter.Record(decorator, codespace, code)
+	}
+}
+
+type instrumentedDecorator struct {
+	sdk.AnteDecorator
+	name    string
+	counter *types.AnteRejectionCounter
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d instrumentedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// the errors returned by the next decorators are recorded by them
+	var nextFailed bool
+	newCtx, err := d.AnteDecorator.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		newCtx, err := next(ctx, tx, simulate)
+		nextFailed = err != nil
+		return newCtx, err
+	})
+	if err != nil && !nextFailed && !simulate {
+		RecordRejection(d.counter, d.name, err)
+	}
+
+	return newCtx, err
+}
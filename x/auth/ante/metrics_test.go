@@ -0,0 +1,56 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type passDecorator struct{}
+
+func (passDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+type rejectDecorator struct{ err error }
+
+func (d *rejectDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, d.err
+}
+
+func TestInstrumentDecorators(t *testing.T) {
+	counter := types.NewAnteRejectionCounter()
+	handler := sdk.ChainAnteDecorators(ante.InstrumentDecorators(
+		counter,
+		passDecorator{},
+		&rejectDecorator{err: sdkerrors.ErrInsufficientFee},
+	)...)
+
+	ctx := sdk.Context{}
+	_, err := handler(ctx, nil, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	_, err = handler(ctx, nil, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the rejections in simulation mode are not counted
+	_, err = handler(ctx.WithExecMode(sdk.ExecModeSimulate), nil, true)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the rejections are only attributed to the decorator returning the error
+	require.Equal(t, []types.AnteRejectionCount{{
+		Decorator: "rejectDecorator",
+		Codespace: sdkerrors.ErrInsufficientFee.Codespace(),
+		Code:      sdkerrors.ErrInsufficientFee.ABCICode(),
+		Count:     2,
+	}}, counter.Counts())
+
+	// the counter is optional
+	handler = sdk.ChainAnteDecorators(ante.InstrumentDecorators(nil, &rejectDecorator{err: sdkerrors.ErrWrongSequence})...)
+	_, err = handler(ctx, nil, false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+}
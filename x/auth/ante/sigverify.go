@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 
-	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"cosmossdk.io/core/event"
@@ -105,51 +104,6 @@ func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 	}
 }
 
-func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
-	// when simulating pubKey.Key will always be nil
-	if pubKey.Bytes() == nil {
-		return nil
-	}
-
-	switch typedPubKey := pubKey.(type) {
-	case *secp256k1.PubKey:
-		pubKeyObject, err := secp256k1dcrd.ParsePubKey(typedPubKey.Bytes())
-		if err != nil {
-			if errors.Is(err, secp256k1dcrd.ErrPubKeyNotOnCurve) {
-				return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256k1 key is not on curve")
-			}
-			return err
-		}
-		if !pubKeyObject.IsOnCurve() {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256k1 key is not on curve")
-		}
-
-	case *secp256r1.PubKey:
-		pubKeyObject := typedPubKey.Key.PublicKey
-		if !pubKeyObject.IsOnCurve(pubKeyObject.X, pubKeyObject.Y) {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key is not on curve")
-		}
-
-	case multisig.PubKey:
-		pubKeysObjects := typedPubKey.GetPubKeys()
-		ok := true
-		for _, pubKeyObject := range pubKeysObjects {
-			if err := verifyIsOnCurve(pubKeyObject); err != nil {
-				ok = false
-				break
-			}
-		}
-		if !ok {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "some keys are not on curve")
-		}
-
-	default:
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "unsupported key type: %T", typedPubKey)
-	}
-
-	return nil
-}
-
 func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	if err := svd.ValidateTx(ctx, tx); err != nil {
 		return ctx, err
@@ -417,7 +371,7 @@ func (svd SigVerificationDecorator) setPubKey(ctx context.Context, acc sdk.Accou
 		return sdkerrors.ErrInvalidPubKey.Wrapf("the account %s cannot be claimed by public key with address %x", acc.GetAddress(), txPubKey.Address())
 	}
 
-	err := verifyIsOnCurve(txPubKey)
+	err := types.VerifyIsOnCurve(txPubKey)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,101 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// TxPolicy defines the ceilings imposed on a transaction.
+// A zero value means that no ceiling is imposed.
+type TxPolicy struct {
+	// MaxGas is the maximum gas limit of the transaction.
+	MaxGas uint64
+	// MaxTxSize is the maximum size in bytes of the transaction.
+	MaxTxSize uint64
+}
+
+// TxPolicyProvider provides the policy imposed on the transactions signed by an account.
+// It lets chains impose per-account ceilings, for instance stricter ones for newly created
+// accounts, or global ceilings by returning the same policy for every account.
+type TxPolicyProvider interface {
+	// TxPolicy returns the policy imposed on the transactions signed by signer.
+	// acc is nil when the signer account does not exist in state yet.
+	TxPolicy(ctx context.Context, signer []byte, acc sdk.AccountI) (TxPolicy, error)
+}
+
+// TxPolicyDecorator rejects the transactions exceeding the gas or size ceilings returned by
+// the TxPolicyProvider for any of their signers. If no provider is set, it is a no-op.
+// The gas ceiling is not enforced in simulation mode, as the gas limit is being estimated.
+// CONTRACT: Tx must implement GasTx and SigVerifiableTx interfaces
+type TxPolicyDecorator struct {
+	ak       AccountKeeper
+	provider TxPolicyProvider
+}
+
+func NewTxPolicyDecorator(ak AccountKeeper, provider TxPolicyProvider) TxPolicyDecorator {
+	return TxPolicyDecorator{
+		ak:       ak,
+		provider: provider,
+	}
+}
+
+// AnteHandle implements an AnteHandler decorator for the TxPolicyDecorator.
+func (tpd TxPolicyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if err := tpd.ValidateTx(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, false)
+}
+
+// ValidateTx implements an TxValidator for TxPolicyDecorator
+func (tpd TxPolicyDecorator) ValidateTx(ctx context.Context, tx sdk.Tx) error {
+	if tpd.provider == nil {
+		return nil
+	}
+
+	gasTx, ok := tx.(GasTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "transaction is not a GasTx")
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return err
+	}
+
+	simulate := tpd.ak.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate
+	txSize := uint64(len(tx.Bytes()))
+
+	for _, signer := range signers {
+		policy, err := tpd.provider.TxPolicy(ctx, signer, tpd.ak.GetAccount(ctx, signer))
+		if err != nil {
+			return err
+		}
+
+		if policy.MaxTxSize > 0 && txSize > policy.MaxTxSize {
+			return errorsmod.Wrapf(sdkerrors.ErrTxTooLarge,
+				"tx size %d exceeds the maximum tx size %d allowed for signer %X", txSize, policy.MaxTxSize, signer,
+			)
+		}
+
+		if !simulate && policy.MaxGas > 0 && gasTx.GetGas() > policy.MaxGas {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidGasLimit,
+				"tx gas limit %d exceeds the maximum gas %d allowed for signer %X", gasTx.GetGas(), policy.MaxGas, signer,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+type mockTxPolicyProvider func(ctx context.Context, signer []byte, acc sdk.AccountI) (ante.TxPolicy, error)
+
+func (m mockTxPolicyProvider) TxPolicy(ctx context.Context, signer []byte, acc sdk.AccountI) (ante.TxPolicy, error) {
+	return m(ctx, signer, acc)
+}
+
+func TestTxPolicyDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	gasLimit := testdata.NewTestGasLimit()
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	txSize := uint64(len(tx.Bytes()))
+	errPolicy := errors.New("policy error")
+
+	// newAccountPolicy only restricts the signers which do not exist in state.
+	newAccountPolicy := mockTxPolicyProvider(func(_ context.Context, _ []byte, acc sdk.AccountI) (ante.TxPolicy, error) {
+		if acc != nil {
+			return ante.TxPolicy{}, nil
+		}
+		return ante.TxPolicy{MaxGas: 1, MaxTxSize: 1}, nil
+	})
+
+	testCases := []struct {
+		name     string
+		provider ante.TxPolicyProvider
+		signer   sdk.AccAddress
+		simulate bool
+		expErr   error
+	}{
+		{
+			name: "no provider",
+		},
+		{
+			name: "no ceilings",
+			provider: mockTxPolicyProvider(func(context.Context, []byte, sdk.AccountI) (ante.TxPolicy, error) {
+				return ante.TxPolicy{}, nil
+			}),
+		},
+		{
+			name: "within ceilings",
+			provider: mockTxPolicyProvider(func(context.Context, []byte, sdk.AccountI) (ante.TxPolicy, error) {
+				return ante.TxPolicy{MaxGas: gasLimit, MaxTxSize: txSize}, nil
+			}),
+		},
+		{
+			name: "tx size ceiling exceeded",
+			provider: mockTxPolicyProvider(func(context.Context, []byte, sdk.AccountI) (ante.TxPolicy, error) {
+				return ante.TxPolicy{MaxTxSize: txSize - 1}, nil
+			}),
+			expErr: sdkerrors.ErrTxTooLarge,
+		},
+		{
+			name: "gas ceiling exceeded",
+			provider: mockTxPolicyProvider(func(context.Context, []byte, sdk.AccountI) (ante.TxPolicy, error) {
+				return ante.TxPolicy{MaxGas: gasLimit - 1}, nil
+			}),
+			expErr: sdkerrors.ErrInvalidGasLimit,
+		},
+		{
+			name: "gas ceiling not enforced in simulation",
+			provider: mockTxPolicyProvider(func(context.Context, []byte, sdk.AccountI) (ante.TxPolicy, error) {
+				return ante.TxPolicy{MaxGas: gasLimit - 1}, nil
+			}),
+			simulate: true,
+		},
+		{
+			name:     "existing account not restricted",
+			provider: newAccountPolicy,
+		},
+		{
+			name:     "new account restricted",
+			provider: newAccountPolicy,
+			signer:   addr2,
+			expErr:   sdkerrors.ErrTxTooLarge,
+		},
+		{
+			name: "provider error",
+			provider: mockTxPolicyProvider(func(context.Context, []byte, sdk.AccountI) (ante.TxPolicy, error) {
+				return ante.TxPolicy{}, errPolicy
+			}),
+			expErr: errPolicy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testTx := tx
+			if tc.signer != nil {
+				builder := suite.clientCtx.TxConfig.NewTxBuilder()
+				require.NoError(t, builder.SetMsgs(testdata.NewTestMsg(tc.signer)))
+				builder.SetGasLimit(gasLimit)
+				testTx = builder.GetTx()
+			}
+
+			ctx := suite.ctx
+			if tc.simulate {
+				ctx = ctx.WithExecMode(sdk.ExecModeSimulate)
+			}
+
+			tpd := ante.NewTxPolicyDecorator(suite.accountKeeper, tc.provider)
+			antehandler := sdk.ChainAnteDecorators(tpd)
+			_, err := antehandler(ctx, testTx, tc.simulate)
+
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
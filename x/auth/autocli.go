@@ -74,6 +74,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod:      "PubKeyRotations",
+					Use:            "pub-key-rotations <address>",
+					Short:          "Query the public key rotations of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "AccountNumberInfo",
+					Use:       "account-number-info",
+					Short:     "Query the next account number and the highest account number in use",
+				},
+				{
+					RpcMethod: "AccountNumberAudit",
+					Use:       "account-number-audit",
+					Short:     "Scan all the accounts for account number gaps and collisions",
+				},
+				{
+					RpcMethod: "AnteRejections",
+					Use:       "ante-rejections",
+					Short:     "Query the number of transactions rejected by each ante decorator of the node, by error code",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -83,10 +104,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
 					Short:          "Submit a proposal to update auth module params. Note: the entire params must be provided.",
-					Example:        fmt.Sprintf(`%s tx auth update-params-proposal '{ "max_memo_characters": 0, "tx_sig_limit": 0, "tx_size_cost_per_byte": 0, "sig_verify_cost_ed25519": 0, "sig_verify_cost_secp256k1": 0 }'`, version.AppName),
+					Example:        fmt.Sprintf(`%s tx auth update-params-proposal '{ "max_memo_characters": 0, "tx_sig_limit": 0, "tx_size_cost_per_byte": 0, "sig_verify_cost_ed25519": 0, "sig_verify_cost_secp256k1": 0, "pub_key_rotation_timelock": "0s" }'`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "RotatePubKey",
+					Use:            "rotate-pub-key <new-pub-key>",
+					Short:          "Replace the public key of the signer account. The transaction must be signed with the current public key.",
+					Example:        fmt.Sprintf(`%s tx auth rotate-pub-key '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8CPDrTpHBROE1sP93ZJVBw2pUlBOyCtc7Fr3l6gnBy6"}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_pub_key"}},
+				},
 			},
 		},
 	}
@@ -39,6 +39,7 @@ type ModuleInputs struct {
 	AccountI                func() sdk.AccountI           `optional:"true"`
 
 	ExtensionOptionChecker ante.ExtensionOptionChecker `optional:"true"`
+	TxPolicyProvider       ante.TxPolicyProvider       `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -75,6 +76,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	k := keeper.NewAccountKeeper(in.Environment, in.Cdc, in.AccountI, in.AccountsModKeeper, maccPerms, in.AddressCodec, in.Config.Bech32Prefix, auth)
 	m := NewAppModule(in.Cdc, k, in.AccountsModKeeper, in.RandomGenesisAccountsFn, in.ExtensionOptionChecker)
+	m.txPolicyProvider = in.TxPolicyProvider
 
 	return ModuleOutputs{AccountKeeper: k, Module: m}
 }
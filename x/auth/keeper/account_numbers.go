@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountNumberInfo returns the account number which will be assigned to the next
+// created account and the highest account number in use, by either x/auth or
+// x/accounts accounts. The high-water mark is zero when there is no account.
+func (ak AccountKeeper) AccountNumberInfo(ctx context.Context) (next, highWaterMark uint64, err error) {
+	next, err = ak.AccountsModKeeper.PeekAccountNumber(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// the number index is ordered, so its last key is the highest x/auth account number.
+	iter, err := ak.Accounts.Indexes.Number.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return 0, 0, err
+	}
+	defer iter.Close()
+	if iter.Valid() {
+		key, err := iter.FullKey()
+		if err != nil {
+			return 0, 0, err
+		}
+		highWaterMark = key.K1()
+	}
+
+	err = ak.AccountsModKeeper.IterateAccountNumbers(ctx, func(_ []byte, accNum uint64) (bool, error) {
+		highWaterMark = max(highWaterMark, accNum)
+		return false, nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return next, highWaterMark, nil
+}
+
+// AuditAccountNumbers scans the account numbers of all the x/auth and x/accounts
+// accounts and reports the unused account numbers below the high-water mark and the
+// account numbers assigned to more than one account. Gaps are expected, for instance
+// when accounts are pruned, while collisions and a next account number not above the
+// high-water mark denote a corrupted state which can be fixed with
+// ResequenceAccountNumbersUnsafe.
+//
+// The whole account set is loaded in memory, hence this must not be called in a
+// transaction.
+func (ak AccountKeeper) AuditAccountNumbers(ctx context.Context) (types.AccountNumberAudit, error) {
+	audit := types.AccountNumberAudit{}
+
+	owners, err := ak.accountNumberOwners(ctx)
+	if err != nil {
+		return audit, err
+	}
+
+	audit.NextAccountNumber, err = ak.AccountsModKeeper.PeekAccountNumber(ctx)
+	if err != nil {
+		return audit, err
+	}
+
+	accNums := make([]uint64, 0, len(owners))
+	for accNum := range owners {
+		accNums = append(accNums, accNum)
+	}
+	sort.Slice(accNums, func(i, j int) bool { return accNums[i] < accNums[j] })
+
+	expected := uint64(0)
+	for _, accNum := range accNums {
+		if accNum > expected {
+			audit.Gaps = append(audit.Gaps, types.AccountNumberRange{Start: expected, End: accNum - 1})
+		}
+		expected = accNum + 1
+
+		accs := owners[accNum]
+		audit.TotalAccounts += uint64(len(accs))
+		if len(accs) < 2 {
+			continue
+		}
+
+		collision := types.AccountNumberCollision{AccountNumber: accNum}
+		for _, acc := range accs {
+			addr, err := ak.addressCodec.BytesToString(acc.addr)
+			if err != nil {
+				return audit, err
+			}
+			collision.Addresses = append(collision.Addresses, addr)
+		}
+		audit.Collisions = append(audit.Collisions, collision)
+	}
+
+	if len(accNums) > 0 {
+		audit.HighWaterMark = accNums[len(accNums)-1]
+	}
+
+	return audit, nil
+}
+
+// ResequenceAccountNumbersUnsafe fixes the account number collisions reported by
+// AuditAccountNumbers and makes sure the next account number is above the high-water
+// mark, so that no new account can collide with an existing one. It is meant to be
+// run in an upgrade handler after state surgery or a faulty migration.
+//
+// For each collision, the x/accounts account, or the x/auth account with the lowest
+// address if there is none, keeps the account number while the other x/auth accounts
+// are assigned new account numbers, in address order, so that the outcome is
+// deterministic. x/accounts accounts cannot be renumbered as their state is keyed by
+// account number, hence a collision between two of them is an error. Gaps are left
+// as is, account numbers are never reused.
+//
+// Note that the account number is part of the sign bytes, renumbered accounts must
+// sign their transactions with their new account number.
+func (ak AccountKeeper) ResequenceAccountNumbersUnsafe(ctx context.Context) error {
+	owners, err := ak.accountNumberOwners(ctx)
+	if err != nil {
+		return err
+	}
+
+	next, err := ak.AccountsModKeeper.PeekAccountNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	var highWaterMark uint64
+	accNums := make([]uint64, 0, len(owners))
+	for accNum, accs := range owners {
+		highWaterMark = max(highWaterMark, accNum)
+		if len(accs) > 1 {
+			accNums = append(accNums, accNum)
+		}
+	}
+	sort.Slice(accNums, func(i, j int) bool { return accNums[i] < accNums[j] })
+
+	if len(owners) > 0 && next <= highWaterMark {
+		if err := ak.AccountsModKeeper.InitAccountNumberSeqUnsafe(ctx, highWaterMark+1); err != nil {
+			return err
+		}
+	}
+
+	for _, accNum := range accNums {
+		accs := owners[accNum]
+
+		keep := 0
+		for i, acc := range accs {
+			if !acc.accountsMod {
+				continue
+			}
+			if accs[keep].accountsMod && keep != i {
+				return fmt.Errorf("account number %d is assigned to more than one x/accounts account", accNum)
+			}
+			keep = i
+		}
+
+		for i, acc := range accs {
+			if i == keep {
+				continue
+			}
+
+			account, err := ak.Accounts.Get(ctx, acc.addr)
+			if err != nil {
+				return err
+			}
+			newAccNum, err := ak.AccountsModKeeper.NextAccountNumber(ctx)
+			if err != nil {
+				return err
+			}
+			if err := account.SetAccountNumber(newAccNum); err != nil {
+				return err
+			}
+			if err := ak.Accounts.Set(ctx, acc.addr, account); err != nil {
+				return err
+			}
+			addr, err := ak.addressCodec.BytesToString(acc.addr)
+			if err != nil {
+				return err
+			}
+			ak.Logger.Info("account number reassigned", "address", addr, "old", accNum, "new", newAccNum)
+		}
+
+		// renumbering an account drops the index entry of the number it held, which
+		// might have pointed to the account keeping it.
+		if accs[keep].auth {
+			account, err := ak.Accounts.Get(ctx, accs[keep].addr)
+			if err != nil {
+				return err
+			}
+			if err := ak.Accounts.Set(ctx, accs[keep].addr, account); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// accountNumberOwner is an account holding an account number.
+type accountNumberOwner struct {
+	addr sdk.AccAddress
+	// auth and accountsMod tell whether the account is an x/auth or an x/accounts account,
+	// or both.
+	auth, accountsMod bool
+}
+
+// accountNumberOwners returns the accounts holding each account number in use, ordered
+// by address. An address found in both x/auth and x/accounts is only returned once.
+func (ak AccountKeeper) accountNumberOwners(ctx context.Context) (map[uint64][]accountNumberOwner, error) {
+	owners := map[uint64][]accountNumberOwner{}
+
+	err := ak.Accounts.Walk(ctx, nil, func(addr sdk.AccAddress, acc sdk.AccountI) (bool, error) {
+		owners[acc.GetAccountNumber()] = append(owners[acc.GetAccountNumber()], accountNumberOwner{addr: addr, auth: true})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ak.AccountsModKeeper.IterateAccountNumbers(ctx, func(addr []byte, accNum uint64) (bool, error) {
+		owners[accNum] = append(owners[accNum], accountNumberOwner{addr: addr, accountsMod: true})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for accNum, accs := range owners {
+		sort.SliceStable(accs, func(i, j int) bool { return bytes.Compare(accs[i].addr, accs[j].addr) < 0 })
+
+		deduped := accs[:1]
+		for _, acc := range accs[1:] {
+			last := &deduped[len(deduped)-1]
+			if bytes.Equal(last.addr, acc.addr) {
+				last.auth = last.auth || acc.auth
+				last.accountsMod = last.accountsMod || acc.accountsMod
+				continue
+			}
+			deduped = append(deduped, acc)
+		}
+		owners[accNum] = deduped
+	}
+
+	return owners, nil
+}
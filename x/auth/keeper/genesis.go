@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -37,6 +39,21 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		ak.SetAccount(ctx, acc)
 	}
 
+	// rotations are stored in the order they happened for each account.
+	rotationIndexes := make(map[string]uint64)
+	for _, rotation := range data.PubKeyRotations {
+		addr, err := ak.addressCodec.StringToBytes(rotation.Address)
+		if err != nil {
+			return err
+		}
+
+		index := rotationIndexes[rotation.Address]
+		if err := ak.PubKeyRotations.Set(ctx, collections.Join(sdk.AccAddress(addr), index), rotation); err != nil {
+			return err
+		}
+		rotationIndexes[rotation.Address] = index + 1
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 	return nil
 }
@@ -54,5 +71,14 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	err = ak.PubKeyRotations.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], rotation types.PubKeyRotation) (stop bool, err error) {
+		genState.PubKeyRotations = append(genState.PubKeyRotations, rotation)
+		return false, nil
+	})
+	return genState, err
 }
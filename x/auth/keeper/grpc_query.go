@@ -9,6 +9,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -257,6 +259,65 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 	}, nil
 }
 
+// PubKeyRotations returns the public key rotations of an account.
+func (s queryServer) PubKeyRotations(ctx context.Context, req *types.QueryPubKeyRotationsRequest) (*types.QueryPubKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	rotations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.PubKeyRotations,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], value types.PubKeyRotation) (types.PubKeyRotation, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPubKeyRotationsResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
+
+// AccountNumberInfo returns the next account number and the account numbers high-water mark.
+func (s queryServer) AccountNumberInfo(ctx context.Context, req *types.QueryAccountNumberInfoRequest) (*types.QueryAccountNumberInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	next, highWaterMark, err := s.k.AccountNumberInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountNumberInfoResponse{NextAccountNumber: next, HighWaterMark: highWaterMark}, nil
+}
+
+// AccountNumberAudit returns the account number gaps and collisions.
+func (s queryServer) AccountNumberAudit(ctx context.Context, req *types.QueryAccountNumberAuditRequest) (*types.QueryAccountNumberAuditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	audit, err := s.k.AuditAccountNumbers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountNumberAuditResponse{Audit: audit}, nil
+}
+
 var (
 	errNotXAccount              = errors.New("not an x/account")
 	errInvalidLegacyAccountImpl = errors.New("invalid legacy account implementation")
@@ -279,3 +340,12 @@ func (s queryServer) getFromXAccounts(ctx context.Context, address []byte) (*typ
 	}
 	return typedResp, nil
 }
+
+// AnteRejections returns the number of transactions rejected by each ante decorator of the node, by error code.
+func (s queryServer) AnteRejections(_ context.Context, req *types.QueryAnteRejectionsRequest) (*types.QueryAnteRejectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryAnteRejectionsResponse{Rejections: s.k.AnteRejections.Counts()}, nil
+}
@@ -537,3 +537,20 @@ func (suite *KeeperTestSuite) TestQueryAccountInfoWithoutPubKey() {
 	suite.Require().Equal(addr.String(), res.Info.Address)
 	suite.Require().Nil(res.Info.PubKey)
 }
+
+func (suite *KeeperTestSuite) TestQueryAnteRejections() {
+	res, err := suite.queryClient.AnteRejections(context.Background(), &types.QueryAnteRejectionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Rejections)
+
+	suite.accountKeeper.AnteRejections.Record("SigVerificationDecorator", "sdk", 4)
+	suite.accountKeeper.AnteRejections.Record("DeductFeeDecorator", "sdk", 13)
+	suite.accountKeeper.AnteRejections.Record("SigVerificationDecorator", "sdk", 4)
+
+	res, err = suite.queryClient.AnteRejections(context.Background(), &types.QueryAnteRejectionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AnteRejectionCount{
+		{Decorator: "DeductFeeDecorator", Codespace: "sdk", Code: 13, Count: 1},
+		{Decorator: "SigVerificationDecorator", Codespace: "sdk", Code: 4, Count: 2},
+	}, res.Rejections)
+}
@@ -108,6 +108,12 @@ type AccountKeeper struct {
 	accountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// PubKeyRotations key: AccAddr+RotationIndex | value: PubKeyRotation
+	PubKeyRotations collections.Map[collections.Pair[sdk.AccAddress, uint64], types.PubKeyRotation]
+
+	// AnteRejections counts the transactions rejected by the ante decorators of the node, when
+	// passed to the ante handler options. It is not part of the state.
+	AnteRejections *types.AnteRejectionCounter
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -141,6 +147,8 @@ func NewAccountKeeper(
 		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		accountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		PubKeyRotations:   collections.NewMap(sb, types.PubKeyRotationsKeyPrefix, "pub_key_rotations", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.PubKeyRotation](cdc)),
+		AnteRejections:    types.NewAnteRejectionCounter(),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	storetypes "cosmossdk.io/store/types"
@@ -19,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -70,6 +72,9 @@ func (suite *KeeperTestSuite) SetupTest() {
 		accNum++
 		return currNum, nil
 	})
+	suite.acctsModKeeper.EXPECT().PeekAccountNumber(gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context) (uint64, error) {
+		return accNum, nil
+	})
 
 	maccPerms := map[string][]string{
 		"fee_collector":          nil,
@@ -280,3 +285,62 @@ func (suite *KeeperTestSuite) TestMigrateAccountNumberUnsafe() {
 	require.NoError(suite.T(), err)
 	require.Empty(suite.T(), val)
 }
+
+func (suite *KeeperTestSuite) TestAccountNumberAuditAndResequence() {
+	suite.SetupTest() // reset
+	ctx := suite.ctx
+
+	addrs := simtestutil.CreateIncrementalAccounts(5)
+	for _, addr := range addrs[:4] {
+		suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	// account number 2 is pruned, leaving a gap.
+	suite.accountKeeper.RemoveAccount(ctx, suite.accountKeeper.GetAccount(ctx, addrs[2]))
+
+	// the x/accounts account shares its account number with addrs[1].
+	accountsModAccNums := map[string]uint64{string(addrs[4]): 1}
+	suite.acctsModKeeper.EXPECT().IterateAccountNumbers(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, cb func(addr []byte, accNum uint64) (bool, error)) error {
+			for addr, accNum := range accountsModAccNums {
+				if _, err := cb([]byte(addr), accNum); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	res, err := suite.queryClient.AccountNumberAudit(ctx, &types.QueryAccountNumberAuditRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), res.Audit.NextAccountNumber)
+	suite.Require().Equal(uint64(3), res.Audit.HighWaterMark)
+	suite.Require().Equal(uint64(4), res.Audit.TotalAccounts)
+	suite.Require().Equal([]types.AccountNumberRange{{Start: 2, End: 2}}, res.Audit.Gaps)
+	suite.Require().Len(res.Audit.Collisions, 1)
+	suite.Require().Equal(uint64(1), res.Audit.Collisions[0].AccountNumber)
+	suite.Require().ElementsMatch([]string{addrs[1].String(), addrs[4].String()}, res.Audit.Collisions[0].Addresses)
+
+	// the x/auth account is renumbered while the x/accounts account keeps its number.
+	suite.Require().NoError(suite.accountKeeper.ResequenceAccountNumbersUnsafe(ctx))
+	suite.Require().Equal(uint64(4), suite.accountKeeper.GetAccount(ctx, addrs[1]).GetAccountNumber())
+	addr, err := suite.accountKeeper.Accounts.Indexes.Number.MatchExact(ctx, 4)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[1], addr)
+	_, err = suite.accountKeeper.Accounts.Indexes.Number.MatchExact(ctx, 1)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	audit, err := suite.accountKeeper.AuditAccountNumbers(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(audit.Collisions)
+	suite.Require().Equal(uint64(5), audit.NextAccountNumber)
+	suite.Require().Equal(uint64(4), audit.HighWaterMark)
+
+	// an account number above the next account number bumps the sequence.
+	accountsModAccNums[string(addrs[4])] = 10
+	info, err := suite.queryClient.AccountNumberInfo(ctx, &types.QueryAccountNumberInfoRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), info.NextAccountNumber)
+	suite.Require().Equal(uint64(10), info.HighWaterMark)
+
+	suite.acctsModKeeper.EXPECT().InitAccountNumberSeqUnsafe(gomock.Any(), uint64(11)).Return(nil)
+	suite.Require().NoError(suite.accountKeeper.ResequenceAccountNumbersUnsafe(ctx))
+}
@@ -5,13 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/core/event"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -93,13 +97,19 @@ func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAcc
 		return nil, status.Error(codes.InvalidArgument, "only BaseAccount can be migrated")
 	}
 
+	if msg.AccountType == "" {
+		return nil, status.Error(codes.InvalidArgument, "account type cannot be empty")
+	}
+
 	// unwrap any msg
 	initMsg, err := unpackAnyRaw(msg.AccountInitMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	initResp, err := ms.ak.AccountsModKeeper.MigrateLegacyAccount(ctx, signer, acc.GetAccountNumber(), msg.AccountType, initMsg)
+	// the address, account number and sequence of the account are kept, so that
+	// transactions signed for the legacy account can't be replayed.
+	initResp, err := ms.ak.AccountsModKeeper.MigrateLegacyAccount(ctx, signer, acc.GetAccountNumber(), acc.GetSequence(), msg.AccountType, initMsg)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +117,16 @@ func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAcc
 	// account is then removed from state
 	ms.ak.RemoveAccount(ctx, acc)
 
+	if err := ms.ak.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeMigrateAccount,
+		event.NewAttribute(types.AttributeKeyAddress, msg.Signer),
+		event.NewAttribute(types.AttributeKeyAccountType, msg.AccountType),
+		event.NewAttribute(types.AttributeKeyAccountNumber, strconv.FormatUint(acc.GetAccountNumber(), 10)),
+		event.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(acc.GetSequence(), 10)),
+	); err != nil {
+		return nil, err
+	}
+
 	initRespAny, err := codectypes.NewAnyWithValue(initResp)
 	if err != nil {
 		return nil, err
@@ -115,6 +135,28 @@ func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAcc
 	return &types.MsgMigrateAccountResponse{InitResponse: initRespAny}, nil
 }
 
+func (ms msgServer) RotatePubKey(ctx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	signer, err := ms.ak.AddressCodec().StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrap("new public key cannot be empty")
+	}
+
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrapf("expected %T, got %T", (cryptotypes.PubKey)(nil), msg.NewPubKey.GetCachedValue())
+	}
+
+	if err := ms.ak.RotatePubKey(ctx, signer, newPubKey); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
+
 func unpackAnyRaw(m *codectypes.Any) (gogoproto.Message, error) {
 	if m == nil {
 		return nil, fmt.Errorf("cannot unpack nil any")
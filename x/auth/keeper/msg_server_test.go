@@ -2,14 +2,19 @@ package keeper_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/runtime/protoiface"
 
+	"cosmossdk.io/core/header"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -200,3 +205,112 @@ func (s *KeeperTestSuite) TestNonAtomicExec() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	_, oldPk, addr := testdata.KeyTestPubAddr()
+	_, newPk, _ := testdata.KeyTestPubAddr()
+	_, _, noPkAddr := testdata.KeyTestPubAddr()
+
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccount(s.ctx, types.NewBaseAccount(addr, oldPk, 0, 0)))
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, noPkAddr))
+
+	params := types.DefaultParams()
+	params.PubKeyRotationTimelock = time.Hour
+	s.Require().NoError(s.accountKeeper.Params.Set(s.ctx, params))
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPk)
+	s.Require().NoError(err)
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPk)
+	s.Require().NoError(err)
+	// x = 5 is not the coordinate of a point of the secp256k1 curve
+	offCurveKey := make([]byte, secp256k1.PubKeySize)
+	offCurveKey[0], offCurveKey[secp256k1.PubKeySize-1] = 2, 5
+	offCurvePkAny, err := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: offCurveKey})
+	s.Require().NoError(err)
+
+	now := time.Now().UTC()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: now})
+
+	testCases := []struct {
+		name      string
+		ctx       sdk.Context
+		req       *types.MsgRotatePubKey
+		expErrMsg string
+	}{
+		{
+			name:      "error: invalid signer",
+			ctx:       ctx,
+			req:       &types.MsgRotatePubKey{Signer: "invalid_signer", NewPubKey: newPkAny},
+			expErrMsg: "invalid signer address",
+		},
+		{
+			name:      "error: empty new public key",
+			ctx:       ctx,
+			req:       &types.MsgRotatePubKey{Signer: addr.String()},
+			expErrMsg: "new public key cannot be empty",
+		},
+		{
+			name:      "error: account without public key",
+			ctx:       ctx,
+			req:       &types.MsgRotatePubKey{Signer: noPkAddr.String(), NewPubKey: newPkAny},
+			expErrMsg: "has no public key set",
+		},
+		{
+			name:      "error: same public key",
+			ctx:       ctx,
+			req:       &types.MsgRotatePubKey{Signer: addr.String(), NewPubKey: oldPkAny},
+			expErrMsg: "must be different",
+		},
+		{
+			name:      "error: new public key not on curve",
+			ctx:       ctx,
+			req:       &types.MsgRotatePubKey{Signer: addr.String(), NewPubKey: offCurvePkAny},
+			expErrMsg: "secp256k1 key is not on curve",
+		},
+		{
+			name: "valid rotation",
+			ctx:  ctx,
+			req:  &types.MsgRotatePubKey{Signer: addr.String(), NewPubKey: newPkAny},
+		},
+		{
+			name:      "error: timelocked",
+			ctx:       ctx.WithHeaderInfo(header.Info{Height: 11, Time: now.Add(time.Minute)}),
+			req:       &types.MsgRotatePubKey{Signer: addr.String(), NewPubKey: oldPkAny},
+			expErrMsg: "cannot be rotated before",
+		},
+		{
+			name: "valid rotation after timelock",
+			ctx:  ctx.WithHeaderInfo(header.Info{Height: 12, Time: now.Add(time.Hour)}),
+			req:  &types.MsgRotatePubKey{Signer: addr.String(), NewPubKey: oldPkAny},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.RotatePubKey(tc.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	pk, err := s.accountKeeper.GetPubKey(s.ctx, addr)
+	s.Require().NoError(err)
+	s.Require().True(oldPk.Equals(pk))
+
+	res, err := s.queryClient.PubKeyRotations(s.ctx, &types.QueryPubKeyRotationsRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rotations, 2)
+	s.Require().Equal(int64(10), res.Rotations[0].Height)
+	s.Require().Equal(newPkAny.Value, res.Rotations[0].NewPubKey.Value)
+	s.Require().Equal(int64(12), res.Rotations[1].Height)
+	s.Require().Equal(oldPkAny.Value, res.Rotations[1].NewPubKey.Value)
+
+	// the rotation history is part of the genesis
+	genState, err := s.accountKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(genState.PubKeyRotations, 2)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RotatePubKey replaces the public key of the base account at the given address.
+// The account must already have a public key set, which guarantees that the
+// rotation was signed by the current key. The new key is validated like the keys
+// set by the ante handler. When a timelock is set in the params,
+// two rotations of the same account must be at least the timelock apart.
+// Every rotation is recorded for auditing purposes.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, addr sdk.AccAddress, newPubKey cryptotypes.PubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", addr)
+	}
+
+	baseAcc, ok := acc.(*types.BaseAccount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("only BaseAccount can rotate its public key, got %T", acc)
+	}
+
+	oldPubKey := baseAcc.GetPubKey()
+	if oldPubKey == nil {
+		return sdkerrors.ErrInvalidPubKey.Wrapf("account %s has no public key set", addr)
+	}
+
+	if newPubKey == nil || len(newPubKey.Bytes()) == 0 {
+		return sdkerrors.ErrInvalidPubKey.Wrap("new public key cannot be empty")
+	}
+	if _, ok := newPubKey.(*types.ModuleCredential); ok {
+		return sdkerrors.ErrInvalidPubKey.Wrap("cannot rotate to a module credential")
+	}
+	if err := types.VerifyIsOnCurve(newPubKey); err != nil {
+		return err
+	}
+	if oldPubKey.Equals(newPubKey) {
+		return sdkerrors.ErrInvalidPubKey.Wrap("new public key must be different from the current one")
+	}
+
+	headerInfo := ak.HeaderService.HeaderInfo(ctx)
+
+	lastRotation, index, err := ak.lastPubKeyRotation(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if lastRotation != nil {
+		params := ak.GetParams(ctx)
+		unlockTime := lastRotation.Time.Add(params.PubKeyRotationTimelock)
+		if headerInfo.Time.Before(unlockTime) {
+			return sdkerrors.ErrUnauthorized.Wrapf("public key of account %s cannot be rotated before %s", addr, unlockTime)
+		}
+		index++
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	rotation, err := types.NewPubKeyRotation(addrStr, oldPubKey, newPubKey, headerInfo.Height, headerInfo.Time)
+	if err != nil {
+		return err
+	}
+
+	if err := ak.PubKeyRotations.Set(ctx, collections.Join(addr, index), rotation); err != nil {
+		return err
+	}
+
+	if err := baseAcc.SetPubKey(newPubKey); err != nil {
+		return err
+	}
+	ak.SetAccount(ctx, baseAcc)
+
+	return ak.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRotatePubKey,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyOldPubKey, oldPubKey.String()),
+		event.NewAttribute(types.AttributeKeyNewPubKey, newPubKey.String()),
+	)
+}
+
+// lastPubKeyRotation returns the latest public key rotation of an account and
+// its index, or nil if the account public key was never rotated.
+func (ak AccountKeeper) lastPubKeyRotation(ctx context.Context, addr sdk.AccAddress) (*types.PubKeyRotation, uint64, error) {
+	iter, err := ak.PubKeyRotations.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr).Descending())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, 0, nil
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &kv.Value, kv.Key.K2(), nil
+}
@@ -46,6 +46,7 @@ type AppModule struct {
 	accountsModKeeper types.AccountsModKeeper
 	cdc               codec.Codec
 	extOptChecker     ante.ExtensionOptionChecker
+	txPolicyProvider  ante.TxPolicyProvider
 }
 
 // IsAppModule implements the appmodule.AppModule interface.
@@ -160,6 +161,7 @@ func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 		ante.NewValidateBasicDecorator(am.accountKeeper.GetEnvironment()),
 		ante.NewTxTimeoutHeightDecorator(am.accountKeeper.GetEnvironment()),
 		ante.NewValidateMemoDecorator(am.accountKeeper),
+		ante.NewTxPolicyDecorator(am.accountKeeper, am.txPolicyProvider),
 		ante.NewConsumeGasForTxSizeDecorator(am.accountKeeper),
 		ante.NewValidateSigCountDecorator(am.accountKeeper),
 		ante.NewExtensionOptionsDecorator(am.extOptChecker),
@@ -170,8 +172,12 @@ func (am AppModule) TxValidator(ctx context.Context, tx transaction.Tx) error {
 		return fmt.Errorf("invalid tx type %T, expected sdk.Tx", tx)
 	}
 
+	simulate := am.accountKeeper.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate
 	for _, validator := range validators {
 		if err := validator.ValidateTx(ctx, sdkTx); err != nil {
+			if !simulate {
+				ante.RecordRejection(am.accountKeeper.AnteRejections, ante.DecoratorName(validator), err)
+			}
 			return err
 		}
 	}
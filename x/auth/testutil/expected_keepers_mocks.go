@@ -127,6 +127,20 @@ func (mr *MockAccountsModKeeperMockRecorder) InitAccountNumberSeqUnsafe(ctx, cur
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitAccountNumberSeqUnsafe", reflect.TypeOf((*MockAccountsModKeeper)(nil).InitAccountNumberSeqUnsafe), ctx, currentAccNum)
 }
 
+// IterateAccountNumbers mocks base method.
+func (m *MockAccountsModKeeper) IterateAccountNumbers(ctx context.Context, cb func([]byte, uint64) (bool, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateAccountNumbers", ctx, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateAccountNumbers indicates an expected call of IterateAccountNumbers.
+func (mr *MockAccountsModKeeperMockRecorder) IterateAccountNumbers(ctx, cb any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAccountNumbers", reflect.TypeOf((*MockAccountsModKeeper)(nil).IterateAccountNumbers), ctx, cb)
+}
+
 // IsAccountsModuleAccount mocks base method.
 func (m *MockAccountsModKeeper) IsAccountsModuleAccount(ctx context.Context, accountAddr []byte) bool {
 	m.ctrl.T.Helper()
@@ -142,18 +156,18 @@ func (mr *MockAccountsModKeeperMockRecorder) IsAccountsModuleAccount(ctx, accoun
 }
 
 // MigrateLegacyAccount mocks base method.
-func (m *MockAccountsModKeeper) MigrateLegacyAccount(ctx context.Context, addr []byte, accNum uint64, accType string, msg transaction.Msg) (transaction.Msg, error) {
+func (m *MockAccountsModKeeper) MigrateLegacyAccount(ctx context.Context, addr []byte, accNum, sequence uint64, accType string, msg transaction.Msg) (transaction.Msg, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigrateLegacyAccount", ctx, addr, accNum, accType, msg)
+	ret := m.ctrl.Call(m, "MigrateLegacyAccount", ctx, addr, accNum, sequence, accType, msg)
 	ret0, _ := ret[0].(transaction.Msg)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MigrateLegacyAccount indicates an expected call of MigrateLegacyAccount.
-func (mr *MockAccountsModKeeperMockRecorder) MigrateLegacyAccount(ctx, addr, accNum, accType, msg any) *gomock.Call {
+func (mr *MockAccountsModKeeperMockRecorder) MigrateLegacyAccount(ctx, addr, accNum, sequence, accType, msg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateLegacyAccount", reflect.TypeOf((*MockAccountsModKeeper)(nil).MigrateLegacyAccount), ctx, addr, accNum, accType, msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateLegacyAccount", reflect.TypeOf((*MockAccountsModKeeper)(nil).MigrateLegacyAccount), ctx, addr, accNum, sequence, accType, msg)
 }
 
 // NextAccountNumber mocks base method.
@@ -171,6 +185,21 @@ func (mr *MockAccountsModKeeperMockRecorder) NextAccountNumber(ctx any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextAccountNumber", reflect.TypeOf((*MockAccountsModKeeper)(nil).NextAccountNumber), ctx)
 }
 
+// PeekAccountNumber mocks base method.
+func (m *MockAccountsModKeeper) PeekAccountNumber(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeekAccountNumber", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeekAccountNumber indicates an expected call of PeekAccountNumber.
+func (mr *MockAccountsModKeeperMockRecorder) PeekAccountNumber(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekAccountNumber", reflect.TypeOf((*MockAccountsModKeeper)(nil).PeekAccountNumber), ctx)
+}
+
 // Query mocks base method.
 func (m *MockAccountsModKeeper) Query(ctx context.Context, accountAddr []byte, queryRequest transaction.Msg) (transaction.Msg, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"sort"
+	"sync"
+)
+
+// AnteRejectionCounter counts the transactions rejected by the ante decorators of a node, by
+// decorator and error code. It is safe for concurrent use.
+type AnteRejectionCounter struct {
+	mu     sync.Mutex
+	counts map[anteRejectionKey]uint64
+}
+
+type anteRejectionKey struct {
+	decorator string
+	codespace string
+	code      uint32
+}
+
+// NewAnteRejectionCounter returns a new AnteRejectionCounter.
+func NewAnteRejectionCounter() *AnteRejectionCounter {
+	return &AnteRejectionCounter{counts: make(map[anteRejectionKey]uint64)}
+}
+
+// Record increments the counter of the transactions rejected by the decorator with the error code.
+func (c *AnteRejectionCounter) Record(decorator, codespace string, code uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts[anteRejectionKey{decorator: decorator, codespace: codespace, code: code}]++
+}
+
+// Counts returns the rejection counters, sorted by decorator, codespace and code.
+func (c *AnteRejectionCounter) Counts() []AnteRejectionCount {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]AnteRejectionCount, 0, len(c.counts))
+	for key, count := range c.counts {
+		res = append(res, AnteRejectionCount{
+			Decorator: key.decorator,
+			Codespace: key.codespace,
+			Code:      key.code,
+			Count:     count,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Decorator != res[j].Decorator {
+			return res[i].Decorator < res[j].Decorator
+		}
+		if res[i].Codespace != res[j].Codespace {
+			return res[i].Codespace < res[j].Codespace
+		}
+		return res[i].Code < res[j].Code
+	})
+
+	return res
+}
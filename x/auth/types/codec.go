@@ -22,6 +22,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential")
 
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(registrar, &MsgRotatePubKey{}, "cosmos-sdk/x/auth/MsgRotatePubKey")
 
 	legacytx.RegisterLegacyAminoCodec(registrar)
 }
@@ -60,5 +61,6 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgNonAtomicExec{},
 		&MsgMigrateAccount{},
+		&MsgRotatePubKey{},
 	)
 }
@@ -0,0 +1,14 @@
+package types
+
+// auth module event types
+const (
+	EventTypeRotatePubKey   = "rotate_pub_key"
+	EventTypeMigrateAccount = "migrate_account"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyOldPubKey     = "old_pub_key"
+	AttributeKeyNewPubKey     = "new_pub_key"
+	AttributeKeyAccountType   = "account_type"
+	AttributeKeyAccountNumber = "account_number"
+	AttributeKeySequence      = "sequence"
+)
@@ -20,6 +20,10 @@ type AccountsModKeeper interface {
 	SendModuleMessage(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error)
 	IsAccountsModuleAccount(ctx context.Context, accountAddr []byte) bool
 	NextAccountNumber(ctx context.Context) (accNum uint64, err error)
+	// PeekAccountNumber returns the next account number without incrementing it.
+	PeekAccountNumber(ctx context.Context) (accNum uint64, err error)
+	// IterateAccountNumbers iterates over the account numbers of the x/accounts accounts.
+	IterateAccountNumbers(ctx context.Context, cb func(addr []byte, accNum uint64) (stop bool, err error)) error
 
 	// Query is used to query an account
 	Query(
@@ -37,6 +41,7 @@ type AccountsModKeeper interface {
 		ctx context.Context,
 		addr []byte, // The current address of the account
 		accNum uint64, // The current account number
+		sequence uint64, // The current sequence of the account
 		accType string, // The account type to migrate to
 		msg transaction.Msg, // The init msg of the account type we're migrating to
 	) (transaction.Msg, error)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -41,6 +42,11 @@ func (g GenesisState) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error
 			return err
 		}
 	}
+	for _, rotation := range g.PubKeyRotations {
+		if err := rotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -73,7 +79,17 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	// the public key of a rotated account does not match its address anymore,
+	// it must match the public key of its latest rotation instead.
+	rotatedPubKeys := make(map[string]cryptotypes.PubKey)
+	for _, rotation := range data.PubKeyRotations {
+		if err := rotation.Validate(); err != nil {
+			return fmt.Errorf("invalid public key rotation found in genesis state; address: %s, error: %w", rotation.Address, err)
+		}
+		rotatedPubKeys[rotation.Address] = rotation.GetNewPubKeyValue()
+	}
+
+	return validateGenAccounts(genAccs, rotatedPubKeys)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -125,6 +141,13 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 
 // ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
 func ValidateGenAccounts(accounts GenesisAccounts) error {
+	return validateGenAccounts(accounts, nil)
+}
+
+// validateGenAccounts validates an array of GenesisAccounts and checks for duplicates.
+// Accounts present in rotatedPubKeys must be base accounts holding the public key
+// of their latest rotation.
+func validateGenAccounts(accounts GenesisAccounts, rotatedPubKeys map[string]cryptotypes.PubKey) error {
 	addrMap := make(map[string]bool, len(accounts))
 
 	for _, acc := range accounts {
@@ -136,6 +159,21 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 
 		addrMap[addrStr] = true
 
+		if newPubKey, ok := rotatedPubKeys[addrStr]; ok {
+			if _, ok := acc.(*BaseAccount); !ok {
+				return fmt.Errorf("invalid account found in genesis state; address: %s, error: public key of a %T cannot be rotated", addrStr, acc)
+			}
+			if newPubKey == nil || acc.GetPubKey() == nil || !acc.GetPubKey().Equals(newPubKey) {
+				return fmt.Errorf("invalid account found in genesis state; address: %s, error: public key does not match its latest rotation", addrStr)
+			}
+			// the public key of a rotated account doesn't derive its address, the rest
+			// of the account is still validated
+			if err := validateRotatedAccount(acc.(*BaseAccount)); err != nil {
+				return fmt.Errorf("invalid account found in genesis state; address: %s, error: %w", addrStr, err)
+			}
+			continue
+		}
+
 		// check account specific validation
 		if err := acc.Validate(); err != nil {
 			return fmt.Errorf("invalid account found in genesis state; address: %s, error: %w", addrStr, err)
@@ -144,6 +182,15 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 	return nil
 }
 
+// validateRotatedAccount validates a base account whose public key was rotated.
+func validateRotatedAccount(acc *BaseAccount) error {
+	if _, err := sdk.AccAddressFromBech32(acc.Address); err != nil {
+		return err
+	}
+
+	return VerifyIsOnCurve(acc.GetPubKey())
+}
+
 // GenesisAccountIterator implements genesis account iteration.
 type GenesisAccountIterator struct{}
 
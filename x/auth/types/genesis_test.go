@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -10,6 +11,8 @@ import (
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -53,6 +56,46 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisRotatedAccounts(t *testing.T) {
+	oldPk := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPk.Address())
+	// x = 5 is not the coordinate of a point of the secp256k1 curve
+	offCurveKey := make([]byte, secp256k1.PubKeySize)
+	offCurveKey[0], offCurveKey[secp256k1.PubKeySize-1] = 2, 5
+
+	specs := map[string]struct {
+		newPk  cryptotypes.PubKey
+		expErr bool
+	}{
+		"rotated account": {
+			newPk: secp256k1.GenPrivKey().PubKey(),
+		},
+		"rotated to a key not on curve": {
+			newPk:  &secp256k1.PubKey{Key: offCurveKey},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			accs, err := types.PackAccounts(types.GenesisAccounts{types.NewBaseAccount(addr, spec.newPk, 0, 0)})
+			require.NoError(t, err)
+			rotation, err := types.NewPubKeyRotation(addr.String(), oldPk, spec.newPk, 1, time.Now())
+			require.NoError(t, err)
+
+			genState := types.NewGenesisState(types.DefaultParams(), nil)
+			genState.Accounts = accs
+			genState.PubKeyRotations = []types.PubKeyRotation{rotation}
+
+			err = types.ValidateGenesis(*genState)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
 	cdc := encodingConfig.Codec
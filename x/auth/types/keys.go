@@ -26,6 +26,9 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// PubKeyRotationsKeyPrefix prefix for the public key rotations history store
+	PubKeyRotationsKeyPrefix = collections.NewPrefix(3)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
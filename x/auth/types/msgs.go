@@ -1,11 +1,16 @@
 package types
 
 import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
 	coretransaction "cosmossdk.io/core/transaction"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+var _ gogoprotoany.UnpackInterfacesMessage = (*MsgRotatePubKey)(nil)
+
 // GetMessages returns the cache values from the MsgNonAtomicExec.Msgs if present.
 func (msg MsgNonAtomicExec) GetMessages() ([]coretransaction.Msg, error) {
 	msgs := make([]coretransaction.Msg, len(msg.Msgs))
@@ -19,3 +24,12 @@ func (msg MsgNonAtomicExec) GetMessages() ([]coretransaction.Msg, error) {
 
 	return msgs, nil
 }
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	if msg.NewPubKey == nil {
+		return nil
+	}
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -35,6 +36,14 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), errors.New("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), errors.New("invalid tx size cost per byte: 0")},
+		{"negative public key rotation timelock", types.Params{
+			MaxMemoCharacters:      types.DefaultMaxMemoCharacters,
+			TxSigLimit:             types.DefaultTxSigLimit,
+			TxSizeCostPerByte:      types.DefaultTxSizeCostPerByte,
+			SigVerifyCostED25519:   types.DefaultSigVerifyCostED25519,
+			SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1,
+			PubKeyRotationTimelock: -time.Second,
+		}, errors.New("public key rotation timelock cannot be negative: -1s")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
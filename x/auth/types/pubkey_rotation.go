@@ -0,0 +1,128 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = (*PubKeyRotation)(nil)
+
+// NewPubKeyRotation creates a new PubKeyRotation record.
+func NewPubKeyRotation(addr string, oldPubKey, newPubKey cryptotypes.PubKey, height int64, t time.Time) (PubKeyRotation, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return PubKeyRotation{}, err
+	}
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return PubKeyRotation{}, err
+	}
+
+	return PubKeyRotation{
+		Address:   addr,
+		OldPubKey: oldPkAny,
+		NewPubKey: newPkAny,
+		Height:    height,
+		Time:      t,
+	}, nil
+}
+
+// GetNewPubKeyValue returns the public key of the account after the rotation,
+// it returns nil if the public key was not unpacked.
+func (r PubKeyRotation) GetNewPubKeyValue() cryptotypes.PubKey {
+	if r.NewPubKey == nil {
+		return nil
+	}
+	pk, _ := r.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk
+}
+
+// Validate performs a stateless validation of the rotation record.
+func (r PubKeyRotation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return err
+	}
+	if r.OldPubKey == nil || r.NewPubKey == nil {
+		return errors.New("public keys of a rotation cannot be empty")
+	}
+	if r.Height < 0 {
+		return errors.New("rotation height cannot be negative")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r PubKeyRotation) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var oldPubKey, newPubKey cryptotypes.PubKey
+	if r.OldPubKey != nil {
+		if err := unpacker.UnpackAny(r.OldPubKey, &oldPubKey); err != nil {
+			return err
+		}
+	}
+	if r.NewPubKey != nil {
+		return unpacker.UnpackAny(r.NewPubKey, &newPubKey)
+	}
+	return nil
+}
+
+// VerifyIsOnCurve checks that secp256k1 and secp256r1 public keys, including the keys of
+// a multisig, are valid points of their curve. Other key types are rejected. Keys without
+// bytes, as used when simulating, are not checked.
+func VerifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
+	// when simulating pubKey.Key will always be nil
+	if pubKey.Bytes() == nil {
+		return nil
+	}
+
+	switch typedPubKey := pubKey.(type) {
+	case *secp256k1.PubKey:
+		pubKeyObject, err := secp256k1dcrd.ParsePubKey(typedPubKey.Bytes())
+		if err != nil {
+			if errors.Is(err, secp256k1dcrd.ErrPubKeyNotOnCurve) {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256k1 key is not on curve")
+			}
+			return err
+		}
+		if !pubKeyObject.IsOnCurve() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256k1 key is not on curve")
+		}
+
+	case *secp256r1.PubKey:
+		pubKeyObject := typedPubKey.Key.PublicKey
+		if !pubKeyObject.IsOnCurve(pubKeyObject.X, pubKeyObject.Y) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key is not on curve")
+		}
+
+	case multisig.PubKey:
+		pubKeysObjects := typedPubKey.GetPubKeys()
+		ok := true
+		for _, pubKeyObject := range pubKeysObjects {
+			if err := VerifyIsOnCurve(pubKeyObject); err != nil {
+				ok = false
+				break
+			}
+		}
+		if !ok {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "some keys are not on curve")
+		}
+
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "unsupported key type: %T", typedPubKey)
+	}
+
+	return nil
+}
@@ -389,6 +389,97 @@ func (m *MsgMigrateAccountResponse) GetInitResponse() *any.Any {
 	return nil
 }
 
+// MsgRotatePubKey defines a message which allows users to replace the public key
+// of their account.
+type MsgRotatePubKey struct {
+	Signer    string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	NewPubKey *any.Any `protobuf:"bytes,2,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+}
+
+func (m *MsgRotatePubKey) Reset()         { *m = MsgRotatePubKey{} }
+func (m *MsgRotatePubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePubKey) ProtoMessage()    {}
+func (*MsgRotatePubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{7}
+}
+func (m *MsgRotatePubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotatePubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePubKey.Merge(m, src)
+}
+func (m *MsgRotatePubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePubKey proto.InternalMessageInfo
+
+func (m *MsgRotatePubKey) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRotatePubKey) GetNewPubKey() *any.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+// MsgRotatePubKeyResponse defines the Msg/RotatePubKey response type.
+type MsgRotatePubKeyResponse struct {
+}
+
+func (m *MsgRotatePubKeyResponse) Reset()         { *m = MsgRotatePubKeyResponse{} }
+func (m *MsgRotatePubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotatePubKeyResponse) ProtoMessage()    {}
+func (*MsgRotatePubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{8}
+}
+func (m *MsgRotatePubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotatePubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotatePubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotatePubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotatePubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotatePubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotatePubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotatePubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotatePubKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.auth.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateParamsResponse")
@@ -397,54 +488,61 @@ func init() {
 	proto.RegisterType((*MsgNonAtomicExecResponse)(nil), "cosmos.auth.v1beta1.MsgNonAtomicExecResponse")
 	proto.RegisterType((*MsgMigrateAccount)(nil), "cosmos.auth.v1beta1.MsgMigrateAccount")
 	proto.RegisterType((*MsgMigrateAccountResponse)(nil), "cosmos.auth.v1beta1.MsgMigrateAccountResponse")
+	proto.RegisterType((*MsgRotatePubKey)(nil), "cosmos.auth.v1beta1.MsgRotatePubKey")
+	proto.RegisterType((*MsgRotatePubKeyResponse)(nil), "cosmos.auth.v1beta1.MsgRotatePubKeyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
 
 var fileDescriptor_c2d62bd9c4c212e5 = []byte{
-	// 667 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0x8d, 0xfb, 0x42, 0x99, 0xf4, 0xe9, 0x44, 0xaa, 0xeb, 0x22, 0xd3, 0x9a, 0x87, 0x42, 0x45,
-	0xc6, 0x7d, 0x20, 0x10, 0x59, 0x54, 0x4a, 0x50, 0x85, 0x58, 0x18, 0x21, 0x43, 0x59, 0xb0, 0xa0,
-	0x72, 0x9c, 0x61, 0x6a, 0x51, 0x7b, 0xac, 0x99, 0x49, 0x69, 0x76, 0x88, 0x25, 0x2b, 0x96, 0x7c,
-	0x02, 0xcb, 0x2e, 0xfa, 0x11, 0x55, 0x57, 0x55, 0x17, 0x88, 0x0d, 0x08, 0xb5, 0x8b, 0xfe, 0x06,
-	0xf2, 0x78, 0xdc, 0xe2, 0xd4, 0x81, 0x88, 0x4d, 0x62, 0xcf, 0x3d, 0xf7, 0xde, 0x73, 0xce, 0xbd,
-	0x63, 0x70, 0xdd, 0x23, 0x2c, 0x20, 0xcc, 0x72, 0x3b, 0x7c, 0xdb, 0xda, 0x5d, 0x69, 0x21, 0xee,
-	0xae, 0x58, 0x7c, 0x0f, 0x46, 0x94, 0x70, 0xa2, 0x96, 0x93, 0x28, 0x8c, 0xa3, 0x50, 0x46, 0xf5,
-	0x0a, 0x26, 0x98, 0x88, 0xb8, 0x15, 0x3f, 0x25, 0x50, 0x7d, 0x0e, 0x13, 0x82, 0x77, 0x90, 0x25,
-	0xde, 0x5a, 0x9d, 0xb7, 0x96, 0x1b, 0x76, 0xd3, 0x50, 0x52, 0x65, 0x2b, 0xc9, 0x91, 0x25, 0x93,
-	0xd0, 0xac, 0x6c, 0x1f, 0x30, 0x6c, 0xed, 0xae, 0xc4, 0x7f, 0x32, 0x30, 0xe3, 0x06, 0x7e, 0x48,
-	0x2c, 0xf1, 0x2b, 0x8f, 0x8c, 0x3c, 0xaa, 0x82, 0x99, 0x88, 0x9b, 0x27, 0x0a, 0x98, 0xb2, 0x19,
-	0xde, 0x8c, 0xda, 0x2e, 0x47, 0xcf, 0x5d, 0xea, 0x06, 0x4c, 0x7d, 0x00, 0x8a, 0x31, 0x82, 0x50,
-	0x9f, 0x77, 0x35, 0x65, 0x41, 0xa9, 0x16, 0x9b, 0xda, 0xc9, 0x41, 0xad, 0x22, 0x49, 0x34, 0xda,
-	0x6d, 0x8a, 0x18, 0x7b, 0xc1, 0xa9, 0x1f, 0x62, 0xe7, 0x12, 0xaa, 0xae, 0x83, 0xb1, 0x48, 0x54,
-	0xd0, 0x86, 0x16, 0x94, 0x6a, 0x69, 0x75, 0x1e, 0xe6, 0x38, 0x01, 0x93, 0x26, 0xcd, 0xe2, 0xe1,
-	0xcf, 0x1b, 0x85, 0xaf, 0xe7, 0xfb, 0x4b, 0x8a, 0x23, 0xb3, 0xea, 0x4f, 0x4e, 0x0e, 0x6a, 0x53,
-	0x49, 0x4a, 0x8d, 0xb5, 0xdf, 0x2d, 0x2c, 0xc3, 0xfb, 0x0f, 0x3f, 0x9e, 0xef, 0x2f, 0x5d, 0xb6,
-	0xf8, 0x74, 0xbe, 0xbf, 0xb4, 0x78, 0x89, 0xb0, 0xf6, 0x12, 0x5d, 0x3d, 0x02, 0x4c, 0x08, 0x66,
-	0x7b, 0x8e, 0x1c, 0xc4, 0x22, 0x12, 0x32, 0x54, 0x2f, 0xe7, 0xf4, 0x30, 0xbf, 0x28, 0x60, 0xda,
-	0x66, 0xf8, 0x19, 0x09, 0x1b, 0x9c, 0x04, 0xbe, 0xb7, 0xb1, 0x87, 0x3c, 0x75, 0x19, 0x8c, 0x31,
-	0x1f, 0x87, 0x88, 0xfe, 0xd3, 0x02, 0x89, 0x53, 0x37, 0xc0, 0x48, 0xc0, 0x70, 0xac, 0x7e, 0xb8,
-	0x5a, 0x5a, 0xad, 0xc0, 0x64, 0xb8, 0x30, 0x1d, 0x2e, 0x6c, 0x84, 0xdd, 0xe6, 0xfc, 0xd1, 0x41,
-	0x4d, 0xce, 0x0f, 0xb6, 0x5c, 0x86, 0x2e, 0x6c, 0xb1, 0x19, 0x76, 0x44, 0x7a, 0xbd, 0x14, 0x6b,
-	0x96, 0x35, 0xcd, 0x4d, 0x50, 0xce, 0xd0, 0x72, 0x10, 0xeb, 0xec, 0x70, 0xb5, 0x02, 0x46, 0x11,
-	0xa5, 0x44, 0x72, 0x73, 0x92, 0x17, 0xb5, 0x0a, 0x46, 0x28, 0x62, 0x91, 0xb4, 0x3f, 0x97, 0x80,
-	0x23, 0x10, 0xe6, 0x1b, 0xa0, 0xf5, 0x0a, 0x4e, 0x2d, 0x52, 0x9b, 0xe0, 0x1a, 0x15, 0x5d, 0x98,
-	0xa6, 0x08, 0x25, 0xd5, 0xdc, 0x39, 0xe6, 0xd0, 0x72, 0xd2, 0x44, 0xf3, 0x87, 0x02, 0x66, 0x6c,
-	0x86, 0x6d, 0x1f, 0x53, 0x97, 0xa3, 0x86, 0xe7, 0x91, 0x4e, 0xc8, 0xff, 0xc3, 0xd2, 0x45, 0x30,
-	0xee, 0x26, 0xc9, 0x5b, 0xbc, 0x1b, 0x21, 0xa1, 0xac, 0xe8, 0x94, 0xe4, 0xd9, 0xcb, 0x6e, 0x84,
-	0xd4, 0x75, 0x30, 0x9d, 0x42, 0xfc, 0xd0, 0xe7, 0x5b, 0x01, 0xc3, 0xda, 0xf0, 0x5f, 0x0c, 0x98,
-	0x94, 0xe8, 0xa7, 0xa1, 0xcf, 0x6d, 0x86, 0xeb, 0x6b, 0x7f, 0xd8, 0x1d, 0xef, 0xd7, 0xcd, 0xdc,
-	0xfd, 0xca, 0x2a, 0x31, 0x5f, 0x81, 0xb9, 0x2b, 0x87, 0x17, 0x06, 0x3e, 0x02, 0x13, 0x82, 0x09,
-	0x95, 0x07, 0x42, 0x6d, 0x3f, 0x3a, 0xe3, 0x31, 0x34, 0x4d, 0x5d, 0xfd, 0x36, 0x04, 0x86, 0x6d,
-	0x86, 0xd5, 0xf7, 0x60, 0x3c, 0x73, 0x25, 0x6f, 0xe5, 0x8e, 0xa0, 0x67, 0xc9, 0xf5, 0x7b, 0x83,
-	0xa0, 0xd2, 0x5e, 0x66, 0xf9, 0xe8, 0xea, 0x55, 0x50, 0x11, 0x98, 0xc8, 0x5e, 0x83, 0xdb, 0xfd,
-	0x6a, 0x66, 0x60, 0x7a, 0x6d, 0x20, 0xd8, 0x85, 0x45, 0xdb, 0x60, 0xb2, 0x67, 0x37, 0xee, 0xf4,
-	0x2b, 0x90, 0xc5, 0xe9, 0x70, 0x30, 0x5c, 0xda, 0x49, 0x1f, 0xfd, 0x10, 0x7f, 0x63, 0x9a, 0x8f,
-	0x0f, 0x4f, 0x0d, 0xe5, 0xf8, 0xd4, 0x50, 0x7e, 0x9d, 0x1a, 0xca, 0xe7, 0x33, 0xa3, 0x70, 0x7c,
-	0x66, 0x14, 0xbe, 0x9f, 0x19, 0x85, 0xd7, 0x77, 0xb1, 0xcf, 0xb7, 0x3b, 0x2d, 0xe8, 0x91, 0x40,
-	0x7e, 0x66, 0xad, 0xab, 0x1b, 0x10, 0x2f, 0x1f, 0x6b, 0x8d, 0x89, 0xc9, 0xad, 0xfd, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0xc2, 0x24, 0xb6, 0x0e, 0x00, 0x06, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x6f, 0x80, 0xab, 0x4c, 0xc2, 0x9f, 0x13, 0x09, 0x13, 0xae, 0x72, 0xc1, 0xfd, 0x51,
+	0x8a, 0xc8, 0x98, 0x40, 0xd5, 0xaa, 0x59, 0x20, 0x25, 0x15, 0xaa, 0xaa, 0x2a, 0xa8, 0x72, 0x4b,
+	0x17, 0x5d, 0x34, 0xb2, 0x93, 0xe9, 0x60, 0x81, 0x3d, 0x96, 0x67, 0x02, 0x78, 0x57, 0x75, 0xd9,
+	0x55, 0x97, 0x7d, 0x84, 0x2e, 0x59, 0xb0, 0xea, 0x03, 0x54, 0x88, 0x15, 0x62, 0xd5, 0x4d, 0xab,
+	0x0a, 0x16, 0xbc, 0x46, 0x65, 0xcf, 0x38, 0x21, 0xc6, 0xd0, 0x88, 0x4d, 0x62, 0x9f, 0xf3, 0x9d,
+	0xbf, 0x6f, 0xbe, 0x33, 0x06, 0xff, 0xb5, 0x09, 0xb5, 0x09, 0xd5, 0x8c, 0x2e, 0xdb, 0xd2, 0x76,
+	0xab, 0x26, 0x62, 0x46, 0x55, 0x63, 0xfb, 0xd0, 0xf5, 0x08, 0x23, 0x72, 0x9e, 0x7b, 0x61, 0xe0,
+	0x85, 0xc2, 0x5b, 0x2c, 0x60, 0x82, 0x49, 0xe8, 0xd7, 0x82, 0x27, 0x0e, 0x2d, 0xce, 0x62, 0x42,
+	0xf0, 0x0e, 0xd2, 0xc2, 0x37, 0xb3, 0xfb, 0x5e, 0x33, 0x1c, 0x3f, 0x72, 0xf1, 0x2c, 0x2d, 0x1e,
+	0x23, 0x52, 0x72, 0xd7, 0x8c, 0x28, 0x6f, 0x53, 0xac, 0xed, 0x56, 0x83, 0x3f, 0xe1, 0x98, 0x36,
+	0x6c, 0xcb, 0x21, 0x5a, 0xf8, 0x2b, 0x4c, 0xa5, 0xa4, 0x56, 0xc3, 0xce, 0x42, 0xbf, 0x7a, 0x2a,
+	0x81, 0xc9, 0x26, 0xc5, 0x9b, 0x6e, 0xc7, 0x60, 0xe8, 0xa5, 0xe1, 0x19, 0x36, 0x95, 0x1f, 0x81,
+	0x4c, 0x80, 0x20, 0x9e, 0xc5, 0x7c, 0x45, 0x9a, 0x97, 0xca, 0x99, 0x86, 0x72, 0x7a, 0x58, 0x29,
+	0x88, 0x26, 0xea, 0x9d, 0x8e, 0x87, 0x28, 0x7d, 0xc5, 0x3c, 0xcb, 0xc1, 0x7a, 0x1f, 0x2a, 0xaf,
+	0x81, 0x31, 0x37, 0xcc, 0xa0, 0xfc, 0x33, 0x2f, 0x95, 0xb3, 0x2b, 0x73, 0x30, 0x81, 0x09, 0xc8,
+	0x8b, 0x34, 0x32, 0x47, 0xbf, 0xfe, 0x4f, 0x7d, 0xbd, 0x38, 0x58, 0x94, 0x74, 0x11, 0x55, 0x7b,
+	0x76, 0x7a, 0x58, 0x99, 0xe4, 0x21, 0x15, 0xda, 0xd9, 0x9e, 0x5f, 0x86, 0x0f, 0x1f, 0x7f, 0xbc,
+	0x38, 0x58, 0xec, 0x97, 0xf8, 0x74, 0x71, 0xb0, 0xb8, 0xd0, 0x47, 0x68, 0xfb, 0x7c, 0xae, 0xd8,
+	0x00, 0x2a, 0x04, 0x33, 0x31, 0x93, 0x8e, 0xa8, 0x4b, 0x1c, 0x8a, 0x6a, 0xf9, 0x84, 0x1a, 0xea,
+	0x17, 0x09, 0x4c, 0x35, 0x29, 0xde, 0x20, 0x4e, 0x9d, 0x11, 0xdb, 0x6a, 0xaf, 0xef, 0xa3, 0xb6,
+	0xbc, 0x0c, 0xc6, 0xa8, 0x85, 0x1d, 0xe4, 0xfd, 0x95, 0x02, 0x81, 0x93, 0xd7, 0xc1, 0x88, 0x4d,
+	0x71, 0x30, 0x7d, 0xba, 0x9c, 0x5d, 0x29, 0x40, 0x7e, 0xb8, 0x30, 0x3a, 0x5c, 0x58, 0x77, 0xfc,
+	0xc6, 0xdc, 0xf1, 0x61, 0x45, 0x9c, 0x1f, 0x34, 0x0d, 0x8a, 0x7a, 0xb4, 0x34, 0x29, 0xd6, 0xc3,
+	0xf0, 0x5a, 0x36, 0x98, 0x59, 0xe4, 0x54, 0x37, 0x41, 0x7e, 0xa0, 0x2d, 0x1d, 0xd1, 0xee, 0x0e,
+	0x93, 0x0b, 0x60, 0x14, 0x79, 0x1e, 0x11, 0xbd, 0xe9, 0xfc, 0x45, 0x2e, 0x83, 0x11, 0x0f, 0x51,
+	0x57, 0xd0, 0x9f, 0xd8, 0x80, 0x1e, 0x22, 0xd4, 0x77, 0x40, 0x89, 0x0f, 0x1c, 0x51, 0x24, 0x37,
+	0xc0, 0xbf, 0x5e, 0x58, 0x85, 0x2a, 0x52, 0x38, 0x49, 0x39, 0xf1, 0x1c, 0x13, 0xda, 0xd2, 0xa3,
+	0x40, 0xf5, 0xa7, 0x04, 0xa6, 0x9b, 0x14, 0x37, 0x2d, 0xec, 0x19, 0x0c, 0xd5, 0xdb, 0x6d, 0xd2,
+	0x75, 0xd8, 0x2d, 0x28, 0x5d, 0x00, 0x39, 0x83, 0x07, 0xb7, 0x98, 0xef, 0xa2, 0x70, 0xb2, 0x8c,
+	0x9e, 0x15, 0xb6, 0xd7, 0xbe, 0x8b, 0xe4, 0x35, 0x30, 0x15, 0x41, 0x2c, 0xc7, 0x62, 0x2d, 0x9b,
+	0x62, 0x25, 0x7d, 0x03, 0x01, 0x13, 0x02, 0xfd, 0xdc, 0xb1, 0x58, 0x93, 0xe2, 0xda, 0xea, 0x25,
+	0xba, 0x03, 0x7d, 0xdd, 0x49, 0xd4, 0xd7, 0xe0, 0x24, 0xea, 0x1b, 0x30, 0x7b, 0xc5, 0xd8, 0x23,
+	0xf0, 0x09, 0x18, 0x0f, 0x3b, 0xf1, 0x84, 0x41, 0x91, 0x6e, 0x68, 0x27, 0x17, 0x40, 0xa3, 0x50,
+	0xf5, 0x3b, 0x5f, 0x47, 0x9d, 0xb0, 0x40, 0xba, 0x5d, 0xf3, 0x05, 0xf2, 0x6f, 0xc1, 0xda, 0x06,
+	0xc8, 0x3a, 0x68, 0xaf, 0xe5, 0x76, 0xcd, 0xd6, 0x36, 0xf2, 0x6f, 0x92, 0x43, 0x43, 0x39, 0xee,
+	0x27, 0x6b, 0x7b, 0xbe, 0xcb, 0x08, 0xe4, 0x65, 0xf5, 0x8c, 0x83, 0xf6, 0xf8, 0x63, 0xad, 0x1a,
+	0xa3, 0x28, 0x79, 0x05, 0x2f, 0x37, 0xad, 0xce, 0x82, 0x99, 0x98, 0x29, 0x9a, 0x71, 0xe5, 0x5b,
+	0x1a, 0xa4, 0x9b, 0x14, 0xcb, 0x7b, 0x20, 0x37, 0x70, 0xed, 0xdc, 0x4d, 0x94, 0x59, 0x6c, 0x91,
+	0x8b, 0x4b, 0xc3, 0xa0, 0x7a, 0x7c, 0xe6, 0x8f, 0xaf, 0xae, 0xbb, 0x8c, 0xc0, 0xf8, 0xe0, 0xaa,
+	0xdf, 0xbb, 0x2e, 0xe7, 0x00, 0xac, 0x58, 0x19, 0x0a, 0xd6, 0x93, 0xc1, 0x16, 0x98, 0x88, 0xe9,
+	0xff, 0xfe, 0x75, 0x09, 0x06, 0x71, 0x45, 0x38, 0x1c, 0xae, 0x57, 0xc9, 0x04, 0xb9, 0x01, 0xc5,
+	0x5c, 0xcb, 0xe4, 0x65, 0x54, 0x71, 0x69, 0x18, 0x54, 0x54, 0xa3, 0x38, 0xfa, 0x21, 0xb8, 0xab,
+	0x1b, 0x4f, 0x8f, 0xce, 0x4a, 0xd2, 0xc9, 0x59, 0x49, 0xfa, 0x7d, 0x56, 0x92, 0x3e, 0x9f, 0x97,
+	0x52, 0x27, 0xe7, 0xa5, 0xd4, 0x8f, 0xf3, 0x52, 0xea, 0xed, 0x03, 0x6c, 0xb1, 0xad, 0xae, 0x09,
+	0xdb, 0xc4, 0x16, 0x9f, 0x2b, 0xed, 0xaa, 0x4c, 0x82, 0x25, 0xa6, 0xe6, 0x58, 0x28, 0xc1, 0xd5,
+	0x3f, 0x03, 0x00, 0x52, 0x0a, 0x12, 0xc1, 0x48, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -466,6 +564,9 @@ type MsgClient interface {
 	NonAtomicExec(ctx context.Context, in *MsgNonAtomicExec, opts ...grpc.CallOption) (*MsgNonAtomicExecResponse, error)
 	// MigrateAccount migrates the account to x/accounts.
 	MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error)
+	// RotatePubKey replaces the public key of an account. The message must be
+	// signed with the current public key of the account.
+	RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -503,6 +604,15 @@ func (c *msgClient) MigrateAccount(ctx context.Context, in *MsgMigrateAccount, o
 	return out, nil
 }
 
+func (c *msgClient) RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error) {
+	out := new(MsgRotatePubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/RotatePubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
@@ -512,6 +622,9 @@ type MsgServer interface {
 	NonAtomicExec(context.Context, *MsgNonAtomicExec) (*MsgNonAtomicExecResponse, error)
 	// MigrateAccount migrates the account to x/accounts.
 	MigrateAccount(context.Context, *MsgMigrateAccount) (*MsgMigrateAccountResponse, error)
+	// RotatePubKey replaces the public key of an account. The message must be
+	// signed with the current public key of the account.
+	RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -527,6 +640,9 @@ func (*UnimplementedMsgServer) NonAtomicExec(ctx context.Context, req *MsgNonAto
 func (*UnimplementedMsgServer) MigrateAccount(ctx context.Context, req *MsgMigrateAccount) (*MsgMigrateAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateAccount not implemented")
 }
+func (*UnimplementedMsgServer) RotatePubKey(ctx context.Context, req *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePubKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -586,6 +702,24 @@ func _Msg_MigrateAccount_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotatePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/RotatePubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePubKey(ctx, req.(*MsgRotatePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Msg",
@@ -603,6 +737,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MigrateAccount",
 			Handler:    _Msg_MigrateAccount_Handler,
 		},
+		{
+			MethodName: "RotatePubKey",
+			Handler:    _Msg_RotatePubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -878,6 +1016,71 @@ func (m *MsgMigrateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotatePubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotatePubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotatePubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotatePubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -998,6 +1201,32 @@ func (m *MsgMigrateAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotatePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotatePubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1723,6 +1952,174 @@ func (m *MsgMigrateAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotatePubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &any.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotatePubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotatePubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotatePubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
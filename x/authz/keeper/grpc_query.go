@@ -13,6 +13,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -192,3 +193,44 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// CanExec implements the Query/CanExec gRPC method.
+// It returns whether the grantee would be authorized to execute the messages with a MsgExec, without executing them.
+func (k Keeper) CanExec(ctx context.Context, req *authz.QueryCanExecRequest) (*authz.QueryCanExecResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := k.addrCdc.StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msgs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "messages cannot be empty")
+	}
+
+	msgs := make([]sdk.Msg, len(req.Msgs))
+	for i, msgAny := range req.Msgs {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = msg
+	}
+
+	res := &authz.QueryCanExecResponse{
+		Authorized: true,
+		Results:    make([]*authz.CanExecResult, len(msgs)),
+	}
+	for i, err := range k.CheckActions(ctx, grantee, msgs) {
+		if err != nil {
+			res.Authorized = false
+			res.Results[i] = &authz.CanExecResult{Reason: err.Error()}
+			continue
+		}
+		res.Results[i] = &authz.CanExecResult{Authorized: true}
+	}
+
+	return res, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/authz"
 	banktypes "cosmossdk.io/x/bank/types"
 
@@ -287,6 +288,76 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryCanExec() {
+	queryClient, addrs := suite.queryClient, suite.addrs
+	require := suite.Require()
+
+	granterAddr, err := suite.addrCdc.BytesToString(addrs[0])
+	require.NoError(err)
+	granteeAddr, err := suite.addrCdc.BytesToString(addrs[1])
+	require.NoError(err)
+	recipientAddr, err := suite.addrCdc.BytesToString(addrs[2])
+	require.NoError(err)
+
+	send := func(from string, amount sdk.Coins) sdk.Msg {
+		return &banktypes.MsgSend{FromAddress: from, ToAddress: recipientAddr, Amount: amount}
+	}
+
+	newRequest := func(msgs ...sdk.Msg) *authz.QueryCanExecRequest {
+		req := authz.NewMsgExec(granteeAddr, msgs)
+		return &authz.QueryCanExecRequest{Grantee: req.Grantee, Msgs: req.Msgs}
+	}
+
+	_, err = queryClient.CanExec(gocontext.Background(), &authz.QueryCanExecRequest{Grantee: granteeAddr})
+	require.ErrorContains(err, "messages cannot be empty")
+
+	// no grant
+	res, err := queryClient.CanExec(gocontext.Background(), newRequest(send(granterAddr, coins10)))
+	require.NoError(err)
+	require.False(res.Authorized)
+	require.Len(res.Results, 1)
+	require.False(res.Results[0].Authorized)
+	require.Contains(res.Results[0].Reason, "authorization not found")
+
+	// the grantee implicitly authorizes its own messages
+	res, err = queryClient.CanExec(gocontext.Background(), newRequest(send(granteeAddr, coins10)))
+	require.NoError(err)
+	require.True(res.Authorized)
+
+	exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
+	authorization := banktypes.NewSendAuthorization(coins100, nil, suite.addrCdc)
+	require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, addrs[1], addrs[0], authorization, &exp))
+
+	// the second message is checked against the spend limit left by the first one
+	coins60 := sdk.NewCoins(sdk.NewInt64Coin("stake", 60))
+	res, err = queryClient.CanExec(gocontext.Background(), newRequest(send(granterAddr, coins60), send(granterAddr, coins60), send(granteeAddr, coins10)))
+	require.NoError(err)
+	require.False(res.Authorized)
+	require.Equal([]*authz.CanExecResult{
+		{Authorized: true},
+		{Reason: res.Results[1].Reason},
+		{Authorized: true},
+	}, res.Results)
+	require.Contains(res.Results[1].Reason, "requested amount is more than spend limit")
+
+	// the spend limit is used up by the first message
+	res, err = queryClient.CanExec(gocontext.Background(), newRequest(send(granterAddr, coins100), send(granterAddr, coins10)))
+	require.NoError(err)
+	require.False(res.Authorized)
+	require.True(res.Results[0].Authorized)
+	require.Contains(res.Results[1].Reason, "used up by a previous message")
+
+	// the grant is not updated by the query
+	grant, _ := suite.authzKeeper.GetAuthorization(suite.ctx, addrs[1], addrs[0], bankSendAuthMsgType)
+	require.Equal(authorization, grant)
+
+	// expired grant
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: exp.Add(time.Second)})
+	errs := suite.authzKeeper.CheckActions(ctx, addrs[1], []sdk.Msg{send(granterAddr, coins10)})
+	require.Len(errs, 1)
+	require.ErrorIs(errs[0], authz.ErrAuthorizationExpired)
+}
+
 func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -166,6 +166,98 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 	return results, nil
 }
 
+// CheckActions checks whether the provided messages would be authorized by the
+// grants from the message signers to the grantee if they were executed now with a
+// MsgExec, without executing them nor updating the grants. It returns the reason
+// each message is not authorized for, nil if the message is authorized. As with
+// DispatchActions, a message is checked against the authorizations updated by the
+// previous messages.
+func (k Keeper) CheckActions(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) []error {
+	results := make([]error, len(msgs))
+	now := k.Environment.HeaderService.HeaderInfo(ctx).Time
+
+	// authorizations updated by the accepted messages, nil if they would be deleted
+	updated := make(map[string]authz.Authorization)
+
+	for i, msg := range msgs {
+		results[i] = k.checkAction(ctx, grantee, msg, now, updated)
+	}
+
+	return results
+}
+
+func (k Keeper) checkAction(ctx context.Context, grantee sdk.AccAddress, msg sdk.Msg, now time.Time, updated map[string]authz.Authorization) error {
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
+		return err
+	}
+
+	signers, _, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return err
+	}
+
+	if len(signers) != 1 {
+		return authz.ErrAuthorizationNumOfSigners
+	}
+
+	granter := signers[0]
+
+	// the grantee implicitly accepts its own messages
+	if bytes.Equal(granter, grantee) {
+		return nil
+	}
+
+	skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
+
+	authorization, found := updated[string(skey)]
+	if !found {
+		grant, found := k.getGrant(ctx, skey)
+		if !found {
+			return errorsmod.Wrapf(authz.ErrNoAuthorizationFound,
+				"failed to get grant with given granter: %s, grantee: %s & msgType: %s ", sdk.AccAddress(granter), grantee, sdk.MsgTypeURL(msg))
+		}
+
+		if grant.Expiration != nil && grant.Expiration.Before(now) {
+			return authz.ErrAuthorizationExpired
+		}
+
+		authorization, err = grant.GetAuthorization()
+		if err != nil {
+			return err
+		}
+	} else if authorization == nil {
+		return errorsmod.Wrapf(authz.ErrNoAuthorizationFound,
+			"grant with given granter: %s, grantee: %s & msgType: %s is used up by a previous message", sdk.AccAddress(granter), grantee, sdk.MsgTypeURL(msg))
+	}
+
+	resp, err := authorization.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), msg)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return sdkerrors.ErrUnauthorized
+	}
+
+	if resp.Delete {
+		updated[string(skey)] = nil
+	} else if resp.Updated != nil {
+		authorization, ok := resp.Updated.(authz.Authorization)
+		if !ok {
+			return fmt.Errorf("expected authz.Authorization but got %T", resp.Updated)
+		}
+		updated[string(skey)] = authorization
+	}
+
+	return nil
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
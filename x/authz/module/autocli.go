@@ -44,6 +44,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "CanExec",
+					Use:       "can-exec <grantee-addr> <msg-json-file>",
+					Short:     "Query whether a grantee is authorized to execute messages on behalf of their signers",
+					Example:   fmt.Sprintf("$ %s query authz can-exec cosmos1skj.. msg.json", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "grantee"},
+						{ProtoField: "msgs", Varargs: true},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -0,0 +1,21 @@
+package authz
+
+import (
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ gogoprotoany.UnpackInterfacesMessage = &QueryCanExecRequest{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (req QueryCanExecRequest) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for _, x := range req.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(x, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -374,6 +375,170 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryCanExecRequest is the request type for the Query/CanExec RPC method.
+type QueryCanExecRequest struct {
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// msgs are the messages the grantee would execute with a MsgExec.
+	Msgs []*any.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *QueryCanExecRequest) Reset()         { *m = QueryCanExecRequest{} }
+func (m *QueryCanExecRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanExecRequest) ProtoMessage()    {}
+func (*QueryCanExecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{6}
+}
+func (m *QueryCanExecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanExecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanExecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanExecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanExecRequest.Merge(m, src)
+}
+func (m *QueryCanExecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanExecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanExecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanExecRequest proto.InternalMessageInfo
+
+func (m *QueryCanExecRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QueryCanExecRequest) GetMsgs() []*any.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// QueryCanExecResponse is the response type for the Query/CanExec RPC method.
+type QueryCanExecResponse struct {
+	// authorized is true if all the messages are authorized.
+	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	// results are the results of the messages, in the order of the request.
+	Results []*CanExecResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *QueryCanExecResponse) Reset()         { *m = QueryCanExecResponse{} }
+func (m *QueryCanExecResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanExecResponse) ProtoMessage()    {}
+func (*QueryCanExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{7}
+}
+func (m *QueryCanExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanExecResponse.Merge(m, src)
+}
+func (m *QueryCanExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanExecResponse proto.InternalMessageInfo
+
+func (m *QueryCanExecResponse) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
+	}
+	return false
+}
+
+func (m *QueryCanExecResponse) GetResults() []*CanExecResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// CanExecResult is the result of a message of a Query/CanExec request.
+type CanExecResult struct {
+	// authorized is true if the message is authorized.
+	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	// reason is the reason the message is not authorized, empty if it is authorized.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *CanExecResult) Reset()         { *m = CanExecResult{} }
+func (m *CanExecResult) String() string { return proto.CompactTextString(m) }
+func (*CanExecResult) ProtoMessage()    {}
+func (*CanExecResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{8}
+}
+func (m *CanExecResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CanExecResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CanExecResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanExecResult.Merge(m, src)
+}
+func (m *CanExecResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanExecResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanExecResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanExecResult proto.InternalMessageInfo
+
+func (m *CanExecResult) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
+	}
+	return false
+}
+
+func (m *CanExecResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsResponse")
@@ -381,46 +546,60 @@ func init() {
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryCanExecRequest)(nil), "cosmos.authz.v1beta1.QueryCanExecRequest")
+	proto.RegisterType((*QueryCanExecResponse)(nil), "cosmos.authz.v1beta1.QueryCanExecResponse")
+	proto.RegisterType((*CanExecResult)(nil), "cosmos.authz.v1beta1.CanExecResult")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xeb, 0x6e, 0x14, 0xe1, 0x81, 0x90, 0x0c, 0x87, 0x2c, 0x4c, 0x51, 0x54, 0x21, 0x28,
-	0x48, 0xb5, 0xbb, 0x0e, 0x71, 0x66, 0x3b, 0x6c, 0xe2, 0x06, 0x01, 0x2e, 0x5c, 0xaa, 0x94, 0xbe,
-	0xca, 0xa2, 0xb5, 0x71, 0x66, 0x3b, 0x88, 0x0e, 0xed, 0x02, 0x67, 0x24, 0xa4, 0x1d, 0xf8, 0x08,
-	0x7c, 0x81, 0x5d, 0xb9, 0x23, 0x4e, 0x13, 0x5c, 0x38, 0xa2, 0x16, 0xf1, 0x39, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0xc2, 0x9f, 0x4a, 0xbb, 0xc5, 0xd1, 0xf3, 0xbc, 0xef, 0xef, 0x7d, 0x1c, 0x3b,
-	0xd8, 0x7f, 0xc6, 0x65, 0x8f, 0x4b, 0x16, 0x66, 0x6a, 0x73, 0x87, 0x3d, 0x5f, 0x6e, 0x83, 0x0a,
-	0x97, 0xd9, 0x76, 0x06, 0xa2, 0x4f, 0x53, 0xc1, 0x15, 0x27, 0x57, 0x8d, 0x82, 0x6a, 0x05, 0xb5,
-	0x0a, 0x77, 0x29, 0xe2, 0x3c, 0xea, 0x02, 0x0b, 0xd3, 0x98, 0x85, 0x49, 0xc2, 0x55, 0xa8, 0x62,
-	0x9e, 0x48, 0xe3, 0x71, 0x6f, 0xdb, 0xaa, 0xed, 0x50, 0x82, 0x29, 0xf6, 0xab, 0x74, 0x1a, 0x46,
-	0x71, 0xa2, 0xc5, 0x56, 0x9b, 0x4f, 0x60, 0xba, 0x19, 0xc5, 0xa2, 0x51, 0xb4, 0xf4, 0x8a, 0x59,
-	0x1c, 0xbd, 0xa8, 0xfe, 0x40, 0x98, 0x3c, 0x1c, 0xd5, 0xdf, 0x10, 0x61, 0xa2, 0x64, 0x00, 0xdb,
-	0x19, 0x48, 0x45, 0x9a, 0xf8, 0x7c, 0x34, 0x7a, 0x01, 0xc2, 0x41, 0x3e, 0xaa, 0x5d, 0x58, 0x73,
-	0x3e, 0xef, 0xd7, 0xc7, 0x83, 0xac, 0x76, 0x3a, 0x02, 0xa4, 0x7c, 0xa4, 0x44, 0x9c, 0x44, 0xc1,
-	0x58, 0x38, 0xf1, 0x80, 0x53, 0x2e, 0xe6, 0x01, 0xe2, 0xe3, 0x8b, 0x3d, 0x19, 0xb5, 0x54, 0x3f,
-	0x85, 0x56, 0x26, 0xba, 0xce, 0xdc, 0xc8, 0x18, 0xe0, 0x9e, 0x8c, 0x1e, 0xf7, 0x53, 0x78, 0x22,
-	0xba, 0x64, 0x1d, 0xe3, 0xc9, 0xc4, 0xce, 0xbc, 0x8f, 0x6a, 0x0b, 0xcd, 0x1b, 0xd4, 0x56, 0x1d,
-	0xc5, 0x43, 0x4d, 0xd6, 0x76, 0x6e, 0xfa, 0x20, 0x8c, 0xc0, 0x4e, 0x11, 0x1c, 0x72, 0x56, 0xf7,
-	0x10, 0xbe, 0x32, 0x35, 0xa8, 0x4c, 0x79, 0x22, 0x81, 0xac, 0xe0, 0x8a, 0x86, 0x91, 0x0e, 0xf2,
-	0xe7, 0x6a, 0x0b, 0xcd, 0x6b, 0x34, 0x6f, 0xbb, 0xa8, 0x76, 0x05, 0x56, 0x4a, 0x36, 0xa6, 0xa0,
-	0xca, 0x1a, 0xea, 0xe6, 0xa9, 0x50, 0xa6, 0xe3, 0x14, 0xd5, 0x3b, 0x84, 0x17, 0x27, 0x54, 0x20,
-	0xfe, 0x7d, 0x17, 0xd6, 0x73, 0xd0, 0xfe, 0x26, 0xaf, 0xf7, 0x08, 0xbb, 0x79, 0x64, 0x36, 0xb6,
-	0x7b, 0x47, 0x62, 0xab, 0x9d, 0x10, 0xdb, 0x6a, 0xa6, 0x36, 0xb9, 0x88, 0x77, 0x74, 0xe1, 0x99,
-	0x67, 0x08, 0xc7, 0x64, 0x08, 0x45, 0x33, 0x84, 0x59, 0x65, 0x08, 0x67, 0x36, 0xc3, 0xe6, 0x9b,
-	0x79, 0x7c, 0x4e, 0x93, 0x92, 0xd7, 0x08, 0x57, 0x0c, 0x27, 0x39, 0x86, 0xe7, 0xf7, 0xeb, 0xc2,
-	0xbd, 0x55, 0x40, 0x69, 0xba, 0x56, 0xaf, 0xbf, 0xfa, 0xf2, 0x7d, 0xaf, 0xec, 0x91, 0x25, 0x96,
-	0x7b, 0x6d, 0xd9, 0xc1, 0x3e, 0x20, 0x7c, 0x69, 0xea, 0xc3, 0x23, 0xec, 0xb4, 0x16, 0x47, 0x0e,
-	0x8f, 0xdb, 0x28, 0x6e, 0xb0, 0x68, 0xf7, 0x3f, 0xed, 0xd7, 0x2f, 0x1b, 0x53, 0x5d, 0x76, 0xb6,
-	0xfc, 0x06, 0xbd, 0x73, 0x57, 0xd3, 0x36, 0x08, 0x3d, 0x89, 0x96, 0xd9, 0xb3, 0xc7, 0x5e, 0xda,
-	0x87, 0xdd, 0x43, 0xfc, 0x50, 0x98, 0x1f, 0xfe, 0x94, 0x1f, 0xfe, 0x2f, 0x3f, 0x8c, 0xf9, 0x61,
-	0x77, 0x8d, 0x7e, 0x1c, 0x78, 0xe8, 0x60, 0xe0, 0xa1, 0x6f, 0x03, 0x0f, 0xbd, 0x1d, 0x7a, 0xa5,
-	0x83, 0xa1, 0x57, 0xfa, 0x3a, 0xf4, 0x4a, 0x4f, 0xed, 0xd1, 0x91, 0x9d, 0x2d, 0x1a, 0x73, 0xf6,
-	0xc2, 0x14, 0x6c, 0x57, 0xf4, 0xdf, 0x64, 0xe5, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x92,
-	0xc5, 0x36, 0x0e, 0x07, 0x00, 0x00,
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xc1, 0x4f, 0x13, 0x41,
+	0x14, 0xc6, 0x99, 0x02, 0x45, 0x06, 0xd1, 0x64, 0x24, 0xba, 0x14, 0xb2, 0xd9, 0x14, 0xa3, 0x95,
+	0xa4, 0xb3, 0xa5, 0x18, 0x0f, 0x18, 0x13, 0xc1, 0x00, 0xf1, 0x60, 0xa2, 0xab, 0x5e, 0xf4, 0xd0,
+	0x4c, 0xdb, 0xe7, 0xd2, 0xd0, 0xee, 0x96, 0x9d, 0x5d, 0x42, 0x31, 0x5c, 0xf4, 0xe4, 0xcd, 0x84,
+	0x83, 0x27, 0x6f, 0x26, 0x9e, 0xbc, 0xf5, 0xea, 0xdd, 0x70, 0x22, 0x78, 0xf1, 0x68, 0xc0, 0xf8,
+	0x77, 0x98, 0xce, 0xcc, 0xda, 0x2e, 0x2c, 0xb4, 0x8a, 0x24, 0x9e, 0xda, 0xd9, 0x7e, 0xdf, 0x9b,
+	0xdf, 0x7c, 0xf3, 0xf6, 0x15, 0x1b, 0x25, 0x97, 0xd7, 0x5c, 0x6e, 0xb2, 0xc0, 0x5f, 0xd9, 0x34,
+	0xd7, 0x67, 0x8a, 0xe0, 0xb3, 0x19, 0x73, 0x2d, 0x00, 0xaf, 0x41, 0xeb, 0x9e, 0xeb, 0xbb, 0x64,
+	0x4c, 0x2a, 0xa8, 0x50, 0x50, 0xa5, 0x48, 0x4d, 0xda, 0xae, 0x6b, 0x57, 0xc1, 0x64, 0xf5, 0x8a,
+	0xc9, 0x1c, 0xc7, 0xf5, 0x99, 0x5f, 0x71, 0x1d, 0x2e, 0x3d, 0xa9, 0x69, 0x55, 0xb5, 0xc8, 0x38,
+	0xc8, 0x62, 0xbf, 0x4b, 0xd7, 0x99, 0x5d, 0x71, 0x84, 0x58, 0x69, 0xe3, 0x09, 0xe4, 0x6e, 0x52,
+	0x31, 0x2e, 0x15, 0x05, 0xb1, 0x32, 0xe5, 0x22, 0xfc, 0x49, 0x61, 0x88, 0x55, 0x31, 0x78, 0x61,
+	0x32, 0x47, 0x71, 0xa7, 0x7f, 0x22, 0x4c, 0x1e, 0xb5, 0xb6, 0x5e, 0xf6, 0x98, 0xe3, 0x73, 0x0b,
+	0xd6, 0x02, 0xe0, 0x3e, 0xc9, 0xe3, 0x21, 0xbb, 0xf5, 0x00, 0x3c, 0x0d, 0x19, 0x28, 0x33, 0xbc,
+	0xa0, 0xed, 0x35, 0xb3, 0xe1, 0x19, 0xe7, 0xcb, 0x65, 0x0f, 0x38, 0x7f, 0xec, 0x7b, 0x15, 0xc7,
+	0xb6, 0x42, 0x61, 0xdb, 0x03, 0x5a, 0xa2, 0x37, 0x0f, 0x10, 0x03, 0x9f, 0xaf, 0x71, 0xbb, 0xe0,
+	0x37, 0xea, 0x50, 0x08, 0xbc, 0xaa, 0xd6, 0xdf, 0x32, 0x5a, 0xb8, 0xc6, 0xed, 0x27, 0x8d, 0x3a,
+	0x3c, 0xf5, 0xaa, 0x64, 0x09, 0xe3, 0x76, 0x18, 0xda, 0x80, 0x81, 0x32, 0x23, 0xf9, 0x6b, 0x54,
+	0x55, 0x6d, 0x25, 0x47, 0xe5, 0x35, 0xa8, 0x48, 0xe8, 0x43, 0x66, 0x83, 0x3a, 0x85, 0xd5, 0xe1,
+	0x4c, 0x6f, 0x23, 0x7c, 0x29, 0x72, 0x50, 0x5e, 0x77, 0x1d, 0x0e, 0x64, 0x16, 0x27, 0x05, 0x0c,
+	0xd7, 0x90, 0xd1, 0x9f, 0x19, 0xc9, 0x4f, 0xd0, 0xb8, 0x9b, 0xa4, 0xc2, 0x65, 0x29, 0x29, 0x59,
+	0x8e, 0x40, 0x25, 0x04, 0xd4, 0xf5, 0xae, 0x50, 0x72, 0xc7, 0x08, 0xd5, 0x3b, 0x84, 0xc7, 0xdb,
+	0x54, 0xe0, 0x9d, 0xfe, 0x16, 0x96, 0x62, 0xd0, 0xfe, 0x26, 0xaf, 0x8f, 0x08, 0xa7, 0xe2, 0xc8,
+	0x54, 0x6c, 0x77, 0x0f, 0xc5, 0x96, 0x39, 0x21, 0xb6, 0xf9, 0xc0, 0x5f, 0x71, 0xbd, 0xca, 0xa6,
+	0x28, 0x7c, 0xe6, 0x19, 0xc2, 0x31, 0x19, 0x42, 0xaf, 0x19, 0xc2, 0x59, 0x65, 0x08, 0xff, 0x6f,
+	0x86, 0x1f, 0xc2, 0xb7, 0xe3, 0x1e, 0x73, 0x16, 0x37, 0xa0, 0x74, 0x9a, 0xf4, 0x16, 0xf1, 0x40,
+	0x8d, 0xdb, 0x5c, 0x4b, 0x88, 0x43, 0x8d, 0x51, 0x39, 0x7c, 0x68, 0x38, 0x7c, 0xe8, 0xbc, 0xd3,
+	0x58, 0x98, 0xd8, 0x69, 0x66, 0xaf, 0x74, 0x72, 0x86, 0x84, 0x0f, 0xb8, 0x6d, 0x09, 0xfb, 0x1c,
+	0xd9, 0x6b, 0x66, 0x2f, 0x6c, 0xc8, 0x11, 0x67, 0xac, 0xe7, 0x68, 0x9e, 0xe6, 0xd2, 0x6f, 0x10,
+	0x1e, 0x8b, 0x62, 0xaa, 0x28, 0x75, 0x8c, 0x99, 0x4a, 0x08, 0xca, 0x02, 0xf5, 0x9c, 0xd5, 0xf1,
+	0x84, 0xdc, 0xc1, 0x43, 0x1e, 0xf0, 0xa0, 0xea, 0x87, 0x58, 0x53, 0xf1, 0x59, 0xb7, 0xeb, 0x06,
+	0x55, 0xdf, 0x0a, 0x3d, 0xb1, 0x2c, 0xcf, 0xf1, 0x68, 0x44, 0xdd, 0x95, 0xe1, 0x32, 0x4e, 0x7a,
+	0xc0, 0xb8, 0xba, 0xa8, 0x61, 0x4b, 0xad, 0xe2, 0x8a, 0xe7, 0x3f, 0x0d, 0xe2, 0x41, 0x71, 0x50,
+	0xf2, 0x1a, 0xe1, 0xa4, 0xec, 0x1b, 0x72, 0x4c, 0x7f, 0x1c, 0x1d, 0xdf, 0xa9, 0x1b, 0x3d, 0x28,
+	0x65, 0x72, 0xe9, 0xab, 0xaf, 0xbe, 0xfe, 0xd8, 0x4e, 0xe8, 0x64, 0xd2, 0x8c, 0xfd, 0x87, 0x51,
+	0x8d, 0xf6, 0x19, 0xe1, 0xd1, 0xc8, 0x20, 0x20, 0x66, 0xb7, 0x2d, 0x0e, 0x0d, 0xb3, 0x54, 0xae,
+	0x77, 0x83, 0x42, 0xbb, 0xbf, 0xd3, 0xcc, 0x5e, 0x94, 0xa6, 0x2c, 0x2f, 0xaf, 0x1a, 0x39, 0x7a,
+	0xf3, 0x96, 0xa0, 0xcd, 0x11, 0x7a, 0x12, 0xad, 0xfc, 0x00, 0xcf, 0x7c, 0xa9, 0xbe, 0x6c, 0x75,
+	0xf0, 0x43, 0xcf, 0xfc, 0xf0, 0xa7, 0xfc, 0xf0, 0x6f, 0xf9, 0x21, 0xe4, 0x87, 0x2d, 0xf2, 0x1e,
+	0xe1, 0x21, 0xd5, 0x6d, 0xe4, 0xa4, 0xcb, 0x8d, 0xbe, 0xbe, 0xa9, 0xe9, 0x5e, 0xa4, 0x8a, 0xf6,
+	0xf6, 0xce, 0x91, 0x36, 0x14, 0xb0, 0x53, 0x73, 0x68, 0x3a, 0xad, 0xc7, 0xf3, 0x96, 0x98, 0x53,
+	0x80, 0x0d, 0x28, 0x2d, 0xd0, 0x2f, 0xfb, 0x3a, 0xda, 0xdd, 0xd7, 0xd1, 0xf7, 0x7d, 0x1d, 0xbd,
+	0x3d, 0xd0, 0xfb, 0x76, 0x0f, 0xf4, 0xbe, 0x6f, 0x07, 0x7a, 0xdf, 0x33, 0x35, 0x2c, 0x78, 0x79,
+	0x95, 0x56, 0x5c, 0x53, 0xd5, 0x2f, 0x26, 0xc5, 0x34, 0x98, 0xfd, 0x35, 0x00, 0x09, 0x74, 0xc1,
+	0x7f, 0x59, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -441,6 +620,10 @@ type QueryClient interface {
 	GranterGrants(ctx context.Context, in *QueryGranterGrantsRequest, opts ...grpc.CallOption) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// CanExec returns whether the grantee would be authorized to execute the messages
+	// with a MsgExec at the current block, evaluating the grants of the signers of the
+	// messages without executing them.
+	CanExec(ctx context.Context, in *QueryCanExecRequest, opts ...grpc.CallOption) (*QueryCanExecResponse, error)
 }
 
 type queryClient struct {
@@ -478,6 +661,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) CanExec(ctx context.Context, in *QueryCanExecRequest, opts ...grpc.CallOption) (*QueryCanExecResponse, error) {
+	out := new(QueryCanExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/CanExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -486,6 +678,10 @@ type QueryServer interface {
 	GranterGrants(context.Context, *QueryGranterGrantsRequest) (*QueryGranterGrantsResponse, error)
 	// GranteeGrants returns a list of `GrantAuthorization` by grantee.
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// CanExec returns whether the grantee would be authorized to execute the messages
+	// with a MsgExec at the current block, evaluating the grants of the signers of the
+	// messages without executing them.
+	CanExec(context.Context, *QueryCanExecRequest) (*QueryCanExecResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -501,6 +697,9 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) CanExec(ctx context.Context, req *QueryCanExecRequest) (*QueryCanExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanExec not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -560,6 +759,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CanExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/CanExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanExec(ctx, req.(*QueryCanExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
@@ -577,6 +794,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "CanExec",
+			Handler:    _Query_CanExec_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -869,6 +1090,137 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCanExecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanExecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanExecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Authorized {
+		i--
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanExecResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanExecResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanExecResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Authorized {
+		i--
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -996,6 +1348,59 @@ func (m *QueryGranteeGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCanExecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCanExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorized {
+		n += 2
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CanExecResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorized {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1780,6 +2185,328 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCanExecRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanExecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanExecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCanExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Authorized = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &CanExecResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanExecResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanExecResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Authorized = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,3 +27,23 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) BlockAddresses(ctx context.Context, req *bank.MsgBlockAddresses) (*bank.MsgBlockAddressesResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) UnblockAddresses(ctx context.Context, req *bank.MsgUnblockAddresses) (*bank.MsgUnblockAddressesResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SendWithTimeout(ctx context.Context, req *bank.MsgSendWithTimeout) (*bank.MsgSendWithTimeoutResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ClaimEscrow(ctx context.Context, req *bank.MsgClaimEscrow) (*bank.MsgClaimEscrowResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) RefundEscrow(ctx context.Context, req *bank.MsgRefundEscrow) (*bank.MsgRefundEscrowResponse, error) {
+	return nil, nil
+}
@@ -20,13 +20,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "Balance",
 					Use:            "balance <address> <denom>",
 					Short:          "Query an account balance by address and denom",
+					Long:           "Query an account balance by address and denom. Use --convert-to-display to also get the balance converted to its display denom from the denom metadata.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
 				},
 				{
 					RpcMethod:      "AllBalances",
 					Use:            "balances <address>",
 					Short:          "Query for account balances by address",
-					Long:           "Query the total balance of an account or of a specific denomination.",
+					Long:           "Query the total balance of an account or of a specific denomination. Use --convert-to-display to also get the balances converted to their display denom from the denom metadata.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
 				{
@@ -87,6 +88,30 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod: "BlockedAddresses",
+					Use:       "blocked-addresses",
+					Short:     "Query the addresses blocked from receiving funds",
+					Long:      "Query the addresses blocked from receiving funds. The addresses blocked by default by the application are returned separately from the addresses blocked by governance.",
+				},
+				{
+					RpcMethod:      "Escrow",
+					Use:            "escrow <escrow_id>",
+					Short:          "Query a pending escrowed transfer by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "escrow_id"}},
+				},
+				{
+					RpcMethod:      "EscrowsBySender",
+					Use:            "escrows-by-sender <sender>",
+					Short:          "Query the pending escrowed transfers sent by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+				},
+				{
+					RpcMethod:      "EscrowsByRecipient",
+					Use:            "escrows-by-recipient <recipient>",
+					Short:          "Query the pending escrowed transfers an account can claim",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -109,6 +134,28 @@ Note: multiple coins can be send by space separated.`,
 					Short:          "Burns the amount specified from the given account.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "amount", Varargs: true}},
 				},
+				{
+					RpcMethod: "SendWithTimeout",
+					Use:       "send-with-timeout <from_key_or_address> <to_address> <timeout> <amount>...",
+					Short:     "Send funds in escrow, to be claimed by the recipient before the timeout.",
+					Long: `Send funds in escrow, to be claimed by the recipient before the timeout.
+If the recipient does not claim the funds in time, the sender can get them refunded with refund-escrow.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].`,
+					Example:        fmt.Sprintf(`%s tx bank send-with-timeout mykey cosmos1... 24h 100stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "from_address"}, {ProtoField: "to_address"}, {ProtoField: "timeout"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "ClaimEscrow",
+					Use:            "claim-escrow <escrow_id>",
+					Short:          "Claim the funds of a pending escrowed transfer sent to the signer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "escrow_id"}},
+				},
+				{
+					RpcMethod:      "RefundEscrow",
+					Use:            "refund-escrow <escrow_id>",
+					Short:          "Refund the funds of an expired escrowed transfer to the signer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "escrow_id"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
@@ -128,6 +175,20 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "BlockAddresses",
+					Use:            "block-addresses-proposal <addresses>...",
+					Short:          "Submit a proposal to block addresses from receiving funds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UnblockAddresses",
+					Use:            "unblock-addresses-proposal <addresses>...",
+					Short:          "Submit a proposal to unblock addresses blocked by governance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
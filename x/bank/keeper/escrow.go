@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateEscrow moves amt coins from the sender to the escrow address, where they
+// can be claimed by the recipient until expiration, and refunded to the sender
+// from expiration on. The send restrictions are applied to the transfer when the
+// escrow is created. It returns the id of the new escrow.
+func (k BaseKeeper) CreateEscrow(ctx context.Context, sender, recipient sdk.AccAddress, amt sdk.Coins, expiration time.Time) (uint64, error) {
+	if !amt.IsValid() || !amt.IsAllPositive() {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	recipient, err := k.sendRestriction.apply(ctx, sender, recipient, amt)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.sendEscrowCoins(ctx, sender, types.EscrowAddress, amt); err != nil {
+		return 0, err
+	}
+
+	id, err := k.EscrowSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	senderStr, err := k.addrCdc.BytesToString(sender)
+	if err != nil {
+		return 0, err
+	}
+	recipientStr, err := k.addrCdc.BytesToString(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	escrow := types.Escrow{
+		Id:         id,
+		Sender:     senderStr,
+		Recipient:  recipientStr,
+		Amount:     amt,
+		Expiration: expiration,
+	}
+	if err := k.setEscrow(ctx, escrow); err != nil {
+		return 0, err
+	}
+
+	return id, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeEscrowSend,
+		event.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, senderStr),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		event.NewAttribute(types.AttributeKeyExpiration, expiration.Format(time.RFC3339Nano)),
+	)
+}
+
+// ClaimEscrow releases the coins held by the escrow to its recipient. Only the
+// recipient can claim the escrow, and only before it expires.
+func (k BaseKeeper) ClaimEscrow(ctx context.Context, id uint64, recipient sdk.AccAddress) error {
+	escrow, err := k.getEscrow(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	recipientStr, err := k.addrCdc.BytesToString(recipient)
+	if err != nil {
+		return err
+	}
+	if escrow.Recipient != recipientStr {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the recipient of escrow %d", recipientStr, id)
+	}
+
+	if !k.HeaderService.HeaderInfo(ctx).Time.Before(escrow.Expiration) {
+		return errorsmod.Wrapf(types.ErrEscrowExpired, "escrow %d expired at %s", id, escrow.Expiration)
+	}
+
+	if k.BlockedAddr(ctx, recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientStr)
+	}
+
+	if err := k.releaseEscrow(ctx, escrow, recipient); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeEscrowClaim,
+		event.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+	)
+}
+
+// RefundEscrow returns the coins held by an expired escrow to its sender. Only
+// the sender can get the escrow refunded.
+func (k BaseKeeper) RefundEscrow(ctx context.Context, id uint64, sender sdk.AccAddress) error {
+	escrow, err := k.getEscrow(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	senderStr, err := k.addrCdc.BytesToString(sender)
+	if err != nil {
+		return err
+	}
+	if escrow.Sender != senderStr {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of escrow %d", senderStr, id)
+	}
+
+	if k.HeaderService.HeaderInfo(ctx).Time.Before(escrow.Expiration) {
+		return errorsmod.Wrapf(types.ErrEscrowNotExpired, "escrow %d expires at %s", id, escrow.Expiration)
+	}
+
+	if err := k.releaseEscrow(ctx, escrow, sender); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeEscrowRefund,
+		event.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, senderStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+	)
+}
+
+// getEscrow returns the escrow with the given id, or ErrEscrowNotFound.
+func (k BaseKeeper) getEscrow(ctx context.Context, id uint64) (types.Escrow, error) {
+	escrow, err := k.Escrows.Get(ctx, id)
+	if err != nil {
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return types.Escrow{}, errorsmod.Wrapf(types.ErrEscrowNotFound, "%d", id)
+		}
+		return types.Escrow{}, err
+	}
+	return escrow, nil
+}
+
+// setEscrow stores the escrow and indexes it by sender and recipient.
+func (k BaseKeeper) setEscrow(ctx context.Context, escrow types.Escrow) error {
+	sender, recipient, err := k.escrowAddresses(escrow)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Escrows.Set(ctx, escrow.Id, escrow); err != nil {
+		return err
+	}
+	if err := k.BaseViewKeeper.EscrowsBySender.Set(ctx, collections.Join(sender, escrow.Id)); err != nil {
+		return err
+	}
+	return k.BaseViewKeeper.EscrowsByRecipient.Set(ctx, collections.Join(recipient, escrow.Id))
+}
+
+// releaseEscrow sends the coins held by the escrow to addr and removes the escrow.
+func (k BaseKeeper) releaseEscrow(ctx context.Context, escrow types.Escrow, addr sdk.AccAddress) error {
+	sender, recipient, err := k.escrowAddresses(escrow)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Escrows.Remove(ctx, escrow.Id); err != nil {
+		return err
+	}
+	if err := k.BaseViewKeeper.EscrowsBySender.Remove(ctx, collections.Join(sender, escrow.Id)); err != nil {
+		return err
+	}
+	if err := k.BaseViewKeeper.EscrowsByRecipient.Remove(ctx, collections.Join(recipient, escrow.Id)); err != nil {
+		return err
+	}
+
+	return k.sendEscrowCoins(ctx, types.EscrowAddress, addr, escrow.Amount)
+}
+
+// escrowAddresses decodes the sender and recipient addresses of the escrow.
+func (k BaseKeeper) escrowAddresses(escrow types.Escrow) (sender, recipient sdk.AccAddress, err error) {
+	sender, err = k.addrCdc.StringToBytes(escrow.Sender)
+	if err != nil {
+		return nil, nil, err
+	}
+	recipient, err = k.addrCdc.StringToBytes(escrow.Recipient)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sender, recipient, nil
+}
+
+// sendEscrowCoins transfers amt coins from fromAddr to toAddr without applying
+// the send restrictions, which are checked when the escrow is created.
+func (k BaseKeeper) sendEscrowCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.subUnlockedCoins(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, toAddr, amt); err != nil {
+		return err
+	}
+
+	fromAddrString, err := k.addrCdc.BytesToString(fromAddr)
+	if err != nil {
+		return err
+	}
+	toAddrString, err := k.addrCdc.BytesToString(toAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransfer,
+		event.NewAttribute(types.AttributeKeyRecipient, toAddrString),
+		event.NewAttribute(types.AttributeKeySender, fromAddrString),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
@@ -57,7 +57,29 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
-	return nil
+
+	for _, address := range genState.BlockedAddresses {
+		addr, err := k.addrCdc.StringToBytes(address)
+		if err != nil {
+			return err
+		}
+		if err := k.BaseViewKeeper.BlockedAddresses.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	// the escrow ids only need to be unique among the pending escrows, so the
+	// sequence resumes after the highest imported id.
+	var nextEscrowID uint64
+	for _, escrow := range genState.Escrows {
+		if err := k.setEscrow(ctx, escrow); err != nil {
+			return err
+		}
+		if escrow.Id >= nextEscrowID {
+			nextEscrowID = escrow.Id + 1
+		}
+	}
+	return k.EscrowSequence.Set(ctx, nextEscrowID)
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -74,5 +96,25 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	err = k.BaseViewKeeper.BlockedAddresses.Walk(ctx, nil, func(addr sdk.AccAddress) (bool, error) {
+		address, err := k.addrCdc.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		rv.BlockedAddresses = append(rv.BlockedAddresses, address)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Escrows.Walk(ctx, nil, func(_ uint64, escrow types.Escrow) (bool, error) {
+		rv.Escrows = append(rv.Escrows, escrow)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return rv, nil
 }
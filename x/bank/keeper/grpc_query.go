@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -44,7 +46,13 @@ func (k BaseKeeper) Balance(ctx context.Context, req *types.QueryBalanceRequest)
 
 	balance := k.GetBalance(ctx, address, req.Denom)
 
-	return &types.QueryBalanceResponse{Balance: &balance}, nil
+	resp := &types.QueryBalanceResponse{Balance: &balance}
+	if req.ConvertToDisplay {
+		displayBalance := k.displayCoin(ctx, balance)
+		resp.DisplayBalance = &displayBalance
+	}
+
+	return resp, nil
 }
 
 // AllBalances implements the Query/AllBalances gRPC method
@@ -58,11 +66,16 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
 	}
 
+	var displayBalances sdk.DecCoins
 	balances, pageRes, err := query.CollectionPaginate(
 		ctx,
 		k.Balances,
 		req.Pagination,
 		func(key collections.Pair[sdk.AccAddress, string], value math.Int) (sdk.Coin, error) {
+			if req.ConvertToDisplay {
+				displayBalances = append(displayBalances, k.displayCoin(ctx, sdk.NewCoin(key.K2(), value)))
+			}
+
 			if req.ResolveDenom {
 				if metadata, ok := k.GetDenomMetaData(ctx, key.K2()); ok {
 					return sdk.NewCoin(metadata.Display, value), nil
@@ -76,7 +89,19 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
 	}
 
-	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes, DisplayBalances: displayBalances}, nil
+}
+
+// displayCoin converts a coin to its display denom using the denom metadata.
+// The coin is kept in its base denom if it has no metadata or its metadata doesn't allow the conversion.
+func (k BaseKeeper) displayCoin(ctx context.Context, coin sdk.Coin) sdk.DecCoin {
+	if metadata, ok := k.GetDenomMetaData(ctx, coin.Denom); ok {
+		if displayCoin, err := metadata.ToDisplayCoin(coin); err == nil {
+			return displayCoin
+		}
+	}
+
+	return sdk.NewDecCoinFromCoin(coin)
 }
 
 // SpendableBalances implements a gRPC query handler for retrieving an account's
@@ -339,6 +364,38 @@ func (k BaseKeeper) SendEnabled(ctx context.Context, req *types.QuerySendEnabled
 	return resp, nil
 }
 
+// BlockedAddresses implements the Query/BlockedAddresses gRPC method
+func (k BaseKeeper) BlockedAddresses(ctx context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addresses, pageResp, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.BlockedAddresses,
+		req.Pagination, func(key sdk.AccAddress, _ collections.NoValue) (string, error) {
+			return k.addrCdc.BytesToString(key)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defaultAddresses := make([]string, 0, len(k.blockedAddrs))
+	for addr, blocked := range k.blockedAddrs {
+		if blocked {
+			defaultAddresses = append(defaultAddresses, addr)
+		}
+	}
+	slices.Sort(defaultAddresses)
+
+	return &types.QueryBlockedAddressesResponse{
+		DefaultAddresses: defaultAddresses,
+		Addresses:        addresses,
+		Pagination:       pageResp,
+	}, nil
+}
+
 // DenomOwnersByQuery is identical to DenomOwner query, but receives denom values via query string.
 func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDenomOwnersByQueryRequest) (*types.QueryDenomOwnersByQueryResponse, error) {
 	if req == nil {
@@ -354,3 +411,76 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// Escrow implements the Query/Escrow gRPC method
+func (k BaseKeeper) Escrow(ctx context.Context, req *types.QueryEscrowRequest) (*types.QueryEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	escrow, err := k.Escrows.Get(ctx, req.EscrowId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "escrow %d not found", req.EscrowId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowResponse{Escrow: escrow}, nil
+}
+
+// EscrowsBySender implements the Query/EscrowsBySender gRPC method
+func (k BaseKeeper) EscrowsBySender(ctx context.Context, req *types.QueryEscrowsBySenderRequest) (*types.QueryEscrowsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	escrows, pageRes, err := k.paginateEscrows(ctx, k.BaseViewKeeper.EscrowsBySender, req.Sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsBySenderResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
+
+// EscrowsByRecipient implements the Query/EscrowsByRecipient gRPC method
+func (k BaseKeeper) EscrowsByRecipient(ctx context.Context, req *types.QueryEscrowsByRecipientRequest) (*types.QueryEscrowsByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	escrows, pageRes, err := k.paginateEscrows(ctx, k.BaseViewKeeper.EscrowsByRecipient, req.Recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsByRecipientResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
+
+// paginateEscrows returns the escrows referenced by the given escrow index for the address.
+func (k BaseKeeper) paginateEscrows(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]],
+	address string,
+	pagination *query.PageRequest,
+) ([]types.Escrow, *query.PageResponse, error) {
+	addr, err := k.addrCdc.StringToBytes(address)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	escrows, pageRes, err := query.CollectionPaginate(
+		ctx,
+		index,
+		pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Escrow, error) {
+			return k.Escrows.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return escrows, pageRes, nil
+}
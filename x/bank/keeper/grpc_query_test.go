@@ -7,6 +7,7 @@ import (
 
 	v1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/testutil"
 	"cosmossdk.io/x/bank/types"
 
@@ -176,6 +177,49 @@ func (suite *KeeperTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *KeeperTestSuite) TestQueryBalancesConvertToDisplay() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+	_, _, addr := testdata.KeyTestPubAddr()
+	addrStr, err := suite.addrCdc.BytesToString(addr)
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.SetDenomMetaData(ctx, types.Metadata{
+		Name:   "Foo",
+		Symbol: "FOO",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: fooDenom, Exponent: 0},
+			{Denom: "mfoo", Exponent: 3},
+			{Denom: "kfoo", Exponent: 6},
+		},
+		Base:    fooDenom,
+		Display: "kfoo",
+	})
+
+	suite.mockFundAccount(addr)
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.bankKeeper, addr, sdk.NewCoins(newFooCoin(1_500_000), newBarCoin(30))))
+
+	// without conversion, no display balances are returned
+	res, err := queryClient.AllBalances(gocontext.Background(), types.NewQueryAllBalancesRequest(addrStr, nil, false))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.DisplayBalances)
+
+	req := types.NewQueryAllBalancesRequest(addrStr, nil, false)
+	req.ConvertToDisplay = true
+	res, err = queryClient.AllBalances(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(1_500_000), newBarCoin(30)), res.Balances)
+	suite.Require().Equal(sdk.DecCoins{
+		// bar has no metadata and is kept in its base denom
+		sdk.NewInt64DecCoin(barDenom, 30),
+		sdk.NewDecCoinFromDec("kfoo", math.LegacyMustNewDecFromStr("1.5")),
+	}, res.DisplayBalances)
+
+	balanceRes, err := queryClient.Balance(gocontext.Background(), &types.QueryBalanceRequest{Address: addrStr, Denom: fooDenom, ConvertToDisplay: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal(newFooCoin(1_500_000), *balanceRes.Balance)
+	suite.Require().Equal(sdk.NewDecCoinFromDec("kfoo", math.LegacyMustNewDecFromStr("1.5")), *balanceRes.DisplayBalance)
+}
+
 func (suite *KeeperTestSuite) TestSpendableBalances() {
 	_, _, addr := testdata.KeyTestPubAddr()
 	addrStr, err := suite.addrCdc.BytesToString(addr)
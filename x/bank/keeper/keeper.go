@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -50,6 +51,10 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	CreateEscrow(ctx context.Context, sender, recipient sdk.AccAddress, amt sdk.Coins, expiration time.Time) (uint64, error)
+	ClaimEscrow(ctx context.Context, id uint64, recipient sdk.AccAddress) error
+	RefundEscrow(ctx context.Context, id uint64, sender sdk.AccAddress) error
+
 	types.QueryServer
 }
 
@@ -258,7 +263,7 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule)
 	}
 
-	if k.BlockedAddr(recipientAddr) {
+	if k.BlockedAddr(ctx, recipientAddr) {
 		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
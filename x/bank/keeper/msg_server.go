@@ -53,7 +53,7 @@ func (k msgServer) Send(ctx context.Context, msg *types.MsgSend) (*types.MsgSend
 		return nil, err
 	}
 
-	if k.BlockedAddr(to) {
+	if k.BlockedAddr(ctx, to) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
@@ -96,7 +96,7 @@ func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*typ
 				return nil, err
 			}
 
-			if k.BlockedAddr(accAddr) {
+			if k.BlockedAddr(ctx, accAddr) {
 				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
 			}
 		} else {
@@ -162,6 +162,73 @@ func (k msgServer) SetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnab
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
 
+func (k msgServer) BlockAddresses(ctx context.Context, msg *types.MsgBlockAddresses) (*types.MsgBlockAddressesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	addrs, err := k.parseAddresses(msg.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range addrs {
+		if err := k.BlockAddress(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgBlockAddressesResponse{}, nil
+}
+
+func (k msgServer) UnblockAddresses(ctx context.Context, msg *types.MsgUnblockAddresses) (*types.MsgUnblockAddressesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	addrs, err := k.parseAddresses(msg.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range addrs {
+		if err := k.UnblockAddress(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUnblockAddressesResponse{}, nil
+}
+
+// parseAddresses decodes a non-empty list of unique addresses.
+func (k msgServer) parseAddresses(addresses []string) ([]sdk.AccAddress, error) {
+	if len(addresses) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("addresses cannot be empty")
+	}
+
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	seen := make(map[string]bool, len(addresses))
+	addrs := make([]sdk.AccAddress, 0, len(addresses))
+	for _, address := range addresses {
+		if seen[address] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate address %s", address)
+		}
+		seen[address] = true
+
+		addr, err := base.addrCdc.StringToBytes(address)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", address, err)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
 func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	var (
 		from []byte
@@ -197,3 +264,79 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+func (k msgServer) SendWithTimeout(ctx context.Context, msg *types.MsgSendWithTimeout) (*types.MsgSendWithTimeoutResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	from, err := base.addrCdc.StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+	to, err := base.addrCdc.StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if msg.Timeout <= 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("timeout must be positive, got %s", msg.Timeout)
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(ctx, to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	expiration := base.HeaderService.HeaderInfo(ctx).Time.Add(msg.Timeout)
+	id, err := k.CreateEscrow(ctx, from, to, msg.Amount, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendWithTimeoutResponse{EscrowId: id, Expiration: expiration}, nil
+}
+
+func (k msgServer) ClaimEscrow(ctx context.Context, msg *types.MsgClaimEscrow) (*types.MsgClaimEscrowResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	recipient, err := base.addrCdc.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.Keeper.ClaimEscrow(ctx, msg.EscrowId, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimEscrowResponse{}, nil
+}
+
+func (k msgServer) RefundEscrow(ctx context.Context, msg *types.MsgRefundEscrow) (*types.MsgRefundEscrowResponse, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	sender, err := base.addrCdc.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.Keeper.RefundEscrow(ctx, msg.EscrowId, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundEscrowResponse{}, nil
+}
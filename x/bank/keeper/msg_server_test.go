@@ -1,9 +1,16 @@
 package keeper_test
 
 import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	banktestutil "cosmossdk.io/x/bank/testutil"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -75,6 +82,8 @@ func (suite *KeeperTestSuite) TestMsgSend() {
 	suite.Require().NoError(err)
 	minterAccAddr, err := suite.addrCdc.BytesToString(minterAcc.GetAddress())
 	suite.Require().NoError(err)
+	escrowAddr, err := suite.addrCdc.BytesToString(banktypes.EscrowAddress)
+	suite.Require().NoError(err)
 
 	testCases := []struct {
 		name      string
@@ -92,6 +101,16 @@ func (suite *KeeperTestSuite) TestMsgSend() {
 			expErr:    true,
 			expErrMsg: "is not allowed to receive funds",
 		},
+		{
+			name: "invalid send to escrow address",
+			input: &banktypes.MsgSend{
+				FromAddress: minterAccAddr,
+				ToAddress:   escrowAddr,
+				Amount:      origCoins,
+			},
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
 		{
 			name: "invalid coins",
 			input: &banktypes.MsgSend{
@@ -436,3 +455,127 @@ func (suite *KeeperTestSuite) TestMsgBurn() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgBlockAddresses() {
+	ctx := suite.ctx
+	govAccAddr, err := suite.addrCdc.BytesToString(govAcc.GetAddress())
+	suite.Require().NoError(err)
+	addr0, err := suite.addrCdc.BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	addr1, err := suite.addrCdc.BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+	defaultAddr, err := suite.addrCdc.BytesToString(accAddrs[4])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.BlockAddresses(ctx, banktypes.NewMsgBlockAddresses("invalid", []string{addr0}))
+	suite.Require().ErrorContains(err, "invalid authority")
+	_, err = suite.msgServer.BlockAddresses(ctx, banktypes.NewMsgBlockAddresses(govAccAddr, nil))
+	suite.Require().ErrorContains(err, "addresses cannot be empty")
+	_, err = suite.msgServer.BlockAddresses(ctx, banktypes.NewMsgBlockAddresses(govAccAddr, []string{addr0, addr0}))
+	suite.Require().ErrorContains(err, "duplicate address")
+	_, err = suite.msgServer.BlockAddresses(ctx, banktypes.NewMsgBlockAddresses(govAccAddr, []string{"invalid"}))
+	suite.Require().ErrorContains(err, "invalid address")
+
+	suite.Require().False(suite.bankKeeper.BlockedAddr(ctx, accAddrs[0]))
+	_, err = suite.msgServer.BlockAddresses(ctx, banktypes.NewMsgBlockAddresses(govAccAddr, []string{addr0, addr1, defaultAddr}))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.bankKeeper.BlockedAddr(ctx, accAddrs[0]))
+	suite.Require().True(suite.bankKeeper.BlockedAddr(ctx, accAddrs[1]))
+
+	res, err := suite.queryClient.BlockedAddresses(ctx, &banktypes.QueryBlockedAddressesRequest{})
+	suite.Require().NoError(err)
+	escrowAddr, err := suite.addrCdc.BytesToString(banktypes.EscrowAddress)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]string{defaultAddr, escrowAddr}, res.DefaultAddresses)
+	suite.Require().ElementsMatch([]string{addr0, addr1}, res.Addresses)
+
+	// the addresses blocked by default cannot be unblocked
+	_, err = suite.msgServer.UnblockAddresses(ctx, banktypes.NewMsgUnblockAddresses(govAccAddr, []string{defaultAddr}))
+	suite.Require().ErrorIs(err, banktypes.ErrDefaultBlockedAddress)
+
+	_, err = suite.msgServer.UnblockAddresses(ctx, banktypes.NewMsgUnblockAddresses(govAccAddr, []string{addr0}))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.bankKeeper.BlockedAddr(ctx, accAddrs[0]))
+	suite.Require().True(suite.bankKeeper.BlockedAddr(ctx, accAddrs[1]))
+	suite.Require().True(suite.bankKeeper.BlockedAddr(ctx, accAddrs[4]))
+
+	genState, err := suite.bankKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{addr1}, genState.BlockedAddresses)
+}
+
+func (suite *KeeperTestSuite) TestMsgSendWithTimeout() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	sender, recipient := accAddrs[0], accAddrs[1]
+	senderAddr, err := suite.addrCdc.BytesToString(sender)
+	require.NoError(err)
+	recipientAddr, err := suite.addrCdc.BytesToString(recipient)
+	require.NoError(err)
+	blockedAddr, err := suite.addrCdc.BytesToString(accAddrs[4])
+	require.NoError(err)
+
+	suite.mockFundAccount(sender)
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, sender, coins))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	_, err = suite.msgServer.SendWithTimeout(ctx, banktypes.NewMsgSendWithTimeout(senderAddr, recipientAddr, coins, 0))
+	require.ErrorContains(err, "timeout must be positive")
+	_, err = suite.msgServer.SendWithTimeout(ctx, banktypes.NewMsgSendWithTimeout(senderAddr, blockedAddr, coins, time.Hour))
+	require.ErrorContains(err, "is not allowed to receive funds")
+	_, err = suite.msgServer.SendWithTimeout(ctx, banktypes.NewMsgSendWithTimeout(senderAddr, recipientAddr, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, time.Hour))
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	_, err = suite.msgServer.SendWithTimeout(ctx, banktypes.NewMsgSendWithTimeout(senderAddr, recipientAddr, coins.Add(coins...), time.Hour))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the recipient claims the escrow before it expires
+	res, err := suite.msgServer.SendWithTimeout(ctx, banktypes.NewMsgSendWithTimeout(senderAddr, recipientAddr, coins, time.Hour))
+	require.NoError(err)
+	require.Equal(ctx.HeaderInfo().Time.Add(time.Hour), res.Expiration)
+	require.True(suite.bankKeeper.GetAllBalances(ctx, sender).IsZero())
+	require.Equal(coins, suite.bankKeeper.GetAllBalances(ctx, banktypes.EscrowAddress))
+
+	escrows, err := suite.queryClient.EscrowsByRecipient(ctx, &banktypes.QueryEscrowsByRecipientRequest{Recipient: recipientAddr})
+	require.NoError(err)
+	require.Len(escrows.Escrows, 1)
+	require.Equal(banktypes.Escrow{Id: res.EscrowId, Sender: senderAddr, Recipient: recipientAddr, Amount: coins, Expiration: res.Expiration}, escrows.Escrows[0])
+
+	_, err = suite.msgServer.RefundEscrow(ctx, banktypes.NewMsgRefundEscrow(senderAddr, res.EscrowId))
+	require.ErrorIs(err, banktypes.ErrEscrowNotExpired)
+	_, err = suite.msgServer.ClaimEscrow(ctx, banktypes.NewMsgClaimEscrow(senderAddr, res.EscrowId))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.ClaimEscrow(ctx, banktypes.NewMsgClaimEscrow(recipientAddr, res.EscrowId))
+	require.NoError(err)
+	require.Equal(coins, suite.bankKeeper.GetAllBalances(ctx, recipient))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, banktypes.EscrowAddress).IsZero())
+
+	_, err = suite.msgServer.ClaimEscrow(ctx, banktypes.NewMsgClaimEscrow(recipientAddr, res.EscrowId))
+	require.ErrorIs(err, banktypes.ErrEscrowNotFound)
+	_, err = suite.queryClient.Escrow(ctx, &banktypes.QueryEscrowRequest{EscrowId: res.EscrowId})
+	require.ErrorContains(err, "not found")
+
+	// the sender gets an expired escrow refunded
+	res, err = suite.msgServer.SendWithTimeout(ctx, banktypes.NewMsgSendWithTimeout(recipientAddr, senderAddr, coins, time.Hour))
+	require.NoError(err)
+
+	genState, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genState.Escrows, 1)
+	require.Equal(res.EscrowId, genState.Escrows[0].Id)
+
+	expiredCtx := ctx.WithHeaderInfo(header.Info{Time: res.Expiration})
+	_, err = suite.msgServer.ClaimEscrow(expiredCtx, banktypes.NewMsgClaimEscrow(senderAddr, res.EscrowId))
+	require.ErrorIs(err, banktypes.ErrEscrowExpired)
+	_, err = suite.msgServer.RefundEscrow(expiredCtx, banktypes.NewMsgRefundEscrow(senderAddr, res.EscrowId))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.RefundEscrow(expiredCtx, banktypes.NewMsgRefundEscrow(recipientAddr, res.EscrowId))
+	require.NoError(err)
+	require.Equal(coins, suite.bankKeeper.GetAllBalances(ctx, recipient))
+
+	escrowsBySender, err := suite.queryClient.EscrowsBySender(ctx, &banktypes.QueryEscrowsBySenderRequest{Sender: recipientAddr})
+	require.NoError(err)
+	require.Empty(escrowsBySender.Escrows)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
@@ -42,8 +43,10 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 
-	BlockedAddr(addr sdk.AccAddress) bool
+	BlockedAddr(ctx context.Context, addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
+	BlockAddress(ctx context.Context, addr sdk.AccAddress) error
+	UnblockAddress(ctx context.Context, addr sdk.AccAddress) error
 
 	GetAuthority() string
 }
@@ -59,7 +62,9 @@ type BaseSendKeeper struct {
 	cdc codec.BinaryCodec
 	ak  types.AccountKeeper
 
-	// list of addresses that are restricted from receiving transactions
+	// list of addresses that are restricted from receiving transactions by default,
+	// typically the module accounts. The authority can block additional addresses,
+	// see BaseViewKeeper.BlockedAddresses.
 	blockedAddrs map[string]bool
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -80,6 +85,17 @@ func NewBaseSendKeeper(
 		panic(fmt.Errorf("invalid bank authority address: %w", err))
 	}
 
+	// the escrow address only receives funds through the escrowed sends
+	escrowAddr, err := ak.AddressCodec().BytesToString(types.EscrowAddress)
+	if err != nil {
+		panic(fmt.Errorf("invalid bank escrow address: %w", err))
+	}
+	blockedAddrs = maps.Clone(blockedAddrs)
+	if blockedAddrs == nil {
+		blockedAddrs = make(map[string]bool, 1)
+	}
+	blockedAddrs[escrowAddr] = true
+
 	return BaseSendKeeper{
 		Environment:     env,
 		BaseViewKeeper:  NewBaseViewKeeper(env, cdc, ak),
@@ -357,20 +373,77 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bo
 }
 
 // BlockedAddr checks if a given address is restricted from
-// receiving funds.
-func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+// receiving funds, either by default or by the authority.
+func (k BaseSendKeeper) BlockedAddr(ctx context.Context, addr sdk.AccAddress) bool {
 	addrStr, err := k.addrCdc.BytesToString(addr)
 	if err != nil {
 		panic(err)
 	}
-	return k.blockedAddrs[addrStr]
+	if k.blockedAddrs[addrStr] {
+		return true
+	}
+
+	blocked, err := k.BlockedAddresses.Has(ctx, addr)
+	if err != nil {
+		panic(err)
+	}
+	return blocked
 }
 
-// GetBlockedAddresses returns the full list of addresses restricted from receiving funds.
+// GetBlockedAddresses returns the list of addresses restricted from receiving funds by default.
+// The addresses blocked by the authority are stored in BlockedAddresses.
 func (k BaseSendKeeper) GetBlockedAddresses() map[string]bool {
 	return k.blockedAddrs
 }
 
+// BlockAddress restricts an address from receiving funds. Blocking an address
+// which is already blocked is a no-op.
+func (k BaseSendKeeper) BlockAddress(ctx context.Context, addr sdk.AccAddress) error {
+	if k.BlockedAddr(ctx, addr) {
+		return nil
+	}
+
+	if err := k.BlockedAddresses.Set(ctx, addr); err != nil {
+		return err
+	}
+
+	addrStr, err := k.addrCdc.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBlockAddress,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+	)
+}
+
+// UnblockAddress allows an address blocked with BlockAddress to receive funds
+// again. The addresses blocked by default cannot be unblocked. Unblocking an
+// address which is not blocked is a no-op.
+func (k BaseSendKeeper) UnblockAddress(ctx context.Context, addr sdk.AccAddress) error {
+	addrStr, err := k.addrCdc.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+	if k.blockedAddrs[addrStr] {
+		return errorsmod.Wrap(types.ErrDefaultBlockedAddress, addrStr)
+	}
+
+	blocked, err := k.BlockedAddresses.Has(ctx, addr)
+	if err != nil || !blocked {
+		return err
+	}
+
+	if err := k.BlockedAddresses.Remove(ctx, addr); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUnblockAddress,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+	)
+}
+
 // IsSendEnabledDenom returns the current SendEnabled status of the provided denom.
 func (k BaseSendKeeper) IsSendEnabledDenom(ctx context.Context, denom string) bool {
 	return k.getSendEnabledOrDefault(ctx, denom, k.GetParams(ctx).DefaultSendEnabled)
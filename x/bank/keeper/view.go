@@ -68,21 +68,35 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	// BlockedAddresses holds the addresses blocked from receiving funds by the authority,
+	// in addition to the addresses blocked by default.
+	BlockedAddresses collections.KeySet[sdk.AccAddress]
+	// Escrows holds the pending escrowed transfers by id. EscrowsBySender and EscrowsByRecipient
+	// index them by sender and recipient address, and EscrowSequence assigns their ids.
+	Escrows            collections.Map[uint64, types.Escrow]
+	EscrowsBySender    collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	EscrowsByRecipient collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	EscrowSequence     collections.Sequence
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak types.AccountKeeper) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := BaseViewKeeper{
-		Environment:   env,
-		cdc:           cdc,
-		ak:            ak,
-		addrCdc:       ak.AddressCodec(),
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Environment:        env,
+		cdc:                cdc,
+		ak:                 ak,
+		addrCdc:            ak.AddressCodec(),
+		Supply:             collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:      collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:        collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:           collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BlockedAddresses:   collections.NewKeySet(sb, types.BlockedAddressesPrefix, "blocked_addresses", sdk.AccAddressKey),
+		Escrows:            collections.NewMap(sb, types.EscrowsPrefix, "escrows", collections.Uint64Key, codec.CollValue[types.Escrow](cdc)),
+		EscrowsBySender:    collections.NewKeySet(sb, types.EscrowsBySenderPrefix, "escrows_by_sender", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		EscrowsByRecipient: collections.NewKeySet(sb, types.EscrowsByRecipientPrefix, "escrows_by_recipient", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		EscrowSequence:     collections.NewSequence(sb, types.EscrowSequenceKey, "escrow_sequence"),
 	}
 
 	schema, err := sb.Build()
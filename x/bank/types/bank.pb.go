@@ -12,15 +12,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -443,6 +447,90 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// Escrow defines funds sent with a timeout, held in escrow until they are claimed
+// by the recipient or, once expired, refunded to the sender.
+type Escrow struct {
+	// id is the unique identifier of the escrow.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the account which sent the funds.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account which can claim the funds.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of funds held in escrow.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// expiration is the time until which the recipient can claim the funds, and
+	// from which the sender can get them refunded.
+	Expiration time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Escrow) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Escrow) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Escrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Escrow) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -451,57 +539,66 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*Escrow)(nil), "cosmos.bank.v1beta1.Escrow")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x13, 0x4b,
-	0x10, 0xf7, 0xfa, 0x3b, 0xeb, 0x3c, 0x3d, 0xbd, 0x8d, 0xa5, 0x77, 0xf1, 0xd3, 0x3b, 0x5b, 0x6e,
-	0x30, 0x06, 0xdb, 0xf9, 0x40, 0x48, 0xb8, 0x41, 0x38, 0x7c, 0xb9, 0x40, 0xa0, 0x8b, 0x22, 0x24,
-	0x1a, 0x6b, 0xed, 0x5b, 0xec, 0x55, 0xee, 0x76, 0x4f, 0xb7, 0x7b, 0x21, 0x6e, 0xa9, 0x10, 0x15,
-	0x35, 0x55, 0x44, 0x85, 0x10, 0x85, 0x8b, 0xf4, 0xb4, 0x51, 0xaa, 0x88, 0x0a, 0xa5, 0x08, 0xc8,
-	0x29, 0x9c, 0x3f, 0x03, 0xdd, 0xde, 0x9d, 0xed, 0x08, 0xa7, 0x45, 0xa2, 0xb1, 0x77, 0xe6, 0xf7,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x2d, 0xd4, 0x7b, 0x5c, 0xd8, 0x5c, 0x34, 0xba, 0x98, 0xed, 0x36,
-	0xf6, 0xd6, 0xbb, 0x44, 0xe2, 0x75, 0x65, 0xd4, 0x1d, 0x97, 0x4b, 0x8e, 0x56, 0x02, 0xbc, 0xae,
-	0x5c, 0x21, 0x5e, 0xc8, 0xf7, 0x79, 0x9f, 0x2b, 0xbc, 0xe1, 0x9f, 0x02, 0x6a, 0x61, 0x35, 0xa0,
-	0x76, 0x02, 0x20, 0x8c, 0x0b, 0xa0, 0x59, 0x15, 0x41, 0xa6, 0x55, 0x7a, 0x9c, 0xb2, 0x10, 0xff,
-	0x37, 0xc4, 0x6d, 0xd1, 0x6f, 0xec, 0xad, 0xfb, 0x7f, 0x21, 0xf0, 0x0f, 0xb6, 0x29, 0xe3, 0x0d,
-	0xf5, 0x1b, 0xb8, 0xca, 0x1f, 0x00, 0x4c, 0x3f, 0xc3, 0x2e, 0xb6, 0x05, 0x7a, 0x04, 0x97, 0x05,
-	0x61, 0x66, 0x87, 0x30, 0xdc, 0xb5, 0x88, 0xa9, 0x81, 0x52, 0xa2, 0x92, 0xdb, 0x28, 0xd5, 0x17,
-	0x68, 0xae, 0x6f, 0x13, 0x66, 0x3e, 0x08, 0x78, 0xad, 0xb8, 0x06, 0x8c, 0x9c, 0x98, 0x39, 0xd0,
-	0x1a, 0xcc, 0x9b, 0xe4, 0x25, 0xf6, 0x2c, 0xd9, 0xb9, 0x94, 0x30, 0x5e, 0x02, 0x95, 0xac, 0x81,
-	0x42, 0x6c, 0x2e, 0x45, 0xf3, 0xff, 0xb7, 0x93, 0x51, 0x55, 0x0b, 0x0a, 0xd5, 0x84, 0xb9, 0xdb,
-	0xd8, 0x0f, 0x46, 0x18, 0x28, 0x2b, 0x6f, 0xc1, 0xdc, 0x1c, 0x1b, 0xe5, 0x61, 0xca, 0x24, 0x8c,
-	0xdb, 0x1a, 0x28, 0x81, 0xca, 0x92, 0x11, 0x18, 0x48, 0x83, 0x99, 0xcb, 0x85, 0x22, 0xb3, 0x99,
-	0xbc, 0x38, 0x28, 0x82, 0xf2, 0x31, 0x80, 0xa9, 0x36, 0x73, 0x3c, 0x89, 0x36, 0x60, 0x06, 0x9b,
-	0xa6, 0x4b, 0x84, 0x08, 0x32, 0xb4, 0xb4, 0xaf, 0x87, 0xb5, 0x7c, 0xd8, 0xe6, 0xbd, 0x00, 0xd9,
-	0x96, 0x2e, 0x65, 0x7d, 0x23, 0x22, 0xa2, 0x57, 0x30, 0xe5, 0x4f, 0x58, 0x68, 0x71, 0x35, 0x95,
-	0xd5, 0xd9, 0x54, 0x04, 0x99, 0x4e, 0x65, 0x8b, 0x53, 0xd6, 0x7a, 0x78, 0x74, 0x56, 0x8c, 0x7d,
-	0xfa, 0x5e, 0xac, 0xf4, 0xa9, 0x1c, 0x78, 0xdd, 0x7a, 0x8f, 0xdb, 0xe1, 0xf5, 0x35, 0xe6, 0x1a,
-	0x94, 0x43, 0x87, 0x08, 0x15, 0x20, 0xde, 0x4f, 0x46, 0xd5, 0x65, 0x8b, 0xf4, 0x71, 0x6f, 0xd8,
-	0x51, 0x35, 0x3e, 0x4e, 0x46, 0x55, 0x60, 0x04, 0xf5, 0x9a, 0xf9, 0x37, 0x07, 0xc5, 0xd8, 0xc5,
-	0x41, 0x31, 0xf6, 0x7a, 0x32, 0xaa, 0x46, 0x72, 0xca, 0x5f, 0x00, 0x4c, 0x3f, 0xf5, 0xe4, 0x1f,
-	0xd7, 0x4d, 0x36, 0xea, 0xa6, 0xfc, 0x19, 0xc0, 0xf4, 0xb6, 0xe7, 0x38, 0xd6, 0xd0, 0x57, 0x23,
-	0xb9, 0xc4, 0x56, 0xb8, 0x71, 0xbf, 0x43, 0x8d, 0xaa, 0xd7, 0xbc, 0x1e, 0xaa, 0x01, 0xc7, 0x87,
-	0xb5, 0xff, 0x16, 0xae, 0xb9, 0x12, 0xd8, 0xd6, 0x40, 0xf9, 0x39, 0x5c, 0xba, 0xef, 0xaf, 0xd9,
-	0x0e, 0xa3, 0xf2, 0x8a, 0x05, 0x2c, 0xc0, 0x2c, 0xd9, 0x77, 0x38, 0x23, 0x4c, 0xaa, 0x0d, 0xfc,
-	0xcb, 0x98, 0xda, 0xfe, 0x72, 0x62, 0x8b, 0x62, 0x41, 0x84, 0x96, 0x28, 0x25, 0x2a, 0x4b, 0x46,
-	0x64, 0x96, 0x4f, 0xe3, 0x30, 0xfb, 0x84, 0x48, 0x6c, 0x62, 0x89, 0x51, 0x09, 0xe6, 0x4c, 0x22,
-	0x7a, 0x2e, 0x75, 0x24, 0xe5, 0x2c, 0x4c, 0x3f, 0xef, 0x42, 0x77, 0x7d, 0x06, 0xe3, 0x76, 0xc7,
-	0x63, 0x54, 0x46, 0xf7, 0xa7, 0x2f, 0xfc, 0x46, 0xa7, 0x7a, 0x0d, 0x68, 0x46, 0x47, 0x81, 0x10,
-	0x4c, 0xfa, 0x73, 0xd5, 0x12, 0x2a, 0xb7, 0x3a, 0xfb, 0xea, 0x4c, 0x2a, 0x1c, 0x0b, 0x0f, 0xb5,
-	0xa4, 0x72, 0x47, 0x26, 0xba, 0x06, 0x93, 0x0c, 0xdb, 0x44, 0x4b, 0xa9, 0xcd, 0x5a, 0x39, 0x3d,
-	0xac, 0xfd, 0x3d, 0x1b, 0x74, 0x69, 0xad, 0x7e, 0x6b, 0xd3, 0x50, 0x04, 0x74, 0x03, 0xa6, 0xc5,
-	0xd0, 0xee, 0x72, 0x4b, 0x4b, 0x5f, 0x4d, 0x0d, 0x29, 0xe8, 0x26, 0x4c, 0x78, 0x2e, 0xd5, 0x32,
-	0x8a, 0x59, 0x18, 0x9f, 0x15, 0x13, 0x3b, 0x46, 0xfb, 0xd7, 0x80, 0xdb, 0x86, 0x4f, 0x43, 0x77,
-	0x60, 0xd6, 0x73, 0x69, 0x67, 0x80, 0xc5, 0x40, 0xcb, 0xaa, 0x10, 0x7d, 0x7c, 0x56, 0xcc, 0xec,
-	0x18, 0xed, 0xc7, 0x58, 0x0c, 0x16, 0x85, 0x65, 0x3c, 0x97, 0xfa, 0x58, 0x6b, 0xf3, 0x68, 0xac,
-	0x83, 0x93, 0xb1, 0x0e, 0x7e, 0x8c, 0x75, 0xf0, 0xee, 0x5c, 0x8f, 0x9d, 0x9c, 0xeb, 0xb1, 0x6f,
-	0xe7, 0x7a, 0xec, 0x45, 0xf8, 0xbc, 0x0a, 0x73, 0xb7, 0x4e, 0x79, 0xf4, 0xdc, 0xa8, 0xc5, 0xe9,
-	0xa6, 0xd5, 0xcb, 0xb8, 0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x68, 0x6d, 0x04, 0xcd, 0x05,
-	0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd8, 0xbe, 0xb5, 0x6f, 0x9c, 0x03, 0x65, 0x62, 0x89, 0x89, 0x11, 0xbb, 0x96, 0x1b,
+	0xcc, 0x81, 0x77, 0xef, 0x07, 0x8a, 0x84, 0x1b, 0x84, 0x43, 0x00, 0x17, 0x08, 0xb4, 0xc7, 0x09,
+	0x89, 0xc6, 0x1a, 0x7b, 0x27, 0x7b, 0xa3, 0xdb, 0x9d, 0x59, 0xed, 0xcc, 0x5e, 0xce, 0x2d, 0x15,
+	0xa2, 0x4a, 0x4d, 0x75, 0xa2, 0x42, 0x88, 0xe2, 0x8a, 0xeb, 0x69, 0xa3, 0x54, 0x51, 0x2a, 0x14,
+	0xa4, 0x0b, 0xf2, 0x15, 0x97, 0x3f, 0x03, 0xed, 0xcc, 0xee, 0xd9, 0x11, 0x17, 0xa5, 0x8b, 0x44,
+	0x63, 0xcf, 0x9b, 0xef, 0x7b, 0xef, 0x7b, 0xf3, 0xde, 0xdb, 0x19, 0x68, 0xcf, 0x84, 0x8c, 0x85,
+	0xf4, 0xa6, 0x84, 0x1f, 0x7a, 0x47, 0xdb, 0x53, 0xaa, 0xc8, 0xb6, 0x36, 0xdc, 0x24, 0x15, 0x4a,
+	0xa0, 0x5b, 0x06, 0x77, 0xf5, 0x56, 0x81, 0x77, 0xda, 0xa1, 0x08, 0x85, 0xc6, 0xbd, 0x7c, 0x65,
+	0xa8, 0x9d, 0xdb, 0x86, 0x3a, 0x31, 0x40, 0xe1, 0x67, 0xa0, 0xa5, 0x8a, 0xa4, 0x57, 0x2a, 0x33,
+	0xc1, 0x78, 0x81, 0xbf, 0x53, 0xe0, 0xb1, 0x0c, 0xbd, 0xa3, 0xed, 0xfc, 0xaf, 0x00, 0x6e, 0x92,
+	0x98, 0x71, 0xe1, 0xe9, 0xdf, 0x62, 0xcb, 0x09, 0x85, 0x08, 0x23, 0xea, 0x69, 0x6b, 0x9a, 0xdd,
+	0xf7, 0x14, 0x8b, 0xa9, 0x54, 0x24, 0x4e, 0x0c, 0xa1, 0xf7, 0x2b, 0x80, 0xd6, 0xb7, 0x24, 0x25,
+	0xb1, 0x44, 0x5f, 0xc2, 0x1b, 0x92, 0xf2, 0x60, 0x42, 0x39, 0x99, 0x46, 0x34, 0xc0, 0xa0, 0x5b,
+	0xeb, 0xb7, 0x76, 0xba, 0xee, 0x35, 0x87, 0x72, 0xf7, 0x28, 0x0f, 0xee, 0x19, 0xde, 0xa8, 0x8a,
+	0x81, 0xdf, 0x92, 0xcb, 0x0d, 0xb4, 0x05, 0xdb, 0x01, 0xbd, 0x4f, 0xb2, 0x48, 0x4d, 0x5e, 0x0a,
+	0x58, 0xed, 0x82, 0x7e, 0xd3, 0x47, 0x05, 0xb6, 0x12, 0x62, 0xf8, 0xde, 0xcf, 0x97, 0xa7, 0x9b,
+	0xd8, 0x08, 0x0d, 0x64, 0x70, 0xe8, 0x1d, 0x9b, 0x1a, 0x9b, 0xcc, 0x7a, 0x77, 0x61, 0x6b, 0x85,
+	0x8d, 0xda, 0x70, 0x2d, 0xa0, 0x5c, 0xc4, 0x18, 0x74, 0x41, 0x7f, 0xdd, 0x37, 0x06, 0xc2, 0xb0,
+	0xf1, 0xb2, 0x50, 0x69, 0x0e, 0xeb, 0x2f, 0x4e, 0x1c, 0xd0, 0x7b, 0x0c, 0xe0, 0xda, 0x98, 0x27,
+	0x99, 0x42, 0x3b, 0xb0, 0x41, 0x82, 0x20, 0xa5, 0x52, 0x9a, 0x08, 0x23, 0xfc, 0xf4, 0x6c, 0xd0,
+	0x2e, 0x8e, 0xf9, 0x99, 0x41, 0xf6, 0x54, 0xca, 0x78, 0xe8, 0x97, 0x44, 0xf4, 0x00, 0xae, 0xe5,
+	0x2d, 0x90, 0xb8, 0xaa, 0xab, 0x72, 0x7b, 0x59, 0x15, 0x49, 0xaf, 0xaa, 0x72, 0x57, 0x30, 0x3e,
+	0xfa, 0xe2, 0xd1, 0xb9, 0x53, 0xf9, 0xfd, 0xb9, 0xd3, 0x0f, 0x99, 0x3a, 0xc8, 0xa6, 0xee, 0x4c,
+	0xc4, 0x45, 0x7f, 0xbd, 0x95, 0x03, 0xaa, 0x79, 0x42, 0xa5, 0x76, 0x90, 0xbf, 0x5c, 0x9e, 0x6e,
+	0xde, 0x88, 0x68, 0x48, 0x66, 0xf3, 0x89, 0xd6, 0xf8, 0xed, 0xf2, 0x74, 0x13, 0xf8, 0x46, 0x6f,
+	0xd8, 0xfe, 0xe9, 0xc4, 0xa9, 0xbc, 0x38, 0x71, 0x2a, 0x3f, 0x5e, 0x9e, 0x6e, 0x96, 0xe9, 0xf4,
+	0xfe, 0x04, 0xd0, 0xfa, 0x26, 0x53, 0xff, 0xbb, 0xd3, 0x34, 0xcb, 0xd3, 0xf4, 0xfe, 0x00, 0xd0,
+	0xda, 0xcb, 0x92, 0x24, 0x9a, 0xe7, 0xd9, 0x28, 0xa1, 0x48, 0x84, 0xc1, 0x1b, 0xcb, 0x46, 0xeb,
+	0x0d, 0x3f, 0x28, 0xb2, 0x01, 0x8f, 0xcf, 0x06, 0xef, 0x5e, 0x3b, 0xe6, 0x3a, 0xc1, 0x31, 0x06,
+	0xbd, 0xef, 0xe1, 0xfa, 0xe7, 0xf9, 0x98, 0xed, 0x73, 0xa6, 0x5e, 0x31, 0x80, 0x1d, 0xd8, 0xa4,
+	0xc7, 0x89, 0xe0, 0x94, 0x2b, 0x3d, 0x81, 0x1b, 0xfe, 0x95, 0x9d, 0x0f, 0x27, 0x89, 0x18, 0x91,
+	0x54, 0xe2, 0x5a, 0xb7, 0xd6, 0x5f, 0xf7, 0x4b, 0xb3, 0xf7, 0xac, 0x0a, 0x9b, 0x5f, 0x53, 0x45,
+	0x02, 0xa2, 0x08, 0xea, 0xc2, 0x56, 0x40, 0xe5, 0x2c, 0x65, 0x89, 0x62, 0x82, 0x17, 0xe1, 0x57,
+	0xb7, 0xd0, 0xa7, 0x39, 0x83, 0x8b, 0x78, 0x92, 0x71, 0xa6, 0xca, 0xfe, 0xd9, 0xd7, 0x7e, 0xa3,
+	0x57, 0xf9, 0xfa, 0x30, 0x28, 0x97, 0x12, 0x21, 0x58, 0xcf, 0xeb, 0x8a, 0x6b, 0x3a, 0xb6, 0x5e,
+	0xe7, 0xd9, 0x05, 0x4c, 0x26, 0x11, 0x99, 0xe3, 0xba, 0xde, 0x2e, 0x4d, 0xf4, 0x3e, 0xac, 0x73,
+	0x12, 0x53, 0xbc, 0xa6, 0x27, 0xeb, 0xd6, 0xb3, 0xb3, 0xc1, 0xdb, 0xcb, 0x42, 0x77, 0xb7, 0xdc,
+	0x8f, 0x77, 0x7d, 0x4d, 0x40, 0x1f, 0x42, 0x4b, 0xce, 0xe3, 0xa9, 0x88, 0xb0, 0xf5, 0x6a, 0x6a,
+	0x41, 0x41, 0x1f, 0xc1, 0x5a, 0x96, 0x32, 0xdc, 0xd0, 0xcc, 0xce, 0xe2, 0xdc, 0xa9, 0xed, 0xfb,
+	0xe3, 0xff, 0x3a, 0xdc, 0xf1, 0x73, 0x1a, 0xfa, 0x04, 0x36, 0xb3, 0x94, 0x4d, 0x0e, 0x88, 0x3c,
+	0xc0, 0x4d, 0xed, 0x62, 0x2f, 0xce, 0x9d, 0xc6, 0xbe, 0x3f, 0xfe, 0x8a, 0xc8, 0x83, 0xeb, 0xdc,
+	0x1a, 0x59, 0xca, 0x72, 0xac, 0xf7, 0x77, 0x15, 0x5a, 0xf7, 0xe4, 0x2c, 0x15, 0x0f, 0xd0, 0x5b,
+	0xb0, 0xca, 0x02, 0x5d, 0xd1, 0xba, 0x5f, 0x65, 0xf9, 0x25, 0x65, 0xe5, 0x97, 0x13, 0x4d, 0x71,
+	0xf5, 0x35, 0x5f, 0x4d, 0xc1, 0x43, 0x77, 0xe0, 0x7a, 0x4a, 0x67, 0x2c, 0x61, 0x79, 0x83, 0x6b,
+	0xaf, 0x71, 0x5a, 0x52, 0xd1, 0x1c, 0x5a, 0x24, 0x16, 0x19, 0x57, 0xb8, 0xfe, 0xa6, 0xe6, 0xbb,
+	0x10, 0x44, 0x63, 0x08, 0xe9, 0x71, 0xc2, 0x52, 0xa2, 0xc7, 0x29, 0x6f, 0x62, 0x6b, 0xa7, 0xe3,
+	0x9a, 0x37, 0xc1, 0x2d, 0xdf, 0x04, 0xf7, 0xbb, 0xf2, 0x4d, 0x18, 0x6d, 0xe4, 0xfa, 0x0f, 0x9f,
+	0x3b, 0xc0, 0x84, 0x59, 0x71, 0x1e, 0xde, 0x7c, 0x7a, 0x36, 0xd8, 0x30, 0xd7, 0x72, 0xf7, 0x68,
+	0xcb, 0xdd, 0x71, 0xb7, 0x46, 0xbb, 0x8f, 0x16, 0x36, 0x78, 0xb2, 0xb0, 0xc1, 0x3f, 0x0b, 0x1b,
+	0x3c, 0xbc, 0xb0, 0x2b, 0x4f, 0x2e, 0xec, 0xca, 0x5f, 0x17, 0x76, 0xe5, 0x87, 0xe2, 0x75, 0x93,
+	0xc1, 0xa1, 0xcb, 0x44, 0x79, 0x99, 0xeb, 0xb4, 0xa7, 0x96, 0x96, 0xdd, 0xfd, 0x77, 0x00, 0x55,
+	0x57, 0xf8, 0xbf, 0x4c, 0x07, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -902,6 +999,70 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBank(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -1065,6 +1226,34 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2034,6 +2223,206 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Escrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
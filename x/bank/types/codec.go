@@ -15,6 +15,11 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(registrar, &MsgBlockAddresses{}, "cosmos-sdk/MsgBlockAddresses")
+	legacy.RegisterAminoMsg(registrar, &MsgUnblockAddresses{}, "cosmos-sdk/MsgUnblockAddresses")
+	legacy.RegisterAminoMsg(registrar, &MsgSendWithTimeout{}, "cosmos-sdk/MsgSendWithTimeout")
+	legacy.RegisterAminoMsg(registrar, &MsgClaimEscrow{}, "cosmos-sdk/MsgClaimEscrow")
+	legacy.RegisterAminoMsg(registrar, &MsgRefundEscrow{}, "cosmos-sdk/MsgRefundEscrow")
 
 	registrar.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization")
 	registrar.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params")
@@ -27,6 +32,11 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgBurn{},
 		&MsgSetSendEnabled{},
+		&MsgBlockAddresses{},
+		&MsgUnblockAddresses{},
+		&MsgSendWithTimeout{},
+		&MsgClaimEscrow{},
+		&MsgRefundEscrow{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
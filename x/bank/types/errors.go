@@ -13,4 +13,8 @@ var (
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
 	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrDefaultBlockedAddress = errors.Register(ModuleName, 11, "address is blocked by default")
+	ErrEscrowNotFound        = errors.Register(ModuleName, 12, "escrow not found")
+	ErrEscrowExpired         = errors.Register(ModuleName, 13, "escrow has expired")
+	ErrEscrowNotExpired      = errors.Register(ModuleName, 14, "escrow has not expired yet")
 )
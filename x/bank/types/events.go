@@ -21,4 +21,18 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// blocked addresses management events name and attributes
+	EventTypeBlockAddress   = "block_address"
+	EventTypeUnblockAddress = "unblock_address"
+
+	AttributeKeyAddress = "address"
+
+	// escrowed transfers events name and attributes
+	EventTypeEscrowSend   = "escrow_send"
+	EventTypeEscrowClaim  = "escrow_claim"
+	EventTypeEscrowRefund = "escrow_refund"
+
+	AttributeKeyEscrowID   = "escrow_id"
+	AttributeKeyExpiration = "expiration"
 )
@@ -62,6 +62,31 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenBlockedAddresses := make(map[string]bool, len(gs.BlockedAddresses))
+	for _, addr := range gs.BlockedAddresses {
+		if addr == "" {
+			return errors.New("blocked address cannot be empty")
+		}
+		if seenBlockedAddresses[addr] {
+			return fmt.Errorf("duplicate blocked address %s", addr)
+		}
+		seenBlockedAddresses[addr] = true
+	}
+
+	seenEscrows := make(map[uint64]bool, len(gs.Escrows))
+	for _, escrow := range gs.Escrows {
+		if seenEscrows[escrow.Id] {
+			return fmt.Errorf("duplicate escrow %d", escrow.Id)
+		}
+		if escrow.Sender == "" || escrow.Recipient == "" {
+			return fmt.Errorf("escrow %d must have a sender and a recipient", escrow.Id)
+		}
+		if !escrow.Amount.IsValid() || !escrow.Amount.IsAllPositive() {
+			return fmt.Errorf("invalid amount for escrow %d: %s", escrow.Id, escrow.Amount)
+		}
+		seenEscrows[escrow.Id] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -40,6 +40,11 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// blocked_addresses defines the addresses blocked from receiving funds by the authority.
+	// The addresses blocked by default, such as the module accounts, are not included.
+	BlockedAddresses []string `protobuf:"bytes,6,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// escrows defines the pending escrows. Their funds are part of the balance of the escrow address.
+	Escrows []Escrow `protobuf:"bytes,7,rep,name=escrows,proto3" json:"escrows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +115,20 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -160,37 +179,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x40, 0x98, 0x0e, 0x6e, 0x29, 0x4e, 0xe8, 0x14, 0x55,
-	0xca, 0x99, 0xa6, 0x48, 0x48, 0x0c, 0x48, 0xb8, 0x02, 0x26, 0x04, 0x6a, 0x36, 0x96, 0xe8, 0xec,
-	0x3b, 0x19, 0x2b, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a, 0x22, 0xa6,
-	0x0e, 0xfd, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xc8, 0xf7,
-	0xae, 0x49, 0x24, 0x3c, 0xb3, 0xd8, 0x96, 0xff, 0xff, 0xf7, 0xfb, 0xbf, 0xf7, 0xee, 0xc8, 0xdd,
-	0x44, 0x41, 0xae, 0x20, 0x8c, 0x99, 0x1c, 0x86, 0x6f, 0xf6, 0x62, 0xa1, 0xd9, 0x5e, 0x98, 0x0a,
-	0x29, 0x20, 0x03, 0x5a, 0x8c, 0x95, 0x56, 0xde, 0x2d, 0xb4, 0xd0, 0xd2, 0x42, 0xad, 0x65, 0x6b,
-	0x23, 0x55, 0xa9, 0x32, 0x7a, 0x58, 0x7e, 0xa1, 0x75, 0x2b, 0x98, 0xd3, 0x40, 0xcc, 0x69, 0x89,
-	0xca, 0xe4, 0x3f, 0xfa, 0x52, 0x9a, 0xe1, 0xa2, 0xbe, 0x89, 0xfa, 0x00, 0xc1, 0x36, 0x17, 0xa5,
-	0x9b, 0x2c, 0xcf, 0xa4, 0x0a, 0xcd, 0x13, 0x7f, 0xed, 0x7c, 0xad, 0x91, 0xe6, 0x33, 0x6c, 0xb5,
-	0xaf, 0x99, 0x16, 0xde, 0x23, 0x52, 0x2f, 0xd8, 0x98, 0xe5, 0xe0, 0xbb, 0x6d, 0xb7, 0xd3, 0xe8,
-	0xdd, 0xa6, 0x15, 0xad, 0xd3, 0x97, 0xc6, 0x12, 0xad, 0x9f, 0xfd, 0x6c, 0x39, 0x9f, 0x2e, 0x4e,
-	0x76, 0xdd, 0x43, 0x5b, 0xe5, 0x1d, 0x90, 0xb5, 0x98, 0x8d, 0x98, 0x4c, 0x04, 0xf8, 0x57, 0xda,
-	0xb5, 0x4e, 0xa3, 0xb7, 0x5d, 0x49, 0x88, 0xd0, 0xb4, 0x8c, 0x98, 0x17, 0x7a, 0x13, 0x52, 0x87,
-	0xa3, 0xa2, 0x18, 0x4d, 0xfc, 0x9a, 0x41, 0x6c, 0x2e, 0x10, 0x20, 0xe6, 0x88, 0x03, 0x95, 0xc9,
-	0xe8, 0x69, 0x59, 0xff, 0xf9, 0x57, 0xab, 0x93, 0x66, 0xfa, 0xf5, 0x51, 0x4c, 0x13, 0x95, 0xdb,
-	0xa1, 0xed, 0xab, 0x0b, 0x7c, 0x18, 0xea, 0x49, 0x21, 0xc0, 0x14, 0xc0, 0xc7, 0x8b, 0x93, 0xdd,
-	0xe6, 0x48, 0xa4, 0x2c, 0x99, 0x0c, 0xca, 0xb5, 0x82, 0xed, 0x1f, 0x03, 0xbd, 0x17, 0xe4, 0x3a,
-	0x17, 0x52, 0xe5, 0x83, 0x5c, 0x68, 0xc6, 0x99, 0x66, 0xfe, 0x55, 0xd3, 0xc2, 0x9d, 0xca, 0x29,
-	0x9e, 0x5b, 0xd3, 0xf2, 0x18, 0xd7, 0x4c, 0xfd, 0xa5, 0xe2, 0x31, 0xd2, 0x04, 0x21, 0xf9, 0x40,
-	0x48, 0x16, 0x8f, 0x04, 0xf7, 0x57, 0x0c, 0xae, 0x5d, 0x89, 0xeb, 0x0b, 0xc9, 0x9f, 0xa0, 0x2f,
-	0xda, 0x2e, 0x89, 0x3f, 0x4e, 0xbb, 0x37, 0x16, 0x63, 0xb4, 0xef, 0xd1, 0xfb, 0x0f, 0x30, 0xa4,
-	0x01, 0x0b, 0xeb, 0xce, 0x17, 0x97, 0xac, 0xda, 0x7d, 0x7a, 0x3d, 0xb2, 0xca, 0x38, 0x1f, 0x0b,
-	0xc0, 0x03, 0x5c, 0x8f, 0xfc, 0x6f, 0xa7, 0xdd, 0x0d, 0x1b, 0xf6, 0x18, 0x95, 0xbe, 0x1e, 0x67,
-	0x32, 0x3d, 0xbc, 0x34, 0x7a, 0x6f, 0xc9, 0x8a, 0xd9, 0x84, 0x3d, 0xb0, 0xff, 0xb0, 0x6d, 0xcc,
-	0x7b, 0xb8, 0xf6, 0xfe, 0xb8, 0xe5, 0xfc, 0x39, 0x6e, 0x39, 0xd1, 0xfe, 0xd9, 0x34, 0x70, 0xcf,
-	0xa7, 0x81, 0xfb, 0x7b, 0x1a, 0xb8, 0x1f, 0x66, 0x81, 0x73, 0x3e, 0x0b, 0x9c, 0xef, 0xb3, 0xc0,
-	0x79, 0x65, 0xef, 0x33, 0xf0, 0x21, 0xcd, 0x54, 0xf8, 0x0e, 0xef, 0xbd, 0x49, 0x88, 0xeb, 0xe6,
-	0x0e, 0xef, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe3, 0x0e, 0x29, 0x81, 0x03, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xed, 0xa6, 0x4d, 0x9a, 0x4b, 0x0a, 0xd4, 0x74, 0x70, 0x43, 0x71, 0x4c, 0xa7, 0x50,
+	0x29, 0xe7, 0x34, 0x45, 0x42, 0x62, 0x40, 0xaa, 0xab, 0xc2, 0x84, 0x80, 0x44, 0x62, 0x60, 0x89,
+	0xce, 0xbe, 0x93, 0xb1, 0x12, 0xdf, 0x45, 0x79, 0xdd, 0x96, 0x7c, 0x03, 0x46, 0x66, 0xa6, 0x8e,
+	0x88, 0xa9, 0x43, 0x3e, 0x00, 0x63, 0xc7, 0x8a, 0x09, 0x75, 0x00, 0x94, 0x0c, 0x65, 0xe1, 0x3b,
+	0xa0, 0xdc, 0x5d, 0xfe, 0x20, 0xd2, 0xb5, 0x4b, 0x12, 0xe5, 0x7d, 0xde, 0xdf, 0xf3, 0xbc, 0x77,
+	0xef, 0xa1, 0x07, 0xa1, 0x80, 0x44, 0x80, 0x17, 0x10, 0xde, 0xf6, 0x8e, 0x77, 0x03, 0x96, 0x92,
+	0x5d, 0x2f, 0x62, 0x9c, 0x41, 0x0c, 0xb8, 0xdb, 0x13, 0xa9, 0xb0, 0xee, 0x2a, 0x09, 0x1e, 0x4b,
+	0xb0, 0x96, 0x94, 0x36, 0x22, 0x11, 0x09, 0x59, 0xf7, 0xc6, 0xbf, 0x94, 0xb4, 0xe4, 0x4c, 0x69,
+	0xc0, 0xa6, 0xb4, 0x50, 0xc4, 0xfc, 0xbf, 0xfa, 0x9c, 0x9b, 0xe4, 0xaa, 0xfa, 0xa6, 0xaa, 0xb7,
+	0x14, 0x58, 0xfb, 0xaa, 0xd2, 0x3a, 0x49, 0x62, 0x2e, 0x3c, 0xf9, 0xa9, 0xfe, 0xda, 0xfe, 0xb3,
+	0x8c, 0x8a, 0xcf, 0x55, 0xd4, 0x66, 0x4a, 0x52, 0x66, 0x3d, 0x45, 0xd9, 0x2e, 0xe9, 0x91, 0x04,
+	0x6c, 0xd3, 0x35, 0x2b, 0x85, 0xfa, 0x3d, 0xbc, 0x20, 0x3a, 0x7e, 0x25, 0x25, 0x7e, 0xfe, 0xfc,
+	0x47, 0xd9, 0xf8, 0x7c, 0x75, 0xb6, 0x63, 0x36, 0x74, 0x97, 0x75, 0x80, 0x56, 0x03, 0xd2, 0x21,
+	0x3c, 0x64, 0x60, 0x2f, 0xb9, 0x99, 0x4a, 0xa1, 0xbe, 0xb5, 0x90, 0xe0, 0x2b, 0xd1, 0x3c, 0x62,
+	0xda, 0x68, 0xf5, 0x51, 0x16, 0x8e, 0xba, 0xdd, 0x4e, 0xdf, 0xce, 0x48, 0xc4, 0xe6, 0x0c, 0x01,
+	0x6c, 0x8a, 0x38, 0x10, 0x31, 0xf7, 0x9f, 0x8d, 0xfb, 0xbf, 0xfc, 0x2c, 0x57, 0xa2, 0x38, 0x7d,
+	0x77, 0x14, 0xe0, 0x50, 0x24, 0x7a, 0x68, 0xfd, 0x55, 0x05, 0xda, 0xf6, 0xd2, 0x7e, 0x97, 0x81,
+	0x6c, 0x80, 0x4f, 0x57, 0x67, 0x3b, 0xc5, 0x0e, 0x8b, 0x48, 0xd8, 0x6f, 0x8d, 0x8f, 0x15, 0x74,
+	0x7e, 0x65, 0x68, 0xbd, 0x44, 0xb7, 0x28, 0xe3, 0x22, 0x69, 0x25, 0x2c, 0x25, 0x94, 0xa4, 0xc4,
+	0x5e, 0x96, 0x11, 0xee, 0x2f, 0x9c, 0xe2, 0x85, 0x16, 0xcd, 0x8f, 0xb1, 0x26, 0xfb, 0x27, 0x15,
+	0x8b, 0xa0, 0x22, 0x30, 0x4e, 0x5b, 0x8c, 0x93, 0xa0, 0xc3, 0xa8, 0xbd, 0x22, 0x71, 0xee, 0x42,
+	0x5c, 0x93, 0x71, 0x7a, 0xa8, 0x74, 0xfe, 0xd6, 0x98, 0x78, 0x39, 0xa8, 0xde, 0x9e, 0x8d, 0xe1,
+	0xd6, 0xf0, 0xa3, 0xc7, 0xca, 0xa4, 0x00, 0x33, 0xa9, 0xf5, 0x06, 0xad, 0x07, 0x1d, 0x11, 0xb6,
+	0x19, 0x6d, 0x11, 0x4a, 0x7b, 0x0c, 0x80, 0x81, 0x9d, 0x75, 0x33, 0x95, 0xbc, 0xff, 0xf0, 0xdb,
+	0xa0, 0xba, 0xa1, 0xad, 0xf6, 0x55, 0xad, 0x99, 0xf6, 0x62, 0x1e, 0x5d, 0x0e, 0xaa, 0x6b, 0xef,
+	0xe5, 0xde, 0xb8, 0xc7, 0x35, 0x5c, 0xc7, 0xb5, 0xc6, 0x1d, 0xcd, 0xd8, 0x9f, 0x20, 0xac, 0xd7,
+	0x28, 0xc7, 0x20, 0xec, 0x89, 0x13, 0xb0, 0x73, 0x6e, 0xe6, 0xda, 0x65, 0x38, 0x94, 0x1a, 0xbf,
+	0xa4, 0x03, 0xff, 0x8b, 0x55, 0x71, 0x27, 0x9c, 0xed, 0xaf, 0x26, 0xca, 0xe9, 0xab, 0xb7, 0xea,
+	0x28, 0xa7, 0xe3, 0xca, 0x5d, 0xcb, 0xfb, 0xf6, 0x75, 0x61, 0x1b, 0x13, 0xa1, 0x75, 0x82, 0x56,
+	0xe4, 0xa5, 0xd9, 0x4b, 0x37, 0xb5, 0x18, 0xca, 0xef, 0xc9, 0xea, 0x87, 0xd3, 0xb2, 0xf1, 0xfb,
+	0xb4, 0x6c, 0xf8, 0x7b, 0xe7, 0x43, 0xc7, 0xbc, 0x18, 0x3a, 0xe6, 0xaf, 0xa1, 0x63, 0x7e, 0x1c,
+	0x39, 0xc6, 0xc5, 0xc8, 0x31, 0xbe, 0x8f, 0x1c, 0xe3, 0xad, 0x7e, 0x7a, 0x40, 0xdb, 0x38, 0x16,
+	0x9e, 0x3a, 0x06, 0xe5, 0x10, 0x64, 0xe5, 0x73, 0xdb, 0xfb, 0x3b, 0x00, 0xd7, 0xe8, 0x96, 0x66,
+	0x2c, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +237,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -369,6 +416,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +654,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -39,8 +40,22 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// BlockedAddressesPrefix is the prefix for the addresses blocked from receiving funds by the authority.
+	BlockedAddressesPrefix = collections.NewPrefix(6)
+
+	// EscrowsPrefix is the prefix for the pending escrowed transfers.
+	EscrowsPrefix = collections.NewPrefix(7)
+	// EscrowSequenceKey is the key of the sequence used to assign escrow ids.
+	EscrowSequenceKey = collections.NewPrefix(8)
+	// EscrowsBySenderPrefix and EscrowsByRecipientPrefix are the prefixes for the escrow indexes.
+	EscrowsBySenderPrefix    = collections.NewPrefix(9)
+	EscrowsByRecipientPrefix = collections.NewPrefix(10)
 )
 
+// EscrowAddress is the address holding the funds of the pending escrowed transfers.
+var EscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte("escrow")))
+
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
 // Historically, balances were represented as Coin, now they're represented as a simple math.Int
 var BalanceValueCodec = collcodec.NewAltValueCodec(sdk.IntValue, func(bytes []byte) (math.Int, error) {
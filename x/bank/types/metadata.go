@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -77,6 +79,37 @@ func (m Metadata) Validate() error {
 	return nil
 }
 
+// DisplayExponent returns the exponent of the display denomination unit.
+// It returns false if the display denomination isn't part of the denomination units.
+func (m Metadata) DisplayExponent() (uint32, bool) {
+	for _, denomUnit := range m.DenomUnits {
+		if denomUnit.Denom == m.Display {
+			return denomUnit.Exponent, true
+		}
+	}
+
+	return 0, false
+}
+
+// ToDisplayCoin converts a coin of the base denomination into the display denomination,
+// dividing its amount by 10^exponent of the display denomination unit.
+func (m Metadata) ToDisplayCoin(coin sdk.Coin) (sdk.DecCoin, error) {
+	if coin.Denom != m.Base {
+		return sdk.DecCoin{}, fmt.Errorf("coin denom %s doesn't match metadata base denom %s", coin.Denom, m.Base)
+	}
+
+	exponent, ok := m.DisplayExponent()
+	if !ok {
+		return sdk.DecCoin{}, fmt.Errorf("metadata must contain a denomination unit with display denom '%s'", m.Display)
+	}
+
+	if exponent > math.LegacyPrecision {
+		return sdk.DecCoin{}, fmt.Errorf("display denom %s exponent %d exceeds the maximum decimal precision %d", m.Display, exponent, math.LegacyPrecision)
+	}
+
+	return sdk.NewDecCoinFromDec(m.Display, math.LegacyNewDecFromIntWithPrec(coin.Amount, int64(exponent))), nil
+}
+
 // Validate performs a basic validation of the denomination unit fields
 func (du DenomUnit) Validate() error {
 	if err := sdk.ValidateDenom(du.Denom); err != nil {
@@ -5,9 +5,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestMetadataValidate(t *testing.T) {
@@ -274,3 +276,40 @@ func TestMarshalJSONMetaData(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataToDisplayCoin(t *testing.T) {
+	metadata := types.Metadata{
+		DenomUnits: []*types.DenomUnit{
+			{"uatom", uint32(0), nil},
+			{"matom", uint32(3), nil},
+			{"atom", uint32(6), nil},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+
+	exponent, ok := metadata.DisplayExponent()
+	require.True(t, ok)
+	require.Equal(t, uint32(6), exponent)
+
+	coin, err := metadata.ToDisplayCoin(sdk.NewInt64Coin("uatom", 1_234_567))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("atom", math.LegacyMustNewDecFromStr("1.234567")), coin)
+
+	coin, err = metadata.ToDisplayCoin(sdk.NewInt64Coin("uatom", 0))
+	require.NoError(t, err)
+	require.True(t, coin.IsZero())
+
+	_, err = metadata.ToDisplayCoin(sdk.NewInt64Coin("stake", 1))
+	require.ErrorContains(t, err, "doesn't match metadata base denom")
+
+	metadata.Display = "katom"
+	_, ok = metadata.DisplayExponent()
+	require.False(t, ok)
+	_, err = metadata.ToDisplayCoin(sdk.NewInt64Coin("uatom", 1))
+	require.ErrorContains(t, err, "must contain a denomination unit with display denom")
+
+	metadata.DenomUnits = append(metadata.DenomUnits, &types.DenomUnit{Denom: "katom", Exponent: 19})
+	_, err = metadata.ToDisplayCoin(sdk.NewInt64Coin("uatom", 1))
+	require.ErrorContains(t, err, "exceeds the maximum decimal precision")
+}
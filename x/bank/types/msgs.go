@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	coretransaction "cosmossdk.io/core/transaction"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -30,3 +32,35 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgBlockAddresses constructs a message to block addresses from receiving funds.
+func NewMsgBlockAddresses(authority string, addresses []string) *MsgBlockAddresses {
+	return &MsgBlockAddresses{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+// NewMsgUnblockAddresses constructs a message to unblock addresses blocked with MsgBlockAddresses.
+func NewMsgUnblockAddresses(authority string, addresses []string) *MsgUnblockAddresses {
+	return &MsgUnblockAddresses{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+// NewMsgSendWithTimeout constructs a msg to send coins in escrow, to be claimed by the
+// recipient before the timeout or refunded to the sender after it.
+func NewMsgSendWithTimeout(fromAddr, toAddr string, amount sdk.Coins, timeout time.Duration) *MsgSendWithTimeout {
+	return &MsgSendWithTimeout{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount, Timeout: timeout}
+}
+
+// NewMsgClaimEscrow constructs a msg to claim the coins of an escrow.
+func NewMsgClaimEscrow(recipient string, escrowID uint64) *MsgClaimEscrow {
+	return &MsgClaimEscrow{Recipient: recipient, EscrowId: escrowID}
+}
+
+// NewMsgRefundEscrow constructs a msg to refund the coins of an expired escrow.
+func NewMsgRefundEscrow(sender string, escrowID uint64) *MsgRefundEscrow {
+	return &MsgRefundEscrow{Sender: sender, EscrowId: escrowID}
+}
@@ -69,6 +69,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegationRewardsEstimate",
+					Use:       "rewards-estimate <delegator-addr> <validator-addr> <blocks>",
+					Short:     "Project the rewards of a delegation over a number of future blocks",
+					Example:   fmt.Sprintf("$ %s query distribution rewards-estimate [delegator-address] [validator-address] 100", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+						{ProtoField: "validator_address"},
+						{ProtoField: "blocks"},
+					},
+				},
 				{
 					RpcMethod: "DelegationTotalRewards",
 					Use:       "rewards <delegator-addr>",
@@ -25,13 +25,19 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	if feesCollectedInt.Empty() {
 		return nil
 	}
-	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
 
 	// transfer collected fees to the distribution module account
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, feesCollectedInt); err != nil {
 		return err
 	}
 
+	// burn the fraction of the collected fees set by the fee burn rate
+	burnt, err := k.burnFees(ctx, feesCollectedInt)
+	if err != nil {
+		return err
+	}
+	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt.Sub(burnt...)...)
+
 	feePool, err := k.FeePool.Get(ctx)
 	if err != nil {
 		return err
@@ -90,6 +96,40 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	return nil
 }
 
+// burnFees burns the fraction of the collected fees set by the fee burn rate from
+// the distribution module account, and returns the burnt coins. The burnt amount
+// of each denom is truncated, so that only whole coins are burnt.
+func (k Keeper) burnFees(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	burnRate, err := k.GetFeeBurnRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if burnRate.IsNil() || burnRate.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	burnt := sdk.NewCoins()
+	for _, fee := range fees {
+		burnt = burnt.Add(sdk.NewCoin(fee.Denom, fee.Amount.ToLegacyDec().MulTruncate(burnRate).TruncateInt()))
+	}
+	if burnt.IsZero() {
+		return burnt, nil
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), burnt); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBurnFees,
+		event.NewAttribute(sdk.AttributeKeyAmount, burnt.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return burnt, nil
+}
+
 // AllocateTokensToValidator allocate tokens to a particular validator,
 // splitting according to commission.
 func (k Keeper) AllocateTokensToValidator(ctx context.Context, val sdk.ValidatorI, tokens sdk.DecCoins) error {
@@ -509,3 +509,91 @@ func TestAllocateTokensWithZeroTokens(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expected, currentRewards.Rewards)
 }
+
+func TestAllocateTokensWithFeeBurn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	cdcOpts := codectestutil.CodecOptions{}
+	encCfg := moduletestutil.MakeTestEncodingConfig(cdcOpts, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).Times(2)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+	accountKeeper.EXPECT().AddressCodec().Return(cdcOpts.GetAddressCodec())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+
+	authorityAddr, err := cdcOpts.GetAddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		testCometService,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	// burn 10% of the collected fees
+	params := disttypes.DefaultParams()
+	params.FeeBurnRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create validator with 0% commission
+	valAddr0 := sdk.ValAddress(valConsAddr0)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val0, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+	val0.Commission = stakingtypes.NewCommission(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0, nil).AnyTimes()
+
+	// the burnt amount of each denom is truncated: 10stake and 1foo out of 1.5foo
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foo", 15))
+	burnt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("foo", 1))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), distrAcc.GetAddress().Bytes(), burnt)
+	// 2% of the remaining fees go to the community pool: 1.8stake and 0.28foo, of which 1stake is sent
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, disttypes.ProtocolPoolDistrAccount, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	votes := []comet.VoteInfo{{Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100}}}
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 100, votes))
+
+	// 98% of the remaining fees are the rewards of the validator
+	val0OutstandingRewards, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(882, 1)),
+		sdk.NewDecCoinFromDec("foo", math.LegacyNewDecWithPrec(1372, 2)),
+	), val0OutstandingRewards.Rewards)
+
+	feePool, err := distrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(8, 1)),
+		sdk.NewDecCoinFromDec("foo", math.LegacyNewDecWithPrec(28, 2)),
+	), feePool.DecimalPool)
+
+	var burnEvents []sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == disttypes.EventTypeBurnFees {
+			burnEvents = append(burnEvents, e)
+		}
+	}
+	require.Len(t, burnEvents, 1)
+	amount, ok := burnEvents[0].GetAttribute(sdk.AttributeKeyAmount)
+	require.True(t, ok)
+	require.Equal(t, burnt.String(), amount.Value)
+}
@@ -325,3 +325,45 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 
 	return finalRewards, nil
 }
+
+// EstimateDelegationRewards projects the rewards accrued by a delegation over the given
+// number of future blocks. The reward rate per block and per staked token is derived from
+// the rewards accrued since the delegation starting height, that is since the delegation
+// was last modified or its rewards withdrawn, hence it reflects the inflation, fees,
+// community tax and validator commission observed over that window. The rewards are
+// projected on the current delegation stake, assuming the observed rate holds.
+func (k Keeper) EstimateDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI, endingPeriod, blocks uint64) (
+	rewards, estimated, rate sdk.DecCoins, observedBlocks uint64, err error,
+) {
+	rewards, err = k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+
+	delAddr, err := k.addrCdc.StringToBytes(del.GetDelegatorAddr())
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+
+	startingInfo, err := k.DelegatorStartingInfo.Get(ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr)))
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+
+	// no rate can be observed when the delegation started at this height or had no stake.
+	headerinfo := k.HeaderService.HeaderInfo(ctx)
+	if uint64(headerinfo.Height) <= startingInfo.Height || !startingInfo.Stake.IsPositive() {
+		return rewards, rewards, sdk.DecCoins{}, 0, nil
+	}
+	observedBlocks = uint64(headerinfo.Height) - startingInfo.Height
+
+	rate = rewards.QuoDecTruncate(startingInfo.Stake.MulInt(math.NewIntFromUint64(observedBlocks)))
+	stake := val.TokensFromSharesTruncated(del.GetShares())
+	estimated = rewards.Add(rate.MulDecTruncate(stake.MulInt(math.NewIntFromUint64(blocks)))...)
+
+	return rewards, estimated, rate, observedBlocks, nil
+}
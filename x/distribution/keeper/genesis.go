@@ -126,7 +126,8 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 	if !balances.Equal(moduleHoldingsInt) {
 		return fmt.Errorf("distribution module balance does not match the module holdings: %s <-> %s", balances, moduleHoldingsInt)
 	}
-	return nil
+
+	return k.validateFeeBurnPermission(ctx, data.Params.FeeBurnRate)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -251,6 +251,65 @@ func (k Querier) DelegationRewards(ctx context.Context, req *types.QueryDelegati
 	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
 }
 
+// DelegationRewardsEstimate projects the rewards accrued by a delegation over future blocks
+func (k Querier) DelegationRewardsEstimate(ctx context.Context, req *types.QueryDelegationRewardsEstimateRequest) (*types.QueryDelegationRewardsEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAdr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == nil {
+		return nil, errors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	delAdr, err := k.addrCdc.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	del, err := k.stakingKeeper.Delegation(ctx, delAdr, valAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	if del == nil {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	endingPeriod, err := k.IncrementValidatorPeriod(ctx, val)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, estimated, rate, observedBlocks, err := k.EstimateDelegationRewards(ctx, val, del, endingPeriod, req.Blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationRewardsEstimateResponse{
+		Rewards:          rewards,
+		EstimatedRewards: estimated,
+		RewardRate:       rate,
+		ObservedBlocks:   observedBlocks,
+	}, nil
+}
+
 // DelegationTotalRewards the total rewards accrued by a each validator
 func (k Querier) DelegationTotalRewards(ctx context.Context, req *types.QueryDelegationTotalRewardsRequest) (*types.QueryDelegationTotalRewardsResponse, error) {
 	if req == nil {
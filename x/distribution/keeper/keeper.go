@@ -153,7 +153,7 @@ func (k Keeper) GetAuthority() string {
 
 // SetWithdrawAddr sets a new address that will receive the rewards upon withdrawal
 func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr sdk.AccAddress) error {
-	if k.bankKeeper.BlockedAddr(withdrawAddr) {
+	if k.bankKeeper.BlockedAddr(ctx, withdrawAddr) {
 		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
 	}
 
@@ -53,8 +53,8 @@ func initFixture(t *testing.T) (sdk.Context, []sdk.AccAddress, keeper.Keeper, de
 	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 
 	withdrawAddr := addrs[1]
-	bankKeeper.EXPECT().BlockedAddr(withdrawAddr).Return(false).AnyTimes()
-	bankKeeper.EXPECT().BlockedAddr(distrAcc.GetAddress()).Return(true).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any(), withdrawAddr).Return(false).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any(), distrAcc.GetAddress()).Return(true).AnyTimes()
 
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
 
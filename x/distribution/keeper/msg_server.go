@@ -108,6 +108,10 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	if err := k.validateFeeBurnPermission(ctx, msg.Params.FeeBurnRate); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	if err := k.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
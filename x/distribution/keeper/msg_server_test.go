@@ -275,6 +275,31 @@ func TestMsgUpdateParams(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateParamsFeeBurnRate(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	authorityAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FeeBurnRate = math.LegacyNewDecWithPrec(1, 1)
+	msg := &types.MsgUpdateParams{Authority: authorityAddr, Params: params}
+
+	// the fees can't be burnt without the burner permission
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(distrAcc)
+	_, err = msgServer.UpdateParams(ctx, msg)
+	require.ErrorContains(t, err, "must have the burner permission")
+
+	dep.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Burner))
+	_, err = msgServer.UpdateParams(ctx, msg)
+	require.NoError(t, err)
+
+	feeBurnRate, err := distrKeeper.GetFeeBurnRate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.FeeBurnRate, feeBurnRate)
+}
+
 func TestMsgCommunityPoolSpend(t *testing.T) {
 	ctx, addrs, distrKeeper, dep := initFixture(t)
 	msgServer := keeper.NewMsgServerImpl(distrKeeper)
@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // GetCommunityTax returns the current distribution community tax.
@@ -16,6 +20,16 @@ func (k Keeper) GetCommunityTax(ctx context.Context) (math.LegacyDec, error) {
 	return params.CommunityTax, nil
 }
 
+// GetFeeBurnRate returns the current distribution fee burn rate.
+func (k Keeper) GetFeeBurnRate(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return params.FeeBurnRate, nil
+}
+
 // GetWithdrawAddrEnabled returns the current distribution withdraw address
 // enabled parameter.
 func (k Keeper) GetWithdrawAddrEnabled(ctx context.Context) (enabled bool, err error) {
@@ -26,3 +40,19 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx context.Context) (enabled bool, err e
 
 	return params.WithdrawAddrEnabled, nil
 }
+
+// validateFeeBurnPermission checks that the distribution module account is allowed
+// to burn the collected fees when the fee burn rate is positive, as the fees could
+// not be allocated otherwise.
+func (k Keeper) validateFeeBurnPermission(ctx context.Context, feeBurnRate math.LegacyDec) error {
+	if feeBurnRate.IsNil() || feeBurnRate.IsZero() {
+		return nil
+	}
+
+	moduleAcc := k.GetDistributionAccount(ctx)
+	if moduleAcc == nil || !moduleAcc.HasPermission(authtypes.Burner) {
+		return fmt.Errorf("%s module account must have the %s permission to burn fees", types.ModuleName, authtypes.Burner)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package v5
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+)
+
+// MigrateStore sets the fee burn rate parameter, introduced in the consensus version
+// 5, to zero, so that the collected fees keep being fully distributed.
+func MigrateStore(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if p.FeeBurnRate.IsNil() {
+		p.FeeBurnRate = math.LegacyZeroDec()
+	}
+
+	return params.Set(ctx, p)
+}
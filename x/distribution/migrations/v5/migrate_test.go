@@ -0,0 +1,50 @@
+package v5_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution"
+	v5 "cosmossdk.io/x/distribution/migrations/v5"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{}).Codec
+	distrKey := storetypes.NewKVStoreKey("distribution")
+	ctx := testutil.DefaultContext(distrKey, storetypes.NewTransientStoreKey("transient_test"))
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(distrKey))
+	paramsCollection := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// set defaults without the newly added fee burn rate, which is the last field of
+	// the encoded params
+	previousParams := types.DefaultParams()
+	previousParams.CommunityTax = math.LegacyNewDecWithPrec(5, 2)
+	bz := cdc.MustMarshal(&previousParams)
+	feeBurnRateField := []byte{0x2a, 0x01, '0'}
+	require.True(t, bytes.HasSuffix(bz, feeBurnRateField))
+	ctx.KVStore(distrKey).Set(types.ParamsKey, bytes.TrimSuffix(bz, feeBurnRateField))
+
+	oldParams, err := paramsCollection.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, oldParams.FeeBurnRate.IsNil())
+
+	require.NoError(t, v5.MigrateStore(ctx, paramsCollection))
+
+	newParams, err := paramsCollection.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, newParams.FeeBurnRate.IsZero())
+	require.Equal(t, previousParams.CommunityTax, newParams.CommunityTax)
+	require.NoError(t, newParams.ValidateBasic())
+}
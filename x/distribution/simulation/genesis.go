@@ -13,6 +13,7 @@ import (
 const (
 	CommunityTax    = "community_tax"
 	WithdrawEnabled = "withdraw_enabled"
+	FeeBurnRate     = "fee_burn_rate"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -25,6 +26,15 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenFeeBurnRate returns a randomized FeeBurnRate parameter.
+func GenFeeBurnRate(r *rand.Rand) math.LegacyDec {
+	if r.Intn(2) == 0 {
+		return math.LegacyZeroDec() // 50% chance of no fees being burnt
+	}
+
+	return math.LegacyNewDecWithPrec(int64(r.Intn(21)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax math.LegacyDec
@@ -33,11 +43,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var withdrawEnabled bool
 	simState.AppParams.GetOrGenerate(WithdrawEnabled, &withdrawEnabled, simState.Rand, func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) })
 
+	var feeBurnRate math.LegacyDec
+	simState.AppParams.GetOrGenerate(FeeBurnRate, &feeBurnRate, simState.Rand, func(r *rand.Rand) { feeBurnRate = GenFeeBurnRate(r) })
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
 			CommunityTax:        communityTax,
 			WithdrawAddrEnabled: withdrawEnabled,
+			FeeBurnRate:         feeBurnRate,
 		},
 	}
 
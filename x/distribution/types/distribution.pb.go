@@ -38,6 +38,9 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// fee_burn_rate is the fraction of the fees collected in a block which is burnt
+	// before the remaining fees are distributed.
+	FeeBurnRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=fee_burn_rate,json=feeBurnRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -657,73 +660,75 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xa4, 0x8e, 0xd3, 0x4c, 0x7e, 0xb5, 0x9b, 0x1f, 0x75, 0xdc, 0x7e, 0x6d, 0x77, 0xf5,
+	0xad, 0x08, 0x81, 0xac, 0x93, 0x54, 0x42, 0x28, 0x17, 0xd4, 0x24, 0xad, 0x40, 0x2a, 0x34, 0xda,
+	0x20, 0x90, 0xe0, 0xb0, 0x1a, 0xef, 0x4e, 0xec, 0x21, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9c,
+	0xe0, 0x58, 0x38, 0x50, 0x6e, 0x20, 0x4e, 0x15, 0x5c, 0x2a, 0x4e, 0x39, 0xe4, 0x8f, 0xa8, 0x38,
+	0x55, 0x15, 0x20, 0xd4, 0x43, 0x80, 0xe4, 0x10, 0xc4, 0x5f, 0x81, 0x66, 0x67, 0xbc, 0xb6, 0x43,
+	0x40, 0x69, 0xaa, 0x88, 0x8b, 0xe5, 0x79, 0x6f, 0xe7, 0x7d, 0x3e, 0x9f, 0x37, 0xef, 0xbd, 0x19,
+	0xe8, 0xf8, 0x4c, 0x44, 0x4c, 0xd4, 0x02, 0x22, 0x24, 0x27, 0xf5, 0x44, 0x12, 0x46, 0x6b, 0xad,
+	0x85, 0x3a, 0x96, 0x68, 0xa1, 0xc7, 0xe8, 0xc4, 0x9c, 0x49, 0x66, 0x5d, 0xd5, 0xdf, 0x3b, 0x3d,
+	0x2e, 0xf3, 0x7d, 0x69, 0xa2, 0xc1, 0x1a, 0x2c, 0xfd, 0xae, 0xa6, 0xfe, 0xe9, 0x2d, 0xa5, 0xb2,
+	0x81, 0xa8, 0x23, 0x81, 0xb3, 0xd0, 0x3e, 0x23, 0x26, 0x64, 0x69, 0x5a, 0xfb, 0x3d, 0xbd, 0xd1,
+	0xc4, 0xd7, 0xae, 0xcb, 0x28, 0x22, 0x94, 0xd5, 0xd2, 0x5f, 0x6d, 0xb2, 0x1f, 0xe4, 0x61, 0x61,
+	0x0d, 0x71, 0x14, 0x09, 0xeb, 0x43, 0x38, 0xe2, 0xb3, 0x28, 0x4a, 0x28, 0x91, 0x3b, 0x9e, 0x44,
+	0xdb, 0x45, 0x50, 0x05, 0x33, 0x83, 0xcb, 0xaf, 0x3d, 0xde, 0xaf, 0xe4, 0x9e, 0xed, 0x57, 0x0c,
+	0x55, 0x11, 0x6c, 0x3a, 0x84, 0xd5, 0x22, 0x24, 0x9b, 0xce, 0x5d, 0xdc, 0x40, 0xfe, 0xce, 0x2a,
+	0xf6, 0x9f, 0xee, 0xcd, 0x41, 0x83, 0xb4, 0x8a, 0xfd, 0x47, 0x47, 0xbb, 0xb3, 0xc0, 0x1d, 0xce,
+	0x82, 0xbd, 0x8b, 0xb6, 0xad, 0x8f, 0xe0, 0x84, 0x22, 0xac, 0x58, 0xc5, 0x4c, 0x60, 0xee, 0x71,
+	0xbc, 0x85, 0x78, 0x50, 0xec, 0x4b, 0x31, 0x5e, 0x3f, 0x1b, 0x46, 0x11, 0xb8, 0x96, 0x8a, 0xba,
+	0x66, 0x82, 0xba, 0x69, 0x4c, 0x2b, 0x84, 0x93, 0x75, 0x46, 0x13, 0xf1, 0x37, 0xb0, 0x0b, 0x2f,
+	0x08, 0x36, 0x9e, 0x86, 0x3d, 0x86, 0xb6, 0x08, 0x27, 0xb7, 0x88, 0x6c, 0x06, 0x1c, 0x6d, 0x79,
+	0x28, 0x08, 0xb8, 0x87, 0x29, 0xaa, 0x87, 0x38, 0x28, 0xe6, 0xab, 0x60, 0xe6, 0xa2, 0x3b, 0xde,
+	0x76, 0xde, 0x0a, 0x02, 0x7e, 0x5b, 0xbb, 0x2c, 0x01, 0x47, 0x36, 0x30, 0xf6, 0xea, 0x09, 0xa7,
+	0x1e, 0x47, 0x12, 0x17, 0xfb, 0x53, 0x66, 0xf7, 0x9e, 0x9b, 0xd9, 0xb3, 0xbd, 0xb9, 0xc9, 0xed,
+	0x9e, 0xc2, 0xaa, 0xb6, 0xe6, 0x9d, 0x45, 0x67, 0x5e, 0x9f, 0xc1, 0xd0, 0x06, 0xc6, 0xcb, 0x09,
+	0xa7, 0x2e, 0x92, 0x78, 0xe9, 0xc6, 0xe7, 0x47, 0xbb, 0xb3, 0x55, 0xbd, 0x77, 0x4e, 0x04, 0x9b,
+	0xb5, 0xde, 0x8d, 0x35, 0x5d, 0x06, 0xf6, 0xcf, 0x00, 0x96, 0xde, 0x43, 0x21, 0x09, 0x90, 0x64,
+	0xfc, 0x4d, 0x22, 0x24, 0xe3, 0xc4, 0x47, 0xa1, 0x56, 0x2b, 0xac, 0x2f, 0x00, 0xbc, 0xe2, 0x27,
+	0x51, 0x12, 0x22, 0x49, 0x5a, 0xd8, 0x64, 0x56, 0x89, 0x20, 0xac, 0x08, 0xaa, 0x17, 0x66, 0x86,
+	0x16, 0xaf, 0x99, 0x26, 0x70, 0xd4, 0xd1, 0xb4, 0x8b, 0x59, 0x91, 0x5d, 0x61, 0x84, 0xea, 0xec,
+	0x7f, 0xff, 0x6b, 0xe5, 0x95, 0x06, 0x91, 0xcd, 0xa4, 0xee, 0xf8, 0x2c, 0x32, 0x45, 0x5a, 0xeb,
+	0xa2, 0x26, 0x77, 0x62, 0x2c, 0xda, 0x7b, 0x84, 0x16, 0x33, 0xd9, 0x81, 0xd5, 0x64, 0x5c, 0x05,
+	0x6a, 0xbd, 0x04, 0xc7, 0x38, 0xde, 0xc0, 0x1c, 0x53, 0x1f, 0x7b, 0x3e, 0x4b, 0xa8, 0x4c, 0x8b,
+	0x6a, 0xc4, 0x1d, 0xcd, 0xcc, 0x2b, 0xca, 0x6a, 0x7f, 0x07, 0xe0, 0x95, 0x4c, 0xd8, 0x4a, 0xc2,
+	0x39, 0xa6, 0xb2, 0xad, 0x2a, 0x86, 0x03, 0x5a, 0x89, 0x38, 0x67, 0x11, 0x6d, 0x18, 0x6b, 0x0a,
+	0x16, 0x62, 0xcc, 0x09, 0xd3, 0x2d, 0x90, 0x77, 0xcd, 0xca, 0xfe, 0x1a, 0xc0, 0x72, 0xc6, 0xf2,
+	0x96, 0x6f, 0x34, 0xe3, 0x60, 0x85, 0x45, 0x11, 0x11, 0x82, 0x30, 0x6a, 0xb5, 0x20, 0xf4, 0xb3,
+	0xd5, 0x39, 0xf3, 0xed, 0x42, 0xb2, 0x1f, 0x00, 0x78, 0x35, 0xa3, 0x76, 0x2f, 0x91, 0x42, 0x22,
+	0x1a, 0x10, 0xda, 0xf8, 0xcf, 0x92, 0xa8, 0x18, 0x8d, 0x67, 0x8c, 0xd6, 0x43, 0x24, 0x9a, 0xb7,
+	0x5b, 0x98, 0x4a, 0xeb, 0x65, 0x78, 0xa9, 0xd5, 0x36, 0x7b, 0x26, 0xcd, 0x20, 0x4d, 0xf3, 0x58,
+	0x66, 0x5f, 0x4b, 0xcd, 0xd6, 0xdb, 0xf0, 0xe2, 0x06, 0x47, 0xbe, 0xea, 0x00, 0x33, 0x8c, 0x16,
+	0x9e, 0xbb, 0x0b, 0xdd, 0x2c, 0x84, 0xfd, 0x19, 0x80, 0x13, 0x27, 0x30, 0x12, 0xd6, 0xc7, 0x70,
+	0xaa, 0x43, 0x49, 0x28, 0x87, 0x87, 0x53, 0x8f, 0xc9, 0xd5, 0xbc, 0xf3, 0x2f, 0x57, 0x81, 0x73,
+	0x42, 0xc8, 0xe5, 0x41, 0xc5, 0x53, 0x27, 0x64, 0xa2, 0x75, 0x02, 0xa4, 0xfd, 0x69, 0x1f, 0x1c,
+	0xb8, 0x83, 0xf1, 0x1a, 0x63, 0xa1, 0xf5, 0x09, 0x1c, 0xed, 0x0c, 0xf7, 0x98, 0xb1, 0xf0, 0x54,
+	0x47, 0xb4, 0x74, 0xd6, 0x23, 0x2a, 0x02, 0xb7, 0x73, 0x99, 0xa4, 0x04, 0x24, 0x1c, 0x0e, 0xb0,
+	0x4f, 0x22, 0x14, 0x6a, 0xf8, 0xbe, 0x53, 0xc0, 0xdf, 0x3c, 0x03, 0xbc, 0x3b, 0x64, 0x60, 0x14,
+	0xaa, 0xfd, 0x55, 0x1f, 0x2c, 0xad, 0x74, 0xf3, 0x58, 0x8f, 0x31, 0x0d, 0xf4, 0x04, 0x47, 0xa1,
+	0x35, 0x01, 0xfb, 0x25, 0x91, 0x21, 0xd6, 0x57, 0x9d, 0xab, 0x17, 0x56, 0x15, 0x0e, 0x05, 0x58,
+	0xf8, 0x9c, 0xc4, 0x9d, 0xaa, 0x70, 0xbb, 0x4d, 0xd6, 0x35, 0x38, 0xc8, 0xb1, 0x4f, 0x62, 0x82,
+	0xa9, 0xd4, 0xb7, 0x8a, 0xdb, 0x31, 0x58, 0x3b, 0xb0, 0x80, 0xa2, 0x74, 0x10, 0xe5, 0x53, 0x91,
+	0xd3, 0x27, 0x8a, 0x4c, 0x15, 0xde, 0x31, 0x0a, 0x67, 0x4e, 0xa1, 0x30, 0x95, 0xf7, 0xcd, 0xd1,
+	0xee, 0xec, 0x70, 0x98, 0x96, 0xa1, 0xe7, 0x77, 0x3a, 0xc2, 0x00, 0x2e, 0xcd, 0xdc, 0x7f, 0x58,
+	0xc9, 0xfd, 0xf1, 0xb0, 0x92, 0xfb, 0x61, 0x6f, 0xae, 0x64, 0x50, 0x1b, 0xac, 0xd5, 0x05, 0x4a,
+	0xa5, 0xe2, 0x0c, 0xec, 0x1f, 0x01, 0x9c, 0x5c, 0xc5, 0x2a, 0x92, 0xaa, 0x1a, 0x89, 0xb8, 0x24,
+	0xb4, 0xf1, 0x16, 0xdd, 0x48, 0x07, 0x6a, 0xcc, 0x71, 0x8b, 0x30, 0x75, 0x83, 0x76, 0xf7, 0xce,
+	0x68, 0xdb, 0x6c, 0x5a, 0xe7, 0x2e, 0xec, 0x17, 0x12, 0x6d, 0xe2, 0x62, 0xdf, 0x0b, 0x3d, 0x14,
+	0x74, 0x10, 0x6b, 0x15, 0x16, 0x9a, 0x98, 0x34, 0x9a, 0x3a, 0xa1, 0xf9, 0xe5, 0x57, 0xff, 0xdc,
+	0xaf, 0x8c, 0xf9, 0x1c, 0xab, 0x21, 0x4f, 0x3d, 0xed, 0xfa, 0xf6, 0x68, 0x77, 0xf6, 0xb8, 0xcd,
+	0x24, 0x40, 0x2f, 0xec, 0xdf, 0x01, 0x9c, 0x36, 0xb2, 0x08, 0xa3, 0x99, 0x40, 0x73, 0x57, 0xbf,
+	0x03, 0x2f, 0x77, 0x9a, 0x50, 0x5d, 0xd6, 0x58, 0x08, 0xf3, 0xcc, 0xb9, 0xfe, 0x74, 0x6f, 0xee,
+	0x7f, 0x86, 0x5a, 0x67, 0xfe, 0xea, 0x4f, 0xd6, 0x25, 0x57, 0x63, 0xee, 0x52, 0xeb, 0x98, 0xdd,
+	0xa2, 0xb0, 0x90, 0xbd, 0x63, 0xce, 0x73, 0xe0, 0x19, 0x94, 0xa5, 0xbc, 0x3a, 0x5e, 0xfb, 0x27,
+	0x00, 0x6f, 0xfc, 0x73, 0x51, 0xbf, 0x4f, 0x64, 0x73, 0x15, 0xc7, 0x4c, 0x10, 0x79, 0x4e, 0xf5,
+	0x3d, 0xd5, 0x55, 0xdf, 0xca, 0x65, 0x56, 0x56, 0x11, 0x0e, 0x04, 0x1a, 0x58, 0xbf, 0x67, 0xdc,
+	0xf6, 0x72, 0xe9, 0xff, 0xf7, 0x4f, 0x51, 0x92, 0xcb, 0x6f, 0x3c, 0x3a, 0x28, 0x83, 0xc7, 0x07,
+	0x65, 0xf0, 0xe4, 0xa0, 0x0c, 0x7e, 0x3b, 0x28, 0x83, 0x2f, 0x0f, 0xcb, 0xb9, 0x27, 0x87, 0xe5,
+	0xdc, 0x2f, 0x87, 0xe5, 0xdc, 0x07, 0xd7, 0x7b, 0xca, 0xea, 0xd8, 0xdb, 0x25, 0x4d, 0x5a, 0xbd,
+	0x90, 0xbe, 0x69, 0x6f, 0xfe, 0x35, 0x00, 0xed, 0xcc, 0x0a, 0x6a, 0x86, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +762,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.FeeBurnRate.Equal(that1.FeeBurnRate) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1100,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeBurnRate.Size()
+		i -= size
+		if _, err := m.FeeBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1648,6 +1666,8 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.FeeBurnRate.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -2016,6 +2036,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
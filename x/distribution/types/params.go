@@ -14,12 +14,17 @@ func DefaultParams() Params {
 		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
 		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+		FeeBurnRate:         math.LegacyZeroDec(),
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateFeeBurnRate(p.FeeBurnRate)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -40,3 +45,17 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeBurnRate(v math.LegacyDec) error {
+	if v.IsNil() {
+		return errors.New("fee burn rate must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee burn rate must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee burn rate too large: %s", v)
+	}
+
+	return nil
+}
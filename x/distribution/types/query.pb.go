@@ -611,6 +611,131 @@ func (m *QueryDelegationRewardsResponse) GetRewards() github_com_cosmos_cosmos_s
 	return nil
 }
 
+// QueryDelegationRewardsEstimateRequest is the request type for the
+// Query/DelegationRewardsEstimate RPC method.
+type QueryDelegationRewardsEstimateRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// blocks defines the number of future blocks to project the rewards over.
+	Blocks uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryDelegationRewardsEstimateRequest) Reset()         { *m = QueryDelegationRewardsEstimateRequest{} }
+func (m *QueryDelegationRewardsEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationRewardsEstimateRequest) ProtoMessage()    {}
+func (*QueryDelegationRewardsEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+}
+func (m *QueryDelegationRewardsEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationRewardsEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationRewardsEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationRewardsEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationRewardsEstimateRequest.Merge(m, src)
+}
+func (m *QueryDelegationRewardsEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationRewardsEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationRewardsEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationRewardsEstimateRequest proto.InternalMessageInfo
+
+// QueryDelegationRewardsEstimateResponse is the response type for the
+// Query/DelegationRewardsEstimate RPC method.
+type QueryDelegationRewardsEstimateResponse struct {
+	// rewards defines the rewards accrued by the delegation so far.
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+	// estimated_rewards defines the rewards expected to be accrued by the
+	// delegation after the given number of blocks, including the rewards
+	// accrued so far.
+	EstimatedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=estimated_rewards,json=estimatedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"estimated_rewards"`
+	// reward_rate defines the rewards accrued per block and per staked token,
+	// net of the community tax and of the validator commission, as observed
+	// since the delegation was last modified or withdrawn.
+	RewardRate github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=reward_rate,json=rewardRate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_rate"`
+	// observed_blocks defines the number of blocks the reward rate was observed
+	// over, zero when the delegation started at the current height.
+	ObservedBlocks uint64 `protobuf:"varint,4,opt,name=observed_blocks,json=observedBlocks,proto3" json:"observed_blocks,omitempty"`
+}
+
+func (m *QueryDelegationRewardsEstimateResponse) Reset() {
+	*m = QueryDelegationRewardsEstimateResponse{}
+}
+func (m *QueryDelegationRewardsEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationRewardsEstimateResponse) ProtoMessage()    {}
+func (*QueryDelegationRewardsEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+}
+func (m *QueryDelegationRewardsEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationRewardsEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationRewardsEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationRewardsEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationRewardsEstimateResponse.Merge(m, src)
+}
+func (m *QueryDelegationRewardsEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationRewardsEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationRewardsEstimateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationRewardsEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationRewardsEstimateResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryDelegationRewardsEstimateResponse) GetEstimatedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.EstimatedRewards
+	}
+	return nil
+}
+
+func (m *QueryDelegationRewardsEstimateResponse) GetRewardRate() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardRate
+	}
+	return nil
+}
+
+func (m *QueryDelegationRewardsEstimateResponse) GetObservedBlocks() uint64 {
+	if m != nil {
+		return m.ObservedBlocks
+	}
+	return 0
+}
+
 // QueryDelegationTotalRewardsRequest is the request type for the
 // Query/DelegationTotalRewards RPC method.
 type QueryDelegationTotalRewardsRequest struct {
@@ -622,7 +747,7 @@ func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegat
 func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+	return fileDescriptor_5efd02cbc06efdc9, []int{14}
 }
 func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +789,7 @@ func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelega
 func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+	return fileDescriptor_5efd02cbc06efdc9, []int{15}
 }
 func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -718,7 +843,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +883,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +923,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +963,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +1005,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1049,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,6 +1098,8 @@ func init() {
 	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesResponse")
 	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsRequest")
 	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse")
+	proto.RegisterType((*QueryDelegationRewardsEstimateRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsEstimateRequest")
+	proto.RegisterType((*QueryDelegationRewardsEstimateResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsEstimateResponse")
 	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsRequest")
 	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsResponse")
 	proto.RegisterType((*QueryDelegatorValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsRequest")
@@ -988,89 +1115,96 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	// 1420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x54, 0x55,
+	0x14, 0xee, 0x9d, 0x96, 0x22, 0x07, 0xb1, 0xed, 0x05, 0xc9, 0xf4, 0x01, 0xd3, 0xf2, 0x6a, 0x69,
+	0x03, 0xe9, 0xbc, 0x52, 0x22, 0x62, 0x2b, 0x51, 0x66, 0x5a, 0x84, 0x48, 0xf8, 0x19, 0x50, 0xa2,
+	0x86, 0x8c, 0x6f, 0xe6, 0x5d, 0xa6, 0x4f, 0x66, 0xde, 0x9d, 0xbe, 0x7b, 0xa7, 0x63, 0x43, 0xd8,
+	0xc0, 0x06, 0x59, 0x18, 0xa3, 0x1b, 0x77, 0xba, 0x34, 0xae, 0x5c, 0xb0, 0xd7, 0xb8, 0x22, 0xba,
+	0x21, 0x98, 0x18, 0x57, 0x4a, 0x5a, 0x13, 0x31, 0x46, 0xe3, 0xce, 0xad, 0x99, 0x7b, 0xef, 0x9b,
+	0x79, 0x6f, 0xfe, 0x7f, 0x32, 0xea, 0x06, 0x86, 0xf3, 0xee, 0x39, 0xdf, 0xf9, 0xce, 0x39, 0xf7,
+	0xdc, 0x73, 0x02, 0xcc, 0xa4, 0x29, 0xcb, 0x51, 0x66, 0x58, 0x36, 0xe3, 0xae, 0x9d, 0x2a, 0x70,
+	0x9b, 0x3a, 0xc6, 0xfa, 0xd1, 0x14, 0xe1, 0xe6, 0x51, 0x63, 0xad, 0x40, 0xdc, 0x8d, 0x68, 0xde,
+	0xa5, 0x9c, 0xe2, 0x7d, 0xf2, 0x60, 0xd4, 0x7f, 0x30, 0xaa, 0x0e, 0x6a, 0x87, 0x95, 0x95, 0x94,
+	0xc9, 0x88, 0xd4, 0x2a, 0xdb, 0xc8, 0x9b, 0x19, 0xdb, 0x31, 0xc5, 0x69, 0x61, 0x48, 0xdb, 0x93,
+	0xa1, 0x19, 0x2a, 0x7e, 0x1a, 0xa5, 0x5f, 0x4a, 0xba, 0x3f, 0x43, 0x69, 0x26, 0x4b, 0x0c, 0x33,
+	0x6f, 0x1b, 0xa6, 0xe3, 0x50, 0x2e, 0x54, 0x98, 0xfa, 0x1a, 0xf1, 0xdb, 0xf7, 0x2c, 0xa7, 0xa9,
+	0xed, 0xd9, 0x8c, 0x36, 0x63, 0x11, 0xf0, 0x58, 0x9e, 0x1f, 0x97, 0xe7, 0x93, 0xd2, 0x0d, 0xc5,
+	0x4c, 0x7e, 0x1a, 0x33, 0x73, 0xb6, 0x43, 0x0d, 0xf1, 0xa7, 0x14, 0xe9, 0x7b, 0x00, 0x5f, 0x2a,
+	0x71, 0xba, 0x68, 0xba, 0x66, 0x8e, 0x25, 0xc8, 0x5a, 0x81, 0x30, 0xae, 0x5f, 0x83, 0xdd, 0x01,
+	0x29, 0xcb, 0x53, 0x87, 0x11, 0x7c, 0x1a, 0x86, 0xf3, 0x42, 0x12, 0x46, 0x93, 0x68, 0x76, 0xe7,
+	0xc2, 0x54, 0xb4, 0x49, 0xe0, 0xa2, 0x52, 0x39, 0xb6, 0xe3, 0xc1, 0x4f, 0x13, 0x03, 0x9f, 0xff,
+	0xfa, 0xe5, 0x61, 0x94, 0x50, 0xda, 0x7a, 0x11, 0xa6, 0x85, 0xf9, 0x37, 0xcc, 0xac, 0x6d, 0x99,
+	0x9c, 0xba, 0xcb, 0x3e, 0xfd, 0xb3, 0xce, 0x75, 0xaa, 0xfc, 0xc0, 0xe7, 0x61, 0x6c, 0xdd, 0x3b,
+	0x93, 0x34, 0x2d, 0xcb, 0x25, 0x4c, 0x62, 0xef, 0x88, 0x1d, 0x7c, 0x74, 0x7f, 0xee, 0x80, 0x82,
+	0x2f, 0xdb, 0x39, 0x25, 0x8f, 0x5c, 0xe6, 0xae, 0xed, 0x64, 0x12, 0xa3, 0xeb, 0x55, 0x72, 0xfd,
+	0xcf, 0x10, 0x1c, 0x6a, 0x85, 0xac, 0xb8, 0x9e, 0x83, 0x51, 0x9a, 0x27, 0x6e, 0x77, 0xc8, 0x23,
+	0x9e, 0xaa, 0x12, 0xe3, 0xdb, 0x08, 0xc6, 0x18, 0xc9, 0x5e, 0x4f, 0xa6, 0xa8, 0x63, 0x25, 0x5d,
+	0x52, 0x34, 0x5d, 0x8b, 0x85, 0x43, 0x93, 0x83, 0xb3, 0x3b, 0x17, 0xf6, 0x7b, 0x51, 0x2c, 0x55,
+	0x40, 0x39, 0x7a, 0xcb, 0x24, 0x1d, 0xa7, 0xb6, 0x13, 0x3b, 0x51, 0x0a, 0xdf, 0x17, 0x3f, 0x4f,
+	0x1c, 0xc9, 0xd8, 0x7c, 0xb5, 0x90, 0x8a, 0xa6, 0x69, 0x4e, 0x25, 0x55, 0xfd, 0x35, 0xc7, 0xac,
+	0x1b, 0x06, 0xdf, 0xc8, 0x13, 0xe6, 0xe9, 0x30, 0x19, 0xed, 0x91, 0x12, 0x60, 0x8c, 0x3a, 0x56,
+	0x42, 0xc2, 0xe1, 0x35, 0x80, 0x34, 0xcd, 0xe5, 0x6c, 0xc6, 0x6c, 0xea, 0x84, 0x07, 0xdb, 0x00,
+	0x3f, 0xd6, 0x05, 0x78, 0xc2, 0x07, 0xa2, 0x6f, 0xc0, 0x4c, 0x30, 0xde, 0x17, 0x0a, 0x9c, 0x71,
+	0xd3, 0xb1, 0x4a, 0x51, 0x92, 0x6e, 0xf5, 0x2b, 0xd7, 0xef, 0x23, 0x98, 0x6d, 0x8d, 0xad, 0xb2,
+	0x7d, 0x0d, 0xb6, 0x7b, 0x49, 0x91, 0xa5, 0x7d, 0xa2, 0x69, 0x69, 0x37, 0x31, 0xe9, 0xaf, 0x77,
+	0xcf, 0xa6, 0xbe, 0x06, 0x13, 0x41, 0x57, 0xe2, 0xe5, 0x10, 0xf5, 0x8b, 0xfe, 0x3d, 0x04, 0x93,
+	0x8d, 0x31, 0x15, 0xed, 0xeb, 0x81, 0x8a, 0x90, 0xcc, 0x97, 0xda, 0x63, 0x7e, 0x2a, 0x9d, 0x2e,
+	0xe4, 0x0a, 0x59, 0x93, 0x13, 0xab, 0x62, 0xd8, 0x4f, 0xde, 0x5f, 0x06, 0xf7, 0x42, 0xb0, 0x3f,
+	0xe8, 0xcc, 0xe5, 0xac, 0xc9, 0x56, 0x49, 0xbf, 0x92, 0x8f, 0x67, 0x60, 0x84, 0x71, 0xd3, 0xe5,
+	0xb6, 0x93, 0x49, 0xae, 0x12, 0x3b, 0xb3, 0xca, 0xc3, 0xa1, 0x49, 0x34, 0x3b, 0x94, 0x78, 0xc6,
+	0x13, 0x9f, 0x11, 0x52, 0x3c, 0x05, 0xbb, 0x88, 0x63, 0xf9, 0x8e, 0x0d, 0x8a, 0x63, 0x4f, 0x4b,
+	0xa1, 0x3a, 0x74, 0x1a, 0xa0, 0xd2, 0xea, 0xc3, 0x43, 0x22, 0x4c, 0x87, 0x02, 0x17, 0x47, 0xbe,
+	0x26, 0x95, 0xce, 0x97, 0x21, 0x8a, 0x59, 0xc2, 0xa7, 0xb9, 0x38, 0x74, 0xf7, 0xb3, 0x89, 0x01,
+	0xfd, 0x2b, 0x04, 0x07, 0x1a, 0x04, 0x43, 0xa5, 0xe5, 0x75, 0xd8, 0xce, 0xa4, 0x28, 0x8c, 0xc4,
+	0x2d, 0x9d, 0x6f, 0x2f, 0x27, 0xc2, 0xce, 0xca, 0x3a, 0x71, 0x78, 0xa0, 0x0a, 0x95, 0x2d, 0xfc,
+	0x6a, 0x80, 0x46, 0x48, 0xd0, 0x98, 0x69, 0x49, 0x43, 0xfa, 0xe4, 0xe7, 0xa1, 0x7f, 0xed, 0x31,
+	0x58, 0x26, 0x59, 0x92, 0x11, 0xb2, 0xaa, 0xcb, 0xbc, 0x02, 0x63, 0x96, 0xfc, 0x56, 0x93, 0xcf,
+	0xf0, 0xa3, 0xfb, 0x73, 0x7b, 0x14, 0x68, 0x55, 0x1a, 0xcb, 0x2a, 0x5e, 0x1a, 0xeb, 0x96, 0x45,
+	0xa8, 0xeb, 0xb2, 0x58, 0x7c, 0xaa, 0x94, 0x80, 0x27, 0xa5, 0x24, 0x7c, 0x84, 0x20, 0xd2, 0x88,
+	0x82, 0xca, 0x42, 0xde, 0xdf, 0x13, 0xfa, 0xd9, 0xa8, 0xcb, 0x6d, 0xe2, 0x31, 0x82, 0xe9, 0xfa,
+	0x4e, 0xad, 0x30, 0x6e, 0xe7, 0x4c, 0x4e, 0xfe, 0xdf, 0xf1, 0xc5, 0x7b, 0x61, 0x38, 0x95, 0xa5,
+	0xe9, 0x1b, 0x4c, 0x5d, 0x23, 0xf5, 0x2f, 0x5f, 0xdc, 0xbf, 0x19, 0x84, 0x43, 0xad, 0x28, 0xfe,
+	0x57, 0xf1, 0xc7, 0x77, 0x10, 0x8c, 0x11, 0xe5, 0xc6, 0xbf, 0xf5, 0x4a, 0x8f, 0x96, 0x01, 0xbd,
+	0x67, 0xba, 0x08, 0x3b, 0x25, 0x74, 0xd2, 0x35, 0x39, 0x09, 0x0f, 0xf6, 0x15, 0x1e, 0x24, 0x54,
+	0xc2, 0xe4, 0xa4, 0xd4, 0x34, 0x69, 0x8a, 0x11, 0x77, 0x9d, 0x58, 0x49, 0x95, 0xc6, 0x21, 0xd9,
+	0x34, 0x3d, 0x71, 0x4c, 0x48, 0xf5, 0x02, 0xe8, 0x55, 0x39, 0xbc, 0x42, 0xb9, 0x99, 0xed, 0x4b,
+	0x0f, 0xf0, 0xd5, 0xce, 0x5f, 0x08, 0xa6, 0x9a, 0xe2, 0xaa, 0xc2, 0x79, 0xbb, 0xba, 0x70, 0x8e,
+	0x37, 0x6d, 0x9f, 0x15, 0x6b, 0xcb, 0x1e, 0xb6, 0xb4, 0x58, 0xef, 0x29, 0xc7, 0x59, 0xd8, 0xc6,
+	0x4b, 0xa0, 0x7d, 0x2e, 0x0b, 0x09, 0xa2, 0xbb, 0x6a, 0x70, 0x28, 0x7b, 0x56, 0xbe, 0x8a, 0xfd,
+	0x0b, 0xf3, 0x39, 0x98, 0x6c, 0x8c, 0xa9, 0x42, 0x1c, 0x01, 0x28, 0x5f, 0x7e, 0x19, 0xe5, 0x1d,
+	0x09, 0x9f, 0xc4, 0x67, 0xad, 0x08, 0xcf, 0x05, 0xad, 0x5d, 0xb5, 0xf9, 0xaa, 0xe5, 0x9a, 0x45,
+	0x05, 0xdc, 0x37, 0x1a, 0xeb, 0x30, 0xdd, 0x02, 0x58, 0x71, 0x89, 0xc3, 0x68, 0x51, 0x7d, 0x6a,
+	0x1b, 0x78, 0xa4, 0x18, 0x34, 0xe6, 0xc3, 0x5d, 0x80, 0x71, 0x81, 0x5b, 0x9a, 0x8a, 0x0a, 0x8e,
+	0xcd, 0x37, 0x2e, 0x52, 0x9a, 0x55, 0x2c, 0x17, 0x9f, 0x7d, 0x74, 0x7f, 0x6e, 0xa4, 0x92, 0xfa,
+	0xc9, 0xf9, 0xe8, 0xf3, 0xf3, 0x61, 0xa4, 0x7f, 0x8a, 0x40, 0xab, 0xa7, 0xa4, 0x3c, 0x7c, 0x17,
+	0x86, 0xf2, 0x94, 0x66, 0xfb, 0xdc, 0x06, 0x05, 0x46, 0x03, 0x0f, 0x17, 0x7e, 0xdf, 0x0d, 0xdb,
+	0x84, 0x87, 0xf8, 0x13, 0x04, 0xc3, 0x72, 0xb5, 0xc3, 0x46, 0xd3, 0x7b, 0x55, 0xbb, 0x57, 0x6a,
+	0xf3, 0xed, 0x2b, 0x48, 0xea, 0xfa, 0x91, 0xdb, 0xdf, 0xff, 0xf2, 0x71, 0x68, 0x1a, 0x4f, 0x19,
+	0xcd, 0xd6, 0x60, 0xb9, 0x57, 0xe2, 0xdf, 0x10, 0x8c, 0x37, 0xdc, 0xec, 0x70, 0xac, 0x35, 0x78,
+	0xab, 0x85, 0x54, 0x8b, 0xf7, 0x64, 0x43, 0x71, 0x8a, 0x0b, 0x4e, 0x27, 0xf1, 0x52, 0x53, 0x4e,
+	0x95, 0xdb, 0x64, 0xdc, 0xac, 0x79, 0xa4, 0x6f, 0xe1, 0x3b, 0x21, 0xd8, 0xd7, 0x64, 0x0d, 0xc1,
+	0xcb, 0x1d, 0x78, 0xda, 0x70, 0x29, 0xd3, 0x56, 0x7a, 0xb4, 0xa2, 0x18, 0x5f, 0x15, 0x8c, 0x2f,
+	0xe1, 0x0b, 0x3d, 0x30, 0x36, 0x68, 0xc5, 0xbe, 0xf7, 0x36, 0xe3, 0x4d, 0x04, 0xbb, 0xeb, 0x2c,
+	0x38, 0xf8, 0xa5, 0x0e, 0xfc, 0xae, 0xd9, 0xc5, 0xb4, 0x93, 0x5d, 0x6a, 0x2b, 0xb6, 0xe7, 0x05,
+	0xdb, 0x33, 0xf8, 0x74, 0x2f, 0x6c, 0x2b, 0xdb, 0x13, 0xfe, 0x01, 0xc1, 0x68, 0xf5, 0xae, 0x80,
+	0x5f, 0xec, 0xc0, 0xc7, 0xe0, 0xb2, 0xa5, 0x2d, 0x76, 0xa3, 0xaa, 0xb8, 0xbd, 0x26, 0xb8, 0xad,
+	0xe0, 0x78, 0x2f, 0xdc, 0xbc, 0x85, 0xe4, 0x0f, 0x04, 0x63, 0x35, 0x73, 0x20, 0x6e, 0xc3, 0xbd,
+	0x46, 0x7b, 0x87, 0xb6, 0xd4, 0x95, 0xae, 0xe2, 0x96, 0x14, 0xdc, 0xde, 0xc4, 0x57, 0x9b, 0x72,
+	0x2b, 0x3f, 0x39, 0xcc, 0xb8, 0x59, 0xf3, 0x62, 0xdd, 0x32, 0x54, 0x65, 0xd6, 0xbd, 0xb3, 0x1f,
+	0x84, 0x60, 0xbc, 0xe1, 0xdc, 0xdb, 0x4e, 0x7f, 0x6a, 0xb5, 0x17, 0x68, 0xf1, 0x9e, 0x6c, 0xa8,
+	0x38, 0xac, 0x8a, 0x38, 0xa4, 0xf0, 0x3b, 0x7d, 0x8a, 0x83, 0xe1, 0x8d, 0xbc, 0xf8, 0x09, 0x82,
+	0xbd, 0xf5, 0x87, 0x39, 0xfc, 0x72, 0x27, 0x4c, 0xea, 0x8c, 0x9f, 0xda, 0x2b, 0xdd, 0x1b, 0xe8,
+	0xa8, 0xd6, 0xdb, 0x8b, 0x83, 0xe8, 0x54, 0x75, 0x26, 0xaa, 0x76, 0x3a, 0x55, 0xe3, 0xe1, 0x4f,
+	0x3b, 0xd9, 0xa5, 0x76, 0x47, 0x9d, 0xaa, 0x05, 0xc3, 0xca, 0x65, 0xc7, 0x7f, 0x23, 0x08, 0x37,
+	0x9a, 0xb7, 0xf0, 0xa9, 0x0e, 0x7c, 0xad, 0x3f, 0x24, 0x6a, 0xb1, 0x5e, 0x4c, 0x28, 0xce, 0x57,
+	0x04, 0xe7, 0xf3, 0xf8, 0x5c, 0x2f, 0x9c, 0xab, 0x07, 0x46, 0xfc, 0x1d, 0x82, 0x5d, 0x81, 0xe1,
+	0x0d, 0x1f, 0x6f, 0xed, 0x6b, 0xbd, 0x11, 0x51, 0x7b, 0xa1, 0x63, 0x3d, 0x45, 0xec, 0xec, 0xb7,
+	0xb5, 0x93, 0x9b, 0xe0, 0x3a, 0x87, 0x8f, 0x34, 0xe5, 0x9a, 0xf6, 0xcc, 0x25, 0x4b, 0x13, 0xe0,
+	0xdd, 0x10, 0x8a, 0x2d, 0x3d, 0xd8, 0x8c, 0xa0, 0x87, 0x9b, 0x11, 0xf4, 0x78, 0x33, 0x82, 0x3e,
+	0xdc, 0x8a, 0x0c, 0x3c, 0xdc, 0x8a, 0x0c, 0xfc, 0xb8, 0x15, 0x19, 0x78, 0xeb, 0xa0, 0x34, 0xc4,
+	0xac, 0x1b, 0x51, 0x9b, 0x1a, 0xef, 0x05, 0x0d, 0x8a, 0xa9, 0x32, 0x35, 0x2c, 0xfe, 0x67, 0xe1,
+	0xd8, 0x3f, 0x03, 0x00, 0xb9, 0xa3, 0x18, 0x0a, 0x7f, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1097,6 +1231,9 @@ type QueryClient interface {
 	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error)
+	// DelegationRewardsEstimate projects the rewards accrued by a delegation
+	// over the given number of future blocks.
+	DelegationRewardsEstimate(ctx context.Context, in *QueryDelegationRewardsEstimateRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsEstimateResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
@@ -1172,6 +1309,15 @@ func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegation
 	return out, nil
 }
 
+func (c *queryClient) DelegationRewardsEstimate(ctx context.Context, in *QueryDelegationRewardsEstimateRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsEstimateResponse, error) {
+	out := new(QueryDelegationRewardsEstimateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegationRewardsEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error) {
 	out := new(QueryDelegationTotalRewardsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards", in, out, opts...)
@@ -1223,6 +1369,9 @@ type QueryServer interface {
 	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
+	// DelegationRewardsEstimate projects the rewards accrued by a delegation
+	// over the given number of future blocks.
+	DelegationRewardsEstimate(context.Context, *QueryDelegationRewardsEstimateRequest) (*QueryDelegationRewardsEstimateResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
@@ -1258,6 +1407,9 @@ func (*UnimplementedQueryServer) ValidatorSlashes(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
 }
+func (*UnimplementedQueryServer) DelegationRewardsEstimate(ctx context.Context, req *QueryDelegationRewardsEstimateRequest) (*QueryDelegationRewardsEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewardsEstimate not implemented")
+}
 func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
@@ -1383,6 +1535,24 @@ func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegationRewardsEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewardsEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegationRewardsEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewardsEstimate(ctx, req.(*QueryDelegationRewardsEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegationTotalRewardsRequest)
 	if err := dec(in); err != nil {
@@ -1484,6 +1654,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationRewards",
 			Handler:    _Query_DelegationRewards_Handler,
 		},
+		{
+			MethodName: "DelegationRewardsEstimate",
+			Handler:    _Query_DelegationRewardsEstimate_Handler,
+		},
 		{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
@@ -1950,6 +2124,118 @@ func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegationRewardsEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRewardsEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRewardsEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRewardsEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRewardsEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRewardsEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObservedBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservedBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RewardRate) > 0 {
+		for iNdEx := len(m.RewardRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.EstimatedRewards) > 0 {
+		for iNdEx := len(m.EstimatedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EstimatedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2404,6 +2690,56 @@ func (m *QueryDelegationRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegationRewardsEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryDelegationRewardsEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EstimatedRewards) > 0 {
+		for _, e := range m.EstimatedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RewardRate) > 0 {
+		for _, e := range m.RewardRate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ObservedBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.ObservedBlocks))
+	}
+	return n
+}
+
 func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3691,6 +4027,310 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegationRewardsEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationRewardsEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationRewardsEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationRewardsEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationRewardsEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationRewardsEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstimatedRewards = append(m.EstimatedRewards, types.DecCoin{})
+			if err := m.EstimatedRewards[len(m.EstimatedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardRate = append(m.RewardRate, types.DecCoin{})
+			if err := m.RewardRate[len(m.RewardRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedBlocks", wireType)
+			}
+			m.ObservedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
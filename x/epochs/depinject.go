@@ -43,7 +43,7 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Environment, in.Cdc)
+	k := keeper.NewKeeper(in.Environment, in.Cdc).SetAggregateMissedEpochs(in.Config.AggregateMissedEpochs)
 	m := NewAppModule(in.Cdc, k)
 	return ModuleOutputs{EpochKeeper: k, Module: m}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/x/epochs/types"
 
@@ -56,6 +57,18 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 
 				epochInfo.CurrentEpoch += 1
 				epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+
+				// the following epochs which also ended since the previous block were missed
+				// while the chain was halted, they are caught up before starting the current one
+				if elapsed := headerInfo.Time.Sub(epochInfo.CurrentEpochStartTime); elapsed > epochInfo.Duration {
+					missedEpochs := int64((elapsed - 1) / epochInfo.Duration)
+					if err := k.catchUpMissedEpochs(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch, epochInfo.CurrentEpoch+missedEpochs-1); err != nil {
+						return false, err
+					}
+
+					epochInfo.CurrentEpoch += missedEpochs
+					epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(time.Duration(missedEpochs) * epochInfo.Duration)
+				}
 				k.Logger.Debug(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 			}
 
@@ -83,3 +96,39 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	)
 	return err
 }
+
+// catchUpMissedEpochs runs the hooks of the epochs missed while the chain was halted,
+// from firstEpochNumber to lastEpochNumber included, once per missed epoch or once
+// for all of them if missed epochs are aggregated.
+func (k Keeper) catchUpMissedEpochs(ctx context.Context, identifier string, firstEpochNumber, lastEpochNumber int64) error {
+	k.Logger.Info(fmt.Sprintf("Catching up missed epochs with identifier %s epoch numbers %d to %d", identifier, firstEpochNumber, lastEpochNumber))
+
+	err := k.EventService.EventManager(ctx).Emit(&types.EventEpochsMissed{
+		EpochIdentifier:  identifier,
+		FirstEpochNumber: firstEpochNumber,
+		LastEpochNumber:  lastEpochNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	runHooks := func(first, last int64) {
+		if err := k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.AfterMissedEpochs(ctx, identifier, first, last)
+		}); err != nil {
+			// purposely ignoring the error here not to halt the chain if the hook fails
+			k.Logger.Error(fmt.Sprintf("Error after missed epochs with identifier %s epoch numbers %d to %d", identifier, first, last))
+		}
+	}
+
+	if k.aggregateMissedEpochs {
+		runHooks(firstEpochNumber, lastEpochNumber)
+		return nil
+	}
+
+	for epochNumber := firstEpochNumber; epochNumber <= lastEpochNumber; epochNumber++ {
+		runHooks(epochNumber, epochNumber)
+	}
+
+	return nil
+}
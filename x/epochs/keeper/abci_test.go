@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"maps"
 	"slices"
 	"testing"
@@ -9,7 +11,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/core/header"
+	epochskeeper "cosmossdk.io/x/epochs/keeper"
 	"cosmossdk.io/x/epochs/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
 // This test is responsible for testing how epochs increment based off
@@ -48,15 +55,15 @@ func (suite *KeeperTestSuite) TestEpochInfoBeginBlockChanges() {
 			blockHeightTimePairs: map[int]time.Time{2: block1Time.Add(defaultDuration).Add(eps)},
 			expEpochInfo:         types.EpochInfo{StartTime: block1Time, CurrentEpoch: 2, CurrentEpochStartTime: block1Time.Add(time.Hour), CurrentEpochStartHeight: 2},
 		},
-		"Downtime recovery (many intervals), first block causes 1 tick and sets current start time 1 interval ahead": {
+		"Downtime recovery (many intervals), first block catches up the missed epochs and starts the last one": {
 			initialEpochInfo:     types.EpochInfo{StartTime: block1Time, CurrentEpoch: 0, CurrentEpochStartTime: time.Time{}},
 			blockHeightTimePairs: map[int]time.Time{2: block1Time.Add(24 * time.Hour)},
-			expEpochInfo:         types.EpochInfo{StartTime: block1Time, CurrentEpoch: 2, CurrentEpochStartTime: block1Time.Add(time.Hour), CurrentEpochStartHeight: 2},
+			expEpochInfo:         types.EpochInfo{StartTime: block1Time, CurrentEpoch: 24, CurrentEpochStartTime: block1Time.Add(23 * time.Hour), CurrentEpochStartHeight: 2},
 		},
-		"Downtime recovery (many intervals), second block is at tick 2, w/ start time 2 intervals ahead": {
+		"Downtime recovery (many intervals), second block ends the last epoch": {
 			initialEpochInfo:     types.EpochInfo{StartTime: block1Time, CurrentEpoch: 0, CurrentEpochStartTime: time.Time{}},
 			blockHeightTimePairs: map[int]time.Time{2: block1Time.Add(24 * time.Hour), 3: block1Time.Add(24 * time.Hour).Add(eps)},
-			expEpochInfo:         types.EpochInfo{StartTime: block1Time, CurrentEpoch: 3, CurrentEpochStartTime: block1Time.Add(2 * time.Hour), CurrentEpochStartHeight: 3},
+			expEpochInfo:         types.EpochInfo{StartTime: block1Time, CurrentEpoch: 25, CurrentEpochStartTime: block1Time.Add(24 * time.Hour), CurrentEpochStartHeight: 3},
 		},
 		"Many blocks between first and second tick": {
 			initialEpochInfo:     types.EpochInfo{StartTime: block1Time, CurrentEpoch: 1, CurrentEpochStartTime: block1Time},
@@ -189,3 +196,76 @@ func TestEpochStartingOneMonthAfterInitGenesis(t *testing.T) {
 	require.Equal(t, epochInfo.CurrentEpochStartTime.UTC().String(), now.Add(month).UTC().String())
 	require.Equal(t, epochInfo.EpochCountingStarted, true)
 }
+
+type recordingEpochHooks struct {
+	calls []string
+}
+
+func (h *recordingEpochHooks) AfterEpochEnd(_ context.Context, _ string, epochNumber int64) error {
+	h.calls = append(h.calls, fmt.Sprintf("end %d", epochNumber))
+	return nil
+}
+
+func (h *recordingEpochHooks) BeforeEpochStart(_ context.Context, _ string, epochNumber int64) error {
+	h.calls = append(h.calls, fmt.Sprintf("start %d", epochNumber))
+	return nil
+}
+
+type recordingCatchUpEpochHooks struct {
+	recordingEpochHooks
+}
+
+func (h *recordingCatchUpEpochHooks) AfterMissedEpochs(_ context.Context, _ string, firstEpochNumber, lastEpochNumber int64) error {
+	h.calls = append(h.calls, fmt.Sprintf("missed %d-%d", firstEpochNumber, lastEpochNumber))
+	return nil
+}
+
+func TestMissedEpochsCatchUp(t *testing.T) {
+	block1Time := time.Unix(1656907200, 0).UTC()
+
+	for _, aggregate := range []bool{false, true} {
+		t.Run(fmt.Sprintf("aggregate=%t", aggregate), func(t *testing.T) {
+			ctx, _, environment := Setup(t)
+			encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+
+			catchUpHooks, plainHooks := &recordingCatchUpEpochHooks{}, &recordingEpochHooks{}
+			epochsKeeper := epochskeeper.NewKeeper(environment, encCfg.Codec).SetAggregateMissedEpochs(aggregate)
+			epochsKeeper.SetHooks(types.NewMultiEpochHooks(catchUpHooks, plainHooks))
+
+			ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: block1Time})
+			require.NoError(t, epochsKeeper.AddEpochInfo(ctx, types.NewGenesisEpochInfo("catchup", time.Hour)))
+			epochInfo, err := epochsKeeper.EpochInfo.Get(ctx, "catchup")
+			require.NoError(t, err)
+			epochInfo.StartTime = block1Time
+			require.NoError(t, epochsKeeper.EpochInfo.Set(ctx, "catchup", epochInfo))
+			require.NoError(t, epochsKeeper.BeginBlocker(ctx))
+
+			// the chain halts during epochs 2 and 3, and restarts during epoch 4
+			ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: block1Time.Add(4 * time.Hour)}).WithEventManager(sdk.NewEventManager())
+			require.NoError(t, epochsKeeper.BeginBlocker(ctx))
+
+			epochInfo, err = epochsKeeper.EpochInfo.Get(ctx, "catchup")
+			require.NoError(t, err)
+			require.Equal(t, int64(4), epochInfo.CurrentEpoch)
+			require.Equal(t, block1Time.Add(3*time.Hour), epochInfo.CurrentEpochStartTime)
+			require.Equal(t, int64(2), epochInfo.CurrentEpochStartHeight)
+
+			expCatchUpCalls := []string{"start 1", "end 1", "missed 2-2", "missed 3-3", "start 4"}
+			if aggregate {
+				expCatchUpCalls = []string{"start 1", "end 1", "missed 2-3", "start 4"}
+			}
+			require.Equal(t, expCatchUpCalls, catchUpHooks.calls)
+
+			// hooks not implementing the catch-up hooks get all missed epochs
+			require.Equal(t, []string{"start 1", "end 1", "start 2", "end 2", "start 3", "end 3", "start 4"}, plainHooks.calls)
+
+			missedEvents := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == "cosmos.epochs.v1beta1.EventEpochsMissed" {
+					missedEvents++
+				}
+			}
+			require.Equal(t, 1, missedEvents)
+		})
+	}
+}
@@ -25,3 +25,8 @@ func (k Keeper) AfterEpochEnd(ctx context.Context, identifier string, epochNumbe
 func (k Keeper) BeforeEpochStart(ctx context.Context, identifier string, epochNumber int64) error {
 	return k.Hooks().BeforeEpochStart(ctx, identifier, epochNumber)
 }
+
+// AfterMissedEpochs gets called for the epochs which started and ended while the chain was halted.
+func (k Keeper) AfterMissedEpochs(ctx context.Context, identifier string, firstEpochNumber, lastEpochNumber int64) error {
+	return types.NewMultiEpochHooks(k.Hooks()).AfterMissedEpochs(ctx, identifier, firstEpochNumber, lastEpochNumber)
+}
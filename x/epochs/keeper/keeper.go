@@ -14,6 +14,9 @@ type Keeper struct {
 	cdc   codec.BinaryCodec
 	hooks types.EpochHooks
 
+	// aggregateMissedEpochs defines if missed epochs are passed to the hooks in a single call.
+	aggregateMissedEpochs bool
+
 	Schema    collections.Schema
 	EpochInfo collections.Map[string, types.EpochInfo]
 }
@@ -45,3 +48,11 @@ func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 
 	return k
 }
+
+// SetAggregateMissedEpochs sets if the epochs missed while the chain was halted are
+// passed to the catch-up hooks in a single call, instead of once per missed epoch.
+func (k *Keeper) SetAggregateMissedEpochs(aggregate bool) *Keeper {
+	k.aggregateMissedEpochs = aggregate
+
+	return k
+}
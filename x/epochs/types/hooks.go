@@ -12,7 +12,21 @@ type EpochHooks interface {
 	BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error
 }
 
-var _ EpochHooks = MultiEpochHooks{}
+// EpochCatchUpHooks is optionally implemented by EpochHooks to be told apart the
+// epochs missed while the chain was halted, which started and ended between two
+// blocks. The hooks of missed epochs are processed in the block following the
+// halt, by AfterMissedEpochs instead of BeforeEpochStart and AfterEpochEnd.
+type EpochCatchUpHooks interface {
+	// AfterMissedEpochs is called for the missed epochs from firstEpochNumber to
+	// lastEpochNumber included, once per missed epoch, or once for all of them if
+	// the epochs module aggregates missed epochs.
+	AfterMissedEpochs(ctx context.Context, epochIdentifier string, firstEpochNumber, lastEpochNumber int64) error
+}
+
+var (
+	_ EpochHooks        = MultiEpochHooks{}
+	_ EpochCatchUpHooks = MultiEpochHooks{}
+)
 
 // combine multiple gamm hooks, all hook functions are run in array sequence.
 type MultiEpochHooks []EpochHooks
@@ -39,6 +53,24 @@ func (h MultiEpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier s
 	return errs
 }
 
+// AfterMissedEpochs is called for the epochs missed while the chain was halted. Hooks not implementing
+// EpochCatchUpHooks get BeforeEpochStart and AfterEpochEnd called for each missed epoch instead.
+func (h MultiEpochHooks) AfterMissedEpochs(ctx context.Context, epochIdentifier string, firstEpochNumber, lastEpochNumber int64) error {
+	var errs error
+	for i := range h {
+		if catchUpHooks, ok := h[i].(EpochCatchUpHooks); ok {
+			errs = errors.Join(errs, catchUpHooks.AfterMissedEpochs(ctx, epochIdentifier, firstEpochNumber, lastEpochNumber))
+			continue
+		}
+
+		for epochNumber := firstEpochNumber; epochNumber <= lastEpochNumber; epochNumber++ {
+			errs = errors.Join(errs, h[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber))
+			errs = errors.Join(errs, h[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber))
+		}
+	}
+	return errs
+}
+
 // EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
 type EpochHooksWrapper struct{ EpochHooks }
 
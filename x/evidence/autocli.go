@@ -28,6 +28,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence list --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current evidence retention parameters",
+				},
+				{
+					RpcMethod: "ArchivedCount",
+					Use:       "archived-count",
+					Short:     "Query the number of expired evidence that was archived or pruned",
+				},
 			},
 		},
 	}
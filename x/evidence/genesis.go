@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
@@ -13,7 +14,7 @@ import (
 )
 
 // InitGenesis initializes the evidence module's state from a provided genesis
-// state.
+// state. The expiry of the evidence is scheduled from the genesis time.
 func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) error {
 	if err := gs.Validate(); err != nil {
 		return fmt.Errorf("failed to validate %s genesis state: %w", types.ModuleName, err)
@@ -28,11 +29,31 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) e
 			return fmt.Errorf("evidence with hash %s already exists", evi.Hash())
 		}
 
-		if err := k.Evidences.Set(ctx, evi.Hash(), evi); err != nil {
+		if err := k.SetEvidence(ctx, evi); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, e := range gs.ArchivedEvidence {
+		evi, ok := e.GetCachedValue().(exported.Evidence)
+		if !ok {
+			return errors.New("expected evidence")
+		}
+
+		if err := k.ArchivedEvidences.Set(ctx, evi.Hash(), evi); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ArchivedCount.Set(ctx, uint64(len(gs.ArchivedEvidence))); err != nil {
+		return err
+	}
+
+	return k.PrunedCount.Set(ctx, gs.PrunedCount)
 }
 
 // ExportGenesis returns the evidence module's exported genesis.
@@ -49,5 +70,28 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) (*types.GenesisState, e
 	if err != nil {
 		return nil, err
 	}
+
+	gs.Params, err = k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ArchivedEvidences.Walk(ctx, nil, func(_ []byte, value exported.Evidence) (stop bool, err error) {
+		anyEvi, err := codectypes.NewAnyWithValue(value)
+		if err != nil {
+			return false, err
+		}
+		gs.ArchivedEvidence = append(gs.ArchivedEvidence, anyEvi)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gs.PrunedCount, err = k.PrunedCount.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
 	return gs, nil
 }
@@ -12,6 +12,7 @@ import (
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
 // misbehavior submitted by CometBFT. Currently, only equivocation is handled.
+// It then prunes or archives the expired evidence.
 func (k Keeper) BeginBlocker(ctx context.Context, cometService comet.Service) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyBeginBlocker)
@@ -33,5 +34,6 @@ func (k Keeper) BeginBlocker(ctx context.Context, cometService comet.Service) er
 			k.Logger.Error(fmt.Sprintf("ignored unknown evidence type: %x", evidence.Type))
 		}
 	}
-	return nil
+
+	return k.PruneExpiredEvidence(ctx)
 }
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+)
+
+// SetEvidence persists the handled evidence and schedules its expiry from the current block time.
+func (k Keeper) SetEvidence(ctx context.Context, evidence exported.Evidence) error {
+	if err := k.Evidences.Set(ctx, evidence.Hash(), evidence); err != nil {
+		return err
+	}
+
+	return k.ExpiryQueue.Set(ctx, collections.Join(k.HeaderService.HeaderInfo(ctx).Time, evidence.Hash()))
+}
+
+// PruneExpiredEvidence removes the evidence handled before the retention period of the params.
+// Expired evidence is moved to the archive index if the params enable archival, and pruned otherwise.
+func (k Keeper) PruneExpiredEvidence(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.RetentionPeriod == 0 {
+		return nil
+	}
+
+	cutoff := k.HeaderService.HeaderInfo(ctx).Time.Add(-params.RetentionPeriod)
+	var expired []collections.Pair[time.Time, []byte]
+	err = k.ExpiryQueue.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, []byte](cutoff), func(key collections.Pair[time.Time, []byte]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.ExpiryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		hash := key.K2()
+		evidence, err := k.Evidences.Get(ctx, hash)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if err := k.Evidences.Remove(ctx, hash); err != nil {
+			return err
+		}
+
+		eventType := types.EventTypePruneEvidence
+		counter := k.PrunedCount
+		if params.Archive {
+			if err := k.ArchivedEvidences.Set(ctx, hash, evidence); err != nil {
+				return err
+			}
+			eventType = types.EventTypeArchiveEvidence
+			counter = k.ArchivedCount
+		}
+
+		count, err := counter.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := counter.Set(ctx, count+1); err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			eventType,
+			event.NewAttribute(types.AttributeKeyEvidenceHash, strings.ToUpper(hex.EncodeToString(hash))),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
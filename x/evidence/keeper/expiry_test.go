@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/keeper"
+	"cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestPruneExpiredEvidence() {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	evidence := suite.populateEvidence(ctx, 2)
+
+	// the evidence is kept forever by default
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: start.Add(24 * time.Hour)})
+	suite.Require().NoError(suite.evidenceKeeper.PruneExpiredEvidence(ctx))
+	suite.Require().Len(suite.allEvidence(ctx), 2)
+
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(ctx, types.NewParams(time.Hour, false)))
+	late := suite.populateEvidence(ctx, 1)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: start.Add(24*time.Hour + time.Minute)}).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.evidenceKeeper.PruneExpiredEvidence(ctx))
+	suite.Require().Equal(late, suite.allEvidence(ctx))
+	suite.Require().Len(ctx.EventManager().Events(), 2)
+	suite.Require().Equal(types.EventTypePruneEvidence, ctx.EventManager().Events()[0].Type)
+
+	// pruned evidence is not archived
+	for _, e := range evidence {
+		has, err := suite.evidenceKeeper.ArchivedEvidences.Has(ctx, e.Hash())
+		suite.Require().NoError(err)
+		suite.Require().False(has)
+	}
+
+	// expired evidence is archived once enabled
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(ctx, types.NewParams(time.Hour, true)))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 4, Time: start.Add(26 * time.Hour)}).WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.evidenceKeeper.PruneExpiredEvidence(ctx))
+	suite.Require().Empty(suite.allEvidence(ctx))
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+	suite.Require().Equal(types.EventTypeArchiveEvidence, ctx.EventManager().Events()[0].Type)
+
+	archived, err := suite.evidenceKeeper.ArchivedEvidences.Get(ctx, late[0].Hash())
+	suite.Require().NoError(err)
+	suite.Require().Equal(late[0], archived)
+
+	// archived evidence cannot be submitted again
+	suite.Require().ErrorIs(suite.evidenceKeeper.SubmitEvidence(ctx, late[0]), types.ErrEvidenceExists)
+
+	res, err := suite.queryClient.ArchivedCount(ctx, &types.QueryArchivedCountRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryArchivedCountResponse{Archived: 1, Pruned: 2}, res)
+
+	iter, err := suite.evidenceKeeper.ExpiryQueue.Iterate(ctx, nil)
+	suite.Require().NoError(err)
+	defer iter.Close()
+	suite.Require().False(iter.Valid())
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 1, Time: start})
+	evidence := suite.populateEvidence(ctx, 2)
+
+	// the evidence of version 1 has no params nor expiry
+	suite.Require().NoError(suite.evidenceKeeper.Params.Remove(ctx))
+	suite.Require().NoError(suite.evidenceKeeper.ExpiryQueue.Clear(ctx, nil))
+
+	upgradeTime := start.Add(24 * time.Hour)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2, Time: upgradeTime})
+	suite.Require().NoError(keeper.NewMigrator(suite.evidenceKeeper).Migrate1to2(ctx))
+
+	params, err := suite.evidenceKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), params)
+
+	// the expiry of the existing evidence is scheduled from the upgrade
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(ctx, types.NewParams(time.Hour, false)))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: upgradeTime.Add(time.Minute)})
+	suite.Require().NoError(suite.evidenceKeeper.PruneExpiredEvidence(ctx))
+	suite.Require().Len(suite.allEvidence(ctx), len(evidence))
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 4, Time: upgradeTime.Add(time.Hour)})
+	suite.Require().NoError(suite.evidenceKeeper.PruneExpiredEvidence(ctx))
+	suite.Require().Empty(suite.allEvidence(ctx))
+}
+
+func (suite *KeeperTestSuite) allEvidence(ctx sdk.Context) []exported.Evidence {
+	var evidence []exported.Evidence
+	suite.Require().NoError(suite.evidenceKeeper.Evidences.Walk(ctx, nil, func(_ []byte, e exported.Evidence) (bool, error) {
+		evidence = append(evidence, e)
+		return false, nil
+	}))
+
+	return evidence
+}
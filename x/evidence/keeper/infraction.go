@@ -119,8 +119,9 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// Slash validator. The `power` is the int64 power of the validator as provided
 	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
-	// to slash unbonding and rebonding delegations.
-	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+	// to slash unbonding and rebonding delegations, it depends on the power of the
+	// validator if the slashing module scales it with the share of the stake.
+	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSignForPower(ctx, evidence.GetValidatorPower())
 	if err != nil {
 		return err
 	}
@@ -154,5 +155,5 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	if err != nil {
 		return err
 	}
-	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+	return k.SetEvidence(ctx, evidence)
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -15,6 +16,7 @@ import (
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the evidence module's keeper. The keeper is responsible for
@@ -31,15 +33,30 @@ type Keeper struct {
 	addressCodec          address.Codec
 	consensusAddressCodec address.Codec
 
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	Schema collections.Schema
 	// Evidences key: evidence hash bytes | value: Evidence
 	Evidences collections.Map[[]byte, exported.Evidence]
+	// Params key: ParamsKey | value: Params
+	Params collections.Item[types.Params]
+	// ExpiryQueue key: handled time + evidence hash bytes
+	ExpiryQueue collections.KeySet[collections.Pair[time.Time, []byte]]
+	// ArchivedEvidences key: evidence hash bytes | value: Evidence
+	ArchivedEvidences collections.Map[[]byte, exported.Evidence]
+	// ArchivedCount key: ArchivedCountKey | value: number of archived evidence
+	ArchivedCount collections.Item[uint64]
+	// PrunedCount key: PrunedCountKey | value: number of pruned evidence
+	PrunedCount collections.Item[uint64]
 }
 
 // NewKeeper creates a new Keeper object.
 func NewKeeper(
 	cdc codec.BinaryCodec, env appmodule.Environment, stakingKeeper types.StakingKeeper,
 	slashingKeeper types.SlashingKeeper, ck types.ConsensusKeeper, ac, consensusAddressCodec address.Codec,
+	authority string,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -50,7 +67,13 @@ func NewKeeper(
 		consensusKeeper:       ck,
 		addressCodec:          ac,
 		consensusAddressCodec: consensusAddressCodec,
+		authority:             authority,
 		Evidences:             collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ExpiryQueue:           collections.NewKeySet(sb, types.KeyPrefixExpiryQueue, "expiry_queue", collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey)),
+		ArchivedEvidences:     collections.NewMap(sb, types.KeyPrefixArchivedEvidence, "archived_evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		ArchivedCount:         collections.NewItem(sb, types.ArchivedCountKey, "archived_count", collections.Uint64Value),
+		PrunedCount:           collections.NewItem(sb, types.PrunedCountKey, "pruned_count", collections.Uint64Value),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -60,6 +83,11 @@ func NewKeeper(
 	return k
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetRouter sets the Evidence Handler router for the x/evidence module. Note,
 // we allow the ability to set the router after the Keeper is constructed as a
 // given Handler may need access the Keeper before being constructed. The router
@@ -96,6 +124,11 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 	if _, err := k.Evidences.Get(ctx, evidence.Hash()); err == nil {
 		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
 	}
+	if archived, err := k.ArchivedEvidences.Has(ctx, evidence.Hash()); err != nil {
+		return err
+	} else if archived {
+		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
+	}
 	if !k.router.HasRoute(evidence.Route()) {
 		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
 	}
@@ -112,5 +145,5 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 		return err
 	}
 
-	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+	return k.SetEvidence(ctx, evidence)
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
@@ -78,13 +79,15 @@ type KeeperTestSuite struct {
 	addressCodec     coreaddress.Codec
 	consAddressCodec coreaddress.ConsensusAddressCodec
 
-	evidenceKeeper keeper.Keeper
-	accountKeeper  *evidencetestutil.MockAccountKeeper
-	slashingKeeper *evidencetestutil.MockSlashingKeeper
-	stakingKeeper  *evidencetestutil.MockStakingKeeper
-	queryClient    types.QueryClient
-	encCfg         moduletestutil.TestEncodingConfig
-	msgServer      types.MsgServer
+	evidenceKeeper  keeper.Keeper
+	accountKeeper   *evidencetestutil.MockAccountKeeper
+	slashingKeeper  *evidencetestutil.MockSlashingKeeper
+	stakingKeeper   *evidencetestutil.MockStakingKeeper
+	consensusKeeper *evidencetestutil.MockConsensusKeeper
+	queryClient     types.QueryClient
+	encCfg          moduletestutil.TestEncodingConfig
+	msgServer       types.MsgServer
+	authority       string
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -104,6 +107,10 @@ func (suite *KeeperTestSuite) SetupTest() {
 	accountKeeper := evidencetestutil.NewMockAccountKeeper(ctrl)
 	ck := evidencetestutil.NewMockConsensusKeeper(ctrl)
 
+	authority, err := suite.addressCodec.BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
+	suite.Require().NoError(err)
+	suite.authority = authority
+
 	evidenceKeeper := keeper.NewKeeper(
 		encCfg.Codec,
 		env,
@@ -112,10 +119,12 @@ func (suite *KeeperTestSuite) SetupTest() {
 		ck,
 		address.NewBech32Codec("cosmos"),
 		address.NewBech32Codec("cosmosvalcons"),
+		authority,
 	)
 
 	suite.stakingKeeper = stakingKeeper
 	suite.slashingKeeper = slashingKeeper
+	suite.consensusKeeper = ck
 
 	router := types.NewRouter()
 	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
@@ -131,6 +140,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.queryClient = types.NewQueryClient(queryHelper)
 	suite.evidenceKeeper = *evidenceKeeper
 	suite.msgServer = keeper.NewMsgServerImpl(suite.evidenceKeeper)
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(suite.ctx, types.DefaultParams()))
 }
 
 func (suite *KeeperTestSuite) populateEvidence(ctx sdk.Context, numEvidence int) []exported.Evidence {
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/evidence module state from the consensus version 1 to version 2.
+// Specifically, it sets the default params, which keep the evidence forever, and schedules the
+// expiry of the existing evidence from the upgrade block time.
+func (m Migrator) Migrate1to2(ctx context.Context) error {
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	var evidences []exported.Evidence
+	if err := m.keeper.Evidences.Walk(ctx, nil, func(_ []byte, evidence exported.Evidence) (bool, error) {
+		evidences = append(evidences, evidence)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, evidence := range evidences {
+		if err := m.keeper.SetEvidence(ctx, evidence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -44,3 +44,32 @@ func (ms msgServer) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvid
 		Hash: evidence.Hash(),
 	}, nil
 }
+
+// UpdateParams implements MsgServer.UpdateParams method.
+// It defines a method to update the x/evidence module parameters.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	// expired evidence must be too old to be submitted again
+	if msg.Params.RetentionPeriod > 0 {
+		_, maxAgeDuration, _, err := ms.consensusKeeper.EvidenceParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if msg.Params.RetentionPeriod < maxAgeDuration {
+			return nil, errors.Wrapf(types.ErrInvalidParams, "retention period %s is shorter than the evidence max age %s", msg.Params.RetentionPeriod, maxAgeDuration)
+		}
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
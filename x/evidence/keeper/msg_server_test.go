@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	"go.uber.org/mock/gomock"
+
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -81,3 +83,52 @@ func (s *KeeperTestSuite) TestSubmitEvidence() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	s.consensusKeeper.EXPECT().EvidenceParams(gomock.Any()).Return(int64(100000), 48*time.Hour, uint64(1048576), nil).AnyTimes()
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			req:       &types.MsgUpdateParams{Authority: "invalid", Params: types.DefaultParams()},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "negative retention period",
+			req:       &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(-time.Hour, false)},
+			expErrMsg: "retention period cannot be negative",
+		},
+		{
+			name:      "retention period shorter than the evidence max age",
+			req:       &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(24*time.Hour, false)},
+			expErrMsg: "retention period 24h0m0s is shorter than the evidence max age 48h0m0s",
+		},
+		{
+			name: "valid params",
+			req:  &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams(72*time.Hour, true)},
+		},
+		{
+			name: "evidence kept forever",
+			req:  &types.MsgUpdateParams{Authority: s.authority, Params: types.DefaultParams()},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			params, err := s.evidenceKeeper.Params.Get(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Params, params)
+		})
+	}
+}
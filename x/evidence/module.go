@@ -33,9 +33,10 @@ var (
 	_ appmodule.HasBeginBlocker       = AppModule{}
 	_ appmodule.HasRegisterInterfaces = AppModule{}
 	_ appmodule.HasGenesis            = AppModule{}
+	_ appmodule.HasMigrations         = AppModule{}
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 // AppModule implements the AppModule interface for the evidence module.
 type AppModule struct {
@@ -98,6 +99,17 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	return nil
 }
 
+// RegisterMigrations registers evidence module's migrations.
+func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
+	m := keeper.NewMigrator(am.keeper)
+
+	if err := mr.Register(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %w", types.ModuleName, err)
+	}
+
+	return nil
+}
+
 // DefaultGenesis returns the evidence module's default genesis state.
 func (am AppModule) DefaultGenesis() json.RawMessage {
 	return am.cdc.MustMarshalJSON(types.DefaultGenesisState())
@@ -169,19 +169,19 @@ func (mr *MockSlashingKeeperMockRecorder) Slash(arg0, arg1, arg2, arg3, arg4 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockSlashingKeeper)(nil).Slash), arg0, arg1, arg2, arg3, arg4)
 }
 
-// SlashFractionDoubleSign mocks base method.
-func (m *MockSlashingKeeper) SlashFractionDoubleSign(arg0 context.Context) (math.LegacyDec, error) {
+// SlashFractionDoubleSignForPower mocks base method.
+func (m *MockSlashingKeeper) SlashFractionDoubleSignForPower(arg0 context.Context, arg1 int64) (math.LegacyDec, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SlashFractionDoubleSign", arg0)
+	ret := m.ctrl.Call(m, "SlashFractionDoubleSignForPower", arg0, arg1)
 	ret0, _ := ret[0].(math.LegacyDec)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SlashFractionDoubleSign indicates an expected call of SlashFractionDoubleSign.
-func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSign(arg0 any) *gomock.Call {
+// SlashFractionDoubleSignForPower indicates an expected call of SlashFractionDoubleSignForPower.
+func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSignForPower(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSign", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSign), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSignForPower", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSignForPower), arg0, arg1)
 }
 
 // SlashWithInfractionReason mocks base method.
@@ -15,11 +15,16 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterInterface((*exported.Evidence)(nil), nil)
 	legacy.RegisterAminoMsg(registrar, &MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence")
 	registrar.RegisterConcrete(&Equivocation{}, "cosmos-sdk/Equivocation")
+	registrar.RegisterConcrete(Params{}, "cosmos-sdk/x/evidence/Params")
+	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/evidence/MsgUpdateParams")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
 func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
-	registrar.RegisterImplementations((*coretransaction.Msg)(nil), &MsgSubmitEvidence{})
+	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
+		&MsgSubmitEvidence{},
+		&MsgUpdateParams{},
+	)
 	registrar.RegisterInterface(
 		"cosmos.evidence.v1beta1.Evidence",
 		(*exported.Evidence)(nil),
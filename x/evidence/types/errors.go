@@ -7,4 +7,6 @@ var (
 	ErrNoEvidenceHandlerExists = errors.Register(ModuleName, 2, "unregistered handler for evidence type")
 	ErrInvalidEvidence         = errors.Register(ModuleName, 3, "invalid evidence")
 	ErrEvidenceExists          = errors.Register(ModuleName, 5, "evidence already exists")
+	ErrInvalidSigner           = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	ErrInvalidParams           = errors.Register(ModuleName, 7, "invalid params")
 )
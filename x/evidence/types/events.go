@@ -2,7 +2,9 @@ package types
 
 // evidence module events
 const (
-	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeSubmitEvidence  = "submit_evidence"
+	EventTypeArchiveEvidence = "archive_evidence"
+	EventTypePruneEvidence   = "prune_evidence"
 
 	AttributeKeyEvidenceHash = "evidence_hash"
 )
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -75,8 +76,67 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// Params defines the retention policy of the handled evidence.
+type Params struct {
+	// retention_period is the duration after which handled evidence expires. Expired evidence is pruned, or
+	// archived if archive is set. A zero retention period keeps the evidence forever.
+	// It must be at least the maximum age of evidence of the consensus params, so that expired evidence can no
+	// longer be submitted.
+	RetentionPeriod time.Duration `protobuf:"bytes,1,opt,name=retention_period,json=retentionPeriod,proto3,stdduration" json:"retention_period"`
+	// archive defines whether expired evidence is moved to the archive index instead of being pruned.
+	Archive bool `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetArchive() bool {
+	if m != nil {
+		return m.Archive
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
 }
 
 func init() {
@@ -84,31 +144,64 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xd1, 0x12, 0xe0, 0x0a, 0xa2, 0xb1, 0x22, 0x70, 0x23, 0x38, 0x47, 0x15, 0x42, 0x51,
+	0xa5, 0xdc, 0xb5, 0x41, 0x62, 0x28, 0x62, 0x20, 0xa2, 0x7b, 0x95, 0x32, 0xb1, 0x44, 0x17, 0xfb,
+	0x70, 0x4e, 0xc5, 0x3e, 0x73, 0x77, 0x36, 0xf0, 0x0f, 0x10, 0x53, 0x47, 0xc6, 0x8e, 0xdd, 0xe8,
+	0xd0, 0x1f, 0xd1, 0xb1, 0xea, 0xc4, 0x04, 0xc8, 0x19, 0xda, 0x9f, 0x81, 0x7c, 0x77, 0x31, 0x11,
+	0x2c, 0xd6, 0xbd, 0xef, 0x7d, 0xef, 0x7b, 0xdf, 0xf7, 0x64, 0xf8, 0x34, 0x12, 0x2a, 0x15, 0x8a,
+	0xb0, 0x92, 0xc7, 0x2c, 0x8b, 0x18, 0x29, 0x77, 0xa6, 0x4c, 0xd3, 0x9d, 0x06, 0xc0, 0xb9, 0x14,
+	0x5a, 0xf8, 0x0f, 0x2d, 0x0f, 0x37, 0xb0, 0xe3, 0x75, 0xdb, 0x34, 0xe5, 0x99, 0x20, 0xe6, 0x6b,
+	0xb9, 0xdd, 0x4e, 0x22, 0x12, 0x61, 0x9e, 0xa4, 0x7e, 0x39, 0x14, 0x25, 0x42, 0x24, 0xef, 0x19,
+	0x31, 0xd5, 0xb4, 0x78, 0x47, 0xe2, 0x42, 0x52, 0xcd, 0x45, 0xe6, 0xfa, 0xe1, 0xbf, 0x7d, 0xcd,
+	0x53, 0xa6, 0x34, 0x4d, 0x73, 0x47, 0xd8, 0xb0, 0x16, 0x26, 0x56, 0xd9, 0xf9, 0x31, 0xc5, 0xe6,
+	0x35, 0x80, 0x77, 0xf7, 0x3e, 0x14, 0xbc, 0x14, 0x91, 0x91, 0xf4, 0x1f, 0xc0, 0xd6, 0x8c, 0xf1,
+	0x64, 0xa6, 0x03, 0xd0, 0x03, 0xfd, 0x95, 0xb1, 0xab, 0xfc, 0x97, 0x70, 0xb5, 0x96, 0x0d, 0x6e,
+	0xf4, 0x40, 0x7f, 0x6d, 0xd8, 0xc5, 0x76, 0x27, 0x5e, 0xec, 0xc4, 0x6f, 0x16, 0x3b, 0x47, 0xf7,
+	0xce, 0x7f, 0x86, 0xde, 0xd1, 0xaf, 0x10, 0x9c, 0x5c, 0x9d, 0x6e, 0x81, 0xb1, 0x19, 0xf3, 0x3b,
+	0xf0, 0x66, 0x2e, 0x3e, 0x32, 0x19, 0xac, 0x18, 0x55, 0x5b, 0xf8, 0x7b, 0xb0, 0x1d, 0x89, 0x4c,
+	0xb1, 0x4c, 0x15, 0x6a, 0x42, 0xe3, 0x58, 0x32, 0xa5, 0x82, 0xd5, 0x1e, 0xe8, 0xdf, 0x19, 0x05,
+	0x97, 0x67, 0x83, 0x8e, 0xb3, 0xfa, 0xca, 0x76, 0x0e, 0xb4, 0xe4, 0x59, 0x32, 0x5e, 0x6f, 0x46,
+	0x1c, 0xbe, 0xfb, 0xe4, 0xcb, 0x71, 0xe8, 0x5d, 0x1f, 0x87, 0xde, 0xd7, 0xab, 0xd3, 0x2d, 0x77,
+	0xef, 0x81, 0x8a, 0x0f, 0xc9, 0x72, 0xb2, 0xcd, 0xef, 0x00, 0xb6, 0xf6, 0xa9, 0xa4, 0xa9, 0xf2,
+	0x0f, 0xe0, 0xba, 0x64, 0x9a, 0x65, 0x35, 0x3e, 0xc9, 0x99, 0xe4, 0x22, 0x36, 0x71, 0xd7, 0x86,
+	0x1b, 0xff, 0x05, 0x7b, 0xed, 0x8e, 0x6d, 0x73, 0x7d, 0x6b, 0x72, 0xdd, 0x6f, 0x14, 0xf6, 0x8d,
+	0x80, 0x1f, 0xc0, 0x5b, 0x54, 0x46, 0x33, 0x5e, 0xda, 0x23, 0xdd, 0x1e, 0x2f, 0xca, 0xdd, 0xe7,
+	0x97, 0x67, 0x83, 0xf6, 0xa7, 0xe6, 0xc7, 0xe8, 0x95, 0xdb, 0x78, 0x88, 0xb7, 0x6b, 0xb3, 0x8f,
+	0x96, 0xcc, 0xfe, 0x25, 0x10, 0x6b, 0x73, 0xf4, 0xe2, 0xa4, 0x42, 0xe0, 0xbc, 0x42, 0xe0, 0xa2,
+	0x42, 0xe0, 0x77, 0x85, 0xc0, 0xd1, 0x1c, 0x79, 0x17, 0x73, 0xe4, 0xfd, 0x98, 0x23, 0xef, 0xed,
+	0x63, 0x3b, 0xab, 0xe2, 0x43, 0xcc, 0xc5, 0xf2, 0xb4, 0xfe, 0x9c, 0x33, 0x35, 0x6d, 0x99, 0x04,
+	0xcf, 0xfe, 0x0c, 0x00, 0x2e, 0x44, 0xf9, 0x64, 0xa8, 0x02, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RetentionPeriod != that1.RetentionPeriod {
+		return false
+	}
+	if this.Archive != that1.Archive {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +250,47 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Archive {
+		i--
+		if m.Archive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetentionPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +323,20 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetentionPeriod)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Archive {
+		n += 2
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +496,109 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
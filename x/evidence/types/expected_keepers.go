@@ -26,7 +26,7 @@ type SlashingKeeper interface {
 	Tombstone(context.Context, sdk.ConsAddress) error
 	Slash(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64) error
 	SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, st.Infraction) error
-	SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
+	SlashFractionDoubleSignForPower(context.Context, int64) (math.LegacyDec, error)
 	Jail(context.Context, sdk.ConsAddress) error
 	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/cosmos/gogoproto/proto"
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
@@ -30,20 +31,27 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 	}
 	return &GenesisState{
 		Evidence: evidence,
+		Params:   DefaultParams(),
 	}
 }
 
 // DefaultGenesisState returns the evidence module's default genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Evidence: []*types.Any{},
+		Evidence:         []*types.Any{},
+		Params:           DefaultParams(),
+		ArchivedEvidence: []*types.Any{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	for _, e := range gs.Evidence {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, e := range slices.Concat(gs.Evidence, gs.ArchivedEvidence) {
 		evi, ok := e.GetCachedValue().(exported.Evidence)
 		if !ok {
 			return errors.New("expected evidence")
@@ -58,7 +66,7 @@ func (gs GenesisState) Validate() error {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (gs GenesisState) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
-	for _, any := range gs.Evidence {
+	for _, any := range slices.Concat(gs.Evidence, gs.ArchivedEvidence) {
 		var evi exported.Evidence
 		err := unpacker.UnpackAny(any, &evi)
 		if err != nil {
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -27,6 +30,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// evidence defines all the evidence at genesis.
 	Evidence []*any.Any `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// archived_evidence defines the expired evidence of the archive index.
+	ArchivedEvidence []*any.Any `protobuf:"bytes,3,rep,name=archived_evidence,json=archivedEvidence,proto3" json:"archived_evidence,omitempty"`
+	// pruned_count is the number of expired evidence pruned from the state.
+	PrunedCount uint64 `protobuf:"varint,4,opt,name=pruned_count,json=prunedCount,proto3" json:"pruned_count,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +78,27 @@ func (m *GenesisState) GetEvidence() []*any.Any {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetArchivedEvidence() []*any.Any {
+	if m != nil {
+		return m.ArchivedEvidence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrunedCount() uint64 {
+	if m != nil {
+		return m.PrunedCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evidence.v1beta1.GenesisState")
 }
@@ -78,19 +108,29 @@ func init() {
 }
 
 var fileDescriptor_c610c52c26e0e202 = []byte{
-	// 184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x92, 0x4c, 0xcf, 0xcf,
-	0x4f, 0xcf, 0x49, 0xd5, 0x07, 0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x51,
-	0x72, 0xe0, 0xe2, 0x71, 0x87, 0x18, 0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc0, 0xc5, 0x01,
-	0xd3, 0x2e, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa2, 0x07, 0xd1, 0xad, 0x07, 0xd3, 0xad,
-	0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5, 0x64, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xb2, 0x10, 0xf7, 0x14, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb, 0x57, 0x20, 0x9c,
-	0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0x25, 0x11, 0x0c, 0xde, 0x00, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x8f, 0x31, 0x4b, 0x03, 0x31,
+	0x14, 0xc7, 0x2f, 0x6d, 0x29, 0x92, 0x76, 0xb0, 0x47, 0xc5, 0xb6, 0x60, 0x7a, 0x08, 0x4a, 0x11,
+	0x4c, 0xda, 0x3a, 0xe8, 0x6a, 0x45, 0x5c, 0xa5, 0x0e, 0x8a, 0x4b, 0x49, 0xef, 0xe2, 0x79, 0x68,
+	0x93, 0xe3, 0x92, 0x1e, 0xf6, 0x5b, 0xf8, 0x31, 0x5c, 0x04, 0x07, 0x3f, 0x44, 0xc7, 0x8e, 0xe2,
+	0x20, 0xd2, 0x0e, 0x7e, 0x0d, 0x69, 0x72, 0x77, 0x2e, 0xde, 0x12, 0x1e, 0x2f, 0xbf, 0xf7, 0x7f,
+	0xbf, 0x07, 0xf7, 0x5c, 0x21, 0x27, 0x42, 0x12, 0x16, 0x07, 0x1e, 0xe3, 0x2e, 0x23, 0x71, 0x6f,
+	0xcc, 0x14, 0xed, 0x11, 0x9f, 0x71, 0x26, 0x03, 0x89, 0xc3, 0x48, 0x28, 0x61, 0x6f, 0x1b, 0x0c,
+	0xa7, 0x18, 0x4e, 0xb0, 0x56, 0xd3, 0x17, 0xc2, 0x7f, 0x64, 0x44, 0x63, 0xe3, 0xe9, 0x1d, 0xa1,
+	0x7c, 0x66, 0x66, 0x5a, 0x75, 0x5f, 0xf8, 0x42, 0x97, 0x64, 0x5d, 0x25, 0xdd, 0x1a, 0x9d, 0x04,
+	0x5c, 0x10, 0xfd, 0x26, 0xad, 0xa6, 0x09, 0x1f, 0x19, 0x36, 0xd9, 0x64, 0xbe, 0xf6, 0xf3, 0xf4,
+	0x32, 0x11, 0xcd, 0xed, 0xbe, 0x16, 0x60, 0xf5, 0xc2, 0x18, 0x5f, 0x29, 0xaa, 0x98, 0xdd, 0x85,
+	0x1b, 0x29, 0xd2, 0x00, 0x4e, 0xb1, 0x53, 0xe9, 0xd7, 0xb1, 0x51, 0xc5, 0xa9, 0x2a, 0x3e, 0xe5,
+	0xb3, 0x61, 0x46, 0xd9, 0xd7, 0xb0, 0x1c, 0xd2, 0x88, 0x4e, 0x64, 0xa3, 0xe0, 0x80, 0x4e, 0xa5,
+	0xdf, 0xc6, 0x39, 0x37, 0xe3, 0x4b, 0x8d, 0x0d, 0xd0, 0xfc, 0xab, 0x6d, 0x7d, 0xbe, 0x1f, 0xd6,
+	0x9e, 0x32, 0x1b, 0x27, 0xee, 0xe2, 0x3e, 0xee, 0xbe, 0xfc, 0xbc, 0x1d, 0x80, 0x61, 0x12, 0x67,
+	0xdf, 0xc0, 0x1a, 0x8d, 0xdc, 0xfb, 0x20, 0x66, 0xde, 0x28, 0x73, 0x2a, 0xe6, 0x3b, 0x0d, 0xb6,
+	0xfe, 0x0d, 0x1d, 0x6e, 0xa6, 0x29, 0xe7, 0xa9, 0xf2, 0x09, 0xac, 0x86, 0xd1, 0x94, 0x33, 0x6f,
+	0xe4, 0x8a, 0x29, 0x57, 0x8d, 0x92, 0x03, 0x3a, 0xa5, 0xbc, 0xf1, 0x8a, 0x41, 0xcf, 0xd6, 0xe4,
+	0xe0, 0x78, 0xbe, 0x44, 0x60, 0xb1, 0x44, 0xe0, 0x7b, 0x89, 0xc0, 0xf3, 0x0a, 0x59, 0x8b, 0x15,
+	0xb2, 0x3e, 0x56, 0xc8, 0xba, 0xdd, 0x31, 0x57, 0x4b, 0xef, 0x01, 0x07, 0x82, 0xfc, 0xc5, 0x10,
+	0x35, 0x0b, 0x99, 0x1c, 0x97, 0xb5, 0xe9, 0xd1, 0xef, 0x00, 0x77, 0xaf, 0x83, 0x89, 0x38, 0x02,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +153,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PrunedCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PrunedCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ArchivedEvidence) > 0 {
+		for iNdEx := len(m.ArchivedEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Evidence) > 0 {
 		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -153,6 +222,17 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ArchivedEvidence) > 0 {
+		for _, e := range m.ArchivedEvidence {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PrunedCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PrunedCount))
+	}
 	return n
 }
 
@@ -225,6 +305,92 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedEvidence = append(m.ArchivedEvidence, &any.Any{})
+			if err := m.ArchivedEvidence[len(m.ArchivedEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedCount", wireType)
+			}
+			m.PrunedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8,9 +8,17 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = collections.NewPrefix(0)
+	KeyPrefixEvidence         = collections.NewPrefix(0)
+	ParamsKey                 = collections.NewPrefix(1)
+	KeyPrefixExpiryQueue      = collections.NewPrefix(2)
+	KeyPrefixArchivedEvidence = collections.NewPrefix(3)
+	ArchivedCountKey          = collections.NewPrefix(4)
+	PrunedCountKey            = collections.NewPrefix(5)
 )
@@ -1,9 +1,33 @@
 package types
 
 import (
+	"fmt"
 	"time"
 )
 
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// NewParams creates a new Params object
+func NewParams(retentionPeriod time.Duration, archive bool) Params {
+	return Params{
+		RetentionPeriod: retentionPeriod,
+		Archive:         archive,
+	}
+}
+
+// DefaultParams defines the parameters for this module.
+// By default, the evidence is kept forever.
+func DefaultParams() Params {
+	return NewParams(0, false)
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if p.RetentionPeriod < 0 {
+		return fmt.Errorf("retention period cannot be negative: %s", p.RetentionPeriod)
+	}
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -237,11 +239,190 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryArchivedCountRequest is the request type for the Query/ArchivedCount RPC method.
+type QueryArchivedCountRequest struct {
+}
+
+func (m *QueryArchivedCountRequest) Reset()         { *m = QueryArchivedCountRequest{} }
+func (m *QueryArchivedCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedCountRequest) ProtoMessage()    {}
+func (*QueryArchivedCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{6}
+}
+func (m *QueryArchivedCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedCountRequest.Merge(m, src)
+}
+func (m *QueryArchivedCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedCountRequest proto.InternalMessageInfo
+
+// QueryArchivedCountResponse is the response type for the Query/ArchivedCount RPC method.
+type QueryArchivedCountResponse struct {
+	// archived is the number of expired evidence moved to the archive index.
+	Archived uint64 `protobuf:"varint,1,opt,name=archived,proto3" json:"archived,omitempty"`
+	// pruned is the number of expired evidence pruned from the state.
+	Pruned uint64 `protobuf:"varint,2,opt,name=pruned,proto3" json:"pruned,omitempty"`
+}
+
+func (m *QueryArchivedCountResponse) Reset()         { *m = QueryArchivedCountResponse{} }
+func (m *QueryArchivedCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedCountResponse) ProtoMessage()    {}
+func (*QueryArchivedCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{7}
+}
+func (m *QueryArchivedCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedCountResponse.Merge(m, src)
+}
+func (m *QueryArchivedCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedCountResponse proto.InternalMessageInfo
+
+func (m *QueryArchivedCountResponse) GetArchived() uint64 {
+	if m != nil {
+		return m.Archived
+	}
+	return 0
+}
+
+func (m *QueryArchivedCountResponse) GetPruned() uint64 {
+	if m != nil {
+		return m.Pruned
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evidence.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evidence.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryArchivedCountRequest)(nil), "cosmos.evidence.v1beta1.QueryArchivedCountRequest")
+	proto.RegisterType((*QueryArchivedCountResponse)(nil), "cosmos.evidence.v1beta1.QueryArchivedCountResponse")
 }
 
 func init() {
@@ -249,36 +430,49 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0xae, 0xd2, 0x40,
-	0x14, 0xc7, 0x99, 0xfa, 0x91, 0xeb, 0xdc, 0x6b, 0x4c, 0xc6, 0x6b, 0x2e, 0xb7, 0xd1, 0x06, 0x4b,
-	0x22, 0x48, 0xc2, 0x4c, 0x41, 0x13, 0xd6, 0x90, 0xf8, 0xb5, 0xd3, 0x2e, 0xdd, 0x90, 0x29, 0x8c,
-	0x6d, 0x03, 0xce, 0x14, 0xa6, 0x10, 0x89, 0x71, 0xe3, 0x13, 0x98, 0x18, 0x57, 0xc6, 0xb7, 0xf0,
-	0x15, 0x4c, 0x5c, 0x92, 0xb8, 0x31, 0xae, 0x0c, 0xf8, 0x20, 0x86, 0x99, 0x29, 0x20, 0x1f, 0xa2,
-	0xcb, 0xe9, 0xf9, 0x9f, 0xff, 0xff, 0xd7, 0x73, 0x0e, 0x2c, 0x76, 0x84, 0x7c, 0x29, 0x24, 0x61,
-	0xe3, 0xb8, 0xcb, 0x78, 0x87, 0x91, 0x71, 0x2d, 0x60, 0x29, 0xad, 0x91, 0xc1, 0x88, 0x0d, 0x27,
-	0x38, 0x19, 0x8a, 0x54, 0xa0, 0x33, 0x2d, 0xc2, 0x99, 0x08, 0x1b, 0x91, 0x7d, 0xae, 0x0b, 0x6d,
-	0x25, 0x23, 0x46, 0xa5, 0x1e, 0x76, 0xc5, 0x18, 0x07, 0x54, 0x32, 0x6d, 0xb6, 0xb4, 0x4e, 0x68,
-	0x18, 0x73, 0x9a, 0xc6, 0x82, 0x1b, 0xed, 0x79, 0x28, 0x44, 0xd8, 0x67, 0x44, 0xbd, 0x82, 0xd1,
-	0x0b, 0x42, 0xb9, 0x89, 0xb6, 0x6f, 0x9a, 0x12, 0x4d, 0x62, 0x42, 0x39, 0x17, 0xa9, 0xea, 0x33,
-	0x21, 0x6e, 0x04, 0x4f, 0x9f, 0x2d, 0xac, 0x1f, 0x18, 0x30, 0x9f, 0x0d, 0x46, 0x4c, 0xa6, 0xa8,
-	0x04, 0xaf, 0x66, 0xac, 0xed, 0x88, 0xca, 0x28, 0x0f, 0x0a, 0xa0, 0x7c, 0xd2, 0xb2, 0xf2, 0xc0,
-	0x3f, 0xc9, 0x0a, 0x8f, 0xa9, 0x8c, 0x50, 0x09, 0x5e, 0x54, 0x75, 0xab, 0x00, 0xca, 0x57, 0x5a,
-	0xd7, 0x7f, 0x7c, 0xae, 0x5e, 0xd3, 0xdc, 0x55, 0xd9, 0xed, 0x15, 0x3c, 0x7c, 0xbf, 0xe1, 0x2b,
-	0x81, 0xfb, 0x04, 0xde, 0xd8, 0x48, 0x92, 0x89, 0xe0, 0x92, 0x21, 0x0f, 0x1e, 0x65, 0x8e, 0x2a,
-	0xe5, 0xb8, 0x7e, 0x8a, 0x35, 0x33, 0xce, 0x7e, 0x07, 0x37, 0xf9, 0xc4, 0x5f, 0xaa, 0x5c, 0x0a,
-	0xcf, 0x94, 0x55, 0xb3, 0xdf, 0xdf, 0xe4, 0x7e, 0x08, 0xe1, 0x6a, 0x38, 0xc6, 0xee, 0x0e, 0x36,
-	0x73, 0x5d, 0x4c, 0x12, 0xeb, 0xb5, 0x98, 0x49, 0xe2, 0xa7, 0x34, 0xcc, 0x7a, 0xfd, 0xb5, 0x4e,
-	0xf7, 0x03, 0x80, 0xf9, 0xed, 0x8c, 0x9d, 0xc4, 0x17, 0x0e, 0x13, 0xa3, 0x47, 0x7f, 0x60, 0x59,
-	0x0a, 0xab, 0x74, 0x10, 0x4b, 0xc7, 0xad, 0x73, 0xd5, 0xbf, 0x58, 0xf0, 0x92, 0xe2, 0x42, 0x1f,
-	0x01, 0x3c, 0xca, 0xc8, 0x50, 0x15, 0xef, 0x39, 0x30, 0xbc, 0x6b, 0xbb, 0x36, 0xfe, 0x57, 0xb9,
-	0x26, 0x70, 0xbd, 0xb7, 0xdf, 0x7e, 0xbd, 0xb7, 0x2a, 0xa8, 0x4c, 0xf6, 0x1d, 0xfb, 0xf2, 0xc3,
-	0xeb, 0xc5, 0xb2, 0xdf, 0xa0, 0x4f, 0x00, 0x1e, 0xaf, 0x8d, 0x0e, 0x79, 0x7f, 0x4f, 0xdc, 0xde,
-	0xa4, 0x5d, 0xfb, 0x8f, 0x0e, 0x83, 0x79, 0x57, 0x61, 0x16, 0xd1, 0xed, 0x83, 0x98, 0xad, 0xc6,
-	0xd7, 0x99, 0x03, 0xa6, 0x33, 0x07, 0xfc, 0x9c, 0x39, 0xe0, 0xdd, 0xdc, 0xc9, 0x4d, 0xe7, 0x4e,
-	0xee, 0xfb, 0xdc, 0xc9, 0x3d, 0xbf, 0xa5, 0x7b, 0x65, 0xb7, 0x87, 0x63, 0x41, 0x5e, 0xad, 0x3c,
-	0xd2, 0x49, 0xc2, 0x64, 0x70, 0x59, 0x6d, 0xf8, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76,
-	0x21, 0xac, 0x55, 0xf7, 0x03, 0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xfd, 0x41, 0x03, 0x03, 0xe4, 0x17, 0x06, 0x10, 0xd8, 0xe8, 0x52, 0x96, 0x84,
+	0xf2, 0xc7, 0xce, 0x2c, 0xc5, 0x84, 0xc4, 0x78, 0xa1, 0xfe, 0xbf, 0xe1, 0x1e, 0xbd, 0x90, 0x69,
+	0x3b, 0x6e, 0x37, 0xd0, 0x9d, 0xa5, 0xb3, 0x6d, 0x6c, 0x8c, 0x17, 0x5f, 0x81, 0x89, 0xf1, 0x64,
+	0x3c, 0x78, 0x33, 0x9e, 0x3c, 0x70, 0xf6, 0x4c, 0x3c, 0x11, 0xbd, 0x18, 0x0f, 0xc6, 0xb4, 0x26,
+	0xbe, 0x0d, 0xd3, 0x99, 0xd9, 0x42, 0xa1, 0xcb, 0xc2, 0xa5, 0xe9, 0xcc, 0x7c, 0x9f, 0xe7, 0xfb,
+	0x99, 0xe7, 0x79, 0x66, 0xe1, 0x52, 0x99, 0x8b, 0x1a, 0x17, 0x84, 0x35, 0xbd, 0x0a, 0xf3, 0xcb,
+	0x8c, 0x34, 0x37, 0x4a, 0x2c, 0xa4, 0x1b, 0xe4, 0xa0, 0xc1, 0xea, 0x2d, 0x1c, 0xd4, 0x79, 0xc8,
+	0xd1, 0xac, 0x12, 0xe1, 0x48, 0x84, 0xb5, 0xc8, 0x98, 0x57, 0x07, 0xbb, 0x52, 0x46, 0xb4, 0x4a,
+	0x2e, 0x8c, 0x35, 0x9d, 0xb8, 0x44, 0x05, 0x53, 0xc9, 0x7a, 0xa9, 0x03, 0xea, 0x7a, 0x3e, 0x0d,
+	0x3d, 0xee, 0x6b, 0xed, 0xbc, 0xcb, 0xb9, 0xbb, 0xcf, 0x88, 0x5c, 0x95, 0x1a, 0xcf, 0x08, 0xf5,
+	0xb5, 0xb5, 0x71, 0x5d, 0x1f, 0xd1, 0xc0, 0x23, 0xd4, 0xf7, 0x79, 0x28, 0xe3, 0x22, 0x93, 0x69,
+	0x97, 0xbb, 0x5c, 0x99, 0x77, 0xff, 0xe9, 0xdd, 0x49, 0x5a, 0xf3, 0x7c, 0x4e, 0xe4, 0xaf, 0xde,
+	0x5a, 0x8e, 0xbb, 0x66, 0xef, 0x4a, 0x52, 0x67, 0x55, 0xe1, 0xf4, 0x93, 0x2e, 0xeb, 0x7d, 0xbd,
+	0xed, 0xb0, 0x83, 0x06, 0x13, 0x21, 0xca, 0xc1, 0x89, 0x48, 0xb9, 0x5b, 0xa5, 0xa2, 0x3a, 0x07,
+	0xb2, 0x60, 0x65, 0xbc, 0x98, 0x9e, 0x03, 0xce, 0x78, 0x74, 0xf0, 0x88, 0x8a, 0x2a, 0xca, 0xc1,
+	0x21, 0x79, 0x9e, 0xce, 0x82, 0x95, 0xd1, 0xe2, 0xd4, 0xcf, 0xc3, 0xfc, 0xff, 0xca, 0x3a, 0x2f,
+	0x2a, 0x7b, 0x59, 0x1b, 0xdf, 0xda, 0x72, 0xa4, 0xc0, 0x7a, 0x0c, 0x67, 0xce, 0x38, 0x89, 0x80,
+	0xfb, 0x82, 0x21, 0x1b, 0x8e, 0x44, 0x19, 0xa5, 0xcb, 0x58, 0x61, 0x1a, 0xab, 0x22, 0xe0, 0xa8,
+	0x3e, 0x78, 0xdb, 0x6f, 0x39, 0x3d, 0x95, 0x45, 0xe1, 0xac, 0x4c, 0xb5, 0xbd, 0xbf, 0x7f, 0x96,
+	0xfb, 0x01, 0x84, 0x27, 0xd5, 0xd6, 0xe9, 0x96, 0xb1, 0x6e, 0x54, 0xb7, 0x35, 0x58, 0xf5, 0x59,
+	0x97, 0x03, 0xef, 0x50, 0x37, 0x8a, 0x75, 0x4e, 0x45, 0x5a, 0x6f, 0x01, 0x9c, 0x3b, 0xef, 0x31,
+	0x90, 0xf8, 0xbf, 0x64, 0x62, 0xf4, 0xb0, 0x0f, 0x2b, 0x2d, 0xb1, 0x72, 0x89, 0x58, 0xca, 0xae,
+	0x8f, 0x6b, 0x1d, 0x22, 0x89, 0xb5, 0x43, 0xeb, 0xb4, 0x26, 0x34, 0xf9, 0xed, 0x99, 0x6f, 0x87,
+	0xf9, 0xc9, 0xe7, 0xbd, 0xde, 0x66, 0x9b, 0x36, 0x2e, 0x60, 0xdb, 0x0a, 0xe0, 0x54, 0x9f, 0x58,
+	0xe3, 0x17, 0x61, 0x26, 0x90, 0x3b, 0xba, 0x3e, 0x0b, 0x38, 0x66, 0xdc, 0xb1, 0x0a, 0x2c, 0x8e,
+	0x1e, 0xfd, 0x5a, 0x48, 0x7d, 0xfc, 0xfb, 0x79, 0x0d, 0x38, 0x3a, 0x32, 0xce, 0xb1, 0x00, 0xe7,
+	0x55, 0xd5, 0xea, 0xe5, 0xaa, 0xd7, 0x64, 0x95, 0xbb, 0xbc, 0xe1, 0x87, 0x09, 0x94, 0x2e, 0x34,
+	0x06, 0xc5, 0x68, 0x58, 0x03, 0x8e, 0x50, 0x7d, 0x20, 0x71, 0x87, 0x9c, 0xde, 0x1a, 0x5d, 0x83,
+	0x99, 0xa0, 0xde, 0xf0, 0x59, 0x45, 0x56, 0x74, 0xc8, 0xd1, 0xab, 0x18, 0xa3, 0xc2, 0xa7, 0x61,
+	0x38, 0x2c, 0x9d, 0xd0, 0x3b, 0x00, 0x47, 0xa2, 0xae, 0xa2, 0x7c, 0xec, 0xf5, 0x07, 0xbd, 0x0c,
+	0x03, 0x5f, 0x56, 0xae, 0x2e, 0x60, 0xd9, 0xaf, 0xbe, 0xff, 0x79, 0x93, 0x5e, 0x43, 0x2b, 0x24,
+	0xe9, 0x49, 0x92, 0x17, 0xdd, 0x87, 0xf2, 0x12, 0xbd, 0x07, 0x70, 0xec, 0xd4, 0xd8, 0x21, 0xfb,
+	0x62, 0xc7, 0xf3, 0xaf, 0xc0, 0xd8, 0xb8, 0x42, 0x84, 0xc6, 0x5c, 0x95, 0x98, 0x4b, 0x68, 0x31,
+	0x11, 0x13, 0x7d, 0x00, 0x30, 0xa3, 0x26, 0x03, 0xad, 0x5f, 0x6c, 0xd4, 0x37, 0xa5, 0xc6, 0xcd,
+	0xcb, 0x89, 0x35, 0xd0, 0x9d, 0xaf, 0x83, 0x9a, 0x28, 0x29, 0x17, 0xd1, 0x42, 0x2c, 0xa5, 0x9a,
+	0x4f, 0xf4, 0x05, 0xc0, 0x89, 0xbe, 0x81, 0x42, 0x85, 0x84, 0x9a, 0x0c, 0x98, 0x58, 0x63, 0xf3,
+	0x4a, 0x31, 0x1a, 0xfc, 0x5e, 0x3c, 0xf8, 0x2a, 0xca, 0xc5, 0x82, 0x47, 0x53, 0xbd, 0x5b, 0xee,
+	0x66, 0x2b, 0x6e, 0x1d, 0xb5, 0x4d, 0x70, 0xdc, 0x36, 0xc1, 0xef, 0xb6, 0x09, 0x5e, 0x77, 0xcc,
+	0xd4, 0x71, 0xc7, 0x4c, 0xfd, 0xe8, 0x98, 0xa9, 0xa7, 0x37, 0x54, 0x06, 0x51, 0xd9, 0xc3, 0x1e,
+	0x27, 0x27, 0x46, 0x24, 0x6c, 0x05, 0x4c, 0x94, 0x32, 0xf2, 0x13, 0xb4, 0xf9, 0x6f, 0x00, 0x46,
+	0x68, 0x36, 0xad, 0xe9, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -297,6 +491,10 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of x/evidence module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ArchivedCount queries the number of expired evidence that was archived or pruned.
+	ArchivedCount(ctx context.Context, in *QueryArchivedCountRequest, opts ...grpc.CallOption) (*QueryArchivedCountResponse, error)
 }
 
 type queryClient struct {
@@ -325,12 +523,34 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArchivedCount(ctx context.Context, in *QueryArchivedCountRequest, opts ...grpc.CallOption) (*QueryArchivedCountResponse, error) {
+	out := new(QueryArchivedCountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/ArchivedCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of x/evidence module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ArchivedCount queries the number of expired evidence that was archived or pruned.
+	ArchivedCount(context.Context, *QueryArchivedCountRequest) (*QueryArchivedCountResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -343,6 +563,12 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ArchivedCount(ctx context.Context, req *QueryArchivedCountRequest) (*QueryArchivedCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedCount not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -384,6 +610,42 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArchivedCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/ArchivedCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedCount(ctx, req.(*QueryArchivedCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
@@ -397,6 +659,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ArchivedCount",
+			Handler:    _Query_ArchivedCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -558,6 +828,118 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArchivedCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArchivedCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pruned != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Pruned))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Archived != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Archived))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -631,6 +1013,50 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryArchivedCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryArchivedCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Archived != 0 {
+		n += 1 + sovQuery(uint64(m.Archived))
+	}
+	if m.Pruned != 0 {
+		n += 1 + sovQuery(uint64(m.Pruned))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1045,6 +1471,277 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArchivedCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArchivedCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArchivedCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			m.Archived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Archived |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+			}
+			m.Pruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
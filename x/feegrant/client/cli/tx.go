@@ -24,6 +24,8 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+
+	FlagMaxRegrantDepth = "max-regrant-depth"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -39,6 +41,7 @@ func GetTxCmd() *cobra.Command {
 
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
+		NewCmdFeeRegrant(),
 	)
 
 	return feegrantTxCmd
@@ -85,93 +88,86 @@ Examples:
 			if err != nil {
 				return err
 			}
-			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+
+			grant, err := parseAllowance(cmd)
 			if err != nil {
 				return err
 			}
 
-			// if `FlagSpendLimit` isn't set, limit will be nil.
-			// Hence, there won't be any spendlimit for the grantee.
-			limit, err := sdk.ParseCoinsNormalized(sl)
+			maxRegrantDepth, err := cmd.Flags().GetUint32(FlagMaxRegrantDepth)
 			if err != nil {
 				return err
 			}
 
-			exp, err := cmd.Flags().GetString(FlagExpiration)
+			msg, err := feegrant.NewMsgGrantAllowance(grant, granterStr, args[1])
 			if err != nil {
 				return err
 			}
+			msg.MaxRegrantDepth = maxRegrantDepth
 
-			basic := feegrant.BasicAllowance{
-				SpendLimit: limit,
-			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-			var expiresAtTime time.Time
-			if exp != "" {
-				expiresAtTime, err = time.Parse(time.RFC3339, exp)
-				if err != nil {
-					return err
-				}
-				basic.Expiration = &expiresAtTime
-			}
+	flags.AddTxFlagsToCmd(cmd)
+	addAllowanceFlags(cmd)
+	cmd.Flags().Uint32(FlagMaxRegrantDepth, 0, fmt.Sprintf("The number of levels of re-grants the grantee can create from the allowance (max %d)", feegrant.MaxRegrantDepth))
 
-			var grant feegrant.FeeAllowanceI
-			grant = &basic
+	return cmd
+}
 
-			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
-			if err != nil {
+// NewCmdFeeRegrant returns a CLI command handler to create a MsgRegrantAllowance transaction.
+func NewCmdFeeRegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "regrant <grantee_key_or_address> <granter> <regrantee>",
+		Aliases: []string{"regrant-allowance"},
+		Short:   "Re-grant a portion of a fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Re-grant a portion of the fee allowance granted to you by [granter] to [regrantee].
+The re-granted allowance can't exceed your allowance and is revoked along with it. Note, the
+'--from' flag is ignored as it is implied from [grantee].
+
+Examples:
+%s tx %s regrant cosmos1skjw... cosmos1skjw... cosmos1skjw... --spend-limit 10stake --expiration 2022-01-30T15:04:05Z
+				`, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
 				return err
 			}
 
-			periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			// check any of period or periodLimit flags are set,
-			// if set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
-				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
-				if err != nil {
-					return err
-				}
-
-				if periodClock <= 0 {
-					return errors.New("period clock was not set")
-				}
-
-				if periodLimit == nil {
-					return errors.New("period limit was not set")
-				}
-
-				periodReset := getPeriodReset(periodClock)
-				if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
-					return fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
-				}
-
-				periodic := feegrant.PeriodicAllowance{
-					Basic:            basic,
-					Period:           getPeriod(periodClock),
-					PeriodSpendLimit: periodLimit,
-					PeriodCanSpend:   periodLimit,
-				}
-
-				grant = &periodic
+			if _, err = clientCtx.AddressCodec.StringToBytes(args[1]); err != nil {
+				return err
 			}
 
-			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			if _, err = clientCtx.AddressCodec.StringToBytes(args[2]); err != nil {
+				return err
+			}
+
+			granteeStr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
 			if err != nil {
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
-				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
-				if err != nil {
-					return err
-				}
+			grant, err := parseAllowance(cmd)
+			if err != nil {
+				return err
 			}
 
-			msg, err := feegrant.NewMsgGrantAllowance(grant, granterStr, args[1])
+			maxRegrantDepth, err := cmd.Flags().GetUint32(FlagMaxRegrantDepth)
+			if err != nil {
+				return err
+			}
+
+			msg, err := feegrant.NewMsgRegrantAllowance(grant, args[1], granteeStr, args[2], maxRegrantDepth)
 			if err != nil {
 				return err
 			}
@@ -181,13 +177,109 @@ Examples:
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addAllowanceFlags(cmd)
+	cmd.Flags().Uint32(FlagMaxRegrantDepth, 0, "The number of levels of re-grants the regrantee can create from the allowance, lower than the one of the re-granted allowance")
+
+	return cmd
+}
+
+func addAllowanceFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+}
 
-	return cmd
+// parseAllowance builds the fee allowance described by the allowance flags.
+func parseAllowance(cmd *cobra.Command) (feegrant.FeeAllowanceI, error) {
+	sl, err := cmd.Flags().GetString(FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// if `FlagSpendLimit` isn't set, limit will be nil.
+	// Hence, there won't be any spendlimit for the grantee.
+	limit, err := sdk.ParseCoinsNormalized(sl)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	basic := feegrant.BasicAllowance{
+		SpendLimit: limit,
+	}
+
+	var expiresAtTime time.Time
+	if exp != "" {
+		expiresAtTime, err = time.Parse(time.RFC3339, exp)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = &expiresAtTime
+	}
+
+	var grant feegrant.FeeAllowanceI
+	grant = &basic
+
+	periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// check any of period or periodLimit flags are set,
+	// if set consider it as periodic fee allowance.
+	if periodClock > 0 || periodLimitVal != "" {
+		periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+		if err != nil {
+			return nil, err
+		}
+
+		if periodClock <= 0 {
+			return nil, errors.New("period clock was not set")
+		}
+
+		if periodLimit == nil {
+			return nil, errors.New("period limit was not set")
+		}
+
+		periodReset := getPeriodReset(periodClock)
+		if exp != "" && periodReset.Sub(expiresAtTime) > 0 {
+			return nil, fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
+		}
+
+		periodic := feegrant.PeriodicAllowance{
+			Basic:            basic,
+			Period:           getPeriod(periodClock),
+			PeriodSpendLimit: periodLimit,
+			PeriodCanSpend:   periodLimit,
+		}
+
+		grant = &periodic
+	}
+
+	allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedMsgs) > 0 {
+		grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return grant, nil
 }
 
 func getPeriodReset(duration int64) time.Time {
@@ -20,4 +20,8 @@ var (
 	ErrNoMessages = errors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = errors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrRegrantNotAllowed error if the allowance cannot be re-granted
+	ErrRegrantNotAllowed = errors.Register(DefaultCodespace, 8, "allowance cannot be re-granted")
+	// ErrInvalidRegrant error if the re-granted allowance exceeds the allowance it is re-granted from
+	ErrInvalidRegrant = errors.Register(DefaultCodespace, 9, "invalid re-grant")
 )
@@ -11,4 +11,6 @@ const (
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 	AttributeKeyPruner  = "pruner"
+
+	AttributeKeyParentGrantee = "parent_grantee"
 )
@@ -227,6 +227,14 @@ type Grant struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic, periodic, allowed fee allowance.
 	Allowance *any.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_regrant_depth is the number of levels of re-grants that can still be
+	// created below this grant. If it is zero, the grantee cannot re-grant the
+	// allowance.
+	MaxRegrantDepth uint32 `protobuf:"varint,4,opt,name=max_regrant_depth,json=maxRegrantDepth,proto3" json:"max_regrant_depth,omitempty"`
+	// parent_grantee is the address of the grantee that re-granted this allowance
+	// from its own allowance of the granter's funds. It is empty if the allowance
+	// was granted by the granter directly.
+	ParentGrantee string `protobuf:"bytes,5,opt,name=parent_grantee,json=parentGrantee,proto3" json:"parent_grantee,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
@@ -283,6 +291,20 @@ func (m *Grant) GetAllowance() *any.Any {
 	return nil
 }
 
+func (m *Grant) GetMaxRegrantDepth() uint32 {
+	if m != nil {
+		return m.MaxRegrantDepth
+	}
+	return 0
+}
+
+func (m *Grant) GetParentGrantee() string {
+	if m != nil {
+		return m.ParentGrantee
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
@@ -295,48 +317,52 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xbd, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0xe5, 0xa3, 0x3f, 0xe5, 0xd2, 0x4f, 0xb7, 0xd2, 0xcf, 0xa9, 0x90, 0x13, 0x45, 0x02,
+	0xd2, 0xa2, 0xd8, 0x34, 0x88, 0xa5, 0x0b, 0xad, 0x5b, 0xb5, 0x80, 0x5a, 0xa9, 0x72, 0x99, 0x90,
+	0x90, 0x75, 0xb1, 0xaf, 0xae, 0xd5, 0xd8, 0x67, 0xf9, 0x5c, 0x48, 0x56, 0x26, 0x04, 0x03, 0x1d,
+	0x11, 0x53, 0x47, 0xc4, 0xd4, 0xa1, 0x7f, 0x44, 0xc5, 0x54, 0x31, 0x01, 0x03, 0x45, 0xcd, 0xd0,
+	0x99, 0xff, 0x00, 0xf9, 0xee, 0x9c, 0xa4, 0x5f, 0xa2, 0x95, 0x50, 0x97, 0xc4, 0xf7, 0xde, 0xf3,
+	0x3e, 0xef, 0xf3, 0xbc, 0xef, 0x2b, 0x1d, 0xbc, 0x63, 0x11, 0xea, 0x11, 0xaa, 0x6d, 0x60, 0xec,
+	0x84, 0xc8, 0x8f, 0xb4, 0x97, 0x33, 0x0d, 0x1c, 0xa1, 0x99, 0x6e, 0x40, 0x0d, 0x42, 0x12, 0x11,
+	0xe9, 0x7f, 0x8e, 0x53, 0xbb, 0x61, 0x81, 0x9b, 0x9c, 0x70, 0x88, 0x43, 0x18, 0x46, 0x8b, 0xbf,
+	0x38, 0x7c, 0xb2, 0xe8, 0x10, 0xe2, 0x34, 0xb1, 0xc6, 0x4e, 0x8d, 0xed, 0x0d, 0x0d, 0xf9, 0xed,
+	0xe4, 0x8a, 0x33, 0x99, 0x3c, 0x47, 0xd0, 0xf2, 0x2b, 0x45, 0x88, 0x69, 0x20, 0x8a, 0xbb, 0x42,
+	0x2c, 0xe2, 0xfa, 0xe2, 0x7e, 0x0c, 0x79, 0xae, 0x4f, 0x34, 0xf6, 0x2b, 0x42, 0xa5, 0xb3, 0x85,
+	0x22, 0xd7, 0xc3, 0x34, 0x42, 0x5e, 0x90, 0x70, 0x9e, 0x05, 0xd8, 0xdb, 0x21, 0x8a, 0x5c, 0x22,
+	0x38, 0x2b, 0xbb, 0x69, 0x38, 0xac, 0x23, 0xea, 0x5a, 0xf3, 0xcd, 0x26, 0x79, 0x85, 0x7c, 0x0b,
+	0x4b, 0xaf, 0x01, 0x2c, 0xd0, 0x00, 0xfb, 0xb6, 0xd9, 0x74, 0x3d, 0x37, 0x92, 0x41, 0x39, 0x53,
+	0x2d, 0xd4, 0x8b, 0xaa, 0xd0, 0x1a, 0xab, 0x4b, 0xec, 0xab, 0x0b, 0xc4, 0xf5, 0xf5, 0xa5, 0x83,
+	0x9f, 0xa5, 0xd4, 0xe7, 0xa3, 0x52, 0xd5, 0x71, 0xa3, 0xcd, 0xed, 0x86, 0x6a, 0x11, 0x4f, 0x18,
+	0x13, 0x7f, 0x35, 0x6a, 0x6f, 0x69, 0x51, 0x3b, 0xc0, 0x94, 0x25, 0xd0, 0x8f, 0x27, 0x7b, 0xd3,
+	0x83, 0x4d, 0xec, 0x20, 0xab, 0x6d, 0xc6, 0xfe, 0xe8, 0xa7, 0x93, 0xbd, 0x69, 0x60, 0x40, 0x56,
+	0x75, 0x25, 0x2e, 0x2a, 0xcd, 0x41, 0x88, 0x5b, 0x81, 0xcb, 0xb5, 0xca, 0xe9, 0x32, 0xa8, 0x16,
+	0xea, 0x93, 0x2a, 0x37, 0xa3, 0x26, 0x66, 0xd4, 0x67, 0x89, 0x5b, 0x3d, 0xbb, 0x73, 0x54, 0x02,
+	0x46, 0x5f, 0xce, 0xec, 0xf2, 0x97, 0xfd, 0xda, 0xed, 0x4b, 0xc6, 0xa6, 0x2e, 0x61, 0xdc, 0x35,
+	0xfc, 0xe4, 0xed, 0xc9, 0xde, 0x74, 0xb1, 0x4f, 0xe9, 0xe9, 0x7e, 0x54, 0xbe, 0x67, 0xe1, 0xd8,
+	0x1a, 0x0e, 0x5d, 0x62, 0xf7, 0x77, 0xe9, 0x31, 0xcc, 0x35, 0x62, 0x9c, 0x0c, 0x98, 0xb6, 0xbb,
+	0xea, 0x65, 0xa5, 0x4e, 0xb3, 0xe9, 0xf9, 0xb8, 0x59, 0xdc, 0x2f, 0x27, 0x90, 0xe6, 0xe0, 0x40,
+	0xc0, 0xe8, 0x85, 0xcd, 0xe2, 0x39, 0x9b, 0x8b, 0x62, 0x66, 0xfa, 0x50, 0x9c, 0xfc, 0xe1, 0xa8,
+	0x04, 0x38, 0x81, 0xc8, 0x93, 0xde, 0x03, 0x28, 0xf1, 0x4f, 0xb3, 0x7f, 0x70, 0x99, 0x9b, 0x1a,
+	0xdc, 0x28, 0x2f, 0xbe, 0xde, 0x1b, 0xdf, 0x3b, 0x00, 0x45, 0xd0, 0xb4, 0x90, 0xcf, 0x55, 0xc9,
+	0xd9, 0x9b, 0xd2, 0x33, 0xcc, 0x4b, 0x2f, 0x20, 0x9f, 0x49, 0x92, 0x56, 0xe0, 0xa0, 0x10, 0x13,
+	0x62, 0x8a, 0x23, 0x39, 0xf7, 0xd7, 0x75, 0x62, 0x8d, 0xde, 0xe9, 0x36, 0xba, 0xc0, 0xd3, 0x8d,
+	0x38, 0x7b, 0xf6, 0xe9, 0xb5, 0x16, 0xeb, 0x56, 0x9f, 0xf2, 0x73, 0x5b, 0x54, 0xf9, 0x0d, 0xe0,
+	0x38, 0x3b, 0x61, 0x7b, 0x95, 0x3a, 0xbd, 0xed, 0x7a, 0x01, 0xf3, 0x28, 0x39, 0x88, 0x0d, 0x9b,
+	0x38, 0x27, 0x77, 0xde, 0x6f, 0xeb, 0x53, 0x57, 0x16, 0x63, 0xf4, 0x18, 0xa5, 0x29, 0x38, 0x8a,
+	0x78, 0x55, 0xd3, 0xc3, 0x94, 0x22, 0x07, 0x53, 0x39, 0x5d, 0xce, 0x54, 0xf3, 0xc6, 0x88, 0x88,
+	0xaf, 0x8a, 0xf0, 0xec, 0xda, 0x9b, 0xdd, 0x52, 0xea, 0x5a, 0x8e, 0x95, 0x3e, 0xc7, 0x17, 0x78,
+	0xab, 0x74, 0xd2, 0x30, 0xb7, 0x1c, 0x53, 0x48, 0x75, 0xf8, 0x1f, 0xe3, 0xc2, 0x21, 0xf3, 0x98,
+	0xd7, 0xe5, 0xaf, 0xfb, 0xb5, 0x09, 0x51, 0x68, 0xde, 0xb6, 0x43, 0x4c, 0xe9, 0x7a, 0x14, 0xba,
+	0xbe, 0x63, 0x24, 0xc0, 0x5e, 0x0e, 0x96, 0xd3, 0x57, 0xcb, 0x39, 0xd3, 0xcd, 0xcc, 0x3f, 0xef,
+	0xe6, 0x23, 0x38, 0xe6, 0xa1, 0x96, 0x19, 0x72, 0xbc, 0x69, 0xe3, 0x20, 0xda, 0x94, 0xb3, 0x65,
+	0x50, 0x1d, 0xd2, 0xc7, 0x7f, 0xec, 0xd7, 0x46, 0x7a, 0xfd, 0x28, 0xdf, 0x57, 0x1f, 0xd6, 0x8d,
+	0x11, 0x0f, 0xb5, 0x0c, 0x0e, 0x5e, 0x8c, 0xb1, 0x92, 0x01, 0x87, 0x03, 0x14, 0x62, 0x3f, 0x32,
+	0x13, 0x6b, 0x39, 0x66, 0xed, 0xde, 0x65, 0xd6, 0x2e, 0x62, 0x1d, 0xe2, 0x14, 0xcb, 0x9c, 0x41,
+	0x9f, 0x39, 0x38, 0x56, 0xc0, 0xe1, 0xb1, 0x02, 0x7e, 0x1d, 0x2b, 0x60, 0xa7, 0xa3, 0xa4, 0x0e,
+	0x3b, 0x4a, 0xea, 0x5b, 0x47, 0x49, 0x3d, 0x17, 0x6f, 0x19, 0xb5, 0xb7, 0x54, 0x97, 0x68, 0xad,
+	0xee, 0x53, 0xd7, 0x18, 0x60, 0xbd, 0x78, 0xf0, 0x67, 0x00, 0x7c, 0xb9, 0x75, 0x08, 0x15, 0x07,
 	0x00, 0x00,
 }
 
@@ -528,6 +554,18 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParentGrantee) > 0 {
+		i -= len(m.ParentGrantee)
+		copy(dAtA[i:], m.ParentGrantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.ParentGrantee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxRegrantDepth != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxRegrantDepth))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -651,6 +689,13 @@ func (m *Grant) Size() (n int) {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	if m.MaxRegrantDepth != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxRegrantDepth))
+	}
+	l = len(m.ParentGrantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
 	return n
 }
 
@@ -1244,6 +1289,57 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegrantDepth", wireType)
+			}
+			m.MaxRegrantDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRegrantDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGrantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentGrantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
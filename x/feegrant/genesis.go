@@ -2,6 +2,8 @@ package feegrant
 
 import (
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 var _ gogoprotoany.UnpackInterfacesMessage = GenesisState{}
@@ -15,6 +17,11 @@ func NewGenesisState(entries []Grant) *GenesisState {
 
 // ValidateGenesis ensures all grants in the genesis state are valid
 func ValidateGenesis(data GenesisState) error {
+	depths := make(map[[2]string]uint32, len(data.Allowances))
+	for _, f := range data.Allowances {
+		depths[[2]string{f.Granter, f.Grantee}] = f.MaxRegrantDepth
+	}
+
 	for _, f := range data.Allowances {
 		grant, err := f.GetGrant()
 		if err != nil {
@@ -24,6 +31,20 @@ func ValidateGenesis(data GenesisState) error {
 		if err != nil {
 			return err
 		}
+
+		if f.MaxRegrantDepth > MaxRegrantDepth {
+			return errorsmod.Wrapf(ErrInvalidRegrant, "max re-grant depth %d exceeds %d", f.MaxRegrantDepth, MaxRegrantDepth)
+		}
+
+		if f.ParentGrantee != "" {
+			parentDepth, ok := depths[[2]string{f.Granter, f.ParentGrantee}]
+			if !ok {
+				return errorsmod.Wrapf(ErrInvalidRegrant, "parent allowance of %s to %s not found", f.Granter, f.ParentGrantee)
+			}
+			if parentDepth <= f.MaxRegrantDepth {
+				return errorsmod.Wrapf(ErrInvalidRegrant, "max re-grant depth %d must be lower than the parent one %d", f.MaxRegrantDepth, parentDepth)
+			}
+		}
 	}
 	return nil
 }
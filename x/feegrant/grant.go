@@ -43,6 +43,12 @@ func (a Grant) ValidateBasic() error {
 	if a.Grantee == a.Granter {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
 	}
+	if a.ParentGrantee == a.Grantee || a.ParentGrantee == a.Granter {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "parent grantee must differ from granter and grantee")
+	}
+	if a.MaxRegrantDepth > MaxRegrantDepth {
+		return errorsmod.Wrapf(ErrInvalidRegrant, "max re-grant depth %d exceeds %d", a.MaxRegrantDepth, MaxRegrantDepth)
+	}
 
 	f, err := a.GetGrant()
 	if err != nil {
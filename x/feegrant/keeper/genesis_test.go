@@ -6,6 +6,7 @@ import (
 
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/collections"
 	address "cosmossdk.io/core/address"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
@@ -88,6 +89,44 @@ func TestImportExportGenesis(t *testing.T) {
 	assert.DeepEqual(t, genesis, newGenesis)
 }
 
+func TestImportExportRegrantGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	coins := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1_000)))
+	regranteeAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	err := f.feegrantKeeper.GrantRegrantableAllowance(f.ctx, granterAddr, granteeAddr, &feegrant.BasicAllowance{SpendLimit: coins}, 1)
+	assert.NilError(t, err)
+	err = f.feegrantKeeper.RegrantAllowance(f.ctx, granterAddr, granteeAddr, regranteeAddr, &feegrant.BasicAllowance{SpendLimit: coins}, 0)
+	assert.NilError(t, err)
+
+	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, feegrant.ValidateGenesis(*genesis))
+
+	f2 := initFixture(t)
+	err = f2.feegrantKeeper.InitGenesis(f2.ctx, genesis)
+	assert.NilError(t, err)
+
+	newGenesis, err := f2.feegrantKeeper.ExportGenesis(f2.ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, genesis, newGenesis)
+
+	// the re-grants index is rebuilt
+	has, err := f2.feegrantKeeper.Regrants.Has(f2.ctx, collections.Join3(granterAddr, granteeAddr, regranteeAddr))
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+
+	// a re-granted allowance without its parent is invalid
+	for i, grant := range genesis.Allowances {
+		if grant.ParentGrantee == "" {
+			genesis.Allowances = append(genesis.Allowances[:i], genesis.Allowances[i+1:]...)
+			break
+		}
+	}
+	assert.ErrorIs(t, feegrant.ValidateGenesis(*genesis), feegrant.ErrInvalidRegrant)
+}
+
 func TestInitGenesis(t *testing.T) {
 	any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
 	assert.NilError(t, err)
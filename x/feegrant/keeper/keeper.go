@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -30,6 +31,8 @@ type Keeper struct {
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// Regrants key: granter+parent grantee+grantee
+	Regrants collections.KeySet[collections.Triple[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress]]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -56,11 +59,75 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, addrCdc address
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		Regrants: collections.NewKeySet(
+			sb,
+			feegrant.RegrantsKeyPrefix,
+			"regrants",
+			collections.TripleKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey, sdk.AccAddressKey),
+		),
 	}
 }
 
 // GrantAllowance creates a new grant
 func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	return k.grantAllowance(ctx, granter, grantee, feeAllowance, 0, nil)
+}
+
+// GrantRegrantableAllowance creates a new grant which the grantee can re-grant
+// to third parties, with up to maxRegrantDepth levels of re-grants below it.
+func (k Keeper) GrantRegrantableAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, maxRegrantDepth uint32) error {
+	if maxRegrantDepth > feegrant.MaxRegrantDepth {
+		return errorsmod.Wrapf(feegrant.ErrInvalidRegrant, "max re-grant depth %d exceeds %d", maxRegrantDepth, feegrant.MaxRegrantDepth)
+	}
+
+	return k.grantAllowance(ctx, granter, grantee, feeAllowance, maxRegrantDepth, nil)
+}
+
+// RegrantAllowance re-grants a portion of the allowance granted by granter to
+// grantee to the regrantee. The re-granted allowance is paid by the granter,
+// can't exceed the allowance it is re-granted from and is revoked along with it.
+// Fees used from the re-granted allowance are also deducted from all the
+// allowances above it.
+func (k Keeper) RegrantAllowance(
+	ctx context.Context, granter, grantee, regrantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, maxRegrantDepth uint32,
+) error {
+	if regrantee.Equals(granter) || regrantee.Equals(grantee) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot re-grant fee allowance to the granter or the grantee")
+	}
+
+	parent, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(feegrant.ErrNoAllowance, "fee allowance to re-grant not found")
+		}
+		return err
+	}
+
+	if parent.MaxRegrantDepth == 0 {
+		return feegrant.ErrRegrantNotAllowed
+	}
+
+	if maxRegrantDepth >= parent.MaxRegrantDepth {
+		return errorsmod.Wrapf(feegrant.ErrInvalidRegrant, "max re-grant depth must be lower than %d", parent.MaxRegrantDepth)
+	}
+
+	parentAllowance, err := parent.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	if err := feegrant.ValidateRegrant(parentAllowance, feeAllowance); err != nil {
+		return err
+	}
+
+	return k.grantAllowance(ctx, granter, regrantee, feeAllowance, maxRegrantDepth, grantee)
+}
+
+// grantAllowance creates a new grant, re-granted from the allowance of parent
+// if it is not nil.
+func (k Keeper) grantAllowance(
+	ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI, maxRegrantDepth uint32, parent sdk.AccAddress,
+) error {
 	// Checking for duplicate entry
 	if f, _ := k.GetAllowance(ctx, granter, grantee); f != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
@@ -108,38 +175,46 @@ func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddr
 	if err != nil {
 		return err
 	}
+	grant.MaxRegrantDepth = maxRegrantDepth
 
-	if err := k.FeeAllowance.Set(ctx, collections.Join(grantee, granter), grant); err != nil {
-		return err
-	}
-
-	return k.EventService.EventManager(ctx).EmitKV(
-		feegrant.EventTypeSetFeeGrant,
+	attrs := []event.Attribute{
 		event.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
 		event.NewAttribute(feegrant.AttributeKeyGrantee, grant.Grantee),
-	)
-}
+	}
 
-// UpdateAllowance updates the existing grant.
-func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
-	_, err := k.GetAllowance(ctx, granter, grantee)
-	if err != nil {
-		return err
+	if parent != nil {
+		grant.ParentGrantee, err = k.addrCdc.BytesToString(parent)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Regrants.Set(ctx, collections.Join3(granter, parent, grantee)); err != nil {
+			return err
+		}
+
+		attrs = append(attrs, event.NewAttribute(feegrant.AttributeKeyParentGrantee, grant.ParentGrantee))
 	}
 
-	granterStr, err := k.addrCdc.BytesToString(granter)
-	if err != nil {
+	if err := k.FeeAllowance.Set(ctx, collections.Join(grantee, granter), grant); err != nil {
 		return err
 	}
-	granteeStr, err := k.addrCdc.BytesToString(grantee)
+
+	return k.EventService.EventManager(ctx).EmitKV(feegrant.EventTypeSetFeeGrant, attrs...)
+}
+
+// UpdateAllowance updates the allowance of an existing grant.
+func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	existing, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
 	if err != nil {
 		return err
 	}
 
-	grant, err := feegrant.NewGrant(granterStr, granteeStr, feeAllowance)
+	grant, err := feegrant.NewGrant(existing.Granter, existing.Grantee, feeAllowance)
 	if err != nil {
 		return err
 	}
+	grant.MaxRegrantDepth = existing.MaxRegrantDepth
+	grant.ParentGrantee = existing.ParentGrantee
 
 	if err := k.FeeAllowance.Set(ctx, collections.Join(grantee, granter), grant); err != nil {
 		return err
@@ -152,9 +227,15 @@ func (k Keeper) UpdateAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 	)
 }
 
-// revokeAllowance removes an existing grant
+// revokeAllowance removes an existing grant, along with all the allowances
+// re-granted from it.
 func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error {
-	grant, err := k.GetAllowance(ctx, granter, grantee)
+	record, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+	if err != nil {
+		return err
+	}
+
+	grant, err := record.GetGrant()
 	if err != nil {
 		return err
 	}
@@ -174,6 +255,10 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		}
 	}
 
+	if err := k.removeRegrantLink(ctx, granter, grantee, record.ParentGrantee); err != nil {
+		return err
+	}
+
 	granterStr, err := k.addrCdc.BytesToString(granter)
 	if err != nil {
 		return err
@@ -183,11 +268,52 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
-	return k.EventService.EventManager(ctx).EmitKV(
+	if err := k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeRevokeFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, granterStr),
 		event.NewAttribute(feegrant.AttributeKeyGrantee, granteeStr),
-	)
+	); err != nil {
+		return err
+	}
+
+	return k.revokeRegrants(ctx, granter, grantee)
+}
+
+// revokeRegrants revokes the allowances re-granted from the allowance of grantee,
+// and recursively the allowances re-granted from them.
+func (k Keeper) revokeRegrants(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	var regrantees []sdk.AccAddress
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress](granter, grantee)
+	err := k.Regrants.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, sdk.AccAddress, sdk.AccAddress]) (stop bool, err error) {
+		regrantees = append(regrantees, key.K3())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, regrantee := range regrantees {
+		if err := k.revokeAllowance(ctx, granter, regrantee); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeRegrantLink removes the grant of grantee from the allowances re-granted
+// from the allowance of parentGrantee, if any.
+func (k Keeper) removeRegrantLink(ctx context.Context, granter, grantee sdk.AccAddress, parentGrantee string) error {
+	if parentGrantee == "" {
+		return nil
+	}
+
+	parent, err := k.addrCdc.StringToBytes(parentGrantee)
+	if err != nil {
+		return err
+	}
+
+	return k.Regrants.Remove(ctx, collections.Join3(granter, sdk.AccAddress(parent), grantee))
 }
 
 // GetAllowance returns the allowance between the granter and grantee.
@@ -211,9 +337,15 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	})
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the allowance was re-granted, the fee is also deducted from all the allowances it was re-granted from.
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
-	grant, err := k.GetAllowance(ctx, granter, grantee)
+	record, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+	if err != nil {
+		return err
+	}
+
+	grant, err := record.GetGrant()
 	if err != nil {
 		return err
 	}
@@ -227,22 +359,63 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 		return err
 	}
 
-	remove, err := grant.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), fee, msgs)
-	if remove && err == nil {
+	acceptCtx := context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment)
+	remove, err := grant.Accept(acceptCtx, fee, msgs)
+	if err != nil {
+		return err
+	}
+
+	if remove {
 		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
 		_ = k.revokeAllowance(ctx, granter, grantee)
-
-		return k.emitUseGrantEvent(ctx, granterStr, granteeStr)
-	}
-	if err != nil {
+	} else if err := k.UpdateAllowance(ctx, granter, grantee, grant); err != nil {
+		// if fee allowance is accepted, store the updated state of the allowance
 		return err
 	}
-	if err := k.emitUseGrantEvent(ctx, granterStr, granteeStr); err != nil {
+
+	if err := k.useParentAllowances(acceptCtx, granter, record.ParentGrantee, fee, msgs); err != nil {
 		return err
 	}
 
-	// if fee allowance is accepted, store the updated state of the allowance
-	return k.UpdateAllowance(ctx, granter, grantee, grant)
+	return k.emitUseGrantEvent(ctx, granterStr, granteeStr)
+}
+
+// useParentAllowances deducts the fee from the allowance of parentGrantee and
+// all the allowances above it, so that a re-granted allowance can never spend
+// more than the allowances it was re-granted from.
+func (k Keeper) useParentAllowances(ctx context.Context, granter sdk.AccAddress, parentGrantee string, fee sdk.Coins, msgs []sdk.Msg) error {
+	for parentGrantee != "" {
+		parent, err := k.addrCdc.StringToBytes(parentGrantee)
+		if err != nil {
+			return err
+		}
+
+		record, err := k.FeeAllowance.Get(ctx, collections.Join(sdk.AccAddress(parent), granter))
+		if err != nil {
+			return errorsmod.Wrapf(err, "parent fee allowance of %s", parentGrantee)
+		}
+
+		grant, err := record.GetGrant()
+		if err != nil {
+			return err
+		}
+
+		remove, err := grant.Accept(ctx, fee, msgs)
+		if err != nil {
+			return errorsmod.Wrapf(err, "parent fee allowance of %s", parentGrantee)
+		}
+
+		if remove {
+			// revoking the parent allowance also revokes the allowances re-granted from it.
+			_ = k.revokeAllowance(ctx, granter, parent)
+		} else if err := k.UpdateAllowance(ctx, granter, parent, grant); err != nil {
+			return err
+		}
+
+		parentGrantee = record.ParentGrantee
+	}
+
+	return nil
 }
 
 func (k *Keeper) emitUseGrantEvent(ctx context.Context, granter, grantee string) error {
@@ -270,7 +443,15 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 
-		err = k.GrantAllowance(ctx, granter, grantee, grant)
+		var parent sdk.AccAddress
+		if f.ParentGrantee != "" {
+			parent, err = k.addrCdc.StringToBytes(f.ParentGrantee)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = k.grantAllowance(ctx, granter, grantee, grant, f.MaxRegrantDepth, parent)
 		if err != nil {
 			return err
 		}
@@ -300,12 +481,6 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 
 	keysToRemove := []collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress]{}
 	err := k.FeeAllowanceQueue.Walk(ctx, rng, func(key collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], value bool) (stop bool, err error) {
-		grantee, granter := key.K2(), key.K3()
-
-		if err := k.FeeAllowance.Remove(ctx, collections.Join(grantee, granter)); err != nil {
-			return true, err
-		}
-
 		keysToRemove = append(keysToRemove, key)
 
 		// limit the amount of iterations to avoid taking too much time
@@ -324,6 +499,30 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 		if err := k.FeeAllowanceQueue.Remove(ctx, key); err != nil {
 			return err
 		}
+
+		grantee, granter := key.K2(), key.K3()
+		grant, err := k.FeeAllowance.Get(ctx, collections.Join(grantee, granter))
+		if err != nil {
+			// the allowance was already removed, e.g. revoked along with its parent
+			// allowance, only its queue entry was left
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		if err := k.removeRegrantLink(ctx, granter, grantee, grant.ParentGrantee); err != nil {
+			return err
+		}
+
+		if err := k.FeeAllowance.Remove(ctx, collections.Join(grantee, granter)); err != nil {
+			return err
+		}
+
+		// the allowances re-granted from an expired allowance can't be used anymore
+		if err := k.revokeRegrants(ctx, granter, grantee); err != nil {
+			return err
+		}
 	}
 
 	return nil
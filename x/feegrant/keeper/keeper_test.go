@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	sdkmath "cosmossdk.io/math"
@@ -19,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -413,3 +416,232 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPruneRegrantedGrants() {
+	now := suite.ctx.HeaderInfo().Time
+	oneDay := now.AddDate(0, 0, 1)
+	granter, grantee, regrantee := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	// the expired allowance of the grantee must be pruned before the one of the regrantee
+	if bytes.Compare(grantee, regrantee) > 0 {
+		grantee, regrantee = regrantee, grantee
+	}
+
+	err := suite.feegrantKeeper.GrantRegrantableAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneDay,
+	}, 1)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.RegrantAllowance(suite.ctx, granter, grantee, regrantee, &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneDay,
+	}, 0)
+	suite.Require().NoError(err)
+
+	// a queue entry left without an allowance is skipped
+	err = suite.feegrantKeeper.FeeAllowanceQueue.Set(suite.ctx, collections.Join3(now, suite.addrs[3], granter), true)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.RemoveExpiredAllowances(suite.ctx, 5)
+	suite.Require().NoError(err)
+	has, err := suite.feegrantKeeper.FeeAllowanceQueue.Has(suite.ctx, collections.Join3(now, suite.addrs[3], granter))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// the allowance re-granted from the expired allowance is revoked along with it,
+	// even though only the expired allowance is pruned
+	err = suite.feegrantKeeper.RemoveExpiredAllowances(suite.ctx.WithHeaderInfo(header.Info{Time: oneDay}), 1)
+	suite.Require().NoError(err)
+	for _, addr := range []sdk.AccAddress{grantee, regrantee} {
+		_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, addr)
+		suite.Require().ErrorIs(err, collections.ErrNotFound)
+		has, err = suite.feegrantKeeper.FeeAllowanceQueue.Has(suite.ctx, collections.Join3(oneDay, addr, granter))
+		suite.Require().NoError(err)
+		suite.Require().False(has)
+	}
+	has, err = suite.feegrantKeeper.Regrants.Has(suite.ctx, collections.Join3(granter, grantee, regrantee))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestRegrantAllowance() {
+	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	granter, grantee, regrantee := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+
+	err := suite.feegrantKeeper.GrantRegrantableAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &exp,
+	}, 2)
+	suite.Require().NoError(err)
+
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, suite.addrs[3], &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+
+	later := exp.AddDate(1, 0, 0)
+	testCases := []struct {
+		name      string
+		grantee   sdk.AccAddress
+		regrantee sdk.AccAddress
+		allowance feegrant.FeeAllowanceI
+		maxDepth  uint32
+		expErr    error
+	}{
+		{
+			name:      "allowance is not regrantable",
+			grantee:   suite.addrs[3],
+			regrantee: regrantee,
+			allowance: &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp},
+			expErr:    feegrant.ErrRegrantNotAllowed,
+		},
+		{
+			name:      "max depth not lower than the parent one",
+			grantee:   grantee,
+			regrantee: regrantee,
+			allowance: &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp},
+			maxDepth:  2,
+			expErr:    feegrant.ErrInvalidRegrant,
+		},
+		{
+			name:      "no spend limit",
+			grantee:   grantee,
+			regrantee: regrantee,
+			allowance: &feegrant.BasicAllowance{Expiration: &exp},
+			expErr:    feegrant.ErrInvalidRegrant,
+		},
+		{
+			name:      "spend limit exceeds the parent one",
+			grantee:   grantee,
+			regrantee: regrantee,
+			allowance: &feegrant.BasicAllowance{SpendLimit: suite.atom.Add(suite.atom...), Expiration: &exp},
+			expErr:    feegrant.ErrInvalidRegrant,
+		},
+		{
+			name:      "expires after the parent allowance",
+			grantee:   grantee,
+			regrantee: regrantee,
+			allowance: &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &later},
+			expErr:    feegrant.ErrInvalidRegrant,
+		},
+		{
+			name:      "regrant to the granter",
+			grantee:   grantee,
+			regrantee: granter,
+			allowance: &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp},
+			expErr:    sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:      "valid regrant",
+			grantee:   grantee,
+			regrantee: regrantee,
+			allowance: &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp},
+			maxDepth:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.feegrantKeeper.RegrantAllowance(suite.ctx, granter, tc.grantee, tc.regrantee, tc.allowance, tc.maxDepth)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			grant, err := suite.feegrantKeeper.FeeAllowance.Get(suite.ctx, collections.Join(tc.regrantee, granter))
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.encodedAddrs[1], grant.ParentGrantee)
+			suite.Require().Equal(tc.maxDepth, grant.MaxRegrantDepth)
+		})
+	}
+
+	// the regrantee can re-grant one more level, which can't be re-granted further
+	err = suite.feegrantKeeper.RegrantAllowance(suite.ctx, granter, regrantee, suite.addrs[4], &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp}, 0)
+	suite.Require().NoError(err)
+
+	err = suite.feegrantKeeper.RegrantAllowance(suite.ctx, granter, suite.addrs[4], suite.addrs[5], &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp}, 0)
+	suite.Require().ErrorIs(err, feegrant.ErrRegrantNotAllowed)
+}
+
+func (suite *KeeperTestSuite) TestUseRegrantedFees() {
+	granter, grantee, regrantee := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 100))
+
+	err := suite.feegrantKeeper.GrantRegrantableAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: eth}, 1)
+	suite.Require().NoError(err)
+
+	err = suite.feegrantKeeper.RegrantAllowance(suite.ctx, granter, grantee, regrantee, &feegrant.BasicAllowance{SpendLimit: eth}, 0)
+	suite.Require().NoError(err)
+
+	// fees used by the regrantee are deducted from both allowances
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, regrantee, sdk.NewCoins(sdk.NewInt64Coin("eth", 60)), nil)
+	suite.Require().NoError(err)
+
+	for _, addr := range []sdk.AccAddress{grantee, regrantee} {
+		allowance, err := suite.feegrantKeeper.GetAllowance(suite.ctx, granter, addr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("eth", 40)), allowance.(*feegrant.BasicAllowance).SpendLimit)
+	}
+
+	// fees used by the grantee reduce what is left to the regrantee
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("eth", 30)), nil)
+	suite.Require().NoError(err)
+
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, regrantee, sdk.NewCoins(sdk.NewInt64Coin("eth", 20)), nil)
+	suite.Require().Error(err)
+
+	// using up the parent allowance revokes the re-granted one
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, regrantee, sdk.NewCoins(sdk.NewInt64Coin("eth", 10)), nil)
+	suite.Require().NoError(err)
+
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, regrantee)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+func (suite *KeeperTestSuite) TestRevokeRegrant() {
+	granter, grantee, regrantee, regrantee2 := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+
+	err := suite.feegrantKeeper.GrantRegrantableAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: suite.atom}, 2)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.RegrantAllowance(suite.ctx, granter, grantee, regrantee, &feegrant.BasicAllowance{SpendLimit: suite.atom}, 1)
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.RegrantAllowance(suite.ctx, granter, regrantee, regrantee2, &feegrant.BasicAllowance{SpendLimit: suite.atom}, 0)
+	suite.Require().NoError(err)
+
+	// only the grantee which re-granted the allowance can revoke it
+	_, err = suite.msgSrvr.RevokeRegrant(suite.ctx, &feegrant.MsgRevokeRegrant{
+		Granter:   suite.encodedAddrs[0],
+		Grantee:   suite.encodedAddrs[1],
+		Regrantee: suite.encodedAddrs[3],
+	})
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	// revoking a re-granted allowance revokes the allowances re-granted from it
+	_, err = suite.msgSrvr.RevokeRegrant(suite.ctx, &feegrant.MsgRevokeRegrant{
+		Granter:   suite.encodedAddrs[0],
+		Grantee:   suite.encodedAddrs[1],
+		Regrantee: suite.encodedAddrs[2],
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, regrantee)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, regrantee2)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().NoError(err)
+
+	// revoking the root allowance cascades as well
+	err = suite.feegrantKeeper.RegrantAllowance(suite.ctx, granter, grantee, regrantee, &feegrant.BasicAllowance{SpendLimit: suite.atom}, 0)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: suite.encodedAddrs[0], Grantee: suite.encodedAddrs[1]})
+	suite.Require().NoError(err)
+
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, regrantee)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	has, err := suite.feegrantKeeper.Regrants.Has(suite.ctx, collections.Join3(granter, grantee, regrantee))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
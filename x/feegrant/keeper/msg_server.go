@@ -4,10 +4,12 @@ import (
 	"context"
 	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/feegrant"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -54,7 +56,7 @@ func (k msgServer) GrantAllowance(ctx context.Context, msg *feegrant.MsgGrantAll
 		return nil, err
 	}
 
-	err = k.Keeper.GrantAllowance(ctx, granter, grantee, allowance)
+	err = k.Keeper.GrantRegrantableAllowance(ctx, granter, grantee, allowance, msg.MaxRegrantDepth)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +64,44 @@ func (k msgServer) GrantAllowance(ctx context.Context, msg *feegrant.MsgGrantAll
 	return &feegrant.MsgGrantAllowanceResponse{}, nil
 }
 
+// RegrantAllowance re-grants a portion of a fee allowance to a third party.
+func (k msgServer) RegrantAllowance(ctx context.Context, msg *feegrant.MsgRegrantAllowance) (*feegrant.MsgRegrantAllowanceResponse, error) {
+	if strings.EqualFold(msg.Regrantee, msg.Granter) || strings.EqualFold(msg.Regrantee, msg.Grantee) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot re-grant fee allowance to the granter or the grantee")
+	}
+
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := k.addrCdc.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	regrantee, err := k.addrCdc.StringToBytes(msg.Regrantee)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := msg.GetFeeAllowanceI()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.RegrantAllowance(ctx, granter, grantee, regrantee, allowance, msg.MaxRegrantDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRegrantAllowanceResponse{}, nil
+}
+
 // RevokeAllowance revokes a fee allowance between a granter and grantee.
 func (k msgServer) RevokeAllowance(ctx context.Context, msg *feegrant.MsgRevokeAllowance) (*feegrant.MsgRevokeAllowanceResponse, error) {
 	if msg.Grantee == msg.Granter {
@@ -86,6 +126,36 @@ func (k msgServer) RevokeAllowance(ctx context.Context, msg *feegrant.MsgRevokeA
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
 
+// RevokeRegrant revokes a fee allowance re-granted by the grantee, along with
+// all the allowances re-granted from it.
+func (k msgServer) RevokeRegrant(ctx context.Context, msg *feegrant.MsgRevokeRegrant) (*feegrant.MsgRevokeRegrantResponse, error) {
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	regrantee, err := k.addrCdc.StringToBytes(msg.Regrantee)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := k.FeeAllowance.Get(ctx, collections.Join(sdk.AccAddress(regrantee), sdk.AccAddress(granter)))
+	if err != nil {
+		return nil, err
+	}
+
+	if grant.ParentGrantee != msg.Grantee {
+		return nil, errorsmod.Wrapf(feegrant.ErrNoAllowance, "fee allowance of %s was not re-granted by %s", msg.Regrantee, msg.Grantee)
+	}
+
+	err = k.Keeper.revokeAllowance(ctx, granter, regrantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeRegrantResponse{}, nil
+}
+
 // PruneAllowances removes expired allowances from the store.
 func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAllowances) (*feegrant.MsgPruneAllowancesResponse, error) {
 	// 75 is an arbitrary value, we can change it later if needed
@@ -20,4 +20,8 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// RegrantsKeyPrefix is the set of the kvstore for the index of re-granted allowances
+	// - 0x02<granter><parent_grantee><grantee>: <empty value>
+	RegrantsKeyPrefix = collections.NewPrefix(2)
 )
@@ -60,6 +60,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "RevokeRegrant",
+					Use:       "revoke-regrant <granter> <grantee> <regrantee>",
+					Short:     "Revoke a re-granted fee allowance",
+					Long:      "Revoke the fee allowance re-granted by a grantee to a regrantee, along with all the allowances re-granted from it. Note, the '--from' flag is ignored as it is implied from [grantee]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant revoke-regrant [granter] [grantee] [regrantee]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantee"},
+						{ProtoField: "regrantee"},
+					},
+				},
 				{
 					RpcMethod: "PruneAllowances",
 					Use:       "prune",
@@ -12,8 +12,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg                              = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
-	_    gogoprotoany.UnpackInterfacesMessage = &MsgGrantAllowance{}
+	_, _, _, _ sdk.Msg                              = &MsgGrantAllowance{}, &MsgRevokeAllowance{}, &MsgRegrantAllowance{}, &MsgRevokeRegrant{}
+	_, _       gogoprotoany.UnpackInterfacesMessage = &MsgGrantAllowance{}, &MsgRegrantAllowance{}
 )
 
 // NewMsgGrantAllowance creates a new MsgGrantAllowance.
@@ -55,3 +55,45 @@ func (msg MsgGrantAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker)
 func NewMsgRevokeAllowance(granter, grantee string) MsgRevokeAllowance {
 	return MsgRevokeAllowance{Granter: granter, Grantee: grantee}
 }
+
+// NewMsgRegrantAllowance creates a new MsgRegrantAllowance.
+func NewMsgRegrantAllowance(feeAllowance FeeAllowanceI, granter, grantee, regrantee string, maxRegrantDepth uint32) (*MsgRegrantAllowance, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRegrantAllowance{
+		Granter:         granter,
+		Grantee:         grantee,
+		Regrantee:       regrantee,
+		Allowance:       any,
+		MaxRegrantDepth: maxRegrantDepth,
+	}, nil
+}
+
+// GetFeeAllowanceI returns unpacked FeeAllowance
+func (msg MsgRegrantAllowance) GetFeeAllowanceI() (FeeAllowanceI, error) {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRegrantAllowance) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+// NewMsgRevokeRegrant returns a message to revoke a fee allowance re-granted
+// by a grantee.
+func NewMsgRevokeRegrant(granter, grantee, regrantee string) MsgRevokeRegrant {
+	return MsgRevokeRegrant{Granter: granter, Grantee: grantee, Regrantee: regrantee}
+}
@@ -0,0 +1,75 @@
+package feegrant
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxRegrantDepth is the maximum number of levels of re-grants that can be
+// created below an allowance granted directly by a granter.
+const MaxRegrantDepth = 5
+
+// ValidateRegrant checks that the allowance re-granted from parent doesn't
+// exceed it: if the parent allowance has a spend limit, the re-granted one must
+// have a spend limit not greater than it, and if the parent allowance expires,
+// the re-granted one must expire no later.
+func ValidateRegrant(parent, regrant FeeAllowanceI) error {
+	parentLimit, err := spendLimit(parent)
+	if err != nil {
+		return err
+	}
+
+	if !parentLimit.Empty() {
+		regrantLimit, err := spendLimit(regrant)
+		if err != nil {
+			return err
+		}
+
+		if regrantLimit.Empty() {
+			return errorsmod.Wrapf(ErrInvalidRegrant, "spend limit is required as the parent allowance is limited to %s", parentLimit)
+		}
+
+		if !parentLimit.IsAllGTE(regrantLimit) {
+			return errorsmod.Wrapf(ErrInvalidRegrant, "spend limit %s exceeds the parent allowance spend limit %s", regrantLimit, parentLimit)
+		}
+	}
+
+	parentExp, err := parent.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	if parentExp != nil {
+		regrantExp, err := regrant.ExpiresAt()
+		if err != nil {
+			return err
+		}
+
+		if regrantExp == nil || regrantExp.After(*parentExp) {
+			return errorsmod.Wrapf(ErrInvalidRegrant, "allowance must expire no later than the parent allowance (%s)", parentExp)
+		}
+	}
+
+	return nil
+}
+
+// spendLimit returns the remaining spend limit of an allowance, or nil if it
+// is unlimited.
+func spendLimit(allowance FeeAllowanceI) (sdk.Coins, error) {
+	switch a := allowance.(type) {
+	case *BasicAllowance:
+		return a.SpendLimit, nil
+	case *PeriodicAllowance:
+		return a.Basic.SpendLimit, nil
+	case *AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+
+		return spendLimit(inner)
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidRegrant, "cannot re-grant allowance of type %T", allowance)
+	}
+}
@@ -0,0 +1,91 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateRegrant(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	now := time.Now()
+	oneYear := now.AddDate(1, 0, 0)
+	twoYears := now.AddDate(2, 0, 0)
+
+	allowedMsg, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: smallAtom}, []string{"/cosmos.gov.v1.MsgVote"})
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		parent  feegrant.FeeAllowanceI
+		regrant feegrant.FeeAllowanceI
+		valid   bool
+	}{
+		"unlimited parent": {
+			parent:  &feegrant.BasicAllowance{},
+			regrant: &feegrant.BasicAllowance{},
+			valid:   true,
+		},
+		"lower spend limit": {
+			parent:  &feegrant.BasicAllowance{SpendLimit: atom},
+			regrant: &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			valid:   true,
+		},
+		"no spend limit": {
+			parent:  &feegrant.BasicAllowance{SpendLimit: atom},
+			regrant: &feegrant.BasicAllowance{},
+		},
+		"spend limit in another denom": {
+			parent:  &feegrant.BasicAllowance{SpendLimit: atom},
+			regrant: &feegrant.BasicAllowance{SpendLimit: eth},
+		},
+		"higher spend limit": {
+			parent:  &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			regrant: &feegrant.BasicAllowance{SpendLimit: atom},
+		},
+		"periodic within basic limit": {
+			parent: &feegrant.BasicAllowance{SpendLimit: atom},
+			regrant: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: smallAtom},
+				Period:           time.Hour,
+				PeriodSpendLimit: smallAtom,
+			},
+			valid: true,
+		},
+		"allowed msgs within limit": {
+			parent:  &feegrant.BasicAllowance{SpendLimit: atom},
+			regrant: allowedMsg,
+			valid:   true,
+		},
+		"earlier expiration": {
+			parent:  &feegrant.BasicAllowance{Expiration: &twoYears},
+			regrant: &feegrant.BasicAllowance{Expiration: &oneYear},
+			valid:   true,
+		},
+		"no expiration": {
+			parent:  &feegrant.BasicAllowance{Expiration: &oneYear},
+			regrant: &feegrant.BasicAllowance{},
+		},
+		"later expiration": {
+			parent:  &feegrant.BasicAllowance{Expiration: &oneYear},
+			regrant: &feegrant.BasicAllowance{Expiration: &twoYears},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := feegrant.ValidateRegrant(tc.parent, tc.regrant)
+			if !tc.valid {
+				require.ErrorIs(t, err, feegrant.ErrInvalidRegrant)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -40,6 +40,10 @@ type MsgGrantAllowance struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic, periodic, allowed fee allowance.
 	Allowance *any.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_regrant_depth is the number of levels of re-grants the grantee can
+	// create from this allowance. If it is zero, the allowance cannot be
+	// re-granted.
+	MaxRegrantDepth uint32 `protobuf:"varint,4,opt,name=max_regrant_depth,json=maxRegrantDepth,proto3" json:"max_regrant_depth,omitempty"`
 }
 
 func (m *MsgGrantAllowance) Reset()         { *m = MsgGrantAllowance{} }
@@ -96,6 +100,13 @@ func (m *MsgGrantAllowance) GetAllowance() *any.Any {
 	return nil
 }
 
+func (m *MsgGrantAllowance) GetMaxRegrantDepth() uint32 {
+	if m != nil {
+		return m.MaxRegrantDepth
+	}
+	return 0
+}
+
 // MsgGrantAllowanceResponse defines the Msg/GrantAllowanceResponse response type.
 type MsgGrantAllowanceResponse struct {
 }
@@ -308,6 +319,231 @@ func (m *MsgPruneAllowancesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneAllowancesResponse proto.InternalMessageInfo
 
+// MsgRegrantAllowance re-grants a portion of the allowance of Grantee on the
+// funds of Granter to Regrantee. The re-granted allowance is paid by Granter,
+// it cannot exceed the allowance it is re-granted from, and every fee paid
+// through it is also deducted from that allowance.
+type MsgRegrantAllowance struct {
+	// granter is the address of the user whose funds pay the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user re-granting a portion of its allowance.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// regrantee is the address of the user being re-granted the allowance.
+	Regrantee string `protobuf:"bytes,3,opt,name=regrantee,proto3" json:"regrantee,omitempty"`
+	// allowance can be any of basic, periodic, allowed fee allowance.
+	Allowance *any.Any `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_regrant_depth is the number of levels of re-grants the regrantee can
+	// create from this allowance. It must be lower than the one of the grantee.
+	MaxRegrantDepth uint32 `protobuf:"varint,5,opt,name=max_regrant_depth,json=maxRegrantDepth,proto3" json:"max_regrant_depth,omitempty"`
+}
+
+func (m *MsgRegrantAllowance) Reset()         { *m = MsgRegrantAllowance{} }
+func (m *MsgRegrantAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgRegrantAllowance) ProtoMessage()    {}
+func (*MsgRegrantAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{6}
+}
+func (m *MsgRegrantAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegrantAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegrantAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegrantAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegrantAllowance.Merge(m, src)
+}
+func (m *MsgRegrantAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegrantAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegrantAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegrantAllowance proto.InternalMessageInfo
+
+func (m *MsgRegrantAllowance) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgRegrantAllowance) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgRegrantAllowance) GetRegrantee() string {
+	if m != nil {
+		return m.Regrantee
+	}
+	return ""
+}
+
+func (m *MsgRegrantAllowance) GetAllowance() *any.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+func (m *MsgRegrantAllowance) GetMaxRegrantDepth() uint32 {
+	if m != nil {
+		return m.MaxRegrantDepth
+	}
+	return 0
+}
+
+// MsgRegrantAllowanceResponse defines the Msg/RegrantAllowance response type.
+type MsgRegrantAllowanceResponse struct {
+}
+
+func (m *MsgRegrantAllowanceResponse) Reset()         { *m = MsgRegrantAllowanceResponse{} }
+func (m *MsgRegrantAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegrantAllowanceResponse) ProtoMessage()    {}
+func (*MsgRegrantAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{7}
+}
+func (m *MsgRegrantAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegrantAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegrantAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegrantAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegrantAllowanceResponse.Merge(m, src)
+}
+func (m *MsgRegrantAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegrantAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegrantAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegrantAllowanceResponse proto.InternalMessageInfo
+
+// MsgRevokeRegrant revokes the allowance re-granted by Grantee to Regrantee on
+// the funds of Granter.
+type MsgRevokeRegrant struct {
+	// granter is the address of the user whose funds pay the fees.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user that re-granted the allowance.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// regrantee is the address of the user whose re-granted allowance is revoked.
+	Regrantee string `protobuf:"bytes,3,opt,name=regrantee,proto3" json:"regrantee,omitempty"`
+}
+
+func (m *MsgRevokeRegrant) Reset()         { *m = MsgRevokeRegrant{} }
+func (m *MsgRevokeRegrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRegrant) ProtoMessage()    {}
+func (*MsgRevokeRegrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{8}
+}
+func (m *MsgRevokeRegrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRegrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRegrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRegrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRegrant.Merge(m, src)
+}
+func (m *MsgRevokeRegrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRegrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRegrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRegrant proto.InternalMessageInfo
+
+func (m *MsgRevokeRegrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgRevokeRegrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgRevokeRegrant) GetRegrantee() string {
+	if m != nil {
+		return m.Regrantee
+	}
+	return ""
+}
+
+// MsgRevokeRegrantResponse defines the Msg/RevokeRegrant response type.
+type MsgRevokeRegrantResponse struct {
+}
+
+func (m *MsgRevokeRegrantResponse) Reset()         { *m = MsgRevokeRegrantResponse{} }
+func (m *MsgRevokeRegrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRegrantResponse) ProtoMessage()    {}
+func (*MsgRevokeRegrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{9}
+}
+func (m *MsgRevokeRegrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRegrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRegrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRegrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRegrantResponse.Merge(m, src)
+}
+func (m *MsgRevokeRegrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRegrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRegrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRegrantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
@@ -315,43 +551,57 @@ func init() {
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
 	proto.RegisterType((*MsgPruneAllowances)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowances")
 	proto.RegisterType((*MsgPruneAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowancesResponse")
+	proto.RegisterType((*MsgRegrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgRegrantAllowance")
+	proto.RegisterType((*MsgRegrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRegrantAllowanceResponse")
+	proto.RegisterType((*MsgRevokeRegrant)(nil), "cosmos.feegrant.v1beta1.MsgRevokeRegrant")
+	proto.RegisterType((*MsgRevokeRegrantResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeRegrantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0xa8, 0x84, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0x75, 0x32,
-	0x71, 0x09, 0xfa, 0x16, 0xa7, 0xbb, 0x83, 0xac, 0x75, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0xcc, 0x4b,
-	0x4e, 0x15, 0x32, 0xe2, 0x62, 0x07, 0x3b, 0x24, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3,
-	0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x25, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1,
-	0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x30, 0x85, 0x08, 0x3d, 0xa9, 0x12, 0x4c, 0xc4, 0xe9, 0x49,
-	0x15, 0x8a, 0xe5, 0xe2, 0x4c, 0x84, 0x59, 0x2a, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x07, 0xf1, 0xb3, 0x1e, 0xcc, 0xcf, 0x7a, 0x8e, 0x79, 0x95, 0x4e, 0x9a, 0xa7, 0xb6, 0xe8, 0xaa,
-	0xe2, 0x08, 0x25, 0x3d, 0xb7, 0xd4, 0x54, 0xb8, 0xd3, 0x3d, 0x83, 0x10, 0x26, 0x5a, 0xe9, 0x36,
-	0x3d, 0xdf, 0xa0, 0x05, 0x73, 0x60, 0xd7, 0xf3, 0x0d, 0x5a, 0x32, 0x10, 0x23, 0x74, 0x8b, 0x53,
-	0xb2, 0xf5, 0x31, 0x7c, 0xad, 0x24, 0xcd, 0x25, 0x89, 0x21, 0x18, 0x94, 0x5a, 0x5c, 0x90, 0x9f,
-	0x57, 0x9c, 0xaa, 0xb4, 0x86, 0x91, 0x4b, 0xc8, 0xb7, 0x38, 0x3d, 0x28, 0xb5, 0x2c, 0x3f, 0x3b,
-	0x95, 0xee, 0x21, 0x65, 0xa5, 0x87, 0xee, 0x15, 0x59, 0x54, 0xaf, 0xa0, 0xb9, 0x4b, 0x49, 0x86,
-	0x4b, 0x0a, 0x53, 0x14, 0xee, 0x99, 0x34, 0xb0, 0x5f, 0x02, 0x8a, 0x4a, 0xf3, 0x10, 0x92, 0xc5,
-	0x42, 0x06, 0x5c, 0x6c, 0x05, 0x20, 0x21, 0xc2, 0x5e, 0x81, 0xaa, 0xb3, 0x92, 0xbb, 0xb4, 0x45,
-	0x97, 0x1f, 0xe1, 0x10, 0x05, 0x03, 0x3d, 0x53, 0x03, 0x90, 0x43, 0xa1, 0xf2, 0x4a, 0x86, 0x60,
-	0x57, 0xa0, 0xd9, 0x03, 0x73, 0x85, 0x95, 0x30, 0x16, 0xdd, 0x46, 0xff, 0x98, 0xb8, 0x98, 0x7d,
-	0x8b, 0xd3, 0x85, 0x0a, 0xb8, 0xf8, 0xd0, 0x12, 0xa5, 0x96, 0x1e, 0xae, 0x04, 0x80, 0x11, 0x6b,
-	0x52, 0x46, 0xc4, 0xab, 0x85, 0x39, 0x47, 0xa8, 0x98, 0x8b, 0x1f, 0x3d, 0x76, 0xb5, 0xf1, 0x19,
-	0x83, 0xa6, 0x58, 0xca, 0x98, 0x04, 0xc5, 0x70, 0x4b, 0x3b, 0x18, 0xb9, 0xf8, 0xd1, 0xe3, 0x01,
-	0xaf, 0xad, 0x68, 0x8a, 0xf1, 0xdb, 0x8a, 0x23, 0xe4, 0x95, 0x84, 0x4f, 0x61, 0x86, 0xbc, 0x14,
-	0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0x86, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x05, 0x2d, 0x57, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0xe0, 0x05, 0x5a,
-	0x12, 0x1b, 0x38, 0xaf, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x32, 0x6b, 0x8d, 0xea,
-	0x04, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x8f, 0xd2, 0x40,
+	0x14, 0xc7, 0x77, 0xf6, 0x97, 0x61, 0xcc, 0xca, 0x52, 0x36, 0xb1, 0x94, 0xb5, 0x21, 0x4d, 0x4c,
+	0x58, 0x94, 0x0e, 0x2d, 0xea, 0x81, 0xcb, 0x06, 0x62, 0x34, 0x1e, 0x48, 0x4c, 0xbd, 0x99, 0x18,
+	0x52, 0x96, 0xa1, 0x12, 0xa0, 0x25, 0x9d, 0x2e, 0xb2, 0x37, 0xb3, 0xf1, 0x60, 0xf4, 0xa2, 0xff,
+	0x87, 0x07, 0x0e, 0xfc, 0x11, 0x86, 0xd3, 0x66, 0x4f, 0xc6, 0x93, 0x81, 0x03, 0x7f, 0x80, 0xff,
+	0x80, 0x69, 0x3b, 0x2d, 0xd2, 0xb2, 0x5d, 0x48, 0x8c, 0x7a, 0xd9, 0x0d, 0x6f, 0x3e, 0x6f, 0xde,
+	0xfb, 0xbe, 0x79, 0xef, 0xa5, 0x30, 0x73, 0x62, 0x90, 0xae, 0x41, 0x50, 0x13, 0x63, 0xcd, 0x54,
+	0x75, 0x0b, 0xf5, 0xa5, 0x3a, 0xb6, 0x54, 0x09, 0x59, 0x03, 0xb1, 0x67, 0x1a, 0x96, 0xc1, 0xdc,
+	0x76, 0x09, 0xd1, 0x23, 0x44, 0x4a, 0x70, 0x29, 0xcd, 0x30, 0xb4, 0x0e, 0x46, 0x0e, 0x56, 0x3f,
+	0x6d, 0x22, 0x55, 0x3f, 0x73, 0x7d, 0xb8, 0x94, 0xeb, 0x53, 0x73, 0x7e, 0x21, 0x7a, 0x81, 0x7b,
+	0x44, 0xaf, 0x43, 0x5d, 0xa2, 0xa1, 0xbe, 0x64, 0xff, 0xa3, 0x07, 0x09, 0xb5, 0xdb, 0xd2, 0x0d,
+	0xe4, 0xfc, 0x75, 0x4d, 0xc2, 0x78, 0x13, 0x26, 0xaa, 0x44, 0x7b, 0x6a, 0x87, 0x2d, 0x77, 0x3a,
+	0xc6, 0x1b, 0x55, 0x3f, 0xc1, 0x8c, 0x0c, 0x6f, 0x38, 0x89, 0x60, 0x93, 0x05, 0x19, 0x90, 0x8d,
+	0x55, 0xd8, 0xcb, 0x51, 0xfe, 0x80, 0x06, 0x29, 0x37, 0x1a, 0x26, 0x26, 0xe4, 0x85, 0x65, 0xb6,
+	0x74, 0x4d, 0xf1, 0xc0, 0xb9, 0x0f, 0x66, 0x37, 0x57, 0xf3, 0xc1, 0xcc, 0x2b, 0x18, 0x53, 0xbd,
+	0xa0, 0xec, 0x56, 0x06, 0x64, 0x6f, 0xca, 0x07, 0xa2, 0xab, 0x59, 0xf4, 0x34, 0x8b, 0x65, 0xfd,
+	0xac, 0x72, 0x34, 0x1e, 0xe5, 0xef, 0x5e, 0x51, 0x25, 0xf1, 0x09, 0xc6, 0x7e, 0xea, 0xcf, 0x94,
+	0xf9, 0x8d, 0xcc, 0x31, 0x4c, 0x74, 0xd5, 0x41, 0xcd, 0x74, 0xf9, 0x5a, 0x03, 0xf7, 0xac, 0xd7,
+	0xec, 0x76, 0x06, 0x64, 0xf7, 0x2a, 0xc9, 0xef, 0xa3, 0x7c, 0xdc, 0xbd, 0x30, 0x4f, 0x1a, 0xed,
+	0x4c, 0x41, 0x7c, 0x28, 0x2b, 0xf1, 0xae, 0x3a, 0x50, 0x5c, 0xf8, 0xb1, 0xcd, 0x96, 0xf2, 0xe7,
+	0xb3, 0x61, 0xce, 0x53, 0xf8, 0x61, 0x36, 0xcc, 0x1d, 0xce, 0x5d, 0x50, 0xa8, 0x6c, 0x42, 0x1a,
+	0xa6, 0x42, 0x46, 0x05, 0x93, 0x9e, 0xa1, 0x13, 0x2c, 0x7c, 0x01, 0x90, 0xa9, 0x12, 0x4d, 0xc1,
+	0x7d, 0xa3, 0x8d, 0xff, 0x7a, 0xa9, 0x4b, 0x62, 0x50, 0xca, 0x9d, 0x45, 0x29, 0x81, 0xbc, 0x84,
+	0x43, 0xc8, 0x85, 0xad, 0xbe, 0x98, 0xa6, 0xa3, 0xe5, 0xb9, 0x79, 0xaa, 0xcf, 0x0f, 0x09, 0x53,
+	0x80, 0xbb, 0x3d, 0xdb, 0x74, 0xbd, 0x14, 0xca, 0x95, 0xf8, 0xcb, 0xd0, 0x33, 0x14, 0xec, 0x44,
+	0xe9, 0xb9, 0x20, 0x41, 0x2e, 0x1c, 0xc7, 0xcb, 0xa2, 0x94, 0x5c, 0xe2, 0x2d, 0xbc, 0xdb, 0x82,
+	0x49, 0x27, 0x73, 0xed, 0xdf, 0xf4, 0xf4, 0x23, 0x18, 0xa3, 0x0d, 0x87, 0xdd, 0x9e, 0x8e, 0xf2,
+	0x9a, 0xa3, 0x8b, 0xb3, 0xb0, 0xfd, 0xc7, 0x67, 0x21, 0xb7, 0x6c, 0x16, 0x76, 0xec, 0x59, 0x08,
+	0xb7, 0x7d, 0x25, 0x5c, 0x57, 0xf9, 0xb7, 0xf6, 0xc1, 0x76, 0xfb, 0xf0, 0xc1, 0xf6, 0x59, 0x2c,
+	0xb7, 0x20, 0xc3, 0xf4, 0x12, 0x73, 0xd4, 0xd3, 0xc9, 0xc2, 0x4f, 0x00, 0xf7, 0xfd, 0xa6, 0xa3,
+	0xae, 0xff, 0xfb, 0xbb, 0x95, 0x8e, 0x57, 0x28, 0x56, 0x7a, 0xd9, 0xac, 0x51, 0x81, 0x02, 0x82,
+	0x6c, 0xd0, 0x16, 0x59, 0x26, 0xf9, 0xf3, 0x0e, 0xdc, 0xaa, 0x12, 0x8d, 0xe9, 0xc1, 0x5b, 0x81,
+	0xbd, 0x9d, 0x13, 0xaf, 0xea, 0x8b, 0xd0, 0x5e, 0xe2, 0xe4, 0xd5, 0x59, 0x2f, 0x1d, 0x86, 0xc0,
+	0x78, 0x70, 0x7f, 0xdd, 0x8b, 0xba, 0x26, 0x00, 0x73, 0xc5, 0x35, 0x60, 0x3f, 0xe8, 0x7b, 0x00,
+	0xe3, 0xc1, 0x4d, 0x13, 0x19, 0x35, 0x00, 0x73, 0xc5, 0x35, 0x60, 0x7f, 0xc3, 0x25, 0xc7, 0xe1,
+	0xdd, 0xc2, 0x7c, 0x04, 0x70, 0x3f, 0xb4, 0x58, 0xee, 0x47, 0x8b, 0x5a, 0xa4, 0xb9, 0x07, 0xeb,
+	0xd0, 0x51, 0xd9, 0xc8, 0xcc, 0x39, 0x80, 0x7b, 0x8b, 0xb3, 0x72, 0x74, 0x7d, 0x7d, 0x29, 0xca,
+	0x49, 0x2b, 0xa3, 0x91, 0x49, 0x70, 0x3b, 0x6f, 0x67, 0xc3, 0x1c, 0xa8, 0x48, 0x5f, 0x27, 0x3c,
+	0xb8, 0x98, 0xf0, 0xe0, 0xc7, 0x84, 0x07, 0x9f, 0xa6, 0xfc, 0xc6, 0xc5, 0x94, 0xdf, 0xf8, 0x36,
+	0xe5, 0x37, 0x5e, 0xd2, 0xaf, 0x11, 0xd2, 0x68, 0x8b, 0x2d, 0x03, 0x0d, 0xfc, 0xcf, 0xa0, 0xfa,
+	0xae, 0xb3, 0xd5, 0x8a, 0xbf, 0x06, 0x00, 0x7b, 0x3e, 0x79, 0x75, 0x20, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -374,6 +624,12 @@ type MsgClient interface {
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// RegrantAllowance re-grants a portion of the grantee's allowance of the
+	// granter's funds to a third party.
+	RegrantAllowance(ctx context.Context, in *MsgRegrantAllowance, opts ...grpc.CallOption) (*MsgRegrantAllowanceResponse, error)
+	// RevokeRegrant revokes an allowance re-granted by the grantee, along with
+	// all the allowances re-granted from it.
+	RevokeRegrant(ctx context.Context, in *MsgRevokeRegrant, opts ...grpc.CallOption) (*MsgRevokeRegrantResponse, error)
 }
 
 type msgClient struct {
@@ -411,6 +667,24 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) RegrantAllowance(ctx context.Context, in *MsgRegrantAllowance, opts ...grpc.CallOption) (*MsgRegrantAllowanceResponse, error) {
+	out := new(MsgRegrantAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RegrantAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRegrant(ctx context.Context, in *MsgRevokeRegrant, opts ...grpc.CallOption) (*MsgRevokeRegrantResponse, error) {
+	out := new(MsgRevokeRegrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeRegrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -421,6 +695,12 @@ type MsgServer interface {
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// RegrantAllowance re-grants a portion of the grantee's allowance of the
+	// granter's funds to a third party.
+	RegrantAllowance(context.Context, *MsgRegrantAllowance) (*MsgRegrantAllowanceResponse, error)
+	// RevokeRegrant revokes an allowance re-granted by the grantee, along with
+	// all the allowances re-granted from it.
+	RevokeRegrant(context.Context, *MsgRevokeRegrant) (*MsgRevokeRegrantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -436,6 +716,12 @@ func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevo
 func (*UnimplementedMsgServer) PruneAllowances(ctx context.Context, req *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (*UnimplementedMsgServer) RegrantAllowance(ctx context.Context, req *MsgRegrantAllowance) (*MsgRegrantAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegrantAllowance not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRegrant(ctx context.Context, req *MsgRevokeRegrant) (*MsgRevokeRegrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRegrant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -495,6 +781,42 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegrantAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegrantAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegrantAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RegrantAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegrantAllowance(ctx, req.(*MsgRegrantAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRegrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRegrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRegrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeRegrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRegrant(ctx, req.(*MsgRevokeRegrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
@@ -512,6 +834,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "RegrantAllowance",
+			Handler:    _Msg_RegrantAllowance_Handler,
+		},
+		{
+			MethodName: "RevokeRegrant",
+			Handler:    _Msg_RevokeRegrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -537,6 +867,11 @@ func (m *MsgGrantAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRegrantDepth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRegrantDepth))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -702,49 +1037,203 @@ func (m *MsgPruneAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegrantAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrantAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegrantAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegrantAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MaxRegrantDepth != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRegrantDepth))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Regrantee) > 0 {
+		i -= len(m.Regrantee)
+		copy(dAtA[i:], m.Regrantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Regrantee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	return n
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeAllowance) Size() (n int) {
-	if m == nil {
+func (m *MsgRegrantAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegrantAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegrantAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRegrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRegrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRegrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regrantee) > 0 {
+		i -= len(m.Regrantee)
+		copy(dAtA[i:], m.Regrantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Regrantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRegrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRegrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRegrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxRegrantDepth != 0 {
+		n += 1 + sovTx(uint64(m.MaxRegrantDepth))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAllowance) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -791,6 +1280,73 @@ func (m *MsgPruneAllowancesResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegrantAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Regrantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxRegrantDepth != 0 {
+		n += 1 + sovTx(uint64(m.MaxRegrantDepth))
+	}
+	return n
+}
+
+func (m *MsgRegrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeRegrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Regrantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeRegrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -926,6 +1482,25 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegrantDepth", wireType)
+			}
+			m.MaxRegrantDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRegrantDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1293,6 +1868,453 @@ func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegrantAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegrantAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegrantAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regrantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regrantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegrantDepth", wireType)
+			}
+			m.MaxRegrantDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRegrantDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegrantAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegrantAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegrantAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeRegrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeRegrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeRegrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regrantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regrantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeRegrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeRegrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeRegrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,309 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// GenesisAnalysis is the result of the analysis of a genesis file.
+type GenesisAnalysis struct {
+	// ChainID is the chain id of the genesis file.
+	ChainID string `json:"chain_id"`
+	// AppStateSize is the size in bytes of the app state.
+	AppStateSize int `json:"app_state_size"`
+	// Modules holds the statistics of each module state, sorted by module name.
+	Modules []ModuleGenesisStats `json:"modules"`
+	// Issues holds the problems found in the genesis file.
+	Issues []string `json:"issues"`
+}
+
+// ModuleGenesisStats holds the statistics of the genesis state of a module.
+type ModuleGenesisStats struct {
+	// Name is the module name.
+	Name string `json:"name"`
+	// Size is the size in bytes of the module state.
+	Size int `json:"size"`
+	// Entries holds the number of elements of each top-level list of the module state.
+	Entries map[string]int `json:"entries,omitempty"`
+}
+
+// AnalyzeGenesisCmd analyzes a genesis file, reporting the issues which would make the chain
+// fail to start and printing statistics about the state of each module.
+func AnalyzeGenesisCmd(genMM genesisMM) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze [file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Analyzes the genesis file at the default location or at the location passed as an arg",
+		Long: `Analyzes the genesis file at the default location or at the location passed as an arg.
+It validates the state of each module registered in the application, and reports the modules unknown
+to the application or missing from the genesis file, the invalid module states, the duplicate accounts
+and the difference between the bank supply and the sum of the balances.
+It also prints the size and the number of entries of the state of each module.
+The command fails if any issue is found.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cfg := client.GetConfigFromCmd(cmd)
+
+			genesis := cfg.GenesisFile()
+			if len(args) == 1 {
+				genesis = args[0]
+			}
+
+			appGenesis, err := types.AppGenesisFromFile(genesis)
+			if err != nil {
+				return enrichUnmarshalError(err)
+			}
+
+			if err := appGenesis.ValidateAndComplete(); err != nil {
+				return fmt.Errorf("make sure that you have correctly migrated all CometBFT consensus params. Refer the UPGRADING.md (%s): %w", chainUpgradeGuide, err)
+			}
+
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("error unmarshalling app state of genesis doc %s: %w", genesis, err)
+			}
+
+			analysis := AnalyzeGenesis(clientCtx, genMM, appState)
+			analysis.ChainID = appGenesis.ChainID
+			analysis.AppStateSize = len(appGenesis.AppState)
+
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			if output == flags.OutputFormatJSON {
+				bz, err := json.MarshalIndent(analysis, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			} else if err := printGenesisAnalysis(cmd.OutOrStdout(), analysis); err != nil {
+				return err
+			}
+
+			if len(analysis.Issues) > 0 {
+				return fmt.Errorf("found %d issue(s) in genesis file %s", len(analysis.Issues), genesis)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// AnalyzeGenesis analyzes the app state of a genesis file against the modules of the application.
+// The state of each module is validated on its own, on top of the default genesis of the other modules,
+// so that all the invalid module states are reported at once.
+func AnalyzeGenesis(clientCtx client.Context, genMM genesisMM, appState map[string]json.RawMessage) GenesisAnalysis {
+	analysis := GenesisAnalysis{Issues: []string{}}
+
+	for _, name := range slices.Sorted(maps.Keys(appState)) {
+		analysis.Modules = append(analysis.Modules, moduleGenesisStats(name, appState[name]))
+	}
+
+	if genMM != nil {
+		analysis.Issues = append(analysis.Issues, validateModuleStates(genMM, appState)...)
+	}
+
+	if clientCtx.Codec != nil && clientCtx.AddressCodec != nil {
+		analysis.Issues = append(analysis.Issues, checkGenesisAccounts(clientCtx, appState)...)
+		analysis.Issues = append(analysis.Issues, checkGenesisBalances(clientCtx, appState)...)
+	}
+
+	return analysis
+}
+
+func moduleGenesisStats(name string, state json.RawMessage) ModuleGenesisStats {
+	stats := ModuleGenesisStats{Name: name, Size: len(state)}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(state, &fields); err != nil {
+		return stats
+	}
+
+	for field, value := range fields {
+		var list []json.RawMessage
+		if err := json.Unmarshal(value, &list); err != nil {
+			continue
+		}
+		if stats.Entries == nil {
+			stats.Entries = make(map[string]int)
+		}
+		stats.Entries[field] = len(list)
+	}
+
+	return stats
+}
+
+// validateModuleStates reports the modules unknown to the application, the modules missing from the
+// app state, and the module states failing validation.
+func validateModuleStates(genMM genesisMM, appState map[string]json.RawMessage) []string {
+	var issues []string
+
+	defaults := genMM.DefaultGenesis()
+	for _, name := range slices.Sorted(maps.Keys(appState)) {
+		if _, ok := defaults[name]; !ok {
+			issues = append(issues, fmt.Sprintf("unknown module %s: the application has no module with this name", name))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(defaults)) {
+		if _, ok := appState[name]; !ok {
+			issues = append(issues, fmt.Sprintf("module %s is missing from the app state", name))
+		}
+	}
+
+	// the module states can only be validated one by one if the default genesis is valid,
+	// fallback to validating the whole app state otherwise.
+	if err := genMM.ValidateGenesis(defaults); err != nil {
+		if err := genMM.ValidateGenesis(appState); err != nil {
+			issues = append(issues, fmt.Sprintf("invalid app state: %v", err))
+		}
+		return issues
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(defaults)) {
+		state, ok := appState[name]
+		if !ok {
+			continue
+		}
+
+		genesis := maps.Clone(defaults)
+		genesis[name] = state
+		if err := genMM.ValidateGenesis(genesis); err != nil {
+			issues = append(issues, fmt.Sprintf("module %s: invalid state: %v", name, err))
+		}
+	}
+
+	return issues
+}
+
+// checkGenesisAccounts reports the auth accounts sharing an address or an account number.
+func checkGenesisAccounts(clientCtx client.Context, appState map[string]json.RawMessage) []string {
+	state, ok := appState[authtypes.ModuleName]
+	if !ok {
+		return nil
+	}
+
+	var authGenState authtypes.GenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(state, &authGenState); err != nil {
+		return []string{fmt.Sprintf("module %s: failed to decode accounts: %v", authtypes.ModuleName, err)}
+	}
+
+	accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return []string{fmt.Sprintf("module %s: failed to unpack accounts: %v", authtypes.ModuleName, err)}
+	}
+
+	var issues []string
+	addresses := make(map[string]struct{}, len(accounts))
+	numbers := make(map[uint64]string, len(accounts))
+	for _, acc := range accounts {
+		addr, err := clientCtx.AddressCodec.BytesToString(acc.GetAddress())
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("module %s: invalid account address: %v", authtypes.ModuleName, err))
+			continue
+		}
+
+		if _, ok := addresses[addr]; ok {
+			issues = append(issues, fmt.Sprintf("duplicate account %s", addr))
+			continue
+		}
+		addresses[addr] = struct{}{}
+
+		if other, ok := numbers[acc.GetAccountNumber()]; ok {
+			issues = append(issues, fmt.Sprintf("accounts %s and %s share the account number %d", other, addr, acc.GetAccountNumber()))
+			continue
+		}
+		numbers[acc.GetAccountNumber()] = addr
+	}
+
+	return issues
+}
+
+// checkGenesisBalances reports the duplicate bank balances and the difference between the bank supply
+// and the sum of the balances.
+func checkGenesisBalances(clientCtx client.Context, appState map[string]json.RawMessage) []string {
+	state, ok := appState[banktypes.ModuleName]
+	if !ok {
+		return nil
+	}
+
+	var bankGenState banktypes.GenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(state, &bankGenState); err != nil {
+		return []string{fmt.Sprintf("module %s: failed to decode balances: %v", banktypes.ModuleName, err)}
+	}
+
+	var issues []string
+	addresses := make(map[string]struct{}, len(bankGenState.Balances))
+	total := sdk.NewCoins()
+	for _, balance := range bankGenState.Balances {
+		if _, ok := addresses[balance.Address]; ok {
+			issues = append(issues, fmt.Sprintf("duplicate balance for account %s", balance.Address))
+			continue
+		}
+		addresses[balance.Address] = struct{}{}
+
+		// invalid coins are reported by the module state validation.
+		if balance.Coins.Validate() != nil {
+			continue
+		}
+		total = total.Add(balance.Coins...)
+	}
+
+	// an empty supply is computed from the balances on InitGenesis.
+	if !bankGenState.Supply.Empty() && !bankGenState.Supply.Equal(total) {
+		issues = append(issues, fmt.Sprintf("bank supply %s does not match the sum of the balances %s", bankGenState.Supply, total))
+	}
+
+	return issues
+}
+
+func printGenesisAnalysis(out io.Writer, analysis GenesisAnalysis) error {
+	fmt.Fprintf(out, "Chain ID: %s\nApp state size: %d bytes\n\n", analysis.ChainID, analysis.AppStateSize)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODULE\tSIZE (BYTES)\tSHARE\tENTRIES")
+	for _, module := range analysis.Modules {
+		share := 0.0
+		if analysis.AppStateSize > 0 {
+			share = 100 * float64(module.Size) / float64(analysis.AppStateSize)
+		}
+
+		entries := make([]string, 0, len(module.Entries))
+		for _, field := range slices.Sorted(maps.Keys(module.Entries)) {
+			entries = append(entries, fmt.Sprintf("%s=%d", field, module.Entries[field]))
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%.1f%%\t%s\n", module.Name, module.Size, share, strings.Join(entries, " "))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(analysis.Issues) == 0 {
+		fmt.Fprintln(out, "\nNo issue found")
+		return nil
+	}
+
+	fmt.Fprintf(out, "\nIssues:\n")
+	for _, issue := range analysis.Issues {
+		fmt.Fprintf(out, "- %s\n", issue)
+	}
+
+	return nil
+}
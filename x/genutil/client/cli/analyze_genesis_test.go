@@ -0,0 +1,94 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/x/bank"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+)
+
+const analyzedAppState = `{
+	"auth": {
+		"params": {"max_memo_characters": "256", "tx_sig_limit": "7", "tx_size_cost_per_byte": "10", "sig_verify_cost_ed25519": "590", "sig_verify_cost_secp256k1": "1000"},
+		"accounts": [
+			{"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1qmkksxlxqdslq6kkca25m4jn344nx29lytq8f9", "account_number": "1", "sequence": "0"},
+			{"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1pnt5523etwtzv6mj7haryfw6w8h5tkcuhd99m8", "account_number": "1", "sequence": "0"},
+			{"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1qmkksxlxqdslq6kkca25m4jn344nx29lytq8f9", "account_number": "2", "sequence": "0"}
+		]
+	},
+	"bank": {
+		"params": {"default_send_enabled": true},
+		"balances": [
+			{"address": "cosmos1qmkksxlxqdslq6kkca25m4jn344nx29lytq8f9", "coins": [{"denom": "stake", "amount": "100"}]},
+			{"address": "cosmos1pnt5523etwtzv6mj7haryfw6w8h5tkcuhd99m8", "coins": [{"denom": "stake", "amount": "50"}]}
+		],
+		"supply": [{"denom": "stake", "amount": "200"}]
+	},
+	"unknown": {}
+}`
+
+func TestAnalyzeGenesis(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(codectestutil.CodecOptions{}, genutil.AppModule{}, auth.AppModule{}, bank.AppModule{})
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos"))
+	genMM := module.NewManagerFromMap(map[string]appmodulev2.AppModule{
+		"bank": bank.NewAppModule(encCfg.Codec, nil, nil),
+	})
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(analyzedAppState), &appState))
+
+	analysis := cli.AnalyzeGenesis(clientCtx, genMM, appState)
+	require.Len(t, analysis.Modules, 3)
+	require.Equal(t, "auth", analysis.Modules[0].Name)
+	require.Equal(t, map[string]int{"accounts": 3}, analysis.Modules[0].Entries)
+	require.Equal(t, "bank", analysis.Modules[1].Name)
+	require.Equal(t, map[string]int{"balances": 2, "supply": 1}, analysis.Modules[1].Entries)
+
+	issues := analysis.Issues
+	require.Contains(t, issues, "unknown module auth: the application has no module with this name")
+	require.Contains(t, issues, "unknown module unknown: the application has no module with this name")
+	require.Contains(t, issues, "accounts cosmos1qmkksxlxqdslq6kkca25m4jn344nx29lytq8f9 and cosmos1pnt5523etwtzv6mj7haryfw6w8h5tkcuhd99m8 share the account number 1")
+	require.Contains(t, issues, "duplicate account cosmos1qmkksxlxqdslq6kkca25m4jn344nx29lytq8f9")
+	require.Contains(t, issues, "bank supply 200stake does not match the sum of the balances 150stake")
+	require.Len(t, issues, 6) // the bank module state validation fails on the supply too
+
+	// a genesis file holding the default genesis of the application has no issue
+	bz, err := os.ReadFile("../../types/testdata/app_genesis.json")
+	require.NoError(t, err)
+	genesis := map[string]json.RawMessage{}
+	require.NoError(t, json.Unmarshal(bz, &genesis))
+	genesis["app_state"], err = json.Marshal(genMM.DefaultGenesis())
+	require.NoError(t, err)
+	bz, err = json.Marshal(genesis)
+	require.NoError(t, err)
+	genesisFile := testutil.WriteToNewTempFile(t, string(bz))
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.AnalyzeGenesisCmd(genMM), []string{genesisFile.Name()})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "No issue found")
+
+	// issues make the command fail
+	genesis["app_state"] = json.RawMessage(analyzedAppState)
+	bz, err = json.Marshal(genesis)
+	require.NoError(t, err)
+	genesisFile = testutil.WriteToNewTempFile(t, string(bz))
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.AnalyzeGenesisCmd(genMM), []string{genesisFile.Name()})
+	require.ErrorContains(t, err, "found 6 issue(s) in genesis file")
+	require.Contains(t, out.String(), "- duplicate account cosmos1qmkksxlxqdslq6kkca25m4jn344nx29lytq8f9")
+}
@@ -38,6 +38,7 @@ func CommandsWithCustomMigrationMap(genutilModule genutil.AppModule, genMM genes
 		MigrateGenesisCmd(migrationMap),
 		CollectGenTxsCmd(genutilModule.GenTxValidator()),
 		ValidateGenesisCmd(genMM),
+		AnalyzeGenesisCmd(genMM),
 		AddGenesisAccountCmd(),
 		AddBulkGenesisAccountCmd(),
 		ExportCmd(appExport),
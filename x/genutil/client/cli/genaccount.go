@@ -2,9 +2,7 @@ package cli
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 
@@ -103,7 +101,7 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 		},
 	}
 
-	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|enclave|file|kwallet|pass|test)")
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
@@ -118,7 +116,7 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 // This command is provided as a default, applications are expected to provide their own command if custom genesis accounts are needed.
 func AddBulkGenesisAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bulk-add-genesis-account [/file/path.json]",
+		Use:   "bulk-add-genesis-account [/file/path.json|/file/path.csv]",
 		Short: "Bulk add genesis accounts to genesis.json",
 		Example: `bulk-add-genesis-account accounts.json
 
@@ -144,25 +142,37 @@ where accounts.json is:
         "vesting_end": 1914013878
     }
 ]
+
+or, in CSV:
+
+bulk-add-genesis-account accounts.csv
+
+where accounts.csv is:
+
+address,coins,vesting_amt,vesting_start,vesting_end
+cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5,"100000000umuon,200000000stake",,,
+cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg,500000000umuon,400000000umuon,1724711478,1914013878
 `,
 		Long: `Add genesis accounts in bulk to genesis.json. The provided account must specify
 the account address and a list of initial coins. The list of initial tokens must
 contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+The accounts are read from a JSON file, or from a CSV file if its extension is .csv.
+They are all validated before the genesis is written, and the accounts sharing the same
+address are merged into one.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			config := client.GetConfigFromCmd(cmd)
 
-			f, err := os.Open(args[0])
+			accounts, err := genutil.ReadGenesisAccountsFile(args[0])
 			if err != nil {
-				return fmt.Errorf("failed to open file: %w", err)
+				return err
 			}
-			defer f.Close()
 
-			var accounts []genutil.GenesisAccount
-			if err := json.NewDecoder(f).Decode(&accounts); err != nil {
-				return fmt.Errorf("failed to decode JSON: %w", err)
+			accounts, err = genutil.MergeGenesisAccounts(clientCtx.AddressCodec, accounts)
+			if err != nil {
+				return err
 			}
 
 			appendflag, _ := cmd.Flags().GetBool(flagAppendMode)
@@ -1,9 +1,15 @@
 package genutil
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"cosmossdk.io/core/address"
 	banktypes "cosmossdk.io/x/bank/types"
@@ -53,12 +59,18 @@ func AddGenesisAccounts(
 	}
 
 	newSupplyCoinsCache := sdk.NewCoins()
-	balanceCache := make(map[string]banktypes.Balance)
+	accAddrs := make(map[string]struct{}, len(accs))
 	for _, acc := range accs {
-		for _, balance := range bankGenState.GetBalances() {
-			if balance.Address == acc.GetAddress().String() {
-				balanceCache[acc.GetAddress().String()] = balance
-			}
+		accAddrs[acc.GetAddress().String()] = struct{}{}
+	}
+	// index of the balances of the accounts by address
+	balanceIdx := make(map[string]int, len(accs))
+	for idx, balance := range bankGenState.GetBalances() {
+		if _, ok := accAddrs[balance.Address]; !ok {
+			continue
+		}
+		if _, ok := balanceIdx[balance.Address]; !ok {
+			balanceIdx[balance.Address] = idx
 		}
 	}
 
@@ -112,22 +124,16 @@ func AddGenesisAccounts(
 			return fmt.Errorf("failed to validate new genesis account: %w", err)
 		}
 
-		if _, ok := balanceCache[addr]; ok {
+		if idx, ok := balanceIdx[addr]; ok {
 			if !appendAcct {
 				return fmt.Errorf(" Account %s already exists\nUse `append` flag to append account at existing address", accAddr)
 			}
 
-			for idx, acc := range bankGenState.Balances {
-				if acc.Address != addr {
-					continue
-				}
-
-				updatedCoins := acc.Coins.Add(coins...)
-				bankGenState.Balances[idx] = banktypes.Balance{Address: addr, Coins: updatedCoins.Sort()}
-				break
-			}
+			updatedCoins := bankGenState.Balances[idx].Coins.Add(coins...)
+			bankGenState.Balances[idx] = banktypes.Balance{Address: addr, Coins: updatedCoins.Sort()}
 		} else {
 			accs = append(accs, genAccount)
+			balanceIdx[addr] = len(bankGenState.Balances)
 			bankGenState.Balances = append(bankGenState.Balances, balances)
 		}
 
@@ -166,3 +172,159 @@ func AddGenesisAccounts(
 	appGenesis.AppState = appStateJSON
 	return ExportGenesisFile(appGenesis, genesisFileURL)
 }
+
+// ReadGenesisAccountsFile reads the genesis accounts of a file, in CSV if its extension is .csv and
+// in JSON otherwise, see ParseGenesisAccountsCSV and ParseGenesisAccountsJSON.
+func ReadGenesisAccountsFile(path string) ([]GenesisAccount, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return ParseGenesisAccountsCSV(f)
+	}
+
+	return ParseGenesisAccountsJSON(f)
+}
+
+// ParseGenesisAccountsJSON parses a JSON array of genesis accounts.
+func ParseGenesisAccountsJSON(r io.Reader) ([]GenesisAccount, error) {
+	var accounts []GenesisAccount
+	if err := json.NewDecoder(r).Decode(&accounts); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// ParseGenesisAccountsCSV parses genesis accounts in CSV. The first record is a header naming the
+// columns of the records, with the JSON field names of GenesisAccount. The address and coins columns
+// are required, the vesting and module columns are optional and may be left empty. Coins are written
+// as in the add-genesis-account command, e.g. "100stake,200uatom".
+func ParseGenesisAccountsCSV(r io.Reader) ([]GenesisAccount, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "address", "coins", "vesting_amt", "vesting_start", "vesting_end", "module_name":
+		default:
+			return nil, fmt.Errorf("unknown CSV column %q", name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("duplicate CSV column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range []string{"address", "coins"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing CSV column %q", name)
+		}
+	}
+
+	var accounts []GenesisAccount
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read CSV record: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		value := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		acc := GenesisAccount{
+			Address:    value("address"),
+			ModuleName: value("module_name"),
+		}
+		if acc.Coins, err = sdk.ParseCoinsNormalized(value("coins")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid coins: %w", line, err)
+		}
+		if acc.VestingAmt, err = sdk.ParseCoinsNormalized(value("vesting_amt")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid vesting amount: %w", line, err)
+		}
+		if acc.VestingStart, err = parseCSVTime(value("vesting_start")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid vesting start time: %w", line, err)
+		}
+		if acc.VestingEnd, err = parseCSVTime(value("vesting_end")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid vesting end time: %w", line, err)
+		}
+
+		accounts = append(accounts, acc)
+	}
+
+	return accounts, nil
+}
+
+// parseCSVTime parses a unix time, an empty value being zero.
+func parseCSVTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// MergeGenesisAccounts validates the genesis accounts and merges the accounts with the same address,
+// so that a bulk import adds each account once. The coins of the merged accounts are summed, as are
+// their vesting amounts, which must then share the same vesting schedule. The addresses are converted
+// to their canonical form, and the order of the first occurrence of each address is kept.
+func MergeGenesisAccounts(addressCodec address.Codec, accounts []GenesisAccount) ([]GenesisAccount, error) {
+	merged := make([]GenesisAccount, 0, len(accounts))
+	// index of the merged accounts by address
+	accIdx := make(map[string]int, len(accounts))
+
+	for i, acc := range accounts {
+		accAddr, err := addressCodec.StringToBytes(acc.Address)
+		if err != nil {
+			return nil, fmt.Errorf("account %d: failed to parse account address %s: %w", i, acc.Address, err)
+		}
+		acc.Address, err = addressCodec.BytesToString(accAddr)
+		if err != nil {
+			return nil, fmt.Errorf("account %d: %w", i, err)
+		}
+		if err := acc.Coins.Validate(); err != nil {
+			return nil, fmt.Errorf("account %s: invalid coins: %w", acc.Address, err)
+		}
+		if err := acc.VestingAmt.Validate(); err != nil {
+			return nil, fmt.Errorf("account %s: invalid vesting amount: %w", acc.Address, err)
+		}
+
+		idx, ok := accIdx[acc.Address]
+		if !ok {
+			accIdx[acc.Address] = len(merged)
+			merged = append(merged, acc)
+			continue
+		}
+
+		prev := &merged[idx]
+		if acc.ModuleName != prev.ModuleName {
+			return nil, fmt.Errorf("account %s: conflicting module names %q and %q", acc.Address, prev.ModuleName, acc.ModuleName)
+		}
+		if !acc.VestingAmt.IsZero() {
+			if !prev.VestingAmt.IsZero() && (acc.VestingStart != prev.VestingStart || acc.VestingEnd != prev.VestingEnd) {
+				return nil, fmt.Errorf("account %s: conflicting vesting schedules", acc.Address)
+			}
+			prev.VestingAmt = prev.VestingAmt.Add(acc.VestingAmt...)
+			prev.VestingStart, prev.VestingEnd = acc.VestingStart, acc.VestingEnd
+		}
+		prev.Coins = prev.Coins.Add(acc.Coins...)
+	}
+
+	return merged, nil
+}
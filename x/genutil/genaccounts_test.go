@@ -0,0 +1,86 @@
+package genutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseGenesisAccountsCSV(t *testing.T) {
+	t.Parallel()
+
+	accounts, err := ParseGenesisAccountsCSV(strings.NewReader(`address,coins,vesting_amt,vesting_start,vesting_end,module_name
+cosmos1a,"100stake,200uatom",,,,
+cosmos1b, 500uatom ,400uatom,1724711478,1914013878,
+cosmos1c,,,,,mint
+`))
+	require.NoError(t, err)
+	require.Equal(t, []GenesisAccount{
+		{Address: "cosmos1a", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 200))},
+		{
+			Address: "cosmos1b", Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)), VestingAmt: sdk.NewCoins(sdk.NewInt64Coin("uatom", 400)),
+			VestingStart: 1724711478, VestingEnd: 1914013878,
+		},
+		{Address: "cosmos1c", ModuleName: "mint"},
+	}, accounts)
+
+	_, err = ParseGenesisAccountsCSV(strings.NewReader("address,balance\ncosmos1a,100stake\n"))
+	require.ErrorContains(t, err, `unknown CSV column "balance"`)
+
+	_, err = ParseGenesisAccountsCSV(strings.NewReader("address\ncosmos1a\n"))
+	require.ErrorContains(t, err, `missing CSV column "coins"`)
+
+	_, err = ParseGenesisAccountsCSV(strings.NewReader("address,coins\ncosmos1a,100stake\ncosmos1b,stake\n"))
+	require.ErrorContains(t, err, "line 3: invalid coins")
+}
+
+func TestMergeGenesisAccounts(t *testing.T) {
+	t.Parallel()
+
+	ac := address.NewBech32Codec("cosmos")
+	addr1, err := ac.BytesToString([]byte("addr1_______________"))
+	require.NoError(t, err)
+	addr2, err := ac.BytesToString([]byte("addr2_______________"))
+	require.NoError(t, err)
+
+	merged, err := MergeGenesisAccounts(ac, []GenesisAccount{
+		{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+		{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+		// addresses are compared in their canonical form
+		{
+			Address: strings.ToUpper(addr1), Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 3)),
+			VestingAmt: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2)), VestingEnd: 1914013878,
+		},
+		{
+			Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)),
+			VestingAmt: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), VestingEnd: 1914013878,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []GenesisAccount{
+		{
+			Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("uatom", 4)),
+			VestingAmt: sdk.NewCoins(sdk.NewInt64Coin("uatom", 3)), VestingEnd: 1914013878,
+		},
+		{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+	}, merged)
+
+	_, err = MergeGenesisAccounts(ac, []GenesisAccount{{Address: "invalid"}})
+	require.ErrorContains(t, err, "failed to parse account address invalid")
+
+	_, err = MergeGenesisAccounts(ac, []GenesisAccount{
+		{Address: addr1, VestingAmt: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), VestingEnd: 1914013878},
+		{Address: addr1, VestingAmt: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), VestingEnd: 1914013879},
+	})
+	require.ErrorContains(t, err, "conflicting vesting schedules")
+
+	_, err = MergeGenesisAccounts(ac, []GenesisAccount{
+		{Address: addr1},
+		{Address: addr1, ModuleName: "mint"},
+	})
+	require.ErrorContains(t, err, "conflicting module names")
+}
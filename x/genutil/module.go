@@ -7,10 +7,13 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
@@ -59,6 +62,19 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
+// InitGenesisAfter returns the modules whose genesis must be initialized before the
+// genutil module: the genesis transactions are delivered against the accounts and
+// balances of auth and bank, and the staking pools must be initialized.
+func (AppModule) InitGenesisAfter() []string {
+	return []string{authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName}
+}
+
+// InitGenesisBefore returns the modules whose genesis must be initialized after the
+// genutil module.
+func (AppModule) InitGenesisBefore() []string {
+	return nil
+}
+
 // DefaultGenesis returns default genesis state as raw bytes for the genutil module.
 func (am AppModule) DefaultGenesis() json.RawMessage {
 	return am.cdc.MustMarshalJSON(types.DefaultGenesisState())
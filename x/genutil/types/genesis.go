@@ -14,6 +14,7 @@ import (
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/stream"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -176,6 +177,39 @@ func AppGenesisFromFile(genFile string) (*AppGenesis, error) {
 	return appGenesis, nil
 }
 
+// StreamAppState decodes the app state of the genesis read from the reader without
+// loading the genesis in memory. fn is called with the name of each module and the
+// decoder positioned on the genesis state of the module, which fn must consume, e.g.
+// with JSONDecoder.Array to import a large module state one entry at a time, or with
+// JSONDecoder.Skip. The other fields of the genesis are skipped.
+func StreamAppState(reader io.Reader, fn func(module string, dec *stream.JSONDecoder) error) error {
+	dec := stream.NewJSONDecoder(reader)
+
+	found := false
+	err := dec.Object(func(key string) error {
+		if key != "app_state" {
+			return dec.Skip()
+		}
+
+		found = true
+		return dec.Object(func(module string) error {
+			if err := fn(module, dec); err != nil {
+				return fmt.Errorf("failed to decode the genesis state of %s: %w", module, err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("missing app_state in genesis")
+	}
+
+	return nil
+}
+
 // --------------------------
 // CometBFT Genesis Handling
 // --------------------------
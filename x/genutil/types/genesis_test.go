@@ -3,12 +3,14 @@ package types_test
 import (
 	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 
 	cmttypes "github.com/cometbft/cometbft/types"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/golden"
 
+	"github.com/cosmos/cosmos-sdk/codec/stream"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
@@ -67,3 +69,36 @@ func TestAppGenesis_ValidGenesis(t *testing.T) {
 	assert.NilError(t, err)
 	golden.Assert(t, string(rawAppGenesis), "app_genesis.json")
 }
+
+func TestStreamAppState(t *testing.T) {
+	genesis, err := types.AppGenesisFromFile("testdata/app_genesis.json")
+	assert.NilError(t, err)
+	var appState map[string]json.RawMessage
+	assert.NilError(t, json.Unmarshal(genesis.AppState, &appState))
+
+	file, err := os.Open("testdata/app_genesis.json")
+	assert.NilError(t, err)
+	defer file.Close()
+
+	modules := map[string]bool{}
+	var bankState json.RawMessage
+	err = types.StreamAppState(file, func(module string, dec *stream.JSONDecoder) error {
+		modules[module] = true
+		if module != "bank" {
+			return dec.Skip()
+		}
+
+		bankState, err = dec.Raw()
+		return err
+	})
+	assert.NilError(t, err)
+
+	assert.Equal(t, len(modules), len(appState))
+	for module := range appState {
+		assert.Assert(t, modules[module], module)
+	}
+	assert.Equal(t, string(bankState), string(appState["bank"]))
+
+	err = types.StreamAppState(strings.NewReader(`{"chain_id": "demo"}`), func(string, *stream.JSONDecoder) error { return nil })
+	assert.ErrorContains(t, err, "missing app_state in genesis")
+}
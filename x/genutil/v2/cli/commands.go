@@ -44,6 +44,7 @@ func CommandsWithCustomMigrationMap(
 		cli.MigrateGenesisCmd(migrationMap),
 		cli.CollectGenTxsCmd(genutilModule.GenTxValidator()),
 		cli.ValidateGenesisCmd(genMM),
+		cli.AnalyzeGenesisCmd(genMM),
 		cli.AddGenesisAccountCmd(),
 		ExportCmd(appExport),
 	)
@@ -89,11 +89,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "ProposalTurnout",
+					Use:       "turnout <proposal-id>",
+					Short:     "Query the turnout of a proposal vote, broken down between validators and delegators",
+					Example:   fmt.Sprintf("%s query gov turnout 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "ProposalTurnouts",
+					Use:       "turnouts",
+					Short:     "Query the turnouts of all the tallied proposals",
+					Example:   fmt.Sprintf("%s query gov turnouts", version.AppName),
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "ParamAnnotations",
+					Use:       "param-annotations [msg-url]",
+					Short:     "Query the annotations of the module params, optionally of the ones updated by a message",
+					Example:   fmt.Sprintf("%s query gov param-annotations /cosmos.staking.v1beta1.MsgUpdateParams", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_url", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "MinDeposit",
+					Use:       "min-deposit",
+					Short:     "Query the current minimum deposit of standard proposals",
+					Example:   fmt.Sprintf("%s query gov min-deposit", version.AppName),
+				},
+				{
+					RpcMethod: "InactiveStake",
+					Use:       "inactive-stake",
+					Short:     "Query the stake of the inactive accounts excluded from the quorum",
+					Example:   fmt.Sprintf("%s query gov inactive-stake", version.AppName),
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -154,6 +190,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "CancelScheduledProposal",
+					Use:            "cancel-scheduled-proposal <proposal-id>",
+					Short:          "Submit a proposal to cancel the execution of a scheduled proposal. The proposal must pass with the scheduled cancel threshold.",
+					Example:        fmt.Sprintf(`%s tx gov cancel-scheduled-proposal 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+					GovProposal:    true,
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -112,6 +112,8 @@ Where proposal.json contains:
   "title": "My proposal",
   "summary": "A short summary of my proposal",
   "proposal_type": "standard",
+  // optional delay between the end of the voting period and the execution of the messages
+  "execution_delay": "72h",
 }
 
 metadata example: 
@@ -148,6 +150,10 @@ metadata example:
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			if proposal.executionDelay > 0 {
+				msg.ExecutionDelay = &proposal.executionDelay
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
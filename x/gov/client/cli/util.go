@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -95,8 +96,10 @@ type proposal struct {
 	Title           string            `json:"title"`
 	Summary         string            `json:"summary"`
 	ProposalTypeStr string            `json:"proposal_type,omitempty"`
+	ExecutionDelay  string            `json:"execution_delay,omitempty"`
 
-	proposalType govv1.ProposalType
+	proposalType   govv1.ProposalType
+	executionDelay time.Duration
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -119,6 +122,13 @@ func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk
 	}
 	proposal.proposalType = proposalType
 
+	if proposal.ExecutionDelay != "" {
+		proposal.executionDelay, err = time.ParseDuration(proposal.ExecutionDelay)
+		if err != nil {
+			return proposal, nil, nil, fmt.Errorf("invalid execution delay: %w", err)
+		}
+	}
+
 	msgs := make([]sdk.Msg, len(proposal.Messages))
 	for i, anyJSON := range proposal.Messages {
 		var msg sdk.Msg
@@ -13,6 +13,7 @@ import (
 	govclient "cosmossdk.io/x/gov/client"
 	"cosmossdk.io/x/gov/keeper"
 	govtypes "cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -27,7 +28,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(
 		&modulev1.Module{},
-		appconfig.Invoke(InvokeAddRoutes, InvokeSetHooks),
+		appconfig.Invoke(InvokeAddRoutes, InvokeSetHooks, InvokeSetParamAnnotations),
 		appconfig.Provide(ProvideModule))
 }
 
@@ -133,3 +134,17 @@ func InvokeSetHooks(keeper *keeper.Keeper, govHooks map[string]govtypes.GovHooks
 	keeper.SetHooks(multiHooks)
 	return nil
 }
+
+func InvokeSetParamAnnotations(keeper *keeper.Keeper, paramAnnotations map[string]v1.ParamAnnotationsWrapper) error {
+	if keeper == nil || paramAnnotations == nil {
+		return nil
+	}
+
+	// Annotations are collected in lexical order of module name.
+	var annotations []v1.ParamAnnotation
+	for _, modName := range slices.Sorted(maps.Keys(paramAnnotations)) {
+		annotations = append(annotations, paramAnnotations[modName].Annotations...)
+	}
+
+	return keeper.SetParamAnnotations(annotations...)
+}
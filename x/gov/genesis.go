@@ -2,6 +2,7 @@ package gov
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -67,12 +68,51 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 			if err != nil {
 				return err
 			}
+		case v1.StatusScheduled:
+			err := k.ScheduledProposalsQueue.Set(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id), proposal.Id)
+			if err != nil {
+				return err
+			}
 		}
 		if err := k.Proposals.Set(ctx, proposal.Id, *proposal); err != nil {
 			return err
 		}
 	}
 
+	for _, turnout := range data.ProposalTurnouts {
+		if err := k.ProposalTurnouts.Set(ctx, turnout.ProposalId, *turnout); err != nil {
+			return err
+		}
+	}
+
+	if data.DynamicMinDeposit != nil {
+		if err := k.DynamicMinDeposit.Set(ctx, *data.DynamicMinDeposit); err != nil {
+			return err
+		}
+	}
+
+	if data.InactiveStake != nil {
+		if err := k.InactiveStake.Set(ctx, *data.InactiveStake); err != nil {
+			return err
+		}
+	}
+
+	if data.InactiveStakeCalculation != nil {
+		if err := k.InactiveStakeCalculation.Set(ctx, *data.InactiveStakeCalculation); err != nil {
+			return err
+		}
+	}
+
+	for _, activity := range data.AccountActivities {
+		addr, err := ak.AddressCodec().StringToBytes(activity.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.AccountActivities.Set(ctx, addr, *activity); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +171,63 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export proposals turnouts
+	var proposalsTurnouts []*v1.ProposalTurnout
+	err = k.ProposalTurnouts.Walk(ctx, nil, func(_ uint64, value v1.ProposalTurnout) (stop bool, err error) {
+		proposalsTurnouts = append(proposalsTurnouts, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var dynamicMinDeposit *v1.DynamicMinDeposit
+	value, err := k.DynamicMinDeposit.Get(ctx)
+	switch {
+	case err == nil:
+		dynamicMinDeposit = &value
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	var inactiveStake *v1.InactiveStake
+	inactive, err := k.InactiveStake.Get(ctx)
+	switch {
+	case err == nil:
+		inactiveStake = &inactive
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	var inactiveStakeCalculation *v1.InactiveStakeCalculation
+	calc, err := k.InactiveStakeCalculation.Get(ctx)
+	switch {
+	case err == nil:
+		inactiveStakeCalculation = &calc
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	var accountActivities []*v1.AccountActivity
+	err = k.AccountActivities.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.AccountActivity) (stop bool, err error) {
+		accountActivities = append(accountActivities, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:       startingProposalID,
+		Deposits:                 proposalsDeposits,
+		Votes:                    proposalsVotes,
+		Proposals:                proposals,
+		Params:                   &params,
+		Constitution:             constitution,
+		ProposalTurnouts:         proposalsTurnouts,
+		DynamicMinDeposit:        dynamicMinDeposit,
+		InactiveStake:            inactiveStake,
+		AccountActivities:        accountActivities,
+		InactiveStakeCalculation: inactiveStakeCalculation,
 	}, nil
 }
@@ -93,12 +93,17 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 
+		minDeposit, err := k.getProposalMinDeposit(ctx, params, proposal.ProposalType)
+		if err != nil {
+			return err
+		}
+
 		k.Logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
 			"proposal_type", proposal.ProposalType,
 			"title", proposal.Title,
-			"min_deposit", sdk.NewCoins(proposal.GetMinDepositFromParams(params)...).String(),
+			"min_deposit", sdk.NewCoins(minDeposit...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 	}
@@ -141,11 +146,15 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults, err := k.Tally(ctx, proposal)
+		passes, burnDeposits, tallyResults, turnout, err := k.TallyWithTurnout(ctx, proposal)
 		if err != nil {
 			return err
 		}
 
+		if err := k.ProposalTurnouts.Set(ctx, proposal.Id, turnout); err != nil {
+			return err
+		}
+
 		// Deposits are always burned if tally said so, regardless of the proposal type.
 		// If a proposal passes, deposits are always refunded, regardless of the proposal type.
 		// If a proposal fails, and isn't spammy, deposits are refunded, unless the proposal is expedited or optimistic.
@@ -175,49 +184,21 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 		}
 
 		switch {
-		case passes:
-			var (
-				idx int
-				msg sdk.Msg
-			)
-
-			messages, err := proposal.GetMsgs()
-			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-				break
+		case passes && proposal.ExecutionDelay != nil && *proposal.ExecutionDelay > 0:
+			// the proposal is timelocked: its messages are executed once the execution delay
+			// has elapsed, unless governance cancels the execution in the meantime.
+			executionTime := k.HeaderService.HeaderInfo(ctx).Time.Add(*proposal.ExecutionDelay)
+			proposal.ExecutionTime = &executionTime
+			proposal.Status = v1.StatusScheduled
+
+			if err = k.ScheduledProposalsQueue.Set(ctx, collections.Join(executionTime, proposal.Id), proposal.Id); err != nil {
+				return err
 			}
 
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			_, err = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
-				// execute all messages
-				for idx, msg = range messages {
-					if _, err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
-						return err
-					}
-				}
-
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				return nil
-			})
-			if err != nil {
-				// `idx` and `err` are populated with the msg index and error.
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
-
-				break // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
-			}
+			tagValue = types.AttributeValueProposalScheduled
+			logMsg = fmt.Sprintf("passed, execution scheduled at %s", executionTime.Format(time.RFC3339))
+		case passes:
+			tagValue, logMsg = k.executeProposal(ctx, &proposal, params)
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
 			// When a non spammy expedited/optimistic proposal fails, it is converted
@@ -278,7 +259,118 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			k.Logger.Error("failed to emit event", "error", err)
 		}
 	}
-	return nil
+
+	// execute the scheduled proposals whose execution time has been reached.
+	// Scheduled proposals are processed after the active ones, so that a cancellation
+	// passing in the same block as the execution time takes precedence.
+	rng = collections.NewPrefixUntilPairRange[time.Time, uint64](k.HeaderService.HeaderInfo(ctx).Time)
+
+	iter, err = k.ScheduledProposalsQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	scheduledProps, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, prop := range scheduledProps {
+		if err = k.ScheduledProposalsQueue.Remove(ctx, prop.Key); err != nil {
+			return err
+		}
+
+		proposal, err := k.Proposals.Get(ctx, prop.Key.K2())
+		if err != nil {
+			// if the proposal has an encoding error, this means it cannot be processed by x/gov
+			// instead of returning an error (i.e, halting the chain), we fail the proposal
+			if errors.Is(err, collections.ErrEncoding) {
+				proposal.Id = prop.Key.K2()
+				if err := failUnsupportedProposal(ctx, k, proposal, err.Error(), true); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			return err
+		}
+
+		tagValue, logMsg := k.executeProposal(ctx, &proposal, params)
+
+		if err = k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return err
+		}
+
+		k.Logger.Info(
+			"scheduled proposal executed",
+			"proposal", proposal.Id,
+			"proposal_type", proposal.ProposalType,
+			"status", proposal.Status.String(),
+			"title", proposal.Title,
+			"results", logMsg,
+		)
+
+		if err := k.EventService.EventManager(ctx).EmitKV(types.EventTypeScheduledProposal,
+			event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			event.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			event.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+		); err != nil {
+			k.Logger.Error("failed to emit event", "error", err)
+		}
+	}
+
+	// adjust the minimum deposit to the proposal volume once the update period has elapsed.
+	if err := k.updateDynamicMinDeposit(ctx, params); err != nil {
+		return err
+	}
+
+	// recalculate the stake of the inactive accounts once the epoch has elapsed.
+	return k.updateInactiveStake(ctx, params)
+}
+
+// executeProposal executes the messages of a passed proposal and updates its status accordingly.
+// It returns the result tag and the log message of the execution.
+func (k Keeper) executeProposal(ctx context.Context, proposal *v1.Proposal, params v1.Params) (tagValue, logMsg string) {
+	var (
+		idx int
+		msg sdk.Msg
+	)
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", *proposal, err)
+	}
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	_, err = k.BranchService.ExecuteWithGasLimit(ctx, params.ProposalExecutionGas, func(ctx context.Context) error {
+		// execute all messages
+		for idx, msg = range messages {
+			if _, err := safeExecuteHandler(ctx, msg, k.MsgRouterService); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		// `idx` and `err` are populated with the msg index and error.
+		// We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	proposal.Status = v1.StatusPassed
+
+	return types.AttributeValueProposalPassed, "passed"
 }
 
 // executes route(msg) and recovers from panic.
@@ -30,6 +30,9 @@ type Config struct {
 	// This only applies to WeightedVoteOption messages and not to the VoteOption messages
 	// 0 means this param is disabled, hence all supported options are allowed
 	MaxVoteOptionsLen uint64
+	// InactiveStakeBatchSize defines the maximum number of delegations and account activities processed per block
+	// by the calculation of the inactive stake, which is spread over several blocks on chains with many delegations.
+	InactiveStakeBatchSize uint64
 	// CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 	// Keeping it nil will use the default implementation
 	CalculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
@@ -42,6 +45,7 @@ func DefaultConfig() Config {
 		MaxMetadataLen:                       255,
 		MaxSummaryLen:                        10200,
 		MaxVoteOptionsLen:                    0, // 0 means this param is disabled, hence all supported options are allowed
+		InactiveStakeBatchSize:               1000,
 		CalculateVoteResultsAndVotingPowerFn: nil,
 	}
 }
@@ -92,7 +92,11 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 		return false, err
 	}
 
-	minDepositAmount := proposal.GetMinDepositFromParams(params)
+	minDepositAmount, err := k.getProposalMinDeposit(ctx, params, proposal.ProposalType)
+	if err != nil {
+		return false, err
+	}
+
 	minDepositRatio, err := sdkmath.LegacyNewDecFromStr(params.GetMinDepositRatio())
 	if err != nil {
 		return false, err
@@ -289,7 +293,7 @@ func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddres
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
 // the deposit parameters. Returns nil on success, error otherwise.
-func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coins, proposalType v1.ProposalType) error {
+func (k Keeper) validateInitialDeposit(ctx context.Context, params v1.Params, initialDeposit sdk.Coins, proposalType v1.ProposalType) error {
 	if !initialDeposit.IsValid() || initialDeposit.IsAnyNegative() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, initialDeposit.String())
 	}
@@ -302,16 +306,14 @@ func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coin
 		return nil
 	}
 
-	var minDepositCoins sdk.Coins
-	switch proposalType {
-	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
-		minDepositCoins = params.ExpeditedMinDeposit
-	default:
-		minDepositCoins = params.MinDeposit
+	minDeposit, err := k.getProposalMinDeposit(ctx, params, proposalType)
+	if err != nil {
+		return err
 	}
 
-	for i := range minDepositCoins {
-		minDepositCoins[i].Amount = sdkmath.LegacyNewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
+	minDepositCoins := make(sdk.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		minDepositCoins[i] = sdk.NewCoin(coin.Denom, sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(minInitialDepositRatio).RoundInt())
 	}
 	if !initialDeposit.IsAllGTE(minDepositCoins) {
 		return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
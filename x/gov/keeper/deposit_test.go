@@ -3,11 +3,13 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
 	v1 "cosmossdk.io/x/gov/types/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -478,3 +480,119 @@ func TestChargeDeposit(t *testing.T) {
 		}
 	}
 }
+
+func TestDynamicMinDeposit(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+	require.NoError(t, trackMockBalances(bankKeeper))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(100000000))
+	queryServer := keeper.NewQueryServer(govKeeper)
+
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(amount)))
+	}
+	requireMinDeposit := func(ctx sdk.Context, expected sdk.Coins) {
+		t.Helper()
+		params, err := govKeeper.Params.Get(ctx)
+		require.NoError(t, err)
+		minDeposit, err := govKeeper.GetMinDeposit(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, expected, minDeposit)
+
+		res, err := queryServer.MinDeposit(ctx, &v1.QueryMinDepositRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expected, sdk.Coins(res.MinDeposit))
+	}
+	nextPeriod := func(ctx sdk.Context) sdk.Context {
+		header := ctx.HeaderInfo()
+		header.Time = header.Time.Add(time.Hour)
+		ctx = ctx.WithHeaderInfo(header)
+		require.NoError(t, govKeeper.EndBlocker(ctx))
+		return ctx
+	}
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	updatePeriod := time.Hour
+	params.MinDepositThrottler = &v1.MinDepositThrottler{
+		Floor:           stake(5000000),
+		Ceiling:         stake(20000000),
+		UpdatePeriod:    &updatePeriod,
+		TargetProposals: 1,
+		IncreaseRatio:   "0.5",
+		DecreaseRatio:   "0.2",
+	}
+	require.NoError(t, params.ValidateBasic(address.NewBech32Codec("cosmos")))
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	// the first period starts with the min_deposit param
+	requireMinDeposit(ctx, stake(10000000))
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	dynamic, err := govKeeper.DynamicMinDeposit.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ctx.HeaderInfo().Time, *dynamic.LastUpdate)
+
+	var proposalIDs []uint64
+	for i := 0; i < 3; i++ {
+		proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		require.NoError(t, err)
+		proposalIDs = append(proposalIDs, proposal.Id)
+	}
+	dynamic, err = govKeeper.DynamicMinDeposit.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), dynamic.PeriodProposals)
+
+	// the minimum deposit is not adjusted before the end of the period
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	requireMinDeposit(ctx, stake(10000000))
+
+	// more proposals than targeted increase the minimum deposit
+	ctx = nextPeriod(ctx)
+	requireMinDeposit(ctx, stake(15000000))
+	dynamic, err = govKeeper.DynamicMinDeposit.Get(ctx)
+	require.NoError(t, err)
+	require.Zero(t, dynamic.PeriodProposals)
+
+	// the adjusted minimum deposit is required to activate the voting period
+	activated, err := govKeeper.AddDeposit(ctx, proposalIDs[0], addrs[0], stake(10000000))
+	require.NoError(t, err)
+	require.False(t, activated)
+	activated, err = govKeeper.AddDeposit(ctx, proposalIDs[0], addrs[0], stake(5000000))
+	require.NoError(t, err)
+	require.True(t, activated)
+
+	// the minimum deposit is bounded by the ceiling
+	for i := 0; i < 2; i++ {
+		_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		require.NoError(t, err)
+	}
+	ctx = nextPeriod(ctx)
+	requireMinDeposit(ctx, stake(20000000))
+
+	// the target number of proposals leaves the minimum deposit unchanged
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	ctx = nextPeriod(ctx)
+	requireMinDeposit(ctx, stake(20000000))
+
+	// less proposals than targeted decrease the minimum deposit, down to the floor
+	ctx = nextPeriod(ctx)
+	requireMinDeposit(ctx, stake(16000000))
+	for i := 0; i < 10; i++ {
+		ctx = nextPeriod(ctx)
+	}
+	requireMinDeposit(ctx, stake(5000000))
+
+	// disabling the throttler restores the min_deposit param
+	params.MinDepositThrottler = nil
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	requireMinDeposit(ctx, stake(10000000))
+	has, err := govKeeper.DynamicMinDeposit.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+	res, err := queryServer.MinDeposit(ctx, &v1.QueryMinDepositRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.DynamicMinDeposit)
+}
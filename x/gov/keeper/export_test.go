@@ -14,5 +14,11 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 		return err
 	}
 
-	return k.validateInitialDeposit(params, initialDeposit, proposalType)
+	return k.validateInitialDeposit(ctx, params, initialDeposit, proposalType)
+}
+
+// SetInactiveStakeBatchSize sets the maximum number of delegations and account activities processed per
+// block by the calculation of the inactive stake.
+func (k *Keeper) SetInactiveStakeBatchSize(size uint64) {
+	k.config.InactiveStakeBatchSize = size
 }
@@ -56,7 +56,10 @@ func (q queryServer) Proposal(ctx context.Context, req *v1.QueryProposalRequest)
 
 	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
 	if err == nil {
-		return &v1.QueryProposalResponse{Proposal: &proposal}, nil
+		return &v1.QueryProposalResponse{
+			Proposal:         &proposal,
+			ParamAnnotations: q.k.GetProposalParamAnnotations(proposal),
+		}, nil
 	}
 	if errors.IsOf(err, collections.ErrNotFound) {
 		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
@@ -248,6 +251,45 @@ func (q queryServer) MessageBasedParams(ctx context.Context, req *v1.QueryMessag
 	return nil, status.Error(codes.Internal, err.Error())
 }
 
+// MinDeposit returns the current minimum deposit of standard proposals
+func (q queryServer) MinDeposit(ctx context.Context, req *v1.QueryMinDepositRequest) (*v1.QueryMinDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if params.MinDepositThrottler == nil {
+		return &v1.QueryMinDepositResponse{MinDeposit: params.MinDeposit}, nil
+	}
+
+	dynamic, err := q.k.getDynamicMinDeposit(ctx, params)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryMinDepositResponse{MinDeposit: dynamic.Amount, DynamicMinDeposit: &dynamic}, nil
+}
+
+// InactiveStake queries the stake of the inactive accounts of the current epoch.
+func (q queryServer) InactiveStake(ctx context.Context, req *v1.QueryInactiveStakeRequest) (*v1.QueryInactiveStakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	inactive, err := q.k.InactiveStake.Get(ctx)
+	if stderrors.Is(err, collections.ErrNotFound) {
+		return &v1.QueryInactiveStakeResponse{}, nil
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryInactiveStakeResponse{InactiveStake: &inactive}, nil
+}
+
 // Deposit queries single deposit information based on proposalID, depositAddr.
 func (q queryServer) Deposit(ctx context.Context, req *v1.QueryDepositRequest) (*v1.QueryDepositResponse, error) {
 	if req == nil {
@@ -334,6 +376,65 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ProposalTurnout queries the turnout of a proposal vote
+func (q queryServer) ProposalTurnout(ctx context.Context, req *v1.QueryProposalTurnoutRequest) (*v1.QueryProposalTurnoutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var turnout v1.ProposalTurnout
+
+	switch {
+	case proposal.Status == v1.StatusDepositPeriod:
+		turnout = v1.EmptyProposalTurnout(proposal.Id)
+
+	case proposal.Status == v1.StatusVotingPeriod:
+		_, _, _, turnout, err = q.k.TallyWithTurnout(ctx, proposal)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		turnout, err = q.k.ProposalTurnouts.Get(ctx, proposal.Id)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "turnout of proposal %d not found", req.ProposalId)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &v1.QueryProposalTurnoutResponse{Turnout: &turnout}, nil
+}
+
+// ProposalTurnouts queries the turnouts of all the tallied proposals
+func (q queryServer) ProposalTurnouts(ctx context.Context, req *v1.QueryProposalTurnoutsRequest) (*v1.QueryProposalTurnoutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	turnouts, pageRes, err := query.CollectionPaginate(ctx, q.k.ProposalTurnouts, req.Pagination, func(_ uint64, turnout v1.ProposalTurnout) (*v1.ProposalTurnout, error) {
+		return &turnout, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalTurnoutsResponse{Turnouts: turnouts, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -509,3 +610,12 @@ func (q legacyQueryServer) TallyResult(ctx context.Context, req *v1beta1.QueryTa
 
 	return &v1beta1.QueryTallyResultResponse{Tally: tally}, nil
 }
+
+// ParamAnnotations returns the param annotations registered at wiring time
+func (q queryServer) ParamAnnotations(_ context.Context, req *v1.QueryParamAnnotationsRequest) (*v1.QueryParamAnnotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &v1.QueryParamAnnotationsResponse{Annotations: q.k.GetParamAnnotations(req.MsgUrl)}, nil
+}
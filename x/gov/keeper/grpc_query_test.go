@@ -1909,6 +1909,66 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryProposalTurnout() {
+	suite.reset()
+	queryClient := suite.queryClient
+
+	propTime := time.Now()
+	depositProposal := v1.Proposal{Id: 1, Status: v1.StatusDepositPeriod, SubmitTime: &propTime, DepositEndTime: &propTime}
+	passedProposal := v1.Proposal{Id: 2, Status: v1.StatusPassed, SubmitTime: &propTime, VotingStartTime: &propTime, VotingEndTime: &propTime}
+	legacyProposal := v1.Proposal{Id: 3, Status: v1.StatusRejected, SubmitTime: &propTime, VotingStartTime: &propTime, VotingEndTime: &propTime}
+	for _, proposal := range []v1.Proposal{depositProposal, passedProposal, legacyProposal} {
+		suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal))
+	}
+
+	turnout := v1.ProposalTurnout{
+		ProposalId:           passedProposal.Id,
+		ValidatorVotingPower: "3",
+		DelegatorVotingPower: "1",
+		TotalVotingPower:     "4",
+		TotalBonded:          "10",
+		Turnout:              "0.400000000000000000",
+		Quorum:               "0.334000000000000000",
+		ValidatorVoters:      1,
+		DelegatorVoters:      1,
+	}
+	suite.Require().NoError(suite.govKeeper.ProposalTurnouts.Set(suite.ctx, passedProposal.Id, turnout))
+
+	testCases := []struct {
+		msg        string
+		req        *v1.QueryProposalTurnoutRequest
+		expTurnout *v1.ProposalTurnout
+		expErr     bool
+	}{
+		{"zero proposal id request", &v1.QueryProposalTurnoutRequest{}, nil, true},
+		{"non existing proposal request", &v1.QueryProposalTurnoutRequest{ProposalId: 4}, nil, true},
+		{"proposal in deposit period", &v1.QueryProposalTurnoutRequest{ProposalId: depositProposal.Id}, func() *v1.ProposalTurnout {
+			t := v1.EmptyProposalTurnout(depositProposal.Id)
+			return &t
+		}(), false},
+		{"tallied proposal", &v1.QueryProposalTurnoutRequest{ProposalId: passedProposal.Id}, &turnout, false},
+		{"proposal tallied without turnout", &v1.QueryProposalTurnoutRequest{ProposalId: legacyProposal.Id}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.ProposalTurnout(gocontext.Background(), tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expTurnout, res.Turnout)
+		})
+	}
+
+	res, err := queryClient.ProposalTurnouts(gocontext.Background(), &v1.QueryProposalTurnoutsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.ProposalTurnout{&turnout}, res.Turnouts)
+}
+
 func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 	suite.reset()
 
@@ -2038,3 +2098,56 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryParamAnnotations() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	govParamsURL := sdk.MsgTypeURL(&v1.MsgUpdateParams{})
+	bankParamsURL := "/cosmos.bank.v1beta1.MsgUpdateParams"
+	quorum := v1.ParamAnnotation{
+		MsgUrl:      govParamsURL,
+		Param:       "quorum",
+		Description: "Minimum fraction of the voting power which must vote for a proposal to be valid.",
+		RiskLevel:   v1.ParamRiskLevel_PARAM_RISK_LEVEL_HIGH,
+	}
+	votingPeriod := v1.ParamAnnotation{
+		MsgUrl:    govParamsURL,
+		Param:     "voting_period",
+		RiskLevel: v1.ParamRiskLevel_PARAM_RISK_LEVEL_MEDIUM,
+	}
+	sendEnabled := v1.ParamAnnotation{
+		MsgUrl:    bankParamsURL,
+		Param:     "default_send_enabled",
+		RiskLevel: v1.ParamRiskLevel_PARAM_RISK_LEVEL_HIGH,
+	}
+
+	suite.Require().ErrorContains(suite.govKeeper.SetParamAnnotations(quorum, quorum), "duplicate param annotation")
+	suite.Require().Error(suite.govKeeper.SetParamAnnotations(v1.ParamAnnotation{MsgUrl: govParamsURL}))
+	suite.Require().Error(suite.govKeeper.SetParamAnnotations(v1.ParamAnnotation{MsgUrl: govParamsURL, Param: "quorum", RiskLevel: 42}))
+	suite.Require().NoError(suite.govKeeper.SetParamAnnotations(votingPeriod, sendEnabled, quorum))
+
+	res, err := queryClient.ParamAnnotations(gocontext.Background(), &v1.QueryParamAnnotationsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.ParamAnnotation{&sendEnabled, &quorum, &votingPeriod}, res.Annotations)
+
+	res, err = queryClient.ParamAnnotations(gocontext.Background(), &v1.QueryParamAnnotationsRequest{MsgUrl: govParamsURL})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.ParamAnnotation{&quorum, &votingPeriod}, res.Annotations)
+
+	res, err = queryClient.ParamAnnotations(gocontext.Background(), &v1.QueryParamAnnotationsRequest{MsgUrl: "/cosmos.staking.v1beta1.MsgUpdateParams"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Annotations)
+
+	govAcct := suite.govKeeper.GetGovernanceAccount(ctx).GetAddress()
+	govAcctStr, err := suite.acctKeeper.AddressCodec().BytesToString(govAcct)
+	suite.Require().NoError(err)
+	params := v1.DefaultParams()
+	msg := &v1.MsgUpdateParams{Authority: govAcctStr, Params: params}
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msg, msg}, "", "title", "summary", govAcct, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+
+	proposalRes, err := queryClient.Proposal(gocontext.Background(), &v1.QueryProposalRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.ParamAnnotation{&quorum, &votingPeriod}, proposalRes.ParamAnnotations)
+}
@@ -0,0 +1,412 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetInactiveStake returns the stake of the inactive accounts of the current epoch.
+// It is zero when the inactive stake exemption is disabled, or when the inactive
+// stake has not been calculated yet.
+func (k Keeper) GetInactiveStake(ctx context.Context, params v1.Params) (sdkmath.Int, error) {
+	if params.InactiveStakeExemption == nil {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	inactive, err := k.InactiveStake.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.ZeroInt(), nil
+	} else if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	amount, ok := sdkmath.NewIntFromString(inactive.Amount)
+	if !ok {
+		return sdkmath.Int{}, errors.New("invalid inactive stake amount: " + inactive.Amount)
+	}
+
+	return amount, nil
+}
+
+// quorumBondedTokens returns the bonded tokens over which the quorum of a proposal
+// is computed, that is the total bonded tokens minus the stake of the inactive
+// accounts when the inactive stake exemption is enabled. The stake of the inactive
+// accounts which voted on the proposal is not exempted, as it is part of the voting
+// power. The exempted stake is also capped so that the voting power never exceeds
+// the quorum bonded tokens, as the inactive stake is calculated at the start of the epoch.
+func (k Keeper) quorumBondedTokens(ctx context.Context, params v1.Params, totalBonded, votersInactive sdkmath.Int, totalVoterPower sdkmath.LegacyDec) (sdkmath.Int, error) {
+	if !totalVoterPower.IsPositive() {
+		return totalBonded, nil
+	}
+
+	inactive, err := k.GetInactiveStake(ctx, params)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	exempted := inactive.Sub(votersInactive)
+	if !exempted.IsPositive() {
+		return totalBonded, nil
+	}
+
+	maxExempted := totalBonded.ToLegacyDec().Sub(totalVoterPower).TruncateInt()
+	if !maxExempted.IsPositive() {
+		return totalBonded, nil
+	}
+
+	return totalBonded.Sub(sdkmath.MinInt(exempted, maxExempted)), nil
+}
+
+// votersInactiveStake returns the stake delegated to the given bonded validators by
+// the voters of a proposal whose stake is counted in the inactive stake of the current
+// epoch. It must be called before the votes of the proposal are tallied, as tallying
+// removes them.
+func (k Keeper) votersInactiveStake(ctx context.Context, params v1.Params, proposalID uint64, validators map[string]v1.ValidatorGovInfo) (sdkmath.Int, error) {
+	if params.InactiveStakeExemption == nil {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	inactive, err := k.InactiveStake.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.ZeroInt(), nil
+	} else if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	amount := sdkmath.LegacyZeroDec()
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err = k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], _ v1.Vote) (bool, error) {
+		voter := key.K2()
+		activity, err := k.AccountActivities.Get(ctx, voter)
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return true, err
+		}
+		if !inactiveInEpoch(activity, *inactive.EpochStart) {
+			return false, nil
+		}
+
+		return false, k.sk.IterateDelegations(ctx, voter, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+			if val, ok := validators[delegation.GetValidatorAddr()]; ok {
+				// delegation shares * bonded / total shares
+				amount = amount.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			}
+			return false
+		})
+	})
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return amount.TruncateInt(), nil
+}
+
+// inactiveInEpoch returns whether the stake of the account was counted in the inactive
+// stake of the epoch started at epochStart.
+func inactiveInEpoch(activity v1.AccountActivity, epochStart time.Time) bool {
+	if activity.EpochStart != nil && activity.EpochStart.Equal(epochStart) {
+		return activity.Inactive
+	}
+
+	return activity.PreviousEpochStart != nil && activity.PreviousEpochStart.Equal(epochStart) && activity.PreviouslyInactive
+}
+
+// recordVoteActivity records a vote as an activity of the voter, if the inactive
+// stake exemption is enabled.
+func (k Keeper) recordVoteActivity(ctx context.Context, voter sdk.AccAddress) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.InactiveStakeExemption == nil {
+		return nil
+	}
+
+	_, err = k.recordAccountActivity(ctx, voter)
+	return err
+}
+
+// recordAccountActivity sets the last activity of the account to now, along with
+// its current sequence. The epochs in which the account was found delegating are kept.
+func (k Keeper) recordAccountActivity(ctx context.Context, addr sdk.AccAddress) (v1.AccountActivity, error) {
+	addrStr, err := k.authKeeper.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return v1.AccountActivity{}, err
+	}
+
+	activity, err := k.AccountActivities.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return v1.AccountActivity{}, err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	activity.Address = addrStr
+	activity.Sequence = k.accountSequence(ctx, addr)
+	activity.LastActive = &now
+
+	return activity, k.AccountActivities.Set(ctx, addr, activity)
+}
+
+// accountSequence returns the sequence of the account, zero if it does not exist.
+func (k Keeper) accountSequence(ctx context.Context, addr sdk.AccAddress) uint64 {
+	acc := k.authKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return 0
+	}
+
+	return acc.GetSequence()
+}
+
+// accountActivity returns the activity of the account, and whether the account neither
+// voted nor sent a transaction during the activity window. An account is seen sending a
+// transaction when its sequence changed since its activity was last recorded. An account
+// without any recorded activity is not provably inactive, its activity is recorded from now.
+func (k Keeper) accountActivity(ctx context.Context, addr sdk.AccAddress, window time.Duration) (v1.AccountActivity, bool, error) {
+	activity, err := k.AccountActivities.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && activity.Sequence != k.accountSequence(ctx, addr)) {
+		activity, err = k.recordAccountActivity(ctx, addr)
+		return activity, false, err
+	} else if err != nil {
+		return v1.AccountActivity{}, false, err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	return activity, !now.Before(activity.LastActive.Add(window)), nil
+}
+
+// updateInactiveStake starts the calculation of the stake of the inactive accounts once
+// the epoch has elapsed, and continues the calculation in progress. The state of the
+// inactive stake exemption is removed when the exemption is disabled.
+func (k Keeper) updateInactiveStake(ctx context.Context, params v1.Params) error {
+	inactive, err := k.InactiveStake.Get(ctx)
+	has := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	calc, err := k.InactiveStakeCalculation.Get(ctx)
+	calculating := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	exemption := params.InactiveStakeExemption
+	if exemption == nil {
+		if !has && !calculating {
+			return nil
+		}
+		if err := k.InactiveStake.Remove(ctx); err != nil {
+			return err
+		}
+		if err := k.InactiveStakeCalculation.Remove(ctx); err != nil {
+			return err
+		}
+		return k.AccountActivities.Clear(ctx, nil)
+	}
+
+	if !calculating {
+		now := k.HeaderService.HeaderInfo(ctx).Time
+		if has && now.Before(inactive.EpochStart.Add(*exemption.EpochDuration)) {
+			return nil
+		}
+		calc = v1.InactiveStakeCalculation{
+			EpochStart: &now,
+			Amount:     sdkmath.ZeroInt().String(),
+		}
+	}
+
+	return k.calculateInactiveStake(ctx, calc, *exemption.ActivityWindow)
+}
+
+// calculateInactiveStake continues the calculation of the stake delegated to bonded
+// validators by the inactive accounts. The calculation is spread over several blocks,
+// as at most InactiveStakeBatchSize delegations and activities are processed per block.
+// The delegations are processed first, ordered by delegator, then the activities of the
+// accounts which no longer delegate are pruned. The new epoch starts once both are done.
+func (k Keeper) calculateInactiveStake(ctx context.Context, calc v1.InactiveStakeCalculation, window time.Duration) error {
+	budget := k.config.InactiveStakeBatchSize
+
+	if !calc.DelegationsProcessed {
+		processed, err := k.processDelegations(ctx, &calc, window, budget)
+		if err != nil {
+			return err
+		}
+		if processed >= budget {
+			budget = 0
+		} else {
+			budget -= processed
+		}
+	}
+
+	if calc.DelegationsProcessed && budget > 0 {
+		done, err := k.pruneAccountActivities(ctx, &calc, budget)
+		if err != nil {
+			return err
+		}
+		if done {
+			inactive := v1.InactiveStake{
+				Amount:           calc.Amount,
+				InactiveAccounts: calc.InactiveAccounts,
+				EpochStart:       calc.EpochStart,
+			}
+
+			k.Logger.Info(
+				"inactive stake recalculated",
+				"inactive_stake", inactive.Amount,
+				"inactive_accounts", inactive.InactiveAccounts,
+			)
+
+			if err := k.InactiveStakeCalculation.Remove(ctx); err != nil {
+				return err
+			}
+			return k.InactiveStake.Set(ctx, inactive)
+		}
+	}
+
+	return k.InactiveStakeCalculation.Set(ctx, calc)
+}
+
+// processDelegations sums the stake delegated to bonded validators by the inactive
+// accounts, from the delegations of calc.NextDelegator. The delegations of a delegator
+// are always processed in the same block, so that more than budget delegations may be
+// processed when a delegator has more delegations than the budget. The activity of each
+// delegator records whether its stake is counted in the inactive stake of the new epoch.
+// It returns the number of delegations processed.
+func (k Keeper) processDelegations(ctx context.Context, calc *v1.InactiveStakeCalculation, window time.Duration, budget uint64) (uint64, error) {
+	validators := make(map[string]sdk.ValidatorI)
+	if err := k.sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator sdk.ValidatorI) (stop bool) {
+		validators[validator.GetOperator()] = validator
+		return false
+	}); err != nil {
+		return 0, err
+	}
+
+	amount, ok := sdkmath.NewIntFromString(calc.Amount)
+	if !ok {
+		return 0, errors.New("invalid inactive stake amount: " + calc.Amount)
+	}
+
+	var (
+		iterErr   error
+		processed uint64
+		stopped   bool
+		// the delegator whose delegations are being processed
+		delegator sdk.AccAddress
+		stake     = sdkmath.LegacyZeroDec()
+		// whether the delegator delegates to a bonded validator
+		bonded bool
+	)
+	// flush records the activity of the delegator in the new epoch, and counts its stake
+	// if it is inactive
+	flush := func() error {
+		activity, inactive, err := k.accountActivity(ctx, delegator, window)
+		if err != nil {
+			return err
+		}
+
+		if activity.EpochStart == nil || !activity.EpochStart.Equal(*calc.EpochStart) {
+			activity.PreviousEpochStart = activity.EpochStart
+			activity.PreviouslyInactive = activity.Inactive
+		}
+		activity.EpochStart = calc.EpochStart
+		activity.Inactive = inactive && bonded
+		if activity.Inactive {
+			amount = amount.Add(stake.TruncateInt())
+			calc.InactiveAccounts++
+		}
+
+		return k.AccountActivities.Set(ctx, delegator, activity)
+	}
+
+	if err := k.sk.IterateAllDelegationsFrom(ctx, calc.NextDelegator, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.GetDelegatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		if !bytes.Equal(addr, delegator) {
+			if delegator != nil {
+				if iterErr = flush(); iterErr != nil {
+					return true
+				}
+			}
+			if processed >= budget {
+				calc.NextDelegator = addr
+				stopped = true
+				return true
+			}
+			delegator, stake, bonded = addr, sdkmath.LegacyZeroDec(), false
+		}
+
+		if validator, ok := validators[delegation.GetValidatorAddr()]; ok {
+			stake = stake.Add(validator.TokensFromShares(delegation.GetShares()))
+			bonded = true
+		}
+		processed++
+
+		return false
+	}); err != nil {
+		return 0, err
+	}
+	if iterErr != nil {
+		return 0, iterErr
+	}
+
+	if !stopped {
+		if delegator != nil {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+		calc.NextDelegator = nil
+		calc.DelegationsProcessed = true
+	}
+
+	calc.Amount = amount.String()
+	return processed, nil
+}
+
+// pruneAccountActivities removes the activities of the accounts which were not found
+// delegating by the calculation of the new epoch, from calc.NextAccount. At most budget
+// activities are checked. It returns whether all the activities have been checked.
+func (k Keeper) pruneAccountActivities(ctx context.Context, calc *v1.InactiveStakeCalculation, budget uint64) (bool, error) {
+	var (
+		checked  uint64
+		done     = true
+		toRemove []sdk.AccAddress
+	)
+	rng := new(collections.Range[sdk.AccAddress]).StartInclusive(calc.NextAccount)
+	if err := k.AccountActivities.Walk(ctx, rng, func(addr sdk.AccAddress, activity v1.AccountActivity) (bool, error) {
+		if checked >= budget {
+			calc.NextAccount = addr
+			done = false
+			return true, nil
+		}
+		checked++
+
+		if activity.EpochStart == nil || !activity.EpochStart.Equal(*calc.EpochStart) {
+			toRemove = append(toRemove, addr)
+		}
+		return false, nil
+	}); err != nil {
+		return false, err
+	}
+
+	for _, addr := range toRemove {
+		if err := k.AccountActivities.Remove(ctx, addr); err != nil {
+			return false, err
+		}
+	}
+
+	return done, nil
+}
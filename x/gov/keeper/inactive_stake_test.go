@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestInactiveStakeExemption(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	queryServer := keeper.NewQueryServer(govKeeper)
+	acctKeeper, stakingKeeper := mocks.acctKeeper, mocks.stakingKeeper
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+
+	var (
+		addrs    = simtestutil.CreateRandomAccounts(4)
+		valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+		// the operator of the first validator votes, and its delegator sends a transaction,
+		// while the operator of the second validator and its delegator stay inactive
+		activeVal, activeDel, inactiveVal, inactiveDel = addrs[0], addrs[2], addrs[1], addrs[3]
+	)
+	addrStr := func(addr sdk.AccAddress) string {
+		s, err := acctKeeper.AddressCodec().BytesToString(addr)
+		require.NoError(t, err)
+		return s
+	}
+	valAddrStr := func(addr sdk.ValAddress) string {
+		s, err := valCodec.BytesToString(addr)
+		require.NoError(t, err)
+		return s
+	}
+
+	validators := []stakingtypes.Validator{
+		{OperatorAddress: valAddrStr(valAddrs[0]), Status: stakingtypes.Bonded, Tokens: sdkmath.NewInt(1000000), DelegatorShares: sdkmath.LegacyNewDec(1000000)},
+		{OperatorAddress: valAddrStr(valAddrs[1]), Status: stakingtypes.Bonded, Tokens: sdkmath.NewInt(3000000), DelegatorShares: sdkmath.LegacyNewDec(3000000)},
+	}
+	delegations := []stakingtypes.Delegation{
+		{DelegatorAddress: addrStr(activeVal), ValidatorAddress: validators[0].OperatorAddress, Shares: sdkmath.LegacyNewDec(400000)},
+		{DelegatorAddress: addrStr(activeDel), ValidatorAddress: validators[0].OperatorAddress, Shares: sdkmath.LegacyNewDec(600000)},
+		{DelegatorAddress: addrStr(inactiveVal), ValidatorAddress: validators[1].OperatorAddress, Shares: sdkmath.LegacyNewDec(1000000)},
+		{DelegatorAddress: addrStr(inactiveDel), ValidatorAddress: validators[1].OperatorAddress, Shares: sdkmath.LegacyNewDec(2000000)},
+	}
+	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(4000000), nil).AnyTimes()
+	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+			for i, val := range validators {
+				fn(int64(i), val)
+			}
+			return nil
+		}).AnyTimes()
+	// the delegations are iterated through ordered by delegator, like in x/staking
+	sort.Slice(delegations, func(i, j int) bool {
+		return bytes.Compare(sdk.MustAccAddressFromBech32(delegations[i].DelegatorAddress), sdk.MustAccAddressFromBech32(delegations[j].DelegatorAddress)) < 0
+	})
+	stakingKeeper.EXPECT().IterateAllDelegationsFrom(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, delegator sdk.AccAddress, fn func(index int64, delegation sdk.DelegationI) bool) error {
+			var i int64
+			for _, del := range delegations {
+				if bytes.Compare(sdk.MustAccAddressFromBech32(del.DelegatorAddress), delegator) < 0 {
+					continue
+				}
+				if fn(i, del) {
+					return nil
+				}
+				i++
+			}
+			return nil
+		}).AnyTimes()
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, delegator sdk.AccAddress, fn func(index int64, delegation sdk.DelegationI) bool) error {
+			for i, del := range delegations {
+				if del.DelegatorAddress == addrStr(delegator) {
+					fn(int64(i), del)
+				}
+			}
+			return nil
+		}).AnyTimes()
+
+	// the sequence of an account is incremented by its transactions
+	sequences := make(map[string]uint64)
+	acctKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+			return authtypes.NewBaseAccount(addr, nil, 0, sequences[addr.String()])
+		}).AnyTimes()
+
+	startCtx := ctx
+	atTime := func(d time.Duration) sdk.Context {
+		header := startCtx.HeaderInfo()
+		header.Time = header.Time.Add(d)
+		return startCtx.WithHeaderInfo(header)
+	}
+	requireInactiveStake := func(ctx sdk.Context, amount string, accounts uint64) {
+		t.Helper()
+		res, err := queryServer.InactiveStake(ctx, &v1.QueryInactiveStakeRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, res.InactiveStake)
+		require.Equal(t, amount, res.InactiveStake.Amount)
+		require.Equal(t, accounts, res.InactiveStake.InactiveAccounts)
+	}
+
+	params := v1.DefaultParams()
+	activityWindow, epochDuration := 2*time.Hour, time.Hour
+	params.InactiveStakeExemption = &v1.InactiveStakeExemption{
+		ActivityWindow: &activityWindow,
+		EpochDuration:  &epochDuration,
+	}
+	require.NoError(t, params.ValidateBasic(address.NewBech32Codec("cosmos")))
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", activeDel, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+	// the accounts without recorded activity are not provably inactive
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	requireInactiveStake(ctx, "0", 0)
+
+	// the inactive stake is not recalculated before the end of the epoch
+	require.NoError(t, govKeeper.AccountActivities.Remove(ctx, inactiveDel))
+	require.NoError(t, govKeeper.EndBlocker(atTime(30*time.Minute)))
+	has, err := govKeeper.AccountActivities.Has(ctx, inactiveDel)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the accounts are still within the activity window at the end of the first epoch
+	require.NoError(t, govKeeper.EndBlocker(atTime(time.Hour)))
+	requireInactiveStake(ctx, "0", 0)
+
+	// a vote and a transaction are activities
+	require.NoError(t, govKeeper.AddVote(atTime(90*time.Minute), proposal.Id, activeVal, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	sequences[activeDel.String()]++
+
+	ctx = atTime(2 * time.Hour)
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	requireInactiveStake(ctx, "1000000", 1)
+	activity, err := govKeeper.AccountActivities.Get(ctx, activeDel)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), activity.Sequence)
+	require.Equal(t, ctx.HeaderInfo().Time, *activity.LastActive)
+
+	ctx = atTime(3 * time.Hour)
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	requireInactiveStake(ctx, "3000000", 2)
+
+	// the voting power of the first validator reaches the quorum only without the inactive stake
+	passes, _, _, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.True(t, passes)
+
+	// the stake of an inactive account which votes is part of the voting power, so it is not exempted:
+	// the vote of the inactive delegator reaches 2/3 of the bonded tokens without the stake of the
+	// inactive operator, short of the quorum
+	params.Quorum = "0.7"
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+	proposal2, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", activeDel, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal2))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal2.Id, inactiveDel, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	passes, _, _, err = govKeeper.Tally(ctx, proposal2)
+	require.NoError(t, err)
+	require.False(t, passes)
+
+	// the calculation of the inactive stake is spread over several blocks, processing one delegator
+	// then checking one activity per block, while the inactive stake of the current epoch stays in effect
+	govKeeper.SetInactiveStakeBatchSize(1)
+	ctx = atTime(4 * time.Hour)
+	for i := 0; i < 7; i++ {
+		require.NoError(t, govKeeper.EndBlocker(ctx))
+		requireInactiveStake(ctx, "3000000", 2)
+		calc, err := govKeeper.InactiveStakeCalculation.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, i >= 3, calc.DelegationsProcessed)
+	}
+
+	// the stake of the inactive delegator is still not exempted when it votes during the calculation
+	require.NoError(t, govKeeper.AddVote(ctx, proposal2.Id, inactiveDel, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	passes, _, _, err = govKeeper.Tally(ctx, proposal2)
+	require.NoError(t, err)
+	require.False(t, passes)
+
+	// the operators and the first delegator were inactive for the whole activity window, unlike the
+	// inactive delegator which voted
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	requireInactiveStake(ctx, "2000000", 3)
+	has, err = govKeeper.InactiveStakeCalculation.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// disabling the exemption removes its state, and the inactive stake counts again in the quorum
+	params.InactiveStakeExemption = nil
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+	require.NoError(t, govKeeper.EndBlocker(ctx))
+	res, err := queryServer.InactiveStake(ctx, &v1.QueryInactiveStakeRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.InactiveStake)
+	has, err = govKeeper.AccountActivities.Has(ctx, activeDel)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, activeVal, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	passes, _, _, err = govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.False(t, passes)
+}
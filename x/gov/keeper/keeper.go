@@ -38,6 +38,10 @@ type Keeper struct {
 	// Config represent extra module configuration
 	config Config
 
+	// paramAnnotations are the annotations of the module params, by type URL of the message updating them.
+	// They are set at wiring time and not stored in state.
+	paramAnnotations map[string][]v1.ParamAnnotation
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -61,10 +65,27 @@ type Keeper struct {
 	// ProposalVoteOptions key: proposalID | value:
 	// This is used to store multiple choice vote options
 	ProposalVoteOptions collections.Map[uint64, v1.ProposalVoteOptions]
+	// ProposalTurnouts key: proposalID | value: ProposalTurnout
+	// This is used to store the turnout of tallied proposals
+	ProposalTurnouts collections.Map[uint64, v1.ProposalTurnout]
 	// ActiveProposalsQueue key: votingEndTime+proposalID | value: proposalID
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// ScheduledProposalsQueue key: executionTime+proposalID | value: proposalID
+	ScheduledProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]
+	// DynamicMinDeposit stores the minimum deposit adjusted by the min deposit throttler.
+	// It is only set when the min deposit throttler is enabled.
+	DynamicMinDeposit collections.Item[v1.DynamicMinDeposit]
+	// InactiveStake stores the stake of the inactive accounts of the current epoch.
+	// It is only set when the inactive stake exemption is enabled.
+	InactiveStake collections.Item[v1.InactiveStake]
+	// AccountActivities key: delegatorAddr | value: AccountActivity
+	// This is used to track the activity of the delegators when the inactive stake exemption is enabled.
+	AccountActivities collections.Map[sdk.AccAddress, v1.AccountActivity]
+	// InactiveStakeCalculation stores the progress of the calculation of the inactive stake of a new epoch.
+	// It is only set while the calculation, spread over several blocks, is in progress.
+	InactiveStakeCalculation collections.Item[v1.InactiveStakeCalculation]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -110,27 +131,37 @@ func NewKeeper(
 	if config.MaxVoteOptionsLen == 0 {
 		config.MaxVoteOptionsLen = defaultConfig.MaxVoteOptionsLen
 	}
+	// If InactiveStakeBatchSize not set by app developer, set to default value.
+	if config.InactiveStakeBatchSize == 0 {
+		config.InactiveStakeBatchSize = defaultConfig.InactiveStakeBatchSize
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
-		Environment:            env,
-		authKeeper:             authKeeper,
-		bankKeeper:             bankKeeper,
-		sk:                     sk,
-		poolKeeper:             pk,
-		cdc:                    cdc,
-		config:                 config,
-		authority:              authority,
-		Constitution:           collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
-		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
-		MessageBasedParams:     collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
-		Deposits:               collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
-		Votes:                  collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
-		ProposalID:             collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
-		Proposals:              collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc)),
-		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
-		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
-		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		Environment:              env,
+		authKeeper:               authKeeper,
+		bankKeeper:               bankKeeper,
+		sk:                       sk,
+		poolKeeper:               pk,
+		cdc:                      cdc,
+		config:                   config,
+		authority:                authority,
+		Constitution:             collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
+		Params:                   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
+		MessageBasedParams:       collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
+		Deposits:                 collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		Votes:                    collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		ProposalID:               collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
+		Proposals:                collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc)),
+		ProposalVoteOptions:      collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
+		ProposalTurnouts:         collections.NewMap(sb, types.ProposalTurnoutsKeyPrefix, "proposal_turnouts", collections.Uint64Key, codec.CollValue[v1.ProposalTurnout](cdc)),
+		ActiveProposalsQueue:     collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
+		InactiveProposalsQueue:   collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		ScheduledProposalsQueue:  collections.NewMap(sb, types.ScheduledProposalQueuePrefix, "scheduled_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
+		DynamicMinDeposit:        collections.NewItem(sb, types.DynamicMinDepositKey, "dynamic_min_deposit", codec.CollValue[v1.DynamicMinDeposit](cdc)),
+		InactiveStake:            collections.NewItem(sb, types.InactiveStakeKey, "inactive_stake", codec.CollValue[v1.InactiveStake](cdc)),
+		AccountActivities:        collections.NewMap(sb, types.AccountActivitiesKeyPrefix, "account_activities", sdk.AccAddressKey, codec.CollValue[v1.AccountActivity](cdc)),
+		InactiveStakeCalculation: collections.NewItem(sb, types.InactiveStakeCalculationKey, "inactive_stake_calculation", codec.CollValue[v1.InactiveStakeCalculation](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetMinDeposit returns the current minimum deposit of standard proposals.
+// When the min deposit throttler is enabled, it is the minimum deposit adjusted
+// to the proposal volume, otherwise it is the min_deposit param.
+func (k Keeper) GetMinDeposit(ctx context.Context, params v1.Params) (sdk.Coins, error) {
+	if params.MinDepositThrottler == nil {
+		return params.MinDeposit, nil
+	}
+
+	dynamic, err := k.getDynamicMinDeposit(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamic.Amount, nil
+}
+
+// getProposalMinDeposit returns the minimum deposit of a proposal of the given type.
+func (k Keeper) getProposalMinDeposit(ctx context.Context, params v1.Params, proposalType v1.ProposalType) (sdk.Coins, error) {
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED {
+		return params.ExpeditedMinDeposit, nil
+	}
+
+	return k.GetMinDeposit(ctx, params)
+}
+
+// getDynamicMinDeposit returns the state of the dynamic minimum deposit. When it
+// has not been set yet, the update period starts now with the min_deposit param
+// clamped to the bounds of the throttler.
+func (k Keeper) getDynamicMinDeposit(ctx context.Context, params v1.Params) (v1.DynamicMinDeposit, error) {
+	dynamic, err := k.DynamicMinDeposit.Get(ctx)
+	if err == nil {
+		return dynamic, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return v1.DynamicMinDeposit{}, err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	return v1.DynamicMinDeposit{
+		Amount:     clampMinDeposit(params.MinDeposit, params.MinDepositThrottler),
+		LastUpdate: &now,
+	}, nil
+}
+
+// trackProposalSubmission counts a submitted proposal in the current update
+// period of the min deposit throttler, if enabled.
+func (k Keeper) trackProposalSubmission(ctx context.Context, params v1.Params) error {
+	if params.MinDepositThrottler == nil {
+		return nil
+	}
+
+	dynamic, err := k.getDynamicMinDeposit(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	dynamic.PeriodProposals++
+	return k.DynamicMinDeposit.Set(ctx, dynamic)
+}
+
+// updateDynamicMinDeposit adjusts the minimum deposit to the number of proposals
+// submitted during the update period, once the period has elapsed. The state of
+// the dynamic minimum deposit is removed when the throttler is disabled.
+func (k Keeper) updateDynamicMinDeposit(ctx context.Context, params v1.Params) error {
+	has, err := k.DynamicMinDeposit.Has(ctx)
+	if err != nil {
+		return err
+	}
+
+	throttler := params.MinDepositThrottler
+	if throttler == nil {
+		if has {
+			return k.DynamicMinDeposit.Remove(ctx)
+		}
+		return nil
+	}
+
+	dynamic, err := k.getDynamicMinDeposit(ctx, params)
+	if err != nil {
+		return err
+	}
+	if !has {
+		// the throttler has just been enabled, the first update period starts now
+		return k.DynamicMinDeposit.Set(ctx, dynamic)
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	if now.Before(dynamic.LastUpdate.Add(*throttler.UpdatePeriod)) {
+		// the bounds may have been updated by governance during the period
+		clamped := clampMinDeposit(dynamic.Amount, throttler)
+		if clamped.Equal(sdk.Coins(dynamic.Amount)) {
+			return nil
+		}
+
+		dynamic.Amount = clamped
+		return k.DynamicMinDeposit.Set(ctx, dynamic)
+	}
+
+	amount, err := nextMinDeposit(dynamic.Amount, dynamic.PeriodProposals, throttler)
+	if err != nil {
+		return err
+	}
+
+	if !amount.Equal(sdk.Coins(dynamic.Amount)) {
+		k.Logger.Info(
+			"minimum deposit adjusted",
+			"proposals", dynamic.PeriodProposals,
+			"previous_min_deposit", sdk.NewCoins(dynamic.Amount...).String(),
+			"min_deposit", amount.String(),
+		)
+	}
+
+	return k.DynamicMinDeposit.Set(ctx, v1.DynamicMinDeposit{
+		Amount:     amount,
+		LastUpdate: &now,
+	})
+}
+
+// nextMinDeposit returns the minimum deposit of the next update period, given the
+// number of proposals submitted during the elapsed one.
+func nextMinDeposit(current sdk.Coins, proposals uint64, throttler *v1.MinDepositThrottler) (sdk.Coins, error) {
+	var factor sdkmath.LegacyDec
+	switch {
+	case proposals > throttler.TargetProposals:
+		increaseRatio, err := sdkmath.LegacyNewDecFromStr(throttler.IncreaseRatio)
+		if err != nil {
+			return nil, err
+		}
+		factor = sdkmath.LegacyOneDec().Add(increaseRatio)
+	case proposals < throttler.TargetProposals:
+		decreaseRatio, err := sdkmath.LegacyNewDecFromStr(throttler.DecreaseRatio)
+		if err != nil {
+			return nil, err
+		}
+		factor = sdkmath.LegacyOneDec().Sub(decreaseRatio)
+	default:
+		return clampMinDeposit(current, throttler), nil
+	}
+
+	next := make(sdk.Coins, 0, len(current))
+	for _, coin := range current {
+		next = append(next, sdk.NewCoin(coin.Denom, coin.Amount.ToLegacyDec().Mul(factor).TruncateInt()))
+	}
+
+	return clampMinDeposit(next, throttler), nil
+}
+
+// clampMinDeposit returns the minimum deposit bounded by the floor and the ceiling
+// of the throttler, for each denom of the floor. A denom missing from the minimum
+// deposit is set to the floor.
+func clampMinDeposit(minDeposit sdk.Coins, throttler *v1.MinDepositThrottler) sdk.Coins {
+	ceiling := sdk.Coins(throttler.Ceiling)
+
+	clamped := make(sdk.Coins, 0, len(throttler.Floor))
+	for _, floor := range throttler.Floor {
+		amount := sdkmath.MaxInt(minDeposit.AmountOf(floor.Denom), floor.Amount)
+		amount = sdkmath.MinInt(amount, ceiling.AmountOf(floor.Denom))
+		clamped = append(clamped, sdk.NewCoin(floor.Denom, amount))
+	}
+
+	return clamped.Sort()
+}
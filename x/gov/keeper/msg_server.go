@@ -78,7 +78,7 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 	if msg.Expedited { // checking for backward compatibility
 		msg.ProposalType = v1.ProposalType_PROPOSAL_TYPE_EXPEDITED
 	}
-	if err := k.validateInitialDeposit(params, msg.GetInitialDeposit(), msg.ProposalType); err != nil {
+	if err := k.validateInitialDeposit(ctx, params, msg.GetInitialDeposit(), msg.ProposalType); err != nil {
 		return nil, err
 	}
 
@@ -86,11 +86,25 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 		return nil, err
 	}
 
+	if msg.ExecutionDelay != nil {
+		if err := validateExecutionDelay(params, *msg.ExecutionDelay, proposalMsgs, msg.ProposalType); err != nil {
+			return nil, err
+		}
+	}
+
 	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.ProposalType)
 	if err != nil {
 		return nil, err
 	}
 
+	// the execution delay is applied once the proposal passes
+	if msg.ExecutionDelay != nil && *msg.ExecutionDelay > 0 {
+		proposal.ExecutionDelay = msg.ExecutionDelay
+		if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -188,6 +202,27 @@ func (k msgServer) CancelProposal(ctx context.Context, msg *v1.MsgCancelProposal
 	}, nil
 }
 
+// CancelScheduledProposal implements the MsgServer.CancelScheduledProposal method.
+func (k msgServer) CancelScheduledProposal(ctx context.Context, msg *v1.MsgCancelScheduledProposal) (*v1.MsgCancelScheduledProposalResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.CancelScheduledProposal(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		govtypes.EventTypeScheduledProposal,
+		event.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute(govtypes.AttributeKeyProposalResult, govtypes.AttributeValueProposalCanceled),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeScheduledProposal)
+	}
+
+	return &v1.MsgCancelScheduledProposalResponse{}, nil
+}
+
 // ExecLegacyContent implements the MsgServer.ExecLegacyContent method.
 func (k msgServer) ExecLegacyContent(ctx context.Context, msg *v1.MsgExecLegacyContent) (*v1.MsgExecLegacyContentResponse, error) {
 	govAcct, err := k.authKeeper.AddressCodec().BytesToString(k.GetGovernanceAccount(ctx).GetAddress())
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -284,6 +285,73 @@ func (suite *KeeperTestSuite) TestMsgSubmitProposal() {
 				)
 			},
 			expErr: false,
+		},		"execution delay greater than max execution delay": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposerAddr,
+					"",
+					"Proposal",
+					"description of proposal",
+					v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+				)
+				executionDelay := *params.MaxExecutionDelay + time.Second
+				msg.ExecutionDelay = &executionDelay
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "greater than the maximum execution delay",
+		},
+		"scheduled cancellation of a scheduled proposal": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{&v1.MsgCancelScheduledProposal{Authority: govStrAcct, ProposalId: 1}},
+					initialDeposit,
+					proposerAddr,
+					"",
+					"Proposal",
+					"description of proposal",
+					v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+				)
+				executionDelay := time.Hour
+				msg.ExecutionDelay = &executionDelay
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "the cancellation of a scheduled proposal cannot be scheduled",
+		},
+		"optimistic cancellation of a scheduled proposal": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				return v1.NewMsgSubmitProposal(
+					[]sdk.Msg{&v1.MsgCancelScheduledProposal{Authority: govStrAcct, ProposalId: 1}},
+					initialDeposit,
+					proposerAddr,
+					"",
+					"Proposal",
+					"description of proposal",
+					v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC,
+				)
+			},
+			expErr:    true,
+			expErrMsg: "cannot cancel a scheduled proposal with an optimistic proposal",
+		},
+		"all good with execution delay": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposerAddr,
+					"",
+					"Proposal",
+					"description of proposal",
+					v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+				)
+				executionDelay := time.Hour
+				msg.ExecutionDelay = &executionDelay
+				return msg, err
+			},
+			expErr: false,
 		},
 	}
 
@@ -303,6 +371,86 @@ func (suite *KeeperTestSuite) TestMsgSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgCancelScheduledProposal() {
+	suite.reset()
+	proposerAddr, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	params, _ := suite.govKeeper.Params.Get(suite.ctx)
+
+	submitProposal := func(scheduled bool) uint64 {
+		msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{}, params.MinDeposit, proposerAddr, "{\"title\":\"Proposal\", \"summary\":\"description of proposal\"}", "Proposal", "description of proposal", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		suite.Require().NoError(err)
+		res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+
+		if scheduled {
+			proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+			suite.Require().NoError(err)
+			executionTime := suite.ctx.HeaderInfo().Time.Add(time.Hour)
+			proposal.Status = v1.StatusScheduled
+			proposal.ExecutionTime = &executionTime
+			suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal))
+			suite.Require().NoError(suite.govKeeper.ScheduledProposalsQueue.Set(suite.ctx, collections.Join(executionTime, proposal.Id), proposal.Id))
+		}
+
+		return res.ProposalId
+	}
+
+	votingProposalID := submitProposal(false)
+	scheduledProposalID := submitProposal(true)
+
+	testCases := []struct {
+		name      string
+		input     *v1.MsgCancelScheduledProposal
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &v1.MsgCancelScheduledProposal{Authority: proposerAddr, ProposalId: scheduledProposalID},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "proposal not found",
+			input:     &v1.MsgCancelScheduledProposal{Authority: suite.govKeeper.GetAuthority(), ProposalId: 100},
+			expErrMsg: "proposal 100 doesn't exist",
+		},
+		{
+			name:      "proposal not scheduled",
+			input:     &v1.MsgCancelScheduledProposal{Authority: suite.govKeeper.GetAuthority(), ProposalId: votingProposalID},
+			expErrMsg: "is not scheduled for execution",
+		},
+		{
+			name:  "valid",
+			input: &v1.MsgCancelScheduledProposal{Authority: suite.govKeeper.GetAuthority(), ProposalId: scheduledProposalID},
+		},
+		{
+			name:      "already canceled",
+			input:     &v1.MsgCancelScheduledProposal{Authority: suite.govKeeper.GetAuthority(), ProposalId: scheduledProposalID},
+			expErrMsg: "is not scheduled for execution",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.CancelScheduledProposal(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, tc.input.ProposalId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(v1.StatusRejected, proposal.Status)
+
+			has, err := suite.govKeeper.ScheduledProposalsQueue.Has(suite.ctx, collections.Join(*proposal.ExecutionTime, proposal.Id))
+			suite.Require().NoError(err)
+			suite.Require().False(has)
+		})
+	}
+}
+
 // TestSubmitMultipleChoiceProposal tests only multiple choice proposal specific logic.
 // Internally the message uses MsgSubmitProposal, which is tested above.
 func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// SetParamAnnotations sets the annotations of the module params, which describe them and the risk of
+// changing them to the voters of the proposals updating them. The annotations are not stored in state,
+// they must be set at wiring time, before the app is started.
+func (k *Keeper) SetParamAnnotations(annotations ...v1.ParamAnnotation) error {
+	paramAnnotations := make(map[string][]v1.ParamAnnotation)
+	for _, annotation := range annotations {
+		if err := annotation.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if slices.ContainsFunc(paramAnnotations[annotation.MsgUrl], func(a v1.ParamAnnotation) bool {
+			return a.Param == annotation.Param
+		}) {
+			return fmt.Errorf("duplicate param annotation of %s %s", annotation.MsgUrl, annotation.Param)
+		}
+
+		paramAnnotations[annotation.MsgUrl] = append(paramAnnotations[annotation.MsgUrl], annotation)
+	}
+
+	for _, annotations := range paramAnnotations {
+		slices.SortFunc(annotations, func(a, b v1.ParamAnnotation) int {
+			return strings.Compare(a.Param, b.Param)
+		})
+	}
+
+	k.paramAnnotations = paramAnnotations
+	return nil
+}
+
+// GetParamAnnotations returns the annotations of the params updated by the message with the given type URL,
+// or all the annotations if it is empty, sorted by message type URL and param name.
+func (k Keeper) GetParamAnnotations(msgURL string) []*v1.ParamAnnotation {
+	msgURLs := []string{msgURL}
+	if msgURL == "" {
+		msgURLs = make([]string, 0, len(k.paramAnnotations))
+		for url := range k.paramAnnotations {
+			msgURLs = append(msgURLs, url)
+		}
+		slices.Sort(msgURLs)
+	}
+
+	var annotations []*v1.ParamAnnotation
+	for _, url := range msgURLs {
+		for _, annotation := range k.paramAnnotations[url] {
+			annotations = append(annotations, &annotation)
+		}
+	}
+
+	return annotations
+}
+
+// GetProposalParamAnnotations returns the annotations of the params updated by the messages of the proposal.
+func (k Keeper) GetProposalParamAnnotations(proposal v1.Proposal) []*v1.ParamAnnotation {
+	var annotations []*v1.ParamAnnotation
+	seen := make(map[string]bool, len(proposal.Messages))
+	for _, msg := range proposal.Messages {
+		if seen[msg.TypeUrl] {
+			continue
+		}
+		seen[msg.TypeUrl] = true
+
+		annotations = append(annotations, k.GetParamAnnotations(msg.TypeUrl)...)
+	}
+
+	return annotations
+}
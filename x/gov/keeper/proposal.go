@@ -44,6 +44,12 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		}
 	}
 
+	// the cancellation of a scheduled proposal requires a supermajority,
+	// which an optimistic proposal passing without votes cannot provide.
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC && cancelsScheduledProposal(messages) {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, "cannot cancel a scheduled proposal with an optimistic proposal")
+	}
+
 	msgs := make([]string, 0, len(messages)) // will hold a string slice of all Msg type URLs.
 
 	// Loop through all messages and confirm that each has a handler and the gov module account as the only signer
@@ -164,6 +170,10 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		return v1.Proposal{}, err
 	}
 
+	if err = k.trackProposalSubmission(ctx, params); err != nil {
+		return v1.Proposal{}, err
+	}
+
 	// called right after a proposal is submitted
 	err = k.Hooks().AfterProposalSubmission(ctx, proposalID)
 	if err != nil {
@@ -256,6 +266,87 @@ func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer
 	return nil
 }
 
+// CancelScheduledProposal cancels the execution of a proposal that has passed and is waiting for its
+// scheduled execution time. The proposal is marked as rejected and its messages are never executed.
+func (k Keeper) CancelScheduledProposal(ctx context.Context, proposalID uint64) error {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrInvalidProposal.Wrapf("proposal %d doesn't exist", proposalID)
+		}
+		return err
+	}
+
+	if proposal.Status != v1.StatusScheduled || proposal.ExecutionTime == nil {
+		return types.ErrInvalidProposal.Wrapf("proposal %d is not scheduled for execution", proposalID)
+	}
+
+	if err := k.ScheduledProposalsQueue.Remove(ctx, collections.Join(*proposal.ExecutionTime, proposal.Id)); err != nil {
+		return err
+	}
+
+	proposal.Status = v1.StatusRejected
+	proposal.FailedReason = "scheduled execution canceled by governance"
+	if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return err
+	}
+
+	k.Logger.Info(
+		"scheduled proposal execution is canceled",
+		"proposal", proposal.Id,
+		"execution_time", proposal.ExecutionTime.String(),
+	)
+
+	return nil
+}
+
+// validateExecutionDelay checks that a proposal can be scheduled with the given execution delay.
+func validateExecutionDelay(params v1.Params, executionDelay time.Duration, messages []sdk.Msg, proposalType v1.ProposalType) error {
+	if executionDelay < 0 {
+		return errorsmod.Wrapf(types.ErrInvalidExecutionDelay, "execution delay must not be negative: %s", executionDelay)
+	}
+
+	if executionDelay == 0 {
+		return nil
+	}
+
+	if params.MaxExecutionDelay == nil || *params.MaxExecutionDelay == 0 {
+		return errorsmod.Wrap(types.ErrInvalidExecutionDelay, "scheduled proposal execution is disabled")
+	}
+
+	if executionDelay > *params.MaxExecutionDelay {
+		return errorsmod.Wrapf(types.ErrInvalidExecutionDelay, "execution delay %s is greater than the maximum execution delay %s", executionDelay, params.MaxExecutionDelay)
+	}
+
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE || len(messages) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidExecutionDelay, "a proposal without messages cannot be scheduled")
+	}
+
+	if cancelsScheduledProposal(messages) {
+		return errorsmod.Wrap(types.ErrInvalidExecutionDelay, "the cancellation of a scheduled proposal cannot be scheduled")
+	}
+
+	return nil
+}
+
+// cancelsScheduledProposal returns true if any of the messages, or of the messages they sudo execute,
+// cancels the execution of a scheduled proposal.
+func cancelsScheduledProposal(messages []sdk.Msg) bool {
+	for _, msg := range messages {
+		switch msg := msg.(type) {
+		case *v1.MsgCancelScheduledProposal:
+			return true
+		case *v1.MsgSudoExec:
+			sudoedMsg, err := msg.GetSudoedMsg()
+			if err == nil && cancelsScheduledProposal([]sdk.Msg{sudoedMsg}) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // DeleteProposal deletes a proposal from store.
 func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 	proposal, err := k.Proposals.Get(ctx, proposalID)
@@ -275,6 +366,16 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 			return err
 		}
 	}
+	if proposal.ExecutionTime != nil {
+		err := k.ScheduledProposalsQueue.Remove(ctx, collections.Join(*proposal.ExecutionTime, proposalID))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := k.ProposalTurnouts.Remove(ctx, proposalID); err != nil {
+		return err
+	}
 
 	return k.Proposals.Remove(ctx, proposalID)
 }
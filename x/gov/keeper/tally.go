@@ -13,53 +13,88 @@ import (
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the voters
 func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	passes, burnDeposits, tallyResults, _, err = k.TallyWithTurnout(ctx, proposal)
+	return passes, burnDeposits, tallyResults, err
+}
+
+// TallyWithTurnout tallies a proposal like Tally and additionally returns its turnout.
+// The split between validator and delegator voting power is accumulated while
+// the votes are tallied, it is only available with the default vote calculation function.
+func (k Keeper) TallyWithTurnout(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, turnout v1.ProposalTurnout, err error) {
 	validators, err := k.getCurrentValidators(ctx)
 	if err != nil {
-		return false, false, v1.TallyResult{}, err
+		return false, false, v1.TallyResult{}, v1.ProposalTurnout{}, err
 	}
 
-	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
-		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, false, v1.TallyResult{}, v1.ProposalTurnout{}, err
 	}
 
-	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	// the stake of the inactive voters is computed before the votes are tallied, which removes them
+	votersInactive, err := k.votersInactiveStake(ctx, params, proposal.Id, validators)
 	if err != nil {
-		return false, false, v1.TallyResult{}, err
+		return false, false, v1.TallyResult{}, v1.ProposalTurnout{}, err
 	}
 
-	params, err := k.Params.Get(ctx)
+	tracker := newTurnoutTracker()
+
+	var (
+		totalVoterPower math.LegacyDec
+		results         map[v1.VoteOption]math.LegacyDec
+	)
+	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
+		totalVoterPower, results, err = calculateVoteResultsAndVotingPower(ctx, k, proposal.Id, validators, tracker)
+	} else {
+		totalVoterPower, results, err = k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	}
 	if err != nil {
-		return false, false, v1.TallyResult{}, err
+		return false, false, v1.TallyResult{}, v1.ProposalTurnout{}, err
 	}
+
 	tallyResults = v1.NewTallyResultFromMap(results)
 
-	// If there is no staked coins, the proposal fails
 	totalBonded, err := k.sk.TotalBondedTokens(ctx)
 	if err != nil {
-		return false, false, v1.TallyResult{}, err
+		return false, false, v1.TallyResult{}, v1.ProposalTurnout{}, err
+	}
+
+	quorum, err := k.proposalQuorum(ctx, proposal, params)
+	if err != nil {
+		return false, false, v1.TallyResult{}, v1.ProposalTurnout{}, err
 	}
+	turnout = tracker.turnout(proposal.Id, totalVoterPower, totalBonded, quorum)
 
+	// If there is no staked coins, the proposal fails
 	if totalBonded.IsZero() {
-		return false, false, tallyResults, nil
+		return false, false, tallyResults, turnout, nil
 	}
 
 	// If there are more spam votes than the sum of all other options, proposal fails
 	// A proposal with no votes should not be considered spam
 	if !totalVoterPower.Equal(math.LegacyZeroDec()) &&
 		results[v1.OptionSpam].GTE(results[v1.OptionOne].Add(results[v1.OptionTwo].Add(results[v1.OptionThree].Add(results[v1.OptionFour])))) {
-		return false, true, tallyResults, nil
+		return false, true, tallyResults, turnout, nil
+	}
+
+	// the stake of the inactive accounts which did not vote is excluded from the quorum denominator
+	quorumBonded, err := k.quorumBondedTokens(ctx, params, totalBonded, votersInactive, totalVoterPower)
+	if err != nil {
+		return false, false, v1.TallyResult{}, v1.ProposalTurnout{}, err
 	}
 
 	switch proposal.ProposalType {
 	case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
-		return k.tallyOptimistic(totalVoterPower, totalBonded, results, params)
+		passes, burnDeposits, tallyResults, err = k.tallyOptimistic(totalVoterPower, totalBonded, results, params)
 	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
-		return k.tallyExpedited(totalVoterPower, totalBonded, results, params)
+		passes, burnDeposits, tallyResults, err = k.tallyExpedited(proposal, totalVoterPower, quorumBonded, results, params)
 	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
-		return k.tallyMultipleChoice(totalVoterPower, totalBonded, results, params)
+		passes, burnDeposits, tallyResults, err = k.tallyMultipleChoice(totalVoterPower, quorumBonded, results, params)
 	default:
-		return k.tallyStandard(ctx, proposal, totalVoterPower, totalBonded, results, params)
+		passes, burnDeposits, tallyResults, err = k.tallyStandard(ctx, proposal, totalVoterPower, quorumBonded, results, params)
 	}
+
+	return passes, burnDeposits, tallyResults, turnout, err
 }
 
 // tallyStandard tallies the votes of a standard proposal
@@ -116,6 +151,7 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
+	threshold = scheduledCancelThreshold(proposal, params, threshold)
 	if results[v1.OptionYes].Quo(totalVoterPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults, nil
 	}
@@ -131,7 +167,7 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 // If more than 2/3 of non-abstaining voters vote Yes, proposal passes
 // If more than 1/2 of non-abstaining voters vote No, proposal fails
 // Checking for spam votes is done before calling this function
-func (k Keeper) tallyExpedited(totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+func (k Keeper) tallyExpedited(proposal v1.Proposal, totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is not enough quorum of votes, the proposal fails
@@ -160,6 +196,7 @@ func (k Keeper) tallyExpedited(totalVoterPower math.LegacyDec, totalBonded math.
 
 	// If more than 2/3 of non-abstaining voters vote Yes, proposal passes
 	threshold, _ := math.LegacyNewDecFromStr(params.GetExpeditedThreshold())
+	threshold = scheduledCancelThreshold(proposal, params, threshold)
 	if results[v1.OptionYes].Quo(totalVoterPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults, nil
 	}
@@ -233,12 +270,14 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 }
 
 // calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
-// and returns the votes results from voters
-func defaultCalculateVoteResultsAndVotingPower(
+// and returns the votes results from voters. The voting power cast by validators and delegators
+// is recorded in the turnout tracker.
+func calculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	k Keeper,
 	proposalID uint64,
 	validators map[string]v1.ValidatorGovInfo,
+	tracker *turnoutTracker,
 ) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
@@ -258,12 +297,14 @@ func defaultCalculateVoteResultsAndVotingPower(
 			return false, err
 		}
 
-		if val, ok := validators[valAddrStr]; ok {
+		val, isValidator := validators[valAddrStr]
+		if isValidator {
 			val.Vote = vote.Options
 			validators[valAddrStr] = val
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
+		delegatorPower := math.LegacyZeroDec()
 		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr()
 
@@ -283,6 +324,7 @@ func defaultCalculateVoteResultsAndVotingPower(
 				}
 
 				totalVP = totalVP.Add(votingPower)
+				delegatorPower = delegatorPower.Add(votingPower)
 			}
 
 			return false
@@ -290,6 +332,7 @@ func defaultCalculateVoteResultsAndVotingPower(
 		if err != nil {
 			return false, err
 		}
+		tracker.addDelegatorVote(delegatorPower, isValidator)
 
 		votesToRemove = append(votesToRemove, key)
 		return false, nil
@@ -319,6 +362,7 @@ func defaultCalculateVoteResultsAndVotingPower(
 			results[option.Option] = results[option.Option].Add(subPower)
 		}
 		totalVP = totalVP.Add(votingPower)
+		tracker.addValidatorVote(votingPower)
 	}
 
 	return totalVP, results, nil
@@ -334,3 +378,100 @@ func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
 
 	return results
 }
+
+// proposalQuorum returns the quorum applicable to a proposal, it depends on the
+// proposal type and on the message based params of its first message.
+func (k Keeper) proposalQuorum(ctx context.Context, proposal v1.Proposal, params v1.Params) (string, error) {
+	switch proposal.ProposalType {
+	case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
+		// optimistic proposals have no quorum
+		return math.LegacyZeroDec().String(), nil
+	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
+		return params.ExpeditedQuorum, nil
+	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
+		return params.Quorum, nil
+	}
+
+	if len(proposal.Messages) > 0 {
+		customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
+		if err == nil {
+			return customMessageParams.GetQuorum(), nil
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return "", err
+		}
+	}
+
+	return params.Quorum, nil
+}
+
+// turnoutTracker accumulates the voting power cast by validators and by
+// delegators while the votes of a proposal are tallied.
+type turnoutTracker struct {
+	validatorPower  math.LegacyDec
+	delegatorPower  math.LegacyDec
+	validatorVoters uint64
+	delegatorVoters uint64
+}
+
+func newTurnoutTracker() *turnoutTracker {
+	return &turnoutTracker{
+		validatorPower: math.LegacyZeroDec(),
+		delegatorPower: math.LegacyZeroDec(),
+	}
+}
+
+func (t *turnoutTracker) addValidatorVote(votingPower math.LegacyDec) {
+	t.validatorPower = t.validatorPower.Add(votingPower)
+	t.validatorVoters++
+}
+
+// addDelegatorVote records the voting power of the delegations of a voter.
+// The delegations of a validator operator are accounted as validator voting power,
+// and voters without any delegation to a bonded validator are not counted.
+func (t *turnoutTracker) addDelegatorVote(votingPower math.LegacyDec, isValidator bool) {
+	if isValidator {
+		t.validatorPower = t.validatorPower.Add(votingPower)
+		return
+	}
+	if !votingPower.IsPositive() {
+		return
+	}
+	t.delegatorPower = t.delegatorPower.Add(votingPower)
+	t.delegatorVoters++
+}
+
+func (t *turnoutTracker) turnout(proposalID uint64, totalVoterPower math.LegacyDec, totalBonded math.Int, quorum string) v1.ProposalTurnout {
+	turnout := math.LegacyZeroDec()
+	if totalBonded.IsPositive() {
+		turnout = totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	}
+
+	return v1.ProposalTurnout{
+		ProposalId:           proposalID,
+		ValidatorVotingPower: t.validatorPower.TruncateInt().String(),
+		DelegatorVotingPower: t.delegatorPower.TruncateInt().String(),
+		TotalVotingPower:     totalVoterPower.TruncateInt().String(),
+		TotalBonded:          totalBonded.String(),
+		Turnout:              turnout.String(),
+		Quorum:               quorum,
+		ValidatorVoters:      t.validatorVoters,
+		DelegatorVoters:      t.delegatorVoters,
+	}
+}
+
+// scheduledCancelThreshold returns the threshold a proposal must reach to pass. When the proposal
+// cancels the execution of a scheduled proposal, the threshold is raised to the scheduled cancel threshold.
+func scheduledCancelThreshold(proposal v1.Proposal, params v1.Params, threshold math.LegacyDec) math.LegacyDec {
+	messages, err := proposal.GetMsgs()
+	if err != nil || !cancelsScheduledProposal(messages) {
+		return threshold
+	}
+
+	cancelThreshold, err := math.LegacyNewDecFromStr(params.ScheduledCancelThreshold)
+	if err != nil {
+		// params set before the scheduled cancel threshold was introduced
+		cancelThreshold = v1.DefaultScheduledCancelThreshold
+	}
+
+	return math.LegacyMaxDec(threshold, cancelThreshold)
+}
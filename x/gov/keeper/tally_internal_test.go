@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestScheduledCancelThreshold(t *testing.T) {
+	t.Parallel()
+
+	params := v1.DefaultParams()
+	threshold := math.LegacyNewDecWithPrec(5, 1)
+	cancelMsg := &v1.MsgCancelScheduledProposal{Authority: "authority", ProposalId: 1}
+
+	sudoCancelMsg := &v1.MsgSudoExec{Authority: "authority"}
+	_, err := sudoCancelMsg.SetSudoedMsg(cancelMsg)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		params   func(*v1.Params)
+		expected math.LegacyDec
+	}{
+		{
+			name:     "no messages",
+			expected: threshold,
+		},
+		{
+			name:     "no cancellation",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}},
+			expected: threshold,
+		},
+		{
+			name:     "cancellation",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}, cancelMsg},
+			expected: v1.DefaultScheduledCancelThreshold,
+		},
+		{
+			name:     "sudo-ed cancellation",
+			msgs:     []sdk.Msg{sudoCancelMsg},
+			expected: v1.DefaultScheduledCancelThreshold,
+		},
+		{
+			name: "cancellation with a lower cancel threshold",
+			msgs: []sdk.Msg{cancelMsg},
+			params: func(p *v1.Params) {
+				p.ScheduledCancelThreshold = "0.4"
+			},
+			expected: threshold,
+		},
+		{
+			name: "cancellation without cancel threshold",
+			msgs: []sdk.Msg{cancelMsg},
+			params: func(p *v1.Params) {
+				p.ScheduledCancelThreshold = ""
+			},
+			expected: v1.DefaultScheduledCancelThreshold,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := params
+			if tc.params != nil {
+				tc.params(&p)
+			}
+
+			proposal, err := v1.NewProposal(tc.msgs, 1, time.Now(), time.Now(), "", "title", "summary", "proposer", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, scheduledCancelThreshold(proposal, p, threshold))
+		})
+	}
+}
@@ -1546,3 +1546,82 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestTallyWithTurnout(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	params := v1.DefaultParams()
+	err := govKeeper.Params.Set(ctx, params)
+	require.NoError(t, err)
+
+	var (
+		numVals       = 10
+		numDelegators = 5
+		addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+		valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+		delAddrs      = addrs[numVals:]
+	)
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+				for i := int64(0); i < int64(numVals); i++ {
+					valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+					require.NoError(t, err)
+					fn(i, stakingtypes.Validator{
+						OperatorAddress: valAddr,
+						Status:          stakingtypes.Bonded,
+						Tokens:          sdkmath.NewInt(1000000),
+						DelegatorShares: sdkmath.LegacyNewDec(1000000),
+					})
+				}
+				return nil
+			})
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+	require.NoError(t, err)
+	s := tallyFixture{
+		t:        t,
+		proposal: proposal,
+		valAddrs: valAddrs,
+		delAddrs: delAddrs,
+		ctx:      ctx,
+		keeper:   govKeeper,
+		mocks:    mocks,
+	}
+
+	setTotalBonded(s, 10000000)
+	del0Addr, err := mocks.acctKeeper.AddressCodec().BytesToString(delAddrs[0])
+	require.NoError(t, err)
+	val0Addr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[0])
+	require.NoError(t, err)
+	val1Addr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[1])
+	require.NoError(t, err)
+
+	// validator 0 votes with its delegators minus delegator 0
+	validatorVote(s, valAddrs[0], v1.VoteOption_VOTE_OPTION_ONE)
+	// delegator 0 overrides the vote of validator 0 and votes for validator 1
+	delegatorVote(s, delAddrs[0], []stakingtypes.Delegation{
+		{DelegatorAddress: del0Addr, ValidatorAddress: val0Addr, Shares: sdkmath.LegacyNewDec(200000)},
+		{DelegatorAddress: del0Addr, ValidatorAddress: val1Addr, Shares: sdkmath.LegacyNewDec(300000)},
+	}, v1.VoteOption_VOTE_OPTION_THREE)
+	// delegator 1 has no delegation, its vote does not count
+	delegatorVote(s, delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+
+	_, _, tally, turnout, err := govKeeper.TallyWithTurnout(ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, "800000", tally.OptionOneCount)
+	require.Equal(t, "500000", tally.OptionThreeCount)
+	require.Equal(t, v1.ProposalTurnout{
+		ProposalId:           proposal.Id,
+		ValidatorVotingPower: "800000",
+		DelegatorVotingPower: "500000",
+		TotalVotingPower:     "1300000",
+		TotalBonded:          "10000000",
+		Turnout:              sdkmath.LegacyNewDecWithPrec(13, 2).String(),
+		Quorum:               params.Quorum,
+		ValidatorVoters:      1,
+		DelegatorVoters:      1,
+	}, turnout)
+}
@@ -83,6 +83,11 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 
+	// a vote is an activity of the voter for the inactive stake exemption
+	if err := k.recordVoteActivity(ctx, voterAddr); err != nil {
+		return err
+	}
+
 	// called after a vote on a proposal is cast
 	if err = k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
 		return err
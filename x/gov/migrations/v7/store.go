@@ -0,0 +1,30 @@
+package v7
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The migration
+// includes:
+//
+// Addition of gov params for scheduled proposal execution.
+func MigrateStore(ctx context.Context, paramsCollection collections.Item[v1.Params]) error {
+	govParams, err := paramsCollection.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get gov params: %w", err)
+	}
+
+	defaultParams := v1.DefaultParams()
+	if govParams.MaxExecutionDelay == nil {
+		govParams.MaxExecutionDelay = defaultParams.MaxExecutionDelay
+	}
+	if govParams.ScheduledCancelThreshold == "" {
+		govParams.ScheduledCancelThreshold = defaultParams.ScheduledCancelThreshold
+	}
+
+	return paramsCollection.Set(ctx, govParams)
+}
@@ -31,6 +31,8 @@ const (
 	ProposalCancelRate            = "proposal_cancel_rate"
 	ProposalMaxCancelVotingPeriod = "proposal_max_cancel_voting_period"
 	MinDepositRatio               = "min_deposit_ratio"
+	MaxExecutionDelay             = "max_execution_delay"
+	ScheduledCancelThreshold      = "scheduled_cancel_threshold"
 
 	// ExpeditedThreshold must be at least as large as the regular Threshold
 	// Therefore, we use this break out point in randomization.
@@ -116,6 +118,17 @@ func GenMinDepositRatio(r *rand.Rand) sdkmath.LegacyDec {
 	return sdkmath.LegacyMustNewDecFromStr("0.01")
 }
 
+// GenMaxExecutionDelay returns randomized MaxExecutionDelay
+func GenMaxExecutionDelay(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*2)) * time.Second
+}
+
+// GenScheduledCancelThreshold returns randomized ScheduledCancelThreshold
+// It is always greater than GenThreshold
+func GenScheduledCancelThreshold(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 667, 1000)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -168,6 +181,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var minDepositRatio sdkmath.LegacyDec
 	simState.AppParams.GetOrGenerate(MinDepositRatio, &minDepositRatio, simState.Rand, func(r *rand.Rand) { minDepositRatio = GenMinDepositRatio(r) })
 
+	var maxExecutionDelay time.Duration
+	simState.AppParams.GetOrGenerate(MaxExecutionDelay, &maxExecutionDelay, simState.Rand, func(r *rand.Rand) { maxExecutionDelay = GenMaxExecutionDelay(r) })
+
+	var scheduledCancelThreshold sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(ScheduledCancelThreshold, &scheduledCancelThreshold, simState.Rand, func(r *rand.Rand) { scheduledCancelThreshold = GenScheduledCancelThreshold(r) })
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
 		v1.NewParams(
@@ -193,6 +212,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			optimisticRejectedThreshold.String(),
 			[]string{},
 			10_000_000,
+			maxExecutionDelay,
+			scheduledCancelThreshold.String(),
 		),
 	)
 
@@ -60,6 +60,11 @@ type StakingKeeper interface {
 		ctx context.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
 	) error
+	// iterate through the delegations ordered by delegator, from the delegations of the given delegator
+	IterateAllDelegationsFrom(
+		ctx context.Context, delegator sdk.AccAddress,
+		fn func(index int64, delegation sdk.DelegationI) (stop bool),
+	) error
 
 	BondDenom(ctx context.Context) (string, error)
 	TokensFromConsensusPower(ctx context.Context, power int64) math.Int
@@ -350,6 +350,20 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// IterateAllDelegationsFrom mocks base method.
+func (m *MockStakingKeeper) IterateAllDelegationsFrom(ctx context.Context, delegator types.AccAddress, fn func(int64, types.DelegationI) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateAllDelegationsFrom", ctx, delegator, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateAllDelegationsFrom indicates an expected call of IterateAllDelegationsFrom.
+func (mr *MockStakingKeeperMockRecorder) IterateAllDelegationsFrom(ctx, delegator, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllDelegationsFrom", reflect.TypeOf((*MockStakingKeeper)(nil).IterateAllDelegationsFrom), ctx, delegator, fn)
+}
+
 // IterateBondedValidatorsByPower mocks base method.
 func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, types.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
@@ -2,12 +2,13 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeSubmitProposal    = "submit_proposal"
+	EventTypeProposalDeposit   = "proposal_deposit"
+	EventTypeProposalVote      = "proposal_vote"
+	EventTypeInactiveProposal  = "inactive_proposal"
+	EventTypeActiveProposal    = "active_proposal"
+	EventTypeCancelProposal    = "cancel_proposal"
+	EventTypeScheduledProposal = "scheduled_proposal"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +20,7 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyExecutionTime        = "execution_time"         // execution time of a scheduled proposal
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
@@ -27,6 +29,7 @@ const (
 	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // didn't meet optimistic vote quorum
 	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
 	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueProposalScheduled          = "proposal_scheduled"           // passed, execution scheduled
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
@@ -26,6 +26,12 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	ProposalTurnoutsKeyPrefix    = collections.NewPrefix(52) // ProposalTurnoutsKeyPrefix stores the turnouts of tallied proposals.
+	ScheduledProposalQueuePrefix = collections.NewPrefix(53) // ScheduledProposalQueuePrefix stores the passed proposals waiting for execution.
+	DynamicMinDepositKey         = collections.NewPrefix(54) // DynamicMinDepositKey stores the minimum deposit adjusted by the min deposit throttler.
+	InactiveStakeKey             = collections.NewPrefix(55) // InactiveStakeKey stores the stake of the inactive accounts excluded from the quorum.
+	AccountActivitiesKeyPrefix   = collections.NewPrefix(56) // AccountActivitiesKeyPrefix stores the last activities of the delegator accounts.
+	InactiveStakeCalculationKey  = collections.NewPrefix(57) // InactiveStakeCalculationKey stores the progress of the calculation of the inactive stake of a new epoch.
 )
 
 // Reserved kvstore keys
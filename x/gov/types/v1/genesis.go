@@ -8,6 +8,9 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -52,6 +55,47 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		proposalIds[p.Id] = struct{}{}
 	}
 
+	if d := data.DynamicMinDeposit; d != nil {
+		if amount := sdk.Coins(d.Amount); !amount.IsValid() {
+			return fmt.Errorf("invalid dynamic minimum deposit: %s", amount)
+		}
+		if d.LastUpdate == nil {
+			return errors.New("dynamic minimum deposit last update must not be nil")
+		}
+	}
+
+	if s := data.InactiveStake; s != nil {
+		if amount, ok := math.NewIntFromString(s.Amount); !ok || amount.IsNegative() {
+			return fmt.Errorf("invalid inactive stake amount: %s", s.Amount)
+		}
+		if s.EpochStart == nil {
+			return errors.New("inactive stake epoch start must not be nil")
+		}
+	}
+
+	if c := data.InactiveStakeCalculation; c != nil {
+		if amount, ok := math.NewIntFromString(c.Amount); !ok || amount.IsNegative() {
+			return fmt.Errorf("invalid inactive stake calculation amount: %s", c.Amount)
+		}
+		if c.EpochStart == nil {
+			return errors.New("inactive stake calculation epoch start must not be nil")
+		}
+	}
+
+	activities := make(map[string]struct{})
+	for _, a := range data.AccountActivities {
+		if _, err := ac.StringToBytes(a.Address); err != nil {
+			return fmt.Errorf("invalid account activity address %s: %w", a.Address, err)
+		}
+		if _, ok := activities[a.Address]; ok {
+			return fmt.Errorf("duplicate account activity: %s", a.Address)
+		}
+		if a.LastActive == nil {
+			return fmt.Errorf("account activity last active time must not be nil: %s", a.Address)
+		}
+		activities[a.Address] = struct{}{}
+	}
+
 	// weed out duplicate deposits
 	errGroup.Go(func() error {
 		type depositKey struct {
@@ -98,6 +142,24 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate turnouts
+	errGroup.Go(func() error {
+		turnoutIds := make(map[uint64]struct{})
+		for _, t := range data.ProposalTurnouts {
+			if _, ok := proposalIds[t.ProposalId]; !ok {
+				return fmt.Errorf("turnout %v has non-existent proposal id: %d", t, t.ProposalId)
+			}
+
+			if _, ok := turnoutIds[t.ProposalId]; ok {
+				return fmt.Errorf("duplicate turnout: %v", t)
+			}
+
+			turnoutIds[t.ProposalId] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -49,6 +49,16 @@ type GenesisState struct {
 	// There are no amendments, to go outside of scope, just fork.
 	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// proposal_turnouts defines the turnouts of the tallied proposals present at genesis.
+	ProposalTurnouts []*ProposalTurnout `protobuf:"bytes,10,rep,name=proposal_turnouts,json=proposalTurnouts,proto3" json:"proposal_turnouts,omitempty"`
+	// dynamic_min_deposit defines the state of the minimum deposit adjusted by the min deposit throttler.
+	DynamicMinDeposit *DynamicMinDeposit `protobuf:"bytes,11,opt,name=dynamic_min_deposit,json=dynamicMinDeposit,proto3" json:"dynamic_min_deposit,omitempty"`
+	// inactive_stake defines the stake of the inactive accounts calculated by the inactive stake exemption.
+	InactiveStake *InactiveStake `protobuf:"bytes,12,opt,name=inactive_stake,json=inactiveStake,proto3" json:"inactive_stake,omitempty"`
+	// account_activities defines the last activities of the delegator accounts tracked by the inactive stake exemption.
+	AccountActivities []*AccountActivity `protobuf:"bytes,13,rep,name=account_activities,json=accountActivities,proto3" json:"account_activities,omitempty"`
+	// inactive_stake_calculation defines the progress of the calculation of the inactive stake of a new epoch.
+	InactiveStakeCalculation *InactiveStakeCalculation `protobuf:"bytes,14,opt,name=inactive_stake_calculation,json=inactiveStakeCalculation,proto3" json:"inactive_stake_calculation,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +160,41 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetProposalTurnouts() []*ProposalTurnout {
+	if m != nil {
+		return m.ProposalTurnouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDynamicMinDeposit() *DynamicMinDeposit {
+	if m != nil {
+		return m.DynamicMinDeposit
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInactiveStake() *InactiveStake {
+	if m != nil {
+		return m.InactiveStake
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountActivities() []*AccountActivity {
+	if m != nil {
+		return m.AccountActivities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInactiveStakeCalculation() *InactiveStakeCalculation {
+	if m != nil {
+		return m.InactiveStakeCalculation
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -157,32 +202,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbd, 0x6e, 0xe2, 0x40,
-	0x14, 0x85, 0x19, 0xfe, 0x16, 0x06, 0xb3, 0x2b, 0x0d, 0xbb, 0x8b, 0x17, 0x56, 0x96, 0xb5, 0x95,
-	0xb7, 0xc0, 0x06, 0x12, 0x44, 0x1d, 0x84, 0x84, 0xd2, 0x21, 0x27, 0x4a, 0x91, 0x06, 0x39, 0xd8,
-	0xb2, 0x2c, 0xc0, 0x63, 0x71, 0x27, 0xa3, 0xf0, 0x16, 0x79, 0x89, 0xbc, 0x41, 0x1e, 0x22, 0x25,
-	0x65, 0x94, 0x2a, 0x82, 0x17, 0x89, 0x98, 0xb1, 0x03, 0x38, 0x94, 0x73, 0xcf, 0x77, 0xce, 0x1c,
-	0x5d, 0x5d, 0xdc, 0x9c, 0x52, 0x58, 0x50, 0xb0, 0x7c, 0xca, 0x2d, 0xde, 0xb1, 0x7c, 0x2f, 0xf4,
-	0x20, 0x00, 0x33, 0x5a, 0x52, 0x46, 0x49, 0x55, 0x8a, 0xa6, 0x4f, 0xb9, 0xc9, 0x3b, 0x8d, 0x7a,
-	0x8a, 0xa5, 0x5c, 0x72, 0x8d, 0x3f, 0x52, 0x98, 0x88, 0x97, 0x15, 0x9b, 0xc4, 0xe3, 0xdf, 0x53,
-	0x1e, 0x2b, 0x23, 0x19, 0x7a, 0xc5, 0x1c, 0xe6, 0x91, 0x36, 0xfe, 0x09, 0xcc, 0x59, 0xb2, 0x20,
-	0xf4, 0x77, 0x7c, 0x44, 0xc1, 0x99, 0x4f, 0x02, 0x57, 0x45, 0x3a, 0x32, 0xf2, 0x36, 0x49, 0xb4,
-	0x71, 0x2c, 0x5d, 0xba, 0xa4, 0x8b, 0x4b, 0xae, 0x17, 0x51, 0x08, 0x18, 0xa8, 0x59, 0x3d, 0x67,
-	0x54, 0xba, 0xbf, 0xcd, 0xa3, 0x62, 0xe6, 0x50, 0xca, 0xf6, 0x27, 0x47, 0xfe, 0xe3, 0x02, 0xa7,
-	0xcc, 0x03, 0x35, 0x27, 0x0c, 0xb5, 0x94, 0xe1, 0x86, 0x32, 0xcf, 0x96, 0x04, 0xe9, 0xe1, 0x72,
-	0xd2, 0x03, 0xd4, 0xbc, 0xc0, 0xeb, 0x29, 0x3c, 0x29, 0x63, 0xef, 0x49, 0x32, 0xc2, 0xdf, 0xe3,
-	0xdf, 0x26, 0x91, 0xb3, 0x74, 0x16, 0xa0, 0x16, 0x74, 0x64, 0x54, 0xba, 0x7f, 0x4f, 0x77, 0x1b,
-	0x0b, 0x66, 0x90, 0x55, 0x91, 0x5d, 0x75, 0x0f, 0x47, 0x64, 0x88, 0xab, 0x9c, 0xca, 0x75, 0xc8,
-	0x9c, 0xa2, 0xc8, 0x69, 0x7e, 0xad, 0xbc, 0x5b, 0xcb, 0x3e, 0x46, 0xe1, 0x07, 0x13, 0x72, 0x81,
-	0x15, 0xe6, 0xcc, 0xe7, 0xab, 0x24, 0xe4, 0x9b, 0x08, 0x69, 0xa4, 0x42, 0xae, 0x77, 0xc8, 0x41,
-	0x46, 0x85, 0xed, 0x07, 0x64, 0x80, 0x8b, 0xb1, 0xb9, 0x24, 0xcc, 0xbf, 0xd2, 0x5b, 0x90, 0xbe,
-	0xda, 0xdb, 0x73, 0xeb, 0x87, 0x54, 0x5a, 0xe0, 0xce, 0xf4, 0xb6, 0x79, 0xde, 0xb7, 0x63, 0x27,
-	0xe9, 0x63, 0x65, 0x4a, 0x43, 0x60, 0x01, 0xbb, 0x67, 0x01, 0x0d, 0xd5, 0xb2, 0x8e, 0x8c, 0xf2,
-	0x09, 0x4b, 0xaf, 0x6d, 0x1f, 0x81, 0x83, 0xde, 0xcb, 0x46, 0x43, 0xeb, 0x8d, 0x86, 0xde, 0x37,
-	0x1a, 0x7a, 0xdc, 0x6a, 0x99, 0xf5, 0x56, 0xcb, 0xbc, 0x6e, 0xb5, 0xcc, 0x6d, 0x7c, 0xa1, 0xe0,
-	0xce, 0xcc, 0x80, 0x5a, 0x0f, 0xe2, 0xfa, 0xd8, 0x2a, 0xf2, 0xc0, 0xe2, 0x9d, 0xbb, 0xa2, 0xb8,
-	0xb2, 0xb3, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0xe0, 0xfa, 0xb1, 0xc7, 0x02, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6f, 0xda, 0x30,
+	0x18, 0xc6, 0x9b, 0xfe, 0x61, 0x60, 0x02, 0x6b, 0xcd, 0xb6, 0x7a, 0x74, 0x8a, 0xa2, 0x5d, 0xc6,
+	0x0e, 0x24, 0xc0, 0x86, 0x7a, 0x86, 0x55, 0xaa, 0xaa, 0x69, 0x52, 0x95, 0x76, 0x3b, 0xec, 0x12,
+	0xb9, 0x89, 0x85, 0x2c, 0xc0, 0x8e, 0xb0, 0xf1, 0xc6, 0x27, 0xd8, 0x75, 0x1f, 0xa6, 0x1f, 0x62,
+	0xc7, 0x1e, 0xa7, 0x9e, 0x26, 0xf8, 0x22, 0x13, 0x76, 0x28, 0x90, 0xa5, 0x3b, 0xe6, 0x7d, 0x7f,
+	0xcf, 0x93, 0xc7, 0xef, 0x6b, 0x19, 0x9c, 0x44, 0x5c, 0x8c, 0xb9, 0xf0, 0x07, 0x5c, 0xf9, 0xaa,
+	0xed, 0x0f, 0x08, 0x23, 0x82, 0x0a, 0x2f, 0x99, 0x70, 0xc9, 0x61, 0xc5, 0x34, 0xbd, 0x01, 0x57,
+	0x9e, 0x6a, 0xd7, 0x8f, 0x33, 0x2c, 0x57, 0x86, 0xab, 0xbf, 0x34, 0x8d, 0x50, 0x7f, 0xf9, 0xa9,
+	0x48, 0x7f, 0xbc, 0xfe, 0x51, 0x04, 0xf6, 0xb9, 0x31, 0xbd, 0x92, 0x58, 0x12, 0xd8, 0x02, 0xcf,
+	0x84, 0xc4, 0x13, 0x49, 0xd9, 0x60, 0xc9, 0x27, 0x5c, 0xe0, 0x51, 0x48, 0x63, 0x64, 0xb9, 0x56,
+	0x63, 0x3f, 0x80, 0xab, 0xde, 0x65, 0xda, 0xba, 0x88, 0x61, 0x07, 0x14, 0x63, 0x92, 0x70, 0x41,
+	0xa5, 0x40, 0xbb, 0xee, 0x5e, 0xa3, 0xdc, 0x79, 0xe1, 0x6d, 0x05, 0xf3, 0xce, 0x4c, 0x3b, 0x78,
+	0xe0, 0xe0, 0x5b, 0x70, 0xa0, 0xb8, 0x24, 0x02, 0xed, 0x69, 0x41, 0x2d, 0x23, 0xf8, 0xc2, 0x25,
+	0x09, 0x0c, 0x01, 0xbb, 0xa0, 0xb4, 0xca, 0x21, 0xd0, 0xbe, 0xc6, 0x8f, 0x33, 0xf8, 0x2a, 0x4c,
+	0xb0, 0x26, 0xe1, 0x39, 0xa8, 0xa6, 0x7f, 0x0b, 0x13, 0x3c, 0xc1, 0x63, 0x81, 0x0e, 0x5c, 0xab,
+	0x51, 0xee, 0xbc, 0xca, 0xcf, 0x76, 0xa9, 0x99, 0xfe, 0x2e, 0xb2, 0x82, 0x4a, 0xbc, 0x59, 0x82,
+	0x67, 0xa0, 0xa2, 0xb8, 0x19, 0x87, 0xf1, 0x29, 0x68, 0x9f, 0x93, 0x7f, 0x23, 0x2f, 0xc7, 0xb2,
+	0xb6, 0xb1, 0xd5, 0x46, 0x05, 0xf6, 0x80, 0x2d, 0xf1, 0x68, 0x34, 0x5b, 0x99, 0x3c, 0xd1, 0x26,
+	0xf5, 0x8c, 0xc9, 0xf5, 0x12, 0xd9, 0xf0, 0x28, 0xcb, 0x75, 0x01, 0xf6, 0x41, 0x21, 0x15, 0x17,
+	0xb5, 0xf8, 0x79, 0x76, 0x0a, 0x46, 0x57, 0xbb, 0xbf, 0x6d, 0x3e, 0x35, 0x9d, 0xa6, 0x88, 0x87,
+	0x6e, 0xcb, 0x7b, 0x7f, 0x1a, 0xa4, 0x4a, 0x78, 0x0a, 0xec, 0x88, 0x33, 0x21, 0xa9, 0x9c, 0x4a,
+	0xca, 0x19, 0x2a, 0xb9, 0x56, 0xa3, 0x94, 0x23, 0xe9, 0xb6, 0x82, 0x2d, 0x10, 0x7e, 0x04, 0x47,
+	0x0f, 0xb7, 0x41, 0x4e, 0x27, 0x8c, 0x4f, 0xa5, 0x40, 0x40, 0x6f, 0xc3, 0x79, 0x64, 0x1b, 0xd7,
+	0x06, 0x0b, 0x0e, 0x93, 0xed, 0x82, 0x80, 0x37, 0xa0, 0x16, 0xcf, 0x18, 0x1e, 0xd3, 0x28, 0x1c,
+	0x53, 0x16, 0xa6, 0xf3, 0x46, 0x65, 0x7d, 0x2c, 0x37, 0xbb, 0x20, 0x43, 0x7e, 0xa2, 0x2c, 0x5d,
+	0x55, 0xff, 0xf0, 0xfe, 0xb6, 0x69, 0x7f, 0x5f, 0xde, 0x6f, 0x57, 0xb5, 0xbc, 0x8e, 0xd7, 0x0a,
+	0x8e, 0xe2, 0x2c, 0x04, 0x3f, 0x83, 0x2a, 0x65, 0x38, 0x92, 0x54, 0x91, 0x50, 0x48, 0x3c, 0x24,
+	0xc8, 0xce, 0xdd, 0xff, 0x45, 0x0a, 0x5d, 0x2d, 0x99, 0x1c, 0xeb, 0x0a, 0xdd, 0x04, 0x60, 0x08,
+	0x20, 0x8e, 0x22, 0x3e, 0x65, 0x32, 0xd4, 0x65, 0x2a, 0x29, 0x11, 0xa8, 0x92, 0x3b, 0x88, 0x9e,
+	0x01, 0x7b, 0x86, 0x9b, 0xe5, 0xe5, 0xc6, 0x5b, 0x08, 0x25, 0x02, 0x7e, 0x03, 0xf5, 0xed, 0xdc,
+	0x61, 0x84, 0x47, 0xd1, 0x74, 0x84, 0xf5, 0xbe, 0xaa, 0xfa, 0x0c, 0x6f, 0xfe, 0x77, 0x86, 0x0f,
+	0x6b, 0x3c, 0xe7, 0x8f, 0x88, 0x3e, 0xc6, 0x76, 0x7f, 0xcd, 0x1d, 0xeb, 0x6e, 0xee, 0x58, 0x7f,
+	0xe6, 0x8e, 0xf5, 0x73, 0xe1, 0xec, 0xdc, 0x2d, 0x9c, 0x9d, 0xdf, 0x0b, 0x67, 0xe7, 0x6b, 0xfa,
+	0x06, 0x89, 0x78, 0xe8, 0x51, 0xee, 0x6b, 0x3b, 0x5f, 0xce, 0x12, 0x22, 0x7c, 0xd5, 0xbe, 0x29,
+	0xe8, 0x77, 0xe4, 0xdd, 0xdf, 0x01, 0x00, 0x2a, 0xe7, 0xfa, 0x58, 0xa9, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -205,6 +260,70 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InactiveStakeCalculation != nil {
+		{
+			size, err := m.InactiveStakeCalculation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.AccountActivities) > 0 {
+		for iNdEx := len(m.AccountActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.InactiveStake != nil {
+		{
+			size, err := m.InactiveStake.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DynamicMinDeposit != nil {
+		{
+			size, err := m.DynamicMinDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ProposalTurnouts) > 0 {
+		for iNdEx := len(m.ProposalTurnouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalTurnouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -368,6 +487,30 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ProposalTurnouts) > 0 {
+		for _, e := range m.ProposalTurnouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DynamicMinDeposit != nil {
+		l = m.DynamicMinDeposit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InactiveStake != nil {
+		l = m.InactiveStake.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AccountActivities) > 0 {
+		for _, e := range m.AccountActivities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.InactiveStakeCalculation != nil {
+		l = m.InactiveStakeCalculation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -703,6 +846,182 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTurnouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTurnouts = append(m.ProposalTurnouts, &ProposalTurnout{})
+			if err := m.ProposalTurnouts[len(m.ProposalTurnouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicMinDeposit == nil {
+				m.DynamicMinDeposit = &DynamicMinDeposit{}
+			}
+			if err := m.DynamicMinDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InactiveStake == nil {
+				m.InactiveStake = &InactiveStake{}
+			}
+			if err := m.InactiveStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountActivities = append(m.AccountActivities, &AccountActivity{})
+			if err := m.AccountActivities[len(m.AccountActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveStakeCalculation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InactiveStakeCalculation == nil {
+				m.InactiveStakeCalculation = &InactiveStakeCalculation{}
+			}
+			if err := m.InactiveStakeCalculation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -172,6 +173,45 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "invalid inactive stake exemption",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				activityWindow, epochDuration := time.Hour, time.Duration(0)
+				params1.InactiveStakeExemption = &v1.InactiveStakeExemption{
+					ActivityWindow: &activityWindow,
+					EpochDuration:  &epochDuration,
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "epoch duration must be positive",
+		},
+		{
+			name: "invalid inactive stake",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				epochStart := time.Now()
+				state.InactiveStake = &v1.InactiveStake{Amount: "-1", EpochStart: &epochStart}
+
+				return state
+			},
+			expErrMsg: "invalid inactive stake amount: -1",
+		},
+		{
+			name: "duplicate account activities",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				lastActive := time.Now()
+				addr, err := codec.BytesToString(sdk.AccAddress("account"))
+				require.NoError(t, err)
+				activity := &v1.AccountActivity{Address: addr, LastActive: &lastActive}
+				state.AccountActivities = append(state.AccountActivities, activity, activity)
+
+				return state
+			},
+			expErrMsg: "duplicate account activity",
+		},
 	}
 
 	for _, tc := range testCases {
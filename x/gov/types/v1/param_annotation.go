@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ValidateBasic performs basic validation of the param annotation.
+func (a ParamAnnotation) ValidateBasic() error {
+	if a.MsgUrl == "" {
+		return errors.New("param annotation msg url cannot be empty")
+	}
+
+	if a.Param == "" {
+		return fmt.Errorf("param annotation of %s: param cannot be empty", a.MsgUrl)
+	}
+
+	if _, ok := ParamRiskLevel_name[int32(a.RiskLevel)]; !ok {
+		return fmt.Errorf("param annotation of %s %s: invalid risk level %d", a.MsgUrl, a.Param, a.RiskLevel)
+	}
+
+	return nil
+}
+
+// ParamAnnotationsWrapper is a wrapper for the param annotations a module provides to the
+// governance module through depinject.
+type ParamAnnotationsWrapper struct{ Annotations []ParamAnnotation }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (ParamAnnotationsWrapper) IsOnePerModuleType() {}
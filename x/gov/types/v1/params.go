@@ -38,7 +38,9 @@ var (
 	DefaultMinDepositRatio                     = sdkmath.LegacyMustNewDecFromStr("0.01")
 	DefaultOptimisticRejectedThreshold         = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses        = []string(nil)
-	DefaultProposalExecutionGas         uint64 = 10_000_000 // ten million
+	DefaultProposalExecutionGas         uint64 = 10_000_000          // ten million
+	DefaultMaxExecutionDelay                   = time.Hour * 24 * 14 // 14 days
+	DefaultScheduledCancelThreshold            = sdkmath.LegacyNewDecWithPrec(667, 3)
 )
 
 // NewParams creates a new Params instance with given values.
@@ -50,6 +52,8 @@ func NewParams(
 	minDepositRatio, optimisticRejectedThreshold string,
 	optimisticAuthorizedAddresses []string,
 	proposalExecutionGas uint64,
+	maxExecutionDelay time.Duration,
+	scheduledCancelThreshold string,
 ) Params {
 	return Params{
 		MinDeposit:                    minDeposit,
@@ -74,6 +78,8 @@ func NewParams(
 		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
 		ProposalExecutionGas:          proposalExecutionGas,
+		MaxExecutionDelay:             &maxExecutionDelay,
+		ScheduledCancelThreshold:      scheduledCancelThreshold,
 	}
 }
 
@@ -102,6 +108,8 @@ func DefaultParams() Params {
 		DefaultOptimisticRejectedThreshold.String(),
 		DefaultOptimisticAuthorizedAddreses,
 		DefaultProposalExecutionGas,
+		DefaultMaxExecutionDelay,
+		DefaultScheduledCancelThreshold.String(),
 	)
 }
 
@@ -275,6 +283,108 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("proposal execution gas must be positive: %d", p.ProposalExecutionGas)
 	}
 
+	if p.MaxExecutionDelay != nil && p.MaxExecutionDelay.Seconds() < 0 {
+		return fmt.Errorf("maximum execution delay must not be negative: %s", p.MaxExecutionDelay)
+	}
+
+	scheduledCancelThreshold, err := sdkmath.LegacyNewDecFromStr(p.ScheduledCancelThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid scheduled cancel threshold string: %w", err)
+	}
+	if scheduledCancelThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("scheduled cancel threshold too large: %s", scheduledCancelThreshold)
+	}
+	if scheduledCancelThreshold.LT(threshold) {
+		return fmt.Errorf("scheduled cancel threshold %s, must be greater or equal than the regular threshold %s", scheduledCancelThreshold, threshold)
+	}
+
+	if p.MinDepositThrottler != nil {
+		if err := p.MinDepositThrottler.ValidateBasic(minDeposit, p.ExpeditedMinDeposit); err != nil {
+			return fmt.Errorf("invalid min deposit throttler: %w", err)
+		}
+	}
+
+	if p.InactiveStakeExemption != nil {
+		if err := p.InactiveStakeExemption.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid inactive stake exemption: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation on the inactive stake exemption.
+func (e InactiveStakeExemption) ValidateBasic() error {
+	if e.ActivityWindow == nil {
+		return fmt.Errorf("activity window must not be nil: %d", e.ActivityWindow)
+	}
+	if e.ActivityWindow.Seconds() <= 0 {
+		return fmt.Errorf("activity window must be positive: %s", e.ActivityWindow)
+	}
+
+	if e.EpochDuration == nil {
+		return fmt.Errorf("epoch duration must not be nil: %d", e.EpochDuration)
+	}
+	if e.EpochDuration.Seconds() <= 0 {
+		return fmt.Errorf("epoch duration must be positive: %s", e.EpochDuration)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation on the min deposit throttler, given the
+// minimum deposits of the governance parameters.
+func (t MinDepositThrottler) ValidateBasic(minDeposit, expeditedMinDeposit sdk.Coins) error {
+	floor, ceiling := sdk.Coins(t.Floor), sdk.Coins(t.Ceiling)
+	if floor.Empty() || !floor.IsValid() {
+		return fmt.Errorf("invalid floor: %s", floor)
+	}
+	if ceiling.Empty() || !ceiling.IsValid() {
+		return fmt.Errorf("invalid ceiling: %s", ceiling)
+	}
+	if !floor.DenomsSubsetOf(minDeposit) || !minDeposit.DenomsSubsetOf(floor) {
+		return fmt.Errorf("floor %s must have the denoms of the minimum deposit %s", floor, minDeposit)
+	}
+	if !ceiling.DenomsSubsetOf(minDeposit) || !minDeposit.DenomsSubsetOf(ceiling) {
+		return fmt.Errorf("ceiling %s must have the denoms of the minimum deposit %s", ceiling, minDeposit)
+	}
+	if !floor.IsAllLTE(ceiling) {
+		return fmt.Errorf("floor %s must be lower or equal than the ceiling %s", floor, ceiling)
+	}
+	if expeditedMinDeposit.IsAllLTE(ceiling) {
+		return fmt.Errorf("expedited minimum deposit must be greater than the ceiling: %s", expeditedMinDeposit)
+	}
+
+	if t.UpdatePeriod == nil {
+		return fmt.Errorf("update period must not be nil: %d", t.UpdatePeriod)
+	}
+	if t.UpdatePeriod.Seconds() <= 0 {
+		return fmt.Errorf("update period must be positive: %s", t.UpdatePeriod)
+	}
+
+	if t.TargetProposals == 0 {
+		return fmt.Errorf("target proposals must be positive: %d", t.TargetProposals)
+	}
+
+	increaseRatio, err := sdkmath.LegacyNewDecFromStr(t.IncreaseRatio)
+	if err != nil {
+		return fmt.Errorf("invalid increase ratio string: %w", err)
+	}
+	if !increaseRatio.IsPositive() {
+		return fmt.Errorf("increase ratio must be positive: %s", increaseRatio)
+	}
+
+	decreaseRatio, err := sdkmath.LegacyNewDecFromStr(t.DecreaseRatio)
+	if err != nil {
+		return fmt.Errorf("invalid decrease ratio string: %w", err)
+	}
+	if !decreaseRatio.IsPositive() {
+		return fmt.Errorf("decrease ratio must be positive: %s", decreaseRatio)
+	}
+	if decreaseRatio.GTE(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("decrease ratio must be lower than one: %s", decreaseRatio)
+	}
+
 	return nil
 }
 
@@ -21,6 +21,7 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusScheduled     = ProposalStatus_PROPOSAL_STATUS_SCHEDULED
 )
 
 // NewProposal creates a new Proposal instance
@@ -137,7 +138,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusScheduled {
 		return true
 	}
 	return false
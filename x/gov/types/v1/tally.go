@@ -59,6 +59,19 @@ func EmptyTallyResult() TallyResult {
 	return NewTallyResult(math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt())
 }
 
+// EmptyProposalTurnout returns an empty turnout for the given proposal.
+func EmptyProposalTurnout(proposalID uint64) ProposalTurnout {
+	return ProposalTurnout{
+		ProposalId:           proposalID,
+		ValidatorVotingPower: math.ZeroInt().String(),
+		DelegatorVotingPower: math.ZeroInt().String(),
+		TotalVotingPower:     math.ZeroInt().String(),
+		TotalBonded:          math.ZeroInt().String(),
+		Turnout:              math.LegacyZeroDec().String(),
+		Quorum:               math.LegacyZeroDec().String(),
+	}
+}
+
 // Equals returns if two tally results are equal.
 func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.YesCount == comp.YesCount &&
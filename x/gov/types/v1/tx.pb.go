@@ -19,6 +19,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -61,6 +62,10 @@ type MsgSubmitProposal struct {
 	// proposal_type defines the type of proposal
 	// When not set defaults to PROPOSAL_TYPE_STANDARD
 	ProposalType ProposalType `protobuf:"varint,8,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// execution_delay defines the delay between the end of the voting period and the execution
+	// of the proposal messages. It must not exceed the max_execution_delay param.
+	// When not set, the messages are executed as soon as the proposal passes.
+	ExecutionDelay *time.Duration `protobuf:"bytes,9,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -153,6 +158,13 @@ func (m *MsgSubmitProposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *MsgSubmitProposal) GetExecutionDelay() *time.Duration {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return nil
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -1169,6 +1181,98 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgCancelScheduledProposal defines a message to cancel the execution of a scheduled proposal.
+type MsgCancelScheduledProposal struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// proposal_id defines the unique id of the scheduled proposal to cancel.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgCancelScheduledProposal) Reset()         { *m = MsgCancelScheduledProposal{} }
+func (m *MsgCancelScheduledProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledProposal) ProtoMessage()    {}
+func (*MsgCancelScheduledProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{20}
+}
+func (m *MsgCancelScheduledProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledProposal.Merge(m, src)
+}
+func (m *MsgCancelScheduledProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledProposal proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledProposal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgCancelScheduledProposalResponse defines the Msg/CancelScheduledProposal response type.
+type MsgCancelScheduledProposalResponse struct {
+}
+
+func (m *MsgCancelScheduledProposalResponse) Reset()         { *m = MsgCancelScheduledProposalResponse{} }
+func (m *MsgCancelScheduledProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledProposalResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{21}
+}
+func (m *MsgCancelScheduledProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -1190,105 +1294,114 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.gov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.gov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgCancelScheduledProposal)(nil), "cosmos.gov.v1.MsgCancelScheduledProposal")
+	proto.RegisterType((*MsgCancelScheduledProposalResponse)(nil), "cosmos.gov.v1.MsgCancelScheduledProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0xed, 0x4c, 0xdc, 0xa4, 0xd9, 0xb8, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
-	0xa8, 0x49, 0xf1, 0xda, 0x4e, 0x9b, 0x02, 0xa6, 0xaa, 0x54, 0x87, 0x02, 0x95, 0x30, 0x54, 0xdb,
-	0x0f, 0x24, 0xa8, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xaf, 0x67, 0xf1, 0xac, 0xad, 0xf8, 0x80,
-	0x84, 0x38, 0xf6, 0xd4, 0x0b, 0x12, 0x42, 0xe2, 0x0e, 0x9c, 0x72, 0xf0, 0x09, 0x8e, 0x5c, 0xaa,
-	0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0xa8, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xcd, 0xec, 0xec,
-	0xae, 0xbd, 0xbb, 0x76, 0xd2, 0x22, 0x21, 0x2e, 0xad, 0xf7, 0x7d, 0xcd, 0x7b, 0xbf, 0x79, 0xf3,
-	0x7b, 0x2f, 0x60, 0xa9, 0x81, 0xb0, 0x89, 0x70, 0x51, 0x47, 0xdd, 0x62, 0xb7, 0x5c, 0xb4, 0x77,
-	0x64, 0xab, 0x8d, 0x6c, 0xc4, 0x1f, 0x73, 0xe4, 0xb2, 0x8e, 0xba, 0x72, 0xb7, 0x2c, 0x66, 0x98,
-	0xd9, 0x96, 0x8a, 0x61, 0xb1, 0x5b, 0xde, 0x82, 0xb6, 0x5a, 0x2e, 0x36, 0x90, 0xd1, 0x72, 0xcc,
-	0xc5, 0xe5, 0xe1, 0x30, 0xc4, 0xcb, 0x51, 0xa4, 0x75, 0xa4, 0x23, 0xfa, 0xb3, 0x48, 0x7e, 0x31,
-	0xe9, 0x8a, 0x63, 0x5e, 0x77, 0x14, 0xec, 0x28, 0xa6, 0xd2, 0x11, 0xd2, 0x9b, 0xb0, 0x48, 0xbf,
-	0xb6, 0x3a, 0x9f, 0x16, 0xd5, 0x56, 0x2f, 0x70, 0x88, 0x89, 0x75, 0x72, 0x88, 0x89, 0x75, 0xa6,
-	0x58, 0x50, 0x4d, 0xa3, 0x85, 0x8a, 0xf4, 0x5f, 0x26, 0xca, 0x06, 0xc3, 0xd8, 0x86, 0x09, 0xb1,
-	0xad, 0x9a, 0x96, 0x63, 0x20, 0xfd, 0x38, 0x05, 0x16, 0x6a, 0x58, 0xbf, 0xd1, 0xd9, 0x32, 0x0d,
-	0xfb, 0x7a, 0x1b, 0x59, 0x08, 0xab, 0x4d, 0xbe, 0x04, 0x92, 0x26, 0xc4, 0x58, 0xd5, 0x21, 0x16,
-	0xb8, 0x5c, 0x2c, 0x3f, 0xbb, 0x9e, 0x96, 0x9d, 0x48, 0xb2, 0x1b, 0x49, 0xbe, 0xd2, 0xea, 0x29,
-	0x9e, 0x15, 0x7f, 0x8f, 0x03, 0xf3, 0x46, 0xcb, 0xb0, 0x0d, 0xb5, 0x59, 0xd7, 0xa0, 0x85, 0xb0,
-	0x61, 0x0b, 0x93, 0xd4, 0x73, 0x45, 0x66, 0x85, 0x11, 0xd0, 0x64, 0x06, 0x9a, 0xbc, 0x89, 0x8c,
-	0x56, 0xf5, 0x9d, 0x07, 0x8f, 0xb3, 0x13, 0x3f, 0x3c, 0xc9, 0xe6, 0x75, 0xc3, 0xde, 0xee, 0x6c,
-	0xc9, 0x0d, 0x64, 0x32, 0x14, 0xd8, 0x7f, 0x05, 0xac, 0xdd, 0x2d, 0xda, 0x3d, 0x0b, 0x62, 0xea,
-	0x80, 0xbf, 0x39, 0xd8, 0x5d, 0x4b, 0x35, 0xa1, 0xae, 0x36, 0x7a, 0x75, 0x02, 0x3b, 0xfe, 0xee,
-	0x60, 0x77, 0x8d, 0x53, 0xe6, 0xd8, 0xc9, 0x6f, 0x3b, 0x07, 0xf3, 0x17, 0x40, 0xd2, 0xa2, 0xa5,
-	0xc0, 0xb6, 0x10, 0xcb, 0x71, 0xf9, 0x99, 0xaa, 0xb0, 0xdf, 0x2f, 0xa4, 0x59, 0x1e, 0x57, 0x34,
-	0xad, 0x0d, 0x31, 0xbe, 0x61, 0xb7, 0x8d, 0x96, 0xae, 0x78, 0x96, 0xbc, 0x48, 0x8a, 0xb6, 0x55,
-	0x4d, 0xb5, 0x55, 0x61, 0x8a, 0x78, 0x29, 0xde, 0x37, 0xff, 0x2a, 0x98, 0xb6, 0x0d, 0xbb, 0x09,
-	0x85, 0x69, 0x1a, 0x6e, 0xf1, 0x51, 0xbf, 0x30, 0xef, 0xa7, 0x98, 0x2b, 0xc9, 0x17, 0x5e, 0x57,
-	0x1c, 0x0b, 0xbe, 0x00, 0x12, 0xb8, 0x63, 0x9a, 0x6a, 0xbb, 0x27, 0xc4, 0x47, 0x1b, 0xbb, 0x36,
-	0xfc, 0x79, 0x30, 0x03, 0x77, 0x2c, 0xa8, 0x19, 0x36, 0xd4, 0x84, 0x44, 0x8e, 0xcb, 0x27, 0xab,
-	0x27, 0x42, 0x0e, 0x1b, 0x25, 0x81, 0x53, 0x7c, 0x3b, 0x5e, 0x01, 0xc7, 0x2c, 0x76, 0x57, 0x75,
-	0x02, 0x8f, 0x90, 0xcc, 0x71, 0xf9, 0xb9, 0xf5, 0x93, 0xf2, 0x50, 0xbb, 0xca, 0xee, 0x7d, 0xde,
-	0xec, 0x59, 0xb0, 0x7a, 0xfc, 0x51, 0xbf, 0x90, 0xda, 0x21, 0x3d, 0x99, 0xeb, 0x96, 0xe4, 0x75,
-	0xb9, 0xa4, 0xa4, 0xac, 0x01, 0x7d, 0xa5, 0xfc, 0xe5, 0xc1, 0xee, 0x9a, 0x87, 0xc6, 0xbd, 0x83,
-	0xdd, 0xb5, 0xec, 0xc0, 0x25, 0x74, 0xcb, 0xc5, 0x50, 0x9b, 0x48, 0x97, 0xc0, 0x4a, 0x48, 0xa8,
-	0x40, 0x6c, 0xa1, 0x16, 0x86, 0x7c, 0x16, 0xcc, 0x7a, 0x39, 0x1a, 0x9a, 0xc0, 0xe5, 0xb8, 0xfc,
-	0x94, 0x02, 0x5c, 0xd1, 0x35, 0x4d, 0xfa, 0x89, 0x03, 0xe9, 0x1a, 0xd6, 0xaf, 0xee, 0xc0, 0xc6,
-	0xfb, 0xf4, 0x4a, 0x37, 0x51, 0xcb, 0x86, 0x2d, 0x9b, 0xff, 0x00, 0x24, 0x1a, 0xce, 0x4f, 0xea,
-	0x35, 0xa2, 0xf9, 0xaa, 0x99, 0xbd, 0x7e, 0x41, 0x1c, 0x2a, 0xd8, 0x6d, 0x2d, 0xea, 0xab, 0xb8,
-	0x41, 0xf8, 0x55, 0x30, 0xa3, 0x76, 0xec, 0x6d, 0xd4, 0x36, 0xec, 0x9e, 0x30, 0x49, 0x6f, 0xd6,
-	0x17, 0x54, 0x36, 0x48, 0xdd, 0xfe, 0x37, 0x29, 0x5c, 0x0a, 0x15, 0x1e, 0x4a, 0x52, 0xca, 0x80,
-	0xd5, 0x28, 0xb9, 0x5b, 0xbe, 0xf4, 0x07, 0x07, 0x12, 0x35, 0xac, 0xdf, 0x46, 0x36, 0xe4, 0x37,
-	0x22, 0xa0, 0xa8, 0xa6, 0xff, 0x7a, 0x9c, 0x1d, 0x14, 0x3b, 0xad, 0x3c, 0x00, 0x10, 0x2f, 0x83,
-	0xe9, 0x2e, 0xb2, 0x61, 0xdb, 0xc9, 0x79, 0x4c, 0x0f, 0x3b, 0x66, 0x7c, 0x19, 0xc4, 0x91, 0x65,
-	0x1b, 0xa8, 0x45, 0x9b, 0x7e, 0xce, 0x7f, 0x79, 0xac, 0x1d, 0x48, 0x2e, 0x1f, 0x52, 0x03, 0x85,
-	0x19, 0x8e, 0xeb, 0xf9, 0xca, 0x4b, 0x04, 0x18, 0x27, 0x34, 0x01, 0xe5, 0x44, 0x08, 0x14, 0x12,
-	0x4f, 0x5a, 0x00, 0xf3, 0xec, 0xa7, 0x57, 0xfa, 0xdf, 0x9c, 0x27, 0xfb, 0x08, 0x1a, 0xfa, 0x36,
-	0xe9, 0xd8, 0xff, 0x08, 0x82, 0xb7, 0x40, 0xc2, 0xa9, 0x0c, 0x0b, 0x31, 0xca, 0x3e, 0xa7, 0x03,
-	0x18, 0xb8, 0x09, 0x0d, 0x60, 0xe1, 0x7a, 0x8c, 0x05, 0xe3, 0xb5, 0x61, 0x30, 0x4e, 0x45, 0x82,
-	0xe1, 0x06, 0x97, 0x56, 0xc0, 0x72, 0x40, 0xe4, 0x81, 0xf3, 0x27, 0x07, 0x40, 0x0d, 0xeb, 0x2e,
-	0x55, 0xbd, 0x20, 0x2e, 0x17, 0xc1, 0x0c, 0x63, 0x59, 0x74, 0x38, 0x36, 0xbe, 0x29, 0x7f, 0x09,
-	0xc4, 0x55, 0x13, 0x75, 0x5a, 0x36, 0x83, 0x67, 0x0c, 0x39, 0xcf, 0x10, 0x72, 0x76, 0x4e, 0x66,
-	0x3e, 0x95, 0x73, 0xf4, 0xa9, 0x78, 0xd1, 0x08, 0x10, 0x42, 0x08, 0x08, 0x56, 0x99, 0x94, 0x06,
-	0xbc, 0xff, 0xe5, 0x95, 0xff, 0x8b, 0xd3, 0x1b, 0xb7, 0x2c, 0x4d, 0xb5, 0xe1, 0x75, 0xb5, 0xad,
-	0x9a, 0x98, 0x14, 0xe3, 0xbf, 0x4f, 0xee, 0xb0, 0x62, 0x3c, 0x53, 0xfe, 0x0d, 0x10, 0xb7, 0x68,
-	0x04, 0x8a, 0xc0, 0xec, 0xfa, 0x89, 0x20, 0xfd, 0x51, 0xe5, 0x50, 0x21, 0x8e, 0x7d, 0xe5, 0xda,
-	0x7e, 0x98, 0x92, 0xc3, 0x34, 0x70, 0x66, 0xa0, 0xb6, 0x1d, 0x77, 0xa6, 0x07, 0x92, 0x97, 0x64,
-	0x7a, 0xd5, 0x83, 0x22, 0xb7, 0xd6, 0xca, 0x62, 0xc4, 0x29, 0xd2, 0xb7, 0x1c, 0x1d, 0xb8, 0x9b,
-	0x6a, 0xab, 0x01, 0x9b, 0x03, 0x03, 0x37, 0xa2, 0x0d, 0xe6, 0x03, 0x6d, 0x30, 0xd4, 0x01, 0x83,
-	0x33, 0x6e, 0xf2, 0xa8, 0x33, 0xae, 0x92, 0xdb, 0x0f, 0x8f, 0x96, 0x21, 0xde, 0x97, 0x7e, 0xe5,
-	0x28, 0xa9, 0x0f, 0xe7, 0xe7, 0x91, 0xfa, 0xf3, 0xe7, 0x79, 0x0d, 0x1c, 0x6b, 0xd0, 0x58, 0x50,
-	0xab, 0x93, 0xe5, 0x83, 0xdd, 0x95, 0x18, 0xa2, 0xf4, 0x9b, 0xee, 0x66, 0x52, 0x4d, 0x92, 0x0b,
-	0xbb, 0xff, 0x24, 0xcb, 0x29, 0x29, 0xd7, 0x95, 0x28, 0xf9, 0xb3, 0x60, 0xde, 0x0b, 0xb5, 0x4d,
-	0xdf, 0x15, 0x25, 0xba, 0x29, 0x65, 0xce, 0x15, 0xbf, 0x47, 0xa5, 0x11, 0xc0, 0x6f, 0x94, 0xa4,
-	0xaf, 0x62, 0x20, 0xeb, 0x4d, 0xab, 0x5a, 0xa7, 0x69, 0x1b, 0x56, 0x13, 0x6e, 0x6e, 0x23, 0xa3,
-	0x01, 0xbd, 0x6b, 0x88, 0xda, 0x62, 0xb8, 0xff, 0xc3, 0x16, 0x33, 0xf9, 0x42, 0x5b, 0x4c, 0x2c,
-	0xb0, 0xc5, 0xa4, 0xdd, 0x2d, 0xc6, 0x61, 0x37, 0xb6, 0xb0, 0x08, 0xfe, 0xc2, 0x42, 0xb7, 0x1b,
-	0x7f, 0x37, 0xb9, 0x0a, 0x52, 0x84, 0xf1, 0xea, 0x2e, 0xa5, 0xc6, 0xe9, 0xd5, 0x49, 0x23, 0xb6,
-	0x0c, 0x9f, 0x52, 0xb1, 0x32, 0xdb, 0xf5, 0x3f, 0x2a, 0xab, 0xfb, 0xfd, 0xc2, 0xac, 0xb3, 0x79,
-	0x94, 0xe5, 0x92, 0x1c, 0x68, 0xb8, 0x4f, 0xc0, 0xd9, 0x43, 0xae, 0xe5, 0xc8, 0x2b, 0x45, 0x65,
-	0x3e, 0x70, 0x92, 0xf4, 0x33, 0x07, 0x96, 0xbc, 0xe7, 0x59, 0x73, 0x96, 0xd5, 0x7f, 0xc9, 0x3a,
-	0xcb, 0x20, 0x61, 0x62, 0xbd, 0xde, 0x69, 0x37, 0xd9, 0x2e, 0x11, 0x37, 0xb1, 0x7e, 0xab, 0xdd,
-	0xe4, 0xdf, 0xf4, 0xe8, 0x28, 0x46, 0x71, 0x0a, 0x8e, 0x1e, 0x76, 0x7c, 0x55, 0xc5, 0x50, 0x63,
-	0x4c, 0xe1, 0xf2, 0xd1, 0xa9, 0x08, 0x84, 0xfc, 0x23, 0xa5, 0x32, 0xc8, 0x44, 0x17, 0xe1, 0x51,
-	0x4d, 0xa8, 0xf0, 0xef, 0x39, 0x30, 0x4b, 0x61, 0xd5, 0x10, 0xd9, 0x51, 0x5e, 0xb8, 0xda, 0x4d,
-	0x10, 0x33, 0xb1, 0xce, 0x1e, 0x6d, 0xf4, 0x1e, 0x76, 0x72, 0xaf, 0x5f, 0x58, 0x8e, 0x7a, 0x1d,
-	0x35, 0xac, 0x2b, 0xc4, 0xfb, 0xb0, 0xf2, 0x2e, 0x83, 0xc5, 0x81, 0x54, 0xbd, 0xdb, 0x5e, 0x02,
-	0xf1, 0x36, 0xc4, 0x9d, 0xa6, 0xb3, 0x05, 0xa6, 0x14, 0xf6, 0x15, 0xaa, 0x75, 0xfd, 0x5e, 0x12,
-	0xc4, 0x6a, 0x58, 0xe7, 0xef, 0x80, 0xb9, 0xc0, 0xdf, 0x31, 0xb9, 0xe0, 0x15, 0x04, 0xb7, 0x55,
-	0x31, 0x7f, 0x98, 0x85, 0x97, 0x0e, 0x04, 0x0b, 0xe1, 0x55, 0xf5, 0x4c, 0xd8, 0x3d, 0x64, 0x24,
-	0x9e, 0x3b, 0x82, 0x91, 0x77, 0xcc, 0x65, 0x30, 0x45, 0x77, 0xc6, 0xa5, 0xb0, 0x13, 0x91, 0x8b,
-	0x99, 0x68, 0xb9, 0xe7, 0x7f, 0x1b, 0xa4, 0x86, 0x16, 0xaf, 0x11, 0xf6, 0xae, 0x5e, 0x7c, 0x65,
-	0xbc, 0xde, 0x8b, 0xfb, 0x2e, 0x48, 0xb8, 0xc4, 0xb4, 0x12, 0x76, 0x61, 0x2a, 0xf1, 0xf4, 0x48,
-	0x95, 0x17, 0xe8, 0x2e, 0x48, 0x0d, 0x4d, 0xff, 0x88, 0x04, 0x07, 0xf5, 0x51, 0x09, 0x46, 0x4d,
-	0x5b, 0x69, 0x71, 0x2f, 0x3c, 0x6d, 0xf9, 0xcf, 0xc0, 0x5c, 0x60, 0xd2, 0x46, 0xb4, 0xc4, 0xb0,
-	0x45, 0x54, 0x4b, 0x44, 0x4f, 0xc3, 0x88, 0x23, 0x37, 0x4a, 0xfc, 0xd7, 0x1c, 0x58, 0x1d, 0x3b,
-	0x64, 0xe4, 0x51, 0x2d, 0x17, 0x6d, 0x2f, 0x5e, 0x7c, 0x3e, 0x7b, 0x2f, 0xbb, 0xe3, 0x7b, 0xfd,
-	0x42, 0x2a, 0x37, 0xf0, 0x50, 0xf8, 0xcf, 0xc1, 0x62, 0x14, 0x13, 0xbe, 0x3c, 0x0a, 0xe1, 0x21,
-	0x33, 0xb1, 0x70, 0x24, 0xb3, 0x31, 0xc7, 0xdf, 0x01, 0x49, 0x8f, 0x8f, 0xc4, 0xa8, 0xa2, 0x1c,
-	0x9d, 0x28, 0x8d, 0xd6, 0x8d, 0x8e, 0x2e, 0x4e, 0x7f, 0x41, 0xa6, 0x68, 0x75, 0xe3, 0xc1, 0xd3,
-	0x0c, 0xf7, 0xf0, 0x69, 0x86, 0xfb, 0xfd, 0x69, 0x86, 0xbb, 0xff, 0x2c, 0x33, 0xf1, 0xf0, 0x59,
-	0x66, 0xe2, 0xb7, 0x67, 0x99, 0x89, 0x8f, 0x4f, 0x3a, 0x51, 0xb1, 0x76, 0x57, 0x36, 0x10, 0x5b,
-	0xe8, 0xe8, 0x54, 0x2e, 0x76, 0xcb, 0x5b, 0x71, 0x4a, 0x69, 0xe7, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0xac, 0x54, 0xba, 0x09, 0x12, 0x00, 0x00,
+	// 1600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x2d, 0x5b, 0xb6, 0xc7, 0x8a, 0x1d, 0xd3, 0x8a, 0x4d, 0x33, 0x8e, 0xa4, 0x30, 0xbb,
+	0x89, 0xe2, 0xac, 0x28, 0xc9, 0x89, 0xb3, 0xbb, 0xda, 0x20, 0x40, 0xe4, 0x24, 0xbb, 0x01, 0x56,
+	0xbb, 0x01, 0xf3, 0xb1, 0x8b, 0x36, 0x80, 0x40, 0x8b, 0x53, 0x9a, 0x88, 0xa8, 0x61, 0x35, 0x94,
+	0x60, 0x1d, 0x0a, 0x14, 0xbd, 0x35, 0xa7, 0x1c, 0xda, 0x22, 0x28, 0xd0, 0x7b, 0xdb, 0x93, 0x0f,
+	0xbe, 0x15, 0x3d, 0xf5, 0x12, 0xf8, 0x50, 0x04, 0x3e, 0x14, 0x45, 0x0e, 0x49, 0x91, 0xa0, 0x35,
+	0xd0, 0x7f, 0xa2, 0xc5, 0x0c, 0x87, 0x23, 0x89, 0xa4, 0x64, 0xc7, 0x05, 0x8a, 0x5e, 0x12, 0xf1,
+	0xbd, 0xdf, 0x7b, 0x6f, 0xde, 0xc7, 0xbc, 0xf9, 0x25, 0x60, 0xa1, 0x86, 0xb0, 0x8d, 0x70, 0xde,
+	0x44, 0xed, 0x7c, 0xbb, 0x98, 0x77, 0xb7, 0x54, 0xa7, 0x89, 0x5c, 0x24, 0x1e, 0xf3, 0xe4, 0xaa,
+	0x89, 0xda, 0x6a, 0xbb, 0x28, 0xa7, 0x18, 0x6c, 0x43, 0xc7, 0x30, 0xdf, 0x2e, 0x6e, 0x40, 0x57,
+	0x2f, 0xe6, 0x6b, 0xc8, 0x6a, 0x78, 0x70, 0x79, 0xb1, 0xdf, 0x0d, 0xb1, 0xf2, 0x14, 0x49, 0x13,
+	0x99, 0x88, 0xfe, 0xcc, 0x93, 0x5f, 0x4c, 0xba, 0xe4, 0xc1, 0xab, 0x9e, 0x82, 0x85, 0x62, 0x2a,
+	0x13, 0x21, 0xb3, 0x0e, 0xf3, 0xf4, 0x6b, 0xa3, 0xf5, 0x4e, 0x5e, 0x6f, 0x74, 0x02, 0x41, 0x6c,
+	0x6c, 0x92, 0x20, 0x36, 0x36, 0x99, 0x62, 0x4e, 0xb7, 0xad, 0x06, 0xca, 0xd3, 0x3f, 0x99, 0x28,
+	0x1d, 0x74, 0xe3, 0x5a, 0x36, 0xc4, 0xae, 0x6e, 0x3b, 0x0c, 0x90, 0x0a, 0x02, 0x8c, 0x56, 0x53,
+	0x77, 0x2d, 0xc4, 0x32, 0x52, 0x3e, 0x1a, 0x07, 0x73, 0x15, 0x6c, 0xde, 0x69, 0x6d, 0xd8, 0x96,
+	0x7b, 0xbb, 0x89, 0x1c, 0x84, 0xf5, 0xba, 0x58, 0x00, 0x93, 0x36, 0xc4, 0x58, 0x37, 0x21, 0x96,
+	0x84, 0x4c, 0x2c, 0x3b, 0xbd, 0x9a, 0x54, 0x3d, 0x47, 0xaa, 0xef, 0x48, 0xbd, 0xd6, 0xe8, 0x68,
+	0x1c, 0x25, 0x3e, 0x12, 0xc0, 0xac, 0xd5, 0xb0, 0x5c, 0x4b, 0xaf, 0x57, 0x0d, 0xe8, 0x20, 0x6c,
+	0xb9, 0xd2, 0x28, 0xb5, 0x5c, 0x52, 0x59, 0xe2, 0xa4, 0xa8, 0x2a, 0x2b, 0xaa, 0xba, 0x8e, 0xac,
+	0x46, 0xf9, 0xe6, 0xd3, 0x17, 0xe9, 0x91, 0x2f, 0x5f, 0xa6, 0xb3, 0xa6, 0xe5, 0x6e, 0xb6, 0x36,
+	0xd4, 0x1a, 0xb2, 0x59, 0x95, 0xd8, 0x5f, 0x39, 0x6c, 0x3c, 0xcc, 0xbb, 0x1d, 0x07, 0x62, 0x6a,
+	0x80, 0x3f, 0xdd, 0xdf, 0x5e, 0x49, 0xd4, 0xa1, 0xa9, 0xd7, 0x3a, 0x55, 0xd2, 0x16, 0xfc, 0xf9,
+	0xfe, 0xf6, 0x8a, 0xa0, 0xcd, 0xb0, 0xc8, 0xd7, 0xbd, 0xc0, 0xe2, 0x25, 0x30, 0xe9, 0xd0, 0x54,
+	0x60, 0x53, 0x8a, 0x65, 0x84, 0xec, 0x54, 0x59, 0xda, 0xdb, 0xc9, 0x25, 0xd9, 0x39, 0xae, 0x19,
+	0x46, 0x13, 0x62, 0x7c, 0xc7, 0x6d, 0x5a, 0x0d, 0x53, 0xe3, 0x48, 0x51, 0x26, 0x49, 0xbb, 0xba,
+	0xa1, 0xbb, 0xba, 0x34, 0x46, 0xac, 0x34, 0xfe, 0x2d, 0x9e, 0x07, 0xe3, 0xae, 0xe5, 0xd6, 0xa1,
+	0x34, 0x4e, 0xdd, 0xcd, 0x3f, 0xdf, 0xc9, 0xcd, 0x76, 0x8f, 0x98, 0x29, 0xa8, 0x97, 0xfe, 0xaa,
+	0x79, 0x08, 0x31, 0x07, 0x26, 0x70, 0xcb, 0xb6, 0xf5, 0x66, 0x47, 0x8a, 0x0f, 0x06, 0xfb, 0x18,
+	0xf1, 0x22, 0x98, 0x82, 0x5b, 0x0e, 0x34, 0x2c, 0x17, 0x1a, 0xd2, 0x44, 0x46, 0xc8, 0x4e, 0x96,
+	0x4f, 0x84, 0x0c, 0xd6, 0x0a, 0x92, 0xa0, 0x75, 0x71, 0xa2, 0x06, 0x8e, 0x39, 0xac, 0x57, 0x55,
+	0x52, 0x1e, 0x69, 0x32, 0x23, 0x64, 0x67, 0x56, 0x4f, 0xaa, 0x7d, 0xe3, 0xac, 0xfa, 0xfd, 0xbc,
+	0xdb, 0x71, 0x60, 0xf9, 0xf8, 0xf3, 0x9d, 0x5c, 0x62, 0x8b, 0xcc, 0x6c, 0xa6, 0x5d, 0x50, 0x57,
+	0xd5, 0x82, 0x96, 0x70, 0x7a, 0xf4, 0xe2, 0xff, 0xc1, 0x2c, 0xdc, 0x82, 0xb5, 0x16, 0x19, 0x8e,
+	0xaa, 0x01, 0xeb, 0x7a, 0x47, 0x9a, 0xca, 0x08, 0xb4, 0x81, 0xc1, 0xd6, 0x5f, 0x67, 0x33, 0x54,
+	0x4e, 0x3e, 0x79, 0x99, 0x16, 0x42, 0x7e, 0x67, 0xb8, 0x9f, 0xeb, 0xc4, 0x4d, 0xa9, 0xf8, 0xc1,
+	0xfe, 0xf6, 0x0a, 0xaf, 0xf3, 0xa3, 0xfd, 0xed, 0x95, 0x74, 0x4f, 0x7b, 0xdb, 0xc5, 0x7c, 0x68,
+	0x00, 0x95, 0x2b, 0x60, 0x29, 0x24, 0xd4, 0x20, 0x76, 0x50, 0x03, 0x43, 0x31, 0x0d, 0xa6, 0x79,
+	0xf6, 0x96, 0x21, 0x09, 0x19, 0x21, 0x3b, 0xa6, 0x01, 0x5f, 0x74, 0xcb, 0x50, 0xbe, 0x12, 0x40,
+	0xb2, 0x82, 0xcd, 0x1b, 0x5b, 0xb0, 0xf6, 0x6f, 0x3a, 0x2c, 0xeb, 0xa8, 0xe1, 0xc2, 0x86, 0x2b,
+	0xfe, 0x07, 0x4c, 0xd4, 0xbc, 0x9f, 0xd4, 0x6a, 0xc0, 0x58, 0x97, 0x53, 0xbb, 0x3b, 0x39, 0xb9,
+	0xaf, 0x94, 0xfe, 0xd0, 0x52, 0x5b, 0xcd, 0x77, 0x22, 0x2e, 0x83, 0x29, 0xbd, 0xe5, 0x6e, 0xa2,
+	0xa6, 0xe5, 0x76, 0xa4, 0x51, 0x3a, 0x33, 0x5d, 0x41, 0x69, 0x8d, 0xe4, 0xdd, 0xfd, 0x26, 0x89,
+	0x2b, 0xa1, 0xc4, 0x43, 0x87, 0x54, 0x52, 0x60, 0x39, 0x4a, 0xee, 0xa7, 0xaf, 0xfc, 0x28, 0x80,
+	0x89, 0x0a, 0x36, 0xef, 0x23, 0x17, 0x8a, 0x6b, 0x11, 0xa5, 0x28, 0x27, 0x7f, 0x7e, 0x91, 0xee,
+	0x15, 0x7b, 0x97, 0xa4, 0xa7, 0x40, 0xa2, 0x0a, 0xc6, 0xdb, 0xc8, 0x85, 0x4d, 0x69, 0xf4, 0x80,
+	0xdb, 0xe1, 0xc1, 0xc4, 0x22, 0x88, 0x23, 0x87, 0x34, 0x94, 0x5e, 0xa7, 0x99, 0xee, 0x9d, 0x66,
+	0x83, 0x46, 0xce, 0xf2, 0x5f, 0x0a, 0xd0, 0x18, 0x70, 0xd8, 0x6d, 0x2a, 0xfd, 0x89, 0x14, 0xc6,
+	0x73, 0x4d, 0x8a, 0x72, 0x22, 0x54, 0x14, 0xe2, 0x4f, 0x99, 0x03, 0xb3, 0xec, 0x27, 0x4f, 0xfd,
+	0x17, 0x81, 0xcb, 0xfe, 0x07, 0x2d, 0x73, 0x93, 0xdc, 0x85, 0xdf, 0xa9, 0x04, 0xff, 0x00, 0x13,
+	0x5e, 0x66, 0x58, 0x8a, 0xd1, 0xbd, 0x76, 0x3a, 0x50, 0x03, 0xff, 0x40, 0x3d, 0xb5, 0xf0, 0x2d,
+	0x86, 0x16, 0xe3, 0x2f, 0xfd, 0xc5, 0x38, 0x15, 0x59, 0x0c, 0xdf, 0xb9, 0xb2, 0x04, 0x16, 0x03,
+	0x22, 0x5e, 0x9c, 0x9f, 0x04, 0x00, 0x2a, 0xd8, 0xf4, 0x97, 0xe0, 0x11, 0xeb, 0x72, 0x19, 0x4c,
+	0xb1, 0xfd, 0x8d, 0x0e, 0xae, 0x4d, 0x17, 0x2a, 0x5e, 0x01, 0x71, 0xdd, 0x46, 0xad, 0x86, 0xcb,
+	0xca, 0x33, 0x64, 0xed, 0x4f, 0x91, 0xb5, 0xef, 0x45, 0x66, 0x36, 0xa5, 0x0b, 0xf4, 0xaa, 0x70,
+	0x6f, 0xa4, 0x10, 0x52, 0xa8, 0x10, 0x2c, 0x33, 0x25, 0x09, 0xc4, 0xee, 0x17, 0x4f, 0xff, 0x5b,
+	0x6f, 0x36, 0xee, 0x39, 0x86, 0xee, 0xc2, 0xdb, 0x7a, 0x53, 0xb7, 0x31, 0x49, 0xa6, 0x7b, 0x3f,
+	0x85, 0x83, 0x92, 0xe1, 0x50, 0xf1, 0x6f, 0x20, 0xee, 0x50, 0x0f, 0xb4, 0x02, 0xd3, 0xab, 0x27,
+	0x82, 0x8b, 0x95, 0x2a, 0xfb, 0x12, 0xf1, 0xf0, 0xa5, 0x5b, 0x7b, 0xe1, 0x65, 0x1f, 0x5e, 0x03,
+	0x67, 0x7a, 0x72, 0xdb, 0xf2, 0xd9, 0x44, 0xe0, 0xf0, 0x8a, 0x0a, 0x16, 0x03, 0x22, 0x3f, 0xd7,
+	0xd2, 0x7c, 0x44, 0x14, 0xe5, 0x33, 0x81, 0x3e, 0xe5, 0xeb, 0x7a, 0xa3, 0x06, 0xeb, 0x3d, 0x4f,
+	0x79, 0xc4, 0x18, 0xcc, 0x06, 0xc6, 0xa0, 0x6f, 0x02, 0x7a, 0x5f, 0xcf, 0xd1, 0xc3, 0xbe, 0x9e,
+	0xa5, 0xcc, 0x5e, 0xf8, 0xd1, 0xea, 0xdb, 0xfb, 0xca, 0x77, 0x02, 0x58, 0x0a, 0x9d, 0x8f, 0x2f,
+	0xf5, 0x37, 0x3f, 0xe7, 0x2d, 0x70, 0xac, 0x46, 0x7d, 0x41, 0xa3, 0x4a, 0x68, 0x0f, 0xeb, 0x95,
+	0x1c, 0x5a, 0xe9, 0x77, 0x7d, 0x4e, 0x54, 0x9e, 0x24, 0x0d, 0x7b, 0xfc, 0x32, 0x2d, 0x68, 0x09,
+	0xdf, 0x94, 0x28, 0xc5, 0x73, 0x60, 0x96, 0xbb, 0xda, 0xa4, 0xf7, 0x8a, 0x2e, 0xba, 0x31, 0x6d,
+	0xc6, 0x17, 0xff, 0x8b, 0x4a, 0x23, 0x0a, 0xbf, 0x56, 0x50, 0x3e, 0x8e, 0x81, 0x34, 0x7f, 0xad,
+	0x2a, 0xad, 0xba, 0x6b, 0x39, 0x75, 0xb8, 0xbe, 0x89, 0xac, 0x1a, 0xe4, 0x6d, 0x88, 0xe2, 0x47,
+	0xc2, 0x1f, 0x81, 0x1f, 0x8d, 0x1e, 0x89, 0x1f, 0xc5, 0x02, 0xfc, 0x28, 0xe9, 0xf3, 0x23, 0x6f,
+	0xbb, 0x79, 0x1f, 0xa2, 0xd4, 0xa5, 0x42, 0x94, 0x37, 0x75, 0x59, 0xcf, 0x0d, 0x90, 0x20, 0x1b,
+	0xaf, 0xea, 0xaf, 0xd4, 0x38, 0x6d, 0x9d, 0x32, 0x80, 0xbf, 0x74, 0x57, 0x2a, 0xd6, 0xa6, 0xdb,
+	0xdd, 0x8f, 0xd2, 0xf2, 0xde, 0x4e, 0x6e, 0xda, 0xe3, 0x1e, 0x45, 0xb5, 0xa0, 0x06, 0x06, 0xee,
+	0x6d, 0x70, 0xee, 0x80, 0xb6, 0x1c, 0x9a, 0x52, 0x94, 0x66, 0x03, 0x91, 0x94, 0x6f, 0x04, 0xb0,
+	0xc0, 0xaf, 0x67, 0xc5, 0xa3, 0xc1, 0xbf, 0x71, 0xeb, 0x2c, 0x82, 0x09, 0x1b, 0x9b, 0xd5, 0x56,
+	0xb3, 0xce, 0xb8, 0x44, 0xdc, 0xc6, 0xe6, 0xbd, 0x66, 0x5d, 0xfc, 0x3b, 0x5f, 0x47, 0xb1, 0x8c,
+	0x10, 0xf1, 0xf4, 0xb0, 0xf0, 0x65, 0x1d, 0x43, 0x83, 0x6d, 0x0a, 0x7f, 0x1f, 0x9d, 0x8a, 0xa8,
+	0x50, 0x37, 0xa4, 0x52, 0x04, 0xa9, 0xe8, 0x24, 0xf8, 0xaa, 0x09, 0x25, 0xfe, 0x85, 0x00, 0xa6,
+	0x69, 0x59, 0x0d, 0x44, 0x38, 0xca, 0x91, 0xb3, 0x5d, 0x07, 0x31, 0x1b, 0x9b, 0xd2, 0xe8, 0x10,
+	0x1e, 0x76, 0x72, 0x77, 0x27, 0xb7, 0x18, 0x75, 0x3b, 0x2a, 0xd8, 0xd4, 0x88, 0xf5, 0x41, 0xe9,
+	0x5d, 0x05, 0xf3, 0x3d, 0x47, 0xe5, 0xdd, 0x5e, 0x00, 0xf1, 0x26, 0xc4, 0xad, 0xba, 0xc7, 0x02,
+	0x13, 0x1a, 0xfb, 0x0a, 0xe7, 0xfa, 0xb5, 0x00, 0x64, 0xbe, 0xb2, 0xee, 0xd4, 0x36, 0xa1, 0xd1,
+	0xaa, 0x43, 0x83, 0x5f, 0xea, 0xa3, 0xa6, 0x1e, 0x98, 0xb6, 0xd1, 0xd0, 0xb4, 0xdd, 0xdc, 0x0b,
+	0x70, 0xea, 0x88, 0x27, 0xa4, 0xf7, 0xdd, 0x18, 0x70, 0x40, 0xe5, 0x32, 0x50, 0x06, 0x6b, 0x79,
+	0x8b, 0x8f, 0x07, 0xa3, 0xad, 0x7e, 0x32, 0x05, 0x62, 0x15, 0x6c, 0x8a, 0x0f, 0xc0, 0x4c, 0xe0,
+	0x5f, 0x86, 0x99, 0xe0, 0xe8, 0x05, 0x59, 0xba, 0x9c, 0x3d, 0x08, 0xc1, 0xdb, 0x00, 0xc1, 0x5c,
+	0x98, 0xa2, 0x9f, 0x09, 0x9b, 0x87, 0x40, 0xf2, 0x85, 0x43, 0x80, 0x78, 0x98, 0xab, 0x60, 0x8c,
+	0x72, 0xe5, 0x85, 0xb0, 0x11, 0x91, 0xcb, 0xa9, 0x68, 0x39, 0xb7, 0xbf, 0x0f, 0x12, 0x7d, 0x84,
+	0x73, 0x00, 0xde, 0xd7, 0xcb, 0x67, 0x87, 0xeb, 0xb9, 0xdf, 0x7f, 0x82, 0x09, 0x7f, 0x21, 0x2f,
+	0x85, 0x4d, 0x98, 0x4a, 0x3e, 0x3d, 0x50, 0xc5, 0x1d, 0x3d, 0x04, 0x89, 0x3e, 0xd6, 0x13, 0x71,
+	0xc0, 0x5e, 0xbd, 0x7c, 0x76, 0xb8, 0x9e, 0x33, 0xaa, 0xf9, 0xdd, 0x30, 0xcb, 0x10, 0xdf, 0x05,
+	0x33, 0x01, 0x86, 0x11, 0x31, 0x12, 0xfd, 0x08, 0x39, 0x7b, 0x10, 0x62, 0x48, 0xc8, 0xb5, 0x82,
+	0xf8, 0x44, 0x00, 0xcb, 0x43, 0x1f, 0x57, 0x75, 0xd0, 0xc8, 0x45, 0xe3, 0xe5, 0xcb, 0x6f, 0x86,
+	0xe7, 0xa7, 0x3b, 0xbe, 0xbb, 0x93, 0x4b, 0x64, 0x7a, 0x16, 0x84, 0xf8, 0x1e, 0x98, 0x8f, 0x7a,
+	0x01, 0xfe, 0x3c, 0xa8, 0xc2, 0x7d, 0x30, 0x39, 0x77, 0x28, 0xd8, 0x90, 0xf0, 0x0f, 0xc0, 0x24,
+	0xdf, 0xc3, 0x72, 0x54, 0x52, 0x9e, 0x4e, 0x56, 0x06, 0xeb, 0x86, 0x78, 0xff, 0x50, 0x00, 0x8b,
+	0x83, 0x56, 0xdf, 0xf9, 0x41, 0x2d, 0x0d, 0x41, 0xe5, 0xe2, 0xa1, 0xa1, 0xfd, 0x67, 0xe9, 0xdd,
+	0x48, 0xf2, 0xf8, 0xfb, 0x84, 0xc9, 0x94, 0xd7, 0x9e, 0xbe, 0x4a, 0x09, 0xcf, 0x5e, 0xa5, 0x84,
+	0x1f, 0x5e, 0xa5, 0x84, 0xc7, 0xaf, 0x53, 0x23, 0xcf, 0x5e, 0xa7, 0x46, 0xbe, 0x7f, 0x9d, 0x1a,
+	0x79, 0xeb, 0xa4, 0x17, 0x04, 0x1b, 0x0f, 0x55, 0x0b, 0x31, 0x52, 0x4d, 0x99, 0x11, 0xf9, 0x7f,
+	0xbc, 0x38, 0x7d, 0x56, 0x2e, 0xfe, 0x3a, 0x00, 0xbd, 0x48, 0x65, 0x05, 0x07, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1439,9 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// CancelScheduledProposal defines a governance operation to cancel the execution of a scheduled proposal.
+	// A proposal containing this message only passes with the scheduled_cancel_threshold supermajority.
+	CancelScheduledProposal(ctx context.Context, in *MsgCancelScheduledProposal, opts ...grpc.CallOption) (*MsgCancelScheduledProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1426,6 +1542,15 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CancelScheduledProposal(ctx context.Context, in *MsgCancelScheduledProposal, opts ...grpc.CallOption) (*MsgCancelScheduledProposalResponse, error) {
+	out := new(MsgCancelScheduledProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/CancelScheduledProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1451,6 +1576,9 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// CancelScheduledProposal defines a governance operation to cancel the execution of a scheduled proposal.
+	// A proposal containing this message only passes with the scheduled_cancel_threshold supermajority.
+	CancelScheduledProposal(context.Context, *MsgCancelScheduledProposal) (*MsgCancelScheduledProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1487,6 +1615,9 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) CancelScheduledProposal(ctx context.Context, req *MsgCancelScheduledProposal) (*MsgCancelScheduledProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1672,6 +1803,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelScheduledProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/CancelScheduledProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledProposal(ctx, req.(*MsgCancelScheduledProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
@@ -1717,6 +1866,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "CancelScheduledProposal",
+			Handler:    _Msg_CancelScheduledProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1742,6 +1895,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionDelay != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -2253,12 +2416,12 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x18
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if m.ProposalId != 0 {
@@ -2518,6 +2681,64 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelScheduledProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2569,6 +2790,10 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 1 + sovTx(uint64(m.ProposalType))
 	}
+	if m.ExecutionDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDelay)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2872,6 +3097,31 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelScheduledProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3142,6 +3392,42 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionDelay == nil {
+				m.ExecutionDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5110,6 +5396,157 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelScheduledProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,7 +28,10 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgSubmitProposal{}, "cosmos-sdk/group/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(registrar, &MsgWithdrawProposal{}, "cosmos-sdk/group/MsgWithdrawProposal")
 	legacy.RegisterAminoMsg(registrar, &MsgVote{}, "cosmos-sdk/group/MsgVote")
+	legacy.RegisterAminoMsg(registrar, &MsgCommitVote{}, "cosmos-sdk/group/MsgCommitVote")
+	legacy.RegisterAminoMsg(registrar, &MsgRevealVote{}, "cosmos-sdk/group/MsgRevealVote")
 	legacy.RegisterAminoMsg(registrar, &MsgExec{}, "cosmos-sdk/group/MsgExec")
+	legacy.RegisterAminoMsg(registrar, &MsgRetryExec{}, "cosmos-sdk/group/MsgRetryExec")
 	legacy.RegisterAminoMsg(registrar, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
 }
 
@@ -47,7 +50,10 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSubmitProposal{},
 		&MsgWithdrawProposal{},
 		&MsgVote{},
+		&MsgCommitVote{},
+		&MsgRevealVote{},
 		&MsgExec{},
+		&MsgRetryExec{},
 		&MsgLeaveGroup{},
 	)
 
@@ -60,3 +66,18 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&PercentageDecisionPolicy{},
 	)
 }
+
+// RegisterDecisionPolicies registers custom decision policies as implementations
+// of the DecisionPolicy interface, so that chains can set them on group policies
+// without modifying the group module. It is usually called by the RegisterInterfaces
+// method of the module defining the policies. The policies which count the votes
+// by a voting power other than the weight of the members implement
+// VotingPowerDecisionPolicy.
+func RegisterDecisionPolicies(registrar registry.InterfaceRegistrar, policies ...DecisionPolicy) {
+	impls := make([]coretransaction.Msg, len(policies))
+	for i, policy := range policies {
+		impls[i] = policy
+	}
+
+	registrar.RegisterImplementations((*DecisionPolicy)(nil), impls...)
+}
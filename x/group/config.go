@@ -20,6 +20,11 @@ type Config struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64
+
+	// MaxExecRetries defines the max number of times the execution of a
+	// proposal can be retried after a failure.
+	// Defaults to 3 if not explicitly set.
+	MaxExecRetries uint64
 }
 
 // DefaultConfig returns the default config for group.
@@ -29,5 +34,6 @@ func DefaultConfig() Config {
 		MaxMetadataLen:        255,
 		MaxProposalTitleLen:   255,
 		MaxProposalSummaryLen: 10200,
+		MaxExecRetries:        3,
 	}
 }
@@ -182,11 +182,13 @@ replace github.com/cosmos/cosmos-sdk => ../../
 replace (
 	cosmossdk.io/api => ../../api
 	cosmossdk.io/collections => ../../collections
+	cosmossdk.io/schema => ../../schema
 	cosmossdk.io/store => ../../store
 	cosmossdk.io/x/accounts => ../accounts
 	cosmossdk.io/x/accounts/defaults/base => ../accounts/defaults/base
 	cosmossdk.io/x/accounts/defaults/lockup => ../accounts/defaults/lockup
 	cosmossdk.io/x/accounts/defaults/multisig => ../accounts/defaults/multisig
+	cosmossdk.io/x/accounts/defaults/spendlimit => ../accounts/defaults/spendlimit
 	cosmossdk.io/x/authz => ../authz
 	cosmossdk.io/x/bank => ../bank
 	cosmossdk.io/x/consensus => ../consensus
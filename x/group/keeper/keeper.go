@@ -92,6 +92,7 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 		config.MaxMetadataLen = 1000 			// example metadata length in bytes
 		config.MaxProposalTitleLen = 255 		// example max title length in characters
 		config.MaxProposalSummaryLen = 10200 	// example max summary length in characters
+		config.MaxExecRetries = 3 				// example max number of execution retries
 	*/
 
 	defaultConfig := group.DefaultConfig()
@@ -111,6 +112,10 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if config.MaxProposalSummaryLen <= 0 {
 		config.MaxProposalSummaryLen = defaultConfig.MaxProposalSummaryLen
 	}
+	// If MaxExecRetries not set by app developer, set to default value.
+	if config.MaxExecRetries <= 0 {
+		config.MaxExecRetries = defaultConfig.MaxExecRetries
+	}
 	k.config = config
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc, k.accKeeper.AddressCodec())
@@ -379,6 +384,12 @@ func (k Keeper) PruneProposals(ctx context.Context) error {
 		return nil
 	}
 	for _, proposal := range proposals {
+		// The execution window of commit-reveal proposals starts at the end
+		// of their reveal period.
+		if proposal.FinalTallyTime().After(endTime) {
+			continue
+		}
+
 		err := k.pruneProposal(ctx, proposal.Id)
 		if err != nil {
 			return err
@@ -435,6 +446,11 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx context.Context) error {
 				return err
 			}
 		} else if proposal.Status == group.PROPOSAL_STATUS_SUBMITTED {
+			// Commit-reveal proposals are tallied at the end of their reveal period.
+			if !k.HeaderService.HeaderInfo(ctx).Time.After(proposal.FinalTallyTime()) {
+				continue
+			}
+
 			if err := k.doTallyAndUpdate(ctx, &proposal, electorate, policyInfo); err != nil {
 				return errorsmod.Wrap(err, "doTallyAndUpdate")
 			}
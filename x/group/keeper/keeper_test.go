@@ -56,6 +56,7 @@ func (s *TestSuite) SetupTest() {
 
 	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{}, bank.AppModule{})
+	group.RegisterDecisionPolicies(encCfg.InterfaceRegistry, &quadraticDecisionPolicy{})
 	addressCodec := address.NewBech32Codec("cosmos")
 	s.addrs = simtestutil.CreateIncrementalAccounts(6)
 	s.addrsStr = make([]string, len(s.addrs))
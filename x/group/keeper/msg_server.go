@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -598,6 +599,12 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		return nil, err
 	}
 
+	// The votes of a commit-reveal proposal are only known after its reveal
+	// period, so it cannot be executed on submission.
+	if policy.GetRevealPeriod() > 0 && msg.Exec == group.Exec_EXEC_TRY {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "cannot try to execute a commit-reveal proposal on submission")
+	}
+
 	m := &group.Proposal{
 		Id:                 k.proposalTable.Sequence().PeekNextVal(kvStore),
 		GroupPolicyAddress: msg.GroupPolicyAddress,
@@ -614,6 +621,11 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		Summary:            msg.Summary,
 	}
 
+	if revealPeriod := policy.GetRevealPeriod(); revealPeriod > 0 {
+		revealPeriodEnd := m.VotingPeriodEnd.Add(revealPeriod)
+		m.RevealPeriodEnd = &revealPeriodEnd
+	}
+
 	if err := m.SetMsgs(msgs); err != nil {
 		return nil, errorsmod.Wrap(err, "create proposal")
 	}
@@ -738,6 +750,10 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 		return nil, errorsmod.Wrap(errors.ErrExpired, "voting period has ended already")
 	}
 
+	if proposal.RevealPeriodEnd != nil {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "proposal uses commit-reveal voting, use MsgCommitVote instead")
+	}
+
 	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "load group policy")
@@ -782,6 +798,148 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 	return &group.MsgVoteResponse{}, nil
 }
 
+// CommitVote stores the hash commitment of a vote on a commit-reveal proposal.
+// The vote option stays hidden until it is revealed with RevealVote.
+func (k Keeper) CommitVote(ctx context.Context, msg *group.MsgCommitVote) (*group.MsgCommitVoteResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if len(msg.Commitment) != sha256.Size {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "commitment must be %d bytes long", sha256.Size)
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Voter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", msg.Voter)
+	}
+
+	kvStore := k.KVStoreService.OpenKVStore(ctx)
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.RevealPeriodEnd == nil {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "proposal does not use commit-reveal voting, use MsgVote instead")
+	}
+
+	// Ensure that we can still accept votes for this proposal.
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "proposal not open for voting")
+	}
+
+	if k.HeaderService.HeaderInfo(ctx).Time.After(proposal.VotingPeriodEnd) {
+		return nil, errorsmod.Wrap(errors.ErrExpired, "voting period has ended already")
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	voter := group.GroupMember{GroupId: policyInfo.GroupId, Member: &group.Member{Address: msg.Voter}}
+	if err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&voter, k.accKeeper.AddressCodec()), &voter); err != nil {
+		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
+	}
+	newVote := group.Vote{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Voter,
+		Option:     group.VOTE_OPTION_UNSPECIFIED,
+		SubmitTime: k.HeaderService.HeaderInfo(ctx).Time,
+		Commitment: msg.Commitment,
+	}
+
+	// The ORM will return an error if the vote already exists,
+	// making sure than a voter hasn't already committed to a vote.
+	if err := k.voteTable.Create(kvStore, &newVote); err != nil {
+		return nil, errorsmod.Wrap(err, "store vote commitment")
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventVote{ProposalId: msg.ProposalId}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCommitVoteResponse{}, nil
+}
+
+// RevealVote reveals the option of a vote committed to with CommitVote. The
+// revealed option, voter and salt must match the stored commitment.
+func (k Keeper) RevealVote(ctx context.Context, msg *group.MsgRevealVote) (*group.MsgRevealVoteResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	// verify vote options
+	if msg.Option == group.VOTE_OPTION_UNSPECIFIED {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "vote option")
+	}
+
+	if _, ok := group.VoteOption_name[int32(msg.Option)]; !ok {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "vote option")
+	}
+
+	if len(msg.Salt) == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "salt")
+	}
+
+	if err := k.assertMetadataLength(msg.Metadata, "metadata"); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Voter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", msg.Voter)
+	}
+
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.RevealPeriodEnd == nil {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "proposal does not use commit-reveal voting")
+	}
+
+	if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "proposal not open for revealing votes")
+	}
+
+	// Votes can only be revealed once the voting period has ended, so that
+	// they don't influence the votes which are not cast yet.
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	if !now.After(proposal.VotingPeriodEnd) {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "votes cannot be revealed before the voting period end")
+	}
+
+	if now.After(*proposal.RevealPeriodEnd) {
+		return nil, errorsmod.Wrap(errors.ErrExpired, "reveal period has ended already")
+	}
+
+	vote, err := k.getVote(ctx, msg.ProposalId, msg.Voter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "vote commitment of voter: %s", msg.Voter)
+	}
+
+	if vote.Option != group.VOTE_OPTION_UNSPECIFIED {
+		return nil, errorsmod.Wrap(errors.ErrDuplicate, "vote already revealed")
+	}
+
+	if !bytes.Equal(vote.Commitment, group.VoteCommitment(msg.ProposalId, msg.Voter, msg.Option, msg.Salt)) {
+		return nil, errorsmod.Wrap(errors.ErrUnauthorized, "revealed vote does not match the commitment")
+	}
+
+	vote.Option = msg.Option
+	vote.Metadata = msg.Metadata
+	if err := k.voteTable.Update(k.KVStoreService.OpenKVStore(ctx), &vote); err != nil {
+		return nil, errorsmod.Wrap(err, "reveal vote")
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&group.EventVote{ProposalId: msg.ProposalId}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgRevealVoteResponse{}, nil
+}
+
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
 // - prune all the votes.
@@ -791,19 +949,29 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 		return err
 	}
 
-	tallyResult, err := k.Tally(ctx, *p, policyInfo.GroupId)
+	tallyResult, err := k.tally(ctx, *p, policyInfo.GroupId, policy)
+	if err != nil {
+		return err
+	}
+
+	totalPower, err := k.totalPower(ctx, groupInfo, policy)
 	if err != nil {
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
+	result, err := policy.Allow(tallyResult, totalPower)
 	if err != nil {
 		return errorsmod.Wrap(err, "policy allow")
 	}
 
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
-	if isFinal := result.Final || k.HeaderService.HeaderInfo(ctx).Time.After(p.VotingPeriodEnd); isFinal {
+	isFinal := result.Final || k.HeaderService.HeaderInfo(ctx).Time.After(p.VotingPeriodEnd)
+	// Commit-reveal proposals are only final once all votes could be revealed.
+	if p.RevealPeriodEnd != nil {
+		isFinal = k.HeaderService.HeaderInfo(ctx).Time.After(*p.RevealPeriodEnd)
+	}
+	if isFinal {
 		if err := k.pruneVotes(ctx, p.Id); err != nil {
 			return err
 		}
@@ -853,12 +1021,64 @@ func (k Keeper) Exec(ctx context.Context, msg *group.MsgExec) (*group.MsgExecRes
 		}
 	}
 
+	result, err := k.doExecuteProposal(ctx, proposal, policyInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgExecResponse{
+		Result: result,
+	}, nil
+}
+
+// RetryExec retries the execution of a proposal whose previous execution failed.
+func (k Keeper) RetryExec(ctx context.Context, msg *group.MsgRetryExec) (*group.MsgRetryExecResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != group.PROPOSAL_STATUS_ACCEPTED || proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_FAILURE {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "not possible to retry exec with proposal status %s and executor result %s", proposal.Status.String(), proposal.ExecutorResult.String())
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	result, err := k.doExecuteProposal(ctx, proposal, policyInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgRetryExecResponse{
+		Result: result,
+	}, nil
+}
+
+// doExecuteProposal executes the messages of an accepted proposal which has
+// not run successfully yet, records the failure reason if the execution fails,
+// and then either prunes or updates the proposal. Executing a proposal whose
+// previous execution failed counts as a retry, bounded by MaxExecRetries.
+func (k Keeper) doExecuteProposal(ctx context.Context, proposal group.Proposal, policyInfo group.GroupPolicyInfo) (group.ProposalExecutorResult, error) {
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_FAILURE {
+			if proposal.ExecRetries >= k.config.MaxExecRetries {
+				return group.PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED, errorsmod.Wrapf(errors.ErrMaxLimit, "proposal %d execution already retried %d times", proposal.Id, proposal.ExecRetries)
+			}
+			proposal.ExecRetries++
+		}
+
 		addr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
 		if err != nil {
-			return nil, err
+			return group.PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED, err
 		}
 
 		decisionPolicy := policyInfo.DecisionPolicy.GetCachedValue().(group.DecisionPolicy)
@@ -867,6 +1087,7 @@ func (k Keeper) Exec(ctx context.Context, msg *group.MsgExec) (*group.MsgExecRes
 			return k.doExecuteMsgs(ctx, proposal, addr, decisionPolicy)
 		}); err != nil {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+			proposal.ExecutionFailures = append(proposal.ExecutionFailures, newProposalExecutionFailure(err, k.HeaderService.HeaderInfo(ctx).Time))
 			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error())
 			k.Logger.Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
 		} else {
@@ -878,7 +1099,7 @@ func (k Keeper) Exec(ctx context.Context, msg *group.MsgExec) (*group.MsgExecRes
 	// If proposal has successfully run, delete it from state.
 	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
-			return nil, err
+			return group.PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED, err
 		}
 
 		// Emit event for proposal finalized with its result
@@ -888,12 +1109,12 @@ func (k Keeper) Exec(ctx context.Context, msg *group.MsgExec) (*group.MsgExecRes
 				Status:      proposal.Status,
 				TallyResult: &proposal.FinalTallyResult,
 			}); err != nil {
-			return nil, err
+			return group.PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED, err
 		}
 	} else {
 		store := k.KVStoreService.OpenKVStore(ctx)
 		if err := k.proposalTable.Update(store, proposal.Id, &proposal); err != nil {
-			return nil, err
+			return group.PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED, err
 		}
 	}
 
@@ -902,12 +1123,10 @@ func (k Keeper) Exec(ctx context.Context, msg *group.MsgExec) (*group.MsgExecRes
 		Logs:       logs,
 		Result:     proposal.ExecutorResult,
 	}); err != nil {
-		return nil, err
+		return group.PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED, err
 	}
 
-	return &group.MsgExecResponse{
-		Result: proposal.ExecutorResult,
-	}, nil
+	return proposal.ExecutorResult, nil
 }
 
 // LeaveGroup implements the MsgServer/LeaveGroup method.
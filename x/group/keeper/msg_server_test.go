@@ -21,6 +21,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -2969,6 +2970,178 @@ func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	}
 }
 
+func (s *TestSuite) TestRetryExec() {
+	proposers := []string{s.addrsStr[1]}
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyStrAddr,
+		ToAddress:   s.addrsStr[1],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 108)},
+	}
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: sdkCtx.HeaderInfo().Time.Add(minExecutionPeriod)})
+
+	// retrying a proposal which has not been executed yet is not possible
+	_, err := s.groupKeeper.RetryExec(sdkCtx, &group.MsgRetryExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+	s.Require().ErrorContains(err, "not possible to retry exec")
+
+	// first execution fails and records the failure reason
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, sdkerrors.ErrInsufficientFunds)
+	res, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, res.Result)
+
+	proposalRes, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), proposalRes.Proposal.ExecRetries)
+	s.Require().Len(proposalRes.Proposal.ExecutionFailures, 1)
+	failure := proposalRes.Proposal.ExecutionFailures[0]
+	s.Require().Equal(int64(0), failure.MsgIndex)
+	s.Require().Equal(sdkerrors.ErrInsufficientFunds.Codespace(), failure.Codespace)
+	s.Require().Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), failure.Code)
+	s.Require().Equal(sdkCtx.HeaderInfo().Time, failure.Time)
+
+	// retries are bounded by MaxExecRetries
+	maxRetries := group.DefaultConfig().MaxExecRetries
+	for i := uint64(1); i <= maxRetries; i++ {
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, sdkerrors.ErrInsufficientFunds)
+		retryRes, err := s.groupKeeper.RetryExec(sdkCtx, &group.MsgRetryExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, retryRes.Result)
+
+		proposalRes, err = s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(i, proposalRes.Proposal.ExecRetries)
+		s.Require().Len(proposalRes.Proposal.ExecutionFailures, int(i)+1)
+	}
+
+	_, err = s.groupKeeper.RetryExec(sdkCtx, &group.MsgRetryExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+	s.Require().ErrorContains(err, "already retried")
+	_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+	s.Require().ErrorContains(err, "already retried")
+
+	// a successful retry prunes the proposal
+	sdkCtx, _ = s.sdkCtx.CacheContext()
+	proposalID = submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: sdkCtx.HeaderInfo().Time.Add(minExecutionPeriod)})
+
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, sdkerrors.ErrInsufficientFunds)
+	_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+	retryRes, err := s.groupKeeper.RetryExec(sdkCtx, &group.MsgRetryExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, retryRes.Result)
+
+	_, err = s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().ErrorContains(err, "load proposal: not found")
+}
+
+func (s *TestSuite) TestCommitRevealVote() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+
+	// enable commit-reveal voting on the group policy
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold: "2",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod:       time.Second,
+			MinExecutionPeriod: minExecutionPeriod,
+			RevealPeriod:       10 * time.Second,
+		},
+	}
+	updateReq, err := group.NewMsgUpdateGroupPolicyDecisionPolicy(s.addrsStr[0], s.groupPolicyStrAddr, policy)
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.UpdateGroupPolicyDecisionPolicy(sdkCtx, updateReq)
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyStrAddr,
+		ToAddress:   s.addrsStr[1],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalID := submitProposal(sdkCtx, s, []sdk.Msg{msgSend}, []string{s.addrsStr[1]})
+	proposalRes, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().NotNil(proposalRes.Proposal.RevealPeriodEnd)
+	s.Require().Equal(proposalRes.Proposal.VotingPeriodEnd.Add(10*time.Second), *proposalRes.Proposal.RevealPeriodEnd)
+
+	// plain votes are rejected
+	_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: s.addrsStr[1], Option: group.VOTE_OPTION_YES})
+	s.Require().ErrorContains(err, "proposal uses commit-reveal voting")
+
+	yesSalt, noSalt := []byte("yes-salt"), []byte("no-salt")
+	_, err = s.groupKeeper.CommitVote(sdkCtx, &group.MsgCommitVote{ProposalId: proposalID, Voter: s.addrsStr[1], Commitment: []byte("too short")})
+	s.Require().ErrorContains(err, "commitment must be 32 bytes long")
+	_, err = s.groupKeeper.CommitVote(sdkCtx, &group.MsgCommitVote{
+		ProposalId: proposalID,
+		Voter:      s.addrsStr[1],
+		Commitment: group.VoteCommitment(proposalID, s.addrsStr[1], group.VOTE_OPTION_YES, yesSalt),
+	})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.CommitVote(sdkCtx, &group.MsgCommitVote{
+		ProposalId: proposalID,
+		Voter:      s.addrsStr[1],
+		Commitment: group.VoteCommitment(proposalID, s.addrsStr[1], group.VOTE_OPTION_NO, noSalt),
+	})
+	s.Require().ErrorContains(err, "store vote commitment")
+	// this commitment is never revealed
+	_, err = s.groupKeeper.CommitVote(sdkCtx, &group.MsgCommitVote{
+		ProposalId: proposalID,
+		Voter:      s.addrsStr[4],
+		Commitment: group.VoteCommitment(proposalID, s.addrsStr[4], group.VOTE_OPTION_NO, noSalt),
+	})
+	s.Require().NoError(err)
+
+	// the committed options are hidden during the voting period
+	voteRes, err := s.groupKeeper.VoteByProposalVoter(sdkCtx, &group.QueryVoteByProposalVoterRequest{ProposalId: proposalID, Voter: s.addrsStr[1]})
+	s.Require().NoError(err)
+	s.Require().Equal(group.VOTE_OPTION_UNSPECIFIED, voteRes.Vote.Option)
+
+	reveal := &group.MsgRevealVote{ProposalId: proposalID, Voter: s.addrsStr[1], Option: group.VOTE_OPTION_YES, Salt: yesSalt}
+	_, err = s.groupKeeper.RevealVote(sdkCtx, reveal)
+	s.Require().ErrorContains(err, "votes cannot be revealed before the voting period end")
+
+	// reveal period
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: proposalRes.Proposal.VotingPeriodEnd.Add(time.Second)})
+	_, err = s.groupKeeper.CommitVote(sdkCtx, &group.MsgCommitVote{
+		ProposalId: proposalID,
+		Voter:      s.addrsStr[4],
+		Commitment: group.VoteCommitment(proposalID, s.addrsStr[4], group.VOTE_OPTION_YES, yesSalt),
+	})
+	s.Require().ErrorContains(err, "voting period has ended already")
+
+	_, err = s.groupKeeper.RevealVote(sdkCtx, &group.MsgRevealVote{ProposalId: proposalID, Voter: s.addrsStr[1], Option: group.VOTE_OPTION_NO, Salt: yesSalt})
+	s.Require().ErrorContains(err, "revealed vote does not match the commitment")
+	_, err = s.groupKeeper.RevealVote(sdkCtx, reveal)
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.RevealVote(sdkCtx, reveal)
+	s.Require().ErrorContains(err, "vote already revealed")
+
+	// the proposal is not tallied before the end of the reveal period
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(sdkCtx))
+	proposalRes, err = s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposalRes.Proposal.Status)
+
+	// unrevealed commitments are counted as abstain
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: proposalRes.Proposal.RevealPeriodEnd.Add(time.Second)})
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(sdkCtx))
+	proposalRes, err = s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposalRes.Proposal.Status)
+	s.Require().Equal("2", proposalRes.Proposal.FinalTallyResult.YesCount)
+	s.Require().Equal("0", proposalRes.Proposal.FinalTallyResult.NoCount)
+	s.Require().Equal("1", proposalRes.Proposal.FinalTallyResult.AbstainCount)
+
+	// the proposal can be executed within the max execution period following the reveal period
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+	execRes, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: s.addrsStr[1], ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+}
+
 func (s *TestSuite) TestLeaveGroup() {
 	addrs := simtestutil.CreateIncrementalAccounts(7)
 
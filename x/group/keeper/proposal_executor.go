@@ -3,6 +3,8 @@ package keeper
 import (
 	"bytes"
 	"context"
+	stderrors "errors"
+	"time"
 
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
@@ -30,7 +32,7 @@ func (k Keeper) doExecuteMsgs(ctx context.Context, proposal group.Proposal, grou
 	// be pruned automatically, so this function should not even be called, as
 	// the proposal doesn't exist in state. For sanity check, we can still keep
 	// this simple and cheap check.
-	expiryDate := proposal.VotingPeriodEnd.Add(k.config.MaxExecutionPeriod)
+	expiryDate := proposal.FinalTallyTime().Add(k.config.MaxExecutionPeriod)
 	if expiryDate.Before(currentTime) {
 		return errors.ErrExpired.Wrapf("proposal expired on %s", expiryDate)
 	}
@@ -46,12 +48,50 @@ func (k Keeper) doExecuteMsgs(ctx context.Context, proposal group.Proposal, grou
 
 	for i, msg := range msgs {
 		if _, err := k.MsgRouterService.Invoke(ctx, msg); err != nil {
-			return errorsmod.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i)
+			return &msgExecError{
+				msgIndex: i,
+				err:      errorsmod.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i),
+			}
 		}
 	}
 	return nil
 }
 
+// msgExecError is returned by doExecuteMsgs when one of the proposal messages
+// fails, it keeps track of the index of the failing message.
+type msgExecError struct {
+	msgIndex int
+	err      error
+}
+
+func (e *msgExecError) Error() string { return e.err.Error() }
+
+// Cause is used by errorsmod to find the ABCI code of the underlying error.
+func (e *msgExecError) Cause() error { return e.err }
+
+func (e *msgExecError) Unwrap() error { return e.err }
+
+// newProposalExecutionFailure builds the failure reason stored on a proposal
+// from the error returned by its execution.
+func newProposalExecutionFailure(err error, blockTime time.Time) group.ProposalExecutionFailure {
+	msgIndex := int64(-1)
+	var msgErr *msgExecError
+	if stderrors.As(err, &msgErr) {
+		msgIndex = int64(msgErr.msgIndex)
+	}
+
+	// debug is false so that errors not registered with errorsmod are redacted
+	// and the stored log stays deterministic.
+	codespace, code, log := errorsmod.ABCIInfo(err, false)
+	return group.ProposalExecutionFailure{
+		MsgIndex:  msgIndex,
+		Codespace: codespace,
+		Code:      code,
+		Log:       log,
+		Time:      blockTime,
+	}
+}
+
 // ensureMsgAuthZ checks that if a message requires signers that all of them
 // are equal to the given account address of group policy.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Codec, addressCodec address.Codec) error {
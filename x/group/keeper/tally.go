@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/internal/orm"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -23,6 +24,21 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 		return p.FinalTallyResult, nil
 	}
 
+	policyInfo, err := k.getGroupPolicyInfo(ctx, p.GroupPolicyAddress)
+	if err != nil {
+		return group.TallyResult{}, errorsmod.Wrap(err, "load group policy")
+	}
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+
+	return k.tally(ctx, p, groupID, policy)
+}
+
+// tally tallies the votes of a proposal with the voting power of the voters
+// under the decision policy, see group.VotingPower.
+func (k Keeper) tally(ctx context.Context, p group.Proposal, groupID uint64, policy group.DecisionPolicy) (group.TallyResult, error) {
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
 
 	it, err := k.voteByProposalIndex.Get(kvStore, p.Id)
@@ -59,10 +75,62 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 			return group.TallyResult{}, err
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		// Commitments which were not revealed count as abstain.
+		if vote.Option == group.VOTE_OPTION_UNSPECIFIED && len(vote.Commitment) > 0 {
+			vote.Option = group.VOTE_OPTION_ABSTAIN
+		}
+
+		power, err := group.VotingPower(policy, *member.Member)
+		if err != nil {
+			return group.TallyResult{}, err
+		}
+		if err := tallyResult.Add(vote, power); err != nil {
 			return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
 		}
 	}
 
 	return tallyResult, nil
 }
+
+// totalPower returns the total power of a group under the decision policy, which
+// is the total weight of the group unless the policy is a
+// group.VotingPowerDecisionPolicy, in which case it is the sum of the voting
+// powers of the group members.
+func (k Keeper) totalPower(ctx context.Context, groupInfo group.GroupInfo, policy group.DecisionPolicy) (string, error) {
+	if _, ok := policy.(group.VotingPowerDecisionPolicy); !ok {
+		return groupInfo.TotalWeight, nil
+	}
+
+	it, err := k.groupMemberByGroupIndex.Get(k.KVStoreService.OpenKVStore(ctx), groupInfo.Id)
+	if err != nil {
+		return "", err
+	}
+	defer it.Close()
+
+	totalPower := math.NewDecFromInt64(0)
+	for {
+		var member group.GroupMember
+		_, err = it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		power, err := group.VotingPower(policy, *member.Member)
+		if err != nil {
+			return "", err
+		}
+		powerDec, err := math.NewNonNegativeDecFromString(power)
+		if err != nil {
+			return "", err
+		}
+		totalPower, err = math.Add(totalPower, powerDec)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return totalPower.String(), nil
+}
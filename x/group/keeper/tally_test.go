@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"math"
+	"strconv"
 	"time"
 
+	"cosmossdk.io/core/header"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
 
@@ -83,3 +86,79 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+// quadraticDecisionPolicy is a custom percentage decision policy counting the
+// votes by the square root of the weights of the members.
+type quadraticDecisionPolicy struct {
+	group.PercentageDecisionPolicy
+}
+
+var _ group.VotingPowerDecisionPolicy = &quadraticDecisionPolicy{}
+
+func (*quadraticDecisionPolicy) XXX_MessageName() string {
+	return "cosmos.group.v1.testutil.QuadraticDecisionPolicy"
+}
+
+func (p *quadraticDecisionPolicy) VotingPower(member group.Member) (string, error) {
+	weight, err := strconv.ParseFloat(member.Weight, 64)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatFloat(math.Sqrt(weight), 'f', -1, 64), nil
+}
+
+func (s *TestSuite) TestTallyVotingPowerDecisionPolicy() {
+	votingPeriod := time.Minute
+	members := []group.MemberRequest{
+		{Address: s.addrsStr[1], Weight: "9"},
+		{Address: s.addrsStr[2], Weight: "1"},
+		{Address: s.addrsStr[3], Weight: "1"},
+		{Address: s.addrsStr[4], Weight: "1"},
+	}
+	percentagePolicy := group.NewPercentageDecisionPolicy("0.6", votingPeriod, 0).(*group.PercentageDecisionPolicy)
+
+	// the member with the largest weight votes yes alone: its vote counts for
+	// 9 out of 12 by weight, but only for 3 out of 6 by voting power
+	specs := map[string]struct {
+		policy    group.DecisionPolicy
+		expYes    string
+		expStatus group.ProposalStatus
+	}{
+		"weight": {
+			policy:    percentagePolicy,
+			expYes:    "9",
+			expStatus: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		"voting power": {
+			policy:    &quadraticDecisionPolicy{*percentagePolicy},
+			expYes:    "3",
+			expStatus: group.PROPOSAL_STATUS_REJECTED,
+		},
+	}
+
+	for msg, spec := range specs {
+		s.Run(msg, func() {
+			policyAddr, _ := s.createGroupAndGroupPolicy(s.addrs[0], members, spec.policy)
+			proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+				GroupPolicyAddress: policyAddr,
+				Proposers:          []string{s.addrsStr[1]},
+			})
+			s.Require().NoError(err)
+			_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+				ProposalId: proposalRes.ProposalId,
+				Voter:      s.addrsStr[1],
+				Option:     group.VOTE_OPTION_YES,
+			})
+			s.Require().NoError(err)
+
+			ctx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(votingPeriod + 1)})
+			s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+			res, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expYes, res.Proposal.FinalTallyResult.YesCount)
+			s.Require().Equal(spec.expStatus, res.Proposal.Status)
+		})
+	}
+}
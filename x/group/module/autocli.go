@@ -189,6 +189,26 @@ Parameters:
 						"exec": {Name: "exec", DefaultValue: "", Usage: "Set to 'try' for trying to execute proposal immediately after voting"},
 					},
 				},
+				{
+					RpcMethod: "CommitVote",
+					Use:       "commit-vote <proposal-id> <voter> <commitment>",
+					Short:     "Commit to a hidden vote on a commit-reveal proposal",
+					Long: `Commit to a hidden vote on a commit-reveal proposal during its voting period.
+The commitment is the base64 encoded SHA-256 hash of the big-endian proposal id,
+the voter address, the big-endian vote option and a secret salt.
+The vote must then be revealed with reveal-vote during the proposal reveal period.`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"}, {ProtoField: "voter"}, {ProtoField: "commitment"},
+					},
+				},
+				{
+					RpcMethod: "RevealVote",
+					Use:       "reveal-vote <proposal-id> <voter> <vote-option> <salt> <metadata>",
+					Short:     "Reveal a vote committed to on a commit-reveal proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"}, {ProtoField: "voter"}, {ProtoField: "option"}, {ProtoField: "salt"}, {ProtoField: "metadata"},
+					},
+				},
 				{
 					RpcMethod: "Exec",
 					Use:       "exec <proposal-id>",
@@ -197,6 +217,14 @@ Parameters:
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "RetryExec",
+					Use:       "retry-exec <proposal-id>",
+					Short:     "Retry the execution of a proposal whose previous execution failed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "LeaveGroup",
 					Use:       "leave-group <member-address> <group-id>",
@@ -23,7 +23,10 @@ var (
 	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
 	_ sdk.Msg = &MsgLeaveGroup{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRetryExec{}
 	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgCommitVote{}
+	_ sdk.Msg = &MsgRevealVote{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
 	_ sdk.Msg = &MsgSubmitProposal{}
 	_ sdk.Msg = &MsgCreateGroupPolicy{}
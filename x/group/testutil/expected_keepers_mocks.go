@@ -133,6 +133,21 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BlockAddresses mocks base method.
+func (m *MockBankKeeper) BlockAddresses(arg0 context.Context, arg1 *types.MsgBlockAddresses) (*types.MsgBlockAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgBlockAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockAddresses indicates an expected call of BlockAddresses.
+func (mr *MockBankKeeperMockRecorder) BlockAddresses(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockAddresses", reflect.TypeOf((*MockBankKeeper)(nil).BlockAddresses), arg0, arg1)
+}
+
 // Burn mocks base method.
 func (m *MockBankKeeper) Burn(arg0 context.Context, arg1 *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +163,21 @@ func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
 }
 
+// ClaimEscrow mocks base method.
+func (m *MockBankKeeper) ClaimEscrow(arg0 context.Context, arg1 *types.MsgClaimEscrow) (*types.MsgClaimEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimEscrow", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgClaimEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimEscrow indicates an expected call of ClaimEscrow.
+func (mr *MockBankKeeperMockRecorder) ClaimEscrow(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimEscrow", reflect.TypeOf((*MockBankKeeper)(nil).ClaimEscrow), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -191,6 +221,21 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// RefundEscrow mocks base method.
+func (m *MockBankKeeper) RefundEscrow(arg0 context.Context, arg1 *types.MsgRefundEscrow) (*types.MsgRefundEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundEscrow", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgRefundEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundEscrow indicates an expected call of RefundEscrow.
+func (mr *MockBankKeeperMockRecorder) RefundEscrow(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundEscrow", reflect.TypeOf((*MockBankKeeper)(nil).RefundEscrow), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types.MsgSend) (*types.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +265,21 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SendWithTimeout mocks base method.
+func (m *MockBankKeeper) SendWithTimeout(arg0 context.Context, arg1 *types.MsgSendWithTimeout) (*types.MsgSendWithTimeoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWithTimeout", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSendWithTimeoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendWithTimeout indicates an expected call of SendWithTimeout.
+func (mr *MockBankKeeperMockRecorder) SendWithTimeout(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWithTimeout", reflect.TypeOf((*MockBankKeeper)(nil).SendWithTimeout), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -249,6 +309,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// UnblockAddresses mocks base method.
+func (m *MockBankKeeper) UnblockAddresses(arg0 context.Context, arg1 *types.MsgUnblockAddresses) (*types.MsgUnblockAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgUnblockAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockAddresses indicates an expected call of UnblockAddresses.
+func (mr *MockBankKeeperMockRecorder) UnblockAddresses(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockAddresses", reflect.TypeOf((*MockBankKeeper)(nil).UnblockAddresses), arg0, arg1)
+}
+
 // UpdateParams mocks base method.
 func (m *MockBankKeeper) UpdateParams(arg0 context.Context, arg1 *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1272,6 +1272,227 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgCommitVote is the Msg/CommitVote request type.
+type MsgCommitVote struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter account address.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// commitment is the SHA-256 hash of the vote, computed over the big-endian
+	// proposal id, the voter address, the big-endian vote option and a secret salt.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *MsgCommitVote) Reset()         { *m = MsgCommitVote{} }
+func (m *MsgCommitVote) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitVote) ProtoMessage()    {}
+func (*MsgCommitVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{24}
+}
+func (m *MsgCommitVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitVote.Merge(m, src)
+}
+func (m *MsgCommitVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitVote proto.InternalMessageInfo
+
+func (m *MsgCommitVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCommitVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgCommitVote) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// MsgCommitVoteResponse is the Msg/CommitVote response type.
+type MsgCommitVoteResponse struct {
+}
+
+func (m *MsgCommitVoteResponse) Reset()         { *m = MsgCommitVoteResponse{} }
+func (m *MsgCommitVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitVoteResponse) ProtoMessage()    {}
+func (*MsgCommitVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{25}
+}
+func (m *MsgCommitVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitVoteResponse.Merge(m, src)
+}
+func (m *MsgCommitVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitVoteResponse proto.InternalMessageInfo
+
+// MsgRevealVote is the Msg/RevealVote request type.
+type MsgRevealVote struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter account address.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// option is the voter's choice on the proposal, as committed to.
+	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// salt is the secret salt used to compute the commitment.
+	Salt []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgRevealVote) Reset()         { *m = MsgRevealVote{} }
+func (m *MsgRevealVote) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealVote) ProtoMessage()    {}
+func (*MsgRevealVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{26}
+}
+func (m *MsgRevealVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealVote.Merge(m, src)
+}
+func (m *MsgRevealVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealVote proto.InternalMessageInfo
+
+func (m *MsgRevealVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgRevealVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgRevealVote) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *MsgRevealVote) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *MsgRevealVote) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// MsgRevealVoteResponse is the Msg/RevealVote response type.
+type MsgRevealVoteResponse struct {
+}
+
+func (m *MsgRevealVoteResponse) Reset()         { *m = MsgRevealVoteResponse{} }
+func (m *MsgRevealVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealVoteResponse) ProtoMessage()    {}
+func (*MsgRevealVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{27}
+}
+func (m *MsgRevealVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealVoteResponse.Merge(m, src)
+}
+func (m *MsgRevealVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealVoteResponse proto.InternalMessageInfo
+
 // MsgExec is the Msg/Exec request type.
 type MsgExec struct {
 	// proposal is the unique ID of the proposal.
@@ -1284,7 +1505,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1558,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1373,6 +1594,107 @@ func (m *MsgExecResponse) GetResult() ProposalExecutorResult {
 	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
 }
 
+// MsgRetryExec is the Msg/RetryExec request type.
+type MsgRetryExec struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// executor is the account address used to execute the proposal.
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *MsgRetryExec) Reset()         { *m = MsgRetryExec{} }
+func (m *MsgRetryExec) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryExec) ProtoMessage()    {}
+func (*MsgRetryExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgRetryExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryExec.Merge(m, src)
+}
+func (m *MsgRetryExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryExec proto.InternalMessageInfo
+
+func (m *MsgRetryExec) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgRetryExec) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+// MsgRetryExecResponse is the Msg/RetryExec response type.
+type MsgRetryExecResponse struct {
+	// result is the result of the proposal execution retry.
+	Result ProposalExecutorResult `protobuf:"varint,1,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+}
+
+func (m *MsgRetryExecResponse) Reset()         { *m = MsgRetryExecResponse{} }
+func (m *MsgRetryExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryExecResponse) ProtoMessage()    {}
+func (*MsgRetryExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgRetryExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryExecResponse.Merge(m, src)
+}
+func (m *MsgRetryExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryExecResponse proto.InternalMessageInfo
+
+func (m *MsgRetryExecResponse) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
 // MsgLeaveGroup is the Msg/LeaveGroup request type.
 type MsgLeaveGroup struct {
 	// address is the account address of the group member.
@@ -1385,7 +1707,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{32}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,7 +1758,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{33}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1491,8 +1813,14 @@ func init() {
 	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "cosmos.group.v1.MsgWithdrawProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.group.v1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1.MsgVoteResponse")
+	proto.RegisterType((*MsgCommitVote)(nil), "cosmos.group.v1.MsgCommitVote")
+	proto.RegisterType((*MsgCommitVoteResponse)(nil), "cosmos.group.v1.MsgCommitVoteResponse")
+	proto.RegisterType((*MsgRevealVote)(nil), "cosmos.group.v1.MsgRevealVote")
+	proto.RegisterType((*MsgRevealVoteResponse)(nil), "cosmos.group.v1.MsgRevealVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
+	proto.RegisterType((*MsgRetryExec)(nil), "cosmos.group.v1.MsgRetryExec")
+	proto.RegisterType((*MsgRetryExecResponse)(nil), "cosmos.group.v1.MsgRetryExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
 }
@@ -1500,98 +1828,107 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xda, 0xce, 0xdb, 0x93, 0x7f, 0x9d, 0x64, 0x93, 0xb4, 0xce, 0xb6, 0xb5, 0xdd, 0xe9,
-	0x4b, 0x52, 0xab, 0x5e, 0x37, 0x4e, 0xdb, 0xbf, 0x30, 0x48, 0xa8, 0x49, 0x03, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xbb, 0x5d, 0x56, 0xb5, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x2f, 0x17, 0xde, 0x24, 0x90, 0xe0, 0x03, 0xc0, 0x8d, 0x63, 0x91, 0x7a, 0xe0, 0xc6, 0x0d, 0x55,
-	0xe5, 0x52, 0x71, 0x42, 0x1c, 0x10, 0x6a, 0x85, 0x7a, 0xe3, 0x2b, 0x80, 0x76, 0x66, 0x77, 0xec,
-	0xf1, 0xee, 0x7a, 0xb7, 0x96, 0x05, 0x97, 0xc8, 0x3b, 0xcf, 0xef, 0x79, 0xfb, 0x3d, 0xcf, 0x3c,
-	0x33, 0x13, 0xc8, 0xec, 0x59, 0xb8, 0x61, 0xe1, 0x92, 0xd1, 0xb6, 0x3a, 0xad, 0xd2, 0xfe, 0x5a,
-	0xc9, 0x3e, 0x90, 0x5b, 0x6d, 0xcb, 0xb6, 0xc4, 0x59, 0x2a, 0x91, 0x89, 0x44, 0xde, 0x5f, 0x93,
-	0x16, 0x0d, 0xcb, 0xb0, 0x88, 0xac, 0xe4, 0xfc, 0xa2, 0x30, 0x69, 0x99, 0xc2, 0x6a, 0x54, 0xe0,
-	0xea, 0xb8, 0x22, 0xc3, 0xb2, 0x8c, 0xba, 0x5e, 0x22, 0x5f, 0xbb, 0x9d, 0xdb, 0x25, 0xb5, 0x79,
-	0xe8, 0x8a, 0x8e, 0xfb, 0xdc, 0x1e, 0xb6, 0x74, 0x4f, 0xef, 0x98, 0x2b, 0x6c, 0x60, 0xc3, 0x11,
-	0x35, 0xb0, 0xe1, 0x0a, 0xe6, 0xd5, 0x86, 0xd9, 0xb4, 0x4a, 0xe4, 0x2f, 0x5d, 0x42, 0x3f, 0x0b,
-	0x90, 0xae, 0x62, 0x63, 0xb3, 0xad, 0xab, 0xb6, 0xfe, 0xb2, 0x63, 0x4d, 0x94, 0x61, 0x5c, 0xd5,
-	0x1a, 0x66, 0x33, 0x23, 0xe4, 0x85, 0xd5, 0xe9, 0x8d, 0xcc, 0x2f, 0xf7, 0x8b, 0x8b, 0x6e, 0x5c,
-	0x57, 0x35, 0xad, 0xad, 0x63, 0x7c, 0xdd, 0x6e, 0x9b, 0x4d, 0x43, 0xa1, 0x30, 0x71, 0x13, 0x26,
-	0x1b, 0x7a, 0x63, 0x57, 0x6f, 0xe3, 0x4c, 0x22, 0x9f, 0x5c, 0x9d, 0x29, 0x67, 0xe5, 0xbe, 0xd4,
-	0xe5, 0x2a, 0x91, 0x2b, 0xfa, 0x7b, 0x1d, 0x1d, 0xdb, 0x1b, 0xd3, 0x0f, 0x7e, 0xcf, 0x8d, 0x7d,
-	0xf7, 0xf4, 0x5e, 0x41, 0x50, 0x3c, 0x4d, 0x51, 0x82, 0xa9, 0x86, 0x6e, 0xab, 0x9a, 0x6a, 0xab,
-	0x99, 0xa4, 0xe3, 0x57, 0x61, 0xdf, 0x95, 0xd5, 0x0f, 0x9f, 0xde, 0x2b, 0x50, 0x67, 0x9f, 0x3e,
-	0xbd, 0x57, 0x70, 0x19, 0x2b, 0x62, 0xed, 0x4e, 0x89, 0x0f, 0x1d, 0xad, 0xc3, 0x51, 0x7e, 0x45,
-	0xd1, 0x71, 0xcb, 0x6a, 0x62, 0x5d, 0x5c, 0x86, 0x29, 0x12, 0x4d, 0xcd, 0xd4, 0x48, 0x5e, 0x29,
-	0x65, 0x92, 0x7c, 0x6f, 0x6b, 0xe8, 0x4f, 0x01, 0x96, 0xaa, 0xd8, 0xb8, 0xd9, 0xd2, 0x3c, 0xad,
-	0xaa, 0x1b, 0xd4, 0xb3, 0x32, 0xd1, 0xeb, 0x24, 0xc1, 0x39, 0x11, 0x77, 0x20, 0x4d, 0x53, 0xad,
-	0x75, 0x88, 0x1f, 0x9c, 0x49, 0x3e, 0x2b, 0x57, 0x47, 0xa8, 0x01, 0x1a, 0x27, 0xae, 0x94, 0x78,
-	0x56, 0xf2, 0x3c, 0x2b, 0xfe, 0x6c, 0x50, 0x0e, 0x4e, 0x06, 0x0a, 0x3c, 0x8e, 0xd0, 0x4f, 0x02,
-	0x2c, 0xf0, 0x88, 0xab, 0x24, 0xad, 0x11, 0xd2, 0x70, 0x19, 0xa6, 0x9b, 0xfa, 0xdd, 0x1a, 0x35,
-	0x97, 0x8c, 0x30, 0x37, 0xd5, 0xd4, 0xef, 0x92, 0x08, 0x2a, 0x45, 0x3e, 0xd7, 0x6c, 0x68, 0xae,
-	0x04, 0x8e, 0x4e, 0xc2, 0xf1, 0x80, 0x65, 0x96, 0xe7, 0xf7, 0x02, 0x69, 0x13, 0x8e, 0x09, 0xda,
-	0x6a, 0xa3, 0x4c, 0x75, 0x50, 0x47, 0x5f, 0xe4, 0xf3, 0x39, 0x35, 0xa0, 0x76, 0x54, 0x03, 0xe5,
-	0x21, 0x1b, 0x2c, 0x61, 0x59, 0x7d, 0x9d, 0x80, 0x45, 0xbe, 0xf9, 0x77, 0xac, 0xba, 0xb9, 0x77,
-	0xf8, 0x2f, 0xe5, 0x24, 0xaa, 0x30, 0xab, 0xe9, 0x7b, 0x26, 0x36, 0xad, 0x66, 0xad, 0x45, 0x3c,
-	0x67, 0x52, 0x79, 0x61, 0x75, 0xa6, 0xbc, 0x28, 0xd3, 0x39, 0x26, 0x7b, 0x73, 0x4c, 0xbe, 0xda,
-	0x3c, 0xdc, 0x40, 0x0f, 0xef, 0x17, 0xb3, 0xfd, 0xbd, 0x7f, 0xcd, 0x35, 0x40, 0x23, 0x57, 0xd2,
-	0x1a, 0xf7, 0x5d, 0x29, 0x7f, 0xfc, 0x4d, 0x6e, 0x8c, 0xa7, 0x2e, 0x17, 0x3a, 0x0c, 0xa8, 0x0e,
-	0x52, 0xe0, 0x44, 0xd0, 0x3a, 0x1b, 0x0c, 0x65, 0x98, 0x54, 0x29, 0x0b, 0x91, 0xfc, 0x78, 0x40,
-	0xf4, 0x51, 0x02, 0x96, 0xf9, 0x6a, 0x50, 0xa3, 0xc3, 0x6d, 0x97, 0x57, 0x60, 0x91, 0xf2, 0x4d,
-	0x59, 0xab, 0x79, 0xe1, 0x24, 0x22, 0xd4, 0x45, 0xa3, 0xd7, 0x33, 0x91, 0x0c, 0xbb, 0xbf, 0xd6,
-	0x79, 0x52, 0xcf, 0x84, 0xf6, 0x63, 0x4f, 0x9e, 0xe8, 0x34, 0x9c, 0x0a, 0x15, 0xb2, 0xae, 0xfc,
-	0x21, 0x09, 0x19, 0x9e, 0xff, 0x5b, 0xa6, 0xfd, 0xee, 0x90, 0x9d, 0x39, 0x92, 0x93, 0xe6, 0x2c,
-	0xa4, 0x29, 0xdd, 0x7d, 0x9d, 0x7c, 0xc4, 0xe0, 0x26, 0x41, 0x19, 0x96, 0xb8, 0xaa, 0x30, 0x74,
-	0x8a, 0xa0, 0x17, 0x7a, 0xc8, 0x67, 0x3a, 0x6b, 0x7d, 0x3a, 0x2a, 0x76, 0x2b, 0x31, 0x9e, 0x17,
-	0x56, 0xa7, 0xf8, 0x82, 0x61, 0xda, 0x2c, 0x01, 0xbb, 0x66, 0x62, 0xc4, 0xbb, 0xe6, 0x8a, 0x7f,
-	0xd7, 0x9c, 0x0e, 0xdd, 0x35, 0xdd, 0xea, 0xa0, 0x4f, 0x04, 0xc8, 0x87, 0x09, 0x63, 0x9c, 0xab,
-	0xa3, 0xec, 0x6b, 0xf4, 0x63, 0x02, 0x50, 0x50, 0xb3, 0xf1, 0xa9, 0xff, 0xa7, 0x5b, 0x2f, 0xa0,
-	0x92, 0xc9, 0x11, 0x57, 0xb2, 0xe2, 0xaf, 0xe4, 0x4a, 0xe8, 0x56, 0xe5, 0x6d, 0xa1, 0x0b, 0x50,
-	0x88, 0x26, 0x90, 0x6d, 0xdb, 0xbf, 0x04, 0x32, 0x36, 0x7d, 0xf0, 0xa1, 0x0f, 0xca, 0x51, 0x32,
-	0x3d, 0xe8, 0x64, 0xbd, 0x12, 0x97, 0x1e, 0x3e, 0x1f, 0x74, 0x0e, 0xce, 0x0c, 0x92, 0x33, 0x62,
-	0x3e, 0x4b, 0xc2, 0x7c, 0x15, 0x1b, 0xd7, 0x3b, 0xbb, 0x0d, 0xd3, 0xde, 0x69, 0x5b, 0x2d, 0x0b,
-	0xab, 0xf5, 0xd0, 0xec, 0x84, 0x21, 0xb2, 0x3b, 0x01, 0xd3, 0x2d, 0x62, 0xd7, 0x1b, 0x73, 0xd3,
-	0x4a, 0x77, 0x61, 0xe0, 0x09, 0x7c, 0xd1, 0x91, 0x61, 0xac, 0x1a, 0x3a, 0xce, 0xa4, 0xc8, 0x7c,
-	0x0c, 0x6c, 0x3d, 0x85, 0xa1, 0xc4, 0xf3, 0x90, 0xd2, 0x0f, 0xf4, 0x3d, 0x32, 0x9f, 0xd2, 0xe5,
-	0x25, 0xdf, 0x34, 0xdd, 0x3a, 0xd0, 0xf7, 0x14, 0x02, 0x11, 0xcf, 0xc3, 0xb8, 0x6d, 0xda, 0x75,
-	0x9d, 0x8c, 0xa7, 0xe9, 0x8d, 0x85, 0xdf, 0xee, 0x17, 0x67, 0xbb, 0xe4, 0xe6, 0x2f, 0xca, 0x97,
-	0xfe, 0xaf, 0x50, 0x84, 0x58, 0x84, 0x49, 0xdc, 0x69, 0x34, 0xd4, 0xf6, 0x61, 0x66, 0x32, 0x1c,
-	0xec, 0x61, 0x2a, 0xcf, 0x79, 0x5d, 0xdd, 0x4d, 0xd3, 0x29, 0x1d, 0xea, 0x29, 0x1d, 0x7d, 0xe6,
-	0xf8, 0x78, 0x47, 0x2f, 0x90, 0x73, 0x98, 0x5f, 0x64, 0xa3, 0x29, 0x07, 0x33, 0x2d, 0x77, 0xad,
-	0x3b, 0x9d, 0xc0, 0x5b, 0xda, 0xd6, 0xd0, 0xb7, 0xf4, 0xbe, 0xeb, 0x4c, 0x35, 0xad, 0xad, 0xde,
-	0x65, 0xd5, 0x8c, 0x52, 0xec, 0xbd, 0x33, 0x24, 0x62, 0xde, 0x19, 0x2a, 0x97, 0x9d, 0x0c, 0xbd,
-	0xaf, 0xfe, 0x43, 0x96, 0xe5, 0xd7, 0x1f, 0x8b, 0x7b, 0x95, 0xed, 0x5f, 0x66, 0xed, 0xf8, 0xb7,
-	0x00, 0x93, 0x55, 0x6c, 0xbc, 0x61, 0xd9, 0xd1, 0xf9, 0x3a, 0x7b, 0x76, 0xdf, 0xb2, 0xf5, 0x76,
-	0x64, 0xd0, 0x14, 0x26, 0xae, 0xc3, 0x84, 0xd5, 0xb2, 0x4d, 0x8b, 0xde, 0x24, 0xd2, 0xe5, 0xe3,
-	0xbe, 0xfe, 0x70, 0xfc, 0xbe, 0x4e, 0x20, 0x8a, 0x0b, 0xe5, 0x1a, 0x34, 0xd5, 0xd7, 0xa0, 0xf1,
-	0xdb, 0xad, 0xb2, 0x42, 0xf6, 0x31, 0x89, 0xc3, 0x21, 0x2b, 0x13, 0x44, 0x96, 0xe3, 0x1d, 0xcd,
-	0xc3, 0xac, 0xfb, 0x93, 0x91, 0xf2, 0x39, 0x25, 0xc5, 0xb1, 0x16, 0x4d, 0xca, 0x25, 0x98, 0x72,
-	0x1c, 0x76, 0x6c, 0x2b, 0x9a, 0x17, 0x86, 0xac, 0x14, 0x9c, 0xf0, 0xd8, 0x67, 0x68, 0x84, 0x4e,
-	0x08, 0x48, 0x21, 0x11, 0x92, 0xdc, 0xbc, 0xd6, 0x7c, 0x11, 0x26, 0xda, 0x3a, 0xee, 0xd4, 0x6d,
-	0xe2, 0x32, 0x5d, 0x5e, 0xf1, 0x51, 0xe1, 0x55, 0x7a, 0xcb, 0x75, 0xa1, 0x10, 0xb8, 0xe2, 0xaa,
-	0xa1, 0x2f, 0x04, 0x38, 0x52, 0xc5, 0xc6, 0xab, 0xba, 0xba, 0xef, 0xbe, 0xda, 0x87, 0xb8, 0xc7,
-	0x0e, 0xb8, 0xe9, 0xd3, 0xd7, 0x65, 0x6f, 0xbb, 0x66, 0x83, 0xf2, 0xeb, 0xfa, 0x47, 0xc7, 0xc8,
-	0x23, 0xba, 0xbb, 0xe0, 0xe5, 0x5a, 0x28, 0x40, 0x8a, 0x54, 0x62, 0x11, 0xe6, 0xb6, 0xde, 0xdc,
-	0xda, 0xac, 0xdd, 0x7c, 0xed, 0xfa, 0xce, 0xd6, 0xe6, 0xf6, 0x4b, 0xdb, 0x5b, 0xd7, 0xe6, 0xc6,
-	0xc4, 0xff, 0xc1, 0x14, 0x59, 0xbd, 0xa1, 0xbc, 0x35, 0x27, 0x94, 0x1f, 0xce, 0x40, 0xb2, 0x8a,
-	0x0d, 0xf1, 0x16, 0xcc, 0xf4, 0xfe, 0x47, 0x22, 0xe7, 0xbf, 0xe6, 0x71, 0xf7, 0x12, 0x69, 0x25,
-	0x02, 0xc0, 0x88, 0xaf, 0x83, 0x18, 0xf0, 0xce, 0x3f, 0x17, 0xa4, 0xee, 0xc7, 0x49, 0x72, 0x3c,
-	0x1c, 0xf3, 0x76, 0x1b, 0xe6, 0x7c, 0x8f, 0xe9, 0x33, 0x11, 0x36, 0x08, 0x4a, 0xba, 0x10, 0x07,
-	0xc5, 0xfc, 0x58, 0xb0, 0x10, 0xf4, 0x98, 0x5d, 0x89, 0x0c, 0x97, 0x02, 0xa5, 0x52, 0x4c, 0x20,
-	0x73, 0x68, 0xc2, 0xbc, 0xff, 0x9d, 0x79, 0x36, 0xa2, 0x08, 0x14, 0x26, 0x15, 0x63, 0xc1, 0x98,
-	0xab, 0x0e, 0x2c, 0x05, 0x3f, 0x1e, 0xce, 0x47, 0xd8, 0xe9, 0x42, 0xa5, 0xb5, 0xd8, 0x50, 0xe6,
-	0xf6, 0x00, 0x8e, 0x86, 0x3c, 0xef, 0x0a, 0x11, 0x64, 0xf5, 0x60, 0xa5, 0x72, 0x7c, 0x2c, 0xf3,
-	0xfc, 0x95, 0x00, 0xb9, 0xa8, 0x7b, 0xee, 0x7a, 0x2c, 0xbb, 0xbc, 0x92, 0xf4, 0xfc, 0x10, 0x4a,
-	0x2c, 0xaa, 0x0f, 0x04, 0x58, 0x0e, 0xbf, 0x0d, 0x16, 0x63, 0x99, 0x66, 0xfd, 0x76, 0xf9, 0x99,
-	0xe0, 0x2c, 0x86, 0x77, 0x20, 0xdd, 0x77, 0xef, 0x42, 0x41, 0x86, 0x78, 0x8c, 0x54, 0x88, 0xc6,
-	0xf4, 0x6e, 0x58, 0xdf, 0x6d, 0x20, 0x70, 0xc3, 0xf6, 0xa3, 0x82, 0x37, 0x6c, 0xd8, 0xb1, 0x2d,
-	0x6e, 0x40, 0x8a, 0x1c, 0xd9, 0x99, 0x20, 0x2d, 0x47, 0x22, 0xe5, 0xc3, 0x24, 0xbd, 0x36, 0xc8,
-	0x5c, 0x0d, 0xb4, 0xe1, 0x48, 0x82, 0x6d, 0x70, 0xe7, 0xd0, 0x0d, 0x80, 0x9e, 0x23, 0x24, 0x1b,
-	0x84, 0xef, 0xca, 0xa5, 0x73, 0x83, 0xe5, 0x9e, 0x55, 0x69, 0xfc, 0x7d, 0xe7, 0xc5, 0xbd, 0x21,
-	0x3f, 0x78, 0x9c, 0x15, 0x1e, 0x3d, 0xce, 0x0a, 0x7f, 0x3c, 0xce, 0x0a, 0x5f, 0x3e, 0xc9, 0x8e,
-	0x3d, 0x7a, 0x92, 0x1d, 0xfb, 0xf5, 0x49, 0x76, 0xec, 0x6d, 0xf7, 0x58, 0xc2, 0xda, 0x1d, 0xd9,
-	0xb4, 0x4a, 0x07, 0xf4, 0x3c, 0xd9, 0x9d, 0x20, 0x97, 0xd4, 0xf5, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x59, 0x7d, 0x05, 0x2f, 0x53, 0x17, 0x00, 0x00,
+	// 1598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x70, 0xdb, 0xc4,
+	0x17, 0x8f, 0x6c, 0xe7, 0xc3, 0x2f, 0x89, 0x93, 0x28, 0x4e, 0xeb, 0xa8, 0x8d, 0xed, 0xaa, 0x6d,
+	0x92, 0x7a, 0x6a, 0xbb, 0x71, 0xda, 0xfe, 0xe7, 0x6f, 0x98, 0x61, 0x9a, 0x34, 0x30, 0x61, 0x30,
+	0x04, 0xb5, 0xa5, 0xc0, 0xc5, 0x28, 0xb1, 0x2a, 0x34, 0xb5, 0x2c, 0xe3, 0x95, 0xd3, 0xe4, 0xc6,
+	0xc7, 0x85, 0xaf, 0x19, 0x98, 0x81, 0xe1, 0x0c, 0x37, 0x6e, 0x94, 0x99, 0x1e, 0xb8, 0x71, 0x63,
+	0x0a, 0x5c, 0x3a, 0x9c, 0x18, 0x0e, 0x0c, 0xd3, 0x0e, 0xd3, 0x1b, 0x27, 0xee, 0x30, 0xda, 0x95,
+	0x56, 0x92, 0x25, 0x59, 0xaa, 0xc7, 0x05, 0x2e, 0x1e, 0x6b, 0xdf, 0x6f, 0xdf, 0x7b, 0xbf, 0xdf,
+	0x7b, 0xbb, 0xab, 0x15, 0x64, 0xf6, 0x34, 0xa4, 0x6a, 0xa8, 0x2c, 0x77, 0xb4, 0x6e, 0xbb, 0xbc,
+	0xbf, 0x56, 0xd6, 0x0f, 0x4a, 0xed, 0x8e, 0xa6, 0x6b, 0xec, 0x0c, 0xb1, 0x94, 0xb0, 0xa5, 0xb4,
+	0xbf, 0xc6, 0xa5, 0x65, 0x4d, 0xd6, 0xb0, 0xad, 0x6c, 0xfc, 0x23, 0x30, 0x6e, 0x91, 0xc0, 0xea,
+	0xc4, 0x60, 0xce, 0x31, 0x4d, 0xb2, 0xa6, 0xc9, 0x4d, 0xa9, 0x8c, 0x9f, 0x76, 0xbb, 0x37, 0xca,
+	0x62, 0xeb, 0xd0, 0x34, 0x1d, 0xf3, 0x84, 0x3d, 0x6c, 0x4b, 0xd6, 0xbc, 0xa3, 0xa6, 0x51, 0x45,
+	0xb2, 0x61, 0x52, 0x91, 0x6c, 0x1a, 0xe6, 0x44, 0x55, 0x69, 0x69, 0x65, 0xfc, 0x4b, 0x86, 0xf8,
+	0x1f, 0x19, 0x48, 0xd5, 0x90, 0xbc, 0xd9, 0x91, 0x44, 0x5d, 0x7a, 0xc6, 0xf0, 0xc6, 0x96, 0x60,
+	0x54, 0x6c, 0xa8, 0x4a, 0x2b, 0xc3, 0xe4, 0x99, 0xd5, 0xe4, 0x46, 0xe6, 0xa7, 0x3b, 0xc5, 0xb4,
+	0x99, 0xd7, 0xa5, 0x46, 0xa3, 0x23, 0x21, 0x74, 0x45, 0xef, 0x28, 0x2d, 0x59, 0x20, 0x30, 0x76,
+	0x13, 0xc6, 0x55, 0x49, 0xdd, 0x95, 0x3a, 0x28, 0x13, 0xcb, 0xc7, 0x57, 0x27, 0x2b, 0xd9, 0x52,
+	0x0f, 0xf5, 0x52, 0x0d, 0xdb, 0x05, 0xe9, 0x8d, 0xae, 0x84, 0xf4, 0x8d, 0xe4, 0xdd, 0x5f, 0x73,
+	0x23, 0x5f, 0x3e, 0xbc, 0x5d, 0x60, 0x04, 0x6b, 0x26, 0xcb, 0xc1, 0x84, 0x2a, 0xe9, 0x62, 0x43,
+	0xd4, 0xc5, 0x4c, 0xdc, 0x88, 0x2b, 0xd0, 0xe7, 0xea, 0xea, 0xdb, 0x0f, 0x6f, 0x17, 0x48, 0xb0,
+	0xf7, 0x1f, 0xde, 0x2e, 0x98, 0x8a, 0x15, 0x51, 0xe3, 0x66, 0xd9, 0x9d, 0x3a, 0xbf, 0x0e, 0x47,
+	0xdc, 0x23, 0x82, 0x84, 0xda, 0x5a, 0x0b, 0x49, 0xec, 0x22, 0x4c, 0xe0, 0x6c, 0xea, 0x4a, 0x03,
+	0xf3, 0x4a, 0x08, 0xe3, 0xf8, 0x79, 0xbb, 0xc1, 0xff, 0xce, 0xc0, 0x42, 0x0d, 0xc9, 0xd7, 0xda,
+	0x0d, 0x6b, 0x56, 0xcd, 0x4c, 0xea, 0x51, 0x95, 0x70, 0x06, 0x89, 0xb9, 0x82, 0xb0, 0x3b, 0x90,
+	0x22, 0x54, 0xeb, 0x5d, 0x1c, 0x07, 0x65, 0xe2, 0x8f, 0xaa, 0xd5, 0x34, 0x71, 0x40, 0xf2, 0x44,
+	0xd5, 0xb2, 0x5b, 0x95, 0xbc, 0x5b, 0x15, 0x2f, 0x1b, 0x3e, 0x07, 0x4b, 0xbe, 0x06, 0x4b, 0x23,
+	0xfe, 0x3b, 0x06, 0xe6, 0xdd, 0x88, 0x4b, 0x98, 0xd6, 0x10, 0x65, 0xb8, 0x00, 0xc9, 0x96, 0x74,
+	0xab, 0x4e, 0xdc, 0xc5, 0x43, 0xdc, 0x4d, 0xb4, 0xa4, 0x5b, 0x38, 0x83, 0x6a, 0xd1, 0xcd, 0x35,
+	0x1b, 0xc8, 0x15, 0xc3, 0xf9, 0x25, 0x38, 0xe6, 0x33, 0x4c, 0x79, 0x7e, 0xcd, 0xc0, 0x11, 0xb7,
+	0xbd, 0x66, 0xb6, 0xda, 0x30, 0xa9, 0xf6, 0xeb, 0xe8, 0x73, 0x6e, 0x3e, 0x27, 0xfa, 0xd4, 0x8e,
+	0xcc, 0xe0, 0xf3, 0x90, 0xf5, 0xb7, 0x50, 0x56, 0x9f, 0xc6, 0x20, 0xed, 0x6e, 0xfe, 0x1d, 0xad,
+	0xa9, 0xec, 0x1d, 0xfe, 0x43, 0x9c, 0x58, 0x11, 0x66, 0x1a, 0xd2, 0x9e, 0x82, 0x14, 0xad, 0x55,
+	0x6f, 0xe3, 0xc8, 0x99, 0x44, 0x9e, 0x59, 0x9d, 0xac, 0xa4, 0x4b, 0x64, 0x1f, 0x2b, 0x59, 0xfb,
+	0x58, 0xe9, 0x52, 0xeb, 0x70, 0x83, 0xff, 0xe1, 0x4e, 0x31, 0xdb, 0xdb, 0xfb, 0x97, 0x4d, 0x07,
+	0x24, 0x73, 0x21, 0xd5, 0x70, 0x3d, 0x57, 0x2b, 0xef, 0x7e, 0x9e, 0x1b, 0x71, 0x4b, 0x97, 0x0b,
+	0xdc, 0x0c, 0xc8, 0x1c, 0x5e, 0x80, 0xe3, 0x7e, 0xe3, 0x74, 0x63, 0xa8, 0xc0, 0xb8, 0x48, 0x54,
+	0x08, 0xd5, 0xc7, 0x02, 0xf2, 0xef, 0xc4, 0x60, 0xd1, 0x5d, 0x0d, 0xe2, 0x74, 0xb0, 0xe5, 0xf2,
+	0x2c, 0xa4, 0x89, 0xde, 0x44, 0xb5, 0xba, 0x95, 0x4e, 0x2c, 0x64, 0x3a, 0x2b, 0x3b, 0x23, 0x63,
+	0xcb, 0xa0, 0xeb, 0x6b, 0xdd, 0x2d, 0xea, 0xa9, 0xc0, 0x7e, 0x74, 0xf0, 0xe4, 0x4f, 0xc2, 0x89,
+	0x40, 0x23, 0xed, 0xca, 0x6f, 0xe2, 0x90, 0x71, 0xeb, 0x7f, 0x5d, 0xd1, 0x5f, 0x1f, 0xb0, 0x33,
+	0x87, 0x72, 0xd2, 0x9c, 0x86, 0x14, 0x91, 0xbb, 0xa7, 0x93, 0xa7, 0x65, 0xd7, 0x4e, 0x50, 0x81,
+	0x05, 0x57, 0x55, 0x28, 0x3a, 0x81, 0xd1, 0xf3, 0x0e, 0xf1, 0xe9, 0x9c, 0xb5, 0x9e, 0x39, 0x22,
+	0x32, 0x2b, 0x31, 0x9a, 0x67, 0x56, 0x27, 0xdc, 0x05, 0x43, 0xa4, 0x59, 0x7c, 0x56, 0xcd, 0xd8,
+	0x90, 0x57, 0xcd, 0x45, 0xef, 0xaa, 0x39, 0x19, 0xb8, 0x6a, 0xec, 0xea, 0xf0, 0xef, 0x31, 0x90,
+	0x0f, 0x32, 0x46, 0x38, 0x57, 0x87, 0xd9, 0xd7, 0xfc, 0xb7, 0x31, 0xe0, 0xfd, 0x9a, 0xcd, 0x4d,
+	0xfd, 0x5f, 0x5d, 0x7a, 0x3e, 0x95, 0x8c, 0x0f, 0xb9, 0x92, 0x55, 0x6f, 0x25, 0x57, 0x02, 0x97,
+	0xaa, 0xdb, 0x17, 0x7f, 0x16, 0x0a, 0xe1, 0x02, 0xd2, 0x65, 0xfb, 0x07, 0x03, 0xc7, 0xfd, 0xe0,
+	0x03, 0x1f, 0x94, 0xc3, 0x54, 0xba, 0xdf, 0xc9, 0x7a, 0x31, 0xaa, 0x3c, 0x6e, 0x3e, 0xfc, 0x32,
+	0x9c, 0xea, 0x67, 0xa7, 0xc2, 0x7c, 0x10, 0x87, 0xb9, 0x1a, 0x92, 0xaf, 0x74, 0x77, 0x55, 0x45,
+	0xdf, 0xe9, 0x68, 0x6d, 0x0d, 0x89, 0xcd, 0x40, 0x76, 0xcc, 0x00, 0xec, 0x8e, 0x43, 0xb2, 0x8d,
+	0xfd, 0x5a, 0xdb, 0x5c, 0x52, 0xb0, 0x07, 0xfa, 0x9e, 0xc0, 0xe7, 0x0c, 0x1b, 0x42, 0xa2, 0x2c,
+	0xa1, 0x4c, 0x22, 0x1f, 0x0f, 0x6a, 0x3d, 0x81, 0xa2, 0xd8, 0x33, 0x90, 0x90, 0x0e, 0xa4, 0x3d,
+	0xbc, 0x3f, 0xa5, 0x2a, 0x0b, 0x9e, 0xdd, 0x74, 0xeb, 0x40, 0xda, 0x13, 0x30, 0x84, 0x3d, 0x03,
+	0xa3, 0xba, 0xa2, 0x37, 0x25, 0xbc, 0x3d, 0x25, 0x37, 0xe6, 0x7f, 0xb9, 0x53, 0x9c, 0xb1, 0xc5,
+	0xcd, 0x9f, 0x2b, 0x9d, 0xff, 0x9f, 0x40, 0x10, 0x6c, 0x11, 0xc6, 0x51, 0x57, 0x55, 0xc5, 0xce,
+	0x61, 0x66, 0x3c, 0x18, 0x6c, 0x61, 0xaa, 0xff, 0xb7, 0xba, 0xda, 0xa6, 0x69, 0x94, 0x8e, 0x77,
+	0x94, 0x8e, 0x5c, 0x73, 0x3c, 0xba, 0xf3, 0x4f, 0xc2, 0xa2, 0x67, 0x90, 0x6e, 0x4d, 0x39, 0x98,
+	0x6c, 0x9b, 0x63, 0xf6, 0xee, 0x04, 0xd6, 0xd0, 0x76, 0x83, 0xff, 0x82, 0xbc, 0xef, 0x1a, 0xbb,
+	0x5a, 0xa3, 0x23, 0xde, 0xa2, 0xd5, 0x0c, 0x9b, 0xe8, 0x7c, 0x67, 0x88, 0x45, 0x7c, 0x67, 0xa8,
+	0x5e, 0x30, 0x18, 0x5a, 0x4f, 0xbd, 0x87, 0x2c, 0xe5, 0xd7, 0x9b, 0x8b, 0xf9, 0x2a, 0xdb, 0x3b,
+	0x4c, 0xdb, 0xf1, 0x2f, 0x06, 0xc6, 0x6b, 0x48, 0x7e, 0x49, 0xd3, 0xc3, 0xf9, 0x1a, 0x6b, 0x76,
+	0x5f, 0xd3, 0xa5, 0x4e, 0x68, 0xd2, 0x04, 0xc6, 0xae, 0xc3, 0x98, 0xd6, 0xd6, 0x15, 0x8d, 0xbc,
+	0x49, 0xa4, 0x2a, 0xc7, 0x3c, 0xfd, 0x61, 0xc4, 0x7d, 0x01, 0x43, 0x04, 0x13, 0xea, 0x6a, 0xd0,
+	0x44, 0x4f, 0x83, 0x46, 0x6f, 0xb7, 0xea, 0x0a, 0x5e, 0xc7, 0x38, 0x0f, 0x43, 0xac, 0x8c, 0x9f,
+	0x58, 0x46, 0x74, 0x7e, 0x0e, 0x66, 0xcc, 0xbf, 0x54, 0x94, 0xaf, 0x18, 0x98, 0x36, 0x0e, 0x2e,
+	0x4d, 0x55, 0x15, 0xfd, 0xf1, 0x48, 0x93, 0x05, 0xd8, 0xc3, 0xee, 0x55, 0xa9, 0xa5, 0x63, 0x79,
+	0xa6, 0x04, 0xc7, 0x88, 0x79, 0x61, 0xa1, 0xe9, 0x67, 0xfd, 0xd2, 0xb7, 0xf3, 0xe3, 0x8f, 0xc2,
+	0x82, 0x6b, 0x80, 0x52, 0xf9, 0x93, 0x50, 0x11, 0xa4, 0x7d, 0x49, 0x6c, 0xfe, 0x87, 0xaa, 0xcc,
+	0x42, 0x02, 0x89, 0x4d, 0x1d, 0x57, 0x78, 0x4a, 0xc0, 0xff, 0x5d, 0x95, 0x1f, 0xed, 0xd9, 0x96,
+	0xa3, 0xe8, 0x61, 0x93, 0x34, 0xf5, 0xb0, 0x07, 0xa8, 0x1e, 0x1f, 0x92, 0x7e, 0x37, 0x1a, 0x25,
+	0x5c, 0x89, 0xf3, 0x30, 0x61, 0xf4, 0x52, 0x57, 0xd7, 0xc2, 0xc5, 0xa0, 0xc8, 0x6a, 0xc1, 0x48,
+	0x95, 0x3e, 0x06, 0x36, 0x9f, 0x91, 0x02, 0x2f, 0xc0, 0x8c, 0xf9, 0x97, 0xee, 0x3a, 0x4f, 0xc1,
+	0x58, 0x47, 0x42, 0xdd, 0xa6, 0x8e, 0x43, 0xa6, 0x2a, 0x2b, 0x1e, 0x39, 0xad, 0x45, 0xbc, 0x65,
+	0x86, 0x10, 0x30, 0x5c, 0x30, 0xa7, 0xf1, 0x9f, 0x31, 0x30, 0x85, 0xc9, 0xeb, 0x9d, 0xc3, 0xc7,
+	0xc9, 0xb3, 0xec, 0xe1, 0xb9, 0xe4, 0x5f, 0x15, 0x33, 0x0f, 0xfe, 0x3a, 0xa4, 0x9d, 0xcf, 0x3e,
+	0x8c, 0x99, 0xc1, 0x18, 0x7f, 0x44, 0x9a, 0xfc, 0x39, 0x49, 0xdc, 0x37, 0x3f, 0x41, 0x0d, 0x70,
+	0x29, 0xeb, 0x73, 0x6d, 0x25, 0x54, 0x9d, 0x7b, 0xaf, 0x6f, 0xff, 0xd9, 0xf1, 0xcd, 0xfe, 0xb3,
+	0x07, 0x2c, 0xae, 0x85, 0x02, 0x24, 0x70, 0x4d, 0xd2, 0x30, 0xbb, 0xf5, 0xf2, 0xd6, 0x66, 0xfd,
+	0xda, 0xf3, 0x57, 0x76, 0xb6, 0x36, 0xb7, 0x9f, 0xde, 0xde, 0xba, 0x3c, 0x3b, 0xc2, 0x4e, 0xc1,
+	0x04, 0x1e, 0xbd, 0x2a, 0xbc, 0x32, 0xcb, 0x54, 0xbe, 0x9f, 0x86, 0x78, 0x0d, 0xc9, 0xec, 0x75,
+	0x98, 0x74, 0x7e, 0x5e, 0xcb, 0x79, 0xef, 0x2c, 0xae, 0x97, 0x6c, 0x6e, 0x25, 0x04, 0x40, 0x85,
+	0x6f, 0x02, 0xeb, 0xf3, 0xd1, 0x6a, 0xd9, 0x6f, 0xba, 0x17, 0xc7, 0x95, 0xa2, 0xe1, 0x68, 0xb4,
+	0x1b, 0x30, 0xeb, 0xf9, 0x32, 0x74, 0x2a, 0xc4, 0x07, 0x46, 0x71, 0x67, 0xa3, 0xa0, 0x68, 0x1c,
+	0x0d, 0xe6, 0xfd, 0xbe, 0xcc, 0xac, 0x84, 0xa6, 0x4b, 0x80, 0x5c, 0x39, 0x22, 0x90, 0x06, 0x54,
+	0x60, 0xce, 0xfb, 0xd1, 0xe4, 0x74, 0x48, 0x11, 0x08, 0x8c, 0x2b, 0x46, 0x82, 0xd1, 0x50, 0x5d,
+	0x58, 0xf0, 0xbf, 0x09, 0x9f, 0x09, 0xf1, 0x63, 0x43, 0xb9, 0xb5, 0xc8, 0x50, 0x1a, 0xf6, 0x00,
+	0x8e, 0x04, 0x7c, 0xab, 0x28, 0x84, 0x88, 0xe5, 0xc0, 0x72, 0x95, 0xe8, 0x58, 0x1a, 0xf9, 0x13,
+	0x06, 0x72, 0x61, 0x97, 0xb6, 0xf5, 0x48, 0x7e, 0xdd, 0x93, 0xb8, 0x27, 0x06, 0x98, 0x44, 0xb3,
+	0x7a, 0x8b, 0x81, 0xc5, 0xe0, 0xab, 0x4d, 0x31, 0x92, 0x6b, 0xda, 0x6f, 0x17, 0x1e, 0x09, 0x4e,
+	0x73, 0x78, 0x0d, 0x52, 0x3d, 0x97, 0x08, 0xde, 0xcf, 0x91, 0x1b, 0xc3, 0x15, 0xc2, 0x31, 0xce,
+	0x05, 0xeb, 0x79, 0xb5, 0xf5, 0x5d, 0xb0, 0xbd, 0x28, 0xee, 0x6c, 0x14, 0x14, 0x8d, 0xb3, 0x01,
+	0x09, 0xfc, 0x66, 0x92, 0xf1, 0x9b, 0x65, 0x58, 0xb8, 0x7c, 0x90, 0x85, 0xfa, 0xb8, 0x0a, 0xe0,
+	0x78, 0x5d, 0xcb, 0xfa, 0xb6, 0x38, 0xb5, 0x73, 0xcb, 0xfd, 0xed, 0x4e, 0xaf, 0x8e, 0x37, 0x27,
+	0x5f, 0xaf, 0xb6, 0x9d, 0x5b, 0xee, 0x6f, 0x77, 0xf2, 0xc5, 0x67, 0x80, 0x2f, 0x5f, 0xc3, 0xc2,
+	0xe5, 0x83, 0x2c, 0xd4, 0xc7, 0x8b, 0x90, 0xb4, 0x0f, 0xf8, 0x25, 0xff, 0xc0, 0xa6, 0x99, 0x3b,
+	0xdd, 0xd7, 0xec, 0x24, 0xeb, 0x38, 0x41, 0x7d, 0xc9, 0xda, 0x76, 0x6e, 0xb9, 0xbf, 0xdd, 0xf2,
+	0xca, 0x8d, 0xbe, 0x69, 0x7c, 0x3d, 0xdb, 0x28, 0xdd, 0xbd, 0x9f, 0x65, 0xee, 0xdd, 0xcf, 0x32,
+	0xbf, 0xdd, 0xcf, 0x32, 0x1f, 0x3f, 0xc8, 0x8e, 0xdc, 0x7b, 0x90, 0x1d, 0xf9, 0xf9, 0x41, 0x76,
+	0xe4, 0x55, 0xf3, 0x54, 0x46, 0x8d, 0x9b, 0x25, 0x45, 0x2b, 0x1f, 0x90, 0xe3, 0x74, 0x77, 0x0c,
+	0x5f, 0x38, 0xd7, 0xff, 0x1e, 0x00, 0x6d, 0x9f, 0x65, 0xde, 0x1f, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1630,8 +1967,17 @@ type MsgClient interface {
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// CommitVote allows a voter to commit to a hidden vote on a commit-reveal
+	// proposal during its voting period.
+	CommitVote(ctx context.Context, in *MsgCommitVote, opts ...grpc.CallOption) (*MsgCommitVoteResponse, error)
+	// RevealVote allows a voter to reveal the vote committed to with CommitVote
+	// during the reveal period of a commit-reveal proposal.
+	RevealVote(ctx context.Context, in *MsgRevealVote, opts ...grpc.CallOption) (*MsgRevealVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// RetryExec retries the execution of a proposal whose previous execution
+	// failed. The number of retries is bounded by the module's MaxExecRetries.
+	RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
 }
@@ -1752,6 +2098,24 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) CommitVote(ctx context.Context, in *MsgCommitVote, opts ...grpc.CallOption) (*MsgCommitVoteResponse, error) {
+	out := new(MsgCommitVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/CommitVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealVote(ctx context.Context, in *MsgRevealVote, opts ...grpc.CallOption) (*MsgRevealVoteResponse, error) {
+	out := new(MsgRevealVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/RevealVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
 	out := new(MsgExecResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/Exec", in, out, opts...)
@@ -1761,6 +2125,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) RetryExec(ctx context.Context, in *MsgRetryExec, opts ...grpc.CallOption) (*MsgRetryExecResponse, error) {
+	out := new(MsgRetryExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/RetryExec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
 	out := new(MsgLeaveGroupResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/LeaveGroup", in, out, opts...)
@@ -1796,8 +2169,17 @@ type MsgServer interface {
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// CommitVote allows a voter to commit to a hidden vote on a commit-reveal
+	// proposal during its voting period.
+	CommitVote(context.Context, *MsgCommitVote) (*MsgCommitVoteResponse, error)
+	// RevealVote allows a voter to reveal the vote committed to with CommitVote
+	// during the reveal period of a commit-reveal proposal.
+	RevealVote(context.Context, *MsgRevealVote) (*MsgRevealVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// RetryExec retries the execution of a proposal whose previous execution
+	// failed. The number of retries is bounded by the module's MaxExecRetries.
+	RetryExec(context.Context, *MsgRetryExec) (*MsgRetryExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
 }
@@ -1842,9 +2224,18 @@ func (*UnimplementedMsgServer) WithdrawProposal(ctx context.Context, req *MsgWit
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) CommitVote(ctx context.Context, req *MsgCommitVote) (*MsgCommitVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitVote not implemented")
+}
+func (*UnimplementedMsgServer) RevealVote(ctx context.Context, req *MsgRevealVote) (*MsgRevealVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealVote not implemented")
+}
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) RetryExec(ctx context.Context, req *MsgRetryExec) (*MsgRetryExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryExec not implemented")
+}
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
@@ -2069,55 +2460,109 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExec)
+func _Msg_CommitVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitVote)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Exec(ctx, in)
+		return srv.(MsgServer).CommitVote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.group.v1.Msg/Exec",
+		FullMethod: "/cosmos.group.v1.Msg/CommitVote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Exec(ctx, req.(*MsgExec))
+		return srv.(MsgServer).CommitVote(ctx, req.(*MsgCommitVote))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLeaveGroup)
+func _Msg_RevealVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealVote)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).LeaveGroup(ctx, in)
+		return srv.(MsgServer).RevealVote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.group.v1.Msg/LeaveGroup",
+		FullMethod: "/cosmos.group.v1.Msg/RevealVote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LeaveGroup(ctx, req.(*MsgLeaveGroup))
+		return srv.(MsgServer).RevealVote(ctx, req.(*MsgRevealVote))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.group.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateGroup",
-			Handler:    _Msg_CreateGroup_Handler,
-		},
-		{
-			MethodName: "UpdateGroupMembers",
-			Handler:    _Msg_UpdateGroupMembers_Handler,
-		},
+func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Exec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/Exec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Exec(ctx, req.(*MsgExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/RetryExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryExec(ctx, req.(*MsgRetryExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveGroup(ctx, req.(*MsgLeaveGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.group.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Msg_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroupMembers",
+			Handler:    _Msg_UpdateGroupMembers_Handler,
+		},
 		{
 			MethodName: "UpdateGroupAdmin",
 			Handler:    _Msg_UpdateGroupAdmin_Handler,
@@ -2158,10 +2603,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "CommitVote",
+			Handler:    _Msg_CommitVote_Handler,
+		},
+		{
+			MethodName: "RevealVote",
+			Handler:    _Msg_RevealVote_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "RetryExec",
+			Handler:    _Msg_RetryExec_Handler,
+		},
 		{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
@@ -3097,6 +3554,148 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCommitVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3160,6 +3759,69 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRetryExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3642,7 +4304,7 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgExec) Size() (n int) {
+func (m *MsgCommitVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3651,22 +4313,115 @@ func (m *MsgExec) Size() (n int) {
 	if m.ProposalId != 0 {
 		n += 1 + sovTx(uint64(m.ProposalId))
 	}
-	l = len(m.Executor)
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgExecResponse) Size() (n int) {
+func (m *MsgCommitVoteResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
-	}
+	return n
+}
+
+func (m *MsgRevealVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgRetryExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
 	return n
 }
 
@@ -6353,7 +7108,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6376,10 +7131,10 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6403,7 +7158,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6431,7 +7186,41 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executor = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6454,7 +7243,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6477,13 +7266,570 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
@@ -1,6 +1,8 @@
 package group
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -40,6 +42,10 @@ type DecisionPolicy interface {
 	// where we can execution a proposal. It can be set to 0 or to a value
 	// lesser than VotingPeriod to allow TRY_EXEC.
 	GetMinExecutionPeriod() time.Duration
+	// GetRevealPeriod returns the duration after the voting period end where
+	// committed votes can be revealed. A non-zero reveal period enables
+	// commit-reveal voting.
+	GetRevealPeriod() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
 	// based on its tally result, the group's total power and the time since
 	// the proposal was submitted.
@@ -49,12 +55,46 @@ type DecisionPolicy interface {
 	Validate(g GroupInfo, config Config) error
 }
 
+// VotingPowerDecisionPolicy is a DecisionPolicy which doesn't count the votes
+// of the group members by their weight, e.g. a quadratic policy counting them
+// by the square root of the weights. The votes are tallied with the voting
+// power of their voters, and Allow is called with the sum of the voting powers
+// of all the group members as total power.
+//
+// Custom decision policies are registered with RegisterDecisionPolicies.
+type VotingPowerDecisionPolicy interface {
+	DecisionPolicy
+
+	// VotingPower returns the voting power of a group member, as a
+	// non-negative decimal string.
+	VotingPower(member Member) (string, error)
+}
+
+// VotingPower returns the voting power of a group member under a decision
+// policy, which is its weight unless the policy is a VotingPowerDecisionPolicy.
+func VotingPower(policy DecisionPolicy, member Member) (string, error) {
+	vpPolicy, ok := policy.(VotingPowerDecisionPolicy)
+	if !ok {
+		return member.Weight, nil
+	}
+
+	power, err := vpPolicy.VotingPower(member)
+	if err != nil {
+		return "", err
+	}
+	if _, err := math.NewNonNegativeDecFromString(power); err != nil {
+		return "", errorsmod.Wrapf(err, "voting power of %s", member.Address)
+	}
+
+	return power, nil
+}
+
 // Implements DecisionPolicy Interface
 var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of ThresholdDecisionPolicy
@@ -67,6 +107,11 @@ func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetRevealPeriod returns the reveal period of ThresholdDecisionPolicy
+func (p ThresholdDecisionPolicy) GetRevealPeriod() time.Duration {
+	return p.Windows.RevealPeriod
+}
+
 // ValidateBasic does basic validation on ThresholdDecisionPolicy
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
@@ -77,6 +122,10 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
 	}
 
+	if p.Windows.RevealPeriod < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "reveal period cannot be negative")
+	}
+
 	return nil
 }
 
@@ -148,8 +197,8 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 		return errorsmod.Wrap(err, "group total weight")
 	}
 
-	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
-		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+p.Windows.RevealPeriod+config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + reveal_period + max_execution_period")
 	}
 	return nil
 }
@@ -159,7 +208,7 @@ var _ DecisionPolicy = &PercentageDecisionPolicy{}
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of PercentageDecisionPolicy
@@ -172,6 +221,11 @@ func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetRevealPeriod returns the reveal period of PercentageDecisionPolicy
+func (p PercentageDecisionPolicy) GetRevealPeriod() time.Duration {
+	return p.Windows.RevealPeriod
+}
+
 // ValidateBasic does basic validation on PercentageDecisionPolicy
 func (p PercentageDecisionPolicy) ValidateBasic() error {
 	percentage, err := math.NewPositiveDecFromString(p.Percentage)
@@ -186,13 +240,17 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be 0")
 	}
 
+	if p.Windows.RevealPeriod < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "reveal period cannot be negative")
+	}
+
 	return nil
 }
 
 // Validate validates the policy against the group.
 func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
-	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
-		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+p.Windows.RevealPeriod+config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + reveal_period + max_execution_period")
 	}
 	return nil
 }
@@ -429,6 +487,16 @@ func (g Proposal) ValidateBasic() error {
 	return nil
 }
 
+// FinalTallyTime returns the time at which the proposal is tallied, that is
+// the end of the reveal period for commit-reveal proposals, and the end of the
+// voting period otherwise.
+func (g Proposal) FinalTallyTime() time.Time {
+	if g.RevealPeriodEnd != nil {
+		return *g.RevealPeriodEnd
+	}
+	return g.VotingPeriodEnd
+}
+
 func (v Vote) PrimaryKeyFields(addressCodec address.Codec) ([]interface{}, error) {
 	addr, err := addressCodec.StringToBytes(v.Voter)
 	if err != nil {
@@ -449,7 +517,8 @@ func (v Vote) ValidateBasic() error {
 	if v.ProposalId == 0 {
 		return errorsmod.Wrap(errors.ErrEmpty, "voter ProposalId")
 	}
-	if v.Option == VOTE_OPTION_UNSPECIFIED {
+	// the option of a committed vote is unspecified until it is revealed.
+	if v.Option == VOTE_OPTION_UNSPECIFIED && len(v.Commitment) == 0 {
 		return errorsmod.Wrap(errors.ErrEmpty, "voter vote option")
 	}
 	if _, ok := VoteOption_name[int32(v.Option)]; !ok {
@@ -458,6 +527,20 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+// VoteCommitment returns the commitment of a vote on a commit-reveal proposal,
+// that is the SHA-256 hash of the big-endian proposal id, the voter address,
+// the big-endian vote option and the salt. The salt must be kept secret until
+// the vote is revealed and should be at least 32 random bytes, as the vote
+// options are otherwise easily brute-forced.
+func VoteCommitment(proposalID uint64, voter string, option VoteOption, salt []byte) []byte {
+	h := sha256.New()
+	h.Write(binary.BigEndian.AppendUint64(nil, proposalID))
+	h.Write([]byte(voter))
+	h.Write(binary.BigEndian.AppendUint32(nil, uint32(option)))
+	h.Write(salt)
+	return h.Sum(nil)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
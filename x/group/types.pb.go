@@ -435,6 +435,14 @@ type DecisionPolicyWindows struct {
 	// is empty, meaning that all proposals created with this decision policy
 	// won't be able to be executed.
 	MinExecutionPeriod time.Duration `protobuf:"bytes,2,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+	// reveal_period is the duration following the end of the voting period
+	// during which voters reveal the votes they committed to with MsgCommitVote.
+	// When set, proposals use commit-reveal voting: MsgVote is rejected, votes
+	// are hidden behind a hash commitment until the voting period ends, and the
+	// tally is deferred to the end of the reveal period. Commitments which are not
+	// revealed in time are counted as abstain.
+	// If not set, reveal_period defaults to 0 and commit-reveal voting is disabled.
+	RevealPeriod time.Duration `protobuf:"bytes,3,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period"`
 }
 
 func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
@@ -484,6 +492,13 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 	return 0
 }
 
+func (m *DecisionPolicyWindows) GetRevealPeriod() time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// id is the unique ID of the group.
@@ -734,6 +749,17 @@ type Proposal struct {
 	Title string `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
 	// summary is a short summary of the proposal
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// execution_failures records the reason of each failed execution of the
+	// proposal, in the order the executions were attempted.
+	ExecutionFailures []ProposalExecutionFailure `protobuf:"bytes,15,rep,name=execution_failures,json=executionFailures,proto3" json:"execution_failures"`
+	// exec_retries is the number of times the execution of the proposal has been
+	// retried after a failure. It is bounded by the module's MaxExecRetries.
+	ExecRetries uint64 `protobuf:"varint,16,opt,name=exec_retries,json=execRetries,proto3" json:"exec_retries,omitempty"`
+	// reveal_period_end is the timestamp before which the votes committed with
+	// MsgCommitVote must be revealed. It is only set for proposals submitted to a
+	// group policy with a commit-reveal decision policy, in which case tallying is
+	// done at this point instead of at `voting_period_end`.
+	RevealPeriodEnd *time.Time `protobuf:"bytes,17,opt,name=reveal_period_end,json=revealPeriodEnd,proto3,stdtime" json:"reveal_period_end,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -769,6 +795,90 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// ProposalExecutionFailure defines the reason of a failed proposal execution.
+type ProposalExecutionFailure struct {
+	// msg_index is the index of the proposal message whose execution failed.
+	// It is -1 when the execution failed before any message was run (e.g. the
+	// minimum execution period has not passed yet).
+	MsgIndex int64 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// codespace is the codespace of the error which made the execution fail.
+	Codespace string `protobuf:"bytes,2,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code is the ABCI code of the error which made the execution fail.
+	Code uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// log is the error message of the failed execution.
+	Log string `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
+	// time is the block time at which the execution failed.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *ProposalExecutionFailure) Reset()         { *m = ProposalExecutionFailure{} }
+func (m *ProposalExecutionFailure) String() string { return proto.CompactTextString(m) }
+func (*ProposalExecutionFailure) ProtoMessage()    {}
+func (*ProposalExecutionFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+}
+func (m *ProposalExecutionFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalExecutionFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalExecutionFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalExecutionFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExecutionFailure.Merge(m, src)
+}
+func (m *ProposalExecutionFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalExecutionFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExecutionFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalExecutionFailure proto.InternalMessageInfo
+
+func (m *ProposalExecutionFailure) GetMsgIndex() int64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *ProposalExecutionFailure) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *ProposalExecutionFailure) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ProposalExecutionFailure) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
+func (m *ProposalExecutionFailure) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // TallyResult represents the sum of weighted votes for each vote option.
 type TallyResult struct {
 	// yes_count is the weighted sum of yes votes.
@@ -785,7 +895,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,13 +937,17 @@ type Vote struct {
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// submit_time is the timestamp when the vote was submitted.
 	SubmitTime time.Time `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
+	// commitment is the hash commitment of a vote cast with MsgCommitVote on a
+	// commit-reveal proposal. The option of such a vote is unspecified until it
+	// is revealed with MsgRevealVote.
+	Commitment []byte `protobuf:"bytes,6,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,6 +1011,13 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+func (m *Vote) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -910,6 +1031,7 @@ func init() {
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
+	proto.RegisterType((*ProposalExecutionFailure)(nil), "cosmos.group.v1.ProposalExecutionFailure")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
 }
@@ -917,93 +1039,105 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0x3a, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x04, 0xc5, 0x6e, 0x53, 0x44, 0xa5, 0x9e, 0xb0, 0x9d, 0x2d, 0x75, 0xd4, 0xda, 0xd6,
-	0x7a, 0x9d, 0xd0, 0x5e, 0x56, 0x1b, 0xef, 0xd4, 0x59, 0xd5, 0xde, 0x31, 0xbb, 0xe3, 0xa4, 0xfe,
-	0x0f, 0x2a, 0x2e, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0x97, 0x1e, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0x84, 0x38, 0x00, 0x6a, 0x0f, 0xe5, 0xc4, 0x89, 0x2b, 0x12, 0xda, 0x99,
-	0x59, 0xc7, 0x1f, 0xb1, 0x4b, 0xaa, 0x8a, 0x4b, 0x94, 0x99, 0xe7, 0x79, 0xdf, 0x79, 0x9f, 0xf7,
-	0x6b, 0x13, 0x58, 0x6d, 0x10, 0xb7, 0x4d, 0xdc, 0x5c, 0xd3, 0x21, 0xdd, 0x4e, 0xee, 0xf0, 0x72,
-	0x8e, 0xf6, 0x3a, 0xd8, 0xcd, 0x76, 0x1c, 0x42, 0x09, 0x4a, 0x70, 0x30, 0xcb, 0xc0, 0xec, 0xe1,
-	0xe5, 0x95, 0xc5, 0x26, 0x69, 0x12, 0x86, 0xe5, 0xbc, 0xdf, 0x38, 0x6d, 0x25, 0xd5, 0x24, 0xa4,
-	0xd9, 0xc2, 0x39, 0x76, 0xda, 0xef, 0xde, 0xcd, 0x99, 0x5d, 0xc7, 0xa0, 0x16, 0xb1, 0x05, 0x9e,
-	0x1e, 0xc5, 0xa9, 0xd5, 0xc6, 0x2e, 0x35, 0xda, 0x1d, 0x41, 0x58, 0xe6, 0xef, 0xe8, 0xdc, 0xb3,
-	0x78, 0x54, 0x40, 0xa3, 0xb6, 0x86, 0xdd, 0x13, 0xd0, 0x19, 0xa3, 0x6d, 0xd9, 0x24, 0xc7, 0x7e,
-	0xf2, 0xab, 0xcc, 0x37, 0x12, 0x84, 0x6e, 0xe1, 0xf6, 0x3e, 0x76, 0xd0, 0x16, 0x84, 0x0d, 0xd3,
-	0x74, 0xb0, 0xeb, 0xca, 0xd2, 0x9a, 0xb4, 0x1e, 0x2d, 0xc8, 0x3f, 0x3d, 0xdd, 0x5c, 0x14, 0xbe,
-	0xf3, 0x1c, 0xa9, 0x51, 0xc7, 0xb2, 0x9b, 0xaa, 0x4f, 0x44, 0xe7, 0x20, 0x74, 0x84, 0xad, 0xe6,
-	0x01, 0x95, 0x03, 0x9e, 0x89, 0x2a, 0x4e, 0x68, 0x05, 0x22, 0x6d, 0x4c, 0x0d, 0xd3, 0xa0, 0x86,
-	0x3c, 0xcb, 0x90, 0xfe, 0x19, 0x6d, 0x43, 0xc4, 0x30, 0x4d, 0x6c, 0xea, 0x06, 0x95, 0x83, 0x6b,
-	0xd2, 0x7a, 0x6c, 0x6b, 0x25, 0xcb, 0x63, 0xce, 0xfa, 0x31, 0x67, 0x35, 0x5f, 0x6f, 0x61, 0xe1,
-	0xd9, 0xaf, 0xe9, 0x99, 0x87, 0xbf, 0xa5, 0xa5, 0xc7, 0xaf, 0x9e, 0x6c, 0x48, 0xec, 0x65, 0x6c,
-	0xe6, 0x69, 0xe6, 0x08, 0x16, 0x78, 0xdc, 0x2a, 0xfe, 0xac, 0x8b, 0x5d, 0xfa, 0x5f, 0x85, 0x9f,
-	0xf9, 0x5e, 0x82, 0x25, 0xed, 0xc0, 0xc1, 0xee, 0x01, 0x69, 0x99, 0xdb, 0xb8, 0x61, 0xb9, 0x16,
-	0xb1, 0xab, 0xa4, 0x65, 0x35, 0x7a, 0xe8, 0x3c, 0x44, 0xa9, 0x0f, 0xf1, 0x28, 0xd4, 0xe3, 0x0b,
-	0xf4, 0x31, 0x84, 0x8f, 0x2c, 0xdb, 0x24, 0x47, 0x2e, 0x7b, 0x2e, 0xb6, 0xf5, 0x6e, 0x76, 0xa4,
-	0x5d, 0xb2, 0xc3, 0xfe, 0xf6, 0x38, 0x5b, 0xf5, 0xcd, 0xae, 0x95, 0x7e, 0x78, 0xba, 0x99, 0x9a,
-	0x6e, 0xf3, 0xf9, 0xab, 0x27, 0x1b, 0x19, 0x4e, 0xd9, 0x74, 0xcd, 0x7b, 0xb9, 0x09, 0xa1, 0x66,
-	0x9e, 0x49, 0x20, 0x57, 0xb1, 0xd3, 0xc0, 0x36, 0x35, 0x9a, 0x78, 0x44, 0x47, 0x0a, 0xa0, 0xd3,
-	0xc7, 0x84, 0x90, 0x81, 0x9b, 0xb7, 0xa0, 0x64, 0xe7, 0xdf, 0x29, 0xb9, 0x30, 0xa0, 0x64, 0x52,
-	0xb4, 0x99, 0xef, 0x24, 0xf8, 0xdf, 0x89, 0xcf, 0xa1, 0x5b, 0xb0, 0x70, 0x48, 0xa8, 0x65, 0x37,
-	0xf5, 0x0e, 0x76, 0x2c, 0xc2, 0x6b, 0x12, 0xdb, 0x5a, 0x1e, 0xeb, 0xb7, 0x6d, 0x31, 0x7f, 0xbc,
-	0xdd, 0xbe, 0xec, 0xb7, 0xdb, 0x3c, 0x37, 0xaf, 0x32, 0x6b, 0x74, 0x07, 0x16, 0xdb, 0x96, 0xad,
-	0xe3, 0xfb, 0xb8, 0xd1, 0xf5, 0xd8, 0xbe, 0xd7, 0xc0, 0x29, 0xbd, 0xa2, 0xb6, 0x65, 0x2b, 0xbe,
-	0x13, 0xee, 0x3b, 0xf3, 0xa7, 0x04, 0xd1, 0x4f, 0xbc, 0x44, 0x94, 0xec, 0xbb, 0x04, 0xc5, 0x21,
-	0x60, 0xf1, 0x68, 0x83, 0x6a, 0xc0, 0x32, 0x51, 0x16, 0xe6, 0x0c, 0xb3, 0x6d, 0xd9, 0xbc, 0x4f,
-	0xa7, 0xb4, 0x36, 0xa7, 0x4d, 0x9d, 0x3f, 0x19, 0xc2, 0x87, 0xd8, 0xf1, 0x92, 0xc5, 0xc6, 0x2f,
-	0xa8, 0xfa, 0x47, 0xf4, 0x0e, 0xcc, 0x53, 0x42, 0x8d, 0x96, 0x2e, 0x86, 0x62, 0x8e, 0x59, 0xc6,
-	0xd8, 0xdd, 0x1e, 0x9f, 0x8c, 0x1b, 0x00, 0x0d, 0x07, 0x1b, 0x94, 0x8f, 0x6f, 0xe8, 0xb4, 0xe3,
-	0x1b, 0x15, 0xc6, 0x79, 0x9a, 0xb9, 0x0d, 0x31, 0xa6, 0x57, 0x6c, 0x9f, 0x65, 0x88, 0xb0, 0x3e,
-	0xd0, 0xfb, 0xba, 0xc3, 0xec, 0x5c, 0x32, 0x51, 0x0e, 0x42, 0x6d, 0x46, 0x12, 0x89, 0x5e, 0x1a,
-	0x6b, 0x36, 0xb1, 0x09, 0x04, 0x2d, 0xf3, 0x77, 0x00, 0x12, 0xcc, 0x37, 0xef, 0x06, 0x96, 0xd1,
-	0x37, 0x59, 0x0f, 0x83, 0x31, 0x05, 0x86, 0x63, 0xea, 0x17, 0x64, 0xf6, 0xf4, 0x05, 0x09, 0x4e,
-	0x2e, 0xc8, 0xdc, 0x70, 0x41, 0x0c, 0x48, 0x98, 0xa2, 0xb1, 0xf5, 0x0e, 0xd3, 0x22, 0x52, 0xbe,
-	0x38, 0x96, 0xf2, 0xbc, 0xdd, 0x2b, 0x64, 0x5e, 0x3f, 0x54, 0x6a, 0xdc, 0x1c, 0x1e, 0xf5, 0xe1,
-	0x82, 0x86, 0xdf, 0xbc, 0xa0, 0xd7, 0x22, 0x0f, 0x1e, 0xa5, 0x67, 0xfe, 0x78, 0x94, 0x96, 0x32,
-	0x8f, 0x43, 0x10, 0xa9, 0x3a, 0xa4, 0x43, 0x5c, 0xa3, 0x35, 0xd6, 0xca, 0x3b, 0xb0, 0xc8, 0x93,
-	0xca, 0x05, 0xe9, 0x7e, 0x55, 0x5e, 0xd7, 0xd9, 0xa8, 0x79, 0x5c, 0x51, 0x81, 0x4c, 0x6d, 0xf3,
-	0x8f, 0x20, 0xda, 0x61, 0x31, 0x60, 0xc7, 0x95, 0x83, 0x6b, 0xb3, 0x53, 0x9d, 0x1f, 0x53, 0xd1,
-	0x0e, 0xc4, 0xdc, 0xee, 0x7e, 0xdb, 0xa2, 0xba, 0xf7, 0xd1, 0x65, 0x15, 0x39, 0x55, 0x46, 0x80,
-	0x5b, 0x7b, 0x38, 0xba, 0x00, 0x0b, 0x5c, 0xab, 0x5f, 0xdf, 0x10, 0x4b, 0xc3, 0x3c, 0xbb, 0xdc,
-	0x15, 0x45, 0xbe, 0x34, 0x92, 0x10, 0x9f, 0x1b, 0x66, 0xdc, 0x41, 0xd9, 0xbe, 0xc5, 0x55, 0x08,
-	0xb9, 0xd4, 0xa0, 0x5d, 0x57, 0x8e, 0xac, 0x49, 0xeb, 0xf1, 0xad, 0xf4, 0xd8, 0x40, 0xf8, 0xd9,
-	0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0e, 0xe8, 0xae, 0x65, 0x1b, 0x2d, 0x9d, 0x1a, 0xad, 0x56,
-	0x4f, 0x77, 0xb0, 0xdb, 0x6d, 0x51, 0x39, 0xca, 0x24, 0x9e, 0x1f, 0x73, 0xa2, 0x79, 0x24, 0x95,
-	0x71, 0x0a, 0x51, 0x4f, 0x24, 0x17, 0x98, 0x64, 0x2e, 0x06, 0x40, 0x54, 0x87, 0x33, 0x43, 0x6b,
-	0x56, 0xc7, 0xb6, 0x29, 0xc3, 0x69, 0x13, 0x97, 0x18, 0xdc, 0xb5, 0x8a, 0x6d, 0xa2, 0x2a, 0x24,
-	0xf8, 0xaa, 0x25, 0x8e, 0x1f, 0x6a, 0x8c, 0xe9, 0x7d, 0x6f, 0xa2, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x1c, 0x0f, 0x9d, 0xd1, 0x25, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x62, 0x57, 0x9e, 0x5f, 0x9b,
-	0x9d, 0x34, 0x48, 0x6a, 0x9f, 0x85, 0xde, 0x87, 0x39, 0x6a, 0xd1, 0x16, 0x96, 0x17, 0x58, 0x7b,
-	0x9e, 0xfd, 0xe5, 0xe9, 0x66, 0xe2, 0xf8, 0x9b, 0xb4, 0x76, 0x29, 0xfb, 0xe1, 0x55, 0x95, 0x33,
-	0xd0, 0x26, 0x84, 0xdd, 0x6e, 0xbb, 0x6d, 0x38, 0x3d, 0x39, 0x3e, 0x99, 0xec, 0x73, 0xae, 0x05,
-	0xbd, 0x71, 0xc9, 0x7c, 0x25, 0x41, 0x6c, 0x30, 0x95, 0xab, 0x10, 0xed, 0x61, 0x57, 0x6f, 0x90,
-	0xae, 0x4d, 0xc5, 0x87, 0x37, 0xd2, 0xc3, 0x6e, 0xd1, 0x3b, 0x7b, 0xed, 0x64, 0xec, 0xbb, 0xd4,
-	0xb0, 0x6c, 0x41, 0xe0, 0x7f, 0xb5, 0xcc, 0x8b, 0x4b, 0x4e, 0x5a, 0x86, 0x88, 0x4d, 0x04, 0xce,
-	0x67, 0x22, 0x6c, 0x13, 0x0e, 0x7d, 0x00, 0xc8, 0x26, 0xfa, 0x91, 0x45, 0x0f, 0xf4, 0x43, 0x4c,
-	0x7d, 0x12, 0x5f, 0x47, 0x09, 0x9b, 0xec, 0x59, 0xf4, 0x60, 0x17, 0x53, 0x4e, 0x16, 0xf1, 0xfd,
-	0x25, 0x41, 0x70, 0x97, 0x50, 0x8c, 0xd2, 0x10, 0xeb, 0x88, 0x24, 0x1f, 0xaf, 0x68, 0xf0, 0xaf,
-	0xf8, 0x46, 0x3c, 0x24, 0x54, 0x2c, 0xe9, 0xa9, 0x1b, 0x91, 0xd1, 0xd0, 0x15, 0x08, 0x91, 0x8e,
-	0xf7, 0x01, 0x64, 0x51, 0xc6, 0xb7, 0x56, 0xc7, 0x8a, 0xea, 0xbd, 0x5b, 0x61, 0x14, 0x55, 0x50,
-	0xa7, 0xae, 0xd1, 0xb7, 0x38, 0xb8, 0x1b, 0x5f, 0x48, 0x00, 0xc7, 0xcf, 0xa3, 0x55, 0x58, 0xda,
-	0xad, 0x68, 0x8a, 0x5e, 0xa9, 0x6a, 0xa5, 0x4a, 0x59, 0xaf, 0x97, 0x6b, 0x55, 0xa5, 0x58, 0xba,
-	0x5e, 0x52, 0xb6, 0x93, 0x33, 0xe8, 0x2c, 0x24, 0x06, 0xc1, 0xdb, 0x4a, 0x2d, 0x29, 0xa1, 0x25,
-	0x38, 0x3b, 0x78, 0x99, 0x2f, 0xd4, 0xb4, 0x7c, 0xa9, 0x9c, 0x0c, 0x20, 0x04, 0xf1, 0x41, 0xa0,
-	0x5c, 0x49, 0xce, 0xa2, 0xf3, 0x20, 0x0f, 0xdf, 0xe9, 0x7b, 0x25, 0xed, 0x86, 0xbe, 0xab, 0x68,
-	0x95, 0x64, 0x70, 0x25, 0xf8, 0xe0, 0xeb, 0xd4, 0xcc, 0xc6, 0x8f, 0x12, 0xc4, 0x87, 0xa7, 0x1a,
-	0xa5, 0x61, 0xb5, 0xaa, 0x56, 0xaa, 0x95, 0x5a, 0xfe, 0xa6, 0x5e, 0xd3, 0xf2, 0x5a, 0xbd, 0x36,
-	0x12, 0xd9, 0xff, 0x61, 0x79, 0x94, 0x50, 0xab, 0x17, 0x6e, 0x95, 0x34, 0x4d, 0xd9, 0x4e, 0x4a,
-	0xde, 0xb3, 0xa3, 0x70, 0xbe, 0x58, 0x54, 0xaa, 0x1e, 0x1a, 0x38, 0x09, 0x55, 0x95, 0x1d, 0xa5,
-	0xe8, 0xa1, 0xb3, 0x5e, 0x46, 0xc6, 0x6c, 0x0b, 0x15, 0xd5, 0x03, 0x83, 0x27, 0xbd, 0xeb, 0x09,
-	0xda, 0x56, 0xf3, 0x7b, 0xe5, 0xe4, 0x9c, 0x10, 0xf4, 0xad, 0x04, 0xe7, 0x4e, 0x1e, 0x5b, 0xb4,
-	0x0e, 0x17, 0xfb, 0xf6, 0xca, 0xa7, 0x4a, 0xb1, 0xae, 0x55, 0x54, 0x5d, 0x55, 0x6a, 0xf5, 0x9b,
-	0xda, 0x88, 0xc2, 0x8b, 0xb0, 0x36, 0x91, 0x59, 0xae, 0x68, 0xba, 0x5a, 0x2f, 0x27, 0xa5, 0xa9,
-	0xac, 0x5a, 0xbd, 0x58, 0x54, 0x6a, 0xb5, 0x64, 0x60, 0x2a, 0xeb, 0x7a, 0xbe, 0x74, 0xb3, 0xae,
-	0x2a, 0xc9, 0x59, 0x1e, 0x7c, 0x21, 0xfb, 0xec, 0x45, 0x4a, 0x7a, 0xfe, 0x22, 0x25, 0xfd, 0xfe,
-	0x22, 0x25, 0x3d, 0x7c, 0x99, 0x9a, 0x79, 0xfe, 0x32, 0x35, 0xf3, 0xf3, 0xcb, 0xd4, 0xcc, 0x1d,
-	0xd1, 0xf3, 0xae, 0x79, 0x2f, 0x6b, 0x91, 0xdc, 0x7d, 0xfe, 0x6f, 0xe2, 0x7e, 0x88, 0xb5, 0xdf,
-	0x95, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x6e, 0xa9, 0xde, 0x3d, 0x0e, 0x00, 0x00,
+	// 1554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xbd, 0x6f, 0x1b, 0xc9,
+	0x15, 0xd7, 0x92, 0x14, 0x3f, 0x1e, 0x25, 0x92, 0x1a, 0x2b, 0xd6, 0x4a, 0x72, 0x48, 0x85, 0x36,
+	0x12, 0x59, 0x81, 0x48, 0x5b, 0x0e, 0x62, 0xc0, 0x40, 0x80, 0x90, 0xd4, 0x3a, 0xa6, 0x20, 0x8b,
+	0xc4, 0x92, 0x94, 0x62, 0x17, 0x59, 0xac, 0xb8, 0x23, 0x6a, 0x61, 0xee, 0x0e, 0xb3, 0x3b, 0x94,
+	0xcc, 0xff, 0xc0, 0x08, 0x02, 0xc4, 0x45, 0x8a, 0x34, 0x01, 0x0c, 0xa4, 0x49, 0xe9, 0xc2, 0x48,
+	0xe1, 0x3a, 0x85, 0x91, 0x22, 0x30, 0xae, 0x3a, 0x5c, 0x71, 0x77, 0xb0, 0x0b, 0x5f, 0x75, 0xfd,
+	0x15, 0x07, 0x1c, 0x76, 0x66, 0x56, 0xfc, 0x12, 0xa9, 0x93, 0x61, 0x5c, 0x23, 0x70, 0xde, 0xfb,
+	0xbd, 0x37, 0xef, 0xf7, 0xbe, 0x66, 0x21, 0x58, 0x6d, 0x12, 0xd7, 0x22, 0x6e, 0xbe, 0xe5, 0x90,
+	0x6e, 0x27, 0x7f, 0x72, 0x3b, 0x4f, 0x7b, 0x1d, 0xec, 0xe6, 0x3a, 0x0e, 0xa1, 0x04, 0x25, 0xb9,
+	0x32, 0xc7, 0x94, 0xb9, 0x93, 0xdb, 0x2b, 0x8b, 0x2d, 0xd2, 0x22, 0x4c, 0x97, 0xf7, 0x7e, 0x71,
+	0xd8, 0x4a, 0xba, 0x45, 0x48, 0xab, 0x8d, 0xf3, 0xec, 0x74, 0xd8, 0x3d, 0xca, 0x1b, 0x5d, 0x47,
+	0xa7, 0x26, 0xb1, 0x85, 0x3e, 0x33, 0xaa, 0xa7, 0xa6, 0x85, 0x5d, 0xaa, 0x5b, 0x1d, 0x01, 0x58,
+	0xe6, 0xf7, 0x68, 0xdc, 0xb3, 0xb8, 0x54, 0xa8, 0x46, 0x6d, 0x75, 0xbb, 0x27, 0x54, 0x0b, 0xba,
+	0x65, 0xda, 0x24, 0xcf, 0xfe, 0x72, 0x51, 0xf6, 0x3f, 0x12, 0x84, 0x1f, 0x62, 0xeb, 0x10, 0x3b,
+	0x68, 0x0b, 0x22, 0xba, 0x61, 0x38, 0xd8, 0x75, 0x65, 0x69, 0x4d, 0x5a, 0x8f, 0x15, 0xe5, 0xcf,
+	0x5e, 0x6d, 0x2e, 0x0a, 0xdf, 0x05, 0xae, 0xa9, 0x51, 0xc7, 0xb4, 0x5b, 0xaa, 0x0f, 0x44, 0x57,
+	0x21, 0x7c, 0x8a, 0xcd, 0xd6, 0x31, 0x95, 0x03, 0x9e, 0x89, 0x2a, 0x4e, 0x68, 0x05, 0xa2, 0x16,
+	0xa6, 0xba, 0xa1, 0x53, 0x5d, 0x0e, 0x32, 0xcd, 0xd9, 0x19, 0x6d, 0x43, 0x54, 0x37, 0x0c, 0x6c,
+	0x68, 0x3a, 0x95, 0x43, 0x6b, 0xd2, 0x7a, 0x7c, 0x6b, 0x25, 0xc7, 0x63, 0xce, 0xf9, 0x31, 0xe7,
+	0xea, 0x3e, 0xdf, 0xe2, 0xfc, 0x9b, 0x2f, 0x33, 0x33, 0xcf, 0xbf, 0xca, 0x48, 0xff, 0xfe, 0xf0,
+	0x72, 0x43, 0x62, 0x37, 0x63, 0xa3, 0x40, 0xb3, 0xa7, 0x30, 0xcf, 0xe3, 0x56, 0xf1, 0x9f, 0xbb,
+	0xd8, 0xa5, 0x3f, 0x55, 0xf8, 0xd9, 0xff, 0x4a, 0xb0, 0x54, 0x3f, 0x76, 0xb0, 0x7b, 0x4c, 0xda,
+	0xc6, 0x36, 0x6e, 0x9a, 0xae, 0x49, 0xec, 0x2a, 0x69, 0x9b, 0xcd, 0x1e, 0xba, 0x06, 0x31, 0xea,
+	0xab, 0x78, 0x14, 0x6a, 0x5f, 0x80, 0x7e, 0x0f, 0x91, 0x53, 0xd3, 0x36, 0xc8, 0xa9, 0xcb, 0xae,
+	0x8b, 0x6f, 0xfd, 0x32, 0x37, 0xd2, 0x2e, 0xb9, 0x61, 0x7f, 0x07, 0x1c, 0xad, 0xfa, 0x66, 0xf7,
+	0xca, 0xff, 0x7b, 0xb5, 0x99, 0x9e, 0x6e, 0xf3, 0x97, 0x0f, 0x2f, 0x37, 0xb2, 0x1c, 0xb2, 0xe9,
+	0x1a, 0x4f, 0xf2, 0x13, 0x42, 0xcd, 0xbe, 0x91, 0x40, 0xae, 0x62, 0xa7, 0x89, 0x6d, 0xaa, 0xb7,
+	0xf0, 0x08, 0x8f, 0x34, 0x40, 0xe7, 0x4c, 0x27, 0x88, 0x0c, 0x48, 0x3e, 0x01, 0x93, 0x9d, 0x1f,
+	0xc7, 0xe4, 0xfa, 0x00, 0x93, 0x49, 0xd1, 0x66, 0xff, 0x1a, 0x80, 0x9f, 0x9d, 0x7b, 0x1d, 0x7a,
+	0x08, 0xf3, 0x27, 0x84, 0x9a, 0x76, 0x4b, 0xeb, 0x60, 0xc7, 0x24, 0xbc, 0x26, 0xf1, 0xad, 0xe5,
+	0xb1, 0x7e, 0xdb, 0x16, 0xf3, 0xc7, 0xdb, 0xed, 0x1f, 0x67, 0xed, 0x36, 0xc7, 0xcd, 0xab, 0xcc,
+	0x1a, 0x3d, 0x86, 0x45, 0xcb, 0xb4, 0x35, 0xfc, 0x14, 0x37, 0xbb, 0x1e, 0xda, 0xf7, 0x1a, 0xb8,
+	0xa4, 0x57, 0x64, 0x99, 0xb6, 0xe2, 0x3b, 0x11, 0xbe, 0x1f, 0xc0, 0xbc, 0x83, 0x4f, 0xb0, 0xde,
+	0xf6, 0x9d, 0x06, 0x2f, 0x72, 0x1a, 0xf5, 0x9d, 0xaa, 0x73, 0xdc, 0x92, 0x7b, 0xca, 0x7e, 0x2b,
+	0x41, 0xec, 0x0f, 0x5e, 0x4a, 0xcb, 0xf6, 0x11, 0x41, 0x09, 0x08, 0x98, 0x9c, 0x77, 0x48, 0x0d,
+	0x98, 0x06, 0xca, 0xc1, 0xac, 0x6e, 0x58, 0xa6, 0x2d, 0x07, 0x2e, 0x18, 0x12, 0x0e, 0x9b, 0x3a,
+	0xc9, 0x32, 0x44, 0x4e, 0xb0, 0xe3, 0xa5, 0x9d, 0x0d, 0x72, 0x48, 0xf5, 0x8f, 0xe8, 0x17, 0x30,
+	0x47, 0x09, 0xd5, 0xdb, 0x9a, 0x18, 0xaf, 0x59, 0x66, 0x19, 0x67, 0xb2, 0x03, 0x3e, 0x63, 0x0f,
+	0x00, 0x9a, 0x0e, 0xd6, 0x29, 0x5f, 0x04, 0xe1, 0xcb, 0x2e, 0x82, 0x98, 0x30, 0x2e, 0xd0, 0xec,
+	0x23, 0x88, 0x33, 0xbe, 0x62, 0x8f, 0x2d, 0x43, 0x94, 0x75, 0x94, 0x76, 0xc6, 0x3b, 0xc2, 0xce,
+	0x65, 0x03, 0xe5, 0x21, 0x6c, 0x31, 0x90, 0x28, 0xd9, 0xd2, 0x58, 0xdb, 0x8a, 0x9d, 0x22, 0x60,
+	0xd9, 0xef, 0x03, 0x90, 0x64, 0xbe, 0x79, 0x5f, 0xb1, 0x8c, 0x7e, 0xcc, 0xa2, 0x19, 0x8c, 0x29,
+	0x30, 0x1c, 0xd3, 0x59, 0x41, 0x82, 0x97, 0x2f, 0x48, 0x68, 0x72, 0x41, 0x66, 0x87, 0x0b, 0xa2,
+	0x43, 0xd2, 0x10, 0x23, 0xa2, 0x75, 0x18, 0x17, 0x91, 0xf2, 0xc5, 0xb1, 0x94, 0x17, 0xec, 0x5e,
+	0x31, 0x7b, 0xf1, 0x78, 0xaa, 0x09, 0x63, 0xe8, 0x3c, 0x52, 0xd0, 0xc8, 0xc7, 0x17, 0xf4, 0x5e,
+	0xf4, 0xd9, 0x8b, 0xcc, 0xcc, 0x37, 0x2f, 0x32, 0x52, 0xf6, 0xbb, 0x08, 0x44, 0xab, 0x0e, 0xe9,
+	0x10, 0x57, 0x6f, 0x8f, 0xb5, 0xf2, 0x0e, 0x2c, 0xf2, 0xa4, 0x72, 0x42, 0x9a, 0x5f, 0x95, 0x8b,
+	0x3a, 0x1b, 0xb5, 0xfa, 0x15, 0x15, 0x9a, 0xa9, 0x6d, 0xfe, 0x5b, 0x88, 0x75, 0x58, 0x0c, 0xd8,
+	0x71, 0xe5, 0xd0, 0x5a, 0x70, 0xaa, 0xf3, 0x3e, 0x14, 0xed, 0x40, 0xdc, 0xed, 0x1e, 0x5a, 0x26,
+	0xd5, 0xbc, 0xe7, 0x5b, 0x9e, 0xbd, 0x6c, 0x46, 0x80, 0x5b, 0x7b, 0x7a, 0x74, 0x1d, 0xe6, 0x39,
+	0x57, 0xbf, 0xbe, 0x61, 0x96, 0x86, 0x39, 0x26, 0xdc, 0x17, 0x45, 0xbe, 0x35, 0x92, 0x10, 0x1f,
+	0x1b, 0x61, 0xd8, 0x41, 0xda, 0xbe, 0xc5, 0x5d, 0x08, 0xbb, 0x54, 0xa7, 0x5d, 0x57, 0x8e, 0xae,
+	0x49, 0xeb, 0x89, 0xad, 0xcc, 0xd8, 0x40, 0xf8, 0xd9, 0xaf, 0x31, 0x98, 0x2a, 0xe0, 0xa8, 0x01,
+	0xe8, 0xc8, 0xb4, 0xf5, 0xb6, 0x46, 0xf5, 0x76, 0xbb, 0xa7, 0x39, 0xd8, 0xed, 0xb6, 0xa9, 0x1c,
+	0x63, 0x14, 0xaf, 0x8d, 0x39, 0xa9, 0x7b, 0x20, 0x95, 0x61, 0x8a, 0x31, 0x8f, 0x24, 0x27, 0x98,
+	0x62, 0x2e, 0x06, 0x94, 0xa8, 0x01, 0x0b, 0x43, 0x0b, 0x5b, 0xc3, 0xb6, 0x21, 0xc3, 0x65, 0x13,
+	0x97, 0x1c, 0xdc, 0xda, 0x8a, 0x6d, 0xa0, 0x2a, 0x24, 0xf9, 0xd2, 0x26, 0x8e, 0x1f, 0x6a, 0x9c,
+	0xf1, 0xfd, 0xd5, 0x44, 0xbe, 0x8a, 0xc0, 0xf3, 0xc0, 0xd4, 0x04, 0x1e, 0x3a, 0xa3, 0x5b, 0x5e,
+	0xbf, 0xb8, 0xae, 0xde, 0xc2, 0xae, 0x3c, 0xb7, 0x16, 0x9c, 0x34, 0x48, 0xea, 0x19, 0x0a, 0xdd,
+	0x84, 0x59, 0x6a, 0xd2, 0x36, 0x96, 0xe7, 0x59, 0x7b, 0x5e, 0xf9, 0xe2, 0xd5, 0x66, 0xb2, 0xff,
+	0xba, 0xad, 0xdd, 0xca, 0xfd, 0xe6, 0xae, 0xca, 0x11, 0x68, 0x13, 0x22, 0x6e, 0xd7, 0xb2, 0x74,
+	0xa7, 0x27, 0x27, 0x26, 0x83, 0x7d, 0x0c, 0xfa, 0x13, 0xa0, 0xfe, 0x93, 0x74, 0xa4, 0x9b, 0xed,
+	0xae, 0x83, 0x5d, 0x39, 0xc9, 0xa2, 0xba, 0x79, 0x01, 0x41, 0x93, 0xd8, 0xf7, 0xb9, 0x45, 0x31,
+	0xe4, 0x25, 0x51, 0x5d, 0xc0, 0x23, 0x72, 0xd7, 0x5b, 0xe6, 0x9e, 0x50, 0x73, 0x30, 0x75, 0x4c,
+	0xec, 0xca, 0x29, 0xd6, 0x4e, 0x71, 0x4f, 0xa6, 0x72, 0x11, 0xda, 0x85, 0x85, 0xa1, 0xd7, 0x8b,
+	0xd5, 0x6d, 0xe1, 0xc2, 0xba, 0x85, 0xbc, 0x9a, 0xa9, 0xc9, 0xc1, 0xe7, 0x4b, 0xb1, 0x8d, 0x7b,
+	0x21, 0x6f, 0xfe, 0xb3, 0xaf, 0xbd, 0x2f, 0x94, 0x09, 0xc1, 0xa2, 0x55, 0x88, 0x59, 0x6e, 0x4b,
+	0x33, 0x6d, 0x03, 0x3f, 0x65, 0x2b, 0x21, 0xa8, 0x46, 0x2d, 0xb7, 0x55, 0xf6, 0xce, 0xde, 0x67,
+	0x58, 0x93, 0x18, 0xd8, 0xed, 0xe8, 0x4d, 0x2c, 0xbe, 0xec, 0xfa, 0x02, 0x84, 0x20, 0xe4, 0x1d,
+	0xd8, 0x98, 0xcf, 0xab, 0xec, 0x37, 0x4a, 0x41, 0xb0, 0x4d, 0x5a, 0x62, 0x9f, 0x7a, 0x3f, 0xd1,
+	0xef, 0x20, 0xf4, 0x71, 0x53, 0xcb, 0xcc, 0xb2, 0xff, 0x94, 0x20, 0x3e, 0xd8, 0xd8, 0xab, 0x10,
+	0xeb, 0x61, 0x57, 0x6b, 0x92, 0xae, 0x4d, 0xc5, 0x07, 0x55, 0xb4, 0x87, 0xdd, 0x92, 0x77, 0xf6,
+	0x86, 0x5b, 0x3f, 0x74, 0xa9, 0x6e, 0xda, 0x02, 0xc0, 0x63, 0x9e, 0x13, 0x42, 0x0e, 0x5a, 0x86,
+	0xa8, 0x4d, 0x84, 0x9e, 0x6f, 0xa8, 0x88, 0x4d, 0xb8, 0xea, 0xd7, 0x80, 0x6c, 0xa2, 0x9d, 0x9a,
+	0xf4, 0x58, 0x3b, 0xc1, 0xd4, 0x07, 0x71, 0x32, 0x49, 0x9b, 0x1c, 0x98, 0xf4, 0x78, 0x1f, 0x53,
+	0x0e, 0x16, 0xc9, 0xfd, 0x7b, 0x00, 0x42, 0xfb, 0x84, 0x62, 0x94, 0x81, 0x78, 0x47, 0x24, 0xb9,
+	0xff, 0x60, 0x82, 0x2f, 0xe2, 0xef, 0xd3, 0x09, 0xa1, 0xe2, 0xc9, 0x9c, 0xfa, 0x3e, 0x31, 0x18,
+	0xba, 0x03, 0x61, 0xd2, 0xf1, 0x4a, 0xc5, 0xa2, 0x4c, 0x6c, 0xad, 0x8e, 0x75, 0xa0, 0x77, 0x6f,
+	0x85, 0x41, 0x54, 0x01, 0x9d, 0xfa, 0xa8, 0x7d, 0xca, 0x35, 0x9a, 0x06, 0x68, 0x12, 0xcb, 0x32,
+	0xa9, 0x85, 0x6d, 0xfe, 0xd1, 0x31, 0xa7, 0x0e, 0x48, 0x36, 0xfe, 0x26, 0x01, 0xf4, 0xc3, 0x43,
+	0xab, 0xb0, 0xb4, 0x5f, 0xa9, 0x2b, 0x5a, 0xa5, 0x5a, 0x2f, 0x57, 0xf6, 0xb4, 0xc6, 0x5e, 0xad,
+	0xaa, 0x94, 0xca, 0xf7, 0xcb, 0xca, 0x76, 0x6a, 0x06, 0x5d, 0x81, 0xe4, 0xa0, 0xf2, 0x91, 0x52,
+	0x4b, 0x49, 0x68, 0x09, 0xae, 0x0c, 0x0a, 0x0b, 0xc5, 0x5a, 0xbd, 0x50, 0xde, 0x4b, 0x05, 0x10,
+	0x82, 0xc4, 0xa0, 0x62, 0xaf, 0x92, 0x0a, 0xa2, 0x6b, 0x20, 0x0f, 0xcb, 0xb4, 0x83, 0x72, 0xfd,
+	0x81, 0xb6, 0xaf, 0xd4, 0x2b, 0xa9, 0xd0, 0x4a, 0xe8, 0xd9, 0xbf, 0xd2, 0x33, 0x1b, 0xff, 0x97,
+	0x20, 0x31, 0xbc, 0x83, 0x51, 0x06, 0x56, 0xab, 0x6a, 0xa5, 0x5a, 0xa9, 0x15, 0x76, 0xb5, 0x5a,
+	0xbd, 0x50, 0x6f, 0xd4, 0x46, 0x22, 0xfb, 0x39, 0x2c, 0x8f, 0x02, 0x6a, 0x8d, 0xe2, 0xc3, 0x72,
+	0xbd, 0xae, 0x6c, 0xa7, 0x24, 0xef, 0xda, 0x51, 0x75, 0xa1, 0x54, 0x52, 0xaa, 0x9e, 0x36, 0x70,
+	0x9e, 0x56, 0x55, 0x76, 0x94, 0x92, 0xa7, 0x0d, 0x7a, 0x19, 0x19, 0xb3, 0x2d, 0x56, 0x54, 0x4f,
+	0x19, 0x3a, 0xef, 0x5e, 0x8f, 0xd0, 0xb6, 0x5a, 0x38, 0xd8, 0x4b, 0xcd, 0x0a, 0x42, 0xaf, 0x25,
+	0xb8, 0x7a, 0xfe, 0x92, 0x45, 0xeb, 0x70, 0xe3, 0xcc, 0x5e, 0xf9, 0xa3, 0x52, 0x6a, 0xd4, 0x2b,
+	0xaa, 0xa6, 0x2a, 0xb5, 0xc6, 0x6e, 0x7d, 0x84, 0xe1, 0x0d, 0x58, 0x9b, 0x88, 0xdc, 0xab, 0xd4,
+	0x35, 0xb5, 0xb1, 0x97, 0x92, 0xa6, 0xa2, 0x6a, 0x8d, 0x52, 0x49, 0xa9, 0xd5, 0x52, 0x81, 0xa9,
+	0xa8, 0xfb, 0x85, 0xf2, 0x6e, 0x43, 0x55, 0x52, 0x41, 0x1e, 0x7c, 0x31, 0xf7, 0xe6, 0x5d, 0x5a,
+	0x7a, 0xfb, 0x2e, 0x2d, 0x7d, 0xfd, 0x2e, 0x2d, 0x3d, 0x7f, 0x9f, 0x9e, 0x79, 0xfb, 0x3e, 0x3d,
+	0xf3, 0xf9, 0xfb, 0xf4, 0xcc, 0x63, 0x31, 0x13, 0xae, 0xf1, 0x24, 0x67, 0x92, 0xfc, 0x53, 0xfe,
+	0xef, 0x81, 0xc3, 0x30, 0x6b, 0xcf, 0x3b, 0x3f, 0x0c, 0x00, 0x14, 0x70, 0xaf, 0x06, 0x35, 0x10,
+	0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1248,21 +1382,29 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RevealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintTypes(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1287,12 +1429,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1389,12 +1531,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1463,6 +1605,39 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RevealPeriodEnd != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RevealPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RevealPeriodEnd):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTypes(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ExecRetries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ExecutionFailures) > 0 {
+		for iNdEx := len(m.ExecutionFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1496,12 +1671,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1529,12 +1704,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1568,6 +1743,61 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalExecutionFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalExecutionFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExecutionFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Code != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1639,12 +1869,19 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1773,6 +2010,8 @@ func (m *DecisionPolicyWindows) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod)
 	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1909,6 +2148,44 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.ExecutionFailures) > 0 {
+		for _, e := range m.ExecutionFailures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExecRetries != 0 {
+		n += 2 + sovTypes(uint64(m.ExecRetries))
+	}
+	if m.RevealPeriodEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RevealPeriodEnd)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalExecutionFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovTypes(uint64(m.MsgIndex))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovTypes(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1959,6 +2236,10 @@ func (m *Vote) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime)
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2624,6 +2905,39 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3637,6 +3951,280 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFailures = append(m.ExecutionFailures, ProposalExecutionFailure{})
+			if err := m.ExecutionFailures[len(m.ExecutionFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecRetries", wireType)
+			}
+			m.ExecRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriodEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevealPeriodEnd == nil {
+				m.RevealPeriodEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RevealPeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalExecutionFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalExecutionFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalExecutionFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4000,6 +4588,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package group_test
 
 import (
+	"errors"
+	"math"
+	"strconv"
 	"testing"
 	"time"
 
@@ -386,3 +389,73 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+// quadraticDecisionPolicy is a percentage decision policy counting the votes by
+// the square root of the weights of the members.
+type quadraticDecisionPolicy struct {
+	*group.PercentageDecisionPolicy
+}
+
+func (p quadraticDecisionPolicy) VotingPower(member group.Member) (string, error) {
+	weight, err := strconv.ParseFloat(member.Weight, 64)
+	if err != nil {
+		return "", err
+	}
+	if member.Metadata == "banned" {
+		return "", errors.New("banned member")
+	}
+	if member.Metadata == "negative" {
+		return "-1", nil
+	}
+
+	return strconv.FormatFloat(math.Sqrt(weight), 'f', -1, 64), nil
+}
+
+func TestVotingPower(t *testing.T) {
+	percentagePolicy := group.NewPercentageDecisionPolicy("0.5", time.Second, 0).(*group.PercentageDecisionPolicy)
+	quadraticPolicy := quadraticDecisionPolicy{percentagePolicy}
+
+	testCases := []struct {
+		name     string
+		policy   group.DecisionPolicy
+		member   group.Member
+		expPower string
+		expErr   string
+	}{
+		{
+			name:     "weight of the member",
+			policy:   percentagePolicy,
+			member:   group.Member{Weight: "9"},
+			expPower: "9",
+		},
+		{
+			name:     "voting power of the policy",
+			policy:   quadraticPolicy,
+			member:   group.Member{Weight: "9"},
+			expPower: "3",
+		},
+		{
+			name:   "voting power error",
+			policy: quadraticPolicy,
+			member: group.Member{Weight: "9", Metadata: "banned"},
+			expErr: "banned member",
+		},
+		{
+			name:   "negative voting power",
+			policy: quadraticPolicy,
+			member: group.Member{Weight: "9", Metadata: "negative"},
+			expErr: "expected a non-negative decimal",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			power, err := group.VotingPower(tc.policy, tc.member)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expPower, power)
+		})
+	}
+}
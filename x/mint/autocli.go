@@ -29,6 +29,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "annual-provisions",
 					Short:     "Query the current minting annual provisions value",
 				},
+				{
+					RpcMethod: "PauseStatus",
+					Use:       "pause-status",
+					Short:     "Query whether minting is paused and who last set it",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -43,6 +48,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "SetPaused",
+					Use:            "set-paused-proposal <paused>",
+					Short:          "Submit a proposal to pause or resume minting",
+					Example:        fmt.Sprintf(`%s tx mint set-paused-proposal true`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "paused"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
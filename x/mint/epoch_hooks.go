@@ -10,6 +10,15 @@ var _ epochstypes.EpochHooks = AppModule{}
 
 // BeforeEpochStart calls the mint function.
 func (am AppModule) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	status, err := am.keeper.GetPauseStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	if status.Paused {
+		return nil
+	}
+
 	minter, err := am.keeper.Minter.Get(ctx)
 	if err != nil {
 		return err
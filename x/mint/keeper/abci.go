@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -13,6 +15,21 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyBeginBlocker)
 
+	status, err := k.GetPauseStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	// no tokens are minted while paused, an event is emitted instead so that
+	// the pause can't go unnoticed.
+	if status.Paused {
+		return k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeMintPaused,
+			event.NewAttribute(types.AttributeKeyPausedBy, status.SetBy),
+			event.NewAttribute(types.AttributeKeyPausedAtHeight, strconv.FormatInt(status.SetAtHeight, 10)),
+		)
+	}
+
 	// fetch stored minter & params
 	minter, err := k.Minter.Get(ctx)
 	if err != nil {
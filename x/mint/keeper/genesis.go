@@ -16,6 +16,10 @@ func (keeper Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, da
 		return err
 	}
 
+	if err := keeper.PauseStatus.Set(ctx, data.PauseStatus); err != nil {
+		return err
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 
 	return nil
@@ -33,5 +37,13 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 		return nil, err
 	}
 
-	return types.NewGenesisState(minter, params), nil
+	pauseStatus, err := keeper.GetPauseStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := types.NewGenesisState(minter, params)
+	genesis.PauseStatus = pauseStatus
+
+	return genesis, nil
 }
@@ -78,6 +78,7 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 		uint64(60*60*8766/5),
 		math.ZeroInt(),
 	)
+	genesisState.PauseStatus = types.PauseStatus{Paused: true, SetBy: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", SetAtHeight: 5}
 
 	err := s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
 	s.NoError(err)
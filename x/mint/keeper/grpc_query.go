@@ -45,3 +45,13 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// PauseStatus returns whether minting is paused and who last set it.
+func (q queryServer) PauseStatus(ctx context.Context, _ *types.QueryPauseStatusRequest) (*types.QueryPauseStatusResponse, error) {
+	status, err := q.k.GetPauseStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPauseStatusResponse{PauseStatus: status}, nil
+}
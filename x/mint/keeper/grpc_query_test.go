@@ -79,6 +79,16 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	annualProvisions, err := suite.queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(annualProvisions.AnnualProvisions, minter.AnnualProvisions)
+
+	pauseStatus, err := suite.queryClient.PauseStatus(gocontext.Background(), &types.QueryPauseStatusRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.PauseStatus{}, pauseStatus.PauseStatus)
+
+	status := types.PauseStatus{Paused: true, SetBy: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", SetAtHeight: 5}
+	suite.Require().NoError(suite.mintKeeper.PauseStatus.Set(suite.ctx, status))
+	pauseStatus, err = suite.queryClient.PauseStatus(gocontext.Background(), &types.QueryPauseStatusRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(status, pauseStatus.PauseStatus)
 }
 
 func TestMintTestSuite(t *testing.T) {
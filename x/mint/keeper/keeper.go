@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -29,6 +30,8 @@ type Keeper struct {
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 	Minter collections.Item[types.Minter]
+	// PauseStatus defines whether minting is paused, it is set by the authority.
+	PauseStatus collections.Item[types.PauseStatus]
 
 	// mintFn is used to mint new coins during BeginBlock. This function is in charge of
 	// minting new coins based on arbitrary logic, previously done through InflationCalculationFn.
@@ -58,6 +61,7 @@ func NewKeeper(
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Minter:           collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		PauseStatus:      collections.NewItem(sb, types.PauseStatusKey, "pause_status", codec.CollValue[types.PauseStatus](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -81,6 +85,16 @@ func (k *Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// GetPauseStatus returns whether minting is paused and who last set it.
+func (k *Keeper) GetPauseStatus(ctx context.Context) (types.PauseStatus, error) {
+	status, err := k.PauseStatus.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PauseStatus{}, nil
+	}
+
+	return status, err
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k *Keeper) MintCoins(ctx context.Context, newCoins sdk.Coins) error {
@@ -172,6 +186,18 @@ func DefaultMintFn(ic types.InflationCalculationFn, staking types.StakingKeeper,
 			return err
 		}
 
+		// the typed event holds the minted tokens and the minting state, so that indexers
+		// track the minting history without it being kept in state
+		if err := env.EventService.EventManager(ctx).Emit(&types.EventMint{
+			Denom:            params.MintDenom,
+			Amount:           mintedCoins.AmountOf(params.MintDenom),
+			Inflation:        minter.Inflation,
+			AnnualProvisions: minter.AnnualProvisions,
+			BondedRatio:      bondedRatio,
+		}); err != nil {
+			return err
+		}
+
 		if mintedCoin.Amount.IsInt64() {
 			defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
 		}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -150,6 +152,82 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.Equal(newMinter, unchangedMinter)
 }
 
+func (s *KeeperTestSuite) TestMintEvent() {
+	s.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewIntFromUint64(100000000000), nil).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(15, 2), nil).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+	s.NoError(s.mintKeeper.SetMintFn(keeper.DefaultMintFn(types.DefaultInflationCalculationFn, s.stakingKeeper, s.mintKeeper)))
+
+	s.NoError(s.mintKeeper.BeginBlocker(s.ctx))
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+
+	var mintEvents []*types.EventMint
+	for _, e := range s.ctx.EventManager().Events() {
+		if e.Type != "cosmos.mint.v1beta1.EventMint" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		s.Require().NoError(err)
+		mintEvents = append(mintEvents, msg.(*types.EventMint))
+	}
+	s.Require().Len(mintEvents, 1)
+	s.Equal("stake", mintEvents[0].Denom)
+	s.Equal(math.NewInt(792), mintEvents[0].Amount)
+	s.Equal(minter.Inflation, mintEvents[0].Inflation)
+	s.Equal(minter.AnnualProvisions, mintEvents[0].AnnualProvisions)
+	s.Equal(math.LegacyNewDecWithPrec(15, 2), mintEvents[0].BondedRatio)
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerPaused() {
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: 10})
+	err := s.mintKeeper.SetMintFn(keeper.DefaultMintFn(types.DefaultInflationCalculationFn, s.stakingKeeper, s.mintKeeper))
+	s.NoError(err)
+
+	_, err = s.msgServer.SetPaused(s.ctx, &types.MsgSetPaused{Authority: govModuleNameStr, Paused: true})
+	s.NoError(err)
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+
+	// no staking or bank calls are expected while paused
+	err = s.mintKeeper.BeginBlocker(s.ctx)
+	s.NoError(err)
+
+	unchangedMinter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+	s.Equal(minter, unchangedMinter)
+
+	events := s.ctx.EventManager().Events()
+	s.Require().NotEmpty(events)
+	pausedEvent := events[len(events)-1]
+	s.Equal(types.EventTypeMintPaused, pausedEvent.Type)
+	pausedBy, found := pausedEvent.GetAttribute(types.AttributeKeyPausedBy)
+	s.True(found)
+	s.Equal(govModuleNameStr, pausedBy.Value)
+	pausedAtHeight, found := pausedEvent.GetAttribute(types.AttributeKeyPausedAtHeight)
+	s.True(found)
+	s.Equal("10", pausedAtHeight.Value)
+
+	// minting resumes once unpaused
+	_, err = s.msgServer.SetPaused(s.ctx, &types.MsgSetPaused{Authority: govModuleNameStr, Paused: false})
+	s.NoError(err)
+
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewIntFromUint64(100000000000), nil)
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(15, 2), nil)
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+
+	err = s.mintKeeper.BeginBlocker(s.ctx)
+	s.NoError(err)
+
+	newMinter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.NoError(err)
+	s.NotEqual(minter, newMinter)
+}
+
 func (s *KeeperTestSuite) TestMigrator() {
 	m := keeper.NewMigrator(s.mintKeeper)
 	s.NoError(m.Migrate1to2(s.ctx)) // just to get the coverage up
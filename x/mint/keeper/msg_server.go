@@ -37,3 +37,23 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetPaused pauses or resumes minting.
+func (ms msgServer) SetPaused(ctx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	status := types.PauseStatus{
+		Paused:      msg.Paused,
+		SetBy:       msg.Authority,
+		SetAtHeight: ms.HeaderService.HeaderInfo(ctx).Height,
+	}
+	if err := ms.PauseStatus.Set(ctx, status); err != nil {
+		return nil, err
+	}
+
+	ms.Logger.Info("minting pause status updated", "paused", status.Paused, "set_by", status.SetBy)
+
+	return &types.MsgSetPausedResponse{}, nil
+}
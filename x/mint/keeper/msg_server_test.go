@@ -71,3 +71,56 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSetPaused() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgSetPaused
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &types.MsgSetPaused{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+				Paused:    true,
+			},
+			expectErr: true,
+		},
+		{
+			name: "pause minting",
+			request: &types.MsgSetPaused{
+				Authority: s.mintKeeper.GetAuthority(),
+				Paused:    true,
+			},
+			expectErr: false,
+		},
+		{
+			name: "resume minting",
+			request: &types.MsgSetPaused{
+				Authority: s.mintKeeper.GetAuthority(),
+				Paused:    false,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			before, err := s.mintKeeper.GetPauseStatus(s.ctx)
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.SetPaused(s.ctx, tc.request)
+			status, statusErr := s.mintKeeper.GetPauseStatus(s.ctx)
+			s.Require().NoError(statusErr)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Equal(before, status)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.request.Paused, status.Paused)
+				s.Require().Equal(tc.request.Authority, status.SetBy)
+				s.Require().Equal(s.ctx.HeaderInfo().Height, status.SetAtHeight)
+			}
+		})
+	}
+}
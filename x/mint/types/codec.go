@@ -12,6 +12,7 @@ import (
 func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(Params{}, "cosmos-sdk/x/mint/Params")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/mint/MsgUpdateParams")
+	legacy.RegisterAminoMsg(registrar, &MsgSetPaused{}, "cosmos-sdk/x/mint/MsgSetPaused")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -19,6 +20,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations(
 		(*coretransaction.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetPaused{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
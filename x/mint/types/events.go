@@ -2,9 +2,12 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint       = ModuleName
+	EventTypeMintPaused = "mint_paused"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyPausedBy         = "paused_by"
+	AttributeKeyPausedAtHeight   = "paused_at_height"
 )
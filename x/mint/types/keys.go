@@ -6,6 +6,8 @@ var (
 	// MinterKey is the key to use for the keeper store.
 	MinterKey = collections.NewPrefix(0)
 	ParamsKey = collections.NewPrefix(1)
+	// PauseStatusKey is the key of the minting pause status.
+	PauseStatusKey = collections.NewPrefix(2)
 )
 
 const (
@@ -143,46 +143,117 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+// PauseStatus defines whether minting is paused and who last set it.
+type PauseStatus struct {
+	// paused defines whether minting is paused.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// set_by is the address of the authority that last set the pause status.
+	SetBy string `protobuf:"bytes,2,opt,name=set_by,json=setBy,proto3" json:"set_by,omitempty"`
+	// set_at_height is the block height at which the pause status was last set.
+	SetAtHeight int64 `protobuf:"varint,3,opt,name=set_at_height,json=setAtHeight,proto3" json:"set_at_height,omitempty"`
+}
+
+func (m *PauseStatus) Reset()         { *m = PauseStatus{} }
+func (m *PauseStatus) String() string { return proto.CompactTextString(m) }
+func (*PauseStatus) ProtoMessage()    {}
+func (*PauseStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *PauseStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseStatus.Merge(m, src)
+}
+func (m *PauseStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseStatus proto.InternalMessageInfo
+
+func (m *PauseStatus) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *PauseStatus) GetSetBy() string {
+	if m != nil {
+		return m.SetBy
+	}
+	return ""
+}
+
+func (m *PauseStatus) GetSetAtHeight() int64 {
+	if m != nil {
+		return m.SetAtHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*PauseStatus)(nil), "cosmos.mint.v1beta1.PauseStatus")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x10, 0x82, 0x72, 0xb4, 0x82, 0x5e, 0xa9, 0xe4, 0x16, 0xd5, 0x8d, 0x3a, 0xa0,
-	0xa8, 0xa8, 0xb1, 0xa2, 0x4a, 0x0c, 0x8c, 0x21, 0x4b, 0x11, 0x15, 0x91, 0x19, 0x10, 0x20, 0x61,
-	0xbd, 0xb6, 0xaf, 0xee, 0x11, 0xdf, 0x9d, 0xe5, 0xbb, 0x54, 0xf6, 0x57, 0x60, 0xe2, 0x63, 0x30,
-	0x76, 0x60, 0xe1, 0x1b, 0x74, 0x41, 0xaa, 0x98, 0x10, 0x43, 0x85, 0x92, 0xa1, 0x5f, 0x03, 0xf9,
-	0xce, 0xa4, 0xfc, 0x99, 0x08, 0x5d, 0xac, 0xbb, 0xf7, 0x79, 0xdf, 0xdf, 0xf3, 0xd8, 0xf2, 0x8b,
-	0xdd, 0x48, 0x2a, 0x2e, 0x95, 0xc7, 0x99, 0xd0, 0xde, 0x71, 0x3f, 0xa4, 0x1a, 0xfa, 0xe6, 0xd2,
-	0xcb, 0x72, 0xa9, 0x25, 0x59, 0xb5, 0x7a, 0xcf, 0x94, 0x6a, 0x7d, 0xe3, 0x6e, 0x22, 0x13, 0x69,
-	0x74, 0xaf, 0x3a, 0xd9, 0xd6, 0x8d, 0x75, 0xdb, 0x1a, 0x58, 0xa1, 0x9e, 0xb3, 0xd2, 0x0a, 0x70,
-	0x26, 0xa4, 0x67, 0x9e, 0x3f, 0xbb, 0x13, 0x29, 0x93, 0x94, 0x7a, 0xe6, 0x16, 0x4e, 0x0e, 0x3d,
-	0x10, 0xa5, 0x95, 0xb6, 0x3f, 0x23, 0xdc, 0x3a, 0x60, 0x42, 0xd3, 0x9c, 0x3c, 0xc3, 0x6d, 0x26,
-	0x0e, 0x53, 0xd0, 0x4c, 0x0a, 0x07, 0x75, 0x50, 0xb7, 0x3d, 0xe8, 0x9f, 0x9e, 0x6f, 0x35, 0xbe,
-	0x9d, 0x6f, 0xdd, 0xb3, 0x0e, 0x2a, 0x1e, 0xf7, 0x98, 0xf4, 0x38, 0xe8, 0xa3, 0xde, 0x53, 0x9a,
-	0x40, 0x54, 0x0e, 0x69, 0xf4, 0xe5, 0xe3, 0x2e, 0xae, 0x03, 0x0c, 0x69, 0xe4, 0x5f, 0x32, 0xc8,
-	0x1b, 0xbc, 0x02, 0x42, 0x4c, 0x20, 0xad, 0x62, 0x1e, 0x33, 0xc5, 0xa4, 0x50, 0xce, 0xb5, 0x45,
-	0xc1, 0x77, 0x2c, 0x6b, 0x34, 0x47, 0x11, 0x82, 0x9b, 0x31, 0x68, 0x70, 0xae, 0x77, 0x50, 0x77,
-	0xc9, 0x37, 0xe7, 0xed, 0x4f, 0x4d, 0xdc, 0x1a, 0x41, 0x0e, 0x5c, 0x91, 0x4d, 0x8c, 0xab, 0x2f,
-	0x19, 0xc4, 0x54, 0x48, 0x6e, 0x5f, 0xc8, 0x6f, 0x57, 0x95, 0x61, 0x55, 0x20, 0x6f, 0xf1, 0xda,
-	0x3c, 0x6a, 0x90, 0x83, 0xa6, 0x41, 0x74, 0x04, 0x22, 0xa1, 0x75, 0xc2, 0x87, 0xff, 0x9c, 0xf0,
-	0xc3, 0xc5, 0xc9, 0x0e, 0xf2, 0x57, 0xe7, 0x50, 0x1f, 0x34, 0x7d, 0x6c, 0x90, 0xe4, 0x35, 0x5e,
-	0xbe, 0xf4, 0xe2, 0x50, 0x98, 0xc8, 0x8b, 0x7b, 0x2c, 0xcd, 0x61, 0x07, 0x50, 0xfc, 0x01, 0x67,
-	0xc2, 0x69, 0x5e, 0x15, 0x9c, 0x09, 0xf2, 0x02, 0xdf, 0x4a, 0x24, 0xa4, 0x41, 0x28, 0x45, 0x4c,
-	0x63, 0xe7, 0xc6, 0x7f, 0xa1, 0x71, 0x85, 0x1a, 0x18, 0x12, 0xb9, 0x8f, 0x6f, 0x87, 0xa9, 0x8c,
-	0xc6, 0x2a, 0xc8, 0x68, 0x1e, 0x94, 0x14, 0x72, 0xa7, 0xd5, 0x41, 0xdd, 0xa6, 0xbf, 0x6c, 0xcb,
-	0x23, 0x9a, 0xbf, 0xa4, 0x90, 0x93, 0x27, 0x18, 0x73, 0x28, 0x02, 0x35, 0xc9, 0xb2, 0xb4, 0x74,
-	0x6e, 0x1a, 0xff, 0x07, 0xb5, 0xff, 0xda, 0xdf, 0xfe, 0xfb, 0x42, 0xff, 0xe2, 0xbc, 0x2f, 0xb4,
-	0xdf, 0xe6, 0x50, 0x3c, 0x37, 0xd3, 0x8f, 0x36, 0xdf, 0x5d, 0x9c, 0xec, 0x38, 0x56, 0xdb, 0x55,
-	0xf1, 0xd8, 0x2b, 0xec, 0x2e, 0xda, 0x1f, 0x66, 0xb0, 0x77, 0x3a, 0x75, 0xd1, 0xd9, 0xd4, 0x45,
-	0xdf, 0xa7, 0x2e, 0x7a, 0x3f, 0x73, 0x1b, 0x67, 0x33, 0xb7, 0xf1, 0x75, 0xe6, 0x36, 0x5e, 0xad,
-	0xff, 0x66, 0x54, 0x4f, 0xe9, 0x32, 0xa3, 0x2a, 0x6c, 0x99, 0x3d, 0xda, 0xfb, 0x11, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x44, 0x4d, 0x1a, 0xdd, 0x03, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xaf, 0xa9, 0x3f, 0x32, 0x6d, 0x55, 0x3a, 0x6d, 0x91, 0x5b, 0x54, 0x37, 0xca,
+	0x02, 0x55, 0x45, 0x89, 0x29, 0x15, 0x2c, 0xba, 0x6b, 0xe8, 0x82, 0x22, 0x2a, 0x22, 0x77, 0x81,
+	0x00, 0x09, 0xeb, 0xda, 0x9e, 0x3a, 0x43, 0xed, 0x19, 0xcb, 0x33, 0xa9, 0xe2, 0x57, 0x60, 0x03,
+	0x8f, 0xc1, 0xb2, 0x8b, 0x6e, 0x78, 0x83, 0x6e, 0x90, 0xaa, 0xac, 0x10, 0x8b, 0x0a, 0x25, 0x8b,
+	0xbe, 0x06, 0xf2, 0x8c, 0x49, 0xf8, 0xb3, 0x82, 0xb2, 0x89, 0xe6, 0xde, 0x33, 0xf3, 0x3b, 0x67,
+	0xe2, 0x3b, 0xc8, 0x0e, 0xb8, 0x48, 0xb8, 0x70, 0x12, 0xca, 0xa4, 0x73, 0xb2, 0xe5, 0x13, 0x09,
+	0x5b, 0xaa, 0x68, 0xa5, 0x19, 0x97, 0x1c, 0x2f, 0x6a, 0xbd, 0xa5, 0x5a, 0xa5, 0xbe, 0xba, 0x14,
+	0xf1, 0x88, 0x2b, 0xdd, 0x29, 0x56, 0x7a, 0xeb, 0xea, 0x8a, 0xde, 0xea, 0x69, 0xa1, 0x3c, 0xa7,
+	0xa5, 0x05, 0x48, 0x28, 0xe3, 0x8e, 0xfa, 0xfd, 0xbe, 0x3b, 0xe2, 0x3c, 0x8a, 0x89, 0xa3, 0x2a,
+	0xbf, 0x77, 0xe4, 0x00, 0xcb, 0xb5, 0xd4, 0xf8, 0x64, 0x20, 0xf3, 0x80, 0x32, 0x49, 0x32, 0xfc,
+	0x0c, 0xd5, 0x28, 0x3b, 0x8a, 0x41, 0x52, 0xce, 0x2c, 0xa3, 0x6e, 0x6c, 0xd4, 0xda, 0x5b, 0xe7,
+	0x97, 0xeb, 0x95, 0x2f, 0x97, 0xeb, 0xb7, 0xb5, 0x83, 0x08, 0x8f, 0x5b, 0x94, 0x3b, 0x09, 0xc8,
+	0x6e, 0xeb, 0x29, 0x89, 0x20, 0xc8, 0xf7, 0x48, 0x30, 0x38, 0x6b, 0xa2, 0x32, 0xc0, 0x1e, 0x09,
+	0xdc, 0x09, 0x03, 0xbf, 0x46, 0x0b, 0xc0, 0x58, 0x0f, 0xe2, 0x22, 0xe6, 0x09, 0x15, 0x94, 0x33,
+	0x61, 0xfd, 0xf7, 0xb7, 0xe0, 0x9b, 0x9a, 0xd5, 0x19, 0xa3, 0x30, 0x46, 0xd5, 0x10, 0x24, 0x58,
+	0x53, 0x75, 0x63, 0x63, 0xd6, 0x55, 0xeb, 0xc6, 0xc7, 0x2a, 0x32, 0x3b, 0x90, 0x41, 0x22, 0xf0,
+	0x1a, 0x42, 0xc5, 0x3f, 0xe9, 0x85, 0x84, 0xf1, 0x44, 0x5f, 0xc8, 0xad, 0x15, 0x9d, 0xbd, 0xa2,
+	0x81, 0xdf, 0xa0, 0xe5, 0x71, 0x54, 0x2f, 0x03, 0x49, 0xbc, 0xa0, 0x0b, 0x2c, 0x22, 0x65, 0xc2,
+	0x87, 0x7f, 0x9c, 0xf0, 0xc3, 0xd5, 0xe9, 0xa6, 0xe1, 0x2e, 0x8e, 0xa1, 0x2e, 0x48, 0xf2, 0x48,
+	0x21, 0xf1, 0x2b, 0x34, 0x37, 0xf1, 0x4a, 0xa0, 0x6f, 0x4d, 0x5d, 0xcb, 0x63, 0x76, 0x0c, 0x3b,
+	0x80, 0xfe, 0x2f, 0x70, 0xca, 0xac, 0xea, 0xbf, 0x82, 0x53, 0x86, 0x9f, 0xa3, 0x99, 0x88, 0x43,
+	0xec, 0xf9, 0x9c, 0x85, 0x24, 0xb4, 0xa6, 0xaf, 0x85, 0x46, 0x05, 0xaa, 0xad, 0x48, 0xf8, 0x0e,
+	0x9a, 0xf7, 0x63, 0x1e, 0x1c, 0x0b, 0x2f, 0x25, 0x99, 0x97, 0x13, 0xc8, 0x2c, 0xb3, 0x6e, 0x6c,
+	0x54, 0xdd, 0x39, 0xdd, 0xee, 0x90, 0xec, 0x05, 0x81, 0x0c, 0x3f, 0x41, 0x28, 0x81, 0xbe, 0x27,
+	0x7a, 0x69, 0x1a, 0xe7, 0xd6, 0xff, 0xca, 0xff, 0x6e, 0xe9, 0xbf, 0xfc, 0xbb, 0xff, 0x3e, 0x93,
+	0x3f, 0x38, 0xef, 0x33, 0xe9, 0xd6, 0x12, 0xe8, 0x1f, 0xaa, 0xd3, 0x3b, 0x6b, 0x6f, 0xaf, 0x4e,
+	0x37, 0x2d, 0xad, 0x35, 0x45, 0x78, 0xec, 0xf4, 0xf5, 0x5b, 0xd4, 0x03, 0xd3, 0x78, 0x67, 0xa0,
+	0x99, 0x0e, 0xf4, 0x04, 0x39, 0x94, 0x20, 0x7b, 0x02, 0xdf, 0x42, 0x66, 0x5a, 0x94, 0xa1, 0x1a,
+	0x9e, 0x1b, 0x6e, 0x59, 0x61, 0x07, 0x99, 0x82, 0x48, 0xcf, 0xcf, 0xcb, 0x51, 0xb1, 0x06, 0x67,
+	0xcd, 0xa5, 0xd2, 0x71, 0x37, 0x0c, 0x33, 0x22, 0xc4, 0xa1, 0xcc, 0x28, 0x8b, 0xdc, 0x69, 0x41,
+	0x64, 0x3b, 0xc7, 0x0d, 0x34, 0x57, 0x1c, 0x00, 0xe9, 0x75, 0x09, 0x8d, 0xba, 0x52, 0x7d, 0xfe,
+	0x29, 0x77, 0x46, 0x10, 0xb9, 0x2b, 0x1f, 0xab, 0xd6, 0xce, 0xe2, 0xe0, 0xac, 0x39, 0x3f, 0x89,
+	0x56, 0xbf, 0xd7, 0x7a, 0x70, 0xbf, 0xbd, 0x7d, 0x3e, 0xb4, 0x8d, 0x8b, 0xa1, 0x6d, 0x7c, 0x1d,
+	0xda, 0xc6, 0xfb, 0x91, 0x5d, 0xb9, 0x18, 0xd9, 0x95, 0xcf, 0x23, 0xbb, 0xf2, 0x72, 0xe5, 0xa7,
+	0xab, 0x97, 0xf7, 0x90, 0x79, 0x4a, 0x84, 0x6f, 0xaa, 0x97, 0xbd, 0xfd, 0x6d, 0x00, 0x68, 0x3d,
+	0x3d, 0x37, 0x6f, 0x04, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -320,6 +391,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PauseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SetAtHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.SetAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SetBy) > 0 {
+		i -= len(m.SetBy)
+		copy(dAtA[i:], m.SetBy)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.SetBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -374,6 +490,25 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *PauseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.SetBy)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.SetAtHeight != 0 {
+		n += 1 + sovMint(uint64(m.SetAtHeight))
+	}
+	return n
+}
+
 func sovMint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -803,6 +938,127 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PauseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAtHeight", wireType)
+			}
+			m.SetAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SetAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
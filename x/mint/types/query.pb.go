@@ -270,6 +270,91 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryPauseStatusRequest is the request type for the Query/PauseStatus RPC
+// method.
+type QueryPauseStatusRequest struct {
+}
+
+func (m *QueryPauseStatusRequest) Reset()         { *m = QueryPauseStatusRequest{} }
+func (m *QueryPauseStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusRequest) ProtoMessage()    {}
+func (*QueryPauseStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryPauseStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusRequest.Merge(m, src)
+}
+func (m *QueryPauseStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusRequest proto.InternalMessageInfo
+
+// QueryPauseStatusResponse is the response type for the Query/PauseStatus RPC
+// method.
+type QueryPauseStatusResponse struct {
+	// pause_status defines whether minting is paused and who last set it.
+	PauseStatus PauseStatus `protobuf:"bytes,1,opt,name=pause_status,json=pauseStatus,proto3" json:"pause_status"`
+}
+
+func (m *QueryPauseStatusResponse) Reset()         { *m = QueryPauseStatusResponse{} }
+func (m *QueryPauseStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusResponse) ProtoMessage()    {}
+func (*QueryPauseStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryPauseStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusResponse.Merge(m, src)
+}
+func (m *QueryPauseStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStatusResponse) GetPauseStatus() PauseStatus {
+	if m != nil {
+		return m.PauseStatus
+	}
+	return PauseStatus{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,42 +362,50 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryPauseStatusRequest)(nil), "cosmos.mint.v1beta1.QueryPauseStatusRequest")
+	proto.RegisterType((*QueryPauseStatusResponse)(nil), "cosmos.mint.v1beta1.QueryPauseStatusResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x8a, 0x85, 0x8e, 0x1e, 0x76, 0x67, 0xd7, 0x97, 0x4d, 0x77, 0xa7, 0x4b, 0x84,
-	0xb5, 0xac, 0x38, 0x43, 0xbb, 0xe0, 0x51, 0xb0, 0xec, 0x45, 0xf0, 0xb0, 0x2e, 0x7a, 0xf1, 0x22,
-	0xb3, 0x71, 0x8c, 0xc1, 0x66, 0x26, 0x9b, 0x99, 0x16, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b,
-	0xe8, 0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0x69, 0x05, 0xbf, 0x86, 0x64,
-	0x66, 0x5a, 0x69, 0x9a, 0xa0, 0xe2, 0x25, 0x24, 0xcf, 0xdb, 0xff, 0x37, 0xcf, 0x7f, 0x02, 0x9b,
-	0xa1, 0x54, 0x89, 0x54, 0x34, 0x89, 0x85, 0xa6, 0x83, 0xf6, 0x09, 0xd7, 0xac, 0x4d, 0x4f, 0xfb,
-	0x3c, 0x1b, 0x92, 0x34, 0x93, 0x5a, 0xa2, 0x0d, 0x5b, 0x40, 0xf2, 0x02, 0xe2, 0x0a, 0xfc, 0xcd,
-	0x48, 0x46, 0xd2, 0xe4, 0x69, 0xfe, 0x66, 0x4b, 0xfd, 0xed, 0x48, 0xca, 0xa8, 0xc7, 0x29, 0x4b,
-	0x63, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x58, 0x0a, 0xe5, 0xb2, 0xb8, 0x4c, 0xc9, 0x4c, 0xb5, 0xf9,
-	0x75, 0x96, 0xc4, 0x42, 0x52, 0xf3, 0x74, 0xa1, 0x2d, 0xdb, 0xf2, 0xc8, 0x2a, 0x39, 0x10, 0xf3,
-	0x11, 0x6c, 0x42, 0x74, 0x2f, 0xa7, 0x3c, 0x62, 0x19, 0x4b, 0xd4, 0x31, 0x3f, 0xed, 0x73, 0xa5,
-	0x83, 0x07, 0x70, 0x63, 0x29, 0xaa, 0x52, 0x29, 0x14, 0x47, 0xb7, 0x60, 0x2d, 0x35, 0x91, 0x2b,
-	0x60, 0x17, 0xb4, 0xce, 0x77, 0x1a, 0xa4, 0xe4, 0x50, 0xc4, 0x36, 0x75, 0xeb, 0xa3, 0x49, 0xd3,
-	0x7b, 0xf7, 0xf3, 0xc3, 0x3e, 0x38, 0x76, 0x5d, 0xc1, 0x65, 0x78, 0xd1, 0x8c, 0xbd, 0x23, 0x9e,
-	0xf4, 0xcc, 0x99, 0xe6, 0x7a, 0x02, 0x5e, 0x2a, 0x26, 0x9c, 0xe4, 0x7d, 0x58, 0x8f, 0xe7, 0x41,
-	0xa3, 0x7a, 0xa1, 0x7b, 0x33, 0x1f, 0xfc, 0x6d, 0xd2, 0x6c, 0x58, 0x71, 0xf5, 0xf8, 0x19, 0x89,
-	0x25, 0x4d, 0x98, 0x7e, 0x4a, 0xee, 0xf2, 0x88, 0x85, 0xc3, 0x43, 0x1e, 0x7e, 0xfe, 0x78, 0x03,
-	0x3a, 0xb6, 0x43, 0x1e, 0x5a, 0x8a, 0xdf, 0x83, 0x02, 0x0c, 0xb7, 0x8d, 0xde, 0x6d, 0x21, 0xfa,
-	0xac, 0x77, 0x94, 0xc9, 0x41, 0xac, 0xf2, 0x15, 0xcf, 0x79, 0x5e, 0x01, 0xb8, 0x53, 0x51, 0xe0,
-	0xb8, 0x42, 0xb8, 0xce, 0x4c, 0x2e, 0x5f, 0xaa, 0x4b, 0xfe, 0x27, 0xdf, 0x1a, 0x2b, 0x88, 0x75,
-	0x3e, 0x9d, 0x85, 0xe7, 0x0c, 0x06, 0x7a, 0x01, 0x60, 0xcd, 0xee, 0x15, 0x5d, 0x2b, 0x5d, 0xfa,
-	0xaa, 0x89, 0x7e, 0xeb, 0xcf, 0x85, 0xf6, 0x30, 0xc1, 0xd5, 0x97, 0x5f, 0x7e, 0xbc, 0x3d, 0xb3,
-	0x83, 0x1a, 0xb4, 0xec, 0x6e, 0x59, 0xf3, 0xd0, 0x6b, 0x00, 0xeb, 0x0b, 0x7f, 0xd0, 0x7e, 0xf5,
-	0xf0, 0xa2, 0xbb, 0xfe, 0xf5, 0xbf, 0xaa, 0x75, 0x2c, 0x7b, 0x86, 0x65, 0x17, 0xe1, 0x52, 0x96,
-	0x85, 0x85, 0xe8, 0x3d, 0x80, 0x6b, 0x45, 0x77, 0x50, 0xbb, 0x5a, 0xa9, 0xc2, 0x6a, 0xbf, 0xf3,
-	0x2f, 0x2d, 0x8e, 0x91, 0x18, 0xc6, 0x16, 0xda, 0x2b, 0x65, 0x5c, 0xb9, 0x17, 0xdd, 0x83, 0xd1,
-	0x14, 0x83, 0xf1, 0x14, 0x83, 0xef, 0x53, 0x0c, 0xde, 0xcc, 0xb0, 0x37, 0x9e, 0x61, 0xef, 0xeb,
-	0x0c, 0x7b, 0x0f, 0xb7, 0x96, 0xee, 0xc8, 0x73, 0x3b, 0x48, 0x0f, 0x53, 0xae, 0x4e, 0x6a, 0xe6,
-	0x07, 0x3d, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x7d, 0x50, 0x82, 0x5a, 0x04, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0xa2, 0x85, 0x4c, 0x0a, 0xb6, 0x93, 0x6a, 0xdb, 0x4d, 0xbb, 0x89, 0x5b, 0xa8,
+	0xa1, 0x9a, 0x59, 0x93, 0xa2, 0x07, 0x11, 0xc1, 0xd0, 0x8b, 0x20, 0x52, 0xab, 0x5e, 0xbc, 0x84,
+	0x69, 0x3a, 0xc6, 0xa5, 0xd9, 0x99, 0x6d, 0x66, 0xb6, 0x98, 0x93, 0x22, 0x1e, 0x3d, 0x08, 0x7e,
+	0x89, 0x7a, 0xf3, 0xd0, 0x0f, 0x51, 0x3c, 0x95, 0x7a, 0x11, 0x0f, 0x45, 0x12, 0xc1, 0xab, 0x1f,
+	0x41, 0x76, 0x66, 0x92, 0xb4, 0x9b, 0x5d, 0xad, 0x78, 0x09, 0xc9, 0x7b, 0xff, 0x79, 0xff, 0xdf,
+	0xe4, 0xfd, 0x77, 0x61, 0xb1, 0xc9, 0x85, 0xcf, 0x85, 0xeb, 0x7b, 0x4c, 0xba, 0xbb, 0xd5, 0x4d,
+	0x2a, 0x49, 0xd5, 0xdd, 0x09, 0x69, 0xa7, 0x8b, 0x83, 0x0e, 0x97, 0x1c, 0xe5, 0xb5, 0x00, 0x47,
+	0x02, 0x6c, 0x04, 0xd6, 0x4c, 0x8b, 0xb7, 0xb8, 0xea, 0xbb, 0xd1, 0x37, 0x2d, 0xb5, 0x16, 0x5a,
+	0x9c, 0xb7, 0xda, 0xd4, 0x25, 0x81, 0xe7, 0x12, 0xc6, 0xb8, 0x24, 0xd2, 0xe3, 0x4c, 0x98, 0xae,
+	0x9d, 0xe4, 0xa4, 0xa6, 0xea, 0xfe, 0x34, 0xf1, 0x3d, 0xc6, 0x5d, 0xf5, 0x69, 0x4a, 0xf3, 0xfa,
+	0x48, 0x43, 0x3b, 0x19, 0x10, 0xf5, 0xc3, 0x99, 0x81, 0xe8, 0x51, 0x44, 0xb9, 0x4e, 0x3a, 0xc4,
+	0x17, 0x1b, 0x74, 0x27, 0xa4, 0x42, 0x3a, 0x4f, 0x61, 0xfe, 0x54, 0x55, 0x04, 0x9c, 0x09, 0x8a,
+	0xee, 0xc2, 0x89, 0x40, 0x55, 0xe6, 0x40, 0x09, 0x94, 0x73, 0xb5, 0x02, 0x4e, 0xb8, 0x14, 0xd6,
+	0x87, 0xea, 0xd9, 0x83, 0xe3, 0x62, 0x66, 0xef, 0xe7, 0xa7, 0x15, 0xb0, 0x61, 0x4e, 0x39, 0xb3,
+	0xf0, 0x92, 0x1a, 0x7b, 0x9f, 0x3d, 0x6f, 0xab, 0x3b, 0x0d, 0xfc, 0x18, 0xbc, 0x1c, 0x6f, 0x18,
+	0xcb, 0x27, 0x30, 0xeb, 0x0d, 0x8a, 0xca, 0x75, 0xb2, 0x7e, 0x2b, 0x1a, 0xfc, 0xed, 0xb8, 0x58,
+	0xd0, 0xe6, 0x62, 0x6b, 0x1b, 0x7b, 0xdc, 0xf5, 0x89, 0x7c, 0x81, 0x1f, 0xd0, 0x16, 0x69, 0x76,
+	0xd7, 0x68, 0xf3, 0x68, 0xbf, 0x02, 0x0d, 0xdb, 0x1a, 0x6d, 0x6a, 0x8a, 0xd1, 0x20, 0xc7, 0x86,
+	0x0b, 0xca, 0xef, 0x1e, 0x63, 0x21, 0x69, 0xaf, 0x77, 0xf8, 0xae, 0x27, 0xa2, 0xbf, 0x78, 0xc0,
+	0xf3, 0x16, 0xc0, 0xc5, 0x14, 0x81, 0xe1, 0x6a, 0xc2, 0x69, 0xa2, 0x7a, 0x8d, 0x60, 0xd8, 0xfc,
+	0x4f, 0xbe, 0x29, 0x12, 0x33, 0x73, 0x30, 0x9c, 0x35, 0x6b, 0x08, 0x05, 0x7d, 0x2c, 0x89, 0x0c,
+	0x07, 0x84, 0xb7, 0xf3, 0x47, 0xfb, 0x95, 0x8b, 0x7a, 0x42, 0x45, 0x6c, 0x6d, 0x97, 0x6e, 0xe0,
+	0x9b, 0x35, 0xe7, 0x15, 0x9c, 0x1b, 0xd7, 0x1b, 0xe0, 0x87, 0x70, 0x32, 0x88, 0xca, 0x0d, 0xa1,
+	0xea, 0x66, 0x83, 0xa5, 0x94, 0x0d, 0x0e, 0xcf, 0x9f, 0x5c, 0x63, 0x2e, 0x18, 0xd5, 0x13, 0x01,
+	0x6a, 0xbf, 0xce, 0xc3, 0x0b, 0x8a, 0x00, 0xbd, 0x06, 0x70, 0x42, 0x07, 0x01, 0x5d, 0x4d, 0xf4,
+	0x18, 0x4f, 0x9d, 0x55, 0xfe, 0xbb, 0x50, 0x5f, 0xc6, 0x59, 0x7a, 0xf3, 0xe5, 0xc7, 0x87, 0x73,
+	0x8b, 0xa8, 0xe0, 0x26, 0x3d, 0x0c, 0x3a, 0x6d, 0xe8, 0x1d, 0x80, 0xd9, 0x61, 0xa0, 0xd0, 0x4a,
+	0xfa, 0xf0, 0x78, 0x1c, 0xad, 0x6b, 0x67, 0xd2, 0x1a, 0x96, 0x65, 0xc5, 0x52, 0x42, 0x76, 0x22,
+	0xcb, 0x30, 0x73, 0xe8, 0x23, 0x80, 0x53, 0xf1, 0x38, 0xa1, 0x6a, 0xba, 0x53, 0x4a, 0x36, 0xad,
+	0xda, 0xbf, 0x1c, 0x31, 0x8c, 0x58, 0x31, 0x96, 0xd1, 0x72, 0x22, 0xe3, 0x58, 0x90, 0xd1, 0x1e,
+	0x80, 0xb9, 0x13, 0x21, 0x40, 0xd7, 0xff, 0xb4, 0x99, 0x78, 0x36, 0xad, 0xca, 0x19, 0xd5, 0x06,
+	0xee, 0xce, 0xe7, 0xf1, 0x24, 0x29, 0xde, 0x25, 0x74, 0x25, 0x65, 0xbf, 0xa3, 0x1c, 0xd7, 0x57,
+	0x0f, 0x7a, 0x36, 0x38, 0xec, 0xd9, 0xe0, 0x7b, 0xcf, 0x06, 0xef, 0xfb, 0x76, 0xe6, 0xb0, 0x6f,
+	0x67, 0xbe, 0xf6, 0xed, 0xcc, 0xb3, 0xf9, 0x53, 0xcf, 0xdf, 0x4b, 0x3d, 0x43, 0x76, 0x03, 0x2a,
+	0x36, 0x27, 0xd4, 0xcb, 0x6f, 0xf5, 0xf7, 0x00, 0x52, 0x8f, 0x8c, 0xbd, 0xb6, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +426,8 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// PauseStatus returns whether minting is paused and who last set it.
+	PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error)
 }
 
 type queryClient struct {
@@ -370,6 +465,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error) {
+	out := new(QueryPauseStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/PauseStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -378,6 +482,8 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// PauseStatus returns whether minting is paused and who last set it.
+	PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -393,6 +499,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) PauseStatus(ctx context.Context, req *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -452,6 +561,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/PauseStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseStatus(ctx, req.(*QueryPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
@@ -469,6 +596,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "PauseStatus",
+			Handler:    _Query_PauseStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -642,6 +773,62 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPauseStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PauseStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -713,6 +900,26 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPauseStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PauseStatus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1118,6 +1325,139 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPauseStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -126,38 +126,138 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetPaused is the Msg/SetPaused request type.
+type MsgSetPaused struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// paused defines whether minting must be paused or resumed.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetPaused) Reset()         { *m = MsgSetPaused{} }
+func (m *MsgSetPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPaused) ProtoMessage()    {}
+func (*MsgSetPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{2}
+}
+func (m *MsgSetPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPaused.Merge(m, src)
+}
+func (m *MsgSetPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPaused proto.InternalMessageInfo
+
+func (m *MsgSetPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// MsgSetPausedResponse defines the response structure for executing a
+// MsgSetPaused message.
+type MsgSetPausedResponse struct {
+}
+
+func (m *MsgSetPausedResponse) Reset()         { *m = MsgSetPausedResponse{} }
+func (m *MsgSetPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedResponse) ProtoMessage()    {}
+func (*MsgSetPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{3}
+}
+func (m *MsgSetPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedResponse.Merge(m, src)
+}
+func (m *MsgSetPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPausedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.mint.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.mint.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetPaused)(nil), "cosmos.mint.v1beta1.MsgSetPaused")
+	proto.RegisterType((*MsgSetPausedResponse)(nil), "cosmos.mint.v1beta1.MsgSetPausedResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/tx.proto", fileDescriptor_a0d933a8bf5e188a) }
 
 var fileDescriptor_a0d933a8bf5e188a = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0xe2, 0x30, 0x2d, 0xc5, 0xe9, 0xfa, 0x65, 0x86, 0x20, 0x0a, 0xa2, 0x5a, 0x4a,
-	0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x85, 0xe4, 0xb0, 0x19, 0x0f, 0x36, 0x0d,
-	0x22, 0x2f, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0x49, 0x88, 0xae,
-	0x78, 0x88, 0x04, 0xd4, 0x0d, 0x60, 0x8e, 0xd2, 0x25, 0x46, 0x2e, 0x7e, 0xdf, 0xe2, 0xf4, 0xd0,
-	0x82, 0x94, 0xc4, 0x92, 0xd4, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x33, 0x2e, 0xce, 0xc4,
-	0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89,
-	0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x1a, 0x1d, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a,
-	0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xec, 0xb8, 0xd8, 0x0a, 0xc0, 0x26, 0x48, 0x30, 0x29,
-	0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0xf1, 0xae, 0x1e, 0xc4, 0x12, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x65, 0xe5, 0x7e, 0x69, 0x8b, 0x2e,
-	0x3f, 0x44, 0x8b, 0x6e, 0x71, 0x4a, 0xb6, 0x82, 0x81, 0x9e, 0x89, 0x79, 0xd3, 0xf3, 0x0d, 0x5a,
-	0x08, 0x2b, 0xba, 0x9e, 0x6f, 0xd0, 0x52, 0x44, 0xa8, 0xd0, 0xaf, 0x80, 0x04, 0x04, 0x9a, 0x07,
-	0x94, 0xf4, 0xb8, 0xc4, 0xd1, 0x84, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0xad, 0x84,
-	0xb1, 0xd8, 0x61, 0xd4, 0xca, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x54, 0xce, 0xc5, 0x83, 0x12,
-	0x10, 0x2a, 0x58, 0x3d, 0x80, 0x66, 0xb4, 0x94, 0x0e, 0x31, 0xaa, 0x60, 0x0e, 0x50, 0x12, 0x3e,
-	0x85, 0xe9, 0x00, 0x29, 0xd6, 0x06, 0x50, 0x40, 0x38, 0x19, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34, 0x06, 0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0x61, 0xde, 0x2e,
-	0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x47, 0xa4, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xba,
-	0xd0, 0xf3, 0x77, 0x7a, 0x02, 0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x8f, 0xd2, 0x40,
+	0x14, 0xee, 0x68, 0xdc, 0xd8, 0x71, 0x93, 0x8d, 0x85, 0xb8, 0x58, 0xcd, 0xc8, 0x36, 0x1e, 0x56,
+	0x94, 0xa9, 0x14, 0xd1, 0x84, 0x83, 0x89, 0xc4, 0xc4, 0x13, 0x09, 0x29, 0xf1, 0xe2, 0xc5, 0x14,
+	0x3b, 0xa9, 0x0d, 0x69, 0xa7, 0xe9, 0x0c, 0x08, 0x37, 0xe3, 0xd1, 0x93, 0x3f, 0xc2, 0x83, 0x47,
+	0x0e, 0xfc, 0x08, 0xe2, 0x89, 0x70, 0xf2, 0x64, 0x0c, 0x1c, 0xf8, 0x05, 0xde, 0x4d, 0x3b, 0x03,
+	0x55, 0xa8, 0x68, 0xf6, 0x02, 0xf3, 0xde, 0xfb, 0xde, 0xfb, 0xde, 0xf7, 0x4d, 0x07, 0xde, 0x7e,
+	0x43, 0x59, 0x40, 0x99, 0x19, 0xf8, 0x21, 0x37, 0x87, 0xb5, 0x1e, 0xe1, 0x4e, 0xcd, 0xe4, 0x23,
+	0x1c, 0xc5, 0x94, 0x53, 0xad, 0x20, 0xaa, 0x38, 0xa9, 0x62, 0x59, 0xd5, 0x4f, 0x37, 0x2d, 0xcc,
+	0x33, 0x87, 0xb5, 0xe4, 0x4f, 0xa0, 0xf5, 0xeb, 0x4e, 0xe0, 0x87, 0xd4, 0x4c, 0x7f, 0x65, 0x0a,
+	0xe5, 0x8d, 0x4f, 0xa7, 0x89, 0x7a, 0xd1, 0xa3, 0x1e, 0x4d, 0x8f, 0x66, 0x72, 0x92, 0xd9, 0x9b,
+	0xa2, 0xeb, 0xb5, 0x28, 0xc8, 0x1d, 0xd2, 0xc0, 0x58, 0x00, 0x78, 0xd2, 0x66, 0xde, 0xcb, 0xc8,
+	0x75, 0x38, 0xe9, 0x38, 0xb1, 0x13, 0x30, 0xed, 0x31, 0x54, 0x9d, 0x01, 0x7f, 0x4b, 0x63, 0x9f,
+	0x8f, 0x4b, 0xa0, 0x0c, 0xce, 0xd5, 0x56, 0x69, 0x31, 0xad, 0x16, 0x65, 0xe3, 0x33, 0xd7, 0x8d,
+	0x09, 0x63, 0x5d, 0x1e, 0xfb, 0xa1, 0x67, 0x67, 0x50, 0xed, 0x29, 0x3c, 0x8a, 0xd2, 0x09, 0xa5,
+	0x4b, 0x65, 0x70, 0x7e, 0xcd, 0xba, 0x85, 0x73, 0xe4, 0x62, 0x41, 0xd2, 0x52, 0x67, 0xdf, 0xef,
+	0x28, 0x5f, 0xd6, 0x93, 0x0a, 0xb0, 0x65, 0x57, 0xf3, 0xc5, 0x62, 0x5a, 0x3d, 0x11, 0x2d, 0x55,
+	0xe6, 0xf6, 0xcb, 0x0f, 0xf1, 0xa3, 0x27, 0x1f, 0xd6, 0x93, 0x4a, 0x46, 0xf1, 0x71, 0x3d, 0xa9,
+	0x9c, 0x65, 0x08, 0x73, 0x24, 0x8c, 0xd8, 0x11, 0x60, 0x60, 0x78, 0xba, 0x93, 0xb2, 0x09, 0x8b,
+	0x68, 0xc8, 0x48, 0xb3, 0x90, 0xc3, 0x61, 0x7c, 0x06, 0xf0, 0xb8, 0xcd, 0xbc, 0x2e, 0xe1, 0x1d,
+	0x67, 0xc0, 0x88, 0x7b, 0x61, 0x07, 0x6e, 0x24, 0x0e, 0x24, 0x13, 0x52, 0x07, 0xae, 0xda, 0x32,
+	0x6a, 0x3e, 0xdf, 0x63, 0x6d, 0x58, 0xfb, 0xca, 0x50, 0xae, 0xb2, 0xed, 0x56, 0xc6, 0x7d, 0x58,
+	0xfc, 0x3d, 0x3e, 0xa0, 0xa9, 0x61, 0x59, 0x3f, 0x01, 0xbc, 0xdc, 0x66, 0x9e, 0xf6, 0x0e, 0x1e,
+	0xff, 0x71, 0xb9, 0x77, 0x73, 0x2f, 0x65, 0xc7, 0x2e, 0xfd, 0xc1, 0xff, 0xa0, 0x36, 0x0b, 0x18,
+	0x85, 0xaf, 0xfb, 0xa6, 0x6a, 0x21, 0x54, 0x33, 0x43, 0xcf, 0xfe, 0x36, 0x6f, 0x0b, 0xd1, 0xef,
+	0xfd, 0x13, 0x72, 0x80, 0xaf, 0x61, 0xe9, 0x57, 0xde, 0x27, 0x1f, 0x53, 0xab, 0x3e, 0x5b, 0x22,
+	0x30, 0x5f, 0x22, 0xf0, 0x63, 0x89, 0xc0, 0xa7, 0x15, 0x52, 0xe6, 0x2b, 0xa4, 0x7c, 0x5b, 0x21,
+	0xe5, 0x95, 0x7c, 0x05, 0xcc, 0xed, 0x63, 0x9f, 0x6e, 0x0c, 0xe6, 0xe3, 0x88, 0xb0, 0xde, 0x51,
+	0xfa, 0x18, 0xea, 0xbf, 0x06, 0x00, 0xa2, 0xad, 0x7b, 0x56, 0xbe, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -175,6 +275,10 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
 	// parameters. The authority is defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetPaused defines an authority operation for pausing or resuming minting,
+	// for instance as an emergency response. The authority is defaults to the
+	// x/gov module account.
+	SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error)
 }
 
 type msgClient struct {
@@ -194,11 +298,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error) {
+	out := new(MsgSetPausedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Msg/SetPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
 	// parameters. The authority is defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetPaused defines an authority operation for pausing or resuming minting,
+	// for instance as an emergency response. The authority is defaults to the
+	// x/gov module account.
+	SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +325,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetPaused(ctx context.Context, req *MsgSetPaused) (*MsgSetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +351,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Msg/SetPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaused(ctx, req.(*MsgSetPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Msg",
@@ -240,6 +378,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetPaused",
+			Handler:    _Msg_SetPaused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/tx.proto",
@@ -308,6 +450,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -343,6 +548,31 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -514,6 +744,158 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,14 +2,20 @@ package nft
 
 import (
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
 )
 
 // ValidateGenesis checks that the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState, ac address.Codec) error {
+	traits := make(map[string][]*TraitDefinition, len(data.Classes))
 	for _, class := range data.Classes {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		if err := ValidateTraitDefinitions(class.Traits); err != nil {
+			return errors.Wrap(err, class.Id)
+		}
+		traits[class.Id] = class.Traits
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -19,6 +25,9 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			if _, err := ac.StringToBytes(entry.Owner); err != nil {
 				return err
 			}
+			if _, err := EncodeTraits(traits[nft.ClassId], nft.Traits, ac); err != nil {
+				return errors.Wrap(err, nft.Id)
+			}
 		}
 	}
 	return nil
@@ -15,6 +15,9 @@ func (k Keeper) SaveClass(ctx context.Context, class nft.Class) error {
 	if k.HasClass(ctx, class.Id) {
 		return errors.Wrap(nft.ErrClassExists, class.Id)
 	}
+	if err := nft.ValidateTraitDefinitions(class.Traits); err != nil {
+		return errors.Wrap(err, class.Id)
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return errors.Wrap(err, "Marshal nft.Class failed")
@@ -23,11 +26,16 @@ func (k Keeper) SaveClass(ctx context.Context, class nft.Class) error {
 	return store.Set(classStoreKey(class.Id), bz)
 }
 
-// UpdateClass defines a method for updating an exist nft class
+// UpdateClass defines a method for updating an exist nft class.
+// Note: the traits of the class can only be extended with optional traits.
 func (k Keeper) UpdateClass(ctx context.Context, class nft.Class) error {
-	if !k.HasClass(ctx, class.Id) {
+	current, has := k.GetClass(ctx, class.Id)
+	if !has {
 		return errors.Wrap(nft.ErrClassNotExists, class.Id)
 	}
+	if err := nft.ValidateTraitsUpdate(current.Traits, class.Traits); err != nil {
+		return errors.Wrap(err, class.Id)
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return errors.Wrap(err, "Marshal nft.Class failed")
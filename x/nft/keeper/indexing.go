@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/x/nft"
+)
+
+// Object types of the nft module schema.
+const (
+	ClassObjectType      = "class"
+	ClassTraitObjectType = "class_trait"
+	NFTObjectType        = "nft"
+	NFTOwnerObjectType   = "nft_owner"
+	NFTTraitObjectType   = "nft_trait"
+)
+
+var moduleSchema = schema.MustCompileModuleSchema(
+	schema.StateObjectType{
+		Name:      ClassObjectType,
+		KeyFields: []schema.Field{{Name: "id", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{
+			{Name: "name", Kind: schema.StringKind},
+			{Name: "symbol", Kind: schema.StringKind},
+			{Name: "description", Kind: schema.StringKind},
+			{Name: "uri", Kind: schema.StringKind},
+			{Name: "uri_hash", Kind: schema.StringKind},
+		},
+	},
+	schema.StateObjectType{
+		Name: ClassTraitObjectType,
+		KeyFields: []schema.Field{
+			{Name: "class_id", Kind: schema.StringKind},
+			{Name: "name", Kind: schema.StringKind},
+		},
+		ValueFields: []schema.Field{
+			{Name: "kind", Kind: schema.StringKind},
+			{Name: "required", Kind: schema.BoolKind},
+		},
+	},
+	schema.StateObjectType{
+		Name: NFTObjectType,
+		KeyFields: []schema.Field{
+			{Name: "class_id", Kind: schema.StringKind},
+			{Name: "id", Kind: schema.StringKind},
+		},
+		ValueFields: []schema.Field{
+			{Name: "uri", Kind: schema.StringKind},
+			{Name: "uri_hash", Kind: schema.StringKind},
+		},
+	},
+	schema.StateObjectType{
+		Name: NFTOwnerObjectType,
+		KeyFields: []schema.Field{
+			{Name: "class_id", Kind: schema.StringKind},
+			{Name: "id", Kind: schema.StringKind},
+		},
+		ValueFields: []schema.Field{
+			{Name: "owner", Kind: schema.AddressKind},
+		},
+	},
+	schema.StateObjectType{
+		Name: NFTTraitObjectType,
+		KeyFields: []schema.Field{
+			{Name: "class_id", Kind: schema.StringKind},
+			{Name: "nft_id", Kind: schema.StringKind},
+			{Name: "name", Kind: schema.StringKind},
+		},
+		// the value is the canonical JSON encoding of the trait value, as defined
+		// by the kind of the trait declared by the class.
+		ValueFields: []schema.Field{
+			{Name: "value", Kind: schema.JSONKind},
+		},
+	},
+)
+
+// ModuleCodec returns the schema of the nft module state and a decoder of its
+// key-value pairs, so that indexers can build collections of NFTs filterable by
+// trait without any nft specific decoding logic.
+func ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema:    moduleSchema,
+		KVDecoder: decodeKVPair,
+	}, nil
+}
+
+func decodeKVPair(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+	if len(update.Key) == 0 {
+		return nil, nil
+	}
+
+	prefix, key := update.Key[:1], update.Key[1:]
+	switch {
+	case bytes.Equal(prefix, ClassKey):
+		return decodeClass(string(key), update)
+	case bytes.Equal(prefix, NFTKey):
+		ids, err := splitKey(key, 2)
+		if err != nil {
+			return nil, err
+		}
+		return decodeNFT(ids, update)
+	case bytes.Equal(prefix, OwnerKey):
+		ids, err := splitKey(key, 2)
+		if err != nil {
+			return nil, err
+		}
+		objectUpdate := schema.StateObjectUpdate{TypeName: NFTOwnerObjectType, Key: ids, Delete: update.Remove}
+		if !update.Remove {
+			objectUpdate.Value = update.Value
+		}
+		return []schema.StateObjectUpdate{objectUpdate}, nil
+	case bytes.Equal(prefix, TraitKey):
+		ids, err := splitKey(key, 3)
+		if err != nil {
+			return nil, err
+		}
+		objectUpdate := schema.StateObjectUpdate{TypeName: NFTTraitObjectType, Key: ids, Delete: update.Remove}
+		if !update.Remove {
+			objectUpdate.Value = json.RawMessage(update.Value)
+		}
+		return []schema.StateObjectUpdate{objectUpdate}, nil
+	default:
+		// the total supply and the owner index are not decoded
+		return nil, nil
+	}
+}
+
+func decodeClass(classID string, update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+	if update.Remove {
+		return []schema.StateObjectUpdate{{TypeName: ClassObjectType, Key: classID, Delete: true}}, nil
+	}
+
+	var class nft.Class
+	if err := class.Unmarshal(update.Value); err != nil {
+		return nil, fmt.Errorf("failed to decode class %s: %w", classID, err)
+	}
+
+	updates := []schema.StateObjectUpdate{{
+		TypeName: ClassObjectType,
+		Key:      classID,
+		Value:    []interface{}{class.Name, class.Symbol, class.Description, class.Uri, class.UriHash},
+	}}
+	// the traits of a class are never removed, see Keeper.UpdateClass
+	for _, trait := range class.Traits {
+		updates = append(updates, schema.StateObjectUpdate{
+			TypeName: ClassTraitObjectType,
+			Key:      []interface{}{classID, trait.Name},
+			Value:    []interface{}{trait.Kind, trait.Required},
+		})
+	}
+	return updates, nil
+}
+
+func decodeNFT(key []interface{}, update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+	if update.Remove {
+		return []schema.StateObjectUpdate{{TypeName: NFTObjectType, Key: key, Delete: true}}, nil
+	}
+
+	var token nft.NFT
+	if err := token.Unmarshal(update.Value); err != nil {
+		return nil, fmt.Errorf("failed to decode nft %s/%s: %w", key[0], key[1], err)
+	}
+
+	return []schema.StateObjectUpdate{{
+		TypeName: NFTObjectType,
+		Key:      key,
+		Value:    []interface{}{token.Uri, token.UriHash},
+	}}, nil
+}
+
+// splitKey splits a key made of n delimited parts.
+func splitKey(key []byte, n int) ([]interface{}, error) {
+	parts := bytes.SplitN(key, Delimiter, n)
+	if len(parts) != n {
+		return nil, fmt.Errorf("invalid nft store key %X: expected %d parts", key, n)
+	}
+
+	res := make([]interface{}, n)
+	for i, part := range parts {
+		res[i] = string(part)
+	}
+	return res, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/nft"
 	"cosmossdk.io/x/nft/keeper"
@@ -41,6 +43,7 @@ type TestSuite struct {
 	suite.Suite
 
 	ctx           sdk.Context
+	storeKey      *storetypes.KVStoreKey
 	addrs         []sdk.AccAddress
 	encodedAddrs  []string
 	queryClient   nft.QueryClient
@@ -82,6 +85,7 @@ func (s *TestSuite) SetupTest() {
 	s.nftKeeper = nftKeeper
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
+	s.storeKey = key
 }
 
 func TestTestSuite(t *testing.T) {
@@ -399,3 +403,101 @@ func (s *TestSuite) TestInitGenesis() {
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
 }
+
+func (s *TestSuite) TestTraits() {
+	class := nft.Class{
+		Id: testClassID,
+		Traits: []*nft.TraitDefinition{
+			{Name: "color", Kind: "string", Required: true},
+			{Name: "generation", Kind: "uint32"},
+			{Name: "born_at", Kind: "time"},
+		},
+	}
+
+	invalidClass := class
+	invalidClass.Traits = []*nft.TraitDefinition{{Name: "color", Kind: "enum"}}
+	err := s.nftKeeper.SaveClass(s.ctx, invalidClass)
+	s.Require().ErrorContains(err, `unsupported kind "enum" for trait color`)
+	invalidClass.Traits = []*nft.TraitDefinition{{Name: "eye color", Kind: "string"}}
+	err = s.nftKeeper.SaveClass(s.ctx, invalidClass)
+	s.Require().ErrorContains(err, `invalid trait name "eye color"`)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+
+	testCases := []struct {
+		name   string
+		traits []*nft.Trait
+		expErr string
+	}{
+		{"missing required trait", []*nft.Trait{{Name: "generation", Value: "1"}}, "missing required trait color"},
+		{"undeclared trait", []*nft.Trait{{Name: "color", Value: "red"}, {Name: "size", Value: "1"}}, "trait size is not declared by the class"},
+		{"duplicate trait", []*nft.Trait{{Name: "color", Value: "red"}, {Name: "color", Value: "blue"}}, "duplicate trait color"},
+		{"invalid value", []*nft.Trait{{Name: "color", Value: "red"}, {Name: "generation", Value: "-1"}}, "expected uint32, got -1"},
+	}
+	for _, tc := range testCases {
+		err := s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Traits: tc.traits}, s.addrs[0])
+		s.Require().ErrorIs(err, nft.ErrInvalidTrait, tc.name)
+		s.Require().ErrorContains(err, tc.expErr, tc.name)
+	}
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+
+	token := nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+		Traits: []*nft.Trait{
+			{Name: "color", Value: "red"},
+			{Name: "generation", Value: "07"},
+			{Name: "born_at", Value: "2024-01-01T02:00:00.500+02:00"},
+		},
+	}
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, token, s.addrs[0]))
+	s.Require().Equal(map[string]json.RawMessage{
+		"color":      json.RawMessage(`"red"`),
+		"generation": json.RawMessage(`7`),
+		"born_at":    json.RawMessage(`"2024-01-01T00:00:00.5Z"`),
+	}, s.nftKeeper.GetTraits(s.ctx, testClassID, testID))
+
+	// the module codec decodes the nft state into valid object updates
+	codec, err := keeper.ModuleCodec()
+	s.Require().NoError(err)
+	var traitUpdates []schema.StateObjectUpdate
+	iterator := s.ctx.KVStore(s.storeKey).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		updates, err := codec.KVDecoder(schema.KVPairUpdate{Key: iterator.Key(), Value: iterator.Value()})
+		s.Require().NoError(err)
+		for _, update := range updates {
+			s.Require().NoError(codec.Schema.ValidateObjectUpdate(update))
+			if update.TypeName == keeper.NFTTraitObjectType {
+				traitUpdates = append(traitUpdates, update)
+			}
+		}
+	}
+	s.Require().NoError(iterator.Close())
+	s.Require().Equal([]schema.StateObjectUpdate{
+		{TypeName: keeper.NFTTraitObjectType, Key: []interface{}{testClassID, testID, "born_at"}, Value: json.RawMessage(`"2024-01-01T00:00:00.5Z"`)},
+		{TypeName: keeper.NFTTraitObjectType, Key: []interface{}{testClassID, testID, "color"}, Value: json.RawMessage(`"red"`)},
+		{TypeName: keeper.NFTTraitObjectType, Key: []interface{}{testClassID, testID, "generation"}, Value: json.RawMessage(`7`)},
+	}, traitUpdates)
+
+	// updating the nft replaces its traits
+	token.Traits = []*nft.Trait{{Name: "color", Value: "blue"}}
+	s.Require().NoError(s.nftKeeper.Update(s.ctx, token))
+	s.Require().Equal(map[string]json.RawMessage{"color": json.RawMessage(`"blue"`)}, s.nftKeeper.GetTraits(s.ctx, testClassID, testID))
+	token.Traits = nil
+	s.Require().ErrorContains(s.nftKeeper.Update(s.ctx, token), "missing required trait color")
+
+	// the traits of a class can only be extended with optional traits
+	updated := class
+	updated.Traits = class.Traits[1:]
+	s.Require().ErrorContains(s.nftKeeper.UpdateClass(s.ctx, updated), "traits cannot be removed from a class")
+	updated.Traits = append([]*nft.TraitDefinition{{Name: "color", Kind: "string"}}, class.Traits[1:]...)
+	s.Require().ErrorContains(s.nftKeeper.UpdateClass(s.ctx, updated), "trait color cannot be modified")
+	updated.Traits = append(class.Traits[:3:3], &nft.TraitDefinition{Name: "size", Kind: "int64", Required: true})
+	s.Require().ErrorContains(s.nftKeeper.UpdateClass(s.ctx, updated), "new trait size cannot be required")
+	updated.Traits = append(class.Traits[:3:3], &nft.TraitDefinition{Name: "size", Kind: "int64"})
+	s.Require().NoError(s.nftKeeper.UpdateClass(s.ctx, updated))
+
+	// burning the nft removes its traits
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID))
+	s.Require().Empty(s.nftKeeper.GetTraits(s.ctx, testClassID, testID))
+}
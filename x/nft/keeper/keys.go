@@ -16,6 +16,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	TraitKey             = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -105,3 +106,19 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// traitStoreKey returns the byte representation of the prefix of the nft traits
+// Items are stored with the following key: values
+// 0x06<classID><Delimiter(1 Byte)><nftID><Delimiter(1 Byte)><traitName>
+func traitStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(TraitKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz)+len(Delimiter))
+	copy(key, TraitKey)
+	copy(key[len(TraitKey):], classIDBz)
+	copy(key[len(TraitKey)+len(classIDBz):], Delimiter)
+	copy(key[len(TraitKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	copy(key[len(TraitKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz):], Delimiter)
+	return key
+}
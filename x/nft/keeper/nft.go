@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
@@ -28,7 +29,13 @@ func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it.
 func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	traits, err := k.encodeTraits(ctx, token)
+	if err != nil {
+		return err
+	}
+
 	k.setNFT(ctx, token)
+	k.setTraits(ctx, token.ClassId, token.Id, traits)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
 
@@ -69,6 +76,7 @@ func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) erro
 	owner := k.GetOwner(ctx, classID, nftID)
 	nftStore := k.getNFTStore(ctx, classID)
 	nftStore.Delete([]byte(nftID))
+	k.deleteTraits(ctx, classID, nftID)
 
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.decrTotalSupply(ctx, classID)
@@ -95,15 +103,22 @@ func (k Keeper) Update(ctx context.Context, token nft.NFT) error {
 	if !k.HasNFT(ctx, token.ClassId, token.Id) {
 		return errors.Wrap(nft.ErrNFTNotExists, token.Id)
 	}
-	k.updateWithNoCheck(ctx, token)
-	return nil
+	return k.updateWithNoCheck(ctx, token)
 }
 
 // updateWithNoCheck defines a method for updating an exist nft
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it
-func (k Keeper) updateWithNoCheck(ctx context.Context, token nft.NFT) {
+func (k Keeper) updateWithNoCheck(ctx context.Context, token nft.NFT) error {
+	traits, err := k.encodeTraits(ctx, token)
+	if err != nil {
+		return err
+	}
+
 	k.setNFT(ctx, token)
+	k.deleteTraits(ctx, token.ClassId, token.Id)
+	k.setTraits(ctx, token.ClassId, token.Id, traits)
+	return nil
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
@@ -219,6 +234,56 @@ func (k Keeper) setNFT(ctx context.Context, token nft.NFT) {
 	nftStore.Set([]byte(token.Id), bz)
 }
 
+// GetTraits returns the canonical JSON encoding of the trait values of the specified nft,
+// indexed by trait name
+func (k Keeper) GetTraits(ctx context.Context, classID, nftID string) map[string]json.RawMessage {
+	traitStore := k.getTraitStore(ctx, classID, nftID)
+	iterator := traitStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	traits := make(map[string]json.RawMessage)
+	for ; iterator.Valid(); iterator.Next() {
+		traits[string(iterator.Key())] = iterator.Value()
+	}
+	return traits
+}
+
+// encodeTraits checks the traits of the nft against the trait definitions of its class
+func (k Keeper) encodeTraits(ctx context.Context, token nft.NFT) (map[string]json.RawMessage, error) {
+	class, has := k.GetClass(ctx, token.ClassId)
+	if !has {
+		return nil, errors.Wrap(nft.ErrClassNotExists, token.ClassId)
+	}
+	traits, err := nft.EncodeTraits(class.Traits, token.Traits, k.ac)
+	if err != nil {
+		return nil, errors.Wrap(err, token.Id)
+	}
+	return traits, nil
+}
+
+// setTraits indexes the trait values of the nft, so that they can be decoded from the
+// store without the trait definitions of the class
+func (k Keeper) setTraits(ctx context.Context, classID, nftID string, traits map[string]json.RawMessage) {
+	traitStore := k.getTraitStore(ctx, classID, nftID)
+	for name, value := range traits {
+		traitStore.Set([]byte(name), value)
+	}
+}
+
+func (k Keeper) deleteTraits(ctx context.Context, classID, nftID string) {
+	traitStore := k.getTraitStore(ctx, classID, nftID)
+	iterator := traitStore.Iterator(nil, nil)
+	var names [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		names = append(names, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, name := range names {
+		traitStore.Delete(name)
+	}
+}
+
 func (k Keeper) setOwner(ctx context.Context, classID, nftID string, owner sdk.AccAddress) {
 	store := k.KVStoreService.OpenKVStore(ctx)
 	err := store.Set(ownerStoreKey(classID, nftID), owner.Bytes())
@@ -245,6 +310,11 @@ func (k Keeper) getNFTStore(ctx context.Context, classID string) prefix.Store {
 	return prefix.NewStore(runtime.KVStoreAdapter(store), nftStoreKey(classID))
 }
 
+func (k Keeper) getTraitStore(ctx context.Context, classID, nftID string) prefix.Store {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return prefix.NewStore(runtime.KVStoreAdapter(store), traitStoreKey(classID, nftID))
+}
+
 func (k Keeper) getClassStoreByOwner(ctx context.Context, owner sdk.AccAddress, classID string) prefix.Store {
 	store := k.KVStoreService.OpenKVStore(ctx)
 	key := nftOfClassByOwnerStoreKey(owner, classID)
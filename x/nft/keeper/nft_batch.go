@@ -62,7 +62,9 @@ func (k Keeper) BatchUpdate(ctx context.Context, tokens []nft.NFT) error {
 			return errors.Wrap(nft.ErrNFTNotExists, token.Id)
 		}
 		checked[token.ClassId] = true
-		k.updateWithNoCheck(ctx, token)
+		if err := k.updateWithNoCheck(ctx, token); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/schema"
 	"cosmossdk.io/x/nft"
 	"cosmossdk.io/x/nft/keeper"
 	"cosmossdk.io/x/nft/simulation"
@@ -29,6 +30,8 @@ var (
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasGenesis            = AppModule{}
 	_ appmodule.HasRegisterInterfaces = AppModule{}
+
+	_ schema.HasModuleCodec = AppModule{}
 )
 
 const ConsensusVersion = 1
@@ -116,6 +119,12 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// ModuleCodec implements schema.HasModuleCodec.
+// It allows the indexers to decode the nft module state, including the NFT traits.
+func (AppModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return keeper.ModuleCodec()
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
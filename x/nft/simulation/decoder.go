@@ -39,6 +39,8 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], keeper.TraitKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 		}
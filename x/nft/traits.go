@@ -0,0 +1,212 @@
+package nft
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/schema"
+)
+
+// traitKinds are the kinds supported for the traits of the NFTs, indexed by name.
+var traitKinds = map[string]schema.Kind{}
+
+func init() {
+	for _, kind := range []schema.Kind{
+		schema.StringKind,
+		schema.BoolKind,
+		schema.Int32Kind,
+		schema.Uint32Kind,
+		schema.Int64Kind,
+		schema.Uint64Kind,
+		schema.IntegerKind,
+		schema.DecimalKind,
+		schema.TimeKind,
+		schema.DurationKind,
+		schema.AddressKind,
+	} {
+		traitKinds[kind.String()] = kind
+	}
+}
+
+// TraitKind returns the schema kind of the trait values.
+func (t TraitDefinition) TraitKind() (schema.Kind, error) {
+	kind, ok := traitKinds[t.Kind]
+	if !ok {
+		return schema.InvalidKind, errors.Wrapf(ErrInvalidTrait, "unsupported kind %q for trait %s", t.Kind, t.Name)
+	}
+	return kind, nil
+}
+
+// ValidateTraitDefinitions checks that the trait definitions of a class have valid
+// and unique names, and a supported kind.
+func ValidateTraitDefinitions(traits []*TraitDefinition) error {
+	names := make(map[string]bool, len(traits))
+	for _, trait := range traits {
+		if !schema.ValidateName(trait.Name) {
+			return errors.Wrapf(ErrInvalidTrait, "invalid trait name %q", trait.Name)
+		}
+		if names[trait.Name] {
+			return errors.Wrapf(ErrInvalidTrait, "duplicate trait %s", trait.Name)
+		}
+		names[trait.Name] = true
+
+		if _, err := trait.TraitKind(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateTraitsUpdate checks that the trait definitions of a class can be updated to
+// the given ones: existing traits cannot be modified nor removed, and new traits must
+// be optional so that the existing NFTs of the class remain valid.
+func ValidateTraitsUpdate(current, updated []*TraitDefinition) error {
+	if len(updated) < len(current) {
+		return errors.Wrap(ErrInvalidTrait, "traits cannot be removed from a class")
+	}
+	for i, trait := range current {
+		if *updated[i] != *trait {
+			return errors.Wrapf(ErrInvalidTrait, "trait %s cannot be modified", trait.Name)
+		}
+	}
+	for _, trait := range updated[len(current):] {
+		if trait.Required {
+			return errors.Wrapf(ErrInvalidTrait, "new trait %s cannot be required", trait.Name)
+		}
+	}
+	return ValidateTraitDefinitions(updated)
+}
+
+// EncodeTraits checks that the traits of an NFT conform to the trait definitions
+// of its class, and returns the canonical JSON encoding of each trait value,
+// indexed by trait name. The JSON encoding of a value is the one defined by the
+// schema kind of its trait.
+func EncodeTraits(definitions []*TraitDefinition, traits []*Trait, ac address.Codec) (map[string]json.RawMessage, error) {
+	kinds := make(map[string]schema.Kind, len(definitions))
+	for _, definition := range definitions {
+		kind, err := definition.TraitKind()
+		if err != nil {
+			return nil, err
+		}
+		kinds[definition.Name] = kind
+	}
+
+	values := make(map[string]json.RawMessage, len(traits))
+	for _, trait := range traits {
+		kind, ok := kinds[trait.Name]
+		if !ok {
+			return nil, errors.Wrapf(ErrInvalidTrait, "trait %s is not declared by the class", trait.Name)
+		}
+		if _, ok := values[trait.Name]; ok {
+			return nil, errors.Wrapf(ErrInvalidTrait, "duplicate trait %s", trait.Name)
+		}
+
+		value, err := encodeTraitValue(kind, trait.Value, ac)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidTrait, "trait %s: %v", trait.Name, err)
+		}
+		values[trait.Name] = value
+	}
+
+	for _, definition := range definitions {
+		if _, ok := values[definition.Name]; definition.Required && !ok {
+			return nil, errors.Wrapf(ErrInvalidTrait, "missing required trait %s", definition.Name)
+		}
+	}
+
+	return values, nil
+}
+
+// encodeTraitValue parses the string representation of a trait value and returns
+// its canonical JSON encoding.
+func encodeTraitValue(kind schema.Kind, value string, ac address.Codec) (json.RawMessage, error) {
+	switch kind {
+	case schema.StringKind:
+		if err := kind.ValidateValue(value); err != nil {
+			return nil, err
+		}
+		return json.Marshal(value)
+	case schema.BoolKind:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected bool, got %s", value)
+		}
+		return json.Marshal(b)
+	case schema.Int32Kind:
+		i, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("expected int32, got %s", value)
+		}
+		return json.Marshal(i)
+	case schema.Uint32Kind:
+		u, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("expected uint32, got %s", value)
+		}
+		return json.Marshal(u)
+	case schema.Int64Kind:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected int64, got %s", value)
+		}
+		return json.Marshal(strconv.FormatInt(i, 10))
+	case schema.Uint64Kind:
+		u, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected uint64, got %s", value)
+		}
+		return json.Marshal(strconv.FormatUint(u, 10))
+	case schema.IntegerKind:
+		if err := kind.ValidateValue(value); err != nil {
+			return nil, err
+		}
+		i, _ := new(big.Int).SetString(value, 10)
+		return json.Marshal(i.String())
+	case schema.DecimalKind:
+		if err := kind.ValidateValue(value); err != nil {
+			return nil, err
+		}
+		return json.Marshal(value)
+	case schema.TimeKind:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, fmt.Errorf("expected RFC 3339 time, got %s", value)
+		}
+		return json.Marshal(t.UTC().Format(time.RFC3339Nano))
+	case schema.DurationKind:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("expected duration, got %s", value)
+		}
+		return json.Marshal(formatDurationSeconds(d))
+	case schema.AddressKind:
+		if _, err := ac.StringToBytes(value); err != nil {
+			return nil, fmt.Errorf("expected address, got %s: %w", value, err)
+		}
+		return json.Marshal(value)
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+}
+
+// formatDurationSeconds formats a duration as a number of seconds with no trailing
+// zeros followed by a lowercase 's', e.g. 1.5s.
+func formatDurationSeconds(d time.Duration) string {
+	sign := ""
+	secs, nanos := int64(d/time.Second), int64(d%time.Second)
+	if d < 0 {
+		sign, secs, nanos = "-", -secs, -nanos
+	}
+
+	s := fmt.Sprintf("%s%d", sign, secs)
+	if nanos != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+	}
+	return s + "s"
+}
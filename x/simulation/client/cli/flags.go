@@ -2,6 +2,9 @@ package cli
 
 import (
 	"flag"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
@@ -17,6 +20,7 @@ var (
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagExportCoveragePathValue string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -24,6 +28,8 @@ var (
 	FlagLeanValue               bool
 	FlagCommitValue             bool
 	FlagDBBackendValue          string
+	FlagModulesValue            []string
+	FlagModuleWeightsValue      map[string]float64
 
 	FlagEnabledValue     bool
 	FlagVerboseValue     bool
@@ -48,6 +54,9 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagLeanValue, "Lean", false, "lean simulation log output")
 	flag.BoolVar(&FlagCommitValue, "Commit", true, "have the simulation commit")
 	flag.StringVar(&FlagDBBackendValue, "DBBackend", "goleveldb", "custom db backend type: goleveldb, memdb")
+	flag.StringVar(&FlagExportCoveragePathValue, "ExportCoveragePath", "", "custom file path to save the simulation coverage report JSON")
+	flag.Func("Modules", "comma separated list of the modules whose operations and invariants are run, e.g. bank,staking; all the modules if empty", parseModules)
+	flag.Func("ModuleWeights", "comma separated multipliers of the weights of the operations of modules, e.g. bank=2,gov=0.5; 0 disables the operations of a module", parseModuleWeights)
 
 	// simulation flags
 	flag.BoolVar(&FlagEnabledValue, "Enabled", false, "enable the simulation")
@@ -75,6 +84,40 @@ func NewConfigFromFlags() simulation.Config {
 		Lean:               FlagLeanValue,
 		Commit:             FlagCommitValue,
 		DBBackend:          FlagDBBackendValue,
+		ExportCoveragePath: FlagExportCoveragePathValue,
+		Modules:            FlagModulesValue,
+		ModuleWeights:      FlagModuleWeightsValue,
 		FauxMerkle:         FlagFauxMerkle,
 	}
 }
+
+func parseModules(value string) error {
+	FlagModulesValue = nil
+	for _, module := range strings.Split(value, ",") {
+		if module = strings.TrimSpace(module); module != "" {
+			FlagModulesValue = append(FlagModulesValue, module)
+		}
+	}
+	return nil
+}
+
+func parseModuleWeights(value string) error {
+	FlagModuleWeightsValue = make(map[string]float64)
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+
+		module, weight, ok := strings.Cut(entry, "=")
+		if !ok {
+			return fmt.Errorf("invalid module weight %q, expected module=multiplier", entry)
+		}
+
+		multiplier, err := strconv.ParseFloat(strings.TrimSpace(weight), 64)
+		if err != nil || multiplier < 0 {
+			return fmt.Errorf("invalid weight multiplier of module %q: %s", module, weight)
+		}
+		FlagModuleWeightsValue[strings.TrimSpace(module)] = multiplier
+	}
+	return nil
+}
@@ -6,10 +6,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/slashing/types"
 
-	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -62,19 +60,7 @@ func (k Querier) SigningInfos(ctx context.Context, req *types.QuerySigningInfosR
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
-	store := k.KVStoreService.OpenKVStore(ctx)
-	var signInfos []types.ValidatorSigningInfo
-
-	sigInfoStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.ValidatorSigningInfoKeyPrefix)
-	pageRes, err := query.Paginate(sigInfoStore, req.Pagination, func(key, value []byte) error {
-		var info types.ValidatorSigningInfo
-		err := k.cdc.Unmarshal(value, &info)
-		if err != nil {
-			return err
-		}
-		signInfos = append(signInfos, info)
-		return nil
-	})
+	signInfos, pageRes, err := query.CollectPage(ctx, k.ValidatorSigningInfo, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +140,38 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	s.Require().NoError(s.slashingKeeper.Jail(s.ctx, consAddr))
 }
 
+func (s *KeeperTestSuite) TestSlashFractionDoubleSignForPower() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := slashingtypes.DefaultParams()
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the double sign slash fraction is not scaled by default
+	fraction, err := keeper.SlashFractionDoubleSignForPower(ctx, 100)
+	require.NoError(err)
+	require.Equal(params.SlashFractionDoubleSign, fraction)
+
+	params.DoubleSignSlashScaling.Enabled = true
+	params.DoubleSignSlashScaling.SaturationShare = sdkmath.LegacyNewDecWithPrec(5, 1)
+	params.DoubleSignSlashScaling.Exponent = 1
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	s.stakingKeeper.EXPECT().TotalBondedTokens(ctx).Return(sdk.TokensFromConsensusPower(300, sdk.DefaultPowerReduction), nil).Times(2)
+	s.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction).Times(2)
+
+	// a validator holding a quarter of the stake is slashed half way to the max fraction
+	fraction, err = keeper.SlashFractionDoubleSignForPower(ctx, 75)
+	require.NoError(err)
+	expected := params.SlashFractionDoubleSign.Add(params.DoubleSignSlashScaling.MaxSlashFraction).QuoInt64(2)
+	require.Equal(expected, fraction)
+
+	// a validator holding the saturation share of the stake is slashed the max fraction
+	fraction, err = keeper.SlashFractionDoubleSignForPower(ctx, 150)
+	require.NoError(err)
+	require.Equal(params.DoubleSignSlashScaling.MaxSlashFraction, fraction)
+}
+
 // ValidatorMissedBlockBitmapKey returns the key for a validator's missed block
 // bitmap chunk.
 func validatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
@@ -162,7 +194,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"df2afbb5f499f9f471674ac7d5816cb482c88dc5c50ec13909cd593d7eb09b88",
 	)
 	s.Require().NoError(err)
 
@@ -174,7 +206,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"df2afbb5f499f9f471674ac7d5816cb482c88dc5c50ec13909cd593d7eb09b88",
 	)
 	s.Require().NoError(err)
 }
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	v4 "cosmossdk.io/x/slashing/migrations/v4"
+	v5 "cosmossdk.io/x/slashing/migrations/v5"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
@@ -47,3 +48,10 @@ func (m Migrator) Migrate3to4(ctx context.Context) error {
 	}
 	return v4.Migrate(ctx, m.keeper.cdc, store, params, m.valCodec)
 }
+
+// Migrate4to5 migrates the x/slashing module state from the consensus
+// version 4 to version 5. Specifically, it sets the double sign slash scaling
+// params to their default, disabled value.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	return v5.Migrate(ctx, m.keeper.Params)
+}
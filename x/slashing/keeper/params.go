@@ -5,6 +5,8 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // SignedBlocksWindow - sliding window for downtime slashing
@@ -35,6 +37,35 @@ func (k Keeper) SlashFractionDoubleSign(ctx context.Context) (sdkmath.LegacyDec,
 	return params.SlashFractionDoubleSign, err
 }
 
+// SlashFractionDoubleSignForPower - fraction of power slashed in case of double sign
+// by a validator of the given consensus power, scaled with its share of the total
+// bonded stake if the double sign slash scaling is enabled
+func (k Keeper) SlashFractionDoubleSignForPower(ctx context.Context, power int64) (sdkmath.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	if !params.DoubleSignSlashScaling.Enabled {
+		return params.SlashFractionDoubleSign, nil
+	}
+
+	bondedTokens, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	// a validator is slashed the max fraction if the total power cannot be known,
+	// as it then holds all of it
+	share := sdkmath.LegacyOneDec()
+	totalPower := sdk.TokensToConsensusPower(bondedTokens, k.sk.PowerReduction(ctx))
+	if totalPower > 0 {
+		share = sdkmath.LegacyNewDec(power).QuoInt64(totalPower)
+	}
+
+	return params.DoubleSignSlashFraction(share), nil
+}
+
 // SlashFractionDowntime - fraction of power slashed for downtime
 func (k Keeper) SlashFractionDowntime(ctx context.Context) (sdkmath.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
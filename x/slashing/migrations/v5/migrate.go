@@ -0,0 +1,23 @@
+package v5
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/slashing/types"
+)
+
+// Migrate migrates state to consensus version 5. Specifically, the migration sets
+// the double sign slash scaling added to the params to its default, disabled value,
+// so that the double sign slash fraction is unchanged.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get slashing params: %w", err)
+	}
+
+	p.DoubleSignSlashScaling = types.DefaultDoubleSignSlashScaling()
+
+	return params.Set(ctx, p)
+}
@@ -0,0 +1,40 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/slashing"
+	v5 "cosmossdk.io/x/slashing/migrations/v5"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, slashing.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(slashingtypes.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	params := collections.NewItem(sb, slashingtypes.ParamsKey, "params", codec.CollValue[slashingtypes.Params](cdc))
+
+	// set the params without the double sign slash scaling
+	previous := slashingtypes.DefaultParams()
+	previous.DoubleSignSlashScaling = slashingtypes.DoubleSignSlashScaling{}
+	require.NoError(t, params.Set(ctx, previous))
+
+	require.NoError(t, v5.Migrate(ctx, params))
+
+	migrated, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, slashingtypes.DefaultParams(), migrated)
+	require.NoError(t, migrated.Validate())
+	require.Equal(t, previous.SlashFractionDoubleSign, migrated.DoubleSignSlashFraction(slashingtypes.DefaultSaturationShare))
+}
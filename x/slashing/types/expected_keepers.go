@@ -53,6 +53,11 @@ type StakingKeeper interface {
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx context.Context, addr sdk.ConsAddress) (bool, error)
 
+	// TotalBondedTokens returns the total amount of bonded tokens.
+	TotalBondedTokens(context.Context) (math.Int, error)
+	// PowerReduction returns the amount of tokens per unit of consensus power.
+	PowerReduction(context.Context) math.Int
+
 	// ValidatorIdentifier maps the new cons key to previous cons key (which is the address before the rotation).
 	// (that is: newConsKey -> oldConsKey)
 	ValidatorIdentifier(context.Context, sdk.ConsAddress) (sdk.ConsAddress, error)
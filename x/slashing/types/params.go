@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -17,8 +18,22 @@ var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+
+	// DefaultMaxSlashFractionDoubleSign is the default slash fraction of the validators
+	// holding at least the saturation share of the stake.
+	DefaultMaxSlashFractionDoubleSign = math.LegacyOneDec()
+	// DefaultSaturationShare is the default share of the stake from which the validators
+	// are slashed the max slash fraction: a third of the stake, from which a validator
+	// can break the safety of the chain with a few others.
+	DefaultSaturationShare = math.LegacyNewDec(1).Quo(math.LegacyNewDec(3))
 )
 
+// DefaultDoubleSignSlashExponent is the default exponent of the double sign slash scaling.
+const DefaultDoubleSignSlashExponent = 2
+
+// maxDoubleSignSlashExponent is the maximum exponent of the double sign slash scaling.
+const maxDoubleSignSlashExponent = 10
+
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
@@ -30,6 +45,18 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		DoubleSignSlashScaling:  DefaultDoubleSignSlashScaling(),
+	}
+}
+
+// DefaultDoubleSignSlashScaling returns the default double sign slash scaling, which
+// is disabled.
+func DefaultDoubleSignSlashScaling() DoubleSignSlashScaling {
+	return DoubleSignSlashScaling{
+		Enabled:          false,
+		MaxSlashFraction: DefaultMaxSlashFractionDoubleSign,
+		SaturationShare:  DefaultSaturationShare,
+		Exponent:         DefaultDoubleSignSlashExponent,
 	}
 }
 
@@ -61,9 +88,59 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if p.DoubleSignSlashScaling.Enabled {
+		if err := p.DoubleSignSlashScaling.Validate(); err != nil {
+			return err
+		}
+		if p.DoubleSignSlashScaling.MaxSlashFraction.LT(p.SlashFractionDoubleSign) {
+			return fmt.Errorf("double sign max slash fraction %s cannot be lower than the double sign slash fraction %s", p.DoubleSignSlashScaling.MaxSlashFraction, p.SlashFractionDoubleSign)
+		}
+	}
 	return nil
 }
 
+// Validate validates the double sign slash scaling. The scaling of the params is only
+// validated when enabled.
+func (s DoubleSignSlashScaling) Validate() error {
+	if s.MaxSlashFraction.IsNil() {
+		return errors.New("double sign max slash fraction cannot be nil")
+	}
+	if s.MaxSlashFraction.IsNegative() || s.MaxSlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("double sign max slash fraction must be between 0 and 1: %s", s.MaxSlashFraction)
+	}
+	if s.SaturationShare.IsNil() {
+		return errors.New("double sign saturation share cannot be nil")
+	}
+	if !s.SaturationShare.IsPositive() || s.SaturationShare.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("double sign saturation share must be positive and at most 1: %s", s.SaturationShare)
+	}
+	if s.Exponent == 0 || s.Exponent > maxDoubleSignSlashExponent {
+		return fmt.Errorf("double sign slash exponent must be between 1 and %d: %d", maxDoubleSignSlashExponent, s.Exponent)
+	}
+
+	return nil
+}
+
+// DoubleSignSlashFraction returns the fraction slashed for a double sign by a validator
+// holding the given share of the total bonded stake, see DoubleSignSlashScaling.
+func (p Params) DoubleSignSlashFraction(share math.LegacyDec) math.LegacyDec {
+	scaling := p.DoubleSignSlashScaling
+	if !scaling.Enabled {
+		return p.SlashFractionDoubleSign
+	}
+
+	saturation := math.LegacyOneDec()
+	if share.LT(scaling.SaturationShare) {
+		saturation = share.Quo(scaling.SaturationShare)
+	}
+	if saturation.IsNegative() {
+		saturation = math.LegacyZeroDec()
+	}
+
+	increase := scaling.MaxSlashFraction.Sub(p.SlashFractionDoubleSign).Mul(saturation.Power(uint64(scaling.Exponent)))
+	return p.SlashFractionDoubleSign.Add(increase)
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
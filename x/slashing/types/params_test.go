@@ -0,0 +1,126 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestDoubleSignSlashScalingValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(*Params)
+		expErr   string
+	}{
+		{
+			name:     "default",
+			malleate: func(*Params) {},
+		},
+		{
+			name: "disabled without scaling",
+			malleate: func(p *Params) {
+				p.DoubleSignSlashScaling = DoubleSignSlashScaling{}
+			},
+		},
+		{
+			name: "nil max slash fraction",
+			malleate: func(p *Params) {
+				p.DoubleSignSlashScaling.MaxSlashFraction = math.LegacyDec{}
+			},
+			expErr: "double sign max slash fraction cannot be nil",
+		},
+		{
+			name: "max slash fraction greater than one",
+			malleate: func(p *Params) {
+				p.DoubleSignSlashScaling.MaxSlashFraction = math.LegacyNewDec(2)
+			},
+			expErr: "double sign max slash fraction must be between 0 and 1",
+		},
+		{
+			name: "max slash fraction lower than the double sign slash fraction",
+			malleate: func(p *Params) {
+				p.DoubleSignSlashScaling.MaxSlashFraction = math.LegacyNewDecWithPrec(1, 2)
+			},
+			expErr: "cannot be lower than the double sign slash fraction",
+		},
+		{
+			name: "zero saturation share",
+			malleate: func(p *Params) {
+				p.DoubleSignSlashScaling.SaturationShare = math.LegacyZeroDec()
+			},
+			expErr: "double sign saturation share must be positive and at most 1",
+		},
+		{
+			name: "saturation share greater than one",
+			malleate: func(p *Params) {
+				p.DoubleSignSlashScaling.SaturationShare = math.LegacyNewDec(2)
+			},
+			expErr: "double sign saturation share must be positive and at most 1",
+		},
+		{
+			name: "zero exponent",
+			malleate: func(p *Params) {
+				p.DoubleSignSlashScaling.Exponent = 0
+			},
+			expErr: "double sign slash exponent must be between 1 and 10",
+		},
+		{
+			name: "exponent too large",
+			malleate: func(p *Params) {
+				p.DoubleSignSlashScaling.Exponent = 11
+			},
+			expErr: "double sign slash exponent must be between 1 and 10",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.DoubleSignSlashScaling.Enabled = true
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDoubleSignSlashFraction(t *testing.T) {
+	params := DefaultParams()
+	params.SlashFractionDoubleSign = math.LegacyNewDecWithPrec(5, 2)
+	params.DoubleSignSlashScaling = DoubleSignSlashScaling{
+		MaxSlashFraction: math.LegacyNewDecWithPrec(45, 2),
+		SaturationShare:  math.LegacyNewDecWithPrec(40, 2),
+		Exponent:         1,
+	}
+
+	// the double sign slash fraction is not scaled when the scaling is disabled
+	require.Equal(t, params.SlashFractionDoubleSign, params.DoubleSignSlashFraction(math.LegacyOneDec()))
+
+	params.DoubleSignSlashScaling.Enabled = true
+	tests := []struct {
+		share    math.LegacyDec
+		exponent uint32
+		expected math.LegacyDec
+	}{
+		{math.LegacyZeroDec(), 1, math.LegacyNewDecWithPrec(5, 2)},
+		{math.LegacyNewDecWithPrec(10, 2), 1, math.LegacyNewDecWithPrec(15, 2)},
+		{math.LegacyNewDecWithPrec(20, 2), 1, math.LegacyNewDecWithPrec(25, 2)},
+		{math.LegacyNewDecWithPrec(40, 2), 1, math.LegacyNewDecWithPrec(45, 2)},
+		{math.LegacyNewDecWithPrec(80, 2), 1, math.LegacyNewDecWithPrec(45, 2)},
+		{math.LegacyNewDecWithPrec(20, 2), 2, math.LegacyNewDecWithPrec(15, 2)},
+		{math.LegacyNewDecWithPrec(10, 2), 3, math.LegacyNewDecWithPrec(5625, 5)},
+		{math.LegacyNewDecWithPrec(40, 2), 3, math.LegacyNewDecWithPrec(45, 2)},
+	}
+
+	for _, tc := range tests {
+		params.DoubleSignSlashScaling.Exponent = tc.exponent
+		require.Equal(t, tc.expected.String(), params.DoubleSignSlashFraction(tc.share).String(), "share %s exponent %d", tc.share, tc.exponent)
+	}
+}
@@ -135,6 +135,9 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// double_sign_slash_scaling defines the optional scaling of the double sign slash
+	// fraction with the share of the total bonded stake of the offending validator.
+	DoubleSignSlashScaling DoubleSignSlashScaling `protobuf:"bytes,6,opt,name=double_sign_slash_scaling,json=doubleSignSlashScaling,proto3" json:"double_sign_slash_scaling"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +187,87 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDoubleSignSlashScaling() DoubleSignSlashScaling {
+	if m != nil {
+		return m.DoubleSignSlashScaling
+	}
+	return DoubleSignSlashScaling{}
+}
+
+// DoubleSignSlashScaling defines how the double sign slash fraction scales with the
+// share of the total bonded stake of the offending validator, which measures the
+// security impact of the equivocation. When enabled, a validator holding the share s
+// of the stake is slashed the fraction:
+//
+//	slash_fraction_double_sign + (max_slash_fraction - slash_fraction_double_sign) * min(s / saturation_share, 1)^exponent
+//
+// so that a validator holding at least saturation_share of the stake is slashed
+// max_slash_fraction.
+type DoubleSignSlashScaling struct {
+	// enabled defines if the double sign slash fraction is scaled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// max_slash_fraction is the slash fraction of a validator holding at least the
+	// saturation share of the stake. It must not be lower than the double sign slash
+	// fraction.
+	MaxSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_slash_fraction,json=maxSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slash_fraction"`
+	// saturation_share is the share of the total bonded stake from which a validator
+	// is slashed the max slash fraction.
+	SaturationShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=saturation_share,json=saturationShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"saturation_share"`
+	// exponent defines the curve of the scaling, e.g. 1 for linear or 2 for quadratic.
+	Exponent uint32 `protobuf:"varint,4,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *DoubleSignSlashScaling) Reset()         { *m = DoubleSignSlashScaling{} }
+func (m *DoubleSignSlashScaling) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignSlashScaling) ProtoMessage()    {}
+func (*DoubleSignSlashScaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *DoubleSignSlashScaling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSignSlashScaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleSignSlashScaling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoubleSignSlashScaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignSlashScaling.Merge(m, src)
+}
+func (m *DoubleSignSlashScaling) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSignSlashScaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignSlashScaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignSlashScaling proto.InternalMessageInfo
+
+func (m *DoubleSignSlashScaling) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DoubleSignSlashScaling) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*DoubleSignSlashScaling)(nil), "cosmos.slashing.v1beta1.DoubleSignSlashScaling")
 }
 
 func init() {
@@ -194,47 +275,55 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x4f, 0xeb, 0x46,
+	0x14, 0x8d, 0x13, 0xe0, 0xd1, 0x49, 0x9e, 0xfa, 0xde, 0x34, 0x0f, 0x4c, 0x5a, 0x9c, 0x80, 0xd4,
+	0x2a, 0x42, 0x8a, 0x0d, 0xa9, 0xd4, 0x05, 0xac, 0x1a, 0xa2, 0xaa, 0xad, 0x90, 0x8a, 0x9c, 0x7e,
+	0x48, 0x5d, 0xd4, 0x9a, 0x78, 0x26, 0xce, 0x14, 0x7b, 0x26, 0xf2, 0x4c, 0x20, 0xfc, 0x05, 0x56,
+	0xec, 0x5a, 0x75, 0xd5, 0x25, 0x4b, 0x16, 0xfc, 0x85, 0x4a, 0x2c, 0x11, 0xab, 0xaa, 0x0b, 0x5a,
+	0x85, 0x05, 0xfd, 0x19, 0x95, 0x67, 0xec, 0x24, 0x7c, 0x74, 0x95, 0x4d, 0x94, 0xb9, 0xf7, 0xdc,
+	0x73, 0xe6, 0x1e, 0x1f, 0x1b, 0x7c, 0xe2, 0x73, 0x11, 0x71, 0xe1, 0x88, 0x10, 0x89, 0x3e, 0x65,
+	0x81, 0x73, 0xbc, 0xd3, 0x25, 0x12, 0xed, 0x4c, 0x0a, 0xf6, 0x20, 0xe6, 0x92, 0xc3, 0x55, 0x8d,
+	0xb3, 0x27, 0xe5, 0x14, 0x57, 0x29, 0x07, 0x3c, 0xe0, 0x0a, 0xe3, 0x24, 0xff, 0x34, 0xbc, 0x62,
+	0x05, 0x9c, 0x07, 0x21, 0x71, 0xd4, 0xa9, 0x3b, 0xec, 0x39, 0x78, 0x18, 0x23, 0x49, 0x39, 0x4b,
+	0xfb, 0xd5, 0xa7, 0x7d, 0x49, 0x23, 0x22, 0x24, 0x8a, 0x06, 0x29, 0x60, 0x4d, 0xeb, 0x79, 0x9a,
+	0x39, 0x15, 0xd7, 0xad, 0xb7, 0x28, 0xa2, 0x8c, 0x3b, 0xea, 0x57, 0x97, 0x36, 0xff, 0xc8, 0x83,
+	0xf2, 0xf7, 0x28, 0xa4, 0x18, 0x49, 0x1e, 0x77, 0x68, 0xc0, 0x28, 0x0b, 0xbe, 0x62, 0x3d, 0x0e,
+	0xf7, 0xc0, 0x2b, 0x84, 0x71, 0x4c, 0x84, 0x30, 0x8d, 0x9a, 0x51, 0x7f, 0xaf, 0xb5, 0x71, 0x7b,
+	0xd5, 0x58, 0x4f, 0xe9, 0xf6, 0x39, 0x13, 0x84, 0x89, 0xa1, 0xf8, 0x5c, 0x43, 0x3a, 0x32, 0xa6,
+	0x2c, 0x70, 0xb3, 0x09, 0xb8, 0x01, 0x4a, 0x42, 0xa2, 0x58, 0x7a, 0x7d, 0x42, 0x83, 0xbe, 0x34,
+	0xf3, 0x35, 0xa3, 0x5e, 0x70, 0x8b, 0xaa, 0xf6, 0xa5, 0x2a, 0xc1, 0x8f, 0x41, 0x89, 0x32, 0x4c,
+	0x46, 0x1e, 0xef, 0xf5, 0x04, 0x91, 0x66, 0x21, 0x81, 0xb4, 0xf2, 0xa6, 0xe1, 0x16, 0x55, 0xfd,
+	0x1b, 0x55, 0x86, 0x07, 0xa0, 0xf4, 0x33, 0xa2, 0x21, 0xc1, 0xde, 0x90, 0x49, 0x1a, 0x9a, 0x0b,
+	0x35, 0xa3, 0x5e, 0x6c, 0x56, 0x6c, 0xed, 0x82, 0x9d, 0xb9, 0x60, 0x7f, 0x9b, 0xb9, 0xd0, 0x7a,
+	0x7d, 0x7d, 0x57, 0xcd, 0x9d, 0xff, 0x5d, 0x35, 0x2e, 0x1e, 0x2e, 0xb7, 0x0c, 0xb7, 0xa8, 0xc7,
+	0xbf, 0x4b, 0xa6, 0xa1, 0x05, 0x80, 0xe4, 0x51, 0x57, 0x48, 0xce, 0x08, 0x36, 0x17, 0x6b, 0x46,
+	0x7d, 0xd9, 0x9d, 0xa9, 0xc0, 0x26, 0x78, 0x17, 0x51, 0x21, 0x08, 0xf6, 0xba, 0x21, 0xf7, 0x8f,
+	0x84, 0xe7, 0xf3, 0x21, 0x93, 0x24, 0x36, 0x97, 0xd4, 0x02, 0x1f, 0xe8, 0x66, 0x4b, 0xf5, 0xf6,
+	0x75, 0x6b, 0x77, 0xe1, 0xdf, 0xdf, 0xab, 0xc6, 0xe6, 0x6f, 0x8b, 0x60, 0xe9, 0x10, 0xc5, 0x28,
+	0x12, 0x70, 0x1b, 0x94, 0x05, 0x0d, 0xd8, 0x94, 0xe4, 0x84, 0x32, 0xcc, 0x4f, 0x94, 0x8d, 0x05,
+	0x17, 0xea, 0x9e, 0xe6, 0xf8, 0x41, 0x75, 0x20, 0x4d, 0x64, 0x99, 0x97, 0x4e, 0x0d, 0x48, 0x9c,
+	0x8d, 0x24, 0xbe, 0x95, 0x5a, 0x9f, 0x25, 0x1b, 0xfd, 0x75, 0x57, 0xfd, 0x50, 0xbb, 0x2f, 0xf0,
+	0x91, 0x4d, 0xb9, 0x13, 0x21, 0xd9, 0xb7, 0x0f, 0x48, 0x80, 0xfc, 0xd3, 0x36, 0xf1, 0x6f, 0xaf,
+	0x1a, 0x20, 0x7d, 0x38, 0x6d, 0xe2, 0xeb, 0xd5, 0x61, 0x44, 0x59, 0x47, 0x71, 0x1e, 0x92, 0x38,
+	0x95, 0xfa, 0x09, 0xac, 0x60, 0x7e, 0xc2, 0x92, 0xd0, 0x78, 0x89, 0x33, 0x5e, 0x16, 0x2f, 0xf5,
+	0x00, 0x8a, 0xcd, 0xb5, 0x67, 0xce, 0xb6, 0x53, 0x80, 0x36, 0xf6, 0xd7, 0x89, 0xb1, 0xe5, 0x8c,
+	0xe7, 0x6b, 0x44, 0xc3, 0x0c, 0x04, 0x05, 0xa8, 0xa8, 0xa0, 0x7b, 0xbd, 0x18, 0xf9, 0x49, 0xc5,
+	0xc3, 0x7c, 0xd8, 0x0d, 0x89, 0x5a, 0xce, 0x5c, 0x98, 0x6b, 0x9f, 0x55, 0xc5, 0xfc, 0x45, 0x4a,
+	0xdc, 0x56, 0xbc, 0xc9, 0x7e, 0x90, 0x81, 0xd5, 0x67, 0xa2, 0xfa, 0x6e, 0xe6, 0xe2, 0x5c, 0x8a,
+	0xef, 0x9e, 0x28, 0x6a, 0x52, 0x78, 0x66, 0x80, 0xb5, 0x99, 0xb5, 0x3c, 0x2d, 0x2e, 0x7c, 0x14,
+	0x52, 0x16, 0xa8, 0xac, 0x14, 0x9b, 0x8e, 0xfd, 0x3f, 0xef, 0xbd, 0x3d, 0xbd, 0x78, 0x27, 0x69,
+	0x75, 0xf4, 0x58, 0xcb, 0x52, 0x77, 0xbc, 0x6a, 0xbc, 0x1d, 0x4d, 0xbe, 0x20, 0xb5, 0xe3, 0x6d,
+	0xbb, 0x69, 0x6f, 0xeb, 0xbb, 0xac, 0xe0, 0x17, 0xe7, 0x76, 0x37, 0xce, 0x1e, 0x2e, 0xb7, 0x3e,
+	0xd2, 0x62, 0x0d, 0x81, 0x8f, 0x9c, 0xe9, 0xbc, 0xa3, 0x13, 0xb9, 0xf9, 0x4b, 0x1e, 0xac, 0xbc,
+	0xac, 0x0a, 0x4d, 0xf0, 0x8a, 0x30, 0xd4, 0x0d, 0x09, 0x56, 0xf9, 0x5c, 0x76, 0xb3, 0x23, 0xc4,
+	0x00, 0x46, 0x68, 0xe4, 0x3d, 0x36, 0x76, 0xce, 0x44, 0xbe, 0x89, 0xd0, 0xa8, 0x33, 0x6b, 0x29,
+	0x44, 0xe0, 0x8d, 0x40, 0x32, 0x4d, 0x8f, 0x27, 0xfa, 0x28, 0x26, 0x66, 0x61, 0x2e, 0x8d, 0xf7,
+	0xa7, 0x7c, 0x9d, 0x84, 0x0e, 0x56, 0xc0, 0x32, 0x19, 0x0d, 0x38, 0x23, 0x4c, 0xaa, 0x00, 0xbe,
+	0x76, 0x27, 0xe7, 0xd6, 0xde, 0xc5, 0xd8, 0x32, 0xae, 0xc7, 0x96, 0x71, 0x33, 0xb6, 0x8c, 0x7f,
+	0xc6, 0x96, 0x71, 0x7e, 0x6f, 0xe5, 0x6e, 0xee, 0xad, 0xdc, 0x9f, 0xf7, 0x56, 0xee, 0xc7, 0xf5,
+	0x47, 0xd2, 0x33, 0xbe, 0xca, 0xd3, 0x01, 0x11, 0xdd, 0x25, 0xf5, 0x8e, 0x7c, 0xfa, 0xdf, 0x00,
+	0x0b, 0xc6, 0x1c, 0x0c, 0x0a, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -310,6 +399,42 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if !this.DoubleSignSlashScaling.Equal(&that1.DoubleSignSlashScaling) {
+		return false
+	}
+	return true
+}
+func (this *DoubleSignSlashScaling) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DoubleSignSlashScaling)
+	if !ok {
+		that2, ok := that.(DoubleSignSlashScaling)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if !this.MaxSlashFraction.Equal(that1.MaxSlashFraction) {
+		return false
+	}
+	if !this.SaturationShare.Equal(that1.SaturationShare) {
+		return false
+	}
+	if this.Exponent != that1.Exponent {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -395,6 +520,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.DoubleSignSlashScaling.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +550,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -441,6 +576,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DoubleSignSlashScaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSignSlashScaling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSignSlashScaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SaturationShare.Size()
+		i -= size
+		if _, err := m.SaturationShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxSlashFraction.Size()
+		i -= size
+		if _, err := m.MaxSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -496,6 +689,27 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DoubleSignSlashScaling.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DoubleSignSlashScaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MaxSlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SaturationShare.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Exponent != 0 {
+		n += 1 + sovSlashing(uint64(m.Exponent))
+	}
 	return n
 }
 
@@ -877,6 +1091,194 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignSlashScaling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DoubleSignSlashScaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoubleSignSlashScaling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleSignSlashScaling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleSignSlashScaling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaturationShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SaturationShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
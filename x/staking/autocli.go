@@ -29,6 +29,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_addr"},
 					},
 				},
+				{
+					RpcMethod: "ValidatorMetadataVerification",
+					Use:       "validator-metadata-verification <validator-addr>",
+					Short:     "Query the verification status of the metadata of a validator",
+					Long:      "Query the verification status of the metadata of a validator, and where its website proof is published, to re-check it against the recorded hash.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 				{
 					RpcMethod: "ValidatorDelegations",
 					Use:       "delegations-to <validator-addr>",
@@ -120,6 +129,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "HistoricalInfo", // Deprecated query
 					Skip:      true,
 				},
+				{
+					RpcMethod: "HistoricalValidatorSet",
+					Use:       "historical-validator-set <height>",
+					Short:     "Query the bonded validator set at a given height",
+					Long:      "Query the bonded validator set at a given height and its hash. Only the heights within the unbonding period are retained.",
+					Example:   fmt.Sprintf("%s query staking historical-validator-set 100", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "height"},
+					},
+				},
 				{
 					RpcMethod: "Pool",
 					Use:       "pool",
@@ -23,6 +23,8 @@ const (
 	FlagDetails                  = "details"
 	FlagMetadataProfilePicUri    = "metadata-profile-pic-uri"
 	FlagMetadataSocialHandleUris = "metadata-social-handle-uris"
+	FlagWebsiteProofMethod       = "website-proof-method"
+	FlagWebsiteProof             = "website-proof"
 
 	FlagCommissionRate          = "commission-rate"
 	FlagCommissionMaxRate       = "commission-max-rate"
@@ -93,6 +95,8 @@ func flagSetDescriptionEdit() *flag.FlagSet {
 	fs.String(FlagDetails, types.DoNotModifyDesc, "The validator's (optional) details")
 	fs.String(FlagMetadataProfilePicUri, "", "The  validator's profile pic uri")
 	fs.StringArray(FlagMetadataSocialHandleUris, []string{}, "The  validator's social handles uris")
+	fs.String(FlagWebsiteProofMethod, "", "The method the proof of the website domain is published with (dns-txt|well-known-file), requires --website")
+	fs.String(FlagWebsiteProof, "", "The file holding the proof of the website domain, as published with the website proof method")
 
 	return fs
 }
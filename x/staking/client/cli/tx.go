@@ -138,6 +138,12 @@ func NewEditValidatorCmd() *cobra.Command {
 				SocialHandleUris: socialHandlesUris,
 			}
 
+			websiteProof, err := buildWebsiteProof(cmd.Flags(), website)
+			if err != nil {
+				return err
+			}
+			metadata.WebsiteProof = websiteProof
+
 			description := types.NewDescription(moniker, identity, website, security, details, metadata)
 
 			var newRate *math.LegacyDec
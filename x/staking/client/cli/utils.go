@@ -3,8 +3,11 @@ package cli
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 
+	flag "github.com/spf13/pflag"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/types"
@@ -142,3 +145,45 @@ func buildMetadata(profilePicUri string, socialHandlesUris []string) (metadata t
 	}
 	return metadata, nil
 }
+
+// buildWebsiteProof builds the website proof of the given website from the website
+// proof flags, recording the hash of the published proof. It returns nil if no
+// website proof method is set.
+func buildWebsiteProof(fs *flag.FlagSet, website string) (*types.WebsiteProof, error) {
+	methodStr, _ := fs.GetString(FlagWebsiteProofMethod)
+	if methodStr == "" {
+		return nil, nil
+	}
+
+	var method types.WebsiteProofMethod
+	switch methodStr {
+	case "dns-txt":
+		method = types.WebsiteProofMethodDNSTXT
+	case "well-known-file":
+		method = types.WebsiteProofMethodWellKnownFile
+	default:
+		return nil, fmt.Errorf("invalid website proof method %s, expected dns-txt or well-known-file", methodStr)
+	}
+
+	if website == "" || website == types.DoNotModifyDesc {
+		return nil, fmt.Errorf("--%s is required with --%s", FlagWebsite, FlagWebsiteProofMethod)
+	}
+
+	domain, err := types.WebsiteDomain(website)
+	if err != nil {
+		return nil, err
+	}
+
+	proofPath, _ := fs.GetString(FlagWebsiteProof)
+	if proofPath == "" {
+		return nil, fmt.Errorf("--%s is required with --%s", FlagWebsiteProof, FlagWebsiteProofMethod)
+	}
+
+	publishedProof, err := os.ReadFile(proofPath)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := types.NewWebsiteProof(domain, method, publishedProof)
+	return proof, proof.Validate(website)
+}
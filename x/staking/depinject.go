@@ -32,7 +32,7 @@ func init() {
 	appconfig.RegisterModule(
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
-		appconfig.Invoke(InvokeSetStakingHooks),
+		appconfig.Invoke(InvokeSetStakingHooks, InvokeSetDelegationBatchHooks),
 	)
 }
 
@@ -124,6 +124,26 @@ func InvokeSetStakingHooks(
 	return nil
 }
 
+// InvokeSetDelegationBatchHooks sets the delegation batch hooks provided by the modules, which are
+// run in the order of the module names.
+func InvokeSetDelegationBatchHooks(
+	keeper *keeper.Keeper,
+	batchHooks map[string]types.DelegationBatchHooksWrapper,
+) {
+	// all arguments to invokers are optional
+	if keeper == nil || len(batchHooks) == 0 {
+		return
+	}
+
+	modNames := slices.Sorted(maps.Keys(batchHooks))
+	var multiHooks types.MultiDelegationBatchHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, batchHooks[modName])
+	}
+
+	keeper.SetDelegationBatchHooks(multiHooks)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the staking module.
@@ -54,9 +54,34 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateModuleDelegations(data.ModuleDelegations); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateModuleDelegations(moduleDelegations []types.ModuleDelegation) error {
+	seen := make(map[string]bool, len(moduleDelegations))
+	for _, md := range moduleDelegations {
+		if md.ModuleName == "" {
+			return fmt.Errorf("module delegation to validator %s has an empty module name", md.ValidatorAddress)
+		}
+
+		if md.Shares.IsNil() || !md.Shares.IsPositive() {
+			return fmt.Errorf("module delegation of %s to validator %s must have positive shares", md.ModuleName, md.ValidatorAddress)
+		}
+
+		key := md.ModuleName + "/" + md.ValidatorAddress
+		if seen[key] {
+			return fmt.Errorf("duplicate module delegation of %s to validator %s", md.ModuleName, md.ValidatorAddress)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
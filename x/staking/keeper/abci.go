@@ -14,5 +14,14 @@ func (k *Keeper) EndBlocker(ctx context.Context) ([]appmodule.ValidatorUpdate, e
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
 
-	return k.BlockValidatorUpdates(ctx)
+	updates, err := k.BlockValidatorUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.DeliverDelegationChanges(ctx); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
 }
@@ -116,6 +116,41 @@ func (k Keeper) IterateDelegations(ctx context.Context, delAddr sdk.AccAddress,
 	})
 }
 
+// IterateAllDelegations iterates through all of the delegations, ordered by delegator
+func (k Keeper) IterateAllDelegations(ctx context.Context,
+	fn func(index int64, del sdk.DelegationI) (stop bool),
+) error {
+	var i int64
+	return k.Delegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], del types.Delegation) (stop bool, err error) {
+		stop = fn(i, del)
+		if stop {
+			return true, nil
+		}
+		i++
+
+		return false, nil
+	})
+}
+
+// IterateAllDelegationsFrom iterates through the delegations, ordered by delegator, starting from the
+// delegations of the given delegator. It allows iterating through all of the delegations over several calls.
+func (k Keeper) IterateAllDelegationsFrom(ctx context.Context, delAddr sdk.AccAddress,
+	fn func(index int64, del sdk.DelegationI) (stop bool),
+) error {
+	var i int64
+	rng := new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).
+		StartInclusive(collections.PairPrefix[sdk.AccAddress, sdk.ValAddress](delAddr))
+	return k.Delegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], del types.Delegation) (stop bool, err error) {
+		stop = fn(i, del)
+		if stop {
+			return true, nil
+		}
+		i++
+
+		return false, nil
+	})
+}
+
 // GetAllSDKDelegations returns all delegations used during genesis dump
 // TODO: remove this func, change all usage for iterate functionality
 func (k Keeper) GetAllSDKDelegations(ctx context.Context) (delegations []types.Delegation, err error) {
@@ -116,6 +116,10 @@ func (k Keeper) RemoveDelegation(ctx context.Context, delegation types.Delegatio
 		return err
 	}
 
+	if err := k.recordDelegationChange(ctx, delegatorAddress, valAddr); err != nil {
+		return err
+	}
+
 	err = k.Delegations.Remove(ctx, collections.Join(sdk.AccAddress(delegatorAddress), sdk.ValAddress(valAddr)))
 	if err != nil {
 		return err
@@ -782,6 +786,10 @@ func (k Keeper) Delegate(
 		return newShares, err
 	}
 
+	if err := k.recordDelegationChange(ctx, delAddr, valbz); err != nil {
+		return newShares, err
+	}
+
 	return newShares, nil
 }
 
@@ -855,13 +863,20 @@ func (k Keeper) Unbond(
 		}
 
 		// call the after delegation modification hook
-		err = k.Hooks().AfterDelegationModified(ctx, delegatorAddress, valAddr)
+		if err = k.Hooks().AfterDelegationModified(ctx, delegatorAddress, valAddr); err == nil {
+			err = k.recordDelegationChange(ctx, delegatorAddress, valAddr)
+		}
 	}
 
 	if err != nil {
 		return amount, err
 	}
 
+	// the module-owned shares cannot exceed the remaining shares of the delegation
+	if err := k.capModuleDelegationShares(ctx, delAddr, valAddr, delegation.Shares); err != nil {
+		return amount, err
+	}
+
 	// remove the shares and coins from the validator
 	// NOTE that the amount is later (in keeper.Delegation) moved between staking module pools
 	validator, amount, err = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordDelegationChange records that the delegation was modified in the current block, so that
+// it is delivered to the batch hooks at EndBlock. Nothing is written if no batch hooks are set.
+func (k Keeper) recordDelegationChange(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if k.batchHooks == nil {
+		return nil
+	}
+
+	return k.ModifiedDelegations.Set(ctx, collections.Join(delAddr, valAddr))
+}
+
+// DeliverDelegationChanges delivers the delegations modified in the current block to the batch
+// hooks and clears them. It is called at EndBlock.
+func (k Keeper) DeliverDelegationChanges(ctx context.Context) error {
+	if k.batchHooks == nil {
+		return nil
+	}
+
+	var changes []types.DelegationChange
+	err := k.ModifiedDelegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (bool, error) {
+		changes = append(changes, types.DelegationChange{
+			DelegatorAddress: key.K1(),
+			ValidatorAddress: key.K2(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	for i, change := range changes {
+		found, err := k.Delegations.Has(ctx, collections.Join(change.DelegatorAddress, change.ValidatorAddress))
+		if err != nil {
+			return err
+		}
+		changes[i].Removed = !found
+	}
+
+	if err := k.ModifiedDelegations.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	return k.batchHooks.AfterDelegationsModified(ctx, changes)
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"context"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+)
+
+type mockBatchHooks struct {
+	calls [][]stakingtypes.DelegationChange
+}
+
+func (h *mockBatchHooks) AfterDelegationsModified(_ context.Context, changes []stakingtypes.DelegationChange) error {
+	h.calls = append(h.calls, changes)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestDeliverDelegationChanges() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	hooks := &mockBatchHooks{}
+	keeper.SetDelegationBatchHooks(hooks)
+
+	delAddrs, valAddrs := createValAddrs(2)
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+
+	shares := issuedShares.QuoInt64(2)
+	for _, delAddr := range delAddrs {
+		delegation := stakingtypes.NewDelegation(s.addressToString(delAddr), s.valAddressToString(valAddrs[0]), shares)
+		require.NoError(keeper.SetDelegation(ctx, delegation))
+	}
+
+	// no delegation was modified through the hooks yet
+	require.NoError(keeper.DeliverDelegationChanges(ctx))
+	require.Empty(hooks.calls)
+
+	// partially unbond the first delegation and fully unbond the second one, twice
+	_, err := keeper.Unbond(ctx, delAddrs[0], valAddrs[0], math.LegacyNewDec(1))
+	require.NoError(err)
+	_, err = keeper.Unbond(ctx, delAddrs[0], valAddrs[0], math.LegacyNewDec(1))
+	require.NoError(err)
+	_, err = keeper.Unbond(ctx, delAddrs[1], valAddrs[0], shares)
+	require.NoError(err)
+
+	require.NoError(keeper.DeliverDelegationChanges(ctx))
+	require.Len(hooks.calls, 1)
+	require.ElementsMatch([]stakingtypes.DelegationChange{
+		{DelegatorAddress: delAddrs[0], ValidatorAddress: valAddrs[0]},
+		{DelegatorAddress: delAddrs[1], ValidatorAddress: valAddrs[0], Removed: true},
+	}, hooks.calls[0])
+
+	// the changes are delivered once
+	require.NoError(keeper.DeliverDelegationChanges(ctx))
+	require.Len(hooks.calls, 1)
+}
@@ -136,6 +136,22 @@ func (s *KeeperTestSuite) TestDelegation() {
 	require.Equal(bond2to2, allBonds[4])
 	require.Equal(bond2to3, allBonds[5])
 
+	// iterate through the delegations from the ones of the second delegator
+	var fromBonds []sdk.DelegationI
+	require.NoError(keeper.IterateAllDelegationsFrom(ctx, addrDels[1], func(_ int64, del sdk.DelegationI) bool {
+		fromBonds = append(fromBonds, del)
+		return false
+	}))
+	require.Equal([]sdk.DelegationI{bond2to1, bond2to2, bond2to3}, fromBonds)
+
+	// iterating from no delegator goes through all of the delegations
+	fromBonds = nil
+	require.NoError(keeper.IterateAllDelegationsFrom(ctx, nil, func(_ int64, del sdk.DelegationI) bool {
+		fromBonds = append(fromBonds, del)
+		return false
+	}))
+	require.Len(fromBonds, 6)
+
 	resVals, err := keeper.GetDelegatorValidators(ctx, addrDels[0], 3)
 	require.NoError(err)
 	require.Equal(3, len(resVals.Validators))
@@ -177,6 +177,22 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		return nil, fmt.Errorf("not bonded pool balance is different from not bonded coins: %s <-> %s", notBondedBalance, notBondedCoins)
 	}
 
+	for _, moduleDelegation := range data.ModuleDelegations {
+		moduleAddr := k.authKeeper.GetModuleAddress(moduleDelegation.ModuleName)
+		if moduleAddr == nil {
+			return nil, fmt.Errorf("%s module account has not been set", moduleDelegation.ModuleName)
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(moduleDelegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.ModuleDelegations.Set(ctx, collections.Join(moduleAddr, sdk.ValAddress(valAddr)), moduleDelegation); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, record := range data.RotationIndexRecords {
 		if err := k.ValidatorConsensusKeyRotationRecordIndexKey.Set(ctx, collections.Join(record.Address, *record.Time)); err != nil {
 			return nil, err
@@ -227,6 +243,10 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	if err := k.TrackHistoricalValidatorSet(ctx, moduleValidatorUpdates); err != nil {
+		return nil, err
+	}
+
 	return moduleValidatorUpdates, nil
 }
 
@@ -331,6 +351,15 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	var moduleDelegations []types.ModuleDelegation
+	err = k.ModuleDelegations.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], value types.ModuleDelegation) (stop bool, err error) {
+		moduleDelegations = append(moduleDelegations, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -343,5 +372,6 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		RotationIndexRecords: rotationIndex,
 		RotationHistory:      conspubKeyRotationHistory,
 		RotationQueue:        rotationQueue,
+		ModuleDelegations:    moduleDelegations,
 	}, nil
 }
@@ -111,6 +111,38 @@ func (k Querier) Validator(ctx context.Context, req *types.QueryValidatorRequest
 	return &types.QueryValidatorResponse{Validator: validator}, nil
 }
 
+// ValidatorMetadataVerification queries the verification status of the metadata of a validator
+func (k Querier) ValidatorMetadataVerification(ctx context.Context, req *types.QueryValidatorMetadataVerificationRequest) (*types.QueryValidatorMetadataVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	proof := validator.Description.Metadata.WebsiteProof
+	if proof == nil {
+		return &types.QueryValidatorMetadataVerificationResponse{Status: types.MetadataVerificationStatusUnverified}, nil
+	}
+
+	return &types.QueryValidatorMetadataVerificationResponse{
+		Status:        types.MetadataVerificationStatusProofRecorded,
+		WebsiteProof:  proof,
+		ProofLocation: proof.Location(),
+	}, nil
+}
+
 // ValidatorDelegations queries delegate info for given validator
 func (k Querier) ValidatorDelegations(ctx context.Context, req *types.QueryValidatorDelegationsRequest) (*types.QueryValidatorDelegationsResponse, error) {
 	if req == nil {
@@ -419,6 +451,27 @@ func (k Querier) HistoricalInfo(ctx context.Context, req *types.QueryHistoricalI
 	return nil, status.Error(codes.Internal, "this endpoint has been deprecated and removed since v0.52")
 }
 
+// HistoricalValidatorSet queries the bonded validator set at a given height
+func (k Querier) HistoricalValidatorSet(ctx context.Context, req *types.QueryHistoricalValidatorSetRequest) (*types.QueryHistoricalValidatorSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	set, hash, err := k.GetHistoricalValidatorSet(ctx, req.Height)
+	if err != nil {
+		if errors.Is(err, types.ErrNoHistoricalValidatorSet) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalValidatorSetResponse{ValidatorSet: set, Hash: hash}, nil
+}
+
 // Redelegations queries redelegations of given address
 func (k Querier) Redelegations(ctx context.Context, req *types.QueryRedelegationsRequest) (*types.QueryRedelegationsResponse, error) {
 	if req == nil {
@@ -62,6 +62,34 @@ func (s *KeeperTestSuite) TestGRPCQueryValidator() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryValidatorMetadataVerification() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	_, err := queryClient.ValidatorMetadataVerification(gocontext.Background(), &types.QueryValidatorMetadataVerificationRequest{})
+	require.Error(err)
+
+	req := &types.QueryValidatorMetadataVerificationRequest{ValidatorAddr: validator.OperatorAddress}
+	res, err := queryClient.ValidatorMetadataVerification(gocontext.Background(), req)
+	require.NoError(err)
+	require.Equal(types.MetadataVerificationStatusUnverified, res.Status)
+	require.Nil(res.WebsiteProof)
+
+	proof := types.NewWebsiteProof("validator.cosmos", types.WebsiteProofMethodDNSTXT, []byte(validator.OperatorAddress))
+	validator.Description.Website = "https://validator.cosmos"
+	validator.Description.Metadata.WebsiteProof = proof
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	res, err = queryClient.ValidatorMetadataVerification(gocontext.Background(), req)
+	require.NoError(err)
+	require.Equal(types.MetadataVerificationStatusProofRecorded, res.Status)
+	require.Equal(proof, res.WebsiteProof)
+	require.Equal("_cosmos-validator.validator.cosmos", res.ProofLocation)
+}
+
 func (s *KeeperTestSuite) TestGRPCQueryValidators() {
 	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
 	require := s.Require()
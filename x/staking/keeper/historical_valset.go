@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/staking/types"
+)
+
+// TrackHistoricalValidatorSet records the validator set updates of the current block in the
+// historical validator set index, and prunes the changes older than the unbonding period by
+// folding them into the checkpoint validator set.
+// The index only stores the validator set changes with the hash of the resulting validator set,
+// the validator set at a given height is rebuilt from the checkpoint when queried.
+func (k Keeper) TrackHistoricalValidatorSet(ctx context.Context, updates []appmodule.ValidatorUpdate) error {
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+
+	if len(updates) > 0 {
+		latest, err := k.HistoricalValidatorSetLatest.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		diff := types.HistoricalValidatorSetDiff{
+			Time:    headerInfo.Time,
+			Updates: make([]types.HistoricalValidator, 0, len(updates)),
+		}
+		for _, update := range updates {
+			diff.Updates = append(diff.Updates, types.HistoricalValidator{
+				PubKey:     update.PubKey,
+				PubKeyType: update.PubKeyType,
+				Power:      update.Power,
+			})
+		}
+
+		latest.Height = headerInfo.Height
+		latest.Validators = applyHistoricalValidatorUpdates(latest.Validators, diff.Updates)
+		diff.Hash, err = k.historicalValidatorSetHash(latest.Validators)
+		if err != nil {
+			return err
+		}
+
+		if err := k.HistoricalValidatorSetDiffs.Set(ctx, headerInfo.Height, diff); err != nil {
+			return err
+		}
+
+		if err := k.HistoricalValidatorSetLatest.Set(ctx, latest); err != nil {
+			return err
+		}
+	}
+
+	return k.pruneHistoricalValidatorSet(ctx)
+}
+
+// SeedHistoricalValidatorSet starts tracking the historical validator set of a chain which did not
+// track it from genesis, by recording the last validator set as both the latest and the checkpoint
+// validator set at the previous height, whose EndBlocker resulted in it. It is a no-op if the
+// historical validator set is already tracked.
+func (k Keeper) SeedHistoricalValidatorSet(ctx context.Context) error {
+	has, err := k.HistoricalValidatorSetLatest.Has(ctx)
+	if err != nil || has {
+		return err
+	}
+
+	var updates []types.HistoricalValidator
+	err = k.LastValidatorPower.Walk(ctx, nil, func(valAddr []byte, power gogotypes.Int64Value) (bool, error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		update := validator.ModuleValidatorUpdate(k.PowerReduction(ctx))
+		updates = append(updates, types.HistoricalValidator{
+			PubKey:     update.PubKey,
+			PubKeyType: update.PubKeyType,
+			Power:      power.Value,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	set := types.HistoricalValidatorSet{
+		Height:     k.HeaderService.HeaderInfo(ctx).Height - 1,
+		Validators: applyHistoricalValidatorUpdates(nil, updates),
+	}
+	if err := k.HistoricalValidatorSetCheckpoint.Set(ctx, set); err != nil {
+		return err
+	}
+
+	return k.HistoricalValidatorSetLatest.Set(ctx, set)
+}
+
+// pruneHistoricalValidatorSet folds the validator set changes older than the unbonding period
+// into the checkpoint validator set.
+func (k Keeper) pruneHistoricalValidatorSet(ctx context.Context) error {
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	checkpoint, err := k.HistoricalValidatorSetCheckpoint.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	blockTime := k.HeaderService.HeaderInfo(ctx).Time
+	var pruned []int64
+	err = k.HistoricalValidatorSetDiffs.Walk(ctx, nil, func(height int64, diff types.HistoricalValidatorSetDiff) (bool, error) {
+		if !diff.Time.Add(unbondingTime).Before(blockTime) {
+			return true, nil
+		}
+
+		checkpoint.Height = height
+		checkpoint.Validators = applyHistoricalValidatorUpdates(checkpoint.Validators, diff.Updates)
+		pruned = append(pruned, height)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(pruned) == 0 {
+		return nil
+	}
+
+	for _, height := range pruned {
+		if err := k.HistoricalValidatorSetDiffs.Remove(ctx, height); err != nil {
+			return err
+		}
+	}
+
+	return k.HistoricalValidatorSetCheckpoint.Set(ctx, checkpoint)
+}
+
+// GetHistoricalValidatorSet returns the bonded validator set at the given height, as resulting
+// from the validator set updates of the EndBlocker at that height, along with its hash.
+// It returns ErrNoHistoricalValidatorSet if the height is pruned or not reached yet.
+func (k Keeper) GetHistoricalValidatorSet(ctx context.Context, height int64) (types.HistoricalValidatorSet, []byte, error) {
+	if height > k.HeaderService.HeaderInfo(ctx).Height {
+		return types.HistoricalValidatorSet{}, nil, errorsmod.Wrapf(types.ErrNoHistoricalValidatorSet, "height %d is not reached yet", height)
+	}
+
+	// without checkpoint, nothing was pruned and the validator set is rebuilt from all the changes
+	rng := new(collections.Range[int64]).EndInclusive(height)
+	set, err := k.HistoricalValidatorSetCheckpoint.Get(ctx)
+	switch {
+	case err == nil:
+		if height < set.Height {
+			return types.HistoricalValidatorSet{}, nil, errorsmod.Wrapf(types.ErrNoHistoricalValidatorSet, "height %d is pruned, the oldest retained height is %d", height, set.Height)
+		}
+		rng = rng.StartExclusive(set.Height)
+	case !errors.Is(err, collections.ErrNotFound):
+		return types.HistoricalValidatorSet{}, nil, err
+	}
+
+	var expectedHash []byte
+	err = k.HistoricalValidatorSetDiffs.Walk(ctx, rng, func(diffHeight int64, diff types.HistoricalValidatorSetDiff) (bool, error) {
+		set.Height = diffHeight
+		set.Validators = applyHistoricalValidatorUpdates(set.Validators, diff.Updates)
+		expectedHash = diff.Hash
+		return false, nil
+	})
+	if err != nil {
+		return types.HistoricalValidatorSet{}, nil, err
+	}
+
+	hash, err := k.historicalValidatorSetHash(set.Validators)
+	if err != nil {
+		return types.HistoricalValidatorSet{}, nil, err
+	}
+
+	if expectedHash != nil && !bytes.Equal(hash, expectedHash) {
+		return types.HistoricalValidatorSet{}, nil, fmt.Errorf("historical validator set hash mismatch at height %d", set.Height)
+	}
+
+	return set, hash, nil
+}
+
+// historicalValidatorSetHash returns the SHA-256 hash of the encoded validator set, without its height.
+func (k Keeper) historicalValidatorSetHash(validators []types.HistoricalValidator) ([]byte, error) {
+	bz, err := k.cdc.Marshal(&types.HistoricalValidatorSet{Validators: validators})
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// applyHistoricalValidatorUpdates applies the updates to the validator set and returns the
+// resulting validator set, sorted by decreasing power and then by public key.
+func applyHistoricalValidatorUpdates(validators, updates []types.HistoricalValidator) []types.HistoricalValidator {
+	byPubKey := make(map[string]types.HistoricalValidator, len(validators)+len(updates))
+	for _, val := range validators {
+		byPubKey[string(val.PubKey)] = val
+	}
+
+	for _, update := range updates {
+		if update.Power == 0 {
+			delete(byPubKey, string(update.PubKey))
+			continue
+		}
+
+		byPubKey[string(update.PubKey)] = update
+	}
+
+	result := make([]types.HistoricalValidator, 0, len(byPubKey))
+	for _, val := range byPubKey {
+		result = append(result, val)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Power != result[j].Power {
+			return result[i].Power > result[j].Power
+		}
+		return bytes.Compare(result[i].PubKey, result[j].PubKey) < 0
+	})
+
+	return result
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestHistoricalValidatorSet() {
+	require := s.Require()
+	keeper := s.stakingKeeper
+	querier := stakingkeeper.Querier{Keeper: keeper}
+
+	params, err := keeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.UnbondingTime = time.Hour
+	require.NoError(keeper.Params.Set(s.ctx, params))
+
+	pk1, pk2, pk3 := PKs[0].Bytes(), PKs[1].Bytes(), PKs[2].Bytes()
+	val := func(pk []byte, power int64) stakingtypes.HistoricalValidator {
+		return stakingtypes.HistoricalValidator{PubKey: pk, PubKeyType: "ed25519", Power: power}
+	}
+	update := func(pk []byte, power int64) appmodule.ValidatorUpdate {
+		return appmodule.ValidatorUpdate{PubKey: pk, PubKeyType: "ed25519", Power: power}
+	}
+
+	start := time.Now().UTC()
+	blocks := []struct {
+		height  int64
+		time    time.Time
+		updates []appmodule.ValidatorUpdate
+	}{
+		{height: 1, time: start, updates: []appmodule.ValidatorUpdate{update(pk1, 10), update(pk2, 20)}},
+		{height: 2, time: start.Add(time.Minute)},
+		{height: 3, time: start.Add(2 * time.Minute), updates: []appmodule.ValidatorUpdate{update(pk1, 30), update(pk3, 5)}},
+		{height: 4, time: start.Add(3 * time.Minute), updates: []appmodule.ValidatorUpdate{update(pk2, 0)}},
+	}
+	for _, block := range blocks {
+		ctx := s.ctx.WithHeaderInfo(header.Info{Height: block.height, Time: block.time})
+		require.NoError(keeper.TrackHistoricalValidatorSet(ctx, block.updates))
+	}
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 4, Time: start.Add(3 * time.Minute)})
+	testCases := []struct {
+		name       string
+		height     int64
+		expHeight  int64
+		expVals    []stakingtypes.HistoricalValidator
+		expErrCode codes.Code
+	}{
+		{
+			name:      "first change",
+			height:    1,
+			expHeight: 1,
+			expVals:   []stakingtypes.HistoricalValidator{val(pk2, 20), val(pk1, 10)},
+		},
+		{
+			name:      "height without change",
+			height:    2,
+			expHeight: 1,
+			expVals:   []stakingtypes.HistoricalValidator{val(pk2, 20), val(pk1, 10)},
+		},
+		{
+			name:      "power changes and new validator",
+			height:    3,
+			expHeight: 3,
+			expVals:   []stakingtypes.HistoricalValidator{val(pk1, 30), val(pk2, 20), val(pk3, 5)},
+		},
+		{
+			name:      "removed validator",
+			height:    4,
+			expHeight: 4,
+			expVals:   []stakingtypes.HistoricalValidator{val(pk1, 30), val(pk3, 5)},
+		},
+		{
+			name:       "future height",
+			height:     5,
+			expErrCode: codes.NotFound,
+		},
+		{
+			name:       "negative height",
+			height:     -1,
+			expErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := querier.HistoricalValidatorSet(ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{Height: tc.height})
+			if tc.expErrCode != codes.OK {
+				require.Equal(tc.expErrCode, status.Code(err))
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tc.expHeight, res.ValidatorSet.Height)
+			require.Equal(tc.expVals, res.ValidatorSet.Validators)
+
+			diff, err := keeper.HistoricalValidatorSetDiffs.Get(ctx, tc.expHeight)
+			require.NoError(err)
+			require.Equal(diff.Hash, res.Hash)
+		})
+	}
+
+	// the changes older than the unbonding period are folded into the checkpoint
+	ctx = s.ctx.WithHeaderInfo(header.Info{Height: 5, Time: start.Add(time.Hour + 2*time.Minute + time.Second)})
+	require.NoError(keeper.TrackHistoricalValidatorSet(ctx, nil))
+
+	checkpoint, err := keeper.HistoricalValidatorSetCheckpoint.Get(ctx)
+	require.NoError(err)
+	require.Equal(int64(3), checkpoint.Height)
+
+	has, err := keeper.HistoricalValidatorSetDiffs.Has(ctx, 1)
+	require.NoError(err)
+	require.False(has)
+	has, err = keeper.HistoricalValidatorSetDiffs.Has(ctx, 4)
+	require.NoError(err)
+	require.True(has)
+
+	_, err = querier.HistoricalValidatorSet(ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{Height: 2})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	res, err := querier.HistoricalValidatorSet(ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{Height: 3})
+	require.NoError(err)
+	require.Equal([]stakingtypes.HistoricalValidator{val(pk1, 30), val(pk2, 20), val(pk3, 5)}, res.ValidatorSet.Validators)
+
+	res, err = querier.HistoricalValidatorSet(ctx, &stakingtypes.QueryHistoricalValidatorSetRequest{Height: 5})
+	require.NoError(err)
+	require.Equal(int64(4), res.ValidatorSet.Height)
+	require.Equal([]stakingtypes.HistoricalValidator{val(pk1, 30), val(pk3, 5)}, res.ValidatorSet.Validators)
+}
+
+func (s *KeeperTestSuite) TestSeedHistoricalValidatorSet() {
+	require := s.Require()
+	keeper := s.stakingKeeper
+	migrator := stakingkeeper.NewMigrator(keeper)
+
+	val := func(pk []byte, power int64) stakingtypes.HistoricalValidator {
+		return stakingtypes.HistoricalValidator{PubKey: pk, PubKeyType: "ed25519", Power: power}
+	}
+
+	// the last validator set results from the EndBlocker of the block before the upgrade
+	for i, power := range []int64{10, 20} {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		require.NoError(keeper.SetValidator(s.ctx, testutil.NewValidator(s.T(), valAddr, PKs[i])))
+		require.NoError(keeper.SetLastValidatorPower(s.ctx, valAddr, power))
+	}
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Now().UTC()})
+	require.NoError(migrator.Migrate6to7(ctx))
+
+	pk1, pk2, pk3 := PKs[0].Bytes(), PKs[1].Bytes(), PKs[2].Bytes()
+	expVals := []stakingtypes.HistoricalValidator{val(pk2, 20), val(pk1, 10)}
+	for _, item := range []collections.Item[stakingtypes.HistoricalValidatorSet]{keeper.HistoricalValidatorSetLatest, keeper.HistoricalValidatorSetCheckpoint} {
+		set, err := item.Get(ctx)
+		require.NoError(err)
+		require.Equal(int64(9), set.Height)
+		require.Equal(expVals, set.Validators)
+	}
+
+	// the changes of the upgrade block apply on top of the seeded validator set
+	require.NoError(keeper.TrackHistoricalValidatorSet(ctx, []appmodule.ValidatorUpdate{
+		{PubKey: pk1, PubKeyType: "ed25519", Power: 0},
+		{PubKey: pk3, PubKeyType: "ed25519", Power: 5},
+	}))
+
+	set, _, err := keeper.GetHistoricalValidatorSet(ctx, 9)
+	require.NoError(err)
+	require.Equal(expVals, set.Validators)
+	set, _, err = keeper.GetHistoricalValidatorSet(ctx, 10)
+	require.NoError(err)
+	require.Equal([]stakingtypes.HistoricalValidator{val(pk2, 20), val(pk3, 5)}, set.Validators)
+	_, _, err = keeper.GetHistoricalValidatorSet(ctx, 8)
+	require.ErrorIs(err, stakingtypes.ErrNoHistoricalValidatorSet)
+
+	// the historical validator set is not seeded again once tracked
+	require.NoError(migrator.Migrate6to7(ctx.WithHeaderInfo(header.Info{Height: 11})))
+	latest, err := keeper.HistoricalValidatorSetLatest.Get(ctx)
+	require.NoError(err)
+	require.Equal(int64(10), latest.Height)
+}
@@ -59,6 +59,7 @@ type Keeper struct {
 	bankKeeper            types.BankKeeper
 	consensusKeeper       types.ConsensusKeeper
 	hooks                 types.StakingHooks
+	batchHooks            types.DelegationBatchHooks
 	authority             string
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
@@ -112,6 +113,16 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// ModuleDelegations key: moduleAddr+valAddr | value: ModuleDelegation
+	ModuleDelegations collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], types.ModuleDelegation]
+	// HistoricalValidatorSetDiffs key: height | value: HistoricalValidatorSetDiff
+	HistoricalValidatorSetDiffs collections.Map[int64, types.HistoricalValidatorSetDiff]
+	// HistoricalValidatorSetCheckpoint value: validator set at the oldest retained height
+	HistoricalValidatorSetCheckpoint collections.Item[types.HistoricalValidatorSet]
+	// HistoricalValidatorSetLatest value: validator set at the latest height
+	HistoricalValidatorSetLatest collections.Item[types.HistoricalValidatorSet]
+	// ModifiedDelegations key: delAddr+valAddr | value: none (delegations modified in the current block, only written when batch hooks are set)
+	ModifiedDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -289,6 +300,40 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 107 | moduleAddr | valAddr
+		ModuleDelegations: collections.NewMap(
+			sb, types.ModuleDelegationKey,
+			"module_delegations",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+			codec.CollValue[types.ModuleDelegation](cdc),
+		),
+
+		// key format is: 108 | height
+		HistoricalValidatorSetDiffs: collections.NewMap(
+			sb, types.HistoricalValidatorSetDiffKey,
+			"historical_validator_set_diffs",
+			collections.Int64Key,
+			codec.CollValue[types.HistoricalValidatorSetDiff](cdc),
+		),
+		// key is: 109 (it's a direct prefix)
+		HistoricalValidatorSetCheckpoint: collections.NewItem(
+			sb, types.HistoricalValidatorSetCheckpointKey,
+			"historical_validator_set_checkpoint",
+			codec.CollValue[types.HistoricalValidatorSet](cdc),
+		),
+		// key is: 110 (it's a direct prefix)
+		HistoricalValidatorSetLatest: collections.NewItem(
+			sb, types.HistoricalValidatorSetLatestKey,
+			"historical_validator_set_latest",
+			codec.CollValue[types.HistoricalValidatorSet](cdc),
+		),
+		// key format is: 111 | delAddr | valAddr
+		ModifiedDelegations: collections.NewKeySet(
+			sb, types.ModifiedDelegationsKey,
+			"modified_delegations",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -319,6 +364,16 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetDelegationBatchHooks sets the delegation batch hooks, which receive the delegations modified
+// during a block at EndBlock. Like SetHooks, this method must take a pointer.
+func (k *Keeper) SetDelegationBatchHooks(bh types.DelegationBatchHooks) {
+	if k.batchHooks != nil {
+		panic("cannot set delegation batch hooks twice")
+	}
+
+	k.batchHooks = bh
+}
+
 // GetAuthority returns the x/staking module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
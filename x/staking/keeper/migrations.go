@@ -47,3 +47,9 @@ func (m Migrator) Migrate5to6(ctx context.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.KVStoreService.OpenKVStore(ctx))
 	return v6.MigrateStore(ctx, store, m.keeper.cdc)
 }
+
+// Migrate6to7 migrates x/staking state from consensus version 6 to 7.
+// It seeds the historical validator set from the last validator set.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	return m.keeper.SeedHistoricalValidatorSet(ctx)
+}
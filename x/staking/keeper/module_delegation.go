@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DelegateFromModule delegates the given amount of bond denom tokens from the
+// module account of moduleName to the validator. The module account must have
+// the staking permission. The created shares are tracked as owned by the module
+// and can only be undelegated or redelegated with UndelegateFromModule and
+// BeginRedelegationFromModule.
+func (k Keeper) DelegateFromModule(
+	ctx context.Context, moduleName string, valAddr sdk.ValAddress, bondAmt math.Int,
+) (math.LegacyDec, error) {
+	moduleAddr, err := k.delegatingModuleAddress(ctx, moduleName)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	newShares, err := k.Delegate(ctx, moduleAddr, bondAmt, types.Unbonded, validator, true)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if err := k.addModuleDelegationShares(ctx, moduleName, moduleAddr, valAddr, newShares); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return newShares, nil
+}
+
+// UndelegateFromModule undelegates module-owned shares of moduleName from the
+// validator. The unbonded tokens are returned to the module account once the
+// unbonding period is over.
+func (k Keeper) UndelegateFromModule(
+	ctx context.Context, moduleName string, valAddr sdk.ValAddress, sharesAmount math.LegacyDec,
+) (time.Time, math.Int, error) {
+	moduleAddr, err := k.delegatingModuleAddress(ctx, moduleName)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	// the module-owned shares are removed first, so that they are not capped by Unbond
+	if err := k.subModuleDelegationShares(ctx, moduleAddr, valAddr, sharesAmount); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	return k.Undelegate(ctx, moduleAddr, valAddr, sharesAmount)
+}
+
+// BeginRedelegationFromModule redelegates module-owned shares of moduleName from
+// the source validator to the destination validator. The shares created on the
+// destination validator remain owned by the module.
+func (k Keeper) BeginRedelegationFromModule(
+	ctx context.Context, moduleName string, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount math.LegacyDec,
+) (time.Time, error) {
+	moduleAddr, err := k.delegatingModuleAddress(ctx, moduleName)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// the module-owned shares are removed first, so that they are not capped by Unbond
+	if err := k.subModuleDelegationShares(ctx, moduleAddr, valSrcAddr, sharesAmount); err != nil {
+		return time.Time{}, err
+	}
+
+	sharesBefore := math.LegacyZeroDec()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(moduleAddr, valDstAddr))
+	if err == nil {
+		sharesBefore = delegation.Shares
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return time.Time{}, err
+	}
+
+	completionTime, err := k.BeginRedelegation(ctx, moduleAddr, valSrcAddr, valDstAddr, sharesAmount)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	delegation, err = k.Delegations.Get(ctx, collections.Join(moduleAddr, valDstAddr))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := k.addModuleDelegationShares(ctx, moduleName, moduleAddr, valDstAddr, delegation.Shares.Sub(sharesBefore)); err != nil {
+		return time.Time{}, err
+	}
+
+	return completionTime, nil
+}
+
+// GetModuleDelegations returns all the delegations owned by the module account of moduleName.
+func (k Keeper) GetModuleDelegations(ctx context.Context, moduleName string) ([]types.ModuleDelegation, error) {
+	moduleAddr := k.authKeeper.GetModuleAddress(moduleName)
+	if moduleAddr == nil {
+		return nil, errorsmod.Wrapf(types.ErrModuleDelegationNotPermitted, "module account %s does not exist", moduleName)
+	}
+
+	var delegations []types.ModuleDelegation
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](moduleAddr)
+	err := k.ModuleDelegations.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], delegation types.ModuleDelegation) (stop bool, err error) {
+		delegations = append(delegations, delegation)
+		return false, nil
+	})
+
+	return delegations, err
+}
+
+// IsModuleOwnedDelegation returns true if the delegation of delAddr to valAddr
+// holds module-owned shares, which cannot be transferred through messages.
+func (k Keeper) IsModuleOwnedDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	return k.ModuleDelegations.Has(ctx, collections.Join(delAddr, valAddr))
+}
+
+// validateTransferableShares returns an error if the given amount of shares of
+// the delegation of delAddr to valAddr includes module-owned shares.
+func (k Keeper) validateTransferableShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	moduleDelegation, err := k.ModuleDelegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if err != nil {
+		return err
+	}
+
+	if delegation.Shares.Sub(moduleDelegation.Shares).LT(shares) {
+		return errorsmod.Wrapf(types.ErrModuleOwnedDelegation, "%s shares are owned by module %s", moduleDelegation.Shares, moduleDelegation.ModuleName)
+	}
+
+	return nil
+}
+
+// delegatingModuleAddress returns the address of the module account of
+// moduleName if it is permitted to delegate.
+func (k Keeper) delegatingModuleAddress(ctx context.Context, moduleName string) (sdk.AccAddress, error) {
+	if moduleName == types.BondedPoolName || moduleName == types.NotBondedPoolName {
+		return nil, errorsmod.Wrapf(types.ErrModuleDelegationNotPermitted, "%s is a staking pool", moduleName)
+	}
+
+	moduleAcc := k.authKeeper.GetModuleAccount(ctx, moduleName)
+	if moduleAcc == nil {
+		return nil, errorsmod.Wrapf(types.ErrModuleDelegationNotPermitted, "module account %s does not exist", moduleName)
+	}
+
+	if !moduleAcc.HasPermission(authtypes.Staking) {
+		return nil, errorsmod.Wrapf(types.ErrModuleDelegationNotPermitted, "module account %s does not have the %s permission", moduleName, authtypes.Staking)
+	}
+
+	return moduleAcc.GetAddress(), nil
+}
+
+func (k Keeper) addModuleDelegationShares(
+	ctx context.Context, moduleName string, moduleAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) error {
+	key := collections.Join(moduleAddr, valAddr)
+	delegation, err := k.ModuleDelegations.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return err
+		}
+
+		delegation = types.ModuleDelegation{
+			ModuleName:       moduleName,
+			ValidatorAddress: valAddrStr,
+			Shares:           math.LegacyZeroDec(),
+		}
+	} else if err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Add(shares)
+	return k.ModuleDelegations.Set(ctx, key, delegation)
+}
+
+// checkModuleDelegationShares returns an error if the module account doesn't
+// own at least the given amount of shares of its delegation to the validator.
+func (k Keeper) checkModuleDelegationShares(
+	ctx context.Context, moduleAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) error {
+	delegation, err := k.ModuleDelegations.Get(ctx, collections.Join(moduleAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrNoDelegation
+	} else if err != nil {
+		return err
+	}
+
+	if delegation.Shares.LT(shares) {
+		return errorsmod.Wrap(types.ErrNotEnoughModuleShares, delegation.Shares.String())
+	}
+
+	return nil
+}
+
+func (k Keeper) subModuleDelegationShares(
+	ctx context.Context, moduleAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) error {
+	if err := k.checkModuleDelegationShares(ctx, moduleAddr, valAddr, shares); err != nil {
+		return err
+	}
+
+	key := collections.Join(moduleAddr, valAddr)
+	delegation, err := k.ModuleDelegations.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		return k.ModuleDelegations.Remove(ctx, key)
+	}
+
+	return k.ModuleDelegations.Set(ctx, key, delegation)
+}
+
+// capModuleDelegationShares caps the module-owned shares of the delegation of delAddr
+// to valAddr to the remaining shares of the delegation, when its shares are removed
+// without going through the module functions, e.g. when a redelegation is slashed.
+func (k Keeper) capModuleDelegationShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) error {
+	key := collections.Join(delAddr, valAddr)
+	delegation, err := k.ModuleDelegations.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if delegation.Shares.LTE(shares) {
+		return nil
+	}
+
+	if shares.IsZero() {
+		return k.ModuleDelegations.Remove(ctx, key)
+	}
+
+	delegation.Shares = shares
+	return k.ModuleDelegations.Set(ctx, key, delegation)
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestModuleDelegations() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	const moduleName = "liquidstaking"
+	moduleAcc := authtypes.NewEmptyModuleAccount(moduleName, authtypes.Staking)
+	noPermAcc := authtypes.NewEmptyModuleAccount("noperm")
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), moduleName).Return(moduleAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "noperm").Return(noPermAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(moduleName).Return(moduleAcc.GetAddress()).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), moduleAcc.GetAddress(), stakingtypes.NotBondedPoolName, gomock.Any()).Return(nil).AnyTimes()
+
+	_, addrVals := createValAddrs(3)
+	for i, valAddr := range addrVals {
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		require.NoError(keeper.SetValidator(ctx, validator))
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	}
+
+	// only module accounts with the staking permission can delegate
+	_, err := keeper.DelegateFromModule(ctx, "noperm", addrVals[0], math.NewInt(100))
+	require.ErrorIs(err, stakingtypes.ErrModuleDelegationNotPermitted)
+	_, err = keeper.DelegateFromModule(ctx, stakingtypes.NotBondedPoolName, addrVals[0], math.NewInt(100))
+	require.ErrorIs(err, stakingtypes.ErrModuleDelegationNotPermitted)
+
+	shares, err := keeper.DelegateFromModule(ctx, moduleName, addrVals[0], math.NewInt(100))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(100), shares)
+
+	delegations, err := keeper.GetModuleDelegations(ctx, moduleName)
+	require.NoError(err)
+	require.Equal([]stakingtypes.ModuleDelegation{{
+		ModuleName:       moduleName,
+		ValidatorAddress: s.valAddressToString(addrVals[0]),
+		Shares:           math.LegacyNewDec(100),
+	}}, delegations)
+
+	owned, err := keeper.IsModuleOwnedDelegation(ctx, moduleAcc.GetAddress(), addrVals[0])
+	require.NoError(err)
+	require.True(owned)
+
+	// module-owned shares cannot be moved through messages
+	_, err = msgServer.Undelegate(ctx, &stakingtypes.MsgUndelegate{
+		DelegatorAddress: s.addressToString(moduleAcc.GetAddress()),
+		ValidatorAddress: s.valAddressToString(addrVals[0]),
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)),
+	})
+	require.ErrorIs(err, stakingtypes.ErrModuleOwnedDelegation)
+	_, err = msgServer.BeginRedelegate(ctx, &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    s.addressToString(moduleAcc.GetAddress()),
+		ValidatorSrcAddress: s.valAddressToString(addrVals[0]),
+		ValidatorDstAddress: s.valAddressToString(addrVals[1]),
+		Amount:              sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)),
+	})
+	require.ErrorIs(err, stakingtypes.ErrModuleOwnedDelegation)
+
+	// the owning module can redelegate and undelegate its shares
+	_, err = keeper.BeginRedelegationFromModule(ctx, moduleName, addrVals[0], addrVals[1], math.LegacyNewDec(40))
+	require.NoError(err)
+
+	delegations, err = keeper.GetModuleDelegations(ctx, moduleName)
+	require.NoError(err)
+	require.Len(delegations, 2)
+	require.Equal(math.LegacyNewDec(60), delegations[0].Shares)
+	require.Equal(math.LegacyNewDec(40), delegations[1].Shares)
+
+	_, _, err = keeper.UndelegateFromModule(ctx, moduleName, addrVals[1], math.LegacyNewDec(41))
+	require.ErrorIs(err, stakingtypes.ErrNotEnoughModuleShares)
+
+	_, amount, err := keeper.UndelegateFromModule(ctx, moduleName, addrVals[1], math.LegacyNewDec(40))
+	require.NoError(err)
+	require.Equal(math.NewInt(40), amount)
+
+	owned, err = keeper.IsModuleOwnedDelegation(ctx, moduleAcc.GetAddress(), addrVals[1])
+	require.NoError(err)
+	require.False(owned)
+
+	// slashing a redelegation of the module unbonds module-owned shares, which are no longer tracked
+	_, err = keeper.BeginRedelegationFromModule(ctx, moduleName, addrVals[0], addrVals[2], math.LegacyNewDec(40))
+	require.NoError(err)
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(notBondedAcc.GetAddress()).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), notBondedAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))).Return(nil).Times(4)
+
+	srcValidator, err := keeper.GetValidator(ctx, addrVals[0])
+	require.NoError(err)
+	redelegation := stakingtypes.NewRedelegation(
+		moduleAcc.GetAddress(), addrVals[0], addrVals[2], 0, ctx.HeaderInfo().Time.Add(time.Hour),
+		math.NewInt(40), math.LegacyNewDec(40), 0, keeper.ValidatorAddressCodec(), s.accountKeeper.AddressCodec(),
+	)
+	for _, expShares := range []int64{30, 20, 10} {
+		_, err = keeper.SlashRedelegation(ctx, srcValidator, redelegation, 0, math.LegacyNewDecWithPrec(25, 2))
+		require.NoError(err)
+
+		delegation, err := keeper.ModuleDelegations.Get(ctx, collections.Join(moduleAcc.GetAddress(), addrVals[2]))
+		require.NoError(err)
+		require.Equal(math.LegacyNewDec(expShares), delegation.Shares)
+	}
+
+	// the module delegation is removed with the delegation
+	_, err = keeper.SlashRedelegation(ctx, srcValidator, redelegation, 0, math.LegacyNewDecWithPrec(25, 2))
+	require.NoError(err)
+	owned, err = keeper.IsModuleOwnedDelegation(ctx, moduleAcc.GetAddress(), addrVals[2])
+	require.NoError(err)
+	require.False(owned)
+	_, _, err = keeper.UndelegateFromModule(ctx, moduleName, addrVals[2], math.LegacyNewDec(1))
+	require.ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	// module delegations are exported
+	require.NoError(keeper.LastTotalPower.Set(ctx, math.ZeroInt()))
+	genesis, err := keeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Len(genesis.ModuleDelegations, 1)
+	require.Equal(math.LegacyNewDec(20), genesis.ModuleDelegations[0].Shares)
+}
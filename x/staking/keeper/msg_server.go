@@ -343,6 +343,10 @@ func (k msgServer) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedel
 		return nil, err
 	}
 
+	if err := k.validateTransferableShares(ctx, delegatorAddress, valSrcAddr, shares); err != nil {
+		return nil, err
+	}
+
 	bondDenom, err := k.BondDenom(ctx)
 	if err != nil {
 		return nil, err
@@ -402,6 +406,10 @@ func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*t
 		return nil, err
 	}
 
+	if err := k.validateTransferableShares(ctx, delegatorAddress, addr, shares); err != nil {
+		return nil, err
+	}
+
 	bondDenom, err := k.BondDenom(ctx)
 	if err != nil {
 		return nil, err
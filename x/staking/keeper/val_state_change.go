@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"sort"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -38,6 +39,10 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 		return nil, err
 	}
 
+	if err := k.TrackHistoricalValidatorSet(ctx, validatorUpdates); err != nil {
+		return nil, err
+	}
+
 	// unbond all mature validators from the unbonding queue
 	err = k.UnbondAllMatureValidators(ctx)
 	if err != nil {
@@ -143,6 +148,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 		return nil, err
 	}
 	maxValidators := params.MaxValidators
+	maxChanges := params.MaxValidatorChangesPerBlock
 	powerReduction := k.PowerReduction(ctx)
 	totalPower := math.ZeroInt()
 	amtFromBondedToNotBonded, amtFromNotBondedToBonded := math.ZeroInt(), math.ZeroInt()
@@ -155,17 +161,17 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 		return nil, err
 	}
 
-	// Iterate over validators, highest power to lowest.
+	// Iterate over validators, highest power to lowest, collecting the validators
+	// becoming or already a part of the bonded validator set.
 	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer iterator.Close()
 
-	var updates []appmodule.ValidatorUpdate
-	for count := 0; iterator.Valid() && count < int(maxValidators); iterator.Next() {
-		// everything that is iterated in this loop is becoming or already a
-		// part of the bonded validator set
+	var bonded []types.Validator
+	noLongerBondedByAddr := maps.Clone(last)
+	for ; iterator.Valid() && len(bonded) < int(maxValidators); iterator.Next() {
 		valAddr := sdk.ValAddress(iterator.Value())
 		validator, err := k.GetValidator(ctx, valAddr)
 		if err != nil {
@@ -182,6 +188,48 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 			break
 		}
 
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+		delete(noLongerBondedByAddr, valAddrStr)
+		bonded = append(bonded, validator)
+	}
+
+	noLongerBonded, err := sortNoLongerBonded(noLongerBondedByAddr, k.validatorAddressCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Once the maximum number of updates is reached, the remaining updates are queued
+	// to the next blocks. The removals of validators leaving the bonded set take
+	// precedence, so that the bonded set never exceeds the maximum number of
+	// validators. The initial validator set is never limited.
+	removals := len(noLongerBonded)
+	limited := maxChanges > 0 && len(last) > 0
+	if limited && removals > int(maxChanges) {
+		removals = int(maxChanges)
+	}
+
+	var updates []appmodule.ValidatorUpdate
+	capped := func() bool {
+		return limited && len(updates)+removals >= int(maxChanges)
+	}
+
+	for _, validator := range bonded {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+		if err != nil {
+			return nil, err
+		}
+
+		// fetch the old power bytes
+		oldPowerBytes, found := last[validator.GetOperator()]
+		if !found && capped() {
+			// the validator joining the bonded set is left out of it
+			// so that it is picked up again in the next blocks.
+			continue
+		}
+
 		// apply the appropriate state change if necessary
 		switch {
 		case validator.IsUnbonded():
@@ -202,35 +250,43 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) ([]appmod
 			return nil, errors.New("unexpected validator status")
 		}
 
-		// fetch the old power bytes
-		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
-		if err != nil {
-			return nil, err
-		}
-		oldPowerBytes, found := last[valAddrStr]
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ModuleValidatorUpdate(powerReduction))
-			if err = k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
-				return nil, err
+			if found && capped() {
+				// the power change of a validator staying in the bonded set is queued:
+				// its last power is kept so that the change is picked up again in the
+				// next blocks.
+				var oldPower gogotypes.Int64Value
+				if err := k.cdc.Unmarshal(oldPowerBytes, &oldPower); err != nil {
+					return nil, err
+				}
+				newPower = oldPower.Value
+			} else {
+				updates = append(updates, validator.ModuleValidatorUpdate(powerReduction))
+				if err = k.SetLastValidatorPower(ctx, valAddr, newPower); err != nil {
+					return nil, err
+				}
 			}
 		}
 
-		delete(last, valAddrStr)
-		count++
-
 		totalPower = totalPower.Add(math.NewInt(newPower))
 	}
 
-	noLongerBonded, err := sortNoLongerBonded(last, k.validatorAddressCodec)
-	if err != nil {
-		return nil, err
-	}
+	for i, valAddrBytes := range noLongerBonded {
+		if i >= removals {
+			// the validator leaving the bonded set stays bonded with its last power
+			// so that its removal is picked up again in the next blocks.
+			lastPower, err := k.GetLastValidatorPower(ctx, valAddrBytes)
+			if err != nil {
+				return nil, err
+			}
+			totalPower = totalPower.Add(math.NewInt(lastPower))
+			continue
+		}
 
-	for _, valAddrBytes := range noLongerBonded {
 		validator, err := k.GetValidator(ctx, sdk.ValAddress(valAddrBytes))
 		if err != nil {
 			return nil, fmt.Errorf("validator record not found for address: %X", sdk.ValAddress(valAddrBytes))
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"time"
 
 	"go.uber.org/mock/gomock"
@@ -325,6 +326,106 @@ func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesPowerDecrease() {
 	require.Equal(validators[1].ModuleValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
 }
 
+func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesMaxChanges() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxValidatorChangesPerBlock = 1
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	powers := []int64{100, 90, 80}
+	var validators [3]stakingtypes.Validator
+	for i, power := range powers {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		tokens := keeper.TokensFromConsensusPower(ctx, power)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], false)
+	}
+
+	// the initial validator set is not limited
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 3)
+
+	// decrease the power of every validator, keeping their order
+	for i := range validators {
+		delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+		validators[i], _ = validators[i].RemoveDelShares(math.LegacyNewDecFromInt(delTokens))
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], false)
+	}
+
+	// the power changes are applied one per block, from the highest power on
+	expectedTotalPowers := []int64{260, 250, 240}
+	for i := range validators {
+		updates := s.applyValidatorSetUpdates(ctx, keeper, 1)
+		require.Equal(validators[i].ModuleValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+
+		lastPower, err := keeper.GetLastValidatorPower(ctx, sdk.ValAddress(PKs[i].Address().Bytes()))
+		require.NoError(err)
+		require.Equal(powers[i]-10, lastPower)
+
+		totalPower, err := keeper.LastTotalPower.Get(ctx)
+		require.NoError(err)
+		require.Equal(math.NewInt(expectedTotalPowers[i]), totalPower)
+	}
+
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+
+	requireBonded := func(i int, bonded bool) {
+		validator, err := keeper.GetValidator(ctx, sdk.ValAddress(PKs[i].Address().Bytes()))
+		require.NoError(err)
+		require.Equal(bonded, validator.IsBonded())
+		has, err := keeper.LastValidatorPower.Has(ctx, sdk.ValAddress(PKs[i].Address().Bytes()))
+		require.NoError(err)
+		require.Equal(bonded, has)
+	}
+
+	// the validators leaving the bonded set are removed one per block, the others
+	// staying bonded with their last power meanwhile
+	params.MaxValidators = 1
+	require.NoError(keeper.Params.Set(ctx, params))
+	removed := make(map[int]bool)
+	for _, expectedTotalPower := range []int64{160, 90} {
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+		updates := s.applyValidatorSetUpdates(ctx, keeper, 1)
+		require.Zero(updates[0].Power)
+		for i := 1; i < len(validators); i++ {
+			if bytes.Equal(updates[0].PubKey, PKs[i].Bytes()) {
+				removed[i] = true
+			}
+			requireBonded(i, !removed[i])
+		}
+
+		totalPower, err := keeper.LastTotalPower.Get(ctx)
+		require.NoError(err)
+		require.Equal(math.NewInt(expectedTotalPower), totalPower)
+	}
+	require.Len(removed, 2)
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+
+	// the validators joining the bonded set are bonded one per block, from the
+	// highest power on, the others staying out of it meanwhile
+	params.MaxValidators = 3
+	require.NoError(keeper.Params.Set(ctx, params))
+	for i, expectedTotalPower := range []int64{170, 240} {
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		updates := s.applyValidatorSetUpdates(ctx, keeper, 1)
+		require.Equal(PKs[i+1].Bytes(), updates[0].PubKey)
+		require.Equal(powers[i+1]-10, updates[0].Power)
+		requireBonded(i+1, true)
+		if i == 0 {
+			requireBonded(2, false)
+		}
+
+		totalPower, err := keeper.LastTotalPower.Get(ctx)
+		require.NoError(err)
+		require.Equal(math.NewInt(expectedTotalPower), totalPower)
+	}
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+}
+
 func (s *KeeperTestSuite) TestUpdateValidatorCommission() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
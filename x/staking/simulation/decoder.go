@@ -83,6 +83,21 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &historyB)
 
 			return fmt.Sprintf("%v\n%v", historyA, historyB)
+		case bytes.Equal(kvA.Key[:1], types.HistoricalValidatorSetDiffKey):
+			var diffA, diffB types.HistoricalValidatorSetDiff
+
+			cdc.MustUnmarshal(kvA.Value, &diffA)
+			cdc.MustUnmarshal(kvB.Value, &diffB)
+
+			return fmt.Sprintf("%v\n%v", diffA, diffB)
+		case bytes.Equal(kvA.Key[:1], types.HistoricalValidatorSetCheckpointKey),
+			bytes.Equal(kvA.Key[:1], types.HistoricalValidatorSetLatestKey):
+			var setA, setB types.HistoricalValidatorSet
+
+			cdc.MustUnmarshal(kvA.Value, &setA)
+			cdc.MustUnmarshal(kvB.Value, &setB)
+
+			return fmt.Sprintf("%v\n%v", setA, setB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -50,4 +50,15 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// module delegation errors
+	ErrModuleDelegationNotPermitted = errors.Register(ModuleName, 48, "module account is not permitted to delegate")
+	ErrModuleOwnedDelegation        = errors.Register(ModuleName, 49, "delegation is owned by a module and cannot be transferred")
+	ErrNotEnoughModuleShares        = errors.Register(ModuleName, 50, "not enough module-owned delegation shares")
+
+	// historical validator set errors
+	ErrNoHistoricalValidatorSet = errors.Register(ModuleName, 51, "no historical validator set found")
+
+	// validator metadata errors
+	ErrInvalidWebsiteProof = errors.Register(ModuleName, 52, "invalid website proof")
 )
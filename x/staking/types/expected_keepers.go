@@ -115,6 +115,22 @@ type StakingHooksWrapper struct{ StakingHooks }
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (StakingHooksWrapper) IsOnePerModuleType() {}
 
+// DelegationBatchHooks event hooks for staking delegations, delivered once per block.
+// They are an alternative to the per-change delegation hooks of StakingHooks for modules
+// which recompute state over all the delegations modified in a block.
+type DelegationBatchHooks interface {
+	// AfterDelegationsModified is called at EndBlock with the delegations created, modified
+	// or removed during the block, in a deterministic order. It isn't called
+	// when no delegation was modified.
+	AfterDelegationsModified(ctx context.Context, changes []DelegationChange) error
+}
+
+// DelegationBatchHooksWrapper is a wrapper for modules to inject DelegationBatchHooks using depinject.
+type DelegationBatchHooksWrapper struct{ DelegationBatchHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (DelegationBatchHooksWrapper) IsOnePerModuleType() {}
+
 type ConsensusKeeper interface {
 	ValidatorPubKeyTypes(context.Context) ([]string, error)
 }
@@ -53,6 +53,8 @@ type GenesisState struct {
 	RotationIndexRecords []RotationIndexRecord       `protobuf:"bytes,9,rep,name=rotation_index_records,json=rotationIndexRecords,proto3" json:"rotation_index_records"`
 	RotationHistory      []ConsPubKeyRotationHistory `protobuf:"bytes,10,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
 	RotationQueue        []RotationQueueRecord       `protobuf:"bytes,11,rep,name=rotation_queue,json=rotationQueue,proto3" json:"rotation_queue"`
+	// module_delegations defines the module-owned delegations active at genesis.
+	ModuleDelegations []ModuleDelegation `protobuf:"bytes,12,rep,name=module_delegations,json=moduleDelegations,proto3" json:"module_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -158,6 +160,13 @@ func (m *GenesisState) GetRotationQueue() []RotationQueueRecord {
 	return nil
 }
 
+func (m *GenesisState) GetModuleDelegations() []ModuleDelegation {
+	if m != nil {
+		return m.ModuleDelegations
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -301,52 +310,53 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xfc, 0x29, 0xed, 0x14, 0xf8, 0xf1, 0x1b, 0x0a, 0x59, 0x1b, 0xd3, 0xd6, 0x86,
-	0x43, 0x83, 0x61, 0xd7, 0xa2, 0xf1, 0xe0, 0x8d, 0x6a, 0xa2, 0x04, 0x22, 0xb8, 0x80, 0x07, 0x12,
-	0xb3, 0x99, 0xb2, 0xc3, 0xb2, 0x61, 0xbb, 0x53, 0x67, 0xa6, 0x95, 0xbe, 0x03, 0x8f, 0xdc, 0xbd,
-	0x70, 0xf4, 0xe8, 0x81, 0x17, 0xc1, 0xc5, 0x84, 0x70, 0x32, 0x1e, 0xd0, 0xc0, 0x41, 0x5f, 0x86,
-	0xd9, 0x99, 0xdd, 0x65, 0x9a, 0xb2, 0x44, 0x2f, 0x4d, 0xa7, 0xcf, 0xf7, 0xfb, 0xf9, 0x3e, 0xcf,
-	0x76, 0xe7, 0x01, 0x0b, 0x7b, 0x84, 0xb5, 0x09, 0x33, 0x19, 0x47, 0x87, 0x5e, 0xe0, 0x9a, 0xbd,
-	0x46, 0x0b, 0x73, 0xd4, 0x30, 0x5d, 0x1c, 0x60, 0xe6, 0x31, 0xa3, 0x43, 0x09, 0x27, 0x70, 0x5e,
-	0xaa, 0x8c, 0x48, 0x65, 0x44, 0xaa, 0x52, 0xd1, 0x25, 0x2e, 0x11, 0x12, 0x33, 0xfc, 0x26, 0xd5,
-	0xa5, 0x34, 0x66, 0xec, 0x96, 0xaa, 0x7b, 0x52, 0x65, 0x4b, 0x7b, 0x14, 0x20, 0x4b, 0xff, 0xa3,
-	0xb6, 0x17, 0x10, 0x53, 0x7c, 0x46, 0x3f, 0x55, 0x5c, 0x42, 0x5c, 0x1f, 0x9b, 0xe2, 0xd4, 0xea,
-	0xee, 0x9b, 0xdc, 0x6b, 0x63, 0xc6, 0x51, 0xbb, 0x23, 0x05, 0xb5, 0xaf, 0x13, 0x60, 0xf2, 0xa5,
-	0x6c, 0x7a, 0x8b, 0x23, 0x8e, 0xe1, 0x0a, 0xc8, 0x76, 0x10, 0x45, 0x6d, 0xa6, 0x6b, 0x55, 0xad,
-	0x5e, 0x58, 0x2e, 0x1b, 0xb7, 0x0f, 0x61, 0x6c, 0x0a, 0x55, 0x33, 0x7f, 0x76, 0x59, 0xc9, 0x7c,
-	0xfe, 0xf5, 0x65, 0x51, 0xb3, 0x22, 0x23, 0xdc, 0x05, 0x33, 0x3e, 0x62, 0xdc, 0xe6, 0x84, 0x23,
-	0xdf, 0xee, 0x90, 0x0f, 0x98, 0xea, 0x23, 0x55, 0xad, 0x3e, 0xd9, 0x7c, 0x14, 0x8a, 0xbf, 0x5f,
-	0x56, 0xe6, 0x24, 0x93, 0x39, 0x87, 0x86, 0x47, 0xcc, 0x36, 0xe2, 0x07, 0xc6, 0x6a, 0xc0, 0x2f,
-	0x4e, 0x97, 0x40, 0x14, 0xb6, 0x1a, 0x70, 0xc9, 0x9c, 0x0e, 0x49, 0xdb, 0x21, 0x68, 0x33, 0xe4,
-	0x40, 0x0f, 0xcc, 0x09, 0x76, 0x0f, 0xf9, 0x9e, 0x83, 0x38, 0xa1, 0x92, 0xcf, 0xf4, 0xd1, 0xea,
-	0x68, 0xbd, 0xb0, 0xbc, 0x98, 0xd6, 0xed, 0x3a, 0x62, 0xfc, 0x6d, 0xec, 0x11, 0x28, 0xb5, 0xf3,
-	0x59, 0x7f, 0xa8, 0xcc, 0xe0, 0x3a, 0x00, 0x49, 0x0a, 0xd3, 0xc7, 0x04, 0xff, 0x41, 0x1a, 0x3f,
-	0x31, 0xab, 0x58, 0xc5, 0x0f, 0x37, 0x40, 0xc1, 0xc1, 0x3e, 0x76, 0x11, 0xf7, 0x48, 0xc0, 0xf4,
-	0x71, 0x81, 0xab, 0xa5, 0xe1, 0x5e, 0x24, 0x52, 0x95, 0xa7, 0x12, 0xe0, 0x21, 0x98, 0xeb, 0x06,
-	0x2d, 0x12, 0x38, 0x5e, 0xe0, 0xda, 0x2a, 0x3a, 0x2b, 0xd0, 0x0f, 0xd3, 0xd0, 0x3b, 0xb1, 0xe9,
-	0xf6, 0x8c, 0x62, 0x77, 0xb8, 0xce, 0xe0, 0x0e, 0x98, 0xa2, 0x58, 0x0d, 0x99, 0x10, 0x21, 0x0b,
-	0x69, 0x21, 0x96, 0x22, 0x56, 0xe9, 0x83, 0x14, 0x58, 0x02, 0x39, 0x7c, 0xd4, 0x21, 0x94, 0x63,
-	0x47, 0xcf, 0x55, 0xb5, 0x7a, 0xce, 0x4a, 0xce, 0xd0, 0x07, 0xf3, 0x94, 0x70, 0x21, 0xb4, 0xbd,
-	0xc0, 0xc1, 0x47, 0x36, 0xc5, 0x7b, 0x84, 0x3a, 0x4c, 0xcf, 0xdf, 0x3d, 0xa0, 0x15, 0xb9, 0x56,
-	0x43, 0x93, 0x25, 0x3c, 0x03, 0x03, 0xd2, 0xe1, 0x3a, 0x83, 0x2e, 0x98, 0x49, 0xd2, 0x0e, 0x3c,
-	0xc6, 0x09, 0xed, 0xeb, 0x40, 0xe4, 0x34, 0xd2, 0x72, 0x9e, 0x93, 0x80, 0x6d, 0x76, 0x5b, 0x6b,
-	0xb8, 0x1f, 0x27, 0xbe, 0x92, 0x46, 0x35, 0xed, 0x3f, 0x3a, 0x58, 0x83, 0xef, 0xc0, 0x74, 0x12,
-	0xf4, 0xbe, 0x8b, 0xbb, 0x58, 0x2f, 0xfc, 0xdd, 0x38, 0x6f, 0x42, 0xf1, 0xf0, 0x38, 0x53, 0x54,
-	0xad, 0xd7, 0x0e, 0x00, 0x1c, 0x7e, 0xd5, 0xe1, 0x32, 0x98, 0x40, 0x8e, 0x43, 0x31, 0x93, 0xb7,
-	0x3a, 0xdf, 0xd4, 0x2f, 0x4e, 0x97, 0x8a, 0x51, 0xe0, 0x8a, 0xac, 0x6c, 0x71, 0xea, 0x05, 0xae,
-	0x15, 0x0b, 0x61, 0x11, 0x8c, 0xdf, 0x5c, 0xdd, 0x51, 0x4b, 0x1e, 0x9e, 0xe5, 0x3e, 0x9e, 0x54,
-	0x32, 0xbf, 0x4f, 0x2a, 0x99, 0x1a, 0x01, 0xb3, 0xb7, 0x3c, 0x69, 0xa8, 0x0f, 0x46, 0x4d, 0xde,
-	0x00, 0x9f, 0x80, 0xb1, 0x70, 0xfb, 0xe8, 0x59, 0xb1, 0x57, 0x4a, 0x86, 0x5c, 0x4d, 0x46, 0xbc,
-	0x9a, 0x8c, 0xed, 0x78, 0x35, 0x35, 0xc7, 0x8e, 0x7f, 0x54, 0x34, 0x4b, 0xa8, 0x95, 0xc0, 0x4f,
-	0xda, 0x4d, 0xa2, 0xf2, 0x30, 0xe0, 0x6b, 0x90, 0xef, 0x21, 0xdf, 0x0e, 0x63, 0xe2, 0xa5, 0xd5,
-	0xb8, 0xe3, 0x9a, 0x86, 0xe3, 0xb2, 0x8d, 0x7d, 0x41, 0xc2, 0x4e, 0xf8, 0x27, 0xae, 0xe1, 0x3e,
-	0xb3, 0x72, 0xbd, 0xa8, 0x94, 0xf4, 0x39, 0xf2, 0x2f, 0x7d, 0x36, 0x9f, 0x9e, 0x5d, 0x95, 0xb5,
-	0xf3, 0xab, 0xb2, 0xf6, 0xf3, 0xaa, 0xac, 0x1d, 0x5f, 0x97, 0x33, 0xe7, 0xd7, 0xe5, 0xcc, 0xb7,
-	0xeb, 0x72, 0x66, 0xf7, 0xfe, 0xc0, 0xb2, 0x3b, 0x4a, 0xf6, 0x3b, 0xef, 0x77, 0x30, 0x6b, 0x65,
-	0x05, 0xf7, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x7b, 0xa0, 0x65, 0x52, 0x06, 0x00,
-	0x00,
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0xfc, 0x29, 0xed, 0xb4, 0x20, 0x0c, 0x85, 0xac, 0x8d, 0x69, 0x6b, 0xc3, 0xa1,
+	0xc1, 0xb0, 0x6b, 0xd1, 0x78, 0xf0, 0x46, 0x35, 0x51, 0x02, 0x0a, 0x2e, 0xe0, 0x81, 0xc4, 0x34,
+	0x53, 0x76, 0x58, 0x36, 0xec, 0xee, 0xd4, 0x99, 0x69, 0xa5, 0xdf, 0xc0, 0x23, 0x77, 0x2f, 0x1c,
+	0x3d, 0x6a, 0xc2, 0x87, 0xe0, 0x48, 0x38, 0x19, 0x0f, 0x68, 0xe0, 0xa0, 0x1f, 0xc3, 0xec, 0xcc,
+	0xee, 0xb2, 0xb5, 0x5d, 0xa2, 0x97, 0xa6, 0xd3, 0xf7, 0x79, 0x7e, 0xcf, 0xfb, 0xb6, 0xd3, 0x77,
+	0xc1, 0xc2, 0x1e, 0x61, 0x2e, 0x61, 0x3a, 0xe3, 0xe8, 0xd0, 0xf6, 0x2c, 0xbd, 0x5b, 0x6f, 0x61,
+	0x8e, 0xea, 0xba, 0x85, 0x3d, 0xcc, 0x6c, 0xa6, 0xb5, 0x29, 0xe1, 0x04, 0xce, 0x4b, 0x95, 0x16,
+	0xa8, 0xb4, 0x40, 0x55, 0x2c, 0x58, 0xc4, 0x22, 0x42, 0xa2, 0xfb, 0xef, 0xa4, 0xba, 0x98, 0xc4,
+	0x0c, 0xdd, 0x52, 0x75, 0x57, 0xaa, 0x9a, 0xd2, 0x1e, 0x04, 0xc8, 0xd2, 0x0c, 0x72, 0x6d, 0x8f,
+	0xe8, 0xe2, 0x35, 0xf8, 0xa8, 0x6c, 0x11, 0x62, 0x39, 0x58, 0x17, 0xa7, 0x56, 0x67, 0x5f, 0xe7,
+	0xb6, 0x8b, 0x19, 0x47, 0x6e, 0x5b, 0x0a, 0xaa, 0x5f, 0x33, 0x20, 0xff, 0x42, 0x36, 0xbd, 0xc5,
+	0x11, 0xc7, 0x70, 0x05, 0xa4, 0xdb, 0x88, 0x22, 0x97, 0xa9, 0x4a, 0x45, 0xa9, 0xe5, 0x96, 0x4b,
+	0xda, 0xf0, 0x21, 0xb4, 0x4d, 0xa1, 0x6a, 0x64, 0xcf, 0x2e, 0xcb, 0xa9, 0xcf, 0xbf, 0xbe, 0x2c,
+	0x2a, 0x46, 0x60, 0x84, 0xbb, 0x60, 0xda, 0x41, 0x8c, 0x37, 0x39, 0xe1, 0xc8, 0x69, 0xb6, 0xc9,
+	0x07, 0x4c, 0xd5, 0x91, 0x8a, 0x52, 0xcb, 0x37, 0x1e, 0xfa, 0xe2, 0xef, 0x97, 0xe5, 0x39, 0xc9,
+	0x64, 0xe6, 0xa1, 0x66, 0x13, 0xdd, 0x45, 0xfc, 0x40, 0x5b, 0xf5, 0xf8, 0xc5, 0xe9, 0x12, 0x08,
+	0xc2, 0x56, 0x3d, 0x2e, 0x99, 0x53, 0x3e, 0x69, 0xdb, 0x07, 0x6d, 0xfa, 0x1c, 0x68, 0x83, 0x39,
+	0xc1, 0xee, 0x22, 0xc7, 0x36, 0x11, 0x27, 0x54, 0xf2, 0x99, 0x3a, 0x5a, 0x19, 0xad, 0xe5, 0x96,
+	0x17, 0x93, 0xba, 0x5d, 0x47, 0x8c, 0xbf, 0x0d, 0x3d, 0x02, 0x15, 0xef, 0x7c, 0xd6, 0x19, 0x28,
+	0x33, 0xb8, 0x0e, 0x40, 0x94, 0xc2, 0xd4, 0x31, 0xc1, 0xbf, 0x9f, 0xc4, 0x8f, 0xcc, 0x71, 0x6c,
+	0xcc, 0x0f, 0x37, 0x40, 0xce, 0xc4, 0x0e, 0xb6, 0x10, 0xb7, 0x89, 0xc7, 0xd4, 0x71, 0x81, 0xab,
+	0x26, 0xe1, 0x9e, 0x47, 0xd2, 0x38, 0x2f, 0x4e, 0x80, 0x87, 0x60, 0xae, 0xe3, 0xb5, 0x88, 0x67,
+	0xda, 0x9e, 0xd5, 0x8c, 0xa3, 0xd3, 0x02, 0xfd, 0x20, 0x09, 0xbd, 0x13, 0x9a, 0x86, 0x67, 0x14,
+	0x3a, 0x83, 0x75, 0x06, 0x77, 0xc0, 0x24, 0xc5, 0xf1, 0x90, 0x09, 0x11, 0xb2, 0x90, 0x14, 0x62,
+	0x60, 0x73, 0x28, 0xbd, 0x9f, 0x02, 0x8b, 0x20, 0x83, 0x8f, 0xda, 0x84, 0x72, 0x6c, 0xaa, 0x99,
+	0x8a, 0x52, 0xcb, 0x18, 0xd1, 0x19, 0x3a, 0x60, 0x9e, 0x12, 0x2e, 0x84, 0x4d, 0xdb, 0x33, 0xf1,
+	0x51, 0x93, 0xe2, 0x3d, 0x42, 0x4d, 0xa6, 0x66, 0x6f, 0x1f, 0xd0, 0x08, 0x5c, 0xab, 0xbe, 0xc9,
+	0x10, 0x9e, 0xbe, 0x01, 0xe9, 0x60, 0x9d, 0x41, 0x0b, 0x4c, 0x47, 0x69, 0x07, 0x36, 0xe3, 0x84,
+	0xf6, 0x54, 0x20, 0x72, 0xea, 0x49, 0x39, 0xcf, 0x88, 0xc7, 0x36, 0x3b, 0xad, 0x35, 0xdc, 0x0b,
+	0x13, 0x5f, 0x4a, 0x63, 0x3c, 0xed, 0x0e, 0xed, 0xaf, 0xc1, 0x77, 0x60, 0x2a, 0x0a, 0x7a, 0xdf,
+	0xc1, 0x1d, 0xac, 0xe6, 0xfe, 0x6d, 0x9c, 0x37, 0xbe, 0x78, 0x70, 0x9c, 0x49, 0x1a, 0xaf, 0xc3,
+	0x16, 0x80, 0x2e, 0x31, 0x3b, 0x0e, 0xee, 0xbb, 0x12, 0x79, 0x11, 0x51, 0x4b, 0x8a, 0x78, 0x25,
+	0x1c, 0xc3, 0xef, 0xc3, 0x8c, 0xfb, 0x57, 0x91, 0x55, 0x0f, 0x00, 0x1c, 0xfc, 0x3b, 0xc1, 0x65,
+	0x30, 0x81, 0x4c, 0x93, 0x62, 0x26, 0x37, 0x47, 0xb6, 0xa1, 0x5e, 0x9c, 0x2e, 0x15, 0x82, 0xc4,
+	0x15, 0x59, 0xd9, 0xe2, 0xd4, 0xf6, 0x2c, 0x23, 0x14, 0xc2, 0x02, 0x18, 0xbf, 0x59, 0x0f, 0xa3,
+	0x86, 0x3c, 0x3c, 0xcd, 0x7c, 0x3c, 0x29, 0xa7, 0x7e, 0x9f, 0x94, 0x53, 0x55, 0x02, 0x66, 0x87,
+	0xfc, 0x9a, 0x50, 0xed, 0x8f, 0xca, 0xdf, 0x00, 0x1f, 0x83, 0x31, 0x7f, 0xc3, 0xa9, 0x69, 0xb1,
+	0xbb, 0x8a, 0x9a, 0x5c, 0x7f, 0x5a, 0xb8, 0xfe, 0xb4, 0xed, 0x70, 0xfd, 0x35, 0xc6, 0x8e, 0x7f,
+	0x94, 0x15, 0x43, 0xa8, 0x63, 0x81, 0x9f, 0x94, 0x9b, 0xc4, 0xd8, 0x17, 0x0e, 0x5f, 0x83, 0x6c,
+	0x17, 0x39, 0x4d, 0x3f, 0x26, 0x5c, 0x8c, 0xf5, 0x5b, 0x56, 0x81, 0x3f, 0x2e, 0xdb, 0xd8, 0x17,
+	0x24, 0x6c, 0xfa, 0x17, 0x65, 0x0d, 0xf7, 0x98, 0x91, 0xe9, 0x06, 0xa5, 0xa8, 0xcf, 0x91, 0xff,
+	0xe9, 0xb3, 0xf1, 0xe4, 0xec, 0xaa, 0xa4, 0x9c, 0x5f, 0x95, 0x94, 0x9f, 0x57, 0x25, 0xe5, 0xf8,
+	0xba, 0x94, 0x3a, 0xbf, 0x2e, 0xa5, 0xbe, 0x5d, 0x97, 0x52, 0xbb, 0xf7, 0xfa, 0x16, 0xea, 0x51,
+	0xf4, 0x0c, 0xe1, 0xbd, 0x36, 0x66, 0xad, 0xb4, 0xe0, 0x3e, 0xfa, 0x33, 0x00, 0x63, 0xa3, 0x0c,
+	0xd5, 0xb6, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +379,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ModuleDelegations) > 0 {
+		for iNdEx := len(m.ModuleDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.RotationQueue) > 0 {
 		for iNdEx := len(m.RotationQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +730,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ModuleDelegations) > 0 {
+		for _, e := range m.ModuleDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1152,6 +1182,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleDelegations = append(m.ModuleDelegations, ModuleDelegation{})
+			if err := m.ModuleDelegations[len(m.ModuleDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -126,3 +126,29 @@ func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPu
 	}
 	return nil
 }
+
+// DelegationChange is a delegation modified during a block, as delivered to the DelegationBatchHooks.
+type DelegationChange struct {
+	DelegatorAddress sdk.AccAddress
+	ValidatorAddress sdk.ValAddress
+	// Removed is true if the delegation no longer exists at the end of the block.
+	Removed bool
+}
+
+// combine multiple delegation batch hooks, all hook functions are run in array sequence
+var _ DelegationBatchHooks = &MultiDelegationBatchHooks{}
+
+type MultiDelegationBatchHooks []DelegationBatchHooks
+
+func NewMultiDelegationBatchHooks(hooks ...DelegationBatchHooks) MultiDelegationBatchHooks {
+	return hooks
+}
+
+func (h MultiDelegationBatchHooks) AfterDelegationsModified(ctx context.Context, changes []DelegationChange) error {
+	for i := range h {
+		if err := h[i].AfterDelegationsModified(ctx, changes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -67,6 +67,14 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	ModuleDelegationKey = collections.NewPrefix(107) // prefix for the delegations owned by module accounts
+
+	HistoricalValidatorSetDiffKey       = collections.NewPrefix(108) // prefix for the validator set changes by height
+	HistoricalValidatorSetCheckpointKey = collections.NewPrefix(109) // key for the validator set at the oldest retained height
+	HistoricalValidatorSetLatestKey     = collections.NewPrefix(110) // key for the validator set at the latest height
+
+	ModifiedDelegationsKey = collections.NewPrefix(111) // prefix for the delegations modified in the current block, pending delivery to the batch hooks
 )
 
 // Reserved kvstore keys
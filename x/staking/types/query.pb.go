@@ -32,6 +32,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MetadataVerificationStatus defines the verification status of the metadata of a validator.
+type MetadataVerificationStatus int32
+
+const (
+	// UNSPECIFIED defines an invalid verification status.
+	MetadataVerificationStatusUnspecified MetadataVerificationStatus = 0
+	// UNVERIFIED defines a validator without website proof.
+	MetadataVerificationStatusUnverified MetadataVerificationStatus = 1
+	// PROOF_RECORDED defines a validator with a website proof recorded for the
+	// domain of its website, which clients re-check against the published proof.
+	MetadataVerificationStatusProofRecorded MetadataVerificationStatus = 2
+)
+
+var MetadataVerificationStatus_name = map[int32]string{
+	0: "METADATA_VERIFICATION_STATUS_UNSPECIFIED",
+	1: "METADATA_VERIFICATION_STATUS_UNVERIFIED",
+	2: "METADATA_VERIFICATION_STATUS_PROOF_RECORDED",
+}
+
+var MetadataVerificationStatus_value = map[string]int32{
+	"METADATA_VERIFICATION_STATUS_UNSPECIFIED":    0,
+	"METADATA_VERIFICATION_STATUS_UNVERIFIED":     1,
+	"METADATA_VERIFICATION_STATUS_PROOF_RECORDED": 2,
+}
+
+func (x MetadataVerificationStatus) String() string {
+	return proto.EnumName(MetadataVerificationStatus_name, int32(x))
+}
+
+func (MetadataVerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{0}
+}
+
 // QueryValidatorsRequest is request type for Query/Validators RPC method.
 type QueryValidatorsRequest struct {
 	// status enables to query for validators matching a given status.
@@ -1286,6 +1319,230 @@ func (m *QueryHistoricalInfoResponse) GetHist() *HistoricalInfo {
 	return nil
 }
 
+// QueryHistoricalValidatorSetRequest is request type for the
+// Query/HistoricalValidatorSet RPC method.
+type QueryHistoricalValidatorSetRequest struct {
+	// height defines at which height to query the validator set.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryHistoricalValidatorSetRequest) Reset()         { *m = QueryHistoricalValidatorSetRequest{} }
+func (m *QueryHistoricalValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalValidatorSetRequest) ProtoMessage()    {}
+func (*QueryHistoricalValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{25}
+}
+func (m *QueryHistoricalValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalValidatorSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalValidatorSetRequest.Merge(m, src)
+}
+func (m *QueryHistoricalValidatorSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalValidatorSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalValidatorSetRequest proto.InternalMessageInfo
+
+func (m *QueryHistoricalValidatorSetRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryHistoricalValidatorSetResponse is response type for the
+// Query/HistoricalValidatorSet RPC method.
+type QueryHistoricalValidatorSetResponse struct {
+	// validator_set is the validator set at the queried height.
+	ValidatorSet HistoricalValidatorSet `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set"`
+	// hash is the hash of the validator set.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryHistoricalValidatorSetResponse) Reset()         { *m = QueryHistoricalValidatorSetResponse{} }
+func (m *QueryHistoricalValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalValidatorSetResponse) ProtoMessage()    {}
+func (*QueryHistoricalValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{26}
+}
+func (m *QueryHistoricalValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalValidatorSetResponse.Merge(m, src)
+}
+func (m *QueryHistoricalValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoricalValidatorSetResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalValidatorSetResponse) GetValidatorSet() HistoricalValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return HistoricalValidatorSet{}
+}
+
+func (m *QueryHistoricalValidatorSetResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// QueryValidatorMetadataVerificationRequest is request type for the
+// Query/ValidatorMetadataVerification RPC method.
+type QueryValidatorMetadataVerificationRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorMetadataVerificationRequest) Reset() {
+	*m = QueryValidatorMetadataVerificationRequest{}
+}
+func (m *QueryValidatorMetadataVerificationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorMetadataVerificationRequest) ProtoMessage() {}
+func (*QueryValidatorMetadataVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{27}
+}
+func (m *QueryValidatorMetadataVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorMetadataVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorMetadataVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorMetadataVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorMetadataVerificationRequest.Merge(m, src)
+}
+func (m *QueryValidatorMetadataVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorMetadataVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorMetadataVerificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorMetadataVerificationRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorMetadataVerificationRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryValidatorMetadataVerificationResponse is response type for the
+// Query/ValidatorMetadataVerification RPC method.
+type QueryValidatorMetadataVerificationResponse struct {
+	// status is the verification status of the validator metadata.
+	Status MetadataVerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cosmos.staking.v1beta1.MetadataVerificationStatus" json:"status,omitempty"`
+	// website_proof is the recorded website proof, if any.
+	WebsiteProof *WebsiteProof `protobuf:"bytes,2,opt,name=website_proof,json=websiteProof,proto3" json:"website_proof,omitempty"`
+	// proof_location is where the website proof is published, the DNS name holding
+	// the TXT record or the URL of the well-known file.
+	ProofLocation string `protobuf:"bytes,3,opt,name=proof_location,json=proofLocation,proto3" json:"proof_location,omitempty"`
+}
+
+func (m *QueryValidatorMetadataVerificationResponse) Reset() {
+	*m = QueryValidatorMetadataVerificationResponse{}
+}
+func (m *QueryValidatorMetadataVerificationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorMetadataVerificationResponse) ProtoMessage() {}
+func (*QueryValidatorMetadataVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryValidatorMetadataVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorMetadataVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorMetadataVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorMetadataVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorMetadataVerificationResponse.Merge(m, src)
+}
+func (m *QueryValidatorMetadataVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorMetadataVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorMetadataVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorMetadataVerificationResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorMetadataVerificationResponse) GetStatus() MetadataVerificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MetadataVerificationStatusUnspecified
+}
+
+func (m *QueryValidatorMetadataVerificationResponse) GetWebsiteProof() *WebsiteProof {
+	if m != nil {
+		return m.WebsiteProof
+	}
+	return nil
+}
+
+func (m *QueryValidatorMetadataVerificationResponse) GetProofLocation() string {
+	if m != nil {
+		return m.ProofLocation
+	}
+	return ""
+}
+
 // QueryPoolRequest is request type for the Query/Pool RPC method.
 type QueryPoolRequest struct {
 }
@@ -1294,7 +1551,7 @@ func (m *QueryPoolRequest) Reset()         { *m = QueryPoolRequest{} }
 func (m *QueryPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolRequest) ProtoMessage()    {}
 func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{25}
+	return fileDescriptor_f270127f442bbcd8, []int{29}
 }
 func (m *QueryPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1590,7 @@ func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
 func (m *QueryPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolResponse) ProtoMessage()    {}
 func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{26}
+	return fileDescriptor_f270127f442bbcd8, []int{30}
 }
 func (m *QueryPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1634,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{27}
+	return fileDescriptor_f270127f442bbcd8, []int{31}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1673,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{28}
+	return fileDescriptor_f270127f442bbcd8, []int{32}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,6 +1710,7 @@ func (m *QueryParamsResponse) GetParams() Params {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.staking.v1beta1.MetadataVerificationStatus", MetadataVerificationStatus_name, MetadataVerificationStatus_value)
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*ValidatorInfo)(nil), "cosmos.staking.v1beta1.ValidatorInfo")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1478,6 +1736,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorResponse)(nil), "cosmos.staking.v1beta1.QueryDelegatorValidatorResponse")
 	proto.RegisterType((*QueryHistoricalInfoRequest)(nil), "cosmos.staking.v1beta1.QueryHistoricalInfoRequest")
 	proto.RegisterType((*QueryHistoricalInfoResponse)(nil), "cosmos.staking.v1beta1.QueryHistoricalInfoResponse")
+	proto.RegisterType((*QueryHistoricalValidatorSetRequest)(nil), "cosmos.staking.v1beta1.QueryHistoricalValidatorSetRequest")
+	proto.RegisterType((*QueryHistoricalValidatorSetResponse)(nil), "cosmos.staking.v1beta1.QueryHistoricalValidatorSetResponse")
+	proto.RegisterType((*QueryValidatorMetadataVerificationRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorMetadataVerificationRequest")
+	proto.RegisterType((*QueryValidatorMetadataVerificationResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorMetadataVerificationResponse")
 	proto.RegisterType((*QueryPoolRequest)(nil), "cosmos.staking.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
@@ -1489,99 +1751,122 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdd, 0x6b, 0x1c, 0x55,
-	0x14, 0xcf, 0xdd, 0xc4, 0x60, 0x4e, 0x69, 0x49, 0xef, 0x6e, 0xe3, 0x76, 0x9a, 0x6e, 0xb6, 0x43,
-	0xad, 0x69, 0x6a, 0x66, 0xda, 0x54, 0xdb, 0x58, 0xa1, 0xed, 0xc6, 0xa2, 0xad, 0x2d, 0x6d, 0xba,
-	0x62, 0x15, 0x3f, 0x08, 0x93, 0xec, 0x74, 0x76, 0xe8, 0x66, 0x66, 0x3b, 0x77, 0x12, 0x5a, 0x4a,
-	0x11, 0x7c, 0x90, 0xfa, 0x22, 0x82, 0xef, 0xd2, 0x47, 0x11, 0x05, 0xc1, 0x54, 0x10, 0xd1, 0x47,
-	0xe9, 0x83, 0x48, 0xa9, 0x54, 0xd4, 0x87, 0x2a, 0x89, 0xa0, 0x2f, 0xfe, 0x07, 0x22, 0x32, 0x33,
-	0x67, 0xbe, 0x32, 0x1f, 0x3b, 0xbb, 0xd9, 0x85, 0xf4, 0x25, 0x64, 0xef, 0xdc, 0x73, 0xce, 0xef,
-	0xf7, 0x3b, 0xe7, 0xdc, 0x39, 0x77, 0x17, 0xf8, 0x05, 0x9d, 0x2d, 0xea, 0x4c, 0x64, 0xa6, 0x74,
-	0x45, 0xd5, 0x14, 0x71, 0xf9, 0xd0, 0xbc, 0x6c, 0x4a, 0x87, 0xc4, 0xab, 0x4b, 0xb2, 0x71, 0x5d,
-	0x68, 0x1a, 0xba, 0xa9, 0xd3, 0x11, 0x67, 0x8f, 0x80, 0x7b, 0x04, 0xdc, 0xc3, 0x4d, 0xa0, 0xed,
-	0xbc, 0xc4, 0x64, 0xc7, 0xc0, 0x33, 0x6f, 0x4a, 0x8a, 0xaa, 0x49, 0xa6, 0xaa, 0x6b, 0x8e, 0x0f,
-	0xae, 0xa0, 0xe8, 0x8a, 0x6e, 0xff, 0x2b, 0x5a, 0xff, 0xe1, 0xea, 0xa8, 0xa2, 0xeb, 0x4a, 0x43,
-	0x16, 0xa5, 0xa6, 0x2a, 0x4a, 0x9a, 0xa6, 0x9b, 0xb6, 0x09, 0xc3, 0xa7, 0x7b, 0x13, 0xb0, 0xb9,
-	0x38, 0x9c, 0x5d, 0x3b, 0x9d, 0x5d, 0x73, 0x8e, 0x73, 0x84, 0xea, 0x3c, 0xda, 0x85, 0x0e, 0x5c,
-	0x6c, 0x41, 0x56, 0xdc, 0x76, 0x69, 0x51, 0xd5, 0x74, 0xd1, 0xfe, 0xeb, 0x2c, 0xf1, 0xd7, 0x60,
-	0xe4, 0xa2, 0xb5, 0xe3, 0x92, 0xd4, 0x50, 0x6b, 0x92, 0xa9, 0x1b, 0xac, 0x2a, 0x5f, 0x5d, 0x92,
-	0x99, 0x49, 0x47, 0x60, 0x90, 0x99, 0x92, 0xb9, 0xc4, 0x8a, 0xa4, 0x4c, 0xc6, 0x87, 0xaa, 0xf8,
-	0x89, 0xbe, 0x08, 0xe0, 0x53, 0x2d, 0xe6, 0xca, 0x64, 0x7c, 0xcb, 0xd4, 0x3e, 0x01, 0x41, 0x58,
-	0xba, 0x08, 0x4e, 0x48, 0x84, 0x2e, 0xcc, 0x4a, 0x8a, 0x8c, 0x3e, 0xab, 0x01, 0x4b, 0xbe, 0x0e,
-	0x5b, 0xbd, 0xa0, 0x67, 0xb4, 0xcb, 0x3a, 0xad, 0xc0, 0xf6, 0x05, 0x5d, 0x63, 0xb2, 0xc6, 0x96,
-	0xd8, 0x9c, 0x54, 0xab, 0x19, 0x32, 0xc3, 0xd8, 0x33, 0x85, 0xdf, 0x56, 0x26, 0x87, 0xaf, 0xb9,
-	0x2a, 0x94, 0x97, 0x0f, 0x0a, 0x53, 0xc2, 0xc1, 0xea, 0xb0, 0xb7, 0xbd, 0xe2, 0xec, 0x3e, 0x56,
-	0xb8, 0x1f, 0xb3, 0x8f, 0x7f, 0x3f, 0x07, 0x4f, 0x44, 0x48, 0xb2, 0xa6, 0x65, 0x4c, 0xcf, 0x01,
-	0x2c, 0x7b, 0xab, 0x45, 0x52, 0xee, 0x1f, 0xdf, 0x32, 0xb5, 0x47, 0x88, 0xcf, 0xbe, 0xe0, 0xd9,
-	0xcf, 0x0c, 0xdd, 0x7d, 0x38, 0xd6, 0xf7, 0xc9, 0x5f, 0x5f, 0x4c, 0x90, 0x6a, 0xc0, 0x9e, 0xbe,
-	0x06, 0xdb, 0xbc, 0x4f, 0x73, 0xaa, 0x76, 0x59, 0x2f, 0xe6, 0x6c, 0x8f, 0x4f, 0xb6, 0xf4, 0x68,
-	0x29, 0x10, 0xf4, 0xba, 0x75, 0x39, 0xa4, 0xcd, 0x4b, 0x21, 0xd1, 0xfb, 0x6d, 0xd1, 0x9f, 0x6a,
-	0x29, 0xba, 0xc3, 0x31, 0xa4, 0xba, 0x04, 0x3b, 0xc2, 0x52, 0xb8, 0xe9, 0x3e, 0x1d, 0x84, 0x6e,
-	0xa9, 0x8f, 0xd2, 0xef, 0xb9, 0xbf, 0x32, 0xb9, 0x1b, 0x03, 0x79, 0x46, 0xa8, 0xf7, 0x2b, 0xa6,
-	0xa1, 0x6a, 0x4a, 0x00, 0xab, 0xb5, 0xce, 0xd7, 0xd6, 0x97, 0x94, 0x27, 0xf6, 0xcb, 0x30, 0xe4,
-	0x6d, 0xb5, 0xdd, 0xb7, 0xab, 0xb5, 0x6f, 0xce, 0xaf, 0x10, 0x28, 0x87, 0xc3, 0x9c, 0x92, 0x1b,
-	0xb2, 0xe2, 0x74, 0x53, 0xd7, 0x49, 0x75, 0xad, 0xea, 0xff, 0x21, 0xb0, 0x27, 0x05, 0x36, 0x0a,
-	0xf5, 0x0e, 0x14, 0x6a, 0xde, 0xf2, 0x9c, 0x81, 0xcb, 0x6e, 0x7d, 0x4e, 0x24, 0x69, 0xe6, 0xbb,
-	0x72, 0x3d, 0xcd, 0x94, 0x2d, 0xf1, 0x3e, 0xfd, 0x7d, 0x2c, 0x1f, 0x7d, 0xc6, 0x1c, 0x4d, 0xf3,
-	0xb5, 0xe8, 0x93, 0x75, 0xf5, 0x96, 0xeb, 0xbc, 0xde, 0xbe, 0x25, 0xb0, 0x3f, 0xcc, 0xf7, 0x55,
-	0x6d, 0x5e, 0xd7, 0x6a, 0xaa, 0xa6, 0x3c, 0x12, 0xf9, 0x7a, 0x48, 0x60, 0x22, 0x0b, 0x7e, 0x4c,
-	0x9c, 0x02, 0xf9, 0x25, 0xf7, 0x79, 0x24, 0x6f, 0x07, 0x92, 0xf2, 0x16, 0xe3, 0x32, 0x58, 0xf5,
-	0xd4, 0x73, 0xd9, 0x83, 0x04, 0x7d, 0x4e, 0xb0, 0x5d, 0x83, 0x05, 0xe2, 0x64, 0xe3, 0x04, 0x6c,
-	0xc3, 0xda, 0x08, 0x67, 0xa3, 0x78, 0x7f, 0x65, 0xb2, 0x80, 0xa1, 0xd6, 0x25, 0xc1, 0xdb, 0x6f,
-	0x27, 0x21, 0x9a, 0xce, 0x5c, 0x67, 0xe9, 0x3c, 0xf6, 0xf8, 0xad, 0xdb, 0x63, 0x7d, 0x7f, 0xdf,
-	0x1e, 0xeb, 0xe3, 0x97, 0xf1, 0x2c, 0x8f, 0xd6, 0x33, 0x7d, 0x13, 0xf2, 0x31, 0x5d, 0x83, 0x07,
-	0x4d, 0x1b, 0x4d, 0x53, 0xa5, 0xd1, 0x96, 0xe0, 0xbf, 0x22, 0x30, 0x66, 0x07, 0x8e, 0x49, 0xd6,
-	0xa6, 0x16, 0xcc, 0xc0, 0x73, 0x32, 0x16, 0x37, 0x2a, 0x77, 0x1e, 0x06, 0x9d, 0x1a, 0x43, 0xb1,
-	0x3a, 0xad, 0x54, 0xf4, 0xc2, 0xdf, 0x71, 0x0f, 0xe7, 0x53, 0x2e, 0xbd, 0x98, 0x66, 0xdf, 0xb0,
-	0x5a, 0x5d, 0xea, 0xf1, 0x80, 0x56, 0x3f, 0xbb, 0xa7, 0x73, 0x3c, 0x6e, 0x54, 0xab, 0xde, 0xb5,
-	0xd3, 0x39, 0x20, 0x5d, 0x6f, 0x8f, 0xe1, 0xef, 0xdc, 0x63, 0xd8, 0x23, 0x96, 0x76, 0x0c, 0x6f,
-	0xc2, 0xcc, 0x78, 0xe7, 0x70, 0x0b, 0x02, 0x8f, 0xec, 0x39, 0x7c, 0x2f, 0x07, 0x3b, 0x6d, 0x82,
-	0x55, 0xb9, 0xd6, 0x83, 0x8c, 0x5c, 0x00, 0xca, 0x8c, 0x85, 0xb9, 0x4e, 0x4f, 0x97, 0x61, 0x66,
-	0x2c, 0x84, 0x1e, 0x59, 0x0e, 0x6b, 0xcc, 0x5c, 0xef, 0xb0, 0x3f, 0xb3, 0xc3, 0x1a, 0x33, 0x2f,
-	0xa5, 0xbc, 0xb1, 0x07, 0xba, 0x50, 0x33, 0x0f, 0x08, 0x70, 0x71, 0x92, 0x62, 0x8d, 0x68, 0x30,
-	0x62, 0xc8, 0x29, 0x8d, 0xfc, 0x74, 0x52, 0x99, 0x04, 0xdd, 0xc5, 0xb5, 0xf2, 0x0e, 0x43, 0xee,
-	0x69, 0x33, 0xaf, 0xb8, 0xaf, 0x22, 0xaf, 0x17, 0xa2, 0xb7, 0xb7, 0x4d, 0xd8, 0xc2, 0x5f, 0x47,
-	0x5e, 0x0a, 0x3d, 0xbf, 0x8f, 0x75, 0x4d, 0xf2, 0x3b, 0x04, 0x4a, 0x09, 0xd8, 0x37, 0xf5, 0xcb,
-	0x7f, 0x31, 0xb1, 0x52, 0x7a, 0x72, 0x29, 0x9b, 0xc6, 0x86, 0x3b, 0xad, 0x32, 0x53, 0x37, 0xd4,
-	0x05, 0xa9, 0x61, 0xdd, 0x5e, 0x03, 0xdf, 0x28, 0xd4, 0x65, 0x55, 0xa9, 0x9b, 0x76, 0x98, 0xfe,
-	0x2a, 0x7e, 0x3a, 0x96, 0x2b, 0x12, 0x5e, 0x82, 0x5d, 0xb1, 0x96, 0x08, 0xf2, 0x38, 0x0c, 0xd4,
-	0x55, 0x66, 0x22, 0xbe, 0x7d, 0x49, 0xf8, 0xc2, 0xd6, 0x33, 0xb9, 0x22, 0xa9, 0xda, 0x76, 0x76,
-	0x08, 0x0a, 0xc3, 0x76, 0x88, 0x59, 0x5d, 0x6f, 0x20, 0x24, 0x7e, 0x16, 0xb6, 0x07, 0xd6, 0x30,
-	0xd8, 0xf3, 0x30, 0xd0, 0xd4, 0xf5, 0x06, 0x06, 0x1b, 0x4d, 0x0a, 0x66, 0xd9, 0x04, 0x75, 0xb0,
-	0x8d, 0xf8, 0x02, 0x50, 0xc7, 0xa3, 0x64, 0x48, 0x8b, 0x6e, 0x3b, 0xf2, 0xaf, 0x43, 0x3e, 0xb4,
-	0x8a, 0x91, 0x2a, 0x30, 0xd8, 0xb4, 0x57, 0x30, 0x56, 0x29, 0x31, 0x96, 0xbd, 0x2b, 0x34, 0x6a,
-	0x39, 0x86, 0x53, 0x5f, 0x8e, 0xc0, 0x63, 0xb6, 0x6b, 0xfa, 0x31, 0x01, 0xf0, 0x3b, 0x8a, 0x0a,
-	0x49, 0xbe, 0xe2, 0xbf, 0xef, 0xe1, 0xc4, 0xcc, 0xfb, 0x71, 0x22, 0x16, 0x6f, 0x59, 0x40, 0xde,
-	0xfd, 0xe9, 0xcf, 0x8f, 0x72, 0x7b, 0x29, 0x2f, 0x26, 0x7c, 0x73, 0x15, 0xe8, 0xc6, 0xcf, 0x08,
-	0x0c, 0x79, 0x7e, 0xe8, 0x64, 0xb6, 0x78, 0x2e, 0x3c, 0x21, 0xeb, 0x76, 0x44, 0x77, 0xd2, 0x47,
-	0xf7, 0x2c, 0x3d, 0xdc, 0x1a, 0x9d, 0x78, 0x23, 0xdc, 0x7c, 0x37, 0xe9, 0xaf, 0x04, 0x0a, 0x71,
-	0xb7, 0x74, 0x3a, 0x9d, 0x0d, 0x4a, 0x74, 0xb0, 0xe2, 0x9e, 0xeb, 0xc0, 0x12, 0xf9, 0x9c, 0xf3,
-	0xf9, 0x54, 0xe8, 0x89, 0x0e, 0xf8, 0x88, 0x81, 0x77, 0x20, 0xfd, 0x8f, 0xc0, 0xee, 0xd4, 0x1b,
-	0x2d, 0xad, 0x64, 0x83, 0x9a, 0x32, 0x46, 0x72, 0x33, 0x1b, 0x71, 0x81, 0xb4, 0x2f, 0xf9, 0xb4,
-	0xcf, 0xd2, 0x33, 0x9d, 0xd0, 0xf6, 0xe7, 0xc0, 0xa0, 0x00, 0x3f, 0x10, 0x00, 0x3f, 0x5e, 0x8b,
-	0x66, 0x89, 0xdc, 0xf4, 0x5a, 0x34, 0x4b, 0x74, 0xd2, 0xe7, 0xdf, 0xf6, 0x79, 0x54, 0xe9, 0xec,
-	0x06, 0xd3, 0x27, 0xde, 0x08, 0xbf, 0x69, 0x6e, 0xd2, 0x7f, 0x09, 0xe4, 0x63, 0x74, 0xa4, 0x47,
-	0x53, 0x71, 0x26, 0x5f, 0x65, 0xb9, 0xe9, 0xf6, 0x0d, 0x91, 0xa9, 0xe1, 0x33, 0x55, 0xa8, 0xdc,
-	0x6d, 0xa6, 0xb1, 0xe9, 0xa4, 0x3f, 0x12, 0x28, 0xc4, 0x5d, 0xd9, 0x5a, 0xb4, 0x6a, 0xca, 0xed,
-	0xb4, 0x45, 0xab, 0xa6, 0xdd, 0x0f, 0xf9, 0x8a, 0xaf, 0xc0, 0x11, 0xfa, 0x4c, 0x92, 0x02, 0xa9,
-	0xf9, 0xb4, 0xfa, 0x33, 0xf5, 0xa6, 0xd3, 0xa2, 0x3f, 0xb3, 0x5c, 0xf3, 0x5a, 0xf4, 0x67, 0xa6,
-	0x8b, 0x56, 0xc6, 0xfe, 0xf4, 0xe8, 0x65, 0x4c, 0x28, 0xa3, 0xdf, 0x13, 0xd8, 0x1a, 0x1a, 0xdb,
-	0xe9, 0xa1, 0x54, 0xb4, 0x71, 0xb7, 0x26, 0x6e, 0xaa, 0x1d, 0x13, 0x24, 0x74, 0xde, 0x27, 0xf4,
-	0x02, 0xad, 0x74, 0x42, 0xc8, 0x08, 0xc1, 0x7e, 0x40, 0x20, 0x1f, 0x33, 0xf0, 0xb6, 0xe8, 0xcc,
-	0xe4, 0xc9, 0x9e, 0x9b, 0x6e, 0xdf, 0x10, 0xa9, 0x9d, 0xf5, 0xa9, 0x9d, 0xa4, 0xc7, 0x3b, 0xa1,
-	0x16, 0x78, 0x99, 0xaf, 0x11, 0xa0, 0xd1, 0x60, 0xf4, 0x48, 0x9b, 0xe8, 0x5c, 0x56, 0x47, 0xdb,
-	0xb6, 0x43, 0x52, 0x6f, 0xf9, 0xa4, 0x2e, 0xd2, 0x0b, 0x1b, 0x23, 0x15, 0x9d, 0x01, 0xbe, 0x21,
-	0xb0, 0x2d, 0x3c, 0x54, 0xd2, 0xf4, 0xa2, 0x8a, 0x9d, 0x7c, 0xb9, 0xc3, 0x6d, 0xd9, 0x44, 0x27,
-	0x98, 0x29, 0x7a, 0x30, 0x89, 0x59, 0xdd, 0x33, 0xb6, 0x7f, 0x70, 0x12, 0x6f, 0x38, 0x43, 0xf5,
-	0xcd, 0x5b, 0x39, 0x42, 0xdf, 0x23, 0x30, 0x60, 0x4d, 0xa9, 0x74, 0x3c, 0x35, 0x7e, 0x60, 0x20,
-	0xe6, 0xf6, 0x67, 0xd8, 0x89, 0xf8, 0xf6, 0xfb, 0xf8, 0x4a, 0x74, 0x34, 0x09, 0x9f, 0x35, 0x14,
-	0xd3, 0x0f, 0x08, 0x0c, 0x3a, 0x23, 0x2c, 0x9d, 0x48, 0x0f, 0x10, 0x9c, 0x9a, 0xb9, 0x03, 0x99,
-	0xf6, 0x22, 0x9c, 0x03, 0x3e, 0x9c, 0x32, 0x2d, 0x25, 0xc2, 0x71, 0x06, 0xe9, 0x23, 0x77, 0x57,
-	0x4b, 0xe4, 0xde, 0x6a, 0x89, 0xfc, 0xb1, 0x5a, 0x22, 0x1f, 0xae, 0x95, 0xfa, 0xee, 0xad, 0x95,
-	0xfa, 0x7e, 0x59, 0x2b, 0xf5, 0xbd, 0x31, 0xea, 0x18, 0xb2, 0xda, 0x15, 0x41, 0xd5, 0x45, 0xef,
-	0xb7, 0x44, 0xd1, 0xbc, 0xde, 0x94, 0xd9, 0xfc, 0xa0, 0xfd, 0xab, 0xe9, 0xe1, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x98, 0x4e, 0x1d, 0xaa, 0x44, 0x1e, 0x00, 0x00,
+	// 1835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdf, 0x6f, 0x14, 0x5f,
+	0x15, 0xdf, 0xbb, 0xad, 0x8d, 0x3d, 0x5f, 0xda, 0x94, 0xdb, 0xa5, 0x2c, 0x43, 0xd9, 0x2e, 0x63,
+	0x91, 0xfe, 0xb0, 0x3b, 0xb0, 0x28, 0x94, 0x92, 0x00, 0xdb, 0x5f, 0xb0, 0x02, 0x6d, 0x99, 0xfe,
+	0xc0, 0x28, 0xba, 0x99, 0xee, 0x4c, 0x77, 0x47, 0xda, 0x99, 0x65, 0x66, 0xba, 0x40, 0x08, 0xd1,
+	0xf8, 0x60, 0x2a, 0x26, 0xc6, 0xe8, 0x33, 0x86, 0xc4, 0x17, 0x63, 0x34, 0x92, 0x58, 0x4c, 0x8c,
+	0xd1, 0x47, 0x43, 0x8c, 0x31, 0x04, 0x83, 0x51, 0x1f, 0xd0, 0x50, 0x13, 0x79, 0xf1, 0x3f, 0x30,
+	0xc6, 0xcc, 0xcc, 0x9d, 0x5f, 0xdd, 0x99, 0xd9, 0xd9, 0xed, 0x36, 0x29, 0x2f, 0x64, 0xe7, 0xce,
+	0x39, 0xe7, 0x7e, 0x3e, 0xe7, 0xc7, 0x9d, 0x7b, 0x0e, 0x05, 0xba, 0x28, 0xab, 0x1b, 0xb2, 0xca,
+	0xa8, 0x1a, 0x77, 0x4f, 0x94, 0x4a, 0x4c, 0xf5, 0xec, 0xaa, 0xa0, 0x71, 0x67, 0x99, 0xfb, 0x9b,
+	0x82, 0xf2, 0x28, 0x53, 0x51, 0x64, 0x4d, 0xc6, 0x7d, 0xa6, 0x4c, 0x86, 0xc8, 0x64, 0x88, 0x0c,
+	0x35, 0x42, 0x74, 0x57, 0x39, 0x55, 0x30, 0x15, 0x6c, 0xf5, 0x0a, 0x57, 0x12, 0x25, 0x4e, 0x13,
+	0x65, 0xc9, 0xb4, 0x41, 0x25, 0x4a, 0x72, 0x49, 0x36, 0x7e, 0x32, 0xfa, 0x2f, 0xb2, 0xda, 0x5f,
+	0x92, 0xe5, 0xd2, 0xba, 0xc0, 0x70, 0x15, 0x91, 0xe1, 0x24, 0x49, 0xd6, 0x0c, 0x15, 0x95, 0xbc,
+	0x1d, 0x0c, 0xc0, 0x66, 0xe1, 0x30, 0xa5, 0x8e, 0x99, 0x52, 0x05, 0xd3, 0x38, 0x81, 0x6a, 0xbe,
+	0x3a, 0x4e, 0x0c, 0x58, 0xd8, 0xdc, 0xac, 0xa8, 0xc3, 0xdc, 0x86, 0x28, 0xc9, 0x8c, 0xf1, 0xaf,
+	0xb9, 0x44, 0x3f, 0x84, 0xbe, 0xdb, 0xba, 0xc4, 0x0a, 0xb7, 0x2e, 0xf2, 0x9c, 0x26, 0x2b, 0x2a,
+	0x2b, 0xdc, 0xdf, 0x14, 0x54, 0x0d, 0xf7, 0x41, 0x87, 0xaa, 0x71, 0xda, 0xa6, 0x9a, 0x44, 0x69,
+	0x34, 0xd4, 0xc9, 0x92, 0x27, 0x3c, 0x0b, 0xe0, 0x50, 0x4d, 0xc6, 0xd3, 0x68, 0xe8, 0x93, 0xec,
+	0x67, 0x33, 0x04, 0x84, 0xee, 0x97, 0x8c, 0xb9, 0x25, 0x81, 0x9e, 0x59, 0xe0, 0x4a, 0x02, 0xb1,
+	0xc9, 0xba, 0x34, 0xe9, 0x32, 0x74, 0xd9, 0x9b, 0xe6, 0xa5, 0x35, 0x19, 0xe7, 0xe0, 0x70, 0x51,
+	0x96, 0x54, 0x41, 0x52, 0x37, 0xd5, 0x02, 0xc7, 0xf3, 0x8a, 0xa0, 0x92, 0xbd, 0x27, 0x13, 0x7f,
+	0xdf, 0x1e, 0xeb, 0x79, 0x68, 0x79, 0x21, 0x5d, 0x3d, 0x93, 0xc9, 0x66, 0xce, 0xb0, 0x3d, 0xb6,
+	0x78, 0xce, 0x94, 0x9e, 0x48, 0xbc, 0xf1, 0x91, 0xa3, 0xbf, 0x13, 0x87, 0xa3, 0x35, 0x24, 0xd5,
+	0x8a, 0xae, 0x8c, 0x6f, 0x02, 0x54, 0xed, 0xd5, 0x24, 0x4a, 0xb7, 0x0d, 0x7d, 0x92, 0x3d, 0x99,
+	0xf1, 0x8f, 0x7e, 0xc6, 0xd6, 0x9f, 0xec, 0x7c, 0xf5, 0x6e, 0x20, 0xf6, 0x93, 0x7f, 0xbf, 0x18,
+	0x41, 0xac, 0x4b, 0x1f, 0xdf, 0x81, 0x6e, 0xfb, 0xa9, 0x20, 0x4a, 0x6b, 0x72, 0x32, 0x6e, 0x58,
+	0x3c, 0x55, 0xd7, 0xa2, 0xee, 0x01, 0xb7, 0xd5, 0xae, 0xaa, 0xc7, 0x37, 0xd7, 0x3c, 0x4e, 0x6f,
+	0x33, 0x9c, 0x7e, 0xba, 0xae, 0xd3, 0x4d, 0x8e, 0x1e, 0xaf, 0x73, 0x70, 0xc4, 0xeb, 0x0a, 0x2b,
+	0xdc, 0xd7, 0xdd, 0xd0, 0x75, 0xef, 0x13, 0xd7, 0x9f, 0x7c, 0xb3, 0x3d, 0x76, 0x82, 0x6c, 0x64,
+	0x2b, 0x11, 0x7f, 0x2f, 0x6a, 0x8a, 0x28, 0x95, 0x5c, 0x58, 0xf5, 0x75, 0x9a, 0xdf, 0x9d, 0x52,
+	0xb6, 0xb3, 0xbf, 0x08, 0x9d, 0xb6, 0xa8, 0x61, 0xbe, 0x51, 0x5f, 0x3b, 0xea, 0xf4, 0x36, 0x82,
+	0xb4, 0x77, 0x9b, 0x69, 0x61, 0x5d, 0x28, 0x99, 0xd5, 0xd4, 0x72, 0x52, 0x2d, 0xcb, 0xfa, 0xff,
+	0x20, 0x38, 0x19, 0x02, 0x9b, 0x38, 0xea, 0x1b, 0x90, 0xe0, 0xed, 0xe5, 0x82, 0x42, 0x96, 0xad,
+	0xfc, 0x1c, 0x09, 0xf2, 0x99, 0x63, 0xca, 0xb2, 0x34, 0x99, 0xd6, 0x9d, 0xf7, 0xd3, 0x7f, 0x0c,
+	0xf4, 0xd6, 0xbe, 0x53, 0x4d, 0x9f, 0xf6, 0xf2, 0xb5, 0x6f, 0xf0, 0x35, 0x1f, 0xba, 0x4d, 0xe5,
+	0xdb, 0x6f, 0x11, 0x0c, 0x7b, 0xf9, 0x2e, 0x4b, 0xab, 0xb2, 0xc4, 0x8b, 0x52, 0xe9, 0xa3, 0x88,
+	0xd7, 0x3b, 0x04, 0x23, 0x51, 0xf0, 0x93, 0xc0, 0x95, 0xa0, 0x77, 0xd3, 0x7a, 0x5f, 0x13, 0xb7,
+	0xd1, 0xa0, 0xb8, 0xf9, 0x98, 0x74, 0x67, 0x3d, 0xb6, 0x4d, 0xee, 0x43, 0x80, 0x7e, 0x8e, 0x48,
+	0xb9, 0xba, 0x13, 0xc4, 0x8c, 0xc6, 0x15, 0xe8, 0x26, 0xb9, 0xe1, 0x8d, 0x46, 0xf2, 0xcd, 0xf6,
+	0x58, 0x82, 0x6c, 0xb5, 0x2b, 0x08, 0xb6, 0xbc, 0x11, 0x84, 0xda, 0x70, 0xc6, 0x9b, 0x0b, 0xe7,
+	0xc4, 0xa7, 0xb7, 0x9e, 0x0f, 0xc4, 0x3e, 0x3c, 0x1f, 0x88, 0xd1, 0x55, 0x38, 0x5a, 0x03, 0x97,
+	0x38, 0xff, 0x2b, 0xd0, 0xeb, 0x53, 0x35, 0xe4, 0xa0, 0x69, 0xa0, 0x68, 0x58, 0x5c, 0x5b, 0x12,
+	0xf4, 0xaf, 0x10, 0x0c, 0x18, 0x1b, 0xfb, 0x04, 0xeb, 0x40, 0x3b, 0x4c, 0x81, 0x74, 0x30, 0x6e,
+	0xe2, 0xb9, 0x39, 0xe8, 0x30, 0x73, 0x8c, 0x38, 0xab, 0xd9, 0x4c, 0x25, 0x56, 0xe8, 0x97, 0xd6,
+	0xe1, 0x3c, 0x6d, 0xd1, 0xf3, 0x29, 0xf6, 0x3d, 0x7b, 0xab, 0x45, 0x35, 0xee, 0xf2, 0xd5, 0x5f,
+	0xac, 0xd3, 0xd9, 0x1f, 0x37, 0xf1, 0x56, 0xb9, 0x65, 0xa7, 0xb3, 0xcb, 0x75, 0xfb, 0x7b, 0x0c,
+	0xff, 0xce, 0x3a, 0x86, 0x6d, 0x62, 0x61, 0xc7, 0xf0, 0x01, 0x8c, 0x8c, 0x7d, 0x0e, 0xd7, 0x21,
+	0xf0, 0xd1, 0x9e, 0xc3, 0xaf, 0xe3, 0x70, 0xcc, 0x20, 0xc8, 0x0a, 0xfc, 0x3e, 0x44, 0x64, 0x1e,
+	0xb0, 0xaa, 0x14, 0x0b, 0xcd, 0x9e, 0x2e, 0x3d, 0xaa, 0x52, 0xf4, 0xbc, 0xd2, 0x0d, 0xf2, 0xaa,
+	0xb6, 0xdb, 0x60, 0x5b, 0x64, 0x83, 0xbc, 0xaa, 0xad, 0x84, 0x7c, 0xb1, 0xdb, 0x5b, 0x90, 0x33,
+	0x6f, 0x11, 0x50, 0x7e, 0x2e, 0x25, 0x39, 0x22, 0x41, 0x9f, 0x22, 0x84, 0x14, 0xf2, 0xe7, 0x82,
+	0xd2, 0xc4, 0x6d, 0xce, 0xaf, 0x94, 0x8f, 0x28, 0xc2, 0xbe, 0x16, 0xf3, 0xb6, 0xf5, 0x29, 0xb2,
+	0x6b, 0xa1, 0xb6, 0x7b, 0x3b, 0x80, 0x25, 0xfc, 0xeb, 0x9a, 0x8f, 0xc2, 0xbe, 0xf7, 0x63, 0x2d,
+	0x73, 0xf9, 0x4b, 0x04, 0xa9, 0x00, 0xec, 0x07, 0xfa, 0xe3, 0xbf, 0x11, 0x98, 0x29, 0xfb, 0xd2,
+	0x94, 0x8d, 0x93, 0x82, 0xbb, 0x2e, 0xaa, 0x9a, 0xac, 0x88, 0x45, 0x6e, 0x5d, 0xef, 0x5e, 0x5d,
+	0x13, 0x85, 0xb2, 0x20, 0x96, 0xca, 0x9a, 0xb1, 0x4d, 0x1b, 0x4b, 0x9e, 0x26, 0xe2, 0x49, 0x44,
+	0x73, 0x70, 0xdc, 0x57, 0x93, 0x80, 0xbc, 0x0c, 0xed, 0x65, 0x51, 0xd5, 0x92, 0xc8, 0x9b, 0x87,
+	0xbb, 0xf1, 0x79, 0xb5, 0x27, 0xe3, 0x49, 0xc4, 0x1a, 0x7a, 0xc6, 0x16, 0x2c, 0xd0, 0xbb, 0xb6,
+	0xb0, 0xe9, 0x2c, 0x0a, 0x5a, 0x3d, 0x90, 0xfe, 0xa3, 0x85, 0x5f, 0x20, 0xf8, 0x4c, 0xa8, 0x51,
+	0x82, 0xff, 0x6b, 0xe0, 0x84, 0xa8, 0xa0, 0x0a, 0x16, 0x91, 0x4c, 0x7d, 0x22, 0x6e, 0x73, 0x6e,
+	0xaf, 0x1f, 0xaa, 0xba, 0x5e, 0x60, 0x0c, 0xed, 0x65, 0x4e, 0x2d, 0x1b, 0x19, 0x73, 0x88, 0x35,
+	0x7e, 0x07, 0x20, 0xfe, 0x6e, 0x4d, 0x43, 0x76, 0x4b, 0xd0, 0x38, 0x9e, 0xd3, 0xb8, 0x15, 0x41,
+	0x11, 0xd7, 0xc4, 0xa2, 0xe7, 0x46, 0xdb, 0xb2, 0x86, 0x2c, 0x00, 0xcd, 0x37, 0xe3, 0x30, 0x12,
+	0x05, 0x8d, 0x9d, 0xab, 0xee, 0x99, 0x54, 0x77, 0x36, 0x1b, 0xe4, 0x3f, 0x3f, 0x2b, 0x8b, 0x86,
+	0xa6, 0x3d, 0xc7, 0xca, 0x43, 0xd7, 0x03, 0x61, 0x55, 0x15, 0x35, 0x41, 0x9f, 0xa3, 0xc9, 0x6b,
+	0xe4, 0x78, 0x18, 0x0c, 0x32, 0x79, 0xc7, 0x14, 0x5e, 0xd0, 0x65, 0xd9, 0x43, 0x0f, 0x5c, 0x4f,
+	0xf8, 0x14, 0x74, 0x1b, 0x26, 0x0a, 0xeb, 0x72, 0xd1, 0x99, 0xd0, 0x74, 0xb2, 0x5d, 0xc6, 0xea,
+	0x4d, 0xb2, 0x18, 0xe0, 0x02, 0x0c, 0x3d, 0x86, 0x07, 0x16, 0x64, 0x79, 0x9d, 0xb8, 0x9d, 0x5e,
+	0x80, 0xc3, 0xae, 0x35, 0x42, 0xfe, 0x12, 0xb4, 0x57, 0x64, 0x79, 0x9d, 0xa4, 0x4e, 0x7f, 0x10,
+	0x4e, 0x5d, 0xc7, 0x9d, 0x28, 0x86, 0x12, 0x9d, 0x00, 0x6c, 0x5a, 0xe4, 0x14, 0x6e, 0xc3, 0xfa,
+	0x4a, 0xd0, 0x5f, 0x82, 0x5e, 0xcf, 0x2a, 0xd9, 0x29, 0x07, 0x1d, 0x15, 0x63, 0x85, 0xec, 0x95,
+	0x0a, 0xdc, 0xcb, 0x90, 0xf2, 0x74, 0x00, 0xa6, 0xe2, 0xc8, 0x8b, 0x38, 0x50, 0xc1, 0x41, 0xc0,
+	0x77, 0x60, 0xe8, 0xd6, 0xcc, 0x52, 0x6e, 0x3a, 0xb7, 0x94, 0x2b, 0xac, 0xcc, 0xb0, 0xf9, 0xd9,
+	0xfc, 0x54, 0x6e, 0x29, 0x3f, 0x3f, 0x57, 0x58, 0x5c, 0xca, 0x2d, 0x2d, 0x2f, 0x16, 0x96, 0xe7,
+	0x16, 0x17, 0x66, 0xa6, 0xf2, 0xb3, 0xf9, 0x99, 0xe9, 0x9e, 0x18, 0x35, 0xfc, 0xf4, 0x59, 0xfa,
+	0x54, 0xb0, 0xb5, 0x65, 0x49, 0xad, 0x08, 0x45, 0x71, 0x4d, 0x14, 0x78, 0xbc, 0x0c, 0xa7, 0xeb,
+	0x18, 0x36, 0x57, 0x67, 0xa6, 0x7b, 0x10, 0x35, 0xf4, 0xf4, 0x59, 0x7a, 0x30, 0xcc, 0x6e, 0xd5,
+	0x58, 0x13, 0x78, 0x7c, 0x17, 0x46, 0x43, 0xcd, 0x2e, 0xb0, 0xf3, 0xf3, 0xb3, 0x05, 0x76, 0x66,
+	0x6a, 0x9e, 0x9d, 0x9e, 0x99, 0xee, 0x89, 0x53, 0xa3, 0x4f, 0x9f, 0xa5, 0x4f, 0x07, 0x9b, 0x36,
+	0xb3, 0x48, 0x28, 0xca, 0x0a, 0x2f, 0xf0, 0x54, 0xfb, 0xd6, 0x8f, 0x53, 0xb1, 0xec, 0x2f, 0x29,
+	0xf8, 0x94, 0x11, 0x0d, 0xfc, 0x23, 0x04, 0xe0, 0x7c, 0x1b, 0x71, 0xe0, 0x29, 0xe1, 0x3f, 0xb9,
+	0xa5, 0x98, 0xc8, 0xf2, 0xa4, 0xb7, 0x65, 0xb6, 0xf4, 0xd8, 0x7d, 0xeb, 0xcf, 0xff, 0xfa, 0x61,
+	0x7c, 0x10, 0xd3, 0x4c, 0xc0, 0x0c, 0xda, 0xf5, 0x5d, 0xfd, 0x19, 0x82, 0x4e, 0xdb, 0x0e, 0x1e,
+	0x8b, 0xb6, 0x9f, 0x05, 0x2f, 0x13, 0x55, 0x9c, 0xa0, 0xbb, 0xea, 0xa0, 0xfb, 0x02, 0x3e, 0x57,
+	0x1f, 0x1d, 0xf3, 0xd8, 0x7b, 0x64, 0x3d, 0xc1, 0x7f, 0x43, 0x90, 0xf0, 0x9b, 0xb7, 0xe1, 0xf1,
+	0x68, 0x50, 0x6a, 0x5b, 0x24, 0xea, 0x62, 0x13, 0x9a, 0x84, 0xcf, 0x4d, 0x87, 0x4f, 0x0e, 0x5f,
+	0x69, 0x82, 0x0f, 0xe3, 0xba, 0xcd, 0xe2, 0xff, 0x21, 0x38, 0x11, 0x3a, 0x9b, 0xc2, 0xb9, 0x68,
+	0x50, 0x43, 0x1a, 0x42, 0x6a, 0x72, 0x2f, 0x26, 0x08, 0xed, 0x15, 0x87, 0xf6, 0x0d, 0x9c, 0x6f,
+	0x86, 0xb6, 0xd3, 0xd1, 0xb9, 0x1d, 0xf0, 0x47, 0x04, 0xe0, 0xec, 0x57, 0xa7, 0x58, 0x6a, 0x66,
+	0x36, 0x14, 0x13, 0x59, 0x9e, 0xf0, 0xf8, 0xaa, 0xc3, 0x83, 0xc5, 0x0b, 0x7b, 0x0c, 0x1f, 0xf3,
+	0xd8, 0x7b, 0x67, 0x7c, 0x82, 0xff, 0x8b, 0xa0, 0xd7, 0xc7, 0x8f, 0xf8, 0x42, 0x28, 0xce, 0xe0,
+	0xa1, 0x14, 0x35, 0xde, 0xb8, 0x22, 0x61, 0xaa, 0x38, 0x4c, 0x4b, 0x58, 0x68, 0x35, 0x53, 0xdf,
+	0x70, 0xe2, 0x3f, 0x21, 0x48, 0xf8, 0x0d, 0x5f, 0xea, 0x94, 0x6a, 0xc8, 0x9c, 0xa9, 0x4e, 0xa9,
+	0x86, 0x4d, 0x7a, 0xe8, 0x9c, 0xe3, 0x81, 0xf3, 0xf8, 0xf3, 0x41, 0x1e, 0x08, 0x8d, 0xa7, 0x5e,
+	0x9f, 0xa1, 0x33, 0x8b, 0x3a, 0xf5, 0x19, 0x65, 0x60, 0x53, 0xa7, 0x3e, 0x23, 0x8d, 0x4c, 0x22,
+	0xd6, 0xa7, 0x4d, 0x2f, 0x62, 0x40, 0x55, 0xfc, 0x7b, 0x04, 0x5d, 0x9e, 0x06, 0x1c, 0x9f, 0x0d,
+	0x45, 0xeb, 0x37, 0xff, 0xa0, 0xb2, 0x8d, 0xa8, 0x10, 0x42, 0x73, 0x0e, 0xa1, 0x29, 0x9c, 0x6b,
+	0x86, 0x90, 0xe2, 0x81, 0xfd, 0x16, 0x41, 0xaf, 0x4f, 0xeb, 0x5a, 0xa7, 0x32, 0x83, 0x7b, 0x74,
+	0x6a, 0xbc, 0x71, 0x45, 0x42, 0xed, 0x86, 0x43, 0xed, 0x2a, 0xbe, 0xdc, 0x0c, 0x35, 0xd7, 0xc7,
+	0x7c, 0x07, 0x01, 0xae, 0xdd, 0x0c, 0x9f, 0x6f, 0x10, 0x9d, 0xc5, 0xea, 0x42, 0xc3, 0x7a, 0x84,
+	0xd4, 0x5d, 0x87, 0xd4, 0x6d, 0x3c, 0xbf, 0x37, 0x52, 0xb5, 0x77, 0x80, 0xdf, 0x20, 0xe8, 0xf6,
+	0xb6, 0x87, 0x38, 0x3c, 0xa9, 0x7c, 0x7b, 0x58, 0xea, 0x5c, 0x43, 0x3a, 0xb5, 0x37, 0x98, 0x2c,
+	0x3e, 0x13, 0xc4, 0xac, 0x6c, 0x2b, 0x1b, 0xff, 0x75, 0xcc, 0x3c, 0x36, 0x3b, 0xcf, 0x27, 0x5b,
+	0x71, 0x84, 0x3f, 0x20, 0xe8, 0xf3, 0xef, 0x09, 0xf1, 0x44, 0x44, 0x44, 0x3e, 0xcd, 0x2e, 0x75,
+	0xa9, 0x29, 0x5d, 0xc2, 0x6a, 0xf9, 0x0f, 0x3e, 0xed, 0x8c, 0x41, 0xf2, 0x12, 0xbe, 0x18, 0x81,
+	0xa4, 0xa7, 0x21, 0xb6, 0xd9, 0xe2, 0x1f, 0xc4, 0x5d, 0x17, 0x1a, 0xbf, 0x1b, 0x74, 0xd4, 0x0b,
+	0x4d, 0x48, 0x5f, 0x4b, 0x4d, 0xee, 0xc5, 0x04, 0xe1, 0xff, 0x75, 0x3f, 0xfe, 0x7b, 0xbe, 0xe4,
+	0x6c, 0x90, 0x2d, 0x0b, 0x55, 0x37, 0xe5, 0x6f, 0x23, 0x68, 0xd7, 0x1b, 0x3b, 0x3c, 0x14, 0x0a,
+	0xdc, 0xd5, 0x43, 0x52, 0xc3, 0x11, 0x24, 0x09, 0x93, 0x61, 0x07, 0x75, 0x0a, 0xf7, 0x07, 0xa1,
+	0xd6, 0xfb, 0x48, 0xfc, 0x3d, 0x04, 0x1d, 0x66, 0xd7, 0x87, 0x47, 0xc2, 0x37, 0x70, 0x37, 0x9a,
+	0xd4, 0x68, 0x24, 0x59, 0x02, 0x67, 0xd4, 0x81, 0x93, 0xc6, 0xa9, 0x40, 0x38, 0x66, 0xef, 0x79,
+	0xfe, 0xd5, 0xfb, 0x14, 0x7a, 0xfd, 0x3e, 0x85, 0xfe, 0xf9, 0x3e, 0x85, 0xbe, 0xbf, 0x93, 0x8a,
+	0xbd, 0xde, 0x49, 0xc5, 0xfe, 0xba, 0x93, 0x8a, 0x7d, 0xb9, 0xdf, 0x54, 0x54, 0xf9, 0x7b, 0x19,
+	0x51, 0x66, 0xec, 0x40, 0x31, 0xda, 0xa3, 0x8a, 0xa0, 0xae, 0x76, 0x18, 0x7f, 0xff, 0x72, 0xee,
+	0xff, 0x03, 0x00, 0xf4, 0x45, 0xc2, 0xa9, 0x0e, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1645,6 +1930,13 @@ type QueryClient interface {
 	DelegatorValidator(ctx context.Context, in *QueryDelegatorValidatorRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(ctx context.Context, in *QueryHistoricalInfoRequest, opts ...grpc.CallOption) (*QueryHistoricalInfoResponse, error)
+	// HistoricalValidatorSet queries the bonded validator set at a given height,
+	// as resulting from the validator set changes of the EndBlocker at that height.
+	// Only the heights within the unbonding period are retained.
+	HistoricalValidatorSet(ctx context.Context, in *QueryHistoricalValidatorSetRequest, opts ...grpc.CallOption) (*QueryHistoricalValidatorSetResponse, error)
+	// ValidatorMetadataVerification queries the verification status of the metadata
+	// of a validator, and where clients can re-check the proof of its website domain.
+	ValidatorMetadataVerification(ctx context.Context, in *QueryValidatorMetadataVerificationRequest, opts ...grpc.CallOption) (*QueryValidatorMetadataVerificationResponse, error)
 	// Pool queries the pool info.
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
@@ -1768,6 +2060,24 @@ func (c *queryClient) HistoricalInfo(ctx context.Context, in *QueryHistoricalInf
 	return out, nil
 }
 
+func (c *queryClient) HistoricalValidatorSet(ctx context.Context, in *QueryHistoricalValidatorSetRequest, opts ...grpc.CallOption) (*QueryHistoricalValidatorSetResponse, error) {
+	out := new(QueryHistoricalValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/HistoricalValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorMetadataVerification(ctx context.Context, in *QueryValidatorMetadataVerificationRequest, opts ...grpc.CallOption) (*QueryValidatorMetadataVerificationResponse, error) {
+	out := new(QueryValidatorMetadataVerificationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorMetadataVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
 	out := new(QueryPoolResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/Pool", in, out, opts...)
@@ -1837,6 +2147,13 @@ type QueryServer interface {
 	DelegatorValidator(context.Context, *QueryDelegatorValidatorRequest) (*QueryDelegatorValidatorResponse, error)
 	// HistoricalInfo queries the historical info for given height.
 	HistoricalInfo(context.Context, *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error)
+	// HistoricalValidatorSet queries the bonded validator set at a given height,
+	// as resulting from the validator set changes of the EndBlocker at that height.
+	// Only the heights within the unbonding period are retained.
+	HistoricalValidatorSet(context.Context, *QueryHistoricalValidatorSetRequest) (*QueryHistoricalValidatorSetResponse, error)
+	// ValidatorMetadataVerification queries the verification status of the metadata
+	// of a validator, and where clients can re-check the proof of its website domain.
+	ValidatorMetadataVerification(context.Context, *QueryValidatorMetadataVerificationRequest) (*QueryValidatorMetadataVerificationResponse, error)
 	// Pool queries the pool info.
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
@@ -1883,6 +2200,12 @@ func (*UnimplementedQueryServer) DelegatorValidator(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) HistoricalInfo(ctx context.Context, req *QueryHistoricalInfoRequest) (*QueryHistoricalInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HistoricalInfo not implemented")
 }
+func (*UnimplementedQueryServer) HistoricalValidatorSet(ctx context.Context, req *QueryHistoricalValidatorSetRequest) (*QueryHistoricalValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalValidatorSet not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorMetadataVerification(ctx context.Context, req *QueryValidatorMetadataVerificationRequest) (*QueryValidatorMetadataVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorMetadataVerification not implemented")
+}
 func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
@@ -2110,6 +2433,42 @@ func _Query_HistoricalInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HistoricalValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/HistoricalValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalValidatorSet(ctx, req.(*QueryHistoricalValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorMetadataVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorMetadataVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorMetadataVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorMetadataVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorMetadataVerification(ctx, req.(*QueryValidatorMetadataVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPoolRequest)
 	if err := dec(in); err != nil {
@@ -2199,6 +2558,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HistoricalInfo",
 			Handler:    _Query_HistoricalInfo_Handler,
 		},
+		{
+			MethodName: "HistoricalValidatorSet",
+			Handler:    _Query_HistoricalValidatorSet_Handler,
+		},
+		{
+			MethodName: "ValidatorMetadataVerification",
+			Handler:    _Query_ValidatorMetadataVerification_Handler,
+		},
 		{
 			MethodName: "Pool",
 			Handler:    _Query_Pool_Handler,
@@ -3245,7 +3612,7 @@ func (m *QueryHistoricalInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHistoricalValidatorSetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3255,20 +3622,25 @@ func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHistoricalValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHistoricalValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHistoricalValidatorSetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3278,18 +3650,25 @@ func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHistoricalValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHistoricalValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3301,7 +3680,7 @@ func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorMetadataVerificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3311,20 +3690,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorMetadataVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorMetadataVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorMetadataVerificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3334,38 +3720,164 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorMetadataVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorMetadataVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.ProofLocation) > 0 {
+		i -= len(m.ProofLocation)
+		copy(dAtA[i:], m.ProofLocation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofLocation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WebsiteProof != nil {
+		{
+			size, err := m.WebsiteProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *QueryValidatorsRequest) Size() (n int) {
@@ -3782,6 +4294,66 @@ func (m *QueryHistoricalInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHistoricalValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryHistoricalValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorMetadataVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorMetadataVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.WebsiteProof != nil {
+		l = m.WebsiteProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProofLocation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6588,6 +7160,411 @@ func (m *QueryHistoricalInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHistoricalValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorMetadataVerificationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorMetadataVerificationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorMetadataVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorMetadataVerificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorMetadataVerificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorMetadataVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MetadataVerificationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WebsiteProof == nil {
+				m.WebsiteProof = &WebsiteProof{}
+			}
+			if err := m.WebsiteProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -40,6 +40,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// WebsiteProofMethod defines how a validator publishes the proof of the ownership of
+// the domain of its website.
+type WebsiteProofMethod int32
+
+const (
+	// UNSPECIFIED defines an invalid proof method.
+	WebsiteProofMethodUnspecified WebsiteProofMethod = 0
+	// DNS_TXT defines a proof published as a TXT record of the _cosmos-validator
+	// subdomain of the website domain.
+	WebsiteProofMethodDNSTXT WebsiteProofMethod = 1
+	// WELL_KNOWN_FILE defines a proof published as the
+	// /.well-known/cosmos-validator.txt file of the website domain.
+	WebsiteProofMethodWellKnownFile WebsiteProofMethod = 2
+)
+
+var WebsiteProofMethod_name = map[int32]string{
+	0: "WEBSITE_PROOF_METHOD_UNSPECIFIED",
+	1: "WEBSITE_PROOF_METHOD_DNS_TXT",
+	2: "WEBSITE_PROOF_METHOD_WELL_KNOWN_FILE",
+}
+
+var WebsiteProofMethod_value = map[string]int32{
+	"WEBSITE_PROOF_METHOD_UNSPECIFIED":     0,
+	"WEBSITE_PROOF_METHOD_DNS_TXT":         1,
+	"WEBSITE_PROOF_METHOD_WELL_KNOWN_FILE": 2,
+}
+
+func (x WebsiteProofMethod) String() string {
+	return proto.EnumName(WebsiteProofMethod_name, int32(x))
+}
+
+func (WebsiteProofMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{0}
+}
+
 // BondStatus is the status of a validator.
 type BondStatus int32
 
@@ -73,7 +108,7 @@ func (x BondStatus) String() string {
 }
 
 func (BondStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{0}
+	return fileDescriptor_64c30c6cf92913c9, []int{1}
 }
 
 // Infraction indicates the infraction a validator committed.
@@ -105,7 +140,7 @@ func (x Infraction) String() string {
 }
 
 func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{1}
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
 }
 
 // HistoricalInfo contains header and validator information for a given block.
@@ -355,6 +390,8 @@ type Metadata struct {
 	ProfilePicUri string `protobuf:"bytes,1,opt,name=profile_pic_uri,json=profilePicUri,proto3" json:"profile_pic_uri,omitempty"`
 	// social_handle_uris defines a string array of uris to the validator's social handles.
 	SocialHandleUris []string `protobuf:"bytes,2,rep,name=social_handle_uris,json=socialHandleUris,proto3" json:"social_handle_uris,omitempty"`
+	// website_proof defines an optional proof of the ownership of the domain of the validator website.
+	WebsiteProof *WebsiteProof `protobuf:"bytes,3,opt,name=website_proof,json=websiteProof,proto3" json:"website_proof,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -404,6 +441,80 @@ func (m *Metadata) GetSocialHandleUris() []string {
 	return nil
 }
 
+func (m *Metadata) GetWebsiteProof() *WebsiteProof {
+	if m != nil {
+		return m.WebsiteProof
+	}
+	return nil
+}
+
+// WebsiteProof defines the proof of the ownership of the domain of a validator
+// website. The chain records the hash of the published proof, which must hold the
+// validator operator address, so that clients can re-check it against the content
+// published on the domain.
+type WebsiteProof struct {
+	// domain is the domain the proof is published on, the host of the validator website.
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// method defines how the proof is published.
+	Method WebsiteProofMethod `protobuf:"varint,2,opt,name=method,proto3,enum=cosmos.staking.v1beta1.WebsiteProofMethod" json:"method,omitempty"`
+	// hash is the hex encoded SHA-256 hash of the published proof.
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *WebsiteProof) Reset()         { *m = WebsiteProof{} }
+func (m *WebsiteProof) String() string { return proto.CompactTextString(m) }
+func (*WebsiteProof) ProtoMessage()    {}
+func (*WebsiteProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{5}
+}
+func (m *WebsiteProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebsiteProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebsiteProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebsiteProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebsiteProof.Merge(m, src)
+}
+func (m *WebsiteProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebsiteProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebsiteProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebsiteProof proto.InternalMessageInfo
+
+func (m *WebsiteProof) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *WebsiteProof) GetMethod() WebsiteProofMethod {
+	if m != nil {
+		return m.Method
+	}
+	return WebsiteProofMethodUnspecified
+}
+
+func (m *WebsiteProof) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
 // Validator defines a validator, together with the total amount of the
 // Validator's bond shares and their exchange rate to coins. Slashing results in
 // a decrease in the exchange rate, allowing correct calculation of future
@@ -445,7 +556,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{5}
+	return fileDescriptor_64c30c6cf92913c9, []int{6}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +594,7 @@ func (m *ValAddresses) Reset()         { *m = ValAddresses{} }
 func (m *ValAddresses) String() string { return proto.CompactTextString(m) }
 func (*ValAddresses) ProtoMessage()    {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{6}
+	return fileDescriptor_64c30c6cf92913c9, []int{7}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +642,7 @@ func (m *DVPair) Reset()         { *m = DVPair{} }
 func (m *DVPair) String() string { return proto.CompactTextString(m) }
 func (*DVPair) ProtoMessage()    {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{7}
+	return fileDescriptor_64c30c6cf92913c9, []int{8}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +680,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{8}
+	return fileDescriptor_64c30c6cf92913c9, []int{9}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,7 +730,7 @@ func (m *DVVTriplet) Reset()         { *m = DVVTriplet{} }
 func (m *DVVTriplet) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplet) ProtoMessage()    {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{9}
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -657,7 +768,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +820,7 @@ func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,6 +849,52 @@ func (m *Delegation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Delegation proto.InternalMessageInfo
 
+// ModuleDelegation tracks the delegation shares owned by a module account
+// which were delegated through the module delegation keeper APIs. These shares
+// are not transferable and can only be undelegated or redelegated by the
+// owning module.
+type ModuleDelegation struct {
+	// module_name is the name of the module owning the delegation.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares define the delegation shares owned by the module.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *ModuleDelegation) Reset()         { *m = ModuleDelegation{} }
+func (m *ModuleDelegation) String() string { return proto.CompactTextString(m) }
+func (*ModuleDelegation) ProtoMessage()    {}
+func (*ModuleDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
+}
+func (m *ModuleDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleDelegation.Merge(m, src)
+}
+func (m *ModuleDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleDelegation proto.InternalMessageInfo
+
 // UnbondingDelegation stores all of a single delegator's unbonding bonds
 // for a single validator in an time-ordered list.
 type UnbondingDelegation struct {
@@ -753,7 +910,7 @@ func (m *UnbondingDelegation) Reset()         { *m = UnbondingDelegation{} }
 func (m *UnbondingDelegation) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegation) ProtoMessage()    {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +959,7 @@ func (m *UnbondingDelegationEntry) Reset()         { *m = UnbondingDelegationEnt
 func (m *UnbondingDelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegationEntry) ProtoMessage()    {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1036,7 @@ func (m *RedelegationEntry) Reset()         { *m = RedelegationEntry{} }
 func (m *RedelegationEntry) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntry) ProtoMessage()    {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,7 +1110,7 @@ func (m *Redelegation) Reset()         { *m = Redelegation{} }
 func (m *Redelegation) String() string { return proto.CompactTextString(m) }
 func (*Redelegation) ProtoMessage()    {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -999,13 +1156,19 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// max_validator_changes_per_block is the maximum number of validator updates
+	// emitted per block, 0 meaning no limit. The validators joining or leaving the
+	// bonded set and the power changes of the other bonded validators beyond the
+	// limit are queued to the next blocks, the validators leaving the bonded set
+	// first. The initial validator set is not limited.
+	MaxValidatorChangesPerBlock uint32 `protobuf:"varint,8,opt,name=max_validator_changes_per_block,json=maxValidatorChangesPerBlock,proto3" json:"max_validator_changes_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,6 +1240,13 @@ func (m *Params) GetKeyRotationFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetMaxValidatorChangesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxValidatorChangesPerBlock
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1088,7 +1258,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1313,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,7 +1361,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1245,7 +1415,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1286,7 +1456,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{21}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1340,7 +1510,7 @@ func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHis
 func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
 func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
 func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{22}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1379,7 +1549,7 @@ func (m *ValAddrsOfRotatedConsKeys) Reset()         { *m = ValAddrsOfRotatedCons
 func (m *ValAddrsOfRotatedConsKeys) String() string { return proto.CompactTextString(m) }
 func (*ValAddrsOfRotatedConsKeys) ProtoMessage()    {}
 func (*ValAddrsOfRotatedConsKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{23}
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
 }
 func (m *ValAddrsOfRotatedConsKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1415,7 +1585,193 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// HistoricalValidator defines a member of a historical bonded validator set.
+type HistoricalValidator struct {
+	// pub_key is the consensus public key of the validator.
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// pub_key_type is the type of the consensus public key, e.g. ed25519.
+	PubKeyType string `protobuf:"bytes,2,opt,name=pub_key_type,json=pubKeyType,proto3" json:"pub_key_type,omitempty"`
+	// power is the consensus power of the validator.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *HistoricalValidator) Reset()         { *m = HistoricalValidator{} }
+func (m *HistoricalValidator) String() string { return proto.CompactTextString(m) }
+func (*HistoricalValidator) ProtoMessage()    {}
+func (*HistoricalValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{26}
+}
+func (m *HistoricalValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalValidator.Merge(m, src)
+}
+func (m *HistoricalValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalValidator proto.InternalMessageInfo
+
+func (m *HistoricalValidator) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *HistoricalValidator) GetPubKeyType() string {
+	if m != nil {
+		return m.PubKeyType
+	}
+	return ""
+}
+
+func (m *HistoricalValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// HistoricalValidatorSet defines a historical bonded validator set, sorted by
+// decreasing power and then by public key.
+type HistoricalValidatorSet struct {
+	// height is the height of the last validator set change included in the set.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// validators are the members of the validator set.
+	Validators []HistoricalValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *HistoricalValidatorSet) Reset()         { *m = HistoricalValidatorSet{} }
+func (m *HistoricalValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*HistoricalValidatorSet) ProtoMessage()    {}
+func (*HistoricalValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{27}
+}
+func (m *HistoricalValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalValidatorSet.Merge(m, src)
+}
+func (m *HistoricalValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalValidatorSet proto.InternalMessageInfo
+
+func (m *HistoricalValidatorSet) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HistoricalValidatorSet) GetValidators() []HistoricalValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// HistoricalValidatorSetDiff defines the changes applied to the bonded
+// validator set at a given height.
+type HistoricalValidatorSetDiff struct {
+	// time is the block time of the height.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// hash is the hash of the validator set resulting from the changes.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// updates are the validator set changes, a zero power removes the validator from the set.
+	Updates []HistoricalValidator `protobuf:"bytes,3,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *HistoricalValidatorSetDiff) Reset()         { *m = HistoricalValidatorSetDiff{} }
+func (m *HistoricalValidatorSetDiff) String() string { return proto.CompactTextString(m) }
+func (*HistoricalValidatorSetDiff) ProtoMessage()    {}
+func (*HistoricalValidatorSetDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{28}
+}
+func (m *HistoricalValidatorSetDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalValidatorSetDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalValidatorSetDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalValidatorSetDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalValidatorSetDiff.Merge(m, src)
+}
+func (m *HistoricalValidatorSetDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalValidatorSetDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalValidatorSetDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalValidatorSetDiff proto.InternalMessageInfo
+
+func (m *HistoricalValidatorSetDiff) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *HistoricalValidatorSetDiff) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *HistoricalValidatorSetDiff) GetUpdates() []HistoricalValidator {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.staking.v1beta1.WebsiteProofMethod", WebsiteProofMethod_name, WebsiteProofMethod_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1423,6 +1779,7 @@ func init() {
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
 	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
 	proto.RegisterType((*Metadata)(nil), "cosmos.staking.v1beta1.Metadata")
+	proto.RegisterType((*WebsiteProof)(nil), "cosmos.staking.v1beta1.WebsiteProof")
 	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
 	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
 	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
@@ -1430,6 +1787,7 @@ func init() {
 	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
 	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
 	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
+	proto.RegisterType((*ModuleDelegation)(nil), "cosmos.staking.v1beta1.ModuleDelegation")
 	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
 	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
@@ -1442,6 +1800,9 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*HistoricalValidator)(nil), "cosmos.staking.v1beta1.HistoricalValidator")
+	proto.RegisterType((*HistoricalValidatorSet)(nil), "cosmos.staking.v1beta1.HistoricalValidatorSet")
+	proto.RegisterType((*HistoricalValidatorSetDiff)(nil), "cosmos.staking.v1beta1.HistoricalValidatorSetDiff")
 }
 
 func init() {
@@ -1449,142 +1810,167 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0xd6, 0x92, 0x34, 0x25, 0xfe, 0x14, 0x45, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x92, 0x19, 0x37,
-	0x91, 0xdd, 0x98, 0x8c, 0xdc, 0xc2, 0x05, 0x84, 0x20, 0x85, 0x29, 0xca, 0x36, 0x93, 0x58, 0x52,
-	0x97, 0xa2, 0xfa, 0x40, 0x9b, 0xc5, 0x70, 0x77, 0x48, 0x6d, 0x45, 0xee, 0xb2, 0x3b, 0x43, 0xd9,
-	0xbc, 0xf7, 0x10, 0xb8, 0x28, 0x90, 0x53, 0x11, 0xa0, 0x30, 0x6a, 0xa0, 0x97, 0xf6, 0x96, 0x83,
-	0xd1, 0x7b, 0x6f, 0x69, 0x81, 0x02, 0x86, 0x4f, 0x45, 0x80, 0xba, 0x85, 0x7d, 0x48, 0xd0, 0x5c,
-	0xda, 0x9e, 0x7a, 0x2c, 0xe6, 0xb1, 0x0f, 0x8a, 0xa2, 0x65, 0xc9, 0x41, 0x11, 0xb4, 0x17, 0x62,
-	0x67, 0xe6, 0xff, 0xbf, 0x99, 0xff, 0x3d, 0xf3, 0x13, 0x2e, 0x9a, 0x2e, 0xed, 0xba, 0xb4, 0x4c,
-	0x19, 0xde, 0xb5, 0x9d, 0x76, 0x79, 0x6f, 0xb9, 0x49, 0x18, 0x5e, 0xf6, 0xc7, 0xa5, 0x9e, 0xe7,
-	0x32, 0x17, 0x9d, 0x91, 0x54, 0x25, 0x7f, 0x56, 0x51, 0x15, 0x66, 0x71, 0xd7, 0x76, 0xdc, 0xb2,
-	0xf8, 0x95, 0xa4, 0x85, 0x57, 0x4c, 0xb7, 0x4b, 0x58, 0xb3, 0xc5, 0xca, 0xb8, 0x69, 0xda, 0xe5,
-	0xbd, 0xe5, 0x32, 0x1b, 0xf4, 0x08, 0x55, 0xab, 0xe7, 0x83, 0x55, 0x31, 0xbb, 0x7f, 0x79, 0x5e,
-	0x9d, 0xa6, 0x89, 0x29, 0x09, 0x8e, 0x62, 0xba, 0xb6, 0xa3, 0xd6, 0xe7, 0xe4, 0xba, 0x21, 0x46,
-	0x65, 0x75, 0x28, 0xb9, 0x74, 0xaa, 0xed, 0xb6, 0x5d, 0x39, 0xcf, 0xbf, 0x7c, 0x86, 0xb6, 0xeb,
-	0xb6, 0x3b, 0xa4, 0x2c, 0x46, 0xcd, 0x7e, 0xab, 0x8c, 0x9d, 0x81, 0xbf, 0xd7, 0xfe, 0x25, 0xab,
-	0xef, 0x61, 0x66, 0xbb, 0xfe, 0x5e, 0x0b, 0xfb, 0xd7, 0x99, 0xdd, 0x25, 0x94, 0xe1, 0x6e, 0x4f,
-	0x12, 0x14, 0x3f, 0xd2, 0x60, 0xe6, 0x96, 0x4d, 0x99, 0xeb, 0xd9, 0x26, 0xee, 0xd4, 0x9c, 0x96,
-	0x8b, 0xde, 0x82, 0xe4, 0x0e, 0xc1, 0x16, 0xf1, 0xf2, 0xda, 0xa2, 0xb6, 0x94, 0xbe, 0x3a, 0x57,
-	0xf2, 0xe5, 0x2d, 0x49, 0x31, 0xf7, 0x96, 0x4b, 0xb7, 0x04, 0x41, 0x25, 0xf5, 0xc9, 0x93, 0x85,
-	0x89, 0xdf, 0x7c, 0xf6, 0xf1, 0x65, 0x4d, 0x57, 0x3c, 0xa8, 0x0a, 0xc9, 0x3d, 0xdc, 0xa1, 0x84,
-	0xe5, 0x63, 0x8b, 0xf1, 0xa5, 0xf4, 0xd5, 0x0b, 0xa5, 0x83, 0xd5, 0x5e, 0xda, 0xc6, 0x1d, 0xdb,
-	0xc2, 0xcc, 0x1d, 0x46, 0x91, 0xbc, 0x2b, 0xb1, 0xbc, 0x56, 0xfc, 0x45, 0x0c, 0xb2, 0xab, 0x6e,
-	0xb7, 0x6b, 0x53, 0x6a, 0xbb, 0x8e, 0x8e, 0x19, 0xa1, 0xe8, 0x1d, 0x48, 0x78, 0x98, 0x11, 0x71,
-	0xb2, 0x54, 0xe5, 0x1a, 0x67, 0xfc, 0xf4, 0xc9, 0xc2, 0x39, 0xb9, 0x05, 0xb5, 0x76, 0x4b, 0xb6,
-	0x5b, 0xee, 0x62, 0xb6, 0x53, 0x7a, 0x8f, 0xb4, 0xb1, 0x39, 0xa8, 0x12, 0xf3, 0xf1, 0xc3, 0x2b,
-	0xa0, 0x4e, 0x50, 0x25, 0xa6, 0xdc, 0x45, 0x60, 0xa0, 0xef, 0xc0, 0x54, 0x17, 0xdf, 0x35, 0x04,
-	0x5e, 0xec, 0xa5, 0xf0, 0x26, 0xbb, 0xf8, 0x2e, 0x3f, 0x1f, 0x7a, 0x1f, 0xb2, 0x1c, 0xd2, 0xdc,
-	0xc1, 0x4e, 0x9b, 0x48, 0xe4, 0xf8, 0x4b, 0x21, 0x67, 0xba, 0xf8, 0xee, 0xaa, 0x40, 0xe3, 0xf8,
-	0x2b, 0x89, 0xcf, 0x1f, 0x2c, 0x68, 0xc5, 0xdf, 0x6b, 0x00, 0xa1, 0x62, 0x10, 0x86, 0x9c, 0x19,
-	0x8c, 0xc4, 0xa6, 0x54, 0x59, 0xee, 0xf5, 0x71, 0xba, 0xdf, 0xa7, 0xd6, 0x4a, 0x86, 0x1f, 0xef,
-	0xd1, 0x93, 0x05, 0x4d, 0xee, 0x9a, 0x35, 0x47, 0xd4, 0x9e, 0xee, 0xf7, 0x2c, 0xcc, 0x88, 0xc1,
-	0xfd, 0x47, 0x68, 0x2b, 0x7d, 0xb5, 0x50, 0x92, 0xce, 0x55, 0xf2, 0x9d, 0xab, 0xb4, 0xe5, 0x3b,
-	0x97, 0x04, 0xfc, 0xf0, 0xaf, 0x3e, 0x20, 0x48, 0x6e, 0xbe, 0xae, 0x64, 0xf8, 0xa7, 0x06, 0xe9,
-	0x2a, 0xa1, 0xa6, 0x67, 0xf7, 0xb8, 0xbb, 0xa2, 0x3c, 0x4c, 0x76, 0x5d, 0xc7, 0xde, 0x55, 0x5e,
-	0x97, 0xd2, 0xfd, 0x21, 0x2a, 0xc0, 0x94, 0x6d, 0x11, 0x87, 0xd9, 0x6c, 0x20, 0xcd, 0xa4, 0x07,
-	0x63, 0xce, 0x75, 0x87, 0x34, 0xa9, 0xed, 0xeb, 0x59, 0xf7, 0x87, 0xe8, 0x12, 0xe4, 0x28, 0x31,
-	0xfb, 0x9e, 0xcd, 0x06, 0x86, 0xe9, 0x3a, 0x0c, 0x9b, 0x2c, 0x9f, 0x10, 0x24, 0x59, 0x7f, 0x7e,
-	0x55, 0x4e, 0x73, 0x10, 0x8b, 0x30, 0x6c, 0x77, 0x68, 0xfe, 0x84, 0x04, 0x51, 0x43, 0x74, 0x13,
-	0xa6, 0xba, 0x84, 0x61, 0x0b, 0x33, 0x9c, 0x4f, 0x0a, 0x99, 0x17, 0xc7, 0x69, 0xf4, 0xb6, 0xa2,
-	0x8b, 0x3a, 0x73, 0xc0, 0xac, 0x64, 0x6e, 0xc1, 0x94, 0x4f, 0x86, 0x5e, 0x83, 0x6c, 0xcf, 0x73,
-	0x5b, 0x76, 0x87, 0x18, 0x3d, 0xdb, 0x34, 0xfa, 0x9e, 0xad, 0xe4, 0xce, 0xa8, 0xe9, 0x4d, 0xdb,
-	0x6c, 0x78, 0x36, 0x7a, 0x03, 0x10, 0x75, 0x4d, 0x1b, 0x77, 0x8c, 0x1d, 0xec, 0x58, 0x1d, 0xc2,
-	0x29, 0xa9, 0x08, 0xad, 0x94, 0x9e, 0x93, 0x2b, 0xb7, 0xc4, 0x42, 0xc3, 0xb3, 0xa9, 0xda, 0xe7,
-	0xfe, 0x24, 0xa4, 0x82, 0xe8, 0x42, 0xab, 0x90, 0x73, 0x7b, 0xc4, 0xe3, 0xdf, 0x06, 0xb6, 0x2c,
-	0x8f, 0x50, 0xaa, 0xc2, 0x27, 0xff, 0xf8, 0xe1, 0x95, 0x53, 0x4a, 0x9e, 0xeb, 0x72, 0xa5, 0xce,
-	0x3c, 0xdb, 0x69, 0xeb, 0x59, 0x9f, 0x43, 0x4d, 0xa3, 0xef, 0x73, 0x1f, 0x73, 0x28, 0x71, 0x68,
-	0x9f, 0x1a, 0xbd, 0x7e, 0x73, 0x97, 0x0c, 0x94, 0x17, 0x9c, 0x1a, 0xf1, 0x82, 0xeb, 0xce, 0xa0,
-	0x92, 0xff, 0x63, 0x08, 0x6d, 0x7a, 0x83, 0x1e, 0x73, 0x4b, 0x9b, 0xfd, 0xe6, 0xbb, 0x64, 0xc0,
-	0x7d, 0x4b, 0xe1, 0x6c, 0x0a, 0x18, 0x74, 0x06, 0x92, 0x3f, 0xc6, 0x76, 0x87, 0x58, 0xc2, 0x84,
-	0x53, 0xba, 0x1a, 0xa1, 0x15, 0x48, 0x52, 0x86, 0x59, 0x9f, 0x0a, 0xbb, 0xcd, 0x5c, 0x2d, 0x8e,
-	0x53, 0x7d, 0xc5, 0x75, 0xac, 0xba, 0xa0, 0xd4, 0x15, 0x07, 0x5a, 0x85, 0x24, 0x73, 0x77, 0x89,
-	0xa3, 0x2c, 0x5a, 0xf9, 0xba, 0x0a, 0xbf, 0xd3, 0xa3, 0xe1, 0x57, 0x73, 0x58, 0x24, 0xf0, 0x6a,
-	0x0e, 0xd3, 0x15, 0x2b, 0xfa, 0x21, 0xe4, 0x2c, 0xd2, 0x21, 0x6d, 0xa1, 0x39, 0xba, 0x83, 0x3d,
-	0x42, 0x85, 0x17, 0xa4, 0x2a, 0xcb, 0x47, 0x8e, 0x66, 0x3d, 0x1b, 0x40, 0xd5, 0x05, 0x12, 0xda,
-	0x84, 0xb4, 0x15, 0xfa, 0x7f, 0x7e, 0x52, 0x28, 0xf3, 0xd5, 0x71, 0x32, 0x46, 0x42, 0x25, 0xea,
-	0x61, 0x51, 0x08, 0xee, 0xf2, 0x7d, 0xa7, 0xe9, 0x3a, 0x96, 0xed, 0xb4, 0x8d, 0x1d, 0x62, 0xb7,
-	0x77, 0x58, 0x7e, 0x6a, 0x51, 0x5b, 0x8a, 0xeb, 0xd9, 0x60, 0xfe, 0x96, 0x98, 0x46, 0x9b, 0x30,
-	0x13, 0x92, 0x8a, 0x90, 0x4e, 0x1d, 0x35, 0xa4, 0x33, 0x01, 0x00, 0x27, 0x41, 0xb7, 0x01, 0xc2,
-	0xa4, 0x91, 0x07, 0x81, 0x56, 0x3c, 0x3c, 0xfd, 0x44, 0x85, 0x89, 0x00, 0x20, 0x07, 0x4e, 0x76,
-	0x6d, 0xc7, 0xa0, 0xa4, 0xd3, 0x32, 0x94, 0xe6, 0x38, 0x6e, 0x5a, 0xa8, 0xff, 0xed, 0x23, 0x58,
-	0xf3, 0xd3, 0x87, 0x57, 0xb2, 0x72, 0x74, 0x85, 0x5a, 0xbb, 0x8b, 0x6f, 0x96, 0xbe, 0xf9, 0x2d,
-	0x7d, 0xb6, 0x6b, 0x3b, 0x75, 0xd2, 0x69, 0x55, 0x03, 0x60, 0xf4, 0x16, 0x9c, 0x0b, 0x15, 0xe2,
-	0x3a, 0xc6, 0x8e, 0xdb, 0xb1, 0x0c, 0x8f, 0xb4, 0x0c, 0xd3, 0xed, 0x3b, 0x2c, 0x3f, 0x2d, 0xd4,
-	0x78, 0x36, 0x20, 0xd9, 0x70, 0x6e, 0xb9, 0x1d, 0x4b, 0x27, 0xad, 0x55, 0xbe, 0x8c, 0x5e, 0x85,
-	0x50, 0x1b, 0x86, 0x6d, 0xd1, 0x7c, 0x66, 0x31, 0xbe, 0x94, 0xd0, 0xa7, 0x83, 0xc9, 0x9a, 0x45,
-	0x57, 0xa6, 0x3e, 0x78, 0xb0, 0x30, 0xf1, 0xf9, 0x83, 0x85, 0x89, 0xe2, 0x0d, 0x98, 0xde, 0xc6,
-	0x1d, 0x15, 0x5a, 0x84, 0xa2, 0x6b, 0x90, 0xc2, 0xfe, 0x20, 0xaf, 0xf1, 0xd0, 0x7e, 0x4e, 0x68,
-	0x86, 0xa4, 0xc5, 0xdf, 0x6a, 0x90, 0xac, 0x6e, 0x6f, 0x62, 0xdb, 0x43, 0x6b, 0x30, 0x1b, 0xfa,
-	0xea, 0x8b, 0x46, 0x79, 0xe8, 0xde, 0x7e, 0x98, 0xaf, 0xc3, 0xec, 0x9e, 0x9f, 0x38, 0x02, 0x18,
-	0x59, 0x1b, 0x2f, 0x3c, 0x7e, 0x78, 0xe5, 0xbc, 0x82, 0x09, 0x92, 0xcb, 0x3e, 0xbc, 0xbd, 0x7d,
-	0xf3, 0x11, 0x99, 0xdf, 0x81, 0x49, 0x79, 0x54, 0x8a, 0xbe, 0x0d, 0x27, 0x7a, 0xfc, 0x43, 0x88,
-	0x9a, 0xbe, 0x3a, 0x3f, 0xd6, 0xe7, 0x05, 0x7d, 0xd4, 0x43, 0x24, 0x5f, 0xf1, 0x67, 0x31, 0x80,
-	0xea, 0xf6, 0xf6, 0x96, 0x67, 0xf7, 0x3a, 0x84, 0x7d, 0x59, 0xb2, 0x37, 0xe0, 0x74, 0x28, 0x3b,
-	0xf5, 0xcc, 0xa3, 0xcb, 0x7f, 0x32, 0xe0, 0xaf, 0x7b, 0xe6, 0x81, 0xb0, 0x16, 0x65, 0x01, 0x6c,
-	0xfc, 0xe8, 0xb0, 0x55, 0xca, 0x46, 0x35, 0xfb, 0x3d, 0x48, 0x87, 0xca, 0xa0, 0xa8, 0x06, 0x53,
-	0x4c, 0x7d, 0x2b, 0x05, 0x17, 0xc7, 0x2b, 0xd8, 0x67, 0x1b, 0xaa, 0x5a, 0x3e, 0x7b, 0xf1, 0xdf,
-	0x1a, 0x40, 0x24, 0x46, 0xbe, 0x9a, 0x3e, 0x86, 0x6a, 0x90, 0x54, 0xc9, 0x39, 0x7e, 0xdc, 0xe4,
-	0xac, 0x00, 0x22, 0x4a, 0xfd, 0x79, 0x0c, 0x4e, 0x36, 0xfc, 0xe8, 0xfd, 0xea, 0xeb, 0xa0, 0x01,
-	0x93, 0xc4, 0x61, 0x9e, 0x2d, 0x94, 0xc0, 0x6d, 0xfe, 0xe6, 0x38, 0x9b, 0x1f, 0x20, 0xd4, 0x9a,
-	0xc3, 0xbc, 0x41, 0xd4, 0x03, 0x7c, 0xac, 0x88, 0x3e, 0x7e, 0x19, 0x87, 0xfc, 0x38, 0x56, 0xf4,
-	0x3a, 0x64, 0x4d, 0x8f, 0x88, 0x09, 0xbf, 0xee, 0x68, 0x22, 0x61, 0xce, 0xf8, 0xd3, 0xaa, 0xec,
-	0xe8, 0xc0, 0x6f, 0x96, 0xdc, 0xb9, 0x38, 0xe9, 0xf1, 0xae, 0x92, 0x33, 0x21, 0x82, 0x28, 0x3c,
-	0x5b, 0x90, 0xb5, 0x1d, 0x9b, 0xf1, 0x1b, 0x52, 0x13, 0x77, 0xb0, 0x63, 0xfa, 0x57, 0xee, 0x23,
-	0xd5, 0xfc, 0x19, 0x85, 0x51, 0x91, 0x10, 0x68, 0x0d, 0x26, 0x7d, 0xb4, 0xc4, 0xd1, 0xd1, 0x7c,
-	0x5e, 0x74, 0x01, 0xa6, 0xa3, 0x85, 0x41, 0xdc, 0x46, 0x12, 0x7a, 0x3a, 0x52, 0x17, 0x0e, 0xab,
-	0x3c, 0xc9, 0xe7, 0x56, 0x1e, 0x75, 0xe1, 0xfb, 0x55, 0x1c, 0x66, 0x75, 0x62, 0xfd, 0xef, 0x9b,
-	0x65, 0x13, 0x40, 0x86, 0x2a, 0xcf, 0xa4, 0xca, 0x32, 0xc7, 0x88, 0xf7, 0x94, 0x04, 0xa9, 0x52,
-	0xf6, 0xdf, 0xb2, 0xd0, 0x5f, 0x62, 0x30, 0x1d, 0xb5, 0xd0, 0xff, 0x65, 0xd1, 0x42, 0xeb, 0x61,
-	0x9a, 0x4a, 0x88, 0x34, 0x75, 0x69, 0x5c, 0x9a, 0x1a, 0xf1, 0xe6, 0x43, 0xf2, 0xd3, 0x17, 0x71,
-	0x48, 0x6e, 0x62, 0x0f, 0x77, 0x29, 0xda, 0x18, 0xb9, 0xdb, 0xfa, 0x6d, 0x8c, 0xfd, 0xce, 0x5c,
-	0x55, 0xbd, 0x12, 0xe9, 0xcb, 0x1f, 0x8d, 0xbb, 0xda, 0x7e, 0x0d, 0x66, 0xf8, 0xa3, 0x3e, 0x10,
-	0x48, 0x2a, 0x37, 0x23, 0xde, 0xe6, 0x81, 0xf4, 0x14, 0x2d, 0x40, 0x9a, 0x93, 0x85, 0x79, 0x98,
-	0xd3, 0x40, 0x17, 0xdf, 0x5d, 0x93, 0x33, 0x68, 0x19, 0xd0, 0x4e, 0xd0, 0x69, 0x31, 0x42, 0x45,
-	0x68, 0x4b, 0x99, 0x4a, 0x2c, 0xaf, 0xe9, 0xb3, 0xe1, 0xaa, 0xcf, 0x72, 0x1e, 0x80, 0x9f, 0xc4,
-	0xb0, 0x88, 0xe3, 0x76, 0xd5, 0xeb, 0x34, 0xc5, 0x67, 0xaa, 0x7c, 0x02, 0xfd, 0x54, 0x93, 0xd7,
-	0xe4, 0x7d, 0xcf, 0x7f, 0xf5, 0x4a, 0xd9, 0x7a, 0x81, 0xc0, 0xf8, 0xd7, 0x93, 0x85, 0xc2, 0x00,
-	0x77, 0x3b, 0x2b, 0xc5, 0x03, 0x70, 0x8a, 0x07, 0x75, 0x24, 0xf8, 0xe5, 0x79, 0xb8, 0x7d, 0x80,
-	0x6a, 0x90, 0xdb, 0x25, 0x03, 0xc3, 0x73, 0x99, 0x4c, 0x36, 0x2d, 0x42, 0xd4, 0x7b, 0x66, 0xce,
-	0xb7, 0x6f, 0x13, 0x53, 0x12, 0xb9, 0xfe, 0xdb, 0x4e, 0x25, 0xc1, 0x4f, 0xa7, 0xcf, 0xec, 0x92,
-	0x81, 0xae, 0xf8, 0x6e, 0x10, 0xb2, 0x72, 0x91, 0x47, 0xcb, 0xbd, 0xcf, 0x3e, 0xbe, 0x7c, 0x2e,
-	0xbc, 0xb4, 0x97, 0xef, 0x06, 0x8d, 0x3d, 0x69, 0x62, 0x7e, 0xf1, 0x45, 0x61, 0x11, 0xd2, 0x09,
-	0xed, 0xf1, 0x37, 0x25, 0x7f, 0x83, 0x44, 0xde, 0x0a, 0xda, 0xf3, 0xdf, 0x20, 0x21, 0xff, 0xd0,
-	0x1b, 0x24, 0x12, 0xa2, 0x6f, 0x87, 0x35, 0x20, 0x76, 0x98, 0x34, 0x51, 0xef, 0x54, 0x4c, 0x22,
-	0xf2, 0x27, 0x8a, 0x7f, 0xd2, 0x60, 0x6e, 0xc4, 0x9b, 0x83, 0x23, 0x9b, 0x80, 0xbc, 0xc8, 0xa2,
-	0xf0, 0x8a, 0x81, 0x3a, 0xfa, 0xf1, 0x82, 0x63, 0xd6, 0x1b, 0x29, 0x04, 0x5f, 0x4e, 0x31, 0x53,
-	0x99, 0xec, 0x0f, 0x1a, 0x9c, 0x8a, 0x1e, 0x20, 0x10, 0xa5, 0x0e, 0xd3, 0xd1, 0xad, 0x95, 0x10,
-	0x17, 0x5f, 0x44, 0x88, 0xe8, 0xf9, 0x87, 0x40, 0xd0, 0x76, 0x98, 0x31, 0x64, 0x3b, 0x71, 0xf9,
-	0x85, 0x95, 0xe2, 0x1f, 0xec, 0xc0, 0xcc, 0x21, 0x6d, 0xf3, 0x85, 0x06, 0x89, 0x4d, 0xd7, 0xed,
-	0xa0, 0x9f, 0xc0, 0xac, 0xe3, 0x32, 0x83, 0x47, 0x16, 0xb1, 0x0c, 0xd5, 0x3a, 0x90, 0xd9, 0x78,
-	0xed, 0xb9, 0xba, 0xfa, 0xfb, 0x93, 0x85, 0x51, 0xce, 0x61, 0x05, 0xaa, 0x96, 0x9a, 0xe3, 0xb2,
-	0x8a, 0x20, 0xda, 0x92, 0xdd, 0x85, 0x16, 0x64, 0x86, 0xb7, 0x93, 0x19, 0xfb, 0xfa, 0x61, 0xdb,
-	0x65, 0x0e, 0xdd, 0x6a, 0xba, 0x19, 0xd9, 0x67, 0x65, 0x8a, 0x5b, 0xed, 0x1f, 0xdc, 0x72, 0xef,
-	0x43, 0x2e, 0x48, 0x57, 0x0d, 0xd1, 0x8f, 0xa3, 0xe8, 0x06, 0x4c, 0xca, 0xd6, 0x9c, 0xff, 0x58,
-	0xb8, 0x10, 0x36, 0x7b, 0x71, 0xd3, 0xb4, 0x4b, 0x7b, 0x91, 0x46, 0xad, 0x64, 0x1a, 0xd2, 0xa7,
-	0x62, 0x16, 0xfd, 0xda, 0x47, 0x31, 0x98, 0x5b, 0x75, 0x1d, 0xaa, 0x1a, 0x3d, 0x2a, 0xaa, 0x65,
-	0x73, 0x79, 0x80, 0x2e, 0x8d, 0x69, 0x43, 0x4d, 0x8f, 0x36, 0x9b, 0xb6, 0x21, 0xcb, 0x4b, 0xac,
-	0xe9, 0x3a, 0x2f, 0xd9, 0x6b, 0xca, 0xb8, 0x1d, 0x4b, 0x9d, 0x68, 0x97, 0x0c, 0x38, 0xae, 0x43,
-	0xee, 0x0c, 0xe1, 0xc6, 0x8f, 0x87, 0xeb, 0x90, 0x3b, 0x11, 0xdc, 0x33, 0x90, 0x54, 0xf7, 0xab,
-	0x84, 0xb8, 0x3d, 0xa8, 0x11, 0xba, 0x06, 0x71, 0x9e, 0x0a, 0x4f, 0x1c, 0x21, 0x79, 0x70, 0x86,
-	0x48, 0x59, 0xab, 0xc3, 0x9c, 0xea, 0x14, 0xd0, 0x8d, 0x96, 0xd0, 0x28, 0x11, 0x02, 0xbd, 0x4b,
-	0x06, 0x07, 0xb4, 0x0d, 0xa6, 0x5f, 0xa8, 0x6d, 0x70, 0xf9, 0x77, 0x1a, 0x40, 0xd8, 0x33, 0x43,
-	0x6f, 0xc0, 0xd9, 0xca, 0xc6, 0x7a, 0xd5, 0xa8, 0x6f, 0x5d, 0xdf, 0x6a, 0xd4, 0x8d, 0xc6, 0x7a,
-	0x7d, 0x73, 0x6d, 0xb5, 0x76, 0xa3, 0xb6, 0x56, 0xcd, 0x4d, 0x14, 0xb2, 0xf7, 0xee, 0x2f, 0xa6,
-	0x1b, 0x0e, 0xed, 0x11, 0xd3, 0x6e, 0xd9, 0xc4, 0x42, 0xaf, 0xc1, 0xa9, 0x61, 0x6a, 0x3e, 0x5a,
-	0xab, 0xe6, 0xb4, 0xc2, 0xf4, 0xbd, 0xfb, 0x8b, 0x53, 0xf2, 0x8d, 0x40, 0x2c, 0xb4, 0x04, 0xa7,
-	0x47, 0xe9, 0x6a, 0xeb, 0x37, 0x73, 0xb1, 0x42, 0xe6, 0xde, 0xfd, 0xc5, 0x54, 0xf0, 0x98, 0x40,
-	0x45, 0x40, 0x51, 0x4a, 0x85, 0x17, 0x2f, 0xc0, 0xbd, 0xfb, 0x8b, 0x49, 0x19, 0x32, 0x85, 0xc4,
-	0x07, 0xbf, 0x9e, 0x9f, 0xb8, 0xfc, 0x23, 0x80, 0x9a, 0xd3, 0xf2, 0xb0, 0x29, 0x52, 0x43, 0x01,
-	0xce, 0xd4, 0xd6, 0x6f, 0xe8, 0xd7, 0x57, 0xb7, 0x6a, 0x1b, 0xeb, 0xc3, 0xc7, 0xde, 0xb7, 0x56,
-	0xdd, 0x68, 0x54, 0xde, 0x5b, 0x33, 0xea, 0xb5, 0x9b, 0xeb, 0x39, 0x0d, 0x9d, 0x85, 0x93, 0x43,
-	0x6b, 0xdf, 0x5d, 0xdf, 0xaa, 0xdd, 0x5e, 0xcb, 0xc5, 0x2a, 0xd7, 0x3e, 0x79, 0x3a, 0xaf, 0x3d,
-	0x7a, 0x3a, 0xaf, 0xfd, 0xed, 0xe9, 0xbc, 0xf6, 0xe1, 0xb3, 0xf9, 0x89, 0x47, 0xcf, 0xe6, 0x27,
-	0xfe, 0xfc, 0x6c, 0x7e, 0xe2, 0x07, 0xaf, 0x0c, 0x05, 0x63, 0x58, 0x8e, 0xc4, 0xdf, 0x21, 0xcd,
-	0xa4, 0xf0, 0x9a, 0x6f, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x85, 0x67, 0xcc, 0xc5, 0x86, 0x1a,
-	0x00, 0x00,
+	// 2558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x34, 0x25, 0x3d, 0x92, 0x22, 0x35, 0x56, 0x6c, 0x9a, 0xb6, 0x25, 0x9a, 0x71,
+	0x13, 0xc7, 0x89, 0x29, 0x4b, 0x2d, 0xdc, 0x42, 0x08, 0x52, 0x98, 0x22, 0x65, 0x33, 0xb6, 0x28,
+	0x76, 0x49, 0xc9, 0x69, 0xd1, 0x66, 0x31, 0xdc, 0x1d, 0x4a, 0x5b, 0x2d, 0x77, 0xd9, 0x9d, 0xa5,
+	0x6c, 0x02, 0x3d, 0xf6, 0x10, 0xa8, 0x28, 0x90, 0x53, 0x11, 0xa0, 0x50, 0x1b, 0xa0, 0x97, 0xf6,
+	0x96, 0x02, 0x46, 0xef, 0x3d, 0x35, 0x2d, 0x50, 0xc0, 0xf0, 0x29, 0x08, 0x50, 0xb7, 0x88, 0x0f,
+	0x09, 0xda, 0x4b, 0x9b, 0x53, 0x8f, 0xc5, 0xcc, 0xce, 0xfe, 0x50, 0x24, 0x2d, 0xcb, 0x0e, 0x8a,
+	0xb4, 0xbd, 0x10, 0x9c, 0x99, 0xf7, 0xbe, 0x79, 0xef, 0xcd, 0xfb, 0x99, 0x79, 0x0b, 0x17, 0x55,
+	0x8b, 0x76, 0x2c, 0xba, 0x48, 0x1d, 0xbc, 0xab, 0x9b, 0xdb, 0x8b, 0x7b, 0x4b, 0x2d, 0xe2, 0xe0,
+	0x25, 0x6f, 0x5c, 0xec, 0xda, 0x96, 0x63, 0xa1, 0x53, 0x2e, 0x55, 0xd1, 0x9b, 0x15, 0x54, 0xb9,
+	0x59, 0xdc, 0xd1, 0x4d, 0x6b, 0x91, 0xff, 0xba, 0xa4, 0xb9, 0x73, 0xaa, 0xd5, 0x21, 0x4e, 0xab,
+	0xed, 0x2c, 0xe2, 0x96, 0xaa, 0x2f, 0xee, 0x2d, 0x2d, 0x3a, 0xfd, 0x2e, 0xa1, 0x62, 0xf5, 0xbc,
+	0xbf, 0xca, 0x67, 0x0f, 0x2f, 0xcf, 0x0b, 0x69, 0x5a, 0x98, 0x12, 0x5f, 0x14, 0xd5, 0xd2, 0x4d,
+	0xb1, 0x7e, 0xc6, 0x5d, 0x57, 0xf8, 0x68, 0x51, 0x08, 0xe5, 0x2e, 0xcd, 0x6d, 0x5b, 0xdb, 0x96,
+	0x3b, 0xcf, 0xfe, 0x79, 0x0c, 0xdb, 0x96, 0xb5, 0x6d, 0x90, 0x45, 0x3e, 0x6a, 0xf5, 0xda, 0x8b,
+	0xd8, 0xec, 0x7b, 0x7b, 0x1d, 0x5e, 0xd2, 0x7a, 0x36, 0x76, 0x74, 0xcb, 0xdb, 0x6b, 0xe1, 0xf0,
+	0xba, 0xa3, 0x77, 0x08, 0x75, 0x70, 0xa7, 0xeb, 0x12, 0x14, 0xde, 0x93, 0x60, 0xe6, 0xa6, 0x4e,
+	0x1d, 0xcb, 0xd6, 0x55, 0x6c, 0x54, 0xcd, 0xb6, 0x85, 0x5e, 0x87, 0xf8, 0x0e, 0xc1, 0x1a, 0xb1,
+	0xb3, 0x52, 0x5e, 0xba, 0x94, 0x58, 0x3e, 0x53, 0xf4, 0xf4, 0x2d, 0xba, 0x6a, 0xee, 0x2d, 0x15,
+	0x6f, 0x72, 0x82, 0xd2, 0xf4, 0x87, 0x8f, 0x16, 0x26, 0x7e, 0xf5, 0xe9, 0x07, 0x97, 0x25, 0x59,
+	0xf0, 0xa0, 0x32, 0xc4, 0xf7, 0xb0, 0x41, 0x89, 0x93, 0x8d, 0xe4, 0xa3, 0x97, 0x12, 0xcb, 0x17,
+	0x8a, 0xa3, 0xcd, 0x5e, 0xdc, 0xc2, 0x86, 0xae, 0x61, 0xc7, 0x1a, 0x44, 0x71, 0x79, 0x57, 0x22,
+	0x59, 0xa9, 0xf0, 0xd3, 0x08, 0xa4, 0x57, 0xad, 0x4e, 0x47, 0xa7, 0x54, 0xb7, 0x4c, 0x19, 0x3b,
+	0x84, 0xa2, 0x37, 0x21, 0x66, 0x63, 0x87, 0x70, 0xc9, 0xa6, 0x4b, 0xd7, 0x18, 0xe3, 0xc7, 0x8f,
+	0x16, 0xce, 0xba, 0x5b, 0x50, 0x6d, 0xb7, 0xa8, 0x5b, 0x8b, 0x1d, 0xec, 0xec, 0x14, 0x6f, 0x93,
+	0x6d, 0xac, 0xf6, 0xcb, 0x44, 0x7d, 0x78, 0xff, 0x0a, 0x08, 0x09, 0xca, 0x44, 0x75, 0x77, 0xe1,
+	0x18, 0xe8, 0x5b, 0x30, 0xd5, 0xc1, 0xf7, 0x14, 0x8e, 0x17, 0x79, 0x2e, 0xbc, 0xc9, 0x0e, 0xbe,
+	0xc7, 0xe4, 0x43, 0x6f, 0x43, 0x9a, 0x41, 0xaa, 0x3b, 0xd8, 0xdc, 0x26, 0x2e, 0x72, 0xf4, 0xb9,
+	0x90, 0x53, 0x1d, 0x7c, 0x6f, 0x95, 0xa3, 0x31, 0xfc, 0x95, 0xd8, 0x67, 0xef, 0x2f, 0x48, 0x85,
+	0xdf, 0x49, 0x00, 0x81, 0x61, 0x10, 0x86, 0x8c, 0xea, 0x8f, 0xf8, 0xa6, 0x54, 0x9c, 0xdc, 0xcb,
+	0xe3, 0x6c, 0x7f, 0xc8, 0xac, 0xa5, 0x14, 0x13, 0xef, 0xc1, 0xa3, 0x05, 0xc9, 0xdd, 0x35, 0xad,
+	0x0e, 0x99, 0x3d, 0xd1, 0xeb, 0x6a, 0xd8, 0x21, 0x0a, 0xf3, 0x1f, 0x6e, 0xad, 0xc4, 0x72, 0xae,
+	0xe8, 0x3a, 0x57, 0xd1, 0x73, 0xae, 0x62, 0xd3, 0x73, 0x2e, 0x17, 0xf0, 0xdd, 0xbf, 0x78, 0x80,
+	0xe0, 0x72, 0xb3, 0x75, 0xa1, 0xc3, 0x3f, 0x25, 0x48, 0x94, 0x09, 0x55, 0x6d, 0xbd, 0xcb, 0xdc,
+	0x15, 0x65, 0x61, 0xb2, 0x63, 0x99, 0xfa, 0xae, 0xf0, 0xba, 0x69, 0xd9, 0x1b, 0xa2, 0x1c, 0x4c,
+	0xe9, 0x1a, 0x31, 0x1d, 0xdd, 0xe9, 0xbb, 0xc7, 0x24, 0xfb, 0x63, 0xc6, 0x75, 0x97, 0xb4, 0xa8,
+	0xee, 0xd9, 0x59, 0xf6, 0x86, 0xe8, 0x15, 0xc8, 0x50, 0xa2, 0xf6, 0x6c, 0xdd, 0xe9, 0x2b, 0xaa,
+	0x65, 0x3a, 0x58, 0x75, 0xb2, 0x31, 0x4e, 0x92, 0xf6, 0xe6, 0x57, 0xdd, 0x69, 0x06, 0xa2, 0x11,
+	0x07, 0xeb, 0x06, 0xcd, 0x9e, 0x70, 0x41, 0xc4, 0x10, 0xdd, 0x80, 0xa9, 0x0e, 0x71, 0xb0, 0x86,
+	0x1d, 0x9c, 0x8d, 0x73, 0x9d, 0xf3, 0xe3, 0x2c, 0xba, 0x2e, 0xe8, 0xc2, 0xce, 0xec, 0x33, 0x0b,
+	0x9d, 0x7f, 0x2f, 0xc1, 0x94, 0x47, 0x87, 0x5e, 0x82, 0x74, 0xd7, 0xb6, 0xda, 0xba, 0x41, 0x94,
+	0xae, 0xae, 0x2a, 0x3d, 0x5b, 0x17, 0x8a, 0xa7, 0xc4, 0x74, 0x5d, 0x57, 0x37, 0x6d, 0x1d, 0xbd,
+	0x06, 0x88, 0x5a, 0xaa, 0x8e, 0x0d, 0x65, 0x07, 0x9b, 0x9a, 0x41, 0x18, 0x25, 0xe5, 0xb1, 0x35,
+	0x2d, 0x67, 0xdc, 0x95, 0x9b, 0x7c, 0x61, 0xd3, 0xd6, 0x29, 0x52, 0x20, 0x25, 0x2c, 0xc0, 0xd2,
+	0x8b, 0xd5, 0xe6, 0x66, 0x49, 0x2c, 0x5f, 0x1c, 0x27, 0xf6, 0x1d, 0x97, 0xb8, 0xce, 0x68, 0x4b,
+	0x73, 0x1f, 0xdf, 0xbf, 0x92, 0xb9, 0xe7, 0x65, 0xcd, 0xfc, 0xde, 0xd5, 0xe2, 0x72, 0xf1, 0xaa,
+	0x9c, 0xbc, 0x1b, 0xa2, 0x11, 0x9a, 0xbc, 0x27, 0x41, 0x32, 0xcc, 0x8a, 0x4e, 0x41, 0x5c, 0xb3,
+	0x3a, 0x58, 0x37, 0x85, 0x12, 0x62, 0x84, 0x4a, 0x10, 0xef, 0x10, 0x67, 0xc7, 0xd2, 0xf8, 0xd1,
+	0xcd, 0x2c, 0x5f, 0x7e, 0x1a, 0x41, 0xd6, 0x39, 0x87, 0x2c, 0x38, 0x11, 0x82, 0xd8, 0x0e, 0xa6,
+	0x3b, 0xe2, 0x84, 0xf9, 0xff, 0x95, 0x2c, 0x13, 0xe3, 0xe1, 0x08, 0x71, 0x0b, 0x07, 0x93, 0x30,
+	0xed, 0xa7, 0x16, 0xb4, 0x0a, 0x19, 0xab, 0x4b, 0x6c, 0xf6, 0x5f, 0xc1, 0x9a, 0x66, 0x13, 0x4a,
+	0x45, 0xee, 0xc8, 0x3e, 0xbc, 0x7f, 0x65, 0x4e, 0x08, 0x73, 0xdd, 0x5d, 0x69, 0x38, 0xb6, 0x6e,
+	0x6e, 0xcb, 0x69, 0x8f, 0x43, 0x4c, 0xa3, 0x6f, 0xb3, 0x00, 0x33, 0x29, 0x31, 0x69, 0x8f, 0x2a,
+	0xdd, 0x5e, 0x6b, 0x97, 0xf4, 0x45, 0x08, 0xcc, 0x0d, 0x85, 0xc0, 0x75, 0xb3, 0x5f, 0xca, 0xfe,
+	0x31, 0x80, 0x56, 0xed, 0x7e, 0xd7, 0xb1, 0x8a, 0xf5, 0x5e, 0xeb, 0x16, 0xe9, 0xcb, 0x69, 0x1f,
+	0xa7, 0xce, 0x61, 0x98, 0xdd, 0xbe, 0x8f, 0x75, 0x83, 0x68, 0x5c, 0xbb, 0x29, 0x59, 0x8c, 0xd0,
+	0x0a, 0xc4, 0xa9, 0x83, 0x9d, 0x1e, 0xe5, 0x4e, 0x3b, 0xb3, 0x5c, 0x18, 0x67, 0xb7, 0x92, 0x65,
+	0x6a, 0x0d, 0x4e, 0x29, 0x0b, 0x0e, 0xb4, 0x0a, 0x71, 0xc7, 0xda, 0x25, 0xa6, 0x70, 0xe7, 0xd2,
+	0xab, 0x22, 0xf7, 0xbc, 0x30, 0x9c, 0x7b, 0xaa, 0xa6, 0x13, 0xca, 0x3a, 0x55, 0xd3, 0x91, 0x05,
+	0x2b, 0xfa, 0x2e, 0x64, 0x34, 0x62, 0x90, 0x6d, 0x6e, 0x39, 0xba, 0x83, 0x6d, 0x42, 0x79, 0x08,
+	0x4c, 0x97, 0x96, 0x8e, 0x9d, 0xca, 0xe4, 0xb4, 0x0f, 0xd5, 0xe0, 0x48, 0xa8, 0x0e, 0x09, 0x2d,
+	0x08, 0xfe, 0xec, 0x24, 0x37, 0xe6, 0x8b, 0xe3, 0x74, 0x0c, 0xe5, 0x89, 0x70, 0x78, 0x85, 0x21,
+	0x58, 0xbc, 0xf7, 0xcc, 0x96, 0x65, 0x6a, 0xba, 0xb9, 0xad, 0xec, 0x10, 0x7d, 0x7b, 0xc7, 0xc9,
+	0x4e, 0xe5, 0xa5, 0x4b, 0x51, 0x39, 0xed, 0xcf, 0xdf, 0xe4, 0xd3, 0xa8, 0x0e, 0x33, 0x01, 0x29,
+	0xcf, 0x67, 0xd3, 0xc7, 0xcd, 0x67, 0x29, 0x1f, 0x80, 0x91, 0xa0, 0x75, 0x80, 0x20, 0x63, 0x66,
+	0x81, 0xa3, 0x15, 0x8e, 0xce, 0xbd, 0x61, 0x65, 0x42, 0x00, 0xc8, 0x84, 0x93, 0x1d, 0xdd, 0x54,
+	0x28, 0x31, 0xda, 0x8a, 0xb0, 0x1c, 0xc3, 0x4d, 0x70, 0xf3, 0xbf, 0x71, 0x8c, 0xd3, 0xfc, 0xf8,
+	0xfe, 0x95, 0xb4, 0x3b, 0xba, 0x42, 0xb5, 0xdd, 0xfc, 0xd5, 0xe2, 0xd7, 0xbe, 0x2e, 0xcf, 0x76,
+	0x74, 0xb3, 0x41, 0x8c, 0x76, 0xd9, 0x07, 0x46, 0xaf, 0xc3, 0xd9, 0xc0, 0x20, 0x96, 0xa9, 0xec,
+	0x58, 0x86, 0xa6, 0xd8, 0xa4, 0xad, 0xa8, 0x56, 0xcf, 0x74, 0xb2, 0x49, 0x6e, 0xc6, 0xd3, 0x3e,
+	0xc9, 0x86, 0x79, 0xd3, 0x32, 0x34, 0x99, 0xb4, 0x57, 0xd9, 0x32, 0x7a, 0x11, 0x02, 0x6b, 0x28,
+	0xba, 0x46, 0xb3, 0xa9, 0x7c, 0xf4, 0x52, 0x4c, 0x4e, 0xfa, 0x93, 0x55, 0x8d, 0xae, 0x4c, 0xbd,
+	0xf3, 0xfe, 0xc2, 0xc4, 0x67, 0xef, 0x2f, 0x4c, 0x14, 0xd6, 0x20, 0xb9, 0x85, 0x0d, 0x11, 0x5a,
+	0x84, 0xa2, 0x6b, 0x30, 0x8d, 0xbd, 0x41, 0x56, 0xca, 0x47, 0x9f, 0x18, 0x9a, 0x01, 0x69, 0xe1,
+	0xd7, 0x12, 0xc4, 0xcb, 0x5b, 0x75, 0xac, 0xdb, 0xa8, 0x02, 0xb3, 0x81, 0xaf, 0x3e, 0x6d, 0x94,
+	0x07, 0xee, 0xed, 0x85, 0x79, 0x0d, 0x66, 0xf7, 0xbc, 0xc4, 0xe1, 0xc3, 0xb8, 0x17, 0x83, 0x0b,
+	0x0f, 0xef, 0x5f, 0x39, 0x2f, 0x60, 0xfc, 0xe4, 0x72, 0x08, 0x6f, 0xef, 0xd0, 0x7c, 0x48, 0xe7,
+	0x37, 0x61, 0xd2, 0x15, 0x95, 0xa2, 0x6f, 0xc2, 0x89, 0x2e, 0xfb, 0xc3, 0x55, 0x4d, 0x2c, 0xcf,
+	0x8f, 0xf5, 0x79, 0x4e, 0x1f, 0xf6, 0x10, 0x97, 0xaf, 0xf0, 0xe3, 0x08, 0x40, 0x79, 0x6b, 0xab,
+	0x69, 0xeb, 0x5d, 0x83, 0x38, 0x5f, 0x94, 0xee, 0x9b, 0xf0, 0x42, 0xa0, 0x3b, 0xb5, 0xd5, 0xe3,
+	0xeb, 0x7f, 0xd2, 0xe7, 0x6f, 0xd8, 0xea, 0x48, 0x58, 0x8d, 0x3a, 0x3e, 0x6c, 0xf4, 0xf8, 0xb0,
+	0x65, 0xea, 0x0c, 0x5b, 0xf6, 0x2d, 0x48, 0x04, 0xc6, 0xa0, 0xa8, 0x0a, 0x53, 0x8e, 0xf8, 0x2f,
+	0x0c, 0x5c, 0x18, 0x6f, 0x60, 0x8f, 0x6d, 0xa0, 0x64, 0x7b, 0xec, 0x85, 0x7f, 0x49, 0x00, 0xa1,
+	0x18, 0xf9, 0x72, 0xfa, 0x18, 0xaa, 0x42, 0x5c, 0x24, 0xe7, 0xe8, 0xb3, 0x26, 0x67, 0x01, 0x10,
+	0x32, 0xea, 0x47, 0x12, 0x64, 0xd6, 0x2d, 0xad, 0x67, 0x90, 0x90, 0x01, 0x16, 0x20, 0xd1, 0xe1,
+	0x73, 0x8a, 0x89, 0x3b, 0xe2, 0x02, 0x2e, 0x83, 0x3b, 0x55, 0xc3, 0x1d, 0xf2, 0xdf, 0xa1, 0xda,
+	0x4f, 0x22, 0x70, 0x72, 0xd3, 0x4b, 0x4c, 0x5f, 0xfe, 0xe3, 0xdd, 0x84, 0x49, 0x62, 0x3a, 0xb6,
+	0xce, 0x8d, 0xc0, 0xdc, 0xf9, 0xea, 0x38, 0x77, 0x1e, 0xa1, 0x54, 0xc5, 0x74, 0xec, 0x7e, 0xd8,
+	0xb9, 0x3d, 0xac, 0x90, 0x3d, 0x7e, 0x16, 0x85, 0xec, 0x38, 0x56, 0xf4, 0x32, 0xa4, 0x55, 0x9b,
+	0xf0, 0x09, 0xaf, 0xa4, 0x4a, 0xbc, 0x16, 0xcc, 0x78, 0xd3, 0xa2, 0xa2, 0xca, 0xc0, 0x5e, 0x0c,
+	0x2c, 0x6e, 0x18, 0xe9, 0xb3, 0x3d, 0x11, 0x66, 0x02, 0x04, 0x5e, 0x53, 0x9b, 0x90, 0xd6, 0x4d,
+	0xdd, 0x61, 0x17, 0xdf, 0x16, 0x36, 0xb0, 0xa9, 0x7a, 0x4f, 0xa9, 0x63, 0x5d, 0x67, 0x66, 0x04,
+	0x46, 0xc9, 0x85, 0x40, 0x15, 0x98, 0xf4, 0xd0, 0x62, 0xc7, 0x47, 0xf3, 0x78, 0xd1, 0x05, 0x48,
+	0x86, 0x6b, 0x1e, 0xbf, 0x68, 0xc5, 0xe4, 0x44, 0xa8, 0xe4, 0x1d, 0x55, 0x54, 0xe3, 0x4f, 0x2c,
+	0xaa, 0xe2, 0x9a, 0xfd, 0x8b, 0x28, 0xcc, 0xca, 0x44, 0xfb, 0xdf, 0x3f, 0x96, 0x3a, 0x80, 0x1b,
+	0xaa, 0xac, 0x48, 0x64, 0x63, 0xcf, 0x1a, 0xef, 0xd3, 0x2e, 0x48, 0x99, 0x3a, 0xff, 0xa9, 0x13,
+	0xfa, 0x73, 0x04, 0x92, 0xe1, 0x13, 0xfa, 0xbf, 0xac, 0xc7, 0xa8, 0x16, 0xa4, 0xa9, 0x18, 0x4f,
+	0x53, 0xaf, 0x8c, 0x4b, 0x53, 0x43, 0xde, 0x7c, 0x44, 0x7e, 0xfa, 0x4d, 0x0c, 0xe2, 0x75, 0x6c,
+	0xe3, 0x0e, 0x45, 0x1b, 0x43, 0xd7, 0x76, 0xaf, 0x3d, 0x75, 0xd8, 0x99, 0xcb, 0xa2, 0x07, 0xe6,
+	0xfa, 0xf2, 0x7b, 0xe3, 0x6e, 0xed, 0x5f, 0x81, 0x19, 0xd6, 0xac, 0xf1, 0x15, 0x72, 0x8d, 0x9b,
+	0xe2, 0x3d, 0x17, 0x5f, 0x7b, 0xca, 0x0b, 0x1f, 0xbe, 0xa7, 0x04, 0x79, 0x98, 0xd1, 0x40, 0x07,
+	0xdf, 0xab, 0xb8, 0x33, 0x68, 0x09, 0xd0, 0x8e, 0xdf, 0x41, 0x53, 0x02, 0x43, 0x48, 0x97, 0x52,
+	0xa5, 0x48, 0x56, 0x92, 0x67, 0x83, 0x55, 0x8f, 0xe5, 0x3c, 0x00, 0x93, 0x44, 0xd1, 0x88, 0x69,
+	0x75, 0x44, 0xd7, 0x61, 0x9a, 0xcd, 0x94, 0xd9, 0x04, 0xfa, 0x91, 0xe4, 0xbe, 0x00, 0x0e, 0xb5,
+	0x75, 0xc4, 0x03, 0xac, 0xf9, 0x14, 0x81, 0xf1, 0xf9, 0xa3, 0x85, 0x5c, 0x1f, 0x77, 0x8c, 0x95,
+	0xc2, 0x08, 0x9c, 0xc2, 0xa8, 0x4e, 0x13, 0x7b, 0x17, 0x0c, 0xb6, 0x85, 0x50, 0x15, 0x32, 0xbb,
+	0xa4, 0xaf, 0xd8, 0x96, 0xe3, 0x26, 0x9b, 0x36, 0x21, 0xd9, 0x49, 0xbf, 0x25, 0xc8, 0xd9, 0x5b,
+	0x98, 0x92, 0xd0, 0xcb, 0x46, 0x37, 0x4b, 0x31, 0x26, 0x9d, 0x3c, 0xb3, 0x4b, 0xfa, 0xb2, 0xe0,
+	0x5b, 0x23, 0x04, 0x95, 0x61, 0x61, 0xc0, 0xd6, 0xa2, 0x45, 0x46, 0x95, 0x2e, 0xb1, 0x95, 0x96,
+	0x61, 0xa9, 0xbb, 0xfc, 0xb5, 0x96, 0x92, 0xcf, 0x86, 0x8d, 0xef, 0x76, 0xbe, 0x68, 0x9d, 0xd8,
+	0x25, 0x46, 0xb2, 0x72, 0x91, 0xc5, 0xdc, 0xfe, 0xa7, 0x1f, 0x5c, 0x3e, 0x1b, 0xbc, 0x6a, 0x16,
+	0xfd, 0x0e, 0xc0, 0xa2, 0xeb, 0x28, 0xec, 0x65, 0x80, 0x82, 0x52, 0x26, 0x13, 0xda, 0xb5, 0x4c,
+	0xca, 0x1f, 0x69, 0xa1, 0xc7, 0x94, 0xf4, 0xe4, 0x47, 0x5a, 0xc0, 0x3f, 0xf0, 0x48, 0x0b, 0x05,
+	0xfa, 0x1b, 0x41, 0x25, 0x89, 0x1c, 0x65, 0x93, 0xb0, 0x8f, 0x0b, 0x26, 0x9e, 0x3f, 0x26, 0x0a,
+	0x7f, 0x92, 0xe0, 0xcc, 0x50, 0x4c, 0xf8, 0x22, 0xab, 0x80, 0xec, 0xd0, 0x22, 0xf7, 0xad, 0xbe,
+	0x10, 0xfd, 0xd9, 0x42, 0x6c, 0xd6, 0x3e, 0xbc, 0xfa, 0x05, 0x95, 0x44, 0x91, 0x0f, 0xff, 0x20,
+	0xc1, 0x5c, 0x58, 0x00, 0x5f, 0x95, 0x06, 0x24, 0xc3, 0x5b, 0x67, 0xa5, 0x27, 0xf7, 0xa5, 0xc2,
+	0x18, 0x61, 0xf9, 0x07, 0x40, 0xd0, 0x56, 0x90, 0x77, 0xdc, 0x66, 0xf3, 0xd2, 0x53, 0x1b, 0xc5,
+	0x13, 0x6c, 0x64, 0xfe, 0x71, 0xcf, 0xe6, 0xef, 0x12, 0xc4, 0xea, 0x96, 0x65, 0xa0, 0x1f, 0xc0,
+	0xac, 0x69, 0x39, 0x0a, 0x8b, 0x4f, 0xa2, 0x29, 0xa2, 0xb7, 0xe2, 0xe6, 0xf4, 0xca, 0x13, 0x6d,
+	0xf5, 0xb7, 0x47, 0x0b, 0xc3, 0x9c, 0x83, 0x06, 0x14, 0x0d, 0x57, 0xd3, 0x72, 0x4a, 0x9c, 0xa8,
+	0xc9, 0x69, 0x50, 0x1b, 0x52, 0x83, 0xdb, 0xb9, 0x79, 0xff, 0xfa, 0x51, 0xdb, 0xa5, 0x8e, 0xdc,
+	0x2a, 0xd9, 0x0a, 0xed, 0xb3, 0x32, 0xc5, 0x4e, 0xed, 0x1f, 0xec, 0xe4, 0xde, 0x86, 0x8c, 0x1f,
+	0x77, 0x9b, 0xbc, 0x5b, 0x4b, 0xd1, 0x1a, 0x4c, 0xba, 0x8d, 0x5b, 0xef, 0x35, 0x75, 0x21, 0xf8,
+	0x14, 0xc0, 0x3e, 0x8c, 0xb0, 0x2f, 0x01, 0x87, 0x98, 0x06, 0xec, 0x29, 0x98, 0x79, 0x37, 0xff,
+	0x41, 0x04, 0xce, 0xac, 0x5a, 0x26, 0x15, 0x9d, 0x30, 0x91, 0x1b, 0xdc, 0x4f, 0x0f, 0x7d, 0xd6,
+	0xbe, 0x19, 0xd9, 0xa7, 0x4b, 0x0e, 0x77, 0xe3, 0xb6, 0x20, 0xcd, 0x0a, 0x35, 0xeb, 0xa4, 0x3d,
+	0x5f, 0x33, 0x2e, 0x65, 0x19, 0x9a, 0x90, 0x88, 0xb5, 0xe2, 0xb6, 0x20, 0x6d, 0x92, 0xbb, 0x03,
+	0xb8, 0xd1, 0x67, 0xc3, 0x35, 0xc9, 0xdd, 0x10, 0xee, 0x29, 0xf6, 0x39, 0x85, 0xdf, 0xd2, 0x62,
+	0xfc, 0x0e, 0x22, 0x46, 0xe8, 0x1a, 0x44, 0x59, 0x42, 0x3d, 0x71, 0x8c, 0xe4, 0xc1, 0x18, 0x42,
+	0xc5, 0xb1, 0x01, 0x67, 0x44, 0x2b, 0x85, 0x6e, 0xb4, 0xb9, 0x45, 0x09, 0x57, 0xe8, 0x16, 0xe9,
+	0x8f, 0xe8, 0xab, 0x24, 0x9f, 0xae, 0xaf, 0xf2, 0x43, 0x38, 0x19, 0x7c, 0x0f, 0x0a, 0x1a, 0xa9,
+	0xa7, 0x61, 0xb2, 0xdb, 0x6b, 0x29, 0xcc, 0x2a, 0xee, 0xb9, 0xc4, 0xbb, 0x5c, 0x5b, 0x94, 0x87,
+	0xa4, 0x58, 0x50, 0xd8, 0xd7, 0x21, 0xd1, 0xa2, 0x07, 0x77, 0xb5, 0xd9, 0xef, 0x12, 0x34, 0x07,
+	0x27, 0xba, 0xd6, 0x5d, 0x62, 0x73, 0x73, 0x46, 0x65, 0x77, 0xb0, 0x32, 0x37, 0xb2, 0x7b, 0xfb,
+	0x73, 0x09, 0x4e, 0x8d, 0xd8, 0xbe, 0x41, 0x9c, 0x90, 0x1d, 0xdd, 0xdb, 0xae, 0x67, 0xc7, 0x2d,
+	0x80, 0x81, 0x12, 0xce, 0xfc, 0xf4, 0xd5, 0x71, 0x79, 0x60, 0x04, 0xf6, 0x40, 0x82, 0x0f, 0x90,
+	0xc6, 0x08, 0xf8, 0x50, 0x82, 0xdc, 0x68, 0x01, 0xcb, 0x7a, 0xbb, 0x8d, 0xbe, 0x01, 0xb1, 0xd0,
+	0xd5, 0xe4, 0x49, 0xf7, 0xec, 0x29, 0xef, 0x9e, 0x2d, 0x73, 0x0e, 0xbf, 0xcb, 0x1d, 0xe1, 0xd6,
+	0xe5, 0xff, 0x51, 0x3d, 0x88, 0xbf, 0xe8, 0x73, 0xe9, 0xe5, 0x47, 0xe2, 0x48, 0xa5, 0x2e, 0x7f,
+	0x2e, 0x01, 0x1a, 0x6e, 0xc0, 0xa3, 0x1b, 0x90, 0xbf, 0x53, 0x29, 0x35, 0xaa, 0xcd, 0x8a, 0x52,
+	0x97, 0x37, 0x36, 0xd6, 0x94, 0xf5, 0x4a, 0xf3, 0xe6, 0x46, 0x59, 0xd9, 0xac, 0x35, 0xea, 0x95,
+	0xd5, 0xea, 0x5a, 0xb5, 0x52, 0xce, 0x4c, 0xe4, 0x2e, 0xec, 0x1f, 0xe4, 0xcf, 0x0f, 0x73, 0x6f,
+	0x9a, 0xb4, 0x4b, 0x54, 0xbd, 0xad, 0x13, 0x0d, 0xbd, 0x01, 0xe7, 0x46, 0x02, 0x95, 0x6b, 0x0d,
+	0xa5, 0xf9, 0x56, 0x33, 0x23, 0xe5, 0xce, 0xed, 0x1f, 0xe4, 0xb3, 0xc3, 0x20, 0xe5, 0x5a, 0xa3,
+	0xf9, 0x56, 0x13, 0xad, 0xc3, 0xc5, 0x91, 0xfc, 0x77, 0x2a, 0xb7, 0x6f, 0x2b, 0xb7, 0x6a, 0x1b,
+	0x77, 0x6a, 0xca, 0x5a, 0xf5, 0x76, 0x25, 0x13, 0xc9, 0xbd, 0xb8, 0x7f, 0x90, 0x5f, 0x18, 0xc6,
+	0xb9, 0x43, 0x0c, 0xe3, 0x96, 0x69, 0xdd, 0x35, 0xd7, 0x74, 0x83, 0xe4, 0x62, 0xef, 0xfc, 0x72,
+	0x7e, 0xe2, 0xf2, 0x6f, 0x25, 0x80, 0xa0, 0x7b, 0x8e, 0x5e, 0x83, 0xd3, 0xa5, 0x8d, 0x5a, 0x59,
+	0x69, 0x34, 0xaf, 0x37, 0x37, 0x1b, 0x87, 0x74, 0x4c, 0xef, 0x1f, 0xe4, 0x13, 0x61, 0x8d, 0x5e,
+	0x82, 0xb9, 0x41, 0x6a, 0x36, 0xaa, 0x94, 0x33, 0x52, 0x2e, 0xb9, 0x7f, 0x90, 0x9f, 0x72, 0x9f,
+	0xd4, 0x44, 0x43, 0x97, 0xe0, 0x85, 0x61, 0xba, 0x6a, 0xed, 0x46, 0x26, 0x92, 0x4b, 0xed, 0x1f,
+	0xe4, 0xa7, 0xfd, 0xb7, 0x37, 0x2a, 0x00, 0x0a, 0x53, 0x0a, 0xbc, 0x68, 0x0e, 0xf6, 0x0f, 0xf2,
+	0x71, 0xb7, 0x36, 0x08, 0xc1, 0xbf, 0x07, 0x50, 0x35, 0xdb, 0x36, 0x56, 0x79, 0x0d, 0xcc, 0xc1,
+	0xa9, 0x6a, 0x6d, 0x4d, 0xbe, 0xbe, 0xda, 0xac, 0x6e, 0xd4, 0x06, 0xc5, 0x3e, 0xb4, 0x56, 0xde,
+	0xd8, 0x2c, 0xdd, 0xae, 0x28, 0x8d, 0xea, 0x8d, 0x5a, 0x46, 0x42, 0xa7, 0xe1, 0xe4, 0xc0, 0xda,
+	0x9d, 0x5a, 0xb3, 0xba, 0x5e, 0xc9, 0x44, 0x4a, 0xd7, 0x3e, 0xfc, 0x64, 0x5e, 0x7a, 0xf0, 0xc9,
+	0xbc, 0xf4, 0xd7, 0x4f, 0xe6, 0xa5, 0x77, 0x1f, 0xcf, 0x4f, 0x3c, 0x78, 0x3c, 0x3f, 0xf1, 0xd1,
+	0xe3, 0xf9, 0x89, 0xef, 0x9c, 0x1b, 0xa8, 0x3a, 0xc1, 0xbd, 0x8b, 0x7f, 0x15, 0x6e, 0xc5, 0xb9,
+	0x93, 0x7f, 0xf5, 0xdf, 0x03, 0x00, 0xf3, 0xad, 0xf9, 0xff, 0x8d, 0x1f, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1593,750 +1979,837 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11876 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x94, 0x24, 0xd7,
-		0x59, 0xd8, 0x56, 0x77, 0x4f, 0x4f, 0xf7, 0xd7, 0xaf, 0x9a, 0x3b, 0xb3, 0xbb, 0xb3, 0xb3, 0xd2,
-		0xce, 0x6c, 0xad, 0xa4, 0x5d, 0xad, 0xa4, 0x59, 0xed, 0x4a, 0xbb, 0x92, 0x5a, 0x96, 0x44, 0xf7,
-		0x4c, 0xef, 0x6c, 0xaf, 0xe6, 0xe5, 0xea, 0x9e, 0xb5, 0x24, 0x1e, 0x45, 0x4d, 0xf5, 0x9d, 0x99,
-		0xd2, 0x76, 0x57, 0xb5, 0xab, 0xaa, 0x77, 0x77, 0x94, 0x73, 0x72, 0x4c, 0xc0, 0x8e, 0x11, 0x8f,
-		0x98, 0x40, 0xc0, 0xd8, 0x5e, 0x23, 0x20, 0x60, 0x1b, 0x02, 0x81, 0xd8, 0x10, 0x1c, 0x4e, 0x1e,
-		0xe4, 0x24, 0x04, 0xc8, 0x09, 0x71, 0xf8, 0x11, 0x38, 0x9c, 0x83, 0x02, 0x36, 0xe7, 0xe0, 0x60,
-		0x43, 0x80, 0x18, 0x0e, 0x27, 0x3e, 0xc9, 0xc9, 0xb9, 0xaf, 0x7a, 0x74, 0x57, 0x4f, 0xf7, 0xac,
-		0x24, 0x43, 0x20, 0x7f, 0x76, 0xa7, 0xee, 0xfd, 0xbe, 0xef, 0xde, 0xfb, 0xdd, 0xef, 0x7e, 0xaf,
-		0xfb, 0x68, 0xf8, 0xbd, 0xab, 0xb0, 0xb0, 0x6b, 0xdb, 0xbb, 0x6d, 0x7c, 0xa1, 0xeb, 0xd8, 0x9e,
-		0xbd, 0xdd, 0xdb, 0xb9, 0xd0, 0xc2, 0xae, 0xe1, 0x98, 0x5d, 0xcf, 0x76, 0x16, 0x69, 0x19, 0x2a,
-		0x31, 0x88, 0x45, 0x01, 0xa1, 0xac, 0xc1, 0xd4, 0x55, 0xb3, 0x8d, 0x97, 0x7d, 0xc0, 0x06, 0xf6,
-		0xd0, 0xd3, 0x90, 0xda, 0x31, 0xdb, 0x78, 0x56, 0x5a, 0x48, 0x9e, 0xcb, 0x5d, 0x7a, 0x60, 0xb1,
-		0x0f, 0x69, 0x31, 0x8a, 0xb1, 0x49, 0x8a, 0x55, 0x8a, 0xa1, 0xfc, 0x9f, 0x14, 0x4c, 0xc7, 0xd4,
-		0x22, 0x04, 0x29, 0x4b, 0xef, 0x10, 0x8a, 0xd2, 0xb9, 0xac, 0x4a, 0xff, 0x46, 0xb3, 0x30, 0xd9,
-		0xd5, 0x8d, 0x9b, 0xfa, 0x2e, 0x9e, 0x4d, 0xd0, 0x62, 0xf1, 0x89, 0x4e, 0x01, 0xb4, 0x70, 0x17,
-		0x5b, 0x2d, 0x6c, 0x19, 0xfb, 0xb3, 0xc9, 0x85, 0xe4, 0xb9, 0xac, 0x1a, 0x2a, 0x41, 0x8f, 0xc0,
-		0x54, 0xb7, 0xb7, 0xdd, 0x36, 0x0d, 0x2d, 0x04, 0x06, 0x0b, 0xc9, 0x73, 0x13, 0xaa, 0xcc, 0x2a,
-		0x96, 0x03, 0xe0, 0xb3, 0x50, 0xba, 0x8d, 0xf5, 0x9b, 0x61, 0xd0, 0x1c, 0x05, 0x2d, 0x92, 0xe2,
-		0x10, 0xe0, 0x12, 0xe4, 0x3b, 0xd8, 0x75, 0xf5, 0x5d, 0xac, 0x79, 0xfb, 0x5d, 0x3c, 0x9b, 0xa2,
-		0xa3, 0x5f, 0x18, 0x18, 0x7d, 0xff, 0xc8, 0x73, 0x1c, 0xab, 0xb9, 0xdf, 0xc5, 0xa8, 0x02, 0x59,
-		0x6c, 0xf5, 0x3a, 0x8c, 0xc2, 0xc4, 0x10, 0xfe, 0xd5, 0xac, 0x5e, 0xa7, 0x9f, 0x4a, 0x86, 0xa0,
-		0x71, 0x12, 0x93, 0x2e, 0x76, 0x6e, 0x99, 0x06, 0x9e, 0x4d, 0x53, 0x02, 0x67, 0x07, 0x08, 0x34,
-		0x58, 0x7d, 0x3f, 0x0d, 0x81, 0x87, 0x96, 0x20, 0x8b, 0xef, 0x78, 0xd8, 0x72, 0x4d, 0xdb, 0x9a,
-		0x9d, 0xa4, 0x44, 0x1e, 0x8c, 0x99, 0x45, 0xdc, 0x6e, 0xf5, 0x93, 0x08, 0xf0, 0xd0, 0x15, 0x98,
-		0xb4, 0xbb, 0x9e, 0x69, 0x5b, 0xee, 0x6c, 0x66, 0x41, 0x3a, 0x97, 0xbb, 0x74, 0x5f, 0xac, 0x20,
-		0x6c, 0x30, 0x18, 0x55, 0x00, 0xa3, 0x3a, 0xc8, 0xae, 0xdd, 0x73, 0x0c, 0xac, 0x19, 0x76, 0x0b,
-		0x6b, 0xa6, 0xb5, 0x63, 0xcf, 0x66, 0x29, 0x81, 0xf9, 0xc1, 0x81, 0x50, 0xc0, 0x25, 0xbb, 0x85,
-		0xeb, 0xd6, 0x8e, 0xad, 0x16, 0xdd, 0xc8, 0x37, 0x3a, 0x06, 0x69, 0x77, 0xdf, 0xf2, 0xf4, 0x3b,
-		0xb3, 0x79, 0x2a, 0x21, 0xfc, 0x8b, 0x88, 0x0e, 0x6e, 0x99, 0xa4, 0xb9, 0xd9, 0x02, 0x13, 0x1d,
-		0xfe, 0xa9, 0x7c, 0x36, 0x0d, 0xa5, 0x71, 0x84, 0xef, 0x59, 0x98, 0xd8, 0x21, 0xe3, 0x9f, 0x4d,
-		0x1c, 0x86, 0x3b, 0x0c, 0x27, 0xca, 0xde, 0xf4, 0x3d, 0xb2, 0xb7, 0x02, 0x39, 0x0b, 0xbb, 0x1e,
-		0x6e, 0x31, 0x59, 0x49, 0x8e, 0x29, 0x6d, 0xc0, 0x90, 0x06, 0x85, 0x2d, 0x75, 0x4f, 0xc2, 0xf6,
-		0x12, 0x94, 0xfc, 0x2e, 0x69, 0x8e, 0x6e, 0xed, 0x0a, 0xa9, 0xbd, 0x30, 0xaa, 0x27, 0x8b, 0x35,
-		0x81, 0xa7, 0x12, 0x34, 0xb5, 0x88, 0x23, 0xdf, 0x68, 0x19, 0xc0, 0xb6, 0xb0, 0xbd, 0xa3, 0xb5,
-		0xb0, 0xd1, 0x9e, 0xcd, 0x0c, 0xe1, 0xd2, 0x06, 0x01, 0x19, 0xe0, 0x92, 0xcd, 0x4a, 0x8d, 0x36,
-		0x7a, 0x26, 0x10, 0xc2, 0xc9, 0x21, 0x32, 0xb4, 0xc6, 0x96, 0xdf, 0x80, 0x1c, 0x6e, 0x41, 0xd1,
-		0xc1, 0x64, 0x45, 0xe0, 0x16, 0x1f, 0x59, 0x96, 0x76, 0x62, 0x71, 0xe4, 0xc8, 0x54, 0x8e, 0xc6,
-		0x06, 0x56, 0x70, 0xc2, 0x9f, 0xe8, 0x0c, 0xf8, 0x05, 0x1a, 0x15, 0x2b, 0xa0, 0xfa, 0x29, 0x2f,
-		0x0a, 0xd7, 0xf5, 0x0e, 0x9e, 0x7b, 0x0d, 0x8a, 0x51, 0xf6, 0xa0, 0x19, 0x98, 0x70, 0x3d, 0xdd,
-		0xf1, 0xa8, 0x14, 0x4e, 0xa8, 0xec, 0x03, 0xc9, 0x90, 0xc4, 0x56, 0x8b, 0xea, 0xbf, 0x09, 0x95,
-		0xfc, 0x89, 0xbe, 0x2e, 0x18, 0x70, 0x92, 0x0e, 0xf8, 0xa1, 0xc1, 0x19, 0x8d, 0x50, 0xee, 0x1f,
-		0xf7, 0xdc, 0x53, 0x50, 0x88, 0x0c, 0x60, 0xdc, 0xa6, 0x95, 0x9f, 0x4a, 0xc1, 0xd1, 0x58, 0xda,
-		0xe8, 0x25, 0x98, 0xe9, 0x59, 0xa6, 0xe5, 0x61, 0xa7, 0xeb, 0x60, 0x22, 0xb2, 0xac, 0xad, 0xd9,
-		0x3f, 0x98, 0x1c, 0x22, 0x74, 0x5b, 0x61, 0x68, 0x46, 0x45, 0x9d, 0xee, 0x0d, 0x16, 0xa2, 0x97,
-		0x21, 0x47, 0xe4, 0x43, 0x77, 0x74, 0x4a, 0x90, 0xad, 0xc6, 0x4b, 0xe3, 0x0d, 0x79, 0x71, 0x39,
-		0xc0, 0xac, 0x26, 0x3f, 0x28, 0x25, 0xd4, 0x30, 0x2d, 0xb4, 0x07, 0xf9, 0x5b, 0xd8, 0x31, 0x77,
-		0x4c, 0x83, 0xd1, 0x26, 0xec, 0x2c, 0x5e, 0x7a, 0x7a, 0x4c, 0xda, 0x37, 0x42, 0xa8, 0x0d, 0x4f,
-		0xf7, 0x70, 0x19, 0xb6, 0xd6, 0x6f, 0xd4, 0xd4, 0xfa, 0xd5, 0x7a, 0x6d, 0x59, 0x8d, 0x50, 0x9e,
-		0xfb, 0xb4, 0x04, 0xb9, 0x50, 0x5f, 0x88, 0xda, 0xb2, 0x7a, 0x9d, 0x6d, 0xec, 0x70, 0x8e, 0xf3,
-		0x2f, 0x74, 0x12, 0xb2, 0x3b, 0xbd, 0x76, 0x9b, 0x89, 0x0d, 0xb3, 0x79, 0x19, 0x52, 0x40, 0x44,
-		0x86, 0x68, 0x29, 0xae, 0x08, 0xa8, 0x96, 0x22, 0x7f, 0xa3, 0x33, 0x90, 0x33, 0x5d, 0xcd, 0xc1,
-		0x5d, 0xac, 0x7b, 0xb8, 0x35, 0x9b, 0x5a, 0x90, 0xce, 0x65, 0xaa, 0x89, 0x59, 0x49, 0x05, 0xd3,
-		0x55, 0x79, 0x29, 0x9a, 0x83, 0x8c, 0x90, 0xbd, 0xd9, 0x09, 0x02, 0xa1, 0xfa, 0xdf, 0xac, 0x8e,
-		0x63, 0xa7, 0x45, 0x1d, 0xfb, 0x56, 0x9e, 0x84, 0xa9, 0x81, 0x41, 0xa2, 0x12, 0xe4, 0x96, 0x6b,
-		0x4b, 0xab, 0x15, 0xb5, 0xd2, 0xac, 0x6f, 0xac, 0xcb, 0x47, 0x50, 0x11, 0x42, 0xe3, 0x96, 0xa5,
-		0xf3, 0xd9, 0xcc, 0x17, 0x27, 0xe5, 0xf7, 0xbd, 0xef, 0x7d, 0xef, 0x4b, 0x28, 0xbf, 0x94, 0x86,
-		0x99, 0x38, 0x2d, 0x17, 0xab, 0x70, 0x03, 0x9e, 0x24, 0x23, 0x3c, 0xa9, 0xc0, 0x44, 0x5b, 0xdf,
-		0xc6, 0x6d, 0x3a, 0xb8, 0xe2, 0xa5, 0x47, 0xc6, 0xd2, 0xa3, 0x8b, 0xab, 0x04, 0x45, 0x65, 0x98,
-		0xe8, 0x79, 0xce, 0xb9, 0x09, 0x4a, 0xe1, 0xfc, 0x78, 0x14, 0x88, 0xf6, 0xe3, 0x5c, 0x3e, 0x09,
-		0x59, 0xf2, 0x3f, 0x9b, 0x96, 0x34, 0x9b, 0x16, 0x52, 0x40, 0xa7, 0x65, 0x0e, 0x32, 0x54, 0xb1,
-		0xb5, 0xb0, 0x3f, 0x65, 0xe2, 0x9b, 0xa8, 0x82, 0x16, 0xde, 0xd1, 0x7b, 0x6d, 0x4f, 0xbb, 0xa5,
-		0xb7, 0x7b, 0x98, 0xaa, 0xa8, 0xac, 0x9a, 0xe7, 0x85, 0x37, 0x48, 0x19, 0x9a, 0x87, 0x1c, 0xd3,
-		0x83, 0xa6, 0xd5, 0xc2, 0x77, 0xa8, 0x25, 0x9c, 0x50, 0x99, 0x6a, 0xac, 0x93, 0x12, 0xd2, 0xfc,
-		0xab, 0xae, 0x6d, 0x09, 0x65, 0x42, 0x9b, 0x20, 0x05, 0xb4, 0xf9, 0xa7, 0xfa, 0x8d, 0xf0, 0xfd,
-		0xf1, 0xc3, 0x1b, 0xd0, 0x7e, 0x67, 0xa1, 0x44, 0x21, 0x9e, 0xe0, 0x6b, 0x55, 0x6f, 0xcf, 0x4e,
-		0x51, 0x01, 0x28, 0xb2, 0xe2, 0x0d, 0x5e, 0xaa, 0xfc, 0x7c, 0x02, 0x52, 0xd4, 0x14, 0x94, 0x20,
-		0xd7, 0x7c, 0x79, 0xb3, 0xa6, 0x2d, 0x6f, 0x6c, 0x55, 0x57, 0x6b, 0xb2, 0x44, 0xa6, 0x9e, 0x16,
-		0x5c, 0x5d, 0xdd, 0xa8, 0x34, 0xe5, 0x84, 0xff, 0x5d, 0x5f, 0x6f, 0x5e, 0x79, 0x52, 0x4e, 0xfa,
-		0x08, 0x5b, 0xac, 0x20, 0x15, 0x06, 0x78, 0xe2, 0x92, 0x3c, 0x81, 0x64, 0xc8, 0x33, 0x02, 0xf5,
-		0x97, 0x6a, 0xcb, 0x57, 0x9e, 0x94, 0xd3, 0xd1, 0x92, 0x27, 0x2e, 0xc9, 0x93, 0xa8, 0x00, 0x59,
-		0x5a, 0x52, 0xdd, 0xd8, 0x58, 0x95, 0x33, 0x3e, 0xcd, 0x46, 0x53, 0xad, 0xaf, 0xaf, 0xc8, 0x59,
-		0x9f, 0xe6, 0x8a, 0xba, 0xb1, 0xb5, 0x29, 0x83, 0x4f, 0x61, 0xad, 0xd6, 0x68, 0x54, 0x56, 0x6a,
-		0x72, 0xce, 0x87, 0xa8, 0xbe, 0xdc, 0xac, 0x35, 0xe4, 0x7c, 0xa4, 0x5b, 0x4f, 0x5c, 0x92, 0x0b,
-		0x7e, 0x13, 0xb5, 0xf5, 0xad, 0x35, 0xb9, 0x88, 0xa6, 0xa0, 0xc0, 0x9a, 0x10, 0x9d, 0x28, 0xf5,
-		0x15, 0x5d, 0x79, 0x52, 0x96, 0x83, 0x8e, 0x30, 0x2a, 0x53, 0x91, 0x82, 0x2b, 0x4f, 0xca, 0x48,
-		0x59, 0x82, 0x09, 0x2a, 0x86, 0x08, 0x41, 0x71, 0xb5, 0x52, 0xad, 0xad, 0x6a, 0x1b, 0x9b, 0x64,
-		0xd1, 0x54, 0x56, 0x65, 0x29, 0x28, 0x53, 0x6b, 0xef, 0xde, 0xaa, 0xab, 0xb5, 0x65, 0x39, 0x11,
-		0x2e, 0xdb, 0xac, 0x55, 0x9a, 0xb5, 0x65, 0x39, 0xa9, 0x18, 0x30, 0x13, 0x67, 0x02, 0x63, 0x97,
-		0x50, 0x48, 0x16, 0x12, 0x43, 0x64, 0x81, 0xd2, 0xea, 0x97, 0x05, 0xe5, 0x0b, 0x09, 0x98, 0x8e,
-		0x71, 0x03, 0x62, 0x1b, 0x79, 0x01, 0x26, 0x98, 0x2c, 0x33, 0x55, 0xfc, 0x70, 0xac, 0x3f, 0x41,
-		0x25, 0x7b, 0xc0, 0x39, 0xa2, 0x78, 0x61, 0xb7, 0x31, 0x39, 0xc4, 0x6d, 0x24, 0x24, 0x06, 0x04,
-		0xf6, 0x1b, 0x07, 0xcc, 0x35, 0xf3, 0x68, 0xae, 0x8c, 0xe3, 0xd1, 0xd0, 0xb2, 0xc3, 0x99, 0xed,
-		0x89, 0x18, 0xb3, 0xfd, 0x2c, 0x4c, 0x0d, 0x10, 0x1a, 0xdb, 0x7c, 0x7e, 0xab, 0x04, 0xb3, 0xc3,
-		0x98, 0x33, 0x42, 0x25, 0x26, 0x22, 0x2a, 0xf1, 0xd9, 0x7e, 0x0e, 0x9e, 0x1e, 0x3e, 0x09, 0x03,
-		0x73, 0xfd, 0x09, 0x09, 0x8e, 0xc5, 0x87, 0x07, 0xb1, 0x7d, 0x78, 0x1e, 0xd2, 0x1d, 0xec, 0xed,
-		0xd9, 0xc2, 0x11, 0x7e, 0x28, 0xc6, 0xbd, 0x22, 0xd5, 0xfd, 0x93, 0xcd, 0xb1, 0xc2, 0xfe, 0x59,
-		0x72, 0x98, 0x8f, 0xcf, 0x7a, 0x33, 0xd0, 0xd3, 0x6f, 0x4f, 0xc0, 0xd1, 0x58, 0xe2, 0xb1, 0x1d,
-		0xbd, 0x1f, 0xc0, 0xb4, 0xba, 0x3d, 0x8f, 0x39, 0xbb, 0x4c, 0x13, 0x67, 0x69, 0x09, 0x55, 0x5e,
-		0x44, 0xcb, 0xf6, 0x3c, 0xbf, 0x9e, 0x19, 0x51, 0x60, 0x45, 0x14, 0xe0, 0xe9, 0xa0, 0xa3, 0x29,
-		0xda, 0xd1, 0x53, 0x43, 0x46, 0x3a, 0x20, 0x98, 0x8f, 0x83, 0x6c, 0xb4, 0x4d, 0x6c, 0x79, 0x9a,
-		0xeb, 0x39, 0x58, 0xef, 0x98, 0xd6, 0x2e, 0xb3, 0xb3, 0xe5, 0x89, 0x1d, 0xbd, 0xed, 0x62, 0xb5,
-		0xc4, 0xaa, 0x1b, 0xa2, 0x96, 0x60, 0x50, 0x01, 0x72, 0x42, 0x18, 0xe9, 0x08, 0x06, 0xab, 0xf6,
-		0x31, 0x94, 0xef, 0xc9, 0x42, 0x2e, 0x14, 0x4c, 0xa1, 0xd3, 0x90, 0x7f, 0x55, 0xbf, 0xa5, 0x6b,
-		0x22, 0x40, 0x66, 0x9c, 0xc8, 0x91, 0xb2, 0x4d, 0x1e, 0x24, 0x3f, 0x0e, 0x33, 0x14, 0xc4, 0xee,
-		0x79, 0xd8, 0xd1, 0x8c, 0xb6, 0xee, 0xba, 0x94, 0x69, 0x19, 0x0a, 0x8a, 0x48, 0xdd, 0x06, 0xa9,
-		0x5a, 0x12, 0x35, 0xe8, 0x32, 0x4c, 0x53, 0x8c, 0x4e, 0xaf, 0xed, 0x99, 0xdd, 0x36, 0xd6, 0x48,
-		0xc8, 0xee, 0x52, 0x93, 0xe3, 0xf7, 0x6c, 0x8a, 0x40, 0xac, 0x71, 0x00, 0xd2, 0x23, 0x17, 0x2d,
-		0xc3, 0xfd, 0x14, 0x6d, 0x17, 0x5b, 0xd8, 0xd1, 0x3d, 0xac, 0xe1, 0xf7, 0xf6, 0xf4, 0xb6, 0xab,
-		0xe9, 0x56, 0x4b, 0xdb, 0xd3, 0xdd, 0xbd, 0xd9, 0x19, 0xdf, 0x2d, 0x39, 0x41, 0x00, 0x57, 0x38,
-		0x5c, 0x8d, 0x82, 0x55, 0xac, 0xd6, 0x35, 0xdd, 0xdd, 0x43, 0x65, 0x38, 0x46, 0xa9, 0xb8, 0x9e,
-		0x63, 0x5a, 0xbb, 0x9a, 0xb1, 0x87, 0x8d, 0x9b, 0x5a, 0xcf, 0xdb, 0x79, 0x7a, 0xf6, 0x64, 0xb8,
-		0x7d, 0xda, 0xc3, 0x06, 0x85, 0x59, 0x22, 0x20, 0x5b, 0xde, 0xce, 0xd3, 0xa8, 0x01, 0x79, 0x32,
-		0x19, 0x1d, 0xf3, 0x35, 0xac, 0xed, 0xd8, 0x0e, 0xb5, 0xa1, 0xc5, 0x18, 0xd5, 0x14, 0xe2, 0xe0,
-		0xe2, 0x06, 0x47, 0x58, 0xb3, 0x5b, 0xb8, 0x3c, 0xd1, 0xd8, 0xac, 0xd5, 0x96, 0xd5, 0x9c, 0xa0,
-		0x72, 0xd5, 0x76, 0x88, 0x40, 0xed, 0xda, 0x3e, 0x83, 0x73, 0x4c, 0xa0, 0x76, 0x6d, 0xc1, 0xde,
-		0xcb, 0x30, 0x6d, 0x18, 0x6c, 0xcc, 0xa6, 0xa1, 0xf1, 0xc0, 0xda, 0x9d, 0x95, 0x23, 0xcc, 0x32,
-		0x8c, 0x15, 0x06, 0xc0, 0x65, 0xdc, 0x45, 0xcf, 0xc0, 0xd1, 0x80, 0x59, 0x61, 0xc4, 0xa9, 0x81,
-		0x51, 0xf6, 0xa3, 0x5e, 0x86, 0xe9, 0xee, 0xfe, 0x20, 0x22, 0x8a, 0xb4, 0xd8, 0xdd, 0xef, 0x47,
-		0x7b, 0x0a, 0x66, 0xba, 0x7b, 0xdd, 0x41, 0xbc, 0xf3, 0x61, 0x3c, 0xd4, 0xdd, 0xeb, 0xf6, 0x23,
-		0x3e, 0x48, 0xb3, 0x2c, 0x0e, 0x36, 0xa8, 0x77, 0x78, 0x3c, 0x0c, 0x1e, 0xaa, 0x40, 0x8b, 0x20,
-		0x1b, 0x86, 0x86, 0x2d, 0x7d, 0xbb, 0x8d, 0x35, 0xdd, 0xc1, 0x96, 0xee, 0xce, 0xce, 0x53, 0xe0,
-		0x94, 0xe7, 0xf4, 0xb0, 0x5a, 0x34, 0x8c, 0x1a, 0xad, 0xac, 0xd0, 0x3a, 0x74, 0x1e, 0xa6, 0xec,
-		0xed, 0x57, 0x0d, 0x26, 0x91, 0x5a, 0xd7, 0xc1, 0x3b, 0xe6, 0x9d, 0xd9, 0x07, 0x28, 0x7b, 0x4b,
-		0xa4, 0x82, 0xca, 0xe3, 0x26, 0x2d, 0x46, 0x0f, 0x83, 0x6c, 0xb8, 0x7b, 0xba, 0xd3, 0xa5, 0x2a,
-		0xd9, 0xed, 0xea, 0x06, 0x9e, 0x7d, 0x90, 0x81, 0xb2, 0xf2, 0x75, 0x51, 0x4c, 0x56, 0x84, 0x7b,
-		0xdb, 0xdc, 0xf1, 0x04, 0xc5, 0xb3, 0x6c, 0x45, 0xd0, 0x32, 0x4e, 0xed, 0x1c, 0xc8, 0x84, 0x13,
-		0x91, 0x86, 0xcf, 0x51, 0xb0, 0x62, 0x77, 0xaf, 0x1b, 0x6e, 0xf7, 0x0c, 0x14, 0x08, 0x64, 0xd0,
-		0xe8, 0xc3, 0xcc, 0x71, 0xeb, 0xee, 0x85, 0x5a, 0x7c, 0x12, 0x8e, 0x11, 0xa0, 0x0e, 0xf6, 0xf4,
-		0x96, 0xee, 0xe9, 0x21, 0xe8, 0x47, 0x29, 0x34, 0x61, 0xfb, 0x1a, 0xaf, 0x8c, 0xf4, 0xd3, 0xe9,
-		0x6d, 0xef, 0xfb, 0x82, 0xf5, 0x18, 0xeb, 0x27, 0x29, 0x13, 0xa2, 0xf5, 0x8e, 0x45, 0x53, 0x4a,
-		0x19, 0xf2, 0x61, 0xb9, 0x47, 0x59, 0x60, 0x92, 0x2f, 0x4b, 0xc4, 0x09, 0x5a, 0xda, 0x58, 0x26,
-		0xee, 0xcb, 0x2b, 0x35, 0x39, 0x41, 0xdc, 0xa8, 0xd5, 0x7a, 0xb3, 0xa6, 0xa9, 0x5b, 0xeb, 0xcd,
-		0xfa, 0x5a, 0x4d, 0x4e, 0x86, 0x1c, 0xfb, 0xeb, 0xa9, 0xcc, 0x43, 0xf2, 0x59, 0xe5, 0x17, 0x93,
-		0x50, 0x8c, 0xc6, 0xd6, 0xe8, 0x5d, 0x70, 0x5c, 0xa4, 0xc8, 0x5c, 0xec, 0x69, 0xb7, 0x4d, 0x87,
-		0x2e, 0xc8, 0x8e, 0xce, 0x8c, 0xa3, 0x2f, 0x3f, 0x33, 0x1c, 0xaa, 0x81, 0xbd, 0xf7, 0x98, 0x0e,
-		0x59, 0x6e, 0x1d, 0xdd, 0x43, 0xab, 0x30, 0x6f, 0xd9, 0x9a, 0xeb, 0xe9, 0x56, 0x4b, 0x77, 0x5a,
-		0x5a, 0x90, 0x9c, 0xd4, 0x74, 0xc3, 0xc0, 0xae, 0x6b, 0x33, 0x43, 0xe8, 0x53, 0xb9, 0xcf, 0xb2,
-		0x1b, 0x1c, 0x38, 0xb0, 0x10, 0x15, 0x0e, 0xda, 0x27, 0xbe, 0xc9, 0x61, 0xe2, 0x7b, 0x12, 0xb2,
-		0x1d, 0xbd, 0xab, 0x61, 0xcb, 0x73, 0xf6, 0xa9, 0x7f, 0x9e, 0x51, 0x33, 0x1d, 0xbd, 0x5b, 0x23,
-		0xdf, 0xe8, 0x06, 0x3c, 0x14, 0x80, 0x6a, 0x6d, 0xbc, 0xab, 0x1b, 0xfb, 0x1a, 0x75, 0xc6, 0x69,
-		0xa2, 0x47, 0x33, 0x6c, 0x6b, 0xa7, 0x6d, 0x1a, 0x9e, 0x4b, 0xf5, 0x03, 0xd3, 0x71, 0x4a, 0x80,
-		0xb1, 0x4a, 0x11, 0xae, 0xbb, 0xb6, 0x45, 0x7d, 0xf0, 0x25, 0x01, 0xfd, 0xce, 0xcd, 0x70, 0x74,
-		0x96, 0x52, 0xf2, 0xc4, 0xf5, 0x54, 0x66, 0x42, 0x4e, 0x5f, 0x4f, 0x65, 0xd2, 0xf2, 0xe4, 0xf5,
-		0x54, 0x26, 0x23, 0x67, 0xaf, 0xa7, 0x32, 0x59, 0x19, 0x94, 0xf7, 0x67, 0x21, 0x1f, 0x8e, 0x0c,
-		0x48, 0xa0, 0x65, 0x50, 0xdb, 0x28, 0x51, 0xed, 0x79, 0xe6, 0xc0, 0x38, 0x62, 0x71, 0x89, 0x18,
-		0xcd, 0x72, 0x9a, 0xb9, 0xe1, 0x2a, 0xc3, 0x24, 0x0e, 0x0b, 0x11, 0x6b, 0xcc, 0xdc, 0x9e, 0x8c,
-		0xca, 0xbf, 0xd0, 0x0a, 0xa4, 0x5f, 0x75, 0x29, 0xed, 0x34, 0xa5, 0xfd, 0xc0, 0xc1, 0xb4, 0xaf,
-		0x37, 0x28, 0xf1, 0xec, 0xf5, 0x86, 0xb6, 0xbe, 0xa1, 0xae, 0x55, 0x56, 0x55, 0x8e, 0x8e, 0x4e,
-		0x40, 0xaa, 0xad, 0xbf, 0xb6, 0x1f, 0x35, 0xaf, 0xb4, 0x08, 0x2d, 0x42, 0xa9, 0x67, 0xb1, 0xa8,
-		0x9b, 0x4c, 0x15, 0x81, 0x2a, 0x85, 0xa1, 0x8a, 0x41, 0xed, 0x2a, 0x81, 0x1f, 0x53, 0x3c, 0x4e,
-		0x40, 0xea, 0x36, 0xd6, 0x6f, 0x46, 0x8d, 0x20, 0x2d, 0x42, 0xe7, 0x20, 0xdf, 0xc2, 0xdb, 0xbd,
-		0x5d, 0xcd, 0xc1, 0x2d, 0xdd, 0xf0, 0xa2, 0xaa, 0x3f, 0x47, 0xab, 0x54, 0x5a, 0x83, 0x5e, 0x84,
-		0x2c, 0x99, 0x23, 0x8b, 0xce, 0xf1, 0x14, 0x65, 0xc1, 0x63, 0x07, 0xb3, 0x80, 0x4f, 0xb1, 0x40,
-		0x52, 0x03, 0x7c, 0x74, 0x1d, 0xd2, 0x9e, 0xee, 0xec, 0x62, 0x8f, 0x6a, 0xfe, 0x62, 0x4c, 0xba,
-		0x2a, 0x86, 0x52, 0x93, 0x62, 0x10, 0xb6, 0x52, 0x19, 0xe5, 0x14, 0xd0, 0x35, 0x98, 0x64, 0x7f,
-		0xb9, 0xb3, 0xd3, 0x0b, 0xc9, 0xc3, 0x13, 0x53, 0x05, 0xfa, 0x3b, 0xa8, 0xb3, 0x2e, 0xc0, 0x04,
-		0x15, 0x36, 0x04, 0xc0, 0xc5, 0x4d, 0x3e, 0x82, 0x32, 0x90, 0x5a, 0xda, 0x50, 0x89, 0xde, 0x92,
-		0x21, 0xcf, 0x4a, 0xb5, 0xcd, 0x7a, 0x6d, 0xa9, 0x26, 0x27, 0x94, 0xcb, 0x90, 0x66, 0x12, 0x44,
-		0x74, 0x9a, 0x2f, 0x43, 0xf2, 0x11, 0xfe, 0xc9, 0x69, 0x48, 0xa2, 0x76, 0x6b, 0xad, 0x5a, 0x53,
-		0xe5, 0x84, 0xb2, 0x05, 0xa5, 0x3e, 0xae, 0xa3, 0xa3, 0x30, 0xa5, 0xd6, 0x9a, 0xb5, 0x75, 0x12,
-		0xb5, 0x69, 0x5b, 0xeb, 0x2f, 0xae, 0x6f, 0xbc, 0x67, 0x5d, 0x3e, 0x12, 0x2d, 0x16, 0x0a, 0x52,
-		0x42, 0x33, 0x20, 0x07, 0xc5, 0x8d, 0x8d, 0x2d, 0x95, 0xf6, 0xe6, 0x3b, 0x13, 0x20, 0xf7, 0xb3,
-		0x0d, 0x1d, 0x87, 0xe9, 0x66, 0x45, 0x5d, 0xa9, 0x35, 0x35, 0x16, 0x89, 0xfa, 0xa4, 0x67, 0x40,
-		0x0e, 0x57, 0x5c, 0xad, 0xd3, 0x40, 0x7b, 0x1e, 0x4e, 0x86, 0x4b, 0x6b, 0x2f, 0x35, 0x6b, 0xeb,
-		0x0d, 0xda, 0x78, 0x65, 0x7d, 0x85, 0x68, 0xeb, 0x3e, 0x7a, 0x22, 0xf6, 0x4d, 0x92, 0xae, 0x46,
-		0xe9, 0xd5, 0x56, 0x97, 0xe5, 0x54, 0x7f, 0xf1, 0xc6, 0x7a, 0x6d, 0xe3, 0xaa, 0x3c, 0xd1, 0xdf,
-		0x3a, 0x8d, 0x87, 0xd3, 0x68, 0x0e, 0x8e, 0xf5, 0x97, 0x6a, 0xb5, 0xf5, 0xa6, 0xfa, 0xb2, 0x3c,
-		0xd9, 0xdf, 0x70, 0xa3, 0xa6, 0xde, 0xa8, 0x2f, 0xd5, 0xe4, 0x0c, 0x3a, 0x06, 0x28, 0xda, 0xa3,
-		0xe6, 0xb5, 0x8d, 0x65, 0x39, 0x3b, 0xa0, 0x9f, 0x14, 0x17, 0xf2, 0xe1, 0xa0, 0xf4, 0x6b, 0xa2,
-		0x1a, 0x95, 0x0f, 0x27, 0x20, 0x17, 0x0a, 0x32, 0x49, 0x74, 0xa0, 0xb7, 0xdb, 0xf6, 0x6d, 0x4d,
-		0x6f, 0x9b, 0xba, 0xcb, 0xb5, 0x17, 0xd0, 0xa2, 0x0a, 0x29, 0x19, 0x57, 0x5b, 0x8c, 0x6f, 0x2f,
-		0xd2, 0x7f, 0x1d, 0xed, 0xc5, 0x84, 0x9c, 0x56, 0x3e, 0x2e, 0x81, 0xdc, 0x1f, 0x3d, 0xf6, 0x0d,
-		0x5f, 0x1a, 0x36, 0xfc, 0xaf, 0xc9, 0xdc, 0x7d, 0x4c, 0x82, 0x62, 0x34, 0x64, 0xec, 0xeb, 0xde,
-		0xe9, 0xbf, 0xd2, 0xee, 0xfd, 0x6e, 0x02, 0x0a, 0x91, 0x40, 0x71, 0xdc, 0xde, 0xbd, 0x17, 0xa6,
-		0xcc, 0x16, 0xee, 0x74, 0x6d, 0x0f, 0x5b, 0xc6, 0xbe, 0xd6, 0xc6, 0xb7, 0x70, 0x7b, 0x56, 0xa1,
-		0x2a, 0xfe, 0xc2, 0xc1, 0xa1, 0xe8, 0x62, 0x3d, 0xc0, 0x5b, 0x25, 0x68, 0xe5, 0xe9, 0xfa, 0x72,
-		0x6d, 0x6d, 0x73, 0xa3, 0x59, 0x5b, 0x5f, 0x7a, 0x59, 0x68, 0x17, 0x55, 0x36, 0xfb, 0xc0, 0xde,
-		0x41, 0xa5, 0xbd, 0x09, 0x72, 0x7f, 0xa7, 0x88, 0xae, 0x88, 0xe9, 0x96, 0x7c, 0x04, 0x4d, 0x43,
-		0x69, 0x7d, 0x43, 0x6b, 0xd4, 0x97, 0x6b, 0x5a, 0xed, 0xea, 0xd5, 0xda, 0x52, 0xb3, 0xc1, 0x92,
-		0x8b, 0x3e, 0x74, 0x53, 0x4e, 0x84, 0x59, 0xfc, 0x91, 0x24, 0x4c, 0xc7, 0xf4, 0x04, 0x55, 0x78,
-		0x5a, 0x80, 0x65, 0x2a, 0x1e, 0x1b, 0xa7, 0xf7, 0x8b, 0xc4, 0x31, 0xdf, 0xd4, 0x1d, 0x8f, 0x67,
-		0x11, 0x1e, 0x06, 0xc2, 0x25, 0xcb, 0x23, 0x7e, 0x82, 0xc3, 0x93, 0xb6, 0x2c, 0x57, 0x50, 0x0a,
-		0xca, 0x59, 0xde, 0xf6, 0x51, 0x40, 0x5d, 0xdb, 0x35, 0x3d, 0xf3, 0x16, 0xd6, 0x4c, 0x4b, 0x64,
-		0x78, 0x53, 0x0b, 0xd2, 0xb9, 0x94, 0x2a, 0x8b, 0x9a, 0xba, 0xe5, 0xf9, 0xd0, 0x16, 0xde, 0xd5,
-		0xfb, 0xa0, 0x89, 0x1f, 0x93, 0x54, 0x65, 0x51, 0xe3, 0x43, 0x9f, 0x86, 0x7c, 0xcb, 0xee, 0x91,
-		0x80, 0x8a, 0xc1, 0x11, 0x6d, 0x21, 0xa9, 0x39, 0x56, 0xe6, 0x83, 0xf0, 0x50, 0x39, 0x48, 0x2d,
-		0xe7, 0xd5, 0x1c, 0x2b, 0x63, 0x20, 0x67, 0xa1, 0xa4, 0xef, 0xee, 0x3a, 0x84, 0xb8, 0x20, 0xc4,
-		0x82, 0xff, 0xa2, 0x5f, 0x4c, 0x01, 0xe7, 0xae, 0x43, 0x46, 0xf0, 0x81, 0xf8, 0xc3, 0x84, 0x13,
-		0x5a, 0x97, 0x65, 0xb4, 0x12, 0xe7, 0xb2, 0x6a, 0xc6, 0x12, 0x95, 0xa7, 0x21, 0x6f, 0xba, 0x5a,
-		0xb0, 0xb7, 0x99, 0x58, 0x48, 0x9c, 0xcb, 0xa8, 0x39, 0xd3, 0xf5, 0xf7, 0x48, 0x94, 0x4f, 0x24,
-		0xa0, 0x18, 0xdd, 0xb5, 0x45, 0xcb, 0x90, 0x69, 0xdb, 0x7c, 0x93, 0x85, 0x1d, 0x19, 0x38, 0x37,
-		0x62, 0xa3, 0x77, 0x71, 0x95, 0xc3, 0xab, 0x3e, 0xe6, 0xdc, 0xaf, 0x4b, 0x90, 0x11, 0xc5, 0xe8,
-		0x18, 0xa4, 0xba, 0xba, 0xb7, 0x47, 0xc9, 0x4d, 0x54, 0x13, 0xb2, 0xa4, 0xd2, 0x6f, 0x52, 0xee,
-		0x76, 0x75, 0xb6, 0x4f, 0xc4, 0xcb, 0xc9, 0x37, 0x99, 0xd7, 0x36, 0xd6, 0x5b, 0x34, 0xb3, 0x60,
-		0x77, 0x3a, 0xd8, 0xf2, 0x5c, 0x31, 0xaf, 0xbc, 0x7c, 0x89, 0x17, 0xa3, 0x47, 0x60, 0xca, 0x73,
-		0x74, 0xb3, 0x1d, 0x81, 0x4d, 0x51, 0x58, 0x59, 0x54, 0xf8, 0xc0, 0x65, 0x38, 0x21, 0xe8, 0xb6,
-		0xb0, 0xa7, 0x1b, 0x7b, 0xb8, 0x15, 0x20, 0xa5, 0x69, 0x06, 0xf1, 0x38, 0x07, 0x58, 0xe6, 0xf5,
-		0x02, 0x57, 0xf9, 0x5c, 0x02, 0xa6, 0x44, 0x2e, 0xa4, 0xe5, 0x33, 0x6b, 0x0d, 0x40, 0xb7, 0x2c,
-		0xdb, 0x0b, 0xb3, 0x6b, 0x50, 0x94, 0x07, 0xf0, 0x16, 0x2b, 0x3e, 0x92, 0x1a, 0x22, 0x30, 0xf7,
-		0x25, 0x09, 0x20, 0xa8, 0x1a, 0xca, 0xb7, 0x79, 0xc8, 0xf1, 0x3d, 0x79, 0x7a, 0xb0, 0x83, 0xa5,
-		0xcf, 0x80, 0x15, 0x5d, 0x35, 0xdb, 0x34, 0xc9, 0xb9, 0x8d, 0x77, 0x4d, 0x8b, 0xef, 0xce, 0xb0,
-		0x0f, 0x91, 0xe4, 0x4c, 0x05, 0xdb, 0x93, 0x2a, 0x64, 0x5c, 0xdc, 0xd1, 0x2d, 0xcf, 0x34, 0xf8,
-		0x7e, 0xcb, 0x95, 0x43, 0x75, 0x7e, 0xb1, 0xc1, 0xb1, 0x55, 0x9f, 0x8e, 0x72, 0x0e, 0x32, 0xa2,
-		0x94, 0x38, 0x7e, 0xeb, 0x1b, 0xeb, 0x35, 0xf9, 0x08, 0x9a, 0x84, 0x64, 0xa3, 0xd6, 0x94, 0x25,
-		0x12, 0xc4, 0x56, 0x56, 0xeb, 0x95, 0x86, 0x9c, 0xa8, 0xfe, 0x5d, 0x98, 0x36, 0xec, 0x4e, 0x7f,
-		0x83, 0x55, 0xb9, 0x2f, 0x81, 0xe8, 0x5e, 0x93, 0x5e, 0x79, 0x8c, 0x03, 0xed, 0xda, 0x6d, 0xdd,
-		0xda, 0x5d, 0xb4, 0x9d, 0xdd, 0xe0, 0x58, 0x0c, 0x89, 0x35, 0xdc, 0xd0, 0xe1, 0x98, 0xee, 0xf6,
-		0x5f, 0x4a, 0xd2, 0x8f, 0x24, 0x92, 0x2b, 0x9b, 0xd5, 0x9f, 0x48, 0xcc, 0xad, 0x30, 0xc4, 0x4d,
-		0x31, 0x1c, 0x15, 0xef, 0xb4, 0xb1, 0x41, 0x3a, 0x0f, 0x1f, 0x4d, 0xc1, 0x94, 0xde, 0x31, 0x2d,
-		0xfb, 0x02, 0xfd, 0x97, 0x1f, 0xaa, 0x99, 0xa0, 0x1f, 0x73, 0x23, 0x4f, 0xdf, 0x94, 0xaf, 0x30,
-		0x05, 0x86, 0x46, 0xed, 0x61, 0xcf, 0xfe, 0xe9, 0x77, 0xfe, 0xf8, 0x44, 0x90, 0xfb, 0x2c, 0xaf,
-		0x81, 0x2c, 0xc2, 0x6e, 0x6c, 0x19, 0x36, 0x91, 0xb6, 0xd1, 0x34, 0xfe, 0x4c, 0xd0, 0x28, 0x71,
-		0xdc, 0x1a, 0x47, 0x2d, 0xbf, 0x0b, 0x32, 0x3e, 0x99, 0x83, 0xb7, 0x93, 0x66, 0xff, 0xa7, 0x20,
-		0xe2, 0x63, 0x94, 0x5f, 0x00, 0x60, 0xce, 0x0e, 0x4b, 0xcb, 0x1e, 0x8c, 0xff, 0x15, 0x81, 0x9f,
-		0xa5, 0x38, 0x44, 0x0b, 0x95, 0x57, 0xa0, 0xd8, 0xb2, 0x2d, 0x4f, 0xb3, 0x3b, 0xa6, 0x87, 0x3b,
-		0x5d, 0x6f, 0x7f, 0x14, 0x91, 0x3f, 0x67, 0x44, 0x32, 0x6a, 0x81, 0xe0, 0x6d, 0x08, 0x34, 0xd2,
-		0x13, 0xb6, 0xb3, 0x36, 0x4e, 0x4f, 0xfe, 0xc2, 0xef, 0x09, 0xc5, 0x21, 0x3d, 0xa9, 0xd6, 0x7e,
-		0xe5, 0xf3, 0xa7, 0xa4, 0xcf, 0x7d, 0xfe, 0x94, 0xf4, 0xbb, 0x9f, 0x3f, 0x25, 0x7d, 0xe8, 0x0b,
-		0xa7, 0x8e, 0x7c, 0xee, 0x0b, 0xa7, 0x8e, 0xfc, 0xd6, 0x17, 0x4e, 0x1d, 0x79, 0xe5, 0x91, 0x5d,
-		0xd3, 0xdb, 0xeb, 0x6d, 0x2f, 0x1a, 0x76, 0xe7, 0x82, 0x61, 0xbb, 0x1d, 0xdb, 0xe5, 0xff, 0x3d,
-		0xe6, 0xb6, 0x6e, 0x72, 0xf9, 0xf1, 0xee, 0x30, 0x29, 0xd8, 0x4e, 0xb3, 0x1d, 0x35, 0xf8, 0xa3,
-		0x47, 0x60, 0x66, 0xd7, 0xde, 0xb5, 0xe9, 0xe7, 0x05, 0xf2, 0x17, 0x17, 0x90, 0xac, 0x5f, 0x3a,
-		0x86, 0x90, 0xac, 0xc3, 0x34, 0x07, 0xd6, 0xe8, 0xe1, 0x0e, 0x96, 0xc8, 0x42, 0x07, 0xee, 0xa2,
-		0xcc, 0xfe, 0xec, 0xef, 0x53, 0x9f, 0x55, 0x9d, 0xe2, 0xa8, 0xa4, 0x8e, 0xe5, 0xba, 0xca, 0x2a,
-		0x1c, 0x8d, 0xd0, 0x63, 0x16, 0x04, 0x3b, 0x23, 0x28, 0xfe, 0x7b, 0x4e, 0x71, 0x3a, 0x44, 0xb1,
-		0xc1, 0x51, 0xcb, 0x4b, 0x50, 0x38, 0x0c, 0xad, 0x5f, 0xe6, 0xb4, 0xf2, 0x38, 0x4c, 0x64, 0x05,
-		0x4a, 0x94, 0x88, 0xd1, 0x73, 0x3d, 0xbb, 0x43, 0xe7, 0xf0, 0x60, 0x32, 0xff, 0xe1, 0xf7, 0x99,
-		0x4a, 0x2f, 0x12, 0xb4, 0x25, 0x1f, 0xab, 0x5c, 0x06, 0x7a, 0x9e, 0xa5, 0x85, 0x8d, 0xf6, 0x08,
-		0x0a, 0xbf, 0xc2, 0x3b, 0xe2, 0xc3, 0x97, 0x6f, 0xc0, 0x0c, 0xf9, 0x9b, 0x5a, 0xcf, 0x70, 0x4f,
-		0x46, 0x6f, 0xb9, 0xcc, 0xfe, 0x97, 0x6f, 0x65, 0x56, 0x63, 0xda, 0x27, 0x10, 0xea, 0x53, 0x68,
-		0x16, 0x77, 0xb1, 0xe7, 0x61, 0xc7, 0xd5, 0xf4, 0x76, 0x5c, 0xf7, 0x42, 0x39, 0xeb, 0xd9, 0x1f,
-		0xfc, 0x72, 0x74, 0x16, 0x57, 0x18, 0x66, 0xa5, 0xdd, 0x2e, 0x6f, 0xc1, 0xf1, 0x18, 0xa9, 0x18,
-		0x83, 0xe6, 0x47, 0x38, 0xcd, 0x99, 0x01, 0xc9, 0x20, 0x64, 0x37, 0x41, 0x94, 0xfb, 0x73, 0x39,
-		0x06, 0xcd, 0x8f, 0x72, 0x9a, 0x88, 0xe3, 0x8a, 0x29, 0x25, 0x14, 0xaf, 0xc3, 0xd4, 0x2d, 0xec,
-		0x6c, 0xdb, 0x2e, 0xdf, 0x27, 0x18, 0x83, 0xdc, 0xc7, 0x38, 0xb9, 0x12, 0x47, 0xa4, 0x1b, 0x07,
-		0x84, 0xd6, 0x33, 0x90, 0xd9, 0xd1, 0x0d, 0x3c, 0x06, 0x89, 0xbb, 0x9c, 0xc4, 0x24, 0x81, 0x27,
-		0xa8, 0x15, 0xc8, 0xef, 0xda, 0xdc, 0x81, 0x1a, 0x8d, 0xfe, 0x71, 0x8e, 0x9e, 0x13, 0x38, 0x9c,
-		0x44, 0xd7, 0xee, 0xf6, 0xda, 0xc4, 0xbb, 0x1a, 0x4d, 0xe2, 0x87, 0x04, 0x09, 0x81, 0xc3, 0x49,
-		0x1c, 0x82, 0xad, 0x6f, 0x08, 0x12, 0x6e, 0x88, 0x9f, 0x2f, 0x40, 0xce, 0xb6, 0xda, 0xfb, 0xb6,
-		0x35, 0x4e, 0x27, 0x7e, 0x98, 0x53, 0x00, 0x8e, 0x42, 0x08, 0x3c, 0x0b, 0xd9, 0x71, 0x27, 0xe2,
-		0xc7, 0xbe, 0x2c, 0x96, 0x87, 0x98, 0x81, 0x15, 0x28, 0x09, 0x05, 0x65, 0xda, 0xd6, 0x18, 0x24,
-		0x7e, 0x9c, 0x93, 0x28, 0x86, 0xd0, 0xf8, 0x30, 0x3c, 0xec, 0x7a, 0xbb, 0x78, 0x1c, 0x22, 0x9f,
-		0x10, 0xc3, 0xe0, 0x28, 0x9c, 0x95, 0xdb, 0xd8, 0x32, 0xf6, 0xc6, 0xa3, 0xf0, 0x49, 0xc1, 0x4a,
-		0x81, 0x43, 0x48, 0x2c, 0x41, 0xa1, 0xa3, 0x3b, 0xee, 0x9e, 0xde, 0x1e, 0x6b, 0x3a, 0x3e, 0xc5,
-		0x69, 0xe4, 0x7d, 0x24, 0xce, 0x91, 0x9e, 0x75, 0x18, 0x32, 0x3f, 0x21, 0x38, 0x12, 0x42, 0xe3,
-		0x4b, 0xcf, 0xf5, 0xe8, 0xa6, 0xca, 0x61, 0xa8, 0xfd, 0xa4, 0x58, 0x7a, 0x0c, 0x77, 0x2d, 0x4c,
-		0xf1, 0x59, 0xc8, 0xba, 0xe6, 0x6b, 0x63, 0x91, 0xf9, 0x27, 0x62, 0xa6, 0x29, 0x02, 0x41, 0x7e,
-		0x19, 0x4e, 0xc4, 0x9a, 0x89, 0x31, 0x88, 0xfd, 0x14, 0x27, 0x76, 0x2c, 0xc6, 0x54, 0x70, 0x95,
-		0x70, 0x58, 0x92, 0x3f, 0x2d, 0x54, 0x02, 0xee, 0xa3, 0xb5, 0x49, 0x42, 0x5a, 0x57, 0xdf, 0x39,
-		0x1c, 0xd7, 0xfe, 0xa9, 0xe0, 0x1a, 0xc3, 0x8d, 0x70, 0xad, 0x09, 0xc7, 0x38, 0xc5, 0xc3, 0xcd,
-		0xeb, 0xcf, 0x08, 0xc5, 0xca, 0xb0, 0xb7, 0xa2, 0xb3, 0xfb, 0xf5, 0x30, 0xe7, 0xb3, 0x53, 0xc4,
-		0x4e, 0xae, 0xd6, 0xd1, 0xbb, 0x63, 0x50, 0xfe, 0x59, 0x4e, 0x59, 0x68, 0x7c, 0x3f, 0xf8, 0x72,
-		0xd7, 0xf4, 0x2e, 0x21, 0xfe, 0x12, 0xcc, 0x0a, 0xe2, 0x3d, 0xcb, 0xc1, 0x86, 0xbd, 0x6b, 0x99,
-		0xaf, 0xe1, 0xd6, 0x18, 0xa4, 0xff, 0x59, 0xdf, 0x54, 0x6d, 0x85, 0xd0, 0x09, 0xe5, 0x3a, 0xc8,
-		0xbe, 0xaf, 0xa2, 0x99, 0x9d, 0xae, 0xed, 0x78, 0x23, 0x28, 0x7e, 0x5a, 0xcc, 0x94, 0x8f, 0x57,
-		0xa7, 0x68, 0xe5, 0x1a, 0xb0, 0x93, 0x46, 0xe3, 0x8a, 0xe4, 0x67, 0x38, 0xa1, 0x42, 0x80, 0xc5,
-		0x15, 0x87, 0x61, 0x77, 0xba, 0xba, 0x33, 0x8e, 0xfe, 0xfb, 0x39, 0xa1, 0x38, 0x38, 0x0a, 0x57,
-		0x1c, 0xc4, 0x5f, 0x23, 0xd6, 0x7e, 0x0c, 0x0a, 0x3f, 0x2f, 0x14, 0x87, 0xc0, 0xe1, 0x24, 0x84,
-		0xc3, 0x30, 0x06, 0x89, 0x7f, 0x2e, 0x48, 0x08, 0x1c, 0x42, 0xe2, 0xdd, 0x81, 0xa1, 0x75, 0xf0,
-		0xae, 0xe9, 0x7a, 0xfc, 0xa8, 0xe0, 0xc1, 0xa4, 0x7e, 0xe1, 0xcb, 0x51, 0x27, 0x4c, 0x0d, 0xa1,
-		0x12, 0x4d, 0xc4, 0x3d, 0x7b, 0x1a, 0xd0, 0x8f, 0xee, 0xd8, 0x67, 0x85, 0x26, 0x0a, 0xa1, 0x91,
-		0xbe, 0x85, 0x3c, 0x44, 0xc2, 0x76, 0x83, 0x84, 0xb1, 0x63, 0x90, 0xfb, 0x17, 0x7d, 0x9d, 0x6b,
-		0x08, 0x5c, 0x42, 0x33, 0xe4, 0xff, 0xf4, 0xac, 0x9b, 0x78, 0x7f, 0x2c, 0xe9, 0xfc, 0xc5, 0x3e,
-		0xff, 0x67, 0x8b, 0x61, 0x32, 0x1d, 0x52, 0xea, 0xf3, 0xa7, 0x46, 0x47, 0x40, 0xdf, 0xf2, 0x15,
-		0x3e, 0xde, 0xa8, 0x3b, 0x55, 0x5e, 0x25, 0x42, 0x1e, 0x75, 0x7a, 0x46, 0x13, 0xfb, 0xd6, 0xaf,
-		0xf8, 0x72, 0x1e, 0xf1, 0x79, 0xca, 0x57, 0xa1, 0x10, 0x71, 0x78, 0x46, 0x93, 0xfa, 0x36, 0x4e,
-		0x2a, 0x1f, 0xf6, 0x77, 0xca, 0x97, 0x21, 0x45, 0x9c, 0x97, 0xd1, 0xe8, 0xef, 0xe7, 0xe8, 0x14,
-		0xbc, 0xfc, 0x1c, 0x64, 0x84, 0xd3, 0x32, 0x1a, 0xf5, 0x03, 0x1c, 0xd5, 0x47, 0x21, 0xe8, 0xc2,
-		0x61, 0x19, 0x8d, 0xfe, 0xf7, 0x05, 0xba, 0x40, 0x21, 0xe8, 0xe3, 0xb3, 0xf0, 0xdf, 0x7c, 0x47,
-		0x8a, 0x1b, 0x1d, 0xc1, 0xbb, 0x67, 0x61, 0x92, 0x7b, 0x2a, 0xa3, 0xb1, 0xbf, 0x9d, 0x37, 0x2e,
-		0x30, 0xca, 0x4f, 0xc1, 0xc4, 0x98, 0x0c, 0xff, 0x2e, 0x8e, 0xca, 0xe0, 0xcb, 0x4b, 0x90, 0x0b,
-		0x79, 0x27, 0xa3, 0xd1, 0xbf, 0x9b, 0xa3, 0x87, 0xb1, 0x48, 0xd7, 0xb9, 0x77, 0x32, 0x9a, 0xc0,
-		0x3f, 0x10, 0x5d, 0xe7, 0x18, 0x84, 0x6d, 0xc2, 0x31, 0x19, 0x8d, 0xfd, 0x21, 0xc1, 0x75, 0x81,
-		0x52, 0x7e, 0x01, 0xb2, 0xbe, 0xb1, 0x19, 0x8d, 0xff, 0x3d, 0x1c, 0x3f, 0xc0, 0x21, 0x1c, 0x08,
-		0x19, 0xbb, 0xd1, 0x24, 0xfe, 0xa1, 0xe0, 0x40, 0x08, 0x8b, 0x2c, 0xa3, 0x7e, 0x07, 0x66, 0x34,
-		0xa5, 0xef, 0x15, 0xcb, 0xa8, 0xcf, 0x7f, 0x21, 0xb3, 0x49, 0x75, 0xfe, 0x68, 0x12, 0xdf, 0x27,
-		0x66, 0x93, 0xc2, 0x93, 0x6e, 0xf4, 0x7b, 0x04, 0xa3, 0x69, 0xfc, 0x80, 0xe8, 0x46, 0x9f, 0x43,
-		0x50, 0xde, 0x04, 0x34, 0xe8, 0x0d, 0x8c, 0xa6, 0xf7, 0x61, 0x4e, 0x6f, 0x6a, 0xc0, 0x19, 0x28,
-		0xbf, 0x07, 0x8e, 0xc5, 0x7b, 0x02, 0xa3, 0xa9, 0xfe, 0xe0, 0x57, 0xfa, 0x62, 0xb7, 0xb0, 0x23,
-		0x50, 0x6e, 0x06, 0x26, 0x25, 0xec, 0x05, 0x8c, 0x26, 0xfb, 0x91, 0xaf, 0x44, 0x15, 0x77, 0xd8,
-		0x09, 0x28, 0x57, 0x00, 0x02, 0x03, 0x3c, 0x9a, 0xd6, 0xc7, 0x38, 0xad, 0x10, 0x12, 0x59, 0x1a,
-		0xdc, 0xfe, 0x8e, 0xc6, 0xbf, 0x2b, 0x96, 0x06, 0xc7, 0x20, 0x4b, 0x43, 0x98, 0xde, 0xd1, 0xd8,
-		0x1f, 0x17, 0x4b, 0x43, 0xa0, 0x10, 0xc9, 0x0e, 0x59, 0xb7, 0xd1, 0x14, 0x7e, 0x58, 0x48, 0x76,
-		0x08, 0xab, 0xbc, 0x0e, 0x53, 0x03, 0x06, 0x71, 0x34, 0xa9, 0x1f, 0xe1, 0xa4, 0xe4, 0x7e, 0x7b,
-		0x18, 0x36, 0x5e, 0xdc, 0x18, 0x8e, 0xa6, 0xf6, 0xa3, 0x7d, 0xc6, 0x8b, 0xdb, 0xc2, 0xf2, 0xb3,
-		0x90, 0xb1, 0x7a, 0xed, 0x36, 0x59, 0x3c, 0xa3, 0x52, 0x5e, 0xff, 0xfd, 0xab, 0x9c, 0x3b, 0x02,
-		0xa1, 0x7c, 0x19, 0x26, 0x70, 0x67, 0x1b, 0xb7, 0x46, 0x61, 0xfe, 0xe1, 0x57, 0x85, 0xc2, 0x24,
-		0xd0, 0xe5, 0x17, 0x00, 0x58, 0x6a, 0x84, 0x1e, 0xd2, 0x18, 0x81, 0xfb, 0xa5, 0xaf, 0xf2, 0xc3,
-		0x97, 0x01, 0x4a, 0x40, 0x60, 0x9c, 0x4c, 0xdd, 0x97, 0xa3, 0x04, 0xe8, 0x8c, 0x3c, 0x03, 0x93,
-		0xaf, 0xba, 0xb6, 0xe5, 0xe9, 0x23, 0x33, 0x96, 0x7f, 0xc4, 0xb1, 0x05, 0x3c, 0x61, 0x58, 0xc7,
-		0x76, 0xb0, 0xa7, 0xef, 0xba, 0xa3, 0x70, 0xff, 0x98, 0xe3, 0xfa, 0x08, 0x04, 0xd9, 0xd0, 0x5d,
-		0x6f, 0x9c, 0x71, 0xff, 0x0f, 0x81, 0x2c, 0x10, 0x48, 0xa7, 0xc9, 0xdf, 0x37, 0xf1, 0xc8, 0x0c,
-		0xe7, 0x9f, 0x88, 0x4e, 0x73, 0xf8, 0xf2, 0x73, 0x90, 0x25, 0x7f, 0xb2, 0x13, 0xd5, 0x23, 0x90,
-		0xff, 0x94, 0x23, 0x07, 0x18, 0xa4, 0x65, 0xd7, 0x6b, 0x79, 0xe6, 0x68, 0x66, 0xff, 0x19, 0x9f,
-		0x69, 0x01, 0x5f, 0xae, 0x40, 0xce, 0xf5, 0x5a, 0xad, 0x1e, 0xf7, 0x4f, 0x47, 0xe5, 0x87, 0xbf,
-		0xea, 0xa7, 0x2c, 0x7c, 0x1c, 0x32, 0xdb, 0xb7, 0x6f, 0x7a, 0x5d, 0x9b, 0xee, 0xc6, 0x8d, 0xcc,
-		0x10, 0x73, 0x0a, 0x21, 0x94, 0xf2, 0x12, 0xe4, 0xc9, 0x58, 0xc4, 0x4d, 0x95, 0x91, 0xf9, 0x61,
-		0xce, 0x80, 0x08, 0x52, 0xf5, 0x9b, 0x87, 0x25, 0x77, 0xe3, 0xf7, 0x10, 0x60, 0xc5, 0x5e, 0xb1,
-		0xd9, 0xee, 0xc1, 0x2b, 0x0f, 0x0e, 0x66, 0x7f, 0xa3, 0x79, 0x5d, 0xfa, 0x17, 0xfc, 0x2f, 0x09,
-		0xee, 0x37, 0xec, 0x0e, 0xf6, 0xb6, 0x77, 0xbc, 0x0b, 0x86, 0xb3, 0xdf, 0xf5, 0xec, 0x0b, 0xb7,
-		0x2e, 0x5e, 0xb8, 0x89, 0xf7, 0x5d, 0x9e, 0xf8, 0x45, 0xa2, 0x7a, 0x91, 0x55, 0x2f, 0xde, 0xba,
-		0x38, 0x17, 0x9b, 0x22, 0x56, 0x5e, 0x82, 0xec, 0x26, 0xbd, 0xb9, 0xfa, 0x22, 0xde, 0x47, 0x73,
-		0x30, 0x89, 0x5b, 0x97, 0x2e, 0x5f, 0xbe, 0xf8, 0x0c, 0xdd, 0x8b, 0xcf, 0x5f, 0x3b, 0xa2, 0x8a,
-		0x02, 0x74, 0x0a, 0xb2, 0x2e, 0x36, 0xba, 0x97, 0x2e, 0x5f, 0xb9, 0x79, 0x91, 0xee, 0xe3, 0x90,
-		0xda, 0xa0, 0xa8, 0x9c, 0xf9, 0xe2, 0x1b, 0xf3, 0xd2, 0x17, 0x7f, 0x78, 0x5e, 0xaa, 0x4e, 0x40,
-		0xd2, 0xed, 0x75, 0xaa, 0x6b, 0x43, 0x93, 0xdc, 0x4f, 0x44, 0x86, 0x29, 0xc6, 0x21, 0xfe, 0xd0,
-		0xbb, 0xe6, 0x85, 0xc1, 0xd1, 0xf9, 0xc9, 0xee, 0x4f, 0xa5, 0xe0, 0x54, 0xcc, 0xe0, 0xbb, 0x8e,
-		0x6d, 0xef, 0x1c, 0x7a, 0xf4, 0x3b, 0x30, 0xb1, 0x49, 0x10, 0xd1, 0x0c, 0x4c, 0x78, 0xb6, 0xa7,
-		0xb7, 0xe9, 0xb8, 0x93, 0x2a, 0xfb, 0x20, 0xa5, 0xec, 0xf2, 0x4c, 0x82, 0x95, 0x9a, 0xe2, 0xde,
-		0x4c, 0x1b, 0xeb, 0x3b, 0xec, 0x0c, 0x72, 0x92, 0x6e, 0x8f, 0x66, 0x48, 0x01, 0x3d, 0x6e, 0x3c,
-		0x03, 0x13, 0x7a, 0x8f, 0xed, 0xec, 0x25, 0xcf, 0xe5, 0x55, 0xf6, 0xa1, 0xac, 0xc2, 0x24, 0x4f,
-		0xe1, 0x22, 0x19, 0x92, 0x37, 0xf1, 0x3e, 0xe3, 0xaf, 0x4a, 0xfe, 0x44, 0x17, 0x60, 0x82, 0xf6,
-		0x9e, 0x5f, 0xae, 0x38, 0xb1, 0x38, 0xd8, 0xfd, 0x45, 0xda, 0x4b, 0x95, 0xc1, 0x29, 0xd7, 0x21,
-		0xb3, 0x6c, 0x77, 0x4c, 0xcb, 0x8e, 0x92, 0xcb, 0x32, 0x72, 0xb4, 0xd3, 0xdd, 0x9e, 0xc7, 0x37,
-		0xdb, 0xd8, 0x07, 0x3a, 0x06, 0x69, 0x76, 0x28, 0x9d, 0x6f, 0x4f, 0xf2, 0x2f, 0x65, 0x09, 0x26,
-		0x29, 0xed, 0x8d, 0xae, 0x7f, 0x11, 0x4c, 0x0a, 0x5d, 0x04, 0xe3, 0xe4, 0x13, 0x41, 0x6f, 0x11,
-		0xa4, 0x5a, 0xba, 0xa7, 0xf3, 0x81, 0xd3, 0xbf, 0x95, 0x17, 0x20, 0xc3, 0x89, 0xb8, 0xe8, 0x09,
-		0x48, 0xda, 0x5d, 0x97, 0x6f, 0x30, 0x9e, 0x1c, 0x3a, 0x96, 0x8d, 0x6e, 0x35, 0xf5, 0x2b, 0x6f,
-		0xce, 0x1f, 0x51, 0x09, 0xf4, 0x3b, 0x25, 0x2b, 0xdf, 0x9b, 0x80, 0x53, 0x03, 0xfb, 0x1e, 0x5c,
-		0x5b, 0x0c, 0xbb, 0x98, 0x5e, 0x86, 0xcc, 0xb2, 0x50, 0x42, 0xb3, 0x30, 0xe9, 0x62, 0xc3, 0xb6,
-		0x5a, 0x2e, 0x97, 0x0b, 0xf1, 0x49, 0x98, 0x6c, 0xe9, 0x96, 0xed, 0xf2, 0x1b, 0x14, 0xec, 0xa3,
-		0xfa, 0x51, 0xe9, 0x70, 0x6b, 0xbf, 0x20, 0x5a, 0xa2, 0xeb, 0x7f, 0x53, 0x7a, 0xe5, 0xe2, 0xc8,
-		0xcd, 0xc3, 0x9b, 0x96, 0x7d, 0xdb, 0xf2, 0x07, 0x11, 0xd9, 0x40, 0x3c, 0xd5, 0xbf, 0x81, 0xf8,
-		0x1e, 0xdc, 0x6e, 0xbf, 0x48, 0xe0, 0x9b, 0x04, 0xd5, 0xe7, 0xca, 0xc7, 0x53, 0x83, 0x5c, 0xb9,
-		0xed, 0xe8, 0xdd, 0x2e, 0x76, 0xdc, 0x61, 0x5c, 0x39, 0x03, 0xb9, 0xe5, 0xd0, 0x21, 0x81, 0x19,
-		0x71, 0x59, 0x47, 0xa2, 0x07, 0x08, 0xd8, 0x87, 0xa2, 0x00, 0x5c, 0x6d, 0xdb, 0xba, 0x17, 0x03,
-		0x93, 0x08, 0xc1, 0xd4, 0x2d, 0xef, 0xca, 0x93, 0x31, 0x30, 0x49, 0x01, 0x73, 0x06, 0x72, 0x5b,
-		0xc3, 0x80, 0x52, 0x51, 0x42, 0x4f, 0x5c, 0x8a, 0x81, 0x99, 0xe8, 0x23, 0x14, 0x0b, 0x54, 0x10,
-		0x40, 0xa7, 0x21, 0x5b, 0xb5, 0xed, 0x76, 0x0c, 0x48, 0x26, 0x44, 0xa7, 0x11, 0x3a, 0xff, 0x10,
-		0x01, 0xca, 0x86, 0x3a, 0x54, 0xdd, 0xf7, 0xb0, 0x1b, 0x03, 0x93, 0xe7, 0x30, 0x87, 0x17, 0x90,
-		0xf7, 0xf0, 0x79, 0x39, 0xac, 0x80, 0x88, 0xf9, 0xbc, 0x27, 0x01, 0xf9, 0x81, 0x7c, 0x48, 0xc5,
-		0x32, 0xaa, 0x44, 0xc3, 0xea, 0x8e, 0xde, 0x11, 0x02, 0x32, 0xe5, 0xaf, 0x6b, 0x5a, 0x3f, 0x54,
-		0xc3, 0xce, 0x8d, 0x58, 0x7f, 0x73, 0x23, 0x24, 0x51, 0xf9, 0x6c, 0x12, 0x4a, 0x4b, 0xb6, 0xe5,
-		0x62, 0xcb, 0xed, 0xb9, 0x9b, 0xb4, 0x0b, 0xe8, 0x49, 0x98, 0xd8, 0x6e, 0xdb, 0xc6, 0x4d, 0xca,
-		0xdb, 0xdc, 0xa5, 0x53, 0x8b, 0x03, 0x9d, 0x59, 0xac, 0x92, 0x7a, 0x06, 0xae, 0x32, 0x60, 0xf4,
-		0x1c, 0x64, 0xf0, 0x2d, 0xb3, 0x85, 0x2d, 0x03, 0x73, 0x4d, 0x7b, 0x3a, 0x06, 0xb1, 0xc6, 0x41,
-		0x38, 0xae, 0x8f, 0x82, 0xbe, 0x0e, 0xb2, 0xb7, 0xf4, 0xb6, 0xd9, 0xd2, 0x3d, 0xdb, 0xe1, 0x57,
-		0x8e, 0x94, 0x18, 0xfc, 0x1b, 0x02, 0x86, 0x13, 0x08, 0x90, 0x50, 0x19, 0x26, 0x6f, 0x61, 0x87,
-		0x9e, 0x6f, 0x61, 0x37, 0x81, 0x16, 0xe2, 0xf0, 0x19, 0x04, 0xc7, 0x16, 0x08, 0xe8, 0x32, 0xa4,
-		0xf4, 0x6d, 0xc3, 0xa4, 0x47, 0x1f, 0x72, 0x97, 0xee, 0x8f, 0x41, 0xac, 0x54, 0x97, 0xea, 0x0c,
-		0x8b, 0x9e, 0xfe, 0xa3, 0xe0, 0xa4, 0xd3, 0xee, 0xbe, 0x65, 0xec, 0x39, 0xb6, 0xb5, 0x4f, 0x0f,
-		0xfb, 0xc4, 0x77, 0xba, 0x21, 0x60, 0x44, 0xa7, 0x7d, 0x24, 0xd2, 0xe9, 0x1d, 0xac, 0x7b, 0x3d,
-		0x07, 0xf3, 0x7b, 0xf0, 0x71, 0x9d, 0xbe, 0xca, 0x20, 0x44, 0xa7, 0x39, 0x82, 0x52, 0x87, 0x5c,
-		0x68, 0x1e, 0xd8, 0x89, 0xf8, 0x3b, 0xda, 0x36, 0x59, 0x24, 0x7c, 0xc1, 0x67, 0x3a, 0xfa, 0x1d,
-		0xba, 0x68, 0xd0, 0x71, 0x98, 0x24, 0x95, 0xbb, 0xfc, 0x94, 0x64, 0x52, 0x4d, 0x77, 0xf4, 0x3b,
-		0x2b, 0xba, 0x7b, 0x3d, 0x95, 0x49, 0xca, 0x29, 0xe5, 0x53, 0x12, 0x14, 0xa3, 0x53, 0x83, 0x1e,
-		0x01, 0x44, 0x30, 0xf4, 0x5d, 0xac, 0x59, 0xbd, 0x8e, 0x46, 0x27, 0x59, 0xd0, 0x2d, 0x75, 0xf4,
-		0x3b, 0x95, 0x5d, 0xbc, 0xde, 0xeb, 0xd0, 0x0e, 0xb8, 0x68, 0x0d, 0x64, 0x01, 0x2c, 0x04, 0xd0,
-		0x37, 0xb7, 0x03, 0xb7, 0xf2, 0x39, 0x40, 0x35, 0x43, 0x0c, 0xd4, 0x87, 0xff, 0xdb, 0xbc, 0xa4,
-		0x16, 0x19, 0x3d, 0xdf, 0x30, 0x44, 0x86, 0x92, 0x8c, 0x0e, 0x45, 0x79, 0x01, 0x4a, 0x7d, 0x52,
-		0x80, 0x14, 0x28, 0x74, 0x7b, 0xdb, 0xda, 0x4d, 0xbc, 0x4f, 0xaf, 0x89, 0x31, 0xf3, 0x98, 0x55,
-		0x73, 0xdd, 0xde, 0xf6, 0x8b, 0x78, 0x9f, 0xae, 0xbe, 0x72, 0xe6, 0x17, 0x88, 0x03, 0xf5, 0xc6,
-		0xbc, 0xa4, 0x3c, 0x02, 0x85, 0x88, 0x18, 0x10, 0x2b, 0xac, 0x77, 0xbb, 0x5c, 0xff, 0x91, 0x3f,
-		0x43, 0xc0, 0xaf, 0x40, 0x9e, 0x38, 0x1e, 0xb8, 0xc5, 0x61, 0x1f, 0x82, 0x12, 0x65, 0x85, 0xd6,
-		0xcf, 0xeb, 0x02, 0x2d, 0x5e, 0x13, 0x0c, 0x57, 0xa0, 0x10, 0xc0, 0x05, 0x6c, 0xcf, 0x09, 0xa8,
-		0x15, 0xdd, 0x55, 0xbe, 0x5f, 0x82, 0x52, 0x9f, 0x6c, 0xa0, 0xe7, 0x20, 0xdb, 0x75, 0xb0, 0x61,
-		0xba, 0xec, 0x18, 0xd1, 0x08, 0x16, 0xa6, 0x28, 0xfb, 0x02, 0x0c, 0xb4, 0x0c, 0x05, 0x71, 0xa4,
-		0xa4, 0x85, 0xdb, 0xfa, 0xfe, 0xe8, 0x59, 0x60, 0x24, 0xc4, 0x13, 0x29, 0xcb, 0x04, 0x49, 0xf9,
-		0x65, 0x09, 0x0a, 0x11, 0xa1, 0x43, 0x2d, 0xb8, 0xff, 0x96, 0xed, 0xe1, 0x70, 0xaa, 0x83, 0x5f,
-		0x1d, 0xda, 0xc3, 0xe6, 0xee, 0x9e, 0xc7, 0xbb, 0x7a, 0x72, 0xa0, 0x9d, 0xc0, 0xd0, 0x50, 0x87,
-		0x44, 0x52, 0xe7, 0x08, 0x9d, 0x20, 0xe3, 0xc1, 0xee, 0x18, 0x5d, 0xa3, 0x44, 0xd0, 0x06, 0xa0,
-		0xee, 0xb6, 0xd7, 0x4f, 0x3a, 0x31, 0x2e, 0x69, 0x99, 0x20, 0x87, 0x09, 0x2a, 0x0d, 0x80, 0x60,
-		0xe1, 0xa2, 0xca, 0x38, 0x83, 0x48, 0x1e, 0xd4, 0xc3, 0x72, 0x62, 0x56, 0xaa, 0x6e, 0x7e, 0xf2,
-		0xf3, 0xa7, 0x86, 0x1a, 0x9a, 0x57, 0x2e, 0x8d, 0xef, 0x51, 0x09, 0xdd, 0xef, 0x5b, 0x86, 0xbf,
-		0x48, 0xc3, 0xe9, 0x41, 0xcb, 0xe0, 0xab, 0xb8, 0xc3, 0x1a, 0x87, 0x83, 0xa3, 0x18, 0xe5, 0x33,
-		0x12, 0xe4, 0xfd, 0x95, 0xd4, 0xc0, 0x1e, 0x7a, 0x17, 0x80, 0xdf, 0x96, 0x70, 0x31, 0xef, 0x3b,
-		0x48, 0x09, 0xab, 0x21, 0x78, 0xf4, 0x34, 0x64, 0xba, 0x8e, 0xdd, 0xb5, 0x5d, 0x7e, 0xf1, 0x75,
-		0x14, 0xae, 0x0f, 0x8d, 0x1e, 0x05, 0x44, 0x03, 0x02, 0xed, 0x96, 0xed, 0x99, 0xd6, 0xae, 0xd6,
-		0xb5, 0x6f, 0xf3, 0xf7, 0x04, 0x92, 0xaa, 0x4c, 0x6b, 0x6e, 0xd0, 0x8a, 0x4d, 0x52, 0xae, 0x7c,
-		0x5a, 0x82, 0xac, 0x4f, 0x85, 0xf8, 0x90, 0x7a, 0xab, 0xe5, 0x60, 0xd7, 0xe5, 0xae, 0x80, 0xf8,
-		0x44, 0xef, 0x82, 0x49, 0xae, 0x14, 0xfc, 0x6b, 0xd5, 0x71, 0xde, 0xb2, 0x88, 0xce, 0xb8, 0xbf,
-		0x9c, 0x66, 0x3a, 0x03, 0x9d, 0x86, 0x7c, 0x4c, 0x6f, 0x72, 0xb7, 0x82, 0x8e, 0xd0, 0xe7, 0x8a,
-		0xf8, 0x10, 0xb4, 0xae, 0x63, 0xda, 0x8e, 0xe9, 0xed, 0x53, 0xd3, 0x93, 0x54, 0x65, 0x51, 0xb1,
-		0xc9, 0xcb, 0x95, 0x36, 0x94, 0x1a, 0x66, 0xa7, 0x4b, 0x3d, 0x3c, 0xde, 0xf5, 0x2b, 0x41, 0x07,
-		0xa5, 0x31, 0x3a, 0x38, 0xb4, 0x6b, 0x89, 0x81, 0xae, 0x9d, 0xff, 0x4d, 0x89, 0xdb, 0x86, 0xfa,
-		0xf2, 0xd5, 0xb6, 0xbe, 0x8b, 0x2e, 0xc2, 0xd1, 0xea, 0xea, 0xc6, 0xd2, 0x8b, 0x5a, 0x7d, 0x59,
-		0xbb, 0xba, 0x5a, 0x59, 0x09, 0x4e, 0xf1, 0xce, 0x1d, 0x7b, 0xfd, 0xee, 0x02, 0x0a, 0xc1, 0x6e,
-		0x59, 0xd4, 0xc5, 0x41, 0x17, 0x60, 0x26, 0x8a, 0x52, 0xa9, 0x36, 0x6a, 0xeb, 0x4d, 0x59, 0x9a,
-		0x3b, 0xfa, 0xfa, 0xdd, 0x85, 0xa9, 0x10, 0x46, 0x65, 0xdb, 0xc5, 0x96, 0x37, 0x88, 0xb0, 0xb4,
-		0xb1, 0xb6, 0x56, 0x6f, 0xca, 0x89, 0x01, 0x84, 0x25, 0xbb, 0xd3, 0x31, 0x3d, 0xf4, 0x30, 0x4c,
-		0x45, 0x11, 0xd6, 0xeb, 0xab, 0x72, 0x72, 0x0e, 0xbd, 0x7e, 0x77, 0xa1, 0x18, 0x82, 0x5e, 0x37,
-		0xdb, 0x73, 0x99, 0x0f, 0xfe, 0xe8, 0xa9, 0x23, 0x9f, 0xfc, 0xc7, 0xa7, 0xa4, 0xea, 0xea, 0x3b,
-		0xb2, 0xf0, 0x7e, 0x20, 0x01, 0xf3, 0xfd, 0x9e, 0x92, 0x67, 0x76, 0xb0, 0xeb, 0xe9, 0x9d, 0xee,
-		0x30, 0xa7, 0xfd, 0x59, 0xc8, 0x36, 0x05, 0xcc, 0xa1, 0x63, 0x99, 0xbb, 0x87, 0x74, 0x55, 0x8b,
-		0x7e, 0x53, 0xc2, 0x57, 0xbd, 0x34, 0xa6, 0xaf, 0xea, 0x8f, 0xe3, 0x9e, 0x9c, 0xd5, 0xdf, 0x69,
-		0xc0, 0x7d, 0x01, 0x13, 0xb7, 0x0d, 0x93, 0x28, 0x11, 0xb6, 0x9a, 0x19, 0x5b, 0x64, 0x5f, 0x66,
-		0x49, 0x2d, 0x51, 0x46, 0x07, 0xab, 0x9d, 0xb9, 0x11, 0xe9, 0x85, 0xb9, 0x11, 0xbe, 0xf1, 0xdc,
-		0x68, 0x0d, 0x39, 0x44, 0x1d, 0x8e, 0x9a, 0x61, 0xe5, 0x3f, 0x67, 0x61, 0x52, 0xc5, 0xef, 0xed,
-		0x61, 0xd7, 0x43, 0x4f, 0x40, 0x0a, 0x1b, 0x7b, 0xf6, 0xe0, 0xca, 0xe4, 0xa3, 0x5c, 0xac, 0x19,
-		0x7b, 0x36, 0x07, 0xbe, 0x76, 0x44, 0xa5, 0xc0, 0xe8, 0x0a, 0x4c, 0xec, 0xb4, 0x7b, 0xee, 0x1e,
-		0x57, 0x38, 0xa7, 0x06, 0xb1, 0xae, 0x92, 0xea, 0x00, 0x8d, 0x81, 0x93, 0xc6, 0xe8, 0x73, 0x5a,
-		0xc9, 0x61, 0x8d, 0xd1, 0x57, 0xb4, 0x82, 0xc6, 0x08, 0x30, 0x5a, 0x02, 0x30, 0x2d, 0xd3, 0xd3,
-		0x8c, 0x3d, 0xdd, 0xb4, 0xb8, 0xe7, 0xaa, 0xc4, 0xa1, 0x9a, 0xde, 0x12, 0x01, 0x09, 0xf0, 0xb3,
-		0xa6, 0x28, 0x23, 0x3d, 0x7e, 0x6f, 0x0f, 0x3b, 0xc2, 0x7b, 0x8d, 0xe9, 0xf1, 0xbb, 0x49, 0x75,
-		0xa8, 0xc7, 0x14, 0x9c, 0x78, 0xfb, 0xec, 0xaa, 0xb7, 0x77, 0x87, 0x3f, 0x60, 0xb2, 0x30, 0x88,
-		0x4a, 0x6f, 0x7a, 0x37, 0xef, 0x04, 0xc8, 0x93, 0x06, 0x2b, 0x41, 0xcf, 0x40, 0xda, 0xa0, 0x4a,
-		0x80, 0x5e, 0xc0, 0xcc, 0x5d, 0x9a, 0x8f, 0x41, 0xa6, 0xf5, 0x01, 0x2e, 0x47, 0x40, 0x1b, 0x50,
-		0x6c, 0x9b, 0xae, 0xa7, 0xb9, 0x96, 0xde, 0x75, 0xf7, 0x6c, 0xcf, 0xa5, 0x6f, 0x88, 0xe5, 0x2e,
-		0x3d, 0x34, 0x48, 0x62, 0xd5, 0x74, 0xbd, 0x86, 0x00, 0x0b, 0x28, 0x15, 0xda, 0xe1, 0x72, 0x42,
-		0xd0, 0xde, 0xd9, 0xc1, 0x8e, 0x4f, 0x91, 0xbe, 0x3d, 0x16, 0x4b, 0x70, 0x83, 0xc0, 0x09, 0xcc,
-		0x10, 0x41, 0x3b, 0x5c, 0x8e, 0xbe, 0x01, 0xa6, 0xdb, 0xb6, 0xde, 0xf2, 0xe9, 0x69, 0xc6, 0x5e,
-		0xcf, 0xba, 0x39, 0x5b, 0xa4, 0x54, 0xcf, 0xc7, 0x74, 0xd3, 0xd6, 0x5b, 0x02, 0x79, 0x89, 0x80,
-		0x06, 0x94, 0xa7, 0xda, 0xfd, 0x75, 0x48, 0x83, 0x19, 0xbd, 0xdb, 0x6d, 0xef, 0xf7, 0x93, 0x2f,
-		0x51, 0xf2, 0x8f, 0x0c, 0x92, 0xaf, 0x10, 0xe8, 0x21, 0xf4, 0x91, 0x3e, 0x50, 0x89, 0xb6, 0x40,
-		0xee, 0x3a, 0x98, 0x9e, 0xcc, 0x60, 0x56, 0x4c, 0x6f, 0xd3, 0x3b, 0x92, 0xb9, 0x4b, 0xe7, 0x06,
-		0x89, 0x6f, 0x32, 0xc8, 0x4d, 0x0e, 0x18, 0x50, 0x2e, 0x75, 0xa3, 0x35, 0x8c, 0xac, 0x6d, 0x60,
-		0x7a, 0x87, 0x9b, 0x93, 0x9d, 0x1a, 0x4e, 0x96, 0x42, 0xc6, 0x92, 0x8d, 0xd4, 0xa0, 0xab, 0x90,
-		0x63, 0xef, 0xf6, 0x10, 0xe7, 0x01, 0xd3, 0xbb, 0x95, 0xb9, 0x4b, 0x67, 0x62, 0x96, 0x2b, 0x05,
-		0xba, 0x61, 0x7b, 0x38, 0x20, 0x06, 0xd8, 0x2f, 0x44, 0xdb, 0x70, 0x94, 0xde, 0x33, 0xdd, 0xd7,
-		0xa2, 0x2e, 0xe2, 0xec, 0x34, 0xa5, 0xf8, 0xe8, 0x20, 0x45, 0xfa, 0xc8, 0xd2, 0xfe, 0x8d, 0xb0,
-		0xaf, 0x18, 0x90, 0x9e, 0xbe, 0x35, 0x58, 0x4b, 0x24, 0x6d, 0xc7, 0xb4, 0xf4, 0xb6, 0xf9, 0x1a,
-		0x66, 0xf1, 0x14, 0x7d, 0x62, 0x21, 0x56, 0xd2, 0xae, 0x72, 0x38, 0x6a, 0x07, 0x43, 0x92, 0xb6,
-		0x13, 0x2e, 0xaf, 0x4e, 0xf2, 0x2c, 0x88, 0x7f, 0x67, 0x78, 0x52, 0xce, 0xb0, 0x7b, 0xc2, 0xd7,
-		0x53, 0x19, 0x90, 0x73, 0xca, 0x59, 0xc8, 0x85, 0xf4, 0x14, 0x31, 0x52, 0xdc, 0xcf, 0xe7, 0xb9,
-		0x15, 0xf1, 0xa9, 0x14, 0x21, 0x1f, 0x56, 0x4d, 0xca, 0x87, 0x24, 0xc8, 0x85, 0x94, 0x0e, 0xc1,
-		0x14, 0xc1, 0x35, 0xc7, 0x14, 0xa1, 0xf3, 0x19, 0x11, 0xe8, 0x88, 0xfa, 0x04, 0x0d, 0xa3, 0xf2,
-		0xb4, 0x90, 0xc7, 0x59, 0x68, 0x1e, 0x72, 0xdd, 0x4b, 0x5d, 0x1f, 0x24, 0x49, 0x41, 0xa0, 0x7b,
-		0xa9, 0x2b, 0x00, 0x4e, 0x43, 0x9e, 0x0c, 0x5d, 0x0b, 0x47, 0xf0, 0x59, 0x35, 0x47, 0xca, 0x38,
-		0x88, 0xf2, 0xeb, 0x09, 0x90, 0xfb, 0x95, 0x19, 0x7a, 0x1a, 0x52, 0x44, 0x8b, 0x73, 0x35, 0x3d,
-		0x37, 0x10, 0x23, 0xf8, 0x56, 0x93, 0x45, 0x9b, 0x1f, 0x22, 0xb1, 0x0e, 0xc5, 0x40, 0x27, 0x88,
-		0x06, 0xd3, 0x4d, 0x4b, 0x33, 0x5b, 0xe2, 0x9d, 0x4a, 0xfa, 0x5d, 0x6f, 0x91, 0x68, 0xd6, 0x10,
-		0x39, 0x11, 0x8d, 0xd9, 0x9e, 0x03, 0x52, 0x12, 0x7d, 0xe9, 0x13, 0xb5, 0x64, 0xf4, 0xe5, 0x53,
-		0x56, 0x22, 0x6e, 0x35, 0x7b, 0xfd, 0xe6, 0x74, 0x8c, 0x3c, 0x09, 0x98, 0xad, 0x6e, 0x4b, 0xf7,
-		0x30, 0xf7, 0x47, 0xc3, 0x1e, 0xf6, 0x43, 0x50, 0xd2, 0xbb, 0x5d, 0xcd, 0xf5, 0x74, 0x0f, 0xf3,
-		0xd8, 0x73, 0x82, 0xfa, 0xbc, 0x05, 0xbd, 0xdb, 0xa5, 0xef, 0x7c, 0xb1, 0xd8, 0xf3, 0x41, 0x28,
-		0x12, 0x0d, 0x6f, 0xea, 0x6d, 0x11, 0xd8, 0xa4, 0x59, 0x88, 0xca, 0x4b, 0x79, 0x70, 0xd4, 0x82,
-		0x7c, 0x58, 0xb9, 0xfb, 0xa9, 0x67, 0x29, 0x48, 0x3d, 0x93, 0x32, 0x7a, 0xf1, 0x84, 0x71, 0x48,
-		0x5c, 0xd6, 0x49, 0x73, 0xb2, 0xcc, 0x29, 0xe6, 0x5f, 0xc4, 0xd1, 0xe9, 0x3a, 0xf6, 0x2d, 0x76,
-		0x99, 0x2a, 0xa3, 0xb2, 0x0f, 0xe5, 0x65, 0x28, 0x46, 0xed, 0x00, 0x2a, 0x42, 0xc2, 0xbb, 0xc3,
-		0x5b, 0x49, 0x78, 0x77, 0xd0, 0xc5, 0xd0, 0x0b, 0x69, 0xc5, 0x38, 0xeb, 0xc7, 0xf1, 0x83, 0xa7,
-		0xbd, 0xae, 0xa7, 0x32, 0x09, 0x39, 0xa9, 0x94, 0xa0, 0x10, 0xb1, 0x12, 0xca, 0x31, 0x98, 0x89,
-		0xd3, 0xf9, 0x8a, 0x09, 0x33, 0x71, 0xaa, 0x1b, 0x5d, 0x81, 0x8c, 0xaf, 0xf4, 0x85, 0x04, 0x0d,
-		0xb4, 0xee, 0x23, 0xf9, 0xb0, 0x44, 0x76, 0xc8, 0x44, 0xd0, 0x1d, 0x8a, 0x04, 0x8f, 0x3a, 0xba,
-		0xdd, 0x6b, 0xba, 0xbb, 0xa7, 0x7c, 0x33, 0xcc, 0x0e, 0xd3, 0xe7, 0x21, 0xc6, 0xb1, 0x54, 0x83,
-		0x60, 0xdc, 0x31, 0x48, 0xf3, 0xd7, 0x16, 0x12, 0x34, 0x73, 0xca, 0xbf, 0x08, 0x43, 0x99, 0x6e,
-		0x4f, 0xb2, 0x84, 0x2a, 0xfd, 0x50, 0x34, 0x38, 0x31, 0x54, 0xa5, 0x07, 0x5b, 0x2a, 0x3c, 0x07,
-		0xcb, 0xb6, 0x54, 0x7c, 0x42, 0xac, 0xb3, 0xec, 0x83, 0xbe, 0xc2, 0x89, 0xad, 0x16, 0x0f, 0x6e,
-		0xb2, 0x2a, 0xff, 0x52, 0x3e, 0x92, 0x84, 0x63, 0xf1, 0x7a, 0x1d, 0x2d, 0x40, 0xbe, 0xa3, 0xdf,
-		0xd1, 0xbc, 0x68, 0xea, 0x03, 0x3a, 0xfa, 0x9d, 0x26, 0xcf, 0x7b, 0xc8, 0x90, 0xf4, 0xee, 0xb8,
-		0xf4, 0x22, 0x57, 0x5e, 0x25, 0x7f, 0xa2, 0x1b, 0x30, 0xd5, 0xb6, 0x0d, 0xbd, 0xad, 0xb5, 0x75,
-		0xd7, 0xd3, 0xb8, 0xd9, 0x67, 0xcb, 0xe9, 0x81, 0x61, 0x7a, 0x9a, 0x5d, 0xb7, 0x32, 0x3d, 0xa2,
-		0x82, 0xf8, 0x42, 0x28, 0x51, 0x22, 0xab, 0xba, 0xeb, 0xf1, 0xf0, 0xa1, 0x06, 0xb9, 0x8e, 0xe9,
-		0x6e, 0xe3, 0x3d, 0xfd, 0x96, 0x69, 0x3b, 0x7c, 0x5d, 0xc5, 0x48, 0xcf, 0x5a, 0x00, 0xc4, 0x49,
-		0x85, 0xf1, 0x42, 0x93, 0x32, 0x11, 0x91, 0x66, 0xa1, 0x59, 0xd2, 0x87, 0xd6, 0x2c, 0x8f, 0xc3,
-		0x8c, 0x85, 0xef, 0xd0, 0xbb, 0x82, 0x7c, 0xe5, 0x32, 0x49, 0x61, 0x57, 0xfd, 0x10, 0xa9, 0xf3,
-		0xd7, 0xba, 0x4b, 0x77, 0xb5, 0x1e, 0x06, 0x3f, 0x60, 0xd4, 0x44, 0x34, 0x9b, 0xa1, 0xd0, 0x25,
-		0x51, 0x5e, 0x61, 0xc5, 0xca, 0xeb, 0x74, 0x72, 0xe2, 0xac, 0xa3, 0x60, 0xbd, 0x14, 0xb0, 0xbe,
-		0x09, 0x33, 0x1c, 0xbf, 0x15, 0xe1, 0xfe, 0x40, 0x78, 0x1e, 0x75, 0xba, 0x42, 0x5c, 0x47, 0x02,
-		0x7f, 0x38, 0xe3, 0x93, 0xf7, 0xc8, 0x78, 0x04, 0x29, 0xca, 0x96, 0x14, 0x53, 0x37, 0xe4, 0xef,
-		0xff, 0xd7, 0x26, 0xe3, 0xfd, 0x49, 0x98, 0x1a, 0x70, 0x2c, 0xfc, 0x81, 0x49, 0xb1, 0x03, 0x4b,
-		0xc4, 0x0e, 0x2c, 0x79, 0xe8, 0x81, 0xf1, 0xd9, 0x4e, 0x8d, 0x9e, 0xed, 0x89, 0xb7, 0x73, 0xb6,
-		0xd3, 0xf7, 0x38, 0xdb, 0xef, 0xe8, 0x3c, 0x7c, 0x4c, 0x82, 0xb9, 0xe1, 0xee, 0x58, 0xec, 0x84,
-		0x3c, 0x02, 0x53, 0x7e, 0x57, 0x7c, 0xf2, 0x4c, 0x3d, 0xca, 0x7e, 0x05, 0xa7, 0x3f, 0xd4, 0xe2,
-		0x3d, 0x08, 0xc5, 0x3e, 0x6f, 0x91, 0x09, 0x73, 0x21, 0x92, 0x41, 0x54, 0x3e, 0x90, 0x84, 0x99,
-		0x38, 0x87, 0x2e, 0x66, 0xc5, 0xaa, 0x30, 0xdd, 0xc2, 0x86, 0xd9, 0xba, 0xe7, 0x05, 0x3b, 0xc5,
-		0xd1, 0xff, 0xff, 0x7a, 0x8d, 0x91, 0x93, 0x1f, 0x03, 0xc8, 0xa8, 0xd8, 0xed, 0x12, 0x07, 0x8d,
-		0xbd, 0xf6, 0x6c, 0xe0, 0xae, 0x17, 0x64, 0xda, 0x63, 0xe3, 0x06, 0x0e, 0x22, 0xf0, 0x48, 0xfc,
-		0xec, 0xe3, 0xa1, 0x27, 0x79, 0x9a, 0x60, 0x68, 0xc0, 0xcf, 0xdc, 0x6f, 0x1f, 0x95, 0xe5, 0x09,
-		0x9e, 0x12, 0x79, 0x82, 0xe4, 0xb0, 0xe8, 0x97, 0x3b, 0xe3, 0x3e, 0x1e, 0x4f, 0x14, 0x3c, 0xc9,
-		0x13, 0x05, 0xa9, 0x61, 0xcd, 0x31, 0x9f, 0x3d, 0x68, 0xce, 0x64, 0x17, 0xb9, 0xc3, 0x99, 0x82,
-		0xf4, 0xb0, 0xa1, 0x86, 0x9c, 0xeb, 0x60, 0xa8, 0x41, 0xaa, 0xe0, 0x29, 0x91, 0x2a, 0x98, 0x1c,
-		0xd6, 0x69, 0xee, 0x4d, 0x06, 0x9d, 0x66, 0xb9, 0x82, 0xe7, 0x43, 0xb9, 0x82, 0x6c, 0xff, 0xce,
-		0xe0, 0x40, 0xae, 0xc0, 0xc7, 0xf6, 0x93, 0x05, 0x65, 0x3f, 0x59, 0x90, 0x1f, 0x9a, 0x69, 0xe0,
-		0x6e, 0xa0, 0x8f, 0x2c, 0xb2, 0x05, 0x9b, 0x03, 0xd9, 0x02, 0x16, 0xdc, 0x9f, 0x1d, 0x99, 0x2d,
-		0xf0, 0x49, 0xf5, 0xa5, 0x0b, 0x36, 0x07, 0xd2, 0x05, 0xc5, 0x61, 0x14, 0xfb, 0x7c, 0xce, 0x80,
-		0x62, 0x34, 0x5f, 0xf0, 0x8d, 0xf1, 0xf9, 0x82, 0xa1, 0x01, 0x7d, 0x8c, 0x7f, 0xe9, 0x93, 0x8e,
-		0x49, 0x18, 0x7c, 0xf3, 0x90, 0x84, 0x81, 0x3c, 0x2c, 0xb0, 0x8d, 0xf3, 0x2e, 0xfd, 0x06, 0xe2,
-		0x32, 0x06, 0x37, 0x62, 0x32, 0x06, 0x2c, 0xb4, 0x7f, 0x78, 0x8c, 0x8c, 0x81, 0x4f, 0x7a, 0x20,
-		0x65, 0x70, 0x23, 0x26, 0x65, 0x80, 0x86, 0xd3, 0xed, 0x73, 0x8a, 0xc2, 0x74, 0xa3, 0x39, 0x83,
-		0x95, 0x68, 0xce, 0x60, 0xfa, 0x60, 0x5f, 0x94, 0x99, 0x76, 0x9f, 0x5a, 0x38, 0x69, 0x60, 0x0c,
-		0x4b, 0x1a, 0xb0, 0xb8, 0xfe, 0xb1, 0x31, 0x93, 0x06, 0x3e, 0xed, 0xd8, 0xac, 0xc1, 0xe6, 0x40,
-		0xd6, 0xe0, 0xe8, 0x30, 0x81, 0xeb, 0x33, 0x32, 0x81, 0xc0, 0x0d, 0x4d, 0x1b, 0xb0, 0x47, 0xc6,
-		0xd8, 0xf3, 0x62, 0x20, 0xe7, 0xae, 0xa7, 0x32, 0x39, 0x39, 0xaf, 0x3c, 0x4c, 0xdc, 0x9a, 0x3e,
-		0xbd, 0x47, 0x82, 0x08, 0xec, 0x38, 0xb6, 0x23, 0x8e, 0x65, 0xd0, 0x0f, 0xe5, 0x1c, 0xe4, 0xc3,
-		0x2a, 0xee, 0x80, 0x14, 0x43, 0x09, 0x0a, 0x11, 0xad, 0xa6, 0xfc, 0x82, 0x04, 0xf9, 0xb0, 0xbe,
-		0x8a, 0x04, 0xa0, 0x59, 0x1e, 0x80, 0x86, 0x12, 0x0f, 0x89, 0x68, 0xe2, 0x61, 0x1e, 0x72, 0x24,
-		0x08, 0xeb, 0xcb, 0x29, 0xe8, 0x5d, 0x3f, 0xa7, 0x70, 0x1e, 0xa6, 0xa8, 0x0d, 0x65, 0xe9, 0x09,
-		0x6e, 0xa7, 0xd8, 0xfe, 0x4c, 0x89, 0x54, 0x50, 0x66, 0xf0, 0x9d, 0xc7, 0xc7, 0x60, 0x3a, 0x04,
-		0xeb, 0x07, 0x77, 0x2c, 0xbc, 0x96, 0x7d, 0xe8, 0x0a, 0x8f, 0xf2, 0x7e, 0x59, 0x82, 0xa9, 0x01,
-		0x75, 0x19, 0x9b, 0x37, 0x90, 0xde, 0xae, 0xbc, 0x41, 0xe2, 0xde, 0xf3, 0x06, 0xe1, 0x70, 0x35,
-		0x19, 0x0d, 0x57, 0xff, 0x52, 0x82, 0x42, 0x44, 0x6d, 0x93, 0x49, 0x30, 0xec, 0x96, 0x38, 0xc4,
-		0x43, 0xff, 0x26, 0x7e, 0x4a, 0xdb, 0xde, 0xe5, 0x61, 0x22, 0xf9, 0x93, 0x40, 0xf9, 0x86, 0x28,
-		0xcb, 0xcd, 0x8c, 0x1f, 0x7b, 0x4e, 0x84, 0x8f, 0xf3, 0xf1, 0x23, 0x6e, 0xe9, 0xe0, 0x88, 0x9b,
-		0x7f, 0x76, 0x67, 0x32, 0x74, 0x76, 0x07, 0x3d, 0x03, 0x59, 0xba, 0x0b, 0xa0, 0xd9, 0xdd, 0xe0,
-		0x87, 0x29, 0x86, 0x1f, 0x6f, 0x73, 0xe9, 0xfe, 0x21, 0x3b, 0x13, 0x17, 0x78, 0x21, 0xd9, 0x88,
-		0x17, 0x72, 0x1f, 0x64, 0x49, 0xf7, 0xd9, 0xe3, 0x8e, 0xc0, 0x0f, 0xd3, 0x8a, 0x02, 0xe5, 0x27,
-		0x13, 0x50, 0xea, 0xb3, 0x3a, 0xb1, 0x83, 0x17, 0x52, 0x99, 0x08, 0xa5, 0x45, 0xc6, 0x63, 0xc8,
-		0x29, 0x80, 0x5d, 0xdd, 0xd5, 0x6e, 0xeb, 0x96, 0xc7, 0xdf, 0x70, 0x4f, 0xaa, 0xa1, 0x12, 0x34,
-		0x07, 0x19, 0xf2, 0xd5, 0x73, 0xf9, 0x2b, 0xee, 0x49, 0xd5, 0xff, 0x46, 0x75, 0x48, 0xe3, 0x5b,
-		0xf4, 0x39, 0x12, 0xf6, 0xa8, 0xcf, 0xf1, 0x18, 0xf5, 0x44, 0xea, 0xab, 0xb3, 0x64, 0xba, 0xff,
-		0xf0, 0xcd, 0x79, 0x99, 0x81, 0x3f, 0xea, 0x3f, 0xbf, 0xa0, 0x72, 0x02, 0x51, 0x36, 0x64, 0xfa,
-		0xd8, 0x40, 0xd3, 0x85, 0x79, 0x11, 0xfb, 0x13, 0xa6, 0xb2, 0x0d, 0x4b, 0xb5, 0xd0, 0xc1, 0x9d,
-		0xae, 0x6d, 0xb7, 0x35, 0xb6, 0xce, 0x2b, 0x50, 0x8c, 0x1a, 0x59, 0xf6, 0xf2, 0xb2, 0xa7, 0x9b,
-		0x96, 0x16, 0xf1, 0x8d, 0xf3, 0xac, 0x90, 0xad, 0xab, 0xeb, 0xa9, 0x8c, 0x24, 0x27, 0x78, 0xba,
-		0xe6, 0xdd, 0x70, 0x34, 0xd6, 0xc6, 0xa2, 0xa7, 0x21, 0x1b, 0xd8, 0x67, 0xb6, 0xed, 0x7c, 0x50,
-		0x1e, 0x26, 0x00, 0x56, 0x6e, 0xc0, 0xd1, 0x58, 0x23, 0x8b, 0x9e, 0x83, 0xb4, 0x83, 0xdd, 0x5e,
-		0xdb, 0xe3, 0xcf, 0x22, 0x3e, 0x38, 0xda, 0x3a, 0xf7, 0xda, 0x9e, 0xca, 0x91, 0x94, 0x8b, 0x70,
-		0x62, 0xa8, 0x95, 0x0d, 0xb2, 0x29, 0x52, 0x28, 0x9b, 0xa2, 0xfc, 0xb4, 0x04, 0x73, 0xc3, 0x2d,
-		0x27, 0xaa, 0xf6, 0x75, 0xe8, 0xfc, 0x98, 0x76, 0x37, 0xd4, 0x2b, 0x12, 0x6e, 0x38, 0x78, 0x07,
-		0x7b, 0xc6, 0x1e, 0x33, 0xe1, 0x4c, 0x29, 0x14, 0xd4, 0x02, 0x2f, 0xa5, 0x38, 0x2e, 0x03, 0x7b,
-		0x15, 0x1b, 0x9e, 0xc6, 0x26, 0xd5, 0xe5, 0x3f, 0xb5, 0x53, 0x60, 0xa5, 0x0d, 0x56, 0xa8, 0x3c,
-		0x02, 0xc7, 0x87, 0xd8, 0xe2, 0xc1, 0xb8, 0x44, 0x79, 0x85, 0x00, 0xc7, 0x1a, 0x58, 0xf4, 0x02,
-		0xa4, 0x5d, 0x4f, 0xf7, 0x7a, 0x2e, 0x1f, 0xd9, 0xd9, 0x91, 0xb6, 0xb9, 0x41, 0xc1, 0x55, 0x8e,
-		0xa6, 0x3c, 0x0b, 0x68, 0xd0, 0xd2, 0xc6, 0xc4, 0x56, 0x52, 0x5c, 0x6c, 0xb5, 0x0d, 0x27, 0x0f,
-		0xb0, 0xa9, 0x68, 0xa9, 0xaf, 0x73, 0x8f, 0x8c, 0x65, 0x92, 0xfb, 0x3a, 0xf8, 0xc7, 0x09, 0x38,
-		0x1a, 0x6b, 0x5a, 0x43, 0xab, 0x54, 0x7a, 0xab, 0xab, 0xf4, 0x39, 0x00, 0xef, 0x8e, 0xc6, 0x66,
-		0x5a, 0x68, 0xfb, 0xb8, 0x78, 0xe2, 0x0e, 0x36, 0xa8, 0xc2, 0x22, 0x82, 0x91, 0xf5, 0xf8, 0x5f,
-		0x24, 0xf8, 0x0f, 0xc5, 0xb3, 0x3d, 0x6a, 0x09, 0x5c, 0x1e, 0xea, 0x8d, 0x6d, 0x33, 0x82, 0xc0,
-		0x97, 0x15, 0xbb, 0xe8, 0x15, 0x38, 0xde, 0x67, 0xd1, 0x7c, 0xda, 0xa9, 0xb1, 0x0d, 0xdb, 0xd1,
-		0xa8, 0x61, 0x13, 0xb4, 0xc3, 0x56, 0x69, 0x22, 0x6a, 0x95, 0x5e, 0x01, 0x08, 0x02, 0x5b, 0xb2,
-		0xde, 0x1c, 0xbb, 0x67, 0xb5, 0xc4, 0xe1, 0x53, 0xfa, 0x81, 0xae, 0xc0, 0x04, 0x91, 0x04, 0xc1,
-		0xaa, 0x18, 0x85, 0x41, 0xa6, 0x34, 0x14, 0x19, 0x33, 0x70, 0xe5, 0x55, 0x21, 0x6d, 0xe1, 0x1c,
-		0xe3, 0x90, 0x36, 0x9e, 0x8f, 0xb6, 0xa1, 0x0c, 0x4f, 0x57, 0xc6, 0xb7, 0xf5, 0x77, 0x60, 0x82,
-		0x4e, 0x7f, 0xec, 0xd9, 0xef, 0x6f, 0x02, 0xd0, 0x3d, 0xcf, 0x31, 0xb7, 0x7b, 0x41, 0x0b, 0x0b,
-		0x43, 0xe4, 0xa7, 0x22, 0x00, 0xab, 0xf7, 0x71, 0x41, 0x9a, 0x09, 0x70, 0x43, 0xc2, 0x14, 0xa2,
-		0xa8, 0xac, 0x43, 0x31, 0x8a, 0x1b, 0x7f, 0x98, 0x5d, 0xfc, 0x2a, 0x40, 0x70, 0xd4, 0x36, 0x30,
-		0xe4, 0xfc, 0xb6, 0x10, 0xfd, 0x50, 0xbe, 0x25, 0x01, 0xf9, 0xb0, 0xf4, 0xfd, 0x2d, 0x34, 0x96,
-		0xca, 0x07, 0x24, 0xc8, 0xf8, 0xe3, 0x8f, 0xa6, 0xf3, 0x23, 0xfb, 0x20, 0xc1, 0xb5, 0x06, 0x3f,
-		0x07, 0xcf, 0x76, 0x3d, 0x92, 0xfe, 0xae, 0xc7, 0xbb, 0x7c, 0x83, 0x30, 0x34, 0x98, 0x0f, 0x73,
-		0x5b, 0x1c, 0x4f, 0xe2, 0x06, 0xea, 0xd9, 0xf1, 0xce, 0x40, 0xcd, 0xc0, 0x44, 0xf8, 0xf8, 0x12,
-		0xfb, 0x50, 0x70, 0xe8, 0x04, 0x25, 0x5b, 0x8d, 0xe1, 0xc3, 0x52, 0xd2, 0xe1, 0x0f, 0x4b, 0xf9,
-		0xcd, 0x24, 0xc2, 0xcd, 0xfc, 0x23, 0x09, 0x32, 0x62, 0x5d, 0xa0, 0x17, 0xc2, 0xe7, 0x7b, 0xc5,
-		0x61, 0xc1, 0xe1, 0x7a, 0x89, 0x37, 0x10, 0x3a, 0xde, 0x5b, 0x15, 0xfb, 0x8c, 0x66, 0x4b, 0xdb,
-		0x69, 0xeb, 0xbb, 0x7c, 0xbb, 0x68, 0xe8, 0xe9, 0x64, 0x76, 0x78, 0x88, 0x1f, 0xb8, 0xac, 0xb7,
-		0xc8, 0x07, 0xf7, 0x43, 0xfe, 0x5c, 0x02, 0xb9, 0x7f, 0xdd, 0xbe, 0xf5, 0xfe, 0x0d, 0xda, 0xab,
-		0x64, 0x8c, 0xbd, 0x42, 0x17, 0x60, 0x3a, 0xf8, 0x61, 0x2e, 0xd7, 0xdc, 0xb5, 0xd8, 0xe1, 0x5f,
-		0x96, 0x54, 0x43, 0x7e, 0x55, 0x43, 0xd4, 0x0c, 0x8e, 0x7b, 0xe2, 0x5e, 0xc7, 0xfd, 0xfe, 0x04,
-		0xe4, 0x42, 0x39, 0x3e, 0x74, 0x39, 0xa4, 0x94, 0x8a, 0x71, 0x56, 0x22, 0x04, 0x1c, 0xfa, 0x59,
-		0x9d, 0x08, 0xa7, 0x12, 0xf7, 0xc0, 0xa9, 0x61, 0xd9, 0x54, 0x91, 0x34, 0x4c, 0x1d, 0x3a, 0x69,
-		0x18, 0x7f, 0x80, 0x70, 0x62, 0xc8, 0x01, 0xc2, 0xbf, 0x27, 0x41, 0xc6, 0x4f, 0xbe, 0x1c, 0x76,
-		0x4f, 0xee, 0x18, 0xa4, 0xb9, 0xef, 0xc5, 0x36, 0xe5, 0xf8, 0x57, 0x6c, 0x76, 0x74, 0x0e, 0x32,
-		0xe2, 0x95, 0x79, 0x6e, 0xe1, 0xfc, 0xef, 0xf3, 0xdb, 0x90, 0x0b, 0x6d, 0x6b, 0xa2, 0x13, 0x70,
-		0x74, 0xe9, 0x5a, 0x6d, 0xe9, 0x45, 0xad, 0xf9, 0x52, 0xff, 0xdb, 0xc2, 0x03, 0x55, 0x6a, 0x8d,
-		0x7e, 0xcb, 0x12, 0x3a, 0x0e, 0xd3, 0xd1, 0x2a, 0x56, 0x91, 0x98, 0x4b, 0x7d, 0xf0, 0x47, 0x4f,
-		0x1d, 0x39, 0xff, 0xe7, 0x12, 0x4c, 0xc7, 0x78, 0xb9, 0xe8, 0x34, 0xdc, 0xbf, 0x71, 0xf5, 0x6a,
-		0x4d, 0xd5, 0x1a, 0xeb, 0x95, 0xcd, 0xc6, 0xb5, 0x8d, 0xa6, 0xa6, 0xd6, 0x1a, 0x5b, 0xab, 0xcd,
-		0x50, 0xa3, 0x0b, 0x70, 0x5f, 0x3c, 0x48, 0x65, 0x69, 0xa9, 0xb6, 0xd9, 0x64, 0x8f, 0x1b, 0x0f,
-		0x81, 0xa8, 0x6e, 0xa8, 0x4d, 0x39, 0x31, 0x9c, 0x84, 0x5a, 0xbb, 0x5e, 0x5b, 0x6a, 0xca, 0x49,
-		0x74, 0x16, 0xce, 0x1c, 0x04, 0xa1, 0x5d, 0xdd, 0x50, 0xd7, 0x2a, 0x4d, 0x39, 0x35, 0x12, 0xb0,
-		0x51, 0x5b, 0x5f, 0xae, 0xa9, 0xf2, 0x04, 0x1f, 0xf7, 0x1b, 0x09, 0x98, 0x1d, 0xe6, 0x4c, 0x13,
-		0x5a, 0x95, 0xcd, 0xcd, 0xd5, 0x97, 0x03, 0x5a, 0x4b, 0xd7, 0xb6, 0xd6, 0x5f, 0x1c, 0x64, 0xc1,
-		0x43, 0xa0, 0x1c, 0x04, 0xe8, 0x33, 0xe2, 0x41, 0x38, 0x7d, 0x20, 0x1c, 0x67, 0xc7, 0x08, 0x30,
-		0xb5, 0xd6, 0x54, 0x5f, 0x96, 0x93, 0x68, 0x11, 0xce, 0x8f, 0x04, 0xf3, 0xeb, 0xe4, 0x14, 0xba,
-		0x00, 0x8f, 0x1c, 0x0c, 0xcf, 0x18, 0x24, 0x10, 0x04, 0x8b, 0x5e, 0x97, 0xe0, 0x68, 0xac, 0x57,
-		0x8e, 0xce, 0xc0, 0xfc, 0xa6, 0xba, 0xb1, 0x54, 0x6b, 0x34, 0xb4, 0x4d, 0x75, 0x63, 0x73, 0xa3,
-		0x51, 0x59, 0xd5, 0x1a, 0xcd, 0x4a, 0x73, 0xab, 0x11, 0xe2, 0x8d, 0x02, 0xa7, 0x86, 0x01, 0xf9,
-		0x7c, 0x39, 0x00, 0x86, 0x4b, 0x80, 0x90, 0xd3, 0xbb, 0x12, 0x9c, 0x18, 0xea, 0x85, 0xa3, 0x73,
-		0xf0, 0x00, 0xfd, 0x9d, 0xb2, 0x97, 0xb5, 0x1b, 0x1b, 0xcd, 0xf0, 0x2b, 0xda, 0x03, 0xbd, 0x3a,
-		0x0b, 0x67, 0x0e, 0x84, 0xf4, 0xbb, 0x36, 0x0a, 0xb0, 0xaf, 0x7f, 0xdf, 0x26, 0x41, 0xa9, 0x4f,
-		0x17, 0xa2, 0xfb, 0x60, 0x76, 0xad, 0xde, 0xa8, 0xd6, 0xae, 0x55, 0x6e, 0xd4, 0x37, 0xd4, 0xfe,
-		0x35, 0x7b, 0x06, 0xe6, 0x07, 0x6a, 0x97, 0xb7, 0x36, 0x57, 0xeb, 0x4b, 0x95, 0x66, 0x8d, 0x36,
-		0x2a, 0x4b, 0x64, 0x60, 0x03, 0x40, 0xab, 0xf5, 0x95, 0x6b, 0x4d, 0x6d, 0x69, 0xb5, 0x5e, 0x5b,
-		0x6f, 0x6a, 0x95, 0x66, 0xb3, 0x12, 0x2c, 0xe7, 0xea, 0x8b, 0x43, 0x8f, 0xbe, 0x5e, 0x1c, 0xff,
-		0xe8, 0x2b, 0x3f, 0xc2, 0x19, 0xdc, 0x56, 0x4b, 0xc0, 0xbc, 0x5f, 0xc9, 0x73, 0x69, 0xfd, 0x47,
-		0x3c, 0xa7, 0x7d, 0xed, 0xce, 0x01, 0x86, 0xdf, 0xf8, 0x7c, 0x0e, 0x92, 0x95, 0x6e, 0x97, 0x68,
-		0x3e, 0xfa, 0x6d, 0xd8, 0x6d, 0xae, 0x57, 0xfd, 0x6f, 0x52, 0xe7, 0xda, 0x3b, 0xde, 0x6d, 0xdd,
-		0xf1, 0x7f, 0x79, 0x4d, 0x7c, 0x2b, 0xcf, 0x40, 0xd6, 0x8f, 0x1e, 0xe8, 0xdb, 0xa5, 0xfe, 0x3d,
-		0xa4, 0x94, 0xb8, 0x67, 0xc4, 0x2f, 0x6b, 0x24, 0x82, 0xcb, 0x1a, 0xa9, 0x2f, 0xbe, 0x31, 0x2f,
-		0x55, 0xd7, 0x87, 0x72, 0xe7, 0xc9, 0xf1, 0xb9, 0x13, 0x30, 0xc0, 0x67, 0xd0, 0xf7, 0xdd, 0x1f,
-		0xba, 0x0d, 0xec, 0x9f, 0x38, 0x0d, 0xb3, 0x27, 0xe6, 0x3c, 0xfe, 0xa8, 0x33, 0xae, 0x63, 0x9c,
-		0x61, 0x1d, 0x35, 0x2b, 0xf7, 0x7a, 0xc8, 0xf5, 0x19, 0x28, 0x6c, 0xea, 0x8e, 0xd7, 0xc0, 0xde,
-		0x35, 0xac, 0xb7, 0xb0, 0x13, 0xbd, 0x9b, 0x5b, 0x10, 0x77, 0x73, 0x85, 0x3d, 0x4b, 0x04, 0xf6,
-		0x4c, 0x31, 0x21, 0x45, 0x9f, 0x13, 0x1e, 0x7a, 0xc8, 0x84, 0x1d, 0x0a, 0xe1, 0x87, 0x4c, 0xe8,
-		0x07, 0xba, 0x2c, 0x6e, 0xdf, 0x26, 0x47, 0xdc, 0xbe, 0x15, 0x91, 0x13, 0xbb, 0x83, 0xdb, 0x81,
-		0x49, 0xee, 0xcd, 0xc4, 0xee, 0xde, 0xae, 0x43, 0xa9, 0xab, 0x3b, 0x1e, 0xfd, 0xb5, 0x92, 0x3d,
-		0x3a, 0x0c, 0xee, 0x89, 0xc4, 0x5d, 0x9f, 0x8a, 0x0c, 0x97, 0x37, 0x53, 0xe8, 0x86, 0x0b, 0x95,
-		0x2f, 0xa6, 0x20, 0xcd, 0xd9, 0xf1, 0x7c, 0xf4, 0xa4, 0x5b, 0xc4, 0x31, 0x0f, 0xc4, 0x3f, 0x08,
-		0x72, 0x39, 0x41, 0x3f, 0x2d, 0xfd, 0x50, 0xff, 0xb9, 0xb2, 0x6a, 0xee, 0xf3, 0x6f, 0xce, 0x4f,
-		0xd2, 0x4c, 0x71, 0x7d, 0x39, 0x38, 0x64, 0xf6, 0xf6, 0x7b, 0x41, 0xcb, 0x50, 0x08, 0xe5, 0xb0,
-		0xcd, 0x16, 0xdf, 0xf8, 0x9f, 0x1b, 0xee, 0x29, 0x8a, 0x6d, 0x5e, 0x3f, 0xbf, 0x5d, 0x6f, 0xa1,
-		0x73, 0x20, 0x87, 0x76, 0x9e, 0x59, 0x78, 0xce, 0x92, 0xb7, 0xc5, 0xb6, 0xbf, 0xa7, 0x4c, 0x37,
-		0x5e, 0x4f, 0x42, 0x96, 0xfe, 0x80, 0x4e, 0x68, 0x7f, 0x36, 0x43, 0x0a, 0x68, 0xe5, 0x59, 0x28,
-		0xf5, 0x6f, 0xe1, 0xb2, 0x4d, 0xd9, 0xe2, 0xad, 0xe8, 0xf6, 0xed, 0xb0, 0x0d, 0xdf, 0xec, 0xd0,
-		0x0d, 0xdf, 0x07, 0xa1, 0x18, 0x24, 0x25, 0x28, 0x2c, 0x30, 0x4f, 0xdb, 0x2f, 0xa5, 0x60, 0xe1,
-		0xfc, 0x42, 0x2e, 0x92, 0x5f, 0xf0, 0x77, 0x06, 0x78, 0xb6, 0x85, 0xc1, 0xe4, 0xd9, 0x9e, 0x31,
-		0xa9, 0xe0, 0x49, 0x15, 0x0a, 0x7b, 0x06, 0x0a, 0xe2, 0x92, 0x22, 0x83, 0x2b, 0x50, 0xb8, 0xbc,
-		0x28, 0x1c, 0xba, 0x07, 0x5d, 0x8c, 0xdf, 0x83, 0x9e, 0x85, 0xd4, 0x32, 0x8f, 0x8a, 0xfb, 0x72,
-		0x6c, 0x9f, 0x4d, 0x42, 0x8a, 0x6e, 0x2b, 0x3d, 0x19, 0x71, 0xcc, 0xe3, 0x44, 0x9a, 0x84, 0x07,
-		0xb8, 0xb5, 0xe6, 0xee, 0x86, 0xfc, 0xf2, 0x61, 0x47, 0x4c, 0xfc, 0xd4, 0x46, 0x32, 0x9c, 0xda,
-		0xb8, 0x0a, 0x19, 0x5f, 0x4e, 0x52, 0x23, 0xe5, 0xa4, 0x44, 0xe4, 0x84, 0x88, 0x31, 0x2f, 0x50,
-		0x27, 0x79, 0x78, 0x81, 0xaa, 0x90, 0xf5, 0x35, 0x8c, 0x2f, 0x70, 0xe3, 0xc8, 0x6c, 0x80, 0x16,
-		0x7f, 0x16, 0x23, 0x3d, 0xe4, 0x2c, 0x46, 0x58, 0xb0, 0xf8, 0x6f, 0x6f, 0x4e, 0xd2, 0x81, 0x05,
-		0x82, 0xc5, 0x7e, 0x7f, 0xf3, 0x3e, 0xc8, 0x06, 0xf1, 0x15, 0x93, 0xbd, 0xa0, 0x80, 0xd4, 0x06,
-		0x91, 0x1a, 0x93, 0xb5, 0xd0, 0x8f, 0x38, 0x0f, 0x89, 0xd2, 0x60, 0x58, 0x94, 0xa6, 0xfc, 0x5b,
-		0x09, 0xd2, 0xfc, 0xb8, 0xc5, 0x01, 0x69, 0x01, 0x36, 0x0f, 0x89, 0x61, 0xf3, 0x90, 0x7c, 0x4b,
-		0xf3, 0x00, 0x7e, 0x3f, 0xc5, 0x21, 0xd3, 0xfb, 0x62, 0x93, 0x73, 0xa4, 0x93, 0x0d, 0x73, 0x57,
-		0xec, 0x13, 0x05, 0x58, 0xca, 0x9b, 0x12, 0x31, 0xbf, 0xbc, 0x7e, 0x30, 0xf0, 0x94, 0x0e, 0x1d,
-		0x78, 0x1e, 0xee, 0x94, 0x4d, 0x44, 0x94, 0x92, 0xf7, 0x26, 0x4a, 0x91, 0x49, 0x4f, 0xf5, 0x4d,
-		0xba, 0xf2, 0x05, 0x89, 0xff, 0x7e, 0xb3, 0x9f, 0xfc, 0xfb, 0x2b, 0x9a, 0xad, 0xaf, 0xe7, 0xf2,
-		0xd5, 0xc2, 0x2d, 0x6d, 0x60, 0xda, 0x1e, 0x88, 0xbb, 0x37, 0x1d, 0xe9, 0x75, 0x30, 0x7d, 0x48,
-		0x90, 0x69, 0x04, 0xd3, 0xf8, 0x73, 0x09, 0x71, 0x2a, 0x2d, 0x04, 0xff, 0x37, 0x70, 0x3a, 0xa3,
-		0x6b, 0x78, 0x62, 0xcc, 0x35, 0x9c, 0x1e, 0xba, 0x86, 0x7f, 0x2e, 0x41, 0xdf, 0xd9, 0x60, 0x67,
-		0x04, 0xbe, 0x16, 0x3a, 0xf8, 0x24, 0x64, 0xbb, 0x76, 0x5b, 0x63, 0x35, 0xec, 0x31, 0xfe, 0x4c,
-		0xd7, 0x6e, 0xab, 0x03, 0xa2, 0x36, 0xf1, 0x76, 0x29, 0xe8, 0xf4, 0xdb, 0x30, 0x0d, 0x93, 0xfd,
-		0xab, 0xca, 0x83, 0x3c, 0xe3, 0x05, 0xf7, 0xa0, 0x2e, 0x12, 0x26, 0x50, 0x9f, 0x4c, 0xea, 0xf7,
-		0xf9, 0xfc, 0x7e, 0x33, 0x50, 0x95, 0x03, 0x12, 0x94, 0xc8, 0x49, 0xb7, 0x13, 0x43, 0x35, 0x97,
-		0x38, 0xd9, 0xa3, 0x7c, 0x58, 0x02, 0x58, 0x25, 0xcc, 0xa5, 0x23, 0x26, 0xce, 0x8f, 0x4b, 0x3b,
-		0xa1, 0x45, 0xda, 0x9e, 0x1f, 0x3a, 0x71, 0xbc, 0x07, 0x79, 0x37, 0xdc, 0xf5, 0x65, 0x28, 0x04,
-		0x02, 0xee, 0x62, 0xd1, 0x9d, 0xf9, 0x83, 0x2e, 0xb2, 0x36, 0xb0, 0xa7, 0xe6, 0x6f, 0x85, 0xbe,
-		0x94, 0x7f, 0x27, 0x41, 0x96, 0xf6, 0x6a, 0x0d, 0x7b, 0x7a, 0x64, 0x22, 0xa5, 0xb7, 0x30, 0x91,
-		0xf7, 0x03, 0x30, 0x3a, 0xae, 0xf9, 0x1a, 0xe6, 0xf2, 0x95, 0xa5, 0x25, 0x0d, 0xf3, 0x35, 0x8c,
-		0x9e, 0xf2, 0xb9, 0x9e, 0x1c, 0xc1, 0x75, 0x91, 0xbc, 0xe5, 0xbc, 0x3f, 0x0e, 0x93, 0x56, 0xaf,
-		0xa3, 0xb1, 0xc3, 0xa4, 0x54, 0x68, 0xad, 0x5e, 0xa7, 0x79, 0xc7, 0x55, 0x6e, 0xc2, 0x64, 0xf3,
-		0x0e, 0x7b, 0xbf, 0xe7, 0x24, 0x64, 0x1d, 0xdb, 0xe6, 0xde, 0x20, 0x73, 0xc4, 0x33, 0xa4, 0x80,
-		0x3a, 0x3f, 0x71, 0x39, 0xff, 0x0b, 0xe3, 0xba, 0xfd, 0xdc, 0xe1, 0x3f, 0xff, 0x9b, 0x12, 0x14,
-		0x22, 0x2b, 0x0a, 0x3d, 0x0a, 0xc7, 0x1b, 0xf5, 0x95, 0xf5, 0xda, 0xb2, 0xb6, 0xd6, 0x58, 0xe9,
-		0x0b, 0xb0, 0xe7, 0x4a, 0xaf, 0xdf, 0x5d, 0xc8, 0xf1, 0xab, 0xaa, 0xc3, 0xa0, 0x37, 0xd5, 0x1a,
-		0x8b, 0xb4, 0x19, 0xf4, 0xa6, 0x83, 0x6f, 0xd9, 0x1e, 0xa6, 0xd0, 0x8f, 0xc3, 0x89, 0x18, 0x68,
-		0xff, 0xc2, 0xea, 0xd4, 0xeb, 0x77, 0x17, 0x0a, 0x9b, 0x0e, 0x66, 0xa2, 0x46, 0x31, 0x16, 0x61,
-		0x76, 0x10, 0x83, 0x65, 0x35, 0xe4, 0x85, 0x39, 0xf9, 0xf5, 0xbb, 0x0b, 0x79, 0xa1, 0x3b, 0x08,
-		0xfc, 0x3b, 0x7e, 0x63, 0xf5, 0xa3, 0x59, 0x38, 0xc1, 0xde, 0xb0, 0xd2, 0x58, 0x0c, 0xc8, 0x3e,
-		0x78, 0x48, 0x9a, 0x0f, 0x57, 0x8d, 0xfe, 0x71, 0x02, 0xe5, 0x45, 0x98, 0xae, 0x5b, 0x1e, 0x76,
-		0x76, 0xf4, 0xf0, 0xcf, 0x0b, 0xc7, 0xfe, 0x60, 0xef, 0x42, 0xe4, 0x95, 0x4d, 0x1e, 0xc1, 0x87,
-		0x8b, 0x94, 0x6f, 0x91, 0x40, 0x6e, 0x18, 0x7a, 0x5b, 0x77, 0xde, 0x2a, 0x29, 0xf4, 0x94, 0xf8,
-		0x51, 0x0a, 0x7e, 0x41, 0x24, 0x79, 0xae, 0x78, 0x69, 0x76, 0x31, 0x3c, 0xb8, 0x45, 0xd6, 0x12,
-		0xd5, 0xc1, 0xec, 0xc7, 0x28, 0xc8, 0x9f, 0xe7, 0x5f, 0x02, 0x08, 0x2a, 0xd0, 0x49, 0x38, 0xde,
-		0x58, 0xaa, 0xac, 0x56, 0xfc, 0x3c, 0x4d, 0x63, 0xb3, 0xb6, 0xc4, 0x7e, 0xf9, 0xfe, 0x08, 0x3a,
-		0x06, 0x28, 0x5c, 0xe9, 0xff, 0xce, 0xdc, 0x51, 0x98, 0x0a, 0x97, 0xb3, 0x9f, 0x21, 0x4f, 0x94,
-		0xaf, 0x41, 0x89, 0xfd, 0x46, 0x32, 0x31, 0x80, 0xb8, 0xa5, 0x99, 0x16, 0x1a, 0xf1, 0x93, 0xc3,
-		0xb3, 0xbf, 0xfa, 0x5f, 0xd9, 0x4f, 0x54, 0x14, 0x18, 0x62, 0x85, 0xe0, 0xd5, 0xad, 0x72, 0x13,
-		0x66, 0xe8, 0x8d, 0x70, 0xfa, 0xb3, 0x32, 0x9a, 0x29, 0xf8, 0x3f, 0xfa, 0x0d, 0x41, 0x42, 0x2f,
-		0x79, 0x2e, 0xab, 0x4e, 0x07, 0xe8, 0xfe, 0xec, 0x95, 0x5f, 0x0c, 0x7e, 0x54, 0xc4, 0xef, 0xe0,
-		0x48, 0x8a, 0xbf, 0xc6, 0x7b, 0x28, 0x9e, 0x10, 0x16, 0x5d, 0x5c, 0x85, 0x29, 0xdd, 0x30, 0x70,
-		0x37, 0xd2, 0xbf, 0x11, 0xcf, 0xb6, 0x89, 0xd1, 0xca, 0x1c, 0x33, 0xe8, 0xda, 0x53, 0x90, 0x76,
-		0xe9, 0xa4, 0x8c, 0x22, 0x21, 0xba, 0xc3, 0xc1, 0xcb, 0x35, 0x28, 0x32, 0x31, 0xf0, 0x47, 0x34,
-		0x82, 0xc0, 0x7f, 0xe4, 0x04, 0xf2, 0x14, 0x4d, 0x8c, 0xc6, 0x82, 0xa9, 0x16, 0x36, 0xda, 0xba,
-		0x83, 0x43, 0xa3, 0x39, 0xf8, 0xe9, 0xe2, 0x7f, 0xf9, 0xe9, 0xc7, 0xfd, 0x3d, 0xf4, 0x90, 0xd0,
-		0xc5, 0x2c, 0x16, 0x55, 0xe6, 0xb4, 0x83, 0xf1, 0x62, 0x28, 0x8a, 0xf6, 0xf8, 0xb8, 0x0f, 0x6e,
-		0xec, 0x5f, 0xf1, 0xc6, 0x4e, 0xc5, 0x49, 0x78, 0xa8, 0xa5, 0x02, 0xa7, 0xca, 0x2a, 0xca, 0x55,
-		0x28, 0xec, 0x98, 0xed, 0xd0, 0x74, 0x1f, 0xdc, 0xca, 0xbf, 0xfe, 0xf4, 0xe3, 0x6c, 0xa1, 0x11,
-		0x24, 0xce, 0x9a, 0x7b, 0xfa, 0xc9, 0x14, 0x4a, 0xfd, 0xd9, 0x70, 0x57, 0x7d, 0xed, 0xf4, 0x89,
-		0x24, 0x9c, 0xe2, 0xc0, 0xdb, 0xba, 0x8b, 0x89, 0xe2, 0xc2, 0x9e, 0x7e, 0xf1, 0x82, 0x61, 0x9b,
-		0x56, 0x90, 0x54, 0xa4, 0x0a, 0x8b, 0xd4, 0x2f, 0xf2, 0xfa, 0x21, 0x39, 0xad, 0xe1, 0x8a, 0x6e,
-		0x6e, 0xf0, 0x67, 0x7b, 0x94, 0x36, 0xa4, 0x96, 0x6c, 0xd3, 0x22, 0x3e, 0x57, 0x0b, 0x5b, 0x76,
-		0x47, 0x9c, 0x57, 0xa4, 0x1f, 0xe8, 0x1a, 0xa4, 0xf5, 0x8e, 0xdd, 0xb3, 0xf8, 0xfb, 0x6d, 0xd5,
-		0xc7, 0x89, 0x2d, 0xfc, 0xed, 0x37, 0xe7, 0x8f, 0x32, 0xb2, 0x6e, 0xeb, 0xe6, 0xa2, 0x69, 0x5f,
-		0xe8, 0xe8, 0xde, 0x1e, 0x99, 0xe4, 0xdf, 0xf8, 0xcc, 0x63, 0xc0, 0xdb, 0xab, 0x5b, 0xde, 0x27,
-		0xff, 0xe0, 0x67, 0xce, 0x4b, 0x2a, 0xc7, 0x67, 0x69, 0x47, 0xa5, 0x0b, 0x93, 0xcb, 0xd8, 0x38,
-		0xa0, 0xc1, 0x7a, 0x5f, 0x83, 0x17, 0x79, 0x83, 0x27, 0x07, 0x1b, 0x64, 0xbf, 0x23, 0xb8, 0x8c,
-		0x8d, 0x50, 0xb3, 0xcb, 0xd8, 0x88, 0xb6, 0x58, 0x5d, 0xfe, 0xad, 0xdf, 0x3b, 0x75, 0xe4, 0x7d,
-		0x9f, 0x3f, 0x75, 0x64, 0xe8, 0x94, 0x29, 0xa3, 0x7f, 0xe5, 0xc6, 0x9f, 0xa9, 0xff, 0x2d, 0xc1,
-		0x89, 0x7e, 0xf3, 0xa0, 0x5b, 0xfb, 0xc3, 0xde, 0x3c, 0xb8, 0x02, 0xc9, 0x8a, 0xb5, 0x8f, 0x4e,
-		0xb0, 0xd7, 0x5c, 0xb5, 0x9e, 0xd3, 0x16, 0xc7, 0x3c, 0xc9, 0xf7, 0x96, 0xd3, 0x8e, 0x1e, 0x29,
-		0xf0, 0x5f, 0xe6, 0xfa, 0xee, 0x43, 0x3e, 0x77, 0x90, 0xa9, 0x58, 0xfb, 0xe2, 0xa1, 0x83, 0x47,
-		0xc7, 0x7c, 0xe8, 0x40, 0xb7, 0xf6, 0xbb, 0xdb, 0x87, 0x7d, 0xdf, 0xe0, 0x03, 0x97, 0xe1, 0x01,
-		0xce, 0x23, 0xd7, 0xd3, 0x6f, 0x9a, 0xd6, 0xae, 0x2f, 0xac, 0xfc, 0x9b, 0xb3, 0xe2, 0x18, 0x9f,
-		0x10, 0x51, 0x2a, 0x44, 0x76, 0x50, 0x02, 0xe7, 0x0e, 0x7c, 0x30, 0x61, 0xee, 0xe0, 0x6c, 0xf2,
-		0xdc, 0x88, 0x75, 0x73, 0xd0, 0x62, 0x18, 0xb2, 0x7a, 0x86, 0x4e, 0xef, 0xc8, 0xd7, 0xc3, 0x46,
-		0x26, 0x93, 0x3f, 0x2c, 0x41, 0xf1, 0x9a, 0xe9, 0x7a, 0xb6, 0x63, 0x1a, 0x7a, 0x9b, 0x6e, 0xa4,
-		0xbf, 0x6b, 0x6c, 0xef, 0xbf, 0x9a, 0x25, 0x4b, 0x81, 0x2f, 0xaa, 0x3d, 0xe1, 0x80, 0xa7, 0x6f,
-		0xe9, 0x6d, 0xe6, 0x79, 0x87, 0xf5, 0x6e, 0x3f, 0xdb, 0x43, 0xfb, 0xcb, 0x61, 0x2a, 0x0c, 0xb7,
-		0x9c, 0x98, 0x95, 0x94, 0xef, 0x4f, 0x40, 0x89, 0x86, 0x0c, 0x2e, 0x3d, 0x10, 0x46, 0x8f, 0x1c,
-		0x5d, 0x87, 0x94, 0xa3, 0x7b, 0xdc, 0x09, 0xa9, 0x5e, 0x39, 0xf4, 0x4a, 0x64, 0xad, 0x50, 0x1a,
-		0xe8, 0xdd, 0x90, 0xe9, 0xe8, 0x77, 0x34, 0x4a, 0x2f, 0xf1, 0x96, 0xe8, 0x4d, 0x76, 0xf4, 0x3b,
-		0xa4, 0x7f, 0xe8, 0x9b, 0xa0, 0x44, 0x48, 0x1a, 0x7b, 0xba, 0xb5, 0x8b, 0x19, 0xe5, 0xe4, 0x5b,
-		0xa2, 0x5c, 0xe8, 0xe8, 0x77, 0x96, 0x28, 0x35, 0x42, 0x9f, 0x6b, 0xac, 0x5f, 0x92, 0xf8, 0xe9,
-		0x2a, 0xca, 0x18, 0xa4, 0x83, 0x6c, 0xf8, 0x5f, 0xb4, 0x51, 0x71, 0x68, 0xf9, 0xec, 0x30, 0xde,
-		0xf7, 0xb1, 0xb5, 0x5a, 0x20, 0xdd, 0xfb, 0xdc, 0x9b, 0xf3, 0x12, 0x6b, 0xb5, 0x64, 0x0c, 0xb0,
-		0x3d, 0xc7, 0x4e, 0x8d, 0x69, 0x34, 0xb3, 0x9d, 0x18, 0x19, 0x84, 0x16, 0x44, 0x10, 0xca, 0x08,
-		0x02, 0xc3, 0x26, 0xf5, 0x7c, 0x0c, 0x7f, 0x2a, 0x41, 0x6e, 0x39, 0xe4, 0x27, 0xce, 0xc2, 0x64,
-		0xc7, 0xb6, 0xcc, 0x9b, 0xd8, 0xf1, 0x4f, 0x9d, 0xb3, 0x4f, 0x34, 0x07, 0x19, 0xf6, 0x0b, 0x90,
-		0xde, 0xbe, 0xd8, 0x6d, 0x12, 0xdf, 0x04, 0xeb, 0x36, 0xde, 0x76, 0x4d, 0xc1, 0x67, 0x55, 0x7c,
-		0xa2, 0x87, 0x41, 0x76, 0xb1, 0xd1, 0x73, 0x4c, 0x6f, 0x5f, 0x33, 0x6c, 0xcb, 0xd3, 0x0d, 0x8f,
-		0x1f, 0x56, 0x2a, 0x89, 0xf2, 0x25, 0x56, 0x4c, 0x88, 0xb4, 0xb0, 0xa7, 0x9b, 0x6d, 0x76, 0x19,
-		0x3b, 0xab, 0x8a, 0x4f, 0xb4, 0x12, 0xda, 0xfe, 0x4f, 0xfb, 0xbb, 0x13, 0xb1, 0x1c, 0x15, 0xbf,
-		0x37, 0x1f, 0x16, 0x66, 0x1f, 0x99, 0x8f, 0x79, 0x07, 0x32, 0x02, 0x0c, 0x3d, 0x04, 0xa5, 0xae,
-		0x63, 0x53, 0xab, 0xdf, 0x35, 0x0d, 0xad, 0xe7, 0x98, 0x7c, 0xdc, 0x05, 0x5e, 0xbc, 0x69, 0x1a,
-		0x5b, 0x8e, 0x89, 0x1e, 0x05, 0xe4, 0xda, 0x06, 0xbd, 0x08, 0xae, 0x5b, 0xad, 0x36, 0x51, 0xd8,
-		0x26, 0x3b, 0x6b, 0x96, 0x55, 0x65, 0x56, 0x73, 0x8d, 0x56, 0x6c, 0x39, 0xa6, 0xcb, 0xdb, 0xb9,
-		0x3b, 0x19, 0x3e, 0x5b, 0xb4, 0x04, 0xb2, 0xdd, 0xc5, 0x4e, 0x24, 0xe3, 0xc3, 0x96, 0xcf, 0xec,
-		0x6f, 0x7c, 0xe6, 0xb1, 0x19, 0x3e, 0x1e, 0x9e, 0xf3, 0x61, 0xaf, 0x38, 0xaa, 0x25, 0x81, 0x21,
-		0x52, 0x41, 0x2f, 0x47, 0x0e, 0xc6, 0xf7, 0xb6, 0x83, 0x37, 0x99, 0x66, 0x06, 0xa4, 0xa0, 0x62,
-		0xed, 0x57, 0x67, 0x7f, 0x2d, 0x20, 0xcd, 0xe3, 0xc5, 0x4d, 0x7a, 0xd2, 0x28, 0x7c, 0x48, 0x9e,
-		0x92, 0x41, 0xc7, 0x20, 0xfd, 0xaa, 0x6e, 0xb6, 0xc5, 0xaf, 0xfb, 0xaa, 0xfc, 0x0b, 0x95, 0xfd,
-		0x83, 0x9f, 0x29, 0x9a, 0xc1, 0x51, 0x86, 0xb1, 0xbe, 0x6a, 0x5b, 0xad, 0xe8, 0x79, 0x4f, 0xb4,
-		0x04, 0x69, 0xcf, 0xbe, 0x89, 0x2d, 0x3e, 0xa3, 0xd5, 0x47, 0x0e, 0xe1, 0x23, 0xa8, 0x1c, 0x15,
-		0x7d, 0x03, 0xc8, 0x2d, 0xdc, 0xc6, 0xbb, 0x2c, 0x95, 0xb0, 0xa7, 0x3b, 0x98, 0xe5, 0xb4, 0xef,
-		0xc9, 0x03, 0x28, 0xf9, 0xa4, 0x1a, 0x94, 0x12, 0xda, 0x8c, 0x86, 0x4e, 0x93, 0xfe, 0x9d, 0xae,
-		0xd8, 0x31, 0x86, 0x96, 0x4a, 0x58, 0xc2, 0x22, 0xa1, 0xd6, 0xc3, 0x20, 0xf7, 0xac, 0x6d, 0xdb,
-		0xa2, 0x3f, 0x8a, 0xc9, 0x93, 0x58, 0x19, 0x76, 0x59, 0xc2, 0x2f, 0xe7, 0x97, 0x25, 0x36, 0xa1,
-		0x18, 0x80, 0xd2, 0x25, 0x9d, 0x3d, 0xec, 0x92, 0x2e, 0xf8, 0x04, 0x08, 0x08, 0x5a, 0x03, 0x08,
-		0x94, 0x06, 0x4d, 0xb3, 0xe7, 0x86, 0xcf, 0x58, 0xa0, 0x7e, 0xc2, 0x83, 0x09, 0x11, 0x40, 0x16,
-		0x4c, 0x77, 0x4c, 0x4b, 0x73, 0x71, 0x7b, 0x47, 0xe3, 0x9c, 0x23, 0x74, 0x73, 0x94, 0xfd, 0xcf,
-		0x1f, 0x62, 0x36, 0x7f, 0xfb, 0x33, 0x8f, 0x95, 0x02, 0xd7, 0x69, 0xe1, 0xf1, 0xc5, 0x27, 0x9f,
-		0x52, 0xa7, 0x3a, 0xa6, 0xd5, 0xc0, 0xed, 0x9d, 0x65, 0x9f, 0x30, 0x7a, 0x17, 0x9c, 0x0c, 0x18,
-		0x62, 0x5b, 0xda, 0x9e, 0xdd, 0x6e, 0x69, 0x0e, 0xde, 0xd1, 0x0c, 0xea, 0xf8, 0xe5, 0x29, 0x1b,
-		0x8f, 0xfb, 0x20, 0x1b, 0xd6, 0x35, 0xbb, 0xdd, 0x52, 0xf1, 0xce, 0x12, 0xa9, 0x46, 0x67, 0x20,
-		0xe0, 0x86, 0x66, 0xb6, 0xdc, 0xd9, 0xc2, 0x42, 0xf2, 0x5c, 0x4a, 0xcd, 0xfb, 0x85, 0xf5, 0x96,
-		0x5b, 0xce, 0x7c, 0xf0, 0x8d, 0xf9, 0x23, 0x5f, 0x7c, 0x63, 0xfe, 0x88, 0x72, 0x95, 0xbe, 0xda,
-		0xc6, 0x97, 0x16, 0x76, 0xd1, 0x15, 0xc8, 0xea, 0xe2, 0x83, 0x3d, 0x7c, 0x78, 0xc0, 0xd2, 0x0c,
-		0x40, 0x95, 0x4f, 0x49, 0x90, 0x5e, 0xbe, 0xb1, 0xa9, 0x9b, 0x0e, 0xaa, 0x91, 0xc0, 0x48, 0xc8,
-		0xea, 0xb8, 0xab, 0x3c, 0x10, 0x6f, 0xb1, 0xcc, 0xd7, 0x87, 0xa5, 0x87, 0xb3, 0xd5, 0xd3, 0xbf,
-		0xf1, 0x99, 0xc7, 0xee, 0xe7, 0x64, 0x6e, 0xf4, 0x65, 0x8a, 0x05, 0xbd, 0xfe, 0x0c, 0x72, 0x68,
-		0xcc, 0xd7, 0x61, 0x92, 0x75, 0xd5, 0x45, 0x2f, 0xc0, 0x44, 0x97, 0xfc, 0xc1, 0x4f, 0x5c, 0x9f,
-		0x1a, 0x2a, 0xf3, 0x14, 0x3e, 0x2c, 0x21, 0x0c, 0x4f, 0xf9, 0x8e, 0x04, 0xc0, 0xf2, 0x8d, 0x1b,
-		0x4d, 0xc7, 0xec, 0xb6, 0xb1, 0xf7, 0x76, 0x8d, 0x7d, 0x0b, 0x8e, 0x86, 0x32, 0x87, 0x8e, 0x71,
-		0xf8, 0xf1, 0x4f, 0x07, 0x39, 0x44, 0xc7, 0x88, 0x25, 0xdb, 0x72, 0x3d, 0x9f, 0x6c, 0xf2, 0xf0,
-		0x64, 0x97, 0x5d, 0x6f, 0x90, 0xb3, 0x2f, 0x41, 0x2e, 0x60, 0x86, 0x8b, 0xea, 0x90, 0xf1, 0xf8,
-		0xdf, 0x9c, 0xc1, 0xca, 0x70, 0x06, 0x0b, 0xb4, 0x88, 0xd5, 0x12, 0xe8, 0xca, 0x5f, 0x4a, 0x00,
-		0xa1, 0x35, 0xf2, 0xd7, 0x53, 0xc6, 0x48, 0x78, 0xc6, 0x95, 0x73, 0xf2, 0x9e, 0xc3, 0x33, 0x46,
-		0x20, 0xc4, 0xd4, 0xef, 0x4a, 0xc0, 0xf4, 0x96, 0x58, 0xbd, 0x7f, 0xfd, 0x79, 0xb0, 0x05, 0x93,
-		0xd8, 0xf2, 0x1c, 0xd3, 0xbf, 0x31, 0xf0, 0xf8, 0xb0, 0x39, 0x8f, 0x19, 0x54, 0xcd, 0xf2, 0x9c,
-		0xfd, 0xb0, 0x04, 0x08, 0x5a, 0x21, 0x7e, 0x7c, 0x34, 0x09, 0xb3, 0xc3, 0x50, 0xd1, 0x59, 0x28,
-		0x19, 0x0e, 0xa6, 0x05, 0xd1, 0x77, 0x38, 0x8b, 0xa2, 0x98, 0x9b, 0x1d, 0x15, 0x88, 0x67, 0x49,
-		0x84, 0x8b, 0x80, 0xde, 0x9b, 0x2b, 0x59, 0x0c, 0x28, 0x50, 0xc3, 0xd3, 0x84, 0x92, 0x78, 0x2a,
-		0x67, 0x5b, 0x6f, 0xeb, 0x96, 0x21, 0x5c, 0xee, 0x43, 0xd9, 0x7c, 0xf1, 0xdc, 0x4e, 0x95, 0x91,
-		0x40, 0x35, 0x98, 0x14, 0xd4, 0x52, 0x87, 0xa7, 0x26, 0x70, 0xd1, 0x69, 0xc8, 0x87, 0x0d, 0x03,
-		0xf5, 0x46, 0x52, 0x6a, 0x2e, 0x64, 0x17, 0x46, 0x59, 0x9e, 0xf4, 0x81, 0x96, 0x87, 0x3b, 0x7c,
-		0x3f, 0x94, 0x84, 0x29, 0x15, 0xb7, 0xfe, 0xe6, 0x4f, 0xcb, 0x26, 0x00, 0x5b, 0xaa, 0x44, 0x93,
-		0xf2, 0x99, 0xb9, 0x87, 0xf5, 0x9e, 0x65, 0x44, 0x96, 0x5d, 0xef, 0x6b, 0x35, 0x43, 0xbf, 0x93,
-		0x80, 0x7c, 0x78, 0x86, 0xfe, 0x56, 0x1a, 0x2d, 0xb4, 0x1e, 0xa8, 0x29, 0xb6, 0x51, 0xfe, 0xf0,
-		0x30, 0x35, 0x35, 0x20, 0xcd, 0x23, 0xf4, 0xd3, 0x97, 0x92, 0x90, 0xe6, 0x97, 0x6e, 0x37, 0x06,
-		0x7c, 0xdb, 0x91, 0x8f, 0x30, 0x17, 0xc4, 0x3b, 0xd6, 0xb1, 0xae, 0xed, 0x83, 0x50, 0x24, 0x41,
-		0x7d, 0xe4, 0x26, 0xaf, 0x74, 0xae, 0x40, 0x63, 0xf3, 0xe0, 0x60, 0x13, 0x9a, 0x87, 0x1c, 0x01,
-		0x0b, 0xf4, 0x30, 0x81, 0x81, 0x8e, 0x7e, 0xa7, 0xc6, 0x4a, 0xd0, 0x45, 0x40, 0x7b, 0x7e, 0xa6,
-		0x45, 0x0b, 0x18, 0x21, 0x9d, 0x2b, 0xd0, 0x17, 0xc9, 0xa7, 0x82, 0x5a, 0x81, 0x72, 0x3f, 0x00,
-		0xe9, 0x89, 0xc6, 0xb2, 0x92, 0xfc, 0x67, 0xb2, 0x49, 0xc9, 0x32, 0xcd, 0x4c, 0x7e, 0x9b, 0xc4,
-		0xdc, 0xe4, 0xbe, 0xf0, 0x9f, 0x47, 0x29, 0xcd, 0x31, 0x16, 0xc6, 0x9f, 0xbd, 0x39, 0x3f, 0xb7,
-		0xaf, 0x77, 0xda, 0x65, 0x25, 0x86, 0x8e, 0x12, 0x97, 0x91, 0x20, 0xce, 0x73, 0x34, 0x7d, 0x80,
-		0xea, 0x20, 0xdf, 0xc4, 0xfb, 0x9a, 0xc3, 0x7f, 0x49, 0x5e, 0xdb, 0xc1, 0xe2, 0x2d, 0xf4, 0x13,
-		0x8b, 0x31, 0x39, 0xe2, 0xc5, 0x25, 0xdb, 0xb4, 0xf8, 0x16, 0x66, 0xf1, 0x26, 0xde, 0x57, 0x39,
-		0xde, 0x55, 0x8c, 0xcb, 0x0f, 0x90, 0xd5, 0xf2, 0xfa, 0x1f, 0xfc, 0xcc, 0xf9, 0x93, 0xa1, 0x7c,
-		0xe7, 0x1d, 0x3f, 0xb1, 0xc7, 0xa6, 0x98, 0x38, 0xbe, 0x28, 0x30, 0x42, 0xa1, 0xdb, 0xdb, 0x10,
-		0x8a, 0x15, 0xa4, 0x83, 0x63, 0x90, 0x00, 0x3f, 0x12, 0x83, 0x84, 0x96, 0xe8, 0xf3, 0x81, 0x0d,
-		0x48, 0x8c, 0x1a, 0x4d, 0x58, 0x3a, 0x39, 0x12, 0x5d, 0xf9, 0x47, 0x94, 0xff, 0x24, 0xc1, 0x89,
-		0x01, 0x69, 0xf6, 0xbb, 0x6c, 0x00, 0x72, 0x42, 0x95, 0x54, 0x2a, 0xc4, 0x05, 0x9e, 0x7b, 0x5b,
-		0x1c, 0x53, 0xce, 0x80, 0x21, 0x78, 0x7b, 0x8c, 0x19, 0xd7, 0x64, 0xbf, 0x2a, 0xc1, 0x4c, 0xb8,
-		0x03, 0xfe, 0x50, 0x1a, 0x90, 0x0f, 0x37, 0xcd, 0x07, 0xf1, 0xc0, 0x38, 0x83, 0x08, 0xf7, 0x3f,
-		0x42, 0x04, 0xdd, 0x08, 0x34, 0x06, 0x4b, 0x27, 0x5e, 0x1c, 0x9b, 0x29, 0xa2, 0x63, 0xb1, 0x9a,
-		0x83, 0xcd, 0xcd, 0x97, 0x24, 0x48, 0x6d, 0xda, 0x76, 0x1b, 0xbd, 0x17, 0xa6, 0x2c, 0xdb, 0xd3,
-		0xc8, 0xca, 0xc2, 0x2d, 0x8d, 0xa7, 0x0e, 0x98, 0x36, 0xae, 0x1d, 0xc8, 0xab, 0x3f, 0x7c, 0x73,
-		0x7e, 0x10, 0x33, 0x6e, 0xcf, 0xa1, 0x64, 0xd9, 0x5e, 0x95, 0x02, 0x35, 0x59, 0x76, 0x61, 0x07,
-		0x0a, 0xd1, 0xe6, 0x98, 0xc6, 0xae, 0x8c, 0x6a, 0xae, 0x30, 0xb2, 0xa9, 0xfc, 0x76, 0xa8, 0x1d,
-		0xf6, 0xb3, 0x43, 0x7f, 0x42, 0x66, 0xee, 0x9b, 0x40, 0xbe, 0xd1, 0x7f, 0x3d, 0xf4, 0x2a, 0x4c,
-		0x8a, 0xeb, 0xa0, 0xd2, 0xb8, 0x57, 0x4d, 0xc3, 0xfc, 0xe4, 0xc8, 0x34, 0x5f, 0xfb, 0xb9, 0x04,
-		0x9c, 0x58, 0xb2, 0x2d, 0x97, 0x27, 0x7a, 0xf8, 0xaa, 0x66, 0xc9, 0xe5, 0x7d, 0xf4, 0xf0, 0x90,
-		0x34, 0x54, 0x7e, 0x30, 0xd9, 0x74, 0x03, 0x4a, 0xc4, 0xc4, 0x1a, 0xb6, 0xf5, 0x16, 0x73, 0x4d,
-		0x05, 0xbb, 0xdd, 0xe2, 0x3d, 0xba, 0x89, 0xf7, 0x09, 0x5d, 0x0b, 0xdf, 0x8e, 0xd0, 0x4d, 0xde,
-		0x1b, 0x5d, 0x0b, 0xdf, 0x0e, 0xd1, 0x0d, 0xce, 0x0c, 0xa5, 0x22, 0x37, 0x90, 0xae, 0x40, 0x92,
-		0xa8, 0xc2, 0x89, 0x43, 0x28, 0x0f, 0x82, 0x10, 0x32, 0x6b, 0x0d, 0x38, 0xc1, 0x33, 0x05, 0xee,
-		0xc6, 0x0e, 0xe5, 0x28, 0xa6, 0x03, 0x7a, 0x11, 0xef, 0xc7, 0xa4, 0x0d, 0xf2, 0x63, 0xa5, 0x0d,
-		0xce, 0xff, 0xbc, 0x04, 0x10, 0xe4, 0xcc, 0xd0, 0xa3, 0x70, 0xbc, 0xba, 0xb1, 0xbe, 0x1c, 0x5c,
-		0xc6, 0x08, 0x6d, 0xad, 0x8b, 0x53, 0x1a, 0x6e, 0x17, 0x1b, 0xe6, 0x8e, 0x89, 0x5b, 0xe8, 0x21,
-		0x98, 0x89, 0x42, 0x93, 0xaf, 0xda, 0xb2, 0x2c, 0xcd, 0xe5, 0x5f, 0xbf, 0xbb, 0x90, 0x61, 0x31,
-		0x02, 0x6e, 0xa1, 0x73, 0x70, 0x74, 0x10, 0xae, 0xbe, 0xbe, 0x22, 0x27, 0xe6, 0x0a, 0xaf, 0xdf,
-		0x5d, 0xc8, 0xfa, 0xc1, 0x04, 0x52, 0x00, 0x85, 0x21, 0x39, 0xbd, 0xe4, 0x1c, 0xbc, 0x7e, 0x77,
-		0x21, 0xcd, 0x96, 0x0c, 0xbf, 0xc5, 0xf1, 0x8d, 0x00, 0x75, 0x6b, 0xc7, 0xd1, 0x0d, 0xaa, 0x1a,
-		0xe6, 0xe0, 0x58, 0x7d, 0xfd, 0xaa, 0x5a, 0x59, 0x6a, 0xd6, 0x37, 0xd6, 0xfb, 0x4e, 0x04, 0x44,
-		0xeb, 0x96, 0x37, 0xb6, 0xaa, 0xab, 0x35, 0xad, 0x51, 0x5f, 0x59, 0x67, 0x57, 0xae, 0x22, 0x75,
-		0xef, 0x59, 0x6f, 0xd6, 0xd7, 0x6a, 0x72, 0xa2, 0x7a, 0x65, 0xe8, 0x76, 0xdc, 0x7d, 0x91, 0xc5,
-		0x18, 0x98, 0xa3, 0xc8, 0x46, 0xdc, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x2e, 0x6f, 0x47,
-		0xe8, 0xa7, 0x00, 0x00,
+		// 13269 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x90, 0x1b, 0xc9,
+		0x79, 0x18, 0xce, 0x01, 0xb0, 0x78, 0x7c, 0x78, 0xcd, 0xf6, 0x2e, 0x49, 0x70, 0x79, 0xc7, 0x5d,
+		0xce, 0xbd, 0x78, 0xa4, 0x6e, 0x79, 0xe4, 0xdd, 0xf1, 0x8e, 0x7b, 0x92, 0xce, 0xc0, 0x02, 0x5c,
+		0x82, 0xb7, 0x2f, 0x0d, 0xb0, 0xbc, 0x87, 0x1f, 0xa3, 0x59, 0x60, 0x76, 0x17, 0x47, 0x60, 0x06,
+		0xc2, 0x0c, 0x48, 0xae, 0x7e, 0xbf, 0x72, 0xc9, 0xb6, 0xec, 0xc8, 0x94, 0xe5, 0xc8, 0xb1, 0x63,
+		0x9f, 0x2d, 0x53, 0x96, 0xed, 0xd8, 0x92, 0x9f, 0xf1, 0x43, 0x76, 0x2c, 0xa7, 0xf2, 0x70, 0x2a,
+		0xf1, 0x2b, 0x89, 0x23, 0xab, 0x2a, 0x89, 0xca, 0x55, 0xb9, 0x24, 0x92, 0xcb, 0x3a, 0xcb, 0xf2,
+		0x4b, 0x91, 0x13, 0x55, 0xa9, 0x52, 0x4a, 0x7d, 0xfd, 0x98, 0x07, 0x30, 0x58, 0x60, 0x79, 0x92,
+		0x23, 0xdb, 0xf9, 0x67, 0x17, 0xdd, 0xfd, 0x7d, 0x5f, 0x77, 0x7f, 0xfd, 0xf5, 0xd7, 0x5f, 0x7f,
+		0xfd, 0x75, 0x0f, 0x7c, 0xfe, 0xed, 0xb0, 0xb0, 0x6b, 0x59, 0xbb, 0x6d, 0xe3, 0x7c, 0xb7, 0x67,
+		0x39, 0xd6, 0x76, 0x7f, 0xe7, 0x7c, 0xd3, 0xb0, 0x1b, 0xbd, 0x56, 0xd7, 0xb1, 0x7a, 0x8b, 0x34,
+		0x8f, 0xe4, 0x19, 0xc4, 0xa2, 0x80, 0x50, 0xd6, 0x60, 0xfa, 0x4a, 0xab, 0x6d, 0x94, 0x5d, 0xc0,
+		0x9a, 0xe1, 0x90, 0x67, 0x20, 0xb6, 0xd3, 0x6a, 0x1b, 0x05, 0x69, 0x21, 0x7a, 0x26, 0x7d, 0xf1,
+		0xc1, 0xc5, 0x01, 0xa4, 0xc5, 0x20, 0xc6, 0x26, 0x66, 0xab, 0x14, 0x43, 0x79, 0x75, 0x0a, 0x66,
+		0x42, 0x4a, 0x09, 0x81, 0x98, 0xa9, 0x77, 0x90, 0xa2, 0x74, 0x26, 0xa5, 0xd2, 0xdf, 0xa4, 0x00,
+		0x89, 0xae, 0xde, 0xb8, 0xa1, 0xef, 0x1a, 0x85, 0x08, 0xcd, 0x16, 0x49, 0x72, 0x0a, 0xa0, 0x69,
+		0x74, 0x0d, 0xb3, 0x69, 0x98, 0x8d, 0xfd, 0x42, 0x74, 0x21, 0x7a, 0x26, 0xa5, 0xfa, 0x72, 0xc8,
+		0x39, 0x98, 0xee, 0xf6, 0xb7, 0xdb, 0xad, 0x86, 0xe6, 0x03, 0x83, 0x85, 0xe8, 0x99, 0x29, 0x55,
+		0x66, 0x05, 0x65, 0x0f, 0xf8, 0x11, 0xc8, 0xdf, 0x32, 0xf4, 0x1b, 0x7e, 0xd0, 0x34, 0x05, 0xcd,
+		0x61, 0xb6, 0x0f, 0x70, 0x19, 0x32, 0x1d, 0xc3, 0xb6, 0xf5, 0x5d, 0x43, 0x73, 0xf6, 0xbb, 0x46,
+		0x21, 0x46, 0x7b, 0xbf, 0x30, 0xd4, 0xfb, 0xc1, 0x9e, 0xa7, 0x39, 0x56, 0x7d, 0xbf, 0x6b, 0x90,
+		0x22, 0xa4, 0x0c, 0xb3, 0xdf, 0x61, 0x14, 0xa6, 0x46, 0xf0, 0xaf, 0x62, 0xf6, 0x3b, 0x83, 0x54,
+		0x92, 0x88, 0xc6, 0x49, 0x24, 0x6c, 0xa3, 0x77, 0xb3, 0xd5, 0x30, 0x0a, 0x71, 0x4a, 0xe0, 0x91,
+		0x21, 0x02, 0x35, 0x56, 0x3e, 0x48, 0x43, 0xe0, 0x91, 0x65, 0x48, 0x19, 0xb7, 0x1d, 0xc3, 0xb4,
+		0x5b, 0x96, 0x59, 0x48, 0x50, 0x22, 0x0f, 0x85, 0x8c, 0xa2, 0xd1, 0x6e, 0x0e, 0x92, 0xf0, 0xf0,
+		0xc8, 0x25, 0x48, 0x58, 0x5d, 0xa7, 0x65, 0x99, 0x76, 0x21, 0xb9, 0x20, 0x9d, 0x49, 0x5f, 0xbc,
+		0x2f, 0x54, 0x10, 0x36, 0x18, 0x8c, 0x2a, 0x80, 0x49, 0x15, 0x64, 0xdb, 0xea, 0xf7, 0x1a, 0x86,
+		0xd6, 0xb0, 0x9a, 0x86, 0xd6, 0x32, 0x77, 0xac, 0x42, 0x8a, 0x12, 0x98, 0x1f, 0xee, 0x08, 0x05,
+		0x5c, 0xb6, 0x9a, 0x46, 0xd5, 0xdc, 0xb1, 0xd4, 0x9c, 0x1d, 0x48, 0x93, 0x63, 0x10, 0xb7, 0xf7,
+		0x4d, 0x47, 0xbf, 0x5d, 0xc8, 0x50, 0x09, 0xe1, 0x29, 0x72, 0x11, 0x12, 0x46, 0xb3, 0x85, 0xd5,
+		0x15, 0x72, 0x0b, 0xd2, 0x99, 0xdc, 0xc5, 0xc2, 0x30, 0x8f, 0x59, 0xb9, 0x2a, 0x00, 0x95, 0x8f,
+		0xc5, 0x21, 0x3f, 0x89, 0x58, 0x3e, 0x0b, 0x53, 0x3b, 0xc8, 0x99, 0x42, 0xe4, 0x30, 0x7c, 0x63,
+		0x38, 0x41, 0xc6, 0xc7, 0xef, 0x91, 0xf1, 0x45, 0x48, 0x9b, 0x86, 0xed, 0x18, 0x4d, 0x26, 0x45,
+		0xd1, 0x09, 0xe5, 0x10, 0x18, 0xd2, 0xb0, 0x18, 0xc6, 0xee, 0x49, 0x0c, 0x5f, 0x84, 0xbc, 0xdb,
+		0x24, 0xad, 0xa7, 0x9b, 0xbb, 0x42, 0x9e, 0xcf, 0x8f, 0x6b, 0xc9, 0x62, 0x45, 0xe0, 0xa9, 0x88,
+		0xa6, 0xe6, 0x8c, 0x40, 0x9a, 0x94, 0x01, 0x2c, 0xd3, 0xb0, 0x76, 0xb4, 0xa6, 0xd1, 0x68, 0x17,
+		0x92, 0x23, 0xb8, 0xb4, 0x81, 0x20, 0x43, 0x5c, 0xb2, 0x58, 0x6e, 0xa3, 0x4d, 0x2e, 0x7b, 0xe2,
+		0x99, 0x18, 0x21, 0x5d, 0x6b, 0x6c, 0x62, 0x0e, 0x49, 0xe8, 0x16, 0xe4, 0x7a, 0x06, 0xce, 0x15,
+		0xa3, 0xc9, 0x7b, 0x96, 0xa2, 0x8d, 0x58, 0x1c, 0xdb, 0x33, 0x95, 0xa3, 0xb1, 0x8e, 0x65, 0x7b,
+		0xfe, 0x24, 0x79, 0x00, 0xdc, 0x0c, 0x8d, 0x8a, 0x15, 0x50, 0xcd, 0x95, 0x11, 0x99, 0xeb, 0x7a,
+		0xc7, 0x98, 0x7b, 0x27, 0xe4, 0x82, 0xec, 0x21, 0xb3, 0x30, 0x65, 0x3b, 0x7a, 0xcf, 0xa1, 0x52,
+		0x38, 0xa5, 0xb2, 0x04, 0x91, 0x21, 0x6a, 0x98, 0x4d, 0xaa, 0x19, 0xa7, 0x54, 0xfc, 0x49, 0xbe,
+		0xce, 0xeb, 0x70, 0x94, 0x76, 0xf8, 0xe1, 0xe1, 0x11, 0x0d, 0x50, 0x1e, 0xec, 0xf7, 0xdc, 0xd3,
+		0x90, 0x0d, 0x74, 0x60, 0xd2, 0xaa, 0x95, 0x7f, 0x17, 0x83, 0xa3, 0xa1, 0xb4, 0xc9, 0x8b, 0x30,
+		0xdb, 0x37, 0x5b, 0xa6, 0x63, 0xf4, 0xba, 0x3d, 0x03, 0x45, 0x96, 0xd5, 0x55, 0xf8, 0x4c, 0x62,
+		0x84, 0xd0, 0x6d, 0xf9, 0xa1, 0x19, 0x15, 0x75, 0xa6, 0x3f, 0x9c, 0x49, 0x5e, 0x82, 0x34, 0xca,
+		0x87, 0xde, 0xd3, 0x29, 0x41, 0x36, 0x1b, 0x2f, 0x4e, 0xd6, 0xe5, 0xc5, 0xb2, 0x87, 0x59, 0x8a,
+		0xbe, 0x47, 0x8a, 0xa8, 0x7e, 0x5a, 0xe4, 0x69, 0x48, 0xee, 0x18, 0xba, 0xd3, 0xef, 0x19, 0x76,
+		0xe1, 0x22, 0x65, 0xe5, 0xc9, 0xe1, 0x49, 0xca, 0x00, 0x6a, 0x86, 0xa3, 0xba, 0xc0, 0xa4, 0x03,
+		0x99, 0x9b, 0x46, 0xaf, 0xb5, 0xd3, 0x6a, 0xb0, 0x46, 0x45, 0xa9, 0xf2, 0x79, 0x66, 0xc2, 0x46,
+		0x5d, 0xf7, 0xa1, 0xd6, 0x1c, 0xdd, 0x31, 0x96, 0x60, 0x6b, 0xfd, 0x7a, 0x45, 0xad, 0x5e, 0xa9,
+		0x56, 0xca, 0xac, 0x99, 0x01, 0xf2, 0x73, 0xdf, 0x27, 0x41, 0xda, 0xd7, 0x13, 0x54, 0x87, 0x66,
+		0xbf, 0xb3, 0x6d, 0xf4, 0xf8, 0x78, 0xf1, 0x14, 0x39, 0x09, 0xa9, 0x9d, 0x7e, 0xbb, 0xcd, 0x84,
+		0x8e, 0xad, 0xa5, 0x49, 0xcc, 0x40, 0x81, 0x43, 0x1d, 0xc7, 0xd5, 0x08, 0xd5, 0x71, 0xf8, 0x9b,
+		0xcc, 0x41, 0x52, 0x08, 0x65, 0x61, 0x6a, 0x41, 0x3a, 0x93, 0x54, 0xdd, 0x34, 0x2b, 0xeb, 0x1a,
+		0xba, 0x63, 0x34, 0x0b, 0x71, 0x51, 0xc6, 0xd2, 0xd7, 0x62, 0xc9, 0x98, 0x3c, 0xa5, 0x3c, 0x09,
+		0xd3, 0x43, 0x5d, 0x21, 0x79, 0x48, 0x97, 0x2b, 0xcb, 0xab, 0x45, 0xb5, 0x58, 0xaf, 0x6e, 0xac,
+		0xcb, 0x47, 0x48, 0x0e, 0x7c, 0xbd, 0x93, 0xa5, 0xb3, 0xa9, 0xe4, 0xeb, 0x09, 0xf9, 0x5d, 0xef,
+		0x7a, 0xd7, 0xbb, 0x22, 0xca, 0x6f, 0xc4, 0x61, 0x36, 0x4c, 0x09, 0x86, 0xea, 0x63, 0xaf, 0xd3,
+		0xd1, 0x40, 0xa7, 0x8b, 0x30, 0xd5, 0xd6, 0xb7, 0x8d, 0x76, 0x21, 0x46, 0x07, 0xe1, 0xdc, 0x44,
+		0x6a, 0x76, 0x71, 0x15, 0x51, 0x54, 0x86, 0x49, 0xde, 0xca, 0x59, 0x33, 0x45, 0x29, 0x9c, 0x9d,
+		0x8c, 0x02, 0x2a, 0x47, 0xce, 0xc6, 0x93, 0x90, 0xc2, 0xff, 0x8c, 0xef, 0x71, 0xc6, 0x77, 0xcc,
+		0xa0, 0x7c, 0x9f, 0x83, 0x24, 0xd5, 0x7b, 0x4d, 0xc3, 0x1d, 0x13, 0x91, 0x46, 0x4d, 0xd1, 0x34,
+		0x76, 0xf4, 0x7e, 0xdb, 0xd1, 0x6e, 0xea, 0xed, 0xbe, 0x41, 0x35, 0x58, 0x4a, 0xcd, 0xf0, 0xcc,
+		0xeb, 0x98, 0x47, 0xe6, 0x21, 0xcd, 0xd4, 0x64, 0xcb, 0x6c, 0x1a, 0xb7, 0xe9, 0x12, 0x3a, 0xa5,
+		0x32, 0xcd, 0x59, 0xc5, 0x1c, 0xac, 0xfe, 0x15, 0xdb, 0x32, 0x85, 0xae, 0xa1, 0x55, 0x60, 0x06,
+		0xad, 0xfe, 0xe9, 0xc1, 0xd5, 0xfb, 0xfe, 0xf0, 0xee, 0x0d, 0x29, 0xc7, 0x47, 0x20, 0x4f, 0x21,
+		0x9e, 0xe0, 0x53, 0x59, 0x6f, 0x17, 0xa6, 0xa9, 0x18, 0xe4, 0x58, 0xf6, 0x06, 0xcf, 0x55, 0x7e,
+		0x35, 0x02, 0x31, 0xba, 0x52, 0xe4, 0x21, 0x5d, 0x7f, 0x69, 0xb3, 0xa2, 0x95, 0x37, 0xb6, 0x4a,
+		0xab, 0x15, 0x59, 0xc2, 0xa1, 0xa7, 0x19, 0x57, 0x56, 0x37, 0x8a, 0x75, 0x39, 0xe2, 0xa6, 0xab,
+		0xeb, 0xf5, 0x4b, 0x4f, 0xca, 0x51, 0x17, 0x61, 0x8b, 0x65, 0xc4, 0xfc, 0x00, 0x4f, 0x5c, 0x94,
+		0xa7, 0x88, 0x0c, 0x19, 0x46, 0xa0, 0xfa, 0x62, 0xa5, 0x7c, 0xe9, 0x49, 0x39, 0x1e, 0xcc, 0x79,
+		0xe2, 0xa2, 0x9c, 0x20, 0x59, 0x48, 0xd1, 0x9c, 0xd2, 0xc6, 0xc6, 0xaa, 0x9c, 0x74, 0x69, 0xd6,
+		0xea, 0x6a, 0x75, 0x7d, 0x45, 0x4e, 0xb9, 0x34, 0x57, 0xd4, 0x8d, 0xad, 0x4d, 0x19, 0x5c, 0x0a,
+		0x6b, 0x95, 0x5a, 0xad, 0xb8, 0x52, 0x91, 0xd3, 0x2e, 0x44, 0xe9, 0xa5, 0x7a, 0xa5, 0x26, 0x67,
+		0x02, 0xcd, 0x7a, 0xe2, 0xa2, 0x9c, 0x75, 0xab, 0xa8, 0xac, 0x6f, 0xad, 0xc9, 0x39, 0x32, 0x0d,
+		0x59, 0x56, 0x85, 0x68, 0x44, 0x7e, 0x20, 0xeb, 0xd2, 0x93, 0xb2, 0xec, 0x35, 0x84, 0x51, 0x99,
+		0x0e, 0x64, 0x5c, 0x7a, 0x52, 0x26, 0xca, 0x32, 0x4c, 0x51, 0x31, 0x24, 0x04, 0x72, 0xab, 0xc5,
+		0x52, 0x65, 0x55, 0xdb, 0xd8, 0xc4, 0x49, 0x53, 0x5c, 0x95, 0x25, 0x2f, 0x4f, 0xad, 0x6c, 0x56,
+		0x8a, 0xf5, 0x4a, 0x59, 0x8e, 0xfa, 0xf3, 0xde, 0xb6, 0x55, 0x55, 0x2b, 0x65, 0x39, 0xa2, 0x34,
+		0x60, 0x36, 0x6c, 0x85, 0x0c, 0x9d, 0x42, 0x3e, 0x59, 0x88, 0x8c, 0x90, 0x05, 0x4a, 0x6b, 0x50,
+		0x16, 0x94, 0x4f, 0x47, 0x60, 0x26, 0xc4, 0x4a, 0x08, 0xad, 0xe4, 0x39, 0x98, 0x62, 0xb2, 0xcc,
+		0x34, 0xf5, 0xa3, 0xa1, 0xe6, 0x06, 0x95, 0xec, 0x21, 0xdb, 0x89, 0xe2, 0xf9, 0xed, 0xcd, 0xe8,
+		0x08, 0x7b, 0x13, 0x49, 0x0c, 0x09, 0xec, 0x37, 0x0e, 0xad, 0xe6, 0xcc, 0xe0, 0xb9, 0x34, 0x89,
+		0xc1, 0x43, 0xf3, 0x0e, 0xb7, 0xaa, 0x4f, 0x85, 0xac, 0xea, 0xcf, 0xc2, 0xf4, 0x10, 0xa1, 0x89,
+		0x57, 0xd7, 0x6f, 0x93, 0xa0, 0x30, 0x8a, 0x39, 0x63, 0x54, 0x62, 0x24, 0xa0, 0x12, 0x9f, 0x1d,
+		0xe4, 0xe0, 0xe9, 0xd1, 0x83, 0x30, 0x34, 0xd6, 0x1f, 0x96, 0xe0, 0x58, 0xf8, 0xbe, 0x22, 0xb4,
+		0x0d, 0x6f, 0x85, 0x78, 0xc7, 0x70, 0xf6, 0x2c, 0x61, 0x27, 0x3f, 0x1c, 0x62, 0x7d, 0x61, 0xf1,
+		0xe0, 0x60, 0x73, 0x2c, 0x72, 0x79, 0xb0, 0xad, 0xf3, 0xa3, 0x76, 0x39, 0x43, 0x2d, 0xfd, 0xce,
+		0x08, 0x1c, 0x0d, 0x25, 0x1e, 0xda, 0xd0, 0xfb, 0x01, 0x5a, 0x66, 0xb7, 0xef, 0x30, 0x5b, 0x98,
+		0x69, 0xe2, 0x14, 0xcd, 0xa1, 0xca, 0x0b, 0xb5, 0x6c, 0xdf, 0x71, 0xcb, 0xd9, 0x2a, 0x09, 0x2c,
+		0x8b, 0x02, 0x3c, 0xe3, 0x35, 0x34, 0x46, 0x1b, 0x7a, 0x6a, 0x44, 0x4f, 0x87, 0x04, 0xf3, 0x71,
+		0x90, 0x1b, 0xed, 0x96, 0x61, 0x3a, 0x9a, 0xed, 0xf4, 0x0c, 0xbd, 0xd3, 0x32, 0x77, 0xd9, 0x6a,
+		0xbb, 0x34, 0xb5, 0xa3, 0xb7, 0x6d, 0x43, 0xcd, 0xb3, 0xe2, 0x9a, 0x28, 0x45, 0x0c, 0x2a, 0x40,
+		0x3d, 0x1f, 0x46, 0x3c, 0x80, 0xc1, 0x8a, 0x5d, 0x0c, 0xe5, 0xe7, 0x52, 0x90, 0xf6, 0xed, 0xc2,
+		0xc8, 0x69, 0xc8, 0xbc, 0xa2, 0xdf, 0xd4, 0x35, 0xb1, 0xb3, 0x66, 0x9c, 0x48, 0x63, 0xde, 0x26,
+		0xcb, 0x22, 0x8f, 0xc3, 0x2c, 0x05, 0xb1, 0xfa, 0x8e, 0xd1, 0xd3, 0x1a, 0x6d, 0xdd, 0xb6, 0x29,
+		0xd3, 0x92, 0x14, 0x94, 0x60, 0xd9, 0x06, 0x16, 0x2d, 0x8b, 0x12, 0xf2, 0x14, 0xcc, 0x50, 0x8c,
+		0x4e, 0xbf, 0xed, 0xb4, 0xba, 0x6d, 0x43, 0xc3, 0xbd, 0xbe, 0x5d, 0x00, 0x7f, 0xcb, 0xa6, 0x11,
+		0x62, 0x8d, 0x03, 0x60, 0x8b, 0x6c, 0x52, 0x86, 0xfb, 0x29, 0xda, 0xae, 0x61, 0x1a, 0x3d, 0xdd,
+		0x31, 0x34, 0xe3, 0x1d, 0x7d, 0xbd, 0x6d, 0x6b, 0xba, 0xd9, 0xd4, 0xf6, 0x74, 0x7b, 0xaf, 0x30,
+		0x8b, 0x04, 0x4a, 0x91, 0x82, 0xa4, 0x9e, 0x40, 0xc0, 0x15, 0x0e, 0x57, 0xa1, 0x60, 0x45, 0xb3,
+		0x79, 0x55, 0xb7, 0xf7, 0xc8, 0x12, 0x1c, 0xa3, 0x54, 0x6c, 0xa7, 0xd7, 0x32, 0x77, 0xb5, 0xc6,
+		0x9e, 0xd1, 0xb8, 0xa1, 0xf5, 0x9d, 0x9d, 0x67, 0x0a, 0x27, 0xfd, 0xf5, 0xd3, 0x16, 0xd6, 0x28,
+		0xcc, 0x32, 0x82, 0x6c, 0x39, 0x3b, 0xcf, 0x90, 0x1a, 0x64, 0x70, 0x30, 0x3a, 0xad, 0x77, 0x1a,
+		0xda, 0x8e, 0xd5, 0xa3, 0x6b, 0x68, 0x2e, 0x44, 0x35, 0xf9, 0x38, 0xb8, 0xb8, 0xc1, 0x11, 0xd6,
+		0xac, 0xa6, 0xb1, 0x34, 0x55, 0xdb, 0xac, 0x54, 0xca, 0x6a, 0x5a, 0x50, 0xb9, 0x62, 0xf5, 0x50,
+		0xa0, 0x76, 0x2d, 0x97, 0xc1, 0x69, 0x26, 0x50, 0xbb, 0x96, 0x60, 0xef, 0x53, 0x30, 0xd3, 0x68,
+		0xb0, 0x3e, 0xb7, 0x1a, 0x1a, 0xdf, 0x91, 0xdb, 0x05, 0x39, 0xc0, 0xac, 0x46, 0x63, 0x85, 0x01,
+		0x70, 0x19, 0xb7, 0xc9, 0x65, 0x38, 0xea, 0x31, 0xcb, 0x8f, 0x38, 0x3d, 0xd4, 0xcb, 0x41, 0xd4,
+		0xa7, 0x60, 0xa6, 0xbb, 0x3f, 0x8c, 0x48, 0x02, 0x35, 0x76, 0xf7, 0x07, 0xd1, 0x1e, 0xa2, 0x5e,
+		0x96, 0x9e, 0xd1, 0xa0, 0xa6, 0xde, 0x71, 0x3f, 0xb4, 0xaf, 0x80, 0x2c, 0x82, 0xdc, 0x68, 0x68,
+		0x86, 0xa9, 0x6f, 0xb7, 0x0d, 0x4d, 0xef, 0x19, 0xa6, 0x6e, 0x17, 0xe6, 0x29, 0x70, 0xcc, 0xe9,
+		0xf5, 0x0d, 0x35, 0xd7, 0x68, 0x54, 0x68, 0x61, 0x91, 0x96, 0x91, 0xb3, 0x30, 0x6d, 0x6d, 0xbf,
+		0xd2, 0x60, 0x82, 0xa5, 0x75, 0x7b, 0xc6, 0x4e, 0xeb, 0x76, 0xe1, 0x41, 0xca, 0xa5, 0x3c, 0x16,
+		0x50, 0xb1, 0xda, 0xa4, 0xd9, 0xe4, 0x51, 0x90, 0x1b, 0xf6, 0x9e, 0xde, 0xeb, 0x52, 0xcd, 0x6a,
+		0x77, 0xf5, 0x86, 0x51, 0x78, 0x88, 0x81, 0xb2, 0xfc, 0x75, 0x91, 0x8d, 0x82, 0x6d, 0xdf, 0x6a,
+		0xed, 0x38, 0x82, 0xe2, 0x23, 0x4c, 0xb0, 0x69, 0x1e, 0xa7, 0x76, 0x06, 0xe4, 0xee, 0x5e, 0x37,
+		0x58, 0xf1, 0x19, 0x0a, 0x96, 0xeb, 0xee, 0x75, 0xfd, 0xf5, 0x3e, 0x00, 0xd9, 0xee, 0x9e, 0xbf,
+		0xd2, 0x47, 0x99, 0xfd, 0xd5, 0xdd, 0xf3, 0xd5, 0xf8, 0x24, 0x1c, 0x43, 0xa0, 0x8e, 0xe1, 0xe8,
+		0x4d, 0xdd, 0xd1, 0x7d, 0xd0, 0x6f, 0xa2, 0xd0, 0xb3, 0xdd, 0xbd, 0xee, 0x1a, 0x2f, 0x0c, 0xb4,
+		0xb3, 0xd7, 0xdf, 0xde, 0x77, 0xe5, 0xe3, 0x31, 0xd6, 0x4e, 0xcc, 0x13, 0x12, 0x72, 0xcf, 0xdb,
+		0x8f, 0xaf, 0xda, 0x66, 0x4b, 0x59, 0x82, 0x8c, 0x5f, 0xee, 0x49, 0x0a, 0x98, 0xe4, 0xcb, 0x12,
+		0x1a, 0x41, 0xcb, 0x1b, 0x65, 0x34, 0x5f, 0x5e, 0xae, 0xc8, 0x11, 0x34, 0xa3, 0x56, 0xab, 0xf5,
+		0x8a, 0xa6, 0x6e, 0xad, 0xd7, 0xab, 0x6b, 0x15, 0x39, 0xea, 0x33, 0xec, 0xaf, 0xc5, 0x92, 0x67,
+		0xe5, 0x73, 0xd7, 0x62, 0xc9, 0x87, 0xe5, 0x47, 0x28, 0x7b, 0x86, 0x84, 0x52, 0xf9, 0x42, 0x14,
+		0x72, 0xc1, 0x6d, 0x39, 0x79, 0x33, 0x1c, 0x17, 0x7e, 0x37, 0xdb, 0x70, 0xb4, 0x5b, 0xad, 0x1e,
+		0x9d, 0xac, 0x1d, 0x9d, 0x2d, 0x9c, 0xae, 0x50, 0xce, 0x72, 0xa8, 0x9a, 0xe1, 0xbc, 0xd0, 0xea,
+		0xe1, 0x54, 0xec, 0xe8, 0x0e, 0x59, 0x85, 0x79, 0xd3, 0xd2, 0x6c, 0x47, 0x37, 0x9b, 0x7a, 0xaf,
+		0xa9, 0x79, 0x1e, 0x4f, 0x4d, 0x6f, 0x34, 0x0c, 0xdb, 0xb6, 0xd8, 0x22, 0xe9, 0x52, 0xb9, 0xcf,
+		0xb4, 0x6a, 0x1c, 0xd8, 0x5b, 0x3d, 0x8a, 0x1c, 0x74, 0x60, 0x4e, 0x44, 0x47, 0xcd, 0x89, 0x93,
+		0x90, 0xea, 0xe8, 0x5d, 0xcd, 0x30, 0x9d, 0xde, 0x3e, 0xb5, 0xdd, 0x93, 0x6a, 0xb2, 0xa3, 0x77,
+		0x2b, 0x98, 0x26, 0xd7, 0xe1, 0x61, 0x0f, 0x54, 0x6b, 0x1b, 0xbb, 0x7a, 0x63, 0x5f, 0xa3, 0x86,
+		0x3a, 0xf5, 0x11, 0x69, 0x0d, 0xcb, 0xdc, 0x69, 0xb7, 0x1a, 0x8e, 0x5d, 0x48, 0xbb, 0xfa, 0x4f,
+		0xf1, 0x30, 0x56, 0x29, 0xc2, 0x35, 0xdb, 0x32, 0xa9, 0x7d, 0xbe, 0x2c, 0xa0, 0x03, 0x62, 0x93,
+		0xf9, 0x9a, 0x10, 0x9b, 0xe0, 0xd0, 0xc7, 0xe4, 0xa9, 0x6b, 0xb1, 0xe4, 0x94, 0x1c, 0xbf, 0x16,
+		0x4b, 0xc6, 0xe5, 0xc4, 0xb5, 0x58, 0x32, 0x29, 0xa7, 0xae, 0xc5, 0x92, 0x29, 0x19, 0x94, 0xbb,
+		0x59, 0xc8, 0xf8, 0xb7, 0x1b, 0xb8, 0x7b, 0x6b, 0xd0, 0x05, 0x57, 0xa2, 0x2a, 0xf9, 0x81, 0x03,
+		0x37, 0x27, 0x8b, 0xcb, 0xb8, 0x12, 0x2f, 0xc5, 0x99, 0x6d, 0xaf, 0x32, 0x4c, 0xb4, 0x82, 0x70,
+		0x92, 0x19, 0xcc, 0x96, 0x4a, 0xaa, 0x3c, 0x45, 0x56, 0x20, 0xfe, 0x8a, 0x4d, 0x69, 0xc7, 0x29,
+		0xed, 0x07, 0x0f, 0xa6, 0x7d, 0xad, 0x46, 0x89, 0xa7, 0xae, 0xd5, 0xb4, 0xf5, 0x0d, 0x75, 0xad,
+		0xb8, 0xaa, 0x72, 0x74, 0x72, 0x02, 0x62, 0x6d, 0xfd, 0x9d, 0xfb, 0xc1, 0x35, 0x9b, 0x66, 0x91,
+		0x45, 0xc8, 0xf7, 0x4d, 0xb6, 0x57, 0xc7, 0x31, 0x46, 0xa8, 0xbc, 0x1f, 0x2a, 0xe7, 0x95, 0xae,
+		0x22, 0xfc, 0x84, 0x72, 0x75, 0x02, 0x62, 0xe8, 0x94, 0x0e, 0xae, 0xac, 0x34, 0x8b, 0x9c, 0x81,
+		0x4c, 0xd3, 0xd8, 0xee, 0xef, 0x6a, 0x3d, 0xa3, 0xa9, 0x37, 0x9c, 0xe0, 0x7a, 0x92, 0xa6, 0x45,
+		0x2a, 0x2d, 0x21, 0xcf, 0x43, 0x0a, 0xc7, 0xc8, 0xa4, 0x63, 0x3c, 0x4d, 0x59, 0xf0, 0xd8, 0xc1,
+		0x2c, 0xe0, 0x43, 0x2c, 0x90, 0x54, 0x0f, 0x9f, 0x5c, 0x85, 0x84, 0xa3, 0xf7, 0x76, 0x0d, 0xc7,
+		0x2e, 0xcc, 0x2c, 0x44, 0xcf, 0xe4, 0x2e, 0x2e, 0x4e, 0x42, 0xaa, 0x4e, 0x51, 0xe8, 0x4e, 0x59,
+		0xa0, 0x93, 0x17, 0x40, 0xe6, 0xae, 0x58, 0x8d, 0x6f, 0x73, 0xed, 0xc2, 0x2c, 0x15, 0xc0, 0x37,
+		0x1d, 0x4c, 0x92, 0x7b, 0x72, 0xcb, 0x0c, 0x49, 0xcd, 0x1b, 0x81, 0x74, 0x70, 0x5e, 0x1c, 0x3d,
+		0xcc, 0xbc, 0xd8, 0x82, 0x3c, 0xff, 0xad, 0xd9, 0xfd, 0x6e, 0xd7, 0xea, 0x39, 0x85, 0x63, 0x0b,
+		0xd2, 0xf8, 0x06, 0x09, 0x62, 0x0c, 0x47, 0xcd, 0xed, 0x04, 0xd2, 0x5f, 0xbd, 0xe9, 0x36, 0xf7,
+		0x32, 0xe4, 0x82, 0xcc, 0xf0, 0x3b, 0xc2, 0xa3, 0x13, 0x3a, 0xc2, 0x71, 0x5b, 0x22, 0x36, 0x6a,
+		0xb8, 0x34, 0xb1, 0xc4, 0xdc, 0xf7, 0x47, 0x20, 0x17, 0xec, 0x18, 0x59, 0x01, 0x22, 0x46, 0xac,
+		0x65, 0x3a, 0x3d, 0xab, 0xd9, 0x6f, 0x18, 0xcd, 0x82, 0x34, 0xa6, 0x9e, 0x69, 0x8e, 0x53, 0x75,
+		0x51, 0xfc, 0x84, 0x7c, 0xb3, 0x20, 0x32, 0x21, 0xa1, 0xb2, 0x37, 0x3f, 0xce, 0xc3, 0x8c, 0x20,
+		0x80, 0xc4, 0x6e, 0xe9, 0x3d, 0x13, 0x4d, 0x64, 0x66, 0xb4, 0x13, 0x5f, 0xd1, 0x0b, 0xac, 0x84,
+		0x14, 0x41, 0x88, 0x8b, 0xd6, 0x33, 0x3a, 0x16, 0xfa, 0xbb, 0x62, 0x63, 0xaa, 0xcd, 0x71, 0x04,
+		0x95, 0xc1, 0x2b, 0xe7, 0x61, 0x8a, 0xaa, 0x1f, 0x02, 0xc0, 0x15, 0x90, 0x7c, 0x84, 0x24, 0x21,
+		0xb6, 0xbc, 0xa1, 0xe2, 0xf2, 0x28, 0x43, 0x86, 0xe5, 0x6a, 0x9b, 0xd5, 0xca, 0x72, 0x45, 0x8e,
+		0x28, 0x4f, 0x41, 0x9c, 0xe9, 0x14, 0x5c, 0x3a, 0x5d, 0xad, 0x22, 0x1f, 0xe1, 0x49, 0x4e, 0x43,
+		0x12, 0xa5, 0x5b, 0x6b, 0xa5, 0x8a, 0x2a, 0x47, 0x94, 0x2d, 0xc8, 0x0f, 0xcc, 0x43, 0x72, 0x14,
+		0xa6, 0xd5, 0x4a, 0xbd, 0xb2, 0x8e, 0xce, 0x01, 0x6d, 0x6b, 0xfd, 0xf9, 0xf5, 0x8d, 0x17, 0xd0,
+		0xb3, 0x16, 0xc8, 0x16, 0xeb, 0xb0, 0x44, 0x66, 0x41, 0xf6, 0xb2, 0x6b, 0x1b, 0x5b, 0x2a, 0x6d,
+		0xcd, 0x77, 0x45, 0x40, 0x1e, 0x9c, 0x94, 0xe4, 0x38, 0xcc, 0xd4, 0x8b, 0xea, 0x4a, 0xa5, 0xae,
+		0x31, 0x87, 0x87, 0x4b, 0x7a, 0x16, 0x64, 0x7f, 0xc1, 0x95, 0x2a, 0xf5, 0xe7, 0xcc, 0xc3, 0x49,
+		0x7f, 0x6e, 0xe5, 0xc5, 0x7a, 0x65, 0xbd, 0x46, 0x2b, 0x2f, 0xae, 0xaf, 0xa0, 0x51, 0x30, 0x40,
+		0x4f, 0xb8, 0x58, 0xa2, 0xd8, 0xd4, 0x20, 0xbd, 0xca, 0x6a, 0x59, 0x8e, 0x0d, 0x66, 0x6f, 0xac,
+		0x57, 0x36, 0xae, 0xc8, 0x53, 0x83, 0xb5, 0x53, 0xb7, 0x4b, 0x9c, 0xcc, 0xc1, 0xb1, 0xc1, 0x5c,
+		0xad, 0xb2, 0x5e, 0x57, 0x5f, 0x92, 0x13, 0x83, 0x15, 0xd7, 0x2a, 0xea, 0xf5, 0xea, 0x72, 0x45,
+		0x4e, 0x92, 0x63, 0x40, 0x82, 0x2d, 0xaa, 0x5f, 0xdd, 0x28, 0xcb, 0xa9, 0xb0, 0x15, 0x8b, 0xc8,
+		0x33, 0xca, 0xcf, 0x4a, 0x90, 0xf1, 0xbb, 0x40, 0x02, 0x4a, 0x45, 0xfa, 0x5a, 0x5b, 0x6c, 0x95,
+		0xdf, 0x8f, 0x40, 0xda, 0xe7, 0x0b, 0xc1, 0x4d, 0xac, 0xde, 0x6e, 0x5b, 0xb7, 0x34, 0xbd, 0xdd,
+		0xd2, 0x6d, 0xbe, 0x1e, 0x02, 0xcd, 0x2a, 0x62, 0xce, 0xa4, 0xeb, 0xcf, 0xe4, 0xa6, 0x4b, 0xfc,
+		0x9e, 0x4d, 0x97, 0xc4, 0xd7, 0xa0, 0xe9, 0x32, 0x25, 0xc7, 0x95, 0x4f, 0x46, 0x40, 0x1e, 0xf4,
+		0x8e, 0x0c, 0xf0, 0x4d, 0x1a, 0xc5, 0x37, 0x7f, 0xff, 0x22, 0x87, 0xe9, 0xdf, 0xe0, 0xaa, 0x1e,
+		0x1d, 0xb9, 0xaa, 0x87, 0x2c, 0x56, 0xb1, 0xaf, 0xe5, 0xc5, 0xca, 0x2f, 0xae, 0xff, 0x51, 0x82,
+		0x5c, 0xd0, 0x99, 0x13, 0xe0, 0x98, 0x72, 0x18, 0x8e, 0x05, 0x47, 0xe4, 0xf4, 0xa8, 0x11, 0xf9,
+		0x6b, 0xe9, 0xd7, 0x0f, 0x46, 0x21, 0x1b, 0xf0, 0xfd, 0x4c, 0xda, 0xba, 0x77, 0xc0, 0x74, 0xab,
+		0x69, 0x74, 0xba, 0x96, 0x83, 0x91, 0x07, 0x5a, 0xdb, 0xb8, 0x69, 0xb4, 0x29, 0x1b, 0x72, 0x21,
+		0xa7, 0xab, 0x81, 0x1a, 0x16, 0xab, 0x1e, 0xde, 0x2a, 0xa2, 0x2d, 0xcd, 0x54, 0xcb, 0x95, 0xb5,
+		0xcd, 0x8d, 0x7a, 0x65, 0x7d, 0xf9, 0x25, 0xa1, 0xc9, 0x55, 0xb9, 0x35, 0x00, 0x16, 0x60, 0xf8,
+		0x03, 0x5f, 0x1b, 0x9b, 0xce, 0x4d, 0x90, 0x07, 0x7b, 0x83, 0x0a, 0x3d, 0xa4, 0x3f, 0xf2, 0x11,
+		0x32, 0x03, 0xf9, 0xf5, 0x0d, 0xad, 0x56, 0x2d, 0x57, 0xb4, 0xca, 0x95, 0x2b, 0x95, 0xe5, 0x7a,
+		0x8d, 0x1d, 0x34, 0xb8, 0xd0, 0x75, 0x39, 0xe2, 0x1f, 0x9b, 0x1f, 0x8a, 0xc2, 0x4c, 0x48, 0x4b,
+		0x48, 0x91, 0xbb, 0x08, 0x99, 0xd7, 0xf2, 0xb1, 0x49, 0x5a, 0xbf, 0x88, 0xbb, 0xfb, 0x4d, 0xbd,
+		0xe7, 0x70, 0x8f, 0xe2, 0xa3, 0x80, 0xec, 0x35, 0x1d, 0x34, 0xef, 0x7b, 0xfc, 0x00, 0x87, 0x99,
+		0x20, 0x79, 0x2f, 0x9f, 0x9d, 0xe1, 0xbc, 0x09, 0x48, 0xd7, 0xb2, 0x5b, 0x4e, 0xeb, 0x26, 0x06,
+		0x42, 0x88, 0xd3, 0x1e, 0x9c, 0xb8, 0x31, 0x55, 0x16, 0x25, 0x55, 0xd3, 0x71, 0xa1, 0x4d, 0x63,
+		0x57, 0x1f, 0x80, 0xc6, 0xed, 0x47, 0x54, 0x95, 0x45, 0x89, 0x0b, 0x7d, 0x1a, 0x32, 0x4d, 0xab,
+		0x8f, 0x5e, 0x19, 0x06, 0x87, 0x2a, 0x59, 0x52, 0xd3, 0x2c, 0xcf, 0x05, 0xe1, 0x6e, 0x33, 0xef,
+		0x98, 0x29, 0xa3, 0xa6, 0x59, 0x1e, 0x03, 0x79, 0x04, 0xf2, 0xfa, 0xee, 0x6e, 0x0f, 0x89, 0x0b,
+		0x42, 0xcc, 0x11, 0x98, 0x73, 0xb3, 0x29, 0xe0, 0xdc, 0x35, 0x48, 0x0a, 0x3e, 0xe0, 0xfe, 0x17,
+		0x39, 0xa1, 0x75, 0x99, 0x77, 0x3b, 0x82, 0x27, 0x4f, 0xa6, 0x28, 0x3c, 0x0d, 0x99, 0x96, 0xad,
+		0x79, 0x61, 0x10, 0x91, 0x85, 0xc8, 0x99, 0xa4, 0x9a, 0x6e, 0xd9, 0xee, 0xa9, 0xa8, 0xf2, 0x73,
+		0x69, 0x00, 0x4f, 0xd8, 0xc8, 0xf7, 0x48, 0x90, 0x63, 0x0b, 0x4c, 0xb7, 0x67, 0xd8, 0x86, 0xd9,
+		0x10, 0xdb, 0xc2, 0x47, 0x0f, 0x10, 0x51, 0xa6, 0xe6, 0x36, 0x39, 0x42, 0xe9, 0xb9, 0xf7, 0x48,
+		0xd2, 0xab, 0x52, 0xec, 0x55, 0x49, 0xfa, 0x31, 0x29, 0x4b, 0x92, 0x95, 0x17, 0x37, 0x57, 0xab,
+		0xcb, 0xd5, 0x7a, 0xe1, 0x8f, 0x12, 0x34, 0x5d, 0x5d, 0xe3, 0xe9, 0xcf, 0x24, 0x82, 0xe5, 0xaf,
+		0x27, 0x7e, 0x49, 0x8a, 0x26, 0x5f, 0x4f, 0xa8, 0xd9, 0x1d, 0x3f, 0x3d, 0xd2, 0xf6, 0x47, 0x50,
+		0x44, 0x46, 0x6d, 0x24, 0xbd, 0xd6, 0x54, 0x78, 0xdc, 0x44, 0xe9, 0x51, 0xda, 0x90, 0x38, 0x6d,
+		0x48, 0x9a, 0xc4, 0x97, 0x57, 0x37, 0x6a, 0x95, 0x32, 0x6d, 0x46, 0x8a, 0xc4, 0x36, 0x36, 0x2b,
+		0xeb, 0x85, 0xcf, 0x88, 0x2a, 0xbd, 0x60, 0x8b, 0x57, 0x25, 0x38, 0x2e, 0x4e, 0x59, 0xf9, 0x5a,
+		0x6b, 0x98, 0x0d, 0xab, 0x29, 0xac, 0xdb, 0xdc, 0xc5, 0x0b, 0x07, 0x55, 0xae, 0x72, 0x54, 0xca,
+		0x92, 0x0a, 0x47, 0x2c, 0x3d, 0x36, 0xc4, 0x92, 0xe2, 0x7a, 0x99, 0xb7, 0x25, 0x4d, 0xe2, 0x9b,
+		0xc5, 0xe5, 0xe7, 0x2b, 0x65, 0xaf, 0x35, 0x47, 0x7b, 0x61, 0x54, 0xc8, 0x37, 0x43, 0x1e, 0xbd,
+		0xad, 0x28, 0x1b, 0xad, 0x26, 0x3b, 0xf6, 0x8e, 0x8d, 0x3a, 0x2f, 0xf5, 0x5a, 0x84, 0xee, 0xd7,
+		0xeb, 0x2e, 0x46, 0xe9, 0x51, 0x5f, 0x53, 0x52, 0x24, 0xb6, 0xbe, 0xb1, 0x5e, 0x11, 0xcd, 0xa0,
+		0x47, 0xc4, 0x2f, 0x79, 0xcd, 0xc8, 0xf5, 0x03, 0xa8, 0xe4, 0x9b, 0x41, 0x16, 0xee, 0x21, 0x97,
+		0x25, 0x53, 0xa3, 0x8e, 0x7c, 0xbd, 0x06, 0x70, 0x27, 0x93, 0xcb, 0x8c, 0x87, 0x7d, 0x2d, 0x98,
+		0x25, 0xf9, 0xd5, 0xca, 0xfa, 0x4a, 0xfd, 0xaa, 0xb6, 0xa9, 0x56, 0xe8, 0xc9, 0x5d, 0xe1, 0x8f,
+		0x44, 0xf5, 0xf9, 0x4e, 0x10, 0x91, 0x7c, 0xab, 0x04, 0x69, 0x66, 0x02, 0x31, 0x9f, 0x14, 0x73,
+		0x2a, 0x3c, 0x7c, 0x50, 0xdd, 0xd4, 0x02, 0xa2, 0xd0, 0xa5, 0xcb, 0xb4, 0xda, 0xa8, 0x10, 0x88,
+		0xe3, 0x84, 0xac, 0x56, 0x56, 0x8a, 0xcb, 0x2f, 0x69, 0xa5, 0x4a, 0xad, 0x8e, 0x9a, 0x6c, 0x43,
+		0x65, 0x32, 0x0a, 0x64, 0xaa, 0xb8, 0xba, 0xba, 0xf1, 0x82, 0xc7, 0x08, 0x78, 0xc5, 0x25, 0xa3,
+		0x7c, 0x03, 0x64, 0x03, 0xe2, 0x8e, 0x46, 0x31, 0x35, 0xa6, 0xb1, 0x07, 0xb5, 0xca, 0xfa, 0xb2,
+		0xdf, 0x88, 0xcf, 0x80, 0x2b, 0xde, 0xb2, 0x84, 0x29, 0x21, 0xfc, 0x72, 0x04, 0xd5, 0x28, 0x6f,
+		0x80, 0x7b, 0x96, 0x18, 0x55, 0x9e, 0x86, 0xa4, 0x10, 0x5f, 0x34, 0xcd, 0xa9, 0x85, 0x3d, 0xb0,
+		0x31, 0x48, 0x02, 0x95, 0x5d, 0x59, 0xc2, 0x6d, 0x10, 0x93, 0x69, 0x39, 0xa2, 0x5c, 0x87, 0xa3,
+		0xa1, 0xa2, 0x47, 0x1e, 0x80, 0x79, 0x71, 0x7e, 0xc9, 0x8c, 0x7e, 0xad, 0xb2, 0xbe, 0xbc, 0x51,
+		0xc6, 0x6d, 0x92, 0x47, 0x13, 0x80, 0xcb, 0x20, 0x6b, 0xa5, 0x90, 0x4f, 0x39, 0xa2, 0x54, 0x21,
+		0x17, 0x14, 0x20, 0x72, 0x12, 0x8e, 0x6f, 0xd5, 0xaf, 0x3c, 0xa3, 0x5d, 0x2f, 0xae, 0x56, 0xcb,
+		0xc5, 0x81, 0x0d, 0x11, 0x00, 0x97, 0x22, 0x39, 0x82, 0x0d, 0x45, 0xe9, 0x92, 0xa3, 0x4a, 0x2c,
+		0x29, 0xc9, 0x92, 0x52, 0x83, 0xfc, 0x80, 0x28, 0x90, 0xfb, 0xa0, 0xc0, 0x77, 0x28, 0x61, 0xad,
+		0x9a, 0x81, 0x41, 0xe1, 0x60, 0x7b, 0xb5, 0x72, 0x65, 0xb5, 0xba, 0x56, 0xad, 0xd3, 0xf6, 0x5d,
+		0x05, 0xf0, 0xc6, 0x18, 0xd7, 0xac, 0x6b, 0xb5, 0x8d, 0x75, 0xed, 0x0a, 0x6e, 0xf4, 0xea, 0x3e,
+		0x52, 0x29, 0x60, 0x63, 0x2a, 0x4b, 0xb8, 0x1f, 0x19, 0x1e, 0x78, 0x39, 0x72, 0xf6, 0xbd, 0x12,
+		0x2e, 0x59, 0xef, 0x5d, 0x9f, 0xfb, 0x56, 0x89, 0xdc, 0x9f, 0x7c, 0x3d, 0x41, 0x12, 0x8b, 0xdd,
+		0xed, 0xc5, 0x46, 0xb7, 0x3b, 0x97, 0xc7, 0x1f, 0xcb, 0xdd, 0xee, 0x15, 0xb1, 0x10, 0xcf, 0x27,
+		0xff, 0x38, 0x41, 0x92, 0x98, 0x8b, 0x87, 0x00, 0x73, 0x32, 0xfe, 0xba, 0xa6, 0xdf, 0xd4, 0x5d,
+		0x80, 0x93, 0xc9, 0xcf, 0x26, 0x48, 0x1c, 0xb3, 0x77, 0xad, 0xb9, 0x1c, 0xfe, 0x5f, 0xb1, 0xdc,
+		0xc2, 0x07, 0x92, 0xdf, 0xb1, 0x4e, 0x00, 0x33, 0xa9, 0xc4, 0x5e, 0x98, 0x23, 0xf8, 0x9b, 0x1e,
+		0x9b, 0x5d, 0x10, 0x40, 0x67, 0xe3, 0xc9, 0xf7, 0xae, 0xcb, 0xef, 0x5f, 0x3f, 0x1b, 0x4f, 0xbe,
+		0x7f, 0x5d, 0xfe, 0x9e, 0xf5, 0x6b, 0xf1, 0xe4, 0x67, 0x12, 0xf2, 0xeb, 0x09, 0xe5, 0xcf, 0xa3,
+		0x40, 0x3c, 0xf9, 0x76, 0x3d, 0x2f, 0x2f, 0x42, 0xd2, 0x75, 0xe5, 0xb0, 0x58, 0xd1, 0x37, 0x1f,
+		0x30, 0x2d, 0x04, 0x9a, 0x2f, 0x6b, 0xc0, 0xb5, 0xe3, 0x52, 0xc3, 0x7d, 0x7b, 0xa7, 0x65, 0xb6,
+		0x3a, 0xfd, 0x8e, 0x26, 0xfc, 0x1b, 0x63, 0xf7, 0xed, 0x1c, 0x81, 0xa7, 0x29, 0x09, 0xfd, 0x76,
+		0x80, 0xc4, 0xd4, 0x58, 0x12, 0x0c, 0x81, 0xa7, 0xe7, 0xbe, 0x28, 0x41, 0x61, 0x54, 0x63, 0xef,
+		0xc9, 0xf5, 0xb2, 0x0e, 0xb3, 0xd6, 0x4d, 0xa3, 0xd7, 0x6b, 0x35, 0xe9, 0x69, 0x8a, 0x6b, 0x90,
+		0xc5, 0xc6, 0x1b, 0x64, 0x33, 0x3e, 0x44, 0x77, 0x50, 0x4b, 0xb8, 0x6e, 0xde, 0xc6, 0x25, 0x43,
+		0x50, 0x9a, 0x1a, 0x4f, 0x29, 0x4b, 0x51, 0x04, 0x8d, 0x6b, 0x38, 0x4d, 0x70, 0x0f, 0x14, 0x91,
+		0xa3, 0x9e, 0xd5, 0xa7, 0x7c, 0x38, 0x02, 0xb9, 0x60, 0x70, 0x26, 0x29, 0x43, 0xb2, 0x6d, 0xf1,
+		0xc0, 0x27, 0x36, 0xda, 0x67, 0xc6, 0xc4, 0x73, 0x2e, 0xae, 0x72, 0x78, 0xd5, 0xc5, 0x9c, 0xfb,
+		0x3d, 0x09, 0x92, 0x22, 0x9b, 0x1c, 0x83, 0x58, 0x57, 0x77, 0xf6, 0x28, 0xb9, 0xa9, 0x52, 0x44,
+		0x96, 0x54, 0x9a, 0xc6, 0x7c, 0xbb, 0xab, 0xb3, 0xa0, 0x2f, 0x9e, 0x8f, 0x69, 0xb4, 0xbc, 0xda,
+		0x86, 0xde, 0xa4, 0xe7, 0x80, 0x56, 0xa7, 0x63, 0x98, 0x8e, 0x2d, 0x2c, 0x2f, 0x9e, 0xbf, 0xcc,
+		0xb3, 0x31, 0x46, 0xd8, 0xe9, 0xe9, 0xad, 0x76, 0x00, 0x36, 0x46, 0x61, 0x65, 0x51, 0xe0, 0x02,
+		0x2f, 0xc1, 0x09, 0x41, 0xb7, 0x69, 0x38, 0x7a, 0x63, 0xcf, 0x68, 0x7a, 0x48, 0x71, 0x7a, 0xde,
+		0x7f, 0x9c, 0x03, 0x94, 0x79, 0xb9, 0xc0, 0x55, 0x3e, 0x1e, 0x81, 0x69, 0x71, 0x72, 0xd9, 0x74,
+		0x99, 0xb5, 0x06, 0xa0, 0x9b, 0xa6, 0xe5, 0xf8, 0xd9, 0x35, 0x6c, 0x6c, 0x0e, 0xe1, 0x2d, 0x16,
+		0x5d, 0x24, 0xd5, 0x47, 0x60, 0xee, 0x4f, 0x24, 0x00, 0xaf, 0x68, 0x24, 0xdf, 0xe6, 0x21, 0xcd,
+		0x43, 0x6f, 0x69, 0xfc, 0x36, 0x73, 0xf0, 0x01, 0xcb, 0xc2, 0x33, 0x4e, 0xf4, 0xfd, 0x6d, 0x1b,
+		0xbb, 0x2d, 0x93, 0xc7, 0x52, 0xb1, 0x84, 0x08, 0x49, 0x88, 0x79, 0xb1, 0x86, 0x2a, 0x24, 0x6d,
+		0xa3, 0xa3, 0x9b, 0x4e, 0xab, 0xc1, 0x67, 0xcd, 0xa5, 0x43, 0x35, 0x7e, 0xb1, 0xc6, 0xb1, 0x55,
+		0x97, 0x8e, 0x72, 0x06, 0x92, 0x22, 0xd7, 0xd5, 0xd2, 0x47, 0x48, 0x02, 0xa2, 0xb5, 0x0a, 0xae,
+		0x53, 0x54, 0x59, 0x56, 0x8b, 0x35, 0x39, 0x72, 0xf6, 0xc3, 0x11, 0x48, 0x88, 0x69, 0x3c, 0x03,
+		0xf9, 0x4a, 0xb9, 0x3a, 0xa0, 0xf0, 0x67, 0x20, 0x27, 0x32, 0x99, 0x56, 0x95, 0xdf, 0x9d, 0xf0,
+		0x67, 0x6e, 0xaa, 0x1b, 0xf5, 0x8d, 0x8b, 0xf2, 0x1f, 0x0d, 0x67, 0x3e, 0x21, 0x7f, 0x26, 0x41,
+		0xa6, 0x21, 0x23, 0x32, 0x2f, 0x3e, 0x7e, 0xf1, 0x09, 0xf9, 0xf5, 0xc1, 0xac, 0x27, 0xe5, 0x3f,
+		0xa6, 0xbe, 0x25, 0x91, 0x75, 0x41, 0xab, 0xa3, 0xd6, 0xde, 0x58, 0x5f, 0x7d, 0x49, 0x96, 0xfc,
+		0x05, 0x17, 0x7d, 0x05, 0x11, 0x72, 0x3f, 0x1c, 0x17, 0x05, 0x97, 0x2f, 0x5f, 0xbe, 0xfc, 0xb4,
+		0xaf, 0xf0, 0xee, 0xfb, 0xe2, 0x83, 0xc5, 0xcf, 0xf8, 0x8a, 0x3f, 0x38, 0x5c, 0x7c, 0xd9, 0x57,
+		0xfc, 0x23, 0xef, 0x8b, 0x93, 0x19, 0x48, 0x8b, 0xe2, 0xb5, 0xe2, 0x8b, 0xf2, 0x97, 0xbf, 0xfc,
+		0xe5, 0x2f, 0x27, 0x4a, 0xdf, 0x0c, 0x33, 0x0d, 0xab, 0x33, 0x38, 0x34, 0x25, 0x79, 0x20, 0x30,
+		0xc2, 0xbe, 0x2a, 0xbd, 0xfc, 0x18, 0x07, 0xda, 0xb5, 0xda, 0xba, 0xb9, 0xbb, 0x68, 0xf5, 0x76,
+		0xbd, 0x7b, 0x02, 0x68, 0xe4, 0xda, 0xbe, 0xdb, 0x02, 0xdd, 0xed, 0x2f, 0x4a, 0xd2, 0x8f, 0x45,
+		0xa2, 0x2b, 0x9b, 0xa5, 0x9f, 0x8e, 0xcc, 0xad, 0x30, 0xc4, 0x4d, 0x31, 0xf0, 0xaa, 0xb1, 0xd3,
+		0x36, 0x1a, 0x38, 0x3a, 0xf0, 0x81, 0x18, 0x4c, 0x63, 0x20, 0x82, 0x75, 0x9e, 0xfe, 0x65, 0x2d,
+		0x20, 0x53, 0x34, 0x31, 0x37, 0xf6, 0x3a, 0xc2, 0xd2, 0x25, 0xb6, 0x19, 0x23, 0xe3, 0x42, 0x77,
+		0x0b, 0x7f, 0xf9, 0x5d, 0x3f, 0x39, 0xe5, 0xc5, 0x74, 0x2c, 0xad, 0x0d, 0xdb, 0x84, 0xe3, 0x69,
+		0x7c, 0x5e, 0xd0, 0x18, 0x34, 0xf1, 0x96, 0xde, 0x0c, 0x49, 0x97, 0xcc, 0xc1, 0x61, 0x72, 0x85,
+		0xff, 0x21, 0x88, 0xb8, 0x18, 0x4b, 0xcf, 0x01, 0x30, 0x8b, 0x9d, 0x85, 0x9b, 0x1c, 0x8c, 0xff,
+		0x05, 0x81, 0x9f, 0xa2, 0x38, 0xb8, 0xa3, 0x5a, 0x5a, 0x81, 0x5c, 0xd3, 0x32, 0x1d, 0xcd, 0xea,
+		0xb4, 0x1c, 0xa3, 0xd3, 0x75, 0xf6, 0xc7, 0x11, 0xf9, 0x2b, 0x46, 0x24, 0xa9, 0x66, 0x11, 0x6f,
+		0x43, 0xa0, 0x61, 0x4b, 0x58, 0xc4, 0xe0, 0x24, 0x2d, 0xf9, 0x9f, 0x6e, 0x4b, 0x28, 0x0e, 0xb6,
+		0xa4, 0x54, 0xf9, 0xed, 0x4f, 0x9d, 0x92, 0x3e, 0xfe, 0xa9, 0x53, 0xd2, 0x7f, 0xfb, 0xd4, 0x29,
+		0xe9, 0xfd, 0x9f, 0x3e, 0x75, 0xe4, 0xe3, 0x9f, 0x3e, 0x75, 0xe4, 0x93, 0x9f, 0x3e, 0x75, 0xe4,
+		0xe5, 0x73, 0xbb, 0x2d, 0x67, 0xaf, 0xbf, 0xbd, 0xd8, 0xb0, 0x3a, 0xe7, 0x1b, 0x96, 0xdd, 0xb1,
+		0x6c, 0xfe, 0xef, 0x31, 0xbb, 0x79, 0x83, 0xcb, 0x8f, 0x73, 0x9b, 0x49, 0xc1, 0x76, 0x9c, 0x45,
+		0x0a, 0xc2, 0x9f, 0x9e, 0x83, 0xd9, 0x5d, 0x6b, 0xd7, 0xa2, 0xc9, 0xf3, 0xf8, 0x8b, 0x0b, 0x48,
+		0xca, 0xcd, 0x9d, 0x40, 0x48, 0xd6, 0x61, 0x86, 0x03, 0x6b, 0x74, 0x47, 0xc6, 0x4e, 0xf6, 0xc9,
+		0x81, 0xd1, 0x61, 0x85, 0x5f, 0xfc, 0x43, 0xea, 0x4a, 0x53, 0xa7, 0x39, 0x2a, 0x96, 0xb1, 0xc3,
+		0xff, 0x25, 0x15, 0x8e, 0x06, 0xe8, 0xb1, 0xdd, 0xb0, 0xd1, 0x1b, 0x43, 0xf1, 0xdf, 0x70, 0x8a,
+		0x33, 0x3e, 0x8a, 0x35, 0x8e, 0xba, 0xb4, 0x0c, 0xd9, 0xc3, 0xd0, 0xfa, 0x4d, 0x4e, 0x2b, 0x63,
+		0xf8, 0x89, 0xac, 0x40, 0x9e, 0x12, 0x69, 0xf4, 0x6d, 0xc7, 0xea, 0xd0, 0x31, 0x3c, 0x98, 0xcc,
+		0x6f, 0xfd, 0x21, 0x5b, 0xfc, 0x72, 0x88, 0xb6, 0xec, 0x62, 0x2d, 0x2d, 0x01, 0xdd, 0x59, 0x62,
+		0xc8, 0xf3, 0x18, 0x0a, 0xbf, 0xcd, 0x1b, 0xe2, 0xc2, 0x2f, 0x5d, 0x87, 0x59, 0xfc, 0x4d, 0x3d,
+		0x01, 0xfe, 0x96, 0x8c, 0x0f, 0x25, 0x2b, 0xfc, 0xfe, 0xb7, 0xb1, 0xf5, 0x75, 0xc6, 0x25, 0xe0,
+		0x6b, 0x93, 0x6f, 0x14, 0x77, 0x0d, 0xc7, 0x31, 0x7a, 0xb6, 0xa6, 0xb7, 0xc3, 0x9a, 0xe7, 0x8b,
+		0xc5, 0x29, 0xfc, 0xe0, 0xe7, 0x82, 0xa3, 0xb8, 0xc2, 0x30, 0x8b, 0xed, 0xf6, 0xd2, 0x16, 0x1c,
+		0x0f, 0x91, 0x8a, 0x09, 0x68, 0xfe, 0x10, 0xa7, 0x39, 0x3b, 0x24, 0x19, 0x48, 0x76, 0x13, 0x44,
+		0xbe, 0x3b, 0x96, 0x13, 0xd0, 0xfc, 0x00, 0xa7, 0x49, 0x38, 0xae, 0x18, 0x52, 0xa4, 0x78, 0x0d,
+		0xa6, 0x6f, 0x1a, 0xbd, 0x6d, 0xcb, 0xe6, 0xf1, 0x4f, 0x13, 0x90, 0xfb, 0x61, 0x4e, 0x2e, 0xcf,
+		0x11, 0x69, 0x40, 0x14, 0xd2, 0xba, 0x0c, 0xc9, 0x1d, 0xbd, 0x61, 0x4c, 0x40, 0xe2, 0x2e, 0x27,
+		0x91, 0x40, 0x78, 0x44, 0x2d, 0x42, 0x66, 0xd7, 0xe2, 0xce, 0xa0, 0xf1, 0xe8, 0x1f, 0xe4, 0xe8,
+		0x69, 0x81, 0xc3, 0x49, 0x74, 0xad, 0x6e, 0xbf, 0x8d, 0x9e, 0xa2, 0xf1, 0x24, 0x7e, 0x44, 0x90,
+		0x10, 0x38, 0x9c, 0xc4, 0x21, 0xd8, 0xfa, 0x21, 0x41, 0xc2, 0xf6, 0xf1, 0xf3, 0x39, 0x0c, 0x8b,
+		0x6e, 0xef, 0x5b, 0xe6, 0x24, 0x8d, 0xf8, 0x51, 0x4e, 0x01, 0x38, 0x0a, 0x12, 0x78, 0x16, 0x52,
+		0x93, 0x0e, 0xc4, 0x4f, 0x7c, 0x4e, 0x4c, 0x0f, 0x31, 0x02, 0x2b, 0x90, 0x17, 0x0a, 0x0a, 0x0f,
+		0x15, 0xc7, 0x93, 0xf8, 0x49, 0x4e, 0x22, 0xe7, 0x43, 0xe3, 0xdd, 0x70, 0x0c, 0xdb, 0xd9, 0x35,
+		0x26, 0x21, 0xf2, 0x61, 0xd1, 0x0d, 0x8e, 0xc2, 0x59, 0xb9, 0x6d, 0x98, 0x8d, 0xbd, 0xc9, 0x28,
+		0x7c, 0x44, 0xb0, 0x52, 0xe0, 0x20, 0x89, 0x65, 0xc8, 0x76, 0xf4, 0x9e, 0xbd, 0xa7, 0xb7, 0x27,
+		0x1a, 0x8e, 0x9f, 0xe2, 0x34, 0x32, 0x2e, 0x12, 0xe7, 0x48, 0xdf, 0x3c, 0x0c, 0x99, 0x9f, 0x16,
+		0x1c, 0xe9, 0x9b, 0x01, 0x42, 0x9b, 0x30, 0x6b, 0x3b, 0x74, 0x5f, 0x74, 0x18, 0x6a, 0x3f, 0x23,
+		0xa6, 0x1e, 0xc3, 0x5d, 0xf3, 0x53, 0x7c, 0x16, 0x52, 0x76, 0xeb, 0x9d, 0x13, 0x91, 0xf9, 0x59,
+		0x31, 0xd2, 0x14, 0x01, 0x91, 0x5f, 0x82, 0x13, 0xa1, 0xcb, 0xc4, 0x04, 0xc4, 0x7e, 0x8e, 0x13,
+		0x3b, 0x16, 0xb2, 0x54, 0x70, 0x95, 0x70, 0x58, 0x92, 0x3f, 0x2f, 0x54, 0x82, 0x31, 0x40, 0x6b,
+		0x13, 0xdd, 0xf3, 0xb6, 0xbe, 0x73, 0x38, 0xae, 0xfd, 0x63, 0xc1, 0x35, 0x86, 0x1b, 0xe0, 0x5a,
+		0x1d, 0x8e, 0x71, 0x8a, 0x87, 0x1b, 0xd7, 0x5f, 0x10, 0x8a, 0x95, 0x61, 0x6f, 0x05, 0x47, 0xf7,
+		0xeb, 0x61, 0xce, 0x65, 0xa7, 0xf0, 0x03, 0xdb, 0x1a, 0x46, 0x51, 0x8d, 0xa7, 0xfc, 0x8b, 0x9c,
+		0xb2, 0xd0, 0xf8, 0xae, 0x23, 0xd9, 0x5e, 0xd3, 0xbb, 0x48, 0xfc, 0x45, 0x28, 0x08, 0xe2, 0x7d,
+		0xb3, 0x67, 0x34, 0xac, 0x5d, 0xb3, 0xf5, 0x4e, 0xa3, 0x39, 0x01, 0xe9, 0x5f, 0x1a, 0x18, 0xaa,
+		0x2d, 0x1f, 0x3a, 0x52, 0xae, 0x82, 0xec, 0xda, 0x2a, 0x5a, 0xab, 0x43, 0xcf, 0xcc, 0x0e, 0xa6,
+		0xf8, 0xcb, 0x62, 0xa4, 0x5c, 0xbc, 0x2a, 0x45, 0x5b, 0xaa, 0x00, 0xbb, 0x41, 0x31, 0xa9, 0x48,
+		0x7e, 0x94, 0x13, 0xca, 0x7a, 0x58, 0x5c, 0x71, 0x34, 0xac, 0x4e, 0x57, 0xef, 0x4d, 0xa2, 0xff,
+		0x7e, 0x45, 0x28, 0x0e, 0x8e, 0xc2, 0x15, 0x07, 0xda, 0x6b, 0xb8, 0xda, 0x4f, 0x40, 0xe1, 0x57,
+		0x85, 0xe2, 0x10, 0x38, 0x9c, 0x84, 0x30, 0x18, 0x26, 0x20, 0xf1, 0x4f, 0x04, 0x09, 0x81, 0x83,
+		0x24, 0xde, 0xe6, 0x2d, 0xb4, 0x3d, 0x63, 0xb7, 0x65, 0x3b, 0xfc, 0x8e, 0xd3, 0xc1, 0xa4, 0x7e,
+		0xed, 0x73, 0x41, 0x23, 0x4c, 0xf5, 0xa1, 0xa2, 0x26, 0xe2, 0x96, 0x3d, 0x3d, 0x9c, 0x18, 0xdf,
+		0xb0, 0x8f, 0x09, 0x4d, 0xe4, 0x43, 0xc3, 0xb6, 0xf9, 0x2c, 0x44, 0x64, 0x7b, 0x03, 0x37, 0xfc,
+		0x13, 0x90, 0xfb, 0xf5, 0x81, 0xc6, 0xd5, 0x04, 0x2e, 0xd2, 0xf4, 0xd9, 0x3f, 0x7d, 0xf3, 0x86,
+		0xb1, 0x3f, 0x91, 0x74, 0xfe, 0xd3, 0x01, 0xfb, 0x67, 0x8b, 0x61, 0x32, 0x1d, 0x92, 0x1f, 0xb0,
+		0xa7, 0xc6, 0xef, 0x80, 0xbe, 0xe5, 0x0b, 0xbc, 0xbf, 0x41, 0x73, 0x6a, 0x69, 0x15, 0x64, 0x9e,
+		0xe3, 0x19, 0xb0, 0x63, 0x89, 0x7d, 0xdb, 0x17, 0x5c, 0x39, 0x0f, 0xd8, 0x3c, 0x4b, 0x57, 0x20,
+		0x1b, 0x30, 0x78, 0xc6, 0x93, 0x7a, 0x37, 0x27, 0x95, 0xf1, 0xdb, 0x3b, 0x4b, 0x4f, 0x41, 0x0c,
+		0x8d, 0x97, 0xf1, 0xe8, 0xdf, 0xce, 0xd1, 0x29, 0xf8, 0xd2, 0x5b, 0x20, 0x29, 0x8c, 0x96, 0xf1,
+		0xa8, 0xdf, 0xc1, 0x51, 0x5d, 0x14, 0x44, 0x17, 0x06, 0xcb, 0x78, 0xf4, 0xbf, 0x27, 0xd0, 0x05,
+		0x0a, 0xa2, 0x4f, 0xce, 0xc2, 0x7f, 0xf9, 0xde, 0x18, 0x43, 0x17, 0x28, 0x4b, 0x78, 0x83, 0x83,
+		0x59, 0x2a, 0xe3, 0xb1, 0xbf, 0x93, 0x57, 0x2e, 0x30, 0x96, 0x9e, 0x86, 0xa9, 0x09, 0x19, 0xfe,
+		0x3e, 0x8e, 0xca, 0xe0, 0x97, 0x96, 0x21, 0xed, 0xb3, 0x4e, 0xc6, 0xa3, 0x7f, 0x37, 0x47, 0xf7,
+		0x63, 0x61, 0xd3, 0xb9, 0x75, 0x32, 0x9e, 0xc0, 0xdf, 0x17, 0x4d, 0xe7, 0x18, 0xc8, 0x36, 0x61,
+		0x98, 0x8c, 0xc7, 0x7e, 0xbf, 0xe0, 0xba, 0x40, 0x59, 0x7a, 0x0e, 0x52, 0xee, 0x62, 0x33, 0x1e,
+		0xff, 0x7b, 0x38, 0xbe, 0x87, 0x83, 0x1c, 0xe8, 0x9b, 0x87, 0x20, 0xf1, 0x0f, 0x04, 0x07, 0x7c,
+		0x58, 0x38, 0x8d, 0x06, 0x0d, 0x98, 0xf1, 0x94, 0xbe, 0x57, 0x4c, 0xa3, 0x01, 0xfb, 0x05, 0x47,
+		0x93, 0xea, 0xfc, 0xf1, 0x24, 0xbe, 0x4f, 0x8c, 0x26, 0x85, 0xc7, 0x66, 0x0c, 0x5a, 0x04, 0xe3,
+		0x69, 0xfc, 0x80, 0x68, 0xc6, 0x80, 0x41, 0xb0, 0xb4, 0x09, 0x64, 0xd8, 0x1a, 0x18, 0x4f, 0xef,
+		0x55, 0x4e, 0x6f, 0x7a, 0xc8, 0x18, 0x58, 0x7a, 0x01, 0x8e, 0x85, 0x5b, 0x02, 0xe3, 0xa9, 0xfe,
+		0xe0, 0x17, 0x06, 0xf6, 0x6e, 0x7e, 0x43, 0x60, 0xa9, 0x0e, 0xb3, 0x61, 0x56, 0xc0, 0x78, 0xb2,
+		0x3f, 0xf4, 0x85, 0xa0, 0xe2, 0xf6, 0x1b, 0x01, 0x4b, 0x45, 0x00, 0x6f, 0x01, 0x1e, 0x4f, 0xeb,
+		0x87, 0x39, 0x2d, 0x1f, 0x12, 0x4e, 0x0d, 0xbe, 0xfe, 0x8e, 0xc7, 0xbf, 0x2b, 0xa6, 0x06, 0xc7,
+		0xc0, 0xa9, 0x21, 0x96, 0xde, 0xf1, 0xd8, 0x1f, 0x14, 0x53, 0x43, 0xa0, 0xa0, 0x64, 0xfb, 0x56,
+		0xb7, 0xf1, 0x14, 0x7e, 0x54, 0x48, 0xb6, 0x0f, 0x6b, 0x69, 0x1d, 0xa6, 0x87, 0x16, 0xc4, 0xf1,
+		0xa4, 0x7e, 0x8c, 0x93, 0x92, 0x07, 0xd7, 0x43, 0xff, 0xe2, 0xc5, 0x17, 0xc3, 0xf1, 0xd4, 0x7e,
+		0x7c, 0x60, 0xf1, 0xe2, 0x6b, 0xe1, 0xd2, 0xb3, 0x90, 0x34, 0xfb, 0xed, 0x36, 0x4e, 0x9e, 0x71,
+		0x2e, 0xaf, 0x3f, 0xfe, 0x12, 0xe7, 0x8e, 0x40, 0x58, 0x7a, 0x0a, 0xa6, 0x8c, 0xce, 0xb6, 0xd1,
+		0x1c, 0x87, 0xf9, 0xd9, 0x2f, 0x09, 0x85, 0x89, 0xd0, 0xe8, 0x68, 0x63, 0xae, 0x11, 0x1a, 0x27,
+		0x3e, 0x06, 0xf7, 0x4f, 0xbe, 0xc4, 0x2f, 0x95, 0x79, 0x28, 0x1e, 0x81, 0x49, 0x3c, 0x75, 0x9f,
+		0x0b, 0x12, 0xa0, 0x23, 0x72, 0x19, 0x12, 0x78, 0x3c, 0xec, 0xe8, 0x63, 0x3d, 0x96, 0x7f, 0xca,
+		0xb1, 0x05, 0x3c, 0x32, 0xac, 0x63, 0xf5, 0x0c, 0x47, 0xdf, 0xb5, 0xc7, 0xe1, 0xfe, 0x19, 0xc7,
+		0x75, 0x11, 0x10, 0xb9, 0xa1, 0xdb, 0xce, 0x24, 0xfd, 0xfe, 0x73, 0x81, 0x2c, 0x10, 0xb0, 0xd1,
+		0xf8, 0xfb, 0x86, 0x31, 0xd6, 0xc3, 0xf9, 0x17, 0xa2, 0xd1, 0x1c, 0x7e, 0xe9, 0x2d, 0x90, 0xc2,
+		0x9f, 0xec, 0xa6, 0xe8, 0x18, 0xe4, 0xbf, 0xe4, 0xc8, 0x1e, 0x06, 0xd6, 0x6c, 0x3b, 0x4d, 0xa7,
+		0x35, 0x9e, 0xd9, 0x9f, 0xe7, 0x23, 0x2d, 0xe0, 0x97, 0x8a, 0x90, 0xb6, 0x9d, 0x66, 0xb3, 0xcf,
+		0xed, 0xd3, 0x71, 0xfe, 0xe1, 0x2f, 0xb9, 0x2e, 0x0b, 0x17, 0x07, 0x47, 0xfb, 0xd6, 0x0d, 0xa7,
+		0x6b, 0xd1, 0xc8, 0xa2, 0xb1, 0x1e, 0x62, 0x4e, 0xc1, 0x87, 0xb2, 0xb4, 0x0c, 0x19, 0xec, 0x8b,
+		0x08, 0xd0, 0x18, 0xeb, 0x1f, 0xe6, 0x0c, 0x08, 0x20, 0x95, 0xde, 0x3e, 0xca, 0xb9, 0x1b, 0x7e,
+		0x86, 0x00, 0x2b, 0xd6, 0x8a, 0xc5, 0x4e, 0x0f, 0x5e, 0x7e, 0x68, 0xd8, 0xfb, 0x1b, 0xf4, 0xeb,
+		0xd2, 0x5f, 0xf0, 0x53, 0x31, 0x38, 0xd5, 0xb0, 0x3a, 0x86, 0xb3, 0xbd, 0xe3, 0x9c, 0x6f, 0xf4,
+		0xf6, 0xbb, 0x8e, 0x75, 0xfe, 0xe6, 0x05, 0x74, 0xed, 0x5a, 0x3b, 0x8c, 0x30, 0x21, 0xa2, 0x7c,
+		0x91, 0x95, 0x2f, 0xde, 0xbc, 0x30, 0x17, 0xea, 0x23, 0x56, 0x76, 0x60, 0x6a, 0x13, 0x11, 0xf1,
+		0xcc, 0xca, 0xb1, 0x1c, 0xbd, 0x4d, 0x63, 0x82, 0xa2, 0x2a, 0x4b, 0x60, 0x2e, 0xbb, 0x17, 0x1f,
+		0x61, 0xb9, 0x2d, 0x71, 0x25, 0xbe, 0x6d, 0xe8, 0x3b, 0xec, 0x7a, 0x61, 0x94, 0x46, 0x3b, 0x25,
+		0x31, 0x83, 0xde, 0x24, 0x9c, 0x85, 0x29, 0xbd, 0xcf, 0x8e, 0x01, 0xa3, 0x67, 0x32, 0x2a, 0x4b,
+		0x28, 0xab, 0x90, 0xe0, 0x5e, 0x4c, 0x3c, 0x07, 0xbb, 0x61, 0xec, 0xd3, 0x7a, 0x32, 0x2a, 0xfe,
+		0x24, 0xe7, 0x61, 0x8a, 0xb6, 0x9e, 0x47, 0x75, 0x9e, 0x58, 0x1c, 0x6e, 0xfe, 0x22, 0x6d, 0xa5,
+		0xca, 0xe0, 0x94, 0x6b, 0x90, 0x2c, 0x5b, 0xe8, 0x04, 0x0f, 0x92, 0x4b, 0x31, 0x72, 0xb4, 0xd1,
+		0xdd, 0xbe, 0x23, 0x42, 0xef, 0x69, 0x02, 0x2f, 0xb2, 0xb0, 0xfb, 0xa6, 0xfc, 0x2c, 0x93, 0xa7,
+		0x94, 0x65, 0x48, 0x50, 0xda, 0x1b, 0x5d, 0xf7, 0x11, 0x07, 0xc9, 0xf7, 0x88, 0x03, 0x27, 0x1f,
+		0xf1, 0x5a, 0x4b, 0x20, 0x86, 0x97, 0xd1, 0x78, 0xc7, 0xe9, 0x6f, 0xe5, 0x39, 0x48, 0x72, 0x22,
+		0x36, 0x79, 0x02, 0xa2, 0x56, 0x57, 0x1c, 0xd5, 0x9f, 0x1c, 0xd9, 0x97, 0x8d, 0x6e, 0x29, 0xf6,
+		0xdb, 0xaf, 0xcd, 0x1f, 0x51, 0x11, 0xba, 0xb4, 0x36, 0xf2, 0x4c, 0xe0, 0x89, 0x80, 0x54, 0x88,
+		0x61, 0x17, 0x3f, 0xf4, 0x6e, 0xeb, 0xfc, 0xb0, 0x30, 0xb8, 0x67, 0x03, 0xdf, 0x1b, 0x81, 0x53,
+		0x43, 0xae, 0x7f, 0x3e, 0x61, 0x46, 0x3d, 0x56, 0xb5, 0x04, 0xc9, 0xb2, 0x98, 0x87, 0x05, 0x7c,
+		0x25, 0xa9, 0x61, 0x99, 0x4d, 0x9b, 0xcb, 0x85, 0x48, 0x22, 0x93, 0x4d, 0xdd, 0xb4, 0x6c, 0x7e,
+		0x39, 0x9a, 0x25, 0x4a, 0x1f, 0x90, 0x0e, 0x27, 0xfe, 0x59, 0x51, 0x13, 0x9d, 0x02, 0x9b, 0xd2,
+		0xcb, 0x17, 0xc6, 0x9e, 0x9f, 0xdd, 0x30, 0xad, 0x5b, 0xa6, 0xdb, 0x89, 0xc0, 0x19, 0xda, 0xa9,
+		0xc1, 0x33, 0xb4, 0x17, 0x8c, 0x76, 0xfb, 0x79, 0x84, 0xc7, 0xa0, 0x1b, 0xdb, 0xe5, 0xca, 0x07,
+		0x63, 0xc3, 0x5c, 0xb9, 0xd5, 0xd3, 0xbb, 0x5d, 0xa3, 0x67, 0x8f, 0xe2, 0xca, 0x03, 0x90, 0x2e,
+		0xfb, 0x62, 0xfe, 0xdc, 0xeb, 0x1d, 0x12, 0x8d, 0x07, 0x64, 0x09, 0x45, 0x01, 0xb8, 0xd2, 0xb6,
+		0x74, 0x27, 0x04, 0x26, 0xe2, 0x83, 0xa9, 0x9a, 0xce, 0xa5, 0x27, 0x43, 0x60, 0xa2, 0x02, 0xe6,
+		0x01, 0x48, 0x6f, 0x8d, 0x02, 0x8a, 0x05, 0x09, 0x3d, 0x71, 0x31, 0x04, 0x66, 0x6a, 0x80, 0x50,
+		0x28, 0x50, 0x56, 0x00, 0x9d, 0x86, 0x54, 0xc9, 0xb2, 0xda, 0x21, 0x20, 0x49, 0x1f, 0x9d, 0x9a,
+		0x2f, 0x9c, 0x31, 0x00, 0x94, 0xf2, 0x35, 0xa8, 0xb4, 0xef, 0x18, 0x76, 0x08, 0x4c, 0x86, 0xc3,
+		0x1c, 0x5e, 0x40, 0x5e, 0xe0, 0xe3, 0x72, 0x58, 0x01, 0x11, 0xe3, 0x79, 0x4f, 0x02, 0xf2, 0x03,
+		0x19, 0x9f, 0x8a, 0x65, 0x54, 0x51, 0xc3, 0xea, 0x3d, 0xbd, 0x23, 0x04, 0x64, 0xda, 0x9d, 0xd7,
+		0xb4, 0x7c, 0xa4, 0x86, 0x9d, 0x1b, 0x33, 0xff, 0xe6, 0xc6, 0x48, 0xa2, 0xf2, 0xb1, 0x28, 0xe4,
+		0x97, 0x2d, 0xd3, 0x36, 0x4c, 0xbb, 0x6f, 0x6f, 0xd2, 0x26, 0x90, 0x27, 0x61, 0x6a, 0xbb, 0x6d,
+		0x35, 0x6e, 0xf0, 0x4b, 0x13, 0xa7, 0x16, 0x87, 0x1a, 0xb3, 0x58, 0xc2, 0x72, 0x06, 0xae, 0x32,
+		0x60, 0xf2, 0x16, 0x48, 0x1a, 0x37, 0x31, 0x76, 0xb6, 0x61, 0x70, 0x4d, 0x7b, 0x3a, 0x04, 0xb1,
+		0xc2, 0x41, 0x38, 0xae, 0x8b, 0x42, 0xbe, 0x0e, 0x52, 0x3c, 0x3a, 0xd1, 0xea, 0xf1, 0xd7, 0x04,
+		0x94, 0x10, 0xfc, 0xeb, 0x02, 0x86, 0x13, 0xf0, 0x90, 0xc8, 0x12, 0x24, 0x6e, 0x1a, 0x3d, 0x5b,
+		0xc4, 0x19, 0xe1, 0x73, 0x5b, 0x21, 0xf8, 0x0c, 0x82, 0x63, 0x0b, 0x04, 0xf2, 0x14, 0xc4, 0xf4,
+		0xed, 0x46, 0x8b, 0x87, 0xde, 0xdc, 0x1f, 0x82, 0x58, 0x2c, 0x2d, 0x57, 0x19, 0x16, 0xbd, 0x31,
+		0x41, 0xc1, 0xb1, 0xd1, 0xf6, 0xbe, 0xd9, 0xd8, 0xeb, 0x59, 0xe6, 0x7e, 0x21, 0x3e, 0xb2, 0xd1,
+		0x35, 0x01, 0x23, 0x1a, 0xed, 0x22, 0x61, 0xa3, 0x79, 0xbc, 0x4e, 0x21, 0x31, 0xb2, 0xd1, 0x3c,
+		0xce, 0x47, 0x34, 0x9a, 0x23, 0x28, 0x55, 0x48, 0xfb, 0xc6, 0x81, 0x5d, 0x68, 0xbd, 0xad, 0x6d,
+		0xe3, 0x24, 0xe1, 0x13, 0x3e, 0xd9, 0xd1, 0x6f, 0xd3, 0x49, 0x43, 0x8e, 0x43, 0x02, 0x0b, 0x77,
+		0xf9, 0xcd, 0x92, 0xa8, 0x1a, 0xef, 0xe8, 0xb7, 0x57, 0x74, 0x0c, 0x1d, 0x8a, 0xca, 0x31, 0xe5,
+		0xa7, 0x24, 0xc8, 0x05, 0x87, 0x86, 0x9c, 0x03, 0x82, 0x18, 0x78, 0x3e, 0x8f, 0x7e, 0x6e, 0x3a,
+		0xc8, 0x82, 0x2e, 0x46, 0x65, 0x15, 0x77, 0x8d, 0xf5, 0x7e, 0x87, 0x36, 0xc0, 0x26, 0x78, 0x98,
+		0xcf, 0x81, 0x85, 0x00, 0xba, 0xcb, 0xed, 0xd0, 0x7b, 0x5c, 0x1c, 0xa0, 0x94, 0xc4, 0x05, 0xea,
+		0xd5, 0xff, 0x3a, 0x2f, 0xd1, 0xa0, 0xad, 0xe2, 0xae, 0x21, 0x4a, 0x82, 0x5d, 0x89, 0x06, 0xbb,
+		0xa2, 0x3c, 0x07, 0xf9, 0x01, 0x29, 0x20, 0x0a, 0x64, 0xbb, 0xfd, 0x6d, 0xed, 0x86, 0xb1, 0x4f,
+		0x63, 0x7d, 0xd9, 0xf2, 0x98, 0x52, 0xd3, 0xdd, 0xfe, 0xf6, 0xf3, 0xc6, 0x3e, 0x9d, 0x7d, 0x4b,
+		0xc9, 0x5f, 0xfb, 0xd0, 0xbc, 0xf4, 0xfa, 0x87, 0xe6, 0x25, 0xe5, 0x1c, 0x64, 0x03, 0x62, 0x80,
+		0xab, 0xb0, 0xde, 0xed, 0x72, 0xfd, 0x87, 0x3f, 0x7d, 0xc0, 0x2f, 0x43, 0x06, 0x0d, 0x0f, 0xa3,
+		0xc9, 0x61, 0x1f, 0x86, 0x3c, 0x65, 0x85, 0x36, 0xc8, 0xeb, 0x2c, 0xcd, 0x5e, 0x13, 0x0c, 0x57,
+		0x20, 0xeb, 0xc1, 0x79, 0x6c, 0x4f, 0x0b, 0xa8, 0x15, 0xdd, 0x56, 0xbe, 0x5f, 0x82, 0xfc, 0x80,
+		0x6c, 0x90, 0xb7, 0x40, 0x0a, 0xaf, 0x18, 0xb4, 0x6c, 0x16, 0x73, 0x34, 0x86, 0x85, 0x31, 0xca,
+		0x3e, 0x0f, 0x83, 0x94, 0x21, 0x2b, 0xa2, 0x2a, 0x9a, 0x46, 0x5b, 0xdf, 0x2f, 0x44, 0x26, 0x23,
+		0x21, 0x9e, 0x4d, 0x2c, 0x23, 0x92, 0xf2, 0x9b, 0x12, 0x64, 0x03, 0x42, 0x47, 0x9a, 0x70, 0xff,
+		0x4d, 0xcb, 0x31, 0xfc, 0xbb, 0x7d, 0xfe, 0x9c, 0xc0, 0x9e, 0xd1, 0xda, 0xdd, 0x73, 0x46, 0x5e,
+		0xb0, 0xf2, 0x16, 0x1a, 0x6a, 0x90, 0x48, 0xea, 0x1c, 0xd2, 0xf1, 0x36, 0xfd, 0xec, 0xdd, 0x81,
+		0xab, 0x94, 0x08, 0xd9, 0x00, 0xd2, 0xdd, 0x76, 0x06, 0x49, 0x47, 0x26, 0x25, 0x2d, 0x23, 0xb2,
+		0x9f, 0xa0, 0x52, 0x03, 0xf0, 0x26, 0x2e, 0x29, 0x4e, 0xd2, 0x89, 0xe8, 0x41, 0x2d, 0x5c, 0x8a,
+		0x14, 0xa4, 0xd2, 0xe6, 0x47, 0x3e, 0x75, 0x6a, 0xe4, 0x42, 0xf3, 0xf2, 0xc5, 0xc9, 0x2d, 0x2a,
+		0xa1, 0xfb, 0xdd, 0x95, 0xe1, 0xd5, 0x08, 0xdc, 0x1f, 0x62, 0x7c, 0xdf, 0x30, 0xf6, 0xed, 0x43,
+		0xdb, 0xde, 0x37, 0x21, 0xb5, 0x49, 0xdf, 0xd1, 0x7c, 0xde, 0xd8, 0x27, 0x73, 0x18, 0xe8, 0x78,
+		0xf1, 0xa9, 0xa7, 0x2e, 0x5c, 0x66, 0x0b, 0xe6, 0xd5, 0x23, 0xaa, 0xc8, 0x20, 0xa7, 0x20, 0x65,
+		0x1b, 0x8d, 0xee, 0xc5, 0xa7, 0x2e, 0xdd, 0xb8, 0xc0, 0x2c, 0xd1, 0xab, 0x47, 0x54, 0x2f, 0x8b,
+		0xdc, 0x07, 0xc9, 0xed, 0xb6, 0x7d, 0xe1, 0xe2, 0x13, 0xcf, 0x5c, 0x60, 0x56, 0xe9, 0xd5, 0x23,
+		0xaa, 0x9b, 0xb3, 0x94, 0xc4, 0x59, 0xf2, 0xfa, 0x8f, 0xce, 0x4b, 0xa5, 0x29, 0x88, 0xda, 0xfd,
+		0xce, 0x57, 0xcb, 0xd6, 0xfc, 0xf5, 0x04, 0x9c, 0x1e, 0x5e, 0x34, 0x5d, 0xed, 0x3f, 0x7a, 0xdd,
+		0x3c, 0x98, 0xa1, 0x23, 0x98, 0xf7, 0x51, 0x09, 0x32, 0xae, 0x92, 0xc1, 0xeb, 0x0d, 0x6f, 0x06,
+		0x70, 0xeb, 0x12, 0xd6, 0xf7, 0x7d, 0x07, 0xad, 0x4f, 0xaa, 0x0f, 0x9e, 0x3c, 0x03, 0xc9, 0x6e,
+		0xcf, 0xea, 0x5a, 0x36, 0x7f, 0xee, 0x67, 0x1c, 0xae, 0x0b, 0x8d, 0xd7, 0x49, 0xe8, 0x5e, 0x49,
+		0xbb, 0x69, 0x39, 0x78, 0xec, 0xdf, 0xb5, 0x6e, 0xf1, 0x57, 0xd4, 0xa2, 0xaa, 0x4c, 0x4b, 0xae,
+		0xd3, 0x82, 0x4d, 0xcc, 0x57, 0xfe, 0x97, 0x04, 0x29, 0x97, 0x0a, 0x9a, 0xd7, 0x7a, 0xb3, 0xd9,
+		0x33, 0x6c, 0x9b, 0x5b, 0x49, 0x22, 0x89, 0xab, 0x0e, 0xd7, 0x97, 0xee, 0x63, 0x52, 0x61, 0x1b,
+		0x09, 0x21, 0x3e, 0x74, 0xc5, 0x8b, 0x33, 0x65, 0x8a, 0x57, 0x43, 0x42, 0xda, 0x92, 0xbe, 0xe9,
+		0x35, 0x83, 0xbe, 0xed, 0xca, 0x3b, 0xa0, 0x75, 0x7b, 0x2d, 0xab, 0xd7, 0x72, 0xf6, 0xe9, 0x9a,
+		0x1c, 0x55, 0x65, 0x51, 0xb0, 0xc9, 0xf3, 0xfd, 0xba, 0x9b, 0xa9, 0xd3, 0x29, 0x76, 0xc1, 0x85,
+		0x55, 0xc7, 0x94, 0xe9, 0x02, 0x64, 0xfc, 0xfa, 0x9d, 0xbf, 0xd3, 0x06, 0x9e, 0x7a, 0x57, 0xda,
+		0x90, 0xaf, 0xb5, 0x3a, 0xdd, 0xb6, 0xe1, 0x75, 0xff, 0x92, 0xd7, 0x49, 0x69, 0x82, 0x4e, 0x8e,
+		0xec, 0x60, 0x64, 0xa8, 0x83, 0x67, 0xff, 0xb3, 0xc4, 0x97, 0xde, 0x6a, 0xf9, 0x4a, 0x5b, 0xdf,
+		0x25, 0x17, 0xe0, 0x68, 0x69, 0x75, 0x63, 0xf9, 0x79, 0xad, 0x5a, 0xd6, 0xae, 0xac, 0x16, 0x7d,
+		0xa1, 0xe8, 0x73, 0xc7, 0xee, 0xdc, 0x5d, 0x20, 0x3e, 0xd8, 0x2d, 0x93, 0x5a, 0x90, 0xe4, 0x3c,
+		0xcc, 0x06, 0x51, 0x8a, 0xa5, 0x1a, 0x5e, 0x80, 0x92, 0xe6, 0x8e, 0xde, 0xb9, 0xbb, 0x30, 0xed,
+		0xc3, 0x28, 0x6e, 0xdb, 0x86, 0xe9, 0x0c, 0x23, 0x2c, 0x6f, 0xac, 0xad, 0xe1, 0x7d, 0x80, 0x21,
+		0x04, 0x0c, 0x6b, 0x6d, 0x39, 0xe4, 0x51, 0x98, 0x0e, 0x22, 0xac, 0x57, 0x57, 0xe5, 0xe8, 0x1c,
+		0xb9, 0x73, 0x77, 0x21, 0xe7, 0x83, 0x5e, 0x6f, 0xb5, 0xe7, 0x92, 0xef, 0xf9, 0xf1, 0x53, 0x47,
+		0x3e, 0xf2, 0x8f, 0x4e, 0x49, 0xa5, 0xd5, 0xaf, 0x8a, 0x5e, 0xfb, 0x81, 0x08, 0xcc, 0x0f, 0x1a,
+		0xa2, 0xe8, 0x98, 0xb1, 0x1d, 0xbd, 0xd3, 0x1d, 0xb5, 0x27, 0x7a, 0x16, 0x52, 0x75, 0x01, 0x73,
+		0xe8, 0xad, 0xe2, 0xdd, 0x43, 0xee, 0x04, 0x72, 0x6e, 0x55, 0x62, 0x2b, 0x70, 0x71, 0xc2, 0xad,
+		0x80, 0xdb, 0x8f, 0x7b, 0xda, 0x0b, 0x7c, 0xb1, 0x06, 0xf7, 0x79, 0x4c, 0xdc, 0x6e, 0xb4, 0x50,
+		0x3d, 0x31, 0x8d, 0xc0, 0xd8, 0x22, 0x8b, 0xd2, 0x45, 0x2c, 0x45, 0x85, 0x36, 0xc6, 0x3d, 0x33,
+		0x37, 0x66, 0x6f, 0x31, 0x37, 0x5e, 0x8d, 0x8e, 0xd8, 0x6b, 0x8c, 0x1b, 0x42, 0xe5, 0x3f, 0xa4,
+		0x20, 0xa1, 0x1a, 0xef, 0xe8, 0x1b, 0xb6, 0x43, 0x9e, 0x80, 0x98, 0xd1, 0xd8, 0xb3, 0x86, 0xa7,
+		0x1e, 0xef, 0xc6, 0x62, 0xa5, 0xb1, 0x67, 0x71, 0xe0, 0xab, 0x47, 0x54, 0x0a, 0x4c, 0x2e, 0xc1,
+		0xd4, 0x4e, 0xbb, 0x6f, 0xef, 0x15, 0x22, 0x83, 0x3b, 0x0f, 0x81, 0x75, 0x05, 0x8b, 0x3d, 0x34,
+		0x06, 0x8e, 0x95, 0xd1, 0x27, 0x8a, 0xa3, 0xa3, 0x2a, 0xa3, 0x2f, 0x13, 0x7b, 0x95, 0x21, 0x30,
+		0x59, 0x06, 0x68, 0x99, 0x2d, 0x47, 0x6b, 0xec, 0xe9, 0x2d, 0xb3, 0x30, 0x35, 0x68, 0xbd, 0x7b,
+		0xa8, 0x2d, 0x67, 0x19, 0x41, 0x3c, 0xfc, 0x54, 0x4b, 0xe4, 0x61, 0x8b, 0xdf, 0xd1, 0x37, 0x7a,
+		0xc2, 0xfa, 0x0f, 0x69, 0xf1, 0xdb, 0xb0, 0xd8, 0xd7, 0x62, 0x0a, 0x8e, 0xbb, 0x25, 0xf6, 0x0a,
+		0x96, 0x73, 0xbb, 0x90, 0x1c, 0x34, 0xfc, 0x05, 0x2a, 0x7d, 0x04, 0xab, 0x7e, 0xdb, 0x43, 0x4e,
+		0x34, 0x58, 0x0e, 0xb9, 0x0c, 0xf1, 0x06, 0x9d, 0xe5, 0x85, 0x34, 0x7f, 0x78, 0x6d, 0x18, 0x99,
+		0x96, 0x7b, 0xb8, 0x1c, 0x81, 0x6c, 0x40, 0xae, 0xdd, 0xb2, 0x1d, 0xcd, 0x36, 0xf5, 0xae, 0xbd,
+		0x67, 0x39, 0xe2, 0x21, 0x9a, 0x87, 0x87, 0x49, 0xac, 0xb6, 0x6c, 0xa7, 0x26, 0xc0, 0x3c, 0x4a,
+		0xd9, 0xb6, 0x3f, 0x1f, 0x09, 0x5a, 0x3b, 0x3b, 0x46, 0xcf, 0xa5, 0x58, 0xc8, 0x8e, 0x22, 0xb8,
+		0x81, 0x70, 0x02, 0xd3, 0x47, 0xd0, 0xf2, 0xe7, 0x93, 0x6f, 0x80, 0x99, 0xb6, 0xa5, 0x37, 0x5d,
+		0x7a, 0x5a, 0x63, 0xaf, 0x6f, 0xde, 0xa0, 0xaf, 0x44, 0xa7, 0x2f, 0x9e, 0x0d, 0x69, 0xa6, 0xa5,
+		0x37, 0x05, 0xf2, 0x32, 0x82, 0x7a, 0x94, 0xa7, 0xdb, 0x83, 0x65, 0x44, 0x83, 0x59, 0xbd, 0xdb,
+		0x6d, 0xef, 0x0f, 0x92, 0xcf, 0x53, 0xf2, 0xe7, 0x86, 0xc9, 0x17, 0x11, 0x7a, 0x04, 0x7d, 0xa2,
+		0x0f, 0x15, 0x92, 0x2d, 0x90, 0xbb, 0x3d, 0x83, 0x06, 0x77, 0xb0, 0xc5, 0x4e, 0x6f, 0xd3, 0x97,
+		0x5e, 0xf0, 0xae, 0xc5, 0x10, 0xf1, 0x4d, 0x06, 0xb9, 0xc9, 0x01, 0x3d, 0xca, 0xf9, 0x6e, 0xb0,
+		0x84, 0x91, 0xb5, 0x1a, 0x06, 0x7d, 0x17, 0x8b, 0x93, 0x9d, 0x1e, 0x4d, 0x96, 0x42, 0x86, 0x92,
+		0x0d, 0x94, 0x90, 0x2b, 0x90, 0x66, 0x4f, 0x9a, 0xa2, 0x85, 0x61, 0xd0, 0x07, 0xc7, 0xd2, 0x17,
+		0x1f, 0x08, 0x99, 0xae, 0x14, 0xe8, 0xba, 0xe5, 0x18, 0x1e, 0x31, 0x30, 0xdc, 0x4c, 0xb2, 0x0d,
+		0x47, 0xe9, 0x6b, 0x39, 0xfb, 0x5a, 0xd0, 0xc4, 0x2e, 0xcc, 0xf0, 0x1b, 0xee, 0x43, 0x14, 0xe9,
+		0xfb, 0xb3, 0xfb, 0xd7, 0xfd, 0xb6, 0xb6, 0x47, 0x7a, 0xe6, 0xe6, 0x70, 0x29, 0x4a, 0xda, 0x4e,
+		0xcb, 0xd4, 0xdb, 0xf8, 0x14, 0x1c, 0xf3, 0x50, 0xcc, 0x8e, 0x92, 0xb4, 0x2b, 0x1c, 0x8e, 0x2e,
+		0x74, 0x3e, 0x49, 0xdb, 0xf1, 0xe7, 0x97, 0x12, 0xdc, 0x8b, 0xe4, 0xbe, 0x23, 0x91, 0x90, 0x93,
+		0xec, 0xb5, 0xa3, 0x6b, 0xb1, 0x24, 0xc8, 0x69, 0xe5, 0x11, 0x48, 0xfb, 0xf4, 0x14, 0xae, 0x42,
+		0x7c, 0x9f, 0xc4, 0x7d, 0x53, 0x22, 0xa9, 0xe4, 0x20, 0xe3, 0x57, 0x4d, 0xca, 0xfb, 0x25, 0x48,
+		0xfb, 0x94, 0x0e, 0x62, 0x0a, 0xe7, 0x04, 0xc7, 0xe4, 0x49, 0x7c, 0xc7, 0x8c, 0x6d, 0x14, 0x45,
+		0x79, 0x84, 0x6e, 0x43, 0x33, 0x34, 0x93, 0xef, 0x53, 0xf1, 0x52, 0x48, 0xf7, 0x62, 0xd7, 0x05,
+		0x89, 0x52, 0x10, 0xe8, 0x5e, 0xec, 0x0a, 0x80, 0xd3, 0x90, 0xc1, 0xae, 0x6b, 0x7e, 0x0f, 0x48,
+		0x4a, 0x4d, 0x63, 0x1e, 0x07, 0x51, 0x7e, 0x2f, 0x02, 0xf2, 0xa0, 0x32, 0xc3, 0xcf, 0x04, 0xa0,
+		0x16, 0xe7, 0x6a, 0x7a, 0x6e, 0x68, 0x8f, 0xe5, 0x2e, 0x8b, 0x6c, 0xb7, 0xfe, 0x7e, 0xdc, 0x2b,
+		0x52, 0x0c, 0x72, 0x02, 0x35, 0x98, 0xde, 0x32, 0xb5, 0x56, 0x53, 0xbc, 0xfd, 0x4f, 0xd3, 0xd5,
+		0x26, 0x7a, 0x03, 0x1a, 0xc2, 0xa7, 0xa4, 0xb1, 0xb5, 0xe7, 0x00, 0x97, 0xce, 0x80, 0xfb, 0x49,
+		0xcd, 0x37, 0x82, 0x19, 0x64, 0x25, 0x60, 0x7b, 0xb3, 0x87, 0x41, 0x4f, 0x87, 0xc8, 0x93, 0x80,
+		0xd9, 0xea, 0x36, 0x75, 0xc7, 0xe0, 0xfe, 0x6f, 0x1f, 0x2a, 0xee, 0xdd, 0xf5, 0x6e, 0x57, 0xb3,
+		0x1d, 0xdd, 0x31, 0x02, 0xc6, 0x66, 0x56, 0xef, 0x76, 0xe9, 0x13, 0xc8, 0xcc, 0xdc, 0x7c, 0x08,
+		0x72, 0xa8, 0xe1, 0x5b, 0x7a, 0x5b, 0x6c, 0x0c, 0xe3, 0x6c, 0x8b, 0xcf, 0x73, 0xf9, 0xe6, 0xb2,
+		0x09, 0x19, 0xbf, 0x72, 0x77, 0x5d, 0xf7, 0x92, 0xe7, 0xba, 0xc7, 0x3c, 0x7a, 0xcb, 0x87, 0x71,
+		0x48, 0xdc, 0x8c, 0x8a, 0x73, 0xb2, 0xcc, 0x76, 0xe6, 0x29, 0xb4, 0x64, 0xba, 0x3d, 0xeb, 0x26,
+		0xbb, 0x5b, 0x9e, 0x54, 0x59, 0x42, 0x79, 0x09, 0x72, 0xc1, 0x75, 0x80, 0xe4, 0x20, 0xe2, 0xdc,
+		0xe6, 0xb5, 0x44, 0x9c, 0xdb, 0xe4, 0x82, 0xef, 0x75, 0xe8, 0x5c, 0xd8, 0xea, 0xc7, 0xf1, 0xbd,
+		0x57, 0x8f, 0xd9, 0x55, 0x31, 0x25, 0x0f, 0xd9, 0xc0, 0x2a, 0xa1, 0x1c, 0x83, 0xd9, 0x30, 0x9d,
+		0xaf, 0xb4, 0x60, 0x36, 0x4c, 0x75, 0x93, 0x4b, 0x90, 0x74, 0x95, 0xbe, 0x90, 0xa0, 0xa1, 0xda,
+		0x5d, 0x24, 0x17, 0x16, 0x65, 0x07, 0x07, 0x82, 0x9e, 0xf0, 0x44, 0xf8, 0xd6, 0xa4, 0xdb, 0x45,
+		0x3f, 0x8b, 0xf2, 0x76, 0x28, 0x8c, 0xd2, 0xe7, 0x3e, 0xc6, 0x31, 0x57, 0x8d, 0x60, 0xdc, 0x31,
+		0x88, 0xf3, 0x8b, 0xbd, 0x11, 0xea, 0x79, 0xe6, 0x29, 0x64, 0x28, 0xd3, 0xed, 0x51, 0x9a, 0xcd,
+		0x12, 0x8a, 0x06, 0x27, 0x46, 0xaa, 0x74, 0xef, 0x48, 0x8a, 0xfb, 0xb0, 0x69, 0xc2, 0x23, 0xc4,
+		0x1a, 0xcb, 0x12, 0x58, 0xad, 0x6d, 0x98, 0x4d, 0xbe, 0x07, 0x4a, 0xa9, 0x3c, 0x85, 0xcf, 0x16,
+		0x1c, 0x0b, 0xd7, 0xeb, 0xb8, 0x91, 0x41, 0x7f, 0x90, 0x13, 0x74, 0x1d, 0x41, 0x47, 0xbf, 0x5d,
+		0xe7, 0x7e, 0x23, 0x19, 0xa2, 0xce, 0x6d, 0x9b, 0xde, 0x9a, 0xcb, 0xa8, 0xf8, 0x93, 0x5c, 0x87,
+		0x69, 0xbc, 0x79, 0xd7, 0xd6, 0xda, 0xba, 0xed, 0x68, 0x7c, 0xd9, 0x67, 0xd3, 0xe9, 0xc1, 0x51,
+		0x7a, 0x9a, 0xdd, 0x6d, 0x6b, 0x39, 0xa8, 0x82, 0xf8, 0x44, 0xc8, 0x53, 0x22, 0xab, 0xba, 0xed,
+		0xb0, 0x22, 0x52, 0x81, 0x74, 0xa7, 0x65, 0x6f, 0x1b, 0x7b, 0xfa, 0xcd, 0x96, 0xd5, 0xe3, 0xf3,
+		0x2a, 0x44, 0x7a, 0xd6, 0x3c, 0x20, 0x4e, 0xca, 0x8f, 0xe7, 0x1b, 0x94, 0xa9, 0x80, 0x34, 0x0b,
+		0xcd, 0x12, 0x3f, 0xb4, 0x66, 0x79, 0x1c, 0x66, 0x4d, 0xe3, 0xb6, 0xa3, 0x79, 0x33, 0x97, 0x49,
+		0x0a, 0x7b, 0xf9, 0x80, 0x60, 0x99, 0x3b, 0xd7, 0x6d, 0x7a, 0x2a, 0xf8, 0x28, 0xb8, 0xfb, 0x4a,
+		0x4d, 0x6c, 0x79, 0x93, 0x14, 0x3a, 0x2f, 0xf2, 0x8b, 0x2c, 0x5b, 0xb9, 0x43, 0x07, 0x27, 0x6c,
+		0x75, 0x14, 0xac, 0x97, 0x3c, 0xd6, 0xd7, 0x61, 0x96, 0xe3, 0x37, 0x03, 0xdc, 0x1f, 0xda, 0xc3,
+		0x07, 0x8d, 0x2e, 0x1f, 0xd7, 0x89, 0xc0, 0x1f, 0xcd, 0xf8, 0xe8, 0x3d, 0x32, 0x9e, 0x40, 0x8c,
+		0xb2, 0x25, 0xc6, 0xd4, 0x0d, 0xfe, 0xfe, 0x9b, 0x36, 0x18, 0xdf, 0x1e, 0x85, 0xe9, 0x21, 0xc3,
+		0xc2, 0xed, 0x98, 0x14, 0xda, 0xb1, 0x48, 0x68, 0xc7, 0xa2, 0x87, 0xee, 0x18, 0x1f, 0xed, 0xd8,
+		0xf8, 0xd1, 0x9e, 0xfa, 0x4a, 0x8e, 0x76, 0xfc, 0x1e, 0x47, 0xfb, 0xab, 0x3a, 0x0e, 0x3f, 0x2c,
+		0xc1, 0xdc, 0x68, 0x73, 0x2c, 0x74, 0x40, 0xce, 0xc1, 0xb4, 0xdb, 0x14, 0x97, 0x3c, 0x53, 0x8f,
+		0xb2, 0x5b, 0xc0, 0xe9, 0x8f, 0x5c, 0xf1, 0x1e, 0x82, 0xdc, 0x80, 0xb5, 0xc8, 0x84, 0x39, 0x1b,
+		0xf0, 0xc0, 0x2a, 0xbf, 0x14, 0xc5, 0x6f, 0x0d, 0x0c, 0x1b, 0x74, 0x21, 0x33, 0x56, 0xc5, 0xd7,
+		0xe5, 0x1a, 0xad, 0xe6, 0x3d, 0x4f, 0xd8, 0x69, 0x8e, 0xfe, 0xff, 0xe6, 0xeb, 0xb0, 0x9c, 0xe0,
+		0xbb, 0xc0, 0x78, 0x74, 0x85, 0xbe, 0x31, 0xc7, 0x12, 0xb6, 0x51, 0x8a, 0x1d, 0x09, 0xf1, 0x82,
+		0xba, 0xc5, 0xad, 0xa3, 0x9f, 0x00, 0x48, 0xaa, 0x86, 0xdd, 0x45, 0x63, 0x8e, 0x7d, 0x53, 0xa7,
+		0x61, 0x74, 0x1d, 0xef, 0x54, 0x23, 0x74, 0x8f, 0xc1, 0x41, 0x04, 0x1e, 0xee, 0xb5, 0x5d, 0x3c,
+		0xf2, 0x24, 0x77, 0x29, 0x8c, 0x74, 0x0e, 0x30, 0x53, 0xdd, 0x45, 0xa5, 0xd0, 0xe4, 0x69, 0xe1,
+		0x53, 0x88, 0x8e, 0xda, 0x29, 0x73, 0xc3, 0xdd, 0xc5, 0x63, 0xf0, 0x58, 0x1d, 0x75, 0x2a, 0xc4,
+		0x46, 0x55, 0xc7, 0xec, 0x7b, 0xaf, 0xba, 0x16, 0xbb, 0x61, 0xef, 0xf7, 0x2a, 0xc4, 0x47, 0x75,
+		0xd5, 0x67, 0x88, 0x7b, 0x5d, 0xf5, 0xdc, 0x0a, 0x4f, 0x0b, 0xb7, 0x42, 0x62, 0x54, 0xa3, 0xb9,
+		0xe5, 0xe9, 0x35, 0x9a, 0xc2, 0x93, 0xb7, 0xfa, 0xfc, 0x0a, 0xa9, 0xc1, 0x53, 0xd8, 0x21, 0xbf,
+		0x82, 0x8b, 0xed, 0x3a, 0x16, 0x96, 0x5c, 0xc7, 0x42, 0x66, 0xa4, 0x57, 0x82, 0x9b, 0x8c, 0x2e,
+		0x32, 0xc7, 0x20, 0x9b, 0x43, 0x9e, 0x05, 0xe6, 0x08, 0x78, 0x64, 0xac, 0x67, 0xc1, 0x25, 0x35,
+		0xe0, 0x5a, 0xd8, 0x1c, 0x72, 0x2d, 0xe4, 0x46, 0x51, 0x1c, 0xb0, 0x4f, 0x3d, 0x8a, 0x41, 0xdf,
+		0xc2, 0x37, 0x86, 0xfb, 0x16, 0x46, 0x6e, 0xfe, 0x43, 0x6c, 0x51, 0x97, 0x74, 0x88, 0x73, 0xe1,
+		0xed, 0x23, 0x9c, 0x0b, 0xf2, 0xa8, 0x4d, 0x70, 0x98, 0x25, 0xea, 0x56, 0x10, 0xe6, 0x5d, 0xb8,
+		0x1e, 0xe2, 0x5d, 0x60, 0x6e, 0x80, 0x47, 0x27, 0xf0, 0x2e, 0xb8, 0xa4, 0x87, 0xdc, 0x0b, 0xd7,
+		0x43, 0xdc, 0x0b, 0x64, 0x34, 0xdd, 0x01, 0x03, 0xca, 0x4f, 0x37, 0x50, 0x44, 0x56, 0x82, 0xfe,
+		0x85, 0x99, 0x83, 0xed, 0x56, 0x66, 0x06, 0xb8, 0xd4, 0xfc, 0x0e, 0x86, 0xc6, 0x28, 0x07, 0x03,
+		0xf3, 0x01, 0x3c, 0x36, 0xa1, 0x83, 0xc1, 0xa5, 0x1d, 0xea, 0x61, 0xd8, 0x1c, 0xf2, 0x30, 0x1c,
+		0x1d, 0x25, 0x70, 0x03, 0x0b, 0x92, 0x27, 0x70, 0x23, 0x5d, 0x0c, 0xec, 0x59, 0x65, 0xf6, 0xa0,
+		0x32, 0xc8, 0xe9, 0x6b, 0xb1, 0x64, 0x5a, 0xce, 0x28, 0x8f, 0xa2, 0x09, 0x34, 0xa0, 0xf7, 0x70,
+		0xc3, 0x61, 0xf4, 0x7a, 0x56, 0x4f, 0x84, 0xc0, 0xd0, 0x84, 0x72, 0x06, 0x32, 0x7e, 0x15, 0x77,
+		0x80, 0x3b, 0x22, 0x0f, 0xd9, 0x80, 0x56, 0x53, 0x7e, 0x4d, 0x82, 0x8c, 0x5f, 0x5f, 0x05, 0x36,
+		0xab, 0x29, 0xbe, 0x59, 0xf5, 0x39, 0x29, 0x22, 0x41, 0x27, 0x05, 0x3e, 0x4e, 0xd9, 0x1d, 0xf2,
+		0x3f, 0xe8, 0x5d, 0xd7, 0xff, 0x70, 0x16, 0xa6, 0xe9, 0x7a, 0xcb, 0x5c, 0x19, 0x7c, 0x65, 0x60,
+		0x47, 0x3e, 0x79, 0x2c, 0xa0, 0xcc, 0xe0, 0xa7, 0xbc, 0x8f, 0xc1, 0x8c, 0x0f, 0xd6, 0xdd, 0x08,
+		0xb2, 0xad, 0xb8, 0xec, 0x42, 0x17, 0xf9, 0x8e, 0xf0, 0x37, 0x25, 0x98, 0x1e, 0x52, 0x97, 0xa1,
+		0x3e, 0x06, 0xe9, 0x2b, 0xe5, 0x63, 0x88, 0xdc, 0xbb, 0x8f, 0xc1, 0xbf, 0xb5, 0x8d, 0x06, 0xb7,
+		0xb6, 0x5f, 0x94, 0x20, 0x1b, 0x50, 0xdb, 0x38, 0x08, 0x0d, 0xab, 0x29, 0x02, 0xa6, 0xe8, 0x6f,
+		0xb4, 0x69, 0xda, 0x96, 0x78, 0x0f, 0x17, 0x7f, 0x22, 0x94, 0xbb, 0x10, 0xa5, 0xf8, 0x32, 0xe3,
+		0xee, 0x53, 0xa7, 0xfc, 0xa1, 0x93, 0x3c, 0x9c, 0x30, 0xee, 0x85, 0x13, 0xba, 0x71, 0x52, 0x09,
+		0x5f, 0x9c, 0x14, 0xb9, 0x8c, 0x41, 0x06, 0x96, 0xb5, 0xa3, 0x59, 0x5d, 0xb6, 0xd6, 0x07, 0x6c,
+		0xa3, 0xa1, 0x50, 0x42, 0x9b, 0x1e, 0x48, 0xd2, 0x5f, 0x3e, 0x8b, 0x25, 0x15, 0xb0, 0x58, 0xee,
+		0x83, 0x14, 0x36, 0x9f, 0x3d, 0xae, 0x0f, 0x3c, 0x76, 0x57, 0x64, 0x28, 0x3f, 0x13, 0x81, 0xfc,
+		0xc0, 0xaa, 0x13, 0xda, 0x79, 0x21, 0x95, 0x11, 0x9f, 0x0b, 0x65, 0x32, 0x86, 0x9c, 0x02, 0xd8,
+		0xd5, 0x6d, 0xed, 0x96, 0x6e, 0x3a, 0xfc, 0x83, 0x58, 0x51, 0xd5, 0x97, 0x83, 0x9f, 0x72, 0xc2,
+		0x54, 0xdf, 0xe6, 0x9f, 0xc4, 0x8a, 0xaa, 0x6e, 0x9a, 0x54, 0x21, 0x6e, 0xdc, 0xa4, 0xef, 0xc4,
+		0xb0, 0xf7, 0x10, 0x8f, 0x87, 0xa8, 0x27, 0x2c, 0x2f, 0x15, 0x70, 0xb8, 0x3f, 0xfb, 0xda, 0xbc,
+		0xcc, 0xc0, 0xdf, 0xe4, 0xbe, 0xf6, 0xa0, 0x72, 0x02, 0x41, 0x36, 0x24, 0x07, 0xd8, 0x40, 0x5d,
+		0x8b, 0x19, 0xe1, 0x27, 0x40, 0xa6, 0xb2, 0x33, 0x50, 0x35, 0xdb, 0x31, 0x3a, 0x5d, 0xcb, 0x6a,
+		0x6b, 0x6c, 0x9e, 0x17, 0x21, 0x17, 0x5c, 0x64, 0xd9, 0x07, 0x6c, 0x1c, 0xf4, 0xb6, 0x05, 0xec,
+		0xe8, 0x0c, 0xcb, 0x64, 0xf3, 0xca, 0xff, 0x0a, 0x90, 0xf2, 0x36, 0x38, 0x1a, 0xba, 0xc6, 0x92,
+		0x67, 0x20, 0xe5, 0xad, 0xcf, 0xec, 0x1c, 0xfb, 0x20, 0x9f, 0x8d, 0x07, 0x8c, 0x0f, 0x85, 0x85,
+		0x2e, 0xb2, 0xe4, 0x2d, 0x10, 0xef, 0x19, 0x76, 0xbf, 0xed, 0xf0, 0x17, 0xff, 0x1e, 0x1a, 0xbf,
+		0x3a, 0xe3, 0x4b, 0x51, 0x1c, 0x49, 0xb9, 0x00, 0x27, 0x46, 0xae, 0xb2, 0x9e, 0xe7, 0x45, 0xf2,
+		0x79, 0x5e, 0x94, 0x9f, 0x97, 0x60, 0x6e, 0xf4, 0xca, 0x49, 0x4a, 0x03, 0x0d, 0x3a, 0x3b, 0xe1,
+		0xba, 0xeb, 0x6b, 0x15, 0x6e, 0x4d, 0x7a, 0xc6, 0x8e, 0xe1, 0x34, 0xf6, 0xd8, 0x12, 0xce, 0x94,
+		0x42, 0x56, 0xcd, 0xf2, 0x5c, 0x8a, 0x63, 0x33, 0xb0, 0x57, 0x8c, 0x86, 0xa3, 0xb1, 0x41, 0xb5,
+		0xf9, 0xa7, 0x4e, 0xb3, 0x2c, 0xb7, 0xc6, 0x32, 0x95, 0x73, 0x70, 0x7c, 0xc4, 0x5a, 0x3c, 0xbc,
+		0x87, 0x51, 0x5e, 0x46, 0xe0, 0xd0, 0x05, 0x96, 0x3c, 0x07, 0x71, 0xdb, 0xd1, 0x9d, 0xbe, 0xcd,
+		0x7b, 0xf6, 0xc8, 0xd8, 0xb5, 0xb9, 0x46, 0xc1, 0x55, 0x8e, 0xa6, 0x3c, 0x0b, 0x64, 0x78, 0xa5,
+		0x0d, 0xd9, 0x87, 0x49, 0x61, 0xfb, 0xb0, 0x6d, 0x38, 0x79, 0xc0, 0x9a, 0x4a, 0x96, 0x07, 0x1a,
+		0x77, 0x6e, 0xa2, 0x25, 0x79, 0xa0, 0x81, 0x7f, 0x16, 0x81, 0xa3, 0xa1, 0x4b, 0xab, 0x6f, 0x96,
+		0x4a, 0x6f, 0x74, 0x96, 0xbe, 0x05, 0xc0, 0xb9, 0xad, 0xb1, 0x91, 0x16, 0xda, 0x3e, 0x6c, 0x3f,
+		0x71, 0xdb, 0x68, 0xd4, 0x6f, 0x73, 0xc1, 0x48, 0x39, 0xfc, 0x17, 0x3a, 0x0a, 0x7c, 0x7b, 0xdf,
+		0x3e, 0x5d, 0x09, 0xec, 0x42, 0xf4, 0x70, 0x6b, 0x86, 0x7c, 0x33, 0x98, 0x6d, 0x93, 0x97, 0xe1,
+		0xf8, 0xc0, 0x8a, 0xe6, 0xd2, 0x8e, 0x4d, 0xbc, 0xb0, 0x1d, 0x0d, 0x2e, 0x6c, 0x82, 0xb6, 0x7f,
+		0x55, 0x9a, 0x0a, 0xae, 0x4a, 0x2f, 0x03, 0x78, 0x9b, 0x60, 0x9c, 0x6f, 0x3d, 0xab, 0x6f, 0x36,
+		0x45, 0xa0, 0x2f, 0x4d, 0xe0, 0x29, 0x27, 0x4a, 0x82, 0x60, 0x55, 0x88, 0xc2, 0xc0, 0x21, 0xf5,
+		0xed, 0xa2, 0x19, 0xb8, 0xf2, 0x0a, 0x90, 0x61, 0x7f, 0xe4, 0x88, 0x3a, 0xde, 0x1a, 0xac, 0x43,
+		0x19, 0xed, 0xda, 0x0c, 0xaf, 0xeb, 0xff, 0x83, 0x29, 0x3a, 0xfc, 0xa1, 0x71, 0xf6, 0xdf, 0x04,
+		0xa0, 0x3b, 0x4e, 0xaf, 0xb5, 0xdd, 0xf7, 0x6a, 0x58, 0x18, 0x21, 0x3f, 0x45, 0x01, 0x58, 0xba,
+		0x8f, 0x0b, 0xd2, 0xac, 0x87, 0xeb, 0x13, 0x26, 0x1f, 0x45, 0x65, 0x1d, 0x72, 0x41, 0xdc, 0xf0,
+		0x8b, 0x03, 0xc3, 0x6f, 0xf6, 0x7b, 0x0b, 0x39, 0xbf, 0x9c, 0x44, 0x13, 0xca, 0xb7, 0x44, 0x20,
+		0xe3, 0x97, 0xbe, 0xbf, 0x83, 0x8b, 0xa5, 0xf2, 0x1d, 0x12, 0x24, 0xdd, 0xfe, 0x07, 0x5d, 0xff,
+		0x81, 0x33, 0x13, 0xef, 0x0a, 0x89, 0xeb, 0xaf, 0x67, 0x27, 0x24, 0x51, 0xf7, 0x84, 0xe4, 0xcd,
+		0xee, 0x82, 0x30, 0x72, 0x33, 0xef, 0xe7, 0x36, 0x17, 0x2c, 0xb1, 0x40, 0x3d, 0x3b, 0x59, 0x50,
+		0x15, 0x1e, 0xdf, 0xf8, 0x22, 0xa2, 0x58, 0x42, 0xb1, 0x7d, 0xd1, 0xaa, 0x6c, 0x36, 0x7a, 0x80,
+		0x11, 0x1f, 0xe0, 0x70, 0x1c, 0x54, 0x74, 0x7c, 0x1c, 0x54, 0x6c, 0x30, 0x0e, 0x8a, 0xd9, 0x00,
+		0xca, 0x3f, 0x94, 0x20, 0x29, 0x66, 0x09, 0x79, 0xce, 0x1f, 0x59, 0x2d, 0xc2, 0x34, 0x47, 0x6b,
+		0x29, 0xde, 0x79, 0x0f, 0x87, 0x94, 0xc4, 0x09, 0x65, 0xab, 0xa9, 0xed, 0xb4, 0x75, 0xf1, 0x9a,
+		0xed, 0xc8, 0xb8, 0x70, 0x16, 0x57, 0xc4, 0x43, 0x5d, 0xab, 0x4d, 0x4c, 0x70, 0xab, 0xe4, 0xaf,
+		0x24, 0x90, 0x07, 0x67, 0xf1, 0x1b, 0x6f, 0xdf, 0xf0, 0xea, 0x15, 0x0d, 0x59, 0xbd, 0xf0, 0xc3,
+		0x13, 0x2e, 0x84, 0x66, 0xb7, 0x76, 0x4d, 0x16, 0x76, 0xcd, 0xdc, 0x71, 0xc4, 0x2d, 0xaa, 0x89,
+		0x92, 0xe1, 0x7e, 0x4f, 0xdd, 0x6b, 0xbf, 0xbf, 0x3d, 0x02, 0x69, 0x9f, 0x77, 0x10, 0xc3, 0xcd,
+		0x7d, 0x1f, 0xce, 0x39, 0x7d, 0xa0, 0x2b, 0xd1, 0xf7, 0xad, 0xd2, 0x00, 0xa7, 0x22, 0xf7, 0xc0,
+		0xa9, 0x51, 0x7e, 0x58, 0xe1, 0x6e, 0x8c, 0x1d, 0xda, 0xdd, 0x18, 0x1e, 0x9f, 0x38, 0x35, 0x22,
+		0x3e, 0xf1, 0x5b, 0x25, 0x48, 0xba, 0xae, 0x98, 0xc3, 0x9e, 0xe6, 0x1d, 0x83, 0x38, 0xb7, 0xc4,
+		0xd8, 0x71, 0x1e, 0x4f, 0x85, 0xfa, 0x55, 0xe7, 0x20, 0x29, 0xbe, 0xf9, 0xc5, 0xd7, 0x3b, 0x37,
+		0x7d, 0x76, 0x1b, 0xd2, 0xbe, 0x03, 0x51, 0x72, 0x02, 0x8e, 0x2e, 0x5f, 0xad, 0x2c, 0x3f, 0xaf,
+		0xd5, 0x5f, 0x1c, 0x7c, 0x30, 0x77, 0xa8, 0x48, 0xad, 0xd0, 0x34, 0x7b, 0x3f, 0x30, 0x58, 0xc4,
+		0x0a, 0x22, 0x73, 0x31, 0x0c, 0xa5, 0x3b, 0xfb, 0x57, 0x12, 0xcc, 0x84, 0xd8, 0xbc, 0xe4, 0x34,
+		0xdc, 0xbf, 0x71, 0xe5, 0x4a, 0x45, 0xd5, 0x6a, 0xeb, 0xc5, 0xcd, 0xda, 0xd5, 0x8d, 0xba, 0xa6,
+		0x56, 0x6a, 0x5b, 0xab, 0xfe, 0x07, 0x67, 0x17, 0xe0, 0xbe, 0x70, 0x90, 0xe2, 0xf2, 0x72, 0x65,
+		0xb3, 0xce, 0x3e, 0xe5, 0x31, 0x02, 0xa2, 0x44, 0x1f, 0xa4, 0x1d, 0x4d, 0x42, 0xad, 0x5c, 0xab,
+		0x2c, 0xd7, 0xe5, 0x28, 0x79, 0x04, 0x1e, 0x38, 0x08, 0x82, 0x3f, 0x83, 0x2b, 0xc7, 0xc6, 0x02,
+		0xd6, 0x2a, 0xeb, 0xe5, 0x8a, 0x2a, 0x4f, 0xf1, 0x7e, 0x7f, 0x28, 0x02, 0x85, 0x51, 0xa6, 0x35,
+		0xd2, 0x2a, 0x6e, 0x6e, 0xae, 0xbe, 0xe4, 0xd1, 0x5a, 0xbe, 0xba, 0xb5, 0xfe, 0xfc, 0x30, 0x0b,
+		0x1e, 0x06, 0xe5, 0x20, 0x40, 0x97, 0x11, 0x0f, 0xc1, 0xe9, 0x03, 0xe1, 0x38, 0x3b, 0xc6, 0x80,
+		0xa9, 0x15, 0xfc, 0x0e, 0x49, 0x94, 0x2c, 0xc2, 0xd9, 0xb1, 0x60, 0x6e, 0x99, 0x1c, 0x23, 0xe7,
+		0xe1, 0xdc, 0xc1, 0xf0, 0x8c, 0x41, 0x02, 0x41, 0xb0, 0xe8, 0x8e, 0x04, 0x47, 0x43, 0x6d, 0x74,
+		0x7c, 0x70, 0x79, 0x53, 0xdd, 0x58, 0xae, 0xd4, 0x6a, 0xf8, 0xce, 0xe5, 0xe6, 0x46, 0xad, 0xb8,
+		0xaa, 0xd5, 0xea, 0xc5, 0xfa, 0x56, 0xcd, 0xc7, 0x1b, 0x05, 0x4e, 0x8d, 0x02, 0x72, 0xf9, 0x72,
+		0x00, 0x0c, 0x97, 0x00, 0x21, 0xa7, 0x77, 0x25, 0x38, 0x31, 0xd2, 0x26, 0x27, 0x67, 0xe0, 0x41,
+		0xf6, 0x26, 0xb3, 0x76, 0x7d, 0xa3, 0xee, 0xff, 0x66, 0xcc, 0x50, 0xab, 0x1e, 0x81, 0x07, 0x0e,
+		0x84, 0x74, 0x9b, 0x36, 0x0e, 0x70, 0xa0, 0x7d, 0xef, 0x96, 0x20, 0x3f, 0xa0, 0x0b, 0xe9, 0xd3,
+		0xcf, 0xd5, 0x5a, 0xa9, 0x72, 0xb5, 0x78, 0xbd, 0xba, 0xa1, 0x0e, 0xce, 0xd9, 0x07, 0x60, 0x7e,
+		0xa8, 0xb4, 0xbc, 0x85, 0x6f, 0x67, 0x17, 0xeb, 0x15, 0x5a, 0xa9, 0x2c, 0x61, 0xc7, 0x86, 0x80,
+		0x56, 0xab, 0x2b, 0x57, 0xeb, 0xda, 0xf2, 0x6a, 0xb5, 0xb2, 0x5e, 0xd7, 0x8a, 0xf5, 0x7a, 0xd1,
+		0x9b, 0xce, 0xa5, 0xe7, 0x47, 0x46, 0xc5, 0x5e, 0x98, 0x3c, 0x2a, 0x96, 0x47, 0x77, 0x7a, 0xf7,
+		0x04, 0x23, 0x30, 0xef, 0x16, 0x72, 0xcf, 0xda, 0x60, 0xf4, 0xe7, 0x8c, 0x00, 0x58, 0xe4, 0x00,
+		0xa3, 0xe3, 0xfd, 0xdf, 0x02, 0xd1, 0x62, 0xb7, 0x8b, 0x9a, 0x8f, 0xa6, 0x1b, 0x56, 0x9b, 0xeb,
+		0x55, 0x37, 0x8d, 0x65, 0xb6, 0xb5, 0xe3, 0xdc, 0xd2, 0x7b, 0xee, 0xe7, 0xac, 0x45, 0x5a, 0xb9,
+		0x0c, 0x29, 0x77, 0x2f, 0x41, 0x9f, 0x98, 0x75, 0x6f, 0x80, 0xc5, 0xc4, 0x0d, 0x2f, 0x7e, 0x4d,
+		0x26, 0xe2, 0x5d, 0x93, 0x89, 0x61, 0xf0, 0x7f, 0x69, 0x7d, 0x24, 0x77, 0x9e, 0x9c, 0x9c, 0x3b,
+		0x1e, 0x03, 0x5c, 0x06, 0x7d, 0xdf, 0xfd, 0xbe, 0xdb, 0x10, 0x6e, 0xac, 0xaa, 0x9f, 0x3d, 0x21,
+		0xe1, 0xfe, 0xe3, 0xa2, 0x63, 0x27, 0x88, 0x7e, 0x1d, 0x37, 0x2a, 0xf7, 0x1a, 0x1e, 0x7b, 0x19,
+		0xb2, 0xf8, 0x71, 0x85, 0x9a, 0xe1, 0x5c, 0x35, 0xf4, 0xa6, 0xd1, 0x0b, 0xde, 0x8a, 0xce, 0x8a,
+		0x5b, 0xd1, 0x62, 0x3d, 0x8b, 0x78, 0xeb, 0x99, 0xd2, 0x82, 0x18, 0xa2, 0x8e, 0x0e, 0x4f, 0x61,
+		0x26, 0x23, 0x43, 0x61, 0x09, 0xf2, 0x94, 0xb8, 0xf7, 0x1c, 0x1d, 0x73, 0xef, 0x59, 0xec, 0xa3,
+		0x28, 0xb4, 0xd2, 0x81, 0x04, 0xb7, 0x66, 0x42, 0xcf, 0x7d, 0xd7, 0x21, 0xdf, 0xd5, 0x7b, 0x0e,
+		0xfd, 0xcc, 0xe3, 0x1e, 0xed, 0x06, 0xb7, 0x44, 0xc2, 0x2e, 0xae, 0x05, 0xba, 0xcb, 0xab, 0xc9,
+		0x76, 0xfd, 0x99, 0xca, 0xeb, 0x31, 0x88, 0x73, 0x76, 0xbc, 0x35, 0x18, 0x23, 0x17, 0x30, 0xd3,
+		0x3d, 0xf1, 0xf7, 0xb6, 0xbc, 0x9c, 0xa0, 0x40, 0x22, 0x0f, 0x0f, 0x46, 0xa4, 0x95, 0xd2, 0x9f,
+		0x7a, 0x6d, 0x3e, 0x41, 0xfd, 0xc6, 0xd5, 0xb2, 0x17, 0x9e, 0xf6, 0x95, 0xb7, 0x82, 0xca, 0x90,
+		0xf5, 0x79, 0xb4, 0x5b, 0x4d, 0x1e, 0x32, 0x30, 0x37, 0xda, 0x52, 0x14, 0x07, 0xc4, 0xae, 0xb7,
+		0xbb, 0xda, 0xc4, 0x6f, 0x9f, 0xfa, 0xce, 0xac, 0xd9, 0x66, 0x9d, 0xb9, 0x72, 0x73, 0x6d, 0xf7,
+		0x34, 0x9a, 0x1e, 0xd9, 0x9e, 0x84, 0x14, 0xfd, 0x9c, 0xa9, 0xef, 0x64, 0x37, 0x89, 0x19, 0xb4,
+		0xf0, 0x11, 0xc8, 0x0f, 0x1e, 0xfe, 0xb2, 0xe3, 0xdc, 0xdc, 0xcd, 0xe0, 0xc1, 0xef, 0xa8, 0xa3,
+		0xe2, 0xd4, 0xc8, 0xa3, 0xe2, 0x87, 0x20, 0xe7, 0xb9, 0x28, 0x28, 0x2c, 0x30, 0x4b, 0xdb, 0xcd,
+		0xa5, 0x60, 0x7e, 0x6f, 0x43, 0x3a, 0xe0, 0x6d, 0x70, 0xcf, 0x09, 0xb8, 0xef, 0x85, 0xc1, 0x64,
+		0xd8, 0x69, 0x33, 0x16, 0x70, 0x17, 0x0b, 0x85, 0x7d, 0x00, 0xb2, 0xe2, 0x7a, 0x28, 0x83, 0xcb,
+		0x52, 0xb8, 0x8c, 0xc8, 0x1c, 0x79, 0x7a, 0x9d, 0x0b, 0x8f, 0x72, 0x28, 0x40, 0xac, 0xcc, 0xf7,
+		0xc8, 0x03, 0x1e, 0xb7, 0x8f, 0x45, 0x21, 0x46, 0x0f, 0x99, 0x9e, 0x0c, 0x18, 0xe6, 0x61, 0x22,
+		0x8d, 0xdb, 0x03, 0xa3, 0xb9, 0x66, 0xef, 0xfa, 0xec, 0xf2, 0x51, 0xc1, 0x29, 0xae, 0xa3, 0x23,
+		0xea, 0x77, 0x74, 0x5c, 0x81, 0xa4, 0x2b, 0x27, 0xb1, 0xb1, 0x72, 0x92, 0x47, 0x39, 0x41, 0x31,
+		0xe6, 0x19, 0x6a, 0x82, 0x6f, 0x2f, 0x48, 0x09, 0x52, 0xae, 0x86, 0x29, 0x4c, 0x1d, 0x42, 0x66,
+		0x3d, 0xb4, 0xf0, 0x28, 0x8e, 0xf8, 0x88, 0x28, 0x0e, 0xbf, 0x60, 0x69, 0x4c, 0x0d, 0x25, 0x68,
+		0xc7, 0x3c, 0xc1, 0xaa, 0x62, 0x2e, 0xee, 0xeb, 0xbd, 0xfd, 0x15, 0x93, 0x3d, 0x2f, 0x03, 0x4b,
+		0xbd, 0x9d, 0x1a, 0x93, 0xb5, 0x94, 0x31, 0x6e, 0x97, 0x06, 0xa3, 0x76, 0x69, 0xca, 0xbf, 0x92,
+		0x20, 0xce, 0x03, 0x35, 0x0e, 0x70, 0x12, 0xb0, 0x71, 0x88, 0x8c, 0x1a, 0x87, 0xe8, 0x1b, 0x1a,
+		0x07, 0x70, 0xdb, 0x29, 0xc2, 0x53, 0xef, 0x0b, 0x75, 0xd5, 0x61, 0x23, 0x6b, 0xad, 0x5d, 0x71,
+		0x6a, 0xe4, 0x61, 0x29, 0xaf, 0x49, 0x90, 0x72, 0xcb, 0x87, 0x37, 0x9e, 0xd2, 0xa1, 0x37, 0x9e,
+		0x87, 0x8b, 0xcf, 0x09, 0x88, 0x52, 0xf4, 0xde, 0x44, 0x29, 0x30, 0xe8, 0xb1, 0x81, 0x41, 0x57,
+		0x3e, 0x8d, 0x17, 0x8c, 0x03, 0xae, 0xc0, 0xff, 0x4b, 0xa3, 0xf5, 0xf5, 0x5c, 0xbe, 0x30, 0x42,
+		0x68, 0x68, 0xd8, 0x1e, 0x0c, 0x21, 0x19, 0x6c, 0xb5, 0x37, 0x7c, 0x44, 0x90, 0xa9, 0x79, 0xc3,
+		0xf8, 0x2b, 0x11, 0x98, 0x1e, 0x82, 0xff, 0x5b, 0x38, 0x9c, 0xc1, 0x39, 0x3c, 0x35, 0xe1, 0x1c,
+		0x8e, 0x8f, 0x9c, 0xc3, 0xbf, 0x12, 0x81, 0xa4, 0xd8, 0x0c, 0xfd, 0xb5, 0xe8, 0xe0, 0x93, 0x90,
+		0xea, 0x5a, 0x6d, 0x8d, 0x95, 0xb0, 0x6f, 0x26, 0x24, 0xbb, 0x56, 0x5b, 0x1d, 0x12, 0xb5, 0xa9,
+		0xaf, 0x94, 0x82, 0x8e, 0x7f, 0x05, 0x86, 0x21, 0x31, 0x38, 0xab, 0x1c, 0xc8, 0x30, 0x5e, 0x70,
+		0x0b, 0xea, 0x02, 0x32, 0x01, 0x7f, 0xb9, 0x37, 0xc7, 0x87, 0xdb, 0xcd, 0x40, 0xd5, 0xf8, 0x9e,
+		0x8b, 0x12, 0x88, 0x91, 0x3b, 0x31, 0x52, 0x73, 0x89, 0x38, 0x1f, 0xe5, 0x55, 0x09, 0x60, 0x15,
+		0x99, 0x4b, 0x7b, 0x8c, 0xc6, 0x8f, 0x4d, 0x1b, 0xa1, 0x05, 0xea, 0x9e, 0x1f, 0x39, 0x70, 0xbc,
+		0x05, 0x19, 0xdb, 0xdf, 0xf4, 0x32, 0x64, 0x3d, 0x01, 0xb7, 0x0d, 0xd1, 0x9c, 0xf9, 0x83, 0xee,
+		0xc9, 0xe2, 0x97, 0x50, 0x32, 0x37, 0x7d, 0x29, 0xe5, 0x5f, 0x4b, 0x90, 0xa2, 0xad, 0xc2, 0x8f,
+		0xba, 0x07, 0x06, 0x52, 0x7a, 0x03, 0x03, 0x79, 0x3f, 0x00, 0xa3, 0x83, 0x0f, 0xa7, 0x71, 0xf9,
+		0x4a, 0xd1, 0x1c, 0x7c, 0x0e, 0x8d, 0x3c, 0xed, 0x72, 0x3d, 0x3a, 0x86, 0xeb, 0xc2, 0xb1, 0xcc,
+		0x79, 0x7f, 0x1c, 0x12, 0xf4, 0xf3, 0x64, 0xb7, 0x6d, 0x1e, 0x2a, 0x11, 0xc7, 0x2f, 0x38, 0xdd,
+		0xb6, 0x95, 0x1b, 0x90, 0xa8, 0xdf, 0x66, 0x2f, 0x27, 0x9d, 0x84, 0x54, 0xcf, 0xb2, 0xb8, 0x35,
+		0xc8, 0x0c, 0xf1, 0x24, 0x66, 0x50, 0xe3, 0x27, 0xec, 0x04, 0xe0, 0xfc, 0xa4, 0x66, 0x3f, 0x37,
+		0xf8, 0xf1, 0x32, 0x6b, 0x36, 0x30, 0xa3, 0xc8, 0x9b, 0xe0, 0x78, 0xad, 0xba, 0xb2, 0x5e, 0x29,
+		0x6b, 0x6b, 0xb5, 0x95, 0x81, 0x0d, 0xf6, 0x5c, 0xfe, 0xce, 0xdd, 0x85, 0x34, 0xbf, 0xc5, 0x3a,
+		0x0a, 0x7a, 0x53, 0xad, 0xb0, 0x9d, 0x36, 0x83, 0xde, 0xec, 0x19, 0xe8, 0x8a, 0xa5, 0xd0, 0x8f,
+		0xc3, 0x89, 0x10, 0x68, 0xf7, 0x2e, 0xeb, 0xf4, 0x9d, 0xbb, 0x0b, 0xd9, 0xcd, 0x9e, 0xc1, 0x44,
+		0x8d, 0x62, 0x2c, 0x42, 0x61, 0x18, 0x83, 0x79, 0x35, 0xe4, 0x85, 0x39, 0xf9, 0xce, 0xdd, 0x85,
+		0x8c, 0xd0, 0x1d, 0x08, 0xff, 0x55, 0xbf, 0xcc, 0xfa, 0x81, 0x14, 0x9c, 0x60, 0x0f, 0x68, 0x69,
+		0x6c, 0x0f, 0xc8, 0x12, 0x7c, 0x4b, 0x9a, 0xf1, 0x17, 0x8d, 0xff, 0x32, 0x82, 0xf2, 0x3c, 0xcc,
+		0x54, 0x4d, 0xc7, 0xe8, 0xe1, 0xbb, 0xa7, 0xde, 0x17, 0x3f, 0x70, 0x64, 0xe9, 0x27, 0x0e, 0x25,
+		0xef, 0x8b, 0x19, 0x64, 0x21, 0xf0, 0xc4, 0x27, 0xdf, 0xc1, 0xfb, 0xb3, 0x94, 0x6f, 0x91, 0x40,
+		0xae, 0x35, 0xf4, 0xb6, 0xde, 0x7b, 0xa3, 0xa4, 0xc8, 0xd3, 0xe2, 0x8b, 0x18, 0xfc, 0x6a, 0x49,
+		0x94, 0x7e, 0x1d, 0xc9, 0xdf, 0xb9, 0x45, 0x56, 0x13, 0xd5, 0xc1, 0xec, 0x4b, 0x18, 0xf8, 0xf3,
+		0xec, 0x8b, 0x00, 0x5e, 0x01, 0x7e, 0xf3, 0xab, 0xb6, 0x5c, 0x5c, 0x2d, 0xba, 0x7e, 0x9a, 0xda,
+		0x66, 0x65, 0xb9, 0x7a, 0xa5, 0x5a, 0x29, 0xcb, 0x47, 0xf0, 0x83, 0x5a, 0xfe, 0x42, 0xf7, 0xab,
+		0xca, 0x47, 0x61, 0xda, 0x9f, 0x5f, 0x7a, 0xa9, 0x5e, 0xa9, 0xc9, 0x91, 0xa5, 0xab, 0xf8, 0x36,
+		0x30, 0x7e, 0x47, 0x13, 0x17, 0x40, 0xa3, 0xa9, 0xb5, 0x4c, 0x72, 0xea, 0xe0, 0x2f, 0x6d, 0x16,
+		0x7e, 0xe7, 0x3f, 0xb1, 0xef, 0x63, 0x64, 0x19, 0x62, 0x11, 0xf1, 0xaa, 0x26, 0xbe, 0x32, 0x49,
+		0x2f, 0x8b, 0xd3, 0xaf, 0xff, 0x68, 0x2d, 0xc1, 0xff, 0xf1, 0x0f, 0x18, 0x22, 0x3d, 0x3c, 0xde,
+		0x9f, 0xf1, 0xd0, 0xdd, 0xd1, 0x5b, 0x7a, 0xde, 0xfb, 0xa2, 0x89, 0xdb, 0xc0, 0xb1, 0x14, 0x7f,
+		0x97, 0xb7, 0x50, 0xbc, 0x5f, 0x2c, 0x9a, 0xb8, 0x0a, 0xd3, 0x7a, 0xa3, 0x61, 0x74, 0x03, 0xed,
+		0x1b, 0xf3, 0x66, 0x9c, 0xe8, 0xad, 0xcc, 0x31, 0xbd, 0xa6, 0x3d, 0x0d, 0x71, 0x9b, 0x0e, 0xca,
+		0x38, 0x12, 0xa2, 0x39, 0x1c, 0x1c, 0x1f, 0xbc, 0x66, 0x62, 0xe0, 0xf6, 0x68, 0x0c, 0x81, 0x7f,
+		0xcb, 0x09, 0x64, 0x28, 0x9a, 0xe8, 0x8d, 0x09, 0x18, 0x57, 0xdd, 0xc6, 0xa8, 0x45, 0xaf, 0x37,
+		0x07, 0xbf, 0x9b, 0xfc, 0xcf, 0x7e, 0xf9, 0x71, 0xf7, 0x44, 0xdd, 0x27, 0x74, 0x21, 0x93, 0x45,
+		0x95, 0x39, 0x6d, 0xaf, 0xbf, 0x06, 0xe4, 0x44, 0x7d, 0xbc, 0xdf, 0x07, 0x57, 0xf6, 0xcf, 0x79,
+		0x65, 0xa7, 0xc2, 0x24, 0xdc, 0x57, 0x53, 0x96, 0x53, 0x65, 0x05, 0x4b, 0x25, 0xc8, 0xee, 0xb4,
+		0xda, 0xbe, 0xe1, 0x3e, 0xb8, 0x96, 0x7f, 0xf1, 0xcb, 0x8f, 0xb3, 0x89, 0x86, 0x48, 0x9c, 0x35,
+		0xf7, 0xf4, 0xbd, 0x16, 0x4a, 0xfd, 0x59, 0x7f, 0x53, 0x5d, 0xed, 0xf4, 0xe1, 0x28, 0x9c, 0x62,
+		0x05, 0xe7, 0xb7, 0x75, 0xdb, 0x40, 0xc5, 0x65, 0x38, 0xfa, 0x85, 0xf3, 0x0d, 0xab, 0x65, 0x7a,
+		0x4e, 0x45, 0x2c, 0x5f, 0xc4, 0xf2, 0x45, 0x5e, 0x3e, 0xc2, 0xa7, 0x35, 0x5a, 0xd1, 0xcd, 0x0d,
+		0x7f, 0x33, 0x48, 0x69, 0x43, 0x6c, 0xd9, 0x6a, 0xd1, 0xaf, 0xd3, 0x37, 0x0d, 0xd3, 0xea, 0x88,
+		0xe8, 0x45, 0x9a, 0x20, 0x57, 0x21, 0xae, 0x77, 0xac, 0xbe, 0xc9, 0x5f, 0xce, 0x2b, 0x3d, 0x8e,
+		0x6b, 0xe1, 0x1f, 0xbc, 0x36, 0x7f, 0x94, 0x91, 0xb5, 0x9b, 0x37, 0x16, 0x5b, 0xd6, 0xf9, 0x8e,
+		0xee, 0xec, 0xe1, 0x20, 0x7f, 0xe2, 0xa3, 0x8f, 0x01, 0xaf, 0xaf, 0x6a, 0x3a, 0x1f, 0xf9, 0xcc,
+		0x2f, 0x9c, 0x95, 0x54, 0x8e, 0xcf, 0xdc, 0x8e, 0x4a, 0x17, 0x12, 0x65, 0xa3, 0x71, 0x40, 0x85,
+		0xd5, 0x81, 0x0a, 0x2f, 0xf0, 0x0a, 0x4f, 0x0e, 0x57, 0xc8, 0xbe, 0x7a, 0x5d, 0x36, 0x1a, 0xbe,
+		0x6a, 0xcb, 0x46, 0x23, 0x58, 0x63, 0xa9, 0xfc, 0xc9, 0xff, 0x7e, 0xea, 0xc8, 0xbb, 0x3e, 0x75,
+		0xea, 0xc8, 0xc8, 0x21, 0x53, 0xc6, 0x7f, 0x62, 0xc7, 0x1d, 0xa9, 0xff, 0x2d, 0xc1, 0x09, 0x26,
+		0x2d, 0xde, 0xf2, 0xa0, 0x9b, 0xfb, 0xa3, 0x9e, 0x43, 0xb8, 0x04, 0xd1, 0xa2, 0xb9, 0x8f, 0xbe,
+		0x15, 0x24, 0xa3, 0xf5, 0x7b, 0x6d, 0x11, 0xf4, 0x89, 0xe9, 0xad, 0x5e, 0x3b, 0x18, 0x60, 0xe0,
+		0xbe, 0x89, 0xf6, 0xdd, 0x87, 0x7c, 0x09, 0x21, 0x59, 0x34, 0xf7, 0xc5, 0x1b, 0x08, 0x6f, 0x9a,
+		0xf0, 0x0d, 0x04, 0xdd, 0xdc, 0xef, 0x6e, 0x1f, 0xf6, 0xe9, 0x83, 0xf7, 0x3d, 0x07, 0x0f, 0x72,
+		0x1e, 0xd9, 0x8e, 0x7e, 0xa3, 0x65, 0xee, 0xba, 0xc2, 0xca, 0xd3, 0x9c, 0x15, 0xc7, 0xf8, 0x80,
+		0x88, 0x5c, 0x21, 0xb2, 0xc3, 0x12, 0x38, 0x77, 0xe0, 0x5b, 0x0a, 0x73, 0x07, 0x7b, 0x93, 0xe7,
+		0xc6, 0xcc, 0x9b, 0x83, 0x26, 0xc3, 0x88, 0xd9, 0x33, 0x72, 0x78, 0xc7, 0xbe, 0xdb, 0x36, 0xd6,
+		0x99, 0xfc, 0xaa, 0x04, 0xb9, 0xab, 0x2d, 0xdb, 0xb1, 0x7a, 0xad, 0x86, 0xde, 0xa6, 0x07, 0xe9,
+		0x6f, 0x9e, 0xd8, 0xfa, 0x2f, 0xa5, 0x70, 0x2a, 0xf0, 0x49, 0xb5, 0x27, 0x0c, 0xf0, 0xf8, 0x4d,
+		0xbd, 0xcd, 0x2c, 0x6f, 0xbf, 0xde, 0x1d, 0x64, 0xbb, 0xef, 0x7c, 0xd9, 0x4f, 0x85, 0xe1, 0xe2,
+		0xfb, 0x48, 0xca, 0xf7, 0x63, 0xb0, 0x27, 0xda, 0x6f, 0x36, 0x0d, 0x0f, 0xa3, 0x01, 0x48, 0xd7,
+		0x20, 0xd6, 0xd3, 0x1d, 0x6e, 0x84, 0x94, 0x2e, 0x1d, 0x7a, 0x26, 0xb2, 0x5a, 0x28, 0x0d, 0xf2,
+		0x36, 0xc0, 0xc7, 0xc0, 0x34, 0x4a, 0x2f, 0xf2, 0x86, 0xe8, 0xe1, 0x9b, 0x68, 0xd8, 0x3e, 0xf2,
+		0x4d, 0xf4, 0x43, 0x93, 0x78, 0x69, 0xc3, 0xdc, 0x35, 0x18, 0xe5, 0xe8, 0x1b, 0xa2, 0x9c, 0xed,
+		0xe8, 0xb7, 0x97, 0x29, 0x35, 0xa4, 0xcf, 0x35, 0xd6, 0x6f, 0x48, 0x00, 0x1e, 0x63, 0x88, 0x8e,
+		0x21, 0xcc, 0x22, 0x45, 0x2b, 0x15, 0x21, 0xcc, 0x8f, 0x8c, 0xe2, 0xfd, 0x00, 0x5b, 0x4b, 0x59,
+		0x6c, 0xde, 0xc7, 0x5f, 0x9b, 0x97, 0x58, 0xad, 0xf9, 0xc6, 0x10, 0xdb, 0xd3, 0x2c, 0x86, 0x4c,
+		0xa3, 0x9e, 0xed, 0xc8, 0xd8, 0x4d, 0x68, 0x56, 0x6c, 0x42, 0x19, 0x41, 0x60, 0xd8, 0x58, 0xce,
+		0xfb, 0xf0, 0x97, 0x12, 0xa4, 0xcb, 0x3e, 0x3b, 0x11, 0x63, 0xd0, 0x2d, 0xb3, 0x75, 0xc3, 0xe8,
+		0xb9, 0x31, 0xe8, 0x2c, 0x89, 0xa7, 0x4d, 0xec, 0x53, 0xda, 0xce, 0xbe, 0x38, 0x6d, 0x12, 0x69,
+		0xc4, 0xba, 0x65, 0x6c, 0xdb, 0x2d, 0xc1, 0x67, 0x55, 0x24, 0xd1, 0x9f, 0x6b, 0x1b, 0x8d, 0x3e,
+		0x86, 0xc5, 0x6a, 0x0d, 0xcb, 0x74, 0xf0, 0x8b, 0xfa, 0x2c, 0xcc, 0x25, 0x2f, 0xf2, 0x97, 0x59,
+		0x36, 0x12, 0x69, 0x1a, 0x8e, 0xde, 0x6a, 0xb3, 0x6b, 0xdc, 0x29, 0x55, 0x24, 0xc9, 0x8a, 0xef,
+		0xf8, 0x3f, 0xee, 0x9e, 0x4e, 0x84, 0x72, 0x74, 0x8d, 0xc3, 0xf9, 0x85, 0xd9, 0x45, 0xe6, 0x7d,
+		0xfe, 0x2d, 0x09, 0x92, 0x02, 0x0e, 0x2f, 0x91, 0x77, 0x7b, 0x16, 0x5d, 0xf6, 0xbb, 0xad, 0x86,
+		0xd6, 0xef, 0xb5, 0x78, 0xc7, 0xb3, 0x3c, 0x7b, 0xb3, 0xd5, 0xd8, 0xea, 0xb5, 0x30, 0x32, 0xc2,
+		0xb6, 0x1a, 0xf4, 0x0e, 0xb9, 0x6e, 0x36, 0xdb, 0xa8, 0xb1, 0x5b, 0x2c, 0xf4, 0x2c, 0xa5, 0xca,
+		0xac, 0xe4, 0x2a, 0x2d, 0xd8, 0xea, 0xb5, 0x6c, 0xa2, 0x41, 0x96, 0x73, 0x40, 0xf3, 0xef, 0xde,
+		0x1e, 0x1c, 0xd5, 0xec, 0x17, 0x18, 0x30, 0x3b, 0xbf, 0x99, 0xfd, 0x83, 0x8f, 0x3e, 0x26, 0xdf,
+		0x16, 0x5a, 0x73, 0xe1, 0xe6, 0xe3, 0x8b, 0x17, 0x17, 0x1f, 0x57, 0x33, 0xb7, 0x7c, 0x30, 0xbc,
+		0x27, 0xaf, 0x4a, 0x90, 0xf1, 0xa3, 0xa2, 0xdb, 0xa4, 0x69, 0x75, 0xf0, 0xaa, 0x12, 0xeb, 0x04,
+		0x4f, 0x61, 0x08, 0x2d, 0x33, 0x99, 0x0b, 0x11, 0x37, 0x84, 0x76, 0x6c, 0x43, 0x98, 0xed, 0xad,
+		0x72, 0x4c, 0xf7, 0xf8, 0x88, 0x8d, 0x30, 0xfd, 0xbd, 0x54, 0xc0, 0x66, 0x7c, 0x22, 0xa4, 0xb9,
+		0xca, 0xdd, 0x84, 0x3f, 0xcc, 0x6a, 0x19, 0x64, 0xab, 0x6b, 0xf4, 0x02, 0xee, 0x2e, 0xa6, 0x3b,
+		0x0a, 0x9f, 0xf8, 0xe8, 0x63, 0xb3, 0xbc, 0x31, 0xdc, 0xe1, 0xc5, 0x1e, 0x0f, 0x55, 0xf3, 0x02,
+		0x83, 0x67, 0x93, 0x97, 0x02, 0x77, 0x04, 0xfa, 0xdb, 0xde, 0x7b, 0x57, 0xb3, 0x43, 0x53, 0xa0,
+		0x68, 0xee, 0x97, 0x0a, 0xbf, 0xeb, 0x91, 0xe6, 0x9b, 0xe5, 0x4d, 0x1a, 0x5a, 0xe5, 0xbf, 0x2f,
+		0x40, 0xc9, 0x20, 0xdf, 0x5e, 0xd1, 0x5b, 0x6d, 0xa3, 0xc9, 0xe3, 0xf6, 0x78, 0x0a, 0xef, 0x4f,
+		0xf1, 0x18, 0x58, 0xf6, 0xad, 0x5b, 0x65, 0x14, 0xdf, 0x4a, 0x96, 0xd9, 0x0c, 0x86, 0xbe, 0x62,
+		0xfc, 0xac, 0x63, 0xdd, 0x30, 0x4c, 0x2e, 0xce, 0xa5, 0x73, 0x87, 0x30, 0x90, 0x54, 0x8e, 0x4a,
+		0xbe, 0x01, 0xe4, 0xa6, 0xd1, 0x36, 0x76, 0x99, 0x1f, 0x65, 0x4f, 0x47, 0xe7, 0x66, 0xfc, 0x5e,
+		0xcd, 0x9f, 0xbc, 0x4b, 0xaa, 0x46, 0x29, 0x91, 0xcd, 0xe0, 0xbe, 0x31, 0xe1, 0x5e, 0x6f, 0x0b,
+		0xed, 0xa3, 0x4f, 0x4f, 0xf8, 0xa7, 0x57, 0x60, 0x9f, 0xf9, 0x28, 0x7e, 0xe9, 0x60, 0xdb, 0x32,
+		0xe9, 0x87, 0x5b, 0xb9, 0x07, 0x2f, 0xc9, 0xee, 0x8d, 0xb8, 0xf9, 0xfc, 0xde, 0xc8, 0x26, 0xe4,
+		0x3c, 0x50, 0xaa, 0xcf, 0x52, 0x87, 0xd5, 0x67, 0x59, 0x97, 0x00, 0x82, 0xe0, 0x17, 0x5e, 0x3d,
+		0x8d, 0x49, 0xcf, 0x18, 0xd2, 0x17, 0x95, 0xf1, 0xba, 0xd7, 0xdf, 0x19, 0x1f, 0x01, 0x62, 0xc2,
+		0x4c, 0xa7, 0x65, 0x6a, 0xb6, 0xd1, 0xde, 0xd1, 0x38, 0xe7, 0x90, 0x6e, 0x9a, 0xb2, 0xff, 0xad,
+		0x87, 0x18, 0xcd, 0x3f, 0xf8, 0xe8, 0x63, 0x79, 0xcf, 0x6e, 0x5c, 0x78, 0x7c, 0xf1, 0xc9, 0xa7,
+		0xd5, 0xe9, 0x4e, 0xcb, 0xac, 0x19, 0xed, 0x9d, 0xb2, 0x4b, 0x98, 0xbc, 0x19, 0x4e, 0x7a, 0x0c,
+		0xb1, 0x4c, 0x6d, 0xcf, 0x6a, 0x37, 0xb5, 0x9e, 0xb1, 0xa3, 0x35, 0xa8, 0xd5, 0x9b, 0xa1, 0x6c,
+		0x3c, 0xee, 0x82, 0x6c, 0x98, 0x57, 0xad, 0x76, 0x53, 0x35, 0x76, 0x96, 0xb1, 0x18, 0x8f, 0xd7,
+		0x3c, 0xec, 0x56, 0x13, 0x6f, 0xeb, 0x45, 0xf1, 0xe1, 0x11, 0x37, 0xb3, 0xda, 0xb4, 0x97, 0x92,
+		0xef, 0xf9, 0xd0, 0xfc, 0x91, 0xd7, 0x3f, 0x34, 0x7f, 0x44, 0xb9, 0x42, 0x5f, 0xc4, 0xe3, 0x53,
+		0xcb, 0xb0, 0xc9, 0x25, 0x48, 0xe9, 0x22, 0xc1, 0xde, 0xdb, 0x3c, 0x60, 0x6a, 0x7a, 0xa0, 0xf8,
+		0xd4, 0x68, 0xbc, 0x7c, 0x7d, 0x53, 0x6f, 0xf5, 0x48, 0x05, 0x77, 0x85, 0x42, 0x56, 0x27, 0x9d,
+		0xe5, 0x9e, 0x78, 0x8b, 0x69, 0xbe, 0x3e, 0xca, 0x37, 0x9e, 0x2a, 0x9d, 0xfe, 0xc4, 0x47, 0x1f,
+		0xbb, 0x9f, 0x93, 0xb9, 0x3e, 0xe0, 0x26, 0x17, 0xf4, 0x06, 0xdd, 0xe7, 0xbe, 0x3e, 0x5f, 0x83,
+		0x04, 0x6b, 0xaa, 0x4d, 0x9e, 0x83, 0xa9, 0x2e, 0xfe, 0x28, 0x48, 0x81, 0x8d, 0xe2, 0xb0, 0xcc,
+		0x53, 0x78, 0xbf, 0x84, 0x30, 0x3c, 0xe5, 0xbd, 0x11, 0x80, 0xf2, 0xf5, 0xeb, 0xf5, 0x5e, 0xab,
+		0xdb, 0x36, 0x9c, 0xaf, 0x54, 0xdf, 0xb7, 0xe0, 0xa8, 0xd7, 0x77, 0xbb, 0xd7, 0x38, 0x7c, 0xff,
+		0x67, 0x3c, 0x07, 0x6a, 0xaf, 0x11, 0x4a, 0xb6, 0x69, 0x3b, 0x2e, 0xd9, 0xe8, 0xe1, 0xc9, 0x96,
+		0x6d, 0x67, 0x98, 0xb3, 0x2f, 0x42, 0xda, 0x63, 0x86, 0x4d, 0xaa, 0x90, 0x74, 0xf8, 0x6f, 0xce,
+		0x60, 0x65, 0x34, 0x83, 0x05, 0x5a, 0x60, 0xc9, 0x16, 0xe8, 0x78, 0xcb, 0x0a, 0x7c, 0x73, 0xe4,
+		0x6b, 0x53, 0xc6, 0x70, 0x6f, 0xca, 0x95, 0x73, 0xf4, 0x9e, 0xf7, 0xa6, 0x8c, 0x80, 0x8f, 0xa9,
+		0x9f, 0x94, 0x40, 0x5e, 0xb3, 0x9a, 0xfd, 0xb6, 0xe1, 0x63, 0xc0, 0x3c, 0xa4, 0x3b, 0x34, 0x4f,
+		0xf3, 0x79, 0x01, 0x81, 0x65, 0xe1, 0x07, 0x63, 0xff, 0x66, 0x74, 0xed, 0x7d, 0x11, 0x98, 0xd9,
+		0x12, 0x8a, 0xe9, 0x6b, 0x7f, 0x78, 0xb7, 0x20, 0x61, 0x98, 0x4e, 0xaf, 0xe5, 0xde, 0x0b, 0x79,
+		0x7c, 0x94, 0x38, 0x87, 0x74, 0xaa, 0x62, 0x3a, 0xbd, 0x7d, 0xbf, 0x70, 0x0b, 0x5a, 0x3e, 0x7e,
+		0x7c, 0x20, 0x0a, 0x85, 0x51, 0xa8, 0x78, 0x50, 0xdf, 0xe8, 0x19, 0x34, 0x23, 0xf8, 0xb2, 0x6d,
+		0x4e, 0x64, 0xf3, 0x15, 0x55, 0x05, 0xdc, 0x31, 0xe0, 0xbc, 0x41, 0xd0, 0x7b, 0xdb, 0x22, 0xe4,
+		0x3c, 0x0a, 0x74, 0x4d, 0xad, 0x43, 0x5e, 0x3c, 0x9e, 0xb4, 0xad, 0xb7, 0x75, 0x7c, 0x0e, 0x3c,
+		0x7a, 0x78, 0x73, 0x46, 0x3c, 0xc0, 0x54, 0x62, 0x24, 0x48, 0x05, 0x12, 0x82, 0x5a, 0xec, 0xf0,
+		0xd4, 0x04, 0x2e, 0x3e, 0x93, 0xe5, 0x5f, 0xf3, 0xa8, 0xa1, 0x15, 0x53, 0xd3, 0x6e, 0x5e, 0xb5,
+		0x39, 0x6e, 0x51, 0x8d, 0x1f, 0xb8, 0xa8, 0x72, 0x33, 0xfb, 0x47, 0xa2, 0x30, 0xad, 0x1a, 0xcd,
+		0xbf, 0xfd, 0xc3, 0xb2, 0x09, 0xc0, 0xa6, 0x2a, 0x2e, 0x12, 0x85, 0xd8, 0xbd, 0xce, 0xf7, 0x14,
+		0x23, 0x52, 0xb6, 0x9d, 0xbf, 0xae, 0x11, 0xfa, 0x2f, 0x11, 0xc8, 0xf8, 0x47, 0xe8, 0xef, 0xe4,
+		0x7a, 0x4c, 0xd6, 0x3d, 0x35, 0xc5, 0x02, 0x20, 0x1e, 0x1d, 0xa5, 0xa6, 0x86, 0xa4, 0x79, 0x8c,
+		0x7e, 0xfa, 0xc5, 0x18, 0xc4, 0xf9, 0xd5, 0xea, 0x8d, 0x21, 0xb3, 0x7d, 0xec, 0xb3, 0xe6, 0x59,
+		0xf1, 0x32, 0x7c, 0xa8, 0xd5, 0xfe, 0x10, 0xe0, 0x7b, 0xf1, 0x5a, 0xe0, 0xbe, 0x36, 0x46, 0x43,
+		0xa2, 0xcf, 0xc5, 0xed, 0xbd, 0x4d, 0x17, 0x3e, 0xfd, 0xb6, 0xe6, 0xe9, 0x61, 0x84, 0xc1, 0xa7,
+		0xb6, 0x2a, 0x2c, 0x87, 0x5c, 0x00, 0xb2, 0xe7, 0x7a, 0xd0, 0x34, 0x8f, 0x11, 0xd2, 0x99, 0x2c,
+		0x7d, 0xf1, 0x78, 0xda, 0x2b, 0x15, 0x28, 0x78, 0x12, 0x6c, 0x99, 0x4d, 0x8d, 0x79, 0x9b, 0xf9,
+		0xb7, 0xd7, 0x31, 0xa7, 0x8c, 0x19, 0xe4, 0xdd, 0x12, 0xdb, 0x01, 0x0c, 0xb8, 0x75, 0xf8, 0x06,
+		0xac, 0x3e, 0xc1, 0xc4, 0xf8, 0xfc, 0x6b, 0xf3, 0x73, 0xfb, 0x7a, 0xa7, 0xbd, 0xa4, 0x84, 0xd0,
+		0x51, 0xc2, 0x3c, 0x4d, 0xb8, 0x2f, 0x08, 0xba, 0x85, 0x48, 0x15, 0x64, 0xbc, 0x22, 0xd4, 0xb3,
+		0x1c, 0xa6, 0x6c, 0x76, 0x0c, 0xf1, 0x75, 0x81, 0x13, 0x8b, 0x21, 0xbe, 0xff, 0x45, 0xf4, 0xa1,
+		0xf3, 0xa3, 0xe9, 0xdc, 0x0d, 0x63, 0x5f, 0xe5, 0x78, 0x57, 0x0c, 0x8c, 0x6c, 0x9c, 0x0f, 0xf0,
+		0x9a, 0xbb, 0xc8, 0x6c, 0xad, 0x6b, 0xf4, 0xf8, 0x0b, 0x09, 0x49, 0xca, 0xd8, 0x93, 0x7e, 0xe6,
+		0x33, 0xcf, 0x97, 0xbd, 0x69, 0xf4, 0xe8, 0x59, 0xfa, 0xd2, 0x83, 0x38, 0xe7, 0xee, 0x7c, 0xe6,
+		0x17, 0xce, 0x9e, 0xf4, 0x79, 0xc3, 0x5d, 0x0f, 0xc0, 0x79, 0x26, 0x28, 0xb8, 0x33, 0x20, 0xde,
+		0x52, 0xe6, 0xbb, 0xe9, 0x0f, 0xbe, 0xcd, 0x94, 0x74, 0xf0, 0x26, 0xcd, 0xc3, 0x0f, 0x6c, 0xd2,
+		0x7c, 0x13, 0xfd, 0xad, 0xde, 0x4a, 0x12, 0x19, 0xc7, 0x13, 0xbf, 0x8c, 0x73, 0x24, 0xaa, 0x3f,
+		0x8e, 0x28, 0xff, 0x5e, 0x82, 0x13, 0x43, 0x73, 0xc2, 0x6d, 0x72, 0x03, 0x48, 0xcf, 0x57, 0x48,
+		0x65, 0x4b, 0xbc, 0x42, 0x7d, 0x6f, 0x53, 0x6c, 0xba, 0x37, 0x58, 0xfa, 0x15, 0x5a, 0x12, 0xb9,
+		0x3e, 0xfc, 0x1d, 0x09, 0x66, 0xfd, 0x0d, 0x70, 0xbb, 0x52, 0x83, 0x8c, 0xbf, 0xea, 0x82, 0x74,
+		0xb0, 0x5f, 0xca, 0x4f, 0xc3, 0xdf, 0xfe, 0x00, 0x11, 0x72, 0xdd, 0xd3, 0x3b, 0xcc, 0xd9, 0x7c,
+		0x61, 0x62, 0xa6, 0x88, 0x86, 0x85, 0xea, 0x1f, 0x36, 0x36, 0x7f, 0x22, 0x41, 0x6c, 0xd3, 0xb2,
+		0xda, 0xe4, 0x1d, 0x30, 0x6d, 0x5a, 0x8e, 0x86, 0xf3, 0xd3, 0x68, 0x6a, 0xdc, 0xb7, 0xc2, 0x74,
+		0x7a, 0xe5, 0x40, 0x5e, 0x7d, 0xf6, 0xb5, 0xf9, 0x61, 0xcc, 0xb0, 0x13, 0xa9, 0xbc, 0x69, 0x39,
+		0x25, 0x0a, 0x54, 0xa7, 0x30, 0x64, 0x07, 0xb2, 0xc1, 0xea, 0x98, 0xde, 0x2f, 0x8e, 0xab, 0x2e,
+		0x3b, 0xb6, 0xaa, 0xcc, 0xb6, 0xaf, 0x1e, 0xf6, 0xec, 0xfe, 0x5f, 0xe0, 0xc8, 0x7d, 0x13, 0xc8,
+		0xd7, 0x07, 0xaf, 0x12, 0x5f, 0x81, 0x84, 0xb8, 0x3a, 0x2c, 0x4d, 0x7a, 0x2d, 0xd9, 0xcf, 0x4f,
+		0x8e, 0x4c, 0xbd, 0xf9, 0x1f, 0x8f, 0xc0, 0x09, 0x0c, 0xb9, 0xe6, 0x9e, 0x30, 0xae, 0x1b, 0xd8,
+		0xd1, 0xc3, 0x3e, 0xba, 0x6f, 0x42, 0xfd, 0x74, 0x99, 0x61, 0x6f, 0xdc, 0x75, 0xc8, 0xe3, 0x42,
+		0x8d, 0x9e, 0xb4, 0x37, 0xe6, 0x8c, 0xcb, 0x5a, 0xed, 0x26, 0x6f, 0x11, 0xba, 0xe2, 0xae, 0x43,
+		0xde, 0x34, 0x6e, 0x05, 0xe8, 0x46, 0xef, 0x8d, 0xae, 0x69, 0xdc, 0xf2, 0xd1, 0xf5, 0x22, 0xca,
+		0x62, 0x81, 0xfb, 0x69, 0x97, 0x20, 0x8a, 0x0a, 0x75, 0xea, 0x10, 0xca, 0x03, 0x11, 0x7c, 0x8b,
+		0x63, 0x0d, 0x4e, 0x70, 0x57, 0x8a, 0xbd, 0xb1, 0x43, 0x39, 0x6a, 0xd0, 0x0e, 0x3d, 0x6f, 0xec,
+		0x87, 0xf8, 0x55, 0x32, 0x93, 0xf9, 0x55, 0xfe, 0x7f, 0x98, 0xf1, 0xce, 0x83, 0x3c, 0x47, 0xea,
+		0xf1, 0xe0, 0x2b, 0xf8, 0x19, 0xf7, 0x99, 0xfb, 0xc1, 0xbb, 0xa4, 0x91, 0xc1, 0xbb, 0xa4, 0xe1,
+		0x17, 0x5a, 0x97, 0x66, 0x43, 0xbd, 0xb7, 0x1f, 0x94, 0xe0, 0x58, 0x48, 0xf5, 0xf8, 0xe9, 0x84,
+		0x51, 0x71, 0x9e, 0xd7, 0x43, 0x9e, 0x5c, 0x39, 0x37, 0x4a, 0x0f, 0x84, 0xd0, 0x0e, 0x28, 0x78,
+		0x8f, 0xd2, 0x88, 0x06, 0x7e, 0x42, 0x82, 0xb9, 0xf0, 0x06, 0x96, 0x5b, 0x3b, 0x3b, 0x6f, 0xe0,
+		0x1d, 0xdc, 0x90, 0xeb, 0x1a, 0x64, 0xd3, 0x9b, 0x7f, 0xd1, 0x37, 0xd4, 0x2f, 0x77, 0x26, 0x86,
+		0x76, 0xea, 0xec, 0xe7, 0x25, 0x20, 0xc3, 0x0e, 0x78, 0xb2, 0x02, 0x0b, 0x2f, 0x54, 0x4a, 0xb5,
+		0x6a, 0x9d, 0x46, 0x4a, 0x6d, 0x5c, 0xd1, 0xd6, 0x2a, 0xf5, 0xab, 0x1b, 0xe5, 0x60, 0xf4, 0xcd,
+		0xdc, 0xe9, 0x3b, 0x77, 0x17, 0xee, 0x1f, 0xc6, 0xde, 0x32, 0xed, 0xae, 0xd1, 0x68, 0xed, 0xb4,
+		0x0c, 0xbc, 0x93, 0x7f, 0x5f, 0x28, 0xa1, 0xf2, 0x7a, 0x4d, 0xab, 0xbf, 0x88, 0x1f, 0x2b, 0xb8,
+		0xef, 0xce, 0xdd, 0x85, 0xc2, 0x30, 0x91, 0xf2, 0x7a, 0xad, 0xfe, 0x62, 0x9d, 0xac, 0xc1, 0x83,
+		0xa1, 0xf8, 0x2f, 0x54, 0x56, 0x57, 0x35, 0x1a, 0x50, 0xa6, 0x5d, 0xa9, 0xae, 0x56, 0xe4, 0xc8,
+		0xdc, 0x03, 0x77, 0xee, 0x2e, 0xcc, 0x0f, 0xd3, 0x71, 0x8f, 0x8e, 0x31, 0x8c, 0x82, 0xdf, 0x09,
+		0xfb, 0x55, 0x09, 0xc0, 0xf3, 0x9e, 0x63, 0xf8, 0x59, 0x69, 0x63, 0xbd, 0xec, 0xdd, 0x49, 0xf3,
+		0xf7, 0x91, 0x07, 0xab, 0x79, 0x3d, 0x7a, 0x18, 0x66, 0x83, 0xd0, 0x98, 0xaa, 0x94, 0x65, 0x69,
+		0x2e, 0x73, 0xe7, 0xee, 0x42, 0x92, 0x6d, 0xa9, 0x0d, 0xbc, 0x8b, 0x71, 0x74, 0x18, 0x0e, 0xa3,
+		0x93, 0x22, 0x73, 0xd9, 0x3b, 0x77, 0x17, 0x52, 0xee, 0xde, 0x9b, 0x28, 0x40, 0xfc, 0x90, 0x9c,
+		0x5e, 0x74, 0x0e, 0xee, 0xdc, 0x5d, 0x88, 0xb3, 0xb5, 0x81, 0x37, 0xfc, 0x1b, 0xf1, 0x93, 0x6a,
+		0x3b, 0x3d, 0xbd, 0x41, 0xd7, 0xc0, 0x39, 0x38, 0x56, 0x5d, 0xbf, 0xa2, 0x16, 0x97, 0xeb, 0x78,
+		0xfd, 0x2d, 0xd0, 0xec, 0x81, 0xb2, 0xf2, 0xc6, 0x56, 0x69, 0xb5, 0xa2, 0x61, 0x10, 0x1c, 0xbb,
+		0x79, 0x1a, 0x28, 0x7b, 0x61, 0xbd, 0x5e, 0x5d, 0xab, 0xc8, 0x91, 0xd2, 0xa5, 0x91, 0x51, 0x09,
+		0xf7, 0x05, 0x56, 0x1d, 0xcf, 0xee, 0x0a, 0xc4, 0x23, 0xfc, 0x9f, 0x01, 0x00, 0x63, 0xd1, 0x19,
+		0x0c, 0x7d, 0xba, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2478,6 +2951,39 @@ func (this *Metadata) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.WebsiteProof.Equal(that1.WebsiteProof) {
+		return false
+	}
+	return true
+}
+func (this *WebsiteProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WebsiteProof)
+	if !ok {
+		that2, ok := that.(WebsiteProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
 	return true
 }
 func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
@@ -2598,6 +3104,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if this.MaxValidatorChangesPerBlock != that1.MaxValidatorChangesPerBlock {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2883,6 +3392,18 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WebsiteProof != nil {
+		{
+			size, err := m.WebsiteProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.SocialHandleUris) > 0 {
 		for iNdEx := len(m.SocialHandleUris) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SocialHandleUris[iNdEx])
@@ -2902,6 +3423,48 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WebsiteProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebsiteProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebsiteProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Method != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Validator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2923,20 +3486,20 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.UnbondingIds) > 0 {
-		dAtA6 := make([]byte, len(m.UnbondingIds)*10)
-		var j5 int
+		dAtA7 := make([]byte, len(m.UnbondingIds)*10)
+		var j6 int
 		for _, num := range m.UnbondingIds {
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j6++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintStaking(dAtA, i, uint64(j5))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintStaking(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -2965,12 +3528,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintStaking(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -3279,6 +3842,53 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ModuleDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3380,12 +3990,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err11 != nil {
-		return 0, err11
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3446,12 +4056,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3540,6 +4150,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxValidatorChangesPerBlock != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidatorChangesPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3582,12 +4197,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err14 != nil {
-		return 0, err14
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3907,6 +4522,142 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoricalValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKeyType) > 0 {
+		i -= len(m.PubKeyType)
+		copy(dAtA[i:], m.PubKeyType)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKeyType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalValidatorSetDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalValidatorSetDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalValidatorSetDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintStaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -4010,6 +4761,30 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
+	if m.WebsiteProof != nil {
+		l = m.WebsiteProof.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *WebsiteProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Method != 0 {
+		n += 1 + sovStaking(uint64(m.Method))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -4163,6 +4938,25 @@ func (m *Delegation) Size() (n int) {
 	return n
 }
 
+func (m *ModuleDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *UnbondingDelegation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4286,6 +5080,9 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidatorChangesPerBlock != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidatorChangesPerBlock))
+	}
 	return n
 }
 
@@ -4401,18 +5198,77 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *HistoricalValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.PubKeyType)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *HistoricalValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistoricalValidatorSetDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowStaking
@@ -5128,6 +5984,175 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.SocialHandleUris = append(m.SocialHandleUris, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WebsiteProof == nil {
+				m.WebsiteProof = &WebsiteProof{}
+			}
+			if err := m.WebsiteProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebsiteProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebsiteProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebsiteProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= WebsiteProofMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6279,7 +7304,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *ModuleDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6302,15 +7327,15 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6338,7 +7363,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6374,9 +7399,9 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6386,23 +7411,23 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6427,7 +7452,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6450,17 +7475,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6470,16 +7495,29 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6489,12 +7527,147 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
 			postIndex := iNdEx + msglen
@@ -7241,6 +8414,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorChangesPerBlock", wireType)
+			}
+			m.MaxValidatorChangesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorChangesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -8104,6 +9296,395 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoricalValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, HistoricalValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalValidatorSetDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalValidatorSetDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalValidatorSetDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, HistoricalValidator{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -227,13 +227,23 @@ func (d Description) UpdateDescription(d2 Description) (Description, error) {
 		d2.Metadata.ProfilePicUri = d.Metadata.ProfilePicUri
 	}
 
+	// a website proof is only kept as long as the website domain is unchanged
+	metadata := d.Metadata
+	if d2.Metadata.WebsiteProof != nil {
+		metadata.WebsiteProof = d2.Metadata.WebsiteProof
+	} else if metadata.WebsiteProof != nil {
+		if domain, err := WebsiteDomain(d2.Website); err != nil || domain != metadata.WebsiteProof.Domain {
+			metadata.WebsiteProof = nil
+		}
+	}
+
 	return NewDescription(
 		d2.Moniker,
 		d2.Identity,
 		d2.Website,
 		d2.SecurityContact,
 		d2.Details,
-		d.Metadata,
+		metadata,
 	).Validate()
 }
 
@@ -264,15 +274,22 @@ func (d Description) EnsureLength() (Description, error) {
 
 func (d Description) IsEmpty() bool {
 	return d.Moniker == "" && d.Details == "" && d.Identity == "" && d.Website == "" && d.SecurityContact == "" &&
-		d.Metadata.ProfilePicUri == "" && len(d.Metadata.SocialHandleUris) == 0
+		d.Metadata.ProfilePicUri == "" && len(d.Metadata.SocialHandleUris) == 0 && d.Metadata.WebsiteProof == nil
 }
 
-// Validate calls metadata.Validate() description.EnsureLength()
+// Validate calls metadata.Validate() description.EnsureLength(), and validates the
+// website proof against the website.
 func (d Description) Validate() (Description, error) {
 	if err := d.Metadata.Validate(); err != nil {
 		return d, err
 	}
 
+	if d.Metadata.WebsiteProof != nil {
+		if err := d.Metadata.WebsiteProof.Validate(d.Website); err != nil {
+			return d, err
+		}
+	}
+
 	return d.EnsureLength()
 }
 
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+
+	"cosmossdk.io/errors"
+)
+
+const (
+	// WebsiteProofDNSSubdomain is the subdomain of the website domain holding the
+	// TXT record of a website proof published with the DNS TXT method.
+	WebsiteProofDNSSubdomain = "_cosmos-validator"
+	// WebsiteProofWellKnownPath is the path of the file of a website proof
+	// published with the well-known file method.
+	WebsiteProofWellKnownPath = "/.well-known/cosmos-validator.txt"
+)
+
+// NewWebsiteProof creates a new website proof recording the hash of the given
+// published proof.
+func NewWebsiteProof(domain string, method WebsiteProofMethod, publishedProof []byte) *WebsiteProof {
+	return &WebsiteProof{
+		Domain: domain,
+		Method: method,
+		Hash:   hashPublishedProof(publishedProof),
+	}
+}
+
+// hashPublishedProof returns the hex encoded SHA-256 hash of a published proof,
+// ignoring its leading and trailing white spaces.
+func hashPublishedProof(publishedProof []byte) string {
+	hash := sha256.Sum256(bytes.TrimSpace(publishedProof))
+	return hex.EncodeToString(hash[:])
+}
+
+// WebsiteDomain returns the domain of a validator website, which may omit its scheme.
+func WebsiteDomain(website string) (string, error) {
+	if !strings.Contains(website, "://") {
+		website = "https://" + website
+	}
+
+	u, err := url.Parse(website)
+	if err != nil {
+		return "", err
+	}
+
+	domain := strings.ToLower(u.Hostname())
+	if domain == "" {
+		return "", errors.Wrapf(ErrInvalidWebsiteProof, "website %s has no domain", website)
+	}
+
+	return domain, nil
+}
+
+// Validate checks that the website proof is well-formed and recorded for the
+// domain of the given validator website.
+func (p WebsiteProof) Validate(website string) error {
+	if website == "" {
+		return errors.Wrap(ErrInvalidWebsiteProof, "validator has no website")
+	}
+
+	domain, err := WebsiteDomain(website)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidWebsiteProof, "invalid website %s: %s", website, err)
+	}
+
+	if p.Domain != domain {
+		return errors.Wrapf(ErrInvalidWebsiteProof, "proof domain %s does not match the website domain %s", p.Domain, domain)
+	}
+
+	if p.Method != WebsiteProofMethodDNSTXT && p.Method != WebsiteProofMethodWellKnownFile {
+		return errors.Wrapf(ErrInvalidWebsiteProof, "invalid proof method %s", p.Method)
+	}
+
+	hash, err := hex.DecodeString(p.Hash)
+	if err != nil || len(hash) != sha256.Size || p.Hash != strings.ToLower(p.Hash) {
+		return errors.Wrapf(ErrInvalidWebsiteProof, "proof hash %s is not a hex encoded SHA-256 hash", p.Hash)
+	}
+
+	return nil
+}
+
+// Location returns where the website proof is published: the DNS name holding
+// its TXT record, or the URL of its well-known file.
+func (p WebsiteProof) Location() string {
+	switch p.Method {
+	case WebsiteProofMethodDNSTXT:
+		return WebsiteProofDNSSubdomain + "." + p.Domain
+	case WebsiteProofMethodWellKnownFile:
+		return "https://" + p.Domain + WebsiteProofWellKnownPath
+	default:
+		return ""
+	}
+}
+
+// Verify checks the proof published at the location of the website proof, the
+// value of the TXT record or the content of the well-known file, against the
+// recorded hash, ignoring leading and trailing white spaces. The published proof
+// must hold the operator address of the validator, so that it cannot be recorded
+// by another validator.
+func (p WebsiteProof) Verify(operatorAddr string, publishedProof []byte) error {
+	if hashPublishedProof(publishedProof) != p.Hash {
+		return errors.Wrapf(ErrInvalidWebsiteProof, "published proof does not match the recorded hash %s", p.Hash)
+	}
+
+	if !bytes.Contains(publishedProof, []byte(operatorAddr)) {
+		return errors.Wrapf(ErrInvalidWebsiteProof, "published proof does not hold the validator operator address %s", operatorAddr)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/staking/types"
+)
+
+func TestWebsiteProofValidate(t *testing.T) {
+	publishedProof := []byte("cosmosvaloper15jkng8hytwt22lllv6mw4k89qkqehtahd84ptu")
+
+	testCases := []struct {
+		name    string
+		website string
+		proof   *types.WebsiteProof
+		expErr  string
+	}{
+		{"valid", "https://validator.cosmos", types.NewWebsiteProof("validator.cosmos", types.WebsiteProofMethodDNSTXT, publishedProof), ""},
+		{"website without scheme", "Validator.Cosmos/about", types.NewWebsiteProof("validator.cosmos", types.WebsiteProofMethodWellKnownFile, publishedProof), ""},
+		{"no website", "", types.NewWebsiteProof("validator.cosmos", types.WebsiteProofMethodDNSTXT, publishedProof), "validator has no website"},
+		{"other domain", "https://validator.cosmos", types.NewWebsiteProof("other.cosmos", types.WebsiteProofMethodDNSTXT, publishedProof), "does not match the website domain"},
+		{"unspecified method", "https://validator.cosmos", types.NewWebsiteProof("validator.cosmos", types.WebsiteProofMethodUnspecified, publishedProof), "invalid proof method"},
+		{"invalid hash", "https://validator.cosmos", &types.WebsiteProof{Domain: "validator.cosmos", Method: types.WebsiteProofMethodDNSTXT, Hash: "abcd"}, "not a hex encoded SHA-256 hash"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proof.Validate(tc.website)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidWebsiteProof)
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestWebsiteProofVerify(t *testing.T) {
+	operatorAddr := "cosmosvaloper15jkng8hytwt22lllv6mw4k89qkqehtahd84ptu"
+	publishedProof := []byte("cosmos-validator=" + operatorAddr)
+
+	proof := types.NewWebsiteProof("validator.cosmos", types.WebsiteProofMethodWellKnownFile, publishedProof)
+	require.Equal(t, "https://validator.cosmos/.well-known/cosmos-validator.txt", proof.Location())
+	require.NoError(t, proof.Verify(operatorAddr, publishedProof))
+	require.NoError(t, proof.Verify(operatorAddr, append(publishedProof, '\n')))
+	require.ErrorContains(t, proof.Verify(operatorAddr, []byte("cosmos-validator=other")), "does not match the recorded hash")
+	require.ErrorContains(t, proof.Verify("cosmosvaloper1other", publishedProof), "does not hold the validator operator address")
+
+	proof = types.NewWebsiteProof("validator.cosmos", types.WebsiteProofMethodDNSTXT, publishedProof)
+	require.Equal(t, "_cosmos-validator.validator.cosmos", proof.Location())
+}
+
+func TestUpdateDescriptionWebsiteProof(t *testing.T) {
+	proof := types.NewWebsiteProof("validator.cosmos", types.WebsiteProofMethodDNSTXT, []byte("proof"))
+	d1 := types.Description{Website: "https://validator.cosmos"}
+
+	// the website proof is recorded
+	d, err := d1.UpdateDescription(types.Description{
+		Website:  types.DoNotModifyDesc,
+		Metadata: types.Metadata{WebsiteProof: proof},
+	})
+	require.NoError(t, err)
+	require.Equal(t, proof, d.Metadata.WebsiteProof)
+
+	// the website proof is kept while the website domain is unchanged
+	d, err = d.UpdateDescription(types.Description{Website: "https://validator.cosmos/about"})
+	require.NoError(t, err)
+	require.Equal(t, proof, d.Metadata.WebsiteProof)
+
+	// and dropped when it changes
+	d, err = d.UpdateDescription(types.Description{Website: "https://other.cosmos"})
+	require.NoError(t, err)
+	require.Nil(t, d.Metadata.WebsiteProof)
+
+	// a website proof of another domain is rejected
+	_, err = d.UpdateDescription(types.Description{
+		Website:  types.DoNotModifyDesc,
+		Metadata: types.Metadata{WebsiteProof: proof},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidWebsiteProof)
+}
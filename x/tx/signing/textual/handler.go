@@ -47,6 +47,11 @@ type SignModeOptions struct {
 	// TypeResolver are the protobuf type resolvers to use for resolving message
 	// types. If it is nil, then a dynamicpb will be used on top of FileResolver.
 	TypeResolver protoregistry.MessageTypeResolver
+
+	// Locales are the locales in which the screens can be displayed, in
+	// addition to the canonical English screens. They do not affect the sign
+	// bytes.
+	Locales []Locale
 }
 
 // SignModeHandler holds the configuration for dispatching
@@ -64,6 +69,8 @@ type SignModeHandler struct {
 	// - Protobuf timestamp
 	// - Protobuf duration
 	messages map[protoreflect.FullName]ValueRenderer
+	// locales defines the localizers of the screens, by locale tag.
+	locales map[string]*localizer
 }
 
 // NewSignModeHandler returns a new SignModeHandler which generates sign bytes and provides  value renderers.
@@ -82,9 +89,20 @@ func NewSignModeHandler(o SignModeOptions) (*SignModeHandler, error) {
 		coinMetadataQuerier: o.CoinMetadataQuerier,
 		fileResolver:        o.FileResolver,
 		typeResolver:        o.TypeResolver,
+		locales:             make(map[string]*localizer, len(o.Locales)),
 	}
 	t.init()
 
+	for _, l := range o.Locales {
+		if err := l.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := t.locales[l.Tag]; ok {
+			return nil, fmt.Errorf("duplicate locale %s", l.Tag)
+		}
+		t.locales[l.Tag] = newLocalizer(l)
+	}
+
 	return t, nil
 }
 
@@ -194,6 +212,22 @@ func (r *SignModeHandler) DefineMessageRenderer(name protoreflect.FullName, vr V
 // GetSignBytes returns the transaction sign bytes which is the CBOR representation
 // of a list of screens created from the TX data.
 func (r *SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	screens, err := NewTxValueRenderer(r).Format(ctx, r.textualData(signerData, txData))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = encode(screens, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// textualData returns the value rendered by the transaction value renderer.
+func (r *SignModeHandler) textualData(signerData signing.SignerData, txData signing.TxData) protoreflect.Value {
 	data := &textualpb.TextualData{
 		BodyBytes:     txData.BodyBytes,
 		AuthInfoBytes: txData.AuthInfoBytes,
@@ -206,18 +240,7 @@ func (r *SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.S
 		},
 	}
 
-	screens, err := NewTxValueRenderer(r).Format(ctx, protoreflect.ValueOf(data.ProtoReflect()))
-	if err != nil {
-		return nil, err
-	}
-
-	var buf bytes.Buffer
-	err = encode(screens, &buf)
-	if err != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
+	return protoreflect.ValueOf(data.ProtoReflect())
 }
 
 func (r *SignModeHandler) Mode() signingv1beta1.SignMode {
@@ -0,0 +1,222 @@
+package textual
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/x/tx/signing"
+)
+
+var (
+	// placeholderRe matches the numbered placeholders of a localization template, e.g. {0}.
+	placeholderRe = regexp.MustCompile(`\{([0-9])\}`)
+	// adjacentPlaceholdersRe matches placeholders which are not separated by any text.
+	adjacentPlaceholdersRe = regexp.MustCompile(`\{[0-9]\}\{[0-9]\}`)
+)
+
+// Locale defines the translations of the canonical (English) texts of the
+// SIGN_MODE_TEXTUAL screens in a language.
+//
+// Localization only applies to the screens displayed to the user: the sign
+// bytes are always computed from the canonical screens, so that signatures do
+// not depend on the language of the signer.
+type Locale struct {
+	// Tag is the BCP 47 language tag of the locale, e.g. "fr" or "pt-BR".
+	Tag string `json:"tag"`
+
+	// Messages maps the canonical texts of the screens, either titles or
+	// contents, to their translation. A canonical text can be a template
+	// holding numbered placeholders from {0} to {9}, each matching any text,
+	// e.g. "This transaction has {0} Messages". The text matched by a
+	// placeholder is itself localized before being substituted in the
+	// translation, which must hold the same placeholders as the template.
+	Messages map[string]string `json:"messages"`
+}
+
+// LoadLocale reads a JSON encoded Locale.
+func LoadLocale(r io.Reader) (Locale, error) {
+	var l Locale
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&l); err != nil {
+		return Locale{}, fmt.Errorf("failed to decode locale: %w", err)
+	}
+
+	return l, l.Validate()
+}
+
+// Validate returns an error if the locale is malformed.
+func (l Locale) Validate() error {
+	if l.Tag == "" {
+		return errors.New("locale tag cannot be empty")
+	}
+
+	for canonical, localized := range l.Messages {
+		if canonical == "" {
+			return fmt.Errorf("locale %s: canonical text cannot be empty", l.Tag)
+		}
+
+		placeholders := templatePlaceholders(canonical)
+		if len(placeholders) > 0 && placeholderRe.ReplaceAllString(canonical, "") == "" {
+			return fmt.Errorf("locale %s: template %q must hold text besides placeholders", l.Tag, canonical)
+		}
+		if adjacentPlaceholdersRe.MatchString(canonical) {
+			return fmt.Errorf("locale %s: template %q has adjacent placeholders", l.Tag, canonical)
+		}
+		if len(placeholders) != len(placeholderRe.FindAllString(canonical, -1)) {
+			return fmt.Errorf("locale %s: template %q has duplicate placeholders", l.Tag, canonical)
+		}
+		if !slices.Equal(placeholders, templatePlaceholders(localized)) {
+			return fmt.Errorf("locale %s: translation %q must hold the placeholders of template %q", l.Tag, localized, canonical)
+		}
+	}
+
+	return nil
+}
+
+// templatePlaceholders returns the sorted unique placeholders of a template.
+func templatePlaceholders(template string) []string {
+	var placeholders []string
+	for _, m := range placeholderRe.FindAllStringSubmatch(template, -1) {
+		placeholders = append(placeholders, m[1])
+	}
+	slices.Sort(placeholders)
+	return slices.Compact(placeholders)
+}
+
+// localizer translates the canonical texts of the screens using a Locale.
+type localizer struct {
+	exact     map[string]string
+	templates []localizedTemplate
+}
+
+type localizedTemplate struct {
+	re           *regexp.Regexp
+	placeholders []string
+	localized    string
+	literalLen   int
+}
+
+func newLocalizer(l Locale) *localizer {
+	lz := &localizer{exact: map[string]string{}}
+	for canonical, localized := range l.Messages {
+		if !placeholderRe.MatchString(canonical) {
+			lz.exact[canonical] = localized
+			continue
+		}
+
+		var (
+			pattern      strings.Builder
+			placeholders []string
+			literals     = placeholderRe.Split(canonical, -1)
+		)
+		pattern.WriteString("^")
+		for i, m := range placeholderRe.FindAllStringSubmatch(canonical, -1) {
+			pattern.WriteString(regexp.QuoteMeta(literals[i]))
+			pattern.WriteString("(.+?)")
+			placeholders = append(placeholders, m[1])
+		}
+		pattern.WriteString(regexp.QuoteMeta(literals[len(literals)-1]))
+		pattern.WriteString("$")
+
+		lz.templates = append(lz.templates, localizedTemplate{
+			re:           regexp.MustCompile(pattern.String()),
+			placeholders: placeholders,
+			localized:    localized,
+			literalLen:   len(strings.Join(literals, "")),
+		})
+	}
+
+	// The most specific templates are tried first, ties are broken by the
+	// template itself so that localization is deterministic.
+	slices.SortFunc(lz.templates, func(a, b localizedTemplate) int {
+		if a.literalLen != b.literalLen {
+			return b.literalLen - a.literalLen
+		}
+		return strings.Compare(a.re.String(), b.re.String())
+	})
+
+	return lz
+}
+
+// localize returns the translation of a canonical text, or the text itself if
+// the locale has no translation for it.
+func (lz *localizer) localize(text string) string {
+	if text == "" {
+		return text
+	}
+	if localized, ok := lz.exact[text]; ok {
+		return localized
+	}
+
+	for _, t := range lz.templates {
+		matches := t.re.FindStringSubmatch(text)
+		if matches == nil {
+			continue
+		}
+
+		args := make(map[string]string, len(t.placeholders))
+		for i, p := range t.placeholders {
+			// the matched texts are strictly shorter than the text, as the
+			// templates hold some text besides the placeholders.
+			args[p] = lz.localize(matches[i+1])
+		}
+
+		return placeholderRe.ReplaceAllStringFunc(t.localized, func(placeholder string) string {
+			return args[placeholder[1:len(placeholder)-1]]
+		})
+	}
+
+	return text
+}
+
+// Localize returns the screens with their titles and contents translated in
+// the given locale. The screens must be canonical screens, e.g. decoded from
+// the sign bytes. An empty locale returns the canonical screens.
+func (r *SignModeHandler) Localize(locale string, screens []Screen) ([]Screen, error) {
+	if locale == "" {
+		return screens, nil
+	}
+
+	lz, ok := r.locales[locale]
+	if !ok {
+		return nil, fmt.Errorf("unknown locale %s", locale)
+	}
+
+	localized := make([]Screen, len(screens))
+	for i, s := range screens {
+		s.Title = lz.localize(s.Title)
+		s.Content = lz.localize(s.Content)
+		localized[i] = s
+	}
+
+	return localized, nil
+}
+
+// Locales returns the tags of the locales of the handler, sorted.
+func (r *SignModeHandler) Locales() []string {
+	tags := make([]string, 0, len(r.locales))
+	for tag := range r.locales {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// GetLocalizedScreens returns the screens of the transaction translated in the
+// given locale, for display purposes. The sign bytes returned by GetSignBytes
+// are not affected by the locale.
+func (r *SignModeHandler) GetLocalizedScreens(ctx context.Context, locale string, signerData signing.SignerData, txData signing.TxData) ([]Screen, error) {
+	screens, err := NewTxValueRenderer(r).Format(ctx, r.textualData(signerData, txData))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Localize(locale, screens)
+}
@@ -0,0 +1,145 @@
+package textual_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+const frLocale = `{
+	"tag": "fr",
+	"messages": {
+		"Chain id": "ID de chaîne",
+		"Account number": "Numéro de compte",
+		"Amount": "Montant",
+		"True": "Vrai",
+		"End of {0}": "Fin de {0}",
+		"{0} ({1}/{2})": "{0} ({1} sur {2})",
+		"This transaction has {0} Messages": "Cette transaction contient {0} messages"
+	}
+}`
+
+func TestLocaleValidate(t *testing.T) {
+	testcases := []struct {
+		name   string
+		locale textual.Locale
+		expErr string
+	}{
+		{"valid", textual.Locale{Tag: "fr", Messages: map[string]string{"Fee": "Frais", "End of {0}": "Fin de {0}"}}, ""},
+		{"empty tag", textual.Locale{}, "locale tag cannot be empty"},
+		{"empty canonical text", textual.Locale{Tag: "fr", Messages: map[string]string{"": "Vide"}}, "canonical text cannot be empty"},
+		{"only placeholders", textual.Locale{Tag: "fr", Messages: map[string]string{"{0}": "{0}"}}, "must hold text besides placeholders"},
+		{"adjacent placeholders", textual.Locale{Tag: "fr", Messages: map[string]string{"{0}{1} tokens": "{0}{1} jetons"}}, "has adjacent placeholders"},
+		{"duplicate placeholders", textual.Locale{Tag: "fr", Messages: map[string]string{"{0} and {0}": "{0} et {0}"}}, "has duplicate placeholders"},
+		{"missing placeholder", textual.Locale{Tag: "fr", Messages: map[string]string{"End of {0}": "Fin"}}, "must hold the placeholders"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.locale.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+
+	_, err := textual.LoadLocale(strings.NewReader(`{"tag": "fr", "unknown": {}}`))
+	require.ErrorContains(t, err, "unknown field")
+
+	locale, err := textual.LoadLocale(strings.NewReader(frLocale))
+	require.NoError(t, err)
+	_, err = textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: mockCoinMetadataQuerier, Locales: []textual.Locale{locale, locale}})
+	require.ErrorContains(t, err, "duplicate locale fr")
+}
+
+func TestLocalize(t *testing.T) {
+	locale, err := textual.LoadLocale(strings.NewReader(frLocale))
+	require.NoError(t, err)
+	tr, err := textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: mockCoinMetadataQuerier, Locales: []textual.Locale{locale}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fr"}, tr.Locales())
+
+	screens := []textual.Screen{
+		{Content: "This transaction has 2 Messages"},
+		{Title: "Chain id", Content: "my-chain"},
+		{Title: "Amount (1/2)", Content: "10 ATOM", Indent: 2},
+		{Title: "Account number", Content: "1", Expert: true},
+		{Content: "End of Amount"},
+		{Title: "Memo", Content: "True"},
+	}
+
+	localized, err := tr.Localize("fr", screens)
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Content: "Cette transaction contient 2 messages"},
+		{Title: "ID de chaîne", Content: "my-chain"},
+		{Title: "Montant (1 sur 2)", Content: "10 ATOM", Indent: 2},
+		{Title: "Numéro de compte", Content: "1", Expert: true},
+		{Content: "Fin de Montant"},
+		{Title: "Memo", Content: "Vrai"},
+	}, localized)
+
+	// the canonical screens are left untouched
+	require.Equal(t, "Chain id", screens[1].Title)
+	canonical, err := tr.Localize("", screens)
+	require.NoError(t, err)
+	require.Equal(t, screens, canonical)
+
+	_, err = tr.Localize("de", screens)
+	require.ErrorContains(t, err, "unknown locale de")
+}
+
+func TestLocalizedSignBytes(t *testing.T) {
+	raw, err := os.ReadFile("./internal/testdata/e2e.json")
+	require.NoError(t, err)
+
+	var testcases []e2eJSONTest
+	require.NoError(t, json.Unmarshal(raw, &testcases))
+
+	locale, err := textual.LoadLocale(strings.NewReader(frLocale))
+	require.NoError(t, err)
+	tr, err := textual.NewSignModeHandler(textual.SignModeOptions{CoinMetadataQuerier: mockCoinMetadataQuerier, Locales: []textual.Locale{locale}})
+	require.NoError(t, err)
+
+	for _, tc := range testcases {
+		if tc.Error {
+			continue
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			_, bodyBz, _, authInfoBz, signerData := createTextualData(t, tc.Proto, tc.SignerData)
+			ctx := addMetadataToContext(context.Background(), tc.Metadata)
+			txData := signing.TxData{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz}
+
+			// the locales do not affect the sign bytes
+			signDoc, err := tr.GetSignBytes(ctx, signerData, txData)
+			require.NoError(t, err)
+			require.Equal(t, tc.Cbor, hex.EncodeToString(signDoc))
+
+			screens, err := tr.GetLocalizedScreens(ctx, "", signerData, txData)
+			require.NoError(t, err)
+			require.Equal(t, tc.Screens, screens)
+
+			localized, err := tr.GetLocalizedScreens(ctx, "fr", signerData, txData)
+			require.NoError(t, err)
+			require.Len(t, localized, len(tc.Screens))
+			for i, screen := range localized {
+				if tc.Screens[i].Title == "Chain id" {
+					require.Equal(t, "ID de chaîne", screen.Title)
+				}
+				require.Equal(t, tc.Screens[i].Indent, screen.Indent)
+				require.Equal(t, tc.Screens[i].Expert, screen.Expert)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/header"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	flagSnapshotHeight = "snapshot-height"
+	flagSnapshotFormat = "snapshot-format"
+	flagGenesisFile    = "genesis-file"
+	flagBlockTime      = "block-time"
+	flagDryRunDir      = "dry-run-dir"
+)
+
+// dryRunApp is the application interface required to dry-run an upgrade.
+// It is implemented by apps built on baseapp.
+type dryRunApp interface {
+	servertypes.Application
+
+	NewUncachedContext(isCheckTx bool, header cmtproto.Header) sdk.Context
+}
+
+// DryRunUpgradeCmd returns a command applying the upgrade handler of a plan on the state of a local
+// snapshot or of an exported genesis, and reporting the time taken, the module versions changed and
+// the state changes per store, without persisting anything.
+// upgradeKeeper returns the upgrade keeper of the app, in which the upgrade handlers are registered.
+func DryRunUpgradeCmd[T servertypes.Application](appCreator servertypes.AppCreator[T], upgradeKeeper func(T) *keeper.Keeper) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run-upgrade <plan-name>",
+		Short: "Rehearse an upgrade against a state snapshot or export",
+		Long: `Rehearse an upgrade by applying the upgrade handler of the given plan, including the module migrations
+it runs, on the state of a local state sync snapshot or of an exported genesis, at the height following that state.
+The time taken by the upgrade, the module versions it changed and its state changes per store are reported.
+
+The state is loaded in a separate directory, so that the state of the node is left untouched, and the upgrade
+is never committed. If the plan is scheduled in the state, the scheduled plan is used.`,
+		Example: fmt.Sprintf(`%[1]s dry-run-upgrade v2 --snapshot-height 1000000
+%[1]s dry-run-upgrade v2 --genesis-file exported-genesis.json`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			planName := args[0]
+			cmd.SilenceUsage = true
+
+			snapshotHeight, err := cmd.Flags().GetUint64(flagSnapshotHeight)
+			if err != nil {
+				return err
+			}
+			snapshotFormat, err := cmd.Flags().GetUint32(flagSnapshotFormat)
+			if err != nil {
+				return err
+			}
+			genesisFile, err := cmd.Flags().GetString(flagGenesisFile)
+			if err != nil {
+				return err
+			}
+			if (snapshotHeight == 0) == (genesisFile == "") {
+				return fmt.Errorf("exactly one of --%s or --%s must be provided", flagSnapshotHeight, flagGenesisFile)
+			}
+
+			blockTime := time.Now().UTC()
+			if s, _ := cmd.Flags().GetString(flagBlockTime); s != "" {
+				if blockTime, err = time.Parse(time.RFC3339, s); err != nil {
+					return fmt.Errorf("invalid block time: %w", err)
+				}
+			}
+
+			dir, err := cmd.Flags().GetString(flagDryRunDir)
+			if err != nil {
+				return err
+			}
+			if dir == "" {
+				dir, err = os.MkdirTemp("", "dry-run-upgrade")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(dir)
+			}
+
+			// the genesis of the node is used for the chain ID of the snapshot
+			if genesisFile == "" {
+				genesisFile = serverCtx.Config.GenesisFile()
+			}
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+			if err != nil {
+				return err
+			}
+			genDoc, err := appGenesis.ToGenesisDoc()
+			if err != nil {
+				return err
+			}
+
+			db, err := server.OpenDB(dir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+
+			appOpts := dryRunAppOptions{
+				AppOptions: serverCtx.Viper,
+				overrides: map[string]any{
+					flags.FlagHome:                       dir,
+					flags.FlagChainID:                    genDoc.ChainID,
+					server.FlagHaltHeight:                0,
+					server.FlagHaltTime:                  0,
+					server.FlagStateSyncSnapshotInterval: 0,
+					"indexer":                            nil,
+					"streaming":                          nil,
+				},
+			}
+			app := appCreator(serverCtx.Logger, db, nil, appOpts)
+			defer app.Close()
+
+			dApp, ok := any(app).(dryRunApp)
+			if !ok {
+				return fmt.Errorf("app %T does not support upgrade dry-runs", app)
+			}
+
+			if snapshotHeight > 0 {
+				err = restoreSnapshot(serverCtx, app, snapshotHeight, snapshotFormat)
+			} else {
+				err = initChain(app, genDoc)
+			}
+			if err != nil {
+				return err
+			}
+
+			height := app.CommitMultiStore().LastCommitID().Version + 1
+			ctx := dApp.NewUncachedContext(false, cmtproto.Header{ChainID: genDoc.ChainID, Height: height, Time: blockTime}).
+				WithHeaderInfo(header.Info{ChainID: genDoc.ChainID, Height: height, Time: blockTime})
+
+			k := upgradeKeeper(app)
+			plan, err := k.GetUpgradePlan(ctx)
+			if err != nil && !errors.Is(err, types.ErrNoUpgradePlanFound) {
+				return err
+			}
+			if plan.Name != planName {
+				plan = types.Plan{Name: planName, Height: height}
+			}
+
+			report, err := k.DryRunUpgrade(ctx, plan)
+			if err != nil {
+				return err
+			}
+
+			if output, _ := cmd.Flags().GetString(flags.FlagOutput); output == flags.OutputFormatJSON {
+				bz, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			} else if err := printDryRunReport(cmd.OutOrStdout(), report); err != nil {
+				return err
+			}
+
+			if report.Error != "" {
+				return fmt.Errorf("upgrade %s failed: %s", report.Name, report.Error)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagSnapshotHeight, 0, "Height of the local snapshot to load the state from")
+	cmd.Flags().Uint32(flagSnapshotFormat, snapshottypes.CurrentFormat, "Format of the local snapshot to load the state from")
+	cmd.Flags().String(flagGenesisFile, "", "Exported genesis file to load the state from")
+	cmd.Flags().String(flagBlockTime, "", "Time of the upgrade block in RFC3339 format, defaults to the current time")
+	cmd.Flags().String(flagDryRunDir, "", "Directory of the loaded state, defaults to a temporary directory removed afterwards")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// restoreSnapshot restores the local snapshot at the given height into the app.
+func restoreSnapshot(serverCtx *server.Context, app servertypes.Application, height uint64, format uint32) error {
+	snapshotStore, err := server.GetSnapshotStore(serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := snapshotStore.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d with format %d not found", height, format)
+	}
+
+	abciSnapshot, err := snapshot.ToABCI()
+	if err != nil {
+		return err
+	}
+
+	offerRes, err := app.OfferSnapshot(&abci.OfferSnapshotRequest{Snapshot: &abciSnapshot})
+	if err != nil {
+		return err
+	}
+	if offerRes.Result != abci.OFFER_SNAPSHOT_RESULT_ACCEPT {
+		return fmt.Errorf("snapshot at height %d rejected: %s", height, offerRes.Result)
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		reader, err := snapshotStore.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		chunk, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return err
+		}
+
+		applyRes, err := app.ApplySnapshotChunk(&abci.ApplySnapshotChunkRequest{Index: i, Chunk: chunk})
+		if err != nil {
+			return err
+		}
+		if applyRes.Result != abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT {
+			return fmt.Errorf("snapshot chunk %d rejected: %s", i, applyRes.Result)
+		}
+	}
+
+	return nil
+}
+
+// initChain initializes the app from the exported genesis and commits the initial block, so that the
+// genesis state is the latest committed state of the app.
+func initChain(app servertypes.Application, genDoc *cmttypes.GenesisDoc) error {
+	validators := make([]*cmttypes.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = cmttypes.NewValidator(val.PubKey, val.Power)
+	}
+
+	consensusParams := genDoc.ConsensusParams.ToProto()
+	if _, err := app.InitChain(&abci.InitChainRequest{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		InitialHeight:   genDoc.InitialHeight,
+		ConsensusParams: &consensusParams,
+		Validators:      cmttypes.TM2PB.ValidatorUpdates(cmttypes.NewValidatorSet(validators)),
+		AppStateBytes:   genDoc.AppState,
+	}); err != nil {
+		return err
+	}
+
+	if _, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: genDoc.InitialHeight, Time: genDoc.GenesisTime}); err != nil {
+		return err
+	}
+
+	_, err := app.Commit()
+	return err
+}
+
+// printDryRunReport prints the dry-run report in a human-readable format.
+func printDryRunReport(out io.Writer, report types.DryRunReport) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	if report.Error != "" {
+		fmt.Fprintf(w, "Upgrade %s at height %d failed after %s: %s\n", report.Name, report.Height, report.Duration, report.Error)
+		return w.Flush()
+	}
+
+	fmt.Fprintf(w, "Upgrade %s at height %d applied in %s\n", report.Name, report.Height, report.Duration)
+
+	fmt.Fprintln(w, "\nModule versions:")
+	if len(report.ModuleVersions) == 0 {
+		fmt.Fprintln(w, "  none changed")
+	}
+	for _, change := range report.ModuleVersions {
+		fmt.Fprintf(w, "  %s\t%d -> %d\n", change.Module, change.From, change.To)
+	}
+
+	fmt.Fprintln(w, "\nState changes:")
+	if len(report.StoreChanges) == 0 {
+		fmt.Fprintln(w, "  none")
+	} else {
+		fmt.Fprintln(w, "  STORE\tADDED\tUPDATED\tDELETED")
+	}
+	for _, change := range report.StoreChanges {
+		fmt.Fprintf(w, "  %s\t%d\t%d\t%d\n", change.Store, change.Added, change.Updated, change.Deleted)
+	}
+
+	return w.Flush()
+}
+
+// dryRunAppOptions overrides some of the app options of the node for the dry-run app.
+type dryRunAppOptions struct {
+	servertypes.AppOptions
+
+	overrides map[string]any
+}
+
+func (o dryRunAppOptions) Get(key string) any {
+	if value, ok := o.overrides[key]; ok {
+		return value
+	}
+
+	return o.AppOptions.Get(key)
+}
+
+func (o dryRunAppOptions) GetString(key string) string {
+	return cast.ToString(o.Get(key))
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DryRunUpgrade applies the upgrade plan on a branch of the state, which is then discarded, and reports
+// the time taken by the upgrade handler, the module versions it changed and its state changes per store.
+// The upgrade handler of the plan must be registered. An error of the upgrade handler, including a panic,
+// is reported in the DryRunReport instead of being returned.
+// The context must be backed by the root multistore of the app, so that the state changes can be attributed
+// to their stores.
+func (k Keeper) DryRunUpgrade(ctx context.Context, plan types.Plan) (types.DryRunReport, error) {
+	if !k.HasHandler(plan.Name) {
+		return types.DryRunReport{}, fmt.Errorf("no upgrade handler registered for %s", plan.Name)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	parent := sdkCtx.MultiStore()
+	rootStore, ok := parent.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return types.DryRunReport{}, fmt.Errorf("expected the root multistore, got %T", parent)
+	}
+
+	fromVM, err := k.GetModuleVersionMap(ctx)
+	if err != nil {
+		return types.DryRunReport{}, err
+	}
+
+	// the writes of the upgrade are traced when they are flushed to the branch, so that only
+	// the keys actually written by the upgrade are compared
+	branch := parent.CacheMultiStore()
+	tracer := &writeTracer{keys: map[string]map[string]struct{}{}}
+	upgradeStore := branch.SetTracer(tracer).CacheMultiStore()
+
+	report := types.DryRunReport{Name: plan.Name, Height: plan.Height}
+	start := time.Now()
+	err = k.applyUpgradeRecover(sdkCtx.WithMultiStore(upgradeStore), plan)
+	report.Duration = time.Since(start)
+	if err != nil {
+		report.Error = err.Error()
+		return report, nil
+	}
+
+	upgradeStore.Write()
+	if tracer.err != nil {
+		return types.DryRunReport{}, tracer.err
+	}
+
+	toVM, err := k.GetModuleVersionMap(sdkCtx.WithMultiStore(branch))
+	if err != nil {
+		return types.DryRunReport{}, err
+	}
+
+	for module, to := range toVM {
+		if from := fromVM[module]; from != to {
+			report.ModuleVersions = append(report.ModuleVersions, types.ModuleVersionChange{Module: module, From: from, To: to})
+		}
+	}
+	sort.Slice(report.ModuleVersions, func(i, j int) bool {
+		return report.ModuleVersions[i].Module < report.ModuleVersions[j].Module
+	})
+
+	storeKeys := rootStore.StoreKeysByName()
+	for name, keys := range tracer.keys {
+		storeKey, ok := storeKeys[name]
+		if !ok {
+			return types.DryRunReport{}, fmt.Errorf("unknown store %s", name)
+		}
+
+		before, after := parent.GetKVStore(storeKey), branch.GetKVStore(storeKey)
+		change := types.StoreChange{Store: name}
+		for key := range keys {
+			beforeValue, afterValue := before.Get([]byte(key)), after.Get([]byte(key))
+			switch {
+			case beforeValue == nil && afterValue != nil:
+				change.Added++
+			case beforeValue != nil && afterValue == nil:
+				change.Deleted++
+			case !bytes.Equal(beforeValue, afterValue):
+				change.Updated++
+			}
+		}
+
+		if change.Added+change.Updated+change.Deleted > 0 {
+			report.StoreChanges = append(report.StoreChanges, change)
+		}
+	}
+	sort.Slice(report.StoreChanges, func(i, j int) bool {
+		return report.StoreChanges[i].Store < report.StoreChanges[j].Store
+	})
+
+	return report, nil
+}
+
+// applyUpgradeRecover applies the upgrade plan, converting a panic of the upgrade handler into an error.
+func (k Keeper) applyUpgradeRecover(ctx context.Context, plan types.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade handler panicked: %v", r)
+		}
+	}()
+
+	return k.ApplyUpgrade(ctx, plan)
+}
+
+// writeTracer collects the keys written or deleted in each store from the operations traced by the multistore.
+type writeTracer struct {
+	buf  []byte
+	keys map[string]map[string]struct{}
+	err  error
+}
+
+// traceOperation is an operation traced by the multistore, see cosmossdk.io/store/tracekv.
+type traceOperation struct {
+	Operation string         `json:"operation"`
+	Key       string         `json:"key"`
+	Metadata  map[string]any `json:"metadata"`
+}
+
+// Write implements io.Writer, the operations are written as JSON lines.
+func (t *writeTracer) Write(p []byte) (int, error) {
+	t.buf = append(t.buf, p...)
+	for {
+		i := bytes.IndexByte(t.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+
+		line := t.buf[:i]
+		t.buf = t.buf[i+1:]
+		if t.err == nil {
+			t.err = t.collect(line)
+		}
+	}
+}
+
+func (t *writeTracer) collect(line []byte) error {
+	var op traceOperation
+	if err := json.Unmarshal(line, &op); err != nil {
+		return err
+	}
+
+	if op.Operation != "write" && op.Operation != "delete" {
+		return nil
+	}
+
+	store, ok := op.Metadata["store_name"].(string)
+	if !ok {
+		return errors.New("traced operation without store name")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(op.Key)
+	if err != nil {
+		return err
+	}
+
+	if t.keys[store] == nil {
+		t.keys[store] = map[string]struct{}{}
+	}
+	t.keys[store][string(key)] = struct{}{}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestDryRunUpgrade() {
+	require := s.Require()
+
+	require.NoError(s.upgradeKeeper.SetModuleVersionMap(s.ctx, appmodule.VersionMap{"bank": 1}))
+	store := s.ctx.KVStore(s.key)
+	store.Set([]byte("existing"), []byte("old"))
+	store.Set([]byte("unchanged"), []byte("value"))
+	store.Set([]byte("to-delete"), []byte("value"))
+
+	plan := types.Plan{Name: "dry-run", Height: 11}
+	_, err := s.upgradeKeeper.DryRunUpgrade(s.ctx, plan)
+	require.ErrorContains(err, "no upgrade handler registered for dry-run")
+
+	s.upgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx context.Context, _ types.Plan, vm appmodule.VersionMap) (appmodule.VersionMap, error) {
+		store := sdk.UnwrapSDKContext(ctx).KVStore(s.key)
+		store.Set([]byte("existing"), []byte("new"))
+		store.Set([]byte("unchanged"), []byte("value"))
+		store.Delete([]byte("to-delete"))
+		store.Set([]byte("added"), []byte("value"))
+
+		// writes discarded by the upgrade are not reported
+		cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+		cacheCtx.KVStore(s.key).Set([]byte("discarded"), []byte("value"))
+
+		vm["bank"]++
+		vm["foo"] = 1
+		return vm, nil
+	})
+
+	report, err := s.upgradeKeeper.DryRunUpgrade(s.ctx, plan)
+	require.NoError(err)
+	require.Equal(plan.Name, report.Name)
+	require.Equal(plan.Height, report.Height)
+	require.Empty(report.Error)
+	require.Equal([]types.ModuleVersionChange{
+		{Module: "bank", From: 1, To: 2},
+		{Module: "foo", From: 0, To: 1},
+	}, report.ModuleVersions)
+	// the added keys are the added key, the version of foo and the done marker of the plan,
+	// the updated keys are the existing key and the version of bank
+	require.Equal([]types.StoreChange{
+		{Store: types.StoreKey, Added: 3, Updated: 2, Deleted: 1},
+	}, report.StoreChanges)
+
+	// the state is left untouched
+	require.Equal([]byte("old"), store.Get([]byte("existing")))
+	require.True(store.Has([]byte("to-delete")))
+	require.False(store.Has([]byte("added")))
+	doneHeight, err := s.upgradeKeeper.GetDoneHeight(s.ctx, plan.Name)
+	require.NoError(err)
+	require.Zero(doneHeight)
+	vm, err := s.upgradeKeeper.GetModuleVersionMap(s.ctx)
+	require.NoError(err)
+	require.Equal(appmodule.VersionMap{"bank": 1}, vm)
+
+	// the errors of the upgrade handler are reported
+	s.upgradeKeeper.SetUpgradeHandler(plan.Name, func(context.Context, types.Plan, appmodule.VersionMap) (appmodule.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	report, err = s.upgradeKeeper.DryRunUpgrade(s.ctx, plan)
+	require.NoError(err)
+	require.Equal("migration failed", report.Error)
+	require.Empty(report.ModuleVersions)
+	require.Empty(report.StoreChanges)
+
+	s.upgradeKeeper.SetUpgradeHandler(plan.Name, func(context.Context, types.Plan, appmodule.VersionMap) (appmodule.VersionMap, error) {
+		panic("unexpected state")
+	})
+	report, err = s.upgradeKeeper.DryRunUpgrade(s.ctx, plan)
+	require.NoError(err)
+	require.Equal("upgrade handler panicked: unexpected state", report.Error)
+}
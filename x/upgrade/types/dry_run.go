@@ -0,0 +1,37 @@
+package types
+
+import "time"
+
+// DryRunReport is the result of applying an upgrade plan on a discarded branch of the state.
+type DryRunReport struct {
+	// Name is the name of the upgrade plan.
+	Name string `json:"name"`
+	// Height is the height of the upgrade plan.
+	Height int64 `json:"height"`
+	// Duration is the time taken by the upgrade handler, including the module migrations it runs.
+	Duration time.Duration `json:"duration"`
+	// ModuleVersions are the module versions changed by the upgrade, sorted by module name.
+	ModuleVersions []ModuleVersionChange `json:"module_versions"`
+	// StoreChanges are the state changes of the upgrade per store, sorted by store name.
+	// Only the stores with changes are reported.
+	StoreChanges []StoreChange `json:"store_changes"`
+	// Error is the error returned by the upgrade handler, if any.
+	// In case of error, ModuleVersions and StoreChanges are empty.
+	Error string `json:"error,omitempty"`
+}
+
+// ModuleVersionChange is the change of the consensus version of a module during an upgrade.
+// From is 0 for the modules added by the upgrade.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	From   uint64 `json:"from"`
+	To     uint64 `json:"to"`
+}
+
+// StoreChange counts the keys of a store that were added, updated or deleted during an upgrade.
+type StoreChange struct {
+	Store   string `json:"store"`
+	Added   int    `json:"added"`
+	Updated int    `json:"updated"`
+	Deleted int    `json:"deleted"`
+}